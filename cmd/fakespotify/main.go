@@ -0,0 +1,56 @@
+package main
+
+import (
+	"beyerleinf/spotify-backup/pkg/fakespotify"
+	"beyerleinf/spotify-backup/pkg/logger"
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+func main() {
+	slogger := logger.New("fakespotify", logger.LevelInfo)
+
+	port := flag.Int("port", 8081, "port to listen on")
+	fixturePath := flag.String("fixture", "", "path to a JSON fixture (defaults to the embedded fixture)")
+	pageSize := flag.Int("page-size", 0, "maximum number of items per page (0 honors the requested limit)")
+	rateLimitEvery := flag.Int("rate-limit-every", 0, "answer every n-th API request with 429 Too Many Requests (0 disables it)")
+	retryAfter := flag.Duration("retry-after", time.Second, "Retry-After of rate limited responses")
+	tokenLifetime := flag.Duration("token-lifetime", time.Hour, "lifetime of access tokens")
+	failPaths := flag.String("fail", "", "comma-separated Web API paths that fail with 500 Internal Server Error, e.g. /v1/me/albums")
+	flag.Parse()
+
+	fixture := fakespotify.DefaultFixture()
+	if *fixturePath != "" {
+		var err error
+		fixture, err = fakespotify.LoadFixture(*fixturePath)
+		if err != nil {
+			slogger.Fatal("Failed to load fixture", "err", err)
+			panic(err)
+		}
+	}
+
+	server := fakespotify.New(fixture, fakespotify.Options{
+		MaxPageSize:    *pageSize,
+		RateLimitEvery: *rateLimitEvery,
+		RetryAfter:     *retryAfter,
+		TokenLifetime:  *tokenLifetime,
+		FailPaths:      strings.FieldsFunc(*failPaths, func(r rune) bool { return r == ',' }),
+	})
+
+	slogger.Info(fmt.Sprintf("Starting fake Spotify on [::]:%d", *port),
+		"accounts_url", fmt.Sprintf("http://localhost:%d", *port),
+		"api_url", fmt.Sprintf("http://localhost:%d/v1", *port),
+	)
+
+	e := echo.New()
+	e.HideBanner = true
+	e.HidePort = true
+	e.Use(logger.GetEchoLogger())
+	e.Any("/*", echo.WrapHandler(server))
+
+	e.Logger.Fatal(e.Start(fmt.Sprintf(":%d", *port)))
+}
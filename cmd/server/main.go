@@ -9,21 +9,43 @@ import (
 	uiRouter "beyerleinf/spotify-backup/internal/server/ui/router"
 	uiTmpl "beyerleinf/spotify-backup/internal/server/ui/template"
 	"beyerleinf/spotify-backup/pkg/logger"
+	"beyerleinf/spotify-backup/pkg/request"
 	"beyerleinf/spotify-backup/pkg/router"
+	"beyerleinf/spotify-backup/pkg/service/leader"
+	"beyerleinf/spotify-backup/pkg/service/lock"
+	"beyerleinf/spotify-backup/pkg/service/migration"
+	"beyerleinf/spotify-backup/pkg/service/queue"
+	"beyerleinf/spotify-backup/pkg/service/retention"
+	"beyerleinf/spotify-backup/pkg/service/scheduler"
 	"beyerleinf/spotify-backup/pkg/service/spotify"
 	"beyerleinf/spotify-backup/web"
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
+	"os/signal"
 	"path/filepath"
+	"syscall"
+	"time"
+	_ "time/tzdata"
 
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	_ "github.com/lib/pq"
 )
 
-const storageDir = ".spotify-backup"
+const (
+	storageDir      = ".spotify-backup"
+	shutdownTimeout = 10 * time.Second
+	// schedulerLease is the name of the lease that makes a single
+	// instance queue scheduled backups.
+	schedulerLease = "scheduler"
+)
 
 func main() {
 	slogger := logger.New("main", logger.LevelInfo)
@@ -46,21 +68,27 @@ func main() {
 		cfg.Database.Password,
 	)
 
-	client, err := ent.Open("postgres", dbURL)
+	db, err := sql.Open(dialect.Postgres, dbURL)
 	if err != nil {
 		slogger.Fatal("Failed opening connection to postgres", "err", err)
 		panic(err)
 	}
+
+	// The connection pool is shared with the locker, which holds advisory locks on connections of its own.
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.Postgres, db)))
 	defer client.Close()
 
-	if err := client.Schema.Create(context.Background()); err != nil {
-		slogger.Fatal("Failed creating schema resources", "err", err)
+	locker := lock.NewPostgres(db)
+
+	if err := migration.New(cfg, client).Run(context.Background()); err != nil {
+		slogger.Fatal("Failed migrating database", "err", err)
 		panic(err)
 	}
 
 	slogger.Info("Connected to database")
 
-	healthHandler := handler.NewHealthHandler(client, cfg)
+	schedulerElector := leader.New(cfg, client, schedulerLease)
+	healthHandler := handler.NewHealthHandler(client, schedulerElector, cfg)
 
 	e := echo.New()
 	e.HideBanner = true
@@ -71,10 +99,6 @@ func main() {
 	apiBase := e.Group("/api")
 	uiBase := e.Group("/ui")
 
-	router.SetupRoutes(apiBase,
-		apiRouter.HealthRoutes(healthHandler),
-	)
-
 	renderer, err := uiTmpl.NewRenderer(web.TemplatesFS)
 	if err != nil {
 		slogger.Fatal("Failed to initialize renderer", "err", err)
@@ -83,16 +107,89 @@ func main() {
 	e.Renderer = renderer
 	e.StaticFS("/", web.StaticFS)
 
-	spotifyService := spotify.New(cfg, storageDir)
+	httpClient, err := request.NewClient(cfg)
+	if err != nil {
+		slogger.Fatal("Failed to create http client", "err", err)
+		panic(err)
+	}
 
-	spotifyHandler := uiHandler.NewSpotifyHandler(spotifyService, cfg)
+	jobQueue := queue.New(cfg, client)
+	spotifyService := spotify.New(cfg, storageDir, client, httpClient, jobQueue, locker)
+	jobQueue.Register(spotify.BackupJobType, spotifyService.RunBackupJob)
+	jobQueue.Register(spotify.ApplyRestorePlanJobType, spotifyService.RunApplyRestorePlanJob)
+	jobQueue.Register(spotify.RestorePlaylistJobType, spotifyService.RunRestorePlaylistJob)
+	jobQueue.Register(spotify.RestoreCollectionsJobType, spotifyService.RunRestoreCollectionsJob)
+	pruner := retention.New(cfg, client, locker)
+	jobQueue.Register(retention.PruneJobType, pruner.RunPruneJob)
+	backupScheduler := scheduler.New(cfg, client, spotifyService)
+
+	backupHandler := handler.NewBackupHandler(spotifyService, cfg)
+	jobHandler := handler.NewJobHandler(jobQueue, cfg)
+	playlistHandler := handler.NewPlaylistHandler(spotifyService, cfg)
+	restoreHandler := handler.NewRestoreHandler(spotifyService, cfg)
+
+	router.SetupRoutes(apiBase,
+		apiRouter.HealthRoutes(healthHandler),
+		apiRouter.BackupRoutes(backupHandler),
+		apiRouter.PlaylistSnapshotRoutes(backupHandler),
+		apiRouter.JobRoutes(jobHandler),
+		apiRouter.PlaylistRoutes(playlistHandler),
+		apiRouter.RestorePlanRoutes(restoreHandler),
+	)
+
+	spotifyHandler := uiHandler.NewSpotifyHandler(spotifyService, backupScheduler, pruner, cfg)
+	backupsHandler := uiHandler.NewBackupsHandler(spotifyService, cfg)
+	jobsHandler := uiHandler.NewJobsHandler(jobQueue, cfg)
+	playlistsHandler := uiHandler.NewPlaylistsHandler(spotifyService, cfg)
+	restorePlansHandler := uiHandler.NewRestorePlansHandler(spotifyService, cfg)
 
 	router.SetupRoutes(uiBase,
 		uiRouter.SpotifyRoutes(spotifyHandler),
+		uiRouter.BackupRoutes(backupsHandler),
+		uiRouter.JobRoutes(jobsHandler),
+		uiRouter.PlaylistRoutes(playlistsHandler),
+		uiRouter.RestorePlanRoutes(restorePlansHandler),
 	)
 
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	schedulerDone := make(chan struct{})
+	if cfg.Scheduler.Enabled {
+		go func() {
+			schedulerElector.Run(ctx, backupScheduler.Run)
+			close(schedulerDone)
+		}()
+	} else {
+		slogger.Warn("Scheduler is disabled. Backups only run when triggered manually.")
+		close(schedulerDone)
+	}
+
+	queueDone := make(chan struct{})
+	go func() {
+		jobQueue.Run(ctx)
+		close(queueDone)
+	}()
+
+	go func() {
+		<-ctx.Done()
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+
+		if err := e.Shutdown(shutdownCtx); err != nil {
+			slogger.Error("Failed to shut down server", "err", err)
+		}
+	}()
+
 	slogger.Info(fmt.Sprintf("Starting server on [::]:%d", cfg.Server.Port))
-	e.Logger.Fatal(e.Start(fmt.Sprintf(":%d", cfg.Server.Port)))
+	if err := e.Start(fmt.Sprintf(":%d", cfg.Server.Port)); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		e.Logger.Fatal(err)
+	}
+
+	// Running jobs are interrupted and put back into the queue.
+	<-schedulerDone
+	<-queueDone
 }
 
 func createStorageDir(slogger *logger.Logger) string {
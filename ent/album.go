@@ -0,0 +1,252 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/album"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Album is the model entity for the Album schema.
+type Album struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// SpotifyID holds the value of the "spotify_id" field.
+	SpotifyID string `json:"spotify_id,omitempty"`
+	// URI holds the value of the "uri" field.
+	URI string `json:"uri,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// AlbumType holds the value of the "album_type" field.
+	AlbumType string `json:"album_type,omitempty"`
+	// ReleaseDate holds the value of the "release_date" field.
+	ReleaseDate string `json:"release_date,omitempty"`
+	// TotalTracks holds the value of the "total_tracks" field.
+	TotalTracks int `json:"total_tracks,omitempty"`
+	// Label holds the value of the "label" field.
+	Label string `json:"label,omitempty"`
+	// Upc holds the value of the "upc" field.
+	Upc string `json:"upc,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AlbumQuery when eager-loading is set.
+	Edges        AlbumEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AlbumEdges holds the relations/edges for other nodes in the graph.
+type AlbumEdges struct {
+	// Artists holds the value of the artists edge.
+	Artists []*Artist `json:"artists,omitempty"`
+	// Tracks holds the value of the tracks edge.
+	Tracks []*Track `json:"tracks,omitempty"`
+	// SavedAlbums holds the value of the saved_albums edge.
+	SavedAlbums []*SavedAlbum `json:"saved_albums,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// ArtistsOrErr returns the Artists value or an error if the edge
+// was not loaded in eager-loading.
+func (e AlbumEdges) ArtistsOrErr() ([]*Artist, error) {
+	if e.loadedTypes[0] {
+		return e.Artists, nil
+	}
+	return nil, &NotLoadedError{edge: "artists"}
+}
+
+// TracksOrErr returns the Tracks value or an error if the edge
+// was not loaded in eager-loading.
+func (e AlbumEdges) TracksOrErr() ([]*Track, error) {
+	if e.loadedTypes[1] {
+		return e.Tracks, nil
+	}
+	return nil, &NotLoadedError{edge: "tracks"}
+}
+
+// SavedAlbumsOrErr returns the SavedAlbums value or an error if the edge
+// was not loaded in eager-loading.
+func (e AlbumEdges) SavedAlbumsOrErr() ([]*SavedAlbum, error) {
+	if e.loadedTypes[2] {
+		return e.SavedAlbums, nil
+	}
+	return nil, &NotLoadedError{edge: "saved_albums"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Album) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case album.FieldTotalTracks:
+			values[i] = new(sql.NullInt64)
+		case album.FieldID, album.FieldSpotifyID, album.FieldURI, album.FieldName, album.FieldAlbumType, album.FieldReleaseDate, album.FieldLabel, album.FieldUpc:
+			values[i] = new(sql.NullString)
+		case album.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Album fields.
+func (a *Album) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case album.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				a.ID = value.String
+			}
+		case album.FieldSpotifyID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field spotify_id", values[i])
+			} else if value.Valid {
+				a.SpotifyID = value.String
+			}
+		case album.FieldURI:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field uri", values[i])
+			} else if value.Valid {
+				a.URI = value.String
+			}
+		case album.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				a.Name = value.String
+			}
+		case album.FieldAlbumType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field album_type", values[i])
+			} else if value.Valid {
+				a.AlbumType = value.String
+			}
+		case album.FieldReleaseDate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field release_date", values[i])
+			} else if value.Valid {
+				a.ReleaseDate = value.String
+			}
+		case album.FieldTotalTracks:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_tracks", values[i])
+			} else if value.Valid {
+				a.TotalTracks = int(value.Int64)
+			}
+		case album.FieldLabel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field label", values[i])
+			} else if value.Valid {
+				a.Label = value.String
+			}
+		case album.FieldUpc:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field upc", values[i])
+			} else if value.Valid {
+				a.Upc = value.String
+			}
+		case album.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				a.CreatedAt = value.Time
+			}
+		default:
+			a.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Album.
+// This includes values selected through modifiers, order, etc.
+func (a *Album) Value(name string) (ent.Value, error) {
+	return a.selectValues.Get(name)
+}
+
+// QueryArtists queries the "artists" edge of the Album entity.
+func (a *Album) QueryArtists() *ArtistQuery {
+	return NewAlbumClient(a.config).QueryArtists(a)
+}
+
+// QueryTracks queries the "tracks" edge of the Album entity.
+func (a *Album) QueryTracks() *TrackQuery {
+	return NewAlbumClient(a.config).QueryTracks(a)
+}
+
+// QuerySavedAlbums queries the "saved_albums" edge of the Album entity.
+func (a *Album) QuerySavedAlbums() *SavedAlbumQuery {
+	return NewAlbumClient(a.config).QuerySavedAlbums(a)
+}
+
+// Update returns a builder for updating this Album.
+// Note that you need to call Album.Unwrap() before calling this method if this Album
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (a *Album) Update() *AlbumUpdateOne {
+	return NewAlbumClient(a.config).UpdateOne(a)
+}
+
+// Unwrap unwraps the Album entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (a *Album) Unwrap() *Album {
+	_tx, ok := a.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Album is not a transactional entity")
+	}
+	a.config.driver = _tx.drv
+	return a
+}
+
+// String implements the fmt.Stringer.
+func (a *Album) String() string {
+	var builder strings.Builder
+	builder.WriteString("Album(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", a.ID))
+	builder.WriteString("spotify_id=")
+	builder.WriteString(a.SpotifyID)
+	builder.WriteString(", ")
+	builder.WriteString("uri=")
+	builder.WriteString(a.URI)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(a.Name)
+	builder.WriteString(", ")
+	builder.WriteString("album_type=")
+	builder.WriteString(a.AlbumType)
+	builder.WriteString(", ")
+	builder.WriteString("release_date=")
+	builder.WriteString(a.ReleaseDate)
+	builder.WriteString(", ")
+	builder.WriteString("total_tracks=")
+	builder.WriteString(fmt.Sprintf("%v", a.TotalTracks))
+	builder.WriteString(", ")
+	builder.WriteString("label=")
+	builder.WriteString(a.Label)
+	builder.WriteString(", ")
+	builder.WriteString("upc=")
+	builder.WriteString(a.Upc)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(a.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Albums is a parsable slice of Album.
+type Albums []*Album
@@ -0,0 +1,229 @@
+// Code generated by ent, DO NOT EDIT.
+
+package album
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the album type in the database.
+	Label = "album"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSpotifyID holds the string denoting the spotify_id field in the database.
+	FieldSpotifyID = "spotify_id"
+	// FieldURI holds the string denoting the uri field in the database.
+	FieldURI = "uri"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldAlbumType holds the string denoting the album_type field in the database.
+	FieldAlbumType = "album_type"
+	// FieldReleaseDate holds the string denoting the release_date field in the database.
+	FieldReleaseDate = "release_date"
+	// FieldTotalTracks holds the string denoting the total_tracks field in the database.
+	FieldTotalTracks = "total_tracks"
+	// FieldLabel holds the string denoting the label field in the database.
+	FieldLabel = "label"
+	// FieldUpc holds the string denoting the upc field in the database.
+	FieldUpc = "upc"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeArtists holds the string denoting the artists edge name in mutations.
+	EdgeArtists = "artists"
+	// EdgeTracks holds the string denoting the tracks edge name in mutations.
+	EdgeTracks = "tracks"
+	// EdgeSavedAlbums holds the string denoting the saved_albums edge name in mutations.
+	EdgeSavedAlbums = "saved_albums"
+	// Table holds the table name of the album in the database.
+	Table = "albums"
+	// ArtistsTable is the table that holds the artists relation/edge. The primary key declared below.
+	ArtistsTable = "album_artists"
+	// ArtistsInverseTable is the table name for the Artist entity.
+	// It exists in this package in order to avoid circular dependency with the "artist" package.
+	ArtistsInverseTable = "artists"
+	// TracksTable is the table that holds the tracks relation/edge.
+	TracksTable = "tracks"
+	// TracksInverseTable is the table name for the Track entity.
+	// It exists in this package in order to avoid circular dependency with the "track" package.
+	TracksInverseTable = "tracks"
+	// TracksColumn is the table column denoting the tracks relation/edge.
+	TracksColumn = "track_album"
+	// SavedAlbumsTable is the table that holds the saved_albums relation/edge.
+	SavedAlbumsTable = "saved_albums"
+	// SavedAlbumsInverseTable is the table name for the SavedAlbum entity.
+	// It exists in this package in order to avoid circular dependency with the "savedalbum" package.
+	SavedAlbumsInverseTable = "saved_albums"
+	// SavedAlbumsColumn is the table column denoting the saved_albums relation/edge.
+	SavedAlbumsColumn = "saved_album_album"
+)
+
+// Columns holds all SQL columns for album fields.
+var Columns = []string{
+	FieldID,
+	FieldSpotifyID,
+	FieldURI,
+	FieldName,
+	FieldAlbumType,
+	FieldReleaseDate,
+	FieldTotalTracks,
+	FieldLabel,
+	FieldUpc,
+	FieldCreatedAt,
+}
+
+var (
+	// ArtistsPrimaryKey and ArtistsColumn2 are the table columns denoting the
+	// primary key for the artists relation (M2M).
+	ArtistsPrimaryKey = []string{"album_id", "artist_id"}
+)
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// SpotifyIDValidator is a validator for the "spotify_id" field. It is called by the builders before save.
+	SpotifyIDValidator func(string) error
+	// DefaultAlbumType holds the default value on creation for the "album_type" field.
+	DefaultAlbumType string
+	// DefaultReleaseDate holds the default value on creation for the "release_date" field.
+	DefaultReleaseDate string
+	// DefaultTotalTracks holds the default value on creation for the "total_tracks" field.
+	DefaultTotalTracks int
+	// TotalTracksValidator is a validator for the "total_tracks" field. It is called by the builders before save.
+	TotalTracksValidator func(int) error
+	// DefaultLabel holds the default value on creation for the "label" field.
+	DefaultLabel string
+	// DefaultUpc holds the default value on creation for the "upc" field.
+	DefaultUpc string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() string
+)
+
+// OrderOption defines the ordering options for the Album queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// BySpotifyID orders the results by the spotify_id field.
+func BySpotifyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpotifyID, opts...).ToFunc()
+}
+
+// ByURI orders the results by the uri field.
+func ByURI(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldURI, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByAlbumType orders the results by the album_type field.
+func ByAlbumType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAlbumType, opts...).ToFunc()
+}
+
+// ByReleaseDate orders the results by the release_date field.
+func ByReleaseDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReleaseDate, opts...).ToFunc()
+}
+
+// ByTotalTracks orders the results by the total_tracks field.
+func ByTotalTracks(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalTracks, opts...).ToFunc()
+}
+
+// ByLabel orders the results by the label field.
+func ByLabel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLabel, opts...).ToFunc()
+}
+
+// ByUpc orders the results by the upc field.
+func ByUpc(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpc, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByArtistsCount orders the results by artists count.
+func ByArtistsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newArtistsStep(), opts...)
+	}
+}
+
+// ByArtists orders the results by artists terms.
+func ByArtists(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newArtistsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTracksCount orders the results by tracks count.
+func ByTracksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTracksStep(), opts...)
+	}
+}
+
+// ByTracks orders the results by tracks terms.
+func ByTracks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTracksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySavedAlbumsCount orders the results by saved_albums count.
+func BySavedAlbumsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSavedAlbumsStep(), opts...)
+	}
+}
+
+// BySavedAlbums orders the results by saved_albums terms.
+func BySavedAlbums(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSavedAlbumsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newArtistsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ArtistsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, ArtistsTable, ArtistsPrimaryKey...),
+	)
+}
+func newTracksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TracksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, TracksTable, TracksColumn),
+	)
+}
+func newSavedAlbumsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SavedAlbumsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, SavedAlbumsTable, SavedAlbumsColumn),
+	)
+}
@@ -0,0 +1,725 @@
+// Code generated by ent, DO NOT EDIT.
+
+package album
+
+import (
+	"beyerleinf/spotify-backup/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Album {
+	return predicate.Album(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Album {
+	return predicate.Album(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Album {
+	return predicate.Album(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Album {
+	return predicate.Album(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Album {
+	return predicate.Album(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Album {
+	return predicate.Album(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Album {
+	return predicate.Album(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Album {
+	return predicate.Album(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Album {
+	return predicate.Album(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Album {
+	return predicate.Album(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Album {
+	return predicate.Album(sql.FieldContainsFold(FieldID, id))
+}
+
+// SpotifyID applies equality check predicate on the "spotify_id" field. It's identical to SpotifyIDEQ.
+func SpotifyID(v string) predicate.Album {
+	return predicate.Album(sql.FieldEQ(FieldSpotifyID, v))
+}
+
+// URI applies equality check predicate on the "uri" field. It's identical to URIEQ.
+func URI(v string) predicate.Album {
+	return predicate.Album(sql.FieldEQ(FieldURI, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Album {
+	return predicate.Album(sql.FieldEQ(FieldName, v))
+}
+
+// AlbumType applies equality check predicate on the "album_type" field. It's identical to AlbumTypeEQ.
+func AlbumType(v string) predicate.Album {
+	return predicate.Album(sql.FieldEQ(FieldAlbumType, v))
+}
+
+// ReleaseDate applies equality check predicate on the "release_date" field. It's identical to ReleaseDateEQ.
+func ReleaseDate(v string) predicate.Album {
+	return predicate.Album(sql.FieldEQ(FieldReleaseDate, v))
+}
+
+// TotalTracks applies equality check predicate on the "total_tracks" field. It's identical to TotalTracksEQ.
+func TotalTracks(v int) predicate.Album {
+	return predicate.Album(sql.FieldEQ(FieldTotalTracks, v))
+}
+
+// Upc applies equality check predicate on the "upc" field. It's identical to UpcEQ.
+func Upc(v string) predicate.Album {
+	return predicate.Album(sql.FieldEQ(FieldUpc, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Album {
+	return predicate.Album(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// SpotifyIDEQ applies the EQ predicate on the "spotify_id" field.
+func SpotifyIDEQ(v string) predicate.Album {
+	return predicate.Album(sql.FieldEQ(FieldSpotifyID, v))
+}
+
+// SpotifyIDNEQ applies the NEQ predicate on the "spotify_id" field.
+func SpotifyIDNEQ(v string) predicate.Album {
+	return predicate.Album(sql.FieldNEQ(FieldSpotifyID, v))
+}
+
+// SpotifyIDIn applies the In predicate on the "spotify_id" field.
+func SpotifyIDIn(vs ...string) predicate.Album {
+	return predicate.Album(sql.FieldIn(FieldSpotifyID, vs...))
+}
+
+// SpotifyIDNotIn applies the NotIn predicate on the "spotify_id" field.
+func SpotifyIDNotIn(vs ...string) predicate.Album {
+	return predicate.Album(sql.FieldNotIn(FieldSpotifyID, vs...))
+}
+
+// SpotifyIDGT applies the GT predicate on the "spotify_id" field.
+func SpotifyIDGT(v string) predicate.Album {
+	return predicate.Album(sql.FieldGT(FieldSpotifyID, v))
+}
+
+// SpotifyIDGTE applies the GTE predicate on the "spotify_id" field.
+func SpotifyIDGTE(v string) predicate.Album {
+	return predicate.Album(sql.FieldGTE(FieldSpotifyID, v))
+}
+
+// SpotifyIDLT applies the LT predicate on the "spotify_id" field.
+func SpotifyIDLT(v string) predicate.Album {
+	return predicate.Album(sql.FieldLT(FieldSpotifyID, v))
+}
+
+// SpotifyIDLTE applies the LTE predicate on the "spotify_id" field.
+func SpotifyIDLTE(v string) predicate.Album {
+	return predicate.Album(sql.FieldLTE(FieldSpotifyID, v))
+}
+
+// SpotifyIDContains applies the Contains predicate on the "spotify_id" field.
+func SpotifyIDContains(v string) predicate.Album {
+	return predicate.Album(sql.FieldContains(FieldSpotifyID, v))
+}
+
+// SpotifyIDHasPrefix applies the HasPrefix predicate on the "spotify_id" field.
+func SpotifyIDHasPrefix(v string) predicate.Album {
+	return predicate.Album(sql.FieldHasPrefix(FieldSpotifyID, v))
+}
+
+// SpotifyIDHasSuffix applies the HasSuffix predicate on the "spotify_id" field.
+func SpotifyIDHasSuffix(v string) predicate.Album {
+	return predicate.Album(sql.FieldHasSuffix(FieldSpotifyID, v))
+}
+
+// SpotifyIDEqualFold applies the EqualFold predicate on the "spotify_id" field.
+func SpotifyIDEqualFold(v string) predicate.Album {
+	return predicate.Album(sql.FieldEqualFold(FieldSpotifyID, v))
+}
+
+// SpotifyIDContainsFold applies the ContainsFold predicate on the "spotify_id" field.
+func SpotifyIDContainsFold(v string) predicate.Album {
+	return predicate.Album(sql.FieldContainsFold(FieldSpotifyID, v))
+}
+
+// URIEQ applies the EQ predicate on the "uri" field.
+func URIEQ(v string) predicate.Album {
+	return predicate.Album(sql.FieldEQ(FieldURI, v))
+}
+
+// URINEQ applies the NEQ predicate on the "uri" field.
+func URINEQ(v string) predicate.Album {
+	return predicate.Album(sql.FieldNEQ(FieldURI, v))
+}
+
+// URIIn applies the In predicate on the "uri" field.
+func URIIn(vs ...string) predicate.Album {
+	return predicate.Album(sql.FieldIn(FieldURI, vs...))
+}
+
+// URINotIn applies the NotIn predicate on the "uri" field.
+func URINotIn(vs ...string) predicate.Album {
+	return predicate.Album(sql.FieldNotIn(FieldURI, vs...))
+}
+
+// URIGT applies the GT predicate on the "uri" field.
+func URIGT(v string) predicate.Album {
+	return predicate.Album(sql.FieldGT(FieldURI, v))
+}
+
+// URIGTE applies the GTE predicate on the "uri" field.
+func URIGTE(v string) predicate.Album {
+	return predicate.Album(sql.FieldGTE(FieldURI, v))
+}
+
+// URILT applies the LT predicate on the "uri" field.
+func URILT(v string) predicate.Album {
+	return predicate.Album(sql.FieldLT(FieldURI, v))
+}
+
+// URILTE applies the LTE predicate on the "uri" field.
+func URILTE(v string) predicate.Album {
+	return predicate.Album(sql.FieldLTE(FieldURI, v))
+}
+
+// URIContains applies the Contains predicate on the "uri" field.
+func URIContains(v string) predicate.Album {
+	return predicate.Album(sql.FieldContains(FieldURI, v))
+}
+
+// URIHasPrefix applies the HasPrefix predicate on the "uri" field.
+func URIHasPrefix(v string) predicate.Album {
+	return predicate.Album(sql.FieldHasPrefix(FieldURI, v))
+}
+
+// URIHasSuffix applies the HasSuffix predicate on the "uri" field.
+func URIHasSuffix(v string) predicate.Album {
+	return predicate.Album(sql.FieldHasSuffix(FieldURI, v))
+}
+
+// URIEqualFold applies the EqualFold predicate on the "uri" field.
+func URIEqualFold(v string) predicate.Album {
+	return predicate.Album(sql.FieldEqualFold(FieldURI, v))
+}
+
+// URIContainsFold applies the ContainsFold predicate on the "uri" field.
+func URIContainsFold(v string) predicate.Album {
+	return predicate.Album(sql.FieldContainsFold(FieldURI, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Album {
+	return predicate.Album(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Album {
+	return predicate.Album(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Album {
+	return predicate.Album(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Album {
+	return predicate.Album(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Album {
+	return predicate.Album(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Album {
+	return predicate.Album(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Album {
+	return predicate.Album(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Album {
+	return predicate.Album(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Album {
+	return predicate.Album(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Album {
+	return predicate.Album(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Album {
+	return predicate.Album(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Album {
+	return predicate.Album(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Album {
+	return predicate.Album(sql.FieldContainsFold(FieldName, v))
+}
+
+// AlbumTypeEQ applies the EQ predicate on the "album_type" field.
+func AlbumTypeEQ(v string) predicate.Album {
+	return predicate.Album(sql.FieldEQ(FieldAlbumType, v))
+}
+
+// AlbumTypeNEQ applies the NEQ predicate on the "album_type" field.
+func AlbumTypeNEQ(v string) predicate.Album {
+	return predicate.Album(sql.FieldNEQ(FieldAlbumType, v))
+}
+
+// AlbumTypeIn applies the In predicate on the "album_type" field.
+func AlbumTypeIn(vs ...string) predicate.Album {
+	return predicate.Album(sql.FieldIn(FieldAlbumType, vs...))
+}
+
+// AlbumTypeNotIn applies the NotIn predicate on the "album_type" field.
+func AlbumTypeNotIn(vs ...string) predicate.Album {
+	return predicate.Album(sql.FieldNotIn(FieldAlbumType, vs...))
+}
+
+// AlbumTypeGT applies the GT predicate on the "album_type" field.
+func AlbumTypeGT(v string) predicate.Album {
+	return predicate.Album(sql.FieldGT(FieldAlbumType, v))
+}
+
+// AlbumTypeGTE applies the GTE predicate on the "album_type" field.
+func AlbumTypeGTE(v string) predicate.Album {
+	return predicate.Album(sql.FieldGTE(FieldAlbumType, v))
+}
+
+// AlbumTypeLT applies the LT predicate on the "album_type" field.
+func AlbumTypeLT(v string) predicate.Album {
+	return predicate.Album(sql.FieldLT(FieldAlbumType, v))
+}
+
+// AlbumTypeLTE applies the LTE predicate on the "album_type" field.
+func AlbumTypeLTE(v string) predicate.Album {
+	return predicate.Album(sql.FieldLTE(FieldAlbumType, v))
+}
+
+// AlbumTypeContains applies the Contains predicate on the "album_type" field.
+func AlbumTypeContains(v string) predicate.Album {
+	return predicate.Album(sql.FieldContains(FieldAlbumType, v))
+}
+
+// AlbumTypeHasPrefix applies the HasPrefix predicate on the "album_type" field.
+func AlbumTypeHasPrefix(v string) predicate.Album {
+	return predicate.Album(sql.FieldHasPrefix(FieldAlbumType, v))
+}
+
+// AlbumTypeHasSuffix applies the HasSuffix predicate on the "album_type" field.
+func AlbumTypeHasSuffix(v string) predicate.Album {
+	return predicate.Album(sql.FieldHasSuffix(FieldAlbumType, v))
+}
+
+// AlbumTypeEqualFold applies the EqualFold predicate on the "album_type" field.
+func AlbumTypeEqualFold(v string) predicate.Album {
+	return predicate.Album(sql.FieldEqualFold(FieldAlbumType, v))
+}
+
+// AlbumTypeContainsFold applies the ContainsFold predicate on the "album_type" field.
+func AlbumTypeContainsFold(v string) predicate.Album {
+	return predicate.Album(sql.FieldContainsFold(FieldAlbumType, v))
+}
+
+// ReleaseDateEQ applies the EQ predicate on the "release_date" field.
+func ReleaseDateEQ(v string) predicate.Album {
+	return predicate.Album(sql.FieldEQ(FieldReleaseDate, v))
+}
+
+// ReleaseDateNEQ applies the NEQ predicate on the "release_date" field.
+func ReleaseDateNEQ(v string) predicate.Album {
+	return predicate.Album(sql.FieldNEQ(FieldReleaseDate, v))
+}
+
+// ReleaseDateIn applies the In predicate on the "release_date" field.
+func ReleaseDateIn(vs ...string) predicate.Album {
+	return predicate.Album(sql.FieldIn(FieldReleaseDate, vs...))
+}
+
+// ReleaseDateNotIn applies the NotIn predicate on the "release_date" field.
+func ReleaseDateNotIn(vs ...string) predicate.Album {
+	return predicate.Album(sql.FieldNotIn(FieldReleaseDate, vs...))
+}
+
+// ReleaseDateGT applies the GT predicate on the "release_date" field.
+func ReleaseDateGT(v string) predicate.Album {
+	return predicate.Album(sql.FieldGT(FieldReleaseDate, v))
+}
+
+// ReleaseDateGTE applies the GTE predicate on the "release_date" field.
+func ReleaseDateGTE(v string) predicate.Album {
+	return predicate.Album(sql.FieldGTE(FieldReleaseDate, v))
+}
+
+// ReleaseDateLT applies the LT predicate on the "release_date" field.
+func ReleaseDateLT(v string) predicate.Album {
+	return predicate.Album(sql.FieldLT(FieldReleaseDate, v))
+}
+
+// ReleaseDateLTE applies the LTE predicate on the "release_date" field.
+func ReleaseDateLTE(v string) predicate.Album {
+	return predicate.Album(sql.FieldLTE(FieldReleaseDate, v))
+}
+
+// ReleaseDateContains applies the Contains predicate on the "release_date" field.
+func ReleaseDateContains(v string) predicate.Album {
+	return predicate.Album(sql.FieldContains(FieldReleaseDate, v))
+}
+
+// ReleaseDateHasPrefix applies the HasPrefix predicate on the "release_date" field.
+func ReleaseDateHasPrefix(v string) predicate.Album {
+	return predicate.Album(sql.FieldHasPrefix(FieldReleaseDate, v))
+}
+
+// ReleaseDateHasSuffix applies the HasSuffix predicate on the "release_date" field.
+func ReleaseDateHasSuffix(v string) predicate.Album {
+	return predicate.Album(sql.FieldHasSuffix(FieldReleaseDate, v))
+}
+
+// ReleaseDateEqualFold applies the EqualFold predicate on the "release_date" field.
+func ReleaseDateEqualFold(v string) predicate.Album {
+	return predicate.Album(sql.FieldEqualFold(FieldReleaseDate, v))
+}
+
+// ReleaseDateContainsFold applies the ContainsFold predicate on the "release_date" field.
+func ReleaseDateContainsFold(v string) predicate.Album {
+	return predicate.Album(sql.FieldContainsFold(FieldReleaseDate, v))
+}
+
+// TotalTracksEQ applies the EQ predicate on the "total_tracks" field.
+func TotalTracksEQ(v int) predicate.Album {
+	return predicate.Album(sql.FieldEQ(FieldTotalTracks, v))
+}
+
+// TotalTracksNEQ applies the NEQ predicate on the "total_tracks" field.
+func TotalTracksNEQ(v int) predicate.Album {
+	return predicate.Album(sql.FieldNEQ(FieldTotalTracks, v))
+}
+
+// TotalTracksIn applies the In predicate on the "total_tracks" field.
+func TotalTracksIn(vs ...int) predicate.Album {
+	return predicate.Album(sql.FieldIn(FieldTotalTracks, vs...))
+}
+
+// TotalTracksNotIn applies the NotIn predicate on the "total_tracks" field.
+func TotalTracksNotIn(vs ...int) predicate.Album {
+	return predicate.Album(sql.FieldNotIn(FieldTotalTracks, vs...))
+}
+
+// TotalTracksGT applies the GT predicate on the "total_tracks" field.
+func TotalTracksGT(v int) predicate.Album {
+	return predicate.Album(sql.FieldGT(FieldTotalTracks, v))
+}
+
+// TotalTracksGTE applies the GTE predicate on the "total_tracks" field.
+func TotalTracksGTE(v int) predicate.Album {
+	return predicate.Album(sql.FieldGTE(FieldTotalTracks, v))
+}
+
+// TotalTracksLT applies the LT predicate on the "total_tracks" field.
+func TotalTracksLT(v int) predicate.Album {
+	return predicate.Album(sql.FieldLT(FieldTotalTracks, v))
+}
+
+// TotalTracksLTE applies the LTE predicate on the "total_tracks" field.
+func TotalTracksLTE(v int) predicate.Album {
+	return predicate.Album(sql.FieldLTE(FieldTotalTracks, v))
+}
+
+// LabelEQ applies the EQ predicate on the "label" field.
+func LabelEQ(v string) predicate.Album {
+	return predicate.Album(sql.FieldEQ(FieldLabel, v))
+}
+
+// LabelNEQ applies the NEQ predicate on the "label" field.
+func LabelNEQ(v string) predicate.Album {
+	return predicate.Album(sql.FieldNEQ(FieldLabel, v))
+}
+
+// LabelIn applies the In predicate on the "label" field.
+func LabelIn(vs ...string) predicate.Album {
+	return predicate.Album(sql.FieldIn(FieldLabel, vs...))
+}
+
+// LabelNotIn applies the NotIn predicate on the "label" field.
+func LabelNotIn(vs ...string) predicate.Album {
+	return predicate.Album(sql.FieldNotIn(FieldLabel, vs...))
+}
+
+// LabelGT applies the GT predicate on the "label" field.
+func LabelGT(v string) predicate.Album {
+	return predicate.Album(sql.FieldGT(FieldLabel, v))
+}
+
+// LabelGTE applies the GTE predicate on the "label" field.
+func LabelGTE(v string) predicate.Album {
+	return predicate.Album(sql.FieldGTE(FieldLabel, v))
+}
+
+// LabelLT applies the LT predicate on the "label" field.
+func LabelLT(v string) predicate.Album {
+	return predicate.Album(sql.FieldLT(FieldLabel, v))
+}
+
+// LabelLTE applies the LTE predicate on the "label" field.
+func LabelLTE(v string) predicate.Album {
+	return predicate.Album(sql.FieldLTE(FieldLabel, v))
+}
+
+// LabelContains applies the Contains predicate on the "label" field.
+func LabelContains(v string) predicate.Album {
+	return predicate.Album(sql.FieldContains(FieldLabel, v))
+}
+
+// LabelHasPrefix applies the HasPrefix predicate on the "label" field.
+func LabelHasPrefix(v string) predicate.Album {
+	return predicate.Album(sql.FieldHasPrefix(FieldLabel, v))
+}
+
+// LabelHasSuffix applies the HasSuffix predicate on the "label" field.
+func LabelHasSuffix(v string) predicate.Album {
+	return predicate.Album(sql.FieldHasSuffix(FieldLabel, v))
+}
+
+// LabelEqualFold applies the EqualFold predicate on the "label" field.
+func LabelEqualFold(v string) predicate.Album {
+	return predicate.Album(sql.FieldEqualFold(FieldLabel, v))
+}
+
+// LabelContainsFold applies the ContainsFold predicate on the "label" field.
+func LabelContainsFold(v string) predicate.Album {
+	return predicate.Album(sql.FieldContainsFold(FieldLabel, v))
+}
+
+// UpcEQ applies the EQ predicate on the "upc" field.
+func UpcEQ(v string) predicate.Album {
+	return predicate.Album(sql.FieldEQ(FieldUpc, v))
+}
+
+// UpcNEQ applies the NEQ predicate on the "upc" field.
+func UpcNEQ(v string) predicate.Album {
+	return predicate.Album(sql.FieldNEQ(FieldUpc, v))
+}
+
+// UpcIn applies the In predicate on the "upc" field.
+func UpcIn(vs ...string) predicate.Album {
+	return predicate.Album(sql.FieldIn(FieldUpc, vs...))
+}
+
+// UpcNotIn applies the NotIn predicate on the "upc" field.
+func UpcNotIn(vs ...string) predicate.Album {
+	return predicate.Album(sql.FieldNotIn(FieldUpc, vs...))
+}
+
+// UpcGT applies the GT predicate on the "upc" field.
+func UpcGT(v string) predicate.Album {
+	return predicate.Album(sql.FieldGT(FieldUpc, v))
+}
+
+// UpcGTE applies the GTE predicate on the "upc" field.
+func UpcGTE(v string) predicate.Album {
+	return predicate.Album(sql.FieldGTE(FieldUpc, v))
+}
+
+// UpcLT applies the LT predicate on the "upc" field.
+func UpcLT(v string) predicate.Album {
+	return predicate.Album(sql.FieldLT(FieldUpc, v))
+}
+
+// UpcLTE applies the LTE predicate on the "upc" field.
+func UpcLTE(v string) predicate.Album {
+	return predicate.Album(sql.FieldLTE(FieldUpc, v))
+}
+
+// UpcContains applies the Contains predicate on the "upc" field.
+func UpcContains(v string) predicate.Album {
+	return predicate.Album(sql.FieldContains(FieldUpc, v))
+}
+
+// UpcHasPrefix applies the HasPrefix predicate on the "upc" field.
+func UpcHasPrefix(v string) predicate.Album {
+	return predicate.Album(sql.FieldHasPrefix(FieldUpc, v))
+}
+
+// UpcHasSuffix applies the HasSuffix predicate on the "upc" field.
+func UpcHasSuffix(v string) predicate.Album {
+	return predicate.Album(sql.FieldHasSuffix(FieldUpc, v))
+}
+
+// UpcEqualFold applies the EqualFold predicate on the "upc" field.
+func UpcEqualFold(v string) predicate.Album {
+	return predicate.Album(sql.FieldEqualFold(FieldUpc, v))
+}
+
+// UpcContainsFold applies the ContainsFold predicate on the "upc" field.
+func UpcContainsFold(v string) predicate.Album {
+	return predicate.Album(sql.FieldContainsFold(FieldUpc, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Album {
+	return predicate.Album(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Album {
+	return predicate.Album(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Album {
+	return predicate.Album(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Album {
+	return predicate.Album(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Album {
+	return predicate.Album(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Album {
+	return predicate.Album(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Album {
+	return predicate.Album(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Album {
+	return predicate.Album(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasArtists applies the HasEdge predicate on the "artists" edge.
+func HasArtists() predicate.Album {
+	return predicate.Album(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, ArtistsTable, ArtistsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasArtistsWith applies the HasEdge predicate on the "artists" edge with a given conditions (other predicates).
+func HasArtistsWith(preds ...predicate.Artist) predicate.Album {
+	return predicate.Album(func(s *sql.Selector) {
+		step := newArtistsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTracks applies the HasEdge predicate on the "tracks" edge.
+func HasTracks() predicate.Album {
+	return predicate.Album(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, TracksTable, TracksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTracksWith applies the HasEdge predicate on the "tracks" edge with a given conditions (other predicates).
+func HasTracksWith(preds ...predicate.Track) predicate.Album {
+	return predicate.Album(func(s *sql.Selector) {
+		step := newTracksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSavedAlbums applies the HasEdge predicate on the "saved_albums" edge.
+func HasSavedAlbums() predicate.Album {
+	return predicate.Album(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, SavedAlbumsTable, SavedAlbumsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSavedAlbumsWith applies the HasEdge predicate on the "saved_albums" edge with a given conditions (other predicates).
+func HasSavedAlbumsWith(preds ...predicate.SavedAlbum) predicate.Album {
+	return predicate.Album(func(s *sql.Selector) {
+		step := newSavedAlbumsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Album) predicate.Album {
+	return predicate.Album(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Album) predicate.Album {
+	return predicate.Album(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Album) predicate.Album {
+	return predicate.Album(sql.NotPredicates(p))
+}
@@ -0,0 +1,495 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/album"
+	"beyerleinf/spotify-backup/ent/artist"
+	"beyerleinf/spotify-backup/ent/savedalbum"
+	"beyerleinf/spotify-backup/ent/track"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AlbumCreate is the builder for creating a Album entity.
+type AlbumCreate struct {
+	config
+	mutation *AlbumMutation
+	hooks    []Hook
+}
+
+// SetSpotifyID sets the "spotify_id" field.
+func (ac *AlbumCreate) SetSpotifyID(s string) *AlbumCreate {
+	ac.mutation.SetSpotifyID(s)
+	return ac
+}
+
+// SetURI sets the "uri" field.
+func (ac *AlbumCreate) SetURI(s string) *AlbumCreate {
+	ac.mutation.SetURI(s)
+	return ac
+}
+
+// SetName sets the "name" field.
+func (ac *AlbumCreate) SetName(s string) *AlbumCreate {
+	ac.mutation.SetName(s)
+	return ac
+}
+
+// SetAlbumType sets the "album_type" field.
+func (ac *AlbumCreate) SetAlbumType(s string) *AlbumCreate {
+	ac.mutation.SetAlbumType(s)
+	return ac
+}
+
+// SetNillableAlbumType sets the "album_type" field if the given value is not nil.
+func (ac *AlbumCreate) SetNillableAlbumType(s *string) *AlbumCreate {
+	if s != nil {
+		ac.SetAlbumType(*s)
+	}
+	return ac
+}
+
+// SetReleaseDate sets the "release_date" field.
+func (ac *AlbumCreate) SetReleaseDate(s string) *AlbumCreate {
+	ac.mutation.SetReleaseDate(s)
+	return ac
+}
+
+// SetNillableReleaseDate sets the "release_date" field if the given value is not nil.
+func (ac *AlbumCreate) SetNillableReleaseDate(s *string) *AlbumCreate {
+	if s != nil {
+		ac.SetReleaseDate(*s)
+	}
+	return ac
+}
+
+// SetTotalTracks sets the "total_tracks" field.
+func (ac *AlbumCreate) SetTotalTracks(i int) *AlbumCreate {
+	ac.mutation.SetTotalTracks(i)
+	return ac
+}
+
+// SetNillableTotalTracks sets the "total_tracks" field if the given value is not nil.
+func (ac *AlbumCreate) SetNillableTotalTracks(i *int) *AlbumCreate {
+	if i != nil {
+		ac.SetTotalTracks(*i)
+	}
+	return ac
+}
+
+// SetLabel sets the "label" field.
+func (ac *AlbumCreate) SetLabel(s string) *AlbumCreate {
+	ac.mutation.SetLabel(s)
+	return ac
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (ac *AlbumCreate) SetNillableLabel(s *string) *AlbumCreate {
+	if s != nil {
+		ac.SetLabel(*s)
+	}
+	return ac
+}
+
+// SetUpc sets the "upc" field.
+func (ac *AlbumCreate) SetUpc(s string) *AlbumCreate {
+	ac.mutation.SetUpc(s)
+	return ac
+}
+
+// SetNillableUpc sets the "upc" field if the given value is not nil.
+func (ac *AlbumCreate) SetNillableUpc(s *string) *AlbumCreate {
+	if s != nil {
+		ac.SetUpc(*s)
+	}
+	return ac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ac *AlbumCreate) SetCreatedAt(t time.Time) *AlbumCreate {
+	ac.mutation.SetCreatedAt(t)
+	return ac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ac *AlbumCreate) SetNillableCreatedAt(t *time.Time) *AlbumCreate {
+	if t != nil {
+		ac.SetCreatedAt(*t)
+	}
+	return ac
+}
+
+// SetID sets the "id" field.
+func (ac *AlbumCreate) SetID(s string) *AlbumCreate {
+	ac.mutation.SetID(s)
+	return ac
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ac *AlbumCreate) SetNillableID(s *string) *AlbumCreate {
+	if s != nil {
+		ac.SetID(*s)
+	}
+	return ac
+}
+
+// AddArtistIDs adds the "artists" edge to the Artist entity by IDs.
+func (ac *AlbumCreate) AddArtistIDs(ids ...string) *AlbumCreate {
+	ac.mutation.AddArtistIDs(ids...)
+	return ac
+}
+
+// AddArtists adds the "artists" edges to the Artist entity.
+func (ac *AlbumCreate) AddArtists(a ...*Artist) *AlbumCreate {
+	ids := make([]string, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ac.AddArtistIDs(ids...)
+}
+
+// AddTrackIDs adds the "tracks" edge to the Track entity by IDs.
+func (ac *AlbumCreate) AddTrackIDs(ids ...string) *AlbumCreate {
+	ac.mutation.AddTrackIDs(ids...)
+	return ac
+}
+
+// AddTracks adds the "tracks" edges to the Track entity.
+func (ac *AlbumCreate) AddTracks(t ...*Track) *AlbumCreate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return ac.AddTrackIDs(ids...)
+}
+
+// AddSavedAlbumIDs adds the "saved_albums" edge to the SavedAlbum entity by IDs.
+func (ac *AlbumCreate) AddSavedAlbumIDs(ids ...string) *AlbumCreate {
+	ac.mutation.AddSavedAlbumIDs(ids...)
+	return ac
+}
+
+// AddSavedAlbums adds the "saved_albums" edges to the SavedAlbum entity.
+func (ac *AlbumCreate) AddSavedAlbums(s ...*SavedAlbum) *AlbumCreate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ac.AddSavedAlbumIDs(ids...)
+}
+
+// Mutation returns the AlbumMutation object of the builder.
+func (ac *AlbumCreate) Mutation() *AlbumMutation {
+	return ac.mutation
+}
+
+// Save creates the Album in the database.
+func (ac *AlbumCreate) Save(ctx context.Context) (*Album, error) {
+	ac.defaults()
+	return withHooks(ctx, ac.sqlSave, ac.mutation, ac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ac *AlbumCreate) SaveX(ctx context.Context) *Album {
+	v, err := ac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ac *AlbumCreate) Exec(ctx context.Context) error {
+	_, err := ac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ac *AlbumCreate) ExecX(ctx context.Context) {
+	if err := ac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ac *AlbumCreate) defaults() {
+	if _, ok := ac.mutation.AlbumType(); !ok {
+		v := album.DefaultAlbumType
+		ac.mutation.SetAlbumType(v)
+	}
+	if _, ok := ac.mutation.ReleaseDate(); !ok {
+		v := album.DefaultReleaseDate
+		ac.mutation.SetReleaseDate(v)
+	}
+	if _, ok := ac.mutation.TotalTracks(); !ok {
+		v := album.DefaultTotalTracks
+		ac.mutation.SetTotalTracks(v)
+	}
+	if _, ok := ac.mutation.Label(); !ok {
+		v := album.DefaultLabel
+		ac.mutation.SetLabel(v)
+	}
+	if _, ok := ac.mutation.Upc(); !ok {
+		v := album.DefaultUpc
+		ac.mutation.SetUpc(v)
+	}
+	if _, ok := ac.mutation.CreatedAt(); !ok {
+		v := album.DefaultCreatedAt()
+		ac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ac.mutation.ID(); !ok {
+		v := album.DefaultID()
+		ac.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ac *AlbumCreate) check() error {
+	if _, ok := ac.mutation.SpotifyID(); !ok {
+		return &ValidationError{Name: "spotify_id", err: errors.New(`ent: missing required field "Album.spotify_id"`)}
+	}
+	if v, ok := ac.mutation.SpotifyID(); ok {
+		if err := album.SpotifyIDValidator(v); err != nil {
+			return &ValidationError{Name: "spotify_id", err: fmt.Errorf(`ent: validator failed for field "Album.spotify_id": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.URI(); !ok {
+		return &ValidationError{Name: "uri", err: errors.New(`ent: missing required field "Album.uri"`)}
+	}
+	if _, ok := ac.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Album.name"`)}
+	}
+	if _, ok := ac.mutation.AlbumType(); !ok {
+		return &ValidationError{Name: "album_type", err: errors.New(`ent: missing required field "Album.album_type"`)}
+	}
+	if _, ok := ac.mutation.ReleaseDate(); !ok {
+		return &ValidationError{Name: "release_date", err: errors.New(`ent: missing required field "Album.release_date"`)}
+	}
+	if _, ok := ac.mutation.TotalTracks(); !ok {
+		return &ValidationError{Name: "total_tracks", err: errors.New(`ent: missing required field "Album.total_tracks"`)}
+	}
+	if v, ok := ac.mutation.TotalTracks(); ok {
+		if err := album.TotalTracksValidator(v); err != nil {
+			return &ValidationError{Name: "total_tracks", err: fmt.Errorf(`ent: validator failed for field "Album.total_tracks": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.Label(); !ok {
+		return &ValidationError{Name: "label", err: errors.New(`ent: missing required field "Album.label"`)}
+	}
+	if _, ok := ac.mutation.Upc(); !ok {
+		return &ValidationError{Name: "upc", err: errors.New(`ent: missing required field "Album.upc"`)}
+	}
+	if _, ok := ac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Album.created_at"`)}
+	}
+	return nil
+}
+
+func (ac *AlbumCreate) sqlSave(ctx context.Context) (*Album, error) {
+	if err := ac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Album.ID type: %T", _spec.ID.Value)
+		}
+	}
+	ac.mutation.id = &_node.ID
+	ac.mutation.done = true
+	return _node, nil
+}
+
+func (ac *AlbumCreate) createSpec() (*Album, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Album{config: ac.config}
+		_spec = sqlgraph.NewCreateSpec(album.Table, sqlgraph.NewFieldSpec(album.FieldID, field.TypeString))
+	)
+	if id, ok := ac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := ac.mutation.SpotifyID(); ok {
+		_spec.SetField(album.FieldSpotifyID, field.TypeString, value)
+		_node.SpotifyID = value
+	}
+	if value, ok := ac.mutation.URI(); ok {
+		_spec.SetField(album.FieldURI, field.TypeString, value)
+		_node.URI = value
+	}
+	if value, ok := ac.mutation.Name(); ok {
+		_spec.SetField(album.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := ac.mutation.AlbumType(); ok {
+		_spec.SetField(album.FieldAlbumType, field.TypeString, value)
+		_node.AlbumType = value
+	}
+	if value, ok := ac.mutation.ReleaseDate(); ok {
+		_spec.SetField(album.FieldReleaseDate, field.TypeString, value)
+		_node.ReleaseDate = value
+	}
+	if value, ok := ac.mutation.TotalTracks(); ok {
+		_spec.SetField(album.FieldTotalTracks, field.TypeInt, value)
+		_node.TotalTracks = value
+	}
+	if value, ok := ac.mutation.Label(); ok {
+		_spec.SetField(album.FieldLabel, field.TypeString, value)
+		_node.Label = value
+	}
+	if value, ok := ac.mutation.Upc(); ok {
+		_spec.SetField(album.FieldUpc, field.TypeString, value)
+		_node.Upc = value
+	}
+	if value, ok := ac.mutation.CreatedAt(); ok {
+		_spec.SetField(album.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := ac.mutation.ArtistsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   album.ArtistsTable,
+			Columns: album.ArtistsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artist.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.TracksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   album.TracksTable,
+			Columns: []string{album.TracksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.SavedAlbumsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   album.SavedAlbumsTable,
+			Columns: []string{album.SavedAlbumsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedalbum.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AlbumCreateBulk is the builder for creating many Album entities in bulk.
+type AlbumCreateBulk struct {
+	config
+	err      error
+	builders []*AlbumCreate
+}
+
+// Save creates the Album entities in the database.
+func (acb *AlbumCreateBulk) Save(ctx context.Context) ([]*Album, error) {
+	if acb.err != nil {
+		return nil, acb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(acb.builders))
+	nodes := make([]*Album, len(acb.builders))
+	mutators := make([]Mutator, len(acb.builders))
+	for i := range acb.builders {
+		func(i int, root context.Context) {
+			builder := acb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AlbumMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, acb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, acb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, acb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (acb *AlbumCreateBulk) SaveX(ctx context.Context) []*Album {
+	v, err := acb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (acb *AlbumCreateBulk) Exec(ctx context.Context) error {
+	_, err := acb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acb *AlbumCreateBulk) ExecX(ctx context.Context) {
+	if err := acb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,948 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/album"
+	"beyerleinf/spotify-backup/ent/artist"
+	"beyerleinf/spotify-backup/ent/predicate"
+	"beyerleinf/spotify-backup/ent/savedalbum"
+	"beyerleinf/spotify-backup/ent/track"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AlbumUpdate is the builder for updating Album entities.
+type AlbumUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AlbumMutation
+}
+
+// Where appends a list predicates to the AlbumUpdate builder.
+func (au *AlbumUpdate) Where(ps ...predicate.Album) *AlbumUpdate {
+	au.mutation.Where(ps...)
+	return au
+}
+
+// SetURI sets the "uri" field.
+func (au *AlbumUpdate) SetURI(s string) *AlbumUpdate {
+	au.mutation.SetURI(s)
+	return au
+}
+
+// SetNillableURI sets the "uri" field if the given value is not nil.
+func (au *AlbumUpdate) SetNillableURI(s *string) *AlbumUpdate {
+	if s != nil {
+		au.SetURI(*s)
+	}
+	return au
+}
+
+// SetName sets the "name" field.
+func (au *AlbumUpdate) SetName(s string) *AlbumUpdate {
+	au.mutation.SetName(s)
+	return au
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (au *AlbumUpdate) SetNillableName(s *string) *AlbumUpdate {
+	if s != nil {
+		au.SetName(*s)
+	}
+	return au
+}
+
+// SetAlbumType sets the "album_type" field.
+func (au *AlbumUpdate) SetAlbumType(s string) *AlbumUpdate {
+	au.mutation.SetAlbumType(s)
+	return au
+}
+
+// SetNillableAlbumType sets the "album_type" field if the given value is not nil.
+func (au *AlbumUpdate) SetNillableAlbumType(s *string) *AlbumUpdate {
+	if s != nil {
+		au.SetAlbumType(*s)
+	}
+	return au
+}
+
+// SetReleaseDate sets the "release_date" field.
+func (au *AlbumUpdate) SetReleaseDate(s string) *AlbumUpdate {
+	au.mutation.SetReleaseDate(s)
+	return au
+}
+
+// SetNillableReleaseDate sets the "release_date" field if the given value is not nil.
+func (au *AlbumUpdate) SetNillableReleaseDate(s *string) *AlbumUpdate {
+	if s != nil {
+		au.SetReleaseDate(*s)
+	}
+	return au
+}
+
+// SetTotalTracks sets the "total_tracks" field.
+func (au *AlbumUpdate) SetTotalTracks(i int) *AlbumUpdate {
+	au.mutation.ResetTotalTracks()
+	au.mutation.SetTotalTracks(i)
+	return au
+}
+
+// SetNillableTotalTracks sets the "total_tracks" field if the given value is not nil.
+func (au *AlbumUpdate) SetNillableTotalTracks(i *int) *AlbumUpdate {
+	if i != nil {
+		au.SetTotalTracks(*i)
+	}
+	return au
+}
+
+// AddTotalTracks adds i to the "total_tracks" field.
+func (au *AlbumUpdate) AddTotalTracks(i int) *AlbumUpdate {
+	au.mutation.AddTotalTracks(i)
+	return au
+}
+
+// SetLabel sets the "label" field.
+func (au *AlbumUpdate) SetLabel(s string) *AlbumUpdate {
+	au.mutation.SetLabel(s)
+	return au
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (au *AlbumUpdate) SetNillableLabel(s *string) *AlbumUpdate {
+	if s != nil {
+		au.SetLabel(*s)
+	}
+	return au
+}
+
+// SetUpc sets the "upc" field.
+func (au *AlbumUpdate) SetUpc(s string) *AlbumUpdate {
+	au.mutation.SetUpc(s)
+	return au
+}
+
+// SetNillableUpc sets the "upc" field if the given value is not nil.
+func (au *AlbumUpdate) SetNillableUpc(s *string) *AlbumUpdate {
+	if s != nil {
+		au.SetUpc(*s)
+	}
+	return au
+}
+
+// AddArtistIDs adds the "artists" edge to the Artist entity by IDs.
+func (au *AlbumUpdate) AddArtistIDs(ids ...string) *AlbumUpdate {
+	au.mutation.AddArtistIDs(ids...)
+	return au
+}
+
+// AddArtists adds the "artists" edges to the Artist entity.
+func (au *AlbumUpdate) AddArtists(a ...*Artist) *AlbumUpdate {
+	ids := make([]string, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return au.AddArtistIDs(ids...)
+}
+
+// AddTrackIDs adds the "tracks" edge to the Track entity by IDs.
+func (au *AlbumUpdate) AddTrackIDs(ids ...string) *AlbumUpdate {
+	au.mutation.AddTrackIDs(ids...)
+	return au
+}
+
+// AddTracks adds the "tracks" edges to the Track entity.
+func (au *AlbumUpdate) AddTracks(t ...*Track) *AlbumUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return au.AddTrackIDs(ids...)
+}
+
+// AddSavedAlbumIDs adds the "saved_albums" edge to the SavedAlbum entity by IDs.
+func (au *AlbumUpdate) AddSavedAlbumIDs(ids ...string) *AlbumUpdate {
+	au.mutation.AddSavedAlbumIDs(ids...)
+	return au
+}
+
+// AddSavedAlbums adds the "saved_albums" edges to the SavedAlbum entity.
+func (au *AlbumUpdate) AddSavedAlbums(s ...*SavedAlbum) *AlbumUpdate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return au.AddSavedAlbumIDs(ids...)
+}
+
+// Mutation returns the AlbumMutation object of the builder.
+func (au *AlbumUpdate) Mutation() *AlbumMutation {
+	return au.mutation
+}
+
+// ClearArtists clears all "artists" edges to the Artist entity.
+func (au *AlbumUpdate) ClearArtists() *AlbumUpdate {
+	au.mutation.ClearArtists()
+	return au
+}
+
+// RemoveArtistIDs removes the "artists" edge to Artist entities by IDs.
+func (au *AlbumUpdate) RemoveArtistIDs(ids ...string) *AlbumUpdate {
+	au.mutation.RemoveArtistIDs(ids...)
+	return au
+}
+
+// RemoveArtists removes "artists" edges to Artist entities.
+func (au *AlbumUpdate) RemoveArtists(a ...*Artist) *AlbumUpdate {
+	ids := make([]string, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return au.RemoveArtistIDs(ids...)
+}
+
+// ClearTracks clears all "tracks" edges to the Track entity.
+func (au *AlbumUpdate) ClearTracks() *AlbumUpdate {
+	au.mutation.ClearTracks()
+	return au
+}
+
+// RemoveTrackIDs removes the "tracks" edge to Track entities by IDs.
+func (au *AlbumUpdate) RemoveTrackIDs(ids ...string) *AlbumUpdate {
+	au.mutation.RemoveTrackIDs(ids...)
+	return au
+}
+
+// RemoveTracks removes "tracks" edges to Track entities.
+func (au *AlbumUpdate) RemoveTracks(t ...*Track) *AlbumUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return au.RemoveTrackIDs(ids...)
+}
+
+// ClearSavedAlbums clears all "saved_albums" edges to the SavedAlbum entity.
+func (au *AlbumUpdate) ClearSavedAlbums() *AlbumUpdate {
+	au.mutation.ClearSavedAlbums()
+	return au
+}
+
+// RemoveSavedAlbumIDs removes the "saved_albums" edge to SavedAlbum entities by IDs.
+func (au *AlbumUpdate) RemoveSavedAlbumIDs(ids ...string) *AlbumUpdate {
+	au.mutation.RemoveSavedAlbumIDs(ids...)
+	return au
+}
+
+// RemoveSavedAlbums removes "saved_albums" edges to SavedAlbum entities.
+func (au *AlbumUpdate) RemoveSavedAlbums(s ...*SavedAlbum) *AlbumUpdate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return au.RemoveSavedAlbumIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (au *AlbumUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, au.sqlSave, au.mutation, au.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (au *AlbumUpdate) SaveX(ctx context.Context) int {
+	affected, err := au.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (au *AlbumUpdate) Exec(ctx context.Context) error {
+	_, err := au.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (au *AlbumUpdate) ExecX(ctx context.Context) {
+	if err := au.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (au *AlbumUpdate) check() error {
+	if v, ok := au.mutation.TotalTracks(); ok {
+		if err := album.TotalTracksValidator(v); err != nil {
+			return &ValidationError{Name: "total_tracks", err: fmt.Errorf(`ent: validator failed for field "Album.total_tracks": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (au *AlbumUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := au.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(album.Table, album.Columns, sqlgraph.NewFieldSpec(album.FieldID, field.TypeString))
+	if ps := au.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := au.mutation.URI(); ok {
+		_spec.SetField(album.FieldURI, field.TypeString, value)
+	}
+	if value, ok := au.mutation.Name(); ok {
+		_spec.SetField(album.FieldName, field.TypeString, value)
+	}
+	if value, ok := au.mutation.AlbumType(); ok {
+		_spec.SetField(album.FieldAlbumType, field.TypeString, value)
+	}
+	if value, ok := au.mutation.ReleaseDate(); ok {
+		_spec.SetField(album.FieldReleaseDate, field.TypeString, value)
+	}
+	if value, ok := au.mutation.TotalTracks(); ok {
+		_spec.SetField(album.FieldTotalTracks, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.AddedTotalTracks(); ok {
+		_spec.AddField(album.FieldTotalTracks, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.Label(); ok {
+		_spec.SetField(album.FieldLabel, field.TypeString, value)
+	}
+	if value, ok := au.mutation.Upc(); ok {
+		_spec.SetField(album.FieldUpc, field.TypeString, value)
+	}
+	if au.mutation.ArtistsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   album.ArtistsTable,
+			Columns: album.ArtistsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artist.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedArtistsIDs(); len(nodes) > 0 && !au.mutation.ArtistsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   album.ArtistsTable,
+			Columns: album.ArtistsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artist.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.ArtistsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   album.ArtistsTable,
+			Columns: album.ArtistsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artist.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.TracksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   album.TracksTable,
+			Columns: []string{album.TracksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedTracksIDs(); len(nodes) > 0 && !au.mutation.TracksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   album.TracksTable,
+			Columns: []string{album.TracksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.TracksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   album.TracksTable,
+			Columns: []string{album.TracksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.SavedAlbumsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   album.SavedAlbumsTable,
+			Columns: []string{album.SavedAlbumsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedalbum.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedSavedAlbumsIDs(); len(nodes) > 0 && !au.mutation.SavedAlbumsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   album.SavedAlbumsTable,
+			Columns: []string{album.SavedAlbumsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedalbum.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.SavedAlbumsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   album.SavedAlbumsTable,
+			Columns: []string{album.SavedAlbumsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedalbum.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{album.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	au.mutation.done = true
+	return n, nil
+}
+
+// AlbumUpdateOne is the builder for updating a single Album entity.
+type AlbumUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AlbumMutation
+}
+
+// SetURI sets the "uri" field.
+func (auo *AlbumUpdateOne) SetURI(s string) *AlbumUpdateOne {
+	auo.mutation.SetURI(s)
+	return auo
+}
+
+// SetNillableURI sets the "uri" field if the given value is not nil.
+func (auo *AlbumUpdateOne) SetNillableURI(s *string) *AlbumUpdateOne {
+	if s != nil {
+		auo.SetURI(*s)
+	}
+	return auo
+}
+
+// SetName sets the "name" field.
+func (auo *AlbumUpdateOne) SetName(s string) *AlbumUpdateOne {
+	auo.mutation.SetName(s)
+	return auo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (auo *AlbumUpdateOne) SetNillableName(s *string) *AlbumUpdateOne {
+	if s != nil {
+		auo.SetName(*s)
+	}
+	return auo
+}
+
+// SetAlbumType sets the "album_type" field.
+func (auo *AlbumUpdateOne) SetAlbumType(s string) *AlbumUpdateOne {
+	auo.mutation.SetAlbumType(s)
+	return auo
+}
+
+// SetNillableAlbumType sets the "album_type" field if the given value is not nil.
+func (auo *AlbumUpdateOne) SetNillableAlbumType(s *string) *AlbumUpdateOne {
+	if s != nil {
+		auo.SetAlbumType(*s)
+	}
+	return auo
+}
+
+// SetReleaseDate sets the "release_date" field.
+func (auo *AlbumUpdateOne) SetReleaseDate(s string) *AlbumUpdateOne {
+	auo.mutation.SetReleaseDate(s)
+	return auo
+}
+
+// SetNillableReleaseDate sets the "release_date" field if the given value is not nil.
+func (auo *AlbumUpdateOne) SetNillableReleaseDate(s *string) *AlbumUpdateOne {
+	if s != nil {
+		auo.SetReleaseDate(*s)
+	}
+	return auo
+}
+
+// SetTotalTracks sets the "total_tracks" field.
+func (auo *AlbumUpdateOne) SetTotalTracks(i int) *AlbumUpdateOne {
+	auo.mutation.ResetTotalTracks()
+	auo.mutation.SetTotalTracks(i)
+	return auo
+}
+
+// SetNillableTotalTracks sets the "total_tracks" field if the given value is not nil.
+func (auo *AlbumUpdateOne) SetNillableTotalTracks(i *int) *AlbumUpdateOne {
+	if i != nil {
+		auo.SetTotalTracks(*i)
+	}
+	return auo
+}
+
+// AddTotalTracks adds i to the "total_tracks" field.
+func (auo *AlbumUpdateOne) AddTotalTracks(i int) *AlbumUpdateOne {
+	auo.mutation.AddTotalTracks(i)
+	return auo
+}
+
+// SetLabel sets the "label" field.
+func (auo *AlbumUpdateOne) SetLabel(s string) *AlbumUpdateOne {
+	auo.mutation.SetLabel(s)
+	return auo
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (auo *AlbumUpdateOne) SetNillableLabel(s *string) *AlbumUpdateOne {
+	if s != nil {
+		auo.SetLabel(*s)
+	}
+	return auo
+}
+
+// SetUpc sets the "upc" field.
+func (auo *AlbumUpdateOne) SetUpc(s string) *AlbumUpdateOne {
+	auo.mutation.SetUpc(s)
+	return auo
+}
+
+// SetNillableUpc sets the "upc" field if the given value is not nil.
+func (auo *AlbumUpdateOne) SetNillableUpc(s *string) *AlbumUpdateOne {
+	if s != nil {
+		auo.SetUpc(*s)
+	}
+	return auo
+}
+
+// AddArtistIDs adds the "artists" edge to the Artist entity by IDs.
+func (auo *AlbumUpdateOne) AddArtistIDs(ids ...string) *AlbumUpdateOne {
+	auo.mutation.AddArtistIDs(ids...)
+	return auo
+}
+
+// AddArtists adds the "artists" edges to the Artist entity.
+func (auo *AlbumUpdateOne) AddArtists(a ...*Artist) *AlbumUpdateOne {
+	ids := make([]string, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return auo.AddArtistIDs(ids...)
+}
+
+// AddTrackIDs adds the "tracks" edge to the Track entity by IDs.
+func (auo *AlbumUpdateOne) AddTrackIDs(ids ...string) *AlbumUpdateOne {
+	auo.mutation.AddTrackIDs(ids...)
+	return auo
+}
+
+// AddTracks adds the "tracks" edges to the Track entity.
+func (auo *AlbumUpdateOne) AddTracks(t ...*Track) *AlbumUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return auo.AddTrackIDs(ids...)
+}
+
+// AddSavedAlbumIDs adds the "saved_albums" edge to the SavedAlbum entity by IDs.
+func (auo *AlbumUpdateOne) AddSavedAlbumIDs(ids ...string) *AlbumUpdateOne {
+	auo.mutation.AddSavedAlbumIDs(ids...)
+	return auo
+}
+
+// AddSavedAlbums adds the "saved_albums" edges to the SavedAlbum entity.
+func (auo *AlbumUpdateOne) AddSavedAlbums(s ...*SavedAlbum) *AlbumUpdateOne {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return auo.AddSavedAlbumIDs(ids...)
+}
+
+// Mutation returns the AlbumMutation object of the builder.
+func (auo *AlbumUpdateOne) Mutation() *AlbumMutation {
+	return auo.mutation
+}
+
+// ClearArtists clears all "artists" edges to the Artist entity.
+func (auo *AlbumUpdateOne) ClearArtists() *AlbumUpdateOne {
+	auo.mutation.ClearArtists()
+	return auo
+}
+
+// RemoveArtistIDs removes the "artists" edge to Artist entities by IDs.
+func (auo *AlbumUpdateOne) RemoveArtistIDs(ids ...string) *AlbumUpdateOne {
+	auo.mutation.RemoveArtistIDs(ids...)
+	return auo
+}
+
+// RemoveArtists removes "artists" edges to Artist entities.
+func (auo *AlbumUpdateOne) RemoveArtists(a ...*Artist) *AlbumUpdateOne {
+	ids := make([]string, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return auo.RemoveArtistIDs(ids...)
+}
+
+// ClearTracks clears all "tracks" edges to the Track entity.
+func (auo *AlbumUpdateOne) ClearTracks() *AlbumUpdateOne {
+	auo.mutation.ClearTracks()
+	return auo
+}
+
+// RemoveTrackIDs removes the "tracks" edge to Track entities by IDs.
+func (auo *AlbumUpdateOne) RemoveTrackIDs(ids ...string) *AlbumUpdateOne {
+	auo.mutation.RemoveTrackIDs(ids...)
+	return auo
+}
+
+// RemoveTracks removes "tracks" edges to Track entities.
+func (auo *AlbumUpdateOne) RemoveTracks(t ...*Track) *AlbumUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return auo.RemoveTrackIDs(ids...)
+}
+
+// ClearSavedAlbums clears all "saved_albums" edges to the SavedAlbum entity.
+func (auo *AlbumUpdateOne) ClearSavedAlbums() *AlbumUpdateOne {
+	auo.mutation.ClearSavedAlbums()
+	return auo
+}
+
+// RemoveSavedAlbumIDs removes the "saved_albums" edge to SavedAlbum entities by IDs.
+func (auo *AlbumUpdateOne) RemoveSavedAlbumIDs(ids ...string) *AlbumUpdateOne {
+	auo.mutation.RemoveSavedAlbumIDs(ids...)
+	return auo
+}
+
+// RemoveSavedAlbums removes "saved_albums" edges to SavedAlbum entities.
+func (auo *AlbumUpdateOne) RemoveSavedAlbums(s ...*SavedAlbum) *AlbumUpdateOne {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return auo.RemoveSavedAlbumIDs(ids...)
+}
+
+// Where appends a list predicates to the AlbumUpdate builder.
+func (auo *AlbumUpdateOne) Where(ps ...predicate.Album) *AlbumUpdateOne {
+	auo.mutation.Where(ps...)
+	return auo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (auo *AlbumUpdateOne) Select(field string, fields ...string) *AlbumUpdateOne {
+	auo.fields = append([]string{field}, fields...)
+	return auo
+}
+
+// Save executes the query and returns the updated Album entity.
+func (auo *AlbumUpdateOne) Save(ctx context.Context) (*Album, error) {
+	return withHooks(ctx, auo.sqlSave, auo.mutation, auo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (auo *AlbumUpdateOne) SaveX(ctx context.Context) *Album {
+	node, err := auo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (auo *AlbumUpdateOne) Exec(ctx context.Context) error {
+	_, err := auo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (auo *AlbumUpdateOne) ExecX(ctx context.Context) {
+	if err := auo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (auo *AlbumUpdateOne) check() error {
+	if v, ok := auo.mutation.TotalTracks(); ok {
+		if err := album.TotalTracksValidator(v); err != nil {
+			return &ValidationError{Name: "total_tracks", err: fmt.Errorf(`ent: validator failed for field "Album.total_tracks": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (auo *AlbumUpdateOne) sqlSave(ctx context.Context) (_node *Album, err error) {
+	if err := auo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(album.Table, album.Columns, sqlgraph.NewFieldSpec(album.FieldID, field.TypeString))
+	id, ok := auo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Album.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := auo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, album.FieldID)
+		for _, f := range fields {
+			if !album.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != album.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := auo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := auo.mutation.URI(); ok {
+		_spec.SetField(album.FieldURI, field.TypeString, value)
+	}
+	if value, ok := auo.mutation.Name(); ok {
+		_spec.SetField(album.FieldName, field.TypeString, value)
+	}
+	if value, ok := auo.mutation.AlbumType(); ok {
+		_spec.SetField(album.FieldAlbumType, field.TypeString, value)
+	}
+	if value, ok := auo.mutation.ReleaseDate(); ok {
+		_spec.SetField(album.FieldReleaseDate, field.TypeString, value)
+	}
+	if value, ok := auo.mutation.TotalTracks(); ok {
+		_spec.SetField(album.FieldTotalTracks, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.AddedTotalTracks(); ok {
+		_spec.AddField(album.FieldTotalTracks, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.Label(); ok {
+		_spec.SetField(album.FieldLabel, field.TypeString, value)
+	}
+	if value, ok := auo.mutation.Upc(); ok {
+		_spec.SetField(album.FieldUpc, field.TypeString, value)
+	}
+	if auo.mutation.ArtistsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   album.ArtistsTable,
+			Columns: album.ArtistsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artist.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedArtistsIDs(); len(nodes) > 0 && !auo.mutation.ArtistsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   album.ArtistsTable,
+			Columns: album.ArtistsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artist.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.ArtistsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   album.ArtistsTable,
+			Columns: album.ArtistsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artist.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.TracksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   album.TracksTable,
+			Columns: []string{album.TracksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedTracksIDs(); len(nodes) > 0 && !auo.mutation.TracksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   album.TracksTable,
+			Columns: []string{album.TracksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.TracksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   album.TracksTable,
+			Columns: []string{album.TracksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.SavedAlbumsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   album.SavedAlbumsTable,
+			Columns: []string{album.SavedAlbumsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedalbum.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedSavedAlbumsIDs(); len(nodes) > 0 && !auo.mutation.SavedAlbumsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   album.SavedAlbumsTable,
+			Columns: []string{album.SavedAlbumsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedalbum.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.SavedAlbumsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   album.SavedAlbumsTable,
+			Columns: []string{album.SavedAlbumsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedalbum.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Album{config: auo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, auo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{album.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	auo.mutation.done = true
+	return _node, nil
+}
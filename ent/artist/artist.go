@@ -0,0 +1,181 @@
+// Code generated by ent, DO NOT EDIT.
+
+package artist
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the artist type in the database.
+	Label = "artist"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSpotifyID holds the string denoting the spotify_id field in the database.
+	FieldSpotifyID = "spotify_id"
+	// FieldURI holds the string denoting the uri field in the database.
+	FieldURI = "uri"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldGenres holds the string denoting the genres field in the database.
+	FieldGenres = "genres"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeTracks holds the string denoting the tracks edge name in mutations.
+	EdgeTracks = "tracks"
+	// EdgeAlbums holds the string denoting the albums edge name in mutations.
+	EdgeAlbums = "albums"
+	// EdgeFollowedArtists holds the string denoting the followed_artists edge name in mutations.
+	EdgeFollowedArtists = "followed_artists"
+	// Table holds the table name of the artist in the database.
+	Table = "artists"
+	// TracksTable is the table that holds the tracks relation/edge. The primary key declared below.
+	TracksTable = "track_artists"
+	// TracksInverseTable is the table name for the Track entity.
+	// It exists in this package in order to avoid circular dependency with the "track" package.
+	TracksInverseTable = "tracks"
+	// AlbumsTable is the table that holds the albums relation/edge. The primary key declared below.
+	AlbumsTable = "album_artists"
+	// AlbumsInverseTable is the table name for the Album entity.
+	// It exists in this package in order to avoid circular dependency with the "album" package.
+	AlbumsInverseTable = "albums"
+	// FollowedArtistsTable is the table that holds the followed_artists relation/edge.
+	FollowedArtistsTable = "followed_artists"
+	// FollowedArtistsInverseTable is the table name for the FollowedArtist entity.
+	// It exists in this package in order to avoid circular dependency with the "followedartist" package.
+	FollowedArtistsInverseTable = "followed_artists"
+	// FollowedArtistsColumn is the table column denoting the followed_artists relation/edge.
+	FollowedArtistsColumn = "followed_artist_artist"
+)
+
+// Columns holds all SQL columns for artist fields.
+var Columns = []string{
+	FieldID,
+	FieldSpotifyID,
+	FieldURI,
+	FieldName,
+	FieldGenres,
+	FieldCreatedAt,
+}
+
+var (
+	// TracksPrimaryKey and TracksColumn2 are the table columns denoting the
+	// primary key for the tracks relation (M2M).
+	TracksPrimaryKey = []string{"track_id", "artist_id"}
+	// AlbumsPrimaryKey and AlbumsColumn2 are the table columns denoting the
+	// primary key for the albums relation (M2M).
+	AlbumsPrimaryKey = []string{"album_id", "artist_id"}
+)
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// SpotifyIDValidator is a validator for the "spotify_id" field. It is called by the builders before save.
+	SpotifyIDValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() string
+)
+
+// OrderOption defines the ordering options for the Artist queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// BySpotifyID orders the results by the spotify_id field.
+func BySpotifyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpotifyID, opts...).ToFunc()
+}
+
+// ByURI orders the results by the uri field.
+func ByURI(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldURI, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByTracksCount orders the results by tracks count.
+func ByTracksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTracksStep(), opts...)
+	}
+}
+
+// ByTracks orders the results by tracks terms.
+func ByTracks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTracksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAlbumsCount orders the results by albums count.
+func ByAlbumsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAlbumsStep(), opts...)
+	}
+}
+
+// ByAlbums orders the results by albums terms.
+func ByAlbums(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAlbumsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByFollowedArtistsCount orders the results by followed_artists count.
+func ByFollowedArtistsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newFollowedArtistsStep(), opts...)
+	}
+}
+
+// ByFollowedArtists orders the results by followed_artists terms.
+func ByFollowedArtists(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFollowedArtistsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newTracksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TracksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, TracksTable, TracksPrimaryKey...),
+	)
+}
+func newAlbumsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AlbumsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, AlbumsTable, AlbumsPrimaryKey...),
+	)
+}
+func newFollowedArtistsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FollowedArtistsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, FollowedArtistsTable, FollowedArtistsColumn),
+	)
+}
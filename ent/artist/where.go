@@ -0,0 +1,415 @@
+// Code generated by ent, DO NOT EDIT.
+
+package artist
+
+import (
+	"beyerleinf/spotify-backup/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Artist {
+	return predicate.Artist(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Artist {
+	return predicate.Artist(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Artist {
+	return predicate.Artist(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Artist {
+	return predicate.Artist(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Artist {
+	return predicate.Artist(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Artist {
+	return predicate.Artist(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Artist {
+	return predicate.Artist(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Artist {
+	return predicate.Artist(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Artist {
+	return predicate.Artist(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Artist {
+	return predicate.Artist(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Artist {
+	return predicate.Artist(sql.FieldContainsFold(FieldID, id))
+}
+
+// SpotifyID applies equality check predicate on the "spotify_id" field. It's identical to SpotifyIDEQ.
+func SpotifyID(v string) predicate.Artist {
+	return predicate.Artist(sql.FieldEQ(FieldSpotifyID, v))
+}
+
+// URI applies equality check predicate on the "uri" field. It's identical to URIEQ.
+func URI(v string) predicate.Artist {
+	return predicate.Artist(sql.FieldEQ(FieldURI, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Artist {
+	return predicate.Artist(sql.FieldEQ(FieldName, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Artist {
+	return predicate.Artist(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// SpotifyIDEQ applies the EQ predicate on the "spotify_id" field.
+func SpotifyIDEQ(v string) predicate.Artist {
+	return predicate.Artist(sql.FieldEQ(FieldSpotifyID, v))
+}
+
+// SpotifyIDNEQ applies the NEQ predicate on the "spotify_id" field.
+func SpotifyIDNEQ(v string) predicate.Artist {
+	return predicate.Artist(sql.FieldNEQ(FieldSpotifyID, v))
+}
+
+// SpotifyIDIn applies the In predicate on the "spotify_id" field.
+func SpotifyIDIn(vs ...string) predicate.Artist {
+	return predicate.Artist(sql.FieldIn(FieldSpotifyID, vs...))
+}
+
+// SpotifyIDNotIn applies the NotIn predicate on the "spotify_id" field.
+func SpotifyIDNotIn(vs ...string) predicate.Artist {
+	return predicate.Artist(sql.FieldNotIn(FieldSpotifyID, vs...))
+}
+
+// SpotifyIDGT applies the GT predicate on the "spotify_id" field.
+func SpotifyIDGT(v string) predicate.Artist {
+	return predicate.Artist(sql.FieldGT(FieldSpotifyID, v))
+}
+
+// SpotifyIDGTE applies the GTE predicate on the "spotify_id" field.
+func SpotifyIDGTE(v string) predicate.Artist {
+	return predicate.Artist(sql.FieldGTE(FieldSpotifyID, v))
+}
+
+// SpotifyIDLT applies the LT predicate on the "spotify_id" field.
+func SpotifyIDLT(v string) predicate.Artist {
+	return predicate.Artist(sql.FieldLT(FieldSpotifyID, v))
+}
+
+// SpotifyIDLTE applies the LTE predicate on the "spotify_id" field.
+func SpotifyIDLTE(v string) predicate.Artist {
+	return predicate.Artist(sql.FieldLTE(FieldSpotifyID, v))
+}
+
+// SpotifyIDContains applies the Contains predicate on the "spotify_id" field.
+func SpotifyIDContains(v string) predicate.Artist {
+	return predicate.Artist(sql.FieldContains(FieldSpotifyID, v))
+}
+
+// SpotifyIDHasPrefix applies the HasPrefix predicate on the "spotify_id" field.
+func SpotifyIDHasPrefix(v string) predicate.Artist {
+	return predicate.Artist(sql.FieldHasPrefix(FieldSpotifyID, v))
+}
+
+// SpotifyIDHasSuffix applies the HasSuffix predicate on the "spotify_id" field.
+func SpotifyIDHasSuffix(v string) predicate.Artist {
+	return predicate.Artist(sql.FieldHasSuffix(FieldSpotifyID, v))
+}
+
+// SpotifyIDEqualFold applies the EqualFold predicate on the "spotify_id" field.
+func SpotifyIDEqualFold(v string) predicate.Artist {
+	return predicate.Artist(sql.FieldEqualFold(FieldSpotifyID, v))
+}
+
+// SpotifyIDContainsFold applies the ContainsFold predicate on the "spotify_id" field.
+func SpotifyIDContainsFold(v string) predicate.Artist {
+	return predicate.Artist(sql.FieldContainsFold(FieldSpotifyID, v))
+}
+
+// URIEQ applies the EQ predicate on the "uri" field.
+func URIEQ(v string) predicate.Artist {
+	return predicate.Artist(sql.FieldEQ(FieldURI, v))
+}
+
+// URINEQ applies the NEQ predicate on the "uri" field.
+func URINEQ(v string) predicate.Artist {
+	return predicate.Artist(sql.FieldNEQ(FieldURI, v))
+}
+
+// URIIn applies the In predicate on the "uri" field.
+func URIIn(vs ...string) predicate.Artist {
+	return predicate.Artist(sql.FieldIn(FieldURI, vs...))
+}
+
+// URINotIn applies the NotIn predicate on the "uri" field.
+func URINotIn(vs ...string) predicate.Artist {
+	return predicate.Artist(sql.FieldNotIn(FieldURI, vs...))
+}
+
+// URIGT applies the GT predicate on the "uri" field.
+func URIGT(v string) predicate.Artist {
+	return predicate.Artist(sql.FieldGT(FieldURI, v))
+}
+
+// URIGTE applies the GTE predicate on the "uri" field.
+func URIGTE(v string) predicate.Artist {
+	return predicate.Artist(sql.FieldGTE(FieldURI, v))
+}
+
+// URILT applies the LT predicate on the "uri" field.
+func URILT(v string) predicate.Artist {
+	return predicate.Artist(sql.FieldLT(FieldURI, v))
+}
+
+// URILTE applies the LTE predicate on the "uri" field.
+func URILTE(v string) predicate.Artist {
+	return predicate.Artist(sql.FieldLTE(FieldURI, v))
+}
+
+// URIContains applies the Contains predicate on the "uri" field.
+func URIContains(v string) predicate.Artist {
+	return predicate.Artist(sql.FieldContains(FieldURI, v))
+}
+
+// URIHasPrefix applies the HasPrefix predicate on the "uri" field.
+func URIHasPrefix(v string) predicate.Artist {
+	return predicate.Artist(sql.FieldHasPrefix(FieldURI, v))
+}
+
+// URIHasSuffix applies the HasSuffix predicate on the "uri" field.
+func URIHasSuffix(v string) predicate.Artist {
+	return predicate.Artist(sql.FieldHasSuffix(FieldURI, v))
+}
+
+// URIEqualFold applies the EqualFold predicate on the "uri" field.
+func URIEqualFold(v string) predicate.Artist {
+	return predicate.Artist(sql.FieldEqualFold(FieldURI, v))
+}
+
+// URIContainsFold applies the ContainsFold predicate on the "uri" field.
+func URIContainsFold(v string) predicate.Artist {
+	return predicate.Artist(sql.FieldContainsFold(FieldURI, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Artist {
+	return predicate.Artist(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Artist {
+	return predicate.Artist(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Artist {
+	return predicate.Artist(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Artist {
+	return predicate.Artist(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Artist {
+	return predicate.Artist(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Artist {
+	return predicate.Artist(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Artist {
+	return predicate.Artist(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Artist {
+	return predicate.Artist(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Artist {
+	return predicate.Artist(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Artist {
+	return predicate.Artist(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Artist {
+	return predicate.Artist(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Artist {
+	return predicate.Artist(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Artist {
+	return predicate.Artist(sql.FieldContainsFold(FieldName, v))
+}
+
+// GenresIsNil applies the IsNil predicate on the "genres" field.
+func GenresIsNil() predicate.Artist {
+	return predicate.Artist(sql.FieldIsNull(FieldGenres))
+}
+
+// GenresNotNil applies the NotNil predicate on the "genres" field.
+func GenresNotNil() predicate.Artist {
+	return predicate.Artist(sql.FieldNotNull(FieldGenres))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Artist {
+	return predicate.Artist(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Artist {
+	return predicate.Artist(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Artist {
+	return predicate.Artist(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Artist {
+	return predicate.Artist(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Artist {
+	return predicate.Artist(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Artist {
+	return predicate.Artist(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Artist {
+	return predicate.Artist(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Artist {
+	return predicate.Artist(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasTracks applies the HasEdge predicate on the "tracks" edge.
+func HasTracks() predicate.Artist {
+	return predicate.Artist(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, TracksTable, TracksPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTracksWith applies the HasEdge predicate on the "tracks" edge with a given conditions (other predicates).
+func HasTracksWith(preds ...predicate.Track) predicate.Artist {
+	return predicate.Artist(func(s *sql.Selector) {
+		step := newTracksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAlbums applies the HasEdge predicate on the "albums" edge.
+func HasAlbums() predicate.Artist {
+	return predicate.Artist(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, AlbumsTable, AlbumsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAlbumsWith applies the HasEdge predicate on the "albums" edge with a given conditions (other predicates).
+func HasAlbumsWith(preds ...predicate.Album) predicate.Artist {
+	return predicate.Artist(func(s *sql.Selector) {
+		step := newAlbumsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasFollowedArtists applies the HasEdge predicate on the "followed_artists" edge.
+func HasFollowedArtists() predicate.Artist {
+	return predicate.Artist(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, FollowedArtistsTable, FollowedArtistsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFollowedArtistsWith applies the HasEdge predicate on the "followed_artists" edge with a given conditions (other predicates).
+func HasFollowedArtistsWith(preds ...predicate.FollowedArtist) predicate.Artist {
+	return predicate.Artist(func(s *sql.Selector) {
+		step := newFollowedArtistsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Artist) predicate.Artist {
+	return predicate.Artist(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Artist) predicate.Artist {
+	return predicate.Artist(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Artist) predicate.Artist {
+	return predicate.Artist(sql.NotPredicates(p))
+}
@@ -0,0 +1,375 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/album"
+	"beyerleinf/spotify-backup/ent/artist"
+	"beyerleinf/spotify-backup/ent/followedartist"
+	"beyerleinf/spotify-backup/ent/track"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ArtistCreate is the builder for creating a Artist entity.
+type ArtistCreate struct {
+	config
+	mutation *ArtistMutation
+	hooks    []Hook
+}
+
+// SetSpotifyID sets the "spotify_id" field.
+func (ac *ArtistCreate) SetSpotifyID(s string) *ArtistCreate {
+	ac.mutation.SetSpotifyID(s)
+	return ac
+}
+
+// SetURI sets the "uri" field.
+func (ac *ArtistCreate) SetURI(s string) *ArtistCreate {
+	ac.mutation.SetURI(s)
+	return ac
+}
+
+// SetName sets the "name" field.
+func (ac *ArtistCreate) SetName(s string) *ArtistCreate {
+	ac.mutation.SetName(s)
+	return ac
+}
+
+// SetGenres sets the "genres" field.
+func (ac *ArtistCreate) SetGenres(s []string) *ArtistCreate {
+	ac.mutation.SetGenres(s)
+	return ac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ac *ArtistCreate) SetCreatedAt(t time.Time) *ArtistCreate {
+	ac.mutation.SetCreatedAt(t)
+	return ac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ac *ArtistCreate) SetNillableCreatedAt(t *time.Time) *ArtistCreate {
+	if t != nil {
+		ac.SetCreatedAt(*t)
+	}
+	return ac
+}
+
+// SetID sets the "id" field.
+func (ac *ArtistCreate) SetID(s string) *ArtistCreate {
+	ac.mutation.SetID(s)
+	return ac
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ac *ArtistCreate) SetNillableID(s *string) *ArtistCreate {
+	if s != nil {
+		ac.SetID(*s)
+	}
+	return ac
+}
+
+// AddTrackIDs adds the "tracks" edge to the Track entity by IDs.
+func (ac *ArtistCreate) AddTrackIDs(ids ...string) *ArtistCreate {
+	ac.mutation.AddTrackIDs(ids...)
+	return ac
+}
+
+// AddTracks adds the "tracks" edges to the Track entity.
+func (ac *ArtistCreate) AddTracks(t ...*Track) *ArtistCreate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return ac.AddTrackIDs(ids...)
+}
+
+// AddAlbumIDs adds the "albums" edge to the Album entity by IDs.
+func (ac *ArtistCreate) AddAlbumIDs(ids ...string) *ArtistCreate {
+	ac.mutation.AddAlbumIDs(ids...)
+	return ac
+}
+
+// AddAlbums adds the "albums" edges to the Album entity.
+func (ac *ArtistCreate) AddAlbums(a ...*Album) *ArtistCreate {
+	ids := make([]string, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ac.AddAlbumIDs(ids...)
+}
+
+// AddFollowedArtistIDs adds the "followed_artists" edge to the FollowedArtist entity by IDs.
+func (ac *ArtistCreate) AddFollowedArtistIDs(ids ...string) *ArtistCreate {
+	ac.mutation.AddFollowedArtistIDs(ids...)
+	return ac
+}
+
+// AddFollowedArtists adds the "followed_artists" edges to the FollowedArtist entity.
+func (ac *ArtistCreate) AddFollowedArtists(f ...*FollowedArtist) *ArtistCreate {
+	ids := make([]string, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return ac.AddFollowedArtistIDs(ids...)
+}
+
+// Mutation returns the ArtistMutation object of the builder.
+func (ac *ArtistCreate) Mutation() *ArtistMutation {
+	return ac.mutation
+}
+
+// Save creates the Artist in the database.
+func (ac *ArtistCreate) Save(ctx context.Context) (*Artist, error) {
+	ac.defaults()
+	return withHooks(ctx, ac.sqlSave, ac.mutation, ac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ac *ArtistCreate) SaveX(ctx context.Context) *Artist {
+	v, err := ac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ac *ArtistCreate) Exec(ctx context.Context) error {
+	_, err := ac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ac *ArtistCreate) ExecX(ctx context.Context) {
+	if err := ac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ac *ArtistCreate) defaults() {
+	if _, ok := ac.mutation.CreatedAt(); !ok {
+		v := artist.DefaultCreatedAt()
+		ac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ac.mutation.ID(); !ok {
+		v := artist.DefaultID()
+		ac.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ac *ArtistCreate) check() error {
+	if _, ok := ac.mutation.SpotifyID(); !ok {
+		return &ValidationError{Name: "spotify_id", err: errors.New(`ent: missing required field "Artist.spotify_id"`)}
+	}
+	if v, ok := ac.mutation.SpotifyID(); ok {
+		if err := artist.SpotifyIDValidator(v); err != nil {
+			return &ValidationError{Name: "spotify_id", err: fmt.Errorf(`ent: validator failed for field "Artist.spotify_id": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.URI(); !ok {
+		return &ValidationError{Name: "uri", err: errors.New(`ent: missing required field "Artist.uri"`)}
+	}
+	if _, ok := ac.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Artist.name"`)}
+	}
+	if _, ok := ac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Artist.created_at"`)}
+	}
+	return nil
+}
+
+func (ac *ArtistCreate) sqlSave(ctx context.Context) (*Artist, error) {
+	if err := ac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Artist.ID type: %T", _spec.ID.Value)
+		}
+	}
+	ac.mutation.id = &_node.ID
+	ac.mutation.done = true
+	return _node, nil
+}
+
+func (ac *ArtistCreate) createSpec() (*Artist, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Artist{config: ac.config}
+		_spec = sqlgraph.NewCreateSpec(artist.Table, sqlgraph.NewFieldSpec(artist.FieldID, field.TypeString))
+	)
+	if id, ok := ac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := ac.mutation.SpotifyID(); ok {
+		_spec.SetField(artist.FieldSpotifyID, field.TypeString, value)
+		_node.SpotifyID = value
+	}
+	if value, ok := ac.mutation.URI(); ok {
+		_spec.SetField(artist.FieldURI, field.TypeString, value)
+		_node.URI = value
+	}
+	if value, ok := ac.mutation.Name(); ok {
+		_spec.SetField(artist.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := ac.mutation.Genres(); ok {
+		_spec.SetField(artist.FieldGenres, field.TypeJSON, value)
+		_node.Genres = value
+	}
+	if value, ok := ac.mutation.CreatedAt(); ok {
+		_spec.SetField(artist.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := ac.mutation.TracksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   artist.TracksTable,
+			Columns: artist.TracksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.AlbumsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   artist.AlbumsTable,
+			Columns: artist.AlbumsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(album.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.FollowedArtistsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   artist.FollowedArtistsTable,
+			Columns: []string{artist.FollowedArtistsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(followedartist.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ArtistCreateBulk is the builder for creating many Artist entities in bulk.
+type ArtistCreateBulk struct {
+	config
+	err      error
+	builders []*ArtistCreate
+}
+
+// Save creates the Artist entities in the database.
+func (acb *ArtistCreateBulk) Save(ctx context.Context) ([]*Artist, error) {
+	if acb.err != nil {
+		return nil, acb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(acb.builders))
+	nodes := make([]*Artist, len(acb.builders))
+	mutators := make([]Mutator, len(acb.builders))
+	for i := range acb.builders {
+		func(i int, root context.Context) {
+			builder := acb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ArtistMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, acb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, acb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, acb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (acb *ArtistCreateBulk) SaveX(ctx context.Context) []*Artist {
+	v, err := acb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (acb *ArtistCreateBulk) Exec(ctx context.Context) error {
+	_, err := acb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acb *ArtistCreateBulk) ExecX(ctx context.Context) {
+	if err := acb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
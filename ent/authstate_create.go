@@ -0,0 +1,231 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/authstate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AuthStateCreate is the builder for creating a AuthState entity.
+type AuthStateCreate struct {
+	config
+	mutation *AuthStateMutation
+	hooks    []Hook
+}
+
+// SetState sets the "state" field.
+func (asc *AuthStateCreate) SetState(s string) *AuthStateCreate {
+	asc.mutation.SetState(s)
+	return asc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (asc *AuthStateCreate) SetExpiresAt(t time.Time) *AuthStateCreate {
+	asc.mutation.SetExpiresAt(t)
+	return asc
+}
+
+// SetID sets the "id" field.
+func (asc *AuthStateCreate) SetID(s string) *AuthStateCreate {
+	asc.mutation.SetID(s)
+	return asc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (asc *AuthStateCreate) SetNillableID(s *string) *AuthStateCreate {
+	if s != nil {
+		asc.SetID(*s)
+	}
+	return asc
+}
+
+// Mutation returns the AuthStateMutation object of the builder.
+func (asc *AuthStateCreate) Mutation() *AuthStateMutation {
+	return asc.mutation
+}
+
+// Save creates the AuthState in the database.
+func (asc *AuthStateCreate) Save(ctx context.Context) (*AuthState, error) {
+	asc.defaults()
+	return withHooks(ctx, asc.sqlSave, asc.mutation, asc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (asc *AuthStateCreate) SaveX(ctx context.Context) *AuthState {
+	v, err := asc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (asc *AuthStateCreate) Exec(ctx context.Context) error {
+	_, err := asc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (asc *AuthStateCreate) ExecX(ctx context.Context) {
+	if err := asc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (asc *AuthStateCreate) defaults() {
+	if _, ok := asc.mutation.ID(); !ok {
+		v := authstate.DefaultID()
+		asc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (asc *AuthStateCreate) check() error {
+	if _, ok := asc.mutation.State(); !ok {
+		return &ValidationError{Name: "state", err: errors.New(`ent: missing required field "AuthState.state"`)}
+	}
+	if v, ok := asc.mutation.State(); ok {
+		if err := authstate.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "AuthState.state": %w`, err)}
+		}
+	}
+	if _, ok := asc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "AuthState.expires_at"`)}
+	}
+	return nil
+}
+
+func (asc *AuthStateCreate) sqlSave(ctx context.Context) (*AuthState, error) {
+	if err := asc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := asc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, asc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected AuthState.ID type: %T", _spec.ID.Value)
+		}
+	}
+	asc.mutation.id = &_node.ID
+	asc.mutation.done = true
+	return _node, nil
+}
+
+func (asc *AuthStateCreate) createSpec() (*AuthState, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuthState{config: asc.config}
+		_spec = sqlgraph.NewCreateSpec(authstate.Table, sqlgraph.NewFieldSpec(authstate.FieldID, field.TypeString))
+	)
+	if id, ok := asc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := asc.mutation.State(); ok {
+		_spec.SetField(authstate.FieldState, field.TypeString, value)
+		_node.State = value
+	}
+	if value, ok := asc.mutation.ExpiresAt(); ok {
+		_spec.SetField(authstate.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	return _node, _spec
+}
+
+// AuthStateCreateBulk is the builder for creating many AuthState entities in bulk.
+type AuthStateCreateBulk struct {
+	config
+	err      error
+	builders []*AuthStateCreate
+}
+
+// Save creates the AuthState entities in the database.
+func (ascb *AuthStateCreateBulk) Save(ctx context.Context) ([]*AuthState, error) {
+	if ascb.err != nil {
+		return nil, ascb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ascb.builders))
+	nodes := make([]*AuthState, len(ascb.builders))
+	mutators := make([]Mutator, len(ascb.builders))
+	for i := range ascb.builders {
+		func(i int, root context.Context) {
+			builder := ascb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuthStateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ascb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ascb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ascb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ascb *AuthStateCreateBulk) SaveX(ctx context.Context) []*AuthState {
+	v, err := ascb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ascb *AuthStateCreateBulk) Exec(ctx context.Context) error {
+	_, err := ascb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ascb *AuthStateCreateBulk) ExecX(ctx context.Context) {
+	if err := ascb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
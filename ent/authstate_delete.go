@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/authstate"
+	"beyerleinf/spotify-backup/ent/predicate"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AuthStateDelete is the builder for deleting a AuthState entity.
+type AuthStateDelete struct {
+	config
+	hooks    []Hook
+	mutation *AuthStateMutation
+}
+
+// Where appends a list predicates to the AuthStateDelete builder.
+func (asd *AuthStateDelete) Where(ps ...predicate.AuthState) *AuthStateDelete {
+	asd.mutation.Where(ps...)
+	return asd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (asd *AuthStateDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, asd.sqlExec, asd.mutation, asd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (asd *AuthStateDelete) ExecX(ctx context.Context) int {
+	n, err := asd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (asd *AuthStateDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(authstate.Table, sqlgraph.NewFieldSpec(authstate.FieldID, field.TypeString))
+	if ps := asd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, asd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	asd.mutation.done = true
+	return affected, err
+}
+
+// AuthStateDeleteOne is the builder for deleting a single AuthState entity.
+type AuthStateDeleteOne struct {
+	asd *AuthStateDelete
+}
+
+// Where appends a list predicates to the AuthStateDelete builder.
+func (asdo *AuthStateDeleteOne) Where(ps ...predicate.AuthState) *AuthStateDeleteOne {
+	asdo.asd.mutation.Where(ps...)
+	return asdo
+}
+
+// Exec executes the deletion query.
+func (asdo *AuthStateDeleteOne) Exec(ctx context.Context) error {
+	n, err := asdo.asd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{authstate.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (asdo *AuthStateDeleteOne) ExecX(ctx context.Context) {
+	if err := asdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,564 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/authstate"
+	"beyerleinf/spotify-backup/ent/predicate"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AuthStateQuery is the builder for querying AuthState entities.
+type AuthStateQuery struct {
+	config
+	ctx        *QueryContext
+	order      []authstate.OrderOption
+	inters     []Interceptor
+	predicates []predicate.AuthState
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the AuthStateQuery builder.
+func (asq *AuthStateQuery) Where(ps ...predicate.AuthState) *AuthStateQuery {
+	asq.predicates = append(asq.predicates, ps...)
+	return asq
+}
+
+// Limit the number of records to be returned by this query.
+func (asq *AuthStateQuery) Limit(limit int) *AuthStateQuery {
+	asq.ctx.Limit = &limit
+	return asq
+}
+
+// Offset to start from.
+func (asq *AuthStateQuery) Offset(offset int) *AuthStateQuery {
+	asq.ctx.Offset = &offset
+	return asq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (asq *AuthStateQuery) Unique(unique bool) *AuthStateQuery {
+	asq.ctx.Unique = &unique
+	return asq
+}
+
+// Order specifies how the records should be ordered.
+func (asq *AuthStateQuery) Order(o ...authstate.OrderOption) *AuthStateQuery {
+	asq.order = append(asq.order, o...)
+	return asq
+}
+
+// First returns the first AuthState entity from the query.
+// Returns a *NotFoundError when no AuthState was found.
+func (asq *AuthStateQuery) First(ctx context.Context) (*AuthState, error) {
+	nodes, err := asq.Limit(1).All(setContextOp(ctx, asq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{authstate.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (asq *AuthStateQuery) FirstX(ctx context.Context) *AuthState {
+	node, err := asq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first AuthState ID from the query.
+// Returns a *NotFoundError when no AuthState ID was found.
+func (asq *AuthStateQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = asq.Limit(1).IDs(setContextOp(ctx, asq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{authstate.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (asq *AuthStateQuery) FirstIDX(ctx context.Context) string {
+	id, err := asq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single AuthState entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one AuthState entity is found.
+// Returns a *NotFoundError when no AuthState entities are found.
+func (asq *AuthStateQuery) Only(ctx context.Context) (*AuthState, error) {
+	nodes, err := asq.Limit(2).All(setContextOp(ctx, asq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{authstate.Label}
+	default:
+		return nil, &NotSingularError{authstate.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (asq *AuthStateQuery) OnlyX(ctx context.Context) *AuthState {
+	node, err := asq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only AuthState ID in the query.
+// Returns a *NotSingularError when more than one AuthState ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (asq *AuthStateQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = asq.Limit(2).IDs(setContextOp(ctx, asq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{authstate.Label}
+	default:
+		err = &NotSingularError{authstate.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (asq *AuthStateQuery) OnlyIDX(ctx context.Context) string {
+	id, err := asq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of AuthStates.
+func (asq *AuthStateQuery) All(ctx context.Context) ([]*AuthState, error) {
+	ctx = setContextOp(ctx, asq.ctx, ent.OpQueryAll)
+	if err := asq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*AuthState, *AuthStateQuery]()
+	return withInterceptors[[]*AuthState](ctx, asq, qr, asq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (asq *AuthStateQuery) AllX(ctx context.Context) []*AuthState {
+	nodes, err := asq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of AuthState IDs.
+func (asq *AuthStateQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if asq.ctx.Unique == nil && asq.path != nil {
+		asq.Unique(true)
+	}
+	ctx = setContextOp(ctx, asq.ctx, ent.OpQueryIDs)
+	if err = asq.Select(authstate.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (asq *AuthStateQuery) IDsX(ctx context.Context) []string {
+	ids, err := asq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (asq *AuthStateQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, asq.ctx, ent.OpQueryCount)
+	if err := asq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, asq, querierCount[*AuthStateQuery](), asq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (asq *AuthStateQuery) CountX(ctx context.Context) int {
+	count, err := asq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (asq *AuthStateQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, asq.ctx, ent.OpQueryExist)
+	switch _, err := asq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (asq *AuthStateQuery) ExistX(ctx context.Context) bool {
+	exist, err := asq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the AuthStateQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (asq *AuthStateQuery) Clone() *AuthStateQuery {
+	if asq == nil {
+		return nil
+	}
+	return &AuthStateQuery{
+		config:     asq.config,
+		ctx:        asq.ctx.Clone(),
+		order:      append([]authstate.OrderOption{}, asq.order...),
+		inters:     append([]Interceptor{}, asq.inters...),
+		predicates: append([]predicate.AuthState{}, asq.predicates...),
+		// clone intermediate query.
+		sql:  asq.sql.Clone(),
+		path: asq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		State string `json:"state,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.AuthState.Query().
+//		GroupBy(authstate.FieldState).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (asq *AuthStateQuery) GroupBy(field string, fields ...string) *AuthStateGroupBy {
+	asq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &AuthStateGroupBy{build: asq}
+	grbuild.flds = &asq.ctx.Fields
+	grbuild.label = authstate.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		State string `json:"state,omitempty"`
+//	}
+//
+//	client.AuthState.Query().
+//		Select(authstate.FieldState).
+//		Scan(ctx, &v)
+func (asq *AuthStateQuery) Select(fields ...string) *AuthStateSelect {
+	asq.ctx.Fields = append(asq.ctx.Fields, fields...)
+	sbuild := &AuthStateSelect{AuthStateQuery: asq}
+	sbuild.label = authstate.Label
+	sbuild.flds, sbuild.scan = &asq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a AuthStateSelect configured with the given aggregations.
+func (asq *AuthStateQuery) Aggregate(fns ...AggregateFunc) *AuthStateSelect {
+	return asq.Select().Aggregate(fns...)
+}
+
+func (asq *AuthStateQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range asq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, asq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range asq.ctx.Fields {
+		if !authstate.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if asq.path != nil {
+		prev, err := asq.path(ctx)
+		if err != nil {
+			return err
+		}
+		asq.sql = prev
+	}
+	return nil
+}
+
+func (asq *AuthStateQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*AuthState, error) {
+	var (
+		nodes = []*AuthState{}
+		_spec = asq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*AuthState).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &AuthState{config: asq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(asq.modifiers) > 0 {
+		_spec.Modifiers = asq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, asq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (asq *AuthStateQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := asq.querySpec()
+	if len(asq.modifiers) > 0 {
+		_spec.Modifiers = asq.modifiers
+	}
+	_spec.Node.Columns = asq.ctx.Fields
+	if len(asq.ctx.Fields) > 0 {
+		_spec.Unique = asq.ctx.Unique != nil && *asq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, asq.driver, _spec)
+}
+
+func (asq *AuthStateQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(authstate.Table, authstate.Columns, sqlgraph.NewFieldSpec(authstate.FieldID, field.TypeString))
+	_spec.From = asq.sql
+	if unique := asq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if asq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := asq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, authstate.FieldID)
+		for i := range fields {
+			if fields[i] != authstate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := asq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := asq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := asq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := asq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (asq *AuthStateQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(asq.driver.Dialect())
+	t1 := builder.Table(authstate.Table)
+	columns := asq.ctx.Fields
+	if len(columns) == 0 {
+		columns = authstate.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if asq.sql != nil {
+		selector = asq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if asq.ctx.Unique != nil && *asq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range asq.modifiers {
+		m(selector)
+	}
+	for _, p := range asq.predicates {
+		p(selector)
+	}
+	for _, p := range asq.order {
+		p(selector)
+	}
+	if offset := asq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := asq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (asq *AuthStateQuery) ForUpdate(opts ...sql.LockOption) *AuthStateQuery {
+	if asq.driver.Dialect() == dialect.Postgres {
+		asq.Unique(false)
+	}
+	asq.modifiers = append(asq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return asq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (asq *AuthStateQuery) ForShare(opts ...sql.LockOption) *AuthStateQuery {
+	if asq.driver.Dialect() == dialect.Postgres {
+		asq.Unique(false)
+	}
+	asq.modifiers = append(asq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return asq
+}
+
+// AuthStateGroupBy is the group-by builder for AuthState entities.
+type AuthStateGroupBy struct {
+	selector
+	build *AuthStateQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (asgb *AuthStateGroupBy) Aggregate(fns ...AggregateFunc) *AuthStateGroupBy {
+	asgb.fns = append(asgb.fns, fns...)
+	return asgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (asgb *AuthStateGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, asgb.build.ctx, ent.OpQueryGroupBy)
+	if err := asgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AuthStateQuery, *AuthStateGroupBy](ctx, asgb.build, asgb, asgb.build.inters, v)
+}
+
+func (asgb *AuthStateGroupBy) sqlScan(ctx context.Context, root *AuthStateQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(asgb.fns))
+	for _, fn := range asgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*asgb.flds)+len(asgb.fns))
+		for _, f := range *asgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*asgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := asgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// AuthStateSelect is the builder for selecting fields of AuthState entities.
+type AuthStateSelect struct {
+	*AuthStateQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ass *AuthStateSelect) Aggregate(fns ...AggregateFunc) *AuthStateSelect {
+	ass.fns = append(ass.fns, fns...)
+	return ass
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ass *AuthStateSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ass.ctx, ent.OpQuerySelect)
+	if err := ass.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AuthStateQuery, *AuthStateSelect](ctx, ass.AuthStateQuery, ass, ass.inters, v)
+}
+
+func (ass *AuthStateSelect) sqlScan(ctx context.Context, root *AuthStateQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ass.fns))
+	for _, fn := range ass.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ass.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ass.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
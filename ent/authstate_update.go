@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/authstate"
+	"beyerleinf/spotify-backup/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AuthStateUpdate is the builder for updating AuthState entities.
+type AuthStateUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AuthStateMutation
+}
+
+// Where appends a list predicates to the AuthStateUpdate builder.
+func (asu *AuthStateUpdate) Where(ps ...predicate.AuthState) *AuthStateUpdate {
+	asu.mutation.Where(ps...)
+	return asu
+}
+
+// Mutation returns the AuthStateMutation object of the builder.
+func (asu *AuthStateUpdate) Mutation() *AuthStateMutation {
+	return asu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (asu *AuthStateUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, asu.sqlSave, asu.mutation, asu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (asu *AuthStateUpdate) SaveX(ctx context.Context) int {
+	affected, err := asu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (asu *AuthStateUpdate) Exec(ctx context.Context) error {
+	_, err := asu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (asu *AuthStateUpdate) ExecX(ctx context.Context) {
+	if err := asu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (asu *AuthStateUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(authstate.Table, authstate.Columns, sqlgraph.NewFieldSpec(authstate.FieldID, field.TypeString))
+	if ps := asu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, asu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{authstate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	asu.mutation.done = true
+	return n, nil
+}
+
+// AuthStateUpdateOne is the builder for updating a single AuthState entity.
+type AuthStateUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AuthStateMutation
+}
+
+// Mutation returns the AuthStateMutation object of the builder.
+func (asuo *AuthStateUpdateOne) Mutation() *AuthStateMutation {
+	return asuo.mutation
+}
+
+// Where appends a list predicates to the AuthStateUpdate builder.
+func (asuo *AuthStateUpdateOne) Where(ps ...predicate.AuthState) *AuthStateUpdateOne {
+	asuo.mutation.Where(ps...)
+	return asuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (asuo *AuthStateUpdateOne) Select(field string, fields ...string) *AuthStateUpdateOne {
+	asuo.fields = append([]string{field}, fields...)
+	return asuo
+}
+
+// Save executes the query and returns the updated AuthState entity.
+func (asuo *AuthStateUpdateOne) Save(ctx context.Context) (*AuthState, error) {
+	return withHooks(ctx, asuo.sqlSave, asuo.mutation, asuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (asuo *AuthStateUpdateOne) SaveX(ctx context.Context) *AuthState {
+	node, err := asuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (asuo *AuthStateUpdateOne) Exec(ctx context.Context) error {
+	_, err := asuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (asuo *AuthStateUpdateOne) ExecX(ctx context.Context) {
+	if err := asuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (asuo *AuthStateUpdateOne) sqlSave(ctx context.Context) (_node *AuthState, err error) {
+	_spec := sqlgraph.NewUpdateSpec(authstate.Table, authstate.Columns, sqlgraph.NewFieldSpec(authstate.FieldID, field.TypeString))
+	id, ok := asuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AuthState.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := asuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, authstate.FieldID)
+		for _, f := range fields {
+			if !authstate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != authstate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := asuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &AuthState{config: asuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, asuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{authstate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	asuo.mutation.done = true
+	return _node, nil
+}
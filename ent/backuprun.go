@@ -0,0 +1,386 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/schema/schematype"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BackupRun is the model entity for the BackupRun schema.
+type BackupRun struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// Trigger holds the value of the "trigger" field.
+	Trigger backuprun.Trigger `json:"trigger,omitempty"`
+	// JobID holds the value of the "job_id" field.
+	JobID string `json:"job_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status backuprun.Status `json:"status,omitempty"`
+	// Collections holds the value of the "collections" field.
+	Collections map[string]schematype.CollectionResult `json:"collections,omitempty"`
+	// APICalls holds the value of the "api_calls" field.
+	APICalls int `json:"api_calls,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// Checkpoint holds the value of the "checkpoint" field.
+	Checkpoint schematype.Checkpoint `json:"checkpoint,omitempty"`
+	// Resumes holds the value of the "resumes" field.
+	Resumes int `json:"resumes,omitempty"`
+	// Pinned holds the value of the "pinned" field.
+	Pinned bool `json:"pinned,omitempty"`
+	// Note holds the value of the "note" field.
+	Note string `json:"note,omitempty"`
+	// Labels holds the value of the "labels" field.
+	Labels []string `json:"labels,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// FinishedAt holds the value of the "finished_at" field.
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BackupRunQuery when eager-loading is set.
+	Edges        BackupRunEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// BackupRunEdges holds the relations/edges for other nodes in the graph.
+type BackupRunEdges struct {
+	// PlaylistSnapshots holds the value of the playlist_snapshots edge.
+	PlaylistSnapshots []*PlaylistSnapshot `json:"playlist_snapshots,omitempty"`
+	// SavedTracks holds the value of the saved_tracks edge.
+	SavedTracks []*SavedTrack `json:"saved_tracks,omitempty"`
+	// SavedAlbums holds the value of the saved_albums edge.
+	SavedAlbums []*SavedAlbum `json:"saved_albums,omitempty"`
+	// SavedShows holds the value of the saved_shows edge.
+	SavedShows []*SavedShow `json:"saved_shows,omitempty"`
+	// SavedEpisodes holds the value of the saved_episodes edge.
+	SavedEpisodes []*SavedEpisode `json:"saved_episodes,omitempty"`
+	// SavedAudiobooks holds the value of the saved_audiobooks edge.
+	SavedAudiobooks []*SavedAudiobook `json:"saved_audiobooks,omitempty"`
+	// FollowedArtists holds the value of the followed_artists edge.
+	FollowedArtists []*FollowedArtist `json:"followed_artists,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [7]bool
+}
+
+// PlaylistSnapshotsOrErr returns the PlaylistSnapshots value or an error if the edge
+// was not loaded in eager-loading.
+func (e BackupRunEdges) PlaylistSnapshotsOrErr() ([]*PlaylistSnapshot, error) {
+	if e.loadedTypes[0] {
+		return e.PlaylistSnapshots, nil
+	}
+	return nil, &NotLoadedError{edge: "playlist_snapshots"}
+}
+
+// SavedTracksOrErr returns the SavedTracks value or an error if the edge
+// was not loaded in eager-loading.
+func (e BackupRunEdges) SavedTracksOrErr() ([]*SavedTrack, error) {
+	if e.loadedTypes[1] {
+		return e.SavedTracks, nil
+	}
+	return nil, &NotLoadedError{edge: "saved_tracks"}
+}
+
+// SavedAlbumsOrErr returns the SavedAlbums value or an error if the edge
+// was not loaded in eager-loading.
+func (e BackupRunEdges) SavedAlbumsOrErr() ([]*SavedAlbum, error) {
+	if e.loadedTypes[2] {
+		return e.SavedAlbums, nil
+	}
+	return nil, &NotLoadedError{edge: "saved_albums"}
+}
+
+// SavedShowsOrErr returns the SavedShows value or an error if the edge
+// was not loaded in eager-loading.
+func (e BackupRunEdges) SavedShowsOrErr() ([]*SavedShow, error) {
+	if e.loadedTypes[3] {
+		return e.SavedShows, nil
+	}
+	return nil, &NotLoadedError{edge: "saved_shows"}
+}
+
+// SavedEpisodesOrErr returns the SavedEpisodes value or an error if the edge
+// was not loaded in eager-loading.
+func (e BackupRunEdges) SavedEpisodesOrErr() ([]*SavedEpisode, error) {
+	if e.loadedTypes[4] {
+		return e.SavedEpisodes, nil
+	}
+	return nil, &NotLoadedError{edge: "saved_episodes"}
+}
+
+// SavedAudiobooksOrErr returns the SavedAudiobooks value or an error if the edge
+// was not loaded in eager-loading.
+func (e BackupRunEdges) SavedAudiobooksOrErr() ([]*SavedAudiobook, error) {
+	if e.loadedTypes[5] {
+		return e.SavedAudiobooks, nil
+	}
+	return nil, &NotLoadedError{edge: "saved_audiobooks"}
+}
+
+// FollowedArtistsOrErr returns the FollowedArtists value or an error if the edge
+// was not loaded in eager-loading.
+func (e BackupRunEdges) FollowedArtistsOrErr() ([]*FollowedArtist, error) {
+	if e.loadedTypes[6] {
+		return e.FollowedArtists, nil
+	}
+	return nil, &NotLoadedError{edge: "followed_artists"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BackupRun) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case backuprun.FieldCollections, backuprun.FieldCheckpoint, backuprun.FieldLabels:
+			values[i] = new([]byte)
+		case backuprun.FieldPinned:
+			values[i] = new(sql.NullBool)
+		case backuprun.FieldAPICalls, backuprun.FieldResumes:
+			values[i] = new(sql.NullInt64)
+		case backuprun.FieldID, backuprun.FieldUserID, backuprun.FieldTrigger, backuprun.FieldJobID, backuprun.FieldStatus, backuprun.FieldError, backuprun.FieldNote:
+			values[i] = new(sql.NullString)
+		case backuprun.FieldStartedAt, backuprun.FieldFinishedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BackupRun fields.
+func (br *BackupRun) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case backuprun.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				br.ID = value.String
+			}
+		case backuprun.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				br.UserID = value.String
+			}
+		case backuprun.FieldTrigger:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trigger", values[i])
+			} else if value.Valid {
+				br.Trigger = backuprun.Trigger(value.String)
+			}
+		case backuprun.FieldJobID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field job_id", values[i])
+			} else if value.Valid {
+				br.JobID = value.String
+			}
+		case backuprun.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				br.Status = backuprun.Status(value.String)
+			}
+		case backuprun.FieldCollections:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field collections", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &br.Collections); err != nil {
+					return fmt.Errorf("unmarshal field collections: %w", err)
+				}
+			}
+		case backuprun.FieldAPICalls:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field api_calls", values[i])
+			} else if value.Valid {
+				br.APICalls = int(value.Int64)
+			}
+		case backuprun.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				br.Error = value.String
+			}
+		case backuprun.FieldCheckpoint:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field checkpoint", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &br.Checkpoint); err != nil {
+					return fmt.Errorf("unmarshal field checkpoint: %w", err)
+				}
+			}
+		case backuprun.FieldResumes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field resumes", values[i])
+			} else if value.Valid {
+				br.Resumes = int(value.Int64)
+			}
+		case backuprun.FieldPinned:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field pinned", values[i])
+			} else if value.Valid {
+				br.Pinned = value.Bool
+			}
+		case backuprun.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				br.Note = value.String
+			}
+		case backuprun.FieldLabels:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field labels", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &br.Labels); err != nil {
+					return fmt.Errorf("unmarshal field labels: %w", err)
+				}
+			}
+		case backuprun.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				br.StartedAt = value.Time
+			}
+		case backuprun.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				br.FinishedAt = new(time.Time)
+				*br.FinishedAt = value.Time
+			}
+		default:
+			br.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BackupRun.
+// This includes values selected through modifiers, order, etc.
+func (br *BackupRun) Value(name string) (ent.Value, error) {
+	return br.selectValues.Get(name)
+}
+
+// QueryPlaylistSnapshots queries the "playlist_snapshots" edge of the BackupRun entity.
+func (br *BackupRun) QueryPlaylistSnapshots() *PlaylistSnapshotQuery {
+	return NewBackupRunClient(br.config).QueryPlaylistSnapshots(br)
+}
+
+// QuerySavedTracks queries the "saved_tracks" edge of the BackupRun entity.
+func (br *BackupRun) QuerySavedTracks() *SavedTrackQuery {
+	return NewBackupRunClient(br.config).QuerySavedTracks(br)
+}
+
+// QuerySavedAlbums queries the "saved_albums" edge of the BackupRun entity.
+func (br *BackupRun) QuerySavedAlbums() *SavedAlbumQuery {
+	return NewBackupRunClient(br.config).QuerySavedAlbums(br)
+}
+
+// QuerySavedShows queries the "saved_shows" edge of the BackupRun entity.
+func (br *BackupRun) QuerySavedShows() *SavedShowQuery {
+	return NewBackupRunClient(br.config).QuerySavedShows(br)
+}
+
+// QuerySavedEpisodes queries the "saved_episodes" edge of the BackupRun entity.
+func (br *BackupRun) QuerySavedEpisodes() *SavedEpisodeQuery {
+	return NewBackupRunClient(br.config).QuerySavedEpisodes(br)
+}
+
+// QuerySavedAudiobooks queries the "saved_audiobooks" edge of the BackupRun entity.
+func (br *BackupRun) QuerySavedAudiobooks() *SavedAudiobookQuery {
+	return NewBackupRunClient(br.config).QuerySavedAudiobooks(br)
+}
+
+// QueryFollowedArtists queries the "followed_artists" edge of the BackupRun entity.
+func (br *BackupRun) QueryFollowedArtists() *FollowedArtistQuery {
+	return NewBackupRunClient(br.config).QueryFollowedArtists(br)
+}
+
+// Update returns a builder for updating this BackupRun.
+// Note that you need to call BackupRun.Unwrap() before calling this method if this BackupRun
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (br *BackupRun) Update() *BackupRunUpdateOne {
+	return NewBackupRunClient(br.config).UpdateOne(br)
+}
+
+// Unwrap unwraps the BackupRun entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (br *BackupRun) Unwrap() *BackupRun {
+	_tx, ok := br.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BackupRun is not a transactional entity")
+	}
+	br.config.driver = _tx.drv
+	return br
+}
+
+// String implements the fmt.Stringer.
+func (br *BackupRun) String() string {
+	var builder strings.Builder
+	builder.WriteString("BackupRun(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", br.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(br.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("trigger=")
+	builder.WriteString(fmt.Sprintf("%v", br.Trigger))
+	builder.WriteString(", ")
+	builder.WriteString("job_id=")
+	builder.WriteString(br.JobID)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", br.Status))
+	builder.WriteString(", ")
+	builder.WriteString("collections=")
+	builder.WriteString(fmt.Sprintf("%v", br.Collections))
+	builder.WriteString(", ")
+	builder.WriteString("api_calls=")
+	builder.WriteString(fmt.Sprintf("%v", br.APICalls))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(br.Error)
+	builder.WriteString(", ")
+	builder.WriteString("checkpoint=")
+	builder.WriteString(fmt.Sprintf("%v", br.Checkpoint))
+	builder.WriteString(", ")
+	builder.WriteString("resumes=")
+	builder.WriteString(fmt.Sprintf("%v", br.Resumes))
+	builder.WriteString(", ")
+	builder.WriteString("pinned=")
+	builder.WriteString(fmt.Sprintf("%v", br.Pinned))
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(br.Note)
+	builder.WriteString(", ")
+	builder.WriteString("labels=")
+	builder.WriteString(fmt.Sprintf("%v", br.Labels))
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(br.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := br.FinishedAt; v != nil {
+		builder.WriteString("finished_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BackupRuns is a parsable slice of BackupRun.
+type BackupRuns []*BackupRun
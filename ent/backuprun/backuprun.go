@@ -0,0 +1,439 @@
+// Code generated by ent, DO NOT EDIT.
+
+package backuprun
+
+import (
+	"beyerleinf/spotify-backup/ent/schema/schematype"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the backuprun type in the database.
+	Label = "backup_run"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldTrigger holds the string denoting the trigger field in the database.
+	FieldTrigger = "trigger"
+	// FieldJobID holds the string denoting the job_id field in the database.
+	FieldJobID = "job_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldCollections holds the string denoting the collections field in the database.
+	FieldCollections = "collections"
+	// FieldAPICalls holds the string denoting the api_calls field in the database.
+	FieldAPICalls = "api_calls"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldCheckpoint holds the string denoting the checkpoint field in the database.
+	FieldCheckpoint = "checkpoint"
+	// FieldResumes holds the string denoting the resumes field in the database.
+	FieldResumes = "resumes"
+	// FieldPinned holds the string denoting the pinned field in the database.
+	FieldPinned = "pinned"
+	// FieldNote holds the string denoting the note field in the database.
+	FieldNote = "note"
+	// FieldLabels holds the string denoting the labels field in the database.
+	FieldLabels = "labels"
+	// FieldStartedAt holds the string denoting the started_at field in the database.
+	FieldStartedAt = "started_at"
+	// FieldFinishedAt holds the string denoting the finished_at field in the database.
+	FieldFinishedAt = "finished_at"
+	// EdgePlaylistSnapshots holds the string denoting the playlist_snapshots edge name in mutations.
+	EdgePlaylistSnapshots = "playlist_snapshots"
+	// EdgeSavedTracks holds the string denoting the saved_tracks edge name in mutations.
+	EdgeSavedTracks = "saved_tracks"
+	// EdgeSavedAlbums holds the string denoting the saved_albums edge name in mutations.
+	EdgeSavedAlbums = "saved_albums"
+	// EdgeSavedShows holds the string denoting the saved_shows edge name in mutations.
+	EdgeSavedShows = "saved_shows"
+	// EdgeSavedEpisodes holds the string denoting the saved_episodes edge name in mutations.
+	EdgeSavedEpisodes = "saved_episodes"
+	// EdgeSavedAudiobooks holds the string denoting the saved_audiobooks edge name in mutations.
+	EdgeSavedAudiobooks = "saved_audiobooks"
+	// EdgeFollowedArtists holds the string denoting the followed_artists edge name in mutations.
+	EdgeFollowedArtists = "followed_artists"
+	// Table holds the table name of the backuprun in the database.
+	Table = "backup_runs"
+	// PlaylistSnapshotsTable is the table that holds the playlist_snapshots relation/edge. The primary key declared below.
+	PlaylistSnapshotsTable = "backup_run_playlist_snapshots"
+	// PlaylistSnapshotsInverseTable is the table name for the PlaylistSnapshot entity.
+	// It exists in this package in order to avoid circular dependency with the "playlistsnapshot" package.
+	PlaylistSnapshotsInverseTable = "playlist_snapshots"
+	// SavedTracksTable is the table that holds the saved_tracks relation/edge.
+	SavedTracksTable = "saved_tracks"
+	// SavedTracksInverseTable is the table name for the SavedTrack entity.
+	// It exists in this package in order to avoid circular dependency with the "savedtrack" package.
+	SavedTracksInverseTable = "saved_tracks"
+	// SavedTracksColumn is the table column denoting the saved_tracks relation/edge.
+	SavedTracksColumn = "backup_run_saved_tracks"
+	// SavedAlbumsTable is the table that holds the saved_albums relation/edge.
+	SavedAlbumsTable = "saved_albums"
+	// SavedAlbumsInverseTable is the table name for the SavedAlbum entity.
+	// It exists in this package in order to avoid circular dependency with the "savedalbum" package.
+	SavedAlbumsInverseTable = "saved_albums"
+	// SavedAlbumsColumn is the table column denoting the saved_albums relation/edge.
+	SavedAlbumsColumn = "backup_run_saved_albums"
+	// SavedShowsTable is the table that holds the saved_shows relation/edge.
+	SavedShowsTable = "saved_shows"
+	// SavedShowsInverseTable is the table name for the SavedShow entity.
+	// It exists in this package in order to avoid circular dependency with the "savedshow" package.
+	SavedShowsInverseTable = "saved_shows"
+	// SavedShowsColumn is the table column denoting the saved_shows relation/edge.
+	SavedShowsColumn = "backup_run_saved_shows"
+	// SavedEpisodesTable is the table that holds the saved_episodes relation/edge.
+	SavedEpisodesTable = "saved_episodes"
+	// SavedEpisodesInverseTable is the table name for the SavedEpisode entity.
+	// It exists in this package in order to avoid circular dependency with the "savedepisode" package.
+	SavedEpisodesInverseTable = "saved_episodes"
+	// SavedEpisodesColumn is the table column denoting the saved_episodes relation/edge.
+	SavedEpisodesColumn = "backup_run_saved_episodes"
+	// SavedAudiobooksTable is the table that holds the saved_audiobooks relation/edge.
+	SavedAudiobooksTable = "saved_audiobooks"
+	// SavedAudiobooksInverseTable is the table name for the SavedAudiobook entity.
+	// It exists in this package in order to avoid circular dependency with the "savedaudiobook" package.
+	SavedAudiobooksInverseTable = "saved_audiobooks"
+	// SavedAudiobooksColumn is the table column denoting the saved_audiobooks relation/edge.
+	SavedAudiobooksColumn = "backup_run_saved_audiobooks"
+	// FollowedArtistsTable is the table that holds the followed_artists relation/edge.
+	FollowedArtistsTable = "followed_artists"
+	// FollowedArtistsInverseTable is the table name for the FollowedArtist entity.
+	// It exists in this package in order to avoid circular dependency with the "followedartist" package.
+	FollowedArtistsInverseTable = "followed_artists"
+	// FollowedArtistsColumn is the table column denoting the followed_artists relation/edge.
+	FollowedArtistsColumn = "backup_run_followed_artists"
+)
+
+// Columns holds all SQL columns for backuprun fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldTrigger,
+	FieldJobID,
+	FieldStatus,
+	FieldCollections,
+	FieldAPICalls,
+	FieldError,
+	FieldCheckpoint,
+	FieldResumes,
+	FieldPinned,
+	FieldNote,
+	FieldLabels,
+	FieldStartedAt,
+	FieldFinishedAt,
+}
+
+var (
+	// PlaylistSnapshotsPrimaryKey and PlaylistSnapshotsColumn2 are the table columns denoting the
+	// primary key for the playlist_snapshots relation (M2M).
+	PlaylistSnapshotsPrimaryKey = []string{"backup_run_id", "playlist_snapshot_id"}
+)
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultUserID holds the default value on creation for the "user_id" field.
+	DefaultUserID string
+	// DefaultJobID holds the default value on creation for the "job_id" field.
+	DefaultJobID string
+	// DefaultCollections holds the default value on creation for the "collections" field.
+	DefaultCollections map[string]schematype.CollectionResult
+	// DefaultAPICalls holds the default value on creation for the "api_calls" field.
+	DefaultAPICalls int
+	// APICallsValidator is a validator for the "api_calls" field. It is called by the builders before save.
+	APICallsValidator func(int) error
+	// DefaultError holds the default value on creation for the "error" field.
+	DefaultError string
+	// DefaultResumes holds the default value on creation for the "resumes" field.
+	DefaultResumes int
+	// ResumesValidator is a validator for the "resumes" field. It is called by the builders before save.
+	ResumesValidator func(int) error
+	// DefaultPinned holds the default value on creation for the "pinned" field.
+	DefaultPinned bool
+	// DefaultNote holds the default value on creation for the "note" field.
+	DefaultNote string
+	// DefaultLabels holds the default value on creation for the "labels" field.
+	DefaultLabels []string
+	// DefaultStartedAt holds the default value on creation for the "started_at" field.
+	DefaultStartedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() string
+)
+
+// Trigger defines the type for the "trigger" enum field.
+type Trigger string
+
+// TriggerManual is the default value of the Trigger enum.
+const DefaultTrigger = TriggerManual
+
+// Trigger values.
+const (
+	TriggerSchedule Trigger = "schedule"
+	TriggerManual   Trigger = "manual"
+	TriggerAPI      Trigger = "api"
+)
+
+func (t Trigger) String() string {
+	return string(t)
+}
+
+// TriggerValidator is a validator for the "trigger" field enum values. It is called by the builders before save.
+func TriggerValidator(t Trigger) error {
+	switch t {
+	case TriggerSchedule, TriggerManual, TriggerAPI:
+		return nil
+	default:
+		return fmt.Errorf("backuprun: invalid enum value for trigger field: %q", t)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusRunning is the default value of the Status enum.
+const DefaultStatus = StatusRunning
+
+// Status values.
+const (
+	StatusRunning   Status = "running"
+	StatusSucceeded Status = "succeeded"
+	StatusPartial   Status = "partial"
+	StatusFailed    Status = "failed"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusRunning, StatusSucceeded, StatusPartial, StatusFailed:
+		return nil
+	default:
+		return fmt.Errorf("backuprun: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the BackupRun queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByTrigger orders the results by the trigger field.
+func ByTrigger(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrigger, opts...).ToFunc()
+}
+
+// ByJobID orders the results by the job_id field.
+func ByJobID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJobID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByAPICalls orders the results by the api_calls field.
+func ByAPICalls(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAPICalls, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
+
+// ByResumes orders the results by the resumes field.
+func ByResumes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResumes, opts...).ToFunc()
+}
+
+// ByPinned orders the results by the pinned field.
+func ByPinned(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPinned, opts...).ToFunc()
+}
+
+// ByNote orders the results by the note field.
+func ByNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNote, opts...).ToFunc()
+}
+
+// ByStartedAt orders the results by the started_at field.
+func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
+}
+
+// ByFinishedAt orders the results by the finished_at field.
+func ByFinishedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinishedAt, opts...).ToFunc()
+}
+
+// ByPlaylistSnapshotsCount orders the results by playlist_snapshots count.
+func ByPlaylistSnapshotsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPlaylistSnapshotsStep(), opts...)
+	}
+}
+
+// ByPlaylistSnapshots orders the results by playlist_snapshots terms.
+func ByPlaylistSnapshots(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPlaylistSnapshotsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySavedTracksCount orders the results by saved_tracks count.
+func BySavedTracksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSavedTracksStep(), opts...)
+	}
+}
+
+// BySavedTracks orders the results by saved_tracks terms.
+func BySavedTracks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSavedTracksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySavedAlbumsCount orders the results by saved_albums count.
+func BySavedAlbumsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSavedAlbumsStep(), opts...)
+	}
+}
+
+// BySavedAlbums orders the results by saved_albums terms.
+func BySavedAlbums(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSavedAlbumsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySavedShowsCount orders the results by saved_shows count.
+func BySavedShowsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSavedShowsStep(), opts...)
+	}
+}
+
+// BySavedShows orders the results by saved_shows terms.
+func BySavedShows(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSavedShowsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySavedEpisodesCount orders the results by saved_episodes count.
+func BySavedEpisodesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSavedEpisodesStep(), opts...)
+	}
+}
+
+// BySavedEpisodes orders the results by saved_episodes terms.
+func BySavedEpisodes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSavedEpisodesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySavedAudiobooksCount orders the results by saved_audiobooks count.
+func BySavedAudiobooksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSavedAudiobooksStep(), opts...)
+	}
+}
+
+// BySavedAudiobooks orders the results by saved_audiobooks terms.
+func BySavedAudiobooks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSavedAudiobooksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByFollowedArtistsCount orders the results by followed_artists count.
+func ByFollowedArtistsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newFollowedArtistsStep(), opts...)
+	}
+}
+
+// ByFollowedArtists orders the results by followed_artists terms.
+func ByFollowedArtists(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFollowedArtistsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newPlaylistSnapshotsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PlaylistSnapshotsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, PlaylistSnapshotsTable, PlaylistSnapshotsPrimaryKey...),
+	)
+}
+func newSavedTracksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SavedTracksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SavedTracksTable, SavedTracksColumn),
+	)
+}
+func newSavedAlbumsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SavedAlbumsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SavedAlbumsTable, SavedAlbumsColumn),
+	)
+}
+func newSavedShowsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SavedShowsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SavedShowsTable, SavedShowsColumn),
+	)
+}
+func newSavedEpisodesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SavedEpisodesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SavedEpisodesTable, SavedEpisodesColumn),
+	)
+}
+func newSavedAudiobooksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SavedAudiobooksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SavedAudiobooksTable, SavedAudiobooksColumn),
+	)
+}
+func newFollowedArtistsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FollowedArtistsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, FollowedArtistsTable, FollowedArtistsColumn),
+	)
+}
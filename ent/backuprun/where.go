@@ -0,0 +1,777 @@
+// Code generated by ent, DO NOT EDIT.
+
+package backuprun
+
+import (
+	"beyerleinf/spotify-backup/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldContainsFold(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldEQ(FieldUserID, v))
+}
+
+// JobID applies equality check predicate on the "job_id" field. It's identical to JobIDEQ.
+func JobID(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldEQ(FieldJobID, v))
+}
+
+// APICalls applies equality check predicate on the "api_calls" field. It's identical to APICallsEQ.
+func APICalls(v int) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldEQ(FieldAPICalls, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldEQ(FieldError, v))
+}
+
+// Resumes applies equality check predicate on the "resumes" field. It's identical to ResumesEQ.
+func Resumes(v int) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldEQ(FieldResumes, v))
+}
+
+// Pinned applies equality check predicate on the "pinned" field. It's identical to PinnedEQ.
+func Pinned(v bool) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldEQ(FieldPinned, v))
+}
+
+// Note applies equality check predicate on the "note" field. It's identical to NoteEQ.
+func Note(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldEQ(FieldNote, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// FinishedAt applies equality check predicate on the "finished_at" field. It's identical to FinishedAtEQ.
+func FinishedAt(v time.Time) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// TriggerEQ applies the EQ predicate on the "trigger" field.
+func TriggerEQ(v Trigger) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldEQ(FieldTrigger, v))
+}
+
+// TriggerNEQ applies the NEQ predicate on the "trigger" field.
+func TriggerNEQ(v Trigger) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldNEQ(FieldTrigger, v))
+}
+
+// TriggerIn applies the In predicate on the "trigger" field.
+func TriggerIn(vs ...Trigger) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldIn(FieldTrigger, vs...))
+}
+
+// TriggerNotIn applies the NotIn predicate on the "trigger" field.
+func TriggerNotIn(vs ...Trigger) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldNotIn(FieldTrigger, vs...))
+}
+
+// JobIDEQ applies the EQ predicate on the "job_id" field.
+func JobIDEQ(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldEQ(FieldJobID, v))
+}
+
+// JobIDNEQ applies the NEQ predicate on the "job_id" field.
+func JobIDNEQ(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldNEQ(FieldJobID, v))
+}
+
+// JobIDIn applies the In predicate on the "job_id" field.
+func JobIDIn(vs ...string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldIn(FieldJobID, vs...))
+}
+
+// JobIDNotIn applies the NotIn predicate on the "job_id" field.
+func JobIDNotIn(vs ...string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldNotIn(FieldJobID, vs...))
+}
+
+// JobIDGT applies the GT predicate on the "job_id" field.
+func JobIDGT(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldGT(FieldJobID, v))
+}
+
+// JobIDGTE applies the GTE predicate on the "job_id" field.
+func JobIDGTE(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldGTE(FieldJobID, v))
+}
+
+// JobIDLT applies the LT predicate on the "job_id" field.
+func JobIDLT(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldLT(FieldJobID, v))
+}
+
+// JobIDLTE applies the LTE predicate on the "job_id" field.
+func JobIDLTE(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldLTE(FieldJobID, v))
+}
+
+// JobIDContains applies the Contains predicate on the "job_id" field.
+func JobIDContains(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldContains(FieldJobID, v))
+}
+
+// JobIDHasPrefix applies the HasPrefix predicate on the "job_id" field.
+func JobIDHasPrefix(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldHasPrefix(FieldJobID, v))
+}
+
+// JobIDHasSuffix applies the HasSuffix predicate on the "job_id" field.
+func JobIDHasSuffix(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldHasSuffix(FieldJobID, v))
+}
+
+// JobIDEqualFold applies the EqualFold predicate on the "job_id" field.
+func JobIDEqualFold(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldEqualFold(FieldJobID, v))
+}
+
+// JobIDContainsFold applies the ContainsFold predicate on the "job_id" field.
+func JobIDContainsFold(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldContainsFold(FieldJobID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// APICallsEQ applies the EQ predicate on the "api_calls" field.
+func APICallsEQ(v int) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldEQ(FieldAPICalls, v))
+}
+
+// APICallsNEQ applies the NEQ predicate on the "api_calls" field.
+func APICallsNEQ(v int) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldNEQ(FieldAPICalls, v))
+}
+
+// APICallsIn applies the In predicate on the "api_calls" field.
+func APICallsIn(vs ...int) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldIn(FieldAPICalls, vs...))
+}
+
+// APICallsNotIn applies the NotIn predicate on the "api_calls" field.
+func APICallsNotIn(vs ...int) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldNotIn(FieldAPICalls, vs...))
+}
+
+// APICallsGT applies the GT predicate on the "api_calls" field.
+func APICallsGT(v int) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldGT(FieldAPICalls, v))
+}
+
+// APICallsGTE applies the GTE predicate on the "api_calls" field.
+func APICallsGTE(v int) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldGTE(FieldAPICalls, v))
+}
+
+// APICallsLT applies the LT predicate on the "api_calls" field.
+func APICallsLT(v int) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldLT(FieldAPICalls, v))
+}
+
+// APICallsLTE applies the LTE predicate on the "api_calls" field.
+func APICallsLTE(v int) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldLTE(FieldAPICalls, v))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldContainsFold(FieldError, v))
+}
+
+// CheckpointIsNil applies the IsNil predicate on the "checkpoint" field.
+func CheckpointIsNil() predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldIsNull(FieldCheckpoint))
+}
+
+// CheckpointNotNil applies the NotNil predicate on the "checkpoint" field.
+func CheckpointNotNil() predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldNotNull(FieldCheckpoint))
+}
+
+// ResumesEQ applies the EQ predicate on the "resumes" field.
+func ResumesEQ(v int) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldEQ(FieldResumes, v))
+}
+
+// ResumesNEQ applies the NEQ predicate on the "resumes" field.
+func ResumesNEQ(v int) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldNEQ(FieldResumes, v))
+}
+
+// ResumesIn applies the In predicate on the "resumes" field.
+func ResumesIn(vs ...int) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldIn(FieldResumes, vs...))
+}
+
+// ResumesNotIn applies the NotIn predicate on the "resumes" field.
+func ResumesNotIn(vs ...int) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldNotIn(FieldResumes, vs...))
+}
+
+// ResumesGT applies the GT predicate on the "resumes" field.
+func ResumesGT(v int) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldGT(FieldResumes, v))
+}
+
+// ResumesGTE applies the GTE predicate on the "resumes" field.
+func ResumesGTE(v int) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldGTE(FieldResumes, v))
+}
+
+// ResumesLT applies the LT predicate on the "resumes" field.
+func ResumesLT(v int) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldLT(FieldResumes, v))
+}
+
+// ResumesLTE applies the LTE predicate on the "resumes" field.
+func ResumesLTE(v int) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldLTE(FieldResumes, v))
+}
+
+// PinnedEQ applies the EQ predicate on the "pinned" field.
+func PinnedEQ(v bool) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldEQ(FieldPinned, v))
+}
+
+// PinnedNEQ applies the NEQ predicate on the "pinned" field.
+func PinnedNEQ(v bool) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldNEQ(FieldPinned, v))
+}
+
+// NoteEQ applies the EQ predicate on the "note" field.
+func NoteEQ(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldEQ(FieldNote, v))
+}
+
+// NoteNEQ applies the NEQ predicate on the "note" field.
+func NoteNEQ(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldNEQ(FieldNote, v))
+}
+
+// NoteIn applies the In predicate on the "note" field.
+func NoteIn(vs ...string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldIn(FieldNote, vs...))
+}
+
+// NoteNotIn applies the NotIn predicate on the "note" field.
+func NoteNotIn(vs ...string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldNotIn(FieldNote, vs...))
+}
+
+// NoteGT applies the GT predicate on the "note" field.
+func NoteGT(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldGT(FieldNote, v))
+}
+
+// NoteGTE applies the GTE predicate on the "note" field.
+func NoteGTE(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldGTE(FieldNote, v))
+}
+
+// NoteLT applies the LT predicate on the "note" field.
+func NoteLT(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldLT(FieldNote, v))
+}
+
+// NoteLTE applies the LTE predicate on the "note" field.
+func NoteLTE(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldLTE(FieldNote, v))
+}
+
+// NoteContains applies the Contains predicate on the "note" field.
+func NoteContains(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldContains(FieldNote, v))
+}
+
+// NoteHasPrefix applies the HasPrefix predicate on the "note" field.
+func NoteHasPrefix(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldHasPrefix(FieldNote, v))
+}
+
+// NoteHasSuffix applies the HasSuffix predicate on the "note" field.
+func NoteHasSuffix(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldHasSuffix(FieldNote, v))
+}
+
+// NoteEqualFold applies the EqualFold predicate on the "note" field.
+func NoteEqualFold(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldEqualFold(FieldNote, v))
+}
+
+// NoteContainsFold applies the ContainsFold predicate on the "note" field.
+func NoteContainsFold(v string) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldContainsFold(FieldNote, v))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// FinishedAtEQ applies the EQ predicate on the "finished_at" field.
+func FinishedAtEQ(v time.Time) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtNEQ applies the NEQ predicate on the "finished_at" field.
+func FinishedAtNEQ(v time.Time) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldNEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtIn applies the In predicate on the "finished_at" field.
+func FinishedAtIn(vs ...time.Time) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtNotIn applies the NotIn predicate on the "finished_at" field.
+func FinishedAtNotIn(vs ...time.Time) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldNotIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtGT applies the GT predicate on the "finished_at" field.
+func FinishedAtGT(v time.Time) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldGT(FieldFinishedAt, v))
+}
+
+// FinishedAtGTE applies the GTE predicate on the "finished_at" field.
+func FinishedAtGTE(v time.Time) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldGTE(FieldFinishedAt, v))
+}
+
+// FinishedAtLT applies the LT predicate on the "finished_at" field.
+func FinishedAtLT(v time.Time) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldLT(FieldFinishedAt, v))
+}
+
+// FinishedAtLTE applies the LTE predicate on the "finished_at" field.
+func FinishedAtLTE(v time.Time) predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldLTE(FieldFinishedAt, v))
+}
+
+// FinishedAtIsNil applies the IsNil predicate on the "finished_at" field.
+func FinishedAtIsNil() predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldIsNull(FieldFinishedAt))
+}
+
+// FinishedAtNotNil applies the NotNil predicate on the "finished_at" field.
+func FinishedAtNotNil() predicate.BackupRun {
+	return predicate.BackupRun(sql.FieldNotNull(FieldFinishedAt))
+}
+
+// HasPlaylistSnapshots applies the HasEdge predicate on the "playlist_snapshots" edge.
+func HasPlaylistSnapshots() predicate.BackupRun {
+	return predicate.BackupRun(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, PlaylistSnapshotsTable, PlaylistSnapshotsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPlaylistSnapshotsWith applies the HasEdge predicate on the "playlist_snapshots" edge with a given conditions (other predicates).
+func HasPlaylistSnapshotsWith(preds ...predicate.PlaylistSnapshot) predicate.BackupRun {
+	return predicate.BackupRun(func(s *sql.Selector) {
+		step := newPlaylistSnapshotsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSavedTracks applies the HasEdge predicate on the "saved_tracks" edge.
+func HasSavedTracks() predicate.BackupRun {
+	return predicate.BackupRun(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SavedTracksTable, SavedTracksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSavedTracksWith applies the HasEdge predicate on the "saved_tracks" edge with a given conditions (other predicates).
+func HasSavedTracksWith(preds ...predicate.SavedTrack) predicate.BackupRun {
+	return predicate.BackupRun(func(s *sql.Selector) {
+		step := newSavedTracksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSavedAlbums applies the HasEdge predicate on the "saved_albums" edge.
+func HasSavedAlbums() predicate.BackupRun {
+	return predicate.BackupRun(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SavedAlbumsTable, SavedAlbumsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSavedAlbumsWith applies the HasEdge predicate on the "saved_albums" edge with a given conditions (other predicates).
+func HasSavedAlbumsWith(preds ...predicate.SavedAlbum) predicate.BackupRun {
+	return predicate.BackupRun(func(s *sql.Selector) {
+		step := newSavedAlbumsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSavedShows applies the HasEdge predicate on the "saved_shows" edge.
+func HasSavedShows() predicate.BackupRun {
+	return predicate.BackupRun(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SavedShowsTable, SavedShowsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSavedShowsWith applies the HasEdge predicate on the "saved_shows" edge with a given conditions (other predicates).
+func HasSavedShowsWith(preds ...predicate.SavedShow) predicate.BackupRun {
+	return predicate.BackupRun(func(s *sql.Selector) {
+		step := newSavedShowsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSavedEpisodes applies the HasEdge predicate on the "saved_episodes" edge.
+func HasSavedEpisodes() predicate.BackupRun {
+	return predicate.BackupRun(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SavedEpisodesTable, SavedEpisodesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSavedEpisodesWith applies the HasEdge predicate on the "saved_episodes" edge with a given conditions (other predicates).
+func HasSavedEpisodesWith(preds ...predicate.SavedEpisode) predicate.BackupRun {
+	return predicate.BackupRun(func(s *sql.Selector) {
+		step := newSavedEpisodesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSavedAudiobooks applies the HasEdge predicate on the "saved_audiobooks" edge.
+func HasSavedAudiobooks() predicate.BackupRun {
+	return predicate.BackupRun(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SavedAudiobooksTable, SavedAudiobooksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSavedAudiobooksWith applies the HasEdge predicate on the "saved_audiobooks" edge with a given conditions (other predicates).
+func HasSavedAudiobooksWith(preds ...predicate.SavedAudiobook) predicate.BackupRun {
+	return predicate.BackupRun(func(s *sql.Selector) {
+		step := newSavedAudiobooksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasFollowedArtists applies the HasEdge predicate on the "followed_artists" edge.
+func HasFollowedArtists() predicate.BackupRun {
+	return predicate.BackupRun(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, FollowedArtistsTable, FollowedArtistsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFollowedArtistsWith applies the HasEdge predicate on the "followed_artists" edge with a given conditions (other predicates).
+func HasFollowedArtistsWith(preds ...predicate.FollowedArtist) predicate.BackupRun {
+	return predicate.BackupRun(func(s *sql.Selector) {
+		step := newFollowedArtistsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BackupRun) predicate.BackupRun {
+	return predicate.BackupRun(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BackupRun) predicate.BackupRun {
+	return predicate.BackupRun(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BackupRun) predicate.BackupRun {
+	return predicate.BackupRun(sql.NotPredicates(p))
+}
@@ -0,0 +1,765 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/followedartist"
+	"beyerleinf/spotify-backup/ent/playlistsnapshot"
+	"beyerleinf/spotify-backup/ent/savedalbum"
+	"beyerleinf/spotify-backup/ent/savedaudiobook"
+	"beyerleinf/spotify-backup/ent/savedepisode"
+	"beyerleinf/spotify-backup/ent/savedshow"
+	"beyerleinf/spotify-backup/ent/savedtrack"
+	"beyerleinf/spotify-backup/ent/schema/schematype"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BackupRunCreate is the builder for creating a BackupRun entity.
+type BackupRunCreate struct {
+	config
+	mutation *BackupRunMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (brc *BackupRunCreate) SetUserID(s string) *BackupRunCreate {
+	brc.mutation.SetUserID(s)
+	return brc
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (brc *BackupRunCreate) SetNillableUserID(s *string) *BackupRunCreate {
+	if s != nil {
+		brc.SetUserID(*s)
+	}
+	return brc
+}
+
+// SetTrigger sets the "trigger" field.
+func (brc *BackupRunCreate) SetTrigger(b backuprun.Trigger) *BackupRunCreate {
+	brc.mutation.SetTrigger(b)
+	return brc
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (brc *BackupRunCreate) SetNillableTrigger(b *backuprun.Trigger) *BackupRunCreate {
+	if b != nil {
+		brc.SetTrigger(*b)
+	}
+	return brc
+}
+
+// SetJobID sets the "job_id" field.
+func (brc *BackupRunCreate) SetJobID(s string) *BackupRunCreate {
+	brc.mutation.SetJobID(s)
+	return brc
+}
+
+// SetNillableJobID sets the "job_id" field if the given value is not nil.
+func (brc *BackupRunCreate) SetNillableJobID(s *string) *BackupRunCreate {
+	if s != nil {
+		brc.SetJobID(*s)
+	}
+	return brc
+}
+
+// SetStatus sets the "status" field.
+func (brc *BackupRunCreate) SetStatus(b backuprun.Status) *BackupRunCreate {
+	brc.mutation.SetStatus(b)
+	return brc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (brc *BackupRunCreate) SetNillableStatus(b *backuprun.Status) *BackupRunCreate {
+	if b != nil {
+		brc.SetStatus(*b)
+	}
+	return brc
+}
+
+// SetCollections sets the "collections" field.
+func (brc *BackupRunCreate) SetCollections(mr map[string]schematype.CollectionResult) *BackupRunCreate {
+	brc.mutation.SetCollections(mr)
+	return brc
+}
+
+// SetAPICalls sets the "api_calls" field.
+func (brc *BackupRunCreate) SetAPICalls(i int) *BackupRunCreate {
+	brc.mutation.SetAPICalls(i)
+	return brc
+}
+
+// SetNillableAPICalls sets the "api_calls" field if the given value is not nil.
+func (brc *BackupRunCreate) SetNillableAPICalls(i *int) *BackupRunCreate {
+	if i != nil {
+		brc.SetAPICalls(*i)
+	}
+	return brc
+}
+
+// SetError sets the "error" field.
+func (brc *BackupRunCreate) SetError(s string) *BackupRunCreate {
+	brc.mutation.SetError(s)
+	return brc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (brc *BackupRunCreate) SetNillableError(s *string) *BackupRunCreate {
+	if s != nil {
+		brc.SetError(*s)
+	}
+	return brc
+}
+
+// SetCheckpoint sets the "checkpoint" field.
+func (brc *BackupRunCreate) SetCheckpoint(s schematype.Checkpoint) *BackupRunCreate {
+	brc.mutation.SetCheckpoint(s)
+	return brc
+}
+
+// SetNillableCheckpoint sets the "checkpoint" field if the given value is not nil.
+func (brc *BackupRunCreate) SetNillableCheckpoint(s *schematype.Checkpoint) *BackupRunCreate {
+	if s != nil {
+		brc.SetCheckpoint(*s)
+	}
+	return brc
+}
+
+// SetResumes sets the "resumes" field.
+func (brc *BackupRunCreate) SetResumes(i int) *BackupRunCreate {
+	brc.mutation.SetResumes(i)
+	return brc
+}
+
+// SetNillableResumes sets the "resumes" field if the given value is not nil.
+func (brc *BackupRunCreate) SetNillableResumes(i *int) *BackupRunCreate {
+	if i != nil {
+		brc.SetResumes(*i)
+	}
+	return brc
+}
+
+// SetPinned sets the "pinned" field.
+func (brc *BackupRunCreate) SetPinned(b bool) *BackupRunCreate {
+	brc.mutation.SetPinned(b)
+	return brc
+}
+
+// SetNillablePinned sets the "pinned" field if the given value is not nil.
+func (brc *BackupRunCreate) SetNillablePinned(b *bool) *BackupRunCreate {
+	if b != nil {
+		brc.SetPinned(*b)
+	}
+	return brc
+}
+
+// SetNote sets the "note" field.
+func (brc *BackupRunCreate) SetNote(s string) *BackupRunCreate {
+	brc.mutation.SetNote(s)
+	return brc
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (brc *BackupRunCreate) SetNillableNote(s *string) *BackupRunCreate {
+	if s != nil {
+		brc.SetNote(*s)
+	}
+	return brc
+}
+
+// SetLabels sets the "labels" field.
+func (brc *BackupRunCreate) SetLabels(s []string) *BackupRunCreate {
+	brc.mutation.SetLabels(s)
+	return brc
+}
+
+// SetStartedAt sets the "started_at" field.
+func (brc *BackupRunCreate) SetStartedAt(t time.Time) *BackupRunCreate {
+	brc.mutation.SetStartedAt(t)
+	return brc
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (brc *BackupRunCreate) SetNillableStartedAt(t *time.Time) *BackupRunCreate {
+	if t != nil {
+		brc.SetStartedAt(*t)
+	}
+	return brc
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (brc *BackupRunCreate) SetFinishedAt(t time.Time) *BackupRunCreate {
+	brc.mutation.SetFinishedAt(t)
+	return brc
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (brc *BackupRunCreate) SetNillableFinishedAt(t *time.Time) *BackupRunCreate {
+	if t != nil {
+		brc.SetFinishedAt(*t)
+	}
+	return brc
+}
+
+// SetID sets the "id" field.
+func (brc *BackupRunCreate) SetID(s string) *BackupRunCreate {
+	brc.mutation.SetID(s)
+	return brc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (brc *BackupRunCreate) SetNillableID(s *string) *BackupRunCreate {
+	if s != nil {
+		brc.SetID(*s)
+	}
+	return brc
+}
+
+// AddPlaylistSnapshotIDs adds the "playlist_snapshots" edge to the PlaylistSnapshot entity by IDs.
+func (brc *BackupRunCreate) AddPlaylistSnapshotIDs(ids ...string) *BackupRunCreate {
+	brc.mutation.AddPlaylistSnapshotIDs(ids...)
+	return brc
+}
+
+// AddPlaylistSnapshots adds the "playlist_snapshots" edges to the PlaylistSnapshot entity.
+func (brc *BackupRunCreate) AddPlaylistSnapshots(p ...*PlaylistSnapshot) *BackupRunCreate {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return brc.AddPlaylistSnapshotIDs(ids...)
+}
+
+// AddSavedTrackIDs adds the "saved_tracks" edge to the SavedTrack entity by IDs.
+func (brc *BackupRunCreate) AddSavedTrackIDs(ids ...string) *BackupRunCreate {
+	brc.mutation.AddSavedTrackIDs(ids...)
+	return brc
+}
+
+// AddSavedTracks adds the "saved_tracks" edges to the SavedTrack entity.
+func (brc *BackupRunCreate) AddSavedTracks(s ...*SavedTrack) *BackupRunCreate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return brc.AddSavedTrackIDs(ids...)
+}
+
+// AddSavedAlbumIDs adds the "saved_albums" edge to the SavedAlbum entity by IDs.
+func (brc *BackupRunCreate) AddSavedAlbumIDs(ids ...string) *BackupRunCreate {
+	brc.mutation.AddSavedAlbumIDs(ids...)
+	return brc
+}
+
+// AddSavedAlbums adds the "saved_albums" edges to the SavedAlbum entity.
+func (brc *BackupRunCreate) AddSavedAlbums(s ...*SavedAlbum) *BackupRunCreate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return brc.AddSavedAlbumIDs(ids...)
+}
+
+// AddSavedShowIDs adds the "saved_shows" edge to the SavedShow entity by IDs.
+func (brc *BackupRunCreate) AddSavedShowIDs(ids ...string) *BackupRunCreate {
+	brc.mutation.AddSavedShowIDs(ids...)
+	return brc
+}
+
+// AddSavedShows adds the "saved_shows" edges to the SavedShow entity.
+func (brc *BackupRunCreate) AddSavedShows(s ...*SavedShow) *BackupRunCreate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return brc.AddSavedShowIDs(ids...)
+}
+
+// AddSavedEpisodeIDs adds the "saved_episodes" edge to the SavedEpisode entity by IDs.
+func (brc *BackupRunCreate) AddSavedEpisodeIDs(ids ...string) *BackupRunCreate {
+	brc.mutation.AddSavedEpisodeIDs(ids...)
+	return brc
+}
+
+// AddSavedEpisodes adds the "saved_episodes" edges to the SavedEpisode entity.
+func (brc *BackupRunCreate) AddSavedEpisodes(s ...*SavedEpisode) *BackupRunCreate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return brc.AddSavedEpisodeIDs(ids...)
+}
+
+// AddSavedAudiobookIDs adds the "saved_audiobooks" edge to the SavedAudiobook entity by IDs.
+func (brc *BackupRunCreate) AddSavedAudiobookIDs(ids ...string) *BackupRunCreate {
+	brc.mutation.AddSavedAudiobookIDs(ids...)
+	return brc
+}
+
+// AddSavedAudiobooks adds the "saved_audiobooks" edges to the SavedAudiobook entity.
+func (brc *BackupRunCreate) AddSavedAudiobooks(s ...*SavedAudiobook) *BackupRunCreate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return brc.AddSavedAudiobookIDs(ids...)
+}
+
+// AddFollowedArtistIDs adds the "followed_artists" edge to the FollowedArtist entity by IDs.
+func (brc *BackupRunCreate) AddFollowedArtistIDs(ids ...string) *BackupRunCreate {
+	brc.mutation.AddFollowedArtistIDs(ids...)
+	return brc
+}
+
+// AddFollowedArtists adds the "followed_artists" edges to the FollowedArtist entity.
+func (brc *BackupRunCreate) AddFollowedArtists(f ...*FollowedArtist) *BackupRunCreate {
+	ids := make([]string, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return brc.AddFollowedArtistIDs(ids...)
+}
+
+// Mutation returns the BackupRunMutation object of the builder.
+func (brc *BackupRunCreate) Mutation() *BackupRunMutation {
+	return brc.mutation
+}
+
+// Save creates the BackupRun in the database.
+func (brc *BackupRunCreate) Save(ctx context.Context) (*BackupRun, error) {
+	brc.defaults()
+	return withHooks(ctx, brc.sqlSave, brc.mutation, brc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (brc *BackupRunCreate) SaveX(ctx context.Context) *BackupRun {
+	v, err := brc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (brc *BackupRunCreate) Exec(ctx context.Context) error {
+	_, err := brc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (brc *BackupRunCreate) ExecX(ctx context.Context) {
+	if err := brc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (brc *BackupRunCreate) defaults() {
+	if _, ok := brc.mutation.UserID(); !ok {
+		v := backuprun.DefaultUserID
+		brc.mutation.SetUserID(v)
+	}
+	if _, ok := brc.mutation.Trigger(); !ok {
+		v := backuprun.DefaultTrigger
+		brc.mutation.SetTrigger(v)
+	}
+	if _, ok := brc.mutation.JobID(); !ok {
+		v := backuprun.DefaultJobID
+		brc.mutation.SetJobID(v)
+	}
+	if _, ok := brc.mutation.Status(); !ok {
+		v := backuprun.DefaultStatus
+		brc.mutation.SetStatus(v)
+	}
+	if _, ok := brc.mutation.Collections(); !ok {
+		v := backuprun.DefaultCollections
+		brc.mutation.SetCollections(v)
+	}
+	if _, ok := brc.mutation.APICalls(); !ok {
+		v := backuprun.DefaultAPICalls
+		brc.mutation.SetAPICalls(v)
+	}
+	if _, ok := brc.mutation.Error(); !ok {
+		v := backuprun.DefaultError
+		brc.mutation.SetError(v)
+	}
+	if _, ok := brc.mutation.Resumes(); !ok {
+		v := backuprun.DefaultResumes
+		brc.mutation.SetResumes(v)
+	}
+	if _, ok := brc.mutation.Pinned(); !ok {
+		v := backuprun.DefaultPinned
+		brc.mutation.SetPinned(v)
+	}
+	if _, ok := brc.mutation.Note(); !ok {
+		v := backuprun.DefaultNote
+		brc.mutation.SetNote(v)
+	}
+	if _, ok := brc.mutation.Labels(); !ok {
+		v := backuprun.DefaultLabels
+		brc.mutation.SetLabels(v)
+	}
+	if _, ok := brc.mutation.StartedAt(); !ok {
+		v := backuprun.DefaultStartedAt()
+		brc.mutation.SetStartedAt(v)
+	}
+	if _, ok := brc.mutation.ID(); !ok {
+		v := backuprun.DefaultID()
+		brc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (brc *BackupRunCreate) check() error {
+	if _, ok := brc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "BackupRun.user_id"`)}
+	}
+	if _, ok := brc.mutation.Trigger(); !ok {
+		return &ValidationError{Name: "trigger", err: errors.New(`ent: missing required field "BackupRun.trigger"`)}
+	}
+	if v, ok := brc.mutation.Trigger(); ok {
+		if err := backuprun.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "BackupRun.trigger": %w`, err)}
+		}
+	}
+	if _, ok := brc.mutation.JobID(); !ok {
+		return &ValidationError{Name: "job_id", err: errors.New(`ent: missing required field "BackupRun.job_id"`)}
+	}
+	if _, ok := brc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "BackupRun.status"`)}
+	}
+	if v, ok := brc.mutation.Status(); ok {
+		if err := backuprun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "BackupRun.status": %w`, err)}
+		}
+	}
+	if _, ok := brc.mutation.Collections(); !ok {
+		return &ValidationError{Name: "collections", err: errors.New(`ent: missing required field "BackupRun.collections"`)}
+	}
+	if _, ok := brc.mutation.APICalls(); !ok {
+		return &ValidationError{Name: "api_calls", err: errors.New(`ent: missing required field "BackupRun.api_calls"`)}
+	}
+	if v, ok := brc.mutation.APICalls(); ok {
+		if err := backuprun.APICallsValidator(v); err != nil {
+			return &ValidationError{Name: "api_calls", err: fmt.Errorf(`ent: validator failed for field "BackupRun.api_calls": %w`, err)}
+		}
+	}
+	if _, ok := brc.mutation.Error(); !ok {
+		return &ValidationError{Name: "error", err: errors.New(`ent: missing required field "BackupRun.error"`)}
+	}
+	if _, ok := brc.mutation.Resumes(); !ok {
+		return &ValidationError{Name: "resumes", err: errors.New(`ent: missing required field "BackupRun.resumes"`)}
+	}
+	if v, ok := brc.mutation.Resumes(); ok {
+		if err := backuprun.ResumesValidator(v); err != nil {
+			return &ValidationError{Name: "resumes", err: fmt.Errorf(`ent: validator failed for field "BackupRun.resumes": %w`, err)}
+		}
+	}
+	if _, ok := brc.mutation.Pinned(); !ok {
+		return &ValidationError{Name: "pinned", err: errors.New(`ent: missing required field "BackupRun.pinned"`)}
+	}
+	if _, ok := brc.mutation.Note(); !ok {
+		return &ValidationError{Name: "note", err: errors.New(`ent: missing required field "BackupRun.note"`)}
+	}
+	if _, ok := brc.mutation.Labels(); !ok {
+		return &ValidationError{Name: "labels", err: errors.New(`ent: missing required field "BackupRun.labels"`)}
+	}
+	if _, ok := brc.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "BackupRun.started_at"`)}
+	}
+	return nil
+}
+
+func (brc *BackupRunCreate) sqlSave(ctx context.Context) (*BackupRun, error) {
+	if err := brc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := brc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, brc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected BackupRun.ID type: %T", _spec.ID.Value)
+		}
+	}
+	brc.mutation.id = &_node.ID
+	brc.mutation.done = true
+	return _node, nil
+}
+
+func (brc *BackupRunCreate) createSpec() (*BackupRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BackupRun{config: brc.config}
+		_spec = sqlgraph.NewCreateSpec(backuprun.Table, sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeString))
+	)
+	if id, ok := brc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := brc.mutation.UserID(); ok {
+		_spec.SetField(backuprun.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := brc.mutation.Trigger(); ok {
+		_spec.SetField(backuprun.FieldTrigger, field.TypeEnum, value)
+		_node.Trigger = value
+	}
+	if value, ok := brc.mutation.JobID(); ok {
+		_spec.SetField(backuprun.FieldJobID, field.TypeString, value)
+		_node.JobID = value
+	}
+	if value, ok := brc.mutation.Status(); ok {
+		_spec.SetField(backuprun.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := brc.mutation.Collections(); ok {
+		_spec.SetField(backuprun.FieldCollections, field.TypeJSON, value)
+		_node.Collections = value
+	}
+	if value, ok := brc.mutation.APICalls(); ok {
+		_spec.SetField(backuprun.FieldAPICalls, field.TypeInt, value)
+		_node.APICalls = value
+	}
+	if value, ok := brc.mutation.Error(); ok {
+		_spec.SetField(backuprun.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := brc.mutation.Checkpoint(); ok {
+		_spec.SetField(backuprun.FieldCheckpoint, field.TypeJSON, value)
+		_node.Checkpoint = value
+	}
+	if value, ok := brc.mutation.Resumes(); ok {
+		_spec.SetField(backuprun.FieldResumes, field.TypeInt, value)
+		_node.Resumes = value
+	}
+	if value, ok := brc.mutation.Pinned(); ok {
+		_spec.SetField(backuprun.FieldPinned, field.TypeBool, value)
+		_node.Pinned = value
+	}
+	if value, ok := brc.mutation.Note(); ok {
+		_spec.SetField(backuprun.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := brc.mutation.Labels(); ok {
+		_spec.SetField(backuprun.FieldLabels, field.TypeJSON, value)
+		_node.Labels = value
+	}
+	if value, ok := brc.mutation.StartedAt(); ok {
+		_spec.SetField(backuprun.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := brc.mutation.FinishedAt(); ok {
+		_spec.SetField(backuprun.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = &value
+	}
+	if nodes := brc.mutation.PlaylistSnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   backuprun.PlaylistSnapshotsTable,
+			Columns: backuprun.PlaylistSnapshotsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlistsnapshot.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := brc.mutation.SavedTracksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuprun.SavedTracksTable,
+			Columns: []string{backuprun.SavedTracksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedtrack.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := brc.mutation.SavedAlbumsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuprun.SavedAlbumsTable,
+			Columns: []string{backuprun.SavedAlbumsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedalbum.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := brc.mutation.SavedShowsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuprun.SavedShowsTable,
+			Columns: []string{backuprun.SavedShowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedshow.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := brc.mutation.SavedEpisodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuprun.SavedEpisodesTable,
+			Columns: []string{backuprun.SavedEpisodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedepisode.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := brc.mutation.SavedAudiobooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuprun.SavedAudiobooksTable,
+			Columns: []string{backuprun.SavedAudiobooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedaudiobook.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := brc.mutation.FollowedArtistsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuprun.FollowedArtistsTable,
+			Columns: []string{backuprun.FollowedArtistsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(followedartist.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// BackupRunCreateBulk is the builder for creating many BackupRun entities in bulk.
+type BackupRunCreateBulk struct {
+	config
+	err      error
+	builders []*BackupRunCreate
+}
+
+// Save creates the BackupRun entities in the database.
+func (brcb *BackupRunCreateBulk) Save(ctx context.Context) ([]*BackupRun, error) {
+	if brcb.err != nil {
+		return nil, brcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(brcb.builders))
+	nodes := make([]*BackupRun, len(brcb.builders))
+	mutators := make([]Mutator, len(brcb.builders))
+	for i := range brcb.builders {
+		func(i int, root context.Context) {
+			builder := brcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BackupRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, brcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, brcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, brcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (brcb *BackupRunCreateBulk) SaveX(ctx context.Context) []*BackupRun {
+	v, err := brcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (brcb *BackupRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := brcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (brcb *BackupRunCreateBulk) ExecX(ctx context.Context) {
+	if err := brcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/predicate"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BackupRunDelete is the builder for deleting a BackupRun entity.
+type BackupRunDelete struct {
+	config
+	hooks    []Hook
+	mutation *BackupRunMutation
+}
+
+// Where appends a list predicates to the BackupRunDelete builder.
+func (brd *BackupRunDelete) Where(ps ...predicate.BackupRun) *BackupRunDelete {
+	brd.mutation.Where(ps...)
+	return brd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (brd *BackupRunDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, brd.sqlExec, brd.mutation, brd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (brd *BackupRunDelete) ExecX(ctx context.Context) int {
+	n, err := brd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (brd *BackupRunDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(backuprun.Table, sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeString))
+	if ps := brd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, brd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	brd.mutation.done = true
+	return affected, err
+}
+
+// BackupRunDeleteOne is the builder for deleting a single BackupRun entity.
+type BackupRunDeleteOne struct {
+	brd *BackupRunDelete
+}
+
+// Where appends a list predicates to the BackupRunDelete builder.
+func (brdo *BackupRunDeleteOne) Where(ps ...predicate.BackupRun) *BackupRunDeleteOne {
+	brdo.brd.mutation.Where(ps...)
+	return brdo
+}
+
+// Exec executes the deletion query.
+func (brdo *BackupRunDeleteOne) Exec(ctx context.Context) error {
+	n, err := brdo.brd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{backuprun.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (brdo *BackupRunDeleteOne) ExecX(ctx context.Context) {
+	if err := brdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1126 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/followedartist"
+	"beyerleinf/spotify-backup/ent/playlistsnapshot"
+	"beyerleinf/spotify-backup/ent/predicate"
+	"beyerleinf/spotify-backup/ent/savedalbum"
+	"beyerleinf/spotify-backup/ent/savedaudiobook"
+	"beyerleinf/spotify-backup/ent/savedepisode"
+	"beyerleinf/spotify-backup/ent/savedshow"
+	"beyerleinf/spotify-backup/ent/savedtrack"
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BackupRunQuery is the builder for querying BackupRun entities.
+type BackupRunQuery struct {
+	config
+	ctx                   *QueryContext
+	order                 []backuprun.OrderOption
+	inters                []Interceptor
+	predicates            []predicate.BackupRun
+	withPlaylistSnapshots *PlaylistSnapshotQuery
+	withSavedTracks       *SavedTrackQuery
+	withSavedAlbums       *SavedAlbumQuery
+	withSavedShows        *SavedShowQuery
+	withSavedEpisodes     *SavedEpisodeQuery
+	withSavedAudiobooks   *SavedAudiobookQuery
+	withFollowedArtists   *FollowedArtistQuery
+	modifiers             []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the BackupRunQuery builder.
+func (brq *BackupRunQuery) Where(ps ...predicate.BackupRun) *BackupRunQuery {
+	brq.predicates = append(brq.predicates, ps...)
+	return brq
+}
+
+// Limit the number of records to be returned by this query.
+func (brq *BackupRunQuery) Limit(limit int) *BackupRunQuery {
+	brq.ctx.Limit = &limit
+	return brq
+}
+
+// Offset to start from.
+func (brq *BackupRunQuery) Offset(offset int) *BackupRunQuery {
+	brq.ctx.Offset = &offset
+	return brq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (brq *BackupRunQuery) Unique(unique bool) *BackupRunQuery {
+	brq.ctx.Unique = &unique
+	return brq
+}
+
+// Order specifies how the records should be ordered.
+func (brq *BackupRunQuery) Order(o ...backuprun.OrderOption) *BackupRunQuery {
+	brq.order = append(brq.order, o...)
+	return brq
+}
+
+// QueryPlaylistSnapshots chains the current query on the "playlist_snapshots" edge.
+func (brq *BackupRunQuery) QueryPlaylistSnapshots() *PlaylistSnapshotQuery {
+	query := (&PlaylistSnapshotClient{config: brq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := brq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := brq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backuprun.Table, backuprun.FieldID, selector),
+			sqlgraph.To(playlistsnapshot.Table, playlistsnapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, backuprun.PlaylistSnapshotsTable, backuprun.PlaylistSnapshotsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(brq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySavedTracks chains the current query on the "saved_tracks" edge.
+func (brq *BackupRunQuery) QuerySavedTracks() *SavedTrackQuery {
+	query := (&SavedTrackClient{config: brq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := brq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := brq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backuprun.Table, backuprun.FieldID, selector),
+			sqlgraph.To(savedtrack.Table, savedtrack.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, backuprun.SavedTracksTable, backuprun.SavedTracksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(brq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySavedAlbums chains the current query on the "saved_albums" edge.
+func (brq *BackupRunQuery) QuerySavedAlbums() *SavedAlbumQuery {
+	query := (&SavedAlbumClient{config: brq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := brq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := brq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backuprun.Table, backuprun.FieldID, selector),
+			sqlgraph.To(savedalbum.Table, savedalbum.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, backuprun.SavedAlbumsTable, backuprun.SavedAlbumsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(brq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySavedShows chains the current query on the "saved_shows" edge.
+func (brq *BackupRunQuery) QuerySavedShows() *SavedShowQuery {
+	query := (&SavedShowClient{config: brq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := brq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := brq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backuprun.Table, backuprun.FieldID, selector),
+			sqlgraph.To(savedshow.Table, savedshow.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, backuprun.SavedShowsTable, backuprun.SavedShowsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(brq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySavedEpisodes chains the current query on the "saved_episodes" edge.
+func (brq *BackupRunQuery) QuerySavedEpisodes() *SavedEpisodeQuery {
+	query := (&SavedEpisodeClient{config: brq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := brq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := brq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backuprun.Table, backuprun.FieldID, selector),
+			sqlgraph.To(savedepisode.Table, savedepisode.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, backuprun.SavedEpisodesTable, backuprun.SavedEpisodesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(brq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySavedAudiobooks chains the current query on the "saved_audiobooks" edge.
+func (brq *BackupRunQuery) QuerySavedAudiobooks() *SavedAudiobookQuery {
+	query := (&SavedAudiobookClient{config: brq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := brq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := brq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backuprun.Table, backuprun.FieldID, selector),
+			sqlgraph.To(savedaudiobook.Table, savedaudiobook.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, backuprun.SavedAudiobooksTable, backuprun.SavedAudiobooksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(brq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryFollowedArtists chains the current query on the "followed_artists" edge.
+func (brq *BackupRunQuery) QueryFollowedArtists() *FollowedArtistQuery {
+	query := (&FollowedArtistClient{config: brq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := brq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := brq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backuprun.Table, backuprun.FieldID, selector),
+			sqlgraph.To(followedartist.Table, followedartist.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, backuprun.FollowedArtistsTable, backuprun.FollowedArtistsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(brq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first BackupRun entity from the query.
+// Returns a *NotFoundError when no BackupRun was found.
+func (brq *BackupRunQuery) First(ctx context.Context) (*BackupRun, error) {
+	nodes, err := brq.Limit(1).All(setContextOp(ctx, brq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{backuprun.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (brq *BackupRunQuery) FirstX(ctx context.Context) *BackupRun {
+	node, err := brq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first BackupRun ID from the query.
+// Returns a *NotFoundError when no BackupRun ID was found.
+func (brq *BackupRunQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = brq.Limit(1).IDs(setContextOp(ctx, brq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{backuprun.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (brq *BackupRunQuery) FirstIDX(ctx context.Context) string {
+	id, err := brq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single BackupRun entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one BackupRun entity is found.
+// Returns a *NotFoundError when no BackupRun entities are found.
+func (brq *BackupRunQuery) Only(ctx context.Context) (*BackupRun, error) {
+	nodes, err := brq.Limit(2).All(setContextOp(ctx, brq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{backuprun.Label}
+	default:
+		return nil, &NotSingularError{backuprun.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (brq *BackupRunQuery) OnlyX(ctx context.Context) *BackupRun {
+	node, err := brq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only BackupRun ID in the query.
+// Returns a *NotSingularError when more than one BackupRun ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (brq *BackupRunQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = brq.Limit(2).IDs(setContextOp(ctx, brq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{backuprun.Label}
+	default:
+		err = &NotSingularError{backuprun.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (brq *BackupRunQuery) OnlyIDX(ctx context.Context) string {
+	id, err := brq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of BackupRuns.
+func (brq *BackupRunQuery) All(ctx context.Context) ([]*BackupRun, error) {
+	ctx = setContextOp(ctx, brq.ctx, ent.OpQueryAll)
+	if err := brq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*BackupRun, *BackupRunQuery]()
+	return withInterceptors[[]*BackupRun](ctx, brq, qr, brq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (brq *BackupRunQuery) AllX(ctx context.Context) []*BackupRun {
+	nodes, err := brq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of BackupRun IDs.
+func (brq *BackupRunQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if brq.ctx.Unique == nil && brq.path != nil {
+		brq.Unique(true)
+	}
+	ctx = setContextOp(ctx, brq.ctx, ent.OpQueryIDs)
+	if err = brq.Select(backuprun.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (brq *BackupRunQuery) IDsX(ctx context.Context) []string {
+	ids, err := brq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (brq *BackupRunQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, brq.ctx, ent.OpQueryCount)
+	if err := brq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, brq, querierCount[*BackupRunQuery](), brq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (brq *BackupRunQuery) CountX(ctx context.Context) int {
+	count, err := brq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (brq *BackupRunQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, brq.ctx, ent.OpQueryExist)
+	switch _, err := brq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (brq *BackupRunQuery) ExistX(ctx context.Context) bool {
+	exist, err := brq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the BackupRunQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (brq *BackupRunQuery) Clone() *BackupRunQuery {
+	if brq == nil {
+		return nil
+	}
+	return &BackupRunQuery{
+		config:                brq.config,
+		ctx:                   brq.ctx.Clone(),
+		order:                 append([]backuprun.OrderOption{}, brq.order...),
+		inters:                append([]Interceptor{}, brq.inters...),
+		predicates:            append([]predicate.BackupRun{}, brq.predicates...),
+		withPlaylistSnapshots: brq.withPlaylistSnapshots.Clone(),
+		withSavedTracks:       brq.withSavedTracks.Clone(),
+		withSavedAlbums:       brq.withSavedAlbums.Clone(),
+		withSavedShows:        brq.withSavedShows.Clone(),
+		withSavedEpisodes:     brq.withSavedEpisodes.Clone(),
+		withSavedAudiobooks:   brq.withSavedAudiobooks.Clone(),
+		withFollowedArtists:   brq.withFollowedArtists.Clone(),
+		// clone intermediate query.
+		sql:  brq.sql.Clone(),
+		path: brq.path,
+	}
+}
+
+// WithPlaylistSnapshots tells the query-builder to eager-load the nodes that are connected to
+// the "playlist_snapshots" edge. The optional arguments are used to configure the query builder of the edge.
+func (brq *BackupRunQuery) WithPlaylistSnapshots(opts ...func(*PlaylistSnapshotQuery)) *BackupRunQuery {
+	query := (&PlaylistSnapshotClient{config: brq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	brq.withPlaylistSnapshots = query
+	return brq
+}
+
+// WithSavedTracks tells the query-builder to eager-load the nodes that are connected to
+// the "saved_tracks" edge. The optional arguments are used to configure the query builder of the edge.
+func (brq *BackupRunQuery) WithSavedTracks(opts ...func(*SavedTrackQuery)) *BackupRunQuery {
+	query := (&SavedTrackClient{config: brq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	brq.withSavedTracks = query
+	return brq
+}
+
+// WithSavedAlbums tells the query-builder to eager-load the nodes that are connected to
+// the "saved_albums" edge. The optional arguments are used to configure the query builder of the edge.
+func (brq *BackupRunQuery) WithSavedAlbums(opts ...func(*SavedAlbumQuery)) *BackupRunQuery {
+	query := (&SavedAlbumClient{config: brq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	brq.withSavedAlbums = query
+	return brq
+}
+
+// WithSavedShows tells the query-builder to eager-load the nodes that are connected to
+// the "saved_shows" edge. The optional arguments are used to configure the query builder of the edge.
+func (brq *BackupRunQuery) WithSavedShows(opts ...func(*SavedShowQuery)) *BackupRunQuery {
+	query := (&SavedShowClient{config: brq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	brq.withSavedShows = query
+	return brq
+}
+
+// WithSavedEpisodes tells the query-builder to eager-load the nodes that are connected to
+// the "saved_episodes" edge. The optional arguments are used to configure the query builder of the edge.
+func (brq *BackupRunQuery) WithSavedEpisodes(opts ...func(*SavedEpisodeQuery)) *BackupRunQuery {
+	query := (&SavedEpisodeClient{config: brq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	brq.withSavedEpisodes = query
+	return brq
+}
+
+// WithSavedAudiobooks tells the query-builder to eager-load the nodes that are connected to
+// the "saved_audiobooks" edge. The optional arguments are used to configure the query builder of the edge.
+func (brq *BackupRunQuery) WithSavedAudiobooks(opts ...func(*SavedAudiobookQuery)) *BackupRunQuery {
+	query := (&SavedAudiobookClient{config: brq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	brq.withSavedAudiobooks = query
+	return brq
+}
+
+// WithFollowedArtists tells the query-builder to eager-load the nodes that are connected to
+// the "followed_artists" edge. The optional arguments are used to configure the query builder of the edge.
+func (brq *BackupRunQuery) WithFollowedArtists(opts ...func(*FollowedArtistQuery)) *BackupRunQuery {
+	query := (&FollowedArtistClient{config: brq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	brq.withFollowedArtists = query
+	return brq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID string `json:"user_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.BackupRun.Query().
+//		GroupBy(backuprun.FieldUserID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (brq *BackupRunQuery) GroupBy(field string, fields ...string) *BackupRunGroupBy {
+	brq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &BackupRunGroupBy{build: brq}
+	grbuild.flds = &brq.ctx.Fields
+	grbuild.label = backuprun.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID string `json:"user_id,omitempty"`
+//	}
+//
+//	client.BackupRun.Query().
+//		Select(backuprun.FieldUserID).
+//		Scan(ctx, &v)
+func (brq *BackupRunQuery) Select(fields ...string) *BackupRunSelect {
+	brq.ctx.Fields = append(brq.ctx.Fields, fields...)
+	sbuild := &BackupRunSelect{BackupRunQuery: brq}
+	sbuild.label = backuprun.Label
+	sbuild.flds, sbuild.scan = &brq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a BackupRunSelect configured with the given aggregations.
+func (brq *BackupRunQuery) Aggregate(fns ...AggregateFunc) *BackupRunSelect {
+	return brq.Select().Aggregate(fns...)
+}
+
+func (brq *BackupRunQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range brq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, brq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range brq.ctx.Fields {
+		if !backuprun.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if brq.path != nil {
+		prev, err := brq.path(ctx)
+		if err != nil {
+			return err
+		}
+		brq.sql = prev
+	}
+	return nil
+}
+
+func (brq *BackupRunQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*BackupRun, error) {
+	var (
+		nodes       = []*BackupRun{}
+		_spec       = brq.querySpec()
+		loadedTypes = [7]bool{
+			brq.withPlaylistSnapshots != nil,
+			brq.withSavedTracks != nil,
+			brq.withSavedAlbums != nil,
+			brq.withSavedShows != nil,
+			brq.withSavedEpisodes != nil,
+			brq.withSavedAudiobooks != nil,
+			brq.withFollowedArtists != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*BackupRun).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &BackupRun{config: brq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(brq.modifiers) > 0 {
+		_spec.Modifiers = brq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, brq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := brq.withPlaylistSnapshots; query != nil {
+		if err := brq.loadPlaylistSnapshots(ctx, query, nodes,
+			func(n *BackupRun) { n.Edges.PlaylistSnapshots = []*PlaylistSnapshot{} },
+			func(n *BackupRun, e *PlaylistSnapshot) {
+				n.Edges.PlaylistSnapshots = append(n.Edges.PlaylistSnapshots, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := brq.withSavedTracks; query != nil {
+		if err := brq.loadSavedTracks(ctx, query, nodes,
+			func(n *BackupRun) { n.Edges.SavedTracks = []*SavedTrack{} },
+			func(n *BackupRun, e *SavedTrack) { n.Edges.SavedTracks = append(n.Edges.SavedTracks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := brq.withSavedAlbums; query != nil {
+		if err := brq.loadSavedAlbums(ctx, query, nodes,
+			func(n *BackupRun) { n.Edges.SavedAlbums = []*SavedAlbum{} },
+			func(n *BackupRun, e *SavedAlbum) { n.Edges.SavedAlbums = append(n.Edges.SavedAlbums, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := brq.withSavedShows; query != nil {
+		if err := brq.loadSavedShows(ctx, query, nodes,
+			func(n *BackupRun) { n.Edges.SavedShows = []*SavedShow{} },
+			func(n *BackupRun, e *SavedShow) { n.Edges.SavedShows = append(n.Edges.SavedShows, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := brq.withSavedEpisodes; query != nil {
+		if err := brq.loadSavedEpisodes(ctx, query, nodes,
+			func(n *BackupRun) { n.Edges.SavedEpisodes = []*SavedEpisode{} },
+			func(n *BackupRun, e *SavedEpisode) { n.Edges.SavedEpisodes = append(n.Edges.SavedEpisodes, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := brq.withSavedAudiobooks; query != nil {
+		if err := brq.loadSavedAudiobooks(ctx, query, nodes,
+			func(n *BackupRun) { n.Edges.SavedAudiobooks = []*SavedAudiobook{} },
+			func(n *BackupRun, e *SavedAudiobook) { n.Edges.SavedAudiobooks = append(n.Edges.SavedAudiobooks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := brq.withFollowedArtists; query != nil {
+		if err := brq.loadFollowedArtists(ctx, query, nodes,
+			func(n *BackupRun) { n.Edges.FollowedArtists = []*FollowedArtist{} },
+			func(n *BackupRun, e *FollowedArtist) { n.Edges.FollowedArtists = append(n.Edges.FollowedArtists, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (brq *BackupRunQuery) loadPlaylistSnapshots(ctx context.Context, query *PlaylistSnapshotQuery, nodes []*BackupRun, init func(*BackupRun), assign func(*BackupRun, *PlaylistSnapshot)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[string]*BackupRun)
+	nids := make(map[string]map[*BackupRun]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(backuprun.PlaylistSnapshotsTable)
+		s.Join(joinT).On(s.C(playlistsnapshot.FieldID), joinT.C(backuprun.PlaylistSnapshotsPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(backuprun.PlaylistSnapshotsPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(backuprun.PlaylistSnapshotsPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullString)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := values[0].(*sql.NullString).String
+				inValue := values[1].(*sql.NullString).String
+				if nids[inValue] == nil {
+					nids[inValue] = map[*BackupRun]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*PlaylistSnapshot](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "playlist_snapshots" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (brq *BackupRunQuery) loadSavedTracks(ctx context.Context, query *SavedTrackQuery, nodes []*BackupRun, init func(*BackupRun), assign func(*BackupRun, *SavedTrack)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*BackupRun)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.SavedTrack(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(backuprun.SavedTracksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.backup_run_saved_tracks
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "backup_run_saved_tracks" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "backup_run_saved_tracks" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (brq *BackupRunQuery) loadSavedAlbums(ctx context.Context, query *SavedAlbumQuery, nodes []*BackupRun, init func(*BackupRun), assign func(*BackupRun, *SavedAlbum)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*BackupRun)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.SavedAlbum(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(backuprun.SavedAlbumsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.backup_run_saved_albums
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "backup_run_saved_albums" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "backup_run_saved_albums" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (brq *BackupRunQuery) loadSavedShows(ctx context.Context, query *SavedShowQuery, nodes []*BackupRun, init func(*BackupRun), assign func(*BackupRun, *SavedShow)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*BackupRun)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.SavedShow(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(backuprun.SavedShowsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.backup_run_saved_shows
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "backup_run_saved_shows" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "backup_run_saved_shows" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (brq *BackupRunQuery) loadSavedEpisodes(ctx context.Context, query *SavedEpisodeQuery, nodes []*BackupRun, init func(*BackupRun), assign func(*BackupRun, *SavedEpisode)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*BackupRun)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.SavedEpisode(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(backuprun.SavedEpisodesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.backup_run_saved_episodes
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "backup_run_saved_episodes" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "backup_run_saved_episodes" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (brq *BackupRunQuery) loadSavedAudiobooks(ctx context.Context, query *SavedAudiobookQuery, nodes []*BackupRun, init func(*BackupRun), assign func(*BackupRun, *SavedAudiobook)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*BackupRun)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.SavedAudiobook(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(backuprun.SavedAudiobooksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.backup_run_saved_audiobooks
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "backup_run_saved_audiobooks" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "backup_run_saved_audiobooks" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (brq *BackupRunQuery) loadFollowedArtists(ctx context.Context, query *FollowedArtistQuery, nodes []*BackupRun, init func(*BackupRun), assign func(*BackupRun, *FollowedArtist)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*BackupRun)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.FollowedArtist(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(backuprun.FollowedArtistsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.backup_run_followed_artists
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "backup_run_followed_artists" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "backup_run_followed_artists" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (brq *BackupRunQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := brq.querySpec()
+	if len(brq.modifiers) > 0 {
+		_spec.Modifiers = brq.modifiers
+	}
+	_spec.Node.Columns = brq.ctx.Fields
+	if len(brq.ctx.Fields) > 0 {
+		_spec.Unique = brq.ctx.Unique != nil && *brq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, brq.driver, _spec)
+}
+
+func (brq *BackupRunQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(backuprun.Table, backuprun.Columns, sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeString))
+	_spec.From = brq.sql
+	if unique := brq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if brq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := brq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, backuprun.FieldID)
+		for i := range fields {
+			if fields[i] != backuprun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := brq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := brq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := brq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := brq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (brq *BackupRunQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(brq.driver.Dialect())
+	t1 := builder.Table(backuprun.Table)
+	columns := brq.ctx.Fields
+	if len(columns) == 0 {
+		columns = backuprun.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if brq.sql != nil {
+		selector = brq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if brq.ctx.Unique != nil && *brq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range brq.modifiers {
+		m(selector)
+	}
+	for _, p := range brq.predicates {
+		p(selector)
+	}
+	for _, p := range brq.order {
+		p(selector)
+	}
+	if offset := brq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := brq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (brq *BackupRunQuery) ForUpdate(opts ...sql.LockOption) *BackupRunQuery {
+	if brq.driver.Dialect() == dialect.Postgres {
+		brq.Unique(false)
+	}
+	brq.modifiers = append(brq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return brq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (brq *BackupRunQuery) ForShare(opts ...sql.LockOption) *BackupRunQuery {
+	if brq.driver.Dialect() == dialect.Postgres {
+		brq.Unique(false)
+	}
+	brq.modifiers = append(brq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return brq
+}
+
+// BackupRunGroupBy is the group-by builder for BackupRun entities.
+type BackupRunGroupBy struct {
+	selector
+	build *BackupRunQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (brgb *BackupRunGroupBy) Aggregate(fns ...AggregateFunc) *BackupRunGroupBy {
+	brgb.fns = append(brgb.fns, fns...)
+	return brgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (brgb *BackupRunGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, brgb.build.ctx, ent.OpQueryGroupBy)
+	if err := brgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BackupRunQuery, *BackupRunGroupBy](ctx, brgb.build, brgb, brgb.build.inters, v)
+}
+
+func (brgb *BackupRunGroupBy) sqlScan(ctx context.Context, root *BackupRunQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(brgb.fns))
+	for _, fn := range brgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*brgb.flds)+len(brgb.fns))
+		for _, f := range *brgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*brgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := brgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// BackupRunSelect is the builder for selecting fields of BackupRun entities.
+type BackupRunSelect struct {
+	*BackupRunQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (brs *BackupRunSelect) Aggregate(fns ...AggregateFunc) *BackupRunSelect {
+	brs.fns = append(brs.fns, fns...)
+	return brs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (brs *BackupRunSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, brs.ctx, ent.OpQuerySelect)
+	if err := brs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BackupRunQuery, *BackupRunSelect](ctx, brs.BackupRunQuery, brs, brs.inters, v)
+}
+
+func (brs *BackupRunSelect) sqlScan(ctx context.Context, root *BackupRunQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(brs.fns))
+	for _, fn := range brs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*brs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := brs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
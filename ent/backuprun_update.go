@@ -0,0 +1,1805 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/followedartist"
+	"beyerleinf/spotify-backup/ent/playlistsnapshot"
+	"beyerleinf/spotify-backup/ent/predicate"
+	"beyerleinf/spotify-backup/ent/savedalbum"
+	"beyerleinf/spotify-backup/ent/savedaudiobook"
+	"beyerleinf/spotify-backup/ent/savedepisode"
+	"beyerleinf/spotify-backup/ent/savedshow"
+	"beyerleinf/spotify-backup/ent/savedtrack"
+	"beyerleinf/spotify-backup/ent/schema/schematype"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BackupRunUpdate is the builder for updating BackupRun entities.
+type BackupRunUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BackupRunMutation
+}
+
+// Where appends a list predicates to the BackupRunUpdate builder.
+func (bru *BackupRunUpdate) Where(ps ...predicate.BackupRun) *BackupRunUpdate {
+	bru.mutation.Where(ps...)
+	return bru
+}
+
+// SetUserID sets the "user_id" field.
+func (bru *BackupRunUpdate) SetUserID(s string) *BackupRunUpdate {
+	bru.mutation.SetUserID(s)
+	return bru
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (bru *BackupRunUpdate) SetNillableUserID(s *string) *BackupRunUpdate {
+	if s != nil {
+		bru.SetUserID(*s)
+	}
+	return bru
+}
+
+// SetStatus sets the "status" field.
+func (bru *BackupRunUpdate) SetStatus(b backuprun.Status) *BackupRunUpdate {
+	bru.mutation.SetStatus(b)
+	return bru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (bru *BackupRunUpdate) SetNillableStatus(b *backuprun.Status) *BackupRunUpdate {
+	if b != nil {
+		bru.SetStatus(*b)
+	}
+	return bru
+}
+
+// SetCollections sets the "collections" field.
+func (bru *BackupRunUpdate) SetCollections(mr map[string]schematype.CollectionResult) *BackupRunUpdate {
+	bru.mutation.SetCollections(mr)
+	return bru
+}
+
+// SetAPICalls sets the "api_calls" field.
+func (bru *BackupRunUpdate) SetAPICalls(i int) *BackupRunUpdate {
+	bru.mutation.ResetAPICalls()
+	bru.mutation.SetAPICalls(i)
+	return bru
+}
+
+// SetNillableAPICalls sets the "api_calls" field if the given value is not nil.
+func (bru *BackupRunUpdate) SetNillableAPICalls(i *int) *BackupRunUpdate {
+	if i != nil {
+		bru.SetAPICalls(*i)
+	}
+	return bru
+}
+
+// AddAPICalls adds i to the "api_calls" field.
+func (bru *BackupRunUpdate) AddAPICalls(i int) *BackupRunUpdate {
+	bru.mutation.AddAPICalls(i)
+	return bru
+}
+
+// SetError sets the "error" field.
+func (bru *BackupRunUpdate) SetError(s string) *BackupRunUpdate {
+	bru.mutation.SetError(s)
+	return bru
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (bru *BackupRunUpdate) SetNillableError(s *string) *BackupRunUpdate {
+	if s != nil {
+		bru.SetError(*s)
+	}
+	return bru
+}
+
+// SetCheckpoint sets the "checkpoint" field.
+func (bru *BackupRunUpdate) SetCheckpoint(s schematype.Checkpoint) *BackupRunUpdate {
+	bru.mutation.SetCheckpoint(s)
+	return bru
+}
+
+// SetNillableCheckpoint sets the "checkpoint" field if the given value is not nil.
+func (bru *BackupRunUpdate) SetNillableCheckpoint(s *schematype.Checkpoint) *BackupRunUpdate {
+	if s != nil {
+		bru.SetCheckpoint(*s)
+	}
+	return bru
+}
+
+// ClearCheckpoint clears the value of the "checkpoint" field.
+func (bru *BackupRunUpdate) ClearCheckpoint() *BackupRunUpdate {
+	bru.mutation.ClearCheckpoint()
+	return bru
+}
+
+// SetResumes sets the "resumes" field.
+func (bru *BackupRunUpdate) SetResumes(i int) *BackupRunUpdate {
+	bru.mutation.ResetResumes()
+	bru.mutation.SetResumes(i)
+	return bru
+}
+
+// SetNillableResumes sets the "resumes" field if the given value is not nil.
+func (bru *BackupRunUpdate) SetNillableResumes(i *int) *BackupRunUpdate {
+	if i != nil {
+		bru.SetResumes(*i)
+	}
+	return bru
+}
+
+// AddResumes adds i to the "resumes" field.
+func (bru *BackupRunUpdate) AddResumes(i int) *BackupRunUpdate {
+	bru.mutation.AddResumes(i)
+	return bru
+}
+
+// SetPinned sets the "pinned" field.
+func (bru *BackupRunUpdate) SetPinned(b bool) *BackupRunUpdate {
+	bru.mutation.SetPinned(b)
+	return bru
+}
+
+// SetNillablePinned sets the "pinned" field if the given value is not nil.
+func (bru *BackupRunUpdate) SetNillablePinned(b *bool) *BackupRunUpdate {
+	if b != nil {
+		bru.SetPinned(*b)
+	}
+	return bru
+}
+
+// SetNote sets the "note" field.
+func (bru *BackupRunUpdate) SetNote(s string) *BackupRunUpdate {
+	bru.mutation.SetNote(s)
+	return bru
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (bru *BackupRunUpdate) SetNillableNote(s *string) *BackupRunUpdate {
+	if s != nil {
+		bru.SetNote(*s)
+	}
+	return bru
+}
+
+// SetLabels sets the "labels" field.
+func (bru *BackupRunUpdate) SetLabels(s []string) *BackupRunUpdate {
+	bru.mutation.SetLabels(s)
+	return bru
+}
+
+// AppendLabels appends s to the "labels" field.
+func (bru *BackupRunUpdate) AppendLabels(s []string) *BackupRunUpdate {
+	bru.mutation.AppendLabels(s)
+	return bru
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (bru *BackupRunUpdate) SetFinishedAt(t time.Time) *BackupRunUpdate {
+	bru.mutation.SetFinishedAt(t)
+	return bru
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (bru *BackupRunUpdate) SetNillableFinishedAt(t *time.Time) *BackupRunUpdate {
+	if t != nil {
+		bru.SetFinishedAt(*t)
+	}
+	return bru
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (bru *BackupRunUpdate) ClearFinishedAt() *BackupRunUpdate {
+	bru.mutation.ClearFinishedAt()
+	return bru
+}
+
+// AddPlaylistSnapshotIDs adds the "playlist_snapshots" edge to the PlaylistSnapshot entity by IDs.
+func (bru *BackupRunUpdate) AddPlaylistSnapshotIDs(ids ...string) *BackupRunUpdate {
+	bru.mutation.AddPlaylistSnapshotIDs(ids...)
+	return bru
+}
+
+// AddPlaylistSnapshots adds the "playlist_snapshots" edges to the PlaylistSnapshot entity.
+func (bru *BackupRunUpdate) AddPlaylistSnapshots(p ...*PlaylistSnapshot) *BackupRunUpdate {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return bru.AddPlaylistSnapshotIDs(ids...)
+}
+
+// AddSavedTrackIDs adds the "saved_tracks" edge to the SavedTrack entity by IDs.
+func (bru *BackupRunUpdate) AddSavedTrackIDs(ids ...string) *BackupRunUpdate {
+	bru.mutation.AddSavedTrackIDs(ids...)
+	return bru
+}
+
+// AddSavedTracks adds the "saved_tracks" edges to the SavedTrack entity.
+func (bru *BackupRunUpdate) AddSavedTracks(s ...*SavedTrack) *BackupRunUpdate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return bru.AddSavedTrackIDs(ids...)
+}
+
+// AddSavedAlbumIDs adds the "saved_albums" edge to the SavedAlbum entity by IDs.
+func (bru *BackupRunUpdate) AddSavedAlbumIDs(ids ...string) *BackupRunUpdate {
+	bru.mutation.AddSavedAlbumIDs(ids...)
+	return bru
+}
+
+// AddSavedAlbums adds the "saved_albums" edges to the SavedAlbum entity.
+func (bru *BackupRunUpdate) AddSavedAlbums(s ...*SavedAlbum) *BackupRunUpdate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return bru.AddSavedAlbumIDs(ids...)
+}
+
+// AddSavedShowIDs adds the "saved_shows" edge to the SavedShow entity by IDs.
+func (bru *BackupRunUpdate) AddSavedShowIDs(ids ...string) *BackupRunUpdate {
+	bru.mutation.AddSavedShowIDs(ids...)
+	return bru
+}
+
+// AddSavedShows adds the "saved_shows" edges to the SavedShow entity.
+func (bru *BackupRunUpdate) AddSavedShows(s ...*SavedShow) *BackupRunUpdate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return bru.AddSavedShowIDs(ids...)
+}
+
+// AddSavedEpisodeIDs adds the "saved_episodes" edge to the SavedEpisode entity by IDs.
+func (bru *BackupRunUpdate) AddSavedEpisodeIDs(ids ...string) *BackupRunUpdate {
+	bru.mutation.AddSavedEpisodeIDs(ids...)
+	return bru
+}
+
+// AddSavedEpisodes adds the "saved_episodes" edges to the SavedEpisode entity.
+func (bru *BackupRunUpdate) AddSavedEpisodes(s ...*SavedEpisode) *BackupRunUpdate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return bru.AddSavedEpisodeIDs(ids...)
+}
+
+// AddSavedAudiobookIDs adds the "saved_audiobooks" edge to the SavedAudiobook entity by IDs.
+func (bru *BackupRunUpdate) AddSavedAudiobookIDs(ids ...string) *BackupRunUpdate {
+	bru.mutation.AddSavedAudiobookIDs(ids...)
+	return bru
+}
+
+// AddSavedAudiobooks adds the "saved_audiobooks" edges to the SavedAudiobook entity.
+func (bru *BackupRunUpdate) AddSavedAudiobooks(s ...*SavedAudiobook) *BackupRunUpdate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return bru.AddSavedAudiobookIDs(ids...)
+}
+
+// AddFollowedArtistIDs adds the "followed_artists" edge to the FollowedArtist entity by IDs.
+func (bru *BackupRunUpdate) AddFollowedArtistIDs(ids ...string) *BackupRunUpdate {
+	bru.mutation.AddFollowedArtistIDs(ids...)
+	return bru
+}
+
+// AddFollowedArtists adds the "followed_artists" edges to the FollowedArtist entity.
+func (bru *BackupRunUpdate) AddFollowedArtists(f ...*FollowedArtist) *BackupRunUpdate {
+	ids := make([]string, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return bru.AddFollowedArtistIDs(ids...)
+}
+
+// Mutation returns the BackupRunMutation object of the builder.
+func (bru *BackupRunUpdate) Mutation() *BackupRunMutation {
+	return bru.mutation
+}
+
+// ClearPlaylistSnapshots clears all "playlist_snapshots" edges to the PlaylistSnapshot entity.
+func (bru *BackupRunUpdate) ClearPlaylistSnapshots() *BackupRunUpdate {
+	bru.mutation.ClearPlaylistSnapshots()
+	return bru
+}
+
+// RemovePlaylistSnapshotIDs removes the "playlist_snapshots" edge to PlaylistSnapshot entities by IDs.
+func (bru *BackupRunUpdate) RemovePlaylistSnapshotIDs(ids ...string) *BackupRunUpdate {
+	bru.mutation.RemovePlaylistSnapshotIDs(ids...)
+	return bru
+}
+
+// RemovePlaylistSnapshots removes "playlist_snapshots" edges to PlaylistSnapshot entities.
+func (bru *BackupRunUpdate) RemovePlaylistSnapshots(p ...*PlaylistSnapshot) *BackupRunUpdate {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return bru.RemovePlaylistSnapshotIDs(ids...)
+}
+
+// ClearSavedTracks clears all "saved_tracks" edges to the SavedTrack entity.
+func (bru *BackupRunUpdate) ClearSavedTracks() *BackupRunUpdate {
+	bru.mutation.ClearSavedTracks()
+	return bru
+}
+
+// RemoveSavedTrackIDs removes the "saved_tracks" edge to SavedTrack entities by IDs.
+func (bru *BackupRunUpdate) RemoveSavedTrackIDs(ids ...string) *BackupRunUpdate {
+	bru.mutation.RemoveSavedTrackIDs(ids...)
+	return bru
+}
+
+// RemoveSavedTracks removes "saved_tracks" edges to SavedTrack entities.
+func (bru *BackupRunUpdate) RemoveSavedTracks(s ...*SavedTrack) *BackupRunUpdate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return bru.RemoveSavedTrackIDs(ids...)
+}
+
+// ClearSavedAlbums clears all "saved_albums" edges to the SavedAlbum entity.
+func (bru *BackupRunUpdate) ClearSavedAlbums() *BackupRunUpdate {
+	bru.mutation.ClearSavedAlbums()
+	return bru
+}
+
+// RemoveSavedAlbumIDs removes the "saved_albums" edge to SavedAlbum entities by IDs.
+func (bru *BackupRunUpdate) RemoveSavedAlbumIDs(ids ...string) *BackupRunUpdate {
+	bru.mutation.RemoveSavedAlbumIDs(ids...)
+	return bru
+}
+
+// RemoveSavedAlbums removes "saved_albums" edges to SavedAlbum entities.
+func (bru *BackupRunUpdate) RemoveSavedAlbums(s ...*SavedAlbum) *BackupRunUpdate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return bru.RemoveSavedAlbumIDs(ids...)
+}
+
+// ClearSavedShows clears all "saved_shows" edges to the SavedShow entity.
+func (bru *BackupRunUpdate) ClearSavedShows() *BackupRunUpdate {
+	bru.mutation.ClearSavedShows()
+	return bru
+}
+
+// RemoveSavedShowIDs removes the "saved_shows" edge to SavedShow entities by IDs.
+func (bru *BackupRunUpdate) RemoveSavedShowIDs(ids ...string) *BackupRunUpdate {
+	bru.mutation.RemoveSavedShowIDs(ids...)
+	return bru
+}
+
+// RemoveSavedShows removes "saved_shows" edges to SavedShow entities.
+func (bru *BackupRunUpdate) RemoveSavedShows(s ...*SavedShow) *BackupRunUpdate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return bru.RemoveSavedShowIDs(ids...)
+}
+
+// ClearSavedEpisodes clears all "saved_episodes" edges to the SavedEpisode entity.
+func (bru *BackupRunUpdate) ClearSavedEpisodes() *BackupRunUpdate {
+	bru.mutation.ClearSavedEpisodes()
+	return bru
+}
+
+// RemoveSavedEpisodeIDs removes the "saved_episodes" edge to SavedEpisode entities by IDs.
+func (bru *BackupRunUpdate) RemoveSavedEpisodeIDs(ids ...string) *BackupRunUpdate {
+	bru.mutation.RemoveSavedEpisodeIDs(ids...)
+	return bru
+}
+
+// RemoveSavedEpisodes removes "saved_episodes" edges to SavedEpisode entities.
+func (bru *BackupRunUpdate) RemoveSavedEpisodes(s ...*SavedEpisode) *BackupRunUpdate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return bru.RemoveSavedEpisodeIDs(ids...)
+}
+
+// ClearSavedAudiobooks clears all "saved_audiobooks" edges to the SavedAudiobook entity.
+func (bru *BackupRunUpdate) ClearSavedAudiobooks() *BackupRunUpdate {
+	bru.mutation.ClearSavedAudiobooks()
+	return bru
+}
+
+// RemoveSavedAudiobookIDs removes the "saved_audiobooks" edge to SavedAudiobook entities by IDs.
+func (bru *BackupRunUpdate) RemoveSavedAudiobookIDs(ids ...string) *BackupRunUpdate {
+	bru.mutation.RemoveSavedAudiobookIDs(ids...)
+	return bru
+}
+
+// RemoveSavedAudiobooks removes "saved_audiobooks" edges to SavedAudiobook entities.
+func (bru *BackupRunUpdate) RemoveSavedAudiobooks(s ...*SavedAudiobook) *BackupRunUpdate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return bru.RemoveSavedAudiobookIDs(ids...)
+}
+
+// ClearFollowedArtists clears all "followed_artists" edges to the FollowedArtist entity.
+func (bru *BackupRunUpdate) ClearFollowedArtists() *BackupRunUpdate {
+	bru.mutation.ClearFollowedArtists()
+	return bru
+}
+
+// RemoveFollowedArtistIDs removes the "followed_artists" edge to FollowedArtist entities by IDs.
+func (bru *BackupRunUpdate) RemoveFollowedArtistIDs(ids ...string) *BackupRunUpdate {
+	bru.mutation.RemoveFollowedArtistIDs(ids...)
+	return bru
+}
+
+// RemoveFollowedArtists removes "followed_artists" edges to FollowedArtist entities.
+func (bru *BackupRunUpdate) RemoveFollowedArtists(f ...*FollowedArtist) *BackupRunUpdate {
+	ids := make([]string, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return bru.RemoveFollowedArtistIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (bru *BackupRunUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, bru.sqlSave, bru.mutation, bru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bru *BackupRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := bru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (bru *BackupRunUpdate) Exec(ctx context.Context) error {
+	_, err := bru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bru *BackupRunUpdate) ExecX(ctx context.Context) {
+	if err := bru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bru *BackupRunUpdate) check() error {
+	if v, ok := bru.mutation.Status(); ok {
+		if err := backuprun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "BackupRun.status": %w`, err)}
+		}
+	}
+	if v, ok := bru.mutation.APICalls(); ok {
+		if err := backuprun.APICallsValidator(v); err != nil {
+			return &ValidationError{Name: "api_calls", err: fmt.Errorf(`ent: validator failed for field "BackupRun.api_calls": %w`, err)}
+		}
+	}
+	if v, ok := bru.mutation.Resumes(); ok {
+		if err := backuprun.ResumesValidator(v); err != nil {
+			return &ValidationError{Name: "resumes", err: fmt.Errorf(`ent: validator failed for field "BackupRun.resumes": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (bru *BackupRunUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := bru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(backuprun.Table, backuprun.Columns, sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeString))
+	if ps := bru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bru.mutation.UserID(); ok {
+		_spec.SetField(backuprun.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := bru.mutation.Status(); ok {
+		_spec.SetField(backuprun.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := bru.mutation.Collections(); ok {
+		_spec.SetField(backuprun.FieldCollections, field.TypeJSON, value)
+	}
+	if value, ok := bru.mutation.APICalls(); ok {
+		_spec.SetField(backuprun.FieldAPICalls, field.TypeInt, value)
+	}
+	if value, ok := bru.mutation.AddedAPICalls(); ok {
+		_spec.AddField(backuprun.FieldAPICalls, field.TypeInt, value)
+	}
+	if value, ok := bru.mutation.Error(); ok {
+		_spec.SetField(backuprun.FieldError, field.TypeString, value)
+	}
+	if value, ok := bru.mutation.Checkpoint(); ok {
+		_spec.SetField(backuprun.FieldCheckpoint, field.TypeJSON, value)
+	}
+	if bru.mutation.CheckpointCleared() {
+		_spec.ClearField(backuprun.FieldCheckpoint, field.TypeJSON)
+	}
+	if value, ok := bru.mutation.Resumes(); ok {
+		_spec.SetField(backuprun.FieldResumes, field.TypeInt, value)
+	}
+	if value, ok := bru.mutation.AddedResumes(); ok {
+		_spec.AddField(backuprun.FieldResumes, field.TypeInt, value)
+	}
+	if value, ok := bru.mutation.Pinned(); ok {
+		_spec.SetField(backuprun.FieldPinned, field.TypeBool, value)
+	}
+	if value, ok := bru.mutation.Note(); ok {
+		_spec.SetField(backuprun.FieldNote, field.TypeString, value)
+	}
+	if value, ok := bru.mutation.Labels(); ok {
+		_spec.SetField(backuprun.FieldLabels, field.TypeJSON, value)
+	}
+	if value, ok := bru.mutation.AppendedLabels(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, backuprun.FieldLabels, value)
+		})
+	}
+	if value, ok := bru.mutation.FinishedAt(); ok {
+		_spec.SetField(backuprun.FieldFinishedAt, field.TypeTime, value)
+	}
+	if bru.mutation.FinishedAtCleared() {
+		_spec.ClearField(backuprun.FieldFinishedAt, field.TypeTime)
+	}
+	if bru.mutation.PlaylistSnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   backuprun.PlaylistSnapshotsTable,
+			Columns: backuprun.PlaylistSnapshotsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlistsnapshot.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bru.mutation.RemovedPlaylistSnapshotsIDs(); len(nodes) > 0 && !bru.mutation.PlaylistSnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   backuprun.PlaylistSnapshotsTable,
+			Columns: backuprun.PlaylistSnapshotsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlistsnapshot.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bru.mutation.PlaylistSnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   backuprun.PlaylistSnapshotsTable,
+			Columns: backuprun.PlaylistSnapshotsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlistsnapshot.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bru.mutation.SavedTracksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuprun.SavedTracksTable,
+			Columns: []string{backuprun.SavedTracksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedtrack.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bru.mutation.RemovedSavedTracksIDs(); len(nodes) > 0 && !bru.mutation.SavedTracksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuprun.SavedTracksTable,
+			Columns: []string{backuprun.SavedTracksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedtrack.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bru.mutation.SavedTracksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuprun.SavedTracksTable,
+			Columns: []string{backuprun.SavedTracksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedtrack.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bru.mutation.SavedAlbumsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuprun.SavedAlbumsTable,
+			Columns: []string{backuprun.SavedAlbumsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedalbum.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bru.mutation.RemovedSavedAlbumsIDs(); len(nodes) > 0 && !bru.mutation.SavedAlbumsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuprun.SavedAlbumsTable,
+			Columns: []string{backuprun.SavedAlbumsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedalbum.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bru.mutation.SavedAlbumsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuprun.SavedAlbumsTable,
+			Columns: []string{backuprun.SavedAlbumsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedalbum.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bru.mutation.SavedShowsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuprun.SavedShowsTable,
+			Columns: []string{backuprun.SavedShowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedshow.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bru.mutation.RemovedSavedShowsIDs(); len(nodes) > 0 && !bru.mutation.SavedShowsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuprun.SavedShowsTable,
+			Columns: []string{backuprun.SavedShowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedshow.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bru.mutation.SavedShowsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuprun.SavedShowsTable,
+			Columns: []string{backuprun.SavedShowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedshow.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bru.mutation.SavedEpisodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuprun.SavedEpisodesTable,
+			Columns: []string{backuprun.SavedEpisodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedepisode.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bru.mutation.RemovedSavedEpisodesIDs(); len(nodes) > 0 && !bru.mutation.SavedEpisodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuprun.SavedEpisodesTable,
+			Columns: []string{backuprun.SavedEpisodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedepisode.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bru.mutation.SavedEpisodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuprun.SavedEpisodesTable,
+			Columns: []string{backuprun.SavedEpisodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedepisode.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bru.mutation.SavedAudiobooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuprun.SavedAudiobooksTable,
+			Columns: []string{backuprun.SavedAudiobooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedaudiobook.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bru.mutation.RemovedSavedAudiobooksIDs(); len(nodes) > 0 && !bru.mutation.SavedAudiobooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuprun.SavedAudiobooksTable,
+			Columns: []string{backuprun.SavedAudiobooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedaudiobook.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bru.mutation.SavedAudiobooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuprun.SavedAudiobooksTable,
+			Columns: []string{backuprun.SavedAudiobooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedaudiobook.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bru.mutation.FollowedArtistsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuprun.FollowedArtistsTable,
+			Columns: []string{backuprun.FollowedArtistsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(followedartist.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bru.mutation.RemovedFollowedArtistsIDs(); len(nodes) > 0 && !bru.mutation.FollowedArtistsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuprun.FollowedArtistsTable,
+			Columns: []string{backuprun.FollowedArtistsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(followedartist.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bru.mutation.FollowedArtistsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuprun.FollowedArtistsTable,
+			Columns: []string{backuprun.FollowedArtistsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(followedartist.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, bru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{backuprun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	bru.mutation.done = true
+	return n, nil
+}
+
+// BackupRunUpdateOne is the builder for updating a single BackupRun entity.
+type BackupRunUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BackupRunMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (bruo *BackupRunUpdateOne) SetUserID(s string) *BackupRunUpdateOne {
+	bruo.mutation.SetUserID(s)
+	return bruo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (bruo *BackupRunUpdateOne) SetNillableUserID(s *string) *BackupRunUpdateOne {
+	if s != nil {
+		bruo.SetUserID(*s)
+	}
+	return bruo
+}
+
+// SetStatus sets the "status" field.
+func (bruo *BackupRunUpdateOne) SetStatus(b backuprun.Status) *BackupRunUpdateOne {
+	bruo.mutation.SetStatus(b)
+	return bruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (bruo *BackupRunUpdateOne) SetNillableStatus(b *backuprun.Status) *BackupRunUpdateOne {
+	if b != nil {
+		bruo.SetStatus(*b)
+	}
+	return bruo
+}
+
+// SetCollections sets the "collections" field.
+func (bruo *BackupRunUpdateOne) SetCollections(mr map[string]schematype.CollectionResult) *BackupRunUpdateOne {
+	bruo.mutation.SetCollections(mr)
+	return bruo
+}
+
+// SetAPICalls sets the "api_calls" field.
+func (bruo *BackupRunUpdateOne) SetAPICalls(i int) *BackupRunUpdateOne {
+	bruo.mutation.ResetAPICalls()
+	bruo.mutation.SetAPICalls(i)
+	return bruo
+}
+
+// SetNillableAPICalls sets the "api_calls" field if the given value is not nil.
+func (bruo *BackupRunUpdateOne) SetNillableAPICalls(i *int) *BackupRunUpdateOne {
+	if i != nil {
+		bruo.SetAPICalls(*i)
+	}
+	return bruo
+}
+
+// AddAPICalls adds i to the "api_calls" field.
+func (bruo *BackupRunUpdateOne) AddAPICalls(i int) *BackupRunUpdateOne {
+	bruo.mutation.AddAPICalls(i)
+	return bruo
+}
+
+// SetError sets the "error" field.
+func (bruo *BackupRunUpdateOne) SetError(s string) *BackupRunUpdateOne {
+	bruo.mutation.SetError(s)
+	return bruo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (bruo *BackupRunUpdateOne) SetNillableError(s *string) *BackupRunUpdateOne {
+	if s != nil {
+		bruo.SetError(*s)
+	}
+	return bruo
+}
+
+// SetCheckpoint sets the "checkpoint" field.
+func (bruo *BackupRunUpdateOne) SetCheckpoint(s schematype.Checkpoint) *BackupRunUpdateOne {
+	bruo.mutation.SetCheckpoint(s)
+	return bruo
+}
+
+// SetNillableCheckpoint sets the "checkpoint" field if the given value is not nil.
+func (bruo *BackupRunUpdateOne) SetNillableCheckpoint(s *schematype.Checkpoint) *BackupRunUpdateOne {
+	if s != nil {
+		bruo.SetCheckpoint(*s)
+	}
+	return bruo
+}
+
+// ClearCheckpoint clears the value of the "checkpoint" field.
+func (bruo *BackupRunUpdateOne) ClearCheckpoint() *BackupRunUpdateOne {
+	bruo.mutation.ClearCheckpoint()
+	return bruo
+}
+
+// SetResumes sets the "resumes" field.
+func (bruo *BackupRunUpdateOne) SetResumes(i int) *BackupRunUpdateOne {
+	bruo.mutation.ResetResumes()
+	bruo.mutation.SetResumes(i)
+	return bruo
+}
+
+// SetNillableResumes sets the "resumes" field if the given value is not nil.
+func (bruo *BackupRunUpdateOne) SetNillableResumes(i *int) *BackupRunUpdateOne {
+	if i != nil {
+		bruo.SetResumes(*i)
+	}
+	return bruo
+}
+
+// AddResumes adds i to the "resumes" field.
+func (bruo *BackupRunUpdateOne) AddResumes(i int) *BackupRunUpdateOne {
+	bruo.mutation.AddResumes(i)
+	return bruo
+}
+
+// SetPinned sets the "pinned" field.
+func (bruo *BackupRunUpdateOne) SetPinned(b bool) *BackupRunUpdateOne {
+	bruo.mutation.SetPinned(b)
+	return bruo
+}
+
+// SetNillablePinned sets the "pinned" field if the given value is not nil.
+func (bruo *BackupRunUpdateOne) SetNillablePinned(b *bool) *BackupRunUpdateOne {
+	if b != nil {
+		bruo.SetPinned(*b)
+	}
+	return bruo
+}
+
+// SetNote sets the "note" field.
+func (bruo *BackupRunUpdateOne) SetNote(s string) *BackupRunUpdateOne {
+	bruo.mutation.SetNote(s)
+	return bruo
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (bruo *BackupRunUpdateOne) SetNillableNote(s *string) *BackupRunUpdateOne {
+	if s != nil {
+		bruo.SetNote(*s)
+	}
+	return bruo
+}
+
+// SetLabels sets the "labels" field.
+func (bruo *BackupRunUpdateOne) SetLabels(s []string) *BackupRunUpdateOne {
+	bruo.mutation.SetLabels(s)
+	return bruo
+}
+
+// AppendLabels appends s to the "labels" field.
+func (bruo *BackupRunUpdateOne) AppendLabels(s []string) *BackupRunUpdateOne {
+	bruo.mutation.AppendLabels(s)
+	return bruo
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (bruo *BackupRunUpdateOne) SetFinishedAt(t time.Time) *BackupRunUpdateOne {
+	bruo.mutation.SetFinishedAt(t)
+	return bruo
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (bruo *BackupRunUpdateOne) SetNillableFinishedAt(t *time.Time) *BackupRunUpdateOne {
+	if t != nil {
+		bruo.SetFinishedAt(*t)
+	}
+	return bruo
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (bruo *BackupRunUpdateOne) ClearFinishedAt() *BackupRunUpdateOne {
+	bruo.mutation.ClearFinishedAt()
+	return bruo
+}
+
+// AddPlaylistSnapshotIDs adds the "playlist_snapshots" edge to the PlaylistSnapshot entity by IDs.
+func (bruo *BackupRunUpdateOne) AddPlaylistSnapshotIDs(ids ...string) *BackupRunUpdateOne {
+	bruo.mutation.AddPlaylistSnapshotIDs(ids...)
+	return bruo
+}
+
+// AddPlaylistSnapshots adds the "playlist_snapshots" edges to the PlaylistSnapshot entity.
+func (bruo *BackupRunUpdateOne) AddPlaylistSnapshots(p ...*PlaylistSnapshot) *BackupRunUpdateOne {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return bruo.AddPlaylistSnapshotIDs(ids...)
+}
+
+// AddSavedTrackIDs adds the "saved_tracks" edge to the SavedTrack entity by IDs.
+func (bruo *BackupRunUpdateOne) AddSavedTrackIDs(ids ...string) *BackupRunUpdateOne {
+	bruo.mutation.AddSavedTrackIDs(ids...)
+	return bruo
+}
+
+// AddSavedTracks adds the "saved_tracks" edges to the SavedTrack entity.
+func (bruo *BackupRunUpdateOne) AddSavedTracks(s ...*SavedTrack) *BackupRunUpdateOne {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return bruo.AddSavedTrackIDs(ids...)
+}
+
+// AddSavedAlbumIDs adds the "saved_albums" edge to the SavedAlbum entity by IDs.
+func (bruo *BackupRunUpdateOne) AddSavedAlbumIDs(ids ...string) *BackupRunUpdateOne {
+	bruo.mutation.AddSavedAlbumIDs(ids...)
+	return bruo
+}
+
+// AddSavedAlbums adds the "saved_albums" edges to the SavedAlbum entity.
+func (bruo *BackupRunUpdateOne) AddSavedAlbums(s ...*SavedAlbum) *BackupRunUpdateOne {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return bruo.AddSavedAlbumIDs(ids...)
+}
+
+// AddSavedShowIDs adds the "saved_shows" edge to the SavedShow entity by IDs.
+func (bruo *BackupRunUpdateOne) AddSavedShowIDs(ids ...string) *BackupRunUpdateOne {
+	bruo.mutation.AddSavedShowIDs(ids...)
+	return bruo
+}
+
+// AddSavedShows adds the "saved_shows" edges to the SavedShow entity.
+func (bruo *BackupRunUpdateOne) AddSavedShows(s ...*SavedShow) *BackupRunUpdateOne {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return bruo.AddSavedShowIDs(ids...)
+}
+
+// AddSavedEpisodeIDs adds the "saved_episodes" edge to the SavedEpisode entity by IDs.
+func (bruo *BackupRunUpdateOne) AddSavedEpisodeIDs(ids ...string) *BackupRunUpdateOne {
+	bruo.mutation.AddSavedEpisodeIDs(ids...)
+	return bruo
+}
+
+// AddSavedEpisodes adds the "saved_episodes" edges to the SavedEpisode entity.
+func (bruo *BackupRunUpdateOne) AddSavedEpisodes(s ...*SavedEpisode) *BackupRunUpdateOne {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return bruo.AddSavedEpisodeIDs(ids...)
+}
+
+// AddSavedAudiobookIDs adds the "saved_audiobooks" edge to the SavedAudiobook entity by IDs.
+func (bruo *BackupRunUpdateOne) AddSavedAudiobookIDs(ids ...string) *BackupRunUpdateOne {
+	bruo.mutation.AddSavedAudiobookIDs(ids...)
+	return bruo
+}
+
+// AddSavedAudiobooks adds the "saved_audiobooks" edges to the SavedAudiobook entity.
+func (bruo *BackupRunUpdateOne) AddSavedAudiobooks(s ...*SavedAudiobook) *BackupRunUpdateOne {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return bruo.AddSavedAudiobookIDs(ids...)
+}
+
+// AddFollowedArtistIDs adds the "followed_artists" edge to the FollowedArtist entity by IDs.
+func (bruo *BackupRunUpdateOne) AddFollowedArtistIDs(ids ...string) *BackupRunUpdateOne {
+	bruo.mutation.AddFollowedArtistIDs(ids...)
+	return bruo
+}
+
+// AddFollowedArtists adds the "followed_artists" edges to the FollowedArtist entity.
+func (bruo *BackupRunUpdateOne) AddFollowedArtists(f ...*FollowedArtist) *BackupRunUpdateOne {
+	ids := make([]string, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return bruo.AddFollowedArtistIDs(ids...)
+}
+
+// Mutation returns the BackupRunMutation object of the builder.
+func (bruo *BackupRunUpdateOne) Mutation() *BackupRunMutation {
+	return bruo.mutation
+}
+
+// ClearPlaylistSnapshots clears all "playlist_snapshots" edges to the PlaylistSnapshot entity.
+func (bruo *BackupRunUpdateOne) ClearPlaylistSnapshots() *BackupRunUpdateOne {
+	bruo.mutation.ClearPlaylistSnapshots()
+	return bruo
+}
+
+// RemovePlaylistSnapshotIDs removes the "playlist_snapshots" edge to PlaylistSnapshot entities by IDs.
+func (bruo *BackupRunUpdateOne) RemovePlaylistSnapshotIDs(ids ...string) *BackupRunUpdateOne {
+	bruo.mutation.RemovePlaylistSnapshotIDs(ids...)
+	return bruo
+}
+
+// RemovePlaylistSnapshots removes "playlist_snapshots" edges to PlaylistSnapshot entities.
+func (bruo *BackupRunUpdateOne) RemovePlaylistSnapshots(p ...*PlaylistSnapshot) *BackupRunUpdateOne {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return bruo.RemovePlaylistSnapshotIDs(ids...)
+}
+
+// ClearSavedTracks clears all "saved_tracks" edges to the SavedTrack entity.
+func (bruo *BackupRunUpdateOne) ClearSavedTracks() *BackupRunUpdateOne {
+	bruo.mutation.ClearSavedTracks()
+	return bruo
+}
+
+// RemoveSavedTrackIDs removes the "saved_tracks" edge to SavedTrack entities by IDs.
+func (bruo *BackupRunUpdateOne) RemoveSavedTrackIDs(ids ...string) *BackupRunUpdateOne {
+	bruo.mutation.RemoveSavedTrackIDs(ids...)
+	return bruo
+}
+
+// RemoveSavedTracks removes "saved_tracks" edges to SavedTrack entities.
+func (bruo *BackupRunUpdateOne) RemoveSavedTracks(s ...*SavedTrack) *BackupRunUpdateOne {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return bruo.RemoveSavedTrackIDs(ids...)
+}
+
+// ClearSavedAlbums clears all "saved_albums" edges to the SavedAlbum entity.
+func (bruo *BackupRunUpdateOne) ClearSavedAlbums() *BackupRunUpdateOne {
+	bruo.mutation.ClearSavedAlbums()
+	return bruo
+}
+
+// RemoveSavedAlbumIDs removes the "saved_albums" edge to SavedAlbum entities by IDs.
+func (bruo *BackupRunUpdateOne) RemoveSavedAlbumIDs(ids ...string) *BackupRunUpdateOne {
+	bruo.mutation.RemoveSavedAlbumIDs(ids...)
+	return bruo
+}
+
+// RemoveSavedAlbums removes "saved_albums" edges to SavedAlbum entities.
+func (bruo *BackupRunUpdateOne) RemoveSavedAlbums(s ...*SavedAlbum) *BackupRunUpdateOne {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return bruo.RemoveSavedAlbumIDs(ids...)
+}
+
+// ClearSavedShows clears all "saved_shows" edges to the SavedShow entity.
+func (bruo *BackupRunUpdateOne) ClearSavedShows() *BackupRunUpdateOne {
+	bruo.mutation.ClearSavedShows()
+	return bruo
+}
+
+// RemoveSavedShowIDs removes the "saved_shows" edge to SavedShow entities by IDs.
+func (bruo *BackupRunUpdateOne) RemoveSavedShowIDs(ids ...string) *BackupRunUpdateOne {
+	bruo.mutation.RemoveSavedShowIDs(ids...)
+	return bruo
+}
+
+// RemoveSavedShows removes "saved_shows" edges to SavedShow entities.
+func (bruo *BackupRunUpdateOne) RemoveSavedShows(s ...*SavedShow) *BackupRunUpdateOne {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return bruo.RemoveSavedShowIDs(ids...)
+}
+
+// ClearSavedEpisodes clears all "saved_episodes" edges to the SavedEpisode entity.
+func (bruo *BackupRunUpdateOne) ClearSavedEpisodes() *BackupRunUpdateOne {
+	bruo.mutation.ClearSavedEpisodes()
+	return bruo
+}
+
+// RemoveSavedEpisodeIDs removes the "saved_episodes" edge to SavedEpisode entities by IDs.
+func (bruo *BackupRunUpdateOne) RemoveSavedEpisodeIDs(ids ...string) *BackupRunUpdateOne {
+	bruo.mutation.RemoveSavedEpisodeIDs(ids...)
+	return bruo
+}
+
+// RemoveSavedEpisodes removes "saved_episodes" edges to SavedEpisode entities.
+func (bruo *BackupRunUpdateOne) RemoveSavedEpisodes(s ...*SavedEpisode) *BackupRunUpdateOne {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return bruo.RemoveSavedEpisodeIDs(ids...)
+}
+
+// ClearSavedAudiobooks clears all "saved_audiobooks" edges to the SavedAudiobook entity.
+func (bruo *BackupRunUpdateOne) ClearSavedAudiobooks() *BackupRunUpdateOne {
+	bruo.mutation.ClearSavedAudiobooks()
+	return bruo
+}
+
+// RemoveSavedAudiobookIDs removes the "saved_audiobooks" edge to SavedAudiobook entities by IDs.
+func (bruo *BackupRunUpdateOne) RemoveSavedAudiobookIDs(ids ...string) *BackupRunUpdateOne {
+	bruo.mutation.RemoveSavedAudiobookIDs(ids...)
+	return bruo
+}
+
+// RemoveSavedAudiobooks removes "saved_audiobooks" edges to SavedAudiobook entities.
+func (bruo *BackupRunUpdateOne) RemoveSavedAudiobooks(s ...*SavedAudiobook) *BackupRunUpdateOne {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return bruo.RemoveSavedAudiobookIDs(ids...)
+}
+
+// ClearFollowedArtists clears all "followed_artists" edges to the FollowedArtist entity.
+func (bruo *BackupRunUpdateOne) ClearFollowedArtists() *BackupRunUpdateOne {
+	bruo.mutation.ClearFollowedArtists()
+	return bruo
+}
+
+// RemoveFollowedArtistIDs removes the "followed_artists" edge to FollowedArtist entities by IDs.
+func (bruo *BackupRunUpdateOne) RemoveFollowedArtistIDs(ids ...string) *BackupRunUpdateOne {
+	bruo.mutation.RemoveFollowedArtistIDs(ids...)
+	return bruo
+}
+
+// RemoveFollowedArtists removes "followed_artists" edges to FollowedArtist entities.
+func (bruo *BackupRunUpdateOne) RemoveFollowedArtists(f ...*FollowedArtist) *BackupRunUpdateOne {
+	ids := make([]string, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return bruo.RemoveFollowedArtistIDs(ids...)
+}
+
+// Where appends a list predicates to the BackupRunUpdate builder.
+func (bruo *BackupRunUpdateOne) Where(ps ...predicate.BackupRun) *BackupRunUpdateOne {
+	bruo.mutation.Where(ps...)
+	return bruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (bruo *BackupRunUpdateOne) Select(field string, fields ...string) *BackupRunUpdateOne {
+	bruo.fields = append([]string{field}, fields...)
+	return bruo
+}
+
+// Save executes the query and returns the updated BackupRun entity.
+func (bruo *BackupRunUpdateOne) Save(ctx context.Context) (*BackupRun, error) {
+	return withHooks(ctx, bruo.sqlSave, bruo.mutation, bruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bruo *BackupRunUpdateOne) SaveX(ctx context.Context) *BackupRun {
+	node, err := bruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (bruo *BackupRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := bruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bruo *BackupRunUpdateOne) ExecX(ctx context.Context) {
+	if err := bruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bruo *BackupRunUpdateOne) check() error {
+	if v, ok := bruo.mutation.Status(); ok {
+		if err := backuprun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "BackupRun.status": %w`, err)}
+		}
+	}
+	if v, ok := bruo.mutation.APICalls(); ok {
+		if err := backuprun.APICallsValidator(v); err != nil {
+			return &ValidationError{Name: "api_calls", err: fmt.Errorf(`ent: validator failed for field "BackupRun.api_calls": %w`, err)}
+		}
+	}
+	if v, ok := bruo.mutation.Resumes(); ok {
+		if err := backuprun.ResumesValidator(v); err != nil {
+			return &ValidationError{Name: "resumes", err: fmt.Errorf(`ent: validator failed for field "BackupRun.resumes": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (bruo *BackupRunUpdateOne) sqlSave(ctx context.Context) (_node *BackupRun, err error) {
+	if err := bruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(backuprun.Table, backuprun.Columns, sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeString))
+	id, ok := bruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BackupRun.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := bruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, backuprun.FieldID)
+		for _, f := range fields {
+			if !backuprun.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != backuprun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := bruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bruo.mutation.UserID(); ok {
+		_spec.SetField(backuprun.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := bruo.mutation.Status(); ok {
+		_spec.SetField(backuprun.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := bruo.mutation.Collections(); ok {
+		_spec.SetField(backuprun.FieldCollections, field.TypeJSON, value)
+	}
+	if value, ok := bruo.mutation.APICalls(); ok {
+		_spec.SetField(backuprun.FieldAPICalls, field.TypeInt, value)
+	}
+	if value, ok := bruo.mutation.AddedAPICalls(); ok {
+		_spec.AddField(backuprun.FieldAPICalls, field.TypeInt, value)
+	}
+	if value, ok := bruo.mutation.Error(); ok {
+		_spec.SetField(backuprun.FieldError, field.TypeString, value)
+	}
+	if value, ok := bruo.mutation.Checkpoint(); ok {
+		_spec.SetField(backuprun.FieldCheckpoint, field.TypeJSON, value)
+	}
+	if bruo.mutation.CheckpointCleared() {
+		_spec.ClearField(backuprun.FieldCheckpoint, field.TypeJSON)
+	}
+	if value, ok := bruo.mutation.Resumes(); ok {
+		_spec.SetField(backuprun.FieldResumes, field.TypeInt, value)
+	}
+	if value, ok := bruo.mutation.AddedResumes(); ok {
+		_spec.AddField(backuprun.FieldResumes, field.TypeInt, value)
+	}
+	if value, ok := bruo.mutation.Pinned(); ok {
+		_spec.SetField(backuprun.FieldPinned, field.TypeBool, value)
+	}
+	if value, ok := bruo.mutation.Note(); ok {
+		_spec.SetField(backuprun.FieldNote, field.TypeString, value)
+	}
+	if value, ok := bruo.mutation.Labels(); ok {
+		_spec.SetField(backuprun.FieldLabels, field.TypeJSON, value)
+	}
+	if value, ok := bruo.mutation.AppendedLabels(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, backuprun.FieldLabels, value)
+		})
+	}
+	if value, ok := bruo.mutation.FinishedAt(); ok {
+		_spec.SetField(backuprun.FieldFinishedAt, field.TypeTime, value)
+	}
+	if bruo.mutation.FinishedAtCleared() {
+		_spec.ClearField(backuprun.FieldFinishedAt, field.TypeTime)
+	}
+	if bruo.mutation.PlaylistSnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   backuprun.PlaylistSnapshotsTable,
+			Columns: backuprun.PlaylistSnapshotsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlistsnapshot.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bruo.mutation.RemovedPlaylistSnapshotsIDs(); len(nodes) > 0 && !bruo.mutation.PlaylistSnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   backuprun.PlaylistSnapshotsTable,
+			Columns: backuprun.PlaylistSnapshotsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlistsnapshot.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bruo.mutation.PlaylistSnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   backuprun.PlaylistSnapshotsTable,
+			Columns: backuprun.PlaylistSnapshotsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlistsnapshot.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bruo.mutation.SavedTracksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuprun.SavedTracksTable,
+			Columns: []string{backuprun.SavedTracksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedtrack.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bruo.mutation.RemovedSavedTracksIDs(); len(nodes) > 0 && !bruo.mutation.SavedTracksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuprun.SavedTracksTable,
+			Columns: []string{backuprun.SavedTracksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedtrack.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bruo.mutation.SavedTracksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuprun.SavedTracksTable,
+			Columns: []string{backuprun.SavedTracksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedtrack.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bruo.mutation.SavedAlbumsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuprun.SavedAlbumsTable,
+			Columns: []string{backuprun.SavedAlbumsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedalbum.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bruo.mutation.RemovedSavedAlbumsIDs(); len(nodes) > 0 && !bruo.mutation.SavedAlbumsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuprun.SavedAlbumsTable,
+			Columns: []string{backuprun.SavedAlbumsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedalbum.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bruo.mutation.SavedAlbumsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuprun.SavedAlbumsTable,
+			Columns: []string{backuprun.SavedAlbumsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedalbum.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bruo.mutation.SavedShowsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuprun.SavedShowsTable,
+			Columns: []string{backuprun.SavedShowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedshow.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bruo.mutation.RemovedSavedShowsIDs(); len(nodes) > 0 && !bruo.mutation.SavedShowsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuprun.SavedShowsTable,
+			Columns: []string{backuprun.SavedShowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedshow.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bruo.mutation.SavedShowsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuprun.SavedShowsTable,
+			Columns: []string{backuprun.SavedShowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedshow.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bruo.mutation.SavedEpisodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuprun.SavedEpisodesTable,
+			Columns: []string{backuprun.SavedEpisodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedepisode.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bruo.mutation.RemovedSavedEpisodesIDs(); len(nodes) > 0 && !bruo.mutation.SavedEpisodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuprun.SavedEpisodesTable,
+			Columns: []string{backuprun.SavedEpisodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedepisode.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bruo.mutation.SavedEpisodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuprun.SavedEpisodesTable,
+			Columns: []string{backuprun.SavedEpisodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedepisode.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bruo.mutation.SavedAudiobooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuprun.SavedAudiobooksTable,
+			Columns: []string{backuprun.SavedAudiobooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedaudiobook.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bruo.mutation.RemovedSavedAudiobooksIDs(); len(nodes) > 0 && !bruo.mutation.SavedAudiobooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuprun.SavedAudiobooksTable,
+			Columns: []string{backuprun.SavedAudiobooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedaudiobook.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bruo.mutation.SavedAudiobooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuprun.SavedAudiobooksTable,
+			Columns: []string{backuprun.SavedAudiobooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedaudiobook.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bruo.mutation.FollowedArtistsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuprun.FollowedArtistsTable,
+			Columns: []string{backuprun.FollowedArtistsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(followedartist.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bruo.mutation.RemovedFollowedArtistsIDs(); len(nodes) > 0 && !bruo.mutation.FollowedArtistsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuprun.FollowedArtistsTable,
+			Columns: []string{backuprun.FollowedArtistsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(followedartist.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bruo.mutation.FollowedArtistsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backuprun.FollowedArtistsTable,
+			Columns: []string{backuprun.FollowedArtistsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(followedartist.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &BackupRun{config: bruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, bruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{backuprun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	bruo.mutation.done = true
+	return _node, nil
+}
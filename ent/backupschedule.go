@@ -0,0 +1,200 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/backupschedule"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BackupSchedule is the model entity for the BackupSchedule schema.
+type BackupSchedule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// Cron holds the value of the "cron" field.
+	Cron string `json:"cron,omitempty"`
+	// TimeZone holds the value of the "time_zone" field.
+	TimeZone string `json:"time_zone,omitempty"`
+	// MisfirePolicy holds the value of the "misfire_policy" field.
+	MisfirePolicy backupschedule.MisfirePolicy `json:"misfire_policy,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// NextRunAt holds the value of the "next_run_at" field.
+	NextRunAt *time.Time `json:"next_run_at,omitempty"`
+	// LastRunAt holds the value of the "last_run_at" field.
+	LastRunAt *time.Time `json:"last_run_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BackupSchedule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case backupschedule.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case backupschedule.FieldID, backupschedule.FieldUserID, backupschedule.FieldCron, backupschedule.FieldTimeZone, backupschedule.FieldMisfirePolicy:
+			values[i] = new(sql.NullString)
+		case backupschedule.FieldNextRunAt, backupschedule.FieldLastRunAt, backupschedule.FieldCreatedAt, backupschedule.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BackupSchedule fields.
+func (bs *BackupSchedule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case backupschedule.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				bs.ID = value.String
+			}
+		case backupschedule.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				bs.UserID = value.String
+			}
+		case backupschedule.FieldCron:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cron", values[i])
+			} else if value.Valid {
+				bs.Cron = value.String
+			}
+		case backupschedule.FieldTimeZone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field time_zone", values[i])
+			} else if value.Valid {
+				bs.TimeZone = value.String
+			}
+		case backupschedule.FieldMisfirePolicy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field misfire_policy", values[i])
+			} else if value.Valid {
+				bs.MisfirePolicy = backupschedule.MisfirePolicy(value.String)
+			}
+		case backupschedule.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				bs.Enabled = value.Bool
+			}
+		case backupschedule.FieldNextRunAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_run_at", values[i])
+			} else if value.Valid {
+				bs.NextRunAt = new(time.Time)
+				*bs.NextRunAt = value.Time
+			}
+		case backupschedule.FieldLastRunAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_run_at", values[i])
+			} else if value.Valid {
+				bs.LastRunAt = new(time.Time)
+				*bs.LastRunAt = value.Time
+			}
+		case backupschedule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				bs.CreatedAt = value.Time
+			}
+		case backupschedule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				bs.UpdatedAt = value.Time
+			}
+		default:
+			bs.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BackupSchedule.
+// This includes values selected through modifiers, order, etc.
+func (bs *BackupSchedule) Value(name string) (ent.Value, error) {
+	return bs.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BackupSchedule.
+// Note that you need to call BackupSchedule.Unwrap() before calling this method if this BackupSchedule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (bs *BackupSchedule) Update() *BackupScheduleUpdateOne {
+	return NewBackupScheduleClient(bs.config).UpdateOne(bs)
+}
+
+// Unwrap unwraps the BackupSchedule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (bs *BackupSchedule) Unwrap() *BackupSchedule {
+	_tx, ok := bs.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BackupSchedule is not a transactional entity")
+	}
+	bs.config.driver = _tx.drv
+	return bs
+}
+
+// String implements the fmt.Stringer.
+func (bs *BackupSchedule) String() string {
+	var builder strings.Builder
+	builder.WriteString("BackupSchedule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", bs.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(bs.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("cron=")
+	builder.WriteString(bs.Cron)
+	builder.WriteString(", ")
+	builder.WriteString("time_zone=")
+	builder.WriteString(bs.TimeZone)
+	builder.WriteString(", ")
+	builder.WriteString("misfire_policy=")
+	builder.WriteString(fmt.Sprintf("%v", bs.MisfirePolicy))
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", bs.Enabled))
+	builder.WriteString(", ")
+	if v := bs.NextRunAt; v != nil {
+		builder.WriteString("next_run_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := bs.LastRunAt; v != nil {
+		builder.WriteString("last_run_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(bs.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(bs.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BackupSchedules is a parsable slice of BackupSchedule.
+type BackupSchedules []*BackupSchedule
@@ -0,0 +1,402 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/backupschedule"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BackupScheduleCreate is the builder for creating a BackupSchedule entity.
+type BackupScheduleCreate struct {
+	config
+	mutation *BackupScheduleMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (bsc *BackupScheduleCreate) SetUserID(s string) *BackupScheduleCreate {
+	bsc.mutation.SetUserID(s)
+	return bsc
+}
+
+// SetCron sets the "cron" field.
+func (bsc *BackupScheduleCreate) SetCron(s string) *BackupScheduleCreate {
+	bsc.mutation.SetCron(s)
+	return bsc
+}
+
+// SetTimeZone sets the "time_zone" field.
+func (bsc *BackupScheduleCreate) SetTimeZone(s string) *BackupScheduleCreate {
+	bsc.mutation.SetTimeZone(s)
+	return bsc
+}
+
+// SetNillableTimeZone sets the "time_zone" field if the given value is not nil.
+func (bsc *BackupScheduleCreate) SetNillableTimeZone(s *string) *BackupScheduleCreate {
+	if s != nil {
+		bsc.SetTimeZone(*s)
+	}
+	return bsc
+}
+
+// SetMisfirePolicy sets the "misfire_policy" field.
+func (bsc *BackupScheduleCreate) SetMisfirePolicy(bp backupschedule.MisfirePolicy) *BackupScheduleCreate {
+	bsc.mutation.SetMisfirePolicy(bp)
+	return bsc
+}
+
+// SetNillableMisfirePolicy sets the "misfire_policy" field if the given value is not nil.
+func (bsc *BackupScheduleCreate) SetNillableMisfirePolicy(bp *backupschedule.MisfirePolicy) *BackupScheduleCreate {
+	if bp != nil {
+		bsc.SetMisfirePolicy(*bp)
+	}
+	return bsc
+}
+
+// SetEnabled sets the "enabled" field.
+func (bsc *BackupScheduleCreate) SetEnabled(b bool) *BackupScheduleCreate {
+	bsc.mutation.SetEnabled(b)
+	return bsc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (bsc *BackupScheduleCreate) SetNillableEnabled(b *bool) *BackupScheduleCreate {
+	if b != nil {
+		bsc.SetEnabled(*b)
+	}
+	return bsc
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (bsc *BackupScheduleCreate) SetNextRunAt(t time.Time) *BackupScheduleCreate {
+	bsc.mutation.SetNextRunAt(t)
+	return bsc
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (bsc *BackupScheduleCreate) SetNillableNextRunAt(t *time.Time) *BackupScheduleCreate {
+	if t != nil {
+		bsc.SetNextRunAt(*t)
+	}
+	return bsc
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (bsc *BackupScheduleCreate) SetLastRunAt(t time.Time) *BackupScheduleCreate {
+	bsc.mutation.SetLastRunAt(t)
+	return bsc
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (bsc *BackupScheduleCreate) SetNillableLastRunAt(t *time.Time) *BackupScheduleCreate {
+	if t != nil {
+		bsc.SetLastRunAt(*t)
+	}
+	return bsc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (bsc *BackupScheduleCreate) SetCreatedAt(t time.Time) *BackupScheduleCreate {
+	bsc.mutation.SetCreatedAt(t)
+	return bsc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (bsc *BackupScheduleCreate) SetNillableCreatedAt(t *time.Time) *BackupScheduleCreate {
+	if t != nil {
+		bsc.SetCreatedAt(*t)
+	}
+	return bsc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (bsc *BackupScheduleCreate) SetUpdatedAt(t time.Time) *BackupScheduleCreate {
+	bsc.mutation.SetUpdatedAt(t)
+	return bsc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (bsc *BackupScheduleCreate) SetNillableUpdatedAt(t *time.Time) *BackupScheduleCreate {
+	if t != nil {
+		bsc.SetUpdatedAt(*t)
+	}
+	return bsc
+}
+
+// SetID sets the "id" field.
+func (bsc *BackupScheduleCreate) SetID(s string) *BackupScheduleCreate {
+	bsc.mutation.SetID(s)
+	return bsc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (bsc *BackupScheduleCreate) SetNillableID(s *string) *BackupScheduleCreate {
+	if s != nil {
+		bsc.SetID(*s)
+	}
+	return bsc
+}
+
+// Mutation returns the BackupScheduleMutation object of the builder.
+func (bsc *BackupScheduleCreate) Mutation() *BackupScheduleMutation {
+	return bsc.mutation
+}
+
+// Save creates the BackupSchedule in the database.
+func (bsc *BackupScheduleCreate) Save(ctx context.Context) (*BackupSchedule, error) {
+	bsc.defaults()
+	return withHooks(ctx, bsc.sqlSave, bsc.mutation, bsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (bsc *BackupScheduleCreate) SaveX(ctx context.Context) *BackupSchedule {
+	v, err := bsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bsc *BackupScheduleCreate) Exec(ctx context.Context) error {
+	_, err := bsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bsc *BackupScheduleCreate) ExecX(ctx context.Context) {
+	if err := bsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bsc *BackupScheduleCreate) defaults() {
+	if _, ok := bsc.mutation.TimeZone(); !ok {
+		v := backupschedule.DefaultTimeZone
+		bsc.mutation.SetTimeZone(v)
+	}
+	if _, ok := bsc.mutation.MisfirePolicy(); !ok {
+		v := backupschedule.DefaultMisfirePolicy
+		bsc.mutation.SetMisfirePolicy(v)
+	}
+	if _, ok := bsc.mutation.Enabled(); !ok {
+		v := backupschedule.DefaultEnabled
+		bsc.mutation.SetEnabled(v)
+	}
+	if _, ok := bsc.mutation.CreatedAt(); !ok {
+		v := backupschedule.DefaultCreatedAt()
+		bsc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := bsc.mutation.UpdatedAt(); !ok {
+		v := backupschedule.DefaultUpdatedAt()
+		bsc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := bsc.mutation.ID(); !ok {
+		v := backupschedule.DefaultID()
+		bsc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bsc *BackupScheduleCreate) check() error {
+	if _, ok := bsc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "BackupSchedule.user_id"`)}
+	}
+	if v, ok := bsc.mutation.UserID(); ok {
+		if err := backupschedule.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "BackupSchedule.user_id": %w`, err)}
+		}
+	}
+	if _, ok := bsc.mutation.Cron(); !ok {
+		return &ValidationError{Name: "cron", err: errors.New(`ent: missing required field "BackupSchedule.cron"`)}
+	}
+	if v, ok := bsc.mutation.Cron(); ok {
+		if err := backupschedule.CronValidator(v); err != nil {
+			return &ValidationError{Name: "cron", err: fmt.Errorf(`ent: validator failed for field "BackupSchedule.cron": %w`, err)}
+		}
+	}
+	if _, ok := bsc.mutation.TimeZone(); !ok {
+		return &ValidationError{Name: "time_zone", err: errors.New(`ent: missing required field "BackupSchedule.time_zone"`)}
+	}
+	if _, ok := bsc.mutation.MisfirePolicy(); !ok {
+		return &ValidationError{Name: "misfire_policy", err: errors.New(`ent: missing required field "BackupSchedule.misfire_policy"`)}
+	}
+	if v, ok := bsc.mutation.MisfirePolicy(); ok {
+		if err := backupschedule.MisfirePolicyValidator(v); err != nil {
+			return &ValidationError{Name: "misfire_policy", err: fmt.Errorf(`ent: validator failed for field "BackupSchedule.misfire_policy": %w`, err)}
+		}
+	}
+	if _, ok := bsc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "BackupSchedule.enabled"`)}
+	}
+	if _, ok := bsc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BackupSchedule.created_at"`)}
+	}
+	if _, ok := bsc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "BackupSchedule.updated_at"`)}
+	}
+	return nil
+}
+
+func (bsc *BackupScheduleCreate) sqlSave(ctx context.Context) (*BackupSchedule, error) {
+	if err := bsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := bsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, bsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected BackupSchedule.ID type: %T", _spec.ID.Value)
+		}
+	}
+	bsc.mutation.id = &_node.ID
+	bsc.mutation.done = true
+	return _node, nil
+}
+
+func (bsc *BackupScheduleCreate) createSpec() (*BackupSchedule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BackupSchedule{config: bsc.config}
+		_spec = sqlgraph.NewCreateSpec(backupschedule.Table, sqlgraph.NewFieldSpec(backupschedule.FieldID, field.TypeString))
+	)
+	if id, ok := bsc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := bsc.mutation.UserID(); ok {
+		_spec.SetField(backupschedule.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := bsc.mutation.Cron(); ok {
+		_spec.SetField(backupschedule.FieldCron, field.TypeString, value)
+		_node.Cron = value
+	}
+	if value, ok := bsc.mutation.TimeZone(); ok {
+		_spec.SetField(backupschedule.FieldTimeZone, field.TypeString, value)
+		_node.TimeZone = value
+	}
+	if value, ok := bsc.mutation.MisfirePolicy(); ok {
+		_spec.SetField(backupschedule.FieldMisfirePolicy, field.TypeEnum, value)
+		_node.MisfirePolicy = value
+	}
+	if value, ok := bsc.mutation.Enabled(); ok {
+		_spec.SetField(backupschedule.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := bsc.mutation.NextRunAt(); ok {
+		_spec.SetField(backupschedule.FieldNextRunAt, field.TypeTime, value)
+		_node.NextRunAt = &value
+	}
+	if value, ok := bsc.mutation.LastRunAt(); ok {
+		_spec.SetField(backupschedule.FieldLastRunAt, field.TypeTime, value)
+		_node.LastRunAt = &value
+	}
+	if value, ok := bsc.mutation.CreatedAt(); ok {
+		_spec.SetField(backupschedule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := bsc.mutation.UpdatedAt(); ok {
+		_spec.SetField(backupschedule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// BackupScheduleCreateBulk is the builder for creating many BackupSchedule entities in bulk.
+type BackupScheduleCreateBulk struct {
+	config
+	err      error
+	builders []*BackupScheduleCreate
+}
+
+// Save creates the BackupSchedule entities in the database.
+func (bscb *BackupScheduleCreateBulk) Save(ctx context.Context) ([]*BackupSchedule, error) {
+	if bscb.err != nil {
+		return nil, bscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(bscb.builders))
+	nodes := make([]*BackupSchedule, len(bscb.builders))
+	mutators := make([]Mutator, len(bscb.builders))
+	for i := range bscb.builders {
+		func(i int, root context.Context) {
+			builder := bscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BackupScheduleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, bscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, bscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, bscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bscb *BackupScheduleCreateBulk) SaveX(ctx context.Context) []*BackupSchedule {
+	v, err := bscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bscb *BackupScheduleCreateBulk) Exec(ctx context.Context) error {
+	_, err := bscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bscb *BackupScheduleCreateBulk) ExecX(ctx context.Context) {
+	if err := bscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
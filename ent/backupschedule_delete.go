@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/backupschedule"
+	"beyerleinf/spotify-backup/ent/predicate"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BackupScheduleDelete is the builder for deleting a BackupSchedule entity.
+type BackupScheduleDelete struct {
+	config
+	hooks    []Hook
+	mutation *BackupScheduleMutation
+}
+
+// Where appends a list predicates to the BackupScheduleDelete builder.
+func (bsd *BackupScheduleDelete) Where(ps ...predicate.BackupSchedule) *BackupScheduleDelete {
+	bsd.mutation.Where(ps...)
+	return bsd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (bsd *BackupScheduleDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, bsd.sqlExec, bsd.mutation, bsd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bsd *BackupScheduleDelete) ExecX(ctx context.Context) int {
+	n, err := bsd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (bsd *BackupScheduleDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(backupschedule.Table, sqlgraph.NewFieldSpec(backupschedule.FieldID, field.TypeString))
+	if ps := bsd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, bsd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	bsd.mutation.done = true
+	return affected, err
+}
+
+// BackupScheduleDeleteOne is the builder for deleting a single BackupSchedule entity.
+type BackupScheduleDeleteOne struct {
+	bsd *BackupScheduleDelete
+}
+
+// Where appends a list predicates to the BackupScheduleDelete builder.
+func (bsdo *BackupScheduleDeleteOne) Where(ps ...predicate.BackupSchedule) *BackupScheduleDeleteOne {
+	bsdo.bsd.mutation.Where(ps...)
+	return bsdo
+}
+
+// Exec executes the deletion query.
+func (bsdo *BackupScheduleDeleteOne) Exec(ctx context.Context) error {
+	n, err := bsdo.bsd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{backupschedule.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bsdo *BackupScheduleDeleteOne) ExecX(ctx context.Context) {
+	if err := bsdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
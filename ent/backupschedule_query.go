@@ -0,0 +1,564 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/backupschedule"
+	"beyerleinf/spotify-backup/ent/predicate"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BackupScheduleQuery is the builder for querying BackupSchedule entities.
+type BackupScheduleQuery struct {
+	config
+	ctx        *QueryContext
+	order      []backupschedule.OrderOption
+	inters     []Interceptor
+	predicates []predicate.BackupSchedule
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the BackupScheduleQuery builder.
+func (bsq *BackupScheduleQuery) Where(ps ...predicate.BackupSchedule) *BackupScheduleQuery {
+	bsq.predicates = append(bsq.predicates, ps...)
+	return bsq
+}
+
+// Limit the number of records to be returned by this query.
+func (bsq *BackupScheduleQuery) Limit(limit int) *BackupScheduleQuery {
+	bsq.ctx.Limit = &limit
+	return bsq
+}
+
+// Offset to start from.
+func (bsq *BackupScheduleQuery) Offset(offset int) *BackupScheduleQuery {
+	bsq.ctx.Offset = &offset
+	return bsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (bsq *BackupScheduleQuery) Unique(unique bool) *BackupScheduleQuery {
+	bsq.ctx.Unique = &unique
+	return bsq
+}
+
+// Order specifies how the records should be ordered.
+func (bsq *BackupScheduleQuery) Order(o ...backupschedule.OrderOption) *BackupScheduleQuery {
+	bsq.order = append(bsq.order, o...)
+	return bsq
+}
+
+// First returns the first BackupSchedule entity from the query.
+// Returns a *NotFoundError when no BackupSchedule was found.
+func (bsq *BackupScheduleQuery) First(ctx context.Context) (*BackupSchedule, error) {
+	nodes, err := bsq.Limit(1).All(setContextOp(ctx, bsq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{backupschedule.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (bsq *BackupScheduleQuery) FirstX(ctx context.Context) *BackupSchedule {
+	node, err := bsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first BackupSchedule ID from the query.
+// Returns a *NotFoundError when no BackupSchedule ID was found.
+func (bsq *BackupScheduleQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = bsq.Limit(1).IDs(setContextOp(ctx, bsq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{backupschedule.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (bsq *BackupScheduleQuery) FirstIDX(ctx context.Context) string {
+	id, err := bsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single BackupSchedule entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one BackupSchedule entity is found.
+// Returns a *NotFoundError when no BackupSchedule entities are found.
+func (bsq *BackupScheduleQuery) Only(ctx context.Context) (*BackupSchedule, error) {
+	nodes, err := bsq.Limit(2).All(setContextOp(ctx, bsq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{backupschedule.Label}
+	default:
+		return nil, &NotSingularError{backupschedule.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (bsq *BackupScheduleQuery) OnlyX(ctx context.Context) *BackupSchedule {
+	node, err := bsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only BackupSchedule ID in the query.
+// Returns a *NotSingularError when more than one BackupSchedule ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (bsq *BackupScheduleQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = bsq.Limit(2).IDs(setContextOp(ctx, bsq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{backupschedule.Label}
+	default:
+		err = &NotSingularError{backupschedule.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (bsq *BackupScheduleQuery) OnlyIDX(ctx context.Context) string {
+	id, err := bsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of BackupSchedules.
+func (bsq *BackupScheduleQuery) All(ctx context.Context) ([]*BackupSchedule, error) {
+	ctx = setContextOp(ctx, bsq.ctx, ent.OpQueryAll)
+	if err := bsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*BackupSchedule, *BackupScheduleQuery]()
+	return withInterceptors[[]*BackupSchedule](ctx, bsq, qr, bsq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (bsq *BackupScheduleQuery) AllX(ctx context.Context) []*BackupSchedule {
+	nodes, err := bsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of BackupSchedule IDs.
+func (bsq *BackupScheduleQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if bsq.ctx.Unique == nil && bsq.path != nil {
+		bsq.Unique(true)
+	}
+	ctx = setContextOp(ctx, bsq.ctx, ent.OpQueryIDs)
+	if err = bsq.Select(backupschedule.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (bsq *BackupScheduleQuery) IDsX(ctx context.Context) []string {
+	ids, err := bsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (bsq *BackupScheduleQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, bsq.ctx, ent.OpQueryCount)
+	if err := bsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, bsq, querierCount[*BackupScheduleQuery](), bsq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (bsq *BackupScheduleQuery) CountX(ctx context.Context) int {
+	count, err := bsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (bsq *BackupScheduleQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, bsq.ctx, ent.OpQueryExist)
+	switch _, err := bsq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (bsq *BackupScheduleQuery) ExistX(ctx context.Context) bool {
+	exist, err := bsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the BackupScheduleQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (bsq *BackupScheduleQuery) Clone() *BackupScheduleQuery {
+	if bsq == nil {
+		return nil
+	}
+	return &BackupScheduleQuery{
+		config:     bsq.config,
+		ctx:        bsq.ctx.Clone(),
+		order:      append([]backupschedule.OrderOption{}, bsq.order...),
+		inters:     append([]Interceptor{}, bsq.inters...),
+		predicates: append([]predicate.BackupSchedule{}, bsq.predicates...),
+		// clone intermediate query.
+		sql:  bsq.sql.Clone(),
+		path: bsq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID string `json:"user_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.BackupSchedule.Query().
+//		GroupBy(backupschedule.FieldUserID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (bsq *BackupScheduleQuery) GroupBy(field string, fields ...string) *BackupScheduleGroupBy {
+	bsq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &BackupScheduleGroupBy{build: bsq}
+	grbuild.flds = &bsq.ctx.Fields
+	grbuild.label = backupschedule.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID string `json:"user_id,omitempty"`
+//	}
+//
+//	client.BackupSchedule.Query().
+//		Select(backupschedule.FieldUserID).
+//		Scan(ctx, &v)
+func (bsq *BackupScheduleQuery) Select(fields ...string) *BackupScheduleSelect {
+	bsq.ctx.Fields = append(bsq.ctx.Fields, fields...)
+	sbuild := &BackupScheduleSelect{BackupScheduleQuery: bsq}
+	sbuild.label = backupschedule.Label
+	sbuild.flds, sbuild.scan = &bsq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a BackupScheduleSelect configured with the given aggregations.
+func (bsq *BackupScheduleQuery) Aggregate(fns ...AggregateFunc) *BackupScheduleSelect {
+	return bsq.Select().Aggregate(fns...)
+}
+
+func (bsq *BackupScheduleQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range bsq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, bsq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range bsq.ctx.Fields {
+		if !backupschedule.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if bsq.path != nil {
+		prev, err := bsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		bsq.sql = prev
+	}
+	return nil
+}
+
+func (bsq *BackupScheduleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*BackupSchedule, error) {
+	var (
+		nodes = []*BackupSchedule{}
+		_spec = bsq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*BackupSchedule).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &BackupSchedule{config: bsq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(bsq.modifiers) > 0 {
+		_spec.Modifiers = bsq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, bsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (bsq *BackupScheduleQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := bsq.querySpec()
+	if len(bsq.modifiers) > 0 {
+		_spec.Modifiers = bsq.modifiers
+	}
+	_spec.Node.Columns = bsq.ctx.Fields
+	if len(bsq.ctx.Fields) > 0 {
+		_spec.Unique = bsq.ctx.Unique != nil && *bsq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, bsq.driver, _spec)
+}
+
+func (bsq *BackupScheduleQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(backupschedule.Table, backupschedule.Columns, sqlgraph.NewFieldSpec(backupschedule.FieldID, field.TypeString))
+	_spec.From = bsq.sql
+	if unique := bsq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if bsq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := bsq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, backupschedule.FieldID)
+		for i := range fields {
+			if fields[i] != backupschedule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := bsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := bsq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := bsq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := bsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (bsq *BackupScheduleQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(bsq.driver.Dialect())
+	t1 := builder.Table(backupschedule.Table)
+	columns := bsq.ctx.Fields
+	if len(columns) == 0 {
+		columns = backupschedule.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if bsq.sql != nil {
+		selector = bsq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if bsq.ctx.Unique != nil && *bsq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range bsq.modifiers {
+		m(selector)
+	}
+	for _, p := range bsq.predicates {
+		p(selector)
+	}
+	for _, p := range bsq.order {
+		p(selector)
+	}
+	if offset := bsq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := bsq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (bsq *BackupScheduleQuery) ForUpdate(opts ...sql.LockOption) *BackupScheduleQuery {
+	if bsq.driver.Dialect() == dialect.Postgres {
+		bsq.Unique(false)
+	}
+	bsq.modifiers = append(bsq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return bsq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (bsq *BackupScheduleQuery) ForShare(opts ...sql.LockOption) *BackupScheduleQuery {
+	if bsq.driver.Dialect() == dialect.Postgres {
+		bsq.Unique(false)
+	}
+	bsq.modifiers = append(bsq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return bsq
+}
+
+// BackupScheduleGroupBy is the group-by builder for BackupSchedule entities.
+type BackupScheduleGroupBy struct {
+	selector
+	build *BackupScheduleQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (bsgb *BackupScheduleGroupBy) Aggregate(fns ...AggregateFunc) *BackupScheduleGroupBy {
+	bsgb.fns = append(bsgb.fns, fns...)
+	return bsgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (bsgb *BackupScheduleGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, bsgb.build.ctx, ent.OpQueryGroupBy)
+	if err := bsgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BackupScheduleQuery, *BackupScheduleGroupBy](ctx, bsgb.build, bsgb, bsgb.build.inters, v)
+}
+
+func (bsgb *BackupScheduleGroupBy) sqlScan(ctx context.Context, root *BackupScheduleQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(bsgb.fns))
+	for _, fn := range bsgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*bsgb.flds)+len(bsgb.fns))
+		for _, f := range *bsgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*bsgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := bsgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// BackupScheduleSelect is the builder for selecting fields of BackupSchedule entities.
+type BackupScheduleSelect struct {
+	*BackupScheduleQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (bss *BackupScheduleSelect) Aggregate(fns ...AggregateFunc) *BackupScheduleSelect {
+	bss.fns = append(bss.fns, fns...)
+	return bss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (bss *BackupScheduleSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, bss.ctx, ent.OpQuerySelect)
+	if err := bss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BackupScheduleQuery, *BackupScheduleSelect](ctx, bss.BackupScheduleQuery, bss, bss.inters, v)
+}
+
+func (bss *BackupScheduleSelect) sqlScan(ctx context.Context, root *BackupScheduleQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(bss.fns))
+	for _, fn := range bss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*bss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := bss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
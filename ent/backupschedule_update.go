@@ -0,0 +1,488 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/backupschedule"
+	"beyerleinf/spotify-backup/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BackupScheduleUpdate is the builder for updating BackupSchedule entities.
+type BackupScheduleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BackupScheduleMutation
+}
+
+// Where appends a list predicates to the BackupScheduleUpdate builder.
+func (bsu *BackupScheduleUpdate) Where(ps ...predicate.BackupSchedule) *BackupScheduleUpdate {
+	bsu.mutation.Where(ps...)
+	return bsu
+}
+
+// SetCron sets the "cron" field.
+func (bsu *BackupScheduleUpdate) SetCron(s string) *BackupScheduleUpdate {
+	bsu.mutation.SetCron(s)
+	return bsu
+}
+
+// SetNillableCron sets the "cron" field if the given value is not nil.
+func (bsu *BackupScheduleUpdate) SetNillableCron(s *string) *BackupScheduleUpdate {
+	if s != nil {
+		bsu.SetCron(*s)
+	}
+	return bsu
+}
+
+// SetTimeZone sets the "time_zone" field.
+func (bsu *BackupScheduleUpdate) SetTimeZone(s string) *BackupScheduleUpdate {
+	bsu.mutation.SetTimeZone(s)
+	return bsu
+}
+
+// SetNillableTimeZone sets the "time_zone" field if the given value is not nil.
+func (bsu *BackupScheduleUpdate) SetNillableTimeZone(s *string) *BackupScheduleUpdate {
+	if s != nil {
+		bsu.SetTimeZone(*s)
+	}
+	return bsu
+}
+
+// SetMisfirePolicy sets the "misfire_policy" field.
+func (bsu *BackupScheduleUpdate) SetMisfirePolicy(bp backupschedule.MisfirePolicy) *BackupScheduleUpdate {
+	bsu.mutation.SetMisfirePolicy(bp)
+	return bsu
+}
+
+// SetNillableMisfirePolicy sets the "misfire_policy" field if the given value is not nil.
+func (bsu *BackupScheduleUpdate) SetNillableMisfirePolicy(bp *backupschedule.MisfirePolicy) *BackupScheduleUpdate {
+	if bp != nil {
+		bsu.SetMisfirePolicy(*bp)
+	}
+	return bsu
+}
+
+// SetEnabled sets the "enabled" field.
+func (bsu *BackupScheduleUpdate) SetEnabled(b bool) *BackupScheduleUpdate {
+	bsu.mutation.SetEnabled(b)
+	return bsu
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (bsu *BackupScheduleUpdate) SetNillableEnabled(b *bool) *BackupScheduleUpdate {
+	if b != nil {
+		bsu.SetEnabled(*b)
+	}
+	return bsu
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (bsu *BackupScheduleUpdate) SetNextRunAt(t time.Time) *BackupScheduleUpdate {
+	bsu.mutation.SetNextRunAt(t)
+	return bsu
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (bsu *BackupScheduleUpdate) SetNillableNextRunAt(t *time.Time) *BackupScheduleUpdate {
+	if t != nil {
+		bsu.SetNextRunAt(*t)
+	}
+	return bsu
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (bsu *BackupScheduleUpdate) ClearNextRunAt() *BackupScheduleUpdate {
+	bsu.mutation.ClearNextRunAt()
+	return bsu
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (bsu *BackupScheduleUpdate) SetLastRunAt(t time.Time) *BackupScheduleUpdate {
+	bsu.mutation.SetLastRunAt(t)
+	return bsu
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (bsu *BackupScheduleUpdate) SetNillableLastRunAt(t *time.Time) *BackupScheduleUpdate {
+	if t != nil {
+		bsu.SetLastRunAt(*t)
+	}
+	return bsu
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (bsu *BackupScheduleUpdate) ClearLastRunAt() *BackupScheduleUpdate {
+	bsu.mutation.ClearLastRunAt()
+	return bsu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (bsu *BackupScheduleUpdate) SetUpdatedAt(t time.Time) *BackupScheduleUpdate {
+	bsu.mutation.SetUpdatedAt(t)
+	return bsu
+}
+
+// Mutation returns the BackupScheduleMutation object of the builder.
+func (bsu *BackupScheduleUpdate) Mutation() *BackupScheduleMutation {
+	return bsu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (bsu *BackupScheduleUpdate) Save(ctx context.Context) (int, error) {
+	bsu.defaults()
+	return withHooks(ctx, bsu.sqlSave, bsu.mutation, bsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bsu *BackupScheduleUpdate) SaveX(ctx context.Context) int {
+	affected, err := bsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (bsu *BackupScheduleUpdate) Exec(ctx context.Context) error {
+	_, err := bsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bsu *BackupScheduleUpdate) ExecX(ctx context.Context) {
+	if err := bsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bsu *BackupScheduleUpdate) defaults() {
+	if _, ok := bsu.mutation.UpdatedAt(); !ok {
+		v := backupschedule.UpdateDefaultUpdatedAt()
+		bsu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bsu *BackupScheduleUpdate) check() error {
+	if v, ok := bsu.mutation.Cron(); ok {
+		if err := backupschedule.CronValidator(v); err != nil {
+			return &ValidationError{Name: "cron", err: fmt.Errorf(`ent: validator failed for field "BackupSchedule.cron": %w`, err)}
+		}
+	}
+	if v, ok := bsu.mutation.MisfirePolicy(); ok {
+		if err := backupschedule.MisfirePolicyValidator(v); err != nil {
+			return &ValidationError{Name: "misfire_policy", err: fmt.Errorf(`ent: validator failed for field "BackupSchedule.misfire_policy": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (bsu *BackupScheduleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := bsu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(backupschedule.Table, backupschedule.Columns, sqlgraph.NewFieldSpec(backupschedule.FieldID, field.TypeString))
+	if ps := bsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bsu.mutation.Cron(); ok {
+		_spec.SetField(backupschedule.FieldCron, field.TypeString, value)
+	}
+	if value, ok := bsu.mutation.TimeZone(); ok {
+		_spec.SetField(backupschedule.FieldTimeZone, field.TypeString, value)
+	}
+	if value, ok := bsu.mutation.MisfirePolicy(); ok {
+		_spec.SetField(backupschedule.FieldMisfirePolicy, field.TypeEnum, value)
+	}
+	if value, ok := bsu.mutation.Enabled(); ok {
+		_spec.SetField(backupschedule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := bsu.mutation.NextRunAt(); ok {
+		_spec.SetField(backupschedule.FieldNextRunAt, field.TypeTime, value)
+	}
+	if bsu.mutation.NextRunAtCleared() {
+		_spec.ClearField(backupschedule.FieldNextRunAt, field.TypeTime)
+	}
+	if value, ok := bsu.mutation.LastRunAt(); ok {
+		_spec.SetField(backupschedule.FieldLastRunAt, field.TypeTime, value)
+	}
+	if bsu.mutation.LastRunAtCleared() {
+		_spec.ClearField(backupschedule.FieldLastRunAt, field.TypeTime)
+	}
+	if value, ok := bsu.mutation.UpdatedAt(); ok {
+		_spec.SetField(backupschedule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, bsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{backupschedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	bsu.mutation.done = true
+	return n, nil
+}
+
+// BackupScheduleUpdateOne is the builder for updating a single BackupSchedule entity.
+type BackupScheduleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BackupScheduleMutation
+}
+
+// SetCron sets the "cron" field.
+func (bsuo *BackupScheduleUpdateOne) SetCron(s string) *BackupScheduleUpdateOne {
+	bsuo.mutation.SetCron(s)
+	return bsuo
+}
+
+// SetNillableCron sets the "cron" field if the given value is not nil.
+func (bsuo *BackupScheduleUpdateOne) SetNillableCron(s *string) *BackupScheduleUpdateOne {
+	if s != nil {
+		bsuo.SetCron(*s)
+	}
+	return bsuo
+}
+
+// SetTimeZone sets the "time_zone" field.
+func (bsuo *BackupScheduleUpdateOne) SetTimeZone(s string) *BackupScheduleUpdateOne {
+	bsuo.mutation.SetTimeZone(s)
+	return bsuo
+}
+
+// SetNillableTimeZone sets the "time_zone" field if the given value is not nil.
+func (bsuo *BackupScheduleUpdateOne) SetNillableTimeZone(s *string) *BackupScheduleUpdateOne {
+	if s != nil {
+		bsuo.SetTimeZone(*s)
+	}
+	return bsuo
+}
+
+// SetMisfirePolicy sets the "misfire_policy" field.
+func (bsuo *BackupScheduleUpdateOne) SetMisfirePolicy(bp backupschedule.MisfirePolicy) *BackupScheduleUpdateOne {
+	bsuo.mutation.SetMisfirePolicy(bp)
+	return bsuo
+}
+
+// SetNillableMisfirePolicy sets the "misfire_policy" field if the given value is not nil.
+func (bsuo *BackupScheduleUpdateOne) SetNillableMisfirePolicy(bp *backupschedule.MisfirePolicy) *BackupScheduleUpdateOne {
+	if bp != nil {
+		bsuo.SetMisfirePolicy(*bp)
+	}
+	return bsuo
+}
+
+// SetEnabled sets the "enabled" field.
+func (bsuo *BackupScheduleUpdateOne) SetEnabled(b bool) *BackupScheduleUpdateOne {
+	bsuo.mutation.SetEnabled(b)
+	return bsuo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (bsuo *BackupScheduleUpdateOne) SetNillableEnabled(b *bool) *BackupScheduleUpdateOne {
+	if b != nil {
+		bsuo.SetEnabled(*b)
+	}
+	return bsuo
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (bsuo *BackupScheduleUpdateOne) SetNextRunAt(t time.Time) *BackupScheduleUpdateOne {
+	bsuo.mutation.SetNextRunAt(t)
+	return bsuo
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (bsuo *BackupScheduleUpdateOne) SetNillableNextRunAt(t *time.Time) *BackupScheduleUpdateOne {
+	if t != nil {
+		bsuo.SetNextRunAt(*t)
+	}
+	return bsuo
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (bsuo *BackupScheduleUpdateOne) ClearNextRunAt() *BackupScheduleUpdateOne {
+	bsuo.mutation.ClearNextRunAt()
+	return bsuo
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (bsuo *BackupScheduleUpdateOne) SetLastRunAt(t time.Time) *BackupScheduleUpdateOne {
+	bsuo.mutation.SetLastRunAt(t)
+	return bsuo
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (bsuo *BackupScheduleUpdateOne) SetNillableLastRunAt(t *time.Time) *BackupScheduleUpdateOne {
+	if t != nil {
+		bsuo.SetLastRunAt(*t)
+	}
+	return bsuo
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (bsuo *BackupScheduleUpdateOne) ClearLastRunAt() *BackupScheduleUpdateOne {
+	bsuo.mutation.ClearLastRunAt()
+	return bsuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (bsuo *BackupScheduleUpdateOne) SetUpdatedAt(t time.Time) *BackupScheduleUpdateOne {
+	bsuo.mutation.SetUpdatedAt(t)
+	return bsuo
+}
+
+// Mutation returns the BackupScheduleMutation object of the builder.
+func (bsuo *BackupScheduleUpdateOne) Mutation() *BackupScheduleMutation {
+	return bsuo.mutation
+}
+
+// Where appends a list predicates to the BackupScheduleUpdate builder.
+func (bsuo *BackupScheduleUpdateOne) Where(ps ...predicate.BackupSchedule) *BackupScheduleUpdateOne {
+	bsuo.mutation.Where(ps...)
+	return bsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (bsuo *BackupScheduleUpdateOne) Select(field string, fields ...string) *BackupScheduleUpdateOne {
+	bsuo.fields = append([]string{field}, fields...)
+	return bsuo
+}
+
+// Save executes the query and returns the updated BackupSchedule entity.
+func (bsuo *BackupScheduleUpdateOne) Save(ctx context.Context) (*BackupSchedule, error) {
+	bsuo.defaults()
+	return withHooks(ctx, bsuo.sqlSave, bsuo.mutation, bsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bsuo *BackupScheduleUpdateOne) SaveX(ctx context.Context) *BackupSchedule {
+	node, err := bsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (bsuo *BackupScheduleUpdateOne) Exec(ctx context.Context) error {
+	_, err := bsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bsuo *BackupScheduleUpdateOne) ExecX(ctx context.Context) {
+	if err := bsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bsuo *BackupScheduleUpdateOne) defaults() {
+	if _, ok := bsuo.mutation.UpdatedAt(); !ok {
+		v := backupschedule.UpdateDefaultUpdatedAt()
+		bsuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bsuo *BackupScheduleUpdateOne) check() error {
+	if v, ok := bsuo.mutation.Cron(); ok {
+		if err := backupschedule.CronValidator(v); err != nil {
+			return &ValidationError{Name: "cron", err: fmt.Errorf(`ent: validator failed for field "BackupSchedule.cron": %w`, err)}
+		}
+	}
+	if v, ok := bsuo.mutation.MisfirePolicy(); ok {
+		if err := backupschedule.MisfirePolicyValidator(v); err != nil {
+			return &ValidationError{Name: "misfire_policy", err: fmt.Errorf(`ent: validator failed for field "BackupSchedule.misfire_policy": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (bsuo *BackupScheduleUpdateOne) sqlSave(ctx context.Context) (_node *BackupSchedule, err error) {
+	if err := bsuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(backupschedule.Table, backupschedule.Columns, sqlgraph.NewFieldSpec(backupschedule.FieldID, field.TypeString))
+	id, ok := bsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BackupSchedule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := bsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, backupschedule.FieldID)
+		for _, f := range fields {
+			if !backupschedule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != backupschedule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := bsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bsuo.mutation.Cron(); ok {
+		_spec.SetField(backupschedule.FieldCron, field.TypeString, value)
+	}
+	if value, ok := bsuo.mutation.TimeZone(); ok {
+		_spec.SetField(backupschedule.FieldTimeZone, field.TypeString, value)
+	}
+	if value, ok := bsuo.mutation.MisfirePolicy(); ok {
+		_spec.SetField(backupschedule.FieldMisfirePolicy, field.TypeEnum, value)
+	}
+	if value, ok := bsuo.mutation.Enabled(); ok {
+		_spec.SetField(backupschedule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := bsuo.mutation.NextRunAt(); ok {
+		_spec.SetField(backupschedule.FieldNextRunAt, field.TypeTime, value)
+	}
+	if bsuo.mutation.NextRunAtCleared() {
+		_spec.ClearField(backupschedule.FieldNextRunAt, field.TypeTime)
+	}
+	if value, ok := bsuo.mutation.LastRunAt(); ok {
+		_spec.SetField(backupschedule.FieldLastRunAt, field.TypeTime, value)
+	}
+	if bsuo.mutation.LastRunAtCleared() {
+		_spec.ClearField(backupschedule.FieldLastRunAt, field.TypeTime)
+	}
+	if value, ok := bsuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(backupschedule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &BackupSchedule{config: bsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, bsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{backupschedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	bsuo.mutation.done = true
+	return _node, nil
+}
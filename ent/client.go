@@ -11,11 +11,37 @@ import (
 
 	"beyerleinf/spotify-backup/ent/migrate"
 
+	"beyerleinf/spotify-backup/ent/album"
+	"beyerleinf/spotify-backup/ent/artist"
+	"beyerleinf/spotify-backup/ent/authstate"
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/backupschedule"
+	"beyerleinf/spotify-backup/ent/episode"
+	"beyerleinf/spotify-backup/ent/followedartist"
+	"beyerleinf/spotify-backup/ent/job"
+	"beyerleinf/spotify-backup/ent/lease"
+	"beyerleinf/spotify-backup/ent/playlist"
+	"beyerleinf/spotify-backup/ent/playlistsnapshot"
+	"beyerleinf/spotify-backup/ent/restoreplan"
+	"beyerleinf/spotify-backup/ent/retentionpolicy"
+	"beyerleinf/spotify-backup/ent/savedalbum"
+	"beyerleinf/spotify-backup/ent/savedaudiobook"
+	"beyerleinf/spotify-backup/ent/savedepisode"
+	"beyerleinf/spotify-backup/ent/savedshow"
+	"beyerleinf/spotify-backup/ent/savedtrack"
+	"beyerleinf/spotify-backup/ent/schemamigration"
+	"beyerleinf/spotify-backup/ent/show"
+	"beyerleinf/spotify-backup/ent/snapshotitem"
+	"beyerleinf/spotify-backup/ent/spotifytoken"
+	"beyerleinf/spotify-backup/ent/track"
 	"beyerleinf/spotify-backup/ent/user"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+
+	stdsql "database/sql"
 )
 
 // Client is the client that holds all ent builders.
@@ -23,6 +49,52 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// Album is the client for interacting with the Album builders.
+	Album *AlbumClient
+	// Artist is the client for interacting with the Artist builders.
+	Artist *ArtistClient
+	// AuthState is the client for interacting with the AuthState builders.
+	AuthState *AuthStateClient
+	// BackupRun is the client for interacting with the BackupRun builders.
+	BackupRun *BackupRunClient
+	// BackupSchedule is the client for interacting with the BackupSchedule builders.
+	BackupSchedule *BackupScheduleClient
+	// Episode is the client for interacting with the Episode builders.
+	Episode *EpisodeClient
+	// FollowedArtist is the client for interacting with the FollowedArtist builders.
+	FollowedArtist *FollowedArtistClient
+	// Job is the client for interacting with the Job builders.
+	Job *JobClient
+	// Lease is the client for interacting with the Lease builders.
+	Lease *LeaseClient
+	// Playlist is the client for interacting with the Playlist builders.
+	Playlist *PlaylistClient
+	// PlaylistSnapshot is the client for interacting with the PlaylistSnapshot builders.
+	PlaylistSnapshot *PlaylistSnapshotClient
+	// RestorePlan is the client for interacting with the RestorePlan builders.
+	RestorePlan *RestorePlanClient
+	// RetentionPolicy is the client for interacting with the RetentionPolicy builders.
+	RetentionPolicy *RetentionPolicyClient
+	// SavedAlbum is the client for interacting with the SavedAlbum builders.
+	SavedAlbum *SavedAlbumClient
+	// SavedAudiobook is the client for interacting with the SavedAudiobook builders.
+	SavedAudiobook *SavedAudiobookClient
+	// SavedEpisode is the client for interacting with the SavedEpisode builders.
+	SavedEpisode *SavedEpisodeClient
+	// SavedShow is the client for interacting with the SavedShow builders.
+	SavedShow *SavedShowClient
+	// SavedTrack is the client for interacting with the SavedTrack builders.
+	SavedTrack *SavedTrackClient
+	// SchemaMigration is the client for interacting with the SchemaMigration builders.
+	SchemaMigration *SchemaMigrationClient
+	// Show is the client for interacting with the Show builders.
+	Show *ShowClient
+	// SnapshotItem is the client for interacting with the SnapshotItem builders.
+	SnapshotItem *SnapshotItemClient
+	// SpotifyToken is the client for interacting with the SpotifyToken builders.
+	SpotifyToken *SpotifyTokenClient
+	// Track is the client for interacting with the Track builders.
+	Track *TrackClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 }
@@ -36,6 +108,29 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.Album = NewAlbumClient(c.config)
+	c.Artist = NewArtistClient(c.config)
+	c.AuthState = NewAuthStateClient(c.config)
+	c.BackupRun = NewBackupRunClient(c.config)
+	c.BackupSchedule = NewBackupScheduleClient(c.config)
+	c.Episode = NewEpisodeClient(c.config)
+	c.FollowedArtist = NewFollowedArtistClient(c.config)
+	c.Job = NewJobClient(c.config)
+	c.Lease = NewLeaseClient(c.config)
+	c.Playlist = NewPlaylistClient(c.config)
+	c.PlaylistSnapshot = NewPlaylistSnapshotClient(c.config)
+	c.RestorePlan = NewRestorePlanClient(c.config)
+	c.RetentionPolicy = NewRetentionPolicyClient(c.config)
+	c.SavedAlbum = NewSavedAlbumClient(c.config)
+	c.SavedAudiobook = NewSavedAudiobookClient(c.config)
+	c.SavedEpisode = NewSavedEpisodeClient(c.config)
+	c.SavedShow = NewSavedShowClient(c.config)
+	c.SavedTrack = NewSavedTrackClient(c.config)
+	c.SchemaMigration = NewSchemaMigrationClient(c.config)
+	c.Show = NewShowClient(c.config)
+	c.SnapshotItem = NewSnapshotItemClient(c.config)
+	c.SpotifyToken = NewSpotifyTokenClient(c.config)
+	c.Track = NewTrackClient(c.config)
 	c.User = NewUserClient(c.config)
 }
 
@@ -127,9 +222,32 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:    ctx,
-		config: cfg,
-		User:   NewUserClient(cfg),
+		ctx:              ctx,
+		config:           cfg,
+		Album:            NewAlbumClient(cfg),
+		Artist:           NewArtistClient(cfg),
+		AuthState:        NewAuthStateClient(cfg),
+		BackupRun:        NewBackupRunClient(cfg),
+		BackupSchedule:   NewBackupScheduleClient(cfg),
+		Episode:          NewEpisodeClient(cfg),
+		FollowedArtist:   NewFollowedArtistClient(cfg),
+		Job:              NewJobClient(cfg),
+		Lease:            NewLeaseClient(cfg),
+		Playlist:         NewPlaylistClient(cfg),
+		PlaylistSnapshot: NewPlaylistSnapshotClient(cfg),
+		RestorePlan:      NewRestorePlanClient(cfg),
+		RetentionPolicy:  NewRetentionPolicyClient(cfg),
+		SavedAlbum:       NewSavedAlbumClient(cfg),
+		SavedAudiobook:   NewSavedAudiobookClient(cfg),
+		SavedEpisode:     NewSavedEpisodeClient(cfg),
+		SavedShow:        NewSavedShowClient(cfg),
+		SavedTrack:       NewSavedTrackClient(cfg),
+		SchemaMigration:  NewSchemaMigrationClient(cfg),
+		Show:             NewShowClient(cfg),
+		SnapshotItem:     NewSnapshotItemClient(cfg),
+		SpotifyToken:     NewSpotifyTokenClient(cfg),
+		Track:            NewTrackClient(cfg),
+		User:             NewUserClient(cfg),
 	}, nil
 }
 
@@ -147,16 +265,39 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:    ctx,
-		config: cfg,
-		User:   NewUserClient(cfg),
+		ctx:              ctx,
+		config:           cfg,
+		Album:            NewAlbumClient(cfg),
+		Artist:           NewArtistClient(cfg),
+		AuthState:        NewAuthStateClient(cfg),
+		BackupRun:        NewBackupRunClient(cfg),
+		BackupSchedule:   NewBackupScheduleClient(cfg),
+		Episode:          NewEpisodeClient(cfg),
+		FollowedArtist:   NewFollowedArtistClient(cfg),
+		Job:              NewJobClient(cfg),
+		Lease:            NewLeaseClient(cfg),
+		Playlist:         NewPlaylistClient(cfg),
+		PlaylistSnapshot: NewPlaylistSnapshotClient(cfg),
+		RestorePlan:      NewRestorePlanClient(cfg),
+		RetentionPolicy:  NewRetentionPolicyClient(cfg),
+		SavedAlbum:       NewSavedAlbumClient(cfg),
+		SavedAudiobook:   NewSavedAudiobookClient(cfg),
+		SavedEpisode:     NewSavedEpisodeClient(cfg),
+		SavedShow:        NewSavedShowClient(cfg),
+		SavedTrack:       NewSavedTrackClient(cfg),
+		SchemaMigration:  NewSchemaMigrationClient(cfg),
+		Show:             NewShowClient(cfg),
+		SnapshotItem:     NewSnapshotItemClient(cfg),
+		SpotifyToken:     NewSpotifyTokenClient(cfg),
+		Track:            NewTrackClient(cfg),
+		User:             NewUserClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		User.
+//		Album.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -178,18 +319,80 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.User.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.Album, c.Artist, c.AuthState, c.BackupRun, c.BackupSchedule, c.Episode,
+		c.FollowedArtist, c.Job, c.Lease, c.Playlist, c.PlaylistSnapshot,
+		c.RestorePlan, c.RetentionPolicy, c.SavedAlbum, c.SavedAudiobook,
+		c.SavedEpisode, c.SavedShow, c.SavedTrack, c.SchemaMigration, c.Show,
+		c.SnapshotItem, c.SpotifyToken, c.Track, c.User,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.User.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.Album, c.Artist, c.AuthState, c.BackupRun, c.BackupSchedule, c.Episode,
+		c.FollowedArtist, c.Job, c.Lease, c.Playlist, c.PlaylistSnapshot,
+		c.RestorePlan, c.RetentionPolicy, c.SavedAlbum, c.SavedAudiobook,
+		c.SavedEpisode, c.SavedShow, c.SavedTrack, c.SchemaMigration, c.Show,
+		c.SnapshotItem, c.SpotifyToken, c.Track, c.User,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AlbumMutation:
+		return c.Album.mutate(ctx, m)
+	case *ArtistMutation:
+		return c.Artist.mutate(ctx, m)
+	case *AuthStateMutation:
+		return c.AuthState.mutate(ctx, m)
+	case *BackupRunMutation:
+		return c.BackupRun.mutate(ctx, m)
+	case *BackupScheduleMutation:
+		return c.BackupSchedule.mutate(ctx, m)
+	case *EpisodeMutation:
+		return c.Episode.mutate(ctx, m)
+	case *FollowedArtistMutation:
+		return c.FollowedArtist.mutate(ctx, m)
+	case *JobMutation:
+		return c.Job.mutate(ctx, m)
+	case *LeaseMutation:
+		return c.Lease.mutate(ctx, m)
+	case *PlaylistMutation:
+		return c.Playlist.mutate(ctx, m)
+	case *PlaylistSnapshotMutation:
+		return c.PlaylistSnapshot.mutate(ctx, m)
+	case *RestorePlanMutation:
+		return c.RestorePlan.mutate(ctx, m)
+	case *RetentionPolicyMutation:
+		return c.RetentionPolicy.mutate(ctx, m)
+	case *SavedAlbumMutation:
+		return c.SavedAlbum.mutate(ctx, m)
+	case *SavedAudiobookMutation:
+		return c.SavedAudiobook.mutate(ctx, m)
+	case *SavedEpisodeMutation:
+		return c.SavedEpisode.mutate(ctx, m)
+	case *SavedShowMutation:
+		return c.SavedShow.mutate(ctx, m)
+	case *SavedTrackMutation:
+		return c.SavedTrack.mutate(ctx, m)
+	case *SchemaMigrationMutation:
+		return c.SchemaMigration.mutate(ctx, m)
+	case *ShowMutation:
+		return c.Show.mutate(ctx, m)
+	case *SnapshotItemMutation:
+		return c.SnapshotItem.mutate(ctx, m)
+	case *SpotifyTokenMutation:
+		return c.SpotifyToken.mutate(ctx, m)
+	case *TrackMutation:
+		return c.Track.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
 	default:
@@ -197,107 +400,107 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
-// UserClient is a client for the User schema.
-type UserClient struct {
+// AlbumClient is a client for the Album schema.
+type AlbumClient struct {
 	config
 }
 
-// NewUserClient returns a client for the User from the given config.
-func NewUserClient(c config) *UserClient {
-	return &UserClient{config: c}
+// NewAlbumClient returns a client for the Album from the given config.
+func NewAlbumClient(c config) *AlbumClient {
+	return &AlbumClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `user.Hooks(f(g(h())))`.
-func (c *UserClient) Use(hooks ...Hook) {
-	c.hooks.User = append(c.hooks.User, hooks...)
+// A call to `Use(f, g, h)` equals to `album.Hooks(f(g(h())))`.
+func (c *AlbumClient) Use(hooks ...Hook) {
+	c.hooks.Album = append(c.hooks.Album, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
-func (c *UserClient) Intercept(interceptors ...Interceptor) {
-	c.inters.User = append(c.inters.User, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `album.Intercept(f(g(h())))`.
+func (c *AlbumClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Album = append(c.inters.Album, interceptors...)
 }
 
-// Create returns a builder for creating a User entity.
-func (c *UserClient) Create() *UserCreate {
-	mutation := newUserMutation(c.config, OpCreate)
-	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Album entity.
+func (c *AlbumClient) Create() *AlbumCreate {
+	mutation := newAlbumMutation(c.config, OpCreate)
+	return &AlbumCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of User entities.
-func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
-	return &UserCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Album entities.
+func (c *AlbumClient) CreateBulk(builders ...*AlbumCreate) *AlbumCreateBulk {
+	return &AlbumCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *UserClient) MapCreateBulk(slice any, setFunc func(*UserCreate, int)) *UserCreateBulk {
+func (c *AlbumClient) MapCreateBulk(slice any, setFunc func(*AlbumCreate, int)) *AlbumCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &UserCreateBulk{err: fmt.Errorf("calling to UserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &AlbumCreateBulk{err: fmt.Errorf("calling to AlbumClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*UserCreate, rv.Len())
+	builders := make([]*AlbumCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &UserCreateBulk{config: c.config, builders: builders}
+	return &AlbumCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for User.
-func (c *UserClient) Update() *UserUpdate {
-	mutation := newUserMutation(c.config, OpUpdate)
-	return &UserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Album.
+func (c *AlbumClient) Update() *AlbumUpdate {
+	mutation := newAlbumMutation(c.config, OpUpdate)
+	return &AlbumUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *UserClient) UpdateOne(u *User) *UserUpdateOne {
-	mutation := newUserMutation(c.config, OpUpdateOne, withUser(u))
-	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AlbumClient) UpdateOne(a *Album) *AlbumUpdateOne {
+	mutation := newAlbumMutation(c.config, OpUpdateOne, withAlbum(a))
+	return &AlbumUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *UserClient) UpdateOneID(id string) *UserUpdateOne {
-	mutation := newUserMutation(c.config, OpUpdateOne, withUserID(id))
-	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AlbumClient) UpdateOneID(id string) *AlbumUpdateOne {
+	mutation := newAlbumMutation(c.config, OpUpdateOne, withAlbumID(id))
+	return &AlbumUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for User.
-func (c *UserClient) Delete() *UserDelete {
-	mutation := newUserMutation(c.config, OpDelete)
-	return &UserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Album.
+func (c *AlbumClient) Delete() *AlbumDelete {
+	mutation := newAlbumMutation(c.config, OpDelete)
+	return &AlbumDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *UserClient) DeleteOne(u *User) *UserDeleteOne {
-	return c.DeleteOneID(u.ID)
+func (c *AlbumClient) DeleteOne(a *Album) *AlbumDeleteOne {
+	return c.DeleteOneID(a.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *UserClient) DeleteOneID(id string) *UserDeleteOne {
-	builder := c.Delete().Where(user.ID(id))
+func (c *AlbumClient) DeleteOneID(id string) *AlbumDeleteOne {
+	builder := c.Delete().Where(album.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &UserDeleteOne{builder}
+	return &AlbumDeleteOne{builder}
 }
 
-// Query returns a query builder for User.
-func (c *UserClient) Query() *UserQuery {
-	return &UserQuery{
+// Query returns a query builder for Album.
+func (c *AlbumClient) Query() *AlbumQuery {
+	return &AlbumQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeUser},
+		ctx:    &QueryContext{Type: TypeAlbum},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a User entity by its id.
-func (c *UserClient) Get(ctx context.Context, id string) (*User, error) {
-	return c.Query().Where(user.ID(id)).Only(ctx)
+// Get returns a Album entity by its id.
+func (c *AlbumClient) Get(ctx context.Context, id string) (*Album, error) {
+	return c.Query().Where(album.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *UserClient) GetX(ctx context.Context, id string) *User {
+func (c *AlbumClient) GetX(ctx context.Context, id string) *Album {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -305,37 +508,3767 @@ func (c *UserClient) GetX(ctx context.Context, id string) *User {
 	return obj
 }
 
+// QueryArtists queries the artists edge of a Album.
+func (c *AlbumClient) QueryArtists(a *Album) *ArtistQuery {
+	query := (&ArtistClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := a.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(album.Table, album.FieldID, id),
+			sqlgraph.To(artist.Table, artist.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, album.ArtistsTable, album.ArtistsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(a.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTracks queries the tracks edge of a Album.
+func (c *AlbumClient) QueryTracks(a *Album) *TrackQuery {
+	query := (&TrackClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := a.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(album.Table, album.FieldID, id),
+			sqlgraph.To(track.Table, track.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, album.TracksTable, album.TracksColumn),
+		)
+		fromV = sqlgraph.Neighbors(a.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySavedAlbums queries the saved_albums edge of a Album.
+func (c *AlbumClient) QuerySavedAlbums(a *Album) *SavedAlbumQuery {
+	query := (&SavedAlbumClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := a.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(album.Table, album.FieldID, id),
+			sqlgraph.To(savedalbum.Table, savedalbum.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, album.SavedAlbumsTable, album.SavedAlbumsColumn),
+		)
+		fromV = sqlgraph.Neighbors(a.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
-func (c *UserClient) Hooks() []Hook {
-	return c.hooks.User
+func (c *AlbumClient) Hooks() []Hook {
+	return c.hooks.Album
 }
 
 // Interceptors returns the client interceptors.
-func (c *UserClient) Interceptors() []Interceptor {
-	return c.inters.User
+func (c *AlbumClient) Interceptors() []Interceptor {
+	return c.inters.Album
 }
 
-func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error) {
+func (c *AlbumClient) mutate(ctx context.Context, m *AlbumMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&UserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AlbumCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&UserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AlbumUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AlbumUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&UserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AlbumDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown User mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Album mutation op: %q", m.Op())
 	}
 }
 
-// hooks and interceptors per client, for fast access.
-type (
-	hooks struct {
-		User []ent.Hook
+// ArtistClient is a client for the Artist schema.
+type ArtistClient struct {
+	config
+}
+
+// NewArtistClient returns a client for the Artist from the given config.
+func NewArtistClient(c config) *ArtistClient {
+	return &ArtistClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `artist.Hooks(f(g(h())))`.
+func (c *ArtistClient) Use(hooks ...Hook) {
+	c.hooks.Artist = append(c.hooks.Artist, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `artist.Intercept(f(g(h())))`.
+func (c *ArtistClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Artist = append(c.inters.Artist, interceptors...)
+}
+
+// Create returns a builder for creating a Artist entity.
+func (c *ArtistClient) Create() *ArtistCreate {
+	mutation := newArtistMutation(c.config, OpCreate)
+	return &ArtistCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Artist entities.
+func (c *ArtistClient) CreateBulk(builders ...*ArtistCreate) *ArtistCreateBulk {
+	return &ArtistCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ArtistClient) MapCreateBulk(slice any, setFunc func(*ArtistCreate, int)) *ArtistCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ArtistCreateBulk{err: fmt.Errorf("calling to ArtistClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	inters struct {
-		User []ent.Interceptor
+	builders := make([]*ArtistCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
 	}
-)
+	return &ArtistCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Artist.
+func (c *ArtistClient) Update() *ArtistUpdate {
+	mutation := newArtistMutation(c.config, OpUpdate)
+	return &ArtistUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ArtistClient) UpdateOne(a *Artist) *ArtistUpdateOne {
+	mutation := newArtistMutation(c.config, OpUpdateOne, withArtist(a))
+	return &ArtistUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ArtistClient) UpdateOneID(id string) *ArtistUpdateOne {
+	mutation := newArtistMutation(c.config, OpUpdateOne, withArtistID(id))
+	return &ArtistUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Artist.
+func (c *ArtistClient) Delete() *ArtistDelete {
+	mutation := newArtistMutation(c.config, OpDelete)
+	return &ArtistDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ArtistClient) DeleteOne(a *Artist) *ArtistDeleteOne {
+	return c.DeleteOneID(a.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ArtistClient) DeleteOneID(id string) *ArtistDeleteOne {
+	builder := c.Delete().Where(artist.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ArtistDeleteOne{builder}
+}
+
+// Query returns a query builder for Artist.
+func (c *ArtistClient) Query() *ArtistQuery {
+	return &ArtistQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeArtist},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Artist entity by its id.
+func (c *ArtistClient) Get(ctx context.Context, id string) (*Artist, error) {
+	return c.Query().Where(artist.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ArtistClient) GetX(ctx context.Context, id string) *Artist {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTracks queries the tracks edge of a Artist.
+func (c *ArtistClient) QueryTracks(a *Artist) *TrackQuery {
+	query := (&TrackClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := a.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(artist.Table, artist.FieldID, id),
+			sqlgraph.To(track.Table, track.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, artist.TracksTable, artist.TracksPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(a.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAlbums queries the albums edge of a Artist.
+func (c *ArtistClient) QueryAlbums(a *Artist) *AlbumQuery {
+	query := (&AlbumClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := a.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(artist.Table, artist.FieldID, id),
+			sqlgraph.To(album.Table, album.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, artist.AlbumsTable, artist.AlbumsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(a.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFollowedArtists queries the followed_artists edge of a Artist.
+func (c *ArtistClient) QueryFollowedArtists(a *Artist) *FollowedArtistQuery {
+	query := (&FollowedArtistClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := a.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(artist.Table, artist.FieldID, id),
+			sqlgraph.To(followedartist.Table, followedartist.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, artist.FollowedArtistsTable, artist.FollowedArtistsColumn),
+		)
+		fromV = sqlgraph.Neighbors(a.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ArtistClient) Hooks() []Hook {
+	return c.hooks.Artist
+}
+
+// Interceptors returns the client interceptors.
+func (c *ArtistClient) Interceptors() []Interceptor {
+	return c.inters.Artist
+}
+
+func (c *ArtistClient) mutate(ctx context.Context, m *ArtistMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ArtistCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ArtistUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ArtistUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ArtistDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Artist mutation op: %q", m.Op())
+	}
+}
+
+// AuthStateClient is a client for the AuthState schema.
+type AuthStateClient struct {
+	config
+}
+
+// NewAuthStateClient returns a client for the AuthState from the given config.
+func NewAuthStateClient(c config) *AuthStateClient {
+	return &AuthStateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `authstate.Hooks(f(g(h())))`.
+func (c *AuthStateClient) Use(hooks ...Hook) {
+	c.hooks.AuthState = append(c.hooks.AuthState, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `authstate.Intercept(f(g(h())))`.
+func (c *AuthStateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuthState = append(c.inters.AuthState, interceptors...)
+}
+
+// Create returns a builder for creating a AuthState entity.
+func (c *AuthStateClient) Create() *AuthStateCreate {
+	mutation := newAuthStateMutation(c.config, OpCreate)
+	return &AuthStateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AuthState entities.
+func (c *AuthStateClient) CreateBulk(builders ...*AuthStateCreate) *AuthStateCreateBulk {
+	return &AuthStateCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AuthStateClient) MapCreateBulk(slice any, setFunc func(*AuthStateCreate, int)) *AuthStateCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AuthStateCreateBulk{err: fmt.Errorf("calling to AuthStateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AuthStateCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AuthStateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuthState.
+func (c *AuthStateClient) Update() *AuthStateUpdate {
+	mutation := newAuthStateMutation(c.config, OpUpdate)
+	return &AuthStateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuthStateClient) UpdateOne(as *AuthState) *AuthStateUpdateOne {
+	mutation := newAuthStateMutation(c.config, OpUpdateOne, withAuthState(as))
+	return &AuthStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuthStateClient) UpdateOneID(id string) *AuthStateUpdateOne {
+	mutation := newAuthStateMutation(c.config, OpUpdateOne, withAuthStateID(id))
+	return &AuthStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuthState.
+func (c *AuthStateClient) Delete() *AuthStateDelete {
+	mutation := newAuthStateMutation(c.config, OpDelete)
+	return &AuthStateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuthStateClient) DeleteOne(as *AuthState) *AuthStateDeleteOne {
+	return c.DeleteOneID(as.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AuthStateClient) DeleteOneID(id string) *AuthStateDeleteOne {
+	builder := c.Delete().Where(authstate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuthStateDeleteOne{builder}
+}
+
+// Query returns a query builder for AuthState.
+func (c *AuthStateClient) Query() *AuthStateQuery {
+	return &AuthStateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAuthState},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AuthState entity by its id.
+func (c *AuthStateClient) Get(ctx context.Context, id string) (*AuthState, error) {
+	return c.Query().Where(authstate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuthStateClient) GetX(ctx context.Context, id string) *AuthState {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AuthStateClient) Hooks() []Hook {
+	return c.hooks.AuthState
+}
+
+// Interceptors returns the client interceptors.
+func (c *AuthStateClient) Interceptors() []Interceptor {
+	return c.inters.AuthState
+}
+
+func (c *AuthStateClient) mutate(ctx context.Context, m *AuthStateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AuthStateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AuthStateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AuthStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AuthStateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AuthState mutation op: %q", m.Op())
+	}
+}
+
+// BackupRunClient is a client for the BackupRun schema.
+type BackupRunClient struct {
+	config
+}
+
+// NewBackupRunClient returns a client for the BackupRun from the given config.
+func NewBackupRunClient(c config) *BackupRunClient {
+	return &BackupRunClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `backuprun.Hooks(f(g(h())))`.
+func (c *BackupRunClient) Use(hooks ...Hook) {
+	c.hooks.BackupRun = append(c.hooks.BackupRun, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `backuprun.Intercept(f(g(h())))`.
+func (c *BackupRunClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BackupRun = append(c.inters.BackupRun, interceptors...)
+}
+
+// Create returns a builder for creating a BackupRun entity.
+func (c *BackupRunClient) Create() *BackupRunCreate {
+	mutation := newBackupRunMutation(c.config, OpCreate)
+	return &BackupRunCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BackupRun entities.
+func (c *BackupRunClient) CreateBulk(builders ...*BackupRunCreate) *BackupRunCreateBulk {
+	return &BackupRunCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BackupRunClient) MapCreateBulk(slice any, setFunc func(*BackupRunCreate, int)) *BackupRunCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BackupRunCreateBulk{err: fmt.Errorf("calling to BackupRunClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BackupRunCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BackupRunCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BackupRun.
+func (c *BackupRunClient) Update() *BackupRunUpdate {
+	mutation := newBackupRunMutation(c.config, OpUpdate)
+	return &BackupRunUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BackupRunClient) UpdateOne(br *BackupRun) *BackupRunUpdateOne {
+	mutation := newBackupRunMutation(c.config, OpUpdateOne, withBackupRun(br))
+	return &BackupRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BackupRunClient) UpdateOneID(id string) *BackupRunUpdateOne {
+	mutation := newBackupRunMutation(c.config, OpUpdateOne, withBackupRunID(id))
+	return &BackupRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BackupRun.
+func (c *BackupRunClient) Delete() *BackupRunDelete {
+	mutation := newBackupRunMutation(c.config, OpDelete)
+	return &BackupRunDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BackupRunClient) DeleteOne(br *BackupRun) *BackupRunDeleteOne {
+	return c.DeleteOneID(br.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BackupRunClient) DeleteOneID(id string) *BackupRunDeleteOne {
+	builder := c.Delete().Where(backuprun.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BackupRunDeleteOne{builder}
+}
+
+// Query returns a query builder for BackupRun.
+func (c *BackupRunClient) Query() *BackupRunQuery {
+	return &BackupRunQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBackupRun},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BackupRun entity by its id.
+func (c *BackupRunClient) Get(ctx context.Context, id string) (*BackupRun, error) {
+	return c.Query().Where(backuprun.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BackupRunClient) GetX(ctx context.Context, id string) *BackupRun {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPlaylistSnapshots queries the playlist_snapshots edge of a BackupRun.
+func (c *BackupRunClient) QueryPlaylistSnapshots(br *BackupRun) *PlaylistSnapshotQuery {
+	query := (&PlaylistSnapshotClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := br.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backuprun.Table, backuprun.FieldID, id),
+			sqlgraph.To(playlistsnapshot.Table, playlistsnapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, backuprun.PlaylistSnapshotsTable, backuprun.PlaylistSnapshotsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(br.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySavedTracks queries the saved_tracks edge of a BackupRun.
+func (c *BackupRunClient) QuerySavedTracks(br *BackupRun) *SavedTrackQuery {
+	query := (&SavedTrackClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := br.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backuprun.Table, backuprun.FieldID, id),
+			sqlgraph.To(savedtrack.Table, savedtrack.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, backuprun.SavedTracksTable, backuprun.SavedTracksColumn),
+		)
+		fromV = sqlgraph.Neighbors(br.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySavedAlbums queries the saved_albums edge of a BackupRun.
+func (c *BackupRunClient) QuerySavedAlbums(br *BackupRun) *SavedAlbumQuery {
+	query := (&SavedAlbumClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := br.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backuprun.Table, backuprun.FieldID, id),
+			sqlgraph.To(savedalbum.Table, savedalbum.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, backuprun.SavedAlbumsTable, backuprun.SavedAlbumsColumn),
+		)
+		fromV = sqlgraph.Neighbors(br.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySavedShows queries the saved_shows edge of a BackupRun.
+func (c *BackupRunClient) QuerySavedShows(br *BackupRun) *SavedShowQuery {
+	query := (&SavedShowClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := br.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backuprun.Table, backuprun.FieldID, id),
+			sqlgraph.To(savedshow.Table, savedshow.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, backuprun.SavedShowsTable, backuprun.SavedShowsColumn),
+		)
+		fromV = sqlgraph.Neighbors(br.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySavedEpisodes queries the saved_episodes edge of a BackupRun.
+func (c *BackupRunClient) QuerySavedEpisodes(br *BackupRun) *SavedEpisodeQuery {
+	query := (&SavedEpisodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := br.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backuprun.Table, backuprun.FieldID, id),
+			sqlgraph.To(savedepisode.Table, savedepisode.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, backuprun.SavedEpisodesTable, backuprun.SavedEpisodesColumn),
+		)
+		fromV = sqlgraph.Neighbors(br.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySavedAudiobooks queries the saved_audiobooks edge of a BackupRun.
+func (c *BackupRunClient) QuerySavedAudiobooks(br *BackupRun) *SavedAudiobookQuery {
+	query := (&SavedAudiobookClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := br.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backuprun.Table, backuprun.FieldID, id),
+			sqlgraph.To(savedaudiobook.Table, savedaudiobook.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, backuprun.SavedAudiobooksTable, backuprun.SavedAudiobooksColumn),
+		)
+		fromV = sqlgraph.Neighbors(br.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFollowedArtists queries the followed_artists edge of a BackupRun.
+func (c *BackupRunClient) QueryFollowedArtists(br *BackupRun) *FollowedArtistQuery {
+	query := (&FollowedArtistClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := br.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backuprun.Table, backuprun.FieldID, id),
+			sqlgraph.To(followedartist.Table, followedartist.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, backuprun.FollowedArtistsTable, backuprun.FollowedArtistsColumn),
+		)
+		fromV = sqlgraph.Neighbors(br.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *BackupRunClient) Hooks() []Hook {
+	return c.hooks.BackupRun
+}
+
+// Interceptors returns the client interceptors.
+func (c *BackupRunClient) Interceptors() []Interceptor {
+	return c.inters.BackupRun
+}
+
+func (c *BackupRunClient) mutate(ctx context.Context, m *BackupRunMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BackupRunCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BackupRunUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BackupRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BackupRunDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown BackupRun mutation op: %q", m.Op())
+	}
+}
+
+// BackupScheduleClient is a client for the BackupSchedule schema.
+type BackupScheduleClient struct {
+	config
+}
+
+// NewBackupScheduleClient returns a client for the BackupSchedule from the given config.
+func NewBackupScheduleClient(c config) *BackupScheduleClient {
+	return &BackupScheduleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `backupschedule.Hooks(f(g(h())))`.
+func (c *BackupScheduleClient) Use(hooks ...Hook) {
+	c.hooks.BackupSchedule = append(c.hooks.BackupSchedule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `backupschedule.Intercept(f(g(h())))`.
+func (c *BackupScheduleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BackupSchedule = append(c.inters.BackupSchedule, interceptors...)
+}
+
+// Create returns a builder for creating a BackupSchedule entity.
+func (c *BackupScheduleClient) Create() *BackupScheduleCreate {
+	mutation := newBackupScheduleMutation(c.config, OpCreate)
+	return &BackupScheduleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BackupSchedule entities.
+func (c *BackupScheduleClient) CreateBulk(builders ...*BackupScheduleCreate) *BackupScheduleCreateBulk {
+	return &BackupScheduleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BackupScheduleClient) MapCreateBulk(slice any, setFunc func(*BackupScheduleCreate, int)) *BackupScheduleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BackupScheduleCreateBulk{err: fmt.Errorf("calling to BackupScheduleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BackupScheduleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BackupScheduleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BackupSchedule.
+func (c *BackupScheduleClient) Update() *BackupScheduleUpdate {
+	mutation := newBackupScheduleMutation(c.config, OpUpdate)
+	return &BackupScheduleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BackupScheduleClient) UpdateOne(bs *BackupSchedule) *BackupScheduleUpdateOne {
+	mutation := newBackupScheduleMutation(c.config, OpUpdateOne, withBackupSchedule(bs))
+	return &BackupScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BackupScheduleClient) UpdateOneID(id string) *BackupScheduleUpdateOne {
+	mutation := newBackupScheduleMutation(c.config, OpUpdateOne, withBackupScheduleID(id))
+	return &BackupScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BackupSchedule.
+func (c *BackupScheduleClient) Delete() *BackupScheduleDelete {
+	mutation := newBackupScheduleMutation(c.config, OpDelete)
+	return &BackupScheduleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BackupScheduleClient) DeleteOne(bs *BackupSchedule) *BackupScheduleDeleteOne {
+	return c.DeleteOneID(bs.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BackupScheduleClient) DeleteOneID(id string) *BackupScheduleDeleteOne {
+	builder := c.Delete().Where(backupschedule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BackupScheduleDeleteOne{builder}
+}
+
+// Query returns a query builder for BackupSchedule.
+func (c *BackupScheduleClient) Query() *BackupScheduleQuery {
+	return &BackupScheduleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBackupSchedule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BackupSchedule entity by its id.
+func (c *BackupScheduleClient) Get(ctx context.Context, id string) (*BackupSchedule, error) {
+	return c.Query().Where(backupschedule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BackupScheduleClient) GetX(ctx context.Context, id string) *BackupSchedule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BackupScheduleClient) Hooks() []Hook {
+	return c.hooks.BackupSchedule
+}
+
+// Interceptors returns the client interceptors.
+func (c *BackupScheduleClient) Interceptors() []Interceptor {
+	return c.inters.BackupSchedule
+}
+
+func (c *BackupScheduleClient) mutate(ctx context.Context, m *BackupScheduleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BackupScheduleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BackupScheduleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BackupScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BackupScheduleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown BackupSchedule mutation op: %q", m.Op())
+	}
+}
+
+// EpisodeClient is a client for the Episode schema.
+type EpisodeClient struct {
+	config
+}
+
+// NewEpisodeClient returns a client for the Episode from the given config.
+func NewEpisodeClient(c config) *EpisodeClient {
+	return &EpisodeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `episode.Hooks(f(g(h())))`.
+func (c *EpisodeClient) Use(hooks ...Hook) {
+	c.hooks.Episode = append(c.hooks.Episode, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `episode.Intercept(f(g(h())))`.
+func (c *EpisodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Episode = append(c.inters.Episode, interceptors...)
+}
+
+// Create returns a builder for creating a Episode entity.
+func (c *EpisodeClient) Create() *EpisodeCreate {
+	mutation := newEpisodeMutation(c.config, OpCreate)
+	return &EpisodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Episode entities.
+func (c *EpisodeClient) CreateBulk(builders ...*EpisodeCreate) *EpisodeCreateBulk {
+	return &EpisodeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EpisodeClient) MapCreateBulk(slice any, setFunc func(*EpisodeCreate, int)) *EpisodeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EpisodeCreateBulk{err: fmt.Errorf("calling to EpisodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EpisodeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EpisodeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Episode.
+func (c *EpisodeClient) Update() *EpisodeUpdate {
+	mutation := newEpisodeMutation(c.config, OpUpdate)
+	return &EpisodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EpisodeClient) UpdateOne(e *Episode) *EpisodeUpdateOne {
+	mutation := newEpisodeMutation(c.config, OpUpdateOne, withEpisode(e))
+	return &EpisodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EpisodeClient) UpdateOneID(id string) *EpisodeUpdateOne {
+	mutation := newEpisodeMutation(c.config, OpUpdateOne, withEpisodeID(id))
+	return &EpisodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Episode.
+func (c *EpisodeClient) Delete() *EpisodeDelete {
+	mutation := newEpisodeMutation(c.config, OpDelete)
+	return &EpisodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EpisodeClient) DeleteOne(e *Episode) *EpisodeDeleteOne {
+	return c.DeleteOneID(e.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EpisodeClient) DeleteOneID(id string) *EpisodeDeleteOne {
+	builder := c.Delete().Where(episode.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EpisodeDeleteOne{builder}
+}
+
+// Query returns a query builder for Episode.
+func (c *EpisodeClient) Query() *EpisodeQuery {
+	return &EpisodeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEpisode},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Episode entity by its id.
+func (c *EpisodeClient) Get(ctx context.Context, id string) (*Episode, error) {
+	return c.Query().Where(episode.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EpisodeClient) GetX(ctx context.Context, id string) *Episode {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryShow queries the show edge of a Episode.
+func (c *EpisodeClient) QueryShow(e *Episode) *ShowQuery {
+	query := (&ShowClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(episode.Table, episode.FieldID, id),
+			sqlgraph.To(show.Table, show.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, episode.ShowTable, episode.ShowColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySnapshotItems queries the snapshot_items edge of a Episode.
+func (c *EpisodeClient) QuerySnapshotItems(e *Episode) *SnapshotItemQuery {
+	query := (&SnapshotItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(episode.Table, episode.FieldID, id),
+			sqlgraph.To(snapshotitem.Table, snapshotitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, episode.SnapshotItemsTable, episode.SnapshotItemsColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySavedEpisodes queries the saved_episodes edge of a Episode.
+func (c *EpisodeClient) QuerySavedEpisodes(e *Episode) *SavedEpisodeQuery {
+	query := (&SavedEpisodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(episode.Table, episode.FieldID, id),
+			sqlgraph.To(savedepisode.Table, savedepisode.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, episode.SavedEpisodesTable, episode.SavedEpisodesColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *EpisodeClient) Hooks() []Hook {
+	return c.hooks.Episode
+}
+
+// Interceptors returns the client interceptors.
+func (c *EpisodeClient) Interceptors() []Interceptor {
+	return c.inters.Episode
+}
+
+func (c *EpisodeClient) mutate(ctx context.Context, m *EpisodeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EpisodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EpisodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EpisodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EpisodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Episode mutation op: %q", m.Op())
+	}
+}
+
+// FollowedArtistClient is a client for the FollowedArtist schema.
+type FollowedArtistClient struct {
+	config
+}
+
+// NewFollowedArtistClient returns a client for the FollowedArtist from the given config.
+func NewFollowedArtistClient(c config) *FollowedArtistClient {
+	return &FollowedArtistClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `followedartist.Hooks(f(g(h())))`.
+func (c *FollowedArtistClient) Use(hooks ...Hook) {
+	c.hooks.FollowedArtist = append(c.hooks.FollowedArtist, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `followedartist.Intercept(f(g(h())))`.
+func (c *FollowedArtistClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FollowedArtist = append(c.inters.FollowedArtist, interceptors...)
+}
+
+// Create returns a builder for creating a FollowedArtist entity.
+func (c *FollowedArtistClient) Create() *FollowedArtistCreate {
+	mutation := newFollowedArtistMutation(c.config, OpCreate)
+	return &FollowedArtistCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FollowedArtist entities.
+func (c *FollowedArtistClient) CreateBulk(builders ...*FollowedArtistCreate) *FollowedArtistCreateBulk {
+	return &FollowedArtistCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FollowedArtistClient) MapCreateBulk(slice any, setFunc func(*FollowedArtistCreate, int)) *FollowedArtistCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FollowedArtistCreateBulk{err: fmt.Errorf("calling to FollowedArtistClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FollowedArtistCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FollowedArtistCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FollowedArtist.
+func (c *FollowedArtistClient) Update() *FollowedArtistUpdate {
+	mutation := newFollowedArtistMutation(c.config, OpUpdate)
+	return &FollowedArtistUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FollowedArtistClient) UpdateOne(fa *FollowedArtist) *FollowedArtistUpdateOne {
+	mutation := newFollowedArtistMutation(c.config, OpUpdateOne, withFollowedArtist(fa))
+	return &FollowedArtistUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FollowedArtistClient) UpdateOneID(id string) *FollowedArtistUpdateOne {
+	mutation := newFollowedArtistMutation(c.config, OpUpdateOne, withFollowedArtistID(id))
+	return &FollowedArtistUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FollowedArtist.
+func (c *FollowedArtistClient) Delete() *FollowedArtistDelete {
+	mutation := newFollowedArtistMutation(c.config, OpDelete)
+	return &FollowedArtistDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FollowedArtistClient) DeleteOne(fa *FollowedArtist) *FollowedArtistDeleteOne {
+	return c.DeleteOneID(fa.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FollowedArtistClient) DeleteOneID(id string) *FollowedArtistDeleteOne {
+	builder := c.Delete().Where(followedartist.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FollowedArtistDeleteOne{builder}
+}
+
+// Query returns a query builder for FollowedArtist.
+func (c *FollowedArtistClient) Query() *FollowedArtistQuery {
+	return &FollowedArtistQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFollowedArtist},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FollowedArtist entity by its id.
+func (c *FollowedArtistClient) Get(ctx context.Context, id string) (*FollowedArtist, error) {
+	return c.Query().Where(followedartist.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FollowedArtistClient) GetX(ctx context.Context, id string) *FollowedArtist {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRun queries the run edge of a FollowedArtist.
+func (c *FollowedArtistClient) QueryRun(fa *FollowedArtist) *BackupRunQuery {
+	query := (&BackupRunClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := fa.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(followedartist.Table, followedartist.FieldID, id),
+			sqlgraph.To(backuprun.Table, backuprun.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, followedartist.RunTable, followedartist.RunColumn),
+		)
+		fromV = sqlgraph.Neighbors(fa.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryArtist queries the artist edge of a FollowedArtist.
+func (c *FollowedArtistClient) QueryArtist(fa *FollowedArtist) *ArtistQuery {
+	query := (&ArtistClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := fa.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(followedartist.Table, followedartist.FieldID, id),
+			sqlgraph.To(artist.Table, artist.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, followedartist.ArtistTable, followedartist.ArtistColumn),
+		)
+		fromV = sqlgraph.Neighbors(fa.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *FollowedArtistClient) Hooks() []Hook {
+	return c.hooks.FollowedArtist
+}
+
+// Interceptors returns the client interceptors.
+func (c *FollowedArtistClient) Interceptors() []Interceptor {
+	return c.inters.FollowedArtist
+}
+
+func (c *FollowedArtistClient) mutate(ctx context.Context, m *FollowedArtistMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FollowedArtistCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FollowedArtistUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FollowedArtistUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FollowedArtistDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FollowedArtist mutation op: %q", m.Op())
+	}
+}
+
+// JobClient is a client for the Job schema.
+type JobClient struct {
+	config
+}
+
+// NewJobClient returns a client for the Job from the given config.
+func NewJobClient(c config) *JobClient {
+	return &JobClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `job.Hooks(f(g(h())))`.
+func (c *JobClient) Use(hooks ...Hook) {
+	c.hooks.Job = append(c.hooks.Job, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `job.Intercept(f(g(h())))`.
+func (c *JobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Job = append(c.inters.Job, interceptors...)
+}
+
+// Create returns a builder for creating a Job entity.
+func (c *JobClient) Create() *JobCreate {
+	mutation := newJobMutation(c.config, OpCreate)
+	return &JobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Job entities.
+func (c *JobClient) CreateBulk(builders ...*JobCreate) *JobCreateBulk {
+	return &JobCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *JobClient) MapCreateBulk(slice any, setFunc func(*JobCreate, int)) *JobCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &JobCreateBulk{err: fmt.Errorf("calling to JobClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*JobCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &JobCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Job.
+func (c *JobClient) Update() *JobUpdate {
+	mutation := newJobMutation(c.config, OpUpdate)
+	return &JobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *JobClient) UpdateOne(j *Job) *JobUpdateOne {
+	mutation := newJobMutation(c.config, OpUpdateOne, withJob(j))
+	return &JobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *JobClient) UpdateOneID(id string) *JobUpdateOne {
+	mutation := newJobMutation(c.config, OpUpdateOne, withJobID(id))
+	return &JobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Job.
+func (c *JobClient) Delete() *JobDelete {
+	mutation := newJobMutation(c.config, OpDelete)
+	return &JobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *JobClient) DeleteOne(j *Job) *JobDeleteOne {
+	return c.DeleteOneID(j.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *JobClient) DeleteOneID(id string) *JobDeleteOne {
+	builder := c.Delete().Where(job.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &JobDeleteOne{builder}
+}
+
+// Query returns a query builder for Job.
+func (c *JobClient) Query() *JobQuery {
+	return &JobQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeJob},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Job entity by its id.
+func (c *JobClient) Get(ctx context.Context, id string) (*Job, error) {
+	return c.Query().Where(job.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *JobClient) GetX(ctx context.Context, id string) *Job {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *JobClient) Hooks() []Hook {
+	return c.hooks.Job
+}
+
+// Interceptors returns the client interceptors.
+func (c *JobClient) Interceptors() []Interceptor {
+	return c.inters.Job
+}
+
+func (c *JobClient) mutate(ctx context.Context, m *JobMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&JobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&JobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&JobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&JobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Job mutation op: %q", m.Op())
+	}
+}
+
+// LeaseClient is a client for the Lease schema.
+type LeaseClient struct {
+	config
+}
+
+// NewLeaseClient returns a client for the Lease from the given config.
+func NewLeaseClient(c config) *LeaseClient {
+	return &LeaseClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `lease.Hooks(f(g(h())))`.
+func (c *LeaseClient) Use(hooks ...Hook) {
+	c.hooks.Lease = append(c.hooks.Lease, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `lease.Intercept(f(g(h())))`.
+func (c *LeaseClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Lease = append(c.inters.Lease, interceptors...)
+}
+
+// Create returns a builder for creating a Lease entity.
+func (c *LeaseClient) Create() *LeaseCreate {
+	mutation := newLeaseMutation(c.config, OpCreate)
+	return &LeaseCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Lease entities.
+func (c *LeaseClient) CreateBulk(builders ...*LeaseCreate) *LeaseCreateBulk {
+	return &LeaseCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LeaseClient) MapCreateBulk(slice any, setFunc func(*LeaseCreate, int)) *LeaseCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LeaseCreateBulk{err: fmt.Errorf("calling to LeaseClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LeaseCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LeaseCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Lease.
+func (c *LeaseClient) Update() *LeaseUpdate {
+	mutation := newLeaseMutation(c.config, OpUpdate)
+	return &LeaseUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LeaseClient) UpdateOne(l *Lease) *LeaseUpdateOne {
+	mutation := newLeaseMutation(c.config, OpUpdateOne, withLease(l))
+	return &LeaseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LeaseClient) UpdateOneID(id string) *LeaseUpdateOne {
+	mutation := newLeaseMutation(c.config, OpUpdateOne, withLeaseID(id))
+	return &LeaseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Lease.
+func (c *LeaseClient) Delete() *LeaseDelete {
+	mutation := newLeaseMutation(c.config, OpDelete)
+	return &LeaseDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LeaseClient) DeleteOne(l *Lease) *LeaseDeleteOne {
+	return c.DeleteOneID(l.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LeaseClient) DeleteOneID(id string) *LeaseDeleteOne {
+	builder := c.Delete().Where(lease.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LeaseDeleteOne{builder}
+}
+
+// Query returns a query builder for Lease.
+func (c *LeaseClient) Query() *LeaseQuery {
+	return &LeaseQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLease},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Lease entity by its id.
+func (c *LeaseClient) Get(ctx context.Context, id string) (*Lease, error) {
+	return c.Query().Where(lease.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LeaseClient) GetX(ctx context.Context, id string) *Lease {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *LeaseClient) Hooks() []Hook {
+	return c.hooks.Lease
+}
+
+// Interceptors returns the client interceptors.
+func (c *LeaseClient) Interceptors() []Interceptor {
+	return c.inters.Lease
+}
+
+func (c *LeaseClient) mutate(ctx context.Context, m *LeaseMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LeaseCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LeaseUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LeaseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LeaseDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Lease mutation op: %q", m.Op())
+	}
+}
+
+// PlaylistClient is a client for the Playlist schema.
+type PlaylistClient struct {
+	config
+}
+
+// NewPlaylistClient returns a client for the Playlist from the given config.
+func NewPlaylistClient(c config) *PlaylistClient {
+	return &PlaylistClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `playlist.Hooks(f(g(h())))`.
+func (c *PlaylistClient) Use(hooks ...Hook) {
+	c.hooks.Playlist = append(c.hooks.Playlist, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `playlist.Intercept(f(g(h())))`.
+func (c *PlaylistClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Playlist = append(c.inters.Playlist, interceptors...)
+}
+
+// Create returns a builder for creating a Playlist entity.
+func (c *PlaylistClient) Create() *PlaylistCreate {
+	mutation := newPlaylistMutation(c.config, OpCreate)
+	return &PlaylistCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Playlist entities.
+func (c *PlaylistClient) CreateBulk(builders ...*PlaylistCreate) *PlaylistCreateBulk {
+	return &PlaylistCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PlaylistClient) MapCreateBulk(slice any, setFunc func(*PlaylistCreate, int)) *PlaylistCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PlaylistCreateBulk{err: fmt.Errorf("calling to PlaylistClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PlaylistCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PlaylistCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Playlist.
+func (c *PlaylistClient) Update() *PlaylistUpdate {
+	mutation := newPlaylistMutation(c.config, OpUpdate)
+	return &PlaylistUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PlaylistClient) UpdateOne(pl *Playlist) *PlaylistUpdateOne {
+	mutation := newPlaylistMutation(c.config, OpUpdateOne, withPlaylist(pl))
+	return &PlaylistUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PlaylistClient) UpdateOneID(id string) *PlaylistUpdateOne {
+	mutation := newPlaylistMutation(c.config, OpUpdateOne, withPlaylistID(id))
+	return &PlaylistUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Playlist.
+func (c *PlaylistClient) Delete() *PlaylistDelete {
+	mutation := newPlaylistMutation(c.config, OpDelete)
+	return &PlaylistDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PlaylistClient) DeleteOne(pl *Playlist) *PlaylistDeleteOne {
+	return c.DeleteOneID(pl.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PlaylistClient) DeleteOneID(id string) *PlaylistDeleteOne {
+	builder := c.Delete().Where(playlist.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PlaylistDeleteOne{builder}
+}
+
+// Query returns a query builder for Playlist.
+func (c *PlaylistClient) Query() *PlaylistQuery {
+	return &PlaylistQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePlaylist},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Playlist entity by its id.
+func (c *PlaylistClient) Get(ctx context.Context, id string) (*Playlist, error) {
+	return c.Query().Where(playlist.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PlaylistClient) GetX(ctx context.Context, id string) *Playlist {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySnapshots queries the snapshots edge of a Playlist.
+func (c *PlaylistClient) QuerySnapshots(pl *Playlist) *PlaylistSnapshotQuery {
+	query := (&PlaylistSnapshotClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(playlist.Table, playlist.FieldID, id),
+			sqlgraph.To(playlistsnapshot.Table, playlistsnapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, playlist.SnapshotsTable, playlist.SnapshotsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PlaylistClient) Hooks() []Hook {
+	return c.hooks.Playlist
+}
+
+// Interceptors returns the client interceptors.
+func (c *PlaylistClient) Interceptors() []Interceptor {
+	return c.inters.Playlist
+}
+
+func (c *PlaylistClient) mutate(ctx context.Context, m *PlaylistMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PlaylistCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PlaylistUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PlaylistUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PlaylistDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Playlist mutation op: %q", m.Op())
+	}
+}
+
+// PlaylistSnapshotClient is a client for the PlaylistSnapshot schema.
+type PlaylistSnapshotClient struct {
+	config
+}
+
+// NewPlaylistSnapshotClient returns a client for the PlaylistSnapshot from the given config.
+func NewPlaylistSnapshotClient(c config) *PlaylistSnapshotClient {
+	return &PlaylistSnapshotClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `playlistsnapshot.Hooks(f(g(h())))`.
+func (c *PlaylistSnapshotClient) Use(hooks ...Hook) {
+	c.hooks.PlaylistSnapshot = append(c.hooks.PlaylistSnapshot, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `playlistsnapshot.Intercept(f(g(h())))`.
+func (c *PlaylistSnapshotClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PlaylistSnapshot = append(c.inters.PlaylistSnapshot, interceptors...)
+}
+
+// Create returns a builder for creating a PlaylistSnapshot entity.
+func (c *PlaylistSnapshotClient) Create() *PlaylistSnapshotCreate {
+	mutation := newPlaylistSnapshotMutation(c.config, OpCreate)
+	return &PlaylistSnapshotCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PlaylistSnapshot entities.
+func (c *PlaylistSnapshotClient) CreateBulk(builders ...*PlaylistSnapshotCreate) *PlaylistSnapshotCreateBulk {
+	return &PlaylistSnapshotCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PlaylistSnapshotClient) MapCreateBulk(slice any, setFunc func(*PlaylistSnapshotCreate, int)) *PlaylistSnapshotCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PlaylistSnapshotCreateBulk{err: fmt.Errorf("calling to PlaylistSnapshotClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PlaylistSnapshotCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PlaylistSnapshotCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PlaylistSnapshot.
+func (c *PlaylistSnapshotClient) Update() *PlaylistSnapshotUpdate {
+	mutation := newPlaylistSnapshotMutation(c.config, OpUpdate)
+	return &PlaylistSnapshotUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PlaylistSnapshotClient) UpdateOne(ps *PlaylistSnapshot) *PlaylistSnapshotUpdateOne {
+	mutation := newPlaylistSnapshotMutation(c.config, OpUpdateOne, withPlaylistSnapshot(ps))
+	return &PlaylistSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PlaylistSnapshotClient) UpdateOneID(id string) *PlaylistSnapshotUpdateOne {
+	mutation := newPlaylistSnapshotMutation(c.config, OpUpdateOne, withPlaylistSnapshotID(id))
+	return &PlaylistSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PlaylistSnapshot.
+func (c *PlaylistSnapshotClient) Delete() *PlaylistSnapshotDelete {
+	mutation := newPlaylistSnapshotMutation(c.config, OpDelete)
+	return &PlaylistSnapshotDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PlaylistSnapshotClient) DeleteOne(ps *PlaylistSnapshot) *PlaylistSnapshotDeleteOne {
+	return c.DeleteOneID(ps.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PlaylistSnapshotClient) DeleteOneID(id string) *PlaylistSnapshotDeleteOne {
+	builder := c.Delete().Where(playlistsnapshot.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PlaylistSnapshotDeleteOne{builder}
+}
+
+// Query returns a query builder for PlaylistSnapshot.
+func (c *PlaylistSnapshotClient) Query() *PlaylistSnapshotQuery {
+	return &PlaylistSnapshotQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePlaylistSnapshot},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PlaylistSnapshot entity by its id.
+func (c *PlaylistSnapshotClient) Get(ctx context.Context, id string) (*PlaylistSnapshot, error) {
+	return c.Query().Where(playlistsnapshot.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PlaylistSnapshotClient) GetX(ctx context.Context, id string) *PlaylistSnapshot {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPlaylist queries the playlist edge of a PlaylistSnapshot.
+func (c *PlaylistSnapshotClient) QueryPlaylist(ps *PlaylistSnapshot) *PlaylistQuery {
+	query := (&PlaylistClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ps.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(playlistsnapshot.Table, playlistsnapshot.FieldID, id),
+			sqlgraph.To(playlist.Table, playlist.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, playlistsnapshot.PlaylistTable, playlistsnapshot.PlaylistColumn),
+		)
+		fromV = sqlgraph.Neighbors(ps.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRuns queries the runs edge of a PlaylistSnapshot.
+func (c *PlaylistSnapshotClient) QueryRuns(ps *PlaylistSnapshot) *BackupRunQuery {
+	query := (&BackupRunClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ps.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(playlistsnapshot.Table, playlistsnapshot.FieldID, id),
+			sqlgraph.To(backuprun.Table, backuprun.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, playlistsnapshot.RunsTable, playlistsnapshot.RunsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(ps.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryItems queries the items edge of a PlaylistSnapshot.
+func (c *PlaylistSnapshotClient) QueryItems(ps *PlaylistSnapshot) *SnapshotItemQuery {
+	query := (&SnapshotItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ps.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(playlistsnapshot.Table, playlistsnapshot.FieldID, id),
+			sqlgraph.To(snapshotitem.Table, snapshotitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, playlistsnapshot.ItemsTable, playlistsnapshot.ItemsColumn),
+		)
+		fromV = sqlgraph.Neighbors(ps.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PlaylistSnapshotClient) Hooks() []Hook {
+	return c.hooks.PlaylistSnapshot
+}
+
+// Interceptors returns the client interceptors.
+func (c *PlaylistSnapshotClient) Interceptors() []Interceptor {
+	return c.inters.PlaylistSnapshot
+}
+
+func (c *PlaylistSnapshotClient) mutate(ctx context.Context, m *PlaylistSnapshotMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PlaylistSnapshotCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PlaylistSnapshotUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PlaylistSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PlaylistSnapshotDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PlaylistSnapshot mutation op: %q", m.Op())
+	}
+}
+
+// RestorePlanClient is a client for the RestorePlan schema.
+type RestorePlanClient struct {
+	config
+}
+
+// NewRestorePlanClient returns a client for the RestorePlan from the given config.
+func NewRestorePlanClient(c config) *RestorePlanClient {
+	return &RestorePlanClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `restoreplan.Hooks(f(g(h())))`.
+func (c *RestorePlanClient) Use(hooks ...Hook) {
+	c.hooks.RestorePlan = append(c.hooks.RestorePlan, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `restoreplan.Intercept(f(g(h())))`.
+func (c *RestorePlanClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RestorePlan = append(c.inters.RestorePlan, interceptors...)
+}
+
+// Create returns a builder for creating a RestorePlan entity.
+func (c *RestorePlanClient) Create() *RestorePlanCreate {
+	mutation := newRestorePlanMutation(c.config, OpCreate)
+	return &RestorePlanCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RestorePlan entities.
+func (c *RestorePlanClient) CreateBulk(builders ...*RestorePlanCreate) *RestorePlanCreateBulk {
+	return &RestorePlanCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RestorePlanClient) MapCreateBulk(slice any, setFunc func(*RestorePlanCreate, int)) *RestorePlanCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RestorePlanCreateBulk{err: fmt.Errorf("calling to RestorePlanClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RestorePlanCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RestorePlanCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RestorePlan.
+func (c *RestorePlanClient) Update() *RestorePlanUpdate {
+	mutation := newRestorePlanMutation(c.config, OpUpdate)
+	return &RestorePlanUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RestorePlanClient) UpdateOne(rp *RestorePlan) *RestorePlanUpdateOne {
+	mutation := newRestorePlanMutation(c.config, OpUpdateOne, withRestorePlan(rp))
+	return &RestorePlanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RestorePlanClient) UpdateOneID(id string) *RestorePlanUpdateOne {
+	mutation := newRestorePlanMutation(c.config, OpUpdateOne, withRestorePlanID(id))
+	return &RestorePlanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RestorePlan.
+func (c *RestorePlanClient) Delete() *RestorePlanDelete {
+	mutation := newRestorePlanMutation(c.config, OpDelete)
+	return &RestorePlanDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RestorePlanClient) DeleteOne(rp *RestorePlan) *RestorePlanDeleteOne {
+	return c.DeleteOneID(rp.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RestorePlanClient) DeleteOneID(id string) *RestorePlanDeleteOne {
+	builder := c.Delete().Where(restoreplan.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RestorePlanDeleteOne{builder}
+}
+
+// Query returns a query builder for RestorePlan.
+func (c *RestorePlanClient) Query() *RestorePlanQuery {
+	return &RestorePlanQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRestorePlan},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RestorePlan entity by its id.
+func (c *RestorePlanClient) Get(ctx context.Context, id string) (*RestorePlan, error) {
+	return c.Query().Where(restoreplan.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RestorePlanClient) GetX(ctx context.Context, id string) *RestorePlan {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RestorePlanClient) Hooks() []Hook {
+	return c.hooks.RestorePlan
+}
+
+// Interceptors returns the client interceptors.
+func (c *RestorePlanClient) Interceptors() []Interceptor {
+	return c.inters.RestorePlan
+}
+
+func (c *RestorePlanClient) mutate(ctx context.Context, m *RestorePlanMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RestorePlanCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RestorePlanUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RestorePlanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RestorePlanDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RestorePlan mutation op: %q", m.Op())
+	}
+}
+
+// RetentionPolicyClient is a client for the RetentionPolicy schema.
+type RetentionPolicyClient struct {
+	config
+}
+
+// NewRetentionPolicyClient returns a client for the RetentionPolicy from the given config.
+func NewRetentionPolicyClient(c config) *RetentionPolicyClient {
+	return &RetentionPolicyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `retentionpolicy.Hooks(f(g(h())))`.
+func (c *RetentionPolicyClient) Use(hooks ...Hook) {
+	c.hooks.RetentionPolicy = append(c.hooks.RetentionPolicy, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `retentionpolicy.Intercept(f(g(h())))`.
+func (c *RetentionPolicyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RetentionPolicy = append(c.inters.RetentionPolicy, interceptors...)
+}
+
+// Create returns a builder for creating a RetentionPolicy entity.
+func (c *RetentionPolicyClient) Create() *RetentionPolicyCreate {
+	mutation := newRetentionPolicyMutation(c.config, OpCreate)
+	return &RetentionPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RetentionPolicy entities.
+func (c *RetentionPolicyClient) CreateBulk(builders ...*RetentionPolicyCreate) *RetentionPolicyCreateBulk {
+	return &RetentionPolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RetentionPolicyClient) MapCreateBulk(slice any, setFunc func(*RetentionPolicyCreate, int)) *RetentionPolicyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RetentionPolicyCreateBulk{err: fmt.Errorf("calling to RetentionPolicyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RetentionPolicyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RetentionPolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RetentionPolicy.
+func (c *RetentionPolicyClient) Update() *RetentionPolicyUpdate {
+	mutation := newRetentionPolicyMutation(c.config, OpUpdate)
+	return &RetentionPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RetentionPolicyClient) UpdateOne(rp *RetentionPolicy) *RetentionPolicyUpdateOne {
+	mutation := newRetentionPolicyMutation(c.config, OpUpdateOne, withRetentionPolicy(rp))
+	return &RetentionPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RetentionPolicyClient) UpdateOneID(id string) *RetentionPolicyUpdateOne {
+	mutation := newRetentionPolicyMutation(c.config, OpUpdateOne, withRetentionPolicyID(id))
+	return &RetentionPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RetentionPolicy.
+func (c *RetentionPolicyClient) Delete() *RetentionPolicyDelete {
+	mutation := newRetentionPolicyMutation(c.config, OpDelete)
+	return &RetentionPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RetentionPolicyClient) DeleteOne(rp *RetentionPolicy) *RetentionPolicyDeleteOne {
+	return c.DeleteOneID(rp.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RetentionPolicyClient) DeleteOneID(id string) *RetentionPolicyDeleteOne {
+	builder := c.Delete().Where(retentionpolicy.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RetentionPolicyDeleteOne{builder}
+}
+
+// Query returns a query builder for RetentionPolicy.
+func (c *RetentionPolicyClient) Query() *RetentionPolicyQuery {
+	return &RetentionPolicyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRetentionPolicy},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RetentionPolicy entity by its id.
+func (c *RetentionPolicyClient) Get(ctx context.Context, id string) (*RetentionPolicy, error) {
+	return c.Query().Where(retentionpolicy.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RetentionPolicyClient) GetX(ctx context.Context, id string) *RetentionPolicy {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RetentionPolicyClient) Hooks() []Hook {
+	return c.hooks.RetentionPolicy
+}
+
+// Interceptors returns the client interceptors.
+func (c *RetentionPolicyClient) Interceptors() []Interceptor {
+	return c.inters.RetentionPolicy
+}
+
+func (c *RetentionPolicyClient) mutate(ctx context.Context, m *RetentionPolicyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RetentionPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RetentionPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RetentionPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RetentionPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RetentionPolicy mutation op: %q", m.Op())
+	}
+}
+
+// SavedAlbumClient is a client for the SavedAlbum schema.
+type SavedAlbumClient struct {
+	config
+}
+
+// NewSavedAlbumClient returns a client for the SavedAlbum from the given config.
+func NewSavedAlbumClient(c config) *SavedAlbumClient {
+	return &SavedAlbumClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `savedalbum.Hooks(f(g(h())))`.
+func (c *SavedAlbumClient) Use(hooks ...Hook) {
+	c.hooks.SavedAlbum = append(c.hooks.SavedAlbum, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `savedalbum.Intercept(f(g(h())))`.
+func (c *SavedAlbumClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SavedAlbum = append(c.inters.SavedAlbum, interceptors...)
+}
+
+// Create returns a builder for creating a SavedAlbum entity.
+func (c *SavedAlbumClient) Create() *SavedAlbumCreate {
+	mutation := newSavedAlbumMutation(c.config, OpCreate)
+	return &SavedAlbumCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SavedAlbum entities.
+func (c *SavedAlbumClient) CreateBulk(builders ...*SavedAlbumCreate) *SavedAlbumCreateBulk {
+	return &SavedAlbumCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SavedAlbumClient) MapCreateBulk(slice any, setFunc func(*SavedAlbumCreate, int)) *SavedAlbumCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SavedAlbumCreateBulk{err: fmt.Errorf("calling to SavedAlbumClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SavedAlbumCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SavedAlbumCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SavedAlbum.
+func (c *SavedAlbumClient) Update() *SavedAlbumUpdate {
+	mutation := newSavedAlbumMutation(c.config, OpUpdate)
+	return &SavedAlbumUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SavedAlbumClient) UpdateOne(sa *SavedAlbum) *SavedAlbumUpdateOne {
+	mutation := newSavedAlbumMutation(c.config, OpUpdateOne, withSavedAlbum(sa))
+	return &SavedAlbumUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SavedAlbumClient) UpdateOneID(id string) *SavedAlbumUpdateOne {
+	mutation := newSavedAlbumMutation(c.config, OpUpdateOne, withSavedAlbumID(id))
+	return &SavedAlbumUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SavedAlbum.
+func (c *SavedAlbumClient) Delete() *SavedAlbumDelete {
+	mutation := newSavedAlbumMutation(c.config, OpDelete)
+	return &SavedAlbumDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SavedAlbumClient) DeleteOne(sa *SavedAlbum) *SavedAlbumDeleteOne {
+	return c.DeleteOneID(sa.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SavedAlbumClient) DeleteOneID(id string) *SavedAlbumDeleteOne {
+	builder := c.Delete().Where(savedalbum.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SavedAlbumDeleteOne{builder}
+}
+
+// Query returns a query builder for SavedAlbum.
+func (c *SavedAlbumClient) Query() *SavedAlbumQuery {
+	return &SavedAlbumQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSavedAlbum},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SavedAlbum entity by its id.
+func (c *SavedAlbumClient) Get(ctx context.Context, id string) (*SavedAlbum, error) {
+	return c.Query().Where(savedalbum.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SavedAlbumClient) GetX(ctx context.Context, id string) *SavedAlbum {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRun queries the run edge of a SavedAlbum.
+func (c *SavedAlbumClient) QueryRun(sa *SavedAlbum) *BackupRunQuery {
+	query := (&BackupRunClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sa.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(savedalbum.Table, savedalbum.FieldID, id),
+			sqlgraph.To(backuprun.Table, backuprun.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, savedalbum.RunTable, savedalbum.RunColumn),
+		)
+		fromV = sqlgraph.Neighbors(sa.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAlbum queries the album edge of a SavedAlbum.
+func (c *SavedAlbumClient) QueryAlbum(sa *SavedAlbum) *AlbumQuery {
+	query := (&AlbumClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sa.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(savedalbum.Table, savedalbum.FieldID, id),
+			sqlgraph.To(album.Table, album.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, savedalbum.AlbumTable, savedalbum.AlbumColumn),
+		)
+		fromV = sqlgraph.Neighbors(sa.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SavedAlbumClient) Hooks() []Hook {
+	return c.hooks.SavedAlbum
+}
+
+// Interceptors returns the client interceptors.
+func (c *SavedAlbumClient) Interceptors() []Interceptor {
+	return c.inters.SavedAlbum
+}
+
+func (c *SavedAlbumClient) mutate(ctx context.Context, m *SavedAlbumMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SavedAlbumCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SavedAlbumUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SavedAlbumUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SavedAlbumDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SavedAlbum mutation op: %q", m.Op())
+	}
+}
+
+// SavedAudiobookClient is a client for the SavedAudiobook schema.
+type SavedAudiobookClient struct {
+	config
+}
+
+// NewSavedAudiobookClient returns a client for the SavedAudiobook from the given config.
+func NewSavedAudiobookClient(c config) *SavedAudiobookClient {
+	return &SavedAudiobookClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `savedaudiobook.Hooks(f(g(h())))`.
+func (c *SavedAudiobookClient) Use(hooks ...Hook) {
+	c.hooks.SavedAudiobook = append(c.hooks.SavedAudiobook, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `savedaudiobook.Intercept(f(g(h())))`.
+func (c *SavedAudiobookClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SavedAudiobook = append(c.inters.SavedAudiobook, interceptors...)
+}
+
+// Create returns a builder for creating a SavedAudiobook entity.
+func (c *SavedAudiobookClient) Create() *SavedAudiobookCreate {
+	mutation := newSavedAudiobookMutation(c.config, OpCreate)
+	return &SavedAudiobookCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SavedAudiobook entities.
+func (c *SavedAudiobookClient) CreateBulk(builders ...*SavedAudiobookCreate) *SavedAudiobookCreateBulk {
+	return &SavedAudiobookCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SavedAudiobookClient) MapCreateBulk(slice any, setFunc func(*SavedAudiobookCreate, int)) *SavedAudiobookCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SavedAudiobookCreateBulk{err: fmt.Errorf("calling to SavedAudiobookClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SavedAudiobookCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SavedAudiobookCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SavedAudiobook.
+func (c *SavedAudiobookClient) Update() *SavedAudiobookUpdate {
+	mutation := newSavedAudiobookMutation(c.config, OpUpdate)
+	return &SavedAudiobookUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SavedAudiobookClient) UpdateOne(sa *SavedAudiobook) *SavedAudiobookUpdateOne {
+	mutation := newSavedAudiobookMutation(c.config, OpUpdateOne, withSavedAudiobook(sa))
+	return &SavedAudiobookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SavedAudiobookClient) UpdateOneID(id string) *SavedAudiobookUpdateOne {
+	mutation := newSavedAudiobookMutation(c.config, OpUpdateOne, withSavedAudiobookID(id))
+	return &SavedAudiobookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SavedAudiobook.
+func (c *SavedAudiobookClient) Delete() *SavedAudiobookDelete {
+	mutation := newSavedAudiobookMutation(c.config, OpDelete)
+	return &SavedAudiobookDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SavedAudiobookClient) DeleteOne(sa *SavedAudiobook) *SavedAudiobookDeleteOne {
+	return c.DeleteOneID(sa.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SavedAudiobookClient) DeleteOneID(id string) *SavedAudiobookDeleteOne {
+	builder := c.Delete().Where(savedaudiobook.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SavedAudiobookDeleteOne{builder}
+}
+
+// Query returns a query builder for SavedAudiobook.
+func (c *SavedAudiobookClient) Query() *SavedAudiobookQuery {
+	return &SavedAudiobookQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSavedAudiobook},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SavedAudiobook entity by its id.
+func (c *SavedAudiobookClient) Get(ctx context.Context, id string) (*SavedAudiobook, error) {
+	return c.Query().Where(savedaudiobook.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SavedAudiobookClient) GetX(ctx context.Context, id string) *SavedAudiobook {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRun queries the run edge of a SavedAudiobook.
+func (c *SavedAudiobookClient) QueryRun(sa *SavedAudiobook) *BackupRunQuery {
+	query := (&BackupRunClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sa.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(savedaudiobook.Table, savedaudiobook.FieldID, id),
+			sqlgraph.To(backuprun.Table, backuprun.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, savedaudiobook.RunTable, savedaudiobook.RunColumn),
+		)
+		fromV = sqlgraph.Neighbors(sa.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SavedAudiobookClient) Hooks() []Hook {
+	return c.hooks.SavedAudiobook
+}
+
+// Interceptors returns the client interceptors.
+func (c *SavedAudiobookClient) Interceptors() []Interceptor {
+	return c.inters.SavedAudiobook
+}
+
+func (c *SavedAudiobookClient) mutate(ctx context.Context, m *SavedAudiobookMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SavedAudiobookCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SavedAudiobookUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SavedAudiobookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SavedAudiobookDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SavedAudiobook mutation op: %q", m.Op())
+	}
+}
+
+// SavedEpisodeClient is a client for the SavedEpisode schema.
+type SavedEpisodeClient struct {
+	config
+}
+
+// NewSavedEpisodeClient returns a client for the SavedEpisode from the given config.
+func NewSavedEpisodeClient(c config) *SavedEpisodeClient {
+	return &SavedEpisodeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `savedepisode.Hooks(f(g(h())))`.
+func (c *SavedEpisodeClient) Use(hooks ...Hook) {
+	c.hooks.SavedEpisode = append(c.hooks.SavedEpisode, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `savedepisode.Intercept(f(g(h())))`.
+func (c *SavedEpisodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SavedEpisode = append(c.inters.SavedEpisode, interceptors...)
+}
+
+// Create returns a builder for creating a SavedEpisode entity.
+func (c *SavedEpisodeClient) Create() *SavedEpisodeCreate {
+	mutation := newSavedEpisodeMutation(c.config, OpCreate)
+	return &SavedEpisodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SavedEpisode entities.
+func (c *SavedEpisodeClient) CreateBulk(builders ...*SavedEpisodeCreate) *SavedEpisodeCreateBulk {
+	return &SavedEpisodeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SavedEpisodeClient) MapCreateBulk(slice any, setFunc func(*SavedEpisodeCreate, int)) *SavedEpisodeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SavedEpisodeCreateBulk{err: fmt.Errorf("calling to SavedEpisodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SavedEpisodeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SavedEpisodeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SavedEpisode.
+func (c *SavedEpisodeClient) Update() *SavedEpisodeUpdate {
+	mutation := newSavedEpisodeMutation(c.config, OpUpdate)
+	return &SavedEpisodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SavedEpisodeClient) UpdateOne(se *SavedEpisode) *SavedEpisodeUpdateOne {
+	mutation := newSavedEpisodeMutation(c.config, OpUpdateOne, withSavedEpisode(se))
+	return &SavedEpisodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SavedEpisodeClient) UpdateOneID(id string) *SavedEpisodeUpdateOne {
+	mutation := newSavedEpisodeMutation(c.config, OpUpdateOne, withSavedEpisodeID(id))
+	return &SavedEpisodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SavedEpisode.
+func (c *SavedEpisodeClient) Delete() *SavedEpisodeDelete {
+	mutation := newSavedEpisodeMutation(c.config, OpDelete)
+	return &SavedEpisodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SavedEpisodeClient) DeleteOne(se *SavedEpisode) *SavedEpisodeDeleteOne {
+	return c.DeleteOneID(se.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SavedEpisodeClient) DeleteOneID(id string) *SavedEpisodeDeleteOne {
+	builder := c.Delete().Where(savedepisode.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SavedEpisodeDeleteOne{builder}
+}
+
+// Query returns a query builder for SavedEpisode.
+func (c *SavedEpisodeClient) Query() *SavedEpisodeQuery {
+	return &SavedEpisodeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSavedEpisode},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SavedEpisode entity by its id.
+func (c *SavedEpisodeClient) Get(ctx context.Context, id string) (*SavedEpisode, error) {
+	return c.Query().Where(savedepisode.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SavedEpisodeClient) GetX(ctx context.Context, id string) *SavedEpisode {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRun queries the run edge of a SavedEpisode.
+func (c *SavedEpisodeClient) QueryRun(se *SavedEpisode) *BackupRunQuery {
+	query := (&BackupRunClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := se.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(savedepisode.Table, savedepisode.FieldID, id),
+			sqlgraph.To(backuprun.Table, backuprun.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, savedepisode.RunTable, savedepisode.RunColumn),
+		)
+		fromV = sqlgraph.Neighbors(se.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEpisode queries the episode edge of a SavedEpisode.
+func (c *SavedEpisodeClient) QueryEpisode(se *SavedEpisode) *EpisodeQuery {
+	query := (&EpisodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := se.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(savedepisode.Table, savedepisode.FieldID, id),
+			sqlgraph.To(episode.Table, episode.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, savedepisode.EpisodeTable, savedepisode.EpisodeColumn),
+		)
+		fromV = sqlgraph.Neighbors(se.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SavedEpisodeClient) Hooks() []Hook {
+	return c.hooks.SavedEpisode
+}
+
+// Interceptors returns the client interceptors.
+func (c *SavedEpisodeClient) Interceptors() []Interceptor {
+	return c.inters.SavedEpisode
+}
+
+func (c *SavedEpisodeClient) mutate(ctx context.Context, m *SavedEpisodeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SavedEpisodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SavedEpisodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SavedEpisodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SavedEpisodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SavedEpisode mutation op: %q", m.Op())
+	}
+}
+
+// SavedShowClient is a client for the SavedShow schema.
+type SavedShowClient struct {
+	config
+}
+
+// NewSavedShowClient returns a client for the SavedShow from the given config.
+func NewSavedShowClient(c config) *SavedShowClient {
+	return &SavedShowClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `savedshow.Hooks(f(g(h())))`.
+func (c *SavedShowClient) Use(hooks ...Hook) {
+	c.hooks.SavedShow = append(c.hooks.SavedShow, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `savedshow.Intercept(f(g(h())))`.
+func (c *SavedShowClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SavedShow = append(c.inters.SavedShow, interceptors...)
+}
+
+// Create returns a builder for creating a SavedShow entity.
+func (c *SavedShowClient) Create() *SavedShowCreate {
+	mutation := newSavedShowMutation(c.config, OpCreate)
+	return &SavedShowCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SavedShow entities.
+func (c *SavedShowClient) CreateBulk(builders ...*SavedShowCreate) *SavedShowCreateBulk {
+	return &SavedShowCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SavedShowClient) MapCreateBulk(slice any, setFunc func(*SavedShowCreate, int)) *SavedShowCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SavedShowCreateBulk{err: fmt.Errorf("calling to SavedShowClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SavedShowCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SavedShowCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SavedShow.
+func (c *SavedShowClient) Update() *SavedShowUpdate {
+	mutation := newSavedShowMutation(c.config, OpUpdate)
+	return &SavedShowUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SavedShowClient) UpdateOne(ss *SavedShow) *SavedShowUpdateOne {
+	mutation := newSavedShowMutation(c.config, OpUpdateOne, withSavedShow(ss))
+	return &SavedShowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SavedShowClient) UpdateOneID(id string) *SavedShowUpdateOne {
+	mutation := newSavedShowMutation(c.config, OpUpdateOne, withSavedShowID(id))
+	return &SavedShowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SavedShow.
+func (c *SavedShowClient) Delete() *SavedShowDelete {
+	mutation := newSavedShowMutation(c.config, OpDelete)
+	return &SavedShowDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SavedShowClient) DeleteOne(ss *SavedShow) *SavedShowDeleteOne {
+	return c.DeleteOneID(ss.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SavedShowClient) DeleteOneID(id string) *SavedShowDeleteOne {
+	builder := c.Delete().Where(savedshow.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SavedShowDeleteOne{builder}
+}
+
+// Query returns a query builder for SavedShow.
+func (c *SavedShowClient) Query() *SavedShowQuery {
+	return &SavedShowQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSavedShow},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SavedShow entity by its id.
+func (c *SavedShowClient) Get(ctx context.Context, id string) (*SavedShow, error) {
+	return c.Query().Where(savedshow.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SavedShowClient) GetX(ctx context.Context, id string) *SavedShow {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRun queries the run edge of a SavedShow.
+func (c *SavedShowClient) QueryRun(ss *SavedShow) *BackupRunQuery {
+	query := (&BackupRunClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ss.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(savedshow.Table, savedshow.FieldID, id),
+			sqlgraph.To(backuprun.Table, backuprun.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, savedshow.RunTable, savedshow.RunColumn),
+		)
+		fromV = sqlgraph.Neighbors(ss.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryShow queries the show edge of a SavedShow.
+func (c *SavedShowClient) QueryShow(ss *SavedShow) *ShowQuery {
+	query := (&ShowClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ss.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(savedshow.Table, savedshow.FieldID, id),
+			sqlgraph.To(show.Table, show.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, savedshow.ShowTable, savedshow.ShowColumn),
+		)
+		fromV = sqlgraph.Neighbors(ss.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SavedShowClient) Hooks() []Hook {
+	return c.hooks.SavedShow
+}
+
+// Interceptors returns the client interceptors.
+func (c *SavedShowClient) Interceptors() []Interceptor {
+	return c.inters.SavedShow
+}
+
+func (c *SavedShowClient) mutate(ctx context.Context, m *SavedShowMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SavedShowCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SavedShowUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SavedShowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SavedShowDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SavedShow mutation op: %q", m.Op())
+	}
+}
+
+// SavedTrackClient is a client for the SavedTrack schema.
+type SavedTrackClient struct {
+	config
+}
+
+// NewSavedTrackClient returns a client for the SavedTrack from the given config.
+func NewSavedTrackClient(c config) *SavedTrackClient {
+	return &SavedTrackClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `savedtrack.Hooks(f(g(h())))`.
+func (c *SavedTrackClient) Use(hooks ...Hook) {
+	c.hooks.SavedTrack = append(c.hooks.SavedTrack, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `savedtrack.Intercept(f(g(h())))`.
+func (c *SavedTrackClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SavedTrack = append(c.inters.SavedTrack, interceptors...)
+}
+
+// Create returns a builder for creating a SavedTrack entity.
+func (c *SavedTrackClient) Create() *SavedTrackCreate {
+	mutation := newSavedTrackMutation(c.config, OpCreate)
+	return &SavedTrackCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SavedTrack entities.
+func (c *SavedTrackClient) CreateBulk(builders ...*SavedTrackCreate) *SavedTrackCreateBulk {
+	return &SavedTrackCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SavedTrackClient) MapCreateBulk(slice any, setFunc func(*SavedTrackCreate, int)) *SavedTrackCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SavedTrackCreateBulk{err: fmt.Errorf("calling to SavedTrackClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SavedTrackCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SavedTrackCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SavedTrack.
+func (c *SavedTrackClient) Update() *SavedTrackUpdate {
+	mutation := newSavedTrackMutation(c.config, OpUpdate)
+	return &SavedTrackUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SavedTrackClient) UpdateOne(st *SavedTrack) *SavedTrackUpdateOne {
+	mutation := newSavedTrackMutation(c.config, OpUpdateOne, withSavedTrack(st))
+	return &SavedTrackUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SavedTrackClient) UpdateOneID(id string) *SavedTrackUpdateOne {
+	mutation := newSavedTrackMutation(c.config, OpUpdateOne, withSavedTrackID(id))
+	return &SavedTrackUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SavedTrack.
+func (c *SavedTrackClient) Delete() *SavedTrackDelete {
+	mutation := newSavedTrackMutation(c.config, OpDelete)
+	return &SavedTrackDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SavedTrackClient) DeleteOne(st *SavedTrack) *SavedTrackDeleteOne {
+	return c.DeleteOneID(st.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SavedTrackClient) DeleteOneID(id string) *SavedTrackDeleteOne {
+	builder := c.Delete().Where(savedtrack.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SavedTrackDeleteOne{builder}
+}
+
+// Query returns a query builder for SavedTrack.
+func (c *SavedTrackClient) Query() *SavedTrackQuery {
+	return &SavedTrackQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSavedTrack},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SavedTrack entity by its id.
+func (c *SavedTrackClient) Get(ctx context.Context, id string) (*SavedTrack, error) {
+	return c.Query().Where(savedtrack.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SavedTrackClient) GetX(ctx context.Context, id string) *SavedTrack {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRun queries the run edge of a SavedTrack.
+func (c *SavedTrackClient) QueryRun(st *SavedTrack) *BackupRunQuery {
+	query := (&BackupRunClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := st.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(savedtrack.Table, savedtrack.FieldID, id),
+			sqlgraph.To(backuprun.Table, backuprun.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, savedtrack.RunTable, savedtrack.RunColumn),
+		)
+		fromV = sqlgraph.Neighbors(st.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTrack queries the track edge of a SavedTrack.
+func (c *SavedTrackClient) QueryTrack(st *SavedTrack) *TrackQuery {
+	query := (&TrackClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := st.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(savedtrack.Table, savedtrack.FieldID, id),
+			sqlgraph.To(track.Table, track.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, savedtrack.TrackTable, savedtrack.TrackColumn),
+		)
+		fromV = sqlgraph.Neighbors(st.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SavedTrackClient) Hooks() []Hook {
+	return c.hooks.SavedTrack
+}
+
+// Interceptors returns the client interceptors.
+func (c *SavedTrackClient) Interceptors() []Interceptor {
+	return c.inters.SavedTrack
+}
+
+func (c *SavedTrackClient) mutate(ctx context.Context, m *SavedTrackMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SavedTrackCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SavedTrackUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SavedTrackUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SavedTrackDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SavedTrack mutation op: %q", m.Op())
+	}
+}
+
+// SchemaMigrationClient is a client for the SchemaMigration schema.
+type SchemaMigrationClient struct {
+	config
+}
+
+// NewSchemaMigrationClient returns a client for the SchemaMigration from the given config.
+func NewSchemaMigrationClient(c config) *SchemaMigrationClient {
+	return &SchemaMigrationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `schemamigration.Hooks(f(g(h())))`.
+func (c *SchemaMigrationClient) Use(hooks ...Hook) {
+	c.hooks.SchemaMigration = append(c.hooks.SchemaMigration, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `schemamigration.Intercept(f(g(h())))`.
+func (c *SchemaMigrationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SchemaMigration = append(c.inters.SchemaMigration, interceptors...)
+}
+
+// Create returns a builder for creating a SchemaMigration entity.
+func (c *SchemaMigrationClient) Create() *SchemaMigrationCreate {
+	mutation := newSchemaMigrationMutation(c.config, OpCreate)
+	return &SchemaMigrationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SchemaMigration entities.
+func (c *SchemaMigrationClient) CreateBulk(builders ...*SchemaMigrationCreate) *SchemaMigrationCreateBulk {
+	return &SchemaMigrationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SchemaMigrationClient) MapCreateBulk(slice any, setFunc func(*SchemaMigrationCreate, int)) *SchemaMigrationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SchemaMigrationCreateBulk{err: fmt.Errorf("calling to SchemaMigrationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SchemaMigrationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SchemaMigrationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SchemaMigration.
+func (c *SchemaMigrationClient) Update() *SchemaMigrationUpdate {
+	mutation := newSchemaMigrationMutation(c.config, OpUpdate)
+	return &SchemaMigrationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SchemaMigrationClient) UpdateOne(sm *SchemaMigration) *SchemaMigrationUpdateOne {
+	mutation := newSchemaMigrationMutation(c.config, OpUpdateOne, withSchemaMigration(sm))
+	return &SchemaMigrationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SchemaMigrationClient) UpdateOneID(id int) *SchemaMigrationUpdateOne {
+	mutation := newSchemaMigrationMutation(c.config, OpUpdateOne, withSchemaMigrationID(id))
+	return &SchemaMigrationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SchemaMigration.
+func (c *SchemaMigrationClient) Delete() *SchemaMigrationDelete {
+	mutation := newSchemaMigrationMutation(c.config, OpDelete)
+	return &SchemaMigrationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SchemaMigrationClient) DeleteOne(sm *SchemaMigration) *SchemaMigrationDeleteOne {
+	return c.DeleteOneID(sm.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SchemaMigrationClient) DeleteOneID(id int) *SchemaMigrationDeleteOne {
+	builder := c.Delete().Where(schemamigration.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SchemaMigrationDeleteOne{builder}
+}
+
+// Query returns a query builder for SchemaMigration.
+func (c *SchemaMigrationClient) Query() *SchemaMigrationQuery {
+	return &SchemaMigrationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSchemaMigration},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SchemaMigration entity by its id.
+func (c *SchemaMigrationClient) Get(ctx context.Context, id int) (*SchemaMigration, error) {
+	return c.Query().Where(schemamigration.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SchemaMigrationClient) GetX(ctx context.Context, id int) *SchemaMigration {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SchemaMigrationClient) Hooks() []Hook {
+	return c.hooks.SchemaMigration
+}
+
+// Interceptors returns the client interceptors.
+func (c *SchemaMigrationClient) Interceptors() []Interceptor {
+	return c.inters.SchemaMigration
+}
+
+func (c *SchemaMigrationClient) mutate(ctx context.Context, m *SchemaMigrationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SchemaMigrationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SchemaMigrationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SchemaMigrationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SchemaMigrationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SchemaMigration mutation op: %q", m.Op())
+	}
+}
+
+// ShowClient is a client for the Show schema.
+type ShowClient struct {
+	config
+}
+
+// NewShowClient returns a client for the Show from the given config.
+func NewShowClient(c config) *ShowClient {
+	return &ShowClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `show.Hooks(f(g(h())))`.
+func (c *ShowClient) Use(hooks ...Hook) {
+	c.hooks.Show = append(c.hooks.Show, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `show.Intercept(f(g(h())))`.
+func (c *ShowClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Show = append(c.inters.Show, interceptors...)
+}
+
+// Create returns a builder for creating a Show entity.
+func (c *ShowClient) Create() *ShowCreate {
+	mutation := newShowMutation(c.config, OpCreate)
+	return &ShowCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Show entities.
+func (c *ShowClient) CreateBulk(builders ...*ShowCreate) *ShowCreateBulk {
+	return &ShowCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ShowClient) MapCreateBulk(slice any, setFunc func(*ShowCreate, int)) *ShowCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ShowCreateBulk{err: fmt.Errorf("calling to ShowClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ShowCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ShowCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Show.
+func (c *ShowClient) Update() *ShowUpdate {
+	mutation := newShowMutation(c.config, OpUpdate)
+	return &ShowUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ShowClient) UpdateOne(s *Show) *ShowUpdateOne {
+	mutation := newShowMutation(c.config, OpUpdateOne, withShow(s))
+	return &ShowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ShowClient) UpdateOneID(id string) *ShowUpdateOne {
+	mutation := newShowMutation(c.config, OpUpdateOne, withShowID(id))
+	return &ShowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Show.
+func (c *ShowClient) Delete() *ShowDelete {
+	mutation := newShowMutation(c.config, OpDelete)
+	return &ShowDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ShowClient) DeleteOne(s *Show) *ShowDeleteOne {
+	return c.DeleteOneID(s.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ShowClient) DeleteOneID(id string) *ShowDeleteOne {
+	builder := c.Delete().Where(show.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ShowDeleteOne{builder}
+}
+
+// Query returns a query builder for Show.
+func (c *ShowClient) Query() *ShowQuery {
+	return &ShowQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeShow},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Show entity by its id.
+func (c *ShowClient) Get(ctx context.Context, id string) (*Show, error) {
+	return c.Query().Where(show.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ShowClient) GetX(ctx context.Context, id string) *Show {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryEpisodes queries the episodes edge of a Show.
+func (c *ShowClient) QueryEpisodes(s *Show) *EpisodeQuery {
+	query := (&EpisodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(show.Table, show.FieldID, id),
+			sqlgraph.To(episode.Table, episode.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, show.EpisodesTable, show.EpisodesColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySavedShows queries the saved_shows edge of a Show.
+func (c *ShowClient) QuerySavedShows(s *Show) *SavedShowQuery {
+	query := (&SavedShowClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(show.Table, show.FieldID, id),
+			sqlgraph.To(savedshow.Table, savedshow.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, show.SavedShowsTable, show.SavedShowsColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ShowClient) Hooks() []Hook {
+	return c.hooks.Show
+}
+
+// Interceptors returns the client interceptors.
+func (c *ShowClient) Interceptors() []Interceptor {
+	return c.inters.Show
+}
+
+func (c *ShowClient) mutate(ctx context.Context, m *ShowMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ShowCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ShowUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ShowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ShowDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Show mutation op: %q", m.Op())
+	}
+}
+
+// SnapshotItemClient is a client for the SnapshotItem schema.
+type SnapshotItemClient struct {
+	config
+}
+
+// NewSnapshotItemClient returns a client for the SnapshotItem from the given config.
+func NewSnapshotItemClient(c config) *SnapshotItemClient {
+	return &SnapshotItemClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `snapshotitem.Hooks(f(g(h())))`.
+func (c *SnapshotItemClient) Use(hooks ...Hook) {
+	c.hooks.SnapshotItem = append(c.hooks.SnapshotItem, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `snapshotitem.Intercept(f(g(h())))`.
+func (c *SnapshotItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SnapshotItem = append(c.inters.SnapshotItem, interceptors...)
+}
+
+// Create returns a builder for creating a SnapshotItem entity.
+func (c *SnapshotItemClient) Create() *SnapshotItemCreate {
+	mutation := newSnapshotItemMutation(c.config, OpCreate)
+	return &SnapshotItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SnapshotItem entities.
+func (c *SnapshotItemClient) CreateBulk(builders ...*SnapshotItemCreate) *SnapshotItemCreateBulk {
+	return &SnapshotItemCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SnapshotItemClient) MapCreateBulk(slice any, setFunc func(*SnapshotItemCreate, int)) *SnapshotItemCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SnapshotItemCreateBulk{err: fmt.Errorf("calling to SnapshotItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SnapshotItemCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SnapshotItemCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SnapshotItem.
+func (c *SnapshotItemClient) Update() *SnapshotItemUpdate {
+	mutation := newSnapshotItemMutation(c.config, OpUpdate)
+	return &SnapshotItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SnapshotItemClient) UpdateOne(si *SnapshotItem) *SnapshotItemUpdateOne {
+	mutation := newSnapshotItemMutation(c.config, OpUpdateOne, withSnapshotItem(si))
+	return &SnapshotItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SnapshotItemClient) UpdateOneID(id string) *SnapshotItemUpdateOne {
+	mutation := newSnapshotItemMutation(c.config, OpUpdateOne, withSnapshotItemID(id))
+	return &SnapshotItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SnapshotItem.
+func (c *SnapshotItemClient) Delete() *SnapshotItemDelete {
+	mutation := newSnapshotItemMutation(c.config, OpDelete)
+	return &SnapshotItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SnapshotItemClient) DeleteOne(si *SnapshotItem) *SnapshotItemDeleteOne {
+	return c.DeleteOneID(si.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SnapshotItemClient) DeleteOneID(id string) *SnapshotItemDeleteOne {
+	builder := c.Delete().Where(snapshotitem.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SnapshotItemDeleteOne{builder}
+}
+
+// Query returns a query builder for SnapshotItem.
+func (c *SnapshotItemClient) Query() *SnapshotItemQuery {
+	return &SnapshotItemQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSnapshotItem},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SnapshotItem entity by its id.
+func (c *SnapshotItemClient) Get(ctx context.Context, id string) (*SnapshotItem, error) {
+	return c.Query().Where(snapshotitem.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SnapshotItemClient) GetX(ctx context.Context, id string) *SnapshotItem {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySnapshot queries the snapshot edge of a SnapshotItem.
+func (c *SnapshotItemClient) QuerySnapshot(si *SnapshotItem) *PlaylistSnapshotQuery {
+	query := (&PlaylistSnapshotClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := si.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(snapshotitem.Table, snapshotitem.FieldID, id),
+			sqlgraph.To(playlistsnapshot.Table, playlistsnapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, snapshotitem.SnapshotTable, snapshotitem.SnapshotColumn),
+		)
+		fromV = sqlgraph.Neighbors(si.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTrack queries the track edge of a SnapshotItem.
+func (c *SnapshotItemClient) QueryTrack(si *SnapshotItem) *TrackQuery {
+	query := (&TrackClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := si.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(snapshotitem.Table, snapshotitem.FieldID, id),
+			sqlgraph.To(track.Table, track.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, snapshotitem.TrackTable, snapshotitem.TrackColumn),
+		)
+		fromV = sqlgraph.Neighbors(si.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEpisode queries the episode edge of a SnapshotItem.
+func (c *SnapshotItemClient) QueryEpisode(si *SnapshotItem) *EpisodeQuery {
+	query := (&EpisodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := si.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(snapshotitem.Table, snapshotitem.FieldID, id),
+			sqlgraph.To(episode.Table, episode.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, snapshotitem.EpisodeTable, snapshotitem.EpisodeColumn),
+		)
+		fromV = sqlgraph.Neighbors(si.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SnapshotItemClient) Hooks() []Hook {
+	return c.hooks.SnapshotItem
+}
+
+// Interceptors returns the client interceptors.
+func (c *SnapshotItemClient) Interceptors() []Interceptor {
+	return c.inters.SnapshotItem
+}
+
+func (c *SnapshotItemClient) mutate(ctx context.Context, m *SnapshotItemMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SnapshotItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SnapshotItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SnapshotItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SnapshotItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SnapshotItem mutation op: %q", m.Op())
+	}
+}
+
+// SpotifyTokenClient is a client for the SpotifyToken schema.
+type SpotifyTokenClient struct {
+	config
+}
+
+// NewSpotifyTokenClient returns a client for the SpotifyToken from the given config.
+func NewSpotifyTokenClient(c config) *SpotifyTokenClient {
+	return &SpotifyTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `spotifytoken.Hooks(f(g(h())))`.
+func (c *SpotifyTokenClient) Use(hooks ...Hook) {
+	c.hooks.SpotifyToken = append(c.hooks.SpotifyToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `spotifytoken.Intercept(f(g(h())))`.
+func (c *SpotifyTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SpotifyToken = append(c.inters.SpotifyToken, interceptors...)
+}
+
+// Create returns a builder for creating a SpotifyToken entity.
+func (c *SpotifyTokenClient) Create() *SpotifyTokenCreate {
+	mutation := newSpotifyTokenMutation(c.config, OpCreate)
+	return &SpotifyTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SpotifyToken entities.
+func (c *SpotifyTokenClient) CreateBulk(builders ...*SpotifyTokenCreate) *SpotifyTokenCreateBulk {
+	return &SpotifyTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SpotifyTokenClient) MapCreateBulk(slice any, setFunc func(*SpotifyTokenCreate, int)) *SpotifyTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SpotifyTokenCreateBulk{err: fmt.Errorf("calling to SpotifyTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SpotifyTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SpotifyTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SpotifyToken.
+func (c *SpotifyTokenClient) Update() *SpotifyTokenUpdate {
+	mutation := newSpotifyTokenMutation(c.config, OpUpdate)
+	return &SpotifyTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SpotifyTokenClient) UpdateOne(st *SpotifyToken) *SpotifyTokenUpdateOne {
+	mutation := newSpotifyTokenMutation(c.config, OpUpdateOne, withSpotifyToken(st))
+	return &SpotifyTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SpotifyTokenClient) UpdateOneID(id string) *SpotifyTokenUpdateOne {
+	mutation := newSpotifyTokenMutation(c.config, OpUpdateOne, withSpotifyTokenID(id))
+	return &SpotifyTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SpotifyToken.
+func (c *SpotifyTokenClient) Delete() *SpotifyTokenDelete {
+	mutation := newSpotifyTokenMutation(c.config, OpDelete)
+	return &SpotifyTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SpotifyTokenClient) DeleteOne(st *SpotifyToken) *SpotifyTokenDeleteOne {
+	return c.DeleteOneID(st.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SpotifyTokenClient) DeleteOneID(id string) *SpotifyTokenDeleteOne {
+	builder := c.Delete().Where(spotifytoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SpotifyTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for SpotifyToken.
+func (c *SpotifyTokenClient) Query() *SpotifyTokenQuery {
+	return &SpotifyTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSpotifyToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SpotifyToken entity by its id.
+func (c *SpotifyTokenClient) Get(ctx context.Context, id string) (*SpotifyToken, error) {
+	return c.Query().Where(spotifytoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SpotifyTokenClient) GetX(ctx context.Context, id string) *SpotifyToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SpotifyTokenClient) Hooks() []Hook {
+	return c.hooks.SpotifyToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *SpotifyTokenClient) Interceptors() []Interceptor {
+	return c.inters.SpotifyToken
+}
+
+func (c *SpotifyTokenClient) mutate(ctx context.Context, m *SpotifyTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SpotifyTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SpotifyTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SpotifyTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SpotifyTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SpotifyToken mutation op: %q", m.Op())
+	}
+}
+
+// TrackClient is a client for the Track schema.
+type TrackClient struct {
+	config
+}
+
+// NewTrackClient returns a client for the Track from the given config.
+func NewTrackClient(c config) *TrackClient {
+	return &TrackClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `track.Hooks(f(g(h())))`.
+func (c *TrackClient) Use(hooks ...Hook) {
+	c.hooks.Track = append(c.hooks.Track, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `track.Intercept(f(g(h())))`.
+func (c *TrackClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Track = append(c.inters.Track, interceptors...)
+}
+
+// Create returns a builder for creating a Track entity.
+func (c *TrackClient) Create() *TrackCreate {
+	mutation := newTrackMutation(c.config, OpCreate)
+	return &TrackCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Track entities.
+func (c *TrackClient) CreateBulk(builders ...*TrackCreate) *TrackCreateBulk {
+	return &TrackCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TrackClient) MapCreateBulk(slice any, setFunc func(*TrackCreate, int)) *TrackCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TrackCreateBulk{err: fmt.Errorf("calling to TrackClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TrackCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TrackCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Track.
+func (c *TrackClient) Update() *TrackUpdate {
+	mutation := newTrackMutation(c.config, OpUpdate)
+	return &TrackUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TrackClient) UpdateOne(t *Track) *TrackUpdateOne {
+	mutation := newTrackMutation(c.config, OpUpdateOne, withTrack(t))
+	return &TrackUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TrackClient) UpdateOneID(id string) *TrackUpdateOne {
+	mutation := newTrackMutation(c.config, OpUpdateOne, withTrackID(id))
+	return &TrackUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Track.
+func (c *TrackClient) Delete() *TrackDelete {
+	mutation := newTrackMutation(c.config, OpDelete)
+	return &TrackDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TrackClient) DeleteOne(t *Track) *TrackDeleteOne {
+	return c.DeleteOneID(t.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TrackClient) DeleteOneID(id string) *TrackDeleteOne {
+	builder := c.Delete().Where(track.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TrackDeleteOne{builder}
+}
+
+// Query returns a query builder for Track.
+func (c *TrackClient) Query() *TrackQuery {
+	return &TrackQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTrack},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Track entity by its id.
+func (c *TrackClient) Get(ctx context.Context, id string) (*Track, error) {
+	return c.Query().Where(track.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TrackClient) GetX(ctx context.Context, id string) *Track {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAlbum queries the album edge of a Track.
+func (c *TrackClient) QueryAlbum(t *Track) *AlbumQuery {
+	query := (&AlbumClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(track.Table, track.FieldID, id),
+			sqlgraph.To(album.Table, album.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, track.AlbumTable, track.AlbumColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryArtists queries the artists edge of a Track.
+func (c *TrackClient) QueryArtists(t *Track) *ArtistQuery {
+	query := (&ArtistClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(track.Table, track.FieldID, id),
+			sqlgraph.To(artist.Table, artist.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, track.ArtistsTable, track.ArtistsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySnapshotItems queries the snapshot_items edge of a Track.
+func (c *TrackClient) QuerySnapshotItems(t *Track) *SnapshotItemQuery {
+	query := (&SnapshotItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(track.Table, track.FieldID, id),
+			sqlgraph.To(snapshotitem.Table, snapshotitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, track.SnapshotItemsTable, track.SnapshotItemsColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySavedTracks queries the saved_tracks edge of a Track.
+func (c *TrackClient) QuerySavedTracks(t *Track) *SavedTrackQuery {
+	query := (&SavedTrackClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(track.Table, track.FieldID, id),
+			sqlgraph.To(savedtrack.Table, savedtrack.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, track.SavedTracksTable, track.SavedTracksColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TrackClient) Hooks() []Hook {
+	return c.hooks.Track
+}
+
+// Interceptors returns the client interceptors.
+func (c *TrackClient) Interceptors() []Interceptor {
+	return c.inters.Track
+}
+
+func (c *TrackClient) mutate(ctx context.Context, m *TrackMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TrackCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TrackUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TrackUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TrackDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Track mutation op: %q", m.Op())
+	}
+}
+
+// UserClient is a client for the User schema.
+type UserClient struct {
+	config
+}
+
+// NewUserClient returns a client for the User from the given config.
+func NewUserClient(c config) *UserClient {
+	return &UserClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `user.Hooks(f(g(h())))`.
+func (c *UserClient) Use(hooks ...Hook) {
+	c.hooks.User = append(c.hooks.User, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
+func (c *UserClient) Intercept(interceptors ...Interceptor) {
+	c.inters.User = append(c.inters.User, interceptors...)
+}
+
+// Create returns a builder for creating a User entity.
+func (c *UserClient) Create() *UserCreate {
+	mutation := newUserMutation(c.config, OpCreate)
+	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of User entities.
+func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserClient) MapCreateBulk(slice any, setFunc func(*UserCreate, int)) *UserCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserCreateBulk{err: fmt.Errorf("calling to UserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for User.
+func (c *UserClient) Update() *UserUpdate {
+	mutation := newUserMutation(c.config, OpUpdate)
+	return &UserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserClient) UpdateOne(u *User) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUser(u))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserClient) UpdateOneID(id string) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUserID(id))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for User.
+func (c *UserClient) Delete() *UserDelete {
+	mutation := newUserMutation(c.config, OpDelete)
+	return &UserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserClient) DeleteOne(u *User) *UserDeleteOne {
+	return c.DeleteOneID(u.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserClient) DeleteOneID(id string) *UserDeleteOne {
+	builder := c.Delete().Where(user.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserDeleteOne{builder}
+}
+
+// Query returns a query builder for User.
+func (c *UserClient) Query() *UserQuery {
+	return &UserQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUser},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a User entity by its id.
+func (c *UserClient) Get(ctx context.Context, id string) (*User, error) {
+	return c.Query().Where(user.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserClient) GetX(ctx context.Context, id string) *User {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UserClient) Hooks() []Hook {
+	return c.hooks.User
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserClient) Interceptors() []Interceptor {
+	return c.inters.User
+}
+
+func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown User mutation op: %q", m.Op())
+	}
+}
+
+// hooks and interceptors per client, for fast access.
+type (
+	hooks struct {
+		Album, Artist, AuthState, BackupRun, BackupSchedule, Episode, FollowedArtist,
+		Job, Lease, Playlist, PlaylistSnapshot, RestorePlan, RetentionPolicy,
+		SavedAlbum, SavedAudiobook, SavedEpisode, SavedShow, SavedTrack,
+		SchemaMigration, Show, SnapshotItem, SpotifyToken, Track, User []ent.Hook
+	}
+	inters struct {
+		Album, Artist, AuthState, BackupRun, BackupSchedule, Episode, FollowedArtist,
+		Job, Lease, Playlist, PlaylistSnapshot, RestorePlan, RetentionPolicy,
+		SavedAlbum, SavedAudiobook, SavedEpisode, SavedShow, SavedTrack,
+		SchemaMigration, Show, SnapshotItem, SpotifyToken, Track,
+		User []ent.Interceptor
+	}
+)
+
+// ExecContext allows calling the underlying ExecContext method of the driver if it is supported by it.
+// See, database/sql#DB.ExecContext for more information.
+func (c *config) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := c.driver.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the driver if it is supported by it.
+// See, database/sql#DB.QueryContext for more information.
+func (c *config) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := c.driver.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
@@ -3,6 +3,29 @@
 package ent
 
 import (
+	"beyerleinf/spotify-backup/ent/album"
+	"beyerleinf/spotify-backup/ent/artist"
+	"beyerleinf/spotify-backup/ent/authstate"
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/backupschedule"
+	"beyerleinf/spotify-backup/ent/episode"
+	"beyerleinf/spotify-backup/ent/followedartist"
+	"beyerleinf/spotify-backup/ent/job"
+	"beyerleinf/spotify-backup/ent/lease"
+	"beyerleinf/spotify-backup/ent/playlist"
+	"beyerleinf/spotify-backup/ent/playlistsnapshot"
+	"beyerleinf/spotify-backup/ent/restoreplan"
+	"beyerleinf/spotify-backup/ent/retentionpolicy"
+	"beyerleinf/spotify-backup/ent/savedalbum"
+	"beyerleinf/spotify-backup/ent/savedaudiobook"
+	"beyerleinf/spotify-backup/ent/savedepisode"
+	"beyerleinf/spotify-backup/ent/savedshow"
+	"beyerleinf/spotify-backup/ent/savedtrack"
+	"beyerleinf/spotify-backup/ent/schemamigration"
+	"beyerleinf/spotify-backup/ent/show"
+	"beyerleinf/spotify-backup/ent/snapshotitem"
+	"beyerleinf/spotify-backup/ent/spotifytoken"
+	"beyerleinf/spotify-backup/ent/track"
 	"beyerleinf/spotify-backup/ent/user"
 	"context"
 	"errors"
@@ -73,7 +96,30 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			user.Table: user.ValidColumn,
+			album.Table:            album.ValidColumn,
+			artist.Table:           artist.ValidColumn,
+			authstate.Table:        authstate.ValidColumn,
+			backuprun.Table:        backuprun.ValidColumn,
+			backupschedule.Table:   backupschedule.ValidColumn,
+			episode.Table:          episode.ValidColumn,
+			followedartist.Table:   followedartist.ValidColumn,
+			job.Table:              job.ValidColumn,
+			lease.Table:            lease.ValidColumn,
+			playlist.Table:         playlist.ValidColumn,
+			playlistsnapshot.Table: playlistsnapshot.ValidColumn,
+			restoreplan.Table:      restoreplan.ValidColumn,
+			retentionpolicy.Table:  retentionpolicy.ValidColumn,
+			savedalbum.Table:       savedalbum.ValidColumn,
+			savedaudiobook.Table:   savedaudiobook.ValidColumn,
+			savedepisode.Table:     savedepisode.ValidColumn,
+			savedshow.Table:        savedshow.ValidColumn,
+			savedtrack.Table:       savedtrack.ValidColumn,
+			schemamigration.Table:  schemamigration.ValidColumn,
+			show.Table:             show.ValidColumn,
+			snapshotitem.Table:     snapshotitem.ValidColumn,
+			spotifytoken.Table:     spotifytoken.ValidColumn,
+			track.Table:            track.ValidColumn,
+			user.Table:             user.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
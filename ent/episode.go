@@ -0,0 +1,245 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/episode"
+	"beyerleinf/spotify-backup/ent/show"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Episode is the model entity for the Episode schema.
+type Episode struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// SpotifyID holds the value of the "spotify_id" field.
+	SpotifyID string `json:"spotify_id,omitempty"`
+	// URI holds the value of the "uri" field.
+	URI string `json:"uri,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// DurationMs holds the value of the "duration_ms" field.
+	DurationMs int `json:"duration_ms,omitempty"`
+	// Explicit holds the value of the "explicit" field.
+	Explicit bool `json:"explicit,omitempty"`
+	// ReleaseDate holds the value of the "release_date" field.
+	ReleaseDate string `json:"release_date,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the EpisodeQuery when eager-loading is set.
+	Edges        EpisodeEdges `json:"edges"`
+	episode_show *string
+	selectValues sql.SelectValues
+}
+
+// EpisodeEdges holds the relations/edges for other nodes in the graph.
+type EpisodeEdges struct {
+	// Show holds the value of the show edge.
+	Show *Show `json:"show,omitempty"`
+	// SnapshotItems holds the value of the snapshot_items edge.
+	SnapshotItems []*SnapshotItem `json:"snapshot_items,omitempty"`
+	// SavedEpisodes holds the value of the saved_episodes edge.
+	SavedEpisodes []*SavedEpisode `json:"saved_episodes,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// ShowOrErr returns the Show value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EpisodeEdges) ShowOrErr() (*Show, error) {
+	if e.Show != nil {
+		return e.Show, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: show.Label}
+	}
+	return nil, &NotLoadedError{edge: "show"}
+}
+
+// SnapshotItemsOrErr returns the SnapshotItems value or an error if the edge
+// was not loaded in eager-loading.
+func (e EpisodeEdges) SnapshotItemsOrErr() ([]*SnapshotItem, error) {
+	if e.loadedTypes[1] {
+		return e.SnapshotItems, nil
+	}
+	return nil, &NotLoadedError{edge: "snapshot_items"}
+}
+
+// SavedEpisodesOrErr returns the SavedEpisodes value or an error if the edge
+// was not loaded in eager-loading.
+func (e EpisodeEdges) SavedEpisodesOrErr() ([]*SavedEpisode, error) {
+	if e.loadedTypes[2] {
+		return e.SavedEpisodes, nil
+	}
+	return nil, &NotLoadedError{edge: "saved_episodes"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Episode) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case episode.FieldExplicit:
+			values[i] = new(sql.NullBool)
+		case episode.FieldDurationMs:
+			values[i] = new(sql.NullInt64)
+		case episode.FieldID, episode.FieldSpotifyID, episode.FieldURI, episode.FieldName, episode.FieldReleaseDate:
+			values[i] = new(sql.NullString)
+		case episode.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case episode.ForeignKeys[0]: // episode_show
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Episode fields.
+func (e *Episode) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case episode.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				e.ID = value.String
+			}
+		case episode.FieldSpotifyID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field spotify_id", values[i])
+			} else if value.Valid {
+				e.SpotifyID = value.String
+			}
+		case episode.FieldURI:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field uri", values[i])
+			} else if value.Valid {
+				e.URI = value.String
+			}
+		case episode.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				e.Name = value.String
+			}
+		case episode.FieldDurationMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration_ms", values[i])
+			} else if value.Valid {
+				e.DurationMs = int(value.Int64)
+			}
+		case episode.FieldExplicit:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field explicit", values[i])
+			} else if value.Valid {
+				e.Explicit = value.Bool
+			}
+		case episode.FieldReleaseDate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field release_date", values[i])
+			} else if value.Valid {
+				e.ReleaseDate = value.String
+			}
+		case episode.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				e.CreatedAt = value.Time
+			}
+		case episode.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field episode_show", values[i])
+			} else if value.Valid {
+				e.episode_show = new(string)
+				*e.episode_show = value.String
+			}
+		default:
+			e.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Episode.
+// This includes values selected through modifiers, order, etc.
+func (e *Episode) Value(name string) (ent.Value, error) {
+	return e.selectValues.Get(name)
+}
+
+// QueryShow queries the "show" edge of the Episode entity.
+func (e *Episode) QueryShow() *ShowQuery {
+	return NewEpisodeClient(e.config).QueryShow(e)
+}
+
+// QuerySnapshotItems queries the "snapshot_items" edge of the Episode entity.
+func (e *Episode) QuerySnapshotItems() *SnapshotItemQuery {
+	return NewEpisodeClient(e.config).QuerySnapshotItems(e)
+}
+
+// QuerySavedEpisodes queries the "saved_episodes" edge of the Episode entity.
+func (e *Episode) QuerySavedEpisodes() *SavedEpisodeQuery {
+	return NewEpisodeClient(e.config).QuerySavedEpisodes(e)
+}
+
+// Update returns a builder for updating this Episode.
+// Note that you need to call Episode.Unwrap() before calling this method if this Episode
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (e *Episode) Update() *EpisodeUpdateOne {
+	return NewEpisodeClient(e.config).UpdateOne(e)
+}
+
+// Unwrap unwraps the Episode entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (e *Episode) Unwrap() *Episode {
+	_tx, ok := e.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Episode is not a transactional entity")
+	}
+	e.config.driver = _tx.drv
+	return e
+}
+
+// String implements the fmt.Stringer.
+func (e *Episode) String() string {
+	var builder strings.Builder
+	builder.WriteString("Episode(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", e.ID))
+	builder.WriteString("spotify_id=")
+	builder.WriteString(e.SpotifyID)
+	builder.WriteString(", ")
+	builder.WriteString("uri=")
+	builder.WriteString(e.URI)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(e.Name)
+	builder.WriteString(", ")
+	builder.WriteString("duration_ms=")
+	builder.WriteString(fmt.Sprintf("%v", e.DurationMs))
+	builder.WriteString(", ")
+	builder.WriteString("explicit=")
+	builder.WriteString(fmt.Sprintf("%v", e.Explicit))
+	builder.WriteString(", ")
+	builder.WriteString("release_date=")
+	builder.WriteString(e.ReleaseDate)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(e.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Episodes is a parsable slice of Episode.
+type Episodes []*Episode
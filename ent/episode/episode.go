@@ -0,0 +1,207 @@
+// Code generated by ent, DO NOT EDIT.
+
+package episode
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the episode type in the database.
+	Label = "episode"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSpotifyID holds the string denoting the spotify_id field in the database.
+	FieldSpotifyID = "spotify_id"
+	// FieldURI holds the string denoting the uri field in the database.
+	FieldURI = "uri"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldDurationMs holds the string denoting the duration_ms field in the database.
+	FieldDurationMs = "duration_ms"
+	// FieldExplicit holds the string denoting the explicit field in the database.
+	FieldExplicit = "explicit"
+	// FieldReleaseDate holds the string denoting the release_date field in the database.
+	FieldReleaseDate = "release_date"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeShow holds the string denoting the show edge name in mutations.
+	EdgeShow = "show"
+	// EdgeSnapshotItems holds the string denoting the snapshot_items edge name in mutations.
+	EdgeSnapshotItems = "snapshot_items"
+	// EdgeSavedEpisodes holds the string denoting the saved_episodes edge name in mutations.
+	EdgeSavedEpisodes = "saved_episodes"
+	// Table holds the table name of the episode in the database.
+	Table = "episodes"
+	// ShowTable is the table that holds the show relation/edge.
+	ShowTable = "episodes"
+	// ShowInverseTable is the table name for the Show entity.
+	// It exists in this package in order to avoid circular dependency with the "show" package.
+	ShowInverseTable = "shows"
+	// ShowColumn is the table column denoting the show relation/edge.
+	ShowColumn = "episode_show"
+	// SnapshotItemsTable is the table that holds the snapshot_items relation/edge.
+	SnapshotItemsTable = "snapshot_items"
+	// SnapshotItemsInverseTable is the table name for the SnapshotItem entity.
+	// It exists in this package in order to avoid circular dependency with the "snapshotitem" package.
+	SnapshotItemsInverseTable = "snapshot_items"
+	// SnapshotItemsColumn is the table column denoting the snapshot_items relation/edge.
+	SnapshotItemsColumn = "snapshot_item_episode"
+	// SavedEpisodesTable is the table that holds the saved_episodes relation/edge.
+	SavedEpisodesTable = "saved_episodes"
+	// SavedEpisodesInverseTable is the table name for the SavedEpisode entity.
+	// It exists in this package in order to avoid circular dependency with the "savedepisode" package.
+	SavedEpisodesInverseTable = "saved_episodes"
+	// SavedEpisodesColumn is the table column denoting the saved_episodes relation/edge.
+	SavedEpisodesColumn = "saved_episode_episode"
+)
+
+// Columns holds all SQL columns for episode fields.
+var Columns = []string{
+	FieldID,
+	FieldSpotifyID,
+	FieldURI,
+	FieldName,
+	FieldDurationMs,
+	FieldExplicit,
+	FieldReleaseDate,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "episodes"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"episode_show",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// SpotifyIDValidator is a validator for the "spotify_id" field. It is called by the builders before save.
+	SpotifyIDValidator func(string) error
+	// DurationMsValidator is a validator for the "duration_ms" field. It is called by the builders before save.
+	DurationMsValidator func(int) error
+	// DefaultExplicit holds the default value on creation for the "explicit" field.
+	DefaultExplicit bool
+	// DefaultReleaseDate holds the default value on creation for the "release_date" field.
+	DefaultReleaseDate string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() string
+)
+
+// OrderOption defines the ordering options for the Episode queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// BySpotifyID orders the results by the spotify_id field.
+func BySpotifyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpotifyID, opts...).ToFunc()
+}
+
+// ByURI orders the results by the uri field.
+func ByURI(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldURI, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByDurationMs orders the results by the duration_ms field.
+func ByDurationMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDurationMs, opts...).ToFunc()
+}
+
+// ByExplicit orders the results by the explicit field.
+func ByExplicit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExplicit, opts...).ToFunc()
+}
+
+// ByReleaseDate orders the results by the release_date field.
+func ByReleaseDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReleaseDate, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByShowField orders the results by show field.
+func ByShowField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newShowStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// BySnapshotItemsCount orders the results by snapshot_items count.
+func BySnapshotItemsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSnapshotItemsStep(), opts...)
+	}
+}
+
+// BySnapshotItems orders the results by snapshot_items terms.
+func BySnapshotItems(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSnapshotItemsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySavedEpisodesCount orders the results by saved_episodes count.
+func BySavedEpisodesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSavedEpisodesStep(), opts...)
+	}
+}
+
+// BySavedEpisodes orders the results by saved_episodes terms.
+func BySavedEpisodes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSavedEpisodesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newShowStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ShowInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, ShowTable, ShowColumn),
+	)
+}
+func newSnapshotItemsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SnapshotItemsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, SnapshotItemsTable, SnapshotItemsColumn),
+	)
+}
+func newSavedEpisodesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SavedEpisodesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, SavedEpisodesTable, SavedEpisodesColumn),
+	)
+}
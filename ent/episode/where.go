@@ -0,0 +1,535 @@
+// Code generated by ent, DO NOT EDIT.
+
+package episode
+
+import (
+	"beyerleinf/spotify-backup/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Episode {
+	return predicate.Episode(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Episode {
+	return predicate.Episode(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Episode {
+	return predicate.Episode(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Episode {
+	return predicate.Episode(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Episode {
+	return predicate.Episode(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Episode {
+	return predicate.Episode(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Episode {
+	return predicate.Episode(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Episode {
+	return predicate.Episode(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Episode {
+	return predicate.Episode(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Episode {
+	return predicate.Episode(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Episode {
+	return predicate.Episode(sql.FieldContainsFold(FieldID, id))
+}
+
+// SpotifyID applies equality check predicate on the "spotify_id" field. It's identical to SpotifyIDEQ.
+func SpotifyID(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldEQ(FieldSpotifyID, v))
+}
+
+// URI applies equality check predicate on the "uri" field. It's identical to URIEQ.
+func URI(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldEQ(FieldURI, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldEQ(FieldName, v))
+}
+
+// DurationMs applies equality check predicate on the "duration_ms" field. It's identical to DurationMsEQ.
+func DurationMs(v int) predicate.Episode {
+	return predicate.Episode(sql.FieldEQ(FieldDurationMs, v))
+}
+
+// Explicit applies equality check predicate on the "explicit" field. It's identical to ExplicitEQ.
+func Explicit(v bool) predicate.Episode {
+	return predicate.Episode(sql.FieldEQ(FieldExplicit, v))
+}
+
+// ReleaseDate applies equality check predicate on the "release_date" field. It's identical to ReleaseDateEQ.
+func ReleaseDate(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldEQ(FieldReleaseDate, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// SpotifyIDEQ applies the EQ predicate on the "spotify_id" field.
+func SpotifyIDEQ(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldEQ(FieldSpotifyID, v))
+}
+
+// SpotifyIDNEQ applies the NEQ predicate on the "spotify_id" field.
+func SpotifyIDNEQ(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldNEQ(FieldSpotifyID, v))
+}
+
+// SpotifyIDIn applies the In predicate on the "spotify_id" field.
+func SpotifyIDIn(vs ...string) predicate.Episode {
+	return predicate.Episode(sql.FieldIn(FieldSpotifyID, vs...))
+}
+
+// SpotifyIDNotIn applies the NotIn predicate on the "spotify_id" field.
+func SpotifyIDNotIn(vs ...string) predicate.Episode {
+	return predicate.Episode(sql.FieldNotIn(FieldSpotifyID, vs...))
+}
+
+// SpotifyIDGT applies the GT predicate on the "spotify_id" field.
+func SpotifyIDGT(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldGT(FieldSpotifyID, v))
+}
+
+// SpotifyIDGTE applies the GTE predicate on the "spotify_id" field.
+func SpotifyIDGTE(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldGTE(FieldSpotifyID, v))
+}
+
+// SpotifyIDLT applies the LT predicate on the "spotify_id" field.
+func SpotifyIDLT(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldLT(FieldSpotifyID, v))
+}
+
+// SpotifyIDLTE applies the LTE predicate on the "spotify_id" field.
+func SpotifyIDLTE(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldLTE(FieldSpotifyID, v))
+}
+
+// SpotifyIDContains applies the Contains predicate on the "spotify_id" field.
+func SpotifyIDContains(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldContains(FieldSpotifyID, v))
+}
+
+// SpotifyIDHasPrefix applies the HasPrefix predicate on the "spotify_id" field.
+func SpotifyIDHasPrefix(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldHasPrefix(FieldSpotifyID, v))
+}
+
+// SpotifyIDHasSuffix applies the HasSuffix predicate on the "spotify_id" field.
+func SpotifyIDHasSuffix(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldHasSuffix(FieldSpotifyID, v))
+}
+
+// SpotifyIDEqualFold applies the EqualFold predicate on the "spotify_id" field.
+func SpotifyIDEqualFold(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldEqualFold(FieldSpotifyID, v))
+}
+
+// SpotifyIDContainsFold applies the ContainsFold predicate on the "spotify_id" field.
+func SpotifyIDContainsFold(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldContainsFold(FieldSpotifyID, v))
+}
+
+// URIEQ applies the EQ predicate on the "uri" field.
+func URIEQ(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldEQ(FieldURI, v))
+}
+
+// URINEQ applies the NEQ predicate on the "uri" field.
+func URINEQ(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldNEQ(FieldURI, v))
+}
+
+// URIIn applies the In predicate on the "uri" field.
+func URIIn(vs ...string) predicate.Episode {
+	return predicate.Episode(sql.FieldIn(FieldURI, vs...))
+}
+
+// URINotIn applies the NotIn predicate on the "uri" field.
+func URINotIn(vs ...string) predicate.Episode {
+	return predicate.Episode(sql.FieldNotIn(FieldURI, vs...))
+}
+
+// URIGT applies the GT predicate on the "uri" field.
+func URIGT(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldGT(FieldURI, v))
+}
+
+// URIGTE applies the GTE predicate on the "uri" field.
+func URIGTE(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldGTE(FieldURI, v))
+}
+
+// URILT applies the LT predicate on the "uri" field.
+func URILT(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldLT(FieldURI, v))
+}
+
+// URILTE applies the LTE predicate on the "uri" field.
+func URILTE(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldLTE(FieldURI, v))
+}
+
+// URIContains applies the Contains predicate on the "uri" field.
+func URIContains(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldContains(FieldURI, v))
+}
+
+// URIHasPrefix applies the HasPrefix predicate on the "uri" field.
+func URIHasPrefix(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldHasPrefix(FieldURI, v))
+}
+
+// URIHasSuffix applies the HasSuffix predicate on the "uri" field.
+func URIHasSuffix(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldHasSuffix(FieldURI, v))
+}
+
+// URIEqualFold applies the EqualFold predicate on the "uri" field.
+func URIEqualFold(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldEqualFold(FieldURI, v))
+}
+
+// URIContainsFold applies the ContainsFold predicate on the "uri" field.
+func URIContainsFold(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldContainsFold(FieldURI, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Episode {
+	return predicate.Episode(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Episode {
+	return predicate.Episode(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldContainsFold(FieldName, v))
+}
+
+// DurationMsEQ applies the EQ predicate on the "duration_ms" field.
+func DurationMsEQ(v int) predicate.Episode {
+	return predicate.Episode(sql.FieldEQ(FieldDurationMs, v))
+}
+
+// DurationMsNEQ applies the NEQ predicate on the "duration_ms" field.
+func DurationMsNEQ(v int) predicate.Episode {
+	return predicate.Episode(sql.FieldNEQ(FieldDurationMs, v))
+}
+
+// DurationMsIn applies the In predicate on the "duration_ms" field.
+func DurationMsIn(vs ...int) predicate.Episode {
+	return predicate.Episode(sql.FieldIn(FieldDurationMs, vs...))
+}
+
+// DurationMsNotIn applies the NotIn predicate on the "duration_ms" field.
+func DurationMsNotIn(vs ...int) predicate.Episode {
+	return predicate.Episode(sql.FieldNotIn(FieldDurationMs, vs...))
+}
+
+// DurationMsGT applies the GT predicate on the "duration_ms" field.
+func DurationMsGT(v int) predicate.Episode {
+	return predicate.Episode(sql.FieldGT(FieldDurationMs, v))
+}
+
+// DurationMsGTE applies the GTE predicate on the "duration_ms" field.
+func DurationMsGTE(v int) predicate.Episode {
+	return predicate.Episode(sql.FieldGTE(FieldDurationMs, v))
+}
+
+// DurationMsLT applies the LT predicate on the "duration_ms" field.
+func DurationMsLT(v int) predicate.Episode {
+	return predicate.Episode(sql.FieldLT(FieldDurationMs, v))
+}
+
+// DurationMsLTE applies the LTE predicate on the "duration_ms" field.
+func DurationMsLTE(v int) predicate.Episode {
+	return predicate.Episode(sql.FieldLTE(FieldDurationMs, v))
+}
+
+// ExplicitEQ applies the EQ predicate on the "explicit" field.
+func ExplicitEQ(v bool) predicate.Episode {
+	return predicate.Episode(sql.FieldEQ(FieldExplicit, v))
+}
+
+// ExplicitNEQ applies the NEQ predicate on the "explicit" field.
+func ExplicitNEQ(v bool) predicate.Episode {
+	return predicate.Episode(sql.FieldNEQ(FieldExplicit, v))
+}
+
+// ReleaseDateEQ applies the EQ predicate on the "release_date" field.
+func ReleaseDateEQ(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldEQ(FieldReleaseDate, v))
+}
+
+// ReleaseDateNEQ applies the NEQ predicate on the "release_date" field.
+func ReleaseDateNEQ(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldNEQ(FieldReleaseDate, v))
+}
+
+// ReleaseDateIn applies the In predicate on the "release_date" field.
+func ReleaseDateIn(vs ...string) predicate.Episode {
+	return predicate.Episode(sql.FieldIn(FieldReleaseDate, vs...))
+}
+
+// ReleaseDateNotIn applies the NotIn predicate on the "release_date" field.
+func ReleaseDateNotIn(vs ...string) predicate.Episode {
+	return predicate.Episode(sql.FieldNotIn(FieldReleaseDate, vs...))
+}
+
+// ReleaseDateGT applies the GT predicate on the "release_date" field.
+func ReleaseDateGT(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldGT(FieldReleaseDate, v))
+}
+
+// ReleaseDateGTE applies the GTE predicate on the "release_date" field.
+func ReleaseDateGTE(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldGTE(FieldReleaseDate, v))
+}
+
+// ReleaseDateLT applies the LT predicate on the "release_date" field.
+func ReleaseDateLT(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldLT(FieldReleaseDate, v))
+}
+
+// ReleaseDateLTE applies the LTE predicate on the "release_date" field.
+func ReleaseDateLTE(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldLTE(FieldReleaseDate, v))
+}
+
+// ReleaseDateContains applies the Contains predicate on the "release_date" field.
+func ReleaseDateContains(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldContains(FieldReleaseDate, v))
+}
+
+// ReleaseDateHasPrefix applies the HasPrefix predicate on the "release_date" field.
+func ReleaseDateHasPrefix(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldHasPrefix(FieldReleaseDate, v))
+}
+
+// ReleaseDateHasSuffix applies the HasSuffix predicate on the "release_date" field.
+func ReleaseDateHasSuffix(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldHasSuffix(FieldReleaseDate, v))
+}
+
+// ReleaseDateEqualFold applies the EqualFold predicate on the "release_date" field.
+func ReleaseDateEqualFold(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldEqualFold(FieldReleaseDate, v))
+}
+
+// ReleaseDateContainsFold applies the ContainsFold predicate on the "release_date" field.
+func ReleaseDateContainsFold(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldContainsFold(FieldReleaseDate, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasShow applies the HasEdge predicate on the "show" edge.
+func HasShow() predicate.Episode {
+	return predicate.Episode(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ShowTable, ShowColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasShowWith applies the HasEdge predicate on the "show" edge with a given conditions (other predicates).
+func HasShowWith(preds ...predicate.Show) predicate.Episode {
+	return predicate.Episode(func(s *sql.Selector) {
+		step := newShowStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSnapshotItems applies the HasEdge predicate on the "snapshot_items" edge.
+func HasSnapshotItems() predicate.Episode {
+	return predicate.Episode(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, SnapshotItemsTable, SnapshotItemsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSnapshotItemsWith applies the HasEdge predicate on the "snapshot_items" edge with a given conditions (other predicates).
+func HasSnapshotItemsWith(preds ...predicate.SnapshotItem) predicate.Episode {
+	return predicate.Episode(func(s *sql.Selector) {
+		step := newSnapshotItemsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSavedEpisodes applies the HasEdge predicate on the "saved_episodes" edge.
+func HasSavedEpisodes() predicate.Episode {
+	return predicate.Episode(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, SavedEpisodesTable, SavedEpisodesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSavedEpisodesWith applies the HasEdge predicate on the "saved_episodes" edge with a given conditions (other predicates).
+func HasSavedEpisodesWith(preds ...predicate.SavedEpisode) predicate.Episode {
+	return predicate.Episode(func(s *sql.Selector) {
+		step := newSavedEpisodesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Episode) predicate.Episode {
+	return predicate.Episode(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Episode) predicate.Episode {
+	return predicate.Episode(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Episode) predicate.Episode {
+	return predicate.Episode(sql.NotPredicates(p))
+}
@@ -0,0 +1,438 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/episode"
+	"beyerleinf/spotify-backup/ent/savedepisode"
+	"beyerleinf/spotify-backup/ent/show"
+	"beyerleinf/spotify-backup/ent/snapshotitem"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EpisodeCreate is the builder for creating a Episode entity.
+type EpisodeCreate struct {
+	config
+	mutation *EpisodeMutation
+	hooks    []Hook
+}
+
+// SetSpotifyID sets the "spotify_id" field.
+func (ec *EpisodeCreate) SetSpotifyID(s string) *EpisodeCreate {
+	ec.mutation.SetSpotifyID(s)
+	return ec
+}
+
+// SetURI sets the "uri" field.
+func (ec *EpisodeCreate) SetURI(s string) *EpisodeCreate {
+	ec.mutation.SetURI(s)
+	return ec
+}
+
+// SetName sets the "name" field.
+func (ec *EpisodeCreate) SetName(s string) *EpisodeCreate {
+	ec.mutation.SetName(s)
+	return ec
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (ec *EpisodeCreate) SetDurationMs(i int) *EpisodeCreate {
+	ec.mutation.SetDurationMs(i)
+	return ec
+}
+
+// SetExplicit sets the "explicit" field.
+func (ec *EpisodeCreate) SetExplicit(b bool) *EpisodeCreate {
+	ec.mutation.SetExplicit(b)
+	return ec
+}
+
+// SetNillableExplicit sets the "explicit" field if the given value is not nil.
+func (ec *EpisodeCreate) SetNillableExplicit(b *bool) *EpisodeCreate {
+	if b != nil {
+		ec.SetExplicit(*b)
+	}
+	return ec
+}
+
+// SetReleaseDate sets the "release_date" field.
+func (ec *EpisodeCreate) SetReleaseDate(s string) *EpisodeCreate {
+	ec.mutation.SetReleaseDate(s)
+	return ec
+}
+
+// SetNillableReleaseDate sets the "release_date" field if the given value is not nil.
+func (ec *EpisodeCreate) SetNillableReleaseDate(s *string) *EpisodeCreate {
+	if s != nil {
+		ec.SetReleaseDate(*s)
+	}
+	return ec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ec *EpisodeCreate) SetCreatedAt(t time.Time) *EpisodeCreate {
+	ec.mutation.SetCreatedAt(t)
+	return ec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ec *EpisodeCreate) SetNillableCreatedAt(t *time.Time) *EpisodeCreate {
+	if t != nil {
+		ec.SetCreatedAt(*t)
+	}
+	return ec
+}
+
+// SetID sets the "id" field.
+func (ec *EpisodeCreate) SetID(s string) *EpisodeCreate {
+	ec.mutation.SetID(s)
+	return ec
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ec *EpisodeCreate) SetNillableID(s *string) *EpisodeCreate {
+	if s != nil {
+		ec.SetID(*s)
+	}
+	return ec
+}
+
+// SetShowID sets the "show" edge to the Show entity by ID.
+func (ec *EpisodeCreate) SetShowID(id string) *EpisodeCreate {
+	ec.mutation.SetShowID(id)
+	return ec
+}
+
+// SetNillableShowID sets the "show" edge to the Show entity by ID if the given value is not nil.
+func (ec *EpisodeCreate) SetNillableShowID(id *string) *EpisodeCreate {
+	if id != nil {
+		ec = ec.SetShowID(*id)
+	}
+	return ec
+}
+
+// SetShow sets the "show" edge to the Show entity.
+func (ec *EpisodeCreate) SetShow(s *Show) *EpisodeCreate {
+	return ec.SetShowID(s.ID)
+}
+
+// AddSnapshotItemIDs adds the "snapshot_items" edge to the SnapshotItem entity by IDs.
+func (ec *EpisodeCreate) AddSnapshotItemIDs(ids ...string) *EpisodeCreate {
+	ec.mutation.AddSnapshotItemIDs(ids...)
+	return ec
+}
+
+// AddSnapshotItems adds the "snapshot_items" edges to the SnapshotItem entity.
+func (ec *EpisodeCreate) AddSnapshotItems(s ...*SnapshotItem) *EpisodeCreate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ec.AddSnapshotItemIDs(ids...)
+}
+
+// AddSavedEpisodeIDs adds the "saved_episodes" edge to the SavedEpisode entity by IDs.
+func (ec *EpisodeCreate) AddSavedEpisodeIDs(ids ...string) *EpisodeCreate {
+	ec.mutation.AddSavedEpisodeIDs(ids...)
+	return ec
+}
+
+// AddSavedEpisodes adds the "saved_episodes" edges to the SavedEpisode entity.
+func (ec *EpisodeCreate) AddSavedEpisodes(s ...*SavedEpisode) *EpisodeCreate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ec.AddSavedEpisodeIDs(ids...)
+}
+
+// Mutation returns the EpisodeMutation object of the builder.
+func (ec *EpisodeCreate) Mutation() *EpisodeMutation {
+	return ec.mutation
+}
+
+// Save creates the Episode in the database.
+func (ec *EpisodeCreate) Save(ctx context.Context) (*Episode, error) {
+	ec.defaults()
+	return withHooks(ctx, ec.sqlSave, ec.mutation, ec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ec *EpisodeCreate) SaveX(ctx context.Context) *Episode {
+	v, err := ec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ec *EpisodeCreate) Exec(ctx context.Context) error {
+	_, err := ec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ec *EpisodeCreate) ExecX(ctx context.Context) {
+	if err := ec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ec *EpisodeCreate) defaults() {
+	if _, ok := ec.mutation.Explicit(); !ok {
+		v := episode.DefaultExplicit
+		ec.mutation.SetExplicit(v)
+	}
+	if _, ok := ec.mutation.ReleaseDate(); !ok {
+		v := episode.DefaultReleaseDate
+		ec.mutation.SetReleaseDate(v)
+	}
+	if _, ok := ec.mutation.CreatedAt(); !ok {
+		v := episode.DefaultCreatedAt()
+		ec.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ec.mutation.ID(); !ok {
+		v := episode.DefaultID()
+		ec.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ec *EpisodeCreate) check() error {
+	if _, ok := ec.mutation.SpotifyID(); !ok {
+		return &ValidationError{Name: "spotify_id", err: errors.New(`ent: missing required field "Episode.spotify_id"`)}
+	}
+	if v, ok := ec.mutation.SpotifyID(); ok {
+		if err := episode.SpotifyIDValidator(v); err != nil {
+			return &ValidationError{Name: "spotify_id", err: fmt.Errorf(`ent: validator failed for field "Episode.spotify_id": %w`, err)}
+		}
+	}
+	if _, ok := ec.mutation.URI(); !ok {
+		return &ValidationError{Name: "uri", err: errors.New(`ent: missing required field "Episode.uri"`)}
+	}
+	if _, ok := ec.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Episode.name"`)}
+	}
+	if _, ok := ec.mutation.DurationMs(); !ok {
+		return &ValidationError{Name: "duration_ms", err: errors.New(`ent: missing required field "Episode.duration_ms"`)}
+	}
+	if v, ok := ec.mutation.DurationMs(); ok {
+		if err := episode.DurationMsValidator(v); err != nil {
+			return &ValidationError{Name: "duration_ms", err: fmt.Errorf(`ent: validator failed for field "Episode.duration_ms": %w`, err)}
+		}
+	}
+	if _, ok := ec.mutation.Explicit(); !ok {
+		return &ValidationError{Name: "explicit", err: errors.New(`ent: missing required field "Episode.explicit"`)}
+	}
+	if _, ok := ec.mutation.ReleaseDate(); !ok {
+		return &ValidationError{Name: "release_date", err: errors.New(`ent: missing required field "Episode.release_date"`)}
+	}
+	if _, ok := ec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Episode.created_at"`)}
+	}
+	return nil
+}
+
+func (ec *EpisodeCreate) sqlSave(ctx context.Context) (*Episode, error) {
+	if err := ec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Episode.ID type: %T", _spec.ID.Value)
+		}
+	}
+	ec.mutation.id = &_node.ID
+	ec.mutation.done = true
+	return _node, nil
+}
+
+func (ec *EpisodeCreate) createSpec() (*Episode, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Episode{config: ec.config}
+		_spec = sqlgraph.NewCreateSpec(episode.Table, sqlgraph.NewFieldSpec(episode.FieldID, field.TypeString))
+	)
+	if id, ok := ec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := ec.mutation.SpotifyID(); ok {
+		_spec.SetField(episode.FieldSpotifyID, field.TypeString, value)
+		_node.SpotifyID = value
+	}
+	if value, ok := ec.mutation.URI(); ok {
+		_spec.SetField(episode.FieldURI, field.TypeString, value)
+		_node.URI = value
+	}
+	if value, ok := ec.mutation.Name(); ok {
+		_spec.SetField(episode.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := ec.mutation.DurationMs(); ok {
+		_spec.SetField(episode.FieldDurationMs, field.TypeInt, value)
+		_node.DurationMs = value
+	}
+	if value, ok := ec.mutation.Explicit(); ok {
+		_spec.SetField(episode.FieldExplicit, field.TypeBool, value)
+		_node.Explicit = value
+	}
+	if value, ok := ec.mutation.ReleaseDate(); ok {
+		_spec.SetField(episode.FieldReleaseDate, field.TypeString, value)
+		_node.ReleaseDate = value
+	}
+	if value, ok := ec.mutation.CreatedAt(); ok {
+		_spec.SetField(episode.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := ec.mutation.ShowIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   episode.ShowTable,
+			Columns: []string{episode.ShowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(show.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.episode_show = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ec.mutation.SnapshotItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   episode.SnapshotItemsTable,
+			Columns: []string{episode.SnapshotItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshotitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ec.mutation.SavedEpisodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   episode.SavedEpisodesTable,
+			Columns: []string{episode.SavedEpisodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedepisode.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// EpisodeCreateBulk is the builder for creating many Episode entities in bulk.
+type EpisodeCreateBulk struct {
+	config
+	err      error
+	builders []*EpisodeCreate
+}
+
+// Save creates the Episode entities in the database.
+func (ecb *EpisodeCreateBulk) Save(ctx context.Context) ([]*Episode, error) {
+	if ecb.err != nil {
+		return nil, ecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ecb.builders))
+	nodes := make([]*Episode, len(ecb.builders))
+	mutators := make([]Mutator, len(ecb.builders))
+	for i := range ecb.builders {
+		func(i int, root context.Context) {
+			builder := ecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EpisodeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ecb *EpisodeCreateBulk) SaveX(ctx context.Context) []*Episode {
+	v, err := ecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ecb *EpisodeCreateBulk) Exec(ctx context.Context) error {
+	_, err := ecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ecb *EpisodeCreateBulk) ExecX(ctx context.Context) {
+	if err := ecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,802 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/episode"
+	"beyerleinf/spotify-backup/ent/predicate"
+	"beyerleinf/spotify-backup/ent/savedepisode"
+	"beyerleinf/spotify-backup/ent/show"
+	"beyerleinf/spotify-backup/ent/snapshotitem"
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EpisodeQuery is the builder for querying Episode entities.
+type EpisodeQuery struct {
+	config
+	ctx               *QueryContext
+	order             []episode.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.Episode
+	withShow          *ShowQuery
+	withSnapshotItems *SnapshotItemQuery
+	withSavedEpisodes *SavedEpisodeQuery
+	withFKs           bool
+	modifiers         []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the EpisodeQuery builder.
+func (eq *EpisodeQuery) Where(ps ...predicate.Episode) *EpisodeQuery {
+	eq.predicates = append(eq.predicates, ps...)
+	return eq
+}
+
+// Limit the number of records to be returned by this query.
+func (eq *EpisodeQuery) Limit(limit int) *EpisodeQuery {
+	eq.ctx.Limit = &limit
+	return eq
+}
+
+// Offset to start from.
+func (eq *EpisodeQuery) Offset(offset int) *EpisodeQuery {
+	eq.ctx.Offset = &offset
+	return eq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (eq *EpisodeQuery) Unique(unique bool) *EpisodeQuery {
+	eq.ctx.Unique = &unique
+	return eq
+}
+
+// Order specifies how the records should be ordered.
+func (eq *EpisodeQuery) Order(o ...episode.OrderOption) *EpisodeQuery {
+	eq.order = append(eq.order, o...)
+	return eq
+}
+
+// QueryShow chains the current query on the "show" edge.
+func (eq *EpisodeQuery) QueryShow() *ShowQuery {
+	query := (&ShowClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(episode.Table, episode.FieldID, selector),
+			sqlgraph.To(show.Table, show.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, episode.ShowTable, episode.ShowColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySnapshotItems chains the current query on the "snapshot_items" edge.
+func (eq *EpisodeQuery) QuerySnapshotItems() *SnapshotItemQuery {
+	query := (&SnapshotItemClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(episode.Table, episode.FieldID, selector),
+			sqlgraph.To(snapshotitem.Table, snapshotitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, episode.SnapshotItemsTable, episode.SnapshotItemsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySavedEpisodes chains the current query on the "saved_episodes" edge.
+func (eq *EpisodeQuery) QuerySavedEpisodes() *SavedEpisodeQuery {
+	query := (&SavedEpisodeClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(episode.Table, episode.FieldID, selector),
+			sqlgraph.To(savedepisode.Table, savedepisode.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, episode.SavedEpisodesTable, episode.SavedEpisodesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Episode entity from the query.
+// Returns a *NotFoundError when no Episode was found.
+func (eq *EpisodeQuery) First(ctx context.Context) (*Episode, error) {
+	nodes, err := eq.Limit(1).All(setContextOp(ctx, eq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{episode.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (eq *EpisodeQuery) FirstX(ctx context.Context) *Episode {
+	node, err := eq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Episode ID from the query.
+// Returns a *NotFoundError when no Episode ID was found.
+func (eq *EpisodeQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = eq.Limit(1).IDs(setContextOp(ctx, eq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{episode.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (eq *EpisodeQuery) FirstIDX(ctx context.Context) string {
+	id, err := eq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Episode entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Episode entity is found.
+// Returns a *NotFoundError when no Episode entities are found.
+func (eq *EpisodeQuery) Only(ctx context.Context) (*Episode, error) {
+	nodes, err := eq.Limit(2).All(setContextOp(ctx, eq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{episode.Label}
+	default:
+		return nil, &NotSingularError{episode.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (eq *EpisodeQuery) OnlyX(ctx context.Context) *Episode {
+	node, err := eq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Episode ID in the query.
+// Returns a *NotSingularError when more than one Episode ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (eq *EpisodeQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = eq.Limit(2).IDs(setContextOp(ctx, eq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{episode.Label}
+	default:
+		err = &NotSingularError{episode.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (eq *EpisodeQuery) OnlyIDX(ctx context.Context) string {
+	id, err := eq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Episodes.
+func (eq *EpisodeQuery) All(ctx context.Context) ([]*Episode, error) {
+	ctx = setContextOp(ctx, eq.ctx, ent.OpQueryAll)
+	if err := eq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*Episode, *EpisodeQuery]()
+	return withInterceptors[[]*Episode](ctx, eq, qr, eq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (eq *EpisodeQuery) AllX(ctx context.Context) []*Episode {
+	nodes, err := eq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Episode IDs.
+func (eq *EpisodeQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if eq.ctx.Unique == nil && eq.path != nil {
+		eq.Unique(true)
+	}
+	ctx = setContextOp(ctx, eq.ctx, ent.OpQueryIDs)
+	if err = eq.Select(episode.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (eq *EpisodeQuery) IDsX(ctx context.Context) []string {
+	ids, err := eq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (eq *EpisodeQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, eq.ctx, ent.OpQueryCount)
+	if err := eq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, eq, querierCount[*EpisodeQuery](), eq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (eq *EpisodeQuery) CountX(ctx context.Context) int {
+	count, err := eq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (eq *EpisodeQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, eq.ctx, ent.OpQueryExist)
+	switch _, err := eq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (eq *EpisodeQuery) ExistX(ctx context.Context) bool {
+	exist, err := eq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the EpisodeQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (eq *EpisodeQuery) Clone() *EpisodeQuery {
+	if eq == nil {
+		return nil
+	}
+	return &EpisodeQuery{
+		config:            eq.config,
+		ctx:               eq.ctx.Clone(),
+		order:             append([]episode.OrderOption{}, eq.order...),
+		inters:            append([]Interceptor{}, eq.inters...),
+		predicates:        append([]predicate.Episode{}, eq.predicates...),
+		withShow:          eq.withShow.Clone(),
+		withSnapshotItems: eq.withSnapshotItems.Clone(),
+		withSavedEpisodes: eq.withSavedEpisodes.Clone(),
+		// clone intermediate query.
+		sql:  eq.sql.Clone(),
+		path: eq.path,
+	}
+}
+
+// WithShow tells the query-builder to eager-load the nodes that are connected to
+// the "show" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EpisodeQuery) WithShow(opts ...func(*ShowQuery)) *EpisodeQuery {
+	query := (&ShowClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withShow = query
+	return eq
+}
+
+// WithSnapshotItems tells the query-builder to eager-load the nodes that are connected to
+// the "snapshot_items" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EpisodeQuery) WithSnapshotItems(opts ...func(*SnapshotItemQuery)) *EpisodeQuery {
+	query := (&SnapshotItemClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withSnapshotItems = query
+	return eq
+}
+
+// WithSavedEpisodes tells the query-builder to eager-load the nodes that are connected to
+// the "saved_episodes" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EpisodeQuery) WithSavedEpisodes(opts ...func(*SavedEpisodeQuery)) *EpisodeQuery {
+	query := (&SavedEpisodeClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withSavedEpisodes = query
+	return eq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		SpotifyID string `json:"spotify_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Episode.Query().
+//		GroupBy(episode.FieldSpotifyID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (eq *EpisodeQuery) GroupBy(field string, fields ...string) *EpisodeGroupBy {
+	eq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &EpisodeGroupBy{build: eq}
+	grbuild.flds = &eq.ctx.Fields
+	grbuild.label = episode.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		SpotifyID string `json:"spotify_id,omitempty"`
+//	}
+//
+//	client.Episode.Query().
+//		Select(episode.FieldSpotifyID).
+//		Scan(ctx, &v)
+func (eq *EpisodeQuery) Select(fields ...string) *EpisodeSelect {
+	eq.ctx.Fields = append(eq.ctx.Fields, fields...)
+	sbuild := &EpisodeSelect{EpisodeQuery: eq}
+	sbuild.label = episode.Label
+	sbuild.flds, sbuild.scan = &eq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a EpisodeSelect configured with the given aggregations.
+func (eq *EpisodeQuery) Aggregate(fns ...AggregateFunc) *EpisodeSelect {
+	return eq.Select().Aggregate(fns...)
+}
+
+func (eq *EpisodeQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range eq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, eq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range eq.ctx.Fields {
+		if !episode.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if eq.path != nil {
+		prev, err := eq.path(ctx)
+		if err != nil {
+			return err
+		}
+		eq.sql = prev
+	}
+	return nil
+}
+
+func (eq *EpisodeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Episode, error) {
+	var (
+		nodes       = []*Episode{}
+		withFKs     = eq.withFKs
+		_spec       = eq.querySpec()
+		loadedTypes = [3]bool{
+			eq.withShow != nil,
+			eq.withSnapshotItems != nil,
+			eq.withSavedEpisodes != nil,
+		}
+	)
+	if eq.withShow != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, episode.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*Episode).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &Episode{config: eq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(eq.modifiers) > 0 {
+		_spec.Modifiers = eq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, eq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := eq.withShow; query != nil {
+		if err := eq.loadShow(ctx, query, nodes, nil,
+			func(n *Episode, e *Show) { n.Edges.Show = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := eq.withSnapshotItems; query != nil {
+		if err := eq.loadSnapshotItems(ctx, query, nodes,
+			func(n *Episode) { n.Edges.SnapshotItems = []*SnapshotItem{} },
+			func(n *Episode, e *SnapshotItem) { n.Edges.SnapshotItems = append(n.Edges.SnapshotItems, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := eq.withSavedEpisodes; query != nil {
+		if err := eq.loadSavedEpisodes(ctx, query, nodes,
+			func(n *Episode) { n.Edges.SavedEpisodes = []*SavedEpisode{} },
+			func(n *Episode, e *SavedEpisode) { n.Edges.SavedEpisodes = append(n.Edges.SavedEpisodes, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (eq *EpisodeQuery) loadShow(ctx context.Context, query *ShowQuery, nodes []*Episode, init func(*Episode), assign func(*Episode, *Show)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*Episode)
+	for i := range nodes {
+		if nodes[i].episode_show == nil {
+			continue
+		}
+		fk := *nodes[i].episode_show
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(show.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "episode_show" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (eq *EpisodeQuery) loadSnapshotItems(ctx context.Context, query *SnapshotItemQuery, nodes []*Episode, init func(*Episode), assign func(*Episode, *SnapshotItem)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Episode)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.SnapshotItem(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(episode.SnapshotItemsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.snapshot_item_episode
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "snapshot_item_episode" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "snapshot_item_episode" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (eq *EpisodeQuery) loadSavedEpisodes(ctx context.Context, query *SavedEpisodeQuery, nodes []*Episode, init func(*Episode), assign func(*Episode, *SavedEpisode)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Episode)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.SavedEpisode(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(episode.SavedEpisodesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.saved_episode_episode
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "saved_episode_episode" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "saved_episode_episode" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (eq *EpisodeQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := eq.querySpec()
+	if len(eq.modifiers) > 0 {
+		_spec.Modifiers = eq.modifiers
+	}
+	_spec.Node.Columns = eq.ctx.Fields
+	if len(eq.ctx.Fields) > 0 {
+		_spec.Unique = eq.ctx.Unique != nil && *eq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, eq.driver, _spec)
+}
+
+func (eq *EpisodeQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(episode.Table, episode.Columns, sqlgraph.NewFieldSpec(episode.FieldID, field.TypeString))
+	_spec.From = eq.sql
+	if unique := eq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if eq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := eq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, episode.FieldID)
+		for i := range fields {
+			if fields[i] != episode.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := eq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := eq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := eq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := eq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (eq *EpisodeQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(eq.driver.Dialect())
+	t1 := builder.Table(episode.Table)
+	columns := eq.ctx.Fields
+	if len(columns) == 0 {
+		columns = episode.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if eq.sql != nil {
+		selector = eq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if eq.ctx.Unique != nil && *eq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range eq.modifiers {
+		m(selector)
+	}
+	for _, p := range eq.predicates {
+		p(selector)
+	}
+	for _, p := range eq.order {
+		p(selector)
+	}
+	if offset := eq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := eq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (eq *EpisodeQuery) ForUpdate(opts ...sql.LockOption) *EpisodeQuery {
+	if eq.driver.Dialect() == dialect.Postgres {
+		eq.Unique(false)
+	}
+	eq.modifiers = append(eq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return eq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (eq *EpisodeQuery) ForShare(opts ...sql.LockOption) *EpisodeQuery {
+	if eq.driver.Dialect() == dialect.Postgres {
+		eq.Unique(false)
+	}
+	eq.modifiers = append(eq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return eq
+}
+
+// EpisodeGroupBy is the group-by builder for Episode entities.
+type EpisodeGroupBy struct {
+	selector
+	build *EpisodeQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (egb *EpisodeGroupBy) Aggregate(fns ...AggregateFunc) *EpisodeGroupBy {
+	egb.fns = append(egb.fns, fns...)
+	return egb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (egb *EpisodeGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, egb.build.ctx, ent.OpQueryGroupBy)
+	if err := egb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EpisodeQuery, *EpisodeGroupBy](ctx, egb.build, egb, egb.build.inters, v)
+}
+
+func (egb *EpisodeGroupBy) sqlScan(ctx context.Context, root *EpisodeQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(egb.fns))
+	for _, fn := range egb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*egb.flds)+len(egb.fns))
+		for _, f := range *egb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*egb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := egb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// EpisodeSelect is the builder for selecting fields of Episode entities.
+type EpisodeSelect struct {
+	*EpisodeQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (es *EpisodeSelect) Aggregate(fns ...AggregateFunc) *EpisodeSelect {
+	es.fns = append(es.fns, fns...)
+	return es
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (es *EpisodeSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, es.ctx, ent.OpQuerySelect)
+	if err := es.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EpisodeQuery, *EpisodeSelect](ctx, es.EpisodeQuery, es, es.inters, v)
+}
+
+func (es *EpisodeSelect) sqlScan(ctx context.Context, root *EpisodeQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(es.fns))
+	for _, fn := range es.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*es.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := es.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,826 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/episode"
+	"beyerleinf/spotify-backup/ent/predicate"
+	"beyerleinf/spotify-backup/ent/savedepisode"
+	"beyerleinf/spotify-backup/ent/show"
+	"beyerleinf/spotify-backup/ent/snapshotitem"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EpisodeUpdate is the builder for updating Episode entities.
+type EpisodeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EpisodeMutation
+}
+
+// Where appends a list predicates to the EpisodeUpdate builder.
+func (eu *EpisodeUpdate) Where(ps ...predicate.Episode) *EpisodeUpdate {
+	eu.mutation.Where(ps...)
+	return eu
+}
+
+// SetURI sets the "uri" field.
+func (eu *EpisodeUpdate) SetURI(s string) *EpisodeUpdate {
+	eu.mutation.SetURI(s)
+	return eu
+}
+
+// SetNillableURI sets the "uri" field if the given value is not nil.
+func (eu *EpisodeUpdate) SetNillableURI(s *string) *EpisodeUpdate {
+	if s != nil {
+		eu.SetURI(*s)
+	}
+	return eu
+}
+
+// SetName sets the "name" field.
+func (eu *EpisodeUpdate) SetName(s string) *EpisodeUpdate {
+	eu.mutation.SetName(s)
+	return eu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (eu *EpisodeUpdate) SetNillableName(s *string) *EpisodeUpdate {
+	if s != nil {
+		eu.SetName(*s)
+	}
+	return eu
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (eu *EpisodeUpdate) SetDurationMs(i int) *EpisodeUpdate {
+	eu.mutation.ResetDurationMs()
+	eu.mutation.SetDurationMs(i)
+	return eu
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (eu *EpisodeUpdate) SetNillableDurationMs(i *int) *EpisodeUpdate {
+	if i != nil {
+		eu.SetDurationMs(*i)
+	}
+	return eu
+}
+
+// AddDurationMs adds i to the "duration_ms" field.
+func (eu *EpisodeUpdate) AddDurationMs(i int) *EpisodeUpdate {
+	eu.mutation.AddDurationMs(i)
+	return eu
+}
+
+// SetExplicit sets the "explicit" field.
+func (eu *EpisodeUpdate) SetExplicit(b bool) *EpisodeUpdate {
+	eu.mutation.SetExplicit(b)
+	return eu
+}
+
+// SetNillableExplicit sets the "explicit" field if the given value is not nil.
+func (eu *EpisodeUpdate) SetNillableExplicit(b *bool) *EpisodeUpdate {
+	if b != nil {
+		eu.SetExplicit(*b)
+	}
+	return eu
+}
+
+// SetReleaseDate sets the "release_date" field.
+func (eu *EpisodeUpdate) SetReleaseDate(s string) *EpisodeUpdate {
+	eu.mutation.SetReleaseDate(s)
+	return eu
+}
+
+// SetNillableReleaseDate sets the "release_date" field if the given value is not nil.
+func (eu *EpisodeUpdate) SetNillableReleaseDate(s *string) *EpisodeUpdate {
+	if s != nil {
+		eu.SetReleaseDate(*s)
+	}
+	return eu
+}
+
+// SetShowID sets the "show" edge to the Show entity by ID.
+func (eu *EpisodeUpdate) SetShowID(id string) *EpisodeUpdate {
+	eu.mutation.SetShowID(id)
+	return eu
+}
+
+// SetNillableShowID sets the "show" edge to the Show entity by ID if the given value is not nil.
+func (eu *EpisodeUpdate) SetNillableShowID(id *string) *EpisodeUpdate {
+	if id != nil {
+		eu = eu.SetShowID(*id)
+	}
+	return eu
+}
+
+// SetShow sets the "show" edge to the Show entity.
+func (eu *EpisodeUpdate) SetShow(s *Show) *EpisodeUpdate {
+	return eu.SetShowID(s.ID)
+}
+
+// AddSnapshotItemIDs adds the "snapshot_items" edge to the SnapshotItem entity by IDs.
+func (eu *EpisodeUpdate) AddSnapshotItemIDs(ids ...string) *EpisodeUpdate {
+	eu.mutation.AddSnapshotItemIDs(ids...)
+	return eu
+}
+
+// AddSnapshotItems adds the "snapshot_items" edges to the SnapshotItem entity.
+func (eu *EpisodeUpdate) AddSnapshotItems(s ...*SnapshotItem) *EpisodeUpdate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return eu.AddSnapshotItemIDs(ids...)
+}
+
+// AddSavedEpisodeIDs adds the "saved_episodes" edge to the SavedEpisode entity by IDs.
+func (eu *EpisodeUpdate) AddSavedEpisodeIDs(ids ...string) *EpisodeUpdate {
+	eu.mutation.AddSavedEpisodeIDs(ids...)
+	return eu
+}
+
+// AddSavedEpisodes adds the "saved_episodes" edges to the SavedEpisode entity.
+func (eu *EpisodeUpdate) AddSavedEpisodes(s ...*SavedEpisode) *EpisodeUpdate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return eu.AddSavedEpisodeIDs(ids...)
+}
+
+// Mutation returns the EpisodeMutation object of the builder.
+func (eu *EpisodeUpdate) Mutation() *EpisodeMutation {
+	return eu.mutation
+}
+
+// ClearShow clears the "show" edge to the Show entity.
+func (eu *EpisodeUpdate) ClearShow() *EpisodeUpdate {
+	eu.mutation.ClearShow()
+	return eu
+}
+
+// ClearSnapshotItems clears all "snapshot_items" edges to the SnapshotItem entity.
+func (eu *EpisodeUpdate) ClearSnapshotItems() *EpisodeUpdate {
+	eu.mutation.ClearSnapshotItems()
+	return eu
+}
+
+// RemoveSnapshotItemIDs removes the "snapshot_items" edge to SnapshotItem entities by IDs.
+func (eu *EpisodeUpdate) RemoveSnapshotItemIDs(ids ...string) *EpisodeUpdate {
+	eu.mutation.RemoveSnapshotItemIDs(ids...)
+	return eu
+}
+
+// RemoveSnapshotItems removes "snapshot_items" edges to SnapshotItem entities.
+func (eu *EpisodeUpdate) RemoveSnapshotItems(s ...*SnapshotItem) *EpisodeUpdate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return eu.RemoveSnapshotItemIDs(ids...)
+}
+
+// ClearSavedEpisodes clears all "saved_episodes" edges to the SavedEpisode entity.
+func (eu *EpisodeUpdate) ClearSavedEpisodes() *EpisodeUpdate {
+	eu.mutation.ClearSavedEpisodes()
+	return eu
+}
+
+// RemoveSavedEpisodeIDs removes the "saved_episodes" edge to SavedEpisode entities by IDs.
+func (eu *EpisodeUpdate) RemoveSavedEpisodeIDs(ids ...string) *EpisodeUpdate {
+	eu.mutation.RemoveSavedEpisodeIDs(ids...)
+	return eu
+}
+
+// RemoveSavedEpisodes removes "saved_episodes" edges to SavedEpisode entities.
+func (eu *EpisodeUpdate) RemoveSavedEpisodes(s ...*SavedEpisode) *EpisodeUpdate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return eu.RemoveSavedEpisodeIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (eu *EpisodeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, eu.sqlSave, eu.mutation, eu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eu *EpisodeUpdate) SaveX(ctx context.Context) int {
+	affected, err := eu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (eu *EpisodeUpdate) Exec(ctx context.Context) error {
+	_, err := eu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eu *EpisodeUpdate) ExecX(ctx context.Context) {
+	if err := eu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (eu *EpisodeUpdate) check() error {
+	if v, ok := eu.mutation.DurationMs(); ok {
+		if err := episode.DurationMsValidator(v); err != nil {
+			return &ValidationError{Name: "duration_ms", err: fmt.Errorf(`ent: validator failed for field "Episode.duration_ms": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (eu *EpisodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := eu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(episode.Table, episode.Columns, sqlgraph.NewFieldSpec(episode.FieldID, field.TypeString))
+	if ps := eu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := eu.mutation.URI(); ok {
+		_spec.SetField(episode.FieldURI, field.TypeString, value)
+	}
+	if value, ok := eu.mutation.Name(); ok {
+		_spec.SetField(episode.FieldName, field.TypeString, value)
+	}
+	if value, ok := eu.mutation.DurationMs(); ok {
+		_spec.SetField(episode.FieldDurationMs, field.TypeInt, value)
+	}
+	if value, ok := eu.mutation.AddedDurationMs(); ok {
+		_spec.AddField(episode.FieldDurationMs, field.TypeInt, value)
+	}
+	if value, ok := eu.mutation.Explicit(); ok {
+		_spec.SetField(episode.FieldExplicit, field.TypeBool, value)
+	}
+	if value, ok := eu.mutation.ReleaseDate(); ok {
+		_spec.SetField(episode.FieldReleaseDate, field.TypeString, value)
+	}
+	if eu.mutation.ShowCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   episode.ShowTable,
+			Columns: []string{episode.ShowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(show.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.ShowIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   episode.ShowTable,
+			Columns: []string{episode.ShowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(show.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if eu.mutation.SnapshotItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   episode.SnapshotItemsTable,
+			Columns: []string{episode.SnapshotItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshotitem.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.RemovedSnapshotItemsIDs(); len(nodes) > 0 && !eu.mutation.SnapshotItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   episode.SnapshotItemsTable,
+			Columns: []string{episode.SnapshotItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshotitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.SnapshotItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   episode.SnapshotItemsTable,
+			Columns: []string{episode.SnapshotItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshotitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if eu.mutation.SavedEpisodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   episode.SavedEpisodesTable,
+			Columns: []string{episode.SavedEpisodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedepisode.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.RemovedSavedEpisodesIDs(); len(nodes) > 0 && !eu.mutation.SavedEpisodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   episode.SavedEpisodesTable,
+			Columns: []string{episode.SavedEpisodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedepisode.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.SavedEpisodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   episode.SavedEpisodesTable,
+			Columns: []string{episode.SavedEpisodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedepisode.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, eu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{episode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	eu.mutation.done = true
+	return n, nil
+}
+
+// EpisodeUpdateOne is the builder for updating a single Episode entity.
+type EpisodeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EpisodeMutation
+}
+
+// SetURI sets the "uri" field.
+func (euo *EpisodeUpdateOne) SetURI(s string) *EpisodeUpdateOne {
+	euo.mutation.SetURI(s)
+	return euo
+}
+
+// SetNillableURI sets the "uri" field if the given value is not nil.
+func (euo *EpisodeUpdateOne) SetNillableURI(s *string) *EpisodeUpdateOne {
+	if s != nil {
+		euo.SetURI(*s)
+	}
+	return euo
+}
+
+// SetName sets the "name" field.
+func (euo *EpisodeUpdateOne) SetName(s string) *EpisodeUpdateOne {
+	euo.mutation.SetName(s)
+	return euo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (euo *EpisodeUpdateOne) SetNillableName(s *string) *EpisodeUpdateOne {
+	if s != nil {
+		euo.SetName(*s)
+	}
+	return euo
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (euo *EpisodeUpdateOne) SetDurationMs(i int) *EpisodeUpdateOne {
+	euo.mutation.ResetDurationMs()
+	euo.mutation.SetDurationMs(i)
+	return euo
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (euo *EpisodeUpdateOne) SetNillableDurationMs(i *int) *EpisodeUpdateOne {
+	if i != nil {
+		euo.SetDurationMs(*i)
+	}
+	return euo
+}
+
+// AddDurationMs adds i to the "duration_ms" field.
+func (euo *EpisodeUpdateOne) AddDurationMs(i int) *EpisodeUpdateOne {
+	euo.mutation.AddDurationMs(i)
+	return euo
+}
+
+// SetExplicit sets the "explicit" field.
+func (euo *EpisodeUpdateOne) SetExplicit(b bool) *EpisodeUpdateOne {
+	euo.mutation.SetExplicit(b)
+	return euo
+}
+
+// SetNillableExplicit sets the "explicit" field if the given value is not nil.
+func (euo *EpisodeUpdateOne) SetNillableExplicit(b *bool) *EpisodeUpdateOne {
+	if b != nil {
+		euo.SetExplicit(*b)
+	}
+	return euo
+}
+
+// SetReleaseDate sets the "release_date" field.
+func (euo *EpisodeUpdateOne) SetReleaseDate(s string) *EpisodeUpdateOne {
+	euo.mutation.SetReleaseDate(s)
+	return euo
+}
+
+// SetNillableReleaseDate sets the "release_date" field if the given value is not nil.
+func (euo *EpisodeUpdateOne) SetNillableReleaseDate(s *string) *EpisodeUpdateOne {
+	if s != nil {
+		euo.SetReleaseDate(*s)
+	}
+	return euo
+}
+
+// SetShowID sets the "show" edge to the Show entity by ID.
+func (euo *EpisodeUpdateOne) SetShowID(id string) *EpisodeUpdateOne {
+	euo.mutation.SetShowID(id)
+	return euo
+}
+
+// SetNillableShowID sets the "show" edge to the Show entity by ID if the given value is not nil.
+func (euo *EpisodeUpdateOne) SetNillableShowID(id *string) *EpisodeUpdateOne {
+	if id != nil {
+		euo = euo.SetShowID(*id)
+	}
+	return euo
+}
+
+// SetShow sets the "show" edge to the Show entity.
+func (euo *EpisodeUpdateOne) SetShow(s *Show) *EpisodeUpdateOne {
+	return euo.SetShowID(s.ID)
+}
+
+// AddSnapshotItemIDs adds the "snapshot_items" edge to the SnapshotItem entity by IDs.
+func (euo *EpisodeUpdateOne) AddSnapshotItemIDs(ids ...string) *EpisodeUpdateOne {
+	euo.mutation.AddSnapshotItemIDs(ids...)
+	return euo
+}
+
+// AddSnapshotItems adds the "snapshot_items" edges to the SnapshotItem entity.
+func (euo *EpisodeUpdateOne) AddSnapshotItems(s ...*SnapshotItem) *EpisodeUpdateOne {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return euo.AddSnapshotItemIDs(ids...)
+}
+
+// AddSavedEpisodeIDs adds the "saved_episodes" edge to the SavedEpisode entity by IDs.
+func (euo *EpisodeUpdateOne) AddSavedEpisodeIDs(ids ...string) *EpisodeUpdateOne {
+	euo.mutation.AddSavedEpisodeIDs(ids...)
+	return euo
+}
+
+// AddSavedEpisodes adds the "saved_episodes" edges to the SavedEpisode entity.
+func (euo *EpisodeUpdateOne) AddSavedEpisodes(s ...*SavedEpisode) *EpisodeUpdateOne {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return euo.AddSavedEpisodeIDs(ids...)
+}
+
+// Mutation returns the EpisodeMutation object of the builder.
+func (euo *EpisodeUpdateOne) Mutation() *EpisodeMutation {
+	return euo.mutation
+}
+
+// ClearShow clears the "show" edge to the Show entity.
+func (euo *EpisodeUpdateOne) ClearShow() *EpisodeUpdateOne {
+	euo.mutation.ClearShow()
+	return euo
+}
+
+// ClearSnapshotItems clears all "snapshot_items" edges to the SnapshotItem entity.
+func (euo *EpisodeUpdateOne) ClearSnapshotItems() *EpisodeUpdateOne {
+	euo.mutation.ClearSnapshotItems()
+	return euo
+}
+
+// RemoveSnapshotItemIDs removes the "snapshot_items" edge to SnapshotItem entities by IDs.
+func (euo *EpisodeUpdateOne) RemoveSnapshotItemIDs(ids ...string) *EpisodeUpdateOne {
+	euo.mutation.RemoveSnapshotItemIDs(ids...)
+	return euo
+}
+
+// RemoveSnapshotItems removes "snapshot_items" edges to SnapshotItem entities.
+func (euo *EpisodeUpdateOne) RemoveSnapshotItems(s ...*SnapshotItem) *EpisodeUpdateOne {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return euo.RemoveSnapshotItemIDs(ids...)
+}
+
+// ClearSavedEpisodes clears all "saved_episodes" edges to the SavedEpisode entity.
+func (euo *EpisodeUpdateOne) ClearSavedEpisodes() *EpisodeUpdateOne {
+	euo.mutation.ClearSavedEpisodes()
+	return euo
+}
+
+// RemoveSavedEpisodeIDs removes the "saved_episodes" edge to SavedEpisode entities by IDs.
+func (euo *EpisodeUpdateOne) RemoveSavedEpisodeIDs(ids ...string) *EpisodeUpdateOne {
+	euo.mutation.RemoveSavedEpisodeIDs(ids...)
+	return euo
+}
+
+// RemoveSavedEpisodes removes "saved_episodes" edges to SavedEpisode entities.
+func (euo *EpisodeUpdateOne) RemoveSavedEpisodes(s ...*SavedEpisode) *EpisodeUpdateOne {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return euo.RemoveSavedEpisodeIDs(ids...)
+}
+
+// Where appends a list predicates to the EpisodeUpdate builder.
+func (euo *EpisodeUpdateOne) Where(ps ...predicate.Episode) *EpisodeUpdateOne {
+	euo.mutation.Where(ps...)
+	return euo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (euo *EpisodeUpdateOne) Select(field string, fields ...string) *EpisodeUpdateOne {
+	euo.fields = append([]string{field}, fields...)
+	return euo
+}
+
+// Save executes the query and returns the updated Episode entity.
+func (euo *EpisodeUpdateOne) Save(ctx context.Context) (*Episode, error) {
+	return withHooks(ctx, euo.sqlSave, euo.mutation, euo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (euo *EpisodeUpdateOne) SaveX(ctx context.Context) *Episode {
+	node, err := euo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (euo *EpisodeUpdateOne) Exec(ctx context.Context) error {
+	_, err := euo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (euo *EpisodeUpdateOne) ExecX(ctx context.Context) {
+	if err := euo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (euo *EpisodeUpdateOne) check() error {
+	if v, ok := euo.mutation.DurationMs(); ok {
+		if err := episode.DurationMsValidator(v); err != nil {
+			return &ValidationError{Name: "duration_ms", err: fmt.Errorf(`ent: validator failed for field "Episode.duration_ms": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (euo *EpisodeUpdateOne) sqlSave(ctx context.Context) (_node *Episode, err error) {
+	if err := euo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(episode.Table, episode.Columns, sqlgraph.NewFieldSpec(episode.FieldID, field.TypeString))
+	id, ok := euo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Episode.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := euo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, episode.FieldID)
+		for _, f := range fields {
+			if !episode.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != episode.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := euo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := euo.mutation.URI(); ok {
+		_spec.SetField(episode.FieldURI, field.TypeString, value)
+	}
+	if value, ok := euo.mutation.Name(); ok {
+		_spec.SetField(episode.FieldName, field.TypeString, value)
+	}
+	if value, ok := euo.mutation.DurationMs(); ok {
+		_spec.SetField(episode.FieldDurationMs, field.TypeInt, value)
+	}
+	if value, ok := euo.mutation.AddedDurationMs(); ok {
+		_spec.AddField(episode.FieldDurationMs, field.TypeInt, value)
+	}
+	if value, ok := euo.mutation.Explicit(); ok {
+		_spec.SetField(episode.FieldExplicit, field.TypeBool, value)
+	}
+	if value, ok := euo.mutation.ReleaseDate(); ok {
+		_spec.SetField(episode.FieldReleaseDate, field.TypeString, value)
+	}
+	if euo.mutation.ShowCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   episode.ShowTable,
+			Columns: []string{episode.ShowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(show.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.ShowIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   episode.ShowTable,
+			Columns: []string{episode.ShowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(show.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if euo.mutation.SnapshotItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   episode.SnapshotItemsTable,
+			Columns: []string{episode.SnapshotItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshotitem.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.RemovedSnapshotItemsIDs(); len(nodes) > 0 && !euo.mutation.SnapshotItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   episode.SnapshotItemsTable,
+			Columns: []string{episode.SnapshotItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshotitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.SnapshotItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   episode.SnapshotItemsTable,
+			Columns: []string{episode.SnapshotItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshotitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if euo.mutation.SavedEpisodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   episode.SavedEpisodesTable,
+			Columns: []string{episode.SavedEpisodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedepisode.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.RemovedSavedEpisodesIDs(); len(nodes) > 0 && !euo.mutation.SavedEpisodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   episode.SavedEpisodesTable,
+			Columns: []string{episode.SavedEpisodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedepisode.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.SavedEpisodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   episode.SavedEpisodesTable,
+			Columns: []string{episode.SavedEpisodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedepisode.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Episode{config: euo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, euo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{episode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	euo.mutation.done = true
+	return _node, nil
+}
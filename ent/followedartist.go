@@ -0,0 +1,196 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/artist"
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/followedartist"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// FollowedArtist is the model entity for the FollowedArtist schema.
+type FollowedArtist struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Followers holds the value of the "followers" field.
+	Followers int `json:"followers,omitempty"`
+	// Popularity holds the value of the "popularity" field.
+	Popularity int `json:"popularity,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the FollowedArtistQuery when eager-loading is set.
+	Edges                       FollowedArtistEdges `json:"edges"`
+	backup_run_followed_artists *string
+	followed_artist_artist      *string
+	selectValues                sql.SelectValues
+}
+
+// FollowedArtistEdges holds the relations/edges for other nodes in the graph.
+type FollowedArtistEdges struct {
+	// Run holds the value of the run edge.
+	Run *BackupRun `json:"run,omitempty"`
+	// Artist holds the value of the artist edge.
+	Artist *Artist `json:"artist,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// RunOrErr returns the Run value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FollowedArtistEdges) RunOrErr() (*BackupRun, error) {
+	if e.Run != nil {
+		return e.Run, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: backuprun.Label}
+	}
+	return nil, &NotLoadedError{edge: "run"}
+}
+
+// ArtistOrErr returns the Artist value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FollowedArtistEdges) ArtistOrErr() (*Artist, error) {
+	if e.Artist != nil {
+		return e.Artist, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: artist.Label}
+	}
+	return nil, &NotLoadedError{edge: "artist"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FollowedArtist) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case followedartist.FieldFollowers, followedartist.FieldPopularity:
+			values[i] = new(sql.NullInt64)
+		case followedartist.FieldID:
+			values[i] = new(sql.NullString)
+		case followedartist.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case followedartist.ForeignKeys[0]: // backup_run_followed_artists
+			values[i] = new(sql.NullString)
+		case followedartist.ForeignKeys[1]: // followed_artist_artist
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FollowedArtist fields.
+func (fa *FollowedArtist) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case followedartist.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				fa.ID = value.String
+			}
+		case followedartist.FieldFollowers:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field followers", values[i])
+			} else if value.Valid {
+				fa.Followers = int(value.Int64)
+			}
+		case followedartist.FieldPopularity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field popularity", values[i])
+			} else if value.Valid {
+				fa.Popularity = int(value.Int64)
+			}
+		case followedartist.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				fa.CreatedAt = value.Time
+			}
+		case followedartist.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field backup_run_followed_artists", values[i])
+			} else if value.Valid {
+				fa.backup_run_followed_artists = new(string)
+				*fa.backup_run_followed_artists = value.String
+			}
+		case followedartist.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field followed_artist_artist", values[i])
+			} else if value.Valid {
+				fa.followed_artist_artist = new(string)
+				*fa.followed_artist_artist = value.String
+			}
+		default:
+			fa.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the FollowedArtist.
+// This includes values selected through modifiers, order, etc.
+func (fa *FollowedArtist) Value(name string) (ent.Value, error) {
+	return fa.selectValues.Get(name)
+}
+
+// QueryRun queries the "run" edge of the FollowedArtist entity.
+func (fa *FollowedArtist) QueryRun() *BackupRunQuery {
+	return NewFollowedArtistClient(fa.config).QueryRun(fa)
+}
+
+// QueryArtist queries the "artist" edge of the FollowedArtist entity.
+func (fa *FollowedArtist) QueryArtist() *ArtistQuery {
+	return NewFollowedArtistClient(fa.config).QueryArtist(fa)
+}
+
+// Update returns a builder for updating this FollowedArtist.
+// Note that you need to call FollowedArtist.Unwrap() before calling this method if this FollowedArtist
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (fa *FollowedArtist) Update() *FollowedArtistUpdateOne {
+	return NewFollowedArtistClient(fa.config).UpdateOne(fa)
+}
+
+// Unwrap unwraps the FollowedArtist entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (fa *FollowedArtist) Unwrap() *FollowedArtist {
+	_tx, ok := fa.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FollowedArtist is not a transactional entity")
+	}
+	fa.config.driver = _tx.drv
+	return fa
+}
+
+// String implements the fmt.Stringer.
+func (fa *FollowedArtist) String() string {
+	var builder strings.Builder
+	builder.WriteString("FollowedArtist(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", fa.ID))
+	builder.WriteString("followers=")
+	builder.WriteString(fmt.Sprintf("%v", fa.Followers))
+	builder.WriteString(", ")
+	builder.WriteString("popularity=")
+	builder.WriteString(fmt.Sprintf("%v", fa.Popularity))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fa.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// FollowedArtists is a parsable slice of FollowedArtist.
+type FollowedArtists []*FollowedArtist
@@ -0,0 +1,139 @@
+// Code generated by ent, DO NOT EDIT.
+
+package followedartist
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the followedartist type in the database.
+	Label = "followed_artist"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldFollowers holds the string denoting the followers field in the database.
+	FieldFollowers = "followers"
+	// FieldPopularity holds the string denoting the popularity field in the database.
+	FieldPopularity = "popularity"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeRun holds the string denoting the run edge name in mutations.
+	EdgeRun = "run"
+	// EdgeArtist holds the string denoting the artist edge name in mutations.
+	EdgeArtist = "artist"
+	// Table holds the table name of the followedartist in the database.
+	Table = "followed_artists"
+	// RunTable is the table that holds the run relation/edge.
+	RunTable = "followed_artists"
+	// RunInverseTable is the table name for the BackupRun entity.
+	// It exists in this package in order to avoid circular dependency with the "backuprun" package.
+	RunInverseTable = "backup_runs"
+	// RunColumn is the table column denoting the run relation/edge.
+	RunColumn = "backup_run_followed_artists"
+	// ArtistTable is the table that holds the artist relation/edge.
+	ArtistTable = "followed_artists"
+	// ArtistInverseTable is the table name for the Artist entity.
+	// It exists in this package in order to avoid circular dependency with the "artist" package.
+	ArtistInverseTable = "artists"
+	// ArtistColumn is the table column denoting the artist relation/edge.
+	ArtistColumn = "followed_artist_artist"
+)
+
+// Columns holds all SQL columns for followedartist fields.
+var Columns = []string{
+	FieldID,
+	FieldFollowers,
+	FieldPopularity,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "followed_artists"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"backup_run_followed_artists",
+	"followed_artist_artist",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultFollowers holds the default value on creation for the "followers" field.
+	DefaultFollowers int
+	// FollowersValidator is a validator for the "followers" field. It is called by the builders before save.
+	FollowersValidator func(int) error
+	// DefaultPopularity holds the default value on creation for the "popularity" field.
+	DefaultPopularity int
+	// PopularityValidator is a validator for the "popularity" field. It is called by the builders before save.
+	PopularityValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() string
+)
+
+// OrderOption defines the ordering options for the FollowedArtist queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByFollowers orders the results by the followers field.
+func ByFollowers(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFollowers, opts...).ToFunc()
+}
+
+// ByPopularity orders the results by the popularity field.
+func ByPopularity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPopularity, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByRunField orders the results by run field.
+func ByRunField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRunStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByArtistField orders the results by artist field.
+func ByArtistField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newArtistStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newRunStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RunInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, RunTable, RunColumn),
+	)
+}
+func newArtistStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ArtistInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, ArtistTable, ArtistColumn),
+	)
+}
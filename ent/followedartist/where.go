@@ -0,0 +1,262 @@
+// Code generated by ent, DO NOT EDIT.
+
+package followedartist
+
+import (
+	"beyerleinf/spotify-backup/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.FollowedArtist {
+	return predicate.FollowedArtist(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.FollowedArtist {
+	return predicate.FollowedArtist(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.FollowedArtist {
+	return predicate.FollowedArtist(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.FollowedArtist {
+	return predicate.FollowedArtist(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.FollowedArtist {
+	return predicate.FollowedArtist(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.FollowedArtist {
+	return predicate.FollowedArtist(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.FollowedArtist {
+	return predicate.FollowedArtist(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.FollowedArtist {
+	return predicate.FollowedArtist(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.FollowedArtist {
+	return predicate.FollowedArtist(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.FollowedArtist {
+	return predicate.FollowedArtist(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.FollowedArtist {
+	return predicate.FollowedArtist(sql.FieldContainsFold(FieldID, id))
+}
+
+// Followers applies equality check predicate on the "followers" field. It's identical to FollowersEQ.
+func Followers(v int) predicate.FollowedArtist {
+	return predicate.FollowedArtist(sql.FieldEQ(FieldFollowers, v))
+}
+
+// Popularity applies equality check predicate on the "popularity" field. It's identical to PopularityEQ.
+func Popularity(v int) predicate.FollowedArtist {
+	return predicate.FollowedArtist(sql.FieldEQ(FieldPopularity, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.FollowedArtist {
+	return predicate.FollowedArtist(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// FollowersEQ applies the EQ predicate on the "followers" field.
+func FollowersEQ(v int) predicate.FollowedArtist {
+	return predicate.FollowedArtist(sql.FieldEQ(FieldFollowers, v))
+}
+
+// FollowersNEQ applies the NEQ predicate on the "followers" field.
+func FollowersNEQ(v int) predicate.FollowedArtist {
+	return predicate.FollowedArtist(sql.FieldNEQ(FieldFollowers, v))
+}
+
+// FollowersIn applies the In predicate on the "followers" field.
+func FollowersIn(vs ...int) predicate.FollowedArtist {
+	return predicate.FollowedArtist(sql.FieldIn(FieldFollowers, vs...))
+}
+
+// FollowersNotIn applies the NotIn predicate on the "followers" field.
+func FollowersNotIn(vs ...int) predicate.FollowedArtist {
+	return predicate.FollowedArtist(sql.FieldNotIn(FieldFollowers, vs...))
+}
+
+// FollowersGT applies the GT predicate on the "followers" field.
+func FollowersGT(v int) predicate.FollowedArtist {
+	return predicate.FollowedArtist(sql.FieldGT(FieldFollowers, v))
+}
+
+// FollowersGTE applies the GTE predicate on the "followers" field.
+func FollowersGTE(v int) predicate.FollowedArtist {
+	return predicate.FollowedArtist(sql.FieldGTE(FieldFollowers, v))
+}
+
+// FollowersLT applies the LT predicate on the "followers" field.
+func FollowersLT(v int) predicate.FollowedArtist {
+	return predicate.FollowedArtist(sql.FieldLT(FieldFollowers, v))
+}
+
+// FollowersLTE applies the LTE predicate on the "followers" field.
+func FollowersLTE(v int) predicate.FollowedArtist {
+	return predicate.FollowedArtist(sql.FieldLTE(FieldFollowers, v))
+}
+
+// PopularityEQ applies the EQ predicate on the "popularity" field.
+func PopularityEQ(v int) predicate.FollowedArtist {
+	return predicate.FollowedArtist(sql.FieldEQ(FieldPopularity, v))
+}
+
+// PopularityNEQ applies the NEQ predicate on the "popularity" field.
+func PopularityNEQ(v int) predicate.FollowedArtist {
+	return predicate.FollowedArtist(sql.FieldNEQ(FieldPopularity, v))
+}
+
+// PopularityIn applies the In predicate on the "popularity" field.
+func PopularityIn(vs ...int) predicate.FollowedArtist {
+	return predicate.FollowedArtist(sql.FieldIn(FieldPopularity, vs...))
+}
+
+// PopularityNotIn applies the NotIn predicate on the "popularity" field.
+func PopularityNotIn(vs ...int) predicate.FollowedArtist {
+	return predicate.FollowedArtist(sql.FieldNotIn(FieldPopularity, vs...))
+}
+
+// PopularityGT applies the GT predicate on the "popularity" field.
+func PopularityGT(v int) predicate.FollowedArtist {
+	return predicate.FollowedArtist(sql.FieldGT(FieldPopularity, v))
+}
+
+// PopularityGTE applies the GTE predicate on the "popularity" field.
+func PopularityGTE(v int) predicate.FollowedArtist {
+	return predicate.FollowedArtist(sql.FieldGTE(FieldPopularity, v))
+}
+
+// PopularityLT applies the LT predicate on the "popularity" field.
+func PopularityLT(v int) predicate.FollowedArtist {
+	return predicate.FollowedArtist(sql.FieldLT(FieldPopularity, v))
+}
+
+// PopularityLTE applies the LTE predicate on the "popularity" field.
+func PopularityLTE(v int) predicate.FollowedArtist {
+	return predicate.FollowedArtist(sql.FieldLTE(FieldPopularity, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.FollowedArtist {
+	return predicate.FollowedArtist(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.FollowedArtist {
+	return predicate.FollowedArtist(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.FollowedArtist {
+	return predicate.FollowedArtist(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.FollowedArtist {
+	return predicate.FollowedArtist(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.FollowedArtist {
+	return predicate.FollowedArtist(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.FollowedArtist {
+	return predicate.FollowedArtist(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.FollowedArtist {
+	return predicate.FollowedArtist(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.FollowedArtist {
+	return predicate.FollowedArtist(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasRun applies the HasEdge predicate on the "run" edge.
+func HasRun() predicate.FollowedArtist {
+	return predicate.FollowedArtist(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RunTable, RunColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRunWith applies the HasEdge predicate on the "run" edge with a given conditions (other predicates).
+func HasRunWith(preds ...predicate.BackupRun) predicate.FollowedArtist {
+	return predicate.FollowedArtist(func(s *sql.Selector) {
+		step := newRunStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasArtist applies the HasEdge predicate on the "artist" edge.
+func HasArtist() predicate.FollowedArtist {
+	return predicate.FollowedArtist(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ArtistTable, ArtistColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasArtistWith applies the HasEdge predicate on the "artist" edge with a given conditions (other predicates).
+func HasArtistWith(preds ...predicate.Artist) predicate.FollowedArtist {
+	return predicate.FollowedArtist(func(s *sql.Selector) {
+		step := newArtistStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.FollowedArtist) predicate.FollowedArtist {
+	return predicate.FollowedArtist(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.FollowedArtist) predicate.FollowedArtist {
+	return predicate.FollowedArtist(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.FollowedArtist) predicate.FollowedArtist {
+	return predicate.FollowedArtist(sql.NotPredicates(p))
+}
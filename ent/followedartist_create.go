@@ -0,0 +1,349 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/artist"
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/followedartist"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FollowedArtistCreate is the builder for creating a FollowedArtist entity.
+type FollowedArtistCreate struct {
+	config
+	mutation *FollowedArtistMutation
+	hooks    []Hook
+}
+
+// SetFollowers sets the "followers" field.
+func (fac *FollowedArtistCreate) SetFollowers(i int) *FollowedArtistCreate {
+	fac.mutation.SetFollowers(i)
+	return fac
+}
+
+// SetNillableFollowers sets the "followers" field if the given value is not nil.
+func (fac *FollowedArtistCreate) SetNillableFollowers(i *int) *FollowedArtistCreate {
+	if i != nil {
+		fac.SetFollowers(*i)
+	}
+	return fac
+}
+
+// SetPopularity sets the "popularity" field.
+func (fac *FollowedArtistCreate) SetPopularity(i int) *FollowedArtistCreate {
+	fac.mutation.SetPopularity(i)
+	return fac
+}
+
+// SetNillablePopularity sets the "popularity" field if the given value is not nil.
+func (fac *FollowedArtistCreate) SetNillablePopularity(i *int) *FollowedArtistCreate {
+	if i != nil {
+		fac.SetPopularity(*i)
+	}
+	return fac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (fac *FollowedArtistCreate) SetCreatedAt(t time.Time) *FollowedArtistCreate {
+	fac.mutation.SetCreatedAt(t)
+	return fac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (fac *FollowedArtistCreate) SetNillableCreatedAt(t *time.Time) *FollowedArtistCreate {
+	if t != nil {
+		fac.SetCreatedAt(*t)
+	}
+	return fac
+}
+
+// SetID sets the "id" field.
+func (fac *FollowedArtistCreate) SetID(s string) *FollowedArtistCreate {
+	fac.mutation.SetID(s)
+	return fac
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (fac *FollowedArtistCreate) SetNillableID(s *string) *FollowedArtistCreate {
+	if s != nil {
+		fac.SetID(*s)
+	}
+	return fac
+}
+
+// SetRunID sets the "run" edge to the BackupRun entity by ID.
+func (fac *FollowedArtistCreate) SetRunID(id string) *FollowedArtistCreate {
+	fac.mutation.SetRunID(id)
+	return fac
+}
+
+// SetRun sets the "run" edge to the BackupRun entity.
+func (fac *FollowedArtistCreate) SetRun(b *BackupRun) *FollowedArtistCreate {
+	return fac.SetRunID(b.ID)
+}
+
+// SetArtistID sets the "artist" edge to the Artist entity by ID.
+func (fac *FollowedArtistCreate) SetArtistID(id string) *FollowedArtistCreate {
+	fac.mutation.SetArtistID(id)
+	return fac
+}
+
+// SetArtist sets the "artist" edge to the Artist entity.
+func (fac *FollowedArtistCreate) SetArtist(a *Artist) *FollowedArtistCreate {
+	return fac.SetArtistID(a.ID)
+}
+
+// Mutation returns the FollowedArtistMutation object of the builder.
+func (fac *FollowedArtistCreate) Mutation() *FollowedArtistMutation {
+	return fac.mutation
+}
+
+// Save creates the FollowedArtist in the database.
+func (fac *FollowedArtistCreate) Save(ctx context.Context) (*FollowedArtist, error) {
+	fac.defaults()
+	return withHooks(ctx, fac.sqlSave, fac.mutation, fac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (fac *FollowedArtistCreate) SaveX(ctx context.Context) *FollowedArtist {
+	v, err := fac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (fac *FollowedArtistCreate) Exec(ctx context.Context) error {
+	_, err := fac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fac *FollowedArtistCreate) ExecX(ctx context.Context) {
+	if err := fac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fac *FollowedArtistCreate) defaults() {
+	if _, ok := fac.mutation.Followers(); !ok {
+		v := followedartist.DefaultFollowers
+		fac.mutation.SetFollowers(v)
+	}
+	if _, ok := fac.mutation.Popularity(); !ok {
+		v := followedartist.DefaultPopularity
+		fac.mutation.SetPopularity(v)
+	}
+	if _, ok := fac.mutation.CreatedAt(); !ok {
+		v := followedartist.DefaultCreatedAt()
+		fac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := fac.mutation.ID(); !ok {
+		v := followedartist.DefaultID()
+		fac.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fac *FollowedArtistCreate) check() error {
+	if _, ok := fac.mutation.Followers(); !ok {
+		return &ValidationError{Name: "followers", err: errors.New(`ent: missing required field "FollowedArtist.followers"`)}
+	}
+	if v, ok := fac.mutation.Followers(); ok {
+		if err := followedartist.FollowersValidator(v); err != nil {
+			return &ValidationError{Name: "followers", err: fmt.Errorf(`ent: validator failed for field "FollowedArtist.followers": %w`, err)}
+		}
+	}
+	if _, ok := fac.mutation.Popularity(); !ok {
+		return &ValidationError{Name: "popularity", err: errors.New(`ent: missing required field "FollowedArtist.popularity"`)}
+	}
+	if v, ok := fac.mutation.Popularity(); ok {
+		if err := followedartist.PopularityValidator(v); err != nil {
+			return &ValidationError{Name: "popularity", err: fmt.Errorf(`ent: validator failed for field "FollowedArtist.popularity": %w`, err)}
+		}
+	}
+	if _, ok := fac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "FollowedArtist.created_at"`)}
+	}
+	if len(fac.mutation.RunIDs()) == 0 {
+		return &ValidationError{Name: "run", err: errors.New(`ent: missing required edge "FollowedArtist.run"`)}
+	}
+	if len(fac.mutation.ArtistIDs()) == 0 {
+		return &ValidationError{Name: "artist", err: errors.New(`ent: missing required edge "FollowedArtist.artist"`)}
+	}
+	return nil
+}
+
+func (fac *FollowedArtistCreate) sqlSave(ctx context.Context) (*FollowedArtist, error) {
+	if err := fac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := fac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, fac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected FollowedArtist.ID type: %T", _spec.ID.Value)
+		}
+	}
+	fac.mutation.id = &_node.ID
+	fac.mutation.done = true
+	return _node, nil
+}
+
+func (fac *FollowedArtistCreate) createSpec() (*FollowedArtist, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FollowedArtist{config: fac.config}
+		_spec = sqlgraph.NewCreateSpec(followedartist.Table, sqlgraph.NewFieldSpec(followedartist.FieldID, field.TypeString))
+	)
+	if id, ok := fac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := fac.mutation.Followers(); ok {
+		_spec.SetField(followedartist.FieldFollowers, field.TypeInt, value)
+		_node.Followers = value
+	}
+	if value, ok := fac.mutation.Popularity(); ok {
+		_spec.SetField(followedartist.FieldPopularity, field.TypeInt, value)
+		_node.Popularity = value
+	}
+	if value, ok := fac.mutation.CreatedAt(); ok {
+		_spec.SetField(followedartist.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := fac.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   followedartist.RunTable,
+			Columns: []string{followedartist.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.backup_run_followed_artists = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := fac.mutation.ArtistIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   followedartist.ArtistTable,
+			Columns: []string{followedartist.ArtistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artist.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.followed_artist_artist = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// FollowedArtistCreateBulk is the builder for creating many FollowedArtist entities in bulk.
+type FollowedArtistCreateBulk struct {
+	config
+	err      error
+	builders []*FollowedArtistCreate
+}
+
+// Save creates the FollowedArtist entities in the database.
+func (facb *FollowedArtistCreateBulk) Save(ctx context.Context) ([]*FollowedArtist, error) {
+	if facb.err != nil {
+		return nil, facb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(facb.builders))
+	nodes := make([]*FollowedArtist, len(facb.builders))
+	mutators := make([]Mutator, len(facb.builders))
+	for i := range facb.builders {
+		func(i int, root context.Context) {
+			builder := facb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FollowedArtistMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, facb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, facb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, facb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (facb *FollowedArtistCreateBulk) SaveX(ctx context.Context) []*FollowedArtist {
+	v, err := facb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (facb *FollowedArtistCreateBulk) Exec(ctx context.Context) error {
+	_, err := facb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (facb *FollowedArtistCreateBulk) ExecX(ctx context.Context) {
+	if err := facb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
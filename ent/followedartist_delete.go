@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/followedartist"
+	"beyerleinf/spotify-backup/ent/predicate"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FollowedArtistDelete is the builder for deleting a FollowedArtist entity.
+type FollowedArtistDelete struct {
+	config
+	hooks    []Hook
+	mutation *FollowedArtistMutation
+}
+
+// Where appends a list predicates to the FollowedArtistDelete builder.
+func (fad *FollowedArtistDelete) Where(ps ...predicate.FollowedArtist) *FollowedArtistDelete {
+	fad.mutation.Where(ps...)
+	return fad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (fad *FollowedArtistDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, fad.sqlExec, fad.mutation, fad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fad *FollowedArtistDelete) ExecX(ctx context.Context) int {
+	n, err := fad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (fad *FollowedArtistDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(followedartist.Table, sqlgraph.NewFieldSpec(followedartist.FieldID, field.TypeString))
+	if ps := fad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, fad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	fad.mutation.done = true
+	return affected, err
+}
+
+// FollowedArtistDeleteOne is the builder for deleting a single FollowedArtist entity.
+type FollowedArtistDeleteOne struct {
+	fad *FollowedArtistDelete
+}
+
+// Where appends a list predicates to the FollowedArtistDelete builder.
+func (fado *FollowedArtistDeleteOne) Where(ps ...predicate.FollowedArtist) *FollowedArtistDeleteOne {
+	fado.fad.mutation.Where(ps...)
+	return fado
+}
+
+// Exec executes the deletion query.
+func (fado *FollowedArtistDeleteOne) Exec(ctx context.Context) error {
+	n, err := fado.fad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{followedartist.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fado *FollowedArtistDeleteOne) ExecX(ctx context.Context) {
+	if err := fado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
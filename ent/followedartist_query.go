@@ -0,0 +1,726 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/artist"
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/followedartist"
+	"beyerleinf/spotify-backup/ent/predicate"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FollowedArtistQuery is the builder for querying FollowedArtist entities.
+type FollowedArtistQuery struct {
+	config
+	ctx        *QueryContext
+	order      []followedartist.OrderOption
+	inters     []Interceptor
+	predicates []predicate.FollowedArtist
+	withRun    *BackupRunQuery
+	withArtist *ArtistQuery
+	withFKs    bool
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the FollowedArtistQuery builder.
+func (faq *FollowedArtistQuery) Where(ps ...predicate.FollowedArtist) *FollowedArtistQuery {
+	faq.predicates = append(faq.predicates, ps...)
+	return faq
+}
+
+// Limit the number of records to be returned by this query.
+func (faq *FollowedArtistQuery) Limit(limit int) *FollowedArtistQuery {
+	faq.ctx.Limit = &limit
+	return faq
+}
+
+// Offset to start from.
+func (faq *FollowedArtistQuery) Offset(offset int) *FollowedArtistQuery {
+	faq.ctx.Offset = &offset
+	return faq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (faq *FollowedArtistQuery) Unique(unique bool) *FollowedArtistQuery {
+	faq.ctx.Unique = &unique
+	return faq
+}
+
+// Order specifies how the records should be ordered.
+func (faq *FollowedArtistQuery) Order(o ...followedartist.OrderOption) *FollowedArtistQuery {
+	faq.order = append(faq.order, o...)
+	return faq
+}
+
+// QueryRun chains the current query on the "run" edge.
+func (faq *FollowedArtistQuery) QueryRun() *BackupRunQuery {
+	query := (&BackupRunClient{config: faq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := faq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := faq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(followedartist.Table, followedartist.FieldID, selector),
+			sqlgraph.To(backuprun.Table, backuprun.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, followedartist.RunTable, followedartist.RunColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(faq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryArtist chains the current query on the "artist" edge.
+func (faq *FollowedArtistQuery) QueryArtist() *ArtistQuery {
+	query := (&ArtistClient{config: faq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := faq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := faq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(followedartist.Table, followedartist.FieldID, selector),
+			sqlgraph.To(artist.Table, artist.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, followedartist.ArtistTable, followedartist.ArtistColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(faq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first FollowedArtist entity from the query.
+// Returns a *NotFoundError when no FollowedArtist was found.
+func (faq *FollowedArtistQuery) First(ctx context.Context) (*FollowedArtist, error) {
+	nodes, err := faq.Limit(1).All(setContextOp(ctx, faq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{followedartist.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (faq *FollowedArtistQuery) FirstX(ctx context.Context) *FollowedArtist {
+	node, err := faq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first FollowedArtist ID from the query.
+// Returns a *NotFoundError when no FollowedArtist ID was found.
+func (faq *FollowedArtistQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = faq.Limit(1).IDs(setContextOp(ctx, faq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{followedartist.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (faq *FollowedArtistQuery) FirstIDX(ctx context.Context) string {
+	id, err := faq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single FollowedArtist entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one FollowedArtist entity is found.
+// Returns a *NotFoundError when no FollowedArtist entities are found.
+func (faq *FollowedArtistQuery) Only(ctx context.Context) (*FollowedArtist, error) {
+	nodes, err := faq.Limit(2).All(setContextOp(ctx, faq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{followedartist.Label}
+	default:
+		return nil, &NotSingularError{followedartist.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (faq *FollowedArtistQuery) OnlyX(ctx context.Context) *FollowedArtist {
+	node, err := faq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only FollowedArtist ID in the query.
+// Returns a *NotSingularError when more than one FollowedArtist ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (faq *FollowedArtistQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = faq.Limit(2).IDs(setContextOp(ctx, faq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{followedartist.Label}
+	default:
+		err = &NotSingularError{followedartist.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (faq *FollowedArtistQuery) OnlyIDX(ctx context.Context) string {
+	id, err := faq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of FollowedArtists.
+func (faq *FollowedArtistQuery) All(ctx context.Context) ([]*FollowedArtist, error) {
+	ctx = setContextOp(ctx, faq.ctx, ent.OpQueryAll)
+	if err := faq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*FollowedArtist, *FollowedArtistQuery]()
+	return withInterceptors[[]*FollowedArtist](ctx, faq, qr, faq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (faq *FollowedArtistQuery) AllX(ctx context.Context) []*FollowedArtist {
+	nodes, err := faq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of FollowedArtist IDs.
+func (faq *FollowedArtistQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if faq.ctx.Unique == nil && faq.path != nil {
+		faq.Unique(true)
+	}
+	ctx = setContextOp(ctx, faq.ctx, ent.OpQueryIDs)
+	if err = faq.Select(followedartist.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (faq *FollowedArtistQuery) IDsX(ctx context.Context) []string {
+	ids, err := faq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (faq *FollowedArtistQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, faq.ctx, ent.OpQueryCount)
+	if err := faq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, faq, querierCount[*FollowedArtistQuery](), faq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (faq *FollowedArtistQuery) CountX(ctx context.Context) int {
+	count, err := faq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (faq *FollowedArtistQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, faq.ctx, ent.OpQueryExist)
+	switch _, err := faq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (faq *FollowedArtistQuery) ExistX(ctx context.Context) bool {
+	exist, err := faq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the FollowedArtistQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (faq *FollowedArtistQuery) Clone() *FollowedArtistQuery {
+	if faq == nil {
+		return nil
+	}
+	return &FollowedArtistQuery{
+		config:     faq.config,
+		ctx:        faq.ctx.Clone(),
+		order:      append([]followedartist.OrderOption{}, faq.order...),
+		inters:     append([]Interceptor{}, faq.inters...),
+		predicates: append([]predicate.FollowedArtist{}, faq.predicates...),
+		withRun:    faq.withRun.Clone(),
+		withArtist: faq.withArtist.Clone(),
+		// clone intermediate query.
+		sql:  faq.sql.Clone(),
+		path: faq.path,
+	}
+}
+
+// WithRun tells the query-builder to eager-load the nodes that are connected to
+// the "run" edge. The optional arguments are used to configure the query builder of the edge.
+func (faq *FollowedArtistQuery) WithRun(opts ...func(*BackupRunQuery)) *FollowedArtistQuery {
+	query := (&BackupRunClient{config: faq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	faq.withRun = query
+	return faq
+}
+
+// WithArtist tells the query-builder to eager-load the nodes that are connected to
+// the "artist" edge. The optional arguments are used to configure the query builder of the edge.
+func (faq *FollowedArtistQuery) WithArtist(opts ...func(*ArtistQuery)) *FollowedArtistQuery {
+	query := (&ArtistClient{config: faq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	faq.withArtist = query
+	return faq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Followers int `json:"followers,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.FollowedArtist.Query().
+//		GroupBy(followedartist.FieldFollowers).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (faq *FollowedArtistQuery) GroupBy(field string, fields ...string) *FollowedArtistGroupBy {
+	faq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &FollowedArtistGroupBy{build: faq}
+	grbuild.flds = &faq.ctx.Fields
+	grbuild.label = followedartist.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Followers int `json:"followers,omitempty"`
+//	}
+//
+//	client.FollowedArtist.Query().
+//		Select(followedartist.FieldFollowers).
+//		Scan(ctx, &v)
+func (faq *FollowedArtistQuery) Select(fields ...string) *FollowedArtistSelect {
+	faq.ctx.Fields = append(faq.ctx.Fields, fields...)
+	sbuild := &FollowedArtistSelect{FollowedArtistQuery: faq}
+	sbuild.label = followedartist.Label
+	sbuild.flds, sbuild.scan = &faq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a FollowedArtistSelect configured with the given aggregations.
+func (faq *FollowedArtistQuery) Aggregate(fns ...AggregateFunc) *FollowedArtistSelect {
+	return faq.Select().Aggregate(fns...)
+}
+
+func (faq *FollowedArtistQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range faq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, faq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range faq.ctx.Fields {
+		if !followedartist.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if faq.path != nil {
+		prev, err := faq.path(ctx)
+		if err != nil {
+			return err
+		}
+		faq.sql = prev
+	}
+	return nil
+}
+
+func (faq *FollowedArtistQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*FollowedArtist, error) {
+	var (
+		nodes       = []*FollowedArtist{}
+		withFKs     = faq.withFKs
+		_spec       = faq.querySpec()
+		loadedTypes = [2]bool{
+			faq.withRun != nil,
+			faq.withArtist != nil,
+		}
+	)
+	if faq.withRun != nil || faq.withArtist != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, followedartist.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*FollowedArtist).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &FollowedArtist{config: faq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(faq.modifiers) > 0 {
+		_spec.Modifiers = faq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, faq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := faq.withRun; query != nil {
+		if err := faq.loadRun(ctx, query, nodes, nil,
+			func(n *FollowedArtist, e *BackupRun) { n.Edges.Run = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := faq.withArtist; query != nil {
+		if err := faq.loadArtist(ctx, query, nodes, nil,
+			func(n *FollowedArtist, e *Artist) { n.Edges.Artist = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (faq *FollowedArtistQuery) loadRun(ctx context.Context, query *BackupRunQuery, nodes []*FollowedArtist, init func(*FollowedArtist), assign func(*FollowedArtist, *BackupRun)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*FollowedArtist)
+	for i := range nodes {
+		if nodes[i].backup_run_followed_artists == nil {
+			continue
+		}
+		fk := *nodes[i].backup_run_followed_artists
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(backuprun.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "backup_run_followed_artists" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (faq *FollowedArtistQuery) loadArtist(ctx context.Context, query *ArtistQuery, nodes []*FollowedArtist, init func(*FollowedArtist), assign func(*FollowedArtist, *Artist)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*FollowedArtist)
+	for i := range nodes {
+		if nodes[i].followed_artist_artist == nil {
+			continue
+		}
+		fk := *nodes[i].followed_artist_artist
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(artist.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "followed_artist_artist" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (faq *FollowedArtistQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := faq.querySpec()
+	if len(faq.modifiers) > 0 {
+		_spec.Modifiers = faq.modifiers
+	}
+	_spec.Node.Columns = faq.ctx.Fields
+	if len(faq.ctx.Fields) > 0 {
+		_spec.Unique = faq.ctx.Unique != nil && *faq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, faq.driver, _spec)
+}
+
+func (faq *FollowedArtistQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(followedartist.Table, followedartist.Columns, sqlgraph.NewFieldSpec(followedartist.FieldID, field.TypeString))
+	_spec.From = faq.sql
+	if unique := faq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if faq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := faq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, followedartist.FieldID)
+		for i := range fields {
+			if fields[i] != followedartist.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := faq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := faq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := faq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := faq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (faq *FollowedArtistQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(faq.driver.Dialect())
+	t1 := builder.Table(followedartist.Table)
+	columns := faq.ctx.Fields
+	if len(columns) == 0 {
+		columns = followedartist.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if faq.sql != nil {
+		selector = faq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if faq.ctx.Unique != nil && *faq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range faq.modifiers {
+		m(selector)
+	}
+	for _, p := range faq.predicates {
+		p(selector)
+	}
+	for _, p := range faq.order {
+		p(selector)
+	}
+	if offset := faq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := faq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (faq *FollowedArtistQuery) ForUpdate(opts ...sql.LockOption) *FollowedArtistQuery {
+	if faq.driver.Dialect() == dialect.Postgres {
+		faq.Unique(false)
+	}
+	faq.modifiers = append(faq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return faq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (faq *FollowedArtistQuery) ForShare(opts ...sql.LockOption) *FollowedArtistQuery {
+	if faq.driver.Dialect() == dialect.Postgres {
+		faq.Unique(false)
+	}
+	faq.modifiers = append(faq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return faq
+}
+
+// FollowedArtistGroupBy is the group-by builder for FollowedArtist entities.
+type FollowedArtistGroupBy struct {
+	selector
+	build *FollowedArtistQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (fagb *FollowedArtistGroupBy) Aggregate(fns ...AggregateFunc) *FollowedArtistGroupBy {
+	fagb.fns = append(fagb.fns, fns...)
+	return fagb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (fagb *FollowedArtistGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, fagb.build.ctx, ent.OpQueryGroupBy)
+	if err := fagb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*FollowedArtistQuery, *FollowedArtistGroupBy](ctx, fagb.build, fagb, fagb.build.inters, v)
+}
+
+func (fagb *FollowedArtistGroupBy) sqlScan(ctx context.Context, root *FollowedArtistQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(fagb.fns))
+	for _, fn := range fagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*fagb.flds)+len(fagb.fns))
+		for _, f := range *fagb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*fagb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := fagb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// FollowedArtistSelect is the builder for selecting fields of FollowedArtist entities.
+type FollowedArtistSelect struct {
+	*FollowedArtistQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (fas *FollowedArtistSelect) Aggregate(fns ...AggregateFunc) *FollowedArtistSelect {
+	fas.fns = append(fas.fns, fns...)
+	return fas
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (fas *FollowedArtistSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, fas.ctx, ent.OpQuerySelect)
+	if err := fas.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*FollowedArtistQuery, *FollowedArtistSelect](ctx, fas.FollowedArtistQuery, fas, fas.inters, v)
+}
+
+func (fas *FollowedArtistSelect) sqlScan(ctx context.Context, root *FollowedArtistQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(fas.fns))
+	for _, fn := range fas.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*fas.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := fas.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,517 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/artist"
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/followedartist"
+	"beyerleinf/spotify-backup/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FollowedArtistUpdate is the builder for updating FollowedArtist entities.
+type FollowedArtistUpdate struct {
+	config
+	hooks    []Hook
+	mutation *FollowedArtistMutation
+}
+
+// Where appends a list predicates to the FollowedArtistUpdate builder.
+func (fau *FollowedArtistUpdate) Where(ps ...predicate.FollowedArtist) *FollowedArtistUpdate {
+	fau.mutation.Where(ps...)
+	return fau
+}
+
+// SetFollowers sets the "followers" field.
+func (fau *FollowedArtistUpdate) SetFollowers(i int) *FollowedArtistUpdate {
+	fau.mutation.ResetFollowers()
+	fau.mutation.SetFollowers(i)
+	return fau
+}
+
+// SetNillableFollowers sets the "followers" field if the given value is not nil.
+func (fau *FollowedArtistUpdate) SetNillableFollowers(i *int) *FollowedArtistUpdate {
+	if i != nil {
+		fau.SetFollowers(*i)
+	}
+	return fau
+}
+
+// AddFollowers adds i to the "followers" field.
+func (fau *FollowedArtistUpdate) AddFollowers(i int) *FollowedArtistUpdate {
+	fau.mutation.AddFollowers(i)
+	return fau
+}
+
+// SetPopularity sets the "popularity" field.
+func (fau *FollowedArtistUpdate) SetPopularity(i int) *FollowedArtistUpdate {
+	fau.mutation.ResetPopularity()
+	fau.mutation.SetPopularity(i)
+	return fau
+}
+
+// SetNillablePopularity sets the "popularity" field if the given value is not nil.
+func (fau *FollowedArtistUpdate) SetNillablePopularity(i *int) *FollowedArtistUpdate {
+	if i != nil {
+		fau.SetPopularity(*i)
+	}
+	return fau
+}
+
+// AddPopularity adds i to the "popularity" field.
+func (fau *FollowedArtistUpdate) AddPopularity(i int) *FollowedArtistUpdate {
+	fau.mutation.AddPopularity(i)
+	return fau
+}
+
+// SetRunID sets the "run" edge to the BackupRun entity by ID.
+func (fau *FollowedArtistUpdate) SetRunID(id string) *FollowedArtistUpdate {
+	fau.mutation.SetRunID(id)
+	return fau
+}
+
+// SetRun sets the "run" edge to the BackupRun entity.
+func (fau *FollowedArtistUpdate) SetRun(b *BackupRun) *FollowedArtistUpdate {
+	return fau.SetRunID(b.ID)
+}
+
+// SetArtistID sets the "artist" edge to the Artist entity by ID.
+func (fau *FollowedArtistUpdate) SetArtistID(id string) *FollowedArtistUpdate {
+	fau.mutation.SetArtistID(id)
+	return fau
+}
+
+// SetArtist sets the "artist" edge to the Artist entity.
+func (fau *FollowedArtistUpdate) SetArtist(a *Artist) *FollowedArtistUpdate {
+	return fau.SetArtistID(a.ID)
+}
+
+// Mutation returns the FollowedArtistMutation object of the builder.
+func (fau *FollowedArtistUpdate) Mutation() *FollowedArtistMutation {
+	return fau.mutation
+}
+
+// ClearRun clears the "run" edge to the BackupRun entity.
+func (fau *FollowedArtistUpdate) ClearRun() *FollowedArtistUpdate {
+	fau.mutation.ClearRun()
+	return fau
+}
+
+// ClearArtist clears the "artist" edge to the Artist entity.
+func (fau *FollowedArtistUpdate) ClearArtist() *FollowedArtistUpdate {
+	fau.mutation.ClearArtist()
+	return fau
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (fau *FollowedArtistUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, fau.sqlSave, fau.mutation, fau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fau *FollowedArtistUpdate) SaveX(ctx context.Context) int {
+	affected, err := fau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (fau *FollowedArtistUpdate) Exec(ctx context.Context) error {
+	_, err := fau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fau *FollowedArtistUpdate) ExecX(ctx context.Context) {
+	if err := fau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fau *FollowedArtistUpdate) check() error {
+	if v, ok := fau.mutation.Followers(); ok {
+		if err := followedartist.FollowersValidator(v); err != nil {
+			return &ValidationError{Name: "followers", err: fmt.Errorf(`ent: validator failed for field "FollowedArtist.followers": %w`, err)}
+		}
+	}
+	if v, ok := fau.mutation.Popularity(); ok {
+		if err := followedartist.PopularityValidator(v); err != nil {
+			return &ValidationError{Name: "popularity", err: fmt.Errorf(`ent: validator failed for field "FollowedArtist.popularity": %w`, err)}
+		}
+	}
+	if fau.mutation.RunCleared() && len(fau.mutation.RunIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "FollowedArtist.run"`)
+	}
+	if fau.mutation.ArtistCleared() && len(fau.mutation.ArtistIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "FollowedArtist.artist"`)
+	}
+	return nil
+}
+
+func (fau *FollowedArtistUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := fau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(followedartist.Table, followedartist.Columns, sqlgraph.NewFieldSpec(followedartist.FieldID, field.TypeString))
+	if ps := fau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fau.mutation.Followers(); ok {
+		_spec.SetField(followedartist.FieldFollowers, field.TypeInt, value)
+	}
+	if value, ok := fau.mutation.AddedFollowers(); ok {
+		_spec.AddField(followedartist.FieldFollowers, field.TypeInt, value)
+	}
+	if value, ok := fau.mutation.Popularity(); ok {
+		_spec.SetField(followedartist.FieldPopularity, field.TypeInt, value)
+	}
+	if value, ok := fau.mutation.AddedPopularity(); ok {
+		_spec.AddField(followedartist.FieldPopularity, field.TypeInt, value)
+	}
+	if fau.mutation.RunCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   followedartist.RunTable,
+			Columns: []string{followedartist.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fau.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   followedartist.RunTable,
+			Columns: []string{followedartist.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fau.mutation.ArtistCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   followedartist.ArtistTable,
+			Columns: []string{followedartist.ArtistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artist.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fau.mutation.ArtistIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   followedartist.ArtistTable,
+			Columns: []string{followedartist.ArtistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artist.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, fau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{followedartist.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	fau.mutation.done = true
+	return n, nil
+}
+
+// FollowedArtistUpdateOne is the builder for updating a single FollowedArtist entity.
+type FollowedArtistUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *FollowedArtistMutation
+}
+
+// SetFollowers sets the "followers" field.
+func (fauo *FollowedArtistUpdateOne) SetFollowers(i int) *FollowedArtistUpdateOne {
+	fauo.mutation.ResetFollowers()
+	fauo.mutation.SetFollowers(i)
+	return fauo
+}
+
+// SetNillableFollowers sets the "followers" field if the given value is not nil.
+func (fauo *FollowedArtistUpdateOne) SetNillableFollowers(i *int) *FollowedArtistUpdateOne {
+	if i != nil {
+		fauo.SetFollowers(*i)
+	}
+	return fauo
+}
+
+// AddFollowers adds i to the "followers" field.
+func (fauo *FollowedArtistUpdateOne) AddFollowers(i int) *FollowedArtistUpdateOne {
+	fauo.mutation.AddFollowers(i)
+	return fauo
+}
+
+// SetPopularity sets the "popularity" field.
+func (fauo *FollowedArtistUpdateOne) SetPopularity(i int) *FollowedArtistUpdateOne {
+	fauo.mutation.ResetPopularity()
+	fauo.mutation.SetPopularity(i)
+	return fauo
+}
+
+// SetNillablePopularity sets the "popularity" field if the given value is not nil.
+func (fauo *FollowedArtistUpdateOne) SetNillablePopularity(i *int) *FollowedArtistUpdateOne {
+	if i != nil {
+		fauo.SetPopularity(*i)
+	}
+	return fauo
+}
+
+// AddPopularity adds i to the "popularity" field.
+func (fauo *FollowedArtistUpdateOne) AddPopularity(i int) *FollowedArtistUpdateOne {
+	fauo.mutation.AddPopularity(i)
+	return fauo
+}
+
+// SetRunID sets the "run" edge to the BackupRun entity by ID.
+func (fauo *FollowedArtistUpdateOne) SetRunID(id string) *FollowedArtistUpdateOne {
+	fauo.mutation.SetRunID(id)
+	return fauo
+}
+
+// SetRun sets the "run" edge to the BackupRun entity.
+func (fauo *FollowedArtistUpdateOne) SetRun(b *BackupRun) *FollowedArtistUpdateOne {
+	return fauo.SetRunID(b.ID)
+}
+
+// SetArtistID sets the "artist" edge to the Artist entity by ID.
+func (fauo *FollowedArtistUpdateOne) SetArtistID(id string) *FollowedArtistUpdateOne {
+	fauo.mutation.SetArtistID(id)
+	return fauo
+}
+
+// SetArtist sets the "artist" edge to the Artist entity.
+func (fauo *FollowedArtistUpdateOne) SetArtist(a *Artist) *FollowedArtistUpdateOne {
+	return fauo.SetArtistID(a.ID)
+}
+
+// Mutation returns the FollowedArtistMutation object of the builder.
+func (fauo *FollowedArtistUpdateOne) Mutation() *FollowedArtistMutation {
+	return fauo.mutation
+}
+
+// ClearRun clears the "run" edge to the BackupRun entity.
+func (fauo *FollowedArtistUpdateOne) ClearRun() *FollowedArtistUpdateOne {
+	fauo.mutation.ClearRun()
+	return fauo
+}
+
+// ClearArtist clears the "artist" edge to the Artist entity.
+func (fauo *FollowedArtistUpdateOne) ClearArtist() *FollowedArtistUpdateOne {
+	fauo.mutation.ClearArtist()
+	return fauo
+}
+
+// Where appends a list predicates to the FollowedArtistUpdate builder.
+func (fauo *FollowedArtistUpdateOne) Where(ps ...predicate.FollowedArtist) *FollowedArtistUpdateOne {
+	fauo.mutation.Where(ps...)
+	return fauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (fauo *FollowedArtistUpdateOne) Select(field string, fields ...string) *FollowedArtistUpdateOne {
+	fauo.fields = append([]string{field}, fields...)
+	return fauo
+}
+
+// Save executes the query and returns the updated FollowedArtist entity.
+func (fauo *FollowedArtistUpdateOne) Save(ctx context.Context) (*FollowedArtist, error) {
+	return withHooks(ctx, fauo.sqlSave, fauo.mutation, fauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fauo *FollowedArtistUpdateOne) SaveX(ctx context.Context) *FollowedArtist {
+	node, err := fauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (fauo *FollowedArtistUpdateOne) Exec(ctx context.Context) error {
+	_, err := fauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fauo *FollowedArtistUpdateOne) ExecX(ctx context.Context) {
+	if err := fauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fauo *FollowedArtistUpdateOne) check() error {
+	if v, ok := fauo.mutation.Followers(); ok {
+		if err := followedartist.FollowersValidator(v); err != nil {
+			return &ValidationError{Name: "followers", err: fmt.Errorf(`ent: validator failed for field "FollowedArtist.followers": %w`, err)}
+		}
+	}
+	if v, ok := fauo.mutation.Popularity(); ok {
+		if err := followedartist.PopularityValidator(v); err != nil {
+			return &ValidationError{Name: "popularity", err: fmt.Errorf(`ent: validator failed for field "FollowedArtist.popularity": %w`, err)}
+		}
+	}
+	if fauo.mutation.RunCleared() && len(fauo.mutation.RunIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "FollowedArtist.run"`)
+	}
+	if fauo.mutation.ArtistCleared() && len(fauo.mutation.ArtistIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "FollowedArtist.artist"`)
+	}
+	return nil
+}
+
+func (fauo *FollowedArtistUpdateOne) sqlSave(ctx context.Context) (_node *FollowedArtist, err error) {
+	if err := fauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(followedartist.Table, followedartist.Columns, sqlgraph.NewFieldSpec(followedartist.FieldID, field.TypeString))
+	id, ok := fauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FollowedArtist.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := fauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, followedartist.FieldID)
+		for _, f := range fields {
+			if !followedartist.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != followedartist.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := fauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fauo.mutation.Followers(); ok {
+		_spec.SetField(followedartist.FieldFollowers, field.TypeInt, value)
+	}
+	if value, ok := fauo.mutation.AddedFollowers(); ok {
+		_spec.AddField(followedartist.FieldFollowers, field.TypeInt, value)
+	}
+	if value, ok := fauo.mutation.Popularity(); ok {
+		_spec.SetField(followedartist.FieldPopularity, field.TypeInt, value)
+	}
+	if value, ok := fauo.mutation.AddedPopularity(); ok {
+		_spec.AddField(followedartist.FieldPopularity, field.TypeInt, value)
+	}
+	if fauo.mutation.RunCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   followedartist.RunTable,
+			Columns: []string{followedartist.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fauo.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   followedartist.RunTable,
+			Columns: []string{followedartist.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fauo.mutation.ArtistCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   followedartist.ArtistTable,
+			Columns: []string{followedartist.ArtistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artist.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fauo.mutation.ArtistIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   followedartist.ArtistTable,
+			Columns: []string{followedartist.ArtistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artist.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &FollowedArtist{config: fauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, fauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{followedartist.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	fauo.mutation.done = true
+	return _node, nil
+}
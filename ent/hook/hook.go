@@ -8,6 +8,282 @@ import (
 	"fmt"
 )
 
+// The AlbumFunc type is an adapter to allow the use of ordinary
+// function as Album mutator.
+type AlbumFunc func(context.Context, *ent.AlbumMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AlbumFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AlbumMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AlbumMutation", m)
+}
+
+// The ArtistFunc type is an adapter to allow the use of ordinary
+// function as Artist mutator.
+type ArtistFunc func(context.Context, *ent.ArtistMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ArtistFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ArtistMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ArtistMutation", m)
+}
+
+// The AuthStateFunc type is an adapter to allow the use of ordinary
+// function as AuthState mutator.
+type AuthStateFunc func(context.Context, *ent.AuthStateMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuthStateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AuthStateMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuthStateMutation", m)
+}
+
+// The BackupRunFunc type is an adapter to allow the use of ordinary
+// function as BackupRun mutator.
+type BackupRunFunc func(context.Context, *ent.BackupRunMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BackupRunFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BackupRunMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BackupRunMutation", m)
+}
+
+// The BackupScheduleFunc type is an adapter to allow the use of ordinary
+// function as BackupSchedule mutator.
+type BackupScheduleFunc func(context.Context, *ent.BackupScheduleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BackupScheduleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BackupScheduleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BackupScheduleMutation", m)
+}
+
+// The EpisodeFunc type is an adapter to allow the use of ordinary
+// function as Episode mutator.
+type EpisodeFunc func(context.Context, *ent.EpisodeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EpisodeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EpisodeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EpisodeMutation", m)
+}
+
+// The FollowedArtistFunc type is an adapter to allow the use of ordinary
+// function as FollowedArtist mutator.
+type FollowedArtistFunc func(context.Context, *ent.FollowedArtistMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FollowedArtistFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.FollowedArtistMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FollowedArtistMutation", m)
+}
+
+// The JobFunc type is an adapter to allow the use of ordinary
+// function as Job mutator.
+type JobFunc func(context.Context, *ent.JobMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f JobFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.JobMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.JobMutation", m)
+}
+
+// The LeaseFunc type is an adapter to allow the use of ordinary
+// function as Lease mutator.
+type LeaseFunc func(context.Context, *ent.LeaseMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LeaseFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LeaseMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LeaseMutation", m)
+}
+
+// The PlaylistFunc type is an adapter to allow the use of ordinary
+// function as Playlist mutator.
+type PlaylistFunc func(context.Context, *ent.PlaylistMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PlaylistFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PlaylistMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PlaylistMutation", m)
+}
+
+// The PlaylistSnapshotFunc type is an adapter to allow the use of ordinary
+// function as PlaylistSnapshot mutator.
+type PlaylistSnapshotFunc func(context.Context, *ent.PlaylistSnapshotMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PlaylistSnapshotFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PlaylistSnapshotMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PlaylistSnapshotMutation", m)
+}
+
+// The RestorePlanFunc type is an adapter to allow the use of ordinary
+// function as RestorePlan mutator.
+type RestorePlanFunc func(context.Context, *ent.RestorePlanMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RestorePlanFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RestorePlanMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RestorePlanMutation", m)
+}
+
+// The RetentionPolicyFunc type is an adapter to allow the use of ordinary
+// function as RetentionPolicy mutator.
+type RetentionPolicyFunc func(context.Context, *ent.RetentionPolicyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RetentionPolicyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RetentionPolicyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RetentionPolicyMutation", m)
+}
+
+// The SavedAlbumFunc type is an adapter to allow the use of ordinary
+// function as SavedAlbum mutator.
+type SavedAlbumFunc func(context.Context, *ent.SavedAlbumMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SavedAlbumFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SavedAlbumMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SavedAlbumMutation", m)
+}
+
+// The SavedAudiobookFunc type is an adapter to allow the use of ordinary
+// function as SavedAudiobook mutator.
+type SavedAudiobookFunc func(context.Context, *ent.SavedAudiobookMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SavedAudiobookFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SavedAudiobookMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SavedAudiobookMutation", m)
+}
+
+// The SavedEpisodeFunc type is an adapter to allow the use of ordinary
+// function as SavedEpisode mutator.
+type SavedEpisodeFunc func(context.Context, *ent.SavedEpisodeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SavedEpisodeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SavedEpisodeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SavedEpisodeMutation", m)
+}
+
+// The SavedShowFunc type is an adapter to allow the use of ordinary
+// function as SavedShow mutator.
+type SavedShowFunc func(context.Context, *ent.SavedShowMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SavedShowFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SavedShowMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SavedShowMutation", m)
+}
+
+// The SavedTrackFunc type is an adapter to allow the use of ordinary
+// function as SavedTrack mutator.
+type SavedTrackFunc func(context.Context, *ent.SavedTrackMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SavedTrackFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SavedTrackMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SavedTrackMutation", m)
+}
+
+// The SchemaMigrationFunc type is an adapter to allow the use of ordinary
+// function as SchemaMigration mutator.
+type SchemaMigrationFunc func(context.Context, *ent.SchemaMigrationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SchemaMigrationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SchemaMigrationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SchemaMigrationMutation", m)
+}
+
+// The ShowFunc type is an adapter to allow the use of ordinary
+// function as Show mutator.
+type ShowFunc func(context.Context, *ent.ShowMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ShowFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ShowMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ShowMutation", m)
+}
+
+// The SnapshotItemFunc type is an adapter to allow the use of ordinary
+// function as SnapshotItem mutator.
+type SnapshotItemFunc func(context.Context, *ent.SnapshotItemMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SnapshotItemFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SnapshotItemMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SnapshotItemMutation", m)
+}
+
+// The SpotifyTokenFunc type is an adapter to allow the use of ordinary
+// function as SpotifyToken mutator.
+type SpotifyTokenFunc func(context.Context, *ent.SpotifyTokenMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SpotifyTokenFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SpotifyTokenMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SpotifyTokenMutation", m)
+}
+
+// The TrackFunc type is an adapter to allow the use of ordinary
+// function as Track mutator.
+type TrackFunc func(context.Context, *ent.TrackMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TrackFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TrackMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TrackMutation", m)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary
 // function as User mutator.
 type UserFunc func(context.Context, *ent.UserMutation) (ent.Value, error)
@@ -0,0 +1,496 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/job"
+	"context"
+	"encoding/json/jsontext"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// JobCreate is the builder for creating a Job entity.
+type JobCreate struct {
+	config
+	mutation *JobMutation
+	hooks    []Hook
+}
+
+// SetType sets the "type" field.
+func (jc *JobCreate) SetType(s string) *JobCreate {
+	jc.mutation.SetType(s)
+	return jc
+}
+
+// SetKey sets the "key" field.
+func (jc *JobCreate) SetKey(s string) *JobCreate {
+	jc.mutation.SetKey(s)
+	return jc
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (jc *JobCreate) SetNillableKey(s *string) *JobCreate {
+	if s != nil {
+		jc.SetKey(*s)
+	}
+	return jc
+}
+
+// SetStatus sets the "status" field.
+func (jc *JobCreate) SetStatus(j job.Status) *JobCreate {
+	jc.mutation.SetStatus(j)
+	return jc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (jc *JobCreate) SetNillableStatus(j *job.Status) *JobCreate {
+	if j != nil {
+		jc.SetStatus(*j)
+	}
+	return jc
+}
+
+// SetPayload sets the "payload" field.
+func (jc *JobCreate) SetPayload(j jsontext.Value) *JobCreate {
+	jc.mutation.SetPayload(j)
+	return jc
+}
+
+// SetResult sets the "result" field.
+func (jc *JobCreate) SetResult(j jsontext.Value) *JobCreate {
+	jc.mutation.SetResult(j)
+	return jc
+}
+
+// SetError sets the "error" field.
+func (jc *JobCreate) SetError(s string) *JobCreate {
+	jc.mutation.SetError(s)
+	return jc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (jc *JobCreate) SetNillableError(s *string) *JobCreate {
+	if s != nil {
+		jc.SetError(*s)
+	}
+	return jc
+}
+
+// SetAttempts sets the "attempts" field.
+func (jc *JobCreate) SetAttempts(i int) *JobCreate {
+	jc.mutation.SetAttempts(i)
+	return jc
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (jc *JobCreate) SetNillableAttempts(i *int) *JobCreate {
+	if i != nil {
+		jc.SetAttempts(*i)
+	}
+	return jc
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (jc *JobCreate) SetMaxAttempts(i int) *JobCreate {
+	jc.mutation.SetMaxAttempts(i)
+	return jc
+}
+
+// SetRunAfter sets the "run_after" field.
+func (jc *JobCreate) SetRunAfter(t time.Time) *JobCreate {
+	jc.mutation.SetRunAfter(t)
+	return jc
+}
+
+// SetNillableRunAfter sets the "run_after" field if the given value is not nil.
+func (jc *JobCreate) SetNillableRunAfter(t *time.Time) *JobCreate {
+	if t != nil {
+		jc.SetRunAfter(*t)
+	}
+	return jc
+}
+
+// SetLockedBy sets the "locked_by" field.
+func (jc *JobCreate) SetLockedBy(s string) *JobCreate {
+	jc.mutation.SetLockedBy(s)
+	return jc
+}
+
+// SetNillableLockedBy sets the "locked_by" field if the given value is not nil.
+func (jc *JobCreate) SetNillableLockedBy(s *string) *JobCreate {
+	if s != nil {
+		jc.SetLockedBy(*s)
+	}
+	return jc
+}
+
+// SetLockedAt sets the "locked_at" field.
+func (jc *JobCreate) SetLockedAt(t time.Time) *JobCreate {
+	jc.mutation.SetLockedAt(t)
+	return jc
+}
+
+// SetNillableLockedAt sets the "locked_at" field if the given value is not nil.
+func (jc *JobCreate) SetNillableLockedAt(t *time.Time) *JobCreate {
+	if t != nil {
+		jc.SetLockedAt(*t)
+	}
+	return jc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (jc *JobCreate) SetCreatedAt(t time.Time) *JobCreate {
+	jc.mutation.SetCreatedAt(t)
+	return jc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (jc *JobCreate) SetNillableCreatedAt(t *time.Time) *JobCreate {
+	if t != nil {
+		jc.SetCreatedAt(*t)
+	}
+	return jc
+}
+
+// SetStartedAt sets the "started_at" field.
+func (jc *JobCreate) SetStartedAt(t time.Time) *JobCreate {
+	jc.mutation.SetStartedAt(t)
+	return jc
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (jc *JobCreate) SetNillableStartedAt(t *time.Time) *JobCreate {
+	if t != nil {
+		jc.SetStartedAt(*t)
+	}
+	return jc
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (jc *JobCreate) SetFinishedAt(t time.Time) *JobCreate {
+	jc.mutation.SetFinishedAt(t)
+	return jc
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (jc *JobCreate) SetNillableFinishedAt(t *time.Time) *JobCreate {
+	if t != nil {
+		jc.SetFinishedAt(*t)
+	}
+	return jc
+}
+
+// SetID sets the "id" field.
+func (jc *JobCreate) SetID(s string) *JobCreate {
+	jc.mutation.SetID(s)
+	return jc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (jc *JobCreate) SetNillableID(s *string) *JobCreate {
+	if s != nil {
+		jc.SetID(*s)
+	}
+	return jc
+}
+
+// Mutation returns the JobMutation object of the builder.
+func (jc *JobCreate) Mutation() *JobMutation {
+	return jc.mutation
+}
+
+// Save creates the Job in the database.
+func (jc *JobCreate) Save(ctx context.Context) (*Job, error) {
+	jc.defaults()
+	return withHooks(ctx, jc.sqlSave, jc.mutation, jc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (jc *JobCreate) SaveX(ctx context.Context) *Job {
+	v, err := jc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (jc *JobCreate) Exec(ctx context.Context) error {
+	_, err := jc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jc *JobCreate) ExecX(ctx context.Context) {
+	if err := jc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (jc *JobCreate) defaults() {
+	if _, ok := jc.mutation.Key(); !ok {
+		v := job.DefaultKey
+		jc.mutation.SetKey(v)
+	}
+	if _, ok := jc.mutation.Status(); !ok {
+		v := job.DefaultStatus
+		jc.mutation.SetStatus(v)
+	}
+	if _, ok := jc.mutation.Error(); !ok {
+		v := job.DefaultError
+		jc.mutation.SetError(v)
+	}
+	if _, ok := jc.mutation.Attempts(); !ok {
+		v := job.DefaultAttempts
+		jc.mutation.SetAttempts(v)
+	}
+	if _, ok := jc.mutation.RunAfter(); !ok {
+		v := job.DefaultRunAfter()
+		jc.mutation.SetRunAfter(v)
+	}
+	if _, ok := jc.mutation.LockedBy(); !ok {
+		v := job.DefaultLockedBy
+		jc.mutation.SetLockedBy(v)
+	}
+	if _, ok := jc.mutation.CreatedAt(); !ok {
+		v := job.DefaultCreatedAt()
+		jc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := jc.mutation.ID(); !ok {
+		v := job.DefaultID()
+		jc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (jc *JobCreate) check() error {
+	if _, ok := jc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Job.type"`)}
+	}
+	if v, ok := jc.mutation.GetType(); ok {
+		if err := job.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Job.type": %w`, err)}
+		}
+	}
+	if _, ok := jc.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "Job.key"`)}
+	}
+	if _, ok := jc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Job.status"`)}
+	}
+	if v, ok := jc.mutation.Status(); ok {
+		if err := job.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Job.status": %w`, err)}
+		}
+	}
+	if _, ok := jc.mutation.Error(); !ok {
+		return &ValidationError{Name: "error", err: errors.New(`ent: missing required field "Job.error"`)}
+	}
+	if _, ok := jc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "Job.attempts"`)}
+	}
+	if v, ok := jc.mutation.Attempts(); ok {
+		if err := job.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`ent: validator failed for field "Job.attempts": %w`, err)}
+		}
+	}
+	if _, ok := jc.mutation.MaxAttempts(); !ok {
+		return &ValidationError{Name: "max_attempts", err: errors.New(`ent: missing required field "Job.max_attempts"`)}
+	}
+	if v, ok := jc.mutation.MaxAttempts(); ok {
+		if err := job.MaxAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "max_attempts", err: fmt.Errorf(`ent: validator failed for field "Job.max_attempts": %w`, err)}
+		}
+	}
+	if _, ok := jc.mutation.RunAfter(); !ok {
+		return &ValidationError{Name: "run_after", err: errors.New(`ent: missing required field "Job.run_after"`)}
+	}
+	if _, ok := jc.mutation.LockedBy(); !ok {
+		return &ValidationError{Name: "locked_by", err: errors.New(`ent: missing required field "Job.locked_by"`)}
+	}
+	if _, ok := jc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Job.created_at"`)}
+	}
+	return nil
+}
+
+func (jc *JobCreate) sqlSave(ctx context.Context) (*Job, error) {
+	if err := jc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := jc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, jc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Job.ID type: %T", _spec.ID.Value)
+		}
+	}
+	jc.mutation.id = &_node.ID
+	jc.mutation.done = true
+	return _node, nil
+}
+
+func (jc *JobCreate) createSpec() (*Job, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Job{config: jc.config}
+		_spec = sqlgraph.NewCreateSpec(job.Table, sqlgraph.NewFieldSpec(job.FieldID, field.TypeString))
+	)
+	if id, ok := jc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := jc.mutation.GetType(); ok {
+		_spec.SetField(job.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := jc.mutation.Key(); ok {
+		_spec.SetField(job.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := jc.mutation.Status(); ok {
+		_spec.SetField(job.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := jc.mutation.Payload(); ok {
+		_spec.SetField(job.FieldPayload, field.TypeJSON, value)
+		_node.Payload = value
+	}
+	if value, ok := jc.mutation.Result(); ok {
+		_spec.SetField(job.FieldResult, field.TypeJSON, value)
+		_node.Result = value
+	}
+	if value, ok := jc.mutation.Error(); ok {
+		_spec.SetField(job.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := jc.mutation.Attempts(); ok {
+		_spec.SetField(job.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := jc.mutation.MaxAttempts(); ok {
+		_spec.SetField(job.FieldMaxAttempts, field.TypeInt, value)
+		_node.MaxAttempts = value
+	}
+	if value, ok := jc.mutation.RunAfter(); ok {
+		_spec.SetField(job.FieldRunAfter, field.TypeTime, value)
+		_node.RunAfter = value
+	}
+	if value, ok := jc.mutation.LockedBy(); ok {
+		_spec.SetField(job.FieldLockedBy, field.TypeString, value)
+		_node.LockedBy = value
+	}
+	if value, ok := jc.mutation.LockedAt(); ok {
+		_spec.SetField(job.FieldLockedAt, field.TypeTime, value)
+		_node.LockedAt = &value
+	}
+	if value, ok := jc.mutation.CreatedAt(); ok {
+		_spec.SetField(job.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := jc.mutation.StartedAt(); ok {
+		_spec.SetField(job.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = &value
+	}
+	if value, ok := jc.mutation.FinishedAt(); ok {
+		_spec.SetField(job.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = &value
+	}
+	return _node, _spec
+}
+
+// JobCreateBulk is the builder for creating many Job entities in bulk.
+type JobCreateBulk struct {
+	config
+	err      error
+	builders []*JobCreate
+}
+
+// Save creates the Job entities in the database.
+func (jcb *JobCreateBulk) Save(ctx context.Context) ([]*Job, error) {
+	if jcb.err != nil {
+		return nil, jcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(jcb.builders))
+	nodes := make([]*Job, len(jcb.builders))
+	mutators := make([]Mutator, len(jcb.builders))
+	for i := range jcb.builders {
+		func(i int, root context.Context) {
+			builder := jcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*JobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, jcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, jcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, jcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jcb *JobCreateBulk) SaveX(ctx context.Context) []*Job {
+	v, err := jcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (jcb *JobCreateBulk) Exec(ctx context.Context) error {
+	_, err := jcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jcb *JobCreateBulk) ExecX(ctx context.Context) {
+	if err := jcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,740 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/job"
+	"beyerleinf/spotify-backup/ent/predicate"
+	"context"
+	"encoding/json/jsontext"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// JobUpdate is the builder for updating Job entities.
+type JobUpdate struct {
+	config
+	hooks    []Hook
+	mutation *JobMutation
+}
+
+// Where appends a list predicates to the JobUpdate builder.
+func (ju *JobUpdate) Where(ps ...predicate.Job) *JobUpdate {
+	ju.mutation.Where(ps...)
+	return ju
+}
+
+// SetStatus sets the "status" field.
+func (ju *JobUpdate) SetStatus(j job.Status) *JobUpdate {
+	ju.mutation.SetStatus(j)
+	return ju
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ju *JobUpdate) SetNillableStatus(j *job.Status) *JobUpdate {
+	if j != nil {
+		ju.SetStatus(*j)
+	}
+	return ju
+}
+
+// SetPayload sets the "payload" field.
+func (ju *JobUpdate) SetPayload(j jsontext.Value) *JobUpdate {
+	ju.mutation.SetPayload(j)
+	return ju
+}
+
+// AppendPayload appends j to the "payload" field.
+func (ju *JobUpdate) AppendPayload(j jsontext.Value) *JobUpdate {
+	ju.mutation.AppendPayload(j)
+	return ju
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (ju *JobUpdate) ClearPayload() *JobUpdate {
+	ju.mutation.ClearPayload()
+	return ju
+}
+
+// SetResult sets the "result" field.
+func (ju *JobUpdate) SetResult(j jsontext.Value) *JobUpdate {
+	ju.mutation.SetResult(j)
+	return ju
+}
+
+// AppendResult appends j to the "result" field.
+func (ju *JobUpdate) AppendResult(j jsontext.Value) *JobUpdate {
+	ju.mutation.AppendResult(j)
+	return ju
+}
+
+// ClearResult clears the value of the "result" field.
+func (ju *JobUpdate) ClearResult() *JobUpdate {
+	ju.mutation.ClearResult()
+	return ju
+}
+
+// SetError sets the "error" field.
+func (ju *JobUpdate) SetError(s string) *JobUpdate {
+	ju.mutation.SetError(s)
+	return ju
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (ju *JobUpdate) SetNillableError(s *string) *JobUpdate {
+	if s != nil {
+		ju.SetError(*s)
+	}
+	return ju
+}
+
+// SetAttempts sets the "attempts" field.
+func (ju *JobUpdate) SetAttempts(i int) *JobUpdate {
+	ju.mutation.ResetAttempts()
+	ju.mutation.SetAttempts(i)
+	return ju
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (ju *JobUpdate) SetNillableAttempts(i *int) *JobUpdate {
+	if i != nil {
+		ju.SetAttempts(*i)
+	}
+	return ju
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (ju *JobUpdate) AddAttempts(i int) *JobUpdate {
+	ju.mutation.AddAttempts(i)
+	return ju
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (ju *JobUpdate) SetMaxAttempts(i int) *JobUpdate {
+	ju.mutation.ResetMaxAttempts()
+	ju.mutation.SetMaxAttempts(i)
+	return ju
+}
+
+// SetNillableMaxAttempts sets the "max_attempts" field if the given value is not nil.
+func (ju *JobUpdate) SetNillableMaxAttempts(i *int) *JobUpdate {
+	if i != nil {
+		ju.SetMaxAttempts(*i)
+	}
+	return ju
+}
+
+// AddMaxAttempts adds i to the "max_attempts" field.
+func (ju *JobUpdate) AddMaxAttempts(i int) *JobUpdate {
+	ju.mutation.AddMaxAttempts(i)
+	return ju
+}
+
+// SetRunAfter sets the "run_after" field.
+func (ju *JobUpdate) SetRunAfter(t time.Time) *JobUpdate {
+	ju.mutation.SetRunAfter(t)
+	return ju
+}
+
+// SetNillableRunAfter sets the "run_after" field if the given value is not nil.
+func (ju *JobUpdate) SetNillableRunAfter(t *time.Time) *JobUpdate {
+	if t != nil {
+		ju.SetRunAfter(*t)
+	}
+	return ju
+}
+
+// SetLockedBy sets the "locked_by" field.
+func (ju *JobUpdate) SetLockedBy(s string) *JobUpdate {
+	ju.mutation.SetLockedBy(s)
+	return ju
+}
+
+// SetNillableLockedBy sets the "locked_by" field if the given value is not nil.
+func (ju *JobUpdate) SetNillableLockedBy(s *string) *JobUpdate {
+	if s != nil {
+		ju.SetLockedBy(*s)
+	}
+	return ju
+}
+
+// SetLockedAt sets the "locked_at" field.
+func (ju *JobUpdate) SetLockedAt(t time.Time) *JobUpdate {
+	ju.mutation.SetLockedAt(t)
+	return ju
+}
+
+// SetNillableLockedAt sets the "locked_at" field if the given value is not nil.
+func (ju *JobUpdate) SetNillableLockedAt(t *time.Time) *JobUpdate {
+	if t != nil {
+		ju.SetLockedAt(*t)
+	}
+	return ju
+}
+
+// ClearLockedAt clears the value of the "locked_at" field.
+func (ju *JobUpdate) ClearLockedAt() *JobUpdate {
+	ju.mutation.ClearLockedAt()
+	return ju
+}
+
+// SetStartedAt sets the "started_at" field.
+func (ju *JobUpdate) SetStartedAt(t time.Time) *JobUpdate {
+	ju.mutation.SetStartedAt(t)
+	return ju
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (ju *JobUpdate) SetNillableStartedAt(t *time.Time) *JobUpdate {
+	if t != nil {
+		ju.SetStartedAt(*t)
+	}
+	return ju
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (ju *JobUpdate) ClearStartedAt() *JobUpdate {
+	ju.mutation.ClearStartedAt()
+	return ju
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (ju *JobUpdate) SetFinishedAt(t time.Time) *JobUpdate {
+	ju.mutation.SetFinishedAt(t)
+	return ju
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (ju *JobUpdate) SetNillableFinishedAt(t *time.Time) *JobUpdate {
+	if t != nil {
+		ju.SetFinishedAt(*t)
+	}
+	return ju
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (ju *JobUpdate) ClearFinishedAt() *JobUpdate {
+	ju.mutation.ClearFinishedAt()
+	return ju
+}
+
+// Mutation returns the JobMutation object of the builder.
+func (ju *JobUpdate) Mutation() *JobMutation {
+	return ju.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ju *JobUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ju.sqlSave, ju.mutation, ju.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ju *JobUpdate) SaveX(ctx context.Context) int {
+	affected, err := ju.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ju *JobUpdate) Exec(ctx context.Context) error {
+	_, err := ju.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ju *JobUpdate) ExecX(ctx context.Context) {
+	if err := ju.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ju *JobUpdate) check() error {
+	if v, ok := ju.mutation.Status(); ok {
+		if err := job.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Job.status": %w`, err)}
+		}
+	}
+	if v, ok := ju.mutation.Attempts(); ok {
+		if err := job.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`ent: validator failed for field "Job.attempts": %w`, err)}
+		}
+	}
+	if v, ok := ju.mutation.MaxAttempts(); ok {
+		if err := job.MaxAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "max_attempts", err: fmt.Errorf(`ent: validator failed for field "Job.max_attempts": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ju *JobUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ju.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(job.Table, job.Columns, sqlgraph.NewFieldSpec(job.FieldID, field.TypeString))
+	if ps := ju.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ju.mutation.Status(); ok {
+		_spec.SetField(job.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ju.mutation.Payload(); ok {
+		_spec.SetField(job.FieldPayload, field.TypeJSON, value)
+	}
+	if value, ok := ju.mutation.AppendedPayload(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, job.FieldPayload, value)
+		})
+	}
+	if ju.mutation.PayloadCleared() {
+		_spec.ClearField(job.FieldPayload, field.TypeJSON)
+	}
+	if value, ok := ju.mutation.Result(); ok {
+		_spec.SetField(job.FieldResult, field.TypeJSON, value)
+	}
+	if value, ok := ju.mutation.AppendedResult(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, job.FieldResult, value)
+		})
+	}
+	if ju.mutation.ResultCleared() {
+		_spec.ClearField(job.FieldResult, field.TypeJSON)
+	}
+	if value, ok := ju.mutation.Error(); ok {
+		_spec.SetField(job.FieldError, field.TypeString, value)
+	}
+	if value, ok := ju.mutation.Attempts(); ok {
+		_spec.SetField(job.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := ju.mutation.AddedAttempts(); ok {
+		_spec.AddField(job.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := ju.mutation.MaxAttempts(); ok {
+		_spec.SetField(job.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := ju.mutation.AddedMaxAttempts(); ok {
+		_spec.AddField(job.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := ju.mutation.RunAfter(); ok {
+		_spec.SetField(job.FieldRunAfter, field.TypeTime, value)
+	}
+	if value, ok := ju.mutation.LockedBy(); ok {
+		_spec.SetField(job.FieldLockedBy, field.TypeString, value)
+	}
+	if value, ok := ju.mutation.LockedAt(); ok {
+		_spec.SetField(job.FieldLockedAt, field.TypeTime, value)
+	}
+	if ju.mutation.LockedAtCleared() {
+		_spec.ClearField(job.FieldLockedAt, field.TypeTime)
+	}
+	if value, ok := ju.mutation.StartedAt(); ok {
+		_spec.SetField(job.FieldStartedAt, field.TypeTime, value)
+	}
+	if ju.mutation.StartedAtCleared() {
+		_spec.ClearField(job.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := ju.mutation.FinishedAt(); ok {
+		_spec.SetField(job.FieldFinishedAt, field.TypeTime, value)
+	}
+	if ju.mutation.FinishedAtCleared() {
+		_spec.ClearField(job.FieldFinishedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ju.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{job.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ju.mutation.done = true
+	return n, nil
+}
+
+// JobUpdateOne is the builder for updating a single Job entity.
+type JobUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *JobMutation
+}
+
+// SetStatus sets the "status" field.
+func (juo *JobUpdateOne) SetStatus(j job.Status) *JobUpdateOne {
+	juo.mutation.SetStatus(j)
+	return juo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableStatus(j *job.Status) *JobUpdateOne {
+	if j != nil {
+		juo.SetStatus(*j)
+	}
+	return juo
+}
+
+// SetPayload sets the "payload" field.
+func (juo *JobUpdateOne) SetPayload(j jsontext.Value) *JobUpdateOne {
+	juo.mutation.SetPayload(j)
+	return juo
+}
+
+// AppendPayload appends j to the "payload" field.
+func (juo *JobUpdateOne) AppendPayload(j jsontext.Value) *JobUpdateOne {
+	juo.mutation.AppendPayload(j)
+	return juo
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (juo *JobUpdateOne) ClearPayload() *JobUpdateOne {
+	juo.mutation.ClearPayload()
+	return juo
+}
+
+// SetResult sets the "result" field.
+func (juo *JobUpdateOne) SetResult(j jsontext.Value) *JobUpdateOne {
+	juo.mutation.SetResult(j)
+	return juo
+}
+
+// AppendResult appends j to the "result" field.
+func (juo *JobUpdateOne) AppendResult(j jsontext.Value) *JobUpdateOne {
+	juo.mutation.AppendResult(j)
+	return juo
+}
+
+// ClearResult clears the value of the "result" field.
+func (juo *JobUpdateOne) ClearResult() *JobUpdateOne {
+	juo.mutation.ClearResult()
+	return juo
+}
+
+// SetError sets the "error" field.
+func (juo *JobUpdateOne) SetError(s string) *JobUpdateOne {
+	juo.mutation.SetError(s)
+	return juo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableError(s *string) *JobUpdateOne {
+	if s != nil {
+		juo.SetError(*s)
+	}
+	return juo
+}
+
+// SetAttempts sets the "attempts" field.
+func (juo *JobUpdateOne) SetAttempts(i int) *JobUpdateOne {
+	juo.mutation.ResetAttempts()
+	juo.mutation.SetAttempts(i)
+	return juo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableAttempts(i *int) *JobUpdateOne {
+	if i != nil {
+		juo.SetAttempts(*i)
+	}
+	return juo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (juo *JobUpdateOne) AddAttempts(i int) *JobUpdateOne {
+	juo.mutation.AddAttempts(i)
+	return juo
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (juo *JobUpdateOne) SetMaxAttempts(i int) *JobUpdateOne {
+	juo.mutation.ResetMaxAttempts()
+	juo.mutation.SetMaxAttempts(i)
+	return juo
+}
+
+// SetNillableMaxAttempts sets the "max_attempts" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableMaxAttempts(i *int) *JobUpdateOne {
+	if i != nil {
+		juo.SetMaxAttempts(*i)
+	}
+	return juo
+}
+
+// AddMaxAttempts adds i to the "max_attempts" field.
+func (juo *JobUpdateOne) AddMaxAttempts(i int) *JobUpdateOne {
+	juo.mutation.AddMaxAttempts(i)
+	return juo
+}
+
+// SetRunAfter sets the "run_after" field.
+func (juo *JobUpdateOne) SetRunAfter(t time.Time) *JobUpdateOne {
+	juo.mutation.SetRunAfter(t)
+	return juo
+}
+
+// SetNillableRunAfter sets the "run_after" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableRunAfter(t *time.Time) *JobUpdateOne {
+	if t != nil {
+		juo.SetRunAfter(*t)
+	}
+	return juo
+}
+
+// SetLockedBy sets the "locked_by" field.
+func (juo *JobUpdateOne) SetLockedBy(s string) *JobUpdateOne {
+	juo.mutation.SetLockedBy(s)
+	return juo
+}
+
+// SetNillableLockedBy sets the "locked_by" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableLockedBy(s *string) *JobUpdateOne {
+	if s != nil {
+		juo.SetLockedBy(*s)
+	}
+	return juo
+}
+
+// SetLockedAt sets the "locked_at" field.
+func (juo *JobUpdateOne) SetLockedAt(t time.Time) *JobUpdateOne {
+	juo.mutation.SetLockedAt(t)
+	return juo
+}
+
+// SetNillableLockedAt sets the "locked_at" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableLockedAt(t *time.Time) *JobUpdateOne {
+	if t != nil {
+		juo.SetLockedAt(*t)
+	}
+	return juo
+}
+
+// ClearLockedAt clears the value of the "locked_at" field.
+func (juo *JobUpdateOne) ClearLockedAt() *JobUpdateOne {
+	juo.mutation.ClearLockedAt()
+	return juo
+}
+
+// SetStartedAt sets the "started_at" field.
+func (juo *JobUpdateOne) SetStartedAt(t time.Time) *JobUpdateOne {
+	juo.mutation.SetStartedAt(t)
+	return juo
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableStartedAt(t *time.Time) *JobUpdateOne {
+	if t != nil {
+		juo.SetStartedAt(*t)
+	}
+	return juo
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (juo *JobUpdateOne) ClearStartedAt() *JobUpdateOne {
+	juo.mutation.ClearStartedAt()
+	return juo
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (juo *JobUpdateOne) SetFinishedAt(t time.Time) *JobUpdateOne {
+	juo.mutation.SetFinishedAt(t)
+	return juo
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableFinishedAt(t *time.Time) *JobUpdateOne {
+	if t != nil {
+		juo.SetFinishedAt(*t)
+	}
+	return juo
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (juo *JobUpdateOne) ClearFinishedAt() *JobUpdateOne {
+	juo.mutation.ClearFinishedAt()
+	return juo
+}
+
+// Mutation returns the JobMutation object of the builder.
+func (juo *JobUpdateOne) Mutation() *JobMutation {
+	return juo.mutation
+}
+
+// Where appends a list predicates to the JobUpdate builder.
+func (juo *JobUpdateOne) Where(ps ...predicate.Job) *JobUpdateOne {
+	juo.mutation.Where(ps...)
+	return juo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (juo *JobUpdateOne) Select(field string, fields ...string) *JobUpdateOne {
+	juo.fields = append([]string{field}, fields...)
+	return juo
+}
+
+// Save executes the query and returns the updated Job entity.
+func (juo *JobUpdateOne) Save(ctx context.Context) (*Job, error) {
+	return withHooks(ctx, juo.sqlSave, juo.mutation, juo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (juo *JobUpdateOne) SaveX(ctx context.Context) *Job {
+	node, err := juo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (juo *JobUpdateOne) Exec(ctx context.Context) error {
+	_, err := juo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (juo *JobUpdateOne) ExecX(ctx context.Context) {
+	if err := juo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (juo *JobUpdateOne) check() error {
+	if v, ok := juo.mutation.Status(); ok {
+		if err := job.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Job.status": %w`, err)}
+		}
+	}
+	if v, ok := juo.mutation.Attempts(); ok {
+		if err := job.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`ent: validator failed for field "Job.attempts": %w`, err)}
+		}
+	}
+	if v, ok := juo.mutation.MaxAttempts(); ok {
+		if err := job.MaxAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "max_attempts", err: fmt.Errorf(`ent: validator failed for field "Job.max_attempts": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (juo *JobUpdateOne) sqlSave(ctx context.Context) (_node *Job, err error) {
+	if err := juo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(job.Table, job.Columns, sqlgraph.NewFieldSpec(job.FieldID, field.TypeString))
+	id, ok := juo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Job.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := juo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, job.FieldID)
+		for _, f := range fields {
+			if !job.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != job.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := juo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := juo.mutation.Status(); ok {
+		_spec.SetField(job.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := juo.mutation.Payload(); ok {
+		_spec.SetField(job.FieldPayload, field.TypeJSON, value)
+	}
+	if value, ok := juo.mutation.AppendedPayload(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, job.FieldPayload, value)
+		})
+	}
+	if juo.mutation.PayloadCleared() {
+		_spec.ClearField(job.FieldPayload, field.TypeJSON)
+	}
+	if value, ok := juo.mutation.Result(); ok {
+		_spec.SetField(job.FieldResult, field.TypeJSON, value)
+	}
+	if value, ok := juo.mutation.AppendedResult(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, job.FieldResult, value)
+		})
+	}
+	if juo.mutation.ResultCleared() {
+		_spec.ClearField(job.FieldResult, field.TypeJSON)
+	}
+	if value, ok := juo.mutation.Error(); ok {
+		_spec.SetField(job.FieldError, field.TypeString, value)
+	}
+	if value, ok := juo.mutation.Attempts(); ok {
+		_spec.SetField(job.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := juo.mutation.AddedAttempts(); ok {
+		_spec.AddField(job.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := juo.mutation.MaxAttempts(); ok {
+		_spec.SetField(job.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := juo.mutation.AddedMaxAttempts(); ok {
+		_spec.AddField(job.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := juo.mutation.RunAfter(); ok {
+		_spec.SetField(job.FieldRunAfter, field.TypeTime, value)
+	}
+	if value, ok := juo.mutation.LockedBy(); ok {
+		_spec.SetField(job.FieldLockedBy, field.TypeString, value)
+	}
+	if value, ok := juo.mutation.LockedAt(); ok {
+		_spec.SetField(job.FieldLockedAt, field.TypeTime, value)
+	}
+	if juo.mutation.LockedAtCleared() {
+		_spec.ClearField(job.FieldLockedAt, field.TypeTime)
+	}
+	if value, ok := juo.mutation.StartedAt(); ok {
+		_spec.SetField(job.FieldStartedAt, field.TypeTime, value)
+	}
+	if juo.mutation.StartedAtCleared() {
+		_spec.ClearField(job.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := juo.mutation.FinishedAt(); ok {
+		_spec.SetField(job.FieldFinishedAt, field.TypeTime, value)
+	}
+	if juo.mutation.FinishedAtCleared() {
+		_spec.ClearField(job.FieldFinishedAt, field.TypeTime)
+	}
+	_node = &Job{config: juo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, juo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{job.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	juo.mutation.done = true
+	return _node, nil
+}
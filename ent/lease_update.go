@@ -0,0 +1,304 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/lease"
+	"beyerleinf/spotify-backup/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LeaseUpdate is the builder for updating Lease entities.
+type LeaseUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LeaseMutation
+}
+
+// Where appends a list predicates to the LeaseUpdate builder.
+func (lu *LeaseUpdate) Where(ps ...predicate.Lease) *LeaseUpdate {
+	lu.mutation.Where(ps...)
+	return lu
+}
+
+// SetHolder sets the "holder" field.
+func (lu *LeaseUpdate) SetHolder(s string) *LeaseUpdate {
+	lu.mutation.SetHolder(s)
+	return lu
+}
+
+// SetNillableHolder sets the "holder" field if the given value is not nil.
+func (lu *LeaseUpdate) SetNillableHolder(s *string) *LeaseUpdate {
+	if s != nil {
+		lu.SetHolder(*s)
+	}
+	return lu
+}
+
+// SetAcquiredAt sets the "acquired_at" field.
+func (lu *LeaseUpdate) SetAcquiredAt(t time.Time) *LeaseUpdate {
+	lu.mutation.SetAcquiredAt(t)
+	return lu
+}
+
+// SetNillableAcquiredAt sets the "acquired_at" field if the given value is not nil.
+func (lu *LeaseUpdate) SetNillableAcquiredAt(t *time.Time) *LeaseUpdate {
+	if t != nil {
+		lu.SetAcquiredAt(*t)
+	}
+	return lu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (lu *LeaseUpdate) SetExpiresAt(t time.Time) *LeaseUpdate {
+	lu.mutation.SetExpiresAt(t)
+	return lu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (lu *LeaseUpdate) SetNillableExpiresAt(t *time.Time) *LeaseUpdate {
+	if t != nil {
+		lu.SetExpiresAt(*t)
+	}
+	return lu
+}
+
+// Mutation returns the LeaseMutation object of the builder.
+func (lu *LeaseUpdate) Mutation() *LeaseMutation {
+	return lu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (lu *LeaseUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, lu.sqlSave, lu.mutation, lu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lu *LeaseUpdate) SaveX(ctx context.Context) int {
+	affected, err := lu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (lu *LeaseUpdate) Exec(ctx context.Context) error {
+	_, err := lu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lu *LeaseUpdate) ExecX(ctx context.Context) {
+	if err := lu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lu *LeaseUpdate) check() error {
+	if v, ok := lu.mutation.Holder(); ok {
+		if err := lease.HolderValidator(v); err != nil {
+			return &ValidationError{Name: "holder", err: fmt.Errorf(`ent: validator failed for field "Lease.holder": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (lu *LeaseUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := lu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(lease.Table, lease.Columns, sqlgraph.NewFieldSpec(lease.FieldID, field.TypeString))
+	if ps := lu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lu.mutation.Holder(); ok {
+		_spec.SetField(lease.FieldHolder, field.TypeString, value)
+	}
+	if value, ok := lu.mutation.AcquiredAt(); ok {
+		_spec.SetField(lease.FieldAcquiredAt, field.TypeTime, value)
+	}
+	if value, ok := lu.mutation.ExpiresAt(); ok {
+		_spec.SetField(lease.FieldExpiresAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, lu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{lease.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	lu.mutation.done = true
+	return n, nil
+}
+
+// LeaseUpdateOne is the builder for updating a single Lease entity.
+type LeaseUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LeaseMutation
+}
+
+// SetHolder sets the "holder" field.
+func (luo *LeaseUpdateOne) SetHolder(s string) *LeaseUpdateOne {
+	luo.mutation.SetHolder(s)
+	return luo
+}
+
+// SetNillableHolder sets the "holder" field if the given value is not nil.
+func (luo *LeaseUpdateOne) SetNillableHolder(s *string) *LeaseUpdateOne {
+	if s != nil {
+		luo.SetHolder(*s)
+	}
+	return luo
+}
+
+// SetAcquiredAt sets the "acquired_at" field.
+func (luo *LeaseUpdateOne) SetAcquiredAt(t time.Time) *LeaseUpdateOne {
+	luo.mutation.SetAcquiredAt(t)
+	return luo
+}
+
+// SetNillableAcquiredAt sets the "acquired_at" field if the given value is not nil.
+func (luo *LeaseUpdateOne) SetNillableAcquiredAt(t *time.Time) *LeaseUpdateOne {
+	if t != nil {
+		luo.SetAcquiredAt(*t)
+	}
+	return luo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (luo *LeaseUpdateOne) SetExpiresAt(t time.Time) *LeaseUpdateOne {
+	luo.mutation.SetExpiresAt(t)
+	return luo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (luo *LeaseUpdateOne) SetNillableExpiresAt(t *time.Time) *LeaseUpdateOne {
+	if t != nil {
+		luo.SetExpiresAt(*t)
+	}
+	return luo
+}
+
+// Mutation returns the LeaseMutation object of the builder.
+func (luo *LeaseUpdateOne) Mutation() *LeaseMutation {
+	return luo.mutation
+}
+
+// Where appends a list predicates to the LeaseUpdate builder.
+func (luo *LeaseUpdateOne) Where(ps ...predicate.Lease) *LeaseUpdateOne {
+	luo.mutation.Where(ps...)
+	return luo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (luo *LeaseUpdateOne) Select(field string, fields ...string) *LeaseUpdateOne {
+	luo.fields = append([]string{field}, fields...)
+	return luo
+}
+
+// Save executes the query and returns the updated Lease entity.
+func (luo *LeaseUpdateOne) Save(ctx context.Context) (*Lease, error) {
+	return withHooks(ctx, luo.sqlSave, luo.mutation, luo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (luo *LeaseUpdateOne) SaveX(ctx context.Context) *Lease {
+	node, err := luo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (luo *LeaseUpdateOne) Exec(ctx context.Context) error {
+	_, err := luo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (luo *LeaseUpdateOne) ExecX(ctx context.Context) {
+	if err := luo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (luo *LeaseUpdateOne) check() error {
+	if v, ok := luo.mutation.Holder(); ok {
+		if err := lease.HolderValidator(v); err != nil {
+			return &ValidationError{Name: "holder", err: fmt.Errorf(`ent: validator failed for field "Lease.holder": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (luo *LeaseUpdateOne) sqlSave(ctx context.Context) (_node *Lease, err error) {
+	if err := luo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(lease.Table, lease.Columns, sqlgraph.NewFieldSpec(lease.FieldID, field.TypeString))
+	id, ok := luo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Lease.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := luo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, lease.FieldID)
+		for _, f := range fields {
+			if !lease.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != lease.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := luo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := luo.mutation.Holder(); ok {
+		_spec.SetField(lease.FieldHolder, field.TypeString, value)
+	}
+	if value, ok := luo.mutation.AcquiredAt(); ok {
+		_spec.SetField(lease.FieldAcquiredAt, field.TypeTime, value)
+	}
+	if value, ok := luo.mutation.ExpiresAt(); ok {
+		_spec.SetField(lease.FieldExpiresAt, field.TypeTime, value)
+	}
+	_node = &Lease{config: luo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, luo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{lease.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	luo.mutation.done = true
+	return _node, nil
+}
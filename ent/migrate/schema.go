@@ -3,11 +3,578 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
 
 var (
+	// AlbumsColumns holds the columns for the "albums" table.
+	AlbumsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "spotify_id", Type: field.TypeString, Unique: true},
+		{Name: "uri", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "album_type", Type: field.TypeString, Default: ""},
+		{Name: "release_date", Type: field.TypeString, Default: ""},
+		{Name: "total_tracks", Type: field.TypeInt, Default: 0},
+		{Name: "label", Type: field.TypeString, Default: ""},
+		{Name: "upc", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AlbumsTable holds the schema information for the "albums" table.
+	AlbumsTable = &schema.Table{
+		Name:       "albums",
+		Columns:    AlbumsColumns,
+		PrimaryKey: []*schema.Column{AlbumsColumns[0]},
+	}
+	// ArtistsColumns holds the columns for the "artists" table.
+	ArtistsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "spotify_id", Type: field.TypeString, Unique: true},
+		{Name: "uri", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "genres", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// ArtistsTable holds the schema information for the "artists" table.
+	ArtistsTable = &schema.Table{
+		Name:       "artists",
+		Columns:    ArtistsColumns,
+		PrimaryKey: []*schema.Column{ArtistsColumns[0]},
+	}
+	// AuthStatesColumns holds the columns for the "auth_states" table.
+	AuthStatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "state", Type: field.TypeString, Unique: true},
+		{Name: "expires_at", Type: field.TypeTime},
+	}
+	// AuthStatesTable holds the schema information for the "auth_states" table.
+	AuthStatesTable = &schema.Table{
+		Name:       "auth_states",
+		Columns:    AuthStatesColumns,
+		PrimaryKey: []*schema.Column{AuthStatesColumns[0]},
+	}
+	// BackupRunsColumns holds the columns for the "backup_runs" table.
+	BackupRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "user_id", Type: field.TypeString, Default: ""},
+		{Name: "trigger", Type: field.TypeEnum, Enums: []string{"schedule", "manual", "api"}, Default: "manual"},
+		{Name: "job_id", Type: field.TypeString, Default: ""},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"running", "succeeded", "partial", "failed"}, Default: "running"},
+		{Name: "collections", Type: field.TypeJSON},
+		{Name: "api_calls", Type: field.TypeInt, Default: 0},
+		{Name: "error", Type: field.TypeString, Default: ""},
+		{Name: "checkpoint", Type: field.TypeJSON, Nullable: true},
+		{Name: "resumes", Type: field.TypeInt, Default: 0},
+		{Name: "pinned", Type: field.TypeBool, Default: false},
+		{Name: "note", Type: field.TypeString, Default: ""},
+		{Name: "labels", Type: field.TypeJSON},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+	}
+	// BackupRunsTable holds the schema information for the "backup_runs" table.
+	BackupRunsTable = &schema.Table{
+		Name:       "backup_runs",
+		Columns:    BackupRunsColumns,
+		PrimaryKey: []*schema.Column{BackupRunsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "backuprun_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{BackupRunsColumns[13]},
+			},
+		},
+	}
+	// BackupSchedulesColumns holds the columns for the "backup_schedules" table.
+	BackupSchedulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "user_id", Type: field.TypeString, Unique: true},
+		{Name: "cron", Type: field.TypeString},
+		{Name: "time_zone", Type: field.TypeString, Default: "UTC"},
+		{Name: "misfire_policy", Type: field.TypeEnum, Enums: []string{"skip", "catch_up"}, Default: "catch_up"},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "next_run_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_run_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// BackupSchedulesTable holds the schema information for the "backup_schedules" table.
+	BackupSchedulesTable = &schema.Table{
+		Name:       "backup_schedules",
+		Columns:    BackupSchedulesColumns,
+		PrimaryKey: []*schema.Column{BackupSchedulesColumns[0]},
+	}
+	// EpisodesColumns holds the columns for the "episodes" table.
+	EpisodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "spotify_id", Type: field.TypeString, Unique: true},
+		{Name: "uri", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "duration_ms", Type: field.TypeInt},
+		{Name: "explicit", Type: field.TypeBool, Default: false},
+		{Name: "release_date", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "episode_show", Type: field.TypeString, Nullable: true},
+	}
+	// EpisodesTable holds the schema information for the "episodes" table.
+	EpisodesTable = &schema.Table{
+		Name:       "episodes",
+		Columns:    EpisodesColumns,
+		PrimaryKey: []*schema.Column{EpisodesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "episodes_shows_show",
+				Columns:    []*schema.Column{EpisodesColumns[8]},
+				RefColumns: []*schema.Column{ShowsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// FollowedArtistsColumns holds the columns for the "followed_artists" table.
+	FollowedArtistsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "followers", Type: field.TypeInt, Default: 0},
+		{Name: "popularity", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "backup_run_followed_artists", Type: field.TypeString},
+		{Name: "followed_artist_artist", Type: field.TypeString},
+	}
+	// FollowedArtistsTable holds the schema information for the "followed_artists" table.
+	FollowedArtistsTable = &schema.Table{
+		Name:       "followed_artists",
+		Columns:    FollowedArtistsColumns,
+		PrimaryKey: []*schema.Column{FollowedArtistsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "followed_artists_backup_runs_followed_artists",
+				Columns:    []*schema.Column{FollowedArtistsColumns[4]},
+				RefColumns: []*schema.Column{BackupRunsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "followed_artists_artists_artist",
+				Columns:    []*schema.Column{FollowedArtistsColumns[5]},
+				RefColumns: []*schema.Column{ArtistsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// JobsColumns holds the columns for the "jobs" table.
+	JobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "type", Type: field.TypeString},
+		{Name: "key", Type: field.TypeString, Default: ""},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "running", "succeeded", "failed", "canceled"}, Default: "pending"},
+		{Name: "payload", Type: field.TypeJSON, Nullable: true},
+		{Name: "result", Type: field.TypeJSON, Nullable: true},
+		{Name: "error", Type: field.TypeString, Default: ""},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "max_attempts", Type: field.TypeInt},
+		{Name: "run_after", Type: field.TypeTime},
+		{Name: "locked_by", Type: field.TypeString, Default: ""},
+		{Name: "locked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "started_at", Type: field.TypeTime, Nullable: true},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+	}
+	// JobsTable holds the schema information for the "jobs" table.
+	JobsTable = &schema.Table{
+		Name:       "jobs",
+		Columns:    JobsColumns,
+		PrimaryKey: []*schema.Column{JobsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "job_status_run_after",
+				Unique:  false,
+				Columns: []*schema.Column{JobsColumns[3], JobsColumns[9]},
+			},
+			{
+				Name:    "job_key",
+				Unique:  true,
+				Columns: []*schema.Column{JobsColumns[2]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "key <> '' AND status IN ('pending', 'running')",
+				},
+			},
+		},
+	}
+	// LeasesColumns holds the columns for the "leases" table.
+	LeasesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "holder", Type: field.TypeString},
+		{Name: "acquired_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+	}
+	// LeasesTable holds the schema information for the "leases" table.
+	LeasesTable = &schema.Table{
+		Name:       "leases",
+		Columns:    LeasesColumns,
+		PrimaryKey: []*schema.Column{LeasesColumns[0]},
+	}
+	// PlaylistsColumns holds the columns for the "playlists" table.
+	PlaylistsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "spotify_id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// PlaylistsTable holds the schema information for the "playlists" table.
+	PlaylistsTable = &schema.Table{
+		Name:       "playlists",
+		Columns:    PlaylistsColumns,
+		PrimaryKey: []*schema.Column{PlaylistsColumns[0]},
+	}
+	// PlaylistSnapshotsColumns holds the columns for the "playlist_snapshots" table.
+	PlaylistSnapshotsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Default: ""},
+		{Name: "owner_id", Type: field.TypeString},
+		{Name: "owner_name", Type: field.TypeString, Default: ""},
+		{Name: "collaborative", Type: field.TypeBool, Default: false},
+		{Name: "public", Type: field.TypeBool, Nullable: true},
+		{Name: "snapshot_id", Type: field.TypeString},
+		{Name: "total", Type: field.TypeInt},
+		{Name: "complete", Type: field.TypeBool, Default: true},
+		{Name: "pinned", Type: field.TypeBool, Default: false},
+		{Name: "note", Type: field.TypeString, Default: ""},
+		{Name: "labels", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "playlist_snapshots", Type: field.TypeString},
+	}
+	// PlaylistSnapshotsTable holds the schema information for the "playlist_snapshots" table.
+	PlaylistSnapshotsTable = &schema.Table{
+		Name:       "playlist_snapshots",
+		Columns:    PlaylistSnapshotsColumns,
+		PrimaryKey: []*schema.Column{PlaylistSnapshotsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "playlist_snapshots_playlists_snapshots",
+				Columns:    []*schema.Column{PlaylistSnapshotsColumns[14]},
+				RefColumns: []*schema.Column{PlaylistsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "playlistsnapshot_playlist_snapshots",
+				Unique:  false,
+				Columns: []*schema.Column{PlaylistSnapshotsColumns[14]},
+			},
+		},
+	}
+	// RestorePlansColumns holds the columns for the "restore_plans" table.
+	RestorePlansColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "run_id", Type: field.TypeString, Default: ""},
+		{Name: "strategy", Type: field.TypeEnum, Enums: []string{"overwrite", "merge", "copy"}, Default: "overwrite"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "applying", "applied", "failed", "stale"}, Default: "pending"},
+		{Name: "playlists", Type: field.TypeJSON},
+		{Name: "collections", Type: field.TypeJSON},
+		{Name: "drift", Type: field.TypeJSON},
+		{Name: "error", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "applied_at", Type: field.TypeTime, Nullable: true},
+	}
+	// RestorePlansTable holds the schema information for the "restore_plans" table.
+	RestorePlansTable = &schema.Table{
+		Name:       "restore_plans",
+		Columns:    RestorePlansColumns,
+		PrimaryKey: []*schema.Column{RestorePlansColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "restoreplan_run_id",
+				Unique:  false,
+				Columns: []*schema.Column{RestorePlansColumns[2]},
+			},
+		},
+	}
+	// RetentionPoliciesColumns holds the columns for the "retention_policies" table.
+	RetentionPoliciesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "user_id", Type: field.TypeString, Unique: true},
+		{Name: "keep_all_days", Type: field.TypeInt},
+		{Name: "keep_daily_weeks", Type: field.TypeInt},
+		{Name: "keep_weekly_months", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// RetentionPoliciesTable holds the schema information for the "retention_policies" table.
+	RetentionPoliciesTable = &schema.Table{
+		Name:       "retention_policies",
+		Columns:    RetentionPoliciesColumns,
+		PrimaryKey: []*schema.Column{RetentionPoliciesColumns[0]},
+	}
+	// SavedAlbumsColumns holds the columns for the "saved_albums" table.
+	SavedAlbumsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "added_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "backup_run_saved_albums", Type: field.TypeString},
+		{Name: "saved_album_album", Type: field.TypeString},
+	}
+	// SavedAlbumsTable holds the schema information for the "saved_albums" table.
+	SavedAlbumsTable = &schema.Table{
+		Name:       "saved_albums",
+		Columns:    SavedAlbumsColumns,
+		PrimaryKey: []*schema.Column{SavedAlbumsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "saved_albums_backup_runs_saved_albums",
+				Columns:    []*schema.Column{SavedAlbumsColumns[3]},
+				RefColumns: []*schema.Column{BackupRunsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "saved_albums_albums_album",
+				Columns:    []*schema.Column{SavedAlbumsColumns[4]},
+				RefColumns: []*schema.Column{AlbumsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// SavedAudiobooksColumns holds the columns for the "saved_audiobooks" table.
+	SavedAudiobooksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "added_at", Type: field.TypeTime, Nullable: true},
+		{Name: "spotify_id", Type: field.TypeString},
+		{Name: "uri", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "authors", Type: field.TypeJSON, Nullable: true},
+		{Name: "narrators", Type: field.TypeJSON, Nullable: true},
+		{Name: "publisher", Type: field.TypeString, Default: ""},
+		{Name: "total_chapters", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "backup_run_saved_audiobooks", Type: field.TypeString},
+	}
+	// SavedAudiobooksTable holds the schema information for the "saved_audiobooks" table.
+	SavedAudiobooksTable = &schema.Table{
+		Name:       "saved_audiobooks",
+		Columns:    SavedAudiobooksColumns,
+		PrimaryKey: []*schema.Column{SavedAudiobooksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "saved_audiobooks_backup_runs_saved_audiobooks",
+				Columns:    []*schema.Column{SavedAudiobooksColumns[10]},
+				RefColumns: []*schema.Column{BackupRunsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// SavedEpisodesColumns holds the columns for the "saved_episodes" table.
+	SavedEpisodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "added_at", Type: field.TypeTime},
+		{Name: "fully_played", Type: field.TypeBool, Default: false},
+		{Name: "resume_position_ms", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "backup_run_saved_episodes", Type: field.TypeString},
+		{Name: "saved_episode_episode", Type: field.TypeString},
+	}
+	// SavedEpisodesTable holds the schema information for the "saved_episodes" table.
+	SavedEpisodesTable = &schema.Table{
+		Name:       "saved_episodes",
+		Columns:    SavedEpisodesColumns,
+		PrimaryKey: []*schema.Column{SavedEpisodesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "saved_episodes_backup_runs_saved_episodes",
+				Columns:    []*schema.Column{SavedEpisodesColumns[5]},
+				RefColumns: []*schema.Column{BackupRunsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "saved_episodes_episodes_episode",
+				Columns:    []*schema.Column{SavedEpisodesColumns[6]},
+				RefColumns: []*schema.Column{EpisodesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// SavedShowsColumns holds the columns for the "saved_shows" table.
+	SavedShowsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "added_at", Type: field.TypeTime},
+		{Name: "total_episodes", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "backup_run_saved_shows", Type: field.TypeString},
+		{Name: "saved_show_show", Type: field.TypeString},
+	}
+	// SavedShowsTable holds the schema information for the "saved_shows" table.
+	SavedShowsTable = &schema.Table{
+		Name:       "saved_shows",
+		Columns:    SavedShowsColumns,
+		PrimaryKey: []*schema.Column{SavedShowsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "saved_shows_backup_runs_saved_shows",
+				Columns:    []*schema.Column{SavedShowsColumns[4]},
+				RefColumns: []*schema.Column{BackupRunsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "saved_shows_shows_show",
+				Columns:    []*schema.Column{SavedShowsColumns[5]},
+				RefColumns: []*schema.Column{ShowsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// SavedTracksColumns holds the columns for the "saved_tracks" table.
+	SavedTracksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "added_at", Type: field.TypeTime},
+		{Name: "linked_from_id", Type: field.TypeString, Default: ""},
+		{Name: "is_playable", Type: field.TypeBool, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "backup_run_saved_tracks", Type: field.TypeString},
+		{Name: "saved_track_track", Type: field.TypeString},
+	}
+	// SavedTracksTable holds the schema information for the "saved_tracks" table.
+	SavedTracksTable = &schema.Table{
+		Name:       "saved_tracks",
+		Columns:    SavedTracksColumns,
+		PrimaryKey: []*schema.Column{SavedTracksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "saved_tracks_backup_runs_saved_tracks",
+				Columns:    []*schema.Column{SavedTracksColumns[5]},
+				RefColumns: []*schema.Column{BackupRunsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "saved_tracks_tracks_track",
+				Columns:    []*schema.Column{SavedTracksColumns[6]},
+				RefColumns: []*schema.Column{TracksColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// SchemaMigrationsColumns holds the columns for the "schema_migrations" table.
+	SchemaMigrationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "applied_at", Type: field.TypeTime},
+	}
+	// SchemaMigrationsTable holds the schema information for the "schema_migrations" table.
+	SchemaMigrationsTable = &schema.Table{
+		Name:       "schema_migrations",
+		Columns:    SchemaMigrationsColumns,
+		PrimaryKey: []*schema.Column{SchemaMigrationsColumns[0]},
+	}
+	// ShowsColumns holds the columns for the "shows" table.
+	ShowsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "spotify_id", Type: field.TypeString, Unique: true},
+		{Name: "uri", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "publisher", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// ShowsTable holds the schema information for the "shows" table.
+	ShowsTable = &schema.Table{
+		Name:       "shows",
+		Columns:    ShowsColumns,
+		PrimaryKey: []*schema.Column{ShowsColumns[0]},
+	}
+	// SnapshotItemsColumns holds the columns for the "snapshot_items" table.
+	SnapshotItemsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "position", Type: field.TypeInt},
+		{Name: "added_at", Type: field.TypeTime, Nullable: true},
+		{Name: "added_by", Type: field.TypeString, Default: ""},
+		{Name: "is_local", Type: field.TypeBool, Default: false},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"track", "episode"}},
+		{Name: "uri", Type: field.TypeString},
+		{Name: "linked_from_id", Type: field.TypeString, Default: ""},
+		{Name: "is_playable", Type: field.TypeBool, Nullable: true},
+		{Name: "playlist_snapshot_items", Type: field.TypeString},
+		{Name: "snapshot_item_track", Type: field.TypeString, Nullable: true},
+		{Name: "snapshot_item_episode", Type: field.TypeString, Nullable: true},
+	}
+	// SnapshotItemsTable holds the schema information for the "snapshot_items" table.
+	SnapshotItemsTable = &schema.Table{
+		Name:       "snapshot_items",
+		Columns:    SnapshotItemsColumns,
+		PrimaryKey: []*schema.Column{SnapshotItemsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "snapshot_items_playlist_snapshots_items",
+				Columns:    []*schema.Column{SnapshotItemsColumns[9]},
+				RefColumns: []*schema.Column{PlaylistSnapshotsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "snapshot_items_tracks_track",
+				Columns:    []*schema.Column{SnapshotItemsColumns[10]},
+				RefColumns: []*schema.Column{TracksColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "snapshot_items_episodes_episode",
+				Columns:    []*schema.Column{SnapshotItemsColumns[11]},
+				RefColumns: []*schema.Column{EpisodesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "snapshotitem_position_playlist_snapshot_items",
+				Unique:  true,
+				Columns: []*schema.Column{SnapshotItemsColumns[1], SnapshotItemsColumns[9]},
+			},
+		},
+	}
+	// SpotifyTokensColumns holds the columns for the "spotify_tokens" table.
+	SpotifyTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "token", Type: field.TypeBytes},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// SpotifyTokensTable holds the schema information for the "spotify_tokens" table.
+	SpotifyTokensTable = &schema.Table{
+		Name:       "spotify_tokens",
+		Columns:    SpotifyTokensColumns,
+		PrimaryKey: []*schema.Column{SpotifyTokensColumns[0]},
+	}
+	// TracksColumns holds the columns for the "tracks" table.
+	TracksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "spotify_id", Type: field.TypeString, Unique: true},
+		{Name: "uri", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "duration_ms", Type: field.TypeInt},
+		{Name: "explicit", Type: field.TypeBool, Default: false},
+		{Name: "disc_number", Type: field.TypeInt, Default: 0},
+		{Name: "track_number", Type: field.TypeInt, Default: 0},
+		{Name: "isrc", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "track_album", Type: field.TypeString, Nullable: true},
+	}
+	// TracksTable holds the schema information for the "tracks" table.
+	TracksTable = &schema.Table{
+		Name:       "tracks",
+		Columns:    TracksColumns,
+		PrimaryKey: []*schema.Column{TracksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tracks_albums_album",
+				Columns:    []*schema.Column{TracksColumns[10]},
+				RefColumns: []*schema.Column{AlbumsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "track_isrc",
+				Unique:  false,
+				Columns: []*schema.Column{TracksColumns[8]},
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
@@ -20,11 +587,135 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 	}
+	// AlbumArtistsColumns holds the columns for the "album_artists" table.
+	AlbumArtistsColumns = []*schema.Column{
+		{Name: "album_id", Type: field.TypeString},
+		{Name: "artist_id", Type: field.TypeString},
+	}
+	// AlbumArtistsTable holds the schema information for the "album_artists" table.
+	AlbumArtistsTable = &schema.Table{
+		Name:       "album_artists",
+		Columns:    AlbumArtistsColumns,
+		PrimaryKey: []*schema.Column{AlbumArtistsColumns[0], AlbumArtistsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "album_artists_album_id",
+				Columns:    []*schema.Column{AlbumArtistsColumns[0]},
+				RefColumns: []*schema.Column{AlbumsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "album_artists_artist_id",
+				Columns:    []*schema.Column{AlbumArtistsColumns[1]},
+				RefColumns: []*schema.Column{ArtistsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// BackupRunPlaylistSnapshotsColumns holds the columns for the "backup_run_playlist_snapshots" table.
+	BackupRunPlaylistSnapshotsColumns = []*schema.Column{
+		{Name: "backup_run_id", Type: field.TypeString},
+		{Name: "playlist_snapshot_id", Type: field.TypeString},
+	}
+	// BackupRunPlaylistSnapshotsTable holds the schema information for the "backup_run_playlist_snapshots" table.
+	BackupRunPlaylistSnapshotsTable = &schema.Table{
+		Name:       "backup_run_playlist_snapshots",
+		Columns:    BackupRunPlaylistSnapshotsColumns,
+		PrimaryKey: []*schema.Column{BackupRunPlaylistSnapshotsColumns[0], BackupRunPlaylistSnapshotsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "backup_run_playlist_snapshots_backup_run_id",
+				Columns:    []*schema.Column{BackupRunPlaylistSnapshotsColumns[0]},
+				RefColumns: []*schema.Column{BackupRunsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "backup_run_playlist_snapshots_playlist_snapshot_id",
+				Columns:    []*schema.Column{BackupRunPlaylistSnapshotsColumns[1]},
+				RefColumns: []*schema.Column{PlaylistSnapshotsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// TrackArtistsColumns holds the columns for the "track_artists" table.
+	TrackArtistsColumns = []*schema.Column{
+		{Name: "track_id", Type: field.TypeString},
+		{Name: "artist_id", Type: field.TypeString},
+	}
+	// TrackArtistsTable holds the schema information for the "track_artists" table.
+	TrackArtistsTable = &schema.Table{
+		Name:       "track_artists",
+		Columns:    TrackArtistsColumns,
+		PrimaryKey: []*schema.Column{TrackArtistsColumns[0], TrackArtistsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "track_artists_track_id",
+				Columns:    []*schema.Column{TrackArtistsColumns[0]},
+				RefColumns: []*schema.Column{TracksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "track_artists_artist_id",
+				Columns:    []*schema.Column{TrackArtistsColumns[1]},
+				RefColumns: []*schema.Column{ArtistsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AlbumsTable,
+		ArtistsTable,
+		AuthStatesTable,
+		BackupRunsTable,
+		BackupSchedulesTable,
+		EpisodesTable,
+		FollowedArtistsTable,
+		JobsTable,
+		LeasesTable,
+		PlaylistsTable,
+		PlaylistSnapshotsTable,
+		RestorePlansTable,
+		RetentionPoliciesTable,
+		SavedAlbumsTable,
+		SavedAudiobooksTable,
+		SavedEpisodesTable,
+		SavedShowsTable,
+		SavedTracksTable,
+		SchemaMigrationsTable,
+		ShowsTable,
+		SnapshotItemsTable,
+		SpotifyTokensTable,
+		TracksTable,
 		UsersTable,
+		AlbumArtistsTable,
+		BackupRunPlaylistSnapshotsTable,
+		TrackArtistsTable,
 	}
 )
 
 func init() {
+	EpisodesTable.ForeignKeys[0].RefTable = ShowsTable
+	FollowedArtistsTable.ForeignKeys[0].RefTable = BackupRunsTable
+	FollowedArtistsTable.ForeignKeys[1].RefTable = ArtistsTable
+	PlaylistSnapshotsTable.ForeignKeys[0].RefTable = PlaylistsTable
+	SavedAlbumsTable.ForeignKeys[0].RefTable = BackupRunsTable
+	SavedAlbumsTable.ForeignKeys[1].RefTable = AlbumsTable
+	SavedAudiobooksTable.ForeignKeys[0].RefTable = BackupRunsTable
+	SavedEpisodesTable.ForeignKeys[0].RefTable = BackupRunsTable
+	SavedEpisodesTable.ForeignKeys[1].RefTable = EpisodesTable
+	SavedShowsTable.ForeignKeys[0].RefTable = BackupRunsTable
+	SavedShowsTable.ForeignKeys[1].RefTable = ShowsTable
+	SavedTracksTable.ForeignKeys[0].RefTable = BackupRunsTable
+	SavedTracksTable.ForeignKeys[1].RefTable = TracksTable
+	SnapshotItemsTable.ForeignKeys[0].RefTable = PlaylistSnapshotsTable
+	SnapshotItemsTable.ForeignKeys[1].RefTable = TracksTable
+	SnapshotItemsTable.ForeignKeys[2].RefTable = EpisodesTable
+	TracksTable.ForeignKeys[0].RefTable = AlbumsTable
+	AlbumArtistsTable.ForeignKeys[0].RefTable = AlbumsTable
+	AlbumArtistsTable.ForeignKeys[1].RefTable = ArtistsTable
+	BackupRunPlaylistSnapshotsTable.ForeignKeys[0].RefTable = BackupRunsTable
+	BackupRunPlaylistSnapshotsTable.ForeignKeys[1].RefTable = PlaylistSnapshotsTable
+	TrackArtistsTable.ForeignKeys[0].RefTable = TracksTable
+	TrackArtistsTable.ForeignKeys[1].RefTable = ArtistsTable
 }
@@ -3,12 +3,38 @@
 package ent
 
 import (
+	"beyerleinf/spotify-backup/ent/album"
+	"beyerleinf/spotify-backup/ent/artist"
+	"beyerleinf/spotify-backup/ent/authstate"
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/backupschedule"
+	"beyerleinf/spotify-backup/ent/episode"
+	"beyerleinf/spotify-backup/ent/followedartist"
+	"beyerleinf/spotify-backup/ent/job"
+	"beyerleinf/spotify-backup/ent/lease"
+	"beyerleinf/spotify-backup/ent/playlist"
+	"beyerleinf/spotify-backup/ent/playlistsnapshot"
 	"beyerleinf/spotify-backup/ent/predicate"
+	"beyerleinf/spotify-backup/ent/restoreplan"
+	"beyerleinf/spotify-backup/ent/retentionpolicy"
+	"beyerleinf/spotify-backup/ent/savedalbum"
+	"beyerleinf/spotify-backup/ent/savedaudiobook"
+	"beyerleinf/spotify-backup/ent/savedepisode"
+	"beyerleinf/spotify-backup/ent/savedshow"
+	"beyerleinf/spotify-backup/ent/savedtrack"
+	"beyerleinf/spotify-backup/ent/schema/schematype"
+	"beyerleinf/spotify-backup/ent/schemamigration"
+	"beyerleinf/spotify-backup/ent/show"
+	"beyerleinf/spotify-backup/ent/snapshotitem"
+	"beyerleinf/spotify-backup/ent/spotifytoken"
+	"beyerleinf/spotify-backup/ent/track"
 	"beyerleinf/spotify-backup/ent/user"
 	"context"
+	"encoding/json/jsontext"
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -23,9 +49,18609 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeUser = "User"
+	TypeAlbum            = "Album"
+	TypeArtist           = "Artist"
+	TypeAuthState        = "AuthState"
+	TypeBackupRun        = "BackupRun"
+	TypeBackupSchedule   = "BackupSchedule"
+	TypeEpisode          = "Episode"
+	TypeFollowedArtist   = "FollowedArtist"
+	TypeJob              = "Job"
+	TypeLease            = "Lease"
+	TypePlaylist         = "Playlist"
+	TypePlaylistSnapshot = "PlaylistSnapshot"
+	TypeRestorePlan      = "RestorePlan"
+	TypeRetentionPolicy  = "RetentionPolicy"
+	TypeSavedAlbum       = "SavedAlbum"
+	TypeSavedAudiobook   = "SavedAudiobook"
+	TypeSavedEpisode     = "SavedEpisode"
+	TypeSavedShow        = "SavedShow"
+	TypeSavedTrack       = "SavedTrack"
+	TypeSchemaMigration  = "SchemaMigration"
+	TypeShow             = "Show"
+	TypeSnapshotItem     = "SnapshotItem"
+	TypeSpotifyToken     = "SpotifyToken"
+	TypeTrack            = "Track"
+	TypeUser             = "User"
 )
 
+// AlbumMutation represents an operation that mutates the Album nodes in the graph.
+type AlbumMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *string
+	spotify_id          *string
+	uri                 *string
+	name                *string
+	album_type          *string
+	release_date        *string
+	total_tracks        *int
+	addtotal_tracks     *int
+	label               *string
+	upc                 *string
+	created_at          *time.Time
+	clearedFields       map[string]struct{}
+	artists             map[string]struct{}
+	removedartists      map[string]struct{}
+	clearedartists      bool
+	tracks              map[string]struct{}
+	removedtracks       map[string]struct{}
+	clearedtracks       bool
+	saved_albums        map[string]struct{}
+	removedsaved_albums map[string]struct{}
+	clearedsaved_albums bool
+	done                bool
+	oldValue            func(context.Context) (*Album, error)
+	predicates          []predicate.Album
+}
+
+var _ ent.Mutation = (*AlbumMutation)(nil)
+
+// albumOption allows management of the mutation configuration using functional options.
+type albumOption func(*AlbumMutation)
+
+// newAlbumMutation creates new mutation for the Album entity.
+func newAlbumMutation(c config, op Op, opts ...albumOption) *AlbumMutation {
+	m := &AlbumMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeAlbum,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withAlbumID sets the ID field of the mutation.
+func withAlbumID(id string) albumOption {
+	return func(m *AlbumMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Album
+		)
+		m.oldValue = func(ctx context.Context) (*Album, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Album.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withAlbum sets the old Album of the mutation.
+func withAlbum(node *Album) albumOption {
+	return func(m *AlbumMutation) {
+		m.oldValue = func(context.Context) (*Album, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m AlbumMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m AlbumMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Album entities.
+func (m *AlbumMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *AlbumMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *AlbumMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Album.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSpotifyID sets the "spotify_id" field.
+func (m *AlbumMutation) SetSpotifyID(s string) {
+	m.spotify_id = &s
+}
+
+// SpotifyID returns the value of the "spotify_id" field in the mutation.
+func (m *AlbumMutation) SpotifyID() (r string, exists bool) {
+	v := m.spotify_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSpotifyID returns the old "spotify_id" field's value of the Album entity.
+// If the Album object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AlbumMutation) OldSpotifyID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSpotifyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSpotifyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSpotifyID: %w", err)
+	}
+	return oldValue.SpotifyID, nil
+}
+
+// ResetSpotifyID resets all changes to the "spotify_id" field.
+func (m *AlbumMutation) ResetSpotifyID() {
+	m.spotify_id = nil
+}
+
+// SetURI sets the "uri" field.
+func (m *AlbumMutation) SetURI(s string) {
+	m.uri = &s
+}
+
+// URI returns the value of the "uri" field in the mutation.
+func (m *AlbumMutation) URI() (r string, exists bool) {
+	v := m.uri
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURI returns the old "uri" field's value of the Album entity.
+// If the Album object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AlbumMutation) OldURI(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURI is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURI requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURI: %w", err)
+	}
+	return oldValue.URI, nil
+}
+
+// ResetURI resets all changes to the "uri" field.
+func (m *AlbumMutation) ResetURI() {
+	m.uri = nil
+}
+
+// SetName sets the "name" field.
+func (m *AlbumMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *AlbumMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Album entity.
+// If the Album object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AlbumMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *AlbumMutation) ResetName() {
+	m.name = nil
+}
+
+// SetAlbumType sets the "album_type" field.
+func (m *AlbumMutation) SetAlbumType(s string) {
+	m.album_type = &s
+}
+
+// AlbumType returns the value of the "album_type" field in the mutation.
+func (m *AlbumMutation) AlbumType() (r string, exists bool) {
+	v := m.album_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAlbumType returns the old "album_type" field's value of the Album entity.
+// If the Album object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AlbumMutation) OldAlbumType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAlbumType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAlbumType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAlbumType: %w", err)
+	}
+	return oldValue.AlbumType, nil
+}
+
+// ResetAlbumType resets all changes to the "album_type" field.
+func (m *AlbumMutation) ResetAlbumType() {
+	m.album_type = nil
+}
+
+// SetReleaseDate sets the "release_date" field.
+func (m *AlbumMutation) SetReleaseDate(s string) {
+	m.release_date = &s
+}
+
+// ReleaseDate returns the value of the "release_date" field in the mutation.
+func (m *AlbumMutation) ReleaseDate() (r string, exists bool) {
+	v := m.release_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReleaseDate returns the old "release_date" field's value of the Album entity.
+// If the Album object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AlbumMutation) OldReleaseDate(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReleaseDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReleaseDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReleaseDate: %w", err)
+	}
+	return oldValue.ReleaseDate, nil
+}
+
+// ResetReleaseDate resets all changes to the "release_date" field.
+func (m *AlbumMutation) ResetReleaseDate() {
+	m.release_date = nil
+}
+
+// SetTotalTracks sets the "total_tracks" field.
+func (m *AlbumMutation) SetTotalTracks(i int) {
+	m.total_tracks = &i
+	m.addtotal_tracks = nil
+}
+
+// TotalTracks returns the value of the "total_tracks" field in the mutation.
+func (m *AlbumMutation) TotalTracks() (r int, exists bool) {
+	v := m.total_tracks
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalTracks returns the old "total_tracks" field's value of the Album entity.
+// If the Album object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AlbumMutation) OldTotalTracks(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalTracks is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalTracks requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalTracks: %w", err)
+	}
+	return oldValue.TotalTracks, nil
+}
+
+// AddTotalTracks adds i to the "total_tracks" field.
+func (m *AlbumMutation) AddTotalTracks(i int) {
+	if m.addtotal_tracks != nil {
+		*m.addtotal_tracks += i
+	} else {
+		m.addtotal_tracks = &i
+	}
+}
+
+// AddedTotalTracks returns the value that was added to the "total_tracks" field in this mutation.
+func (m *AlbumMutation) AddedTotalTracks() (r int, exists bool) {
+	v := m.addtotal_tracks
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalTracks resets all changes to the "total_tracks" field.
+func (m *AlbumMutation) ResetTotalTracks() {
+	m.total_tracks = nil
+	m.addtotal_tracks = nil
+}
+
+// SetLabel sets the "label" field.
+func (m *AlbumMutation) SetLabel(s string) {
+	m.label = &s
+}
+
+// Label returns the value of the "label" field in the mutation.
+func (m *AlbumMutation) Label() (r string, exists bool) {
+	v := m.label
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabel returns the old "label" field's value of the Album entity.
+// If the Album object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AlbumMutation) OldLabel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabel: %w", err)
+	}
+	return oldValue.Label, nil
+}
+
+// ResetLabel resets all changes to the "label" field.
+func (m *AlbumMutation) ResetLabel() {
+	m.label = nil
+}
+
+// SetUpc sets the "upc" field.
+func (m *AlbumMutation) SetUpc(s string) {
+	m.upc = &s
+}
+
+// Upc returns the value of the "upc" field in the mutation.
+func (m *AlbumMutation) Upc() (r string, exists bool) {
+	v := m.upc
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpc returns the old "upc" field's value of the Album entity.
+// If the Album object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AlbumMutation) OldUpc(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpc is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpc requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpc: %w", err)
+	}
+	return oldValue.Upc, nil
+}
+
+// ResetUpc resets all changes to the "upc" field.
+func (m *AlbumMutation) ResetUpc() {
+	m.upc = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *AlbumMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AlbumMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Album entity.
+// If the Album object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AlbumMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AlbumMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// AddArtistIDs adds the "artists" edge to the Artist entity by ids.
+func (m *AlbumMutation) AddArtistIDs(ids ...string) {
+	if m.artists == nil {
+		m.artists = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.artists[ids[i]] = struct{}{}
+	}
+}
+
+// ClearArtists clears the "artists" edge to the Artist entity.
+func (m *AlbumMutation) ClearArtists() {
+	m.clearedartists = true
+}
+
+// ArtistsCleared reports if the "artists" edge to the Artist entity was cleared.
+func (m *AlbumMutation) ArtistsCleared() bool {
+	return m.clearedartists
+}
+
+// RemoveArtistIDs removes the "artists" edge to the Artist entity by IDs.
+func (m *AlbumMutation) RemoveArtistIDs(ids ...string) {
+	if m.removedartists == nil {
+		m.removedartists = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.artists, ids[i])
+		m.removedartists[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedArtists returns the removed IDs of the "artists" edge to the Artist entity.
+func (m *AlbumMutation) RemovedArtistsIDs() (ids []string) {
+	for id := range m.removedartists {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ArtistsIDs returns the "artists" edge IDs in the mutation.
+func (m *AlbumMutation) ArtistsIDs() (ids []string) {
+	for id := range m.artists {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetArtists resets all changes to the "artists" edge.
+func (m *AlbumMutation) ResetArtists() {
+	m.artists = nil
+	m.clearedartists = false
+	m.removedartists = nil
+}
+
+// AddTrackIDs adds the "tracks" edge to the Track entity by ids.
+func (m *AlbumMutation) AddTrackIDs(ids ...string) {
+	if m.tracks == nil {
+		m.tracks = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.tracks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTracks clears the "tracks" edge to the Track entity.
+func (m *AlbumMutation) ClearTracks() {
+	m.clearedtracks = true
+}
+
+// TracksCleared reports if the "tracks" edge to the Track entity was cleared.
+func (m *AlbumMutation) TracksCleared() bool {
+	return m.clearedtracks
+}
+
+// RemoveTrackIDs removes the "tracks" edge to the Track entity by IDs.
+func (m *AlbumMutation) RemoveTrackIDs(ids ...string) {
+	if m.removedtracks == nil {
+		m.removedtracks = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.tracks, ids[i])
+		m.removedtracks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTracks returns the removed IDs of the "tracks" edge to the Track entity.
+func (m *AlbumMutation) RemovedTracksIDs() (ids []string) {
+	for id := range m.removedtracks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TracksIDs returns the "tracks" edge IDs in the mutation.
+func (m *AlbumMutation) TracksIDs() (ids []string) {
+	for id := range m.tracks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTracks resets all changes to the "tracks" edge.
+func (m *AlbumMutation) ResetTracks() {
+	m.tracks = nil
+	m.clearedtracks = false
+	m.removedtracks = nil
+}
+
+// AddSavedAlbumIDs adds the "saved_albums" edge to the SavedAlbum entity by ids.
+func (m *AlbumMutation) AddSavedAlbumIDs(ids ...string) {
+	if m.saved_albums == nil {
+		m.saved_albums = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.saved_albums[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSavedAlbums clears the "saved_albums" edge to the SavedAlbum entity.
+func (m *AlbumMutation) ClearSavedAlbums() {
+	m.clearedsaved_albums = true
+}
+
+// SavedAlbumsCleared reports if the "saved_albums" edge to the SavedAlbum entity was cleared.
+func (m *AlbumMutation) SavedAlbumsCleared() bool {
+	return m.clearedsaved_albums
+}
+
+// RemoveSavedAlbumIDs removes the "saved_albums" edge to the SavedAlbum entity by IDs.
+func (m *AlbumMutation) RemoveSavedAlbumIDs(ids ...string) {
+	if m.removedsaved_albums == nil {
+		m.removedsaved_albums = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.saved_albums, ids[i])
+		m.removedsaved_albums[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSavedAlbums returns the removed IDs of the "saved_albums" edge to the SavedAlbum entity.
+func (m *AlbumMutation) RemovedSavedAlbumsIDs() (ids []string) {
+	for id := range m.removedsaved_albums {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SavedAlbumsIDs returns the "saved_albums" edge IDs in the mutation.
+func (m *AlbumMutation) SavedAlbumsIDs() (ids []string) {
+	for id := range m.saved_albums {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSavedAlbums resets all changes to the "saved_albums" edge.
+func (m *AlbumMutation) ResetSavedAlbums() {
+	m.saved_albums = nil
+	m.clearedsaved_albums = false
+	m.removedsaved_albums = nil
+}
+
+// Where appends a list predicates to the AlbumMutation builder.
+func (m *AlbumMutation) Where(ps ...predicate.Album) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AlbumMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AlbumMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Album, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AlbumMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AlbumMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Album).
+func (m *AlbumMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AlbumMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.spotify_id != nil {
+		fields = append(fields, album.FieldSpotifyID)
+	}
+	if m.uri != nil {
+		fields = append(fields, album.FieldURI)
+	}
+	if m.name != nil {
+		fields = append(fields, album.FieldName)
+	}
+	if m.album_type != nil {
+		fields = append(fields, album.FieldAlbumType)
+	}
+	if m.release_date != nil {
+		fields = append(fields, album.FieldReleaseDate)
+	}
+	if m.total_tracks != nil {
+		fields = append(fields, album.FieldTotalTracks)
+	}
+	if m.label != nil {
+		fields = append(fields, album.FieldLabel)
+	}
+	if m.upc != nil {
+		fields = append(fields, album.FieldUpc)
+	}
+	if m.created_at != nil {
+		fields = append(fields, album.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AlbumMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case album.FieldSpotifyID:
+		return m.SpotifyID()
+	case album.FieldURI:
+		return m.URI()
+	case album.FieldName:
+		return m.Name()
+	case album.FieldAlbumType:
+		return m.AlbumType()
+	case album.FieldReleaseDate:
+		return m.ReleaseDate()
+	case album.FieldTotalTracks:
+		return m.TotalTracks()
+	case album.FieldLabel:
+		return m.Label()
+	case album.FieldUpc:
+		return m.Upc()
+	case album.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AlbumMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case album.FieldSpotifyID:
+		return m.OldSpotifyID(ctx)
+	case album.FieldURI:
+		return m.OldURI(ctx)
+	case album.FieldName:
+		return m.OldName(ctx)
+	case album.FieldAlbumType:
+		return m.OldAlbumType(ctx)
+	case album.FieldReleaseDate:
+		return m.OldReleaseDate(ctx)
+	case album.FieldTotalTracks:
+		return m.OldTotalTracks(ctx)
+	case album.FieldLabel:
+		return m.OldLabel(ctx)
+	case album.FieldUpc:
+		return m.OldUpc(ctx)
+	case album.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Album field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AlbumMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case album.FieldSpotifyID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpotifyID(v)
+		return nil
+	case album.FieldURI:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURI(v)
+		return nil
+	case album.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case album.FieldAlbumType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlbumType(v)
+		return nil
+	case album.FieldReleaseDate:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReleaseDate(v)
+		return nil
+	case album.FieldTotalTracks:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalTracks(v)
+		return nil
+	case album.FieldLabel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabel(v)
+		return nil
+	case album.FieldUpc:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpc(v)
+		return nil
+	case album.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Album field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AlbumMutation) AddedFields() []string {
+	var fields []string
+	if m.addtotal_tracks != nil {
+		fields = append(fields, album.FieldTotalTracks)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AlbumMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case album.FieldTotalTracks:
+		return m.AddedTotalTracks()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AlbumMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case album.FieldTotalTracks:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalTracks(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Album numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AlbumMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AlbumMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AlbumMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Album nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AlbumMutation) ResetField(name string) error {
+	switch name {
+	case album.FieldSpotifyID:
+		m.ResetSpotifyID()
+		return nil
+	case album.FieldURI:
+		m.ResetURI()
+		return nil
+	case album.FieldName:
+		m.ResetName()
+		return nil
+	case album.FieldAlbumType:
+		m.ResetAlbumType()
+		return nil
+	case album.FieldReleaseDate:
+		m.ResetReleaseDate()
+		return nil
+	case album.FieldTotalTracks:
+		m.ResetTotalTracks()
+		return nil
+	case album.FieldLabel:
+		m.ResetLabel()
+		return nil
+	case album.FieldUpc:
+		m.ResetUpc()
+		return nil
+	case album.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Album field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AlbumMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.artists != nil {
+		edges = append(edges, album.EdgeArtists)
+	}
+	if m.tracks != nil {
+		edges = append(edges, album.EdgeTracks)
+	}
+	if m.saved_albums != nil {
+		edges = append(edges, album.EdgeSavedAlbums)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AlbumMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case album.EdgeArtists:
+		ids := make([]ent.Value, 0, len(m.artists))
+		for id := range m.artists {
+			ids = append(ids, id)
+		}
+		return ids
+	case album.EdgeTracks:
+		ids := make([]ent.Value, 0, len(m.tracks))
+		for id := range m.tracks {
+			ids = append(ids, id)
+		}
+		return ids
+	case album.EdgeSavedAlbums:
+		ids := make([]ent.Value, 0, len(m.saved_albums))
+		for id := range m.saved_albums {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AlbumMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedartists != nil {
+		edges = append(edges, album.EdgeArtists)
+	}
+	if m.removedtracks != nil {
+		edges = append(edges, album.EdgeTracks)
+	}
+	if m.removedsaved_albums != nil {
+		edges = append(edges, album.EdgeSavedAlbums)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AlbumMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case album.EdgeArtists:
+		ids := make([]ent.Value, 0, len(m.removedartists))
+		for id := range m.removedartists {
+			ids = append(ids, id)
+		}
+		return ids
+	case album.EdgeTracks:
+		ids := make([]ent.Value, 0, len(m.removedtracks))
+		for id := range m.removedtracks {
+			ids = append(ids, id)
+		}
+		return ids
+	case album.EdgeSavedAlbums:
+		ids := make([]ent.Value, 0, len(m.removedsaved_albums))
+		for id := range m.removedsaved_albums {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AlbumMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedartists {
+		edges = append(edges, album.EdgeArtists)
+	}
+	if m.clearedtracks {
+		edges = append(edges, album.EdgeTracks)
+	}
+	if m.clearedsaved_albums {
+		edges = append(edges, album.EdgeSavedAlbums)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AlbumMutation) EdgeCleared(name string) bool {
+	switch name {
+	case album.EdgeArtists:
+		return m.clearedartists
+	case album.EdgeTracks:
+		return m.clearedtracks
+	case album.EdgeSavedAlbums:
+		return m.clearedsaved_albums
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AlbumMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Album unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AlbumMutation) ResetEdge(name string) error {
+	switch name {
+	case album.EdgeArtists:
+		m.ResetArtists()
+		return nil
+	case album.EdgeTracks:
+		m.ResetTracks()
+		return nil
+	case album.EdgeSavedAlbums:
+		m.ResetSavedAlbums()
+		return nil
+	}
+	return fmt.Errorf("unknown Album edge %s", name)
+}
+
+// ArtistMutation represents an operation that mutates the Artist nodes in the graph.
+type ArtistMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *string
+	spotify_id              *string
+	uri                     *string
+	name                    *string
+	genres                  *[]string
+	appendgenres            []string
+	created_at              *time.Time
+	clearedFields           map[string]struct{}
+	tracks                  map[string]struct{}
+	removedtracks           map[string]struct{}
+	clearedtracks           bool
+	albums                  map[string]struct{}
+	removedalbums           map[string]struct{}
+	clearedalbums           bool
+	followed_artists        map[string]struct{}
+	removedfollowed_artists map[string]struct{}
+	clearedfollowed_artists bool
+	done                    bool
+	oldValue                func(context.Context) (*Artist, error)
+	predicates              []predicate.Artist
+}
+
+var _ ent.Mutation = (*ArtistMutation)(nil)
+
+// artistOption allows management of the mutation configuration using functional options.
+type artistOption func(*ArtistMutation)
+
+// newArtistMutation creates new mutation for the Artist entity.
+func newArtistMutation(c config, op Op, opts ...artistOption) *ArtistMutation {
+	m := &ArtistMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeArtist,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withArtistID sets the ID field of the mutation.
+func withArtistID(id string) artistOption {
+	return func(m *ArtistMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Artist
+		)
+		m.oldValue = func(ctx context.Context) (*Artist, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Artist.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withArtist sets the old Artist of the mutation.
+func withArtist(node *Artist) artistOption {
+	return func(m *ArtistMutation) {
+		m.oldValue = func(context.Context) (*Artist, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ArtistMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ArtistMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Artist entities.
+func (m *ArtistMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ArtistMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ArtistMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Artist.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSpotifyID sets the "spotify_id" field.
+func (m *ArtistMutation) SetSpotifyID(s string) {
+	m.spotify_id = &s
+}
+
+// SpotifyID returns the value of the "spotify_id" field in the mutation.
+func (m *ArtistMutation) SpotifyID() (r string, exists bool) {
+	v := m.spotify_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSpotifyID returns the old "spotify_id" field's value of the Artist entity.
+// If the Artist object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArtistMutation) OldSpotifyID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSpotifyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSpotifyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSpotifyID: %w", err)
+	}
+	return oldValue.SpotifyID, nil
+}
+
+// ResetSpotifyID resets all changes to the "spotify_id" field.
+func (m *ArtistMutation) ResetSpotifyID() {
+	m.spotify_id = nil
+}
+
+// SetURI sets the "uri" field.
+func (m *ArtistMutation) SetURI(s string) {
+	m.uri = &s
+}
+
+// URI returns the value of the "uri" field in the mutation.
+func (m *ArtistMutation) URI() (r string, exists bool) {
+	v := m.uri
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURI returns the old "uri" field's value of the Artist entity.
+// If the Artist object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArtistMutation) OldURI(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURI is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURI requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURI: %w", err)
+	}
+	return oldValue.URI, nil
+}
+
+// ResetURI resets all changes to the "uri" field.
+func (m *ArtistMutation) ResetURI() {
+	m.uri = nil
+}
+
+// SetName sets the "name" field.
+func (m *ArtistMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ArtistMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Artist entity.
+// If the Artist object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArtistMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ArtistMutation) ResetName() {
+	m.name = nil
+}
+
+// SetGenres sets the "genres" field.
+func (m *ArtistMutation) SetGenres(s []string) {
+	m.genres = &s
+	m.appendgenres = nil
+}
+
+// Genres returns the value of the "genres" field in the mutation.
+func (m *ArtistMutation) Genres() (r []string, exists bool) {
+	v := m.genres
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGenres returns the old "genres" field's value of the Artist entity.
+// If the Artist object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArtistMutation) OldGenres(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGenres is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGenres requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGenres: %w", err)
+	}
+	return oldValue.Genres, nil
+}
+
+// AppendGenres adds s to the "genres" field.
+func (m *ArtistMutation) AppendGenres(s []string) {
+	m.appendgenres = append(m.appendgenres, s...)
+}
+
+// AppendedGenres returns the list of values that were appended to the "genres" field in this mutation.
+func (m *ArtistMutation) AppendedGenres() ([]string, bool) {
+	if len(m.appendgenres) == 0 {
+		return nil, false
+	}
+	return m.appendgenres, true
+}
+
+// ClearGenres clears the value of the "genres" field.
+func (m *ArtistMutation) ClearGenres() {
+	m.genres = nil
+	m.appendgenres = nil
+	m.clearedFields[artist.FieldGenres] = struct{}{}
+}
+
+// GenresCleared returns if the "genres" field was cleared in this mutation.
+func (m *ArtistMutation) GenresCleared() bool {
+	_, ok := m.clearedFields[artist.FieldGenres]
+	return ok
+}
+
+// ResetGenres resets all changes to the "genres" field.
+func (m *ArtistMutation) ResetGenres() {
+	m.genres = nil
+	m.appendgenres = nil
+	delete(m.clearedFields, artist.FieldGenres)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ArtistMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ArtistMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Artist entity.
+// If the Artist object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArtistMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ArtistMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// AddTrackIDs adds the "tracks" edge to the Track entity by ids.
+func (m *ArtistMutation) AddTrackIDs(ids ...string) {
+	if m.tracks == nil {
+		m.tracks = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.tracks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTracks clears the "tracks" edge to the Track entity.
+func (m *ArtistMutation) ClearTracks() {
+	m.clearedtracks = true
+}
+
+// TracksCleared reports if the "tracks" edge to the Track entity was cleared.
+func (m *ArtistMutation) TracksCleared() bool {
+	return m.clearedtracks
+}
+
+// RemoveTrackIDs removes the "tracks" edge to the Track entity by IDs.
+func (m *ArtistMutation) RemoveTrackIDs(ids ...string) {
+	if m.removedtracks == nil {
+		m.removedtracks = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.tracks, ids[i])
+		m.removedtracks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTracks returns the removed IDs of the "tracks" edge to the Track entity.
+func (m *ArtistMutation) RemovedTracksIDs() (ids []string) {
+	for id := range m.removedtracks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TracksIDs returns the "tracks" edge IDs in the mutation.
+func (m *ArtistMutation) TracksIDs() (ids []string) {
+	for id := range m.tracks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTracks resets all changes to the "tracks" edge.
+func (m *ArtistMutation) ResetTracks() {
+	m.tracks = nil
+	m.clearedtracks = false
+	m.removedtracks = nil
+}
+
+// AddAlbumIDs adds the "albums" edge to the Album entity by ids.
+func (m *ArtistMutation) AddAlbumIDs(ids ...string) {
+	if m.albums == nil {
+		m.albums = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.albums[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAlbums clears the "albums" edge to the Album entity.
+func (m *ArtistMutation) ClearAlbums() {
+	m.clearedalbums = true
+}
+
+// AlbumsCleared reports if the "albums" edge to the Album entity was cleared.
+func (m *ArtistMutation) AlbumsCleared() bool {
+	return m.clearedalbums
+}
+
+// RemoveAlbumIDs removes the "albums" edge to the Album entity by IDs.
+func (m *ArtistMutation) RemoveAlbumIDs(ids ...string) {
+	if m.removedalbums == nil {
+		m.removedalbums = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.albums, ids[i])
+		m.removedalbums[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAlbums returns the removed IDs of the "albums" edge to the Album entity.
+func (m *ArtistMutation) RemovedAlbumsIDs() (ids []string) {
+	for id := range m.removedalbums {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AlbumsIDs returns the "albums" edge IDs in the mutation.
+func (m *ArtistMutation) AlbumsIDs() (ids []string) {
+	for id := range m.albums {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAlbums resets all changes to the "albums" edge.
+func (m *ArtistMutation) ResetAlbums() {
+	m.albums = nil
+	m.clearedalbums = false
+	m.removedalbums = nil
+}
+
+// AddFollowedArtistIDs adds the "followed_artists" edge to the FollowedArtist entity by ids.
+func (m *ArtistMutation) AddFollowedArtistIDs(ids ...string) {
+	if m.followed_artists == nil {
+		m.followed_artists = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.followed_artists[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFollowedArtists clears the "followed_artists" edge to the FollowedArtist entity.
+func (m *ArtistMutation) ClearFollowedArtists() {
+	m.clearedfollowed_artists = true
+}
+
+// FollowedArtistsCleared reports if the "followed_artists" edge to the FollowedArtist entity was cleared.
+func (m *ArtistMutation) FollowedArtistsCleared() bool {
+	return m.clearedfollowed_artists
+}
+
+// RemoveFollowedArtistIDs removes the "followed_artists" edge to the FollowedArtist entity by IDs.
+func (m *ArtistMutation) RemoveFollowedArtistIDs(ids ...string) {
+	if m.removedfollowed_artists == nil {
+		m.removedfollowed_artists = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.followed_artists, ids[i])
+		m.removedfollowed_artists[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFollowedArtists returns the removed IDs of the "followed_artists" edge to the FollowedArtist entity.
+func (m *ArtistMutation) RemovedFollowedArtistsIDs() (ids []string) {
+	for id := range m.removedfollowed_artists {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FollowedArtistsIDs returns the "followed_artists" edge IDs in the mutation.
+func (m *ArtistMutation) FollowedArtistsIDs() (ids []string) {
+	for id := range m.followed_artists {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFollowedArtists resets all changes to the "followed_artists" edge.
+func (m *ArtistMutation) ResetFollowedArtists() {
+	m.followed_artists = nil
+	m.clearedfollowed_artists = false
+	m.removedfollowed_artists = nil
+}
+
+// Where appends a list predicates to the ArtistMutation builder.
+func (m *ArtistMutation) Where(ps ...predicate.Artist) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ArtistMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ArtistMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Artist, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ArtistMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ArtistMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Artist).
+func (m *ArtistMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ArtistMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.spotify_id != nil {
+		fields = append(fields, artist.FieldSpotifyID)
+	}
+	if m.uri != nil {
+		fields = append(fields, artist.FieldURI)
+	}
+	if m.name != nil {
+		fields = append(fields, artist.FieldName)
+	}
+	if m.genres != nil {
+		fields = append(fields, artist.FieldGenres)
+	}
+	if m.created_at != nil {
+		fields = append(fields, artist.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ArtistMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case artist.FieldSpotifyID:
+		return m.SpotifyID()
+	case artist.FieldURI:
+		return m.URI()
+	case artist.FieldName:
+		return m.Name()
+	case artist.FieldGenres:
+		return m.Genres()
+	case artist.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ArtistMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case artist.FieldSpotifyID:
+		return m.OldSpotifyID(ctx)
+	case artist.FieldURI:
+		return m.OldURI(ctx)
+	case artist.FieldName:
+		return m.OldName(ctx)
+	case artist.FieldGenres:
+		return m.OldGenres(ctx)
+	case artist.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Artist field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ArtistMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case artist.FieldSpotifyID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpotifyID(v)
+		return nil
+	case artist.FieldURI:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURI(v)
+		return nil
+	case artist.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case artist.FieldGenres:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGenres(v)
+		return nil
+	case artist.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Artist field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ArtistMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ArtistMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ArtistMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Artist numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ArtistMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(artist.FieldGenres) {
+		fields = append(fields, artist.FieldGenres)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ArtistMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ArtistMutation) ClearField(name string) error {
+	switch name {
+	case artist.FieldGenres:
+		m.ClearGenres()
+		return nil
+	}
+	return fmt.Errorf("unknown Artist nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ArtistMutation) ResetField(name string) error {
+	switch name {
+	case artist.FieldSpotifyID:
+		m.ResetSpotifyID()
+		return nil
+	case artist.FieldURI:
+		m.ResetURI()
+		return nil
+	case artist.FieldName:
+		m.ResetName()
+		return nil
+	case artist.FieldGenres:
+		m.ResetGenres()
+		return nil
+	case artist.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Artist field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ArtistMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.tracks != nil {
+		edges = append(edges, artist.EdgeTracks)
+	}
+	if m.albums != nil {
+		edges = append(edges, artist.EdgeAlbums)
+	}
+	if m.followed_artists != nil {
+		edges = append(edges, artist.EdgeFollowedArtists)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ArtistMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case artist.EdgeTracks:
+		ids := make([]ent.Value, 0, len(m.tracks))
+		for id := range m.tracks {
+			ids = append(ids, id)
+		}
+		return ids
+	case artist.EdgeAlbums:
+		ids := make([]ent.Value, 0, len(m.albums))
+		for id := range m.albums {
+			ids = append(ids, id)
+		}
+		return ids
+	case artist.EdgeFollowedArtists:
+		ids := make([]ent.Value, 0, len(m.followed_artists))
+		for id := range m.followed_artists {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ArtistMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedtracks != nil {
+		edges = append(edges, artist.EdgeTracks)
+	}
+	if m.removedalbums != nil {
+		edges = append(edges, artist.EdgeAlbums)
+	}
+	if m.removedfollowed_artists != nil {
+		edges = append(edges, artist.EdgeFollowedArtists)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ArtistMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case artist.EdgeTracks:
+		ids := make([]ent.Value, 0, len(m.removedtracks))
+		for id := range m.removedtracks {
+			ids = append(ids, id)
+		}
+		return ids
+	case artist.EdgeAlbums:
+		ids := make([]ent.Value, 0, len(m.removedalbums))
+		for id := range m.removedalbums {
+			ids = append(ids, id)
+		}
+		return ids
+	case artist.EdgeFollowedArtists:
+		ids := make([]ent.Value, 0, len(m.removedfollowed_artists))
+		for id := range m.removedfollowed_artists {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ArtistMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedtracks {
+		edges = append(edges, artist.EdgeTracks)
+	}
+	if m.clearedalbums {
+		edges = append(edges, artist.EdgeAlbums)
+	}
+	if m.clearedfollowed_artists {
+		edges = append(edges, artist.EdgeFollowedArtists)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ArtistMutation) EdgeCleared(name string) bool {
+	switch name {
+	case artist.EdgeTracks:
+		return m.clearedtracks
+	case artist.EdgeAlbums:
+		return m.clearedalbums
+	case artist.EdgeFollowedArtists:
+		return m.clearedfollowed_artists
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ArtistMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Artist unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ArtistMutation) ResetEdge(name string) error {
+	switch name {
+	case artist.EdgeTracks:
+		m.ResetTracks()
+		return nil
+	case artist.EdgeAlbums:
+		m.ResetAlbums()
+		return nil
+	case artist.EdgeFollowedArtists:
+		m.ResetFollowedArtists()
+		return nil
+	}
+	return fmt.Errorf("unknown Artist edge %s", name)
+}
+
+// AuthStateMutation represents an operation that mutates the AuthState nodes in the graph.
+type AuthStateMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	state         *string
+	expires_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*AuthState, error)
+	predicates    []predicate.AuthState
+}
+
+var _ ent.Mutation = (*AuthStateMutation)(nil)
+
+// authstateOption allows management of the mutation configuration using functional options.
+type authstateOption func(*AuthStateMutation)
+
+// newAuthStateMutation creates new mutation for the AuthState entity.
+func newAuthStateMutation(c config, op Op, opts ...authstateOption) *AuthStateMutation {
+	m := &AuthStateMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeAuthState,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withAuthStateID sets the ID field of the mutation.
+func withAuthStateID(id string) authstateOption {
+	return func(m *AuthStateMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *AuthState
+		)
+		m.oldValue = func(ctx context.Context) (*AuthState, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().AuthState.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withAuthState sets the old AuthState of the mutation.
+func withAuthState(node *AuthState) authstateOption {
+	return func(m *AuthStateMutation) {
+		m.oldValue = func(context.Context) (*AuthState, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m AuthStateMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m AuthStateMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of AuthState entities.
+func (m *AuthStateMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *AuthStateMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *AuthStateMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().AuthState.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetState sets the "state" field.
+func (m *AuthStateMutation) SetState(s string) {
+	m.state = &s
+}
+
+// State returns the value of the "state" field in the mutation.
+func (m *AuthStateMutation) State() (r string, exists bool) {
+	v := m.state
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldState returns the old "state" field's value of the AuthState entity.
+// If the AuthState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthStateMutation) OldState(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldState is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldState requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldState: %w", err)
+	}
+	return oldValue.State, nil
+}
+
+// ResetState resets all changes to the "state" field.
+func (m *AuthStateMutation) ResetState() {
+	m.state = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *AuthStateMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *AuthStateMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the AuthState entity.
+// If the AuthState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthStateMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *AuthStateMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// Where appends a list predicates to the AuthStateMutation builder.
+func (m *AuthStateMutation) Where(ps ...predicate.AuthState) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AuthStateMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AuthStateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuthState, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AuthStateMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AuthStateMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (AuthState).
+func (m *AuthStateMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AuthStateMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.state != nil {
+		fields = append(fields, authstate.FieldState)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, authstate.FieldExpiresAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AuthStateMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case authstate.FieldState:
+		return m.State()
+	case authstate.FieldExpiresAt:
+		return m.ExpiresAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AuthStateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case authstate.FieldState:
+		return m.OldState(ctx)
+	case authstate.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown AuthState field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuthStateMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case authstate.FieldState:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetState(v)
+		return nil
+	case authstate.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AuthState field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AuthStateMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AuthStateMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuthStateMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown AuthState numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AuthStateMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AuthStateMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AuthStateMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown AuthState nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AuthStateMutation) ResetField(name string) error {
+	switch name {
+	case authstate.FieldState:
+		m.ResetState()
+		return nil
+	case authstate.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown AuthState field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AuthStateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AuthStateMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AuthStateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AuthStateMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AuthStateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AuthStateMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AuthStateMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AuthState unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AuthStateMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AuthState edge %s", name)
+}
+
+// BackupRunMutation represents an operation that mutates the BackupRun nodes in the graph.
+type BackupRunMutation struct {
+	config
+	op                        Op
+	typ                       string
+	id                        *string
+	user_id                   *string
+	trigger                   *backuprun.Trigger
+	job_id                    *string
+	status                    *backuprun.Status
+	collections               *map[string]schematype.CollectionResult
+	api_calls                 *int
+	addapi_calls              *int
+	error                     *string
+	checkpoint                *schematype.Checkpoint
+	resumes                   *int
+	addresumes                *int
+	pinned                    *bool
+	note                      *string
+	labels                    *[]string
+	appendlabels              []string
+	started_at                *time.Time
+	finished_at               *time.Time
+	clearedFields             map[string]struct{}
+	playlist_snapshots        map[string]struct{}
+	removedplaylist_snapshots map[string]struct{}
+	clearedplaylist_snapshots bool
+	saved_tracks              map[string]struct{}
+	removedsaved_tracks       map[string]struct{}
+	clearedsaved_tracks       bool
+	saved_albums              map[string]struct{}
+	removedsaved_albums       map[string]struct{}
+	clearedsaved_albums       bool
+	saved_shows               map[string]struct{}
+	removedsaved_shows        map[string]struct{}
+	clearedsaved_shows        bool
+	saved_episodes            map[string]struct{}
+	removedsaved_episodes     map[string]struct{}
+	clearedsaved_episodes     bool
+	saved_audiobooks          map[string]struct{}
+	removedsaved_audiobooks   map[string]struct{}
+	clearedsaved_audiobooks   bool
+	followed_artists          map[string]struct{}
+	removedfollowed_artists   map[string]struct{}
+	clearedfollowed_artists   bool
+	done                      bool
+	oldValue                  func(context.Context) (*BackupRun, error)
+	predicates                []predicate.BackupRun
+}
+
+var _ ent.Mutation = (*BackupRunMutation)(nil)
+
+// backuprunOption allows management of the mutation configuration using functional options.
+type backuprunOption func(*BackupRunMutation)
+
+// newBackupRunMutation creates new mutation for the BackupRun entity.
+func newBackupRunMutation(c config, op Op, opts ...backuprunOption) *BackupRunMutation {
+	m := &BackupRunMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBackupRun,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBackupRunID sets the ID field of the mutation.
+func withBackupRunID(id string) backuprunOption {
+	return func(m *BackupRunMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BackupRun
+		)
+		m.oldValue = func(ctx context.Context) (*BackupRun, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BackupRun.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBackupRun sets the old BackupRun of the mutation.
+func withBackupRun(node *BackupRun) backuprunOption {
+	return func(m *BackupRunMutation) {
+		m.oldValue = func(context.Context) (*BackupRun, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BackupRunMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BackupRunMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BackupRun entities.
+func (m *BackupRunMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BackupRunMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BackupRunMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BackupRun.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *BackupRunMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *BackupRunMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the BackupRun entity.
+// If the BackupRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupRunMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *BackupRunMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetTrigger sets the "trigger" field.
+func (m *BackupRunMutation) SetTrigger(b backuprun.Trigger) {
+	m.trigger = &b
+}
+
+// Trigger returns the value of the "trigger" field in the mutation.
+func (m *BackupRunMutation) Trigger() (r backuprun.Trigger, exists bool) {
+	v := m.trigger
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrigger returns the old "trigger" field's value of the BackupRun entity.
+// If the BackupRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupRunMutation) OldTrigger(ctx context.Context) (v backuprun.Trigger, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrigger is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrigger requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrigger: %w", err)
+	}
+	return oldValue.Trigger, nil
+}
+
+// ResetTrigger resets all changes to the "trigger" field.
+func (m *BackupRunMutation) ResetTrigger() {
+	m.trigger = nil
+}
+
+// SetJobID sets the "job_id" field.
+func (m *BackupRunMutation) SetJobID(s string) {
+	m.job_id = &s
+}
+
+// JobID returns the value of the "job_id" field in the mutation.
+func (m *BackupRunMutation) JobID() (r string, exists bool) {
+	v := m.job_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJobID returns the old "job_id" field's value of the BackupRun entity.
+// If the BackupRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupRunMutation) OldJobID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJobID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJobID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJobID: %w", err)
+	}
+	return oldValue.JobID, nil
+}
+
+// ResetJobID resets all changes to the "job_id" field.
+func (m *BackupRunMutation) ResetJobID() {
+	m.job_id = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *BackupRunMutation) SetStatus(b backuprun.Status) {
+	m.status = &b
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *BackupRunMutation) Status() (r backuprun.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the BackupRun entity.
+// If the BackupRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupRunMutation) OldStatus(ctx context.Context) (v backuprun.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *BackupRunMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetCollections sets the "collections" field.
+func (m *BackupRunMutation) SetCollections(mr map[string]schematype.CollectionResult) {
+	m.collections = &mr
+}
+
+// Collections returns the value of the "collections" field in the mutation.
+func (m *BackupRunMutation) Collections() (r map[string]schematype.CollectionResult, exists bool) {
+	v := m.collections
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollections returns the old "collections" field's value of the BackupRun entity.
+// If the BackupRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupRunMutation) OldCollections(ctx context.Context) (v map[string]schematype.CollectionResult, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollections is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollections requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollections: %w", err)
+	}
+	return oldValue.Collections, nil
+}
+
+// ResetCollections resets all changes to the "collections" field.
+func (m *BackupRunMutation) ResetCollections() {
+	m.collections = nil
+}
+
+// SetAPICalls sets the "api_calls" field.
+func (m *BackupRunMutation) SetAPICalls(i int) {
+	m.api_calls = &i
+	m.addapi_calls = nil
+}
+
+// APICalls returns the value of the "api_calls" field in the mutation.
+func (m *BackupRunMutation) APICalls() (r int, exists bool) {
+	v := m.api_calls
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAPICalls returns the old "api_calls" field's value of the BackupRun entity.
+// If the BackupRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupRunMutation) OldAPICalls(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAPICalls is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAPICalls requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAPICalls: %w", err)
+	}
+	return oldValue.APICalls, nil
+}
+
+// AddAPICalls adds i to the "api_calls" field.
+func (m *BackupRunMutation) AddAPICalls(i int) {
+	if m.addapi_calls != nil {
+		*m.addapi_calls += i
+	} else {
+		m.addapi_calls = &i
+	}
+}
+
+// AddedAPICalls returns the value that was added to the "api_calls" field in this mutation.
+func (m *BackupRunMutation) AddedAPICalls() (r int, exists bool) {
+	v := m.addapi_calls
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAPICalls resets all changes to the "api_calls" field.
+func (m *BackupRunMutation) ResetAPICalls() {
+	m.api_calls = nil
+	m.addapi_calls = nil
+}
+
+// SetError sets the "error" field.
+func (m *BackupRunMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *BackupRunMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the BackupRun entity.
+// If the BackupRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupRunMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *BackupRunMutation) ResetError() {
+	m.error = nil
+}
+
+// SetCheckpoint sets the "checkpoint" field.
+func (m *BackupRunMutation) SetCheckpoint(s schematype.Checkpoint) {
+	m.checkpoint = &s
+}
+
+// Checkpoint returns the value of the "checkpoint" field in the mutation.
+func (m *BackupRunMutation) Checkpoint() (r schematype.Checkpoint, exists bool) {
+	v := m.checkpoint
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCheckpoint returns the old "checkpoint" field's value of the BackupRun entity.
+// If the BackupRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupRunMutation) OldCheckpoint(ctx context.Context) (v schematype.Checkpoint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCheckpoint is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCheckpoint requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCheckpoint: %w", err)
+	}
+	return oldValue.Checkpoint, nil
+}
+
+// ClearCheckpoint clears the value of the "checkpoint" field.
+func (m *BackupRunMutation) ClearCheckpoint() {
+	m.checkpoint = nil
+	m.clearedFields[backuprun.FieldCheckpoint] = struct{}{}
+}
+
+// CheckpointCleared returns if the "checkpoint" field was cleared in this mutation.
+func (m *BackupRunMutation) CheckpointCleared() bool {
+	_, ok := m.clearedFields[backuprun.FieldCheckpoint]
+	return ok
+}
+
+// ResetCheckpoint resets all changes to the "checkpoint" field.
+func (m *BackupRunMutation) ResetCheckpoint() {
+	m.checkpoint = nil
+	delete(m.clearedFields, backuprun.FieldCheckpoint)
+}
+
+// SetResumes sets the "resumes" field.
+func (m *BackupRunMutation) SetResumes(i int) {
+	m.resumes = &i
+	m.addresumes = nil
+}
+
+// Resumes returns the value of the "resumes" field in the mutation.
+func (m *BackupRunMutation) Resumes() (r int, exists bool) {
+	v := m.resumes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResumes returns the old "resumes" field's value of the BackupRun entity.
+// If the BackupRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupRunMutation) OldResumes(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResumes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResumes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResumes: %w", err)
+	}
+	return oldValue.Resumes, nil
+}
+
+// AddResumes adds i to the "resumes" field.
+func (m *BackupRunMutation) AddResumes(i int) {
+	if m.addresumes != nil {
+		*m.addresumes += i
+	} else {
+		m.addresumes = &i
+	}
+}
+
+// AddedResumes returns the value that was added to the "resumes" field in this mutation.
+func (m *BackupRunMutation) AddedResumes() (r int, exists bool) {
+	v := m.addresumes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetResumes resets all changes to the "resumes" field.
+func (m *BackupRunMutation) ResetResumes() {
+	m.resumes = nil
+	m.addresumes = nil
+}
+
+// SetPinned sets the "pinned" field.
+func (m *BackupRunMutation) SetPinned(b bool) {
+	m.pinned = &b
+}
+
+// Pinned returns the value of the "pinned" field in the mutation.
+func (m *BackupRunMutation) Pinned() (r bool, exists bool) {
+	v := m.pinned
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPinned returns the old "pinned" field's value of the BackupRun entity.
+// If the BackupRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupRunMutation) OldPinned(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPinned is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPinned requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPinned: %w", err)
+	}
+	return oldValue.Pinned, nil
+}
+
+// ResetPinned resets all changes to the "pinned" field.
+func (m *BackupRunMutation) ResetPinned() {
+	m.pinned = nil
+}
+
+// SetNote sets the "note" field.
+func (m *BackupRunMutation) SetNote(s string) {
+	m.note = &s
+}
+
+// Note returns the value of the "note" field in the mutation.
+func (m *BackupRunMutation) Note() (r string, exists bool) {
+	v := m.note
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNote returns the old "note" field's value of the BackupRun entity.
+// If the BackupRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupRunMutation) OldNote(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNote is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNote requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNote: %w", err)
+	}
+	return oldValue.Note, nil
+}
+
+// ResetNote resets all changes to the "note" field.
+func (m *BackupRunMutation) ResetNote() {
+	m.note = nil
+}
+
+// SetLabels sets the "labels" field.
+func (m *BackupRunMutation) SetLabels(s []string) {
+	m.labels = &s
+	m.appendlabels = nil
+}
+
+// Labels returns the value of the "labels" field in the mutation.
+func (m *BackupRunMutation) Labels() (r []string, exists bool) {
+	v := m.labels
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabels returns the old "labels" field's value of the BackupRun entity.
+// If the BackupRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupRunMutation) OldLabels(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabels is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabels requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabels: %w", err)
+	}
+	return oldValue.Labels, nil
+}
+
+// AppendLabels adds s to the "labels" field.
+func (m *BackupRunMutation) AppendLabels(s []string) {
+	m.appendlabels = append(m.appendlabels, s...)
+}
+
+// AppendedLabels returns the list of values that were appended to the "labels" field in this mutation.
+func (m *BackupRunMutation) AppendedLabels() ([]string, bool) {
+	if len(m.appendlabels) == 0 {
+		return nil, false
+	}
+	return m.appendlabels, true
+}
+
+// ResetLabels resets all changes to the "labels" field.
+func (m *BackupRunMutation) ResetLabels() {
+	m.labels = nil
+	m.appendlabels = nil
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *BackupRunMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *BackupRunMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the BackupRun entity.
+// If the BackupRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupRunMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *BackupRunMutation) ResetStartedAt() {
+	m.started_at = nil
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (m *BackupRunMutation) SetFinishedAt(t time.Time) {
+	m.finished_at = &t
+}
+
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *BackupRunMutation) FinishedAt() (r time.Time, exists bool) {
+	v := m.finished_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFinishedAt returns the old "finished_at" field's value of the BackupRun entity.
+// If the BackupRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupRunMutation) OldFinishedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
+	}
+	return oldValue.FinishedAt, nil
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (m *BackupRunMutation) ClearFinishedAt() {
+	m.finished_at = nil
+	m.clearedFields[backuprun.FieldFinishedAt] = struct{}{}
+}
+
+// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
+func (m *BackupRunMutation) FinishedAtCleared() bool {
+	_, ok := m.clearedFields[backuprun.FieldFinishedAt]
+	return ok
+}
+
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *BackupRunMutation) ResetFinishedAt() {
+	m.finished_at = nil
+	delete(m.clearedFields, backuprun.FieldFinishedAt)
+}
+
+// AddPlaylistSnapshotIDs adds the "playlist_snapshots" edge to the PlaylistSnapshot entity by ids.
+func (m *BackupRunMutation) AddPlaylistSnapshotIDs(ids ...string) {
+	if m.playlist_snapshots == nil {
+		m.playlist_snapshots = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.playlist_snapshots[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPlaylistSnapshots clears the "playlist_snapshots" edge to the PlaylistSnapshot entity.
+func (m *BackupRunMutation) ClearPlaylistSnapshots() {
+	m.clearedplaylist_snapshots = true
+}
+
+// PlaylistSnapshotsCleared reports if the "playlist_snapshots" edge to the PlaylistSnapshot entity was cleared.
+func (m *BackupRunMutation) PlaylistSnapshotsCleared() bool {
+	return m.clearedplaylist_snapshots
+}
+
+// RemovePlaylistSnapshotIDs removes the "playlist_snapshots" edge to the PlaylistSnapshot entity by IDs.
+func (m *BackupRunMutation) RemovePlaylistSnapshotIDs(ids ...string) {
+	if m.removedplaylist_snapshots == nil {
+		m.removedplaylist_snapshots = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.playlist_snapshots, ids[i])
+		m.removedplaylist_snapshots[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPlaylistSnapshots returns the removed IDs of the "playlist_snapshots" edge to the PlaylistSnapshot entity.
+func (m *BackupRunMutation) RemovedPlaylistSnapshotsIDs() (ids []string) {
+	for id := range m.removedplaylist_snapshots {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PlaylistSnapshotsIDs returns the "playlist_snapshots" edge IDs in the mutation.
+func (m *BackupRunMutation) PlaylistSnapshotsIDs() (ids []string) {
+	for id := range m.playlist_snapshots {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPlaylistSnapshots resets all changes to the "playlist_snapshots" edge.
+func (m *BackupRunMutation) ResetPlaylistSnapshots() {
+	m.playlist_snapshots = nil
+	m.clearedplaylist_snapshots = false
+	m.removedplaylist_snapshots = nil
+}
+
+// AddSavedTrackIDs adds the "saved_tracks" edge to the SavedTrack entity by ids.
+func (m *BackupRunMutation) AddSavedTrackIDs(ids ...string) {
+	if m.saved_tracks == nil {
+		m.saved_tracks = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.saved_tracks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSavedTracks clears the "saved_tracks" edge to the SavedTrack entity.
+func (m *BackupRunMutation) ClearSavedTracks() {
+	m.clearedsaved_tracks = true
+}
+
+// SavedTracksCleared reports if the "saved_tracks" edge to the SavedTrack entity was cleared.
+func (m *BackupRunMutation) SavedTracksCleared() bool {
+	return m.clearedsaved_tracks
+}
+
+// RemoveSavedTrackIDs removes the "saved_tracks" edge to the SavedTrack entity by IDs.
+func (m *BackupRunMutation) RemoveSavedTrackIDs(ids ...string) {
+	if m.removedsaved_tracks == nil {
+		m.removedsaved_tracks = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.saved_tracks, ids[i])
+		m.removedsaved_tracks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSavedTracks returns the removed IDs of the "saved_tracks" edge to the SavedTrack entity.
+func (m *BackupRunMutation) RemovedSavedTracksIDs() (ids []string) {
+	for id := range m.removedsaved_tracks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SavedTracksIDs returns the "saved_tracks" edge IDs in the mutation.
+func (m *BackupRunMutation) SavedTracksIDs() (ids []string) {
+	for id := range m.saved_tracks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSavedTracks resets all changes to the "saved_tracks" edge.
+func (m *BackupRunMutation) ResetSavedTracks() {
+	m.saved_tracks = nil
+	m.clearedsaved_tracks = false
+	m.removedsaved_tracks = nil
+}
+
+// AddSavedAlbumIDs adds the "saved_albums" edge to the SavedAlbum entity by ids.
+func (m *BackupRunMutation) AddSavedAlbumIDs(ids ...string) {
+	if m.saved_albums == nil {
+		m.saved_albums = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.saved_albums[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSavedAlbums clears the "saved_albums" edge to the SavedAlbum entity.
+func (m *BackupRunMutation) ClearSavedAlbums() {
+	m.clearedsaved_albums = true
+}
+
+// SavedAlbumsCleared reports if the "saved_albums" edge to the SavedAlbum entity was cleared.
+func (m *BackupRunMutation) SavedAlbumsCleared() bool {
+	return m.clearedsaved_albums
+}
+
+// RemoveSavedAlbumIDs removes the "saved_albums" edge to the SavedAlbum entity by IDs.
+func (m *BackupRunMutation) RemoveSavedAlbumIDs(ids ...string) {
+	if m.removedsaved_albums == nil {
+		m.removedsaved_albums = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.saved_albums, ids[i])
+		m.removedsaved_albums[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSavedAlbums returns the removed IDs of the "saved_albums" edge to the SavedAlbum entity.
+func (m *BackupRunMutation) RemovedSavedAlbumsIDs() (ids []string) {
+	for id := range m.removedsaved_albums {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SavedAlbumsIDs returns the "saved_albums" edge IDs in the mutation.
+func (m *BackupRunMutation) SavedAlbumsIDs() (ids []string) {
+	for id := range m.saved_albums {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSavedAlbums resets all changes to the "saved_albums" edge.
+func (m *BackupRunMutation) ResetSavedAlbums() {
+	m.saved_albums = nil
+	m.clearedsaved_albums = false
+	m.removedsaved_albums = nil
+}
+
+// AddSavedShowIDs adds the "saved_shows" edge to the SavedShow entity by ids.
+func (m *BackupRunMutation) AddSavedShowIDs(ids ...string) {
+	if m.saved_shows == nil {
+		m.saved_shows = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.saved_shows[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSavedShows clears the "saved_shows" edge to the SavedShow entity.
+func (m *BackupRunMutation) ClearSavedShows() {
+	m.clearedsaved_shows = true
+}
+
+// SavedShowsCleared reports if the "saved_shows" edge to the SavedShow entity was cleared.
+func (m *BackupRunMutation) SavedShowsCleared() bool {
+	return m.clearedsaved_shows
+}
+
+// RemoveSavedShowIDs removes the "saved_shows" edge to the SavedShow entity by IDs.
+func (m *BackupRunMutation) RemoveSavedShowIDs(ids ...string) {
+	if m.removedsaved_shows == nil {
+		m.removedsaved_shows = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.saved_shows, ids[i])
+		m.removedsaved_shows[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSavedShows returns the removed IDs of the "saved_shows" edge to the SavedShow entity.
+func (m *BackupRunMutation) RemovedSavedShowsIDs() (ids []string) {
+	for id := range m.removedsaved_shows {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SavedShowsIDs returns the "saved_shows" edge IDs in the mutation.
+func (m *BackupRunMutation) SavedShowsIDs() (ids []string) {
+	for id := range m.saved_shows {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSavedShows resets all changes to the "saved_shows" edge.
+func (m *BackupRunMutation) ResetSavedShows() {
+	m.saved_shows = nil
+	m.clearedsaved_shows = false
+	m.removedsaved_shows = nil
+}
+
+// AddSavedEpisodeIDs adds the "saved_episodes" edge to the SavedEpisode entity by ids.
+func (m *BackupRunMutation) AddSavedEpisodeIDs(ids ...string) {
+	if m.saved_episodes == nil {
+		m.saved_episodes = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.saved_episodes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSavedEpisodes clears the "saved_episodes" edge to the SavedEpisode entity.
+func (m *BackupRunMutation) ClearSavedEpisodes() {
+	m.clearedsaved_episodes = true
+}
+
+// SavedEpisodesCleared reports if the "saved_episodes" edge to the SavedEpisode entity was cleared.
+func (m *BackupRunMutation) SavedEpisodesCleared() bool {
+	return m.clearedsaved_episodes
+}
+
+// RemoveSavedEpisodeIDs removes the "saved_episodes" edge to the SavedEpisode entity by IDs.
+func (m *BackupRunMutation) RemoveSavedEpisodeIDs(ids ...string) {
+	if m.removedsaved_episodes == nil {
+		m.removedsaved_episodes = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.saved_episodes, ids[i])
+		m.removedsaved_episodes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSavedEpisodes returns the removed IDs of the "saved_episodes" edge to the SavedEpisode entity.
+func (m *BackupRunMutation) RemovedSavedEpisodesIDs() (ids []string) {
+	for id := range m.removedsaved_episodes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SavedEpisodesIDs returns the "saved_episodes" edge IDs in the mutation.
+func (m *BackupRunMutation) SavedEpisodesIDs() (ids []string) {
+	for id := range m.saved_episodes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSavedEpisodes resets all changes to the "saved_episodes" edge.
+func (m *BackupRunMutation) ResetSavedEpisodes() {
+	m.saved_episodes = nil
+	m.clearedsaved_episodes = false
+	m.removedsaved_episodes = nil
+}
+
+// AddSavedAudiobookIDs adds the "saved_audiobooks" edge to the SavedAudiobook entity by ids.
+func (m *BackupRunMutation) AddSavedAudiobookIDs(ids ...string) {
+	if m.saved_audiobooks == nil {
+		m.saved_audiobooks = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.saved_audiobooks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSavedAudiobooks clears the "saved_audiobooks" edge to the SavedAudiobook entity.
+func (m *BackupRunMutation) ClearSavedAudiobooks() {
+	m.clearedsaved_audiobooks = true
+}
+
+// SavedAudiobooksCleared reports if the "saved_audiobooks" edge to the SavedAudiobook entity was cleared.
+func (m *BackupRunMutation) SavedAudiobooksCleared() bool {
+	return m.clearedsaved_audiobooks
+}
+
+// RemoveSavedAudiobookIDs removes the "saved_audiobooks" edge to the SavedAudiobook entity by IDs.
+func (m *BackupRunMutation) RemoveSavedAudiobookIDs(ids ...string) {
+	if m.removedsaved_audiobooks == nil {
+		m.removedsaved_audiobooks = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.saved_audiobooks, ids[i])
+		m.removedsaved_audiobooks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSavedAudiobooks returns the removed IDs of the "saved_audiobooks" edge to the SavedAudiobook entity.
+func (m *BackupRunMutation) RemovedSavedAudiobooksIDs() (ids []string) {
+	for id := range m.removedsaved_audiobooks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SavedAudiobooksIDs returns the "saved_audiobooks" edge IDs in the mutation.
+func (m *BackupRunMutation) SavedAudiobooksIDs() (ids []string) {
+	for id := range m.saved_audiobooks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSavedAudiobooks resets all changes to the "saved_audiobooks" edge.
+func (m *BackupRunMutation) ResetSavedAudiobooks() {
+	m.saved_audiobooks = nil
+	m.clearedsaved_audiobooks = false
+	m.removedsaved_audiobooks = nil
+}
+
+// AddFollowedArtistIDs adds the "followed_artists" edge to the FollowedArtist entity by ids.
+func (m *BackupRunMutation) AddFollowedArtistIDs(ids ...string) {
+	if m.followed_artists == nil {
+		m.followed_artists = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.followed_artists[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFollowedArtists clears the "followed_artists" edge to the FollowedArtist entity.
+func (m *BackupRunMutation) ClearFollowedArtists() {
+	m.clearedfollowed_artists = true
+}
+
+// FollowedArtistsCleared reports if the "followed_artists" edge to the FollowedArtist entity was cleared.
+func (m *BackupRunMutation) FollowedArtistsCleared() bool {
+	return m.clearedfollowed_artists
+}
+
+// RemoveFollowedArtistIDs removes the "followed_artists" edge to the FollowedArtist entity by IDs.
+func (m *BackupRunMutation) RemoveFollowedArtistIDs(ids ...string) {
+	if m.removedfollowed_artists == nil {
+		m.removedfollowed_artists = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.followed_artists, ids[i])
+		m.removedfollowed_artists[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFollowedArtists returns the removed IDs of the "followed_artists" edge to the FollowedArtist entity.
+func (m *BackupRunMutation) RemovedFollowedArtistsIDs() (ids []string) {
+	for id := range m.removedfollowed_artists {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FollowedArtistsIDs returns the "followed_artists" edge IDs in the mutation.
+func (m *BackupRunMutation) FollowedArtistsIDs() (ids []string) {
+	for id := range m.followed_artists {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFollowedArtists resets all changes to the "followed_artists" edge.
+func (m *BackupRunMutation) ResetFollowedArtists() {
+	m.followed_artists = nil
+	m.clearedfollowed_artists = false
+	m.removedfollowed_artists = nil
+}
+
+// Where appends a list predicates to the BackupRunMutation builder.
+func (m *BackupRunMutation) Where(ps ...predicate.BackupRun) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BackupRunMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BackupRunMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BackupRun, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BackupRunMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BackupRunMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BackupRun).
+func (m *BackupRunMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BackupRunMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.user_id != nil {
+		fields = append(fields, backuprun.FieldUserID)
+	}
+	if m.trigger != nil {
+		fields = append(fields, backuprun.FieldTrigger)
+	}
+	if m.job_id != nil {
+		fields = append(fields, backuprun.FieldJobID)
+	}
+	if m.status != nil {
+		fields = append(fields, backuprun.FieldStatus)
+	}
+	if m.collections != nil {
+		fields = append(fields, backuprun.FieldCollections)
+	}
+	if m.api_calls != nil {
+		fields = append(fields, backuprun.FieldAPICalls)
+	}
+	if m.error != nil {
+		fields = append(fields, backuprun.FieldError)
+	}
+	if m.checkpoint != nil {
+		fields = append(fields, backuprun.FieldCheckpoint)
+	}
+	if m.resumes != nil {
+		fields = append(fields, backuprun.FieldResumes)
+	}
+	if m.pinned != nil {
+		fields = append(fields, backuprun.FieldPinned)
+	}
+	if m.note != nil {
+		fields = append(fields, backuprun.FieldNote)
+	}
+	if m.labels != nil {
+		fields = append(fields, backuprun.FieldLabels)
+	}
+	if m.started_at != nil {
+		fields = append(fields, backuprun.FieldStartedAt)
+	}
+	if m.finished_at != nil {
+		fields = append(fields, backuprun.FieldFinishedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BackupRunMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case backuprun.FieldUserID:
+		return m.UserID()
+	case backuprun.FieldTrigger:
+		return m.Trigger()
+	case backuprun.FieldJobID:
+		return m.JobID()
+	case backuprun.FieldStatus:
+		return m.Status()
+	case backuprun.FieldCollections:
+		return m.Collections()
+	case backuprun.FieldAPICalls:
+		return m.APICalls()
+	case backuprun.FieldError:
+		return m.Error()
+	case backuprun.FieldCheckpoint:
+		return m.Checkpoint()
+	case backuprun.FieldResumes:
+		return m.Resumes()
+	case backuprun.FieldPinned:
+		return m.Pinned()
+	case backuprun.FieldNote:
+		return m.Note()
+	case backuprun.FieldLabels:
+		return m.Labels()
+	case backuprun.FieldStartedAt:
+		return m.StartedAt()
+	case backuprun.FieldFinishedAt:
+		return m.FinishedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BackupRunMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case backuprun.FieldUserID:
+		return m.OldUserID(ctx)
+	case backuprun.FieldTrigger:
+		return m.OldTrigger(ctx)
+	case backuprun.FieldJobID:
+		return m.OldJobID(ctx)
+	case backuprun.FieldStatus:
+		return m.OldStatus(ctx)
+	case backuprun.FieldCollections:
+		return m.OldCollections(ctx)
+	case backuprun.FieldAPICalls:
+		return m.OldAPICalls(ctx)
+	case backuprun.FieldError:
+		return m.OldError(ctx)
+	case backuprun.FieldCheckpoint:
+		return m.OldCheckpoint(ctx)
+	case backuprun.FieldResumes:
+		return m.OldResumes(ctx)
+	case backuprun.FieldPinned:
+		return m.OldPinned(ctx)
+	case backuprun.FieldNote:
+		return m.OldNote(ctx)
+	case backuprun.FieldLabels:
+		return m.OldLabels(ctx)
+	case backuprun.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case backuprun.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown BackupRun field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BackupRunMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case backuprun.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case backuprun.FieldTrigger:
+		v, ok := value.(backuprun.Trigger)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrigger(v)
+		return nil
+	case backuprun.FieldJobID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJobID(v)
+		return nil
+	case backuprun.FieldStatus:
+		v, ok := value.(backuprun.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case backuprun.FieldCollections:
+		v, ok := value.(map[string]schematype.CollectionResult)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollections(v)
+		return nil
+	case backuprun.FieldAPICalls:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAPICalls(v)
+		return nil
+	case backuprun.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case backuprun.FieldCheckpoint:
+		v, ok := value.(schematype.Checkpoint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCheckpoint(v)
+		return nil
+	case backuprun.FieldResumes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResumes(v)
+		return nil
+	case backuprun.FieldPinned:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPinned(v)
+		return nil
+	case backuprun.FieldNote:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNote(v)
+		return nil
+	case backuprun.FieldLabels:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabels(v)
+		return nil
+	case backuprun.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case backuprun.FieldFinishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BackupRun field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BackupRunMutation) AddedFields() []string {
+	var fields []string
+	if m.addapi_calls != nil {
+		fields = append(fields, backuprun.FieldAPICalls)
+	}
+	if m.addresumes != nil {
+		fields = append(fields, backuprun.FieldResumes)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BackupRunMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case backuprun.FieldAPICalls:
+		return m.AddedAPICalls()
+	case backuprun.FieldResumes:
+		return m.AddedResumes()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BackupRunMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case backuprun.FieldAPICalls:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAPICalls(v)
+		return nil
+	case backuprun.FieldResumes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddResumes(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BackupRun numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BackupRunMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(backuprun.FieldCheckpoint) {
+		fields = append(fields, backuprun.FieldCheckpoint)
+	}
+	if m.FieldCleared(backuprun.FieldFinishedAt) {
+		fields = append(fields, backuprun.FieldFinishedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BackupRunMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BackupRunMutation) ClearField(name string) error {
+	switch name {
+	case backuprun.FieldCheckpoint:
+		m.ClearCheckpoint()
+		return nil
+	case backuprun.FieldFinishedAt:
+		m.ClearFinishedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BackupRun nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BackupRunMutation) ResetField(name string) error {
+	switch name {
+	case backuprun.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case backuprun.FieldTrigger:
+		m.ResetTrigger()
+		return nil
+	case backuprun.FieldJobID:
+		m.ResetJobID()
+		return nil
+	case backuprun.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case backuprun.FieldCollections:
+		m.ResetCollections()
+		return nil
+	case backuprun.FieldAPICalls:
+		m.ResetAPICalls()
+		return nil
+	case backuprun.FieldError:
+		m.ResetError()
+		return nil
+	case backuprun.FieldCheckpoint:
+		m.ResetCheckpoint()
+		return nil
+	case backuprun.FieldResumes:
+		m.ResetResumes()
+		return nil
+	case backuprun.FieldPinned:
+		m.ResetPinned()
+		return nil
+	case backuprun.FieldNote:
+		m.ResetNote()
+		return nil
+	case backuprun.FieldLabels:
+		m.ResetLabels()
+		return nil
+	case backuprun.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case backuprun.FieldFinishedAt:
+		m.ResetFinishedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BackupRun field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BackupRunMutation) AddedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.playlist_snapshots != nil {
+		edges = append(edges, backuprun.EdgePlaylistSnapshots)
+	}
+	if m.saved_tracks != nil {
+		edges = append(edges, backuprun.EdgeSavedTracks)
+	}
+	if m.saved_albums != nil {
+		edges = append(edges, backuprun.EdgeSavedAlbums)
+	}
+	if m.saved_shows != nil {
+		edges = append(edges, backuprun.EdgeSavedShows)
+	}
+	if m.saved_episodes != nil {
+		edges = append(edges, backuprun.EdgeSavedEpisodes)
+	}
+	if m.saved_audiobooks != nil {
+		edges = append(edges, backuprun.EdgeSavedAudiobooks)
+	}
+	if m.followed_artists != nil {
+		edges = append(edges, backuprun.EdgeFollowedArtists)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BackupRunMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case backuprun.EdgePlaylistSnapshots:
+		ids := make([]ent.Value, 0, len(m.playlist_snapshots))
+		for id := range m.playlist_snapshots {
+			ids = append(ids, id)
+		}
+		return ids
+	case backuprun.EdgeSavedTracks:
+		ids := make([]ent.Value, 0, len(m.saved_tracks))
+		for id := range m.saved_tracks {
+			ids = append(ids, id)
+		}
+		return ids
+	case backuprun.EdgeSavedAlbums:
+		ids := make([]ent.Value, 0, len(m.saved_albums))
+		for id := range m.saved_albums {
+			ids = append(ids, id)
+		}
+		return ids
+	case backuprun.EdgeSavedShows:
+		ids := make([]ent.Value, 0, len(m.saved_shows))
+		for id := range m.saved_shows {
+			ids = append(ids, id)
+		}
+		return ids
+	case backuprun.EdgeSavedEpisodes:
+		ids := make([]ent.Value, 0, len(m.saved_episodes))
+		for id := range m.saved_episodes {
+			ids = append(ids, id)
+		}
+		return ids
+	case backuprun.EdgeSavedAudiobooks:
+		ids := make([]ent.Value, 0, len(m.saved_audiobooks))
+		for id := range m.saved_audiobooks {
+			ids = append(ids, id)
+		}
+		return ids
+	case backuprun.EdgeFollowedArtists:
+		ids := make([]ent.Value, 0, len(m.followed_artists))
+		for id := range m.followed_artists {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BackupRunMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.removedplaylist_snapshots != nil {
+		edges = append(edges, backuprun.EdgePlaylistSnapshots)
+	}
+	if m.removedsaved_tracks != nil {
+		edges = append(edges, backuprun.EdgeSavedTracks)
+	}
+	if m.removedsaved_albums != nil {
+		edges = append(edges, backuprun.EdgeSavedAlbums)
+	}
+	if m.removedsaved_shows != nil {
+		edges = append(edges, backuprun.EdgeSavedShows)
+	}
+	if m.removedsaved_episodes != nil {
+		edges = append(edges, backuprun.EdgeSavedEpisodes)
+	}
+	if m.removedsaved_audiobooks != nil {
+		edges = append(edges, backuprun.EdgeSavedAudiobooks)
+	}
+	if m.removedfollowed_artists != nil {
+		edges = append(edges, backuprun.EdgeFollowedArtists)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BackupRunMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case backuprun.EdgePlaylistSnapshots:
+		ids := make([]ent.Value, 0, len(m.removedplaylist_snapshots))
+		for id := range m.removedplaylist_snapshots {
+			ids = append(ids, id)
+		}
+		return ids
+	case backuprun.EdgeSavedTracks:
+		ids := make([]ent.Value, 0, len(m.removedsaved_tracks))
+		for id := range m.removedsaved_tracks {
+			ids = append(ids, id)
+		}
+		return ids
+	case backuprun.EdgeSavedAlbums:
+		ids := make([]ent.Value, 0, len(m.removedsaved_albums))
+		for id := range m.removedsaved_albums {
+			ids = append(ids, id)
+		}
+		return ids
+	case backuprun.EdgeSavedShows:
+		ids := make([]ent.Value, 0, len(m.removedsaved_shows))
+		for id := range m.removedsaved_shows {
+			ids = append(ids, id)
+		}
+		return ids
+	case backuprun.EdgeSavedEpisodes:
+		ids := make([]ent.Value, 0, len(m.removedsaved_episodes))
+		for id := range m.removedsaved_episodes {
+			ids = append(ids, id)
+		}
+		return ids
+	case backuprun.EdgeSavedAudiobooks:
+		ids := make([]ent.Value, 0, len(m.removedsaved_audiobooks))
+		for id := range m.removedsaved_audiobooks {
+			ids = append(ids, id)
+		}
+		return ids
+	case backuprun.EdgeFollowedArtists:
+		ids := make([]ent.Value, 0, len(m.removedfollowed_artists))
+		for id := range m.removedfollowed_artists {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BackupRunMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.clearedplaylist_snapshots {
+		edges = append(edges, backuprun.EdgePlaylistSnapshots)
+	}
+	if m.clearedsaved_tracks {
+		edges = append(edges, backuprun.EdgeSavedTracks)
+	}
+	if m.clearedsaved_albums {
+		edges = append(edges, backuprun.EdgeSavedAlbums)
+	}
+	if m.clearedsaved_shows {
+		edges = append(edges, backuprun.EdgeSavedShows)
+	}
+	if m.clearedsaved_episodes {
+		edges = append(edges, backuprun.EdgeSavedEpisodes)
+	}
+	if m.clearedsaved_audiobooks {
+		edges = append(edges, backuprun.EdgeSavedAudiobooks)
+	}
+	if m.clearedfollowed_artists {
+		edges = append(edges, backuprun.EdgeFollowedArtists)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BackupRunMutation) EdgeCleared(name string) bool {
+	switch name {
+	case backuprun.EdgePlaylistSnapshots:
+		return m.clearedplaylist_snapshots
+	case backuprun.EdgeSavedTracks:
+		return m.clearedsaved_tracks
+	case backuprun.EdgeSavedAlbums:
+		return m.clearedsaved_albums
+	case backuprun.EdgeSavedShows:
+		return m.clearedsaved_shows
+	case backuprun.EdgeSavedEpisodes:
+		return m.clearedsaved_episodes
+	case backuprun.EdgeSavedAudiobooks:
+		return m.clearedsaved_audiobooks
+	case backuprun.EdgeFollowedArtists:
+		return m.clearedfollowed_artists
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BackupRunMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BackupRun unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BackupRunMutation) ResetEdge(name string) error {
+	switch name {
+	case backuprun.EdgePlaylistSnapshots:
+		m.ResetPlaylistSnapshots()
+		return nil
+	case backuprun.EdgeSavedTracks:
+		m.ResetSavedTracks()
+		return nil
+	case backuprun.EdgeSavedAlbums:
+		m.ResetSavedAlbums()
+		return nil
+	case backuprun.EdgeSavedShows:
+		m.ResetSavedShows()
+		return nil
+	case backuprun.EdgeSavedEpisodes:
+		m.ResetSavedEpisodes()
+		return nil
+	case backuprun.EdgeSavedAudiobooks:
+		m.ResetSavedAudiobooks()
+		return nil
+	case backuprun.EdgeFollowedArtists:
+		m.ResetFollowedArtists()
+		return nil
+	}
+	return fmt.Errorf("unknown BackupRun edge %s", name)
+}
+
+// BackupScheduleMutation represents an operation that mutates the BackupSchedule nodes in the graph.
+type BackupScheduleMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *string
+	user_id        *string
+	cron           *string
+	time_zone      *string
+	misfire_policy *backupschedule.MisfirePolicy
+	enabled        *bool
+	next_run_at    *time.Time
+	last_run_at    *time.Time
+	created_at     *time.Time
+	updated_at     *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*BackupSchedule, error)
+	predicates     []predicate.BackupSchedule
+}
+
+var _ ent.Mutation = (*BackupScheduleMutation)(nil)
+
+// backupscheduleOption allows management of the mutation configuration using functional options.
+type backupscheduleOption func(*BackupScheduleMutation)
+
+// newBackupScheduleMutation creates new mutation for the BackupSchedule entity.
+func newBackupScheduleMutation(c config, op Op, opts ...backupscheduleOption) *BackupScheduleMutation {
+	m := &BackupScheduleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBackupSchedule,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBackupScheduleID sets the ID field of the mutation.
+func withBackupScheduleID(id string) backupscheduleOption {
+	return func(m *BackupScheduleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BackupSchedule
+		)
+		m.oldValue = func(ctx context.Context) (*BackupSchedule, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BackupSchedule.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBackupSchedule sets the old BackupSchedule of the mutation.
+func withBackupSchedule(node *BackupSchedule) backupscheduleOption {
+	return func(m *BackupScheduleMutation) {
+		m.oldValue = func(context.Context) (*BackupSchedule, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BackupScheduleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BackupScheduleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BackupSchedule entities.
+func (m *BackupScheduleMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BackupScheduleMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BackupScheduleMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BackupSchedule.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *BackupScheduleMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *BackupScheduleMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the BackupSchedule entity.
+// If the BackupSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupScheduleMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *BackupScheduleMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetCron sets the "cron" field.
+func (m *BackupScheduleMutation) SetCron(s string) {
+	m.cron = &s
+}
+
+// Cron returns the value of the "cron" field in the mutation.
+func (m *BackupScheduleMutation) Cron() (r string, exists bool) {
+	v := m.cron
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCron returns the old "cron" field's value of the BackupSchedule entity.
+// If the BackupSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupScheduleMutation) OldCron(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCron is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCron requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCron: %w", err)
+	}
+	return oldValue.Cron, nil
+}
+
+// ResetCron resets all changes to the "cron" field.
+func (m *BackupScheduleMutation) ResetCron() {
+	m.cron = nil
+}
+
+// SetTimeZone sets the "time_zone" field.
+func (m *BackupScheduleMutation) SetTimeZone(s string) {
+	m.time_zone = &s
+}
+
+// TimeZone returns the value of the "time_zone" field in the mutation.
+func (m *BackupScheduleMutation) TimeZone() (r string, exists bool) {
+	v := m.time_zone
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimeZone returns the old "time_zone" field's value of the BackupSchedule entity.
+// If the BackupSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupScheduleMutation) OldTimeZone(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimeZone is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimeZone requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimeZone: %w", err)
+	}
+	return oldValue.TimeZone, nil
+}
+
+// ResetTimeZone resets all changes to the "time_zone" field.
+func (m *BackupScheduleMutation) ResetTimeZone() {
+	m.time_zone = nil
+}
+
+// SetMisfirePolicy sets the "misfire_policy" field.
+func (m *BackupScheduleMutation) SetMisfirePolicy(bp backupschedule.MisfirePolicy) {
+	m.misfire_policy = &bp
+}
+
+// MisfirePolicy returns the value of the "misfire_policy" field in the mutation.
+func (m *BackupScheduleMutation) MisfirePolicy() (r backupschedule.MisfirePolicy, exists bool) {
+	v := m.misfire_policy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMisfirePolicy returns the old "misfire_policy" field's value of the BackupSchedule entity.
+// If the BackupSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupScheduleMutation) OldMisfirePolicy(ctx context.Context) (v backupschedule.MisfirePolicy, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMisfirePolicy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMisfirePolicy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMisfirePolicy: %w", err)
+	}
+	return oldValue.MisfirePolicy, nil
+}
+
+// ResetMisfirePolicy resets all changes to the "misfire_policy" field.
+func (m *BackupScheduleMutation) ResetMisfirePolicy() {
+	m.misfire_policy = nil
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *BackupScheduleMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *BackupScheduleMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the BackupSchedule entity.
+// If the BackupSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupScheduleMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *BackupScheduleMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (m *BackupScheduleMutation) SetNextRunAt(t time.Time) {
+	m.next_run_at = &t
+}
+
+// NextRunAt returns the value of the "next_run_at" field in the mutation.
+func (m *BackupScheduleMutation) NextRunAt() (r time.Time, exists bool) {
+	v := m.next_run_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextRunAt returns the old "next_run_at" field's value of the BackupSchedule entity.
+// If the BackupSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupScheduleMutation) OldNextRunAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextRunAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextRunAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextRunAt: %w", err)
+	}
+	return oldValue.NextRunAt, nil
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (m *BackupScheduleMutation) ClearNextRunAt() {
+	m.next_run_at = nil
+	m.clearedFields[backupschedule.FieldNextRunAt] = struct{}{}
+}
+
+// NextRunAtCleared returns if the "next_run_at" field was cleared in this mutation.
+func (m *BackupScheduleMutation) NextRunAtCleared() bool {
+	_, ok := m.clearedFields[backupschedule.FieldNextRunAt]
+	return ok
+}
+
+// ResetNextRunAt resets all changes to the "next_run_at" field.
+func (m *BackupScheduleMutation) ResetNextRunAt() {
+	m.next_run_at = nil
+	delete(m.clearedFields, backupschedule.FieldNextRunAt)
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (m *BackupScheduleMutation) SetLastRunAt(t time.Time) {
+	m.last_run_at = &t
+}
+
+// LastRunAt returns the value of the "last_run_at" field in the mutation.
+func (m *BackupScheduleMutation) LastRunAt() (r time.Time, exists bool) {
+	v := m.last_run_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastRunAt returns the old "last_run_at" field's value of the BackupSchedule entity.
+// If the BackupSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupScheduleMutation) OldLastRunAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastRunAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastRunAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastRunAt: %w", err)
+	}
+	return oldValue.LastRunAt, nil
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (m *BackupScheduleMutation) ClearLastRunAt() {
+	m.last_run_at = nil
+	m.clearedFields[backupschedule.FieldLastRunAt] = struct{}{}
+}
+
+// LastRunAtCleared returns if the "last_run_at" field was cleared in this mutation.
+func (m *BackupScheduleMutation) LastRunAtCleared() bool {
+	_, ok := m.clearedFields[backupschedule.FieldLastRunAt]
+	return ok
+}
+
+// ResetLastRunAt resets all changes to the "last_run_at" field.
+func (m *BackupScheduleMutation) ResetLastRunAt() {
+	m.last_run_at = nil
+	delete(m.clearedFields, backupschedule.FieldLastRunAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *BackupScheduleMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *BackupScheduleMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the BackupSchedule entity.
+// If the BackupSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupScheduleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *BackupScheduleMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *BackupScheduleMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *BackupScheduleMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the BackupSchedule entity.
+// If the BackupSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupScheduleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *BackupScheduleMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the BackupScheduleMutation builder.
+func (m *BackupScheduleMutation) Where(ps ...predicate.BackupSchedule) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BackupScheduleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BackupScheduleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BackupSchedule, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BackupScheduleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BackupScheduleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BackupSchedule).
+func (m *BackupScheduleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BackupScheduleMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.user_id != nil {
+		fields = append(fields, backupschedule.FieldUserID)
+	}
+	if m.cron != nil {
+		fields = append(fields, backupschedule.FieldCron)
+	}
+	if m.time_zone != nil {
+		fields = append(fields, backupschedule.FieldTimeZone)
+	}
+	if m.misfire_policy != nil {
+		fields = append(fields, backupschedule.FieldMisfirePolicy)
+	}
+	if m.enabled != nil {
+		fields = append(fields, backupschedule.FieldEnabled)
+	}
+	if m.next_run_at != nil {
+		fields = append(fields, backupschedule.FieldNextRunAt)
+	}
+	if m.last_run_at != nil {
+		fields = append(fields, backupschedule.FieldLastRunAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, backupschedule.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, backupschedule.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BackupScheduleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case backupschedule.FieldUserID:
+		return m.UserID()
+	case backupschedule.FieldCron:
+		return m.Cron()
+	case backupschedule.FieldTimeZone:
+		return m.TimeZone()
+	case backupschedule.FieldMisfirePolicy:
+		return m.MisfirePolicy()
+	case backupschedule.FieldEnabled:
+		return m.Enabled()
+	case backupschedule.FieldNextRunAt:
+		return m.NextRunAt()
+	case backupschedule.FieldLastRunAt:
+		return m.LastRunAt()
+	case backupschedule.FieldCreatedAt:
+		return m.CreatedAt()
+	case backupschedule.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BackupScheduleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case backupschedule.FieldUserID:
+		return m.OldUserID(ctx)
+	case backupschedule.FieldCron:
+		return m.OldCron(ctx)
+	case backupschedule.FieldTimeZone:
+		return m.OldTimeZone(ctx)
+	case backupschedule.FieldMisfirePolicy:
+		return m.OldMisfirePolicy(ctx)
+	case backupschedule.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case backupschedule.FieldNextRunAt:
+		return m.OldNextRunAt(ctx)
+	case backupschedule.FieldLastRunAt:
+		return m.OldLastRunAt(ctx)
+	case backupschedule.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case backupschedule.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown BackupSchedule field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BackupScheduleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case backupschedule.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case backupschedule.FieldCron:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCron(v)
+		return nil
+	case backupschedule.FieldTimeZone:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimeZone(v)
+		return nil
+	case backupschedule.FieldMisfirePolicy:
+		v, ok := value.(backupschedule.MisfirePolicy)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMisfirePolicy(v)
+		return nil
+	case backupschedule.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case backupschedule.FieldNextRunAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextRunAt(v)
+		return nil
+	case backupschedule.FieldLastRunAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastRunAt(v)
+		return nil
+	case backupschedule.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case backupschedule.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BackupSchedule field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BackupScheduleMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BackupScheduleMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BackupScheduleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BackupSchedule numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BackupScheduleMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(backupschedule.FieldNextRunAt) {
+		fields = append(fields, backupschedule.FieldNextRunAt)
+	}
+	if m.FieldCleared(backupschedule.FieldLastRunAt) {
+		fields = append(fields, backupschedule.FieldLastRunAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BackupScheduleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BackupScheduleMutation) ClearField(name string) error {
+	switch name {
+	case backupschedule.FieldNextRunAt:
+		m.ClearNextRunAt()
+		return nil
+	case backupschedule.FieldLastRunAt:
+		m.ClearLastRunAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BackupSchedule nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BackupScheduleMutation) ResetField(name string) error {
+	switch name {
+	case backupschedule.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case backupschedule.FieldCron:
+		m.ResetCron()
+		return nil
+	case backupschedule.FieldTimeZone:
+		m.ResetTimeZone()
+		return nil
+	case backupschedule.FieldMisfirePolicy:
+		m.ResetMisfirePolicy()
+		return nil
+	case backupschedule.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case backupschedule.FieldNextRunAt:
+		m.ResetNextRunAt()
+		return nil
+	case backupschedule.FieldLastRunAt:
+		m.ResetLastRunAt()
+		return nil
+	case backupschedule.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case backupschedule.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BackupSchedule field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BackupScheduleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BackupScheduleMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BackupScheduleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BackupScheduleMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BackupScheduleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BackupScheduleMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BackupScheduleMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BackupSchedule unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BackupScheduleMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BackupSchedule edge %s", name)
+}
+
+// EpisodeMutation represents an operation that mutates the Episode nodes in the graph.
+type EpisodeMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *string
+	spotify_id            *string
+	uri                   *string
+	name                  *string
+	duration_ms           *int
+	addduration_ms        *int
+	explicit              *bool
+	release_date          *string
+	created_at            *time.Time
+	clearedFields         map[string]struct{}
+	show                  *string
+	clearedshow           bool
+	snapshot_items        map[string]struct{}
+	removedsnapshot_items map[string]struct{}
+	clearedsnapshot_items bool
+	saved_episodes        map[string]struct{}
+	removedsaved_episodes map[string]struct{}
+	clearedsaved_episodes bool
+	done                  bool
+	oldValue              func(context.Context) (*Episode, error)
+	predicates            []predicate.Episode
+}
+
+var _ ent.Mutation = (*EpisodeMutation)(nil)
+
+// episodeOption allows management of the mutation configuration using functional options.
+type episodeOption func(*EpisodeMutation)
+
+// newEpisodeMutation creates new mutation for the Episode entity.
+func newEpisodeMutation(c config, op Op, opts ...episodeOption) *EpisodeMutation {
+	m := &EpisodeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEpisode,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEpisodeID sets the ID field of the mutation.
+func withEpisodeID(id string) episodeOption {
+	return func(m *EpisodeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Episode
+		)
+		m.oldValue = func(ctx context.Context) (*Episode, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Episode.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEpisode sets the old Episode of the mutation.
+func withEpisode(node *Episode) episodeOption {
+	return func(m *EpisodeMutation) {
+		m.oldValue = func(context.Context) (*Episode, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EpisodeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EpisodeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Episode entities.
+func (m *EpisodeMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EpisodeMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EpisodeMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Episode.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSpotifyID sets the "spotify_id" field.
+func (m *EpisodeMutation) SetSpotifyID(s string) {
+	m.spotify_id = &s
+}
+
+// SpotifyID returns the value of the "spotify_id" field in the mutation.
+func (m *EpisodeMutation) SpotifyID() (r string, exists bool) {
+	v := m.spotify_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSpotifyID returns the old "spotify_id" field's value of the Episode entity.
+// If the Episode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EpisodeMutation) OldSpotifyID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSpotifyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSpotifyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSpotifyID: %w", err)
+	}
+	return oldValue.SpotifyID, nil
+}
+
+// ResetSpotifyID resets all changes to the "spotify_id" field.
+func (m *EpisodeMutation) ResetSpotifyID() {
+	m.spotify_id = nil
+}
+
+// SetURI sets the "uri" field.
+func (m *EpisodeMutation) SetURI(s string) {
+	m.uri = &s
+}
+
+// URI returns the value of the "uri" field in the mutation.
+func (m *EpisodeMutation) URI() (r string, exists bool) {
+	v := m.uri
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURI returns the old "uri" field's value of the Episode entity.
+// If the Episode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EpisodeMutation) OldURI(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURI is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURI requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURI: %w", err)
+	}
+	return oldValue.URI, nil
+}
+
+// ResetURI resets all changes to the "uri" field.
+func (m *EpisodeMutation) ResetURI() {
+	m.uri = nil
+}
+
+// SetName sets the "name" field.
+func (m *EpisodeMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *EpisodeMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Episode entity.
+// If the Episode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EpisodeMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *EpisodeMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (m *EpisodeMutation) SetDurationMs(i int) {
+	m.duration_ms = &i
+	m.addduration_ms = nil
+}
+
+// DurationMs returns the value of the "duration_ms" field in the mutation.
+func (m *EpisodeMutation) DurationMs() (r int, exists bool) {
+	v := m.duration_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDurationMs returns the old "duration_ms" field's value of the Episode entity.
+// If the Episode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EpisodeMutation) OldDurationMs(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDurationMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDurationMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDurationMs: %w", err)
+	}
+	return oldValue.DurationMs, nil
+}
+
+// AddDurationMs adds i to the "duration_ms" field.
+func (m *EpisodeMutation) AddDurationMs(i int) {
+	if m.addduration_ms != nil {
+		*m.addduration_ms += i
+	} else {
+		m.addduration_ms = &i
+	}
+}
+
+// AddedDurationMs returns the value that was added to the "duration_ms" field in this mutation.
+func (m *EpisodeMutation) AddedDurationMs() (r int, exists bool) {
+	v := m.addduration_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDurationMs resets all changes to the "duration_ms" field.
+func (m *EpisodeMutation) ResetDurationMs() {
+	m.duration_ms = nil
+	m.addduration_ms = nil
+}
+
+// SetExplicit sets the "explicit" field.
+func (m *EpisodeMutation) SetExplicit(b bool) {
+	m.explicit = &b
+}
+
+// Explicit returns the value of the "explicit" field in the mutation.
+func (m *EpisodeMutation) Explicit() (r bool, exists bool) {
+	v := m.explicit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExplicit returns the old "explicit" field's value of the Episode entity.
+// If the Episode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EpisodeMutation) OldExplicit(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExplicit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExplicit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExplicit: %w", err)
+	}
+	return oldValue.Explicit, nil
+}
+
+// ResetExplicit resets all changes to the "explicit" field.
+func (m *EpisodeMutation) ResetExplicit() {
+	m.explicit = nil
+}
+
+// SetReleaseDate sets the "release_date" field.
+func (m *EpisodeMutation) SetReleaseDate(s string) {
+	m.release_date = &s
+}
+
+// ReleaseDate returns the value of the "release_date" field in the mutation.
+func (m *EpisodeMutation) ReleaseDate() (r string, exists bool) {
+	v := m.release_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReleaseDate returns the old "release_date" field's value of the Episode entity.
+// If the Episode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EpisodeMutation) OldReleaseDate(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReleaseDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReleaseDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReleaseDate: %w", err)
+	}
+	return oldValue.ReleaseDate, nil
+}
+
+// ResetReleaseDate resets all changes to the "release_date" field.
+func (m *EpisodeMutation) ResetReleaseDate() {
+	m.release_date = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EpisodeMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EpisodeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Episode entity.
+// If the Episode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EpisodeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EpisodeMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetShowID sets the "show" edge to the Show entity by id.
+func (m *EpisodeMutation) SetShowID(id string) {
+	m.show = &id
+}
+
+// ClearShow clears the "show" edge to the Show entity.
+func (m *EpisodeMutation) ClearShow() {
+	m.clearedshow = true
+}
+
+// ShowCleared reports if the "show" edge to the Show entity was cleared.
+func (m *EpisodeMutation) ShowCleared() bool {
+	return m.clearedshow
+}
+
+// ShowID returns the "show" edge ID in the mutation.
+func (m *EpisodeMutation) ShowID() (id string, exists bool) {
+	if m.show != nil {
+		return *m.show, true
+	}
+	return
+}
+
+// ShowIDs returns the "show" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ShowID instead. It exists only for internal usage by the builders.
+func (m *EpisodeMutation) ShowIDs() (ids []string) {
+	if id := m.show; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetShow resets all changes to the "show" edge.
+func (m *EpisodeMutation) ResetShow() {
+	m.show = nil
+	m.clearedshow = false
+}
+
+// AddSnapshotItemIDs adds the "snapshot_items" edge to the SnapshotItem entity by ids.
+func (m *EpisodeMutation) AddSnapshotItemIDs(ids ...string) {
+	if m.snapshot_items == nil {
+		m.snapshot_items = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.snapshot_items[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSnapshotItems clears the "snapshot_items" edge to the SnapshotItem entity.
+func (m *EpisodeMutation) ClearSnapshotItems() {
+	m.clearedsnapshot_items = true
+}
+
+// SnapshotItemsCleared reports if the "snapshot_items" edge to the SnapshotItem entity was cleared.
+func (m *EpisodeMutation) SnapshotItemsCleared() bool {
+	return m.clearedsnapshot_items
+}
+
+// RemoveSnapshotItemIDs removes the "snapshot_items" edge to the SnapshotItem entity by IDs.
+func (m *EpisodeMutation) RemoveSnapshotItemIDs(ids ...string) {
+	if m.removedsnapshot_items == nil {
+		m.removedsnapshot_items = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.snapshot_items, ids[i])
+		m.removedsnapshot_items[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSnapshotItems returns the removed IDs of the "snapshot_items" edge to the SnapshotItem entity.
+func (m *EpisodeMutation) RemovedSnapshotItemsIDs() (ids []string) {
+	for id := range m.removedsnapshot_items {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SnapshotItemsIDs returns the "snapshot_items" edge IDs in the mutation.
+func (m *EpisodeMutation) SnapshotItemsIDs() (ids []string) {
+	for id := range m.snapshot_items {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSnapshotItems resets all changes to the "snapshot_items" edge.
+func (m *EpisodeMutation) ResetSnapshotItems() {
+	m.snapshot_items = nil
+	m.clearedsnapshot_items = false
+	m.removedsnapshot_items = nil
+}
+
+// AddSavedEpisodeIDs adds the "saved_episodes" edge to the SavedEpisode entity by ids.
+func (m *EpisodeMutation) AddSavedEpisodeIDs(ids ...string) {
+	if m.saved_episodes == nil {
+		m.saved_episodes = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.saved_episodes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSavedEpisodes clears the "saved_episodes" edge to the SavedEpisode entity.
+func (m *EpisodeMutation) ClearSavedEpisodes() {
+	m.clearedsaved_episodes = true
+}
+
+// SavedEpisodesCleared reports if the "saved_episodes" edge to the SavedEpisode entity was cleared.
+func (m *EpisodeMutation) SavedEpisodesCleared() bool {
+	return m.clearedsaved_episodes
+}
+
+// RemoveSavedEpisodeIDs removes the "saved_episodes" edge to the SavedEpisode entity by IDs.
+func (m *EpisodeMutation) RemoveSavedEpisodeIDs(ids ...string) {
+	if m.removedsaved_episodes == nil {
+		m.removedsaved_episodes = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.saved_episodes, ids[i])
+		m.removedsaved_episodes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSavedEpisodes returns the removed IDs of the "saved_episodes" edge to the SavedEpisode entity.
+func (m *EpisodeMutation) RemovedSavedEpisodesIDs() (ids []string) {
+	for id := range m.removedsaved_episodes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SavedEpisodesIDs returns the "saved_episodes" edge IDs in the mutation.
+func (m *EpisodeMutation) SavedEpisodesIDs() (ids []string) {
+	for id := range m.saved_episodes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSavedEpisodes resets all changes to the "saved_episodes" edge.
+func (m *EpisodeMutation) ResetSavedEpisodes() {
+	m.saved_episodes = nil
+	m.clearedsaved_episodes = false
+	m.removedsaved_episodes = nil
+}
+
+// Where appends a list predicates to the EpisodeMutation builder.
+func (m *EpisodeMutation) Where(ps ...predicate.Episode) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EpisodeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EpisodeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Episode, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EpisodeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EpisodeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Episode).
+func (m *EpisodeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EpisodeMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.spotify_id != nil {
+		fields = append(fields, episode.FieldSpotifyID)
+	}
+	if m.uri != nil {
+		fields = append(fields, episode.FieldURI)
+	}
+	if m.name != nil {
+		fields = append(fields, episode.FieldName)
+	}
+	if m.duration_ms != nil {
+		fields = append(fields, episode.FieldDurationMs)
+	}
+	if m.explicit != nil {
+		fields = append(fields, episode.FieldExplicit)
+	}
+	if m.release_date != nil {
+		fields = append(fields, episode.FieldReleaseDate)
+	}
+	if m.created_at != nil {
+		fields = append(fields, episode.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EpisodeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case episode.FieldSpotifyID:
+		return m.SpotifyID()
+	case episode.FieldURI:
+		return m.URI()
+	case episode.FieldName:
+		return m.Name()
+	case episode.FieldDurationMs:
+		return m.DurationMs()
+	case episode.FieldExplicit:
+		return m.Explicit()
+	case episode.FieldReleaseDate:
+		return m.ReleaseDate()
+	case episode.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EpisodeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case episode.FieldSpotifyID:
+		return m.OldSpotifyID(ctx)
+	case episode.FieldURI:
+		return m.OldURI(ctx)
+	case episode.FieldName:
+		return m.OldName(ctx)
+	case episode.FieldDurationMs:
+		return m.OldDurationMs(ctx)
+	case episode.FieldExplicit:
+		return m.OldExplicit(ctx)
+	case episode.FieldReleaseDate:
+		return m.OldReleaseDate(ctx)
+	case episode.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Episode field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EpisodeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case episode.FieldSpotifyID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpotifyID(v)
+		return nil
+	case episode.FieldURI:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURI(v)
+		return nil
+	case episode.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case episode.FieldDurationMs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDurationMs(v)
+		return nil
+	case episode.FieldExplicit:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExplicit(v)
+		return nil
+	case episode.FieldReleaseDate:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReleaseDate(v)
+		return nil
+	case episode.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Episode field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EpisodeMutation) AddedFields() []string {
+	var fields []string
+	if m.addduration_ms != nil {
+		fields = append(fields, episode.FieldDurationMs)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EpisodeMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case episode.FieldDurationMs:
+		return m.AddedDurationMs()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EpisodeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case episode.FieldDurationMs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDurationMs(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Episode numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EpisodeMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EpisodeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EpisodeMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Episode nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EpisodeMutation) ResetField(name string) error {
+	switch name {
+	case episode.FieldSpotifyID:
+		m.ResetSpotifyID()
+		return nil
+	case episode.FieldURI:
+		m.ResetURI()
+		return nil
+	case episode.FieldName:
+		m.ResetName()
+		return nil
+	case episode.FieldDurationMs:
+		m.ResetDurationMs()
+		return nil
+	case episode.FieldExplicit:
+		m.ResetExplicit()
+		return nil
+	case episode.FieldReleaseDate:
+		m.ResetReleaseDate()
+		return nil
+	case episode.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Episode field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EpisodeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.show != nil {
+		edges = append(edges, episode.EdgeShow)
+	}
+	if m.snapshot_items != nil {
+		edges = append(edges, episode.EdgeSnapshotItems)
+	}
+	if m.saved_episodes != nil {
+		edges = append(edges, episode.EdgeSavedEpisodes)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EpisodeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case episode.EdgeShow:
+		if id := m.show; id != nil {
+			return []ent.Value{*id}
+		}
+	case episode.EdgeSnapshotItems:
+		ids := make([]ent.Value, 0, len(m.snapshot_items))
+		for id := range m.snapshot_items {
+			ids = append(ids, id)
+		}
+		return ids
+	case episode.EdgeSavedEpisodes:
+		ids := make([]ent.Value, 0, len(m.saved_episodes))
+		for id := range m.saved_episodes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EpisodeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedsnapshot_items != nil {
+		edges = append(edges, episode.EdgeSnapshotItems)
+	}
+	if m.removedsaved_episodes != nil {
+		edges = append(edges, episode.EdgeSavedEpisodes)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EpisodeMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case episode.EdgeSnapshotItems:
+		ids := make([]ent.Value, 0, len(m.removedsnapshot_items))
+		for id := range m.removedsnapshot_items {
+			ids = append(ids, id)
+		}
+		return ids
+	case episode.EdgeSavedEpisodes:
+		ids := make([]ent.Value, 0, len(m.removedsaved_episodes))
+		for id := range m.removedsaved_episodes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EpisodeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedshow {
+		edges = append(edges, episode.EdgeShow)
+	}
+	if m.clearedsnapshot_items {
+		edges = append(edges, episode.EdgeSnapshotItems)
+	}
+	if m.clearedsaved_episodes {
+		edges = append(edges, episode.EdgeSavedEpisodes)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EpisodeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case episode.EdgeShow:
+		return m.clearedshow
+	case episode.EdgeSnapshotItems:
+		return m.clearedsnapshot_items
+	case episode.EdgeSavedEpisodes:
+		return m.clearedsaved_episodes
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EpisodeMutation) ClearEdge(name string) error {
+	switch name {
+	case episode.EdgeShow:
+		m.ClearShow()
+		return nil
+	}
+	return fmt.Errorf("unknown Episode unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EpisodeMutation) ResetEdge(name string) error {
+	switch name {
+	case episode.EdgeShow:
+		m.ResetShow()
+		return nil
+	case episode.EdgeSnapshotItems:
+		m.ResetSnapshotItems()
+		return nil
+	case episode.EdgeSavedEpisodes:
+		m.ResetSavedEpisodes()
+		return nil
+	}
+	return fmt.Errorf("unknown Episode edge %s", name)
+}
+
+// FollowedArtistMutation represents an operation that mutates the FollowedArtist nodes in the graph.
+type FollowedArtistMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	followers     *int
+	addfollowers  *int
+	popularity    *int
+	addpopularity *int
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	run           *string
+	clearedrun    bool
+	artist        *string
+	clearedartist bool
+	done          bool
+	oldValue      func(context.Context) (*FollowedArtist, error)
+	predicates    []predicate.FollowedArtist
+}
+
+var _ ent.Mutation = (*FollowedArtistMutation)(nil)
+
+// followedartistOption allows management of the mutation configuration using functional options.
+type followedartistOption func(*FollowedArtistMutation)
+
+// newFollowedArtistMutation creates new mutation for the FollowedArtist entity.
+func newFollowedArtistMutation(c config, op Op, opts ...followedartistOption) *FollowedArtistMutation {
+	m := &FollowedArtistMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFollowedArtist,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFollowedArtistID sets the ID field of the mutation.
+func withFollowedArtistID(id string) followedartistOption {
+	return func(m *FollowedArtistMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *FollowedArtist
+		)
+		m.oldValue = func(ctx context.Context) (*FollowedArtist, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().FollowedArtist.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFollowedArtist sets the old FollowedArtist of the mutation.
+func withFollowedArtist(node *FollowedArtist) followedartistOption {
+	return func(m *FollowedArtistMutation) {
+		m.oldValue = func(context.Context) (*FollowedArtist, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FollowedArtistMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FollowedArtistMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of FollowedArtist entities.
+func (m *FollowedArtistMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FollowedArtistMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FollowedArtistMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().FollowedArtist.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetFollowers sets the "followers" field.
+func (m *FollowedArtistMutation) SetFollowers(i int) {
+	m.followers = &i
+	m.addfollowers = nil
+}
+
+// Followers returns the value of the "followers" field in the mutation.
+func (m *FollowedArtistMutation) Followers() (r int, exists bool) {
+	v := m.followers
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFollowers returns the old "followers" field's value of the FollowedArtist entity.
+// If the FollowedArtist object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FollowedArtistMutation) OldFollowers(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFollowers is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFollowers requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFollowers: %w", err)
+	}
+	return oldValue.Followers, nil
+}
+
+// AddFollowers adds i to the "followers" field.
+func (m *FollowedArtistMutation) AddFollowers(i int) {
+	if m.addfollowers != nil {
+		*m.addfollowers += i
+	} else {
+		m.addfollowers = &i
+	}
+}
+
+// AddedFollowers returns the value that was added to the "followers" field in this mutation.
+func (m *FollowedArtistMutation) AddedFollowers() (r int, exists bool) {
+	v := m.addfollowers
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFollowers resets all changes to the "followers" field.
+func (m *FollowedArtistMutation) ResetFollowers() {
+	m.followers = nil
+	m.addfollowers = nil
+}
+
+// SetPopularity sets the "popularity" field.
+func (m *FollowedArtistMutation) SetPopularity(i int) {
+	m.popularity = &i
+	m.addpopularity = nil
+}
+
+// Popularity returns the value of the "popularity" field in the mutation.
+func (m *FollowedArtistMutation) Popularity() (r int, exists bool) {
+	v := m.popularity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPopularity returns the old "popularity" field's value of the FollowedArtist entity.
+// If the FollowedArtist object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FollowedArtistMutation) OldPopularity(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPopularity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPopularity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPopularity: %w", err)
+	}
+	return oldValue.Popularity, nil
+}
+
+// AddPopularity adds i to the "popularity" field.
+func (m *FollowedArtistMutation) AddPopularity(i int) {
+	if m.addpopularity != nil {
+		*m.addpopularity += i
+	} else {
+		m.addpopularity = &i
+	}
+}
+
+// AddedPopularity returns the value that was added to the "popularity" field in this mutation.
+func (m *FollowedArtistMutation) AddedPopularity() (r int, exists bool) {
+	v := m.addpopularity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPopularity resets all changes to the "popularity" field.
+func (m *FollowedArtistMutation) ResetPopularity() {
+	m.popularity = nil
+	m.addpopularity = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *FollowedArtistMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FollowedArtistMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the FollowedArtist entity.
+// If the FollowedArtist object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FollowedArtistMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FollowedArtistMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetRunID sets the "run" edge to the BackupRun entity by id.
+func (m *FollowedArtistMutation) SetRunID(id string) {
+	m.run = &id
+}
+
+// ClearRun clears the "run" edge to the BackupRun entity.
+func (m *FollowedArtistMutation) ClearRun() {
+	m.clearedrun = true
+}
+
+// RunCleared reports if the "run" edge to the BackupRun entity was cleared.
+func (m *FollowedArtistMutation) RunCleared() bool {
+	return m.clearedrun
+}
+
+// RunID returns the "run" edge ID in the mutation.
+func (m *FollowedArtistMutation) RunID() (id string, exists bool) {
+	if m.run != nil {
+		return *m.run, true
+	}
+	return
+}
+
+// RunIDs returns the "run" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RunID instead. It exists only for internal usage by the builders.
+func (m *FollowedArtistMutation) RunIDs() (ids []string) {
+	if id := m.run; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRun resets all changes to the "run" edge.
+func (m *FollowedArtistMutation) ResetRun() {
+	m.run = nil
+	m.clearedrun = false
+}
+
+// SetArtistID sets the "artist" edge to the Artist entity by id.
+func (m *FollowedArtistMutation) SetArtistID(id string) {
+	m.artist = &id
+}
+
+// ClearArtist clears the "artist" edge to the Artist entity.
+func (m *FollowedArtistMutation) ClearArtist() {
+	m.clearedartist = true
+}
+
+// ArtistCleared reports if the "artist" edge to the Artist entity was cleared.
+func (m *FollowedArtistMutation) ArtistCleared() bool {
+	return m.clearedartist
+}
+
+// ArtistID returns the "artist" edge ID in the mutation.
+func (m *FollowedArtistMutation) ArtistID() (id string, exists bool) {
+	if m.artist != nil {
+		return *m.artist, true
+	}
+	return
+}
+
+// ArtistIDs returns the "artist" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ArtistID instead. It exists only for internal usage by the builders.
+func (m *FollowedArtistMutation) ArtistIDs() (ids []string) {
+	if id := m.artist; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetArtist resets all changes to the "artist" edge.
+func (m *FollowedArtistMutation) ResetArtist() {
+	m.artist = nil
+	m.clearedartist = false
+}
+
+// Where appends a list predicates to the FollowedArtistMutation builder.
+func (m *FollowedArtistMutation) Where(ps ...predicate.FollowedArtist) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FollowedArtistMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FollowedArtistMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FollowedArtist, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FollowedArtistMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FollowedArtistMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (FollowedArtist).
+func (m *FollowedArtistMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FollowedArtistMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.followers != nil {
+		fields = append(fields, followedartist.FieldFollowers)
+	}
+	if m.popularity != nil {
+		fields = append(fields, followedartist.FieldPopularity)
+	}
+	if m.created_at != nil {
+		fields = append(fields, followedartist.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FollowedArtistMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case followedartist.FieldFollowers:
+		return m.Followers()
+	case followedartist.FieldPopularity:
+		return m.Popularity()
+	case followedartist.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FollowedArtistMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case followedartist.FieldFollowers:
+		return m.OldFollowers(ctx)
+	case followedartist.FieldPopularity:
+		return m.OldPopularity(ctx)
+	case followedartist.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown FollowedArtist field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FollowedArtistMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case followedartist.FieldFollowers:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFollowers(v)
+		return nil
+	case followedartist.FieldPopularity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPopularity(v)
+		return nil
+	case followedartist.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FollowedArtist field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FollowedArtistMutation) AddedFields() []string {
+	var fields []string
+	if m.addfollowers != nil {
+		fields = append(fields, followedartist.FieldFollowers)
+	}
+	if m.addpopularity != nil {
+		fields = append(fields, followedartist.FieldPopularity)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FollowedArtistMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case followedartist.FieldFollowers:
+		return m.AddedFollowers()
+	case followedartist.FieldPopularity:
+		return m.AddedPopularity()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FollowedArtistMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case followedartist.FieldFollowers:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFollowers(v)
+		return nil
+	case followedartist.FieldPopularity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPopularity(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FollowedArtist numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FollowedArtistMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FollowedArtistMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FollowedArtistMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown FollowedArtist nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FollowedArtistMutation) ResetField(name string) error {
+	switch name {
+	case followedartist.FieldFollowers:
+		m.ResetFollowers()
+		return nil
+	case followedartist.FieldPopularity:
+		m.ResetPopularity()
+		return nil
+	case followedartist.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown FollowedArtist field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FollowedArtistMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.run != nil {
+		edges = append(edges, followedartist.EdgeRun)
+	}
+	if m.artist != nil {
+		edges = append(edges, followedartist.EdgeArtist)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FollowedArtistMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case followedartist.EdgeRun:
+		if id := m.run; id != nil {
+			return []ent.Value{*id}
+		}
+	case followedartist.EdgeArtist:
+		if id := m.artist; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FollowedArtistMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FollowedArtistMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FollowedArtistMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedrun {
+		edges = append(edges, followedartist.EdgeRun)
+	}
+	if m.clearedartist {
+		edges = append(edges, followedartist.EdgeArtist)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FollowedArtistMutation) EdgeCleared(name string) bool {
+	switch name {
+	case followedartist.EdgeRun:
+		return m.clearedrun
+	case followedartist.EdgeArtist:
+		return m.clearedartist
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FollowedArtistMutation) ClearEdge(name string) error {
+	switch name {
+	case followedartist.EdgeRun:
+		m.ClearRun()
+		return nil
+	case followedartist.EdgeArtist:
+		m.ClearArtist()
+		return nil
+	}
+	return fmt.Errorf("unknown FollowedArtist unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FollowedArtistMutation) ResetEdge(name string) error {
+	switch name {
+	case followedartist.EdgeRun:
+		m.ResetRun()
+		return nil
+	case followedartist.EdgeArtist:
+		m.ResetArtist()
+		return nil
+	}
+	return fmt.Errorf("unknown FollowedArtist edge %s", name)
+}
+
+// JobMutation represents an operation that mutates the Job nodes in the graph.
+type JobMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *string
+	_type           *string
+	key             *string
+	status          *job.Status
+	payload         *jsontext.Value
+	appendpayload   jsontext.Value
+	result          *jsontext.Value
+	appendresult    jsontext.Value
+	error           *string
+	attempts        *int
+	addattempts     *int
+	max_attempts    *int
+	addmax_attempts *int
+	run_after       *time.Time
+	locked_by       *string
+	locked_at       *time.Time
+	created_at      *time.Time
+	started_at      *time.Time
+	finished_at     *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*Job, error)
+	predicates      []predicate.Job
+}
+
+var _ ent.Mutation = (*JobMutation)(nil)
+
+// jobOption allows management of the mutation configuration using functional options.
+type jobOption func(*JobMutation)
+
+// newJobMutation creates new mutation for the Job entity.
+func newJobMutation(c config, op Op, opts ...jobOption) *JobMutation {
+	m := &JobMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeJob,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withJobID sets the ID field of the mutation.
+func withJobID(id string) jobOption {
+	return func(m *JobMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Job
+		)
+		m.oldValue = func(ctx context.Context) (*Job, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Job.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withJob sets the old Job of the mutation.
+func withJob(node *Job) jobOption {
+	return func(m *JobMutation) {
+		m.oldValue = func(context.Context) (*Job, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m JobMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m JobMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Job entities.
+func (m *JobMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *JobMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *JobMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Job.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetType sets the "type" field.
+func (m *JobMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *JobMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *JobMutation) ResetType() {
+	m._type = nil
+}
+
+// SetKey sets the "key" field.
+func (m *JobMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *JobMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *JobMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *JobMutation) SetStatus(j job.Status) {
+	m.status = &j
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *JobMutation) Status() (r job.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldStatus(ctx context.Context) (v job.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *JobMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetPayload sets the "payload" field.
+func (m *JobMutation) SetPayload(j jsontext.Value) {
+	m.payload = &j
+	m.appendpayload = nil
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *JobMutation) Payload() (r jsontext.Value, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayload returns the old "payload" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldPayload(ctx context.Context) (v jsontext.Value, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
+}
+
+// AppendPayload adds j to the "payload" field.
+func (m *JobMutation) AppendPayload(j jsontext.Value) {
+	m.appendpayload = append(m.appendpayload, j...)
+}
+
+// AppendedPayload returns the list of values that were appended to the "payload" field in this mutation.
+func (m *JobMutation) AppendedPayload() (jsontext.Value, bool) {
+	if len(m.appendpayload) == 0 {
+		return nil, false
+	}
+	return m.appendpayload, true
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (m *JobMutation) ClearPayload() {
+	m.payload = nil
+	m.appendpayload = nil
+	m.clearedFields[job.FieldPayload] = struct{}{}
+}
+
+// PayloadCleared returns if the "payload" field was cleared in this mutation.
+func (m *JobMutation) PayloadCleared() bool {
+	_, ok := m.clearedFields[job.FieldPayload]
+	return ok
+}
+
+// ResetPayload resets all changes to the "payload" field.
+func (m *JobMutation) ResetPayload() {
+	m.payload = nil
+	m.appendpayload = nil
+	delete(m.clearedFields, job.FieldPayload)
+}
+
+// SetResult sets the "result" field.
+func (m *JobMutation) SetResult(j jsontext.Value) {
+	m.result = &j
+	m.appendresult = nil
+}
+
+// Result returns the value of the "result" field in the mutation.
+func (m *JobMutation) Result() (r jsontext.Value, exists bool) {
+	v := m.result
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResult returns the old "result" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldResult(ctx context.Context) (v jsontext.Value, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResult is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResult requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResult: %w", err)
+	}
+	return oldValue.Result, nil
+}
+
+// AppendResult adds j to the "result" field.
+func (m *JobMutation) AppendResult(j jsontext.Value) {
+	m.appendresult = append(m.appendresult, j...)
+}
+
+// AppendedResult returns the list of values that were appended to the "result" field in this mutation.
+func (m *JobMutation) AppendedResult() (jsontext.Value, bool) {
+	if len(m.appendresult) == 0 {
+		return nil, false
+	}
+	return m.appendresult, true
+}
+
+// ClearResult clears the value of the "result" field.
+func (m *JobMutation) ClearResult() {
+	m.result = nil
+	m.appendresult = nil
+	m.clearedFields[job.FieldResult] = struct{}{}
+}
+
+// ResultCleared returns if the "result" field was cleared in this mutation.
+func (m *JobMutation) ResultCleared() bool {
+	_, ok := m.clearedFields[job.FieldResult]
+	return ok
+}
+
+// ResetResult resets all changes to the "result" field.
+func (m *JobMutation) ResetResult() {
+	m.result = nil
+	m.appendresult = nil
+	delete(m.clearedFields, job.FieldResult)
+}
+
+// SetError sets the "error" field.
+func (m *JobMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *JobMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *JobMutation) ResetError() {
+	m.error = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *JobMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *JobMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *JobMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *JobMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *JobMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (m *JobMutation) SetMaxAttempts(i int) {
+	m.max_attempts = &i
+	m.addmax_attempts = nil
+}
+
+// MaxAttempts returns the value of the "max_attempts" field in the mutation.
+func (m *JobMutation) MaxAttempts() (r int, exists bool) {
+	v := m.max_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxAttempts returns the old "max_attempts" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldMaxAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxAttempts: %w", err)
+	}
+	return oldValue.MaxAttempts, nil
+}
+
+// AddMaxAttempts adds i to the "max_attempts" field.
+func (m *JobMutation) AddMaxAttempts(i int) {
+	if m.addmax_attempts != nil {
+		*m.addmax_attempts += i
+	} else {
+		m.addmax_attempts = &i
+	}
+}
+
+// AddedMaxAttempts returns the value that was added to the "max_attempts" field in this mutation.
+func (m *JobMutation) AddedMaxAttempts() (r int, exists bool) {
+	v := m.addmax_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxAttempts resets all changes to the "max_attempts" field.
+func (m *JobMutation) ResetMaxAttempts() {
+	m.max_attempts = nil
+	m.addmax_attempts = nil
+}
+
+// SetRunAfter sets the "run_after" field.
+func (m *JobMutation) SetRunAfter(t time.Time) {
+	m.run_after = &t
+}
+
+// RunAfter returns the value of the "run_after" field in the mutation.
+func (m *JobMutation) RunAfter() (r time.Time, exists bool) {
+	v := m.run_after
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRunAfter returns the old "run_after" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldRunAfter(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRunAfter is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRunAfter requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRunAfter: %w", err)
+	}
+	return oldValue.RunAfter, nil
+}
+
+// ResetRunAfter resets all changes to the "run_after" field.
+func (m *JobMutation) ResetRunAfter() {
+	m.run_after = nil
+}
+
+// SetLockedBy sets the "locked_by" field.
+func (m *JobMutation) SetLockedBy(s string) {
+	m.locked_by = &s
+}
+
+// LockedBy returns the value of the "locked_by" field in the mutation.
+func (m *JobMutation) LockedBy() (r string, exists bool) {
+	v := m.locked_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLockedBy returns the old "locked_by" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldLockedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLockedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLockedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLockedBy: %w", err)
+	}
+	return oldValue.LockedBy, nil
+}
+
+// ResetLockedBy resets all changes to the "locked_by" field.
+func (m *JobMutation) ResetLockedBy() {
+	m.locked_by = nil
+}
+
+// SetLockedAt sets the "locked_at" field.
+func (m *JobMutation) SetLockedAt(t time.Time) {
+	m.locked_at = &t
+}
+
+// LockedAt returns the value of the "locked_at" field in the mutation.
+func (m *JobMutation) LockedAt() (r time.Time, exists bool) {
+	v := m.locked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLockedAt returns the old "locked_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldLockedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLockedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLockedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLockedAt: %w", err)
+	}
+	return oldValue.LockedAt, nil
+}
+
+// ClearLockedAt clears the value of the "locked_at" field.
+func (m *JobMutation) ClearLockedAt() {
+	m.locked_at = nil
+	m.clearedFields[job.FieldLockedAt] = struct{}{}
+}
+
+// LockedAtCleared returns if the "locked_at" field was cleared in this mutation.
+func (m *JobMutation) LockedAtCleared() bool {
+	_, ok := m.clearedFields[job.FieldLockedAt]
+	return ok
+}
+
+// ResetLockedAt resets all changes to the "locked_at" field.
+func (m *JobMutation) ResetLockedAt() {
+	m.locked_at = nil
+	delete(m.clearedFields, job.FieldLockedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *JobMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *JobMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *JobMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *JobMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *JobMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldStartedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (m *JobMutation) ClearStartedAt() {
+	m.started_at = nil
+	m.clearedFields[job.FieldStartedAt] = struct{}{}
+}
+
+// StartedAtCleared returns if the "started_at" field was cleared in this mutation.
+func (m *JobMutation) StartedAtCleared() bool {
+	_, ok := m.clearedFields[job.FieldStartedAt]
+	return ok
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *JobMutation) ResetStartedAt() {
+	m.started_at = nil
+	delete(m.clearedFields, job.FieldStartedAt)
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (m *JobMutation) SetFinishedAt(t time.Time) {
+	m.finished_at = &t
+}
+
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *JobMutation) FinishedAt() (r time.Time, exists bool) {
+	v := m.finished_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFinishedAt returns the old "finished_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldFinishedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
+	}
+	return oldValue.FinishedAt, nil
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (m *JobMutation) ClearFinishedAt() {
+	m.finished_at = nil
+	m.clearedFields[job.FieldFinishedAt] = struct{}{}
+}
+
+// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
+func (m *JobMutation) FinishedAtCleared() bool {
+	_, ok := m.clearedFields[job.FieldFinishedAt]
+	return ok
+}
+
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *JobMutation) ResetFinishedAt() {
+	m.finished_at = nil
+	delete(m.clearedFields, job.FieldFinishedAt)
+}
+
+// Where appends a list predicates to the JobMutation builder.
+func (m *JobMutation) Where(ps ...predicate.Job) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the JobMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *JobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Job, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *JobMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *JobMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Job).
+func (m *JobMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *JobMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m._type != nil {
+		fields = append(fields, job.FieldType)
+	}
+	if m.key != nil {
+		fields = append(fields, job.FieldKey)
+	}
+	if m.status != nil {
+		fields = append(fields, job.FieldStatus)
+	}
+	if m.payload != nil {
+		fields = append(fields, job.FieldPayload)
+	}
+	if m.result != nil {
+		fields = append(fields, job.FieldResult)
+	}
+	if m.error != nil {
+		fields = append(fields, job.FieldError)
+	}
+	if m.attempts != nil {
+		fields = append(fields, job.FieldAttempts)
+	}
+	if m.max_attempts != nil {
+		fields = append(fields, job.FieldMaxAttempts)
+	}
+	if m.run_after != nil {
+		fields = append(fields, job.FieldRunAfter)
+	}
+	if m.locked_by != nil {
+		fields = append(fields, job.FieldLockedBy)
+	}
+	if m.locked_at != nil {
+		fields = append(fields, job.FieldLockedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, job.FieldCreatedAt)
+	}
+	if m.started_at != nil {
+		fields = append(fields, job.FieldStartedAt)
+	}
+	if m.finished_at != nil {
+		fields = append(fields, job.FieldFinishedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *JobMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case job.FieldType:
+		return m.GetType()
+	case job.FieldKey:
+		return m.Key()
+	case job.FieldStatus:
+		return m.Status()
+	case job.FieldPayload:
+		return m.Payload()
+	case job.FieldResult:
+		return m.Result()
+	case job.FieldError:
+		return m.Error()
+	case job.FieldAttempts:
+		return m.Attempts()
+	case job.FieldMaxAttempts:
+		return m.MaxAttempts()
+	case job.FieldRunAfter:
+		return m.RunAfter()
+	case job.FieldLockedBy:
+		return m.LockedBy()
+	case job.FieldLockedAt:
+		return m.LockedAt()
+	case job.FieldCreatedAt:
+		return m.CreatedAt()
+	case job.FieldStartedAt:
+		return m.StartedAt()
+	case job.FieldFinishedAt:
+		return m.FinishedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *JobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case job.FieldType:
+		return m.OldType(ctx)
+	case job.FieldKey:
+		return m.OldKey(ctx)
+	case job.FieldStatus:
+		return m.OldStatus(ctx)
+	case job.FieldPayload:
+		return m.OldPayload(ctx)
+	case job.FieldResult:
+		return m.OldResult(ctx)
+	case job.FieldError:
+		return m.OldError(ctx)
+	case job.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case job.FieldMaxAttempts:
+		return m.OldMaxAttempts(ctx)
+	case job.FieldRunAfter:
+		return m.OldRunAfter(ctx)
+	case job.FieldLockedBy:
+		return m.OldLockedBy(ctx)
+	case job.FieldLockedAt:
+		return m.OldLockedAt(ctx)
+	case job.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case job.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case job.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Job field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case job.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case job.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case job.FieldStatus:
+		v, ok := value.(job.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case job.FieldPayload:
+		v, ok := value.(jsontext.Value)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case job.FieldResult:
+		v, ok := value.(jsontext.Value)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResult(v)
+		return nil
+	case job.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case job.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case job.FieldMaxAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxAttempts(v)
+		return nil
+	case job.FieldRunAfter:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRunAfter(v)
+		return nil
+	case job.FieldLockedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLockedBy(v)
+		return nil
+	case job.FieldLockedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLockedAt(v)
+		return nil
+	case job.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case job.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case job.FieldFinishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Job field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *JobMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, job.FieldAttempts)
+	}
+	if m.addmax_attempts != nil {
+		fields = append(fields, job.FieldMaxAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *JobMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case job.FieldAttempts:
+		return m.AddedAttempts()
+	case job.FieldMaxAttempts:
+		return m.AddedMaxAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case job.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	case job.FieldMaxAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Job numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *JobMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(job.FieldPayload) {
+		fields = append(fields, job.FieldPayload)
+	}
+	if m.FieldCleared(job.FieldResult) {
+		fields = append(fields, job.FieldResult)
+	}
+	if m.FieldCleared(job.FieldLockedAt) {
+		fields = append(fields, job.FieldLockedAt)
+	}
+	if m.FieldCleared(job.FieldStartedAt) {
+		fields = append(fields, job.FieldStartedAt)
+	}
+	if m.FieldCleared(job.FieldFinishedAt) {
+		fields = append(fields, job.FieldFinishedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *JobMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *JobMutation) ClearField(name string) error {
+	switch name {
+	case job.FieldPayload:
+		m.ClearPayload()
+		return nil
+	case job.FieldResult:
+		m.ClearResult()
+		return nil
+	case job.FieldLockedAt:
+		m.ClearLockedAt()
+		return nil
+	case job.FieldStartedAt:
+		m.ClearStartedAt()
+		return nil
+	case job.FieldFinishedAt:
+		m.ClearFinishedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Job nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *JobMutation) ResetField(name string) error {
+	switch name {
+	case job.FieldType:
+		m.ResetType()
+		return nil
+	case job.FieldKey:
+		m.ResetKey()
+		return nil
+	case job.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case job.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case job.FieldResult:
+		m.ResetResult()
+		return nil
+	case job.FieldError:
+		m.ResetError()
+		return nil
+	case job.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case job.FieldMaxAttempts:
+		m.ResetMaxAttempts()
+		return nil
+	case job.FieldRunAfter:
+		m.ResetRunAfter()
+		return nil
+	case job.FieldLockedBy:
+		m.ResetLockedBy()
+		return nil
+	case job.FieldLockedAt:
+		m.ResetLockedAt()
+		return nil
+	case job.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case job.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case job.FieldFinishedAt:
+		m.ResetFinishedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Job field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *JobMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *JobMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *JobMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *JobMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *JobMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *JobMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *JobMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Job unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *JobMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Job edge %s", name)
+}
+
+// LeaseMutation represents an operation that mutates the Lease nodes in the graph.
+type LeaseMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	name          *string
+	holder        *string
+	acquired_at   *time.Time
+	expires_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Lease, error)
+	predicates    []predicate.Lease
+}
+
+var _ ent.Mutation = (*LeaseMutation)(nil)
+
+// leaseOption allows management of the mutation configuration using functional options.
+type leaseOption func(*LeaseMutation)
+
+// newLeaseMutation creates new mutation for the Lease entity.
+func newLeaseMutation(c config, op Op, opts ...leaseOption) *LeaseMutation {
+	m := &LeaseMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLease,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLeaseID sets the ID field of the mutation.
+func withLeaseID(id string) leaseOption {
+	return func(m *LeaseMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Lease
+		)
+		m.oldValue = func(ctx context.Context) (*Lease, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Lease.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLease sets the old Lease of the mutation.
+func withLease(node *Lease) leaseOption {
+	return func(m *LeaseMutation) {
+		m.oldValue = func(context.Context) (*Lease, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LeaseMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LeaseMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Lease entities.
+func (m *LeaseMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LeaseMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LeaseMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Lease.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *LeaseMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *LeaseMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Lease entity.
+// If the Lease object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeaseMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *LeaseMutation) ResetName() {
+	m.name = nil
+}
+
+// SetHolder sets the "holder" field.
+func (m *LeaseMutation) SetHolder(s string) {
+	m.holder = &s
+}
+
+// Holder returns the value of the "holder" field in the mutation.
+func (m *LeaseMutation) Holder() (r string, exists bool) {
+	v := m.holder
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHolder returns the old "holder" field's value of the Lease entity.
+// If the Lease object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeaseMutation) OldHolder(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHolder is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHolder requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHolder: %w", err)
+	}
+	return oldValue.Holder, nil
+}
+
+// ResetHolder resets all changes to the "holder" field.
+func (m *LeaseMutation) ResetHolder() {
+	m.holder = nil
+}
+
+// SetAcquiredAt sets the "acquired_at" field.
+func (m *LeaseMutation) SetAcquiredAt(t time.Time) {
+	m.acquired_at = &t
+}
+
+// AcquiredAt returns the value of the "acquired_at" field in the mutation.
+func (m *LeaseMutation) AcquiredAt() (r time.Time, exists bool) {
+	v := m.acquired_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAcquiredAt returns the old "acquired_at" field's value of the Lease entity.
+// If the Lease object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeaseMutation) OldAcquiredAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAcquiredAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAcquiredAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAcquiredAt: %w", err)
+	}
+	return oldValue.AcquiredAt, nil
+}
+
+// ResetAcquiredAt resets all changes to the "acquired_at" field.
+func (m *LeaseMutation) ResetAcquiredAt() {
+	m.acquired_at = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *LeaseMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *LeaseMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the Lease entity.
+// If the Lease object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LeaseMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *LeaseMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// Where appends a list predicates to the LeaseMutation builder.
+func (m *LeaseMutation) Where(ps ...predicate.Lease) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LeaseMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LeaseMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Lease, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LeaseMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LeaseMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Lease).
+func (m *LeaseMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LeaseMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.name != nil {
+		fields = append(fields, lease.FieldName)
+	}
+	if m.holder != nil {
+		fields = append(fields, lease.FieldHolder)
+	}
+	if m.acquired_at != nil {
+		fields = append(fields, lease.FieldAcquiredAt)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, lease.FieldExpiresAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LeaseMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case lease.FieldName:
+		return m.Name()
+	case lease.FieldHolder:
+		return m.Holder()
+	case lease.FieldAcquiredAt:
+		return m.AcquiredAt()
+	case lease.FieldExpiresAt:
+		return m.ExpiresAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LeaseMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case lease.FieldName:
+		return m.OldName(ctx)
+	case lease.FieldHolder:
+		return m.OldHolder(ctx)
+	case lease.FieldAcquiredAt:
+		return m.OldAcquiredAt(ctx)
+	case lease.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Lease field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LeaseMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case lease.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case lease.FieldHolder:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHolder(v)
+		return nil
+	case lease.FieldAcquiredAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAcquiredAt(v)
+		return nil
+	case lease.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Lease field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LeaseMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LeaseMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LeaseMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Lease numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LeaseMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LeaseMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LeaseMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Lease nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LeaseMutation) ResetField(name string) error {
+	switch name {
+	case lease.FieldName:
+		m.ResetName()
+		return nil
+	case lease.FieldHolder:
+		m.ResetHolder()
+		return nil
+	case lease.FieldAcquiredAt:
+		m.ResetAcquiredAt()
+		return nil
+	case lease.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Lease field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LeaseMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LeaseMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LeaseMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LeaseMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LeaseMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LeaseMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LeaseMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Lease unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LeaseMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Lease edge %s", name)
+}
+
+// PlaylistMutation represents an operation that mutates the Playlist nodes in the graph.
+type PlaylistMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *string
+	spotify_id       *string
+	created_at       *time.Time
+	clearedFields    map[string]struct{}
+	snapshots        map[string]struct{}
+	removedsnapshots map[string]struct{}
+	clearedsnapshots bool
+	done             bool
+	oldValue         func(context.Context) (*Playlist, error)
+	predicates       []predicate.Playlist
+}
+
+var _ ent.Mutation = (*PlaylistMutation)(nil)
+
+// playlistOption allows management of the mutation configuration using functional options.
+type playlistOption func(*PlaylistMutation)
+
+// newPlaylistMutation creates new mutation for the Playlist entity.
+func newPlaylistMutation(c config, op Op, opts ...playlistOption) *PlaylistMutation {
+	m := &PlaylistMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePlaylist,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPlaylistID sets the ID field of the mutation.
+func withPlaylistID(id string) playlistOption {
+	return func(m *PlaylistMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Playlist
+		)
+		m.oldValue = func(ctx context.Context) (*Playlist, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Playlist.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPlaylist sets the old Playlist of the mutation.
+func withPlaylist(node *Playlist) playlistOption {
+	return func(m *PlaylistMutation) {
+		m.oldValue = func(context.Context) (*Playlist, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PlaylistMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PlaylistMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Playlist entities.
+func (m *PlaylistMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PlaylistMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PlaylistMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Playlist.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSpotifyID sets the "spotify_id" field.
+func (m *PlaylistMutation) SetSpotifyID(s string) {
+	m.spotify_id = &s
+}
+
+// SpotifyID returns the value of the "spotify_id" field in the mutation.
+func (m *PlaylistMutation) SpotifyID() (r string, exists bool) {
+	v := m.spotify_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSpotifyID returns the old "spotify_id" field's value of the Playlist entity.
+// If the Playlist object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaylistMutation) OldSpotifyID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSpotifyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSpotifyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSpotifyID: %w", err)
+	}
+	return oldValue.SpotifyID, nil
+}
+
+// ResetSpotifyID resets all changes to the "spotify_id" field.
+func (m *PlaylistMutation) ResetSpotifyID() {
+	m.spotify_id = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PlaylistMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PlaylistMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Playlist entity.
+// If the Playlist object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaylistMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PlaylistMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// AddSnapshotIDs adds the "snapshots" edge to the PlaylistSnapshot entity by ids.
+func (m *PlaylistMutation) AddSnapshotIDs(ids ...string) {
+	if m.snapshots == nil {
+		m.snapshots = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.snapshots[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSnapshots clears the "snapshots" edge to the PlaylistSnapshot entity.
+func (m *PlaylistMutation) ClearSnapshots() {
+	m.clearedsnapshots = true
+}
+
+// SnapshotsCleared reports if the "snapshots" edge to the PlaylistSnapshot entity was cleared.
+func (m *PlaylistMutation) SnapshotsCleared() bool {
+	return m.clearedsnapshots
+}
+
+// RemoveSnapshotIDs removes the "snapshots" edge to the PlaylistSnapshot entity by IDs.
+func (m *PlaylistMutation) RemoveSnapshotIDs(ids ...string) {
+	if m.removedsnapshots == nil {
+		m.removedsnapshots = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.snapshots, ids[i])
+		m.removedsnapshots[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSnapshots returns the removed IDs of the "snapshots" edge to the PlaylistSnapshot entity.
+func (m *PlaylistMutation) RemovedSnapshotsIDs() (ids []string) {
+	for id := range m.removedsnapshots {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SnapshotsIDs returns the "snapshots" edge IDs in the mutation.
+func (m *PlaylistMutation) SnapshotsIDs() (ids []string) {
+	for id := range m.snapshots {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSnapshots resets all changes to the "snapshots" edge.
+func (m *PlaylistMutation) ResetSnapshots() {
+	m.snapshots = nil
+	m.clearedsnapshots = false
+	m.removedsnapshots = nil
+}
+
+// Where appends a list predicates to the PlaylistMutation builder.
+func (m *PlaylistMutation) Where(ps ...predicate.Playlist) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PlaylistMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PlaylistMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Playlist, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PlaylistMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PlaylistMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Playlist).
+func (m *PlaylistMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PlaylistMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.spotify_id != nil {
+		fields = append(fields, playlist.FieldSpotifyID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, playlist.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PlaylistMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case playlist.FieldSpotifyID:
+		return m.SpotifyID()
+	case playlist.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PlaylistMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case playlist.FieldSpotifyID:
+		return m.OldSpotifyID(ctx)
+	case playlist.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Playlist field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PlaylistMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case playlist.FieldSpotifyID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpotifyID(v)
+		return nil
+	case playlist.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Playlist field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PlaylistMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PlaylistMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PlaylistMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Playlist numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PlaylistMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PlaylistMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PlaylistMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Playlist nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PlaylistMutation) ResetField(name string) error {
+	switch name {
+	case playlist.FieldSpotifyID:
+		m.ResetSpotifyID()
+		return nil
+	case playlist.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Playlist field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PlaylistMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.snapshots != nil {
+		edges = append(edges, playlist.EdgeSnapshots)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PlaylistMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case playlist.EdgeSnapshots:
+		ids := make([]ent.Value, 0, len(m.snapshots))
+		for id := range m.snapshots {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PlaylistMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedsnapshots != nil {
+		edges = append(edges, playlist.EdgeSnapshots)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PlaylistMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case playlist.EdgeSnapshots:
+		ids := make([]ent.Value, 0, len(m.removedsnapshots))
+		for id := range m.removedsnapshots {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PlaylistMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedsnapshots {
+		edges = append(edges, playlist.EdgeSnapshots)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PlaylistMutation) EdgeCleared(name string) bool {
+	switch name {
+	case playlist.EdgeSnapshots:
+		return m.clearedsnapshots
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PlaylistMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Playlist unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PlaylistMutation) ResetEdge(name string) error {
+	switch name {
+	case playlist.EdgeSnapshots:
+		m.ResetSnapshots()
+		return nil
+	}
+	return fmt.Errorf("unknown Playlist edge %s", name)
+}
+
+// PlaylistSnapshotMutation represents an operation that mutates the PlaylistSnapshot nodes in the graph.
+type PlaylistSnapshotMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *string
+	name            *string
+	description     *string
+	owner_id        *string
+	owner_name      *string
+	collaborative   *bool
+	public          *bool
+	snapshot_id     *string
+	total           *int
+	addtotal        *int
+	complete        *bool
+	pinned          *bool
+	note            *string
+	labels          *[]string
+	appendlabels    []string
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	playlist        *string
+	clearedplaylist bool
+	runs            map[string]struct{}
+	removedruns     map[string]struct{}
+	clearedruns     bool
+	items           map[string]struct{}
+	removeditems    map[string]struct{}
+	cleareditems    bool
+	done            bool
+	oldValue        func(context.Context) (*PlaylistSnapshot, error)
+	predicates      []predicate.PlaylistSnapshot
+}
+
+var _ ent.Mutation = (*PlaylistSnapshotMutation)(nil)
+
+// playlistsnapshotOption allows management of the mutation configuration using functional options.
+type playlistsnapshotOption func(*PlaylistSnapshotMutation)
+
+// newPlaylistSnapshotMutation creates new mutation for the PlaylistSnapshot entity.
+func newPlaylistSnapshotMutation(c config, op Op, opts ...playlistsnapshotOption) *PlaylistSnapshotMutation {
+	m := &PlaylistSnapshotMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePlaylistSnapshot,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPlaylistSnapshotID sets the ID field of the mutation.
+func withPlaylistSnapshotID(id string) playlistsnapshotOption {
+	return func(m *PlaylistSnapshotMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PlaylistSnapshot
+		)
+		m.oldValue = func(ctx context.Context) (*PlaylistSnapshot, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PlaylistSnapshot.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPlaylistSnapshot sets the old PlaylistSnapshot of the mutation.
+func withPlaylistSnapshot(node *PlaylistSnapshot) playlistsnapshotOption {
+	return func(m *PlaylistSnapshotMutation) {
+		m.oldValue = func(context.Context) (*PlaylistSnapshot, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PlaylistSnapshotMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PlaylistSnapshotMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PlaylistSnapshot entities.
+func (m *PlaylistSnapshotMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PlaylistSnapshotMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PlaylistSnapshotMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PlaylistSnapshot.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *PlaylistSnapshotMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *PlaylistSnapshotMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the PlaylistSnapshot entity.
+// If the PlaylistSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaylistSnapshotMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *PlaylistSnapshotMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *PlaylistSnapshotMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *PlaylistSnapshotMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the PlaylistSnapshot entity.
+// If the PlaylistSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaylistSnapshotMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *PlaylistSnapshotMutation) ResetDescription() {
+	m.description = nil
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (m *PlaylistSnapshotMutation) SetOwnerID(s string) {
+	m.owner_id = &s
+}
+
+// OwnerID returns the value of the "owner_id" field in the mutation.
+func (m *PlaylistSnapshotMutation) OwnerID() (r string, exists bool) {
+	v := m.owner_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwnerID returns the old "owner_id" field's value of the PlaylistSnapshot entity.
+// If the PlaylistSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaylistSnapshotMutation) OldOwnerID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+	}
+	return oldValue.OwnerID, nil
+}
+
+// ResetOwnerID resets all changes to the "owner_id" field.
+func (m *PlaylistSnapshotMutation) ResetOwnerID() {
+	m.owner_id = nil
+}
+
+// SetOwnerName sets the "owner_name" field.
+func (m *PlaylistSnapshotMutation) SetOwnerName(s string) {
+	m.owner_name = &s
+}
+
+// OwnerName returns the value of the "owner_name" field in the mutation.
+func (m *PlaylistSnapshotMutation) OwnerName() (r string, exists bool) {
+	v := m.owner_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwnerName returns the old "owner_name" field's value of the PlaylistSnapshot entity.
+// If the PlaylistSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaylistSnapshotMutation) OldOwnerName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerName: %w", err)
+	}
+	return oldValue.OwnerName, nil
+}
+
+// ResetOwnerName resets all changes to the "owner_name" field.
+func (m *PlaylistSnapshotMutation) ResetOwnerName() {
+	m.owner_name = nil
+}
+
+// SetCollaborative sets the "collaborative" field.
+func (m *PlaylistSnapshotMutation) SetCollaborative(b bool) {
+	m.collaborative = &b
+}
+
+// Collaborative returns the value of the "collaborative" field in the mutation.
+func (m *PlaylistSnapshotMutation) Collaborative() (r bool, exists bool) {
+	v := m.collaborative
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollaborative returns the old "collaborative" field's value of the PlaylistSnapshot entity.
+// If the PlaylistSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaylistSnapshotMutation) OldCollaborative(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollaborative is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollaborative requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollaborative: %w", err)
+	}
+	return oldValue.Collaborative, nil
+}
+
+// ResetCollaborative resets all changes to the "collaborative" field.
+func (m *PlaylistSnapshotMutation) ResetCollaborative() {
+	m.collaborative = nil
+}
+
+// SetPublic sets the "public" field.
+func (m *PlaylistSnapshotMutation) SetPublic(b bool) {
+	m.public = &b
+}
+
+// Public returns the value of the "public" field in the mutation.
+func (m *PlaylistSnapshotMutation) Public() (r bool, exists bool) {
+	v := m.public
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublic returns the old "public" field's value of the PlaylistSnapshot entity.
+// If the PlaylistSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaylistSnapshotMutation) OldPublic(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublic is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublic requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublic: %w", err)
+	}
+	return oldValue.Public, nil
+}
+
+// ClearPublic clears the value of the "public" field.
+func (m *PlaylistSnapshotMutation) ClearPublic() {
+	m.public = nil
+	m.clearedFields[playlistsnapshot.FieldPublic] = struct{}{}
+}
+
+// PublicCleared returns if the "public" field was cleared in this mutation.
+func (m *PlaylistSnapshotMutation) PublicCleared() bool {
+	_, ok := m.clearedFields[playlistsnapshot.FieldPublic]
+	return ok
+}
+
+// ResetPublic resets all changes to the "public" field.
+func (m *PlaylistSnapshotMutation) ResetPublic() {
+	m.public = nil
+	delete(m.clearedFields, playlistsnapshot.FieldPublic)
+}
+
+// SetSnapshotID sets the "snapshot_id" field.
+func (m *PlaylistSnapshotMutation) SetSnapshotID(s string) {
+	m.snapshot_id = &s
+}
+
+// SnapshotID returns the value of the "snapshot_id" field in the mutation.
+func (m *PlaylistSnapshotMutation) SnapshotID() (r string, exists bool) {
+	v := m.snapshot_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSnapshotID returns the old "snapshot_id" field's value of the PlaylistSnapshot entity.
+// If the PlaylistSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaylistSnapshotMutation) OldSnapshotID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSnapshotID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSnapshotID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSnapshotID: %w", err)
+	}
+	return oldValue.SnapshotID, nil
+}
+
+// ResetSnapshotID resets all changes to the "snapshot_id" field.
+func (m *PlaylistSnapshotMutation) ResetSnapshotID() {
+	m.snapshot_id = nil
+}
+
+// SetTotal sets the "total" field.
+func (m *PlaylistSnapshotMutation) SetTotal(i int) {
+	m.total = &i
+	m.addtotal = nil
+}
+
+// Total returns the value of the "total" field in the mutation.
+func (m *PlaylistSnapshotMutation) Total() (r int, exists bool) {
+	v := m.total
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotal returns the old "total" field's value of the PlaylistSnapshot entity.
+// If the PlaylistSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaylistSnapshotMutation) OldTotal(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotal: %w", err)
+	}
+	return oldValue.Total, nil
+}
+
+// AddTotal adds i to the "total" field.
+func (m *PlaylistSnapshotMutation) AddTotal(i int) {
+	if m.addtotal != nil {
+		*m.addtotal += i
+	} else {
+		m.addtotal = &i
+	}
+}
+
+// AddedTotal returns the value that was added to the "total" field in this mutation.
+func (m *PlaylistSnapshotMutation) AddedTotal() (r int, exists bool) {
+	v := m.addtotal
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotal resets all changes to the "total" field.
+func (m *PlaylistSnapshotMutation) ResetTotal() {
+	m.total = nil
+	m.addtotal = nil
+}
+
+// SetComplete sets the "complete" field.
+func (m *PlaylistSnapshotMutation) SetComplete(b bool) {
+	m.complete = &b
+}
+
+// Complete returns the value of the "complete" field in the mutation.
+func (m *PlaylistSnapshotMutation) Complete() (r bool, exists bool) {
+	v := m.complete
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldComplete returns the old "complete" field's value of the PlaylistSnapshot entity.
+// If the PlaylistSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaylistSnapshotMutation) OldComplete(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldComplete is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldComplete requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldComplete: %w", err)
+	}
+	return oldValue.Complete, nil
+}
+
+// ResetComplete resets all changes to the "complete" field.
+func (m *PlaylistSnapshotMutation) ResetComplete() {
+	m.complete = nil
+}
+
+// SetPinned sets the "pinned" field.
+func (m *PlaylistSnapshotMutation) SetPinned(b bool) {
+	m.pinned = &b
+}
+
+// Pinned returns the value of the "pinned" field in the mutation.
+func (m *PlaylistSnapshotMutation) Pinned() (r bool, exists bool) {
+	v := m.pinned
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPinned returns the old "pinned" field's value of the PlaylistSnapshot entity.
+// If the PlaylistSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaylistSnapshotMutation) OldPinned(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPinned is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPinned requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPinned: %w", err)
+	}
+	return oldValue.Pinned, nil
+}
+
+// ResetPinned resets all changes to the "pinned" field.
+func (m *PlaylistSnapshotMutation) ResetPinned() {
+	m.pinned = nil
+}
+
+// SetNote sets the "note" field.
+func (m *PlaylistSnapshotMutation) SetNote(s string) {
+	m.note = &s
+}
+
+// Note returns the value of the "note" field in the mutation.
+func (m *PlaylistSnapshotMutation) Note() (r string, exists bool) {
+	v := m.note
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNote returns the old "note" field's value of the PlaylistSnapshot entity.
+// If the PlaylistSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaylistSnapshotMutation) OldNote(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNote is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNote requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNote: %w", err)
+	}
+	return oldValue.Note, nil
+}
+
+// ResetNote resets all changes to the "note" field.
+func (m *PlaylistSnapshotMutation) ResetNote() {
+	m.note = nil
+}
+
+// SetLabels sets the "labels" field.
+func (m *PlaylistSnapshotMutation) SetLabels(s []string) {
+	m.labels = &s
+	m.appendlabels = nil
+}
+
+// Labels returns the value of the "labels" field in the mutation.
+func (m *PlaylistSnapshotMutation) Labels() (r []string, exists bool) {
+	v := m.labels
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabels returns the old "labels" field's value of the PlaylistSnapshot entity.
+// If the PlaylistSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaylistSnapshotMutation) OldLabels(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabels is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabels requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabels: %w", err)
+	}
+	return oldValue.Labels, nil
+}
+
+// AppendLabels adds s to the "labels" field.
+func (m *PlaylistSnapshotMutation) AppendLabels(s []string) {
+	m.appendlabels = append(m.appendlabels, s...)
+}
+
+// AppendedLabels returns the list of values that were appended to the "labels" field in this mutation.
+func (m *PlaylistSnapshotMutation) AppendedLabels() ([]string, bool) {
+	if len(m.appendlabels) == 0 {
+		return nil, false
+	}
+	return m.appendlabels, true
+}
+
+// ResetLabels resets all changes to the "labels" field.
+func (m *PlaylistSnapshotMutation) ResetLabels() {
+	m.labels = nil
+	m.appendlabels = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PlaylistSnapshotMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PlaylistSnapshotMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PlaylistSnapshot entity.
+// If the PlaylistSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaylistSnapshotMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PlaylistSnapshotMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetPlaylistID sets the "playlist" edge to the Playlist entity by id.
+func (m *PlaylistSnapshotMutation) SetPlaylistID(id string) {
+	m.playlist = &id
+}
+
+// ClearPlaylist clears the "playlist" edge to the Playlist entity.
+func (m *PlaylistSnapshotMutation) ClearPlaylist() {
+	m.clearedplaylist = true
+}
+
+// PlaylistCleared reports if the "playlist" edge to the Playlist entity was cleared.
+func (m *PlaylistSnapshotMutation) PlaylistCleared() bool {
+	return m.clearedplaylist
+}
+
+// PlaylistID returns the "playlist" edge ID in the mutation.
+func (m *PlaylistSnapshotMutation) PlaylistID() (id string, exists bool) {
+	if m.playlist != nil {
+		return *m.playlist, true
+	}
+	return
+}
+
+// PlaylistIDs returns the "playlist" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PlaylistID instead. It exists only for internal usage by the builders.
+func (m *PlaylistSnapshotMutation) PlaylistIDs() (ids []string) {
+	if id := m.playlist; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPlaylist resets all changes to the "playlist" edge.
+func (m *PlaylistSnapshotMutation) ResetPlaylist() {
+	m.playlist = nil
+	m.clearedplaylist = false
+}
+
+// AddRunIDs adds the "runs" edge to the BackupRun entity by ids.
+func (m *PlaylistSnapshotMutation) AddRunIDs(ids ...string) {
+	if m.runs == nil {
+		m.runs = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.runs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRuns clears the "runs" edge to the BackupRun entity.
+func (m *PlaylistSnapshotMutation) ClearRuns() {
+	m.clearedruns = true
+}
+
+// RunsCleared reports if the "runs" edge to the BackupRun entity was cleared.
+func (m *PlaylistSnapshotMutation) RunsCleared() bool {
+	return m.clearedruns
+}
+
+// RemoveRunIDs removes the "runs" edge to the BackupRun entity by IDs.
+func (m *PlaylistSnapshotMutation) RemoveRunIDs(ids ...string) {
+	if m.removedruns == nil {
+		m.removedruns = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.runs, ids[i])
+		m.removedruns[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRuns returns the removed IDs of the "runs" edge to the BackupRun entity.
+func (m *PlaylistSnapshotMutation) RemovedRunsIDs() (ids []string) {
+	for id := range m.removedruns {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RunsIDs returns the "runs" edge IDs in the mutation.
+func (m *PlaylistSnapshotMutation) RunsIDs() (ids []string) {
+	for id := range m.runs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRuns resets all changes to the "runs" edge.
+func (m *PlaylistSnapshotMutation) ResetRuns() {
+	m.runs = nil
+	m.clearedruns = false
+	m.removedruns = nil
+}
+
+// AddItemIDs adds the "items" edge to the SnapshotItem entity by ids.
+func (m *PlaylistSnapshotMutation) AddItemIDs(ids ...string) {
+	if m.items == nil {
+		m.items = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.items[ids[i]] = struct{}{}
+	}
+}
+
+// ClearItems clears the "items" edge to the SnapshotItem entity.
+func (m *PlaylistSnapshotMutation) ClearItems() {
+	m.cleareditems = true
+}
+
+// ItemsCleared reports if the "items" edge to the SnapshotItem entity was cleared.
+func (m *PlaylistSnapshotMutation) ItemsCleared() bool {
+	return m.cleareditems
+}
+
+// RemoveItemIDs removes the "items" edge to the SnapshotItem entity by IDs.
+func (m *PlaylistSnapshotMutation) RemoveItemIDs(ids ...string) {
+	if m.removeditems == nil {
+		m.removeditems = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.items, ids[i])
+		m.removeditems[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedItems returns the removed IDs of the "items" edge to the SnapshotItem entity.
+func (m *PlaylistSnapshotMutation) RemovedItemsIDs() (ids []string) {
+	for id := range m.removeditems {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ItemsIDs returns the "items" edge IDs in the mutation.
+func (m *PlaylistSnapshotMutation) ItemsIDs() (ids []string) {
+	for id := range m.items {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetItems resets all changes to the "items" edge.
+func (m *PlaylistSnapshotMutation) ResetItems() {
+	m.items = nil
+	m.cleareditems = false
+	m.removeditems = nil
+}
+
+// Where appends a list predicates to the PlaylistSnapshotMutation builder.
+func (m *PlaylistSnapshotMutation) Where(ps ...predicate.PlaylistSnapshot) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PlaylistSnapshotMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PlaylistSnapshotMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PlaylistSnapshot, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PlaylistSnapshotMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PlaylistSnapshotMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PlaylistSnapshot).
+func (m *PlaylistSnapshotMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PlaylistSnapshotMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.name != nil {
+		fields = append(fields, playlistsnapshot.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, playlistsnapshot.FieldDescription)
+	}
+	if m.owner_id != nil {
+		fields = append(fields, playlistsnapshot.FieldOwnerID)
+	}
+	if m.owner_name != nil {
+		fields = append(fields, playlistsnapshot.FieldOwnerName)
+	}
+	if m.collaborative != nil {
+		fields = append(fields, playlistsnapshot.FieldCollaborative)
+	}
+	if m.public != nil {
+		fields = append(fields, playlistsnapshot.FieldPublic)
+	}
+	if m.snapshot_id != nil {
+		fields = append(fields, playlistsnapshot.FieldSnapshotID)
+	}
+	if m.total != nil {
+		fields = append(fields, playlistsnapshot.FieldTotal)
+	}
+	if m.complete != nil {
+		fields = append(fields, playlistsnapshot.FieldComplete)
+	}
+	if m.pinned != nil {
+		fields = append(fields, playlistsnapshot.FieldPinned)
+	}
+	if m.note != nil {
+		fields = append(fields, playlistsnapshot.FieldNote)
+	}
+	if m.labels != nil {
+		fields = append(fields, playlistsnapshot.FieldLabels)
+	}
+	if m.created_at != nil {
+		fields = append(fields, playlistsnapshot.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PlaylistSnapshotMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case playlistsnapshot.FieldName:
+		return m.Name()
+	case playlistsnapshot.FieldDescription:
+		return m.Description()
+	case playlistsnapshot.FieldOwnerID:
+		return m.OwnerID()
+	case playlistsnapshot.FieldOwnerName:
+		return m.OwnerName()
+	case playlistsnapshot.FieldCollaborative:
+		return m.Collaborative()
+	case playlistsnapshot.FieldPublic:
+		return m.Public()
+	case playlistsnapshot.FieldSnapshotID:
+		return m.SnapshotID()
+	case playlistsnapshot.FieldTotal:
+		return m.Total()
+	case playlistsnapshot.FieldComplete:
+		return m.Complete()
+	case playlistsnapshot.FieldPinned:
+		return m.Pinned()
+	case playlistsnapshot.FieldNote:
+		return m.Note()
+	case playlistsnapshot.FieldLabels:
+		return m.Labels()
+	case playlistsnapshot.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PlaylistSnapshotMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case playlistsnapshot.FieldName:
+		return m.OldName(ctx)
+	case playlistsnapshot.FieldDescription:
+		return m.OldDescription(ctx)
+	case playlistsnapshot.FieldOwnerID:
+		return m.OldOwnerID(ctx)
+	case playlistsnapshot.FieldOwnerName:
+		return m.OldOwnerName(ctx)
+	case playlistsnapshot.FieldCollaborative:
+		return m.OldCollaborative(ctx)
+	case playlistsnapshot.FieldPublic:
+		return m.OldPublic(ctx)
+	case playlistsnapshot.FieldSnapshotID:
+		return m.OldSnapshotID(ctx)
+	case playlistsnapshot.FieldTotal:
+		return m.OldTotal(ctx)
+	case playlistsnapshot.FieldComplete:
+		return m.OldComplete(ctx)
+	case playlistsnapshot.FieldPinned:
+		return m.OldPinned(ctx)
+	case playlistsnapshot.FieldNote:
+		return m.OldNote(ctx)
+	case playlistsnapshot.FieldLabels:
+		return m.OldLabels(ctx)
+	case playlistsnapshot.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PlaylistSnapshot field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PlaylistSnapshotMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case playlistsnapshot.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case playlistsnapshot.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case playlistsnapshot.FieldOwnerID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerID(v)
+		return nil
+	case playlistsnapshot.FieldOwnerName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerName(v)
+		return nil
+	case playlistsnapshot.FieldCollaborative:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollaborative(v)
+		return nil
+	case playlistsnapshot.FieldPublic:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublic(v)
+		return nil
+	case playlistsnapshot.FieldSnapshotID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSnapshotID(v)
+		return nil
+	case playlistsnapshot.FieldTotal:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotal(v)
+		return nil
+	case playlistsnapshot.FieldComplete:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetComplete(v)
+		return nil
+	case playlistsnapshot.FieldPinned:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPinned(v)
+		return nil
+	case playlistsnapshot.FieldNote:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNote(v)
+		return nil
+	case playlistsnapshot.FieldLabels:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabels(v)
+		return nil
+	case playlistsnapshot.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PlaylistSnapshot field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PlaylistSnapshotMutation) AddedFields() []string {
+	var fields []string
+	if m.addtotal != nil {
+		fields = append(fields, playlistsnapshot.FieldTotal)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PlaylistSnapshotMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case playlistsnapshot.FieldTotal:
+		return m.AddedTotal()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PlaylistSnapshotMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case playlistsnapshot.FieldTotal:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotal(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PlaylistSnapshot numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PlaylistSnapshotMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(playlistsnapshot.FieldPublic) {
+		fields = append(fields, playlistsnapshot.FieldPublic)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PlaylistSnapshotMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PlaylistSnapshotMutation) ClearField(name string) error {
+	switch name {
+	case playlistsnapshot.FieldPublic:
+		m.ClearPublic()
+		return nil
+	}
+	return fmt.Errorf("unknown PlaylistSnapshot nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PlaylistSnapshotMutation) ResetField(name string) error {
+	switch name {
+	case playlistsnapshot.FieldName:
+		m.ResetName()
+		return nil
+	case playlistsnapshot.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case playlistsnapshot.FieldOwnerID:
+		m.ResetOwnerID()
+		return nil
+	case playlistsnapshot.FieldOwnerName:
+		m.ResetOwnerName()
+		return nil
+	case playlistsnapshot.FieldCollaborative:
+		m.ResetCollaborative()
+		return nil
+	case playlistsnapshot.FieldPublic:
+		m.ResetPublic()
+		return nil
+	case playlistsnapshot.FieldSnapshotID:
+		m.ResetSnapshotID()
+		return nil
+	case playlistsnapshot.FieldTotal:
+		m.ResetTotal()
+		return nil
+	case playlistsnapshot.FieldComplete:
+		m.ResetComplete()
+		return nil
+	case playlistsnapshot.FieldPinned:
+		m.ResetPinned()
+		return nil
+	case playlistsnapshot.FieldNote:
+		m.ResetNote()
+		return nil
+	case playlistsnapshot.FieldLabels:
+		m.ResetLabels()
+		return nil
+	case playlistsnapshot.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PlaylistSnapshot field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PlaylistSnapshotMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.playlist != nil {
+		edges = append(edges, playlistsnapshot.EdgePlaylist)
+	}
+	if m.runs != nil {
+		edges = append(edges, playlistsnapshot.EdgeRuns)
+	}
+	if m.items != nil {
+		edges = append(edges, playlistsnapshot.EdgeItems)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PlaylistSnapshotMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case playlistsnapshot.EdgePlaylist:
+		if id := m.playlist; id != nil {
+			return []ent.Value{*id}
+		}
+	case playlistsnapshot.EdgeRuns:
+		ids := make([]ent.Value, 0, len(m.runs))
+		for id := range m.runs {
+			ids = append(ids, id)
+		}
+		return ids
+	case playlistsnapshot.EdgeItems:
+		ids := make([]ent.Value, 0, len(m.items))
+		for id := range m.items {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PlaylistSnapshotMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedruns != nil {
+		edges = append(edges, playlistsnapshot.EdgeRuns)
+	}
+	if m.removeditems != nil {
+		edges = append(edges, playlistsnapshot.EdgeItems)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PlaylistSnapshotMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case playlistsnapshot.EdgeRuns:
+		ids := make([]ent.Value, 0, len(m.removedruns))
+		for id := range m.removedruns {
+			ids = append(ids, id)
+		}
+		return ids
+	case playlistsnapshot.EdgeItems:
+		ids := make([]ent.Value, 0, len(m.removeditems))
+		for id := range m.removeditems {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PlaylistSnapshotMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedplaylist {
+		edges = append(edges, playlistsnapshot.EdgePlaylist)
+	}
+	if m.clearedruns {
+		edges = append(edges, playlistsnapshot.EdgeRuns)
+	}
+	if m.cleareditems {
+		edges = append(edges, playlistsnapshot.EdgeItems)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PlaylistSnapshotMutation) EdgeCleared(name string) bool {
+	switch name {
+	case playlistsnapshot.EdgePlaylist:
+		return m.clearedplaylist
+	case playlistsnapshot.EdgeRuns:
+		return m.clearedruns
+	case playlistsnapshot.EdgeItems:
+		return m.cleareditems
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PlaylistSnapshotMutation) ClearEdge(name string) error {
+	switch name {
+	case playlistsnapshot.EdgePlaylist:
+		m.ClearPlaylist()
+		return nil
+	}
+	return fmt.Errorf("unknown PlaylistSnapshot unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PlaylistSnapshotMutation) ResetEdge(name string) error {
+	switch name {
+	case playlistsnapshot.EdgePlaylist:
+		m.ResetPlaylist()
+		return nil
+	case playlistsnapshot.EdgeRuns:
+		m.ResetRuns()
+		return nil
+	case playlistsnapshot.EdgeItems:
+		m.ResetItems()
+		return nil
+	}
+	return fmt.Errorf("unknown PlaylistSnapshot edge %s", name)
+}
+
+// RestorePlanMutation represents an operation that mutates the RestorePlan nodes in the graph.
+type RestorePlanMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *string
+	user_id           *string
+	run_id            *string
+	strategy          *restoreplan.Strategy
+	status            *restoreplan.Status
+	playlists         *[]schematype.PlaylistRestore
+	appendplaylists   []schematype.PlaylistRestore
+	collections       *[]schematype.CollectionRestore
+	appendcollections []schematype.CollectionRestore
+	drift             *[]string
+	appenddrift       []string
+	error             *string
+	created_at        *time.Time
+	applied_at        *time.Time
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*RestorePlan, error)
+	predicates        []predicate.RestorePlan
+}
+
+var _ ent.Mutation = (*RestorePlanMutation)(nil)
+
+// restoreplanOption allows management of the mutation configuration using functional options.
+type restoreplanOption func(*RestorePlanMutation)
+
+// newRestorePlanMutation creates new mutation for the RestorePlan entity.
+func newRestorePlanMutation(c config, op Op, opts ...restoreplanOption) *RestorePlanMutation {
+	m := &RestorePlanMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRestorePlan,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRestorePlanID sets the ID field of the mutation.
+func withRestorePlanID(id string) restoreplanOption {
+	return func(m *RestorePlanMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RestorePlan
+		)
+		m.oldValue = func(ctx context.Context) (*RestorePlan, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RestorePlan.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRestorePlan sets the old RestorePlan of the mutation.
+func withRestorePlan(node *RestorePlan) restoreplanOption {
+	return func(m *RestorePlanMutation) {
+		m.oldValue = func(context.Context) (*RestorePlan, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RestorePlanMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RestorePlanMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RestorePlan entities.
+func (m *RestorePlanMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RestorePlanMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RestorePlanMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RestorePlan.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *RestorePlanMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *RestorePlanMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the RestorePlan entity.
+// If the RestorePlan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RestorePlanMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *RestorePlanMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetRunID sets the "run_id" field.
+func (m *RestorePlanMutation) SetRunID(s string) {
+	m.run_id = &s
+}
+
+// RunID returns the value of the "run_id" field in the mutation.
+func (m *RestorePlanMutation) RunID() (r string, exists bool) {
+	v := m.run_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRunID returns the old "run_id" field's value of the RestorePlan entity.
+// If the RestorePlan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RestorePlanMutation) OldRunID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRunID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRunID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRunID: %w", err)
+	}
+	return oldValue.RunID, nil
+}
+
+// ResetRunID resets all changes to the "run_id" field.
+func (m *RestorePlanMutation) ResetRunID() {
+	m.run_id = nil
+}
+
+// SetStrategy sets the "strategy" field.
+func (m *RestorePlanMutation) SetStrategy(r restoreplan.Strategy) {
+	m.strategy = &r
+}
+
+// Strategy returns the value of the "strategy" field in the mutation.
+func (m *RestorePlanMutation) Strategy() (r restoreplan.Strategy, exists bool) {
+	v := m.strategy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStrategy returns the old "strategy" field's value of the RestorePlan entity.
+// If the RestorePlan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RestorePlanMutation) OldStrategy(ctx context.Context) (v restoreplan.Strategy, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStrategy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStrategy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStrategy: %w", err)
+	}
+	return oldValue.Strategy, nil
+}
+
+// ResetStrategy resets all changes to the "strategy" field.
+func (m *RestorePlanMutation) ResetStrategy() {
+	m.strategy = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *RestorePlanMutation) SetStatus(r restoreplan.Status) {
+	m.status = &r
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *RestorePlanMutation) Status() (r restoreplan.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the RestorePlan entity.
+// If the RestorePlan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RestorePlanMutation) OldStatus(ctx context.Context) (v restoreplan.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *RestorePlanMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetPlaylists sets the "playlists" field.
+func (m *RestorePlanMutation) SetPlaylists(sr []schematype.PlaylistRestore) {
+	m.playlists = &sr
+	m.appendplaylists = nil
+}
+
+// Playlists returns the value of the "playlists" field in the mutation.
+func (m *RestorePlanMutation) Playlists() (r []schematype.PlaylistRestore, exists bool) {
+	v := m.playlists
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlaylists returns the old "playlists" field's value of the RestorePlan entity.
+// If the RestorePlan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RestorePlanMutation) OldPlaylists(ctx context.Context) (v []schematype.PlaylistRestore, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlaylists is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlaylists requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlaylists: %w", err)
+	}
+	return oldValue.Playlists, nil
+}
+
+// AppendPlaylists adds sr to the "playlists" field.
+func (m *RestorePlanMutation) AppendPlaylists(sr []schematype.PlaylistRestore) {
+	m.appendplaylists = append(m.appendplaylists, sr...)
+}
+
+// AppendedPlaylists returns the list of values that were appended to the "playlists" field in this mutation.
+func (m *RestorePlanMutation) AppendedPlaylists() ([]schematype.PlaylistRestore, bool) {
+	if len(m.appendplaylists) == 0 {
+		return nil, false
+	}
+	return m.appendplaylists, true
+}
+
+// ResetPlaylists resets all changes to the "playlists" field.
+func (m *RestorePlanMutation) ResetPlaylists() {
+	m.playlists = nil
+	m.appendplaylists = nil
+}
+
+// SetCollections sets the "collections" field.
+func (m *RestorePlanMutation) SetCollections(sr []schematype.CollectionRestore) {
+	m.collections = &sr
+	m.appendcollections = nil
+}
+
+// Collections returns the value of the "collections" field in the mutation.
+func (m *RestorePlanMutation) Collections() (r []schematype.CollectionRestore, exists bool) {
+	v := m.collections
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollections returns the old "collections" field's value of the RestorePlan entity.
+// If the RestorePlan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RestorePlanMutation) OldCollections(ctx context.Context) (v []schematype.CollectionRestore, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollections is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollections requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollections: %w", err)
+	}
+	return oldValue.Collections, nil
+}
+
+// AppendCollections adds sr to the "collections" field.
+func (m *RestorePlanMutation) AppendCollections(sr []schematype.CollectionRestore) {
+	m.appendcollections = append(m.appendcollections, sr...)
+}
+
+// AppendedCollections returns the list of values that were appended to the "collections" field in this mutation.
+func (m *RestorePlanMutation) AppendedCollections() ([]schematype.CollectionRestore, bool) {
+	if len(m.appendcollections) == 0 {
+		return nil, false
+	}
+	return m.appendcollections, true
+}
+
+// ResetCollections resets all changes to the "collections" field.
+func (m *RestorePlanMutation) ResetCollections() {
+	m.collections = nil
+	m.appendcollections = nil
+}
+
+// SetDrift sets the "drift" field.
+func (m *RestorePlanMutation) SetDrift(s []string) {
+	m.drift = &s
+	m.appenddrift = nil
+}
+
+// Drift returns the value of the "drift" field in the mutation.
+func (m *RestorePlanMutation) Drift() (r []string, exists bool) {
+	v := m.drift
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDrift returns the old "drift" field's value of the RestorePlan entity.
+// If the RestorePlan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RestorePlanMutation) OldDrift(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDrift is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDrift requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDrift: %w", err)
+	}
+	return oldValue.Drift, nil
+}
+
+// AppendDrift adds s to the "drift" field.
+func (m *RestorePlanMutation) AppendDrift(s []string) {
+	m.appenddrift = append(m.appenddrift, s...)
+}
+
+// AppendedDrift returns the list of values that were appended to the "drift" field in this mutation.
+func (m *RestorePlanMutation) AppendedDrift() ([]string, bool) {
+	if len(m.appenddrift) == 0 {
+		return nil, false
+	}
+	return m.appenddrift, true
+}
+
+// ResetDrift resets all changes to the "drift" field.
+func (m *RestorePlanMutation) ResetDrift() {
+	m.drift = nil
+	m.appenddrift = nil
+}
+
+// SetError sets the "error" field.
+func (m *RestorePlanMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *RestorePlanMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the RestorePlan entity.
+// If the RestorePlan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RestorePlanMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *RestorePlanMutation) ResetError() {
+	m.error = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RestorePlanMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RestorePlanMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RestorePlan entity.
+// If the RestorePlan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RestorePlanMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RestorePlanMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (m *RestorePlanMutation) SetAppliedAt(t time.Time) {
+	m.applied_at = &t
+}
+
+// AppliedAt returns the value of the "applied_at" field in the mutation.
+func (m *RestorePlanMutation) AppliedAt() (r time.Time, exists bool) {
+	v := m.applied_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAppliedAt returns the old "applied_at" field's value of the RestorePlan entity.
+// If the RestorePlan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RestorePlanMutation) OldAppliedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAppliedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAppliedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAppliedAt: %w", err)
+	}
+	return oldValue.AppliedAt, nil
+}
+
+// ClearAppliedAt clears the value of the "applied_at" field.
+func (m *RestorePlanMutation) ClearAppliedAt() {
+	m.applied_at = nil
+	m.clearedFields[restoreplan.FieldAppliedAt] = struct{}{}
+}
+
+// AppliedAtCleared returns if the "applied_at" field was cleared in this mutation.
+func (m *RestorePlanMutation) AppliedAtCleared() bool {
+	_, ok := m.clearedFields[restoreplan.FieldAppliedAt]
+	return ok
+}
+
+// ResetAppliedAt resets all changes to the "applied_at" field.
+func (m *RestorePlanMutation) ResetAppliedAt() {
+	m.applied_at = nil
+	delete(m.clearedFields, restoreplan.FieldAppliedAt)
+}
+
+// Where appends a list predicates to the RestorePlanMutation builder.
+func (m *RestorePlanMutation) Where(ps ...predicate.RestorePlan) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RestorePlanMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RestorePlanMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RestorePlan, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RestorePlanMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RestorePlanMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RestorePlan).
+func (m *RestorePlanMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RestorePlanMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.user_id != nil {
+		fields = append(fields, restoreplan.FieldUserID)
+	}
+	if m.run_id != nil {
+		fields = append(fields, restoreplan.FieldRunID)
+	}
+	if m.strategy != nil {
+		fields = append(fields, restoreplan.FieldStrategy)
+	}
+	if m.status != nil {
+		fields = append(fields, restoreplan.FieldStatus)
+	}
+	if m.playlists != nil {
+		fields = append(fields, restoreplan.FieldPlaylists)
+	}
+	if m.collections != nil {
+		fields = append(fields, restoreplan.FieldCollections)
+	}
+	if m.drift != nil {
+		fields = append(fields, restoreplan.FieldDrift)
+	}
+	if m.error != nil {
+		fields = append(fields, restoreplan.FieldError)
+	}
+	if m.created_at != nil {
+		fields = append(fields, restoreplan.FieldCreatedAt)
+	}
+	if m.applied_at != nil {
+		fields = append(fields, restoreplan.FieldAppliedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RestorePlanMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case restoreplan.FieldUserID:
+		return m.UserID()
+	case restoreplan.FieldRunID:
+		return m.RunID()
+	case restoreplan.FieldStrategy:
+		return m.Strategy()
+	case restoreplan.FieldStatus:
+		return m.Status()
+	case restoreplan.FieldPlaylists:
+		return m.Playlists()
+	case restoreplan.FieldCollections:
+		return m.Collections()
+	case restoreplan.FieldDrift:
+		return m.Drift()
+	case restoreplan.FieldError:
+		return m.Error()
+	case restoreplan.FieldCreatedAt:
+		return m.CreatedAt()
+	case restoreplan.FieldAppliedAt:
+		return m.AppliedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RestorePlanMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case restoreplan.FieldUserID:
+		return m.OldUserID(ctx)
+	case restoreplan.FieldRunID:
+		return m.OldRunID(ctx)
+	case restoreplan.FieldStrategy:
+		return m.OldStrategy(ctx)
+	case restoreplan.FieldStatus:
+		return m.OldStatus(ctx)
+	case restoreplan.FieldPlaylists:
+		return m.OldPlaylists(ctx)
+	case restoreplan.FieldCollections:
+		return m.OldCollections(ctx)
+	case restoreplan.FieldDrift:
+		return m.OldDrift(ctx)
+	case restoreplan.FieldError:
+		return m.OldError(ctx)
+	case restoreplan.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case restoreplan.FieldAppliedAt:
+		return m.OldAppliedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RestorePlan field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RestorePlanMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case restoreplan.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case restoreplan.FieldRunID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRunID(v)
+		return nil
+	case restoreplan.FieldStrategy:
+		v, ok := value.(restoreplan.Strategy)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStrategy(v)
+		return nil
+	case restoreplan.FieldStatus:
+		v, ok := value.(restoreplan.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case restoreplan.FieldPlaylists:
+		v, ok := value.([]schematype.PlaylistRestore)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlaylists(v)
+		return nil
+	case restoreplan.FieldCollections:
+		v, ok := value.([]schematype.CollectionRestore)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollections(v)
+		return nil
+	case restoreplan.FieldDrift:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDrift(v)
+		return nil
+	case restoreplan.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case restoreplan.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case restoreplan.FieldAppliedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAppliedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RestorePlan field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RestorePlanMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RestorePlanMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RestorePlanMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown RestorePlan numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RestorePlanMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(restoreplan.FieldAppliedAt) {
+		fields = append(fields, restoreplan.FieldAppliedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RestorePlanMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RestorePlanMutation) ClearField(name string) error {
+	switch name {
+	case restoreplan.FieldAppliedAt:
+		m.ClearAppliedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RestorePlan nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RestorePlanMutation) ResetField(name string) error {
+	switch name {
+	case restoreplan.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case restoreplan.FieldRunID:
+		m.ResetRunID()
+		return nil
+	case restoreplan.FieldStrategy:
+		m.ResetStrategy()
+		return nil
+	case restoreplan.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case restoreplan.FieldPlaylists:
+		m.ResetPlaylists()
+		return nil
+	case restoreplan.FieldCollections:
+		m.ResetCollections()
+		return nil
+	case restoreplan.FieldDrift:
+		m.ResetDrift()
+		return nil
+	case restoreplan.FieldError:
+		m.ResetError()
+		return nil
+	case restoreplan.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case restoreplan.FieldAppliedAt:
+		m.ResetAppliedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RestorePlan field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RestorePlanMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RestorePlanMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RestorePlanMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RestorePlanMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RestorePlanMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RestorePlanMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RestorePlanMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown RestorePlan unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RestorePlanMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown RestorePlan edge %s", name)
+}
+
+// RetentionPolicyMutation represents an operation that mutates the RetentionPolicy nodes in the graph.
+type RetentionPolicyMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *string
+	user_id               *string
+	keep_all_days         *int
+	addkeep_all_days      *int
+	keep_daily_weeks      *int
+	addkeep_daily_weeks   *int
+	keep_weekly_months    *int
+	addkeep_weekly_months *int
+	created_at            *time.Time
+	updated_at            *time.Time
+	clearedFields         map[string]struct{}
+	done                  bool
+	oldValue              func(context.Context) (*RetentionPolicy, error)
+	predicates            []predicate.RetentionPolicy
+}
+
+var _ ent.Mutation = (*RetentionPolicyMutation)(nil)
+
+// retentionpolicyOption allows management of the mutation configuration using functional options.
+type retentionpolicyOption func(*RetentionPolicyMutation)
+
+// newRetentionPolicyMutation creates new mutation for the RetentionPolicy entity.
+func newRetentionPolicyMutation(c config, op Op, opts ...retentionpolicyOption) *RetentionPolicyMutation {
+	m := &RetentionPolicyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRetentionPolicy,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRetentionPolicyID sets the ID field of the mutation.
+func withRetentionPolicyID(id string) retentionpolicyOption {
+	return func(m *RetentionPolicyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RetentionPolicy
+		)
+		m.oldValue = func(ctx context.Context) (*RetentionPolicy, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RetentionPolicy.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRetentionPolicy sets the old RetentionPolicy of the mutation.
+func withRetentionPolicy(node *RetentionPolicy) retentionpolicyOption {
+	return func(m *RetentionPolicyMutation) {
+		m.oldValue = func(context.Context) (*RetentionPolicy, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RetentionPolicyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RetentionPolicyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RetentionPolicy entities.
+func (m *RetentionPolicyMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RetentionPolicyMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RetentionPolicyMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RetentionPolicy.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *RetentionPolicyMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *RetentionPolicyMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the RetentionPolicy entity.
+// If the RetentionPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RetentionPolicyMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *RetentionPolicyMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetKeepAllDays sets the "keep_all_days" field.
+func (m *RetentionPolicyMutation) SetKeepAllDays(i int) {
+	m.keep_all_days = &i
+	m.addkeep_all_days = nil
+}
+
+// KeepAllDays returns the value of the "keep_all_days" field in the mutation.
+func (m *RetentionPolicyMutation) KeepAllDays() (r int, exists bool) {
+	v := m.keep_all_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeepAllDays returns the old "keep_all_days" field's value of the RetentionPolicy entity.
+// If the RetentionPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RetentionPolicyMutation) OldKeepAllDays(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeepAllDays is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeepAllDays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeepAllDays: %w", err)
+	}
+	return oldValue.KeepAllDays, nil
+}
+
+// AddKeepAllDays adds i to the "keep_all_days" field.
+func (m *RetentionPolicyMutation) AddKeepAllDays(i int) {
+	if m.addkeep_all_days != nil {
+		*m.addkeep_all_days += i
+	} else {
+		m.addkeep_all_days = &i
+	}
+}
+
+// AddedKeepAllDays returns the value that was added to the "keep_all_days" field in this mutation.
+func (m *RetentionPolicyMutation) AddedKeepAllDays() (r int, exists bool) {
+	v := m.addkeep_all_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetKeepAllDays resets all changes to the "keep_all_days" field.
+func (m *RetentionPolicyMutation) ResetKeepAllDays() {
+	m.keep_all_days = nil
+	m.addkeep_all_days = nil
+}
+
+// SetKeepDailyWeeks sets the "keep_daily_weeks" field.
+func (m *RetentionPolicyMutation) SetKeepDailyWeeks(i int) {
+	m.keep_daily_weeks = &i
+	m.addkeep_daily_weeks = nil
+}
+
+// KeepDailyWeeks returns the value of the "keep_daily_weeks" field in the mutation.
+func (m *RetentionPolicyMutation) KeepDailyWeeks() (r int, exists bool) {
+	v := m.keep_daily_weeks
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeepDailyWeeks returns the old "keep_daily_weeks" field's value of the RetentionPolicy entity.
+// If the RetentionPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RetentionPolicyMutation) OldKeepDailyWeeks(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeepDailyWeeks is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeepDailyWeeks requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeepDailyWeeks: %w", err)
+	}
+	return oldValue.KeepDailyWeeks, nil
+}
+
+// AddKeepDailyWeeks adds i to the "keep_daily_weeks" field.
+func (m *RetentionPolicyMutation) AddKeepDailyWeeks(i int) {
+	if m.addkeep_daily_weeks != nil {
+		*m.addkeep_daily_weeks += i
+	} else {
+		m.addkeep_daily_weeks = &i
+	}
+}
+
+// AddedKeepDailyWeeks returns the value that was added to the "keep_daily_weeks" field in this mutation.
+func (m *RetentionPolicyMutation) AddedKeepDailyWeeks() (r int, exists bool) {
+	v := m.addkeep_daily_weeks
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetKeepDailyWeeks resets all changes to the "keep_daily_weeks" field.
+func (m *RetentionPolicyMutation) ResetKeepDailyWeeks() {
+	m.keep_daily_weeks = nil
+	m.addkeep_daily_weeks = nil
+}
+
+// SetKeepWeeklyMonths sets the "keep_weekly_months" field.
+func (m *RetentionPolicyMutation) SetKeepWeeklyMonths(i int) {
+	m.keep_weekly_months = &i
+	m.addkeep_weekly_months = nil
+}
+
+// KeepWeeklyMonths returns the value of the "keep_weekly_months" field in the mutation.
+func (m *RetentionPolicyMutation) KeepWeeklyMonths() (r int, exists bool) {
+	v := m.keep_weekly_months
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeepWeeklyMonths returns the old "keep_weekly_months" field's value of the RetentionPolicy entity.
+// If the RetentionPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RetentionPolicyMutation) OldKeepWeeklyMonths(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeepWeeklyMonths is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeepWeeklyMonths requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeepWeeklyMonths: %w", err)
+	}
+	return oldValue.KeepWeeklyMonths, nil
+}
+
+// AddKeepWeeklyMonths adds i to the "keep_weekly_months" field.
+func (m *RetentionPolicyMutation) AddKeepWeeklyMonths(i int) {
+	if m.addkeep_weekly_months != nil {
+		*m.addkeep_weekly_months += i
+	} else {
+		m.addkeep_weekly_months = &i
+	}
+}
+
+// AddedKeepWeeklyMonths returns the value that was added to the "keep_weekly_months" field in this mutation.
+func (m *RetentionPolicyMutation) AddedKeepWeeklyMonths() (r int, exists bool) {
+	v := m.addkeep_weekly_months
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetKeepWeeklyMonths resets all changes to the "keep_weekly_months" field.
+func (m *RetentionPolicyMutation) ResetKeepWeeklyMonths() {
+	m.keep_weekly_months = nil
+	m.addkeep_weekly_months = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RetentionPolicyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RetentionPolicyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RetentionPolicy entity.
+// If the RetentionPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RetentionPolicyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RetentionPolicyMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RetentionPolicyMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RetentionPolicyMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the RetentionPolicy entity.
+// If the RetentionPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RetentionPolicyMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RetentionPolicyMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the RetentionPolicyMutation builder.
+func (m *RetentionPolicyMutation) Where(ps ...predicate.RetentionPolicy) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RetentionPolicyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RetentionPolicyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RetentionPolicy, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RetentionPolicyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RetentionPolicyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RetentionPolicy).
+func (m *RetentionPolicyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RetentionPolicyMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.user_id != nil {
+		fields = append(fields, retentionpolicy.FieldUserID)
+	}
+	if m.keep_all_days != nil {
+		fields = append(fields, retentionpolicy.FieldKeepAllDays)
+	}
+	if m.keep_daily_weeks != nil {
+		fields = append(fields, retentionpolicy.FieldKeepDailyWeeks)
+	}
+	if m.keep_weekly_months != nil {
+		fields = append(fields, retentionpolicy.FieldKeepWeeklyMonths)
+	}
+	if m.created_at != nil {
+		fields = append(fields, retentionpolicy.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, retentionpolicy.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RetentionPolicyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case retentionpolicy.FieldUserID:
+		return m.UserID()
+	case retentionpolicy.FieldKeepAllDays:
+		return m.KeepAllDays()
+	case retentionpolicy.FieldKeepDailyWeeks:
+		return m.KeepDailyWeeks()
+	case retentionpolicy.FieldKeepWeeklyMonths:
+		return m.KeepWeeklyMonths()
+	case retentionpolicy.FieldCreatedAt:
+		return m.CreatedAt()
+	case retentionpolicy.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RetentionPolicyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case retentionpolicy.FieldUserID:
+		return m.OldUserID(ctx)
+	case retentionpolicy.FieldKeepAllDays:
+		return m.OldKeepAllDays(ctx)
+	case retentionpolicy.FieldKeepDailyWeeks:
+		return m.OldKeepDailyWeeks(ctx)
+	case retentionpolicy.FieldKeepWeeklyMonths:
+		return m.OldKeepWeeklyMonths(ctx)
+	case retentionpolicy.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case retentionpolicy.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RetentionPolicy field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RetentionPolicyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case retentionpolicy.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case retentionpolicy.FieldKeepAllDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeepAllDays(v)
+		return nil
+	case retentionpolicy.FieldKeepDailyWeeks:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeepDailyWeeks(v)
+		return nil
+	case retentionpolicy.FieldKeepWeeklyMonths:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeepWeeklyMonths(v)
+		return nil
+	case retentionpolicy.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case retentionpolicy.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RetentionPolicy field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RetentionPolicyMutation) AddedFields() []string {
+	var fields []string
+	if m.addkeep_all_days != nil {
+		fields = append(fields, retentionpolicy.FieldKeepAllDays)
+	}
+	if m.addkeep_daily_weeks != nil {
+		fields = append(fields, retentionpolicy.FieldKeepDailyWeeks)
+	}
+	if m.addkeep_weekly_months != nil {
+		fields = append(fields, retentionpolicy.FieldKeepWeeklyMonths)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RetentionPolicyMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case retentionpolicy.FieldKeepAllDays:
+		return m.AddedKeepAllDays()
+	case retentionpolicy.FieldKeepDailyWeeks:
+		return m.AddedKeepDailyWeeks()
+	case retentionpolicy.FieldKeepWeeklyMonths:
+		return m.AddedKeepWeeklyMonths()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RetentionPolicyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case retentionpolicy.FieldKeepAllDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddKeepAllDays(v)
+		return nil
+	case retentionpolicy.FieldKeepDailyWeeks:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddKeepDailyWeeks(v)
+		return nil
+	case retentionpolicy.FieldKeepWeeklyMonths:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddKeepWeeklyMonths(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RetentionPolicy numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RetentionPolicyMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RetentionPolicyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RetentionPolicyMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown RetentionPolicy nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RetentionPolicyMutation) ResetField(name string) error {
+	switch name {
+	case retentionpolicy.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case retentionpolicy.FieldKeepAllDays:
+		m.ResetKeepAllDays()
+		return nil
+	case retentionpolicy.FieldKeepDailyWeeks:
+		m.ResetKeepDailyWeeks()
+		return nil
+	case retentionpolicy.FieldKeepWeeklyMonths:
+		m.ResetKeepWeeklyMonths()
+		return nil
+	case retentionpolicy.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case retentionpolicy.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RetentionPolicy field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RetentionPolicyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RetentionPolicyMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RetentionPolicyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RetentionPolicyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RetentionPolicyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RetentionPolicyMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RetentionPolicyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown RetentionPolicy unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RetentionPolicyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown RetentionPolicy edge %s", name)
+}
+
+// SavedAlbumMutation represents an operation that mutates the SavedAlbum nodes in the graph.
+type SavedAlbumMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	added_at      *time.Time
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	run           *string
+	clearedrun    bool
+	album         *string
+	clearedalbum  bool
+	done          bool
+	oldValue      func(context.Context) (*SavedAlbum, error)
+	predicates    []predicate.SavedAlbum
+}
+
+var _ ent.Mutation = (*SavedAlbumMutation)(nil)
+
+// savedalbumOption allows management of the mutation configuration using functional options.
+type savedalbumOption func(*SavedAlbumMutation)
+
+// newSavedAlbumMutation creates new mutation for the SavedAlbum entity.
+func newSavedAlbumMutation(c config, op Op, opts ...savedalbumOption) *SavedAlbumMutation {
+	m := &SavedAlbumMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSavedAlbum,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSavedAlbumID sets the ID field of the mutation.
+func withSavedAlbumID(id string) savedalbumOption {
+	return func(m *SavedAlbumMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SavedAlbum
+		)
+		m.oldValue = func(ctx context.Context) (*SavedAlbum, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SavedAlbum.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSavedAlbum sets the old SavedAlbum of the mutation.
+func withSavedAlbum(node *SavedAlbum) savedalbumOption {
+	return func(m *SavedAlbumMutation) {
+		m.oldValue = func(context.Context) (*SavedAlbum, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SavedAlbumMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SavedAlbumMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SavedAlbum entities.
+func (m *SavedAlbumMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SavedAlbumMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SavedAlbumMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SavedAlbum.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAddedAt sets the "added_at" field.
+func (m *SavedAlbumMutation) SetAddedAt(t time.Time) {
+	m.added_at = &t
+}
+
+// AddedAt returns the value of the "added_at" field in the mutation.
+func (m *SavedAlbumMutation) AddedAt() (r time.Time, exists bool) {
+	v := m.added_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAddedAt returns the old "added_at" field's value of the SavedAlbum entity.
+// If the SavedAlbum object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedAlbumMutation) OldAddedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddedAt: %w", err)
+	}
+	return oldValue.AddedAt, nil
+}
+
+// ResetAddedAt resets all changes to the "added_at" field.
+func (m *SavedAlbumMutation) ResetAddedAt() {
+	m.added_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SavedAlbumMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SavedAlbumMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SavedAlbum entity.
+// If the SavedAlbum object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedAlbumMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SavedAlbumMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetRunID sets the "run" edge to the BackupRun entity by id.
+func (m *SavedAlbumMutation) SetRunID(id string) {
+	m.run = &id
+}
+
+// ClearRun clears the "run" edge to the BackupRun entity.
+func (m *SavedAlbumMutation) ClearRun() {
+	m.clearedrun = true
+}
+
+// RunCleared reports if the "run" edge to the BackupRun entity was cleared.
+func (m *SavedAlbumMutation) RunCleared() bool {
+	return m.clearedrun
+}
+
+// RunID returns the "run" edge ID in the mutation.
+func (m *SavedAlbumMutation) RunID() (id string, exists bool) {
+	if m.run != nil {
+		return *m.run, true
+	}
+	return
+}
+
+// RunIDs returns the "run" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RunID instead. It exists only for internal usage by the builders.
+func (m *SavedAlbumMutation) RunIDs() (ids []string) {
+	if id := m.run; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRun resets all changes to the "run" edge.
+func (m *SavedAlbumMutation) ResetRun() {
+	m.run = nil
+	m.clearedrun = false
+}
+
+// SetAlbumID sets the "album" edge to the Album entity by id.
+func (m *SavedAlbumMutation) SetAlbumID(id string) {
+	m.album = &id
+}
+
+// ClearAlbum clears the "album" edge to the Album entity.
+func (m *SavedAlbumMutation) ClearAlbum() {
+	m.clearedalbum = true
+}
+
+// AlbumCleared reports if the "album" edge to the Album entity was cleared.
+func (m *SavedAlbumMutation) AlbumCleared() bool {
+	return m.clearedalbum
+}
+
+// AlbumID returns the "album" edge ID in the mutation.
+func (m *SavedAlbumMutation) AlbumID() (id string, exists bool) {
+	if m.album != nil {
+		return *m.album, true
+	}
+	return
+}
+
+// AlbumIDs returns the "album" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AlbumID instead. It exists only for internal usage by the builders.
+func (m *SavedAlbumMutation) AlbumIDs() (ids []string) {
+	if id := m.album; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAlbum resets all changes to the "album" edge.
+func (m *SavedAlbumMutation) ResetAlbum() {
+	m.album = nil
+	m.clearedalbum = false
+}
+
+// Where appends a list predicates to the SavedAlbumMutation builder.
+func (m *SavedAlbumMutation) Where(ps ...predicate.SavedAlbum) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SavedAlbumMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SavedAlbumMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SavedAlbum, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SavedAlbumMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SavedAlbumMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SavedAlbum).
+func (m *SavedAlbumMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SavedAlbumMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.added_at != nil {
+		fields = append(fields, savedalbum.FieldAddedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, savedalbum.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SavedAlbumMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case savedalbum.FieldAddedAt:
+		return m.AddedAt()
+	case savedalbum.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SavedAlbumMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case savedalbum.FieldAddedAt:
+		return m.OldAddedAt(ctx)
+	case savedalbum.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown SavedAlbum field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SavedAlbumMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case savedalbum.FieldAddedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddedAt(v)
+		return nil
+	case savedalbum.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SavedAlbum field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SavedAlbumMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SavedAlbumMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SavedAlbumMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown SavedAlbum numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SavedAlbumMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SavedAlbumMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SavedAlbumMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SavedAlbum nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SavedAlbumMutation) ResetField(name string) error {
+	switch name {
+	case savedalbum.FieldAddedAt:
+		m.ResetAddedAt()
+		return nil
+	case savedalbum.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SavedAlbum field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SavedAlbumMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.run != nil {
+		edges = append(edges, savedalbum.EdgeRun)
+	}
+	if m.album != nil {
+		edges = append(edges, savedalbum.EdgeAlbum)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SavedAlbumMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case savedalbum.EdgeRun:
+		if id := m.run; id != nil {
+			return []ent.Value{*id}
+		}
+	case savedalbum.EdgeAlbum:
+		if id := m.album; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SavedAlbumMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SavedAlbumMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SavedAlbumMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedrun {
+		edges = append(edges, savedalbum.EdgeRun)
+	}
+	if m.clearedalbum {
+		edges = append(edges, savedalbum.EdgeAlbum)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SavedAlbumMutation) EdgeCleared(name string) bool {
+	switch name {
+	case savedalbum.EdgeRun:
+		return m.clearedrun
+	case savedalbum.EdgeAlbum:
+		return m.clearedalbum
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SavedAlbumMutation) ClearEdge(name string) error {
+	switch name {
+	case savedalbum.EdgeRun:
+		m.ClearRun()
+		return nil
+	case savedalbum.EdgeAlbum:
+		m.ClearAlbum()
+		return nil
+	}
+	return fmt.Errorf("unknown SavedAlbum unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SavedAlbumMutation) ResetEdge(name string) error {
+	switch name {
+	case savedalbum.EdgeRun:
+		m.ResetRun()
+		return nil
+	case savedalbum.EdgeAlbum:
+		m.ResetAlbum()
+		return nil
+	}
+	return fmt.Errorf("unknown SavedAlbum edge %s", name)
+}
+
+// SavedAudiobookMutation represents an operation that mutates the SavedAudiobook nodes in the graph.
+type SavedAudiobookMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *string
+	added_at          *time.Time
+	spotify_id        *string
+	uri               *string
+	name              *string
+	authors           *[]string
+	appendauthors     []string
+	narrators         *[]string
+	appendnarrators   []string
+	publisher         *string
+	total_chapters    *int
+	addtotal_chapters *int
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	run               *string
+	clearedrun        bool
+	done              bool
+	oldValue          func(context.Context) (*SavedAudiobook, error)
+	predicates        []predicate.SavedAudiobook
+}
+
+var _ ent.Mutation = (*SavedAudiobookMutation)(nil)
+
+// savedaudiobookOption allows management of the mutation configuration using functional options.
+type savedaudiobookOption func(*SavedAudiobookMutation)
+
+// newSavedAudiobookMutation creates new mutation for the SavedAudiobook entity.
+func newSavedAudiobookMutation(c config, op Op, opts ...savedaudiobookOption) *SavedAudiobookMutation {
+	m := &SavedAudiobookMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSavedAudiobook,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSavedAudiobookID sets the ID field of the mutation.
+func withSavedAudiobookID(id string) savedaudiobookOption {
+	return func(m *SavedAudiobookMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SavedAudiobook
+		)
+		m.oldValue = func(ctx context.Context) (*SavedAudiobook, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SavedAudiobook.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSavedAudiobook sets the old SavedAudiobook of the mutation.
+func withSavedAudiobook(node *SavedAudiobook) savedaudiobookOption {
+	return func(m *SavedAudiobookMutation) {
+		m.oldValue = func(context.Context) (*SavedAudiobook, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SavedAudiobookMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SavedAudiobookMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SavedAudiobook entities.
+func (m *SavedAudiobookMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SavedAudiobookMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SavedAudiobookMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SavedAudiobook.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAddedAt sets the "added_at" field.
+func (m *SavedAudiobookMutation) SetAddedAt(t time.Time) {
+	m.added_at = &t
+}
+
+// AddedAt returns the value of the "added_at" field in the mutation.
+func (m *SavedAudiobookMutation) AddedAt() (r time.Time, exists bool) {
+	v := m.added_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAddedAt returns the old "added_at" field's value of the SavedAudiobook entity.
+// If the SavedAudiobook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedAudiobookMutation) OldAddedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddedAt: %w", err)
+	}
+	return oldValue.AddedAt, nil
+}
+
+// ClearAddedAt clears the value of the "added_at" field.
+func (m *SavedAudiobookMutation) ClearAddedAt() {
+	m.added_at = nil
+	m.clearedFields[savedaudiobook.FieldAddedAt] = struct{}{}
+}
+
+// AddedAtCleared returns if the "added_at" field was cleared in this mutation.
+func (m *SavedAudiobookMutation) AddedAtCleared() bool {
+	_, ok := m.clearedFields[savedaudiobook.FieldAddedAt]
+	return ok
+}
+
+// ResetAddedAt resets all changes to the "added_at" field.
+func (m *SavedAudiobookMutation) ResetAddedAt() {
+	m.added_at = nil
+	delete(m.clearedFields, savedaudiobook.FieldAddedAt)
+}
+
+// SetSpotifyID sets the "spotify_id" field.
+func (m *SavedAudiobookMutation) SetSpotifyID(s string) {
+	m.spotify_id = &s
+}
+
+// SpotifyID returns the value of the "spotify_id" field in the mutation.
+func (m *SavedAudiobookMutation) SpotifyID() (r string, exists bool) {
+	v := m.spotify_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSpotifyID returns the old "spotify_id" field's value of the SavedAudiobook entity.
+// If the SavedAudiobook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedAudiobookMutation) OldSpotifyID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSpotifyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSpotifyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSpotifyID: %w", err)
+	}
+	return oldValue.SpotifyID, nil
+}
+
+// ResetSpotifyID resets all changes to the "spotify_id" field.
+func (m *SavedAudiobookMutation) ResetSpotifyID() {
+	m.spotify_id = nil
+}
+
+// SetURI sets the "uri" field.
+func (m *SavedAudiobookMutation) SetURI(s string) {
+	m.uri = &s
+}
+
+// URI returns the value of the "uri" field in the mutation.
+func (m *SavedAudiobookMutation) URI() (r string, exists bool) {
+	v := m.uri
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURI returns the old "uri" field's value of the SavedAudiobook entity.
+// If the SavedAudiobook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedAudiobookMutation) OldURI(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURI is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURI requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURI: %w", err)
+	}
+	return oldValue.URI, nil
+}
+
+// ResetURI resets all changes to the "uri" field.
+func (m *SavedAudiobookMutation) ResetURI() {
+	m.uri = nil
+}
+
+// SetName sets the "name" field.
+func (m *SavedAudiobookMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *SavedAudiobookMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the SavedAudiobook entity.
+// If the SavedAudiobook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedAudiobookMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *SavedAudiobookMutation) ResetName() {
+	m.name = nil
+}
+
+// SetAuthors sets the "authors" field.
+func (m *SavedAudiobookMutation) SetAuthors(s []string) {
+	m.authors = &s
+	m.appendauthors = nil
+}
+
+// Authors returns the value of the "authors" field in the mutation.
+func (m *SavedAudiobookMutation) Authors() (r []string, exists bool) {
+	v := m.authors
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthors returns the old "authors" field's value of the SavedAudiobook entity.
+// If the SavedAudiobook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedAudiobookMutation) OldAuthors(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthors is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthors requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthors: %w", err)
+	}
+	return oldValue.Authors, nil
+}
+
+// AppendAuthors adds s to the "authors" field.
+func (m *SavedAudiobookMutation) AppendAuthors(s []string) {
+	m.appendauthors = append(m.appendauthors, s...)
+}
+
+// AppendedAuthors returns the list of values that were appended to the "authors" field in this mutation.
+func (m *SavedAudiobookMutation) AppendedAuthors() ([]string, bool) {
+	if len(m.appendauthors) == 0 {
+		return nil, false
+	}
+	return m.appendauthors, true
+}
+
+// ClearAuthors clears the value of the "authors" field.
+func (m *SavedAudiobookMutation) ClearAuthors() {
+	m.authors = nil
+	m.appendauthors = nil
+	m.clearedFields[savedaudiobook.FieldAuthors] = struct{}{}
+}
+
+// AuthorsCleared returns if the "authors" field was cleared in this mutation.
+func (m *SavedAudiobookMutation) AuthorsCleared() bool {
+	_, ok := m.clearedFields[savedaudiobook.FieldAuthors]
+	return ok
+}
+
+// ResetAuthors resets all changes to the "authors" field.
+func (m *SavedAudiobookMutation) ResetAuthors() {
+	m.authors = nil
+	m.appendauthors = nil
+	delete(m.clearedFields, savedaudiobook.FieldAuthors)
+}
+
+// SetNarrators sets the "narrators" field.
+func (m *SavedAudiobookMutation) SetNarrators(s []string) {
+	m.narrators = &s
+	m.appendnarrators = nil
+}
+
+// Narrators returns the value of the "narrators" field in the mutation.
+func (m *SavedAudiobookMutation) Narrators() (r []string, exists bool) {
+	v := m.narrators
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNarrators returns the old "narrators" field's value of the SavedAudiobook entity.
+// If the SavedAudiobook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedAudiobookMutation) OldNarrators(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNarrators is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNarrators requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNarrators: %w", err)
+	}
+	return oldValue.Narrators, nil
+}
+
+// AppendNarrators adds s to the "narrators" field.
+func (m *SavedAudiobookMutation) AppendNarrators(s []string) {
+	m.appendnarrators = append(m.appendnarrators, s...)
+}
+
+// AppendedNarrators returns the list of values that were appended to the "narrators" field in this mutation.
+func (m *SavedAudiobookMutation) AppendedNarrators() ([]string, bool) {
+	if len(m.appendnarrators) == 0 {
+		return nil, false
+	}
+	return m.appendnarrators, true
+}
+
+// ClearNarrators clears the value of the "narrators" field.
+func (m *SavedAudiobookMutation) ClearNarrators() {
+	m.narrators = nil
+	m.appendnarrators = nil
+	m.clearedFields[savedaudiobook.FieldNarrators] = struct{}{}
+}
+
+// NarratorsCleared returns if the "narrators" field was cleared in this mutation.
+func (m *SavedAudiobookMutation) NarratorsCleared() bool {
+	_, ok := m.clearedFields[savedaudiobook.FieldNarrators]
+	return ok
+}
+
+// ResetNarrators resets all changes to the "narrators" field.
+func (m *SavedAudiobookMutation) ResetNarrators() {
+	m.narrators = nil
+	m.appendnarrators = nil
+	delete(m.clearedFields, savedaudiobook.FieldNarrators)
+}
+
+// SetPublisher sets the "publisher" field.
+func (m *SavedAudiobookMutation) SetPublisher(s string) {
+	m.publisher = &s
+}
+
+// Publisher returns the value of the "publisher" field in the mutation.
+func (m *SavedAudiobookMutation) Publisher() (r string, exists bool) {
+	v := m.publisher
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublisher returns the old "publisher" field's value of the SavedAudiobook entity.
+// If the SavedAudiobook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedAudiobookMutation) OldPublisher(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublisher is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublisher requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublisher: %w", err)
+	}
+	return oldValue.Publisher, nil
+}
+
+// ResetPublisher resets all changes to the "publisher" field.
+func (m *SavedAudiobookMutation) ResetPublisher() {
+	m.publisher = nil
+}
+
+// SetTotalChapters sets the "total_chapters" field.
+func (m *SavedAudiobookMutation) SetTotalChapters(i int) {
+	m.total_chapters = &i
+	m.addtotal_chapters = nil
+}
+
+// TotalChapters returns the value of the "total_chapters" field in the mutation.
+func (m *SavedAudiobookMutation) TotalChapters() (r int, exists bool) {
+	v := m.total_chapters
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalChapters returns the old "total_chapters" field's value of the SavedAudiobook entity.
+// If the SavedAudiobook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedAudiobookMutation) OldTotalChapters(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalChapters is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalChapters requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalChapters: %w", err)
+	}
+	return oldValue.TotalChapters, nil
+}
+
+// AddTotalChapters adds i to the "total_chapters" field.
+func (m *SavedAudiobookMutation) AddTotalChapters(i int) {
+	if m.addtotal_chapters != nil {
+		*m.addtotal_chapters += i
+	} else {
+		m.addtotal_chapters = &i
+	}
+}
+
+// AddedTotalChapters returns the value that was added to the "total_chapters" field in this mutation.
+func (m *SavedAudiobookMutation) AddedTotalChapters() (r int, exists bool) {
+	v := m.addtotal_chapters
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalChapters resets all changes to the "total_chapters" field.
+func (m *SavedAudiobookMutation) ResetTotalChapters() {
+	m.total_chapters = nil
+	m.addtotal_chapters = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SavedAudiobookMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SavedAudiobookMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SavedAudiobook entity.
+// If the SavedAudiobook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedAudiobookMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SavedAudiobookMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetRunID sets the "run" edge to the BackupRun entity by id.
+func (m *SavedAudiobookMutation) SetRunID(id string) {
+	m.run = &id
+}
+
+// ClearRun clears the "run" edge to the BackupRun entity.
+func (m *SavedAudiobookMutation) ClearRun() {
+	m.clearedrun = true
+}
+
+// RunCleared reports if the "run" edge to the BackupRun entity was cleared.
+func (m *SavedAudiobookMutation) RunCleared() bool {
+	return m.clearedrun
+}
+
+// RunID returns the "run" edge ID in the mutation.
+func (m *SavedAudiobookMutation) RunID() (id string, exists bool) {
+	if m.run != nil {
+		return *m.run, true
+	}
+	return
+}
+
+// RunIDs returns the "run" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RunID instead. It exists only for internal usage by the builders.
+func (m *SavedAudiobookMutation) RunIDs() (ids []string) {
+	if id := m.run; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRun resets all changes to the "run" edge.
+func (m *SavedAudiobookMutation) ResetRun() {
+	m.run = nil
+	m.clearedrun = false
+}
+
+// Where appends a list predicates to the SavedAudiobookMutation builder.
+func (m *SavedAudiobookMutation) Where(ps ...predicate.SavedAudiobook) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SavedAudiobookMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SavedAudiobookMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SavedAudiobook, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SavedAudiobookMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SavedAudiobookMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SavedAudiobook).
+func (m *SavedAudiobookMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SavedAudiobookMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.added_at != nil {
+		fields = append(fields, savedaudiobook.FieldAddedAt)
+	}
+	if m.spotify_id != nil {
+		fields = append(fields, savedaudiobook.FieldSpotifyID)
+	}
+	if m.uri != nil {
+		fields = append(fields, savedaudiobook.FieldURI)
+	}
+	if m.name != nil {
+		fields = append(fields, savedaudiobook.FieldName)
+	}
+	if m.authors != nil {
+		fields = append(fields, savedaudiobook.FieldAuthors)
+	}
+	if m.narrators != nil {
+		fields = append(fields, savedaudiobook.FieldNarrators)
+	}
+	if m.publisher != nil {
+		fields = append(fields, savedaudiobook.FieldPublisher)
+	}
+	if m.total_chapters != nil {
+		fields = append(fields, savedaudiobook.FieldTotalChapters)
+	}
+	if m.created_at != nil {
+		fields = append(fields, savedaudiobook.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SavedAudiobookMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case savedaudiobook.FieldAddedAt:
+		return m.AddedAt()
+	case savedaudiobook.FieldSpotifyID:
+		return m.SpotifyID()
+	case savedaudiobook.FieldURI:
+		return m.URI()
+	case savedaudiobook.FieldName:
+		return m.Name()
+	case savedaudiobook.FieldAuthors:
+		return m.Authors()
+	case savedaudiobook.FieldNarrators:
+		return m.Narrators()
+	case savedaudiobook.FieldPublisher:
+		return m.Publisher()
+	case savedaudiobook.FieldTotalChapters:
+		return m.TotalChapters()
+	case savedaudiobook.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SavedAudiobookMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case savedaudiobook.FieldAddedAt:
+		return m.OldAddedAt(ctx)
+	case savedaudiobook.FieldSpotifyID:
+		return m.OldSpotifyID(ctx)
+	case savedaudiobook.FieldURI:
+		return m.OldURI(ctx)
+	case savedaudiobook.FieldName:
+		return m.OldName(ctx)
+	case savedaudiobook.FieldAuthors:
+		return m.OldAuthors(ctx)
+	case savedaudiobook.FieldNarrators:
+		return m.OldNarrators(ctx)
+	case savedaudiobook.FieldPublisher:
+		return m.OldPublisher(ctx)
+	case savedaudiobook.FieldTotalChapters:
+		return m.OldTotalChapters(ctx)
+	case savedaudiobook.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown SavedAudiobook field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SavedAudiobookMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case savedaudiobook.FieldAddedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddedAt(v)
+		return nil
+	case savedaudiobook.FieldSpotifyID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpotifyID(v)
+		return nil
+	case savedaudiobook.FieldURI:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURI(v)
+		return nil
+	case savedaudiobook.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case savedaudiobook.FieldAuthors:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthors(v)
+		return nil
+	case savedaudiobook.FieldNarrators:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNarrators(v)
+		return nil
+	case savedaudiobook.FieldPublisher:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublisher(v)
+		return nil
+	case savedaudiobook.FieldTotalChapters:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalChapters(v)
+		return nil
+	case savedaudiobook.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SavedAudiobook field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SavedAudiobookMutation) AddedFields() []string {
+	var fields []string
+	if m.addtotal_chapters != nil {
+		fields = append(fields, savedaudiobook.FieldTotalChapters)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SavedAudiobookMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case savedaudiobook.FieldTotalChapters:
+		return m.AddedTotalChapters()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SavedAudiobookMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case savedaudiobook.FieldTotalChapters:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalChapters(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SavedAudiobook numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SavedAudiobookMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(savedaudiobook.FieldAddedAt) {
+		fields = append(fields, savedaudiobook.FieldAddedAt)
+	}
+	if m.FieldCleared(savedaudiobook.FieldAuthors) {
+		fields = append(fields, savedaudiobook.FieldAuthors)
+	}
+	if m.FieldCleared(savedaudiobook.FieldNarrators) {
+		fields = append(fields, savedaudiobook.FieldNarrators)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SavedAudiobookMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SavedAudiobookMutation) ClearField(name string) error {
+	switch name {
+	case savedaudiobook.FieldAddedAt:
+		m.ClearAddedAt()
+		return nil
+	case savedaudiobook.FieldAuthors:
+		m.ClearAuthors()
+		return nil
+	case savedaudiobook.FieldNarrators:
+		m.ClearNarrators()
+		return nil
+	}
+	return fmt.Errorf("unknown SavedAudiobook nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SavedAudiobookMutation) ResetField(name string) error {
+	switch name {
+	case savedaudiobook.FieldAddedAt:
+		m.ResetAddedAt()
+		return nil
+	case savedaudiobook.FieldSpotifyID:
+		m.ResetSpotifyID()
+		return nil
+	case savedaudiobook.FieldURI:
+		m.ResetURI()
+		return nil
+	case savedaudiobook.FieldName:
+		m.ResetName()
+		return nil
+	case savedaudiobook.FieldAuthors:
+		m.ResetAuthors()
+		return nil
+	case savedaudiobook.FieldNarrators:
+		m.ResetNarrators()
+		return nil
+	case savedaudiobook.FieldPublisher:
+		m.ResetPublisher()
+		return nil
+	case savedaudiobook.FieldTotalChapters:
+		m.ResetTotalChapters()
+		return nil
+	case savedaudiobook.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SavedAudiobook field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SavedAudiobookMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.run != nil {
+		edges = append(edges, savedaudiobook.EdgeRun)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SavedAudiobookMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case savedaudiobook.EdgeRun:
+		if id := m.run; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SavedAudiobookMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SavedAudiobookMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SavedAudiobookMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedrun {
+		edges = append(edges, savedaudiobook.EdgeRun)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SavedAudiobookMutation) EdgeCleared(name string) bool {
+	switch name {
+	case savedaudiobook.EdgeRun:
+		return m.clearedrun
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SavedAudiobookMutation) ClearEdge(name string) error {
+	switch name {
+	case savedaudiobook.EdgeRun:
+		m.ClearRun()
+		return nil
+	}
+	return fmt.Errorf("unknown SavedAudiobook unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SavedAudiobookMutation) ResetEdge(name string) error {
+	switch name {
+	case savedaudiobook.EdgeRun:
+		m.ResetRun()
+		return nil
+	}
+	return fmt.Errorf("unknown SavedAudiobook edge %s", name)
+}
+
+// SavedEpisodeMutation represents an operation that mutates the SavedEpisode nodes in the graph.
+type SavedEpisodeMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *string
+	added_at              *time.Time
+	fully_played          *bool
+	resume_position_ms    *int
+	addresume_position_ms *int
+	created_at            *time.Time
+	clearedFields         map[string]struct{}
+	run                   *string
+	clearedrun            bool
+	episode               *string
+	clearedepisode        bool
+	done                  bool
+	oldValue              func(context.Context) (*SavedEpisode, error)
+	predicates            []predicate.SavedEpisode
+}
+
+var _ ent.Mutation = (*SavedEpisodeMutation)(nil)
+
+// savedepisodeOption allows management of the mutation configuration using functional options.
+type savedepisodeOption func(*SavedEpisodeMutation)
+
+// newSavedEpisodeMutation creates new mutation for the SavedEpisode entity.
+func newSavedEpisodeMutation(c config, op Op, opts ...savedepisodeOption) *SavedEpisodeMutation {
+	m := &SavedEpisodeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSavedEpisode,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSavedEpisodeID sets the ID field of the mutation.
+func withSavedEpisodeID(id string) savedepisodeOption {
+	return func(m *SavedEpisodeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SavedEpisode
+		)
+		m.oldValue = func(ctx context.Context) (*SavedEpisode, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SavedEpisode.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSavedEpisode sets the old SavedEpisode of the mutation.
+func withSavedEpisode(node *SavedEpisode) savedepisodeOption {
+	return func(m *SavedEpisodeMutation) {
+		m.oldValue = func(context.Context) (*SavedEpisode, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SavedEpisodeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SavedEpisodeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SavedEpisode entities.
+func (m *SavedEpisodeMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SavedEpisodeMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SavedEpisodeMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SavedEpisode.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAddedAt sets the "added_at" field.
+func (m *SavedEpisodeMutation) SetAddedAt(t time.Time) {
+	m.added_at = &t
+}
+
+// AddedAt returns the value of the "added_at" field in the mutation.
+func (m *SavedEpisodeMutation) AddedAt() (r time.Time, exists bool) {
+	v := m.added_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAddedAt returns the old "added_at" field's value of the SavedEpisode entity.
+// If the SavedEpisode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedEpisodeMutation) OldAddedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddedAt: %w", err)
+	}
+	return oldValue.AddedAt, nil
+}
+
+// ResetAddedAt resets all changes to the "added_at" field.
+func (m *SavedEpisodeMutation) ResetAddedAt() {
+	m.added_at = nil
+}
+
+// SetFullyPlayed sets the "fully_played" field.
+func (m *SavedEpisodeMutation) SetFullyPlayed(b bool) {
+	m.fully_played = &b
+}
+
+// FullyPlayed returns the value of the "fully_played" field in the mutation.
+func (m *SavedEpisodeMutation) FullyPlayed() (r bool, exists bool) {
+	v := m.fully_played
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFullyPlayed returns the old "fully_played" field's value of the SavedEpisode entity.
+// If the SavedEpisode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedEpisodeMutation) OldFullyPlayed(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFullyPlayed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFullyPlayed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFullyPlayed: %w", err)
+	}
+	return oldValue.FullyPlayed, nil
+}
+
+// ResetFullyPlayed resets all changes to the "fully_played" field.
+func (m *SavedEpisodeMutation) ResetFullyPlayed() {
+	m.fully_played = nil
+}
+
+// SetResumePositionMs sets the "resume_position_ms" field.
+func (m *SavedEpisodeMutation) SetResumePositionMs(i int) {
+	m.resume_position_ms = &i
+	m.addresume_position_ms = nil
+}
+
+// ResumePositionMs returns the value of the "resume_position_ms" field in the mutation.
+func (m *SavedEpisodeMutation) ResumePositionMs() (r int, exists bool) {
+	v := m.resume_position_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResumePositionMs returns the old "resume_position_ms" field's value of the SavedEpisode entity.
+// If the SavedEpisode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedEpisodeMutation) OldResumePositionMs(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResumePositionMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResumePositionMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResumePositionMs: %w", err)
+	}
+	return oldValue.ResumePositionMs, nil
+}
+
+// AddResumePositionMs adds i to the "resume_position_ms" field.
+func (m *SavedEpisodeMutation) AddResumePositionMs(i int) {
+	if m.addresume_position_ms != nil {
+		*m.addresume_position_ms += i
+	} else {
+		m.addresume_position_ms = &i
+	}
+}
+
+// AddedResumePositionMs returns the value that was added to the "resume_position_ms" field in this mutation.
+func (m *SavedEpisodeMutation) AddedResumePositionMs() (r int, exists bool) {
+	v := m.addresume_position_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetResumePositionMs resets all changes to the "resume_position_ms" field.
+func (m *SavedEpisodeMutation) ResetResumePositionMs() {
+	m.resume_position_ms = nil
+	m.addresume_position_ms = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SavedEpisodeMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SavedEpisodeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SavedEpisode entity.
+// If the SavedEpisode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedEpisodeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SavedEpisodeMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetRunID sets the "run" edge to the BackupRun entity by id.
+func (m *SavedEpisodeMutation) SetRunID(id string) {
+	m.run = &id
+}
+
+// ClearRun clears the "run" edge to the BackupRun entity.
+func (m *SavedEpisodeMutation) ClearRun() {
+	m.clearedrun = true
+}
+
+// RunCleared reports if the "run" edge to the BackupRun entity was cleared.
+func (m *SavedEpisodeMutation) RunCleared() bool {
+	return m.clearedrun
+}
+
+// RunID returns the "run" edge ID in the mutation.
+func (m *SavedEpisodeMutation) RunID() (id string, exists bool) {
+	if m.run != nil {
+		return *m.run, true
+	}
+	return
+}
+
+// RunIDs returns the "run" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RunID instead. It exists only for internal usage by the builders.
+func (m *SavedEpisodeMutation) RunIDs() (ids []string) {
+	if id := m.run; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRun resets all changes to the "run" edge.
+func (m *SavedEpisodeMutation) ResetRun() {
+	m.run = nil
+	m.clearedrun = false
+}
+
+// SetEpisodeID sets the "episode" edge to the Episode entity by id.
+func (m *SavedEpisodeMutation) SetEpisodeID(id string) {
+	m.episode = &id
+}
+
+// ClearEpisode clears the "episode" edge to the Episode entity.
+func (m *SavedEpisodeMutation) ClearEpisode() {
+	m.clearedepisode = true
+}
+
+// EpisodeCleared reports if the "episode" edge to the Episode entity was cleared.
+func (m *SavedEpisodeMutation) EpisodeCleared() bool {
+	return m.clearedepisode
+}
+
+// EpisodeID returns the "episode" edge ID in the mutation.
+func (m *SavedEpisodeMutation) EpisodeID() (id string, exists bool) {
+	if m.episode != nil {
+		return *m.episode, true
+	}
+	return
+}
+
+// EpisodeIDs returns the "episode" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EpisodeID instead. It exists only for internal usage by the builders.
+func (m *SavedEpisodeMutation) EpisodeIDs() (ids []string) {
+	if id := m.episode; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetEpisode resets all changes to the "episode" edge.
+func (m *SavedEpisodeMutation) ResetEpisode() {
+	m.episode = nil
+	m.clearedepisode = false
+}
+
+// Where appends a list predicates to the SavedEpisodeMutation builder.
+func (m *SavedEpisodeMutation) Where(ps ...predicate.SavedEpisode) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SavedEpisodeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SavedEpisodeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SavedEpisode, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SavedEpisodeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SavedEpisodeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SavedEpisode).
+func (m *SavedEpisodeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SavedEpisodeMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.added_at != nil {
+		fields = append(fields, savedepisode.FieldAddedAt)
+	}
+	if m.fully_played != nil {
+		fields = append(fields, savedepisode.FieldFullyPlayed)
+	}
+	if m.resume_position_ms != nil {
+		fields = append(fields, savedepisode.FieldResumePositionMs)
+	}
+	if m.created_at != nil {
+		fields = append(fields, savedepisode.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SavedEpisodeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case savedepisode.FieldAddedAt:
+		return m.AddedAt()
+	case savedepisode.FieldFullyPlayed:
+		return m.FullyPlayed()
+	case savedepisode.FieldResumePositionMs:
+		return m.ResumePositionMs()
+	case savedepisode.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SavedEpisodeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case savedepisode.FieldAddedAt:
+		return m.OldAddedAt(ctx)
+	case savedepisode.FieldFullyPlayed:
+		return m.OldFullyPlayed(ctx)
+	case savedepisode.FieldResumePositionMs:
+		return m.OldResumePositionMs(ctx)
+	case savedepisode.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown SavedEpisode field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SavedEpisodeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case savedepisode.FieldAddedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddedAt(v)
+		return nil
+	case savedepisode.FieldFullyPlayed:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFullyPlayed(v)
+		return nil
+	case savedepisode.FieldResumePositionMs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResumePositionMs(v)
+		return nil
+	case savedepisode.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SavedEpisode field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SavedEpisodeMutation) AddedFields() []string {
+	var fields []string
+	if m.addresume_position_ms != nil {
+		fields = append(fields, savedepisode.FieldResumePositionMs)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SavedEpisodeMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case savedepisode.FieldResumePositionMs:
+		return m.AddedResumePositionMs()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SavedEpisodeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case savedepisode.FieldResumePositionMs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddResumePositionMs(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SavedEpisode numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SavedEpisodeMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SavedEpisodeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SavedEpisodeMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SavedEpisode nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SavedEpisodeMutation) ResetField(name string) error {
+	switch name {
+	case savedepisode.FieldAddedAt:
+		m.ResetAddedAt()
+		return nil
+	case savedepisode.FieldFullyPlayed:
+		m.ResetFullyPlayed()
+		return nil
+	case savedepisode.FieldResumePositionMs:
+		m.ResetResumePositionMs()
+		return nil
+	case savedepisode.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SavedEpisode field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SavedEpisodeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.run != nil {
+		edges = append(edges, savedepisode.EdgeRun)
+	}
+	if m.episode != nil {
+		edges = append(edges, savedepisode.EdgeEpisode)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SavedEpisodeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case savedepisode.EdgeRun:
+		if id := m.run; id != nil {
+			return []ent.Value{*id}
+		}
+	case savedepisode.EdgeEpisode:
+		if id := m.episode; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SavedEpisodeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SavedEpisodeMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SavedEpisodeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedrun {
+		edges = append(edges, savedepisode.EdgeRun)
+	}
+	if m.clearedepisode {
+		edges = append(edges, savedepisode.EdgeEpisode)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SavedEpisodeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case savedepisode.EdgeRun:
+		return m.clearedrun
+	case savedepisode.EdgeEpisode:
+		return m.clearedepisode
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SavedEpisodeMutation) ClearEdge(name string) error {
+	switch name {
+	case savedepisode.EdgeRun:
+		m.ClearRun()
+		return nil
+	case savedepisode.EdgeEpisode:
+		m.ClearEpisode()
+		return nil
+	}
+	return fmt.Errorf("unknown SavedEpisode unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SavedEpisodeMutation) ResetEdge(name string) error {
+	switch name {
+	case savedepisode.EdgeRun:
+		m.ResetRun()
+		return nil
+	case savedepisode.EdgeEpisode:
+		m.ResetEpisode()
+		return nil
+	}
+	return fmt.Errorf("unknown SavedEpisode edge %s", name)
+}
+
+// SavedShowMutation represents an operation that mutates the SavedShow nodes in the graph.
+type SavedShowMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *string
+	added_at          *time.Time
+	total_episodes    *int
+	addtotal_episodes *int
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	run               *string
+	clearedrun        bool
+	show              *string
+	clearedshow       bool
+	done              bool
+	oldValue          func(context.Context) (*SavedShow, error)
+	predicates        []predicate.SavedShow
+}
+
+var _ ent.Mutation = (*SavedShowMutation)(nil)
+
+// savedshowOption allows management of the mutation configuration using functional options.
+type savedshowOption func(*SavedShowMutation)
+
+// newSavedShowMutation creates new mutation for the SavedShow entity.
+func newSavedShowMutation(c config, op Op, opts ...savedshowOption) *SavedShowMutation {
+	m := &SavedShowMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSavedShow,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSavedShowID sets the ID field of the mutation.
+func withSavedShowID(id string) savedshowOption {
+	return func(m *SavedShowMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SavedShow
+		)
+		m.oldValue = func(ctx context.Context) (*SavedShow, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SavedShow.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSavedShow sets the old SavedShow of the mutation.
+func withSavedShow(node *SavedShow) savedshowOption {
+	return func(m *SavedShowMutation) {
+		m.oldValue = func(context.Context) (*SavedShow, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SavedShowMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SavedShowMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SavedShow entities.
+func (m *SavedShowMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SavedShowMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SavedShowMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SavedShow.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAddedAt sets the "added_at" field.
+func (m *SavedShowMutation) SetAddedAt(t time.Time) {
+	m.added_at = &t
+}
+
+// AddedAt returns the value of the "added_at" field in the mutation.
+func (m *SavedShowMutation) AddedAt() (r time.Time, exists bool) {
+	v := m.added_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAddedAt returns the old "added_at" field's value of the SavedShow entity.
+// If the SavedShow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedShowMutation) OldAddedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddedAt: %w", err)
+	}
+	return oldValue.AddedAt, nil
+}
+
+// ResetAddedAt resets all changes to the "added_at" field.
+func (m *SavedShowMutation) ResetAddedAt() {
+	m.added_at = nil
+}
+
+// SetTotalEpisodes sets the "total_episodes" field.
+func (m *SavedShowMutation) SetTotalEpisodes(i int) {
+	m.total_episodes = &i
+	m.addtotal_episodes = nil
+}
+
+// TotalEpisodes returns the value of the "total_episodes" field in the mutation.
+func (m *SavedShowMutation) TotalEpisodes() (r int, exists bool) {
+	v := m.total_episodes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalEpisodes returns the old "total_episodes" field's value of the SavedShow entity.
+// If the SavedShow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedShowMutation) OldTotalEpisodes(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalEpisodes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalEpisodes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalEpisodes: %w", err)
+	}
+	return oldValue.TotalEpisodes, nil
+}
+
+// AddTotalEpisodes adds i to the "total_episodes" field.
+func (m *SavedShowMutation) AddTotalEpisodes(i int) {
+	if m.addtotal_episodes != nil {
+		*m.addtotal_episodes += i
+	} else {
+		m.addtotal_episodes = &i
+	}
+}
+
+// AddedTotalEpisodes returns the value that was added to the "total_episodes" field in this mutation.
+func (m *SavedShowMutation) AddedTotalEpisodes() (r int, exists bool) {
+	v := m.addtotal_episodes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalEpisodes resets all changes to the "total_episodes" field.
+func (m *SavedShowMutation) ResetTotalEpisodes() {
+	m.total_episodes = nil
+	m.addtotal_episodes = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SavedShowMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SavedShowMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SavedShow entity.
+// If the SavedShow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedShowMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SavedShowMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetRunID sets the "run" edge to the BackupRun entity by id.
+func (m *SavedShowMutation) SetRunID(id string) {
+	m.run = &id
+}
+
+// ClearRun clears the "run" edge to the BackupRun entity.
+func (m *SavedShowMutation) ClearRun() {
+	m.clearedrun = true
+}
+
+// RunCleared reports if the "run" edge to the BackupRun entity was cleared.
+func (m *SavedShowMutation) RunCleared() bool {
+	return m.clearedrun
+}
+
+// RunID returns the "run" edge ID in the mutation.
+func (m *SavedShowMutation) RunID() (id string, exists bool) {
+	if m.run != nil {
+		return *m.run, true
+	}
+	return
+}
+
+// RunIDs returns the "run" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RunID instead. It exists only for internal usage by the builders.
+func (m *SavedShowMutation) RunIDs() (ids []string) {
+	if id := m.run; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRun resets all changes to the "run" edge.
+func (m *SavedShowMutation) ResetRun() {
+	m.run = nil
+	m.clearedrun = false
+}
+
+// SetShowID sets the "show" edge to the Show entity by id.
+func (m *SavedShowMutation) SetShowID(id string) {
+	m.show = &id
+}
+
+// ClearShow clears the "show" edge to the Show entity.
+func (m *SavedShowMutation) ClearShow() {
+	m.clearedshow = true
+}
+
+// ShowCleared reports if the "show" edge to the Show entity was cleared.
+func (m *SavedShowMutation) ShowCleared() bool {
+	return m.clearedshow
+}
+
+// ShowID returns the "show" edge ID in the mutation.
+func (m *SavedShowMutation) ShowID() (id string, exists bool) {
+	if m.show != nil {
+		return *m.show, true
+	}
+	return
+}
+
+// ShowIDs returns the "show" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ShowID instead. It exists only for internal usage by the builders.
+func (m *SavedShowMutation) ShowIDs() (ids []string) {
+	if id := m.show; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetShow resets all changes to the "show" edge.
+func (m *SavedShowMutation) ResetShow() {
+	m.show = nil
+	m.clearedshow = false
+}
+
+// Where appends a list predicates to the SavedShowMutation builder.
+func (m *SavedShowMutation) Where(ps ...predicate.SavedShow) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SavedShowMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SavedShowMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SavedShow, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SavedShowMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SavedShowMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SavedShow).
+func (m *SavedShowMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SavedShowMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.added_at != nil {
+		fields = append(fields, savedshow.FieldAddedAt)
+	}
+	if m.total_episodes != nil {
+		fields = append(fields, savedshow.FieldTotalEpisodes)
+	}
+	if m.created_at != nil {
+		fields = append(fields, savedshow.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SavedShowMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case savedshow.FieldAddedAt:
+		return m.AddedAt()
+	case savedshow.FieldTotalEpisodes:
+		return m.TotalEpisodes()
+	case savedshow.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SavedShowMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case savedshow.FieldAddedAt:
+		return m.OldAddedAt(ctx)
+	case savedshow.FieldTotalEpisodes:
+		return m.OldTotalEpisodes(ctx)
+	case savedshow.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown SavedShow field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SavedShowMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case savedshow.FieldAddedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddedAt(v)
+		return nil
+	case savedshow.FieldTotalEpisodes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalEpisodes(v)
+		return nil
+	case savedshow.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SavedShow field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SavedShowMutation) AddedFields() []string {
+	var fields []string
+	if m.addtotal_episodes != nil {
+		fields = append(fields, savedshow.FieldTotalEpisodes)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SavedShowMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case savedshow.FieldTotalEpisodes:
+		return m.AddedTotalEpisodes()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SavedShowMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case savedshow.FieldTotalEpisodes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalEpisodes(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SavedShow numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SavedShowMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SavedShowMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SavedShowMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SavedShow nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SavedShowMutation) ResetField(name string) error {
+	switch name {
+	case savedshow.FieldAddedAt:
+		m.ResetAddedAt()
+		return nil
+	case savedshow.FieldTotalEpisodes:
+		m.ResetTotalEpisodes()
+		return nil
+	case savedshow.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SavedShow field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SavedShowMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.run != nil {
+		edges = append(edges, savedshow.EdgeRun)
+	}
+	if m.show != nil {
+		edges = append(edges, savedshow.EdgeShow)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SavedShowMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case savedshow.EdgeRun:
+		if id := m.run; id != nil {
+			return []ent.Value{*id}
+		}
+	case savedshow.EdgeShow:
+		if id := m.show; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SavedShowMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SavedShowMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SavedShowMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedrun {
+		edges = append(edges, savedshow.EdgeRun)
+	}
+	if m.clearedshow {
+		edges = append(edges, savedshow.EdgeShow)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SavedShowMutation) EdgeCleared(name string) bool {
+	switch name {
+	case savedshow.EdgeRun:
+		return m.clearedrun
+	case savedshow.EdgeShow:
+		return m.clearedshow
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SavedShowMutation) ClearEdge(name string) error {
+	switch name {
+	case savedshow.EdgeRun:
+		m.ClearRun()
+		return nil
+	case savedshow.EdgeShow:
+		m.ClearShow()
+		return nil
+	}
+	return fmt.Errorf("unknown SavedShow unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SavedShowMutation) ResetEdge(name string) error {
+	switch name {
+	case savedshow.EdgeRun:
+		m.ResetRun()
+		return nil
+	case savedshow.EdgeShow:
+		m.ResetShow()
+		return nil
+	}
+	return fmt.Errorf("unknown SavedShow edge %s", name)
+}
+
+// SavedTrackMutation represents an operation that mutates the SavedTrack nodes in the graph.
+type SavedTrackMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *string
+	added_at       *time.Time
+	linked_from_id *string
+	is_playable    *bool
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	run            *string
+	clearedrun     bool
+	track          *string
+	clearedtrack   bool
+	done           bool
+	oldValue       func(context.Context) (*SavedTrack, error)
+	predicates     []predicate.SavedTrack
+}
+
+var _ ent.Mutation = (*SavedTrackMutation)(nil)
+
+// savedtrackOption allows management of the mutation configuration using functional options.
+type savedtrackOption func(*SavedTrackMutation)
+
+// newSavedTrackMutation creates new mutation for the SavedTrack entity.
+func newSavedTrackMutation(c config, op Op, opts ...savedtrackOption) *SavedTrackMutation {
+	m := &SavedTrackMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSavedTrack,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSavedTrackID sets the ID field of the mutation.
+func withSavedTrackID(id string) savedtrackOption {
+	return func(m *SavedTrackMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SavedTrack
+		)
+		m.oldValue = func(ctx context.Context) (*SavedTrack, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SavedTrack.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSavedTrack sets the old SavedTrack of the mutation.
+func withSavedTrack(node *SavedTrack) savedtrackOption {
+	return func(m *SavedTrackMutation) {
+		m.oldValue = func(context.Context) (*SavedTrack, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SavedTrackMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SavedTrackMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SavedTrack entities.
+func (m *SavedTrackMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SavedTrackMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SavedTrackMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SavedTrack.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAddedAt sets the "added_at" field.
+func (m *SavedTrackMutation) SetAddedAt(t time.Time) {
+	m.added_at = &t
+}
+
+// AddedAt returns the value of the "added_at" field in the mutation.
+func (m *SavedTrackMutation) AddedAt() (r time.Time, exists bool) {
+	v := m.added_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAddedAt returns the old "added_at" field's value of the SavedTrack entity.
+// If the SavedTrack object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedTrackMutation) OldAddedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddedAt: %w", err)
+	}
+	return oldValue.AddedAt, nil
+}
+
+// ResetAddedAt resets all changes to the "added_at" field.
+func (m *SavedTrackMutation) ResetAddedAt() {
+	m.added_at = nil
+}
+
+// SetLinkedFromID sets the "linked_from_id" field.
+func (m *SavedTrackMutation) SetLinkedFromID(s string) {
+	m.linked_from_id = &s
+}
+
+// LinkedFromID returns the value of the "linked_from_id" field in the mutation.
+func (m *SavedTrackMutation) LinkedFromID() (r string, exists bool) {
+	v := m.linked_from_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLinkedFromID returns the old "linked_from_id" field's value of the SavedTrack entity.
+// If the SavedTrack object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedTrackMutation) OldLinkedFromID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLinkedFromID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLinkedFromID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLinkedFromID: %w", err)
+	}
+	return oldValue.LinkedFromID, nil
+}
+
+// ResetLinkedFromID resets all changes to the "linked_from_id" field.
+func (m *SavedTrackMutation) ResetLinkedFromID() {
+	m.linked_from_id = nil
+}
+
+// SetIsPlayable sets the "is_playable" field.
+func (m *SavedTrackMutation) SetIsPlayable(b bool) {
+	m.is_playable = &b
+}
+
+// IsPlayable returns the value of the "is_playable" field in the mutation.
+func (m *SavedTrackMutation) IsPlayable() (r bool, exists bool) {
+	v := m.is_playable
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsPlayable returns the old "is_playable" field's value of the SavedTrack entity.
+// If the SavedTrack object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedTrackMutation) OldIsPlayable(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsPlayable is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsPlayable requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsPlayable: %w", err)
+	}
+	return oldValue.IsPlayable, nil
+}
+
+// ClearIsPlayable clears the value of the "is_playable" field.
+func (m *SavedTrackMutation) ClearIsPlayable() {
+	m.is_playable = nil
+	m.clearedFields[savedtrack.FieldIsPlayable] = struct{}{}
+}
+
+// IsPlayableCleared returns if the "is_playable" field was cleared in this mutation.
+func (m *SavedTrackMutation) IsPlayableCleared() bool {
+	_, ok := m.clearedFields[savedtrack.FieldIsPlayable]
+	return ok
+}
+
+// ResetIsPlayable resets all changes to the "is_playable" field.
+func (m *SavedTrackMutation) ResetIsPlayable() {
+	m.is_playable = nil
+	delete(m.clearedFields, savedtrack.FieldIsPlayable)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SavedTrackMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SavedTrackMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SavedTrack entity.
+// If the SavedTrack object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedTrackMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SavedTrackMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetRunID sets the "run" edge to the BackupRun entity by id.
+func (m *SavedTrackMutation) SetRunID(id string) {
+	m.run = &id
+}
+
+// ClearRun clears the "run" edge to the BackupRun entity.
+func (m *SavedTrackMutation) ClearRun() {
+	m.clearedrun = true
+}
+
+// RunCleared reports if the "run" edge to the BackupRun entity was cleared.
+func (m *SavedTrackMutation) RunCleared() bool {
+	return m.clearedrun
+}
+
+// RunID returns the "run" edge ID in the mutation.
+func (m *SavedTrackMutation) RunID() (id string, exists bool) {
+	if m.run != nil {
+		return *m.run, true
+	}
+	return
+}
+
+// RunIDs returns the "run" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RunID instead. It exists only for internal usage by the builders.
+func (m *SavedTrackMutation) RunIDs() (ids []string) {
+	if id := m.run; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRun resets all changes to the "run" edge.
+func (m *SavedTrackMutation) ResetRun() {
+	m.run = nil
+	m.clearedrun = false
+}
+
+// SetTrackID sets the "track" edge to the Track entity by id.
+func (m *SavedTrackMutation) SetTrackID(id string) {
+	m.track = &id
+}
+
+// ClearTrack clears the "track" edge to the Track entity.
+func (m *SavedTrackMutation) ClearTrack() {
+	m.clearedtrack = true
+}
+
+// TrackCleared reports if the "track" edge to the Track entity was cleared.
+func (m *SavedTrackMutation) TrackCleared() bool {
+	return m.clearedtrack
+}
+
+// TrackID returns the "track" edge ID in the mutation.
+func (m *SavedTrackMutation) TrackID() (id string, exists bool) {
+	if m.track != nil {
+		return *m.track, true
+	}
+	return
+}
+
+// TrackIDs returns the "track" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TrackID instead. It exists only for internal usage by the builders.
+func (m *SavedTrackMutation) TrackIDs() (ids []string) {
+	if id := m.track; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTrack resets all changes to the "track" edge.
+func (m *SavedTrackMutation) ResetTrack() {
+	m.track = nil
+	m.clearedtrack = false
+}
+
+// Where appends a list predicates to the SavedTrackMutation builder.
+func (m *SavedTrackMutation) Where(ps ...predicate.SavedTrack) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SavedTrackMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SavedTrackMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SavedTrack, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SavedTrackMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SavedTrackMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SavedTrack).
+func (m *SavedTrackMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SavedTrackMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.added_at != nil {
+		fields = append(fields, savedtrack.FieldAddedAt)
+	}
+	if m.linked_from_id != nil {
+		fields = append(fields, savedtrack.FieldLinkedFromID)
+	}
+	if m.is_playable != nil {
+		fields = append(fields, savedtrack.FieldIsPlayable)
+	}
+	if m.created_at != nil {
+		fields = append(fields, savedtrack.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SavedTrackMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case savedtrack.FieldAddedAt:
+		return m.AddedAt()
+	case savedtrack.FieldLinkedFromID:
+		return m.LinkedFromID()
+	case savedtrack.FieldIsPlayable:
+		return m.IsPlayable()
+	case savedtrack.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SavedTrackMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case savedtrack.FieldAddedAt:
+		return m.OldAddedAt(ctx)
+	case savedtrack.FieldLinkedFromID:
+		return m.OldLinkedFromID(ctx)
+	case savedtrack.FieldIsPlayable:
+		return m.OldIsPlayable(ctx)
+	case savedtrack.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown SavedTrack field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SavedTrackMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case savedtrack.FieldAddedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddedAt(v)
+		return nil
+	case savedtrack.FieldLinkedFromID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLinkedFromID(v)
+		return nil
+	case savedtrack.FieldIsPlayable:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsPlayable(v)
+		return nil
+	case savedtrack.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SavedTrack field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SavedTrackMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SavedTrackMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SavedTrackMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown SavedTrack numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SavedTrackMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(savedtrack.FieldIsPlayable) {
+		fields = append(fields, savedtrack.FieldIsPlayable)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SavedTrackMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SavedTrackMutation) ClearField(name string) error {
+	switch name {
+	case savedtrack.FieldIsPlayable:
+		m.ClearIsPlayable()
+		return nil
+	}
+	return fmt.Errorf("unknown SavedTrack nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SavedTrackMutation) ResetField(name string) error {
+	switch name {
+	case savedtrack.FieldAddedAt:
+		m.ResetAddedAt()
+		return nil
+	case savedtrack.FieldLinkedFromID:
+		m.ResetLinkedFromID()
+		return nil
+	case savedtrack.FieldIsPlayable:
+		m.ResetIsPlayable()
+		return nil
+	case savedtrack.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SavedTrack field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SavedTrackMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.run != nil {
+		edges = append(edges, savedtrack.EdgeRun)
+	}
+	if m.track != nil {
+		edges = append(edges, savedtrack.EdgeTrack)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SavedTrackMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case savedtrack.EdgeRun:
+		if id := m.run; id != nil {
+			return []ent.Value{*id}
+		}
+	case savedtrack.EdgeTrack:
+		if id := m.track; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SavedTrackMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SavedTrackMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SavedTrackMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedrun {
+		edges = append(edges, savedtrack.EdgeRun)
+	}
+	if m.clearedtrack {
+		edges = append(edges, savedtrack.EdgeTrack)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SavedTrackMutation) EdgeCleared(name string) bool {
+	switch name {
+	case savedtrack.EdgeRun:
+		return m.clearedrun
+	case savedtrack.EdgeTrack:
+		return m.clearedtrack
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SavedTrackMutation) ClearEdge(name string) error {
+	switch name {
+	case savedtrack.EdgeRun:
+		m.ClearRun()
+		return nil
+	case savedtrack.EdgeTrack:
+		m.ClearTrack()
+		return nil
+	}
+	return fmt.Errorf("unknown SavedTrack unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SavedTrackMutation) ResetEdge(name string) error {
+	switch name {
+	case savedtrack.EdgeRun:
+		m.ResetRun()
+		return nil
+	case savedtrack.EdgeTrack:
+		m.ResetTrack()
+		return nil
+	}
+	return fmt.Errorf("unknown SavedTrack edge %s", name)
+}
+
+// SchemaMigrationMutation represents an operation that mutates the SchemaMigration nodes in the graph.
+type SchemaMigrationMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	name          *string
+	applied_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*SchemaMigration, error)
+	predicates    []predicate.SchemaMigration
+}
+
+var _ ent.Mutation = (*SchemaMigrationMutation)(nil)
+
+// schemamigrationOption allows management of the mutation configuration using functional options.
+type schemamigrationOption func(*SchemaMigrationMutation)
+
+// newSchemaMigrationMutation creates new mutation for the SchemaMigration entity.
+func newSchemaMigrationMutation(c config, op Op, opts ...schemamigrationOption) *SchemaMigrationMutation {
+	m := &SchemaMigrationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSchemaMigration,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSchemaMigrationID sets the ID field of the mutation.
+func withSchemaMigrationID(id int) schemamigrationOption {
+	return func(m *SchemaMigrationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SchemaMigration
+		)
+		m.oldValue = func(ctx context.Context) (*SchemaMigration, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SchemaMigration.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSchemaMigration sets the old SchemaMigration of the mutation.
+func withSchemaMigration(node *SchemaMigration) schemamigrationOption {
+	return func(m *SchemaMigrationMutation) {
+		m.oldValue = func(context.Context) (*SchemaMigration, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SchemaMigrationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SchemaMigrationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SchemaMigration entities.
+func (m *SchemaMigrationMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SchemaMigrationMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SchemaMigrationMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SchemaMigration.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *SchemaMigrationMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *SchemaMigrationMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the SchemaMigration entity.
+// If the SchemaMigration object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchemaMigrationMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *SchemaMigrationMutation) ResetName() {
+	m.name = nil
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (m *SchemaMigrationMutation) SetAppliedAt(t time.Time) {
+	m.applied_at = &t
+}
+
+// AppliedAt returns the value of the "applied_at" field in the mutation.
+func (m *SchemaMigrationMutation) AppliedAt() (r time.Time, exists bool) {
+	v := m.applied_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAppliedAt returns the old "applied_at" field's value of the SchemaMigration entity.
+// If the SchemaMigration object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchemaMigrationMutation) OldAppliedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAppliedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAppliedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAppliedAt: %w", err)
+	}
+	return oldValue.AppliedAt, nil
+}
+
+// ResetAppliedAt resets all changes to the "applied_at" field.
+func (m *SchemaMigrationMutation) ResetAppliedAt() {
+	m.applied_at = nil
+}
+
+// Where appends a list predicates to the SchemaMigrationMutation builder.
+func (m *SchemaMigrationMutation) Where(ps ...predicate.SchemaMigration) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SchemaMigrationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SchemaMigrationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SchemaMigration, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SchemaMigrationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SchemaMigrationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SchemaMigration).
+func (m *SchemaMigrationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SchemaMigrationMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.name != nil {
+		fields = append(fields, schemamigration.FieldName)
+	}
+	if m.applied_at != nil {
+		fields = append(fields, schemamigration.FieldAppliedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SchemaMigrationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case schemamigration.FieldName:
+		return m.Name()
+	case schemamigration.FieldAppliedAt:
+		return m.AppliedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SchemaMigrationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case schemamigration.FieldName:
+		return m.OldName(ctx)
+	case schemamigration.FieldAppliedAt:
+		return m.OldAppliedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown SchemaMigration field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SchemaMigrationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case schemamigration.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case schemamigration.FieldAppliedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAppliedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SchemaMigration field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SchemaMigrationMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SchemaMigrationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SchemaMigrationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown SchemaMigration numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SchemaMigrationMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SchemaMigrationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SchemaMigrationMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SchemaMigration nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SchemaMigrationMutation) ResetField(name string) error {
+	switch name {
+	case schemamigration.FieldName:
+		m.ResetName()
+		return nil
+	case schemamigration.FieldAppliedAt:
+		m.ResetAppliedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SchemaMigration field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SchemaMigrationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SchemaMigrationMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SchemaMigrationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SchemaMigrationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SchemaMigrationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SchemaMigrationMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SchemaMigrationMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SchemaMigration unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SchemaMigrationMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SchemaMigration edge %s", name)
+}
+
+// ShowMutation represents an operation that mutates the Show nodes in the graph.
+type ShowMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *string
+	spotify_id         *string
+	uri                *string
+	name               *string
+	publisher          *string
+	created_at         *time.Time
+	clearedFields      map[string]struct{}
+	episodes           map[string]struct{}
+	removedepisodes    map[string]struct{}
+	clearedepisodes    bool
+	saved_shows        map[string]struct{}
+	removedsaved_shows map[string]struct{}
+	clearedsaved_shows bool
+	done               bool
+	oldValue           func(context.Context) (*Show, error)
+	predicates         []predicate.Show
+}
+
+var _ ent.Mutation = (*ShowMutation)(nil)
+
+// showOption allows management of the mutation configuration using functional options.
+type showOption func(*ShowMutation)
+
+// newShowMutation creates new mutation for the Show entity.
+func newShowMutation(c config, op Op, opts ...showOption) *ShowMutation {
+	m := &ShowMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeShow,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withShowID sets the ID field of the mutation.
+func withShowID(id string) showOption {
+	return func(m *ShowMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Show
+		)
+		m.oldValue = func(ctx context.Context) (*Show, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Show.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withShow sets the old Show of the mutation.
+func withShow(node *Show) showOption {
+	return func(m *ShowMutation) {
+		m.oldValue = func(context.Context) (*Show, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ShowMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ShowMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Show entities.
+func (m *ShowMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ShowMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ShowMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Show.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSpotifyID sets the "spotify_id" field.
+func (m *ShowMutation) SetSpotifyID(s string) {
+	m.spotify_id = &s
+}
+
+// SpotifyID returns the value of the "spotify_id" field in the mutation.
+func (m *ShowMutation) SpotifyID() (r string, exists bool) {
+	v := m.spotify_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSpotifyID returns the old "spotify_id" field's value of the Show entity.
+// If the Show object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShowMutation) OldSpotifyID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSpotifyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSpotifyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSpotifyID: %w", err)
+	}
+	return oldValue.SpotifyID, nil
+}
+
+// ResetSpotifyID resets all changes to the "spotify_id" field.
+func (m *ShowMutation) ResetSpotifyID() {
+	m.spotify_id = nil
+}
+
+// SetURI sets the "uri" field.
+func (m *ShowMutation) SetURI(s string) {
+	m.uri = &s
+}
+
+// URI returns the value of the "uri" field in the mutation.
+func (m *ShowMutation) URI() (r string, exists bool) {
+	v := m.uri
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURI returns the old "uri" field's value of the Show entity.
+// If the Show object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShowMutation) OldURI(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURI is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURI requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURI: %w", err)
+	}
+	return oldValue.URI, nil
+}
+
+// ResetURI resets all changes to the "uri" field.
+func (m *ShowMutation) ResetURI() {
+	m.uri = nil
+}
+
+// SetName sets the "name" field.
+func (m *ShowMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ShowMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Show entity.
+// If the Show object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShowMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ShowMutation) ResetName() {
+	m.name = nil
+}
+
+// SetPublisher sets the "publisher" field.
+func (m *ShowMutation) SetPublisher(s string) {
+	m.publisher = &s
+}
+
+// Publisher returns the value of the "publisher" field in the mutation.
+func (m *ShowMutation) Publisher() (r string, exists bool) {
+	v := m.publisher
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublisher returns the old "publisher" field's value of the Show entity.
+// If the Show object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShowMutation) OldPublisher(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublisher is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublisher requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublisher: %w", err)
+	}
+	return oldValue.Publisher, nil
+}
+
+// ResetPublisher resets all changes to the "publisher" field.
+func (m *ShowMutation) ResetPublisher() {
+	m.publisher = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ShowMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ShowMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Show entity.
+// If the Show object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShowMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ShowMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// AddEpisodeIDs adds the "episodes" edge to the Episode entity by ids.
+func (m *ShowMutation) AddEpisodeIDs(ids ...string) {
+	if m.episodes == nil {
+		m.episodes = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.episodes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearEpisodes clears the "episodes" edge to the Episode entity.
+func (m *ShowMutation) ClearEpisodes() {
+	m.clearedepisodes = true
+}
+
+// EpisodesCleared reports if the "episodes" edge to the Episode entity was cleared.
+func (m *ShowMutation) EpisodesCleared() bool {
+	return m.clearedepisodes
+}
+
+// RemoveEpisodeIDs removes the "episodes" edge to the Episode entity by IDs.
+func (m *ShowMutation) RemoveEpisodeIDs(ids ...string) {
+	if m.removedepisodes == nil {
+		m.removedepisodes = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.episodes, ids[i])
+		m.removedepisodes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedEpisodes returns the removed IDs of the "episodes" edge to the Episode entity.
+func (m *ShowMutation) RemovedEpisodesIDs() (ids []string) {
+	for id := range m.removedepisodes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// EpisodesIDs returns the "episodes" edge IDs in the mutation.
+func (m *ShowMutation) EpisodesIDs() (ids []string) {
+	for id := range m.episodes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetEpisodes resets all changes to the "episodes" edge.
+func (m *ShowMutation) ResetEpisodes() {
+	m.episodes = nil
+	m.clearedepisodes = false
+	m.removedepisodes = nil
+}
+
+// AddSavedShowIDs adds the "saved_shows" edge to the SavedShow entity by ids.
+func (m *ShowMutation) AddSavedShowIDs(ids ...string) {
+	if m.saved_shows == nil {
+		m.saved_shows = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.saved_shows[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSavedShows clears the "saved_shows" edge to the SavedShow entity.
+func (m *ShowMutation) ClearSavedShows() {
+	m.clearedsaved_shows = true
+}
+
+// SavedShowsCleared reports if the "saved_shows" edge to the SavedShow entity was cleared.
+func (m *ShowMutation) SavedShowsCleared() bool {
+	return m.clearedsaved_shows
+}
+
+// RemoveSavedShowIDs removes the "saved_shows" edge to the SavedShow entity by IDs.
+func (m *ShowMutation) RemoveSavedShowIDs(ids ...string) {
+	if m.removedsaved_shows == nil {
+		m.removedsaved_shows = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.saved_shows, ids[i])
+		m.removedsaved_shows[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSavedShows returns the removed IDs of the "saved_shows" edge to the SavedShow entity.
+func (m *ShowMutation) RemovedSavedShowsIDs() (ids []string) {
+	for id := range m.removedsaved_shows {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SavedShowsIDs returns the "saved_shows" edge IDs in the mutation.
+func (m *ShowMutation) SavedShowsIDs() (ids []string) {
+	for id := range m.saved_shows {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSavedShows resets all changes to the "saved_shows" edge.
+func (m *ShowMutation) ResetSavedShows() {
+	m.saved_shows = nil
+	m.clearedsaved_shows = false
+	m.removedsaved_shows = nil
+}
+
+// Where appends a list predicates to the ShowMutation builder.
+func (m *ShowMutation) Where(ps ...predicate.Show) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ShowMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ShowMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Show, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ShowMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ShowMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Show).
+func (m *ShowMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ShowMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.spotify_id != nil {
+		fields = append(fields, show.FieldSpotifyID)
+	}
+	if m.uri != nil {
+		fields = append(fields, show.FieldURI)
+	}
+	if m.name != nil {
+		fields = append(fields, show.FieldName)
+	}
+	if m.publisher != nil {
+		fields = append(fields, show.FieldPublisher)
+	}
+	if m.created_at != nil {
+		fields = append(fields, show.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ShowMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case show.FieldSpotifyID:
+		return m.SpotifyID()
+	case show.FieldURI:
+		return m.URI()
+	case show.FieldName:
+		return m.Name()
+	case show.FieldPublisher:
+		return m.Publisher()
+	case show.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ShowMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case show.FieldSpotifyID:
+		return m.OldSpotifyID(ctx)
+	case show.FieldURI:
+		return m.OldURI(ctx)
+	case show.FieldName:
+		return m.OldName(ctx)
+	case show.FieldPublisher:
+		return m.OldPublisher(ctx)
+	case show.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Show field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ShowMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case show.FieldSpotifyID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpotifyID(v)
+		return nil
+	case show.FieldURI:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURI(v)
+		return nil
+	case show.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case show.FieldPublisher:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublisher(v)
+		return nil
+	case show.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Show field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ShowMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ShowMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ShowMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Show numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ShowMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ShowMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ShowMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Show nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ShowMutation) ResetField(name string) error {
+	switch name {
+	case show.FieldSpotifyID:
+		m.ResetSpotifyID()
+		return nil
+	case show.FieldURI:
+		m.ResetURI()
+		return nil
+	case show.FieldName:
+		m.ResetName()
+		return nil
+	case show.FieldPublisher:
+		m.ResetPublisher()
+		return nil
+	case show.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Show field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ShowMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.episodes != nil {
+		edges = append(edges, show.EdgeEpisodes)
+	}
+	if m.saved_shows != nil {
+		edges = append(edges, show.EdgeSavedShows)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ShowMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case show.EdgeEpisodes:
+		ids := make([]ent.Value, 0, len(m.episodes))
+		for id := range m.episodes {
+			ids = append(ids, id)
+		}
+		return ids
+	case show.EdgeSavedShows:
+		ids := make([]ent.Value, 0, len(m.saved_shows))
+		for id := range m.saved_shows {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ShowMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedepisodes != nil {
+		edges = append(edges, show.EdgeEpisodes)
+	}
+	if m.removedsaved_shows != nil {
+		edges = append(edges, show.EdgeSavedShows)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ShowMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case show.EdgeEpisodes:
+		ids := make([]ent.Value, 0, len(m.removedepisodes))
+		for id := range m.removedepisodes {
+			ids = append(ids, id)
+		}
+		return ids
+	case show.EdgeSavedShows:
+		ids := make([]ent.Value, 0, len(m.removedsaved_shows))
+		for id := range m.removedsaved_shows {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ShowMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedepisodes {
+		edges = append(edges, show.EdgeEpisodes)
+	}
+	if m.clearedsaved_shows {
+		edges = append(edges, show.EdgeSavedShows)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ShowMutation) EdgeCleared(name string) bool {
+	switch name {
+	case show.EdgeEpisodes:
+		return m.clearedepisodes
+	case show.EdgeSavedShows:
+		return m.clearedsaved_shows
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ShowMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Show unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ShowMutation) ResetEdge(name string) error {
+	switch name {
+	case show.EdgeEpisodes:
+		m.ResetEpisodes()
+		return nil
+	case show.EdgeSavedShows:
+		m.ResetSavedShows()
+		return nil
+	}
+	return fmt.Errorf("unknown Show edge %s", name)
+}
+
+// SnapshotItemMutation represents an operation that mutates the SnapshotItem nodes in the graph.
+type SnapshotItemMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *string
+	position        *int
+	addposition     *int
+	added_at        *time.Time
+	added_by        *string
+	is_local        *bool
+	_type           *snapshotitem.Type
+	uri             *string
+	linked_from_id  *string
+	is_playable     *bool
+	clearedFields   map[string]struct{}
+	snapshot        *string
+	clearedsnapshot bool
+	track           *string
+	clearedtrack    bool
+	episode         *string
+	clearedepisode  bool
+	done            bool
+	oldValue        func(context.Context) (*SnapshotItem, error)
+	predicates      []predicate.SnapshotItem
+}
+
+var _ ent.Mutation = (*SnapshotItemMutation)(nil)
+
+// snapshotitemOption allows management of the mutation configuration using functional options.
+type snapshotitemOption func(*SnapshotItemMutation)
+
+// newSnapshotItemMutation creates new mutation for the SnapshotItem entity.
+func newSnapshotItemMutation(c config, op Op, opts ...snapshotitemOption) *SnapshotItemMutation {
+	m := &SnapshotItemMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSnapshotItem,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSnapshotItemID sets the ID field of the mutation.
+func withSnapshotItemID(id string) snapshotitemOption {
+	return func(m *SnapshotItemMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SnapshotItem
+		)
+		m.oldValue = func(ctx context.Context) (*SnapshotItem, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SnapshotItem.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSnapshotItem sets the old SnapshotItem of the mutation.
+func withSnapshotItem(node *SnapshotItem) snapshotitemOption {
+	return func(m *SnapshotItemMutation) {
+		m.oldValue = func(context.Context) (*SnapshotItem, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SnapshotItemMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SnapshotItemMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SnapshotItem entities.
+func (m *SnapshotItemMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SnapshotItemMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SnapshotItemMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SnapshotItem.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPosition sets the "position" field.
+func (m *SnapshotItemMutation) SetPosition(i int) {
+	m.position = &i
+	m.addposition = nil
+}
+
+// Position returns the value of the "position" field in the mutation.
+func (m *SnapshotItemMutation) Position() (r int, exists bool) {
+	v := m.position
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPosition returns the old "position" field's value of the SnapshotItem entity.
+// If the SnapshotItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotItemMutation) OldPosition(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPosition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
+	}
+	return oldValue.Position, nil
+}
+
+// AddPosition adds i to the "position" field.
+func (m *SnapshotItemMutation) AddPosition(i int) {
+	if m.addposition != nil {
+		*m.addposition += i
+	} else {
+		m.addposition = &i
+	}
+}
+
+// AddedPosition returns the value that was added to the "position" field in this mutation.
+func (m *SnapshotItemMutation) AddedPosition() (r int, exists bool) {
+	v := m.addposition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPosition resets all changes to the "position" field.
+func (m *SnapshotItemMutation) ResetPosition() {
+	m.position = nil
+	m.addposition = nil
+}
+
+// SetAddedAt sets the "added_at" field.
+func (m *SnapshotItemMutation) SetAddedAt(t time.Time) {
+	m.added_at = &t
+}
+
+// AddedAt returns the value of the "added_at" field in the mutation.
+func (m *SnapshotItemMutation) AddedAt() (r time.Time, exists bool) {
+	v := m.added_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAddedAt returns the old "added_at" field's value of the SnapshotItem entity.
+// If the SnapshotItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotItemMutation) OldAddedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddedAt: %w", err)
+	}
+	return oldValue.AddedAt, nil
+}
+
+// ClearAddedAt clears the value of the "added_at" field.
+func (m *SnapshotItemMutation) ClearAddedAt() {
+	m.added_at = nil
+	m.clearedFields[snapshotitem.FieldAddedAt] = struct{}{}
+}
+
+// AddedAtCleared returns if the "added_at" field was cleared in this mutation.
+func (m *SnapshotItemMutation) AddedAtCleared() bool {
+	_, ok := m.clearedFields[snapshotitem.FieldAddedAt]
+	return ok
+}
+
+// ResetAddedAt resets all changes to the "added_at" field.
+func (m *SnapshotItemMutation) ResetAddedAt() {
+	m.added_at = nil
+	delete(m.clearedFields, snapshotitem.FieldAddedAt)
+}
+
+// SetAddedBy sets the "added_by" field.
+func (m *SnapshotItemMutation) SetAddedBy(s string) {
+	m.added_by = &s
+}
+
+// AddedBy returns the value of the "added_by" field in the mutation.
+func (m *SnapshotItemMutation) AddedBy() (r string, exists bool) {
+	v := m.added_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAddedBy returns the old "added_by" field's value of the SnapshotItem entity.
+// If the SnapshotItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotItemMutation) OldAddedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddedBy: %w", err)
+	}
+	return oldValue.AddedBy, nil
+}
+
+// ResetAddedBy resets all changes to the "added_by" field.
+func (m *SnapshotItemMutation) ResetAddedBy() {
+	m.added_by = nil
+}
+
+// SetIsLocal sets the "is_local" field.
+func (m *SnapshotItemMutation) SetIsLocal(b bool) {
+	m.is_local = &b
+}
+
+// IsLocal returns the value of the "is_local" field in the mutation.
+func (m *SnapshotItemMutation) IsLocal() (r bool, exists bool) {
+	v := m.is_local
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsLocal returns the old "is_local" field's value of the SnapshotItem entity.
+// If the SnapshotItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotItemMutation) OldIsLocal(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsLocal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsLocal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsLocal: %w", err)
+	}
+	return oldValue.IsLocal, nil
+}
+
+// ResetIsLocal resets all changes to the "is_local" field.
+func (m *SnapshotItemMutation) ResetIsLocal() {
+	m.is_local = nil
+}
+
+// SetType sets the "type" field.
+func (m *SnapshotItemMutation) SetType(s snapshotitem.Type) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *SnapshotItemMutation) GetType() (r snapshotitem.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the SnapshotItem entity.
+// If the SnapshotItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotItemMutation) OldType(ctx context.Context) (v snapshotitem.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *SnapshotItemMutation) ResetType() {
+	m._type = nil
+}
+
+// SetURI sets the "uri" field.
+func (m *SnapshotItemMutation) SetURI(s string) {
+	m.uri = &s
+}
+
+// URI returns the value of the "uri" field in the mutation.
+func (m *SnapshotItemMutation) URI() (r string, exists bool) {
+	v := m.uri
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURI returns the old "uri" field's value of the SnapshotItem entity.
+// If the SnapshotItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotItemMutation) OldURI(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURI is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURI requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURI: %w", err)
+	}
+	return oldValue.URI, nil
+}
+
+// ResetURI resets all changes to the "uri" field.
+func (m *SnapshotItemMutation) ResetURI() {
+	m.uri = nil
+}
+
+// SetLinkedFromID sets the "linked_from_id" field.
+func (m *SnapshotItemMutation) SetLinkedFromID(s string) {
+	m.linked_from_id = &s
+}
+
+// LinkedFromID returns the value of the "linked_from_id" field in the mutation.
+func (m *SnapshotItemMutation) LinkedFromID() (r string, exists bool) {
+	v := m.linked_from_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLinkedFromID returns the old "linked_from_id" field's value of the SnapshotItem entity.
+// If the SnapshotItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotItemMutation) OldLinkedFromID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLinkedFromID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLinkedFromID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLinkedFromID: %w", err)
+	}
+	return oldValue.LinkedFromID, nil
+}
+
+// ResetLinkedFromID resets all changes to the "linked_from_id" field.
+func (m *SnapshotItemMutation) ResetLinkedFromID() {
+	m.linked_from_id = nil
+}
+
+// SetIsPlayable sets the "is_playable" field.
+func (m *SnapshotItemMutation) SetIsPlayable(b bool) {
+	m.is_playable = &b
+}
+
+// IsPlayable returns the value of the "is_playable" field in the mutation.
+func (m *SnapshotItemMutation) IsPlayable() (r bool, exists bool) {
+	v := m.is_playable
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsPlayable returns the old "is_playable" field's value of the SnapshotItem entity.
+// If the SnapshotItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotItemMutation) OldIsPlayable(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsPlayable is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsPlayable requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsPlayable: %w", err)
+	}
+	return oldValue.IsPlayable, nil
+}
+
+// ClearIsPlayable clears the value of the "is_playable" field.
+func (m *SnapshotItemMutation) ClearIsPlayable() {
+	m.is_playable = nil
+	m.clearedFields[snapshotitem.FieldIsPlayable] = struct{}{}
+}
+
+// IsPlayableCleared returns if the "is_playable" field was cleared in this mutation.
+func (m *SnapshotItemMutation) IsPlayableCleared() bool {
+	_, ok := m.clearedFields[snapshotitem.FieldIsPlayable]
+	return ok
+}
+
+// ResetIsPlayable resets all changes to the "is_playable" field.
+func (m *SnapshotItemMutation) ResetIsPlayable() {
+	m.is_playable = nil
+	delete(m.clearedFields, snapshotitem.FieldIsPlayable)
+}
+
+// SetSnapshotID sets the "snapshot" edge to the PlaylistSnapshot entity by id.
+func (m *SnapshotItemMutation) SetSnapshotID(id string) {
+	m.snapshot = &id
+}
+
+// ClearSnapshot clears the "snapshot" edge to the PlaylistSnapshot entity.
+func (m *SnapshotItemMutation) ClearSnapshot() {
+	m.clearedsnapshot = true
+}
+
+// SnapshotCleared reports if the "snapshot" edge to the PlaylistSnapshot entity was cleared.
+func (m *SnapshotItemMutation) SnapshotCleared() bool {
+	return m.clearedsnapshot
+}
+
+// SnapshotID returns the "snapshot" edge ID in the mutation.
+func (m *SnapshotItemMutation) SnapshotID() (id string, exists bool) {
+	if m.snapshot != nil {
+		return *m.snapshot, true
+	}
+	return
+}
+
+// SnapshotIDs returns the "snapshot" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SnapshotID instead. It exists only for internal usage by the builders.
+func (m *SnapshotItemMutation) SnapshotIDs() (ids []string) {
+	if id := m.snapshot; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSnapshot resets all changes to the "snapshot" edge.
+func (m *SnapshotItemMutation) ResetSnapshot() {
+	m.snapshot = nil
+	m.clearedsnapshot = false
+}
+
+// SetTrackID sets the "track" edge to the Track entity by id.
+func (m *SnapshotItemMutation) SetTrackID(id string) {
+	m.track = &id
+}
+
+// ClearTrack clears the "track" edge to the Track entity.
+func (m *SnapshotItemMutation) ClearTrack() {
+	m.clearedtrack = true
+}
+
+// TrackCleared reports if the "track" edge to the Track entity was cleared.
+func (m *SnapshotItemMutation) TrackCleared() bool {
+	return m.clearedtrack
+}
+
+// TrackID returns the "track" edge ID in the mutation.
+func (m *SnapshotItemMutation) TrackID() (id string, exists bool) {
+	if m.track != nil {
+		return *m.track, true
+	}
+	return
+}
+
+// TrackIDs returns the "track" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TrackID instead. It exists only for internal usage by the builders.
+func (m *SnapshotItemMutation) TrackIDs() (ids []string) {
+	if id := m.track; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTrack resets all changes to the "track" edge.
+func (m *SnapshotItemMutation) ResetTrack() {
+	m.track = nil
+	m.clearedtrack = false
+}
+
+// SetEpisodeID sets the "episode" edge to the Episode entity by id.
+func (m *SnapshotItemMutation) SetEpisodeID(id string) {
+	m.episode = &id
+}
+
+// ClearEpisode clears the "episode" edge to the Episode entity.
+func (m *SnapshotItemMutation) ClearEpisode() {
+	m.clearedepisode = true
+}
+
+// EpisodeCleared reports if the "episode" edge to the Episode entity was cleared.
+func (m *SnapshotItemMutation) EpisodeCleared() bool {
+	return m.clearedepisode
+}
+
+// EpisodeID returns the "episode" edge ID in the mutation.
+func (m *SnapshotItemMutation) EpisodeID() (id string, exists bool) {
+	if m.episode != nil {
+		return *m.episode, true
+	}
+	return
+}
+
+// EpisodeIDs returns the "episode" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EpisodeID instead. It exists only for internal usage by the builders.
+func (m *SnapshotItemMutation) EpisodeIDs() (ids []string) {
+	if id := m.episode; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetEpisode resets all changes to the "episode" edge.
+func (m *SnapshotItemMutation) ResetEpisode() {
+	m.episode = nil
+	m.clearedepisode = false
+}
+
+// Where appends a list predicates to the SnapshotItemMutation builder.
+func (m *SnapshotItemMutation) Where(ps ...predicate.SnapshotItem) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SnapshotItemMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SnapshotItemMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SnapshotItem, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SnapshotItemMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SnapshotItemMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SnapshotItem).
+func (m *SnapshotItemMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SnapshotItemMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.position != nil {
+		fields = append(fields, snapshotitem.FieldPosition)
+	}
+	if m.added_at != nil {
+		fields = append(fields, snapshotitem.FieldAddedAt)
+	}
+	if m.added_by != nil {
+		fields = append(fields, snapshotitem.FieldAddedBy)
+	}
+	if m.is_local != nil {
+		fields = append(fields, snapshotitem.FieldIsLocal)
+	}
+	if m._type != nil {
+		fields = append(fields, snapshotitem.FieldType)
+	}
+	if m.uri != nil {
+		fields = append(fields, snapshotitem.FieldURI)
+	}
+	if m.linked_from_id != nil {
+		fields = append(fields, snapshotitem.FieldLinkedFromID)
+	}
+	if m.is_playable != nil {
+		fields = append(fields, snapshotitem.FieldIsPlayable)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SnapshotItemMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case snapshotitem.FieldPosition:
+		return m.Position()
+	case snapshotitem.FieldAddedAt:
+		return m.AddedAt()
+	case snapshotitem.FieldAddedBy:
+		return m.AddedBy()
+	case snapshotitem.FieldIsLocal:
+		return m.IsLocal()
+	case snapshotitem.FieldType:
+		return m.GetType()
+	case snapshotitem.FieldURI:
+		return m.URI()
+	case snapshotitem.FieldLinkedFromID:
+		return m.LinkedFromID()
+	case snapshotitem.FieldIsPlayable:
+		return m.IsPlayable()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SnapshotItemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case snapshotitem.FieldPosition:
+		return m.OldPosition(ctx)
+	case snapshotitem.FieldAddedAt:
+		return m.OldAddedAt(ctx)
+	case snapshotitem.FieldAddedBy:
+		return m.OldAddedBy(ctx)
+	case snapshotitem.FieldIsLocal:
+		return m.OldIsLocal(ctx)
+	case snapshotitem.FieldType:
+		return m.OldType(ctx)
+	case snapshotitem.FieldURI:
+		return m.OldURI(ctx)
+	case snapshotitem.FieldLinkedFromID:
+		return m.OldLinkedFromID(ctx)
+	case snapshotitem.FieldIsPlayable:
+		return m.OldIsPlayable(ctx)
+	}
+	return nil, fmt.Errorf("unknown SnapshotItem field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SnapshotItemMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case snapshotitem.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPosition(v)
+		return nil
+	case snapshotitem.FieldAddedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddedAt(v)
+		return nil
+	case snapshotitem.FieldAddedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddedBy(v)
+		return nil
+	case snapshotitem.FieldIsLocal:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsLocal(v)
+		return nil
+	case snapshotitem.FieldType:
+		v, ok := value.(snapshotitem.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case snapshotitem.FieldURI:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURI(v)
+		return nil
+	case snapshotitem.FieldLinkedFromID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLinkedFromID(v)
+		return nil
+	case snapshotitem.FieldIsPlayable:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsPlayable(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SnapshotItem field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SnapshotItemMutation) AddedFields() []string {
+	var fields []string
+	if m.addposition != nil {
+		fields = append(fields, snapshotitem.FieldPosition)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SnapshotItemMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case snapshotitem.FieldPosition:
+		return m.AddedPosition()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SnapshotItemMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case snapshotitem.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPosition(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SnapshotItem numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SnapshotItemMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(snapshotitem.FieldAddedAt) {
+		fields = append(fields, snapshotitem.FieldAddedAt)
+	}
+	if m.FieldCleared(snapshotitem.FieldIsPlayable) {
+		fields = append(fields, snapshotitem.FieldIsPlayable)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SnapshotItemMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SnapshotItemMutation) ClearField(name string) error {
+	switch name {
+	case snapshotitem.FieldAddedAt:
+		m.ClearAddedAt()
+		return nil
+	case snapshotitem.FieldIsPlayable:
+		m.ClearIsPlayable()
+		return nil
+	}
+	return fmt.Errorf("unknown SnapshotItem nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SnapshotItemMutation) ResetField(name string) error {
+	switch name {
+	case snapshotitem.FieldPosition:
+		m.ResetPosition()
+		return nil
+	case snapshotitem.FieldAddedAt:
+		m.ResetAddedAt()
+		return nil
+	case snapshotitem.FieldAddedBy:
+		m.ResetAddedBy()
+		return nil
+	case snapshotitem.FieldIsLocal:
+		m.ResetIsLocal()
+		return nil
+	case snapshotitem.FieldType:
+		m.ResetType()
+		return nil
+	case snapshotitem.FieldURI:
+		m.ResetURI()
+		return nil
+	case snapshotitem.FieldLinkedFromID:
+		m.ResetLinkedFromID()
+		return nil
+	case snapshotitem.FieldIsPlayable:
+		m.ResetIsPlayable()
+		return nil
+	}
+	return fmt.Errorf("unknown SnapshotItem field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SnapshotItemMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.snapshot != nil {
+		edges = append(edges, snapshotitem.EdgeSnapshot)
+	}
+	if m.track != nil {
+		edges = append(edges, snapshotitem.EdgeTrack)
+	}
+	if m.episode != nil {
+		edges = append(edges, snapshotitem.EdgeEpisode)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SnapshotItemMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case snapshotitem.EdgeSnapshot:
+		if id := m.snapshot; id != nil {
+			return []ent.Value{*id}
+		}
+	case snapshotitem.EdgeTrack:
+		if id := m.track; id != nil {
+			return []ent.Value{*id}
+		}
+	case snapshotitem.EdgeEpisode:
+		if id := m.episode; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SnapshotItemMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SnapshotItemMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SnapshotItemMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedsnapshot {
+		edges = append(edges, snapshotitem.EdgeSnapshot)
+	}
+	if m.clearedtrack {
+		edges = append(edges, snapshotitem.EdgeTrack)
+	}
+	if m.clearedepisode {
+		edges = append(edges, snapshotitem.EdgeEpisode)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SnapshotItemMutation) EdgeCleared(name string) bool {
+	switch name {
+	case snapshotitem.EdgeSnapshot:
+		return m.clearedsnapshot
+	case snapshotitem.EdgeTrack:
+		return m.clearedtrack
+	case snapshotitem.EdgeEpisode:
+		return m.clearedepisode
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SnapshotItemMutation) ClearEdge(name string) error {
+	switch name {
+	case snapshotitem.EdgeSnapshot:
+		m.ClearSnapshot()
+		return nil
+	case snapshotitem.EdgeTrack:
+		m.ClearTrack()
+		return nil
+	case snapshotitem.EdgeEpisode:
+		m.ClearEpisode()
+		return nil
+	}
+	return fmt.Errorf("unknown SnapshotItem unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SnapshotItemMutation) ResetEdge(name string) error {
+	switch name {
+	case snapshotitem.EdgeSnapshot:
+		m.ResetSnapshot()
+		return nil
+	case snapshotitem.EdgeTrack:
+		m.ResetTrack()
+		return nil
+	case snapshotitem.EdgeEpisode:
+		m.ResetEpisode()
+		return nil
+	}
+	return fmt.Errorf("unknown SnapshotItem edge %s", name)
+}
+
+// SpotifyTokenMutation represents an operation that mutates the SpotifyToken nodes in the graph.
+type SpotifyTokenMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	name          *string
+	token         *[]byte
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*SpotifyToken, error)
+	predicates    []predicate.SpotifyToken
+}
+
+var _ ent.Mutation = (*SpotifyTokenMutation)(nil)
+
+// spotifytokenOption allows management of the mutation configuration using functional options.
+type spotifytokenOption func(*SpotifyTokenMutation)
+
+// newSpotifyTokenMutation creates new mutation for the SpotifyToken entity.
+func newSpotifyTokenMutation(c config, op Op, opts ...spotifytokenOption) *SpotifyTokenMutation {
+	m := &SpotifyTokenMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSpotifyToken,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSpotifyTokenID sets the ID field of the mutation.
+func withSpotifyTokenID(id string) spotifytokenOption {
+	return func(m *SpotifyTokenMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SpotifyToken
+		)
+		m.oldValue = func(ctx context.Context) (*SpotifyToken, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SpotifyToken.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSpotifyToken sets the old SpotifyToken of the mutation.
+func withSpotifyToken(node *SpotifyToken) spotifytokenOption {
+	return func(m *SpotifyTokenMutation) {
+		m.oldValue = func(context.Context) (*SpotifyToken, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SpotifyTokenMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SpotifyTokenMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SpotifyToken entities.
+func (m *SpotifyTokenMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SpotifyTokenMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SpotifyTokenMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SpotifyToken.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *SpotifyTokenMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *SpotifyTokenMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the SpotifyToken entity.
+// If the SpotifyToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SpotifyTokenMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *SpotifyTokenMutation) ResetName() {
+	m.name = nil
+}
+
+// SetToken sets the "token" field.
+func (m *SpotifyTokenMutation) SetToken(b []byte) {
+	m.token = &b
+}
+
+// Token returns the value of the "token" field in the mutation.
+func (m *SpotifyTokenMutation) Token() (r []byte, exists bool) {
+	v := m.token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToken returns the old "token" field's value of the SpotifyToken entity.
+// If the SpotifyToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SpotifyTokenMutation) OldToken(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+	}
+	return oldValue.Token, nil
+}
+
+// ResetToken resets all changes to the "token" field.
+func (m *SpotifyTokenMutation) ResetToken() {
+	m.token = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SpotifyTokenMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SpotifyTokenMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the SpotifyToken entity.
+// If the SpotifyToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SpotifyTokenMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SpotifyTokenMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the SpotifyTokenMutation builder.
+func (m *SpotifyTokenMutation) Where(ps ...predicate.SpotifyToken) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SpotifyTokenMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SpotifyTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SpotifyToken, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SpotifyTokenMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SpotifyTokenMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SpotifyToken).
+func (m *SpotifyTokenMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SpotifyTokenMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.name != nil {
+		fields = append(fields, spotifytoken.FieldName)
+	}
+	if m.token != nil {
+		fields = append(fields, spotifytoken.FieldToken)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, spotifytoken.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SpotifyTokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case spotifytoken.FieldName:
+		return m.Name()
+	case spotifytoken.FieldToken:
+		return m.Token()
+	case spotifytoken.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SpotifyTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case spotifytoken.FieldName:
+		return m.OldName(ctx)
+	case spotifytoken.FieldToken:
+		return m.OldToken(ctx)
+	case spotifytoken.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown SpotifyToken field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SpotifyTokenMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case spotifytoken.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case spotifytoken.FieldToken:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
+	case spotifytoken.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SpotifyToken field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SpotifyTokenMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SpotifyTokenMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SpotifyTokenMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown SpotifyToken numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SpotifyTokenMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SpotifyTokenMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SpotifyTokenMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SpotifyToken nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SpotifyTokenMutation) ResetField(name string) error {
+	switch name {
+	case spotifytoken.FieldName:
+		m.ResetName()
+		return nil
+	case spotifytoken.FieldToken:
+		m.ResetToken()
+		return nil
+	case spotifytoken.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SpotifyToken field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SpotifyTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SpotifyTokenMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SpotifyTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SpotifyTokenMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SpotifyTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SpotifyTokenMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SpotifyTokenMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SpotifyToken unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SpotifyTokenMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SpotifyToken edge %s", name)
+}
+
+// TrackMutation represents an operation that mutates the Track nodes in the graph.
+type TrackMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *string
+	spotify_id            *string
+	uri                   *string
+	name                  *string
+	duration_ms           *int
+	addduration_ms        *int
+	explicit              *bool
+	disc_number           *int
+	adddisc_number        *int
+	track_number          *int
+	addtrack_number       *int
+	isrc                  *string
+	created_at            *time.Time
+	clearedFields         map[string]struct{}
+	album                 *string
+	clearedalbum          bool
+	artists               map[string]struct{}
+	removedartists        map[string]struct{}
+	clearedartists        bool
+	snapshot_items        map[string]struct{}
+	removedsnapshot_items map[string]struct{}
+	clearedsnapshot_items bool
+	saved_tracks          map[string]struct{}
+	removedsaved_tracks   map[string]struct{}
+	clearedsaved_tracks   bool
+	done                  bool
+	oldValue              func(context.Context) (*Track, error)
+	predicates            []predicate.Track
+}
+
+var _ ent.Mutation = (*TrackMutation)(nil)
+
+// trackOption allows management of the mutation configuration using functional options.
+type trackOption func(*TrackMutation)
+
+// newTrackMutation creates new mutation for the Track entity.
+func newTrackMutation(c config, op Op, opts ...trackOption) *TrackMutation {
+	m := &TrackMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTrack,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTrackID sets the ID field of the mutation.
+func withTrackID(id string) trackOption {
+	return func(m *TrackMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Track
+		)
+		m.oldValue = func(ctx context.Context) (*Track, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Track.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTrack sets the old Track of the mutation.
+func withTrack(node *Track) trackOption {
+	return func(m *TrackMutation) {
+		m.oldValue = func(context.Context) (*Track, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TrackMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TrackMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Track entities.
+func (m *TrackMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TrackMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TrackMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Track.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSpotifyID sets the "spotify_id" field.
+func (m *TrackMutation) SetSpotifyID(s string) {
+	m.spotify_id = &s
+}
+
+// SpotifyID returns the value of the "spotify_id" field in the mutation.
+func (m *TrackMutation) SpotifyID() (r string, exists bool) {
+	v := m.spotify_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSpotifyID returns the old "spotify_id" field's value of the Track entity.
+// If the Track object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TrackMutation) OldSpotifyID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSpotifyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSpotifyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSpotifyID: %w", err)
+	}
+	return oldValue.SpotifyID, nil
+}
+
+// ResetSpotifyID resets all changes to the "spotify_id" field.
+func (m *TrackMutation) ResetSpotifyID() {
+	m.spotify_id = nil
+}
+
+// SetURI sets the "uri" field.
+func (m *TrackMutation) SetURI(s string) {
+	m.uri = &s
+}
+
+// URI returns the value of the "uri" field in the mutation.
+func (m *TrackMutation) URI() (r string, exists bool) {
+	v := m.uri
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURI returns the old "uri" field's value of the Track entity.
+// If the Track object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TrackMutation) OldURI(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURI is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURI requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURI: %w", err)
+	}
+	return oldValue.URI, nil
+}
+
+// ResetURI resets all changes to the "uri" field.
+func (m *TrackMutation) ResetURI() {
+	m.uri = nil
+}
+
+// SetName sets the "name" field.
+func (m *TrackMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TrackMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Track entity.
+// If the Track object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TrackMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TrackMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (m *TrackMutation) SetDurationMs(i int) {
+	m.duration_ms = &i
+	m.addduration_ms = nil
+}
+
+// DurationMs returns the value of the "duration_ms" field in the mutation.
+func (m *TrackMutation) DurationMs() (r int, exists bool) {
+	v := m.duration_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDurationMs returns the old "duration_ms" field's value of the Track entity.
+// If the Track object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TrackMutation) OldDurationMs(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDurationMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDurationMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDurationMs: %w", err)
+	}
+	return oldValue.DurationMs, nil
+}
+
+// AddDurationMs adds i to the "duration_ms" field.
+func (m *TrackMutation) AddDurationMs(i int) {
+	if m.addduration_ms != nil {
+		*m.addduration_ms += i
+	} else {
+		m.addduration_ms = &i
+	}
+}
+
+// AddedDurationMs returns the value that was added to the "duration_ms" field in this mutation.
+func (m *TrackMutation) AddedDurationMs() (r int, exists bool) {
+	v := m.addduration_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDurationMs resets all changes to the "duration_ms" field.
+func (m *TrackMutation) ResetDurationMs() {
+	m.duration_ms = nil
+	m.addduration_ms = nil
+}
+
+// SetExplicit sets the "explicit" field.
+func (m *TrackMutation) SetExplicit(b bool) {
+	m.explicit = &b
+}
+
+// Explicit returns the value of the "explicit" field in the mutation.
+func (m *TrackMutation) Explicit() (r bool, exists bool) {
+	v := m.explicit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExplicit returns the old "explicit" field's value of the Track entity.
+// If the Track object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TrackMutation) OldExplicit(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExplicit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExplicit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExplicit: %w", err)
+	}
+	return oldValue.Explicit, nil
+}
+
+// ResetExplicit resets all changes to the "explicit" field.
+func (m *TrackMutation) ResetExplicit() {
+	m.explicit = nil
+}
+
+// SetDiscNumber sets the "disc_number" field.
+func (m *TrackMutation) SetDiscNumber(i int) {
+	m.disc_number = &i
+	m.adddisc_number = nil
+}
+
+// DiscNumber returns the value of the "disc_number" field in the mutation.
+func (m *TrackMutation) DiscNumber() (r int, exists bool) {
+	v := m.disc_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDiscNumber returns the old "disc_number" field's value of the Track entity.
+// If the Track object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TrackMutation) OldDiscNumber(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDiscNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDiscNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDiscNumber: %w", err)
+	}
+	return oldValue.DiscNumber, nil
+}
+
+// AddDiscNumber adds i to the "disc_number" field.
+func (m *TrackMutation) AddDiscNumber(i int) {
+	if m.adddisc_number != nil {
+		*m.adddisc_number += i
+	} else {
+		m.adddisc_number = &i
+	}
+}
+
+// AddedDiscNumber returns the value that was added to the "disc_number" field in this mutation.
+func (m *TrackMutation) AddedDiscNumber() (r int, exists bool) {
+	v := m.adddisc_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDiscNumber resets all changes to the "disc_number" field.
+func (m *TrackMutation) ResetDiscNumber() {
+	m.disc_number = nil
+	m.adddisc_number = nil
+}
+
+// SetTrackNumber sets the "track_number" field.
+func (m *TrackMutation) SetTrackNumber(i int) {
+	m.track_number = &i
+	m.addtrack_number = nil
+}
+
+// TrackNumber returns the value of the "track_number" field in the mutation.
+func (m *TrackMutation) TrackNumber() (r int, exists bool) {
+	v := m.track_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrackNumber returns the old "track_number" field's value of the Track entity.
+// If the Track object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TrackMutation) OldTrackNumber(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrackNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrackNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrackNumber: %w", err)
+	}
+	return oldValue.TrackNumber, nil
+}
+
+// AddTrackNumber adds i to the "track_number" field.
+func (m *TrackMutation) AddTrackNumber(i int) {
+	if m.addtrack_number != nil {
+		*m.addtrack_number += i
+	} else {
+		m.addtrack_number = &i
+	}
+}
+
+// AddedTrackNumber returns the value that was added to the "track_number" field in this mutation.
+func (m *TrackMutation) AddedTrackNumber() (r int, exists bool) {
+	v := m.addtrack_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTrackNumber resets all changes to the "track_number" field.
+func (m *TrackMutation) ResetTrackNumber() {
+	m.track_number = nil
+	m.addtrack_number = nil
+}
+
+// SetIsrc sets the "isrc" field.
+func (m *TrackMutation) SetIsrc(s string) {
+	m.isrc = &s
+}
+
+// Isrc returns the value of the "isrc" field in the mutation.
+func (m *TrackMutation) Isrc() (r string, exists bool) {
+	v := m.isrc
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsrc returns the old "isrc" field's value of the Track entity.
+// If the Track object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TrackMutation) OldIsrc(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsrc is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsrc requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsrc: %w", err)
+	}
+	return oldValue.Isrc, nil
+}
+
+// ResetIsrc resets all changes to the "isrc" field.
+func (m *TrackMutation) ResetIsrc() {
+	m.isrc = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TrackMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TrackMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Track entity.
+// If the Track object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TrackMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TrackMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetAlbumID sets the "album" edge to the Album entity by id.
+func (m *TrackMutation) SetAlbumID(id string) {
+	m.album = &id
+}
+
+// ClearAlbum clears the "album" edge to the Album entity.
+func (m *TrackMutation) ClearAlbum() {
+	m.clearedalbum = true
+}
+
+// AlbumCleared reports if the "album" edge to the Album entity was cleared.
+func (m *TrackMutation) AlbumCleared() bool {
+	return m.clearedalbum
+}
+
+// AlbumID returns the "album" edge ID in the mutation.
+func (m *TrackMutation) AlbumID() (id string, exists bool) {
+	if m.album != nil {
+		return *m.album, true
+	}
+	return
+}
+
+// AlbumIDs returns the "album" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AlbumID instead. It exists only for internal usage by the builders.
+func (m *TrackMutation) AlbumIDs() (ids []string) {
+	if id := m.album; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAlbum resets all changes to the "album" edge.
+func (m *TrackMutation) ResetAlbum() {
+	m.album = nil
+	m.clearedalbum = false
+}
+
+// AddArtistIDs adds the "artists" edge to the Artist entity by ids.
+func (m *TrackMutation) AddArtistIDs(ids ...string) {
+	if m.artists == nil {
+		m.artists = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.artists[ids[i]] = struct{}{}
+	}
+}
+
+// ClearArtists clears the "artists" edge to the Artist entity.
+func (m *TrackMutation) ClearArtists() {
+	m.clearedartists = true
+}
+
+// ArtistsCleared reports if the "artists" edge to the Artist entity was cleared.
+func (m *TrackMutation) ArtistsCleared() bool {
+	return m.clearedartists
+}
+
+// RemoveArtistIDs removes the "artists" edge to the Artist entity by IDs.
+func (m *TrackMutation) RemoveArtistIDs(ids ...string) {
+	if m.removedartists == nil {
+		m.removedartists = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.artists, ids[i])
+		m.removedartists[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedArtists returns the removed IDs of the "artists" edge to the Artist entity.
+func (m *TrackMutation) RemovedArtistsIDs() (ids []string) {
+	for id := range m.removedartists {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ArtistsIDs returns the "artists" edge IDs in the mutation.
+func (m *TrackMutation) ArtistsIDs() (ids []string) {
+	for id := range m.artists {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetArtists resets all changes to the "artists" edge.
+func (m *TrackMutation) ResetArtists() {
+	m.artists = nil
+	m.clearedartists = false
+	m.removedartists = nil
+}
+
+// AddSnapshotItemIDs adds the "snapshot_items" edge to the SnapshotItem entity by ids.
+func (m *TrackMutation) AddSnapshotItemIDs(ids ...string) {
+	if m.snapshot_items == nil {
+		m.snapshot_items = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.snapshot_items[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSnapshotItems clears the "snapshot_items" edge to the SnapshotItem entity.
+func (m *TrackMutation) ClearSnapshotItems() {
+	m.clearedsnapshot_items = true
+}
+
+// SnapshotItemsCleared reports if the "snapshot_items" edge to the SnapshotItem entity was cleared.
+func (m *TrackMutation) SnapshotItemsCleared() bool {
+	return m.clearedsnapshot_items
+}
+
+// RemoveSnapshotItemIDs removes the "snapshot_items" edge to the SnapshotItem entity by IDs.
+func (m *TrackMutation) RemoveSnapshotItemIDs(ids ...string) {
+	if m.removedsnapshot_items == nil {
+		m.removedsnapshot_items = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.snapshot_items, ids[i])
+		m.removedsnapshot_items[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSnapshotItems returns the removed IDs of the "snapshot_items" edge to the SnapshotItem entity.
+func (m *TrackMutation) RemovedSnapshotItemsIDs() (ids []string) {
+	for id := range m.removedsnapshot_items {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SnapshotItemsIDs returns the "snapshot_items" edge IDs in the mutation.
+func (m *TrackMutation) SnapshotItemsIDs() (ids []string) {
+	for id := range m.snapshot_items {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSnapshotItems resets all changes to the "snapshot_items" edge.
+func (m *TrackMutation) ResetSnapshotItems() {
+	m.snapshot_items = nil
+	m.clearedsnapshot_items = false
+	m.removedsnapshot_items = nil
+}
+
+// AddSavedTrackIDs adds the "saved_tracks" edge to the SavedTrack entity by ids.
+func (m *TrackMutation) AddSavedTrackIDs(ids ...string) {
+	if m.saved_tracks == nil {
+		m.saved_tracks = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.saved_tracks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSavedTracks clears the "saved_tracks" edge to the SavedTrack entity.
+func (m *TrackMutation) ClearSavedTracks() {
+	m.clearedsaved_tracks = true
+}
+
+// SavedTracksCleared reports if the "saved_tracks" edge to the SavedTrack entity was cleared.
+func (m *TrackMutation) SavedTracksCleared() bool {
+	return m.clearedsaved_tracks
+}
+
+// RemoveSavedTrackIDs removes the "saved_tracks" edge to the SavedTrack entity by IDs.
+func (m *TrackMutation) RemoveSavedTrackIDs(ids ...string) {
+	if m.removedsaved_tracks == nil {
+		m.removedsaved_tracks = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.saved_tracks, ids[i])
+		m.removedsaved_tracks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSavedTracks returns the removed IDs of the "saved_tracks" edge to the SavedTrack entity.
+func (m *TrackMutation) RemovedSavedTracksIDs() (ids []string) {
+	for id := range m.removedsaved_tracks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SavedTracksIDs returns the "saved_tracks" edge IDs in the mutation.
+func (m *TrackMutation) SavedTracksIDs() (ids []string) {
+	for id := range m.saved_tracks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSavedTracks resets all changes to the "saved_tracks" edge.
+func (m *TrackMutation) ResetSavedTracks() {
+	m.saved_tracks = nil
+	m.clearedsaved_tracks = false
+	m.removedsaved_tracks = nil
+}
+
+// Where appends a list predicates to the TrackMutation builder.
+func (m *TrackMutation) Where(ps ...predicate.Track) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TrackMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TrackMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Track, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TrackMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TrackMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Track).
+func (m *TrackMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TrackMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.spotify_id != nil {
+		fields = append(fields, track.FieldSpotifyID)
+	}
+	if m.uri != nil {
+		fields = append(fields, track.FieldURI)
+	}
+	if m.name != nil {
+		fields = append(fields, track.FieldName)
+	}
+	if m.duration_ms != nil {
+		fields = append(fields, track.FieldDurationMs)
+	}
+	if m.explicit != nil {
+		fields = append(fields, track.FieldExplicit)
+	}
+	if m.disc_number != nil {
+		fields = append(fields, track.FieldDiscNumber)
+	}
+	if m.track_number != nil {
+		fields = append(fields, track.FieldTrackNumber)
+	}
+	if m.isrc != nil {
+		fields = append(fields, track.FieldIsrc)
+	}
+	if m.created_at != nil {
+		fields = append(fields, track.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TrackMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case track.FieldSpotifyID:
+		return m.SpotifyID()
+	case track.FieldURI:
+		return m.URI()
+	case track.FieldName:
+		return m.Name()
+	case track.FieldDurationMs:
+		return m.DurationMs()
+	case track.FieldExplicit:
+		return m.Explicit()
+	case track.FieldDiscNumber:
+		return m.DiscNumber()
+	case track.FieldTrackNumber:
+		return m.TrackNumber()
+	case track.FieldIsrc:
+		return m.Isrc()
+	case track.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TrackMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case track.FieldSpotifyID:
+		return m.OldSpotifyID(ctx)
+	case track.FieldURI:
+		return m.OldURI(ctx)
+	case track.FieldName:
+		return m.OldName(ctx)
+	case track.FieldDurationMs:
+		return m.OldDurationMs(ctx)
+	case track.FieldExplicit:
+		return m.OldExplicit(ctx)
+	case track.FieldDiscNumber:
+		return m.OldDiscNumber(ctx)
+	case track.FieldTrackNumber:
+		return m.OldTrackNumber(ctx)
+	case track.FieldIsrc:
+		return m.OldIsrc(ctx)
+	case track.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Track field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TrackMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case track.FieldSpotifyID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpotifyID(v)
+		return nil
+	case track.FieldURI:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURI(v)
+		return nil
+	case track.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case track.FieldDurationMs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDurationMs(v)
+		return nil
+	case track.FieldExplicit:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExplicit(v)
+		return nil
+	case track.FieldDiscNumber:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDiscNumber(v)
+		return nil
+	case track.FieldTrackNumber:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrackNumber(v)
+		return nil
+	case track.FieldIsrc:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsrc(v)
+		return nil
+	case track.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Track field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TrackMutation) AddedFields() []string {
+	var fields []string
+	if m.addduration_ms != nil {
+		fields = append(fields, track.FieldDurationMs)
+	}
+	if m.adddisc_number != nil {
+		fields = append(fields, track.FieldDiscNumber)
+	}
+	if m.addtrack_number != nil {
+		fields = append(fields, track.FieldTrackNumber)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TrackMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case track.FieldDurationMs:
+		return m.AddedDurationMs()
+	case track.FieldDiscNumber:
+		return m.AddedDiscNumber()
+	case track.FieldTrackNumber:
+		return m.AddedTrackNumber()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TrackMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case track.FieldDurationMs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDurationMs(v)
+		return nil
+	case track.FieldDiscNumber:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDiscNumber(v)
+		return nil
+	case track.FieldTrackNumber:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTrackNumber(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Track numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TrackMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TrackMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TrackMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Track nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TrackMutation) ResetField(name string) error {
+	switch name {
+	case track.FieldSpotifyID:
+		m.ResetSpotifyID()
+		return nil
+	case track.FieldURI:
+		m.ResetURI()
+		return nil
+	case track.FieldName:
+		m.ResetName()
+		return nil
+	case track.FieldDurationMs:
+		m.ResetDurationMs()
+		return nil
+	case track.FieldExplicit:
+		m.ResetExplicit()
+		return nil
+	case track.FieldDiscNumber:
+		m.ResetDiscNumber()
+		return nil
+	case track.FieldTrackNumber:
+		m.ResetTrackNumber()
+		return nil
+	case track.FieldIsrc:
+		m.ResetIsrc()
+		return nil
+	case track.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Track field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TrackMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.album != nil {
+		edges = append(edges, track.EdgeAlbum)
+	}
+	if m.artists != nil {
+		edges = append(edges, track.EdgeArtists)
+	}
+	if m.snapshot_items != nil {
+		edges = append(edges, track.EdgeSnapshotItems)
+	}
+	if m.saved_tracks != nil {
+		edges = append(edges, track.EdgeSavedTracks)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TrackMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case track.EdgeAlbum:
+		if id := m.album; id != nil {
+			return []ent.Value{*id}
+		}
+	case track.EdgeArtists:
+		ids := make([]ent.Value, 0, len(m.artists))
+		for id := range m.artists {
+			ids = append(ids, id)
+		}
+		return ids
+	case track.EdgeSnapshotItems:
+		ids := make([]ent.Value, 0, len(m.snapshot_items))
+		for id := range m.snapshot_items {
+			ids = append(ids, id)
+		}
+		return ids
+	case track.EdgeSavedTracks:
+		ids := make([]ent.Value, 0, len(m.saved_tracks))
+		for id := range m.saved_tracks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TrackMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedartists != nil {
+		edges = append(edges, track.EdgeArtists)
+	}
+	if m.removedsnapshot_items != nil {
+		edges = append(edges, track.EdgeSnapshotItems)
+	}
+	if m.removedsaved_tracks != nil {
+		edges = append(edges, track.EdgeSavedTracks)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TrackMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case track.EdgeArtists:
+		ids := make([]ent.Value, 0, len(m.removedartists))
+		for id := range m.removedartists {
+			ids = append(ids, id)
+		}
+		return ids
+	case track.EdgeSnapshotItems:
+		ids := make([]ent.Value, 0, len(m.removedsnapshot_items))
+		for id := range m.removedsnapshot_items {
+			ids = append(ids, id)
+		}
+		return ids
+	case track.EdgeSavedTracks:
+		ids := make([]ent.Value, 0, len(m.removedsaved_tracks))
+		for id := range m.removedsaved_tracks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TrackMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedalbum {
+		edges = append(edges, track.EdgeAlbum)
+	}
+	if m.clearedartists {
+		edges = append(edges, track.EdgeArtists)
+	}
+	if m.clearedsnapshot_items {
+		edges = append(edges, track.EdgeSnapshotItems)
+	}
+	if m.clearedsaved_tracks {
+		edges = append(edges, track.EdgeSavedTracks)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TrackMutation) EdgeCleared(name string) bool {
+	switch name {
+	case track.EdgeAlbum:
+		return m.clearedalbum
+	case track.EdgeArtists:
+		return m.clearedartists
+	case track.EdgeSnapshotItems:
+		return m.clearedsnapshot_items
+	case track.EdgeSavedTracks:
+		return m.clearedsaved_tracks
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TrackMutation) ClearEdge(name string) error {
+	switch name {
+	case track.EdgeAlbum:
+		m.ClearAlbum()
+		return nil
+	}
+	return fmt.Errorf("unknown Track unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TrackMutation) ResetEdge(name string) error {
+	switch name {
+	case track.EdgeAlbum:
+		m.ResetAlbum()
+		return nil
+	case track.EdgeArtists:
+		m.ResetArtists()
+		return nil
+	case track.EdgeSnapshotItems:
+		m.ResetSnapshotItems()
+		return nil
+	case track.EdgeSavedTracks:
+		m.ResetSavedTracks()
+		return nil
+	}
+	return fmt.Errorf("unknown Track edge %s", name)
+}
+
 // UserMutation represents an operation that mutates the User nodes in the graph.
 type UserMutation struct {
 	config
@@ -0,0 +1,141 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/playlist"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Playlist is the model entity for the Playlist schema.
+type Playlist struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// SpotifyID holds the value of the "spotify_id" field.
+	SpotifyID string `json:"spotify_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PlaylistQuery when eager-loading is set.
+	Edges        PlaylistEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PlaylistEdges holds the relations/edges for other nodes in the graph.
+type PlaylistEdges struct {
+	// Snapshots holds the value of the snapshots edge.
+	Snapshots []*PlaylistSnapshot `json:"snapshots,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// SnapshotsOrErr returns the Snapshots value or an error if the edge
+// was not loaded in eager-loading.
+func (e PlaylistEdges) SnapshotsOrErr() ([]*PlaylistSnapshot, error) {
+	if e.loadedTypes[0] {
+		return e.Snapshots, nil
+	}
+	return nil, &NotLoadedError{edge: "snapshots"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Playlist) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case playlist.FieldID, playlist.FieldSpotifyID:
+			values[i] = new(sql.NullString)
+		case playlist.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Playlist fields.
+func (pl *Playlist) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case playlist.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				pl.ID = value.String
+			}
+		case playlist.FieldSpotifyID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field spotify_id", values[i])
+			} else if value.Valid {
+				pl.SpotifyID = value.String
+			}
+		case playlist.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pl.CreatedAt = value.Time
+			}
+		default:
+			pl.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Playlist.
+// This includes values selected through modifiers, order, etc.
+func (pl *Playlist) Value(name string) (ent.Value, error) {
+	return pl.selectValues.Get(name)
+}
+
+// QuerySnapshots queries the "snapshots" edge of the Playlist entity.
+func (pl *Playlist) QuerySnapshots() *PlaylistSnapshotQuery {
+	return NewPlaylistClient(pl.config).QuerySnapshots(pl)
+}
+
+// Update returns a builder for updating this Playlist.
+// Note that you need to call Playlist.Unwrap() before calling this method if this Playlist
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pl *Playlist) Update() *PlaylistUpdateOne {
+	return NewPlaylistClient(pl.config).UpdateOne(pl)
+}
+
+// Unwrap unwraps the Playlist entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pl *Playlist) Unwrap() *Playlist {
+	_tx, ok := pl.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Playlist is not a transactional entity")
+	}
+	pl.config.driver = _tx.drv
+	return pl
+}
+
+// String implements the fmt.Stringer.
+func (pl *Playlist) String() string {
+	var builder strings.Builder
+	builder.WriteString("Playlist(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pl.ID))
+	builder.WriteString("spotify_id=")
+	builder.WriteString(pl.SpotifyID)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pl.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Playlists is a parsable slice of Playlist.
+type Playlists []*Playlist
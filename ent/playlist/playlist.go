@@ -0,0 +1,97 @@
+// Code generated by ent, DO NOT EDIT.
+
+package playlist
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the playlist type in the database.
+	Label = "playlist"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSpotifyID holds the string denoting the spotify_id field in the database.
+	FieldSpotifyID = "spotify_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeSnapshots holds the string denoting the snapshots edge name in mutations.
+	EdgeSnapshots = "snapshots"
+	// Table holds the table name of the playlist in the database.
+	Table = "playlists"
+	// SnapshotsTable is the table that holds the snapshots relation/edge.
+	SnapshotsTable = "playlist_snapshots"
+	// SnapshotsInverseTable is the table name for the PlaylistSnapshot entity.
+	// It exists in this package in order to avoid circular dependency with the "playlistsnapshot" package.
+	SnapshotsInverseTable = "playlist_snapshots"
+	// SnapshotsColumn is the table column denoting the snapshots relation/edge.
+	SnapshotsColumn = "playlist_snapshots"
+)
+
+// Columns holds all SQL columns for playlist fields.
+var Columns = []string{
+	FieldID,
+	FieldSpotifyID,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// SpotifyIDValidator is a validator for the "spotify_id" field. It is called by the builders before save.
+	SpotifyIDValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() string
+)
+
+// OrderOption defines the ordering options for the Playlist queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// BySpotifyID orders the results by the spotify_id field.
+func BySpotifyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpotifyID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// BySnapshotsCount orders the results by snapshots count.
+func BySnapshotsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSnapshotsStep(), opts...)
+	}
+}
+
+// BySnapshots orders the results by snapshots terms.
+func BySnapshots(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSnapshotsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newSnapshotsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SnapshotsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SnapshotsTable, SnapshotsColumn),
+	)
+}
@@ -0,0 +1,219 @@
+// Code generated by ent, DO NOT EDIT.
+
+package playlist
+
+import (
+	"beyerleinf/spotify-backup/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Playlist {
+	return predicate.Playlist(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Playlist {
+	return predicate.Playlist(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Playlist {
+	return predicate.Playlist(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Playlist {
+	return predicate.Playlist(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Playlist {
+	return predicate.Playlist(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Playlist {
+	return predicate.Playlist(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Playlist {
+	return predicate.Playlist(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Playlist {
+	return predicate.Playlist(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Playlist {
+	return predicate.Playlist(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Playlist {
+	return predicate.Playlist(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Playlist {
+	return predicate.Playlist(sql.FieldContainsFold(FieldID, id))
+}
+
+// SpotifyID applies equality check predicate on the "spotify_id" field. It's identical to SpotifyIDEQ.
+func SpotifyID(v string) predicate.Playlist {
+	return predicate.Playlist(sql.FieldEQ(FieldSpotifyID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Playlist {
+	return predicate.Playlist(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// SpotifyIDEQ applies the EQ predicate on the "spotify_id" field.
+func SpotifyIDEQ(v string) predicate.Playlist {
+	return predicate.Playlist(sql.FieldEQ(FieldSpotifyID, v))
+}
+
+// SpotifyIDNEQ applies the NEQ predicate on the "spotify_id" field.
+func SpotifyIDNEQ(v string) predicate.Playlist {
+	return predicate.Playlist(sql.FieldNEQ(FieldSpotifyID, v))
+}
+
+// SpotifyIDIn applies the In predicate on the "spotify_id" field.
+func SpotifyIDIn(vs ...string) predicate.Playlist {
+	return predicate.Playlist(sql.FieldIn(FieldSpotifyID, vs...))
+}
+
+// SpotifyIDNotIn applies the NotIn predicate on the "spotify_id" field.
+func SpotifyIDNotIn(vs ...string) predicate.Playlist {
+	return predicate.Playlist(sql.FieldNotIn(FieldSpotifyID, vs...))
+}
+
+// SpotifyIDGT applies the GT predicate on the "spotify_id" field.
+func SpotifyIDGT(v string) predicate.Playlist {
+	return predicate.Playlist(sql.FieldGT(FieldSpotifyID, v))
+}
+
+// SpotifyIDGTE applies the GTE predicate on the "spotify_id" field.
+func SpotifyIDGTE(v string) predicate.Playlist {
+	return predicate.Playlist(sql.FieldGTE(FieldSpotifyID, v))
+}
+
+// SpotifyIDLT applies the LT predicate on the "spotify_id" field.
+func SpotifyIDLT(v string) predicate.Playlist {
+	return predicate.Playlist(sql.FieldLT(FieldSpotifyID, v))
+}
+
+// SpotifyIDLTE applies the LTE predicate on the "spotify_id" field.
+func SpotifyIDLTE(v string) predicate.Playlist {
+	return predicate.Playlist(sql.FieldLTE(FieldSpotifyID, v))
+}
+
+// SpotifyIDContains applies the Contains predicate on the "spotify_id" field.
+func SpotifyIDContains(v string) predicate.Playlist {
+	return predicate.Playlist(sql.FieldContains(FieldSpotifyID, v))
+}
+
+// SpotifyIDHasPrefix applies the HasPrefix predicate on the "spotify_id" field.
+func SpotifyIDHasPrefix(v string) predicate.Playlist {
+	return predicate.Playlist(sql.FieldHasPrefix(FieldSpotifyID, v))
+}
+
+// SpotifyIDHasSuffix applies the HasSuffix predicate on the "spotify_id" field.
+func SpotifyIDHasSuffix(v string) predicate.Playlist {
+	return predicate.Playlist(sql.FieldHasSuffix(FieldSpotifyID, v))
+}
+
+// SpotifyIDEqualFold applies the EqualFold predicate on the "spotify_id" field.
+func SpotifyIDEqualFold(v string) predicate.Playlist {
+	return predicate.Playlist(sql.FieldEqualFold(FieldSpotifyID, v))
+}
+
+// SpotifyIDContainsFold applies the ContainsFold predicate on the "spotify_id" field.
+func SpotifyIDContainsFold(v string) predicate.Playlist {
+	return predicate.Playlist(sql.FieldContainsFold(FieldSpotifyID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Playlist {
+	return predicate.Playlist(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Playlist {
+	return predicate.Playlist(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Playlist {
+	return predicate.Playlist(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Playlist {
+	return predicate.Playlist(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Playlist {
+	return predicate.Playlist(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Playlist {
+	return predicate.Playlist(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Playlist {
+	return predicate.Playlist(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Playlist {
+	return predicate.Playlist(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasSnapshots applies the HasEdge predicate on the "snapshots" edge.
+func HasSnapshots() predicate.Playlist {
+	return predicate.Playlist(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SnapshotsTable, SnapshotsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSnapshotsWith applies the HasEdge predicate on the "snapshots" edge with a given conditions (other predicates).
+func HasSnapshotsWith(preds ...predicate.PlaylistSnapshot) predicate.Playlist {
+	return predicate.Playlist(func(s *sql.Selector) {
+		step := newSnapshotsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Playlist) predicate.Playlist {
+	return predicate.Playlist(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Playlist) predicate.Playlist {
+	return predicate.Playlist(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Playlist) predicate.Playlist {
+	return predicate.Playlist(sql.NotPredicates(p))
+}
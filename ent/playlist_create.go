@@ -0,0 +1,275 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/playlist"
+	"beyerleinf/spotify-backup/ent/playlistsnapshot"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PlaylistCreate is the builder for creating a Playlist entity.
+type PlaylistCreate struct {
+	config
+	mutation *PlaylistMutation
+	hooks    []Hook
+}
+
+// SetSpotifyID sets the "spotify_id" field.
+func (pc *PlaylistCreate) SetSpotifyID(s string) *PlaylistCreate {
+	pc.mutation.SetSpotifyID(s)
+	return pc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pc *PlaylistCreate) SetCreatedAt(t time.Time) *PlaylistCreate {
+	pc.mutation.SetCreatedAt(t)
+	return pc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pc *PlaylistCreate) SetNillableCreatedAt(t *time.Time) *PlaylistCreate {
+	if t != nil {
+		pc.SetCreatedAt(*t)
+	}
+	return pc
+}
+
+// SetID sets the "id" field.
+func (pc *PlaylistCreate) SetID(s string) *PlaylistCreate {
+	pc.mutation.SetID(s)
+	return pc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (pc *PlaylistCreate) SetNillableID(s *string) *PlaylistCreate {
+	if s != nil {
+		pc.SetID(*s)
+	}
+	return pc
+}
+
+// AddSnapshotIDs adds the "snapshots" edge to the PlaylistSnapshot entity by IDs.
+func (pc *PlaylistCreate) AddSnapshotIDs(ids ...string) *PlaylistCreate {
+	pc.mutation.AddSnapshotIDs(ids...)
+	return pc
+}
+
+// AddSnapshots adds the "snapshots" edges to the PlaylistSnapshot entity.
+func (pc *PlaylistCreate) AddSnapshots(p ...*PlaylistSnapshot) *PlaylistCreate {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pc.AddSnapshotIDs(ids...)
+}
+
+// Mutation returns the PlaylistMutation object of the builder.
+func (pc *PlaylistCreate) Mutation() *PlaylistMutation {
+	return pc.mutation
+}
+
+// Save creates the Playlist in the database.
+func (pc *PlaylistCreate) Save(ctx context.Context) (*Playlist, error) {
+	pc.defaults()
+	return withHooks(ctx, pc.sqlSave, pc.mutation, pc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pc *PlaylistCreate) SaveX(ctx context.Context) *Playlist {
+	v, err := pc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pc *PlaylistCreate) Exec(ctx context.Context) error {
+	_, err := pc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pc *PlaylistCreate) ExecX(ctx context.Context) {
+	if err := pc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pc *PlaylistCreate) defaults() {
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		v := playlist.DefaultCreatedAt()
+		pc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pc.mutation.ID(); !ok {
+		v := playlist.DefaultID()
+		pc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pc *PlaylistCreate) check() error {
+	if _, ok := pc.mutation.SpotifyID(); !ok {
+		return &ValidationError{Name: "spotify_id", err: errors.New(`ent: missing required field "Playlist.spotify_id"`)}
+	}
+	if v, ok := pc.mutation.SpotifyID(); ok {
+		if err := playlist.SpotifyIDValidator(v); err != nil {
+			return &ValidationError{Name: "spotify_id", err: fmt.Errorf(`ent: validator failed for field "Playlist.spotify_id": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Playlist.created_at"`)}
+	}
+	return nil
+}
+
+func (pc *PlaylistCreate) sqlSave(ctx context.Context) (*Playlist, error) {
+	if err := pc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Playlist.ID type: %T", _spec.ID.Value)
+		}
+	}
+	pc.mutation.id = &_node.ID
+	pc.mutation.done = true
+	return _node, nil
+}
+
+func (pc *PlaylistCreate) createSpec() (*Playlist, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Playlist{config: pc.config}
+		_spec = sqlgraph.NewCreateSpec(playlist.Table, sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeString))
+	)
+	if id, ok := pc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := pc.mutation.SpotifyID(); ok {
+		_spec.SetField(playlist.FieldSpotifyID, field.TypeString, value)
+		_node.SpotifyID = value
+	}
+	if value, ok := pc.mutation.CreatedAt(); ok {
+		_spec.SetField(playlist.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := pc.mutation.SnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   playlist.SnapshotsTable,
+			Columns: []string{playlist.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlistsnapshot.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PlaylistCreateBulk is the builder for creating many Playlist entities in bulk.
+type PlaylistCreateBulk struct {
+	config
+	err      error
+	builders []*PlaylistCreate
+}
+
+// Save creates the Playlist entities in the database.
+func (pcb *PlaylistCreateBulk) Save(ctx context.Context) ([]*Playlist, error) {
+	if pcb.err != nil {
+		return nil, pcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pcb.builders))
+	nodes := make([]*Playlist, len(pcb.builders))
+	mutators := make([]Mutator, len(pcb.builders))
+	for i := range pcb.builders {
+		func(i int, root context.Context) {
+			builder := pcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PlaylistMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcb *PlaylistCreateBulk) SaveX(ctx context.Context) []*Playlist {
+	v, err := pcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pcb *PlaylistCreateBulk) Exec(ctx context.Context) error {
+	_, err := pcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcb *PlaylistCreateBulk) ExecX(ctx context.Context) {
+	if err := pcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
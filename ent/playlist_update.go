@@ -0,0 +1,338 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/playlist"
+	"beyerleinf/spotify-backup/ent/playlistsnapshot"
+	"beyerleinf/spotify-backup/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PlaylistUpdate is the builder for updating Playlist entities.
+type PlaylistUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PlaylistMutation
+}
+
+// Where appends a list predicates to the PlaylistUpdate builder.
+func (pu *PlaylistUpdate) Where(ps ...predicate.Playlist) *PlaylistUpdate {
+	pu.mutation.Where(ps...)
+	return pu
+}
+
+// AddSnapshotIDs adds the "snapshots" edge to the PlaylistSnapshot entity by IDs.
+func (pu *PlaylistUpdate) AddSnapshotIDs(ids ...string) *PlaylistUpdate {
+	pu.mutation.AddSnapshotIDs(ids...)
+	return pu
+}
+
+// AddSnapshots adds the "snapshots" edges to the PlaylistSnapshot entity.
+func (pu *PlaylistUpdate) AddSnapshots(p ...*PlaylistSnapshot) *PlaylistUpdate {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.AddSnapshotIDs(ids...)
+}
+
+// Mutation returns the PlaylistMutation object of the builder.
+func (pu *PlaylistUpdate) Mutation() *PlaylistMutation {
+	return pu.mutation
+}
+
+// ClearSnapshots clears all "snapshots" edges to the PlaylistSnapshot entity.
+func (pu *PlaylistUpdate) ClearSnapshots() *PlaylistUpdate {
+	pu.mutation.ClearSnapshots()
+	return pu
+}
+
+// RemoveSnapshotIDs removes the "snapshots" edge to PlaylistSnapshot entities by IDs.
+func (pu *PlaylistUpdate) RemoveSnapshotIDs(ids ...string) *PlaylistUpdate {
+	pu.mutation.RemoveSnapshotIDs(ids...)
+	return pu
+}
+
+// RemoveSnapshots removes "snapshots" edges to PlaylistSnapshot entities.
+func (pu *PlaylistUpdate) RemoveSnapshots(p ...*PlaylistSnapshot) *PlaylistUpdate {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.RemoveSnapshotIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pu *PlaylistUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, pu.sqlSave, pu.mutation, pu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pu *PlaylistUpdate) SaveX(ctx context.Context) int {
+	affected, err := pu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pu *PlaylistUpdate) Exec(ctx context.Context) error {
+	_, err := pu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pu *PlaylistUpdate) ExecX(ctx context.Context) {
+	if err := pu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (pu *PlaylistUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(playlist.Table, playlist.Columns, sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeString))
+	if ps := pu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if pu.mutation.SnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   playlist.SnapshotsTable,
+			Columns: []string{playlist.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlistsnapshot.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedSnapshotsIDs(); len(nodes) > 0 && !pu.mutation.SnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   playlist.SnapshotsTable,
+			Columns: []string{playlist.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlistsnapshot.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.SnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   playlist.SnapshotsTable,
+			Columns: []string{playlist.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlistsnapshot.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{playlist.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pu.mutation.done = true
+	return n, nil
+}
+
+// PlaylistUpdateOne is the builder for updating a single Playlist entity.
+type PlaylistUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PlaylistMutation
+}
+
+// AddSnapshotIDs adds the "snapshots" edge to the PlaylistSnapshot entity by IDs.
+func (puo *PlaylistUpdateOne) AddSnapshotIDs(ids ...string) *PlaylistUpdateOne {
+	puo.mutation.AddSnapshotIDs(ids...)
+	return puo
+}
+
+// AddSnapshots adds the "snapshots" edges to the PlaylistSnapshot entity.
+func (puo *PlaylistUpdateOne) AddSnapshots(p ...*PlaylistSnapshot) *PlaylistUpdateOne {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.AddSnapshotIDs(ids...)
+}
+
+// Mutation returns the PlaylistMutation object of the builder.
+func (puo *PlaylistUpdateOne) Mutation() *PlaylistMutation {
+	return puo.mutation
+}
+
+// ClearSnapshots clears all "snapshots" edges to the PlaylistSnapshot entity.
+func (puo *PlaylistUpdateOne) ClearSnapshots() *PlaylistUpdateOne {
+	puo.mutation.ClearSnapshots()
+	return puo
+}
+
+// RemoveSnapshotIDs removes the "snapshots" edge to PlaylistSnapshot entities by IDs.
+func (puo *PlaylistUpdateOne) RemoveSnapshotIDs(ids ...string) *PlaylistUpdateOne {
+	puo.mutation.RemoveSnapshotIDs(ids...)
+	return puo
+}
+
+// RemoveSnapshots removes "snapshots" edges to PlaylistSnapshot entities.
+func (puo *PlaylistUpdateOne) RemoveSnapshots(p ...*PlaylistSnapshot) *PlaylistUpdateOne {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.RemoveSnapshotIDs(ids...)
+}
+
+// Where appends a list predicates to the PlaylistUpdate builder.
+func (puo *PlaylistUpdateOne) Where(ps ...predicate.Playlist) *PlaylistUpdateOne {
+	puo.mutation.Where(ps...)
+	return puo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (puo *PlaylistUpdateOne) Select(field string, fields ...string) *PlaylistUpdateOne {
+	puo.fields = append([]string{field}, fields...)
+	return puo
+}
+
+// Save executes the query and returns the updated Playlist entity.
+func (puo *PlaylistUpdateOne) Save(ctx context.Context) (*Playlist, error) {
+	return withHooks(ctx, puo.sqlSave, puo.mutation, puo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (puo *PlaylistUpdateOne) SaveX(ctx context.Context) *Playlist {
+	node, err := puo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (puo *PlaylistUpdateOne) Exec(ctx context.Context) error {
+	_, err := puo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (puo *PlaylistUpdateOne) ExecX(ctx context.Context) {
+	if err := puo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (puo *PlaylistUpdateOne) sqlSave(ctx context.Context) (_node *Playlist, err error) {
+	_spec := sqlgraph.NewUpdateSpec(playlist.Table, playlist.Columns, sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeString))
+	id, ok := puo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Playlist.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := puo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, playlist.FieldID)
+		for _, f := range fields {
+			if !playlist.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != playlist.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := puo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if puo.mutation.SnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   playlist.SnapshotsTable,
+			Columns: []string{playlist.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlistsnapshot.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedSnapshotsIDs(); len(nodes) > 0 && !puo.mutation.SnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   playlist.SnapshotsTable,
+			Columns: []string{playlist.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlistsnapshot.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.SnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   playlist.SnapshotsTable,
+			Columns: []string{playlist.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlistsnapshot.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Playlist{config: puo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, puo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{playlist.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	puo.mutation.done = true
+	return _node, nil
+}
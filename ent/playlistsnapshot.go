@@ -0,0 +1,319 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/playlist"
+	"beyerleinf/spotify-backup/ent/playlistsnapshot"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// PlaylistSnapshot is the model entity for the PlaylistSnapshot schema.
+type PlaylistSnapshot struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// OwnerID holds the value of the "owner_id" field.
+	OwnerID string `json:"owner_id,omitempty"`
+	// OwnerName holds the value of the "owner_name" field.
+	OwnerName string `json:"owner_name,omitempty"`
+	// Collaborative holds the value of the "collaborative" field.
+	Collaborative bool `json:"collaborative,omitempty"`
+	// Public holds the value of the "public" field.
+	Public *bool `json:"public,omitempty"`
+	// SnapshotID holds the value of the "snapshot_id" field.
+	SnapshotID string `json:"snapshot_id,omitempty"`
+	// Total holds the value of the "total" field.
+	Total int `json:"total,omitempty"`
+	// Complete holds the value of the "complete" field.
+	Complete bool `json:"complete,omitempty"`
+	// Pinned holds the value of the "pinned" field.
+	Pinned bool `json:"pinned,omitempty"`
+	// Note holds the value of the "note" field.
+	Note string `json:"note,omitempty"`
+	// Labels holds the value of the "labels" field.
+	Labels []string `json:"labels,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PlaylistSnapshotQuery when eager-loading is set.
+	Edges              PlaylistSnapshotEdges `json:"edges"`
+	playlist_snapshots *string
+	selectValues       sql.SelectValues
+}
+
+// PlaylistSnapshotEdges holds the relations/edges for other nodes in the graph.
+type PlaylistSnapshotEdges struct {
+	// Playlist holds the value of the playlist edge.
+	Playlist *Playlist `json:"playlist,omitempty"`
+	// Runs holds the value of the runs edge.
+	Runs []*BackupRun `json:"runs,omitempty"`
+	// Items holds the value of the items edge.
+	Items []*SnapshotItem `json:"items,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// PlaylistOrErr returns the Playlist value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PlaylistSnapshotEdges) PlaylistOrErr() (*Playlist, error) {
+	if e.Playlist != nil {
+		return e.Playlist, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: playlist.Label}
+	}
+	return nil, &NotLoadedError{edge: "playlist"}
+}
+
+// RunsOrErr returns the Runs value or an error if the edge
+// was not loaded in eager-loading.
+func (e PlaylistSnapshotEdges) RunsOrErr() ([]*BackupRun, error) {
+	if e.loadedTypes[1] {
+		return e.Runs, nil
+	}
+	return nil, &NotLoadedError{edge: "runs"}
+}
+
+// ItemsOrErr returns the Items value or an error if the edge
+// was not loaded in eager-loading.
+func (e PlaylistSnapshotEdges) ItemsOrErr() ([]*SnapshotItem, error) {
+	if e.loadedTypes[2] {
+		return e.Items, nil
+	}
+	return nil, &NotLoadedError{edge: "items"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PlaylistSnapshot) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case playlistsnapshot.FieldLabels:
+			values[i] = new([]byte)
+		case playlistsnapshot.FieldCollaborative, playlistsnapshot.FieldPublic, playlistsnapshot.FieldComplete, playlistsnapshot.FieldPinned:
+			values[i] = new(sql.NullBool)
+		case playlistsnapshot.FieldTotal:
+			values[i] = new(sql.NullInt64)
+		case playlistsnapshot.FieldID, playlistsnapshot.FieldName, playlistsnapshot.FieldDescription, playlistsnapshot.FieldOwnerID, playlistsnapshot.FieldOwnerName, playlistsnapshot.FieldSnapshotID, playlistsnapshot.FieldNote:
+			values[i] = new(sql.NullString)
+		case playlistsnapshot.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case playlistsnapshot.ForeignKeys[0]: // playlist_snapshots
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PlaylistSnapshot fields.
+func (ps *PlaylistSnapshot) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case playlistsnapshot.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				ps.ID = value.String
+			}
+		case playlistsnapshot.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ps.Name = value.String
+			}
+		case playlistsnapshot.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				ps.Description = value.String
+			}
+		case playlistsnapshot.FieldOwnerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value.Valid {
+				ps.OwnerID = value.String
+			}
+		case playlistsnapshot.FieldOwnerName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_name", values[i])
+			} else if value.Valid {
+				ps.OwnerName = value.String
+			}
+		case playlistsnapshot.FieldCollaborative:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field collaborative", values[i])
+			} else if value.Valid {
+				ps.Collaborative = value.Bool
+			}
+		case playlistsnapshot.FieldPublic:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field public", values[i])
+			} else if value.Valid {
+				ps.Public = new(bool)
+				*ps.Public = value.Bool
+			}
+		case playlistsnapshot.FieldSnapshotID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field snapshot_id", values[i])
+			} else if value.Valid {
+				ps.SnapshotID = value.String
+			}
+		case playlistsnapshot.FieldTotal:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total", values[i])
+			} else if value.Valid {
+				ps.Total = int(value.Int64)
+			}
+		case playlistsnapshot.FieldComplete:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field complete", values[i])
+			} else if value.Valid {
+				ps.Complete = value.Bool
+			}
+		case playlistsnapshot.FieldPinned:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field pinned", values[i])
+			} else if value.Valid {
+				ps.Pinned = value.Bool
+			}
+		case playlistsnapshot.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				ps.Note = value.String
+			}
+		case playlistsnapshot.FieldLabels:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field labels", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ps.Labels); err != nil {
+					return fmt.Errorf("unmarshal field labels: %w", err)
+				}
+			}
+		case playlistsnapshot.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ps.CreatedAt = value.Time
+			}
+		case playlistsnapshot.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field playlist_snapshots", values[i])
+			} else if value.Valid {
+				ps.playlist_snapshots = new(string)
+				*ps.playlist_snapshots = value.String
+			}
+		default:
+			ps.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PlaylistSnapshot.
+// This includes values selected through modifiers, order, etc.
+func (ps *PlaylistSnapshot) Value(name string) (ent.Value, error) {
+	return ps.selectValues.Get(name)
+}
+
+// QueryPlaylist queries the "playlist" edge of the PlaylistSnapshot entity.
+func (ps *PlaylistSnapshot) QueryPlaylist() *PlaylistQuery {
+	return NewPlaylistSnapshotClient(ps.config).QueryPlaylist(ps)
+}
+
+// QueryRuns queries the "runs" edge of the PlaylistSnapshot entity.
+func (ps *PlaylistSnapshot) QueryRuns() *BackupRunQuery {
+	return NewPlaylistSnapshotClient(ps.config).QueryRuns(ps)
+}
+
+// QueryItems queries the "items" edge of the PlaylistSnapshot entity.
+func (ps *PlaylistSnapshot) QueryItems() *SnapshotItemQuery {
+	return NewPlaylistSnapshotClient(ps.config).QueryItems(ps)
+}
+
+// Update returns a builder for updating this PlaylistSnapshot.
+// Note that you need to call PlaylistSnapshot.Unwrap() before calling this method if this PlaylistSnapshot
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ps *PlaylistSnapshot) Update() *PlaylistSnapshotUpdateOne {
+	return NewPlaylistSnapshotClient(ps.config).UpdateOne(ps)
+}
+
+// Unwrap unwraps the PlaylistSnapshot entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ps *PlaylistSnapshot) Unwrap() *PlaylistSnapshot {
+	_tx, ok := ps.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PlaylistSnapshot is not a transactional entity")
+	}
+	ps.config.driver = _tx.drv
+	return ps
+}
+
+// String implements the fmt.Stringer.
+func (ps *PlaylistSnapshot) String() string {
+	var builder strings.Builder
+	builder.WriteString("PlaylistSnapshot(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ps.ID))
+	builder.WriteString("name=")
+	builder.WriteString(ps.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(ps.Description)
+	builder.WriteString(", ")
+	builder.WriteString("owner_id=")
+	builder.WriteString(ps.OwnerID)
+	builder.WriteString(", ")
+	builder.WriteString("owner_name=")
+	builder.WriteString(ps.OwnerName)
+	builder.WriteString(", ")
+	builder.WriteString("collaborative=")
+	builder.WriteString(fmt.Sprintf("%v", ps.Collaborative))
+	builder.WriteString(", ")
+	if v := ps.Public; v != nil {
+		builder.WriteString("public=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("snapshot_id=")
+	builder.WriteString(ps.SnapshotID)
+	builder.WriteString(", ")
+	builder.WriteString("total=")
+	builder.WriteString(fmt.Sprintf("%v", ps.Total))
+	builder.WriteString(", ")
+	builder.WriteString("complete=")
+	builder.WriteString(fmt.Sprintf("%v", ps.Complete))
+	builder.WriteString(", ")
+	builder.WriteString("pinned=")
+	builder.WriteString(fmt.Sprintf("%v", ps.Pinned))
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(ps.Note)
+	builder.WriteString(", ")
+	builder.WriteString("labels=")
+	builder.WriteString(fmt.Sprintf("%v", ps.Labels))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ps.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PlaylistSnapshots is a parsable slice of PlaylistSnapshot.
+type PlaylistSnapshots []*PlaylistSnapshot
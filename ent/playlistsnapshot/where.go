@@ -0,0 +1,730 @@
+// Code generated by ent, DO NOT EDIT.
+
+package playlistsnapshot
+
+import (
+	"beyerleinf/spotify-backup/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldContainsFold(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldEQ(FieldName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldEQ(FieldDescription, v))
+}
+
+// OwnerID applies equality check predicate on the "owner_id" field. It's identical to OwnerIDEQ.
+func OwnerID(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// OwnerName applies equality check predicate on the "owner_name" field. It's identical to OwnerNameEQ.
+func OwnerName(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldEQ(FieldOwnerName, v))
+}
+
+// Collaborative applies equality check predicate on the "collaborative" field. It's identical to CollaborativeEQ.
+func Collaborative(v bool) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldEQ(FieldCollaborative, v))
+}
+
+// Public applies equality check predicate on the "public" field. It's identical to PublicEQ.
+func Public(v bool) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldEQ(FieldPublic, v))
+}
+
+// SnapshotID applies equality check predicate on the "snapshot_id" field. It's identical to SnapshotIDEQ.
+func SnapshotID(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldEQ(FieldSnapshotID, v))
+}
+
+// Total applies equality check predicate on the "total" field. It's identical to TotalEQ.
+func Total(v int) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldEQ(FieldTotal, v))
+}
+
+// Complete applies equality check predicate on the "complete" field. It's identical to CompleteEQ.
+func Complete(v bool) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldEQ(FieldComplete, v))
+}
+
+// Pinned applies equality check predicate on the "pinned" field. It's identical to PinnedEQ.
+func Pinned(v bool) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldEQ(FieldPinned, v))
+}
+
+// Note applies equality check predicate on the "note" field. It's identical to NoteEQ.
+func Note(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldEQ(FieldNote, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldContainsFold(FieldName, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// OwnerIDEQ applies the EQ predicate on the "owner_id" field.
+func OwnerIDEQ(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// OwnerIDNEQ applies the NEQ predicate on the "owner_id" field.
+func OwnerIDNEQ(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldNEQ(FieldOwnerID, v))
+}
+
+// OwnerIDIn applies the In predicate on the "owner_id" field.
+func OwnerIDIn(vs ...string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDNotIn applies the NotIn predicate on the "owner_id" field.
+func OwnerIDNotIn(vs ...string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldNotIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDGT applies the GT predicate on the "owner_id" field.
+func OwnerIDGT(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldGT(FieldOwnerID, v))
+}
+
+// OwnerIDGTE applies the GTE predicate on the "owner_id" field.
+func OwnerIDGTE(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldGTE(FieldOwnerID, v))
+}
+
+// OwnerIDLT applies the LT predicate on the "owner_id" field.
+func OwnerIDLT(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldLT(FieldOwnerID, v))
+}
+
+// OwnerIDLTE applies the LTE predicate on the "owner_id" field.
+func OwnerIDLTE(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldLTE(FieldOwnerID, v))
+}
+
+// OwnerIDContains applies the Contains predicate on the "owner_id" field.
+func OwnerIDContains(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldContains(FieldOwnerID, v))
+}
+
+// OwnerIDHasPrefix applies the HasPrefix predicate on the "owner_id" field.
+func OwnerIDHasPrefix(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldHasPrefix(FieldOwnerID, v))
+}
+
+// OwnerIDHasSuffix applies the HasSuffix predicate on the "owner_id" field.
+func OwnerIDHasSuffix(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldHasSuffix(FieldOwnerID, v))
+}
+
+// OwnerIDEqualFold applies the EqualFold predicate on the "owner_id" field.
+func OwnerIDEqualFold(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldEqualFold(FieldOwnerID, v))
+}
+
+// OwnerIDContainsFold applies the ContainsFold predicate on the "owner_id" field.
+func OwnerIDContainsFold(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldContainsFold(FieldOwnerID, v))
+}
+
+// OwnerNameEQ applies the EQ predicate on the "owner_name" field.
+func OwnerNameEQ(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldEQ(FieldOwnerName, v))
+}
+
+// OwnerNameNEQ applies the NEQ predicate on the "owner_name" field.
+func OwnerNameNEQ(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldNEQ(FieldOwnerName, v))
+}
+
+// OwnerNameIn applies the In predicate on the "owner_name" field.
+func OwnerNameIn(vs ...string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldIn(FieldOwnerName, vs...))
+}
+
+// OwnerNameNotIn applies the NotIn predicate on the "owner_name" field.
+func OwnerNameNotIn(vs ...string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldNotIn(FieldOwnerName, vs...))
+}
+
+// OwnerNameGT applies the GT predicate on the "owner_name" field.
+func OwnerNameGT(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldGT(FieldOwnerName, v))
+}
+
+// OwnerNameGTE applies the GTE predicate on the "owner_name" field.
+func OwnerNameGTE(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldGTE(FieldOwnerName, v))
+}
+
+// OwnerNameLT applies the LT predicate on the "owner_name" field.
+func OwnerNameLT(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldLT(FieldOwnerName, v))
+}
+
+// OwnerNameLTE applies the LTE predicate on the "owner_name" field.
+func OwnerNameLTE(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldLTE(FieldOwnerName, v))
+}
+
+// OwnerNameContains applies the Contains predicate on the "owner_name" field.
+func OwnerNameContains(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldContains(FieldOwnerName, v))
+}
+
+// OwnerNameHasPrefix applies the HasPrefix predicate on the "owner_name" field.
+func OwnerNameHasPrefix(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldHasPrefix(FieldOwnerName, v))
+}
+
+// OwnerNameHasSuffix applies the HasSuffix predicate on the "owner_name" field.
+func OwnerNameHasSuffix(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldHasSuffix(FieldOwnerName, v))
+}
+
+// OwnerNameEqualFold applies the EqualFold predicate on the "owner_name" field.
+func OwnerNameEqualFold(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldEqualFold(FieldOwnerName, v))
+}
+
+// OwnerNameContainsFold applies the ContainsFold predicate on the "owner_name" field.
+func OwnerNameContainsFold(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldContainsFold(FieldOwnerName, v))
+}
+
+// CollaborativeEQ applies the EQ predicate on the "collaborative" field.
+func CollaborativeEQ(v bool) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldEQ(FieldCollaborative, v))
+}
+
+// CollaborativeNEQ applies the NEQ predicate on the "collaborative" field.
+func CollaborativeNEQ(v bool) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldNEQ(FieldCollaborative, v))
+}
+
+// PublicEQ applies the EQ predicate on the "public" field.
+func PublicEQ(v bool) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldEQ(FieldPublic, v))
+}
+
+// PublicNEQ applies the NEQ predicate on the "public" field.
+func PublicNEQ(v bool) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldNEQ(FieldPublic, v))
+}
+
+// PublicIsNil applies the IsNil predicate on the "public" field.
+func PublicIsNil() predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldIsNull(FieldPublic))
+}
+
+// PublicNotNil applies the NotNil predicate on the "public" field.
+func PublicNotNil() predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldNotNull(FieldPublic))
+}
+
+// SnapshotIDEQ applies the EQ predicate on the "snapshot_id" field.
+func SnapshotIDEQ(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldEQ(FieldSnapshotID, v))
+}
+
+// SnapshotIDNEQ applies the NEQ predicate on the "snapshot_id" field.
+func SnapshotIDNEQ(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldNEQ(FieldSnapshotID, v))
+}
+
+// SnapshotIDIn applies the In predicate on the "snapshot_id" field.
+func SnapshotIDIn(vs ...string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldIn(FieldSnapshotID, vs...))
+}
+
+// SnapshotIDNotIn applies the NotIn predicate on the "snapshot_id" field.
+func SnapshotIDNotIn(vs ...string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldNotIn(FieldSnapshotID, vs...))
+}
+
+// SnapshotIDGT applies the GT predicate on the "snapshot_id" field.
+func SnapshotIDGT(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldGT(FieldSnapshotID, v))
+}
+
+// SnapshotIDGTE applies the GTE predicate on the "snapshot_id" field.
+func SnapshotIDGTE(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldGTE(FieldSnapshotID, v))
+}
+
+// SnapshotIDLT applies the LT predicate on the "snapshot_id" field.
+func SnapshotIDLT(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldLT(FieldSnapshotID, v))
+}
+
+// SnapshotIDLTE applies the LTE predicate on the "snapshot_id" field.
+func SnapshotIDLTE(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldLTE(FieldSnapshotID, v))
+}
+
+// SnapshotIDContains applies the Contains predicate on the "snapshot_id" field.
+func SnapshotIDContains(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldContains(FieldSnapshotID, v))
+}
+
+// SnapshotIDHasPrefix applies the HasPrefix predicate on the "snapshot_id" field.
+func SnapshotIDHasPrefix(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldHasPrefix(FieldSnapshotID, v))
+}
+
+// SnapshotIDHasSuffix applies the HasSuffix predicate on the "snapshot_id" field.
+func SnapshotIDHasSuffix(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldHasSuffix(FieldSnapshotID, v))
+}
+
+// SnapshotIDEqualFold applies the EqualFold predicate on the "snapshot_id" field.
+func SnapshotIDEqualFold(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldEqualFold(FieldSnapshotID, v))
+}
+
+// SnapshotIDContainsFold applies the ContainsFold predicate on the "snapshot_id" field.
+func SnapshotIDContainsFold(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldContainsFold(FieldSnapshotID, v))
+}
+
+// TotalEQ applies the EQ predicate on the "total" field.
+func TotalEQ(v int) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldEQ(FieldTotal, v))
+}
+
+// TotalNEQ applies the NEQ predicate on the "total" field.
+func TotalNEQ(v int) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldNEQ(FieldTotal, v))
+}
+
+// TotalIn applies the In predicate on the "total" field.
+func TotalIn(vs ...int) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldIn(FieldTotal, vs...))
+}
+
+// TotalNotIn applies the NotIn predicate on the "total" field.
+func TotalNotIn(vs ...int) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldNotIn(FieldTotal, vs...))
+}
+
+// TotalGT applies the GT predicate on the "total" field.
+func TotalGT(v int) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldGT(FieldTotal, v))
+}
+
+// TotalGTE applies the GTE predicate on the "total" field.
+func TotalGTE(v int) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldGTE(FieldTotal, v))
+}
+
+// TotalLT applies the LT predicate on the "total" field.
+func TotalLT(v int) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldLT(FieldTotal, v))
+}
+
+// TotalLTE applies the LTE predicate on the "total" field.
+func TotalLTE(v int) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldLTE(FieldTotal, v))
+}
+
+// CompleteEQ applies the EQ predicate on the "complete" field.
+func CompleteEQ(v bool) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldEQ(FieldComplete, v))
+}
+
+// CompleteNEQ applies the NEQ predicate on the "complete" field.
+func CompleteNEQ(v bool) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldNEQ(FieldComplete, v))
+}
+
+// PinnedEQ applies the EQ predicate on the "pinned" field.
+func PinnedEQ(v bool) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldEQ(FieldPinned, v))
+}
+
+// PinnedNEQ applies the NEQ predicate on the "pinned" field.
+func PinnedNEQ(v bool) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldNEQ(FieldPinned, v))
+}
+
+// NoteEQ applies the EQ predicate on the "note" field.
+func NoteEQ(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldEQ(FieldNote, v))
+}
+
+// NoteNEQ applies the NEQ predicate on the "note" field.
+func NoteNEQ(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldNEQ(FieldNote, v))
+}
+
+// NoteIn applies the In predicate on the "note" field.
+func NoteIn(vs ...string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldIn(FieldNote, vs...))
+}
+
+// NoteNotIn applies the NotIn predicate on the "note" field.
+func NoteNotIn(vs ...string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldNotIn(FieldNote, vs...))
+}
+
+// NoteGT applies the GT predicate on the "note" field.
+func NoteGT(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldGT(FieldNote, v))
+}
+
+// NoteGTE applies the GTE predicate on the "note" field.
+func NoteGTE(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldGTE(FieldNote, v))
+}
+
+// NoteLT applies the LT predicate on the "note" field.
+func NoteLT(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldLT(FieldNote, v))
+}
+
+// NoteLTE applies the LTE predicate on the "note" field.
+func NoteLTE(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldLTE(FieldNote, v))
+}
+
+// NoteContains applies the Contains predicate on the "note" field.
+func NoteContains(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldContains(FieldNote, v))
+}
+
+// NoteHasPrefix applies the HasPrefix predicate on the "note" field.
+func NoteHasPrefix(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldHasPrefix(FieldNote, v))
+}
+
+// NoteHasSuffix applies the HasSuffix predicate on the "note" field.
+func NoteHasSuffix(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldHasSuffix(FieldNote, v))
+}
+
+// NoteEqualFold applies the EqualFold predicate on the "note" field.
+func NoteEqualFold(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldEqualFold(FieldNote, v))
+}
+
+// NoteContainsFold applies the ContainsFold predicate on the "note" field.
+func NoteContainsFold(v string) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldContainsFold(FieldNote, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasPlaylist applies the HasEdge predicate on the "playlist" edge.
+func HasPlaylist() predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PlaylistTable, PlaylistColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPlaylistWith applies the HasEdge predicate on the "playlist" edge with a given conditions (other predicates).
+func HasPlaylistWith(preds ...predicate.Playlist) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(func(s *sql.Selector) {
+		step := newPlaylistStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRuns applies the HasEdge predicate on the "runs" edge.
+func HasRuns() predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, RunsTable, RunsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRunsWith applies the HasEdge predicate on the "runs" edge with a given conditions (other predicates).
+func HasRunsWith(preds ...predicate.BackupRun) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(func(s *sql.Selector) {
+		step := newRunsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasItems applies the HasEdge predicate on the "items" edge.
+func HasItems() predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ItemsTable, ItemsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasItemsWith applies the HasEdge predicate on the "items" edge with a given conditions (other predicates).
+func HasItemsWith(preds ...predicate.SnapshotItem) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(func(s *sql.Selector) {
+		step := newItemsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PlaylistSnapshot) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PlaylistSnapshot) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PlaylistSnapshot) predicate.PlaylistSnapshot {
+	return predicate.PlaylistSnapshot(sql.NotPredicates(p))
+}
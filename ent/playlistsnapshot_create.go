@@ -0,0 +1,563 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/playlist"
+	"beyerleinf/spotify-backup/ent/playlistsnapshot"
+	"beyerleinf/spotify-backup/ent/snapshotitem"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PlaylistSnapshotCreate is the builder for creating a PlaylistSnapshot entity.
+type PlaylistSnapshotCreate struct {
+	config
+	mutation *PlaylistSnapshotMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (psc *PlaylistSnapshotCreate) SetName(s string) *PlaylistSnapshotCreate {
+	psc.mutation.SetName(s)
+	return psc
+}
+
+// SetDescription sets the "description" field.
+func (psc *PlaylistSnapshotCreate) SetDescription(s string) *PlaylistSnapshotCreate {
+	psc.mutation.SetDescription(s)
+	return psc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (psc *PlaylistSnapshotCreate) SetNillableDescription(s *string) *PlaylistSnapshotCreate {
+	if s != nil {
+		psc.SetDescription(*s)
+	}
+	return psc
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (psc *PlaylistSnapshotCreate) SetOwnerID(s string) *PlaylistSnapshotCreate {
+	psc.mutation.SetOwnerID(s)
+	return psc
+}
+
+// SetOwnerName sets the "owner_name" field.
+func (psc *PlaylistSnapshotCreate) SetOwnerName(s string) *PlaylistSnapshotCreate {
+	psc.mutation.SetOwnerName(s)
+	return psc
+}
+
+// SetNillableOwnerName sets the "owner_name" field if the given value is not nil.
+func (psc *PlaylistSnapshotCreate) SetNillableOwnerName(s *string) *PlaylistSnapshotCreate {
+	if s != nil {
+		psc.SetOwnerName(*s)
+	}
+	return psc
+}
+
+// SetCollaborative sets the "collaborative" field.
+func (psc *PlaylistSnapshotCreate) SetCollaborative(b bool) *PlaylistSnapshotCreate {
+	psc.mutation.SetCollaborative(b)
+	return psc
+}
+
+// SetNillableCollaborative sets the "collaborative" field if the given value is not nil.
+func (psc *PlaylistSnapshotCreate) SetNillableCollaborative(b *bool) *PlaylistSnapshotCreate {
+	if b != nil {
+		psc.SetCollaborative(*b)
+	}
+	return psc
+}
+
+// SetPublic sets the "public" field.
+func (psc *PlaylistSnapshotCreate) SetPublic(b bool) *PlaylistSnapshotCreate {
+	psc.mutation.SetPublic(b)
+	return psc
+}
+
+// SetNillablePublic sets the "public" field if the given value is not nil.
+func (psc *PlaylistSnapshotCreate) SetNillablePublic(b *bool) *PlaylistSnapshotCreate {
+	if b != nil {
+		psc.SetPublic(*b)
+	}
+	return psc
+}
+
+// SetSnapshotID sets the "snapshot_id" field.
+func (psc *PlaylistSnapshotCreate) SetSnapshotID(s string) *PlaylistSnapshotCreate {
+	psc.mutation.SetSnapshotID(s)
+	return psc
+}
+
+// SetTotal sets the "total" field.
+func (psc *PlaylistSnapshotCreate) SetTotal(i int) *PlaylistSnapshotCreate {
+	psc.mutation.SetTotal(i)
+	return psc
+}
+
+// SetComplete sets the "complete" field.
+func (psc *PlaylistSnapshotCreate) SetComplete(b bool) *PlaylistSnapshotCreate {
+	psc.mutation.SetComplete(b)
+	return psc
+}
+
+// SetNillableComplete sets the "complete" field if the given value is not nil.
+func (psc *PlaylistSnapshotCreate) SetNillableComplete(b *bool) *PlaylistSnapshotCreate {
+	if b != nil {
+		psc.SetComplete(*b)
+	}
+	return psc
+}
+
+// SetPinned sets the "pinned" field.
+func (psc *PlaylistSnapshotCreate) SetPinned(b bool) *PlaylistSnapshotCreate {
+	psc.mutation.SetPinned(b)
+	return psc
+}
+
+// SetNillablePinned sets the "pinned" field if the given value is not nil.
+func (psc *PlaylistSnapshotCreate) SetNillablePinned(b *bool) *PlaylistSnapshotCreate {
+	if b != nil {
+		psc.SetPinned(*b)
+	}
+	return psc
+}
+
+// SetNote sets the "note" field.
+func (psc *PlaylistSnapshotCreate) SetNote(s string) *PlaylistSnapshotCreate {
+	psc.mutation.SetNote(s)
+	return psc
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (psc *PlaylistSnapshotCreate) SetNillableNote(s *string) *PlaylistSnapshotCreate {
+	if s != nil {
+		psc.SetNote(*s)
+	}
+	return psc
+}
+
+// SetLabels sets the "labels" field.
+func (psc *PlaylistSnapshotCreate) SetLabels(s []string) *PlaylistSnapshotCreate {
+	psc.mutation.SetLabels(s)
+	return psc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (psc *PlaylistSnapshotCreate) SetCreatedAt(t time.Time) *PlaylistSnapshotCreate {
+	psc.mutation.SetCreatedAt(t)
+	return psc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (psc *PlaylistSnapshotCreate) SetNillableCreatedAt(t *time.Time) *PlaylistSnapshotCreate {
+	if t != nil {
+		psc.SetCreatedAt(*t)
+	}
+	return psc
+}
+
+// SetID sets the "id" field.
+func (psc *PlaylistSnapshotCreate) SetID(s string) *PlaylistSnapshotCreate {
+	psc.mutation.SetID(s)
+	return psc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (psc *PlaylistSnapshotCreate) SetNillableID(s *string) *PlaylistSnapshotCreate {
+	if s != nil {
+		psc.SetID(*s)
+	}
+	return psc
+}
+
+// SetPlaylistID sets the "playlist" edge to the Playlist entity by ID.
+func (psc *PlaylistSnapshotCreate) SetPlaylistID(id string) *PlaylistSnapshotCreate {
+	psc.mutation.SetPlaylistID(id)
+	return psc
+}
+
+// SetPlaylist sets the "playlist" edge to the Playlist entity.
+func (psc *PlaylistSnapshotCreate) SetPlaylist(p *Playlist) *PlaylistSnapshotCreate {
+	return psc.SetPlaylistID(p.ID)
+}
+
+// AddRunIDs adds the "runs" edge to the BackupRun entity by IDs.
+func (psc *PlaylistSnapshotCreate) AddRunIDs(ids ...string) *PlaylistSnapshotCreate {
+	psc.mutation.AddRunIDs(ids...)
+	return psc
+}
+
+// AddRuns adds the "runs" edges to the BackupRun entity.
+func (psc *PlaylistSnapshotCreate) AddRuns(b ...*BackupRun) *PlaylistSnapshotCreate {
+	ids := make([]string, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return psc.AddRunIDs(ids...)
+}
+
+// AddItemIDs adds the "items" edge to the SnapshotItem entity by IDs.
+func (psc *PlaylistSnapshotCreate) AddItemIDs(ids ...string) *PlaylistSnapshotCreate {
+	psc.mutation.AddItemIDs(ids...)
+	return psc
+}
+
+// AddItems adds the "items" edges to the SnapshotItem entity.
+func (psc *PlaylistSnapshotCreate) AddItems(s ...*SnapshotItem) *PlaylistSnapshotCreate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return psc.AddItemIDs(ids...)
+}
+
+// Mutation returns the PlaylistSnapshotMutation object of the builder.
+func (psc *PlaylistSnapshotCreate) Mutation() *PlaylistSnapshotMutation {
+	return psc.mutation
+}
+
+// Save creates the PlaylistSnapshot in the database.
+func (psc *PlaylistSnapshotCreate) Save(ctx context.Context) (*PlaylistSnapshot, error) {
+	psc.defaults()
+	return withHooks(ctx, psc.sqlSave, psc.mutation, psc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (psc *PlaylistSnapshotCreate) SaveX(ctx context.Context) *PlaylistSnapshot {
+	v, err := psc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (psc *PlaylistSnapshotCreate) Exec(ctx context.Context) error {
+	_, err := psc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (psc *PlaylistSnapshotCreate) ExecX(ctx context.Context) {
+	if err := psc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (psc *PlaylistSnapshotCreate) defaults() {
+	if _, ok := psc.mutation.Description(); !ok {
+		v := playlistsnapshot.DefaultDescription
+		psc.mutation.SetDescription(v)
+	}
+	if _, ok := psc.mutation.OwnerName(); !ok {
+		v := playlistsnapshot.DefaultOwnerName
+		psc.mutation.SetOwnerName(v)
+	}
+	if _, ok := psc.mutation.Collaborative(); !ok {
+		v := playlistsnapshot.DefaultCollaborative
+		psc.mutation.SetCollaborative(v)
+	}
+	if _, ok := psc.mutation.Complete(); !ok {
+		v := playlistsnapshot.DefaultComplete
+		psc.mutation.SetComplete(v)
+	}
+	if _, ok := psc.mutation.Pinned(); !ok {
+		v := playlistsnapshot.DefaultPinned
+		psc.mutation.SetPinned(v)
+	}
+	if _, ok := psc.mutation.Note(); !ok {
+		v := playlistsnapshot.DefaultNote
+		psc.mutation.SetNote(v)
+	}
+	if _, ok := psc.mutation.Labels(); !ok {
+		v := playlistsnapshot.DefaultLabels
+		psc.mutation.SetLabels(v)
+	}
+	if _, ok := psc.mutation.CreatedAt(); !ok {
+		v := playlistsnapshot.DefaultCreatedAt()
+		psc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := psc.mutation.ID(); !ok {
+		v := playlistsnapshot.DefaultID()
+		psc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (psc *PlaylistSnapshotCreate) check() error {
+	if _, ok := psc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "PlaylistSnapshot.name"`)}
+	}
+	if _, ok := psc.mutation.Description(); !ok {
+		return &ValidationError{Name: "description", err: errors.New(`ent: missing required field "PlaylistSnapshot.description"`)}
+	}
+	if _, ok := psc.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner_id", err: errors.New(`ent: missing required field "PlaylistSnapshot.owner_id"`)}
+	}
+	if _, ok := psc.mutation.OwnerName(); !ok {
+		return &ValidationError{Name: "owner_name", err: errors.New(`ent: missing required field "PlaylistSnapshot.owner_name"`)}
+	}
+	if _, ok := psc.mutation.Collaborative(); !ok {
+		return &ValidationError{Name: "collaborative", err: errors.New(`ent: missing required field "PlaylistSnapshot.collaborative"`)}
+	}
+	if _, ok := psc.mutation.SnapshotID(); !ok {
+		return &ValidationError{Name: "snapshot_id", err: errors.New(`ent: missing required field "PlaylistSnapshot.snapshot_id"`)}
+	}
+	if _, ok := psc.mutation.Total(); !ok {
+		return &ValidationError{Name: "total", err: errors.New(`ent: missing required field "PlaylistSnapshot.total"`)}
+	}
+	if v, ok := psc.mutation.Total(); ok {
+		if err := playlistsnapshot.TotalValidator(v); err != nil {
+			return &ValidationError{Name: "total", err: fmt.Errorf(`ent: validator failed for field "PlaylistSnapshot.total": %w`, err)}
+		}
+	}
+	if _, ok := psc.mutation.Complete(); !ok {
+		return &ValidationError{Name: "complete", err: errors.New(`ent: missing required field "PlaylistSnapshot.complete"`)}
+	}
+	if _, ok := psc.mutation.Pinned(); !ok {
+		return &ValidationError{Name: "pinned", err: errors.New(`ent: missing required field "PlaylistSnapshot.pinned"`)}
+	}
+	if _, ok := psc.mutation.Note(); !ok {
+		return &ValidationError{Name: "note", err: errors.New(`ent: missing required field "PlaylistSnapshot.note"`)}
+	}
+	if _, ok := psc.mutation.Labels(); !ok {
+		return &ValidationError{Name: "labels", err: errors.New(`ent: missing required field "PlaylistSnapshot.labels"`)}
+	}
+	if _, ok := psc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PlaylistSnapshot.created_at"`)}
+	}
+	if len(psc.mutation.PlaylistIDs()) == 0 {
+		return &ValidationError{Name: "playlist", err: errors.New(`ent: missing required edge "PlaylistSnapshot.playlist"`)}
+	}
+	return nil
+}
+
+func (psc *PlaylistSnapshotCreate) sqlSave(ctx context.Context) (*PlaylistSnapshot, error) {
+	if err := psc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := psc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, psc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected PlaylistSnapshot.ID type: %T", _spec.ID.Value)
+		}
+	}
+	psc.mutation.id = &_node.ID
+	psc.mutation.done = true
+	return _node, nil
+}
+
+func (psc *PlaylistSnapshotCreate) createSpec() (*PlaylistSnapshot, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PlaylistSnapshot{config: psc.config}
+		_spec = sqlgraph.NewCreateSpec(playlistsnapshot.Table, sqlgraph.NewFieldSpec(playlistsnapshot.FieldID, field.TypeString))
+	)
+	if id, ok := psc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := psc.mutation.Name(); ok {
+		_spec.SetField(playlistsnapshot.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := psc.mutation.Description(); ok {
+		_spec.SetField(playlistsnapshot.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := psc.mutation.OwnerID(); ok {
+		_spec.SetField(playlistsnapshot.FieldOwnerID, field.TypeString, value)
+		_node.OwnerID = value
+	}
+	if value, ok := psc.mutation.OwnerName(); ok {
+		_spec.SetField(playlistsnapshot.FieldOwnerName, field.TypeString, value)
+		_node.OwnerName = value
+	}
+	if value, ok := psc.mutation.Collaborative(); ok {
+		_spec.SetField(playlistsnapshot.FieldCollaborative, field.TypeBool, value)
+		_node.Collaborative = value
+	}
+	if value, ok := psc.mutation.Public(); ok {
+		_spec.SetField(playlistsnapshot.FieldPublic, field.TypeBool, value)
+		_node.Public = &value
+	}
+	if value, ok := psc.mutation.SnapshotID(); ok {
+		_spec.SetField(playlistsnapshot.FieldSnapshotID, field.TypeString, value)
+		_node.SnapshotID = value
+	}
+	if value, ok := psc.mutation.Total(); ok {
+		_spec.SetField(playlistsnapshot.FieldTotal, field.TypeInt, value)
+		_node.Total = value
+	}
+	if value, ok := psc.mutation.Complete(); ok {
+		_spec.SetField(playlistsnapshot.FieldComplete, field.TypeBool, value)
+		_node.Complete = value
+	}
+	if value, ok := psc.mutation.Pinned(); ok {
+		_spec.SetField(playlistsnapshot.FieldPinned, field.TypeBool, value)
+		_node.Pinned = value
+	}
+	if value, ok := psc.mutation.Note(); ok {
+		_spec.SetField(playlistsnapshot.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := psc.mutation.Labels(); ok {
+		_spec.SetField(playlistsnapshot.FieldLabels, field.TypeJSON, value)
+		_node.Labels = value
+	}
+	if value, ok := psc.mutation.CreatedAt(); ok {
+		_spec.SetField(playlistsnapshot.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := psc.mutation.PlaylistIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playlistsnapshot.PlaylistTable,
+			Columns: []string{playlistsnapshot.PlaylistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.playlist_snapshots = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := psc.mutation.RunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   playlistsnapshot.RunsTable,
+			Columns: playlistsnapshot.RunsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := psc.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   playlistsnapshot.ItemsTable,
+			Columns: []string{playlistsnapshot.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshotitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PlaylistSnapshotCreateBulk is the builder for creating many PlaylistSnapshot entities in bulk.
+type PlaylistSnapshotCreateBulk struct {
+	config
+	err      error
+	builders []*PlaylistSnapshotCreate
+}
+
+// Save creates the PlaylistSnapshot entities in the database.
+func (pscb *PlaylistSnapshotCreateBulk) Save(ctx context.Context) ([]*PlaylistSnapshot, error) {
+	if pscb.err != nil {
+		return nil, pscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pscb.builders))
+	nodes := make([]*PlaylistSnapshot, len(pscb.builders))
+	mutators := make([]Mutator, len(pscb.builders))
+	for i := range pscb.builders {
+		func(i int, root context.Context) {
+			builder := pscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PlaylistSnapshotMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pscb *PlaylistSnapshotCreateBulk) SaveX(ctx context.Context) []*PlaylistSnapshot {
+	v, err := pscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pscb *PlaylistSnapshotCreateBulk) Exec(ctx context.Context) error {
+	_, err := pscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pscb *PlaylistSnapshotCreateBulk) ExecX(ctx context.Context) {
+	if err := pscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/playlistsnapshot"
+	"beyerleinf/spotify-backup/ent/predicate"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PlaylistSnapshotDelete is the builder for deleting a PlaylistSnapshot entity.
+type PlaylistSnapshotDelete struct {
+	config
+	hooks    []Hook
+	mutation *PlaylistSnapshotMutation
+}
+
+// Where appends a list predicates to the PlaylistSnapshotDelete builder.
+func (psd *PlaylistSnapshotDelete) Where(ps ...predicate.PlaylistSnapshot) *PlaylistSnapshotDelete {
+	psd.mutation.Where(ps...)
+	return psd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (psd *PlaylistSnapshotDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, psd.sqlExec, psd.mutation, psd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (psd *PlaylistSnapshotDelete) ExecX(ctx context.Context) int {
+	n, err := psd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (psd *PlaylistSnapshotDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(playlistsnapshot.Table, sqlgraph.NewFieldSpec(playlistsnapshot.FieldID, field.TypeString))
+	if ps := psd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, psd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	psd.mutation.done = true
+	return affected, err
+}
+
+// PlaylistSnapshotDeleteOne is the builder for deleting a single PlaylistSnapshot entity.
+type PlaylistSnapshotDeleteOne struct {
+	psd *PlaylistSnapshotDelete
+}
+
+// Where appends a list predicates to the PlaylistSnapshotDelete builder.
+func (psdo *PlaylistSnapshotDeleteOne) Where(ps ...predicate.PlaylistSnapshot) *PlaylistSnapshotDeleteOne {
+	psdo.psd.mutation.Where(ps...)
+	return psdo
+}
+
+// Exec executes the deletion query.
+func (psdo *PlaylistSnapshotDeleteOne) Exec(ctx context.Context) error {
+	n, err := psdo.psd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{playlistsnapshot.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (psdo *PlaylistSnapshotDeleteOne) ExecX(ctx context.Context) {
+	if err := psdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,832 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/playlist"
+	"beyerleinf/spotify-backup/ent/playlistsnapshot"
+	"beyerleinf/spotify-backup/ent/predicate"
+	"beyerleinf/spotify-backup/ent/snapshotitem"
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PlaylistSnapshotQuery is the builder for querying PlaylistSnapshot entities.
+type PlaylistSnapshotQuery struct {
+	config
+	ctx          *QueryContext
+	order        []playlistsnapshot.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.PlaylistSnapshot
+	withPlaylist *PlaylistQuery
+	withRuns     *BackupRunQuery
+	withItems    *SnapshotItemQuery
+	withFKs      bool
+	modifiers    []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PlaylistSnapshotQuery builder.
+func (psq *PlaylistSnapshotQuery) Where(ps ...predicate.PlaylistSnapshot) *PlaylistSnapshotQuery {
+	psq.predicates = append(psq.predicates, ps...)
+	return psq
+}
+
+// Limit the number of records to be returned by this query.
+func (psq *PlaylistSnapshotQuery) Limit(limit int) *PlaylistSnapshotQuery {
+	psq.ctx.Limit = &limit
+	return psq
+}
+
+// Offset to start from.
+func (psq *PlaylistSnapshotQuery) Offset(offset int) *PlaylistSnapshotQuery {
+	psq.ctx.Offset = &offset
+	return psq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (psq *PlaylistSnapshotQuery) Unique(unique bool) *PlaylistSnapshotQuery {
+	psq.ctx.Unique = &unique
+	return psq
+}
+
+// Order specifies how the records should be ordered.
+func (psq *PlaylistSnapshotQuery) Order(o ...playlistsnapshot.OrderOption) *PlaylistSnapshotQuery {
+	psq.order = append(psq.order, o...)
+	return psq
+}
+
+// QueryPlaylist chains the current query on the "playlist" edge.
+func (psq *PlaylistSnapshotQuery) QueryPlaylist() *PlaylistQuery {
+	query := (&PlaylistClient{config: psq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := psq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := psq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(playlistsnapshot.Table, playlistsnapshot.FieldID, selector),
+			sqlgraph.To(playlist.Table, playlist.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, playlistsnapshot.PlaylistTable, playlistsnapshot.PlaylistColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(psq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRuns chains the current query on the "runs" edge.
+func (psq *PlaylistSnapshotQuery) QueryRuns() *BackupRunQuery {
+	query := (&BackupRunClient{config: psq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := psq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := psq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(playlistsnapshot.Table, playlistsnapshot.FieldID, selector),
+			sqlgraph.To(backuprun.Table, backuprun.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, playlistsnapshot.RunsTable, playlistsnapshot.RunsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(psq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryItems chains the current query on the "items" edge.
+func (psq *PlaylistSnapshotQuery) QueryItems() *SnapshotItemQuery {
+	query := (&SnapshotItemClient{config: psq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := psq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := psq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(playlistsnapshot.Table, playlistsnapshot.FieldID, selector),
+			sqlgraph.To(snapshotitem.Table, snapshotitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, playlistsnapshot.ItemsTable, playlistsnapshot.ItemsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(psq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first PlaylistSnapshot entity from the query.
+// Returns a *NotFoundError when no PlaylistSnapshot was found.
+func (psq *PlaylistSnapshotQuery) First(ctx context.Context) (*PlaylistSnapshot, error) {
+	nodes, err := psq.Limit(1).All(setContextOp(ctx, psq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{playlistsnapshot.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (psq *PlaylistSnapshotQuery) FirstX(ctx context.Context) *PlaylistSnapshot {
+	node, err := psq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PlaylistSnapshot ID from the query.
+// Returns a *NotFoundError when no PlaylistSnapshot ID was found.
+func (psq *PlaylistSnapshotQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = psq.Limit(1).IDs(setContextOp(ctx, psq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{playlistsnapshot.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (psq *PlaylistSnapshotQuery) FirstIDX(ctx context.Context) string {
+	id, err := psq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PlaylistSnapshot entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PlaylistSnapshot entity is found.
+// Returns a *NotFoundError when no PlaylistSnapshot entities are found.
+func (psq *PlaylistSnapshotQuery) Only(ctx context.Context) (*PlaylistSnapshot, error) {
+	nodes, err := psq.Limit(2).All(setContextOp(ctx, psq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{playlistsnapshot.Label}
+	default:
+		return nil, &NotSingularError{playlistsnapshot.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (psq *PlaylistSnapshotQuery) OnlyX(ctx context.Context) *PlaylistSnapshot {
+	node, err := psq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PlaylistSnapshot ID in the query.
+// Returns a *NotSingularError when more than one PlaylistSnapshot ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (psq *PlaylistSnapshotQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = psq.Limit(2).IDs(setContextOp(ctx, psq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{playlistsnapshot.Label}
+	default:
+		err = &NotSingularError{playlistsnapshot.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (psq *PlaylistSnapshotQuery) OnlyIDX(ctx context.Context) string {
+	id, err := psq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PlaylistSnapshots.
+func (psq *PlaylistSnapshotQuery) All(ctx context.Context) ([]*PlaylistSnapshot, error) {
+	ctx = setContextOp(ctx, psq.ctx, ent.OpQueryAll)
+	if err := psq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*PlaylistSnapshot, *PlaylistSnapshotQuery]()
+	return withInterceptors[[]*PlaylistSnapshot](ctx, psq, qr, psq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (psq *PlaylistSnapshotQuery) AllX(ctx context.Context) []*PlaylistSnapshot {
+	nodes, err := psq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PlaylistSnapshot IDs.
+func (psq *PlaylistSnapshotQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if psq.ctx.Unique == nil && psq.path != nil {
+		psq.Unique(true)
+	}
+	ctx = setContextOp(ctx, psq.ctx, ent.OpQueryIDs)
+	if err = psq.Select(playlistsnapshot.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (psq *PlaylistSnapshotQuery) IDsX(ctx context.Context) []string {
+	ids, err := psq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (psq *PlaylistSnapshotQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, psq.ctx, ent.OpQueryCount)
+	if err := psq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, psq, querierCount[*PlaylistSnapshotQuery](), psq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (psq *PlaylistSnapshotQuery) CountX(ctx context.Context) int {
+	count, err := psq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (psq *PlaylistSnapshotQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, psq.ctx, ent.OpQueryExist)
+	switch _, err := psq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (psq *PlaylistSnapshotQuery) ExistX(ctx context.Context) bool {
+	exist, err := psq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PlaylistSnapshotQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (psq *PlaylistSnapshotQuery) Clone() *PlaylistSnapshotQuery {
+	if psq == nil {
+		return nil
+	}
+	return &PlaylistSnapshotQuery{
+		config:       psq.config,
+		ctx:          psq.ctx.Clone(),
+		order:        append([]playlistsnapshot.OrderOption{}, psq.order...),
+		inters:       append([]Interceptor{}, psq.inters...),
+		predicates:   append([]predicate.PlaylistSnapshot{}, psq.predicates...),
+		withPlaylist: psq.withPlaylist.Clone(),
+		withRuns:     psq.withRuns.Clone(),
+		withItems:    psq.withItems.Clone(),
+		// clone intermediate query.
+		sql:  psq.sql.Clone(),
+		path: psq.path,
+	}
+}
+
+// WithPlaylist tells the query-builder to eager-load the nodes that are connected to
+// the "playlist" edge. The optional arguments are used to configure the query builder of the edge.
+func (psq *PlaylistSnapshotQuery) WithPlaylist(opts ...func(*PlaylistQuery)) *PlaylistSnapshotQuery {
+	query := (&PlaylistClient{config: psq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	psq.withPlaylist = query
+	return psq
+}
+
+// WithRuns tells the query-builder to eager-load the nodes that are connected to
+// the "runs" edge. The optional arguments are used to configure the query builder of the edge.
+func (psq *PlaylistSnapshotQuery) WithRuns(opts ...func(*BackupRunQuery)) *PlaylistSnapshotQuery {
+	query := (&BackupRunClient{config: psq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	psq.withRuns = query
+	return psq
+}
+
+// WithItems tells the query-builder to eager-load the nodes that are connected to
+// the "items" edge. The optional arguments are used to configure the query builder of the edge.
+func (psq *PlaylistSnapshotQuery) WithItems(opts ...func(*SnapshotItemQuery)) *PlaylistSnapshotQuery {
+	query := (&SnapshotItemClient{config: psq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	psq.withItems = query
+	return psq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PlaylistSnapshot.Query().
+//		GroupBy(playlistsnapshot.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (psq *PlaylistSnapshotQuery) GroupBy(field string, fields ...string) *PlaylistSnapshotGroupBy {
+	psq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PlaylistSnapshotGroupBy{build: psq}
+	grbuild.flds = &psq.ctx.Fields
+	grbuild.label = playlistsnapshot.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.PlaylistSnapshot.Query().
+//		Select(playlistsnapshot.FieldName).
+//		Scan(ctx, &v)
+func (psq *PlaylistSnapshotQuery) Select(fields ...string) *PlaylistSnapshotSelect {
+	psq.ctx.Fields = append(psq.ctx.Fields, fields...)
+	sbuild := &PlaylistSnapshotSelect{PlaylistSnapshotQuery: psq}
+	sbuild.label = playlistsnapshot.Label
+	sbuild.flds, sbuild.scan = &psq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PlaylistSnapshotSelect configured with the given aggregations.
+func (psq *PlaylistSnapshotQuery) Aggregate(fns ...AggregateFunc) *PlaylistSnapshotSelect {
+	return psq.Select().Aggregate(fns...)
+}
+
+func (psq *PlaylistSnapshotQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range psq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, psq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range psq.ctx.Fields {
+		if !playlistsnapshot.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if psq.path != nil {
+		prev, err := psq.path(ctx)
+		if err != nil {
+			return err
+		}
+		psq.sql = prev
+	}
+	return nil
+}
+
+func (psq *PlaylistSnapshotQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PlaylistSnapshot, error) {
+	var (
+		nodes       = []*PlaylistSnapshot{}
+		withFKs     = psq.withFKs
+		_spec       = psq.querySpec()
+		loadedTypes = [3]bool{
+			psq.withPlaylist != nil,
+			psq.withRuns != nil,
+			psq.withItems != nil,
+		}
+	)
+	if psq.withPlaylist != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, playlistsnapshot.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*PlaylistSnapshot).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &PlaylistSnapshot{config: psq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(psq.modifiers) > 0 {
+		_spec.Modifiers = psq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, psq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := psq.withPlaylist; query != nil {
+		if err := psq.loadPlaylist(ctx, query, nodes, nil,
+			func(n *PlaylistSnapshot, e *Playlist) { n.Edges.Playlist = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := psq.withRuns; query != nil {
+		if err := psq.loadRuns(ctx, query, nodes,
+			func(n *PlaylistSnapshot) { n.Edges.Runs = []*BackupRun{} },
+			func(n *PlaylistSnapshot, e *BackupRun) { n.Edges.Runs = append(n.Edges.Runs, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := psq.withItems; query != nil {
+		if err := psq.loadItems(ctx, query, nodes,
+			func(n *PlaylistSnapshot) { n.Edges.Items = []*SnapshotItem{} },
+			func(n *PlaylistSnapshot, e *SnapshotItem) { n.Edges.Items = append(n.Edges.Items, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (psq *PlaylistSnapshotQuery) loadPlaylist(ctx context.Context, query *PlaylistQuery, nodes []*PlaylistSnapshot, init func(*PlaylistSnapshot), assign func(*PlaylistSnapshot, *Playlist)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*PlaylistSnapshot)
+	for i := range nodes {
+		if nodes[i].playlist_snapshots == nil {
+			continue
+		}
+		fk := *nodes[i].playlist_snapshots
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(playlist.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "playlist_snapshots" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (psq *PlaylistSnapshotQuery) loadRuns(ctx context.Context, query *BackupRunQuery, nodes []*PlaylistSnapshot, init func(*PlaylistSnapshot), assign func(*PlaylistSnapshot, *BackupRun)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[string]*PlaylistSnapshot)
+	nids := make(map[string]map[*PlaylistSnapshot]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(playlistsnapshot.RunsTable)
+		s.Join(joinT).On(s.C(backuprun.FieldID), joinT.C(playlistsnapshot.RunsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(playlistsnapshot.RunsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(playlistsnapshot.RunsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullString)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := values[0].(*sql.NullString).String
+				inValue := values[1].(*sql.NullString).String
+				if nids[inValue] == nil {
+					nids[inValue] = map[*PlaylistSnapshot]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*BackupRun](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "runs" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (psq *PlaylistSnapshotQuery) loadItems(ctx context.Context, query *SnapshotItemQuery, nodes []*PlaylistSnapshot, init func(*PlaylistSnapshot), assign func(*PlaylistSnapshot, *SnapshotItem)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*PlaylistSnapshot)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.SnapshotItem(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(playlistsnapshot.ItemsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.playlist_snapshot_items
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "playlist_snapshot_items" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "playlist_snapshot_items" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (psq *PlaylistSnapshotQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := psq.querySpec()
+	if len(psq.modifiers) > 0 {
+		_spec.Modifiers = psq.modifiers
+	}
+	_spec.Node.Columns = psq.ctx.Fields
+	if len(psq.ctx.Fields) > 0 {
+		_spec.Unique = psq.ctx.Unique != nil && *psq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, psq.driver, _spec)
+}
+
+func (psq *PlaylistSnapshotQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(playlistsnapshot.Table, playlistsnapshot.Columns, sqlgraph.NewFieldSpec(playlistsnapshot.FieldID, field.TypeString))
+	_spec.From = psq.sql
+	if unique := psq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if psq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := psq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, playlistsnapshot.FieldID)
+		for i := range fields {
+			if fields[i] != playlistsnapshot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := psq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := psq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := psq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := psq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (psq *PlaylistSnapshotQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(psq.driver.Dialect())
+	t1 := builder.Table(playlistsnapshot.Table)
+	columns := psq.ctx.Fields
+	if len(columns) == 0 {
+		columns = playlistsnapshot.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if psq.sql != nil {
+		selector = psq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if psq.ctx.Unique != nil && *psq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range psq.modifiers {
+		m(selector)
+	}
+	for _, p := range psq.predicates {
+		p(selector)
+	}
+	for _, p := range psq.order {
+		p(selector)
+	}
+	if offset := psq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := psq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (psq *PlaylistSnapshotQuery) ForUpdate(opts ...sql.LockOption) *PlaylistSnapshotQuery {
+	if psq.driver.Dialect() == dialect.Postgres {
+		psq.Unique(false)
+	}
+	psq.modifiers = append(psq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return psq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (psq *PlaylistSnapshotQuery) ForShare(opts ...sql.LockOption) *PlaylistSnapshotQuery {
+	if psq.driver.Dialect() == dialect.Postgres {
+		psq.Unique(false)
+	}
+	psq.modifiers = append(psq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return psq
+}
+
+// PlaylistSnapshotGroupBy is the group-by builder for PlaylistSnapshot entities.
+type PlaylistSnapshotGroupBy struct {
+	selector
+	build *PlaylistSnapshotQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (psgb *PlaylistSnapshotGroupBy) Aggregate(fns ...AggregateFunc) *PlaylistSnapshotGroupBy {
+	psgb.fns = append(psgb.fns, fns...)
+	return psgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (psgb *PlaylistSnapshotGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, psgb.build.ctx, ent.OpQueryGroupBy)
+	if err := psgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PlaylistSnapshotQuery, *PlaylistSnapshotGroupBy](ctx, psgb.build, psgb, psgb.build.inters, v)
+}
+
+func (psgb *PlaylistSnapshotGroupBy) sqlScan(ctx context.Context, root *PlaylistSnapshotQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(psgb.fns))
+	for _, fn := range psgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*psgb.flds)+len(psgb.fns))
+		for _, f := range *psgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*psgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := psgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PlaylistSnapshotSelect is the builder for selecting fields of PlaylistSnapshot entities.
+type PlaylistSnapshotSelect struct {
+	*PlaylistSnapshotQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pss *PlaylistSnapshotSelect) Aggregate(fns ...AggregateFunc) *PlaylistSnapshotSelect {
+	pss.fns = append(pss.fns, fns...)
+	return pss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pss *PlaylistSnapshotSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pss.ctx, ent.OpQuerySelect)
+	if err := pss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PlaylistSnapshotQuery, *PlaylistSnapshotSelect](ctx, pss.PlaylistSnapshotQuery, pss, pss.inters, v)
+}
+
+func (pss *PlaylistSnapshotSelect) sqlScan(ctx context.Context, root *PlaylistSnapshotQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pss.fns))
+	for _, fn := range pss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
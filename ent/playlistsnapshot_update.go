@@ -0,0 +1,1079 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/playlist"
+	"beyerleinf/spotify-backup/ent/playlistsnapshot"
+	"beyerleinf/spotify-backup/ent/predicate"
+	"beyerleinf/spotify-backup/ent/snapshotitem"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// PlaylistSnapshotUpdate is the builder for updating PlaylistSnapshot entities.
+type PlaylistSnapshotUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PlaylistSnapshotMutation
+}
+
+// Where appends a list predicates to the PlaylistSnapshotUpdate builder.
+func (psu *PlaylistSnapshotUpdate) Where(ps ...predicate.PlaylistSnapshot) *PlaylistSnapshotUpdate {
+	psu.mutation.Where(ps...)
+	return psu
+}
+
+// SetName sets the "name" field.
+func (psu *PlaylistSnapshotUpdate) SetName(s string) *PlaylistSnapshotUpdate {
+	psu.mutation.SetName(s)
+	return psu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (psu *PlaylistSnapshotUpdate) SetNillableName(s *string) *PlaylistSnapshotUpdate {
+	if s != nil {
+		psu.SetName(*s)
+	}
+	return psu
+}
+
+// SetDescription sets the "description" field.
+func (psu *PlaylistSnapshotUpdate) SetDescription(s string) *PlaylistSnapshotUpdate {
+	psu.mutation.SetDescription(s)
+	return psu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (psu *PlaylistSnapshotUpdate) SetNillableDescription(s *string) *PlaylistSnapshotUpdate {
+	if s != nil {
+		psu.SetDescription(*s)
+	}
+	return psu
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (psu *PlaylistSnapshotUpdate) SetOwnerID(s string) *PlaylistSnapshotUpdate {
+	psu.mutation.SetOwnerID(s)
+	return psu
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (psu *PlaylistSnapshotUpdate) SetNillableOwnerID(s *string) *PlaylistSnapshotUpdate {
+	if s != nil {
+		psu.SetOwnerID(*s)
+	}
+	return psu
+}
+
+// SetOwnerName sets the "owner_name" field.
+func (psu *PlaylistSnapshotUpdate) SetOwnerName(s string) *PlaylistSnapshotUpdate {
+	psu.mutation.SetOwnerName(s)
+	return psu
+}
+
+// SetNillableOwnerName sets the "owner_name" field if the given value is not nil.
+func (psu *PlaylistSnapshotUpdate) SetNillableOwnerName(s *string) *PlaylistSnapshotUpdate {
+	if s != nil {
+		psu.SetOwnerName(*s)
+	}
+	return psu
+}
+
+// SetCollaborative sets the "collaborative" field.
+func (psu *PlaylistSnapshotUpdate) SetCollaborative(b bool) *PlaylistSnapshotUpdate {
+	psu.mutation.SetCollaborative(b)
+	return psu
+}
+
+// SetNillableCollaborative sets the "collaborative" field if the given value is not nil.
+func (psu *PlaylistSnapshotUpdate) SetNillableCollaborative(b *bool) *PlaylistSnapshotUpdate {
+	if b != nil {
+		psu.SetCollaborative(*b)
+	}
+	return psu
+}
+
+// SetPublic sets the "public" field.
+func (psu *PlaylistSnapshotUpdate) SetPublic(b bool) *PlaylistSnapshotUpdate {
+	psu.mutation.SetPublic(b)
+	return psu
+}
+
+// SetNillablePublic sets the "public" field if the given value is not nil.
+func (psu *PlaylistSnapshotUpdate) SetNillablePublic(b *bool) *PlaylistSnapshotUpdate {
+	if b != nil {
+		psu.SetPublic(*b)
+	}
+	return psu
+}
+
+// ClearPublic clears the value of the "public" field.
+func (psu *PlaylistSnapshotUpdate) ClearPublic() *PlaylistSnapshotUpdate {
+	psu.mutation.ClearPublic()
+	return psu
+}
+
+// SetSnapshotID sets the "snapshot_id" field.
+func (psu *PlaylistSnapshotUpdate) SetSnapshotID(s string) *PlaylistSnapshotUpdate {
+	psu.mutation.SetSnapshotID(s)
+	return psu
+}
+
+// SetNillableSnapshotID sets the "snapshot_id" field if the given value is not nil.
+func (psu *PlaylistSnapshotUpdate) SetNillableSnapshotID(s *string) *PlaylistSnapshotUpdate {
+	if s != nil {
+		psu.SetSnapshotID(*s)
+	}
+	return psu
+}
+
+// SetTotal sets the "total" field.
+func (psu *PlaylistSnapshotUpdate) SetTotal(i int) *PlaylistSnapshotUpdate {
+	psu.mutation.ResetTotal()
+	psu.mutation.SetTotal(i)
+	return psu
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (psu *PlaylistSnapshotUpdate) SetNillableTotal(i *int) *PlaylistSnapshotUpdate {
+	if i != nil {
+		psu.SetTotal(*i)
+	}
+	return psu
+}
+
+// AddTotal adds i to the "total" field.
+func (psu *PlaylistSnapshotUpdate) AddTotal(i int) *PlaylistSnapshotUpdate {
+	psu.mutation.AddTotal(i)
+	return psu
+}
+
+// SetComplete sets the "complete" field.
+func (psu *PlaylistSnapshotUpdate) SetComplete(b bool) *PlaylistSnapshotUpdate {
+	psu.mutation.SetComplete(b)
+	return psu
+}
+
+// SetNillableComplete sets the "complete" field if the given value is not nil.
+func (psu *PlaylistSnapshotUpdate) SetNillableComplete(b *bool) *PlaylistSnapshotUpdate {
+	if b != nil {
+		psu.SetComplete(*b)
+	}
+	return psu
+}
+
+// SetPinned sets the "pinned" field.
+func (psu *PlaylistSnapshotUpdate) SetPinned(b bool) *PlaylistSnapshotUpdate {
+	psu.mutation.SetPinned(b)
+	return psu
+}
+
+// SetNillablePinned sets the "pinned" field if the given value is not nil.
+func (psu *PlaylistSnapshotUpdate) SetNillablePinned(b *bool) *PlaylistSnapshotUpdate {
+	if b != nil {
+		psu.SetPinned(*b)
+	}
+	return psu
+}
+
+// SetNote sets the "note" field.
+func (psu *PlaylistSnapshotUpdate) SetNote(s string) *PlaylistSnapshotUpdate {
+	psu.mutation.SetNote(s)
+	return psu
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (psu *PlaylistSnapshotUpdate) SetNillableNote(s *string) *PlaylistSnapshotUpdate {
+	if s != nil {
+		psu.SetNote(*s)
+	}
+	return psu
+}
+
+// SetLabels sets the "labels" field.
+func (psu *PlaylistSnapshotUpdate) SetLabels(s []string) *PlaylistSnapshotUpdate {
+	psu.mutation.SetLabels(s)
+	return psu
+}
+
+// AppendLabels appends s to the "labels" field.
+func (psu *PlaylistSnapshotUpdate) AppendLabels(s []string) *PlaylistSnapshotUpdate {
+	psu.mutation.AppendLabels(s)
+	return psu
+}
+
+// SetPlaylistID sets the "playlist" edge to the Playlist entity by ID.
+func (psu *PlaylistSnapshotUpdate) SetPlaylistID(id string) *PlaylistSnapshotUpdate {
+	psu.mutation.SetPlaylistID(id)
+	return psu
+}
+
+// SetPlaylist sets the "playlist" edge to the Playlist entity.
+func (psu *PlaylistSnapshotUpdate) SetPlaylist(p *Playlist) *PlaylistSnapshotUpdate {
+	return psu.SetPlaylistID(p.ID)
+}
+
+// AddRunIDs adds the "runs" edge to the BackupRun entity by IDs.
+func (psu *PlaylistSnapshotUpdate) AddRunIDs(ids ...string) *PlaylistSnapshotUpdate {
+	psu.mutation.AddRunIDs(ids...)
+	return psu
+}
+
+// AddRuns adds the "runs" edges to the BackupRun entity.
+func (psu *PlaylistSnapshotUpdate) AddRuns(b ...*BackupRun) *PlaylistSnapshotUpdate {
+	ids := make([]string, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return psu.AddRunIDs(ids...)
+}
+
+// AddItemIDs adds the "items" edge to the SnapshotItem entity by IDs.
+func (psu *PlaylistSnapshotUpdate) AddItemIDs(ids ...string) *PlaylistSnapshotUpdate {
+	psu.mutation.AddItemIDs(ids...)
+	return psu
+}
+
+// AddItems adds the "items" edges to the SnapshotItem entity.
+func (psu *PlaylistSnapshotUpdate) AddItems(s ...*SnapshotItem) *PlaylistSnapshotUpdate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return psu.AddItemIDs(ids...)
+}
+
+// Mutation returns the PlaylistSnapshotMutation object of the builder.
+func (psu *PlaylistSnapshotUpdate) Mutation() *PlaylistSnapshotMutation {
+	return psu.mutation
+}
+
+// ClearPlaylist clears the "playlist" edge to the Playlist entity.
+func (psu *PlaylistSnapshotUpdate) ClearPlaylist() *PlaylistSnapshotUpdate {
+	psu.mutation.ClearPlaylist()
+	return psu
+}
+
+// ClearRuns clears all "runs" edges to the BackupRun entity.
+func (psu *PlaylistSnapshotUpdate) ClearRuns() *PlaylistSnapshotUpdate {
+	psu.mutation.ClearRuns()
+	return psu
+}
+
+// RemoveRunIDs removes the "runs" edge to BackupRun entities by IDs.
+func (psu *PlaylistSnapshotUpdate) RemoveRunIDs(ids ...string) *PlaylistSnapshotUpdate {
+	psu.mutation.RemoveRunIDs(ids...)
+	return psu
+}
+
+// RemoveRuns removes "runs" edges to BackupRun entities.
+func (psu *PlaylistSnapshotUpdate) RemoveRuns(b ...*BackupRun) *PlaylistSnapshotUpdate {
+	ids := make([]string, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return psu.RemoveRunIDs(ids...)
+}
+
+// ClearItems clears all "items" edges to the SnapshotItem entity.
+func (psu *PlaylistSnapshotUpdate) ClearItems() *PlaylistSnapshotUpdate {
+	psu.mutation.ClearItems()
+	return psu
+}
+
+// RemoveItemIDs removes the "items" edge to SnapshotItem entities by IDs.
+func (psu *PlaylistSnapshotUpdate) RemoveItemIDs(ids ...string) *PlaylistSnapshotUpdate {
+	psu.mutation.RemoveItemIDs(ids...)
+	return psu
+}
+
+// RemoveItems removes "items" edges to SnapshotItem entities.
+func (psu *PlaylistSnapshotUpdate) RemoveItems(s ...*SnapshotItem) *PlaylistSnapshotUpdate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return psu.RemoveItemIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (psu *PlaylistSnapshotUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, psu.sqlSave, psu.mutation, psu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (psu *PlaylistSnapshotUpdate) SaveX(ctx context.Context) int {
+	affected, err := psu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (psu *PlaylistSnapshotUpdate) Exec(ctx context.Context) error {
+	_, err := psu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (psu *PlaylistSnapshotUpdate) ExecX(ctx context.Context) {
+	if err := psu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (psu *PlaylistSnapshotUpdate) check() error {
+	if v, ok := psu.mutation.Total(); ok {
+		if err := playlistsnapshot.TotalValidator(v); err != nil {
+			return &ValidationError{Name: "total", err: fmt.Errorf(`ent: validator failed for field "PlaylistSnapshot.total": %w`, err)}
+		}
+	}
+	if psu.mutation.PlaylistCleared() && len(psu.mutation.PlaylistIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PlaylistSnapshot.playlist"`)
+	}
+	return nil
+}
+
+func (psu *PlaylistSnapshotUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := psu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(playlistsnapshot.Table, playlistsnapshot.Columns, sqlgraph.NewFieldSpec(playlistsnapshot.FieldID, field.TypeString))
+	if ps := psu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := psu.mutation.Name(); ok {
+		_spec.SetField(playlistsnapshot.FieldName, field.TypeString, value)
+	}
+	if value, ok := psu.mutation.Description(); ok {
+		_spec.SetField(playlistsnapshot.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := psu.mutation.OwnerID(); ok {
+		_spec.SetField(playlistsnapshot.FieldOwnerID, field.TypeString, value)
+	}
+	if value, ok := psu.mutation.OwnerName(); ok {
+		_spec.SetField(playlistsnapshot.FieldOwnerName, field.TypeString, value)
+	}
+	if value, ok := psu.mutation.Collaborative(); ok {
+		_spec.SetField(playlistsnapshot.FieldCollaborative, field.TypeBool, value)
+	}
+	if value, ok := psu.mutation.Public(); ok {
+		_spec.SetField(playlistsnapshot.FieldPublic, field.TypeBool, value)
+	}
+	if psu.mutation.PublicCleared() {
+		_spec.ClearField(playlistsnapshot.FieldPublic, field.TypeBool)
+	}
+	if value, ok := psu.mutation.SnapshotID(); ok {
+		_spec.SetField(playlistsnapshot.FieldSnapshotID, field.TypeString, value)
+	}
+	if value, ok := psu.mutation.Total(); ok {
+		_spec.SetField(playlistsnapshot.FieldTotal, field.TypeInt, value)
+	}
+	if value, ok := psu.mutation.AddedTotal(); ok {
+		_spec.AddField(playlistsnapshot.FieldTotal, field.TypeInt, value)
+	}
+	if value, ok := psu.mutation.Complete(); ok {
+		_spec.SetField(playlistsnapshot.FieldComplete, field.TypeBool, value)
+	}
+	if value, ok := psu.mutation.Pinned(); ok {
+		_spec.SetField(playlistsnapshot.FieldPinned, field.TypeBool, value)
+	}
+	if value, ok := psu.mutation.Note(); ok {
+		_spec.SetField(playlistsnapshot.FieldNote, field.TypeString, value)
+	}
+	if value, ok := psu.mutation.Labels(); ok {
+		_spec.SetField(playlistsnapshot.FieldLabels, field.TypeJSON, value)
+	}
+	if value, ok := psu.mutation.AppendedLabels(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, playlistsnapshot.FieldLabels, value)
+		})
+	}
+	if psu.mutation.PlaylistCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playlistsnapshot.PlaylistTable,
+			Columns: []string{playlistsnapshot.PlaylistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := psu.mutation.PlaylistIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playlistsnapshot.PlaylistTable,
+			Columns: []string{playlistsnapshot.PlaylistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if psu.mutation.RunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   playlistsnapshot.RunsTable,
+			Columns: playlistsnapshot.RunsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := psu.mutation.RemovedRunsIDs(); len(nodes) > 0 && !psu.mutation.RunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   playlistsnapshot.RunsTable,
+			Columns: playlistsnapshot.RunsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := psu.mutation.RunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   playlistsnapshot.RunsTable,
+			Columns: playlistsnapshot.RunsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if psu.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   playlistsnapshot.ItemsTable,
+			Columns: []string{playlistsnapshot.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshotitem.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := psu.mutation.RemovedItemsIDs(); len(nodes) > 0 && !psu.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   playlistsnapshot.ItemsTable,
+			Columns: []string{playlistsnapshot.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshotitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := psu.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   playlistsnapshot.ItemsTable,
+			Columns: []string{playlistsnapshot.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshotitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, psu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{playlistsnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	psu.mutation.done = true
+	return n, nil
+}
+
+// PlaylistSnapshotUpdateOne is the builder for updating a single PlaylistSnapshot entity.
+type PlaylistSnapshotUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PlaylistSnapshotMutation
+}
+
+// SetName sets the "name" field.
+func (psuo *PlaylistSnapshotUpdateOne) SetName(s string) *PlaylistSnapshotUpdateOne {
+	psuo.mutation.SetName(s)
+	return psuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (psuo *PlaylistSnapshotUpdateOne) SetNillableName(s *string) *PlaylistSnapshotUpdateOne {
+	if s != nil {
+		psuo.SetName(*s)
+	}
+	return psuo
+}
+
+// SetDescription sets the "description" field.
+func (psuo *PlaylistSnapshotUpdateOne) SetDescription(s string) *PlaylistSnapshotUpdateOne {
+	psuo.mutation.SetDescription(s)
+	return psuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (psuo *PlaylistSnapshotUpdateOne) SetNillableDescription(s *string) *PlaylistSnapshotUpdateOne {
+	if s != nil {
+		psuo.SetDescription(*s)
+	}
+	return psuo
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (psuo *PlaylistSnapshotUpdateOne) SetOwnerID(s string) *PlaylistSnapshotUpdateOne {
+	psuo.mutation.SetOwnerID(s)
+	return psuo
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (psuo *PlaylistSnapshotUpdateOne) SetNillableOwnerID(s *string) *PlaylistSnapshotUpdateOne {
+	if s != nil {
+		psuo.SetOwnerID(*s)
+	}
+	return psuo
+}
+
+// SetOwnerName sets the "owner_name" field.
+func (psuo *PlaylistSnapshotUpdateOne) SetOwnerName(s string) *PlaylistSnapshotUpdateOne {
+	psuo.mutation.SetOwnerName(s)
+	return psuo
+}
+
+// SetNillableOwnerName sets the "owner_name" field if the given value is not nil.
+func (psuo *PlaylistSnapshotUpdateOne) SetNillableOwnerName(s *string) *PlaylistSnapshotUpdateOne {
+	if s != nil {
+		psuo.SetOwnerName(*s)
+	}
+	return psuo
+}
+
+// SetCollaborative sets the "collaborative" field.
+func (psuo *PlaylistSnapshotUpdateOne) SetCollaborative(b bool) *PlaylistSnapshotUpdateOne {
+	psuo.mutation.SetCollaborative(b)
+	return psuo
+}
+
+// SetNillableCollaborative sets the "collaborative" field if the given value is not nil.
+func (psuo *PlaylistSnapshotUpdateOne) SetNillableCollaborative(b *bool) *PlaylistSnapshotUpdateOne {
+	if b != nil {
+		psuo.SetCollaborative(*b)
+	}
+	return psuo
+}
+
+// SetPublic sets the "public" field.
+func (psuo *PlaylistSnapshotUpdateOne) SetPublic(b bool) *PlaylistSnapshotUpdateOne {
+	psuo.mutation.SetPublic(b)
+	return psuo
+}
+
+// SetNillablePublic sets the "public" field if the given value is not nil.
+func (psuo *PlaylistSnapshotUpdateOne) SetNillablePublic(b *bool) *PlaylistSnapshotUpdateOne {
+	if b != nil {
+		psuo.SetPublic(*b)
+	}
+	return psuo
+}
+
+// ClearPublic clears the value of the "public" field.
+func (psuo *PlaylistSnapshotUpdateOne) ClearPublic() *PlaylistSnapshotUpdateOne {
+	psuo.mutation.ClearPublic()
+	return psuo
+}
+
+// SetSnapshotID sets the "snapshot_id" field.
+func (psuo *PlaylistSnapshotUpdateOne) SetSnapshotID(s string) *PlaylistSnapshotUpdateOne {
+	psuo.mutation.SetSnapshotID(s)
+	return psuo
+}
+
+// SetNillableSnapshotID sets the "snapshot_id" field if the given value is not nil.
+func (psuo *PlaylistSnapshotUpdateOne) SetNillableSnapshotID(s *string) *PlaylistSnapshotUpdateOne {
+	if s != nil {
+		psuo.SetSnapshotID(*s)
+	}
+	return psuo
+}
+
+// SetTotal sets the "total" field.
+func (psuo *PlaylistSnapshotUpdateOne) SetTotal(i int) *PlaylistSnapshotUpdateOne {
+	psuo.mutation.ResetTotal()
+	psuo.mutation.SetTotal(i)
+	return psuo
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (psuo *PlaylistSnapshotUpdateOne) SetNillableTotal(i *int) *PlaylistSnapshotUpdateOne {
+	if i != nil {
+		psuo.SetTotal(*i)
+	}
+	return psuo
+}
+
+// AddTotal adds i to the "total" field.
+func (psuo *PlaylistSnapshotUpdateOne) AddTotal(i int) *PlaylistSnapshotUpdateOne {
+	psuo.mutation.AddTotal(i)
+	return psuo
+}
+
+// SetComplete sets the "complete" field.
+func (psuo *PlaylistSnapshotUpdateOne) SetComplete(b bool) *PlaylistSnapshotUpdateOne {
+	psuo.mutation.SetComplete(b)
+	return psuo
+}
+
+// SetNillableComplete sets the "complete" field if the given value is not nil.
+func (psuo *PlaylistSnapshotUpdateOne) SetNillableComplete(b *bool) *PlaylistSnapshotUpdateOne {
+	if b != nil {
+		psuo.SetComplete(*b)
+	}
+	return psuo
+}
+
+// SetPinned sets the "pinned" field.
+func (psuo *PlaylistSnapshotUpdateOne) SetPinned(b bool) *PlaylistSnapshotUpdateOne {
+	psuo.mutation.SetPinned(b)
+	return psuo
+}
+
+// SetNillablePinned sets the "pinned" field if the given value is not nil.
+func (psuo *PlaylistSnapshotUpdateOne) SetNillablePinned(b *bool) *PlaylistSnapshotUpdateOne {
+	if b != nil {
+		psuo.SetPinned(*b)
+	}
+	return psuo
+}
+
+// SetNote sets the "note" field.
+func (psuo *PlaylistSnapshotUpdateOne) SetNote(s string) *PlaylistSnapshotUpdateOne {
+	psuo.mutation.SetNote(s)
+	return psuo
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (psuo *PlaylistSnapshotUpdateOne) SetNillableNote(s *string) *PlaylistSnapshotUpdateOne {
+	if s != nil {
+		psuo.SetNote(*s)
+	}
+	return psuo
+}
+
+// SetLabels sets the "labels" field.
+func (psuo *PlaylistSnapshotUpdateOne) SetLabels(s []string) *PlaylistSnapshotUpdateOne {
+	psuo.mutation.SetLabels(s)
+	return psuo
+}
+
+// AppendLabels appends s to the "labels" field.
+func (psuo *PlaylistSnapshotUpdateOne) AppendLabels(s []string) *PlaylistSnapshotUpdateOne {
+	psuo.mutation.AppendLabels(s)
+	return psuo
+}
+
+// SetPlaylistID sets the "playlist" edge to the Playlist entity by ID.
+func (psuo *PlaylistSnapshotUpdateOne) SetPlaylistID(id string) *PlaylistSnapshotUpdateOne {
+	psuo.mutation.SetPlaylistID(id)
+	return psuo
+}
+
+// SetPlaylist sets the "playlist" edge to the Playlist entity.
+func (psuo *PlaylistSnapshotUpdateOne) SetPlaylist(p *Playlist) *PlaylistSnapshotUpdateOne {
+	return psuo.SetPlaylistID(p.ID)
+}
+
+// AddRunIDs adds the "runs" edge to the BackupRun entity by IDs.
+func (psuo *PlaylistSnapshotUpdateOne) AddRunIDs(ids ...string) *PlaylistSnapshotUpdateOne {
+	psuo.mutation.AddRunIDs(ids...)
+	return psuo
+}
+
+// AddRuns adds the "runs" edges to the BackupRun entity.
+func (psuo *PlaylistSnapshotUpdateOne) AddRuns(b ...*BackupRun) *PlaylistSnapshotUpdateOne {
+	ids := make([]string, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return psuo.AddRunIDs(ids...)
+}
+
+// AddItemIDs adds the "items" edge to the SnapshotItem entity by IDs.
+func (psuo *PlaylistSnapshotUpdateOne) AddItemIDs(ids ...string) *PlaylistSnapshotUpdateOne {
+	psuo.mutation.AddItemIDs(ids...)
+	return psuo
+}
+
+// AddItems adds the "items" edges to the SnapshotItem entity.
+func (psuo *PlaylistSnapshotUpdateOne) AddItems(s ...*SnapshotItem) *PlaylistSnapshotUpdateOne {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return psuo.AddItemIDs(ids...)
+}
+
+// Mutation returns the PlaylistSnapshotMutation object of the builder.
+func (psuo *PlaylistSnapshotUpdateOne) Mutation() *PlaylistSnapshotMutation {
+	return psuo.mutation
+}
+
+// ClearPlaylist clears the "playlist" edge to the Playlist entity.
+func (psuo *PlaylistSnapshotUpdateOne) ClearPlaylist() *PlaylistSnapshotUpdateOne {
+	psuo.mutation.ClearPlaylist()
+	return psuo
+}
+
+// ClearRuns clears all "runs" edges to the BackupRun entity.
+func (psuo *PlaylistSnapshotUpdateOne) ClearRuns() *PlaylistSnapshotUpdateOne {
+	psuo.mutation.ClearRuns()
+	return psuo
+}
+
+// RemoveRunIDs removes the "runs" edge to BackupRun entities by IDs.
+func (psuo *PlaylistSnapshotUpdateOne) RemoveRunIDs(ids ...string) *PlaylistSnapshotUpdateOne {
+	psuo.mutation.RemoveRunIDs(ids...)
+	return psuo
+}
+
+// RemoveRuns removes "runs" edges to BackupRun entities.
+func (psuo *PlaylistSnapshotUpdateOne) RemoveRuns(b ...*BackupRun) *PlaylistSnapshotUpdateOne {
+	ids := make([]string, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return psuo.RemoveRunIDs(ids...)
+}
+
+// ClearItems clears all "items" edges to the SnapshotItem entity.
+func (psuo *PlaylistSnapshotUpdateOne) ClearItems() *PlaylistSnapshotUpdateOne {
+	psuo.mutation.ClearItems()
+	return psuo
+}
+
+// RemoveItemIDs removes the "items" edge to SnapshotItem entities by IDs.
+func (psuo *PlaylistSnapshotUpdateOne) RemoveItemIDs(ids ...string) *PlaylistSnapshotUpdateOne {
+	psuo.mutation.RemoveItemIDs(ids...)
+	return psuo
+}
+
+// RemoveItems removes "items" edges to SnapshotItem entities.
+func (psuo *PlaylistSnapshotUpdateOne) RemoveItems(s ...*SnapshotItem) *PlaylistSnapshotUpdateOne {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return psuo.RemoveItemIDs(ids...)
+}
+
+// Where appends a list predicates to the PlaylistSnapshotUpdate builder.
+func (psuo *PlaylistSnapshotUpdateOne) Where(ps ...predicate.PlaylistSnapshot) *PlaylistSnapshotUpdateOne {
+	psuo.mutation.Where(ps...)
+	return psuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (psuo *PlaylistSnapshotUpdateOne) Select(field string, fields ...string) *PlaylistSnapshotUpdateOne {
+	psuo.fields = append([]string{field}, fields...)
+	return psuo
+}
+
+// Save executes the query and returns the updated PlaylistSnapshot entity.
+func (psuo *PlaylistSnapshotUpdateOne) Save(ctx context.Context) (*PlaylistSnapshot, error) {
+	return withHooks(ctx, psuo.sqlSave, psuo.mutation, psuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (psuo *PlaylistSnapshotUpdateOne) SaveX(ctx context.Context) *PlaylistSnapshot {
+	node, err := psuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (psuo *PlaylistSnapshotUpdateOne) Exec(ctx context.Context) error {
+	_, err := psuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (psuo *PlaylistSnapshotUpdateOne) ExecX(ctx context.Context) {
+	if err := psuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (psuo *PlaylistSnapshotUpdateOne) check() error {
+	if v, ok := psuo.mutation.Total(); ok {
+		if err := playlistsnapshot.TotalValidator(v); err != nil {
+			return &ValidationError{Name: "total", err: fmt.Errorf(`ent: validator failed for field "PlaylistSnapshot.total": %w`, err)}
+		}
+	}
+	if psuo.mutation.PlaylistCleared() && len(psuo.mutation.PlaylistIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PlaylistSnapshot.playlist"`)
+	}
+	return nil
+}
+
+func (psuo *PlaylistSnapshotUpdateOne) sqlSave(ctx context.Context) (_node *PlaylistSnapshot, err error) {
+	if err := psuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(playlistsnapshot.Table, playlistsnapshot.Columns, sqlgraph.NewFieldSpec(playlistsnapshot.FieldID, field.TypeString))
+	id, ok := psuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PlaylistSnapshot.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := psuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, playlistsnapshot.FieldID)
+		for _, f := range fields {
+			if !playlistsnapshot.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != playlistsnapshot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := psuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := psuo.mutation.Name(); ok {
+		_spec.SetField(playlistsnapshot.FieldName, field.TypeString, value)
+	}
+	if value, ok := psuo.mutation.Description(); ok {
+		_spec.SetField(playlistsnapshot.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := psuo.mutation.OwnerID(); ok {
+		_spec.SetField(playlistsnapshot.FieldOwnerID, field.TypeString, value)
+	}
+	if value, ok := psuo.mutation.OwnerName(); ok {
+		_spec.SetField(playlistsnapshot.FieldOwnerName, field.TypeString, value)
+	}
+	if value, ok := psuo.mutation.Collaborative(); ok {
+		_spec.SetField(playlistsnapshot.FieldCollaborative, field.TypeBool, value)
+	}
+	if value, ok := psuo.mutation.Public(); ok {
+		_spec.SetField(playlistsnapshot.FieldPublic, field.TypeBool, value)
+	}
+	if psuo.mutation.PublicCleared() {
+		_spec.ClearField(playlistsnapshot.FieldPublic, field.TypeBool)
+	}
+	if value, ok := psuo.mutation.SnapshotID(); ok {
+		_spec.SetField(playlistsnapshot.FieldSnapshotID, field.TypeString, value)
+	}
+	if value, ok := psuo.mutation.Total(); ok {
+		_spec.SetField(playlistsnapshot.FieldTotal, field.TypeInt, value)
+	}
+	if value, ok := psuo.mutation.AddedTotal(); ok {
+		_spec.AddField(playlistsnapshot.FieldTotal, field.TypeInt, value)
+	}
+	if value, ok := psuo.mutation.Complete(); ok {
+		_spec.SetField(playlistsnapshot.FieldComplete, field.TypeBool, value)
+	}
+	if value, ok := psuo.mutation.Pinned(); ok {
+		_spec.SetField(playlistsnapshot.FieldPinned, field.TypeBool, value)
+	}
+	if value, ok := psuo.mutation.Note(); ok {
+		_spec.SetField(playlistsnapshot.FieldNote, field.TypeString, value)
+	}
+	if value, ok := psuo.mutation.Labels(); ok {
+		_spec.SetField(playlistsnapshot.FieldLabels, field.TypeJSON, value)
+	}
+	if value, ok := psuo.mutation.AppendedLabels(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, playlistsnapshot.FieldLabels, value)
+		})
+	}
+	if psuo.mutation.PlaylistCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playlistsnapshot.PlaylistTable,
+			Columns: []string{playlistsnapshot.PlaylistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := psuo.mutation.PlaylistIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playlistsnapshot.PlaylistTable,
+			Columns: []string{playlistsnapshot.PlaylistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if psuo.mutation.RunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   playlistsnapshot.RunsTable,
+			Columns: playlistsnapshot.RunsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := psuo.mutation.RemovedRunsIDs(); len(nodes) > 0 && !psuo.mutation.RunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   playlistsnapshot.RunsTable,
+			Columns: playlistsnapshot.RunsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := psuo.mutation.RunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   playlistsnapshot.RunsTable,
+			Columns: playlistsnapshot.RunsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if psuo.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   playlistsnapshot.ItemsTable,
+			Columns: []string{playlistsnapshot.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshotitem.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := psuo.mutation.RemovedItemsIDs(); len(nodes) > 0 && !psuo.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   playlistsnapshot.ItemsTable,
+			Columns: []string{playlistsnapshot.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshotitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := psuo.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   playlistsnapshot.ItemsTable,
+			Columns: []string{playlistsnapshot.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshotitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PlaylistSnapshot{config: psuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, psuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{playlistsnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	psuo.mutation.done = true
+	return _node, nil
+}
@@ -6,5 +6,74 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Album is the predicate function for album builders.
+type Album func(*sql.Selector)
+
+// Artist is the predicate function for artist builders.
+type Artist func(*sql.Selector)
+
+// AuthState is the predicate function for authstate builders.
+type AuthState func(*sql.Selector)
+
+// BackupRun is the predicate function for backuprun builders.
+type BackupRun func(*sql.Selector)
+
+// BackupSchedule is the predicate function for backupschedule builders.
+type BackupSchedule func(*sql.Selector)
+
+// Episode is the predicate function for episode builders.
+type Episode func(*sql.Selector)
+
+// FollowedArtist is the predicate function for followedartist builders.
+type FollowedArtist func(*sql.Selector)
+
+// Job is the predicate function for job builders.
+type Job func(*sql.Selector)
+
+// Lease is the predicate function for lease builders.
+type Lease func(*sql.Selector)
+
+// Playlist is the predicate function for playlist builders.
+type Playlist func(*sql.Selector)
+
+// PlaylistSnapshot is the predicate function for playlistsnapshot builders.
+type PlaylistSnapshot func(*sql.Selector)
+
+// RestorePlan is the predicate function for restoreplan builders.
+type RestorePlan func(*sql.Selector)
+
+// RetentionPolicy is the predicate function for retentionpolicy builders.
+type RetentionPolicy func(*sql.Selector)
+
+// SavedAlbum is the predicate function for savedalbum builders.
+type SavedAlbum func(*sql.Selector)
+
+// SavedAudiobook is the predicate function for savedaudiobook builders.
+type SavedAudiobook func(*sql.Selector)
+
+// SavedEpisode is the predicate function for savedepisode builders.
+type SavedEpisode func(*sql.Selector)
+
+// SavedShow is the predicate function for savedshow builders.
+type SavedShow func(*sql.Selector)
+
+// SavedTrack is the predicate function for savedtrack builders.
+type SavedTrack func(*sql.Selector)
+
+// SchemaMigration is the predicate function for schemamigration builders.
+type SchemaMigration func(*sql.Selector)
+
+// Show is the predicate function for show builders.
+type Show func(*sql.Selector)
+
+// SnapshotItem is the predicate function for snapshotitem builders.
+type SnapshotItem func(*sql.Selector)
+
+// SpotifyToken is the predicate function for spotifytoken builders.
+type SpotifyToken func(*sql.Selector)
+
+// Track is the predicate function for track builders.
+type Track func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
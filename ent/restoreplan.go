@@ -0,0 +1,216 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/restoreplan"
+	"beyerleinf/spotify-backup/ent/schema/schematype"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// RestorePlan is the model entity for the RestorePlan schema.
+type RestorePlan struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// RunID holds the value of the "run_id" field.
+	RunID string `json:"run_id,omitempty"`
+	// Strategy holds the value of the "strategy" field.
+	Strategy restoreplan.Strategy `json:"strategy,omitempty"`
+	// Status holds the value of the "status" field.
+	Status restoreplan.Status `json:"status,omitempty"`
+	// Playlists holds the value of the "playlists" field.
+	Playlists []schematype.PlaylistRestore `json:"playlists,omitempty"`
+	// Collections holds the value of the "collections" field.
+	Collections []schematype.CollectionRestore `json:"collections,omitempty"`
+	// Drift holds the value of the "drift" field.
+	Drift []string `json:"drift,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// AppliedAt holds the value of the "applied_at" field.
+	AppliedAt    *time.Time `json:"applied_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RestorePlan) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case restoreplan.FieldPlaylists, restoreplan.FieldCollections, restoreplan.FieldDrift:
+			values[i] = new([]byte)
+		case restoreplan.FieldID, restoreplan.FieldUserID, restoreplan.FieldRunID, restoreplan.FieldStrategy, restoreplan.FieldStatus, restoreplan.FieldError:
+			values[i] = new(sql.NullString)
+		case restoreplan.FieldCreatedAt, restoreplan.FieldAppliedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RestorePlan fields.
+func (rp *RestorePlan) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case restoreplan.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				rp.ID = value.String
+			}
+		case restoreplan.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				rp.UserID = value.String
+			}
+		case restoreplan.FieldRunID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field run_id", values[i])
+			} else if value.Valid {
+				rp.RunID = value.String
+			}
+		case restoreplan.FieldStrategy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field strategy", values[i])
+			} else if value.Valid {
+				rp.Strategy = restoreplan.Strategy(value.String)
+			}
+		case restoreplan.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				rp.Status = restoreplan.Status(value.String)
+			}
+		case restoreplan.FieldPlaylists:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field playlists", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &rp.Playlists); err != nil {
+					return fmt.Errorf("unmarshal field playlists: %w", err)
+				}
+			}
+		case restoreplan.FieldCollections:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field collections", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &rp.Collections); err != nil {
+					return fmt.Errorf("unmarshal field collections: %w", err)
+				}
+			}
+		case restoreplan.FieldDrift:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field drift", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &rp.Drift); err != nil {
+					return fmt.Errorf("unmarshal field drift: %w", err)
+				}
+			}
+		case restoreplan.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				rp.Error = value.String
+			}
+		case restoreplan.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				rp.CreatedAt = value.Time
+			}
+		case restoreplan.FieldAppliedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field applied_at", values[i])
+			} else if value.Valid {
+				rp.AppliedAt = new(time.Time)
+				*rp.AppliedAt = value.Time
+			}
+		default:
+			rp.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RestorePlan.
+// This includes values selected through modifiers, order, etc.
+func (rp *RestorePlan) Value(name string) (ent.Value, error) {
+	return rp.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this RestorePlan.
+// Note that you need to call RestorePlan.Unwrap() before calling this method if this RestorePlan
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rp *RestorePlan) Update() *RestorePlanUpdateOne {
+	return NewRestorePlanClient(rp.config).UpdateOne(rp)
+}
+
+// Unwrap unwraps the RestorePlan entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rp *RestorePlan) Unwrap() *RestorePlan {
+	_tx, ok := rp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RestorePlan is not a transactional entity")
+	}
+	rp.config.driver = _tx.drv
+	return rp
+}
+
+// String implements the fmt.Stringer.
+func (rp *RestorePlan) String() string {
+	var builder strings.Builder
+	builder.WriteString("RestorePlan(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rp.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(rp.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("run_id=")
+	builder.WriteString(rp.RunID)
+	builder.WriteString(", ")
+	builder.WriteString("strategy=")
+	builder.WriteString(fmt.Sprintf("%v", rp.Strategy))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", rp.Status))
+	builder.WriteString(", ")
+	builder.WriteString("playlists=")
+	builder.WriteString(fmt.Sprintf("%v", rp.Playlists))
+	builder.WriteString(", ")
+	builder.WriteString("collections=")
+	builder.WriteString(fmt.Sprintf("%v", rp.Collections))
+	builder.WriteString(", ")
+	builder.WriteString("drift=")
+	builder.WriteString(fmt.Sprintf("%v", rp.Drift))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(rp.Error)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(rp.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := rp.AppliedAt; v != nil {
+		builder.WriteString("applied_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RestorePlans is a parsable slice of RestorePlan.
+type RestorePlans []*RestorePlan
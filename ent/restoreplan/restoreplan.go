@@ -0,0 +1,183 @@
+// Code generated by ent, DO NOT EDIT.
+
+package restoreplan
+
+import (
+	"beyerleinf/spotify-backup/ent/schema/schematype"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the restoreplan type in the database.
+	Label = "restore_plan"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldRunID holds the string denoting the run_id field in the database.
+	FieldRunID = "run_id"
+	// FieldStrategy holds the string denoting the strategy field in the database.
+	FieldStrategy = "strategy"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldPlaylists holds the string denoting the playlists field in the database.
+	FieldPlaylists = "playlists"
+	// FieldCollections holds the string denoting the collections field in the database.
+	FieldCollections = "collections"
+	// FieldDrift holds the string denoting the drift field in the database.
+	FieldDrift = "drift"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldAppliedAt holds the string denoting the applied_at field in the database.
+	FieldAppliedAt = "applied_at"
+	// Table holds the table name of the restoreplan in the database.
+	Table = "restore_plans"
+)
+
+// Columns holds all SQL columns for restoreplan fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldRunID,
+	FieldStrategy,
+	FieldStatus,
+	FieldPlaylists,
+	FieldCollections,
+	FieldDrift,
+	FieldError,
+	FieldCreatedAt,
+	FieldAppliedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	UserIDValidator func(string) error
+	// DefaultRunID holds the default value on creation for the "run_id" field.
+	DefaultRunID string
+	// DefaultPlaylists holds the default value on creation for the "playlists" field.
+	DefaultPlaylists []schematype.PlaylistRestore
+	// DefaultCollections holds the default value on creation for the "collections" field.
+	DefaultCollections []schematype.CollectionRestore
+	// DefaultDrift holds the default value on creation for the "drift" field.
+	DefaultDrift []string
+	// DefaultError holds the default value on creation for the "error" field.
+	DefaultError string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() string
+)
+
+// Strategy defines the type for the "strategy" enum field.
+type Strategy string
+
+// StrategyOverwrite is the default value of the Strategy enum.
+const DefaultStrategy = StrategyOverwrite
+
+// Strategy values.
+const (
+	StrategyOverwrite Strategy = "overwrite"
+	StrategyMerge     Strategy = "merge"
+	StrategyCopy      Strategy = "copy"
+)
+
+func (s Strategy) String() string {
+	return string(s)
+}
+
+// StrategyValidator is a validator for the "strategy" field enum values. It is called by the builders before save.
+func StrategyValidator(s Strategy) error {
+	switch s {
+	case StrategyOverwrite, StrategyMerge, StrategyCopy:
+		return nil
+	default:
+		return fmt.Errorf("restoreplan: invalid enum value for strategy field: %q", s)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending  Status = "pending"
+	StatusApplying Status = "applying"
+	StatusApplied  Status = "applied"
+	StatusFailed   Status = "failed"
+	StatusStale    Status = "stale"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusApplying, StatusApplied, StatusFailed, StatusStale:
+		return nil
+	default:
+		return fmt.Errorf("restoreplan: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the RestorePlan queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByRunID orders the results by the run_id field.
+func ByRunID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRunID, opts...).ToFunc()
+}
+
+// ByStrategy orders the results by the strategy field.
+func ByStrategy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStrategy, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByAppliedAt orders the results by the applied_at field.
+func ByAppliedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAppliedAt, opts...).ToFunc()
+}
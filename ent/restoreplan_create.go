@@ -0,0 +1,423 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/restoreplan"
+	"beyerleinf/spotify-backup/ent/schema/schematype"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RestorePlanCreate is the builder for creating a RestorePlan entity.
+type RestorePlanCreate struct {
+	config
+	mutation *RestorePlanMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (rpc *RestorePlanCreate) SetUserID(s string) *RestorePlanCreate {
+	rpc.mutation.SetUserID(s)
+	return rpc
+}
+
+// SetRunID sets the "run_id" field.
+func (rpc *RestorePlanCreate) SetRunID(s string) *RestorePlanCreate {
+	rpc.mutation.SetRunID(s)
+	return rpc
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (rpc *RestorePlanCreate) SetNillableRunID(s *string) *RestorePlanCreate {
+	if s != nil {
+		rpc.SetRunID(*s)
+	}
+	return rpc
+}
+
+// SetStrategy sets the "strategy" field.
+func (rpc *RestorePlanCreate) SetStrategy(r restoreplan.Strategy) *RestorePlanCreate {
+	rpc.mutation.SetStrategy(r)
+	return rpc
+}
+
+// SetNillableStrategy sets the "strategy" field if the given value is not nil.
+func (rpc *RestorePlanCreate) SetNillableStrategy(r *restoreplan.Strategy) *RestorePlanCreate {
+	if r != nil {
+		rpc.SetStrategy(*r)
+	}
+	return rpc
+}
+
+// SetStatus sets the "status" field.
+func (rpc *RestorePlanCreate) SetStatus(r restoreplan.Status) *RestorePlanCreate {
+	rpc.mutation.SetStatus(r)
+	return rpc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rpc *RestorePlanCreate) SetNillableStatus(r *restoreplan.Status) *RestorePlanCreate {
+	if r != nil {
+		rpc.SetStatus(*r)
+	}
+	return rpc
+}
+
+// SetPlaylists sets the "playlists" field.
+func (rpc *RestorePlanCreate) SetPlaylists(sr []schematype.PlaylistRestore) *RestorePlanCreate {
+	rpc.mutation.SetPlaylists(sr)
+	return rpc
+}
+
+// SetCollections sets the "collections" field.
+func (rpc *RestorePlanCreate) SetCollections(sr []schematype.CollectionRestore) *RestorePlanCreate {
+	rpc.mutation.SetCollections(sr)
+	return rpc
+}
+
+// SetDrift sets the "drift" field.
+func (rpc *RestorePlanCreate) SetDrift(s []string) *RestorePlanCreate {
+	rpc.mutation.SetDrift(s)
+	return rpc
+}
+
+// SetError sets the "error" field.
+func (rpc *RestorePlanCreate) SetError(s string) *RestorePlanCreate {
+	rpc.mutation.SetError(s)
+	return rpc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (rpc *RestorePlanCreate) SetNillableError(s *string) *RestorePlanCreate {
+	if s != nil {
+		rpc.SetError(*s)
+	}
+	return rpc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rpc *RestorePlanCreate) SetCreatedAt(t time.Time) *RestorePlanCreate {
+	rpc.mutation.SetCreatedAt(t)
+	return rpc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rpc *RestorePlanCreate) SetNillableCreatedAt(t *time.Time) *RestorePlanCreate {
+	if t != nil {
+		rpc.SetCreatedAt(*t)
+	}
+	return rpc
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (rpc *RestorePlanCreate) SetAppliedAt(t time.Time) *RestorePlanCreate {
+	rpc.mutation.SetAppliedAt(t)
+	return rpc
+}
+
+// SetNillableAppliedAt sets the "applied_at" field if the given value is not nil.
+func (rpc *RestorePlanCreate) SetNillableAppliedAt(t *time.Time) *RestorePlanCreate {
+	if t != nil {
+		rpc.SetAppliedAt(*t)
+	}
+	return rpc
+}
+
+// SetID sets the "id" field.
+func (rpc *RestorePlanCreate) SetID(s string) *RestorePlanCreate {
+	rpc.mutation.SetID(s)
+	return rpc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (rpc *RestorePlanCreate) SetNillableID(s *string) *RestorePlanCreate {
+	if s != nil {
+		rpc.SetID(*s)
+	}
+	return rpc
+}
+
+// Mutation returns the RestorePlanMutation object of the builder.
+func (rpc *RestorePlanCreate) Mutation() *RestorePlanMutation {
+	return rpc.mutation
+}
+
+// Save creates the RestorePlan in the database.
+func (rpc *RestorePlanCreate) Save(ctx context.Context) (*RestorePlan, error) {
+	rpc.defaults()
+	return withHooks(ctx, rpc.sqlSave, rpc.mutation, rpc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rpc *RestorePlanCreate) SaveX(ctx context.Context) *RestorePlan {
+	v, err := rpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rpc *RestorePlanCreate) Exec(ctx context.Context) error {
+	_, err := rpc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpc *RestorePlanCreate) ExecX(ctx context.Context) {
+	if err := rpc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rpc *RestorePlanCreate) defaults() {
+	if _, ok := rpc.mutation.RunID(); !ok {
+		v := restoreplan.DefaultRunID
+		rpc.mutation.SetRunID(v)
+	}
+	if _, ok := rpc.mutation.Strategy(); !ok {
+		v := restoreplan.DefaultStrategy
+		rpc.mutation.SetStrategy(v)
+	}
+	if _, ok := rpc.mutation.Status(); !ok {
+		v := restoreplan.DefaultStatus
+		rpc.mutation.SetStatus(v)
+	}
+	if _, ok := rpc.mutation.Playlists(); !ok {
+		v := restoreplan.DefaultPlaylists
+		rpc.mutation.SetPlaylists(v)
+	}
+	if _, ok := rpc.mutation.Collections(); !ok {
+		v := restoreplan.DefaultCollections
+		rpc.mutation.SetCollections(v)
+	}
+	if _, ok := rpc.mutation.Drift(); !ok {
+		v := restoreplan.DefaultDrift
+		rpc.mutation.SetDrift(v)
+	}
+	if _, ok := rpc.mutation.Error(); !ok {
+		v := restoreplan.DefaultError
+		rpc.mutation.SetError(v)
+	}
+	if _, ok := rpc.mutation.CreatedAt(); !ok {
+		v := restoreplan.DefaultCreatedAt()
+		rpc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rpc.mutation.ID(); !ok {
+		v := restoreplan.DefaultID()
+		rpc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rpc *RestorePlanCreate) check() error {
+	if _, ok := rpc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "RestorePlan.user_id"`)}
+	}
+	if v, ok := rpc.mutation.UserID(); ok {
+		if err := restoreplan.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "RestorePlan.user_id": %w`, err)}
+		}
+	}
+	if _, ok := rpc.mutation.RunID(); !ok {
+		return &ValidationError{Name: "run_id", err: errors.New(`ent: missing required field "RestorePlan.run_id"`)}
+	}
+	if _, ok := rpc.mutation.Strategy(); !ok {
+		return &ValidationError{Name: "strategy", err: errors.New(`ent: missing required field "RestorePlan.strategy"`)}
+	}
+	if v, ok := rpc.mutation.Strategy(); ok {
+		if err := restoreplan.StrategyValidator(v); err != nil {
+			return &ValidationError{Name: "strategy", err: fmt.Errorf(`ent: validator failed for field "RestorePlan.strategy": %w`, err)}
+		}
+	}
+	if _, ok := rpc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "RestorePlan.status"`)}
+	}
+	if v, ok := rpc.mutation.Status(); ok {
+		if err := restoreplan.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "RestorePlan.status": %w`, err)}
+		}
+	}
+	if _, ok := rpc.mutation.Playlists(); !ok {
+		return &ValidationError{Name: "playlists", err: errors.New(`ent: missing required field "RestorePlan.playlists"`)}
+	}
+	if _, ok := rpc.mutation.Collections(); !ok {
+		return &ValidationError{Name: "collections", err: errors.New(`ent: missing required field "RestorePlan.collections"`)}
+	}
+	if _, ok := rpc.mutation.Drift(); !ok {
+		return &ValidationError{Name: "drift", err: errors.New(`ent: missing required field "RestorePlan.drift"`)}
+	}
+	if _, ok := rpc.mutation.Error(); !ok {
+		return &ValidationError{Name: "error", err: errors.New(`ent: missing required field "RestorePlan.error"`)}
+	}
+	if _, ok := rpc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RestorePlan.created_at"`)}
+	}
+	return nil
+}
+
+func (rpc *RestorePlanCreate) sqlSave(ctx context.Context) (*RestorePlan, error) {
+	if err := rpc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rpc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected RestorePlan.ID type: %T", _spec.ID.Value)
+		}
+	}
+	rpc.mutation.id = &_node.ID
+	rpc.mutation.done = true
+	return _node, nil
+}
+
+func (rpc *RestorePlanCreate) createSpec() (*RestorePlan, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RestorePlan{config: rpc.config}
+		_spec = sqlgraph.NewCreateSpec(restoreplan.Table, sqlgraph.NewFieldSpec(restoreplan.FieldID, field.TypeString))
+	)
+	if id, ok := rpc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := rpc.mutation.UserID(); ok {
+		_spec.SetField(restoreplan.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := rpc.mutation.RunID(); ok {
+		_spec.SetField(restoreplan.FieldRunID, field.TypeString, value)
+		_node.RunID = value
+	}
+	if value, ok := rpc.mutation.Strategy(); ok {
+		_spec.SetField(restoreplan.FieldStrategy, field.TypeEnum, value)
+		_node.Strategy = value
+	}
+	if value, ok := rpc.mutation.Status(); ok {
+		_spec.SetField(restoreplan.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := rpc.mutation.Playlists(); ok {
+		_spec.SetField(restoreplan.FieldPlaylists, field.TypeJSON, value)
+		_node.Playlists = value
+	}
+	if value, ok := rpc.mutation.Collections(); ok {
+		_spec.SetField(restoreplan.FieldCollections, field.TypeJSON, value)
+		_node.Collections = value
+	}
+	if value, ok := rpc.mutation.Drift(); ok {
+		_spec.SetField(restoreplan.FieldDrift, field.TypeJSON, value)
+		_node.Drift = value
+	}
+	if value, ok := rpc.mutation.Error(); ok {
+		_spec.SetField(restoreplan.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := rpc.mutation.CreatedAt(); ok {
+		_spec.SetField(restoreplan.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rpc.mutation.AppliedAt(); ok {
+		_spec.SetField(restoreplan.FieldAppliedAt, field.TypeTime, value)
+		_node.AppliedAt = &value
+	}
+	return _node, _spec
+}
+
+// RestorePlanCreateBulk is the builder for creating many RestorePlan entities in bulk.
+type RestorePlanCreateBulk struct {
+	config
+	err      error
+	builders []*RestorePlanCreate
+}
+
+// Save creates the RestorePlan entities in the database.
+func (rpcb *RestorePlanCreateBulk) Save(ctx context.Context) ([]*RestorePlan, error) {
+	if rpcb.err != nil {
+		return nil, rpcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rpcb.builders))
+	nodes := make([]*RestorePlan, len(rpcb.builders))
+	mutators := make([]Mutator, len(rpcb.builders))
+	for i := range rpcb.builders {
+		func(i int, root context.Context) {
+			builder := rpcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RestorePlanMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rpcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rpcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rpcb *RestorePlanCreateBulk) SaveX(ctx context.Context) []*RestorePlan {
+	v, err := rpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rpcb *RestorePlanCreateBulk) Exec(ctx context.Context) error {
+	_, err := rpcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpcb *RestorePlanCreateBulk) ExecX(ctx context.Context) {
+	if err := rpcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
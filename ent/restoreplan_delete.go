@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/predicate"
+	"beyerleinf/spotify-backup/ent/restoreplan"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RestorePlanDelete is the builder for deleting a RestorePlan entity.
+type RestorePlanDelete struct {
+	config
+	hooks    []Hook
+	mutation *RestorePlanMutation
+}
+
+// Where appends a list predicates to the RestorePlanDelete builder.
+func (rpd *RestorePlanDelete) Where(ps ...predicate.RestorePlan) *RestorePlanDelete {
+	rpd.mutation.Where(ps...)
+	return rpd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rpd *RestorePlanDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rpd.sqlExec, rpd.mutation, rpd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpd *RestorePlanDelete) ExecX(ctx context.Context) int {
+	n, err := rpd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rpd *RestorePlanDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(restoreplan.Table, sqlgraph.NewFieldSpec(restoreplan.FieldID, field.TypeString))
+	if ps := rpd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rpd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rpd.mutation.done = true
+	return affected, err
+}
+
+// RestorePlanDeleteOne is the builder for deleting a single RestorePlan entity.
+type RestorePlanDeleteOne struct {
+	rpd *RestorePlanDelete
+}
+
+// Where appends a list predicates to the RestorePlanDelete builder.
+func (rpdo *RestorePlanDeleteOne) Where(ps ...predicate.RestorePlan) *RestorePlanDeleteOne {
+	rpdo.rpd.mutation.Where(ps...)
+	return rpdo
+}
+
+// Exec executes the deletion query.
+func (rpdo *RestorePlanDeleteOne) Exec(ctx context.Context) error {
+	n, err := rpdo.rpd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{restoreplan.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpdo *RestorePlanDeleteOne) ExecX(ctx context.Context) {
+	if err := rpdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
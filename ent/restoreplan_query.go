@@ -0,0 +1,564 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/predicate"
+	"beyerleinf/spotify-backup/ent/restoreplan"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RestorePlanQuery is the builder for querying RestorePlan entities.
+type RestorePlanQuery struct {
+	config
+	ctx        *QueryContext
+	order      []restoreplan.OrderOption
+	inters     []Interceptor
+	predicates []predicate.RestorePlan
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RestorePlanQuery builder.
+func (rpq *RestorePlanQuery) Where(ps ...predicate.RestorePlan) *RestorePlanQuery {
+	rpq.predicates = append(rpq.predicates, ps...)
+	return rpq
+}
+
+// Limit the number of records to be returned by this query.
+func (rpq *RestorePlanQuery) Limit(limit int) *RestorePlanQuery {
+	rpq.ctx.Limit = &limit
+	return rpq
+}
+
+// Offset to start from.
+func (rpq *RestorePlanQuery) Offset(offset int) *RestorePlanQuery {
+	rpq.ctx.Offset = &offset
+	return rpq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rpq *RestorePlanQuery) Unique(unique bool) *RestorePlanQuery {
+	rpq.ctx.Unique = &unique
+	return rpq
+}
+
+// Order specifies how the records should be ordered.
+func (rpq *RestorePlanQuery) Order(o ...restoreplan.OrderOption) *RestorePlanQuery {
+	rpq.order = append(rpq.order, o...)
+	return rpq
+}
+
+// First returns the first RestorePlan entity from the query.
+// Returns a *NotFoundError when no RestorePlan was found.
+func (rpq *RestorePlanQuery) First(ctx context.Context) (*RestorePlan, error) {
+	nodes, err := rpq.Limit(1).All(setContextOp(ctx, rpq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{restoreplan.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rpq *RestorePlanQuery) FirstX(ctx context.Context) *RestorePlan {
+	node, err := rpq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first RestorePlan ID from the query.
+// Returns a *NotFoundError when no RestorePlan ID was found.
+func (rpq *RestorePlanQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = rpq.Limit(1).IDs(setContextOp(ctx, rpq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{restoreplan.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rpq *RestorePlanQuery) FirstIDX(ctx context.Context) string {
+	id, err := rpq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single RestorePlan entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one RestorePlan entity is found.
+// Returns a *NotFoundError when no RestorePlan entities are found.
+func (rpq *RestorePlanQuery) Only(ctx context.Context) (*RestorePlan, error) {
+	nodes, err := rpq.Limit(2).All(setContextOp(ctx, rpq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{restoreplan.Label}
+	default:
+		return nil, &NotSingularError{restoreplan.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rpq *RestorePlanQuery) OnlyX(ctx context.Context) *RestorePlan {
+	node, err := rpq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only RestorePlan ID in the query.
+// Returns a *NotSingularError when more than one RestorePlan ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rpq *RestorePlanQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = rpq.Limit(2).IDs(setContextOp(ctx, rpq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{restoreplan.Label}
+	default:
+		err = &NotSingularError{restoreplan.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rpq *RestorePlanQuery) OnlyIDX(ctx context.Context) string {
+	id, err := rpq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of RestorePlans.
+func (rpq *RestorePlanQuery) All(ctx context.Context) ([]*RestorePlan, error) {
+	ctx = setContextOp(ctx, rpq.ctx, ent.OpQueryAll)
+	if err := rpq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*RestorePlan, *RestorePlanQuery]()
+	return withInterceptors[[]*RestorePlan](ctx, rpq, qr, rpq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rpq *RestorePlanQuery) AllX(ctx context.Context) []*RestorePlan {
+	nodes, err := rpq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of RestorePlan IDs.
+func (rpq *RestorePlanQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if rpq.ctx.Unique == nil && rpq.path != nil {
+		rpq.Unique(true)
+	}
+	ctx = setContextOp(ctx, rpq.ctx, ent.OpQueryIDs)
+	if err = rpq.Select(restoreplan.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rpq *RestorePlanQuery) IDsX(ctx context.Context) []string {
+	ids, err := rpq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rpq *RestorePlanQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, rpq.ctx, ent.OpQueryCount)
+	if err := rpq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, rpq, querierCount[*RestorePlanQuery](), rpq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rpq *RestorePlanQuery) CountX(ctx context.Context) int {
+	count, err := rpq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rpq *RestorePlanQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, rpq.ctx, ent.OpQueryExist)
+	switch _, err := rpq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rpq *RestorePlanQuery) ExistX(ctx context.Context) bool {
+	exist, err := rpq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RestorePlanQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rpq *RestorePlanQuery) Clone() *RestorePlanQuery {
+	if rpq == nil {
+		return nil
+	}
+	return &RestorePlanQuery{
+		config:     rpq.config,
+		ctx:        rpq.ctx.Clone(),
+		order:      append([]restoreplan.OrderOption{}, rpq.order...),
+		inters:     append([]Interceptor{}, rpq.inters...),
+		predicates: append([]predicate.RestorePlan{}, rpq.predicates...),
+		// clone intermediate query.
+		sql:  rpq.sql.Clone(),
+		path: rpq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID string `json:"user_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.RestorePlan.Query().
+//		GroupBy(restoreplan.FieldUserID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rpq *RestorePlanQuery) GroupBy(field string, fields ...string) *RestorePlanGroupBy {
+	rpq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &RestorePlanGroupBy{build: rpq}
+	grbuild.flds = &rpq.ctx.Fields
+	grbuild.label = restoreplan.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID string `json:"user_id,omitempty"`
+//	}
+//
+//	client.RestorePlan.Query().
+//		Select(restoreplan.FieldUserID).
+//		Scan(ctx, &v)
+func (rpq *RestorePlanQuery) Select(fields ...string) *RestorePlanSelect {
+	rpq.ctx.Fields = append(rpq.ctx.Fields, fields...)
+	sbuild := &RestorePlanSelect{RestorePlanQuery: rpq}
+	sbuild.label = restoreplan.Label
+	sbuild.flds, sbuild.scan = &rpq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a RestorePlanSelect configured with the given aggregations.
+func (rpq *RestorePlanQuery) Aggregate(fns ...AggregateFunc) *RestorePlanSelect {
+	return rpq.Select().Aggregate(fns...)
+}
+
+func (rpq *RestorePlanQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range rpq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rpq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range rpq.ctx.Fields {
+		if !restoreplan.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rpq.path != nil {
+		prev, err := rpq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rpq.sql = prev
+	}
+	return nil
+}
+
+func (rpq *RestorePlanQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*RestorePlan, error) {
+	var (
+		nodes = []*RestorePlan{}
+		_spec = rpq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*RestorePlan).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &RestorePlan{config: rpq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(rpq.modifiers) > 0 {
+		_spec.Modifiers = rpq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rpq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (rpq *RestorePlanQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rpq.querySpec()
+	if len(rpq.modifiers) > 0 {
+		_spec.Modifiers = rpq.modifiers
+	}
+	_spec.Node.Columns = rpq.ctx.Fields
+	if len(rpq.ctx.Fields) > 0 {
+		_spec.Unique = rpq.ctx.Unique != nil && *rpq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, rpq.driver, _spec)
+}
+
+func (rpq *RestorePlanQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(restoreplan.Table, restoreplan.Columns, sqlgraph.NewFieldSpec(restoreplan.FieldID, field.TypeString))
+	_spec.From = rpq.sql
+	if unique := rpq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if rpq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := rpq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, restoreplan.FieldID)
+		for i := range fields {
+			if fields[i] != restoreplan.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := rpq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rpq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rpq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rpq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rpq *RestorePlanQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rpq.driver.Dialect())
+	t1 := builder.Table(restoreplan.Table)
+	columns := rpq.ctx.Fields
+	if len(columns) == 0 {
+		columns = restoreplan.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rpq.sql != nil {
+		selector = rpq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rpq.ctx.Unique != nil && *rpq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range rpq.modifiers {
+		m(selector)
+	}
+	for _, p := range rpq.predicates {
+		p(selector)
+	}
+	for _, p := range rpq.order {
+		p(selector)
+	}
+	if offset := rpq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rpq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (rpq *RestorePlanQuery) ForUpdate(opts ...sql.LockOption) *RestorePlanQuery {
+	if rpq.driver.Dialect() == dialect.Postgres {
+		rpq.Unique(false)
+	}
+	rpq.modifiers = append(rpq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return rpq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (rpq *RestorePlanQuery) ForShare(opts ...sql.LockOption) *RestorePlanQuery {
+	if rpq.driver.Dialect() == dialect.Postgres {
+		rpq.Unique(false)
+	}
+	rpq.modifiers = append(rpq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return rpq
+}
+
+// RestorePlanGroupBy is the group-by builder for RestorePlan entities.
+type RestorePlanGroupBy struct {
+	selector
+	build *RestorePlanQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rpgb *RestorePlanGroupBy) Aggregate(fns ...AggregateFunc) *RestorePlanGroupBy {
+	rpgb.fns = append(rpgb.fns, fns...)
+	return rpgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rpgb *RestorePlanGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rpgb.build.ctx, ent.OpQueryGroupBy)
+	if err := rpgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RestorePlanQuery, *RestorePlanGroupBy](ctx, rpgb.build, rpgb, rpgb.build.inters, v)
+}
+
+func (rpgb *RestorePlanGroupBy) sqlScan(ctx context.Context, root *RestorePlanQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(rpgb.fns))
+	for _, fn := range rpgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*rpgb.flds)+len(rpgb.fns))
+		for _, f := range *rpgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*rpgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rpgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// RestorePlanSelect is the builder for selecting fields of RestorePlan entities.
+type RestorePlanSelect struct {
+	*RestorePlanQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (rps *RestorePlanSelect) Aggregate(fns ...AggregateFunc) *RestorePlanSelect {
+	rps.fns = append(rps.fns, fns...)
+	return rps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rps *RestorePlanSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rps.ctx, ent.OpQuerySelect)
+	if err := rps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RestorePlanQuery, *RestorePlanSelect](ctx, rps.RestorePlanQuery, rps, rps.inters, v)
+}
+
+func (rps *RestorePlanSelect) sqlScan(ctx context.Context, root *RestorePlanQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(rps.fns))
+	for _, fn := range rps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*rps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,444 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/predicate"
+	"beyerleinf/spotify-backup/ent/restoreplan"
+	"beyerleinf/spotify-backup/ent/schema/schematype"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// RestorePlanUpdate is the builder for updating RestorePlan entities.
+type RestorePlanUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RestorePlanMutation
+}
+
+// Where appends a list predicates to the RestorePlanUpdate builder.
+func (rpu *RestorePlanUpdate) Where(ps ...predicate.RestorePlan) *RestorePlanUpdate {
+	rpu.mutation.Where(ps...)
+	return rpu
+}
+
+// SetStatus sets the "status" field.
+func (rpu *RestorePlanUpdate) SetStatus(r restoreplan.Status) *RestorePlanUpdate {
+	rpu.mutation.SetStatus(r)
+	return rpu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rpu *RestorePlanUpdate) SetNillableStatus(r *restoreplan.Status) *RestorePlanUpdate {
+	if r != nil {
+		rpu.SetStatus(*r)
+	}
+	return rpu
+}
+
+// SetPlaylists sets the "playlists" field.
+func (rpu *RestorePlanUpdate) SetPlaylists(sr []schematype.PlaylistRestore) *RestorePlanUpdate {
+	rpu.mutation.SetPlaylists(sr)
+	return rpu
+}
+
+// AppendPlaylists appends sr to the "playlists" field.
+func (rpu *RestorePlanUpdate) AppendPlaylists(sr []schematype.PlaylistRestore) *RestorePlanUpdate {
+	rpu.mutation.AppendPlaylists(sr)
+	return rpu
+}
+
+// SetCollections sets the "collections" field.
+func (rpu *RestorePlanUpdate) SetCollections(sr []schematype.CollectionRestore) *RestorePlanUpdate {
+	rpu.mutation.SetCollections(sr)
+	return rpu
+}
+
+// AppendCollections appends sr to the "collections" field.
+func (rpu *RestorePlanUpdate) AppendCollections(sr []schematype.CollectionRestore) *RestorePlanUpdate {
+	rpu.mutation.AppendCollections(sr)
+	return rpu
+}
+
+// SetDrift sets the "drift" field.
+func (rpu *RestorePlanUpdate) SetDrift(s []string) *RestorePlanUpdate {
+	rpu.mutation.SetDrift(s)
+	return rpu
+}
+
+// AppendDrift appends s to the "drift" field.
+func (rpu *RestorePlanUpdate) AppendDrift(s []string) *RestorePlanUpdate {
+	rpu.mutation.AppendDrift(s)
+	return rpu
+}
+
+// SetError sets the "error" field.
+func (rpu *RestorePlanUpdate) SetError(s string) *RestorePlanUpdate {
+	rpu.mutation.SetError(s)
+	return rpu
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (rpu *RestorePlanUpdate) SetNillableError(s *string) *RestorePlanUpdate {
+	if s != nil {
+		rpu.SetError(*s)
+	}
+	return rpu
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (rpu *RestorePlanUpdate) SetAppliedAt(t time.Time) *RestorePlanUpdate {
+	rpu.mutation.SetAppliedAt(t)
+	return rpu
+}
+
+// SetNillableAppliedAt sets the "applied_at" field if the given value is not nil.
+func (rpu *RestorePlanUpdate) SetNillableAppliedAt(t *time.Time) *RestorePlanUpdate {
+	if t != nil {
+		rpu.SetAppliedAt(*t)
+	}
+	return rpu
+}
+
+// ClearAppliedAt clears the value of the "applied_at" field.
+func (rpu *RestorePlanUpdate) ClearAppliedAt() *RestorePlanUpdate {
+	rpu.mutation.ClearAppliedAt()
+	return rpu
+}
+
+// Mutation returns the RestorePlanMutation object of the builder.
+func (rpu *RestorePlanUpdate) Mutation() *RestorePlanMutation {
+	return rpu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rpu *RestorePlanUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, rpu.sqlSave, rpu.mutation, rpu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rpu *RestorePlanUpdate) SaveX(ctx context.Context) int {
+	affected, err := rpu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rpu *RestorePlanUpdate) Exec(ctx context.Context) error {
+	_, err := rpu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpu *RestorePlanUpdate) ExecX(ctx context.Context) {
+	if err := rpu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rpu *RestorePlanUpdate) check() error {
+	if v, ok := rpu.mutation.Status(); ok {
+		if err := restoreplan.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "RestorePlan.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rpu *RestorePlanUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rpu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(restoreplan.Table, restoreplan.Columns, sqlgraph.NewFieldSpec(restoreplan.FieldID, field.TypeString))
+	if ps := rpu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rpu.mutation.Status(); ok {
+		_spec.SetField(restoreplan.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := rpu.mutation.Playlists(); ok {
+		_spec.SetField(restoreplan.FieldPlaylists, field.TypeJSON, value)
+	}
+	if value, ok := rpu.mutation.AppendedPlaylists(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, restoreplan.FieldPlaylists, value)
+		})
+	}
+	if value, ok := rpu.mutation.Collections(); ok {
+		_spec.SetField(restoreplan.FieldCollections, field.TypeJSON, value)
+	}
+	if value, ok := rpu.mutation.AppendedCollections(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, restoreplan.FieldCollections, value)
+		})
+	}
+	if value, ok := rpu.mutation.Drift(); ok {
+		_spec.SetField(restoreplan.FieldDrift, field.TypeJSON, value)
+	}
+	if value, ok := rpu.mutation.AppendedDrift(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, restoreplan.FieldDrift, value)
+		})
+	}
+	if value, ok := rpu.mutation.Error(); ok {
+		_spec.SetField(restoreplan.FieldError, field.TypeString, value)
+	}
+	if value, ok := rpu.mutation.AppliedAt(); ok {
+		_spec.SetField(restoreplan.FieldAppliedAt, field.TypeTime, value)
+	}
+	if rpu.mutation.AppliedAtCleared() {
+		_spec.ClearField(restoreplan.FieldAppliedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rpu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{restoreplan.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rpu.mutation.done = true
+	return n, nil
+}
+
+// RestorePlanUpdateOne is the builder for updating a single RestorePlan entity.
+type RestorePlanUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RestorePlanMutation
+}
+
+// SetStatus sets the "status" field.
+func (rpuo *RestorePlanUpdateOne) SetStatus(r restoreplan.Status) *RestorePlanUpdateOne {
+	rpuo.mutation.SetStatus(r)
+	return rpuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rpuo *RestorePlanUpdateOne) SetNillableStatus(r *restoreplan.Status) *RestorePlanUpdateOne {
+	if r != nil {
+		rpuo.SetStatus(*r)
+	}
+	return rpuo
+}
+
+// SetPlaylists sets the "playlists" field.
+func (rpuo *RestorePlanUpdateOne) SetPlaylists(sr []schematype.PlaylistRestore) *RestorePlanUpdateOne {
+	rpuo.mutation.SetPlaylists(sr)
+	return rpuo
+}
+
+// AppendPlaylists appends sr to the "playlists" field.
+func (rpuo *RestorePlanUpdateOne) AppendPlaylists(sr []schematype.PlaylistRestore) *RestorePlanUpdateOne {
+	rpuo.mutation.AppendPlaylists(sr)
+	return rpuo
+}
+
+// SetCollections sets the "collections" field.
+func (rpuo *RestorePlanUpdateOne) SetCollections(sr []schematype.CollectionRestore) *RestorePlanUpdateOne {
+	rpuo.mutation.SetCollections(sr)
+	return rpuo
+}
+
+// AppendCollections appends sr to the "collections" field.
+func (rpuo *RestorePlanUpdateOne) AppendCollections(sr []schematype.CollectionRestore) *RestorePlanUpdateOne {
+	rpuo.mutation.AppendCollections(sr)
+	return rpuo
+}
+
+// SetDrift sets the "drift" field.
+func (rpuo *RestorePlanUpdateOne) SetDrift(s []string) *RestorePlanUpdateOne {
+	rpuo.mutation.SetDrift(s)
+	return rpuo
+}
+
+// AppendDrift appends s to the "drift" field.
+func (rpuo *RestorePlanUpdateOne) AppendDrift(s []string) *RestorePlanUpdateOne {
+	rpuo.mutation.AppendDrift(s)
+	return rpuo
+}
+
+// SetError sets the "error" field.
+func (rpuo *RestorePlanUpdateOne) SetError(s string) *RestorePlanUpdateOne {
+	rpuo.mutation.SetError(s)
+	return rpuo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (rpuo *RestorePlanUpdateOne) SetNillableError(s *string) *RestorePlanUpdateOne {
+	if s != nil {
+		rpuo.SetError(*s)
+	}
+	return rpuo
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (rpuo *RestorePlanUpdateOne) SetAppliedAt(t time.Time) *RestorePlanUpdateOne {
+	rpuo.mutation.SetAppliedAt(t)
+	return rpuo
+}
+
+// SetNillableAppliedAt sets the "applied_at" field if the given value is not nil.
+func (rpuo *RestorePlanUpdateOne) SetNillableAppliedAt(t *time.Time) *RestorePlanUpdateOne {
+	if t != nil {
+		rpuo.SetAppliedAt(*t)
+	}
+	return rpuo
+}
+
+// ClearAppliedAt clears the value of the "applied_at" field.
+func (rpuo *RestorePlanUpdateOne) ClearAppliedAt() *RestorePlanUpdateOne {
+	rpuo.mutation.ClearAppliedAt()
+	return rpuo
+}
+
+// Mutation returns the RestorePlanMutation object of the builder.
+func (rpuo *RestorePlanUpdateOne) Mutation() *RestorePlanMutation {
+	return rpuo.mutation
+}
+
+// Where appends a list predicates to the RestorePlanUpdate builder.
+func (rpuo *RestorePlanUpdateOne) Where(ps ...predicate.RestorePlan) *RestorePlanUpdateOne {
+	rpuo.mutation.Where(ps...)
+	return rpuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rpuo *RestorePlanUpdateOne) Select(field string, fields ...string) *RestorePlanUpdateOne {
+	rpuo.fields = append([]string{field}, fields...)
+	return rpuo
+}
+
+// Save executes the query and returns the updated RestorePlan entity.
+func (rpuo *RestorePlanUpdateOne) Save(ctx context.Context) (*RestorePlan, error) {
+	return withHooks(ctx, rpuo.sqlSave, rpuo.mutation, rpuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rpuo *RestorePlanUpdateOne) SaveX(ctx context.Context) *RestorePlan {
+	node, err := rpuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rpuo *RestorePlanUpdateOne) Exec(ctx context.Context) error {
+	_, err := rpuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpuo *RestorePlanUpdateOne) ExecX(ctx context.Context) {
+	if err := rpuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rpuo *RestorePlanUpdateOne) check() error {
+	if v, ok := rpuo.mutation.Status(); ok {
+		if err := restoreplan.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "RestorePlan.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rpuo *RestorePlanUpdateOne) sqlSave(ctx context.Context) (_node *RestorePlan, err error) {
+	if err := rpuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(restoreplan.Table, restoreplan.Columns, sqlgraph.NewFieldSpec(restoreplan.FieldID, field.TypeString))
+	id, ok := rpuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RestorePlan.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rpuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, restoreplan.FieldID)
+		for _, f := range fields {
+			if !restoreplan.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != restoreplan.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rpuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rpuo.mutation.Status(); ok {
+		_spec.SetField(restoreplan.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := rpuo.mutation.Playlists(); ok {
+		_spec.SetField(restoreplan.FieldPlaylists, field.TypeJSON, value)
+	}
+	if value, ok := rpuo.mutation.AppendedPlaylists(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, restoreplan.FieldPlaylists, value)
+		})
+	}
+	if value, ok := rpuo.mutation.Collections(); ok {
+		_spec.SetField(restoreplan.FieldCollections, field.TypeJSON, value)
+	}
+	if value, ok := rpuo.mutation.AppendedCollections(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, restoreplan.FieldCollections, value)
+		})
+	}
+	if value, ok := rpuo.mutation.Drift(); ok {
+		_spec.SetField(restoreplan.FieldDrift, field.TypeJSON, value)
+	}
+	if value, ok := rpuo.mutation.AppendedDrift(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, restoreplan.FieldDrift, value)
+		})
+	}
+	if value, ok := rpuo.mutation.Error(); ok {
+		_spec.SetField(restoreplan.FieldError, field.TypeString, value)
+	}
+	if value, ok := rpuo.mutation.AppliedAt(); ok {
+		_spec.SetField(restoreplan.FieldAppliedAt, field.TypeTime, value)
+	}
+	if rpuo.mutation.AppliedAtCleared() {
+		_spec.ClearField(restoreplan.FieldAppliedAt, field.TypeTime)
+	}
+	_node = &RestorePlan{config: rpuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rpuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{restoreplan.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rpuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,161 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/retentionpolicy"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// RetentionPolicy is the model entity for the RetentionPolicy schema.
+type RetentionPolicy struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// KeepAllDays holds the value of the "keep_all_days" field.
+	KeepAllDays int `json:"keep_all_days,omitempty"`
+	// KeepDailyWeeks holds the value of the "keep_daily_weeks" field.
+	KeepDailyWeeks int `json:"keep_daily_weeks,omitempty"`
+	// KeepWeeklyMonths holds the value of the "keep_weekly_months" field.
+	KeepWeeklyMonths int `json:"keep_weekly_months,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RetentionPolicy) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case retentionpolicy.FieldKeepAllDays, retentionpolicy.FieldKeepDailyWeeks, retentionpolicy.FieldKeepWeeklyMonths:
+			values[i] = new(sql.NullInt64)
+		case retentionpolicy.FieldID, retentionpolicy.FieldUserID:
+			values[i] = new(sql.NullString)
+		case retentionpolicy.FieldCreatedAt, retentionpolicy.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RetentionPolicy fields.
+func (rp *RetentionPolicy) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case retentionpolicy.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				rp.ID = value.String
+			}
+		case retentionpolicy.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				rp.UserID = value.String
+			}
+		case retentionpolicy.FieldKeepAllDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field keep_all_days", values[i])
+			} else if value.Valid {
+				rp.KeepAllDays = int(value.Int64)
+			}
+		case retentionpolicy.FieldKeepDailyWeeks:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field keep_daily_weeks", values[i])
+			} else if value.Valid {
+				rp.KeepDailyWeeks = int(value.Int64)
+			}
+		case retentionpolicy.FieldKeepWeeklyMonths:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field keep_weekly_months", values[i])
+			} else if value.Valid {
+				rp.KeepWeeklyMonths = int(value.Int64)
+			}
+		case retentionpolicy.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				rp.CreatedAt = value.Time
+			}
+		case retentionpolicy.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				rp.UpdatedAt = value.Time
+			}
+		default:
+			rp.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RetentionPolicy.
+// This includes values selected through modifiers, order, etc.
+func (rp *RetentionPolicy) Value(name string) (ent.Value, error) {
+	return rp.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this RetentionPolicy.
+// Note that you need to call RetentionPolicy.Unwrap() before calling this method if this RetentionPolicy
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rp *RetentionPolicy) Update() *RetentionPolicyUpdateOne {
+	return NewRetentionPolicyClient(rp.config).UpdateOne(rp)
+}
+
+// Unwrap unwraps the RetentionPolicy entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rp *RetentionPolicy) Unwrap() *RetentionPolicy {
+	_tx, ok := rp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RetentionPolicy is not a transactional entity")
+	}
+	rp.config.driver = _tx.drv
+	return rp
+}
+
+// String implements the fmt.Stringer.
+func (rp *RetentionPolicy) String() string {
+	var builder strings.Builder
+	builder.WriteString("RetentionPolicy(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rp.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(rp.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("keep_all_days=")
+	builder.WriteString(fmt.Sprintf("%v", rp.KeepAllDays))
+	builder.WriteString(", ")
+	builder.WriteString("keep_daily_weeks=")
+	builder.WriteString(fmt.Sprintf("%v", rp.KeepDailyWeeks))
+	builder.WriteString(", ")
+	builder.WriteString("keep_weekly_months=")
+	builder.WriteString(fmt.Sprintf("%v", rp.KeepWeeklyMonths))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(rp.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(rp.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RetentionPolicies is a parsable slice of RetentionPolicy.
+type RetentionPolicies []*RetentionPolicy
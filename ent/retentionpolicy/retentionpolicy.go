@@ -0,0 +1,108 @@
+// Code generated by ent, DO NOT EDIT.
+
+package retentionpolicy
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the retentionpolicy type in the database.
+	Label = "retention_policy"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldKeepAllDays holds the string denoting the keep_all_days field in the database.
+	FieldKeepAllDays = "keep_all_days"
+	// FieldKeepDailyWeeks holds the string denoting the keep_daily_weeks field in the database.
+	FieldKeepDailyWeeks = "keep_daily_weeks"
+	// FieldKeepWeeklyMonths holds the string denoting the keep_weekly_months field in the database.
+	FieldKeepWeeklyMonths = "keep_weekly_months"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the retentionpolicy in the database.
+	Table = "retention_policies"
+)
+
+// Columns holds all SQL columns for retentionpolicy fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldKeepAllDays,
+	FieldKeepDailyWeeks,
+	FieldKeepWeeklyMonths,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	UserIDValidator func(string) error
+	// KeepAllDaysValidator is a validator for the "keep_all_days" field. It is called by the builders before save.
+	KeepAllDaysValidator func(int) error
+	// KeepDailyWeeksValidator is a validator for the "keep_daily_weeks" field. It is called by the builders before save.
+	KeepDailyWeeksValidator func(int) error
+	// KeepWeeklyMonthsValidator is a validator for the "keep_weekly_months" field. It is called by the builders before save.
+	KeepWeeklyMonthsValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() string
+)
+
+// OrderOption defines the ordering options for the RetentionPolicy queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByKeepAllDays orders the results by the keep_all_days field.
+func ByKeepAllDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKeepAllDays, opts...).ToFunc()
+}
+
+// ByKeepDailyWeeks orders the results by the keep_daily_weeks field.
+func ByKeepDailyWeeks(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKeepDailyWeeks, opts...).ToFunc()
+}
+
+// ByKeepWeeklyMonths orders the results by the keep_weekly_months field.
+func ByKeepWeeklyMonths(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKeepWeeklyMonths, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,375 @@
+// Code generated by ent, DO NOT EDIT.
+
+package retentionpolicy
+
+import (
+	"beyerleinf/spotify-backup/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldContainsFold(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldEQ(FieldUserID, v))
+}
+
+// KeepAllDays applies equality check predicate on the "keep_all_days" field. It's identical to KeepAllDaysEQ.
+func KeepAllDays(v int) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldEQ(FieldKeepAllDays, v))
+}
+
+// KeepDailyWeeks applies equality check predicate on the "keep_daily_weeks" field. It's identical to KeepDailyWeeksEQ.
+func KeepDailyWeeks(v int) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldEQ(FieldKeepDailyWeeks, v))
+}
+
+// KeepWeeklyMonths applies equality check predicate on the "keep_weekly_months" field. It's identical to KeepWeeklyMonthsEQ.
+func KeepWeeklyMonths(v int) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldEQ(FieldKeepWeeklyMonths, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// KeepAllDaysEQ applies the EQ predicate on the "keep_all_days" field.
+func KeepAllDaysEQ(v int) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldEQ(FieldKeepAllDays, v))
+}
+
+// KeepAllDaysNEQ applies the NEQ predicate on the "keep_all_days" field.
+func KeepAllDaysNEQ(v int) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldNEQ(FieldKeepAllDays, v))
+}
+
+// KeepAllDaysIn applies the In predicate on the "keep_all_days" field.
+func KeepAllDaysIn(vs ...int) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldIn(FieldKeepAllDays, vs...))
+}
+
+// KeepAllDaysNotIn applies the NotIn predicate on the "keep_all_days" field.
+func KeepAllDaysNotIn(vs ...int) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldNotIn(FieldKeepAllDays, vs...))
+}
+
+// KeepAllDaysGT applies the GT predicate on the "keep_all_days" field.
+func KeepAllDaysGT(v int) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldGT(FieldKeepAllDays, v))
+}
+
+// KeepAllDaysGTE applies the GTE predicate on the "keep_all_days" field.
+func KeepAllDaysGTE(v int) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldGTE(FieldKeepAllDays, v))
+}
+
+// KeepAllDaysLT applies the LT predicate on the "keep_all_days" field.
+func KeepAllDaysLT(v int) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldLT(FieldKeepAllDays, v))
+}
+
+// KeepAllDaysLTE applies the LTE predicate on the "keep_all_days" field.
+func KeepAllDaysLTE(v int) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldLTE(FieldKeepAllDays, v))
+}
+
+// KeepDailyWeeksEQ applies the EQ predicate on the "keep_daily_weeks" field.
+func KeepDailyWeeksEQ(v int) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldEQ(FieldKeepDailyWeeks, v))
+}
+
+// KeepDailyWeeksNEQ applies the NEQ predicate on the "keep_daily_weeks" field.
+func KeepDailyWeeksNEQ(v int) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldNEQ(FieldKeepDailyWeeks, v))
+}
+
+// KeepDailyWeeksIn applies the In predicate on the "keep_daily_weeks" field.
+func KeepDailyWeeksIn(vs ...int) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldIn(FieldKeepDailyWeeks, vs...))
+}
+
+// KeepDailyWeeksNotIn applies the NotIn predicate on the "keep_daily_weeks" field.
+func KeepDailyWeeksNotIn(vs ...int) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldNotIn(FieldKeepDailyWeeks, vs...))
+}
+
+// KeepDailyWeeksGT applies the GT predicate on the "keep_daily_weeks" field.
+func KeepDailyWeeksGT(v int) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldGT(FieldKeepDailyWeeks, v))
+}
+
+// KeepDailyWeeksGTE applies the GTE predicate on the "keep_daily_weeks" field.
+func KeepDailyWeeksGTE(v int) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldGTE(FieldKeepDailyWeeks, v))
+}
+
+// KeepDailyWeeksLT applies the LT predicate on the "keep_daily_weeks" field.
+func KeepDailyWeeksLT(v int) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldLT(FieldKeepDailyWeeks, v))
+}
+
+// KeepDailyWeeksLTE applies the LTE predicate on the "keep_daily_weeks" field.
+func KeepDailyWeeksLTE(v int) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldLTE(FieldKeepDailyWeeks, v))
+}
+
+// KeepWeeklyMonthsEQ applies the EQ predicate on the "keep_weekly_months" field.
+func KeepWeeklyMonthsEQ(v int) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldEQ(FieldKeepWeeklyMonths, v))
+}
+
+// KeepWeeklyMonthsNEQ applies the NEQ predicate on the "keep_weekly_months" field.
+func KeepWeeklyMonthsNEQ(v int) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldNEQ(FieldKeepWeeklyMonths, v))
+}
+
+// KeepWeeklyMonthsIn applies the In predicate on the "keep_weekly_months" field.
+func KeepWeeklyMonthsIn(vs ...int) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldIn(FieldKeepWeeklyMonths, vs...))
+}
+
+// KeepWeeklyMonthsNotIn applies the NotIn predicate on the "keep_weekly_months" field.
+func KeepWeeklyMonthsNotIn(vs ...int) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldNotIn(FieldKeepWeeklyMonths, vs...))
+}
+
+// KeepWeeklyMonthsGT applies the GT predicate on the "keep_weekly_months" field.
+func KeepWeeklyMonthsGT(v int) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldGT(FieldKeepWeeklyMonths, v))
+}
+
+// KeepWeeklyMonthsGTE applies the GTE predicate on the "keep_weekly_months" field.
+func KeepWeeklyMonthsGTE(v int) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldGTE(FieldKeepWeeklyMonths, v))
+}
+
+// KeepWeeklyMonthsLT applies the LT predicate on the "keep_weekly_months" field.
+func KeepWeeklyMonthsLT(v int) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldLT(FieldKeepWeeklyMonths, v))
+}
+
+// KeepWeeklyMonthsLTE applies the LTE predicate on the "keep_weekly_months" field.
+func KeepWeeklyMonthsLTE(v int) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldLTE(FieldKeepWeeklyMonths, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RetentionPolicy) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RetentionPolicy) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RetentionPolicy) predicate.RetentionPolicy {
+	return predicate.RetentionPolicy(sql.NotPredicates(p))
+}
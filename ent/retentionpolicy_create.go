@@ -0,0 +1,322 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/retentionpolicy"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RetentionPolicyCreate is the builder for creating a RetentionPolicy entity.
+type RetentionPolicyCreate struct {
+	config
+	mutation *RetentionPolicyMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (rpc *RetentionPolicyCreate) SetUserID(s string) *RetentionPolicyCreate {
+	rpc.mutation.SetUserID(s)
+	return rpc
+}
+
+// SetKeepAllDays sets the "keep_all_days" field.
+func (rpc *RetentionPolicyCreate) SetKeepAllDays(i int) *RetentionPolicyCreate {
+	rpc.mutation.SetKeepAllDays(i)
+	return rpc
+}
+
+// SetKeepDailyWeeks sets the "keep_daily_weeks" field.
+func (rpc *RetentionPolicyCreate) SetKeepDailyWeeks(i int) *RetentionPolicyCreate {
+	rpc.mutation.SetKeepDailyWeeks(i)
+	return rpc
+}
+
+// SetKeepWeeklyMonths sets the "keep_weekly_months" field.
+func (rpc *RetentionPolicyCreate) SetKeepWeeklyMonths(i int) *RetentionPolicyCreate {
+	rpc.mutation.SetKeepWeeklyMonths(i)
+	return rpc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rpc *RetentionPolicyCreate) SetCreatedAt(t time.Time) *RetentionPolicyCreate {
+	rpc.mutation.SetCreatedAt(t)
+	return rpc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rpc *RetentionPolicyCreate) SetNillableCreatedAt(t *time.Time) *RetentionPolicyCreate {
+	if t != nil {
+		rpc.SetCreatedAt(*t)
+	}
+	return rpc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rpc *RetentionPolicyCreate) SetUpdatedAt(t time.Time) *RetentionPolicyCreate {
+	rpc.mutation.SetUpdatedAt(t)
+	return rpc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rpc *RetentionPolicyCreate) SetNillableUpdatedAt(t *time.Time) *RetentionPolicyCreate {
+	if t != nil {
+		rpc.SetUpdatedAt(*t)
+	}
+	return rpc
+}
+
+// SetID sets the "id" field.
+func (rpc *RetentionPolicyCreate) SetID(s string) *RetentionPolicyCreate {
+	rpc.mutation.SetID(s)
+	return rpc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (rpc *RetentionPolicyCreate) SetNillableID(s *string) *RetentionPolicyCreate {
+	if s != nil {
+		rpc.SetID(*s)
+	}
+	return rpc
+}
+
+// Mutation returns the RetentionPolicyMutation object of the builder.
+func (rpc *RetentionPolicyCreate) Mutation() *RetentionPolicyMutation {
+	return rpc.mutation
+}
+
+// Save creates the RetentionPolicy in the database.
+func (rpc *RetentionPolicyCreate) Save(ctx context.Context) (*RetentionPolicy, error) {
+	rpc.defaults()
+	return withHooks(ctx, rpc.sqlSave, rpc.mutation, rpc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rpc *RetentionPolicyCreate) SaveX(ctx context.Context) *RetentionPolicy {
+	v, err := rpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rpc *RetentionPolicyCreate) Exec(ctx context.Context) error {
+	_, err := rpc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpc *RetentionPolicyCreate) ExecX(ctx context.Context) {
+	if err := rpc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rpc *RetentionPolicyCreate) defaults() {
+	if _, ok := rpc.mutation.CreatedAt(); !ok {
+		v := retentionpolicy.DefaultCreatedAt()
+		rpc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rpc.mutation.UpdatedAt(); !ok {
+		v := retentionpolicy.DefaultUpdatedAt()
+		rpc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := rpc.mutation.ID(); !ok {
+		v := retentionpolicy.DefaultID()
+		rpc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rpc *RetentionPolicyCreate) check() error {
+	if _, ok := rpc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "RetentionPolicy.user_id"`)}
+	}
+	if v, ok := rpc.mutation.UserID(); ok {
+		if err := retentionpolicy.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "RetentionPolicy.user_id": %w`, err)}
+		}
+	}
+	if _, ok := rpc.mutation.KeepAllDays(); !ok {
+		return &ValidationError{Name: "keep_all_days", err: errors.New(`ent: missing required field "RetentionPolicy.keep_all_days"`)}
+	}
+	if v, ok := rpc.mutation.KeepAllDays(); ok {
+		if err := retentionpolicy.KeepAllDaysValidator(v); err != nil {
+			return &ValidationError{Name: "keep_all_days", err: fmt.Errorf(`ent: validator failed for field "RetentionPolicy.keep_all_days": %w`, err)}
+		}
+	}
+	if _, ok := rpc.mutation.KeepDailyWeeks(); !ok {
+		return &ValidationError{Name: "keep_daily_weeks", err: errors.New(`ent: missing required field "RetentionPolicy.keep_daily_weeks"`)}
+	}
+	if v, ok := rpc.mutation.KeepDailyWeeks(); ok {
+		if err := retentionpolicy.KeepDailyWeeksValidator(v); err != nil {
+			return &ValidationError{Name: "keep_daily_weeks", err: fmt.Errorf(`ent: validator failed for field "RetentionPolicy.keep_daily_weeks": %w`, err)}
+		}
+	}
+	if _, ok := rpc.mutation.KeepWeeklyMonths(); !ok {
+		return &ValidationError{Name: "keep_weekly_months", err: errors.New(`ent: missing required field "RetentionPolicy.keep_weekly_months"`)}
+	}
+	if v, ok := rpc.mutation.KeepWeeklyMonths(); ok {
+		if err := retentionpolicy.KeepWeeklyMonthsValidator(v); err != nil {
+			return &ValidationError{Name: "keep_weekly_months", err: fmt.Errorf(`ent: validator failed for field "RetentionPolicy.keep_weekly_months": %w`, err)}
+		}
+	}
+	if _, ok := rpc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RetentionPolicy.created_at"`)}
+	}
+	if _, ok := rpc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "RetentionPolicy.updated_at"`)}
+	}
+	return nil
+}
+
+func (rpc *RetentionPolicyCreate) sqlSave(ctx context.Context) (*RetentionPolicy, error) {
+	if err := rpc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rpc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected RetentionPolicy.ID type: %T", _spec.ID.Value)
+		}
+	}
+	rpc.mutation.id = &_node.ID
+	rpc.mutation.done = true
+	return _node, nil
+}
+
+func (rpc *RetentionPolicyCreate) createSpec() (*RetentionPolicy, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RetentionPolicy{config: rpc.config}
+		_spec = sqlgraph.NewCreateSpec(retentionpolicy.Table, sqlgraph.NewFieldSpec(retentionpolicy.FieldID, field.TypeString))
+	)
+	if id, ok := rpc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := rpc.mutation.UserID(); ok {
+		_spec.SetField(retentionpolicy.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := rpc.mutation.KeepAllDays(); ok {
+		_spec.SetField(retentionpolicy.FieldKeepAllDays, field.TypeInt, value)
+		_node.KeepAllDays = value
+	}
+	if value, ok := rpc.mutation.KeepDailyWeeks(); ok {
+		_spec.SetField(retentionpolicy.FieldKeepDailyWeeks, field.TypeInt, value)
+		_node.KeepDailyWeeks = value
+	}
+	if value, ok := rpc.mutation.KeepWeeklyMonths(); ok {
+		_spec.SetField(retentionpolicy.FieldKeepWeeklyMonths, field.TypeInt, value)
+		_node.KeepWeeklyMonths = value
+	}
+	if value, ok := rpc.mutation.CreatedAt(); ok {
+		_spec.SetField(retentionpolicy.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rpc.mutation.UpdatedAt(); ok {
+		_spec.SetField(retentionpolicy.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// RetentionPolicyCreateBulk is the builder for creating many RetentionPolicy entities in bulk.
+type RetentionPolicyCreateBulk struct {
+	config
+	err      error
+	builders []*RetentionPolicyCreate
+}
+
+// Save creates the RetentionPolicy entities in the database.
+func (rpcb *RetentionPolicyCreateBulk) Save(ctx context.Context) ([]*RetentionPolicy, error) {
+	if rpcb.err != nil {
+		return nil, rpcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rpcb.builders))
+	nodes := make([]*RetentionPolicy, len(rpcb.builders))
+	mutators := make([]Mutator, len(rpcb.builders))
+	for i := range rpcb.builders {
+		func(i int, root context.Context) {
+			builder := rpcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RetentionPolicyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rpcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rpcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rpcb *RetentionPolicyCreateBulk) SaveX(ctx context.Context) []*RetentionPolicy {
+	v, err := rpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rpcb *RetentionPolicyCreateBulk) Exec(ctx context.Context) error {
+	_, err := rpcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpcb *RetentionPolicyCreateBulk) ExecX(ctx context.Context) {
+	if err := rpcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
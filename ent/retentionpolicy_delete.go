@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/predicate"
+	"beyerleinf/spotify-backup/ent/retentionpolicy"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RetentionPolicyDelete is the builder for deleting a RetentionPolicy entity.
+type RetentionPolicyDelete struct {
+	config
+	hooks    []Hook
+	mutation *RetentionPolicyMutation
+}
+
+// Where appends a list predicates to the RetentionPolicyDelete builder.
+func (rpd *RetentionPolicyDelete) Where(ps ...predicate.RetentionPolicy) *RetentionPolicyDelete {
+	rpd.mutation.Where(ps...)
+	return rpd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rpd *RetentionPolicyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rpd.sqlExec, rpd.mutation, rpd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpd *RetentionPolicyDelete) ExecX(ctx context.Context) int {
+	n, err := rpd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rpd *RetentionPolicyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(retentionpolicy.Table, sqlgraph.NewFieldSpec(retentionpolicy.FieldID, field.TypeString))
+	if ps := rpd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rpd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rpd.mutation.done = true
+	return affected, err
+}
+
+// RetentionPolicyDeleteOne is the builder for deleting a single RetentionPolicy entity.
+type RetentionPolicyDeleteOne struct {
+	rpd *RetentionPolicyDelete
+}
+
+// Where appends a list predicates to the RetentionPolicyDelete builder.
+func (rpdo *RetentionPolicyDeleteOne) Where(ps ...predicate.RetentionPolicy) *RetentionPolicyDeleteOne {
+	rpdo.rpd.mutation.Where(ps...)
+	return rpdo
+}
+
+// Exec executes the deletion query.
+func (rpdo *RetentionPolicyDeleteOne) Exec(ctx context.Context) error {
+	n, err := rpdo.rpd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{retentionpolicy.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpdo *RetentionPolicyDeleteOne) ExecX(ctx context.Context) {
+	if err := rpdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
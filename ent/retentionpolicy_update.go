@@ -0,0 +1,420 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/predicate"
+	"beyerleinf/spotify-backup/ent/retentionpolicy"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RetentionPolicyUpdate is the builder for updating RetentionPolicy entities.
+type RetentionPolicyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RetentionPolicyMutation
+}
+
+// Where appends a list predicates to the RetentionPolicyUpdate builder.
+func (rpu *RetentionPolicyUpdate) Where(ps ...predicate.RetentionPolicy) *RetentionPolicyUpdate {
+	rpu.mutation.Where(ps...)
+	return rpu
+}
+
+// SetKeepAllDays sets the "keep_all_days" field.
+func (rpu *RetentionPolicyUpdate) SetKeepAllDays(i int) *RetentionPolicyUpdate {
+	rpu.mutation.ResetKeepAllDays()
+	rpu.mutation.SetKeepAllDays(i)
+	return rpu
+}
+
+// SetNillableKeepAllDays sets the "keep_all_days" field if the given value is not nil.
+func (rpu *RetentionPolicyUpdate) SetNillableKeepAllDays(i *int) *RetentionPolicyUpdate {
+	if i != nil {
+		rpu.SetKeepAllDays(*i)
+	}
+	return rpu
+}
+
+// AddKeepAllDays adds i to the "keep_all_days" field.
+func (rpu *RetentionPolicyUpdate) AddKeepAllDays(i int) *RetentionPolicyUpdate {
+	rpu.mutation.AddKeepAllDays(i)
+	return rpu
+}
+
+// SetKeepDailyWeeks sets the "keep_daily_weeks" field.
+func (rpu *RetentionPolicyUpdate) SetKeepDailyWeeks(i int) *RetentionPolicyUpdate {
+	rpu.mutation.ResetKeepDailyWeeks()
+	rpu.mutation.SetKeepDailyWeeks(i)
+	return rpu
+}
+
+// SetNillableKeepDailyWeeks sets the "keep_daily_weeks" field if the given value is not nil.
+func (rpu *RetentionPolicyUpdate) SetNillableKeepDailyWeeks(i *int) *RetentionPolicyUpdate {
+	if i != nil {
+		rpu.SetKeepDailyWeeks(*i)
+	}
+	return rpu
+}
+
+// AddKeepDailyWeeks adds i to the "keep_daily_weeks" field.
+func (rpu *RetentionPolicyUpdate) AddKeepDailyWeeks(i int) *RetentionPolicyUpdate {
+	rpu.mutation.AddKeepDailyWeeks(i)
+	return rpu
+}
+
+// SetKeepWeeklyMonths sets the "keep_weekly_months" field.
+func (rpu *RetentionPolicyUpdate) SetKeepWeeklyMonths(i int) *RetentionPolicyUpdate {
+	rpu.mutation.ResetKeepWeeklyMonths()
+	rpu.mutation.SetKeepWeeklyMonths(i)
+	return rpu
+}
+
+// SetNillableKeepWeeklyMonths sets the "keep_weekly_months" field if the given value is not nil.
+func (rpu *RetentionPolicyUpdate) SetNillableKeepWeeklyMonths(i *int) *RetentionPolicyUpdate {
+	if i != nil {
+		rpu.SetKeepWeeklyMonths(*i)
+	}
+	return rpu
+}
+
+// AddKeepWeeklyMonths adds i to the "keep_weekly_months" field.
+func (rpu *RetentionPolicyUpdate) AddKeepWeeklyMonths(i int) *RetentionPolicyUpdate {
+	rpu.mutation.AddKeepWeeklyMonths(i)
+	return rpu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rpu *RetentionPolicyUpdate) SetUpdatedAt(t time.Time) *RetentionPolicyUpdate {
+	rpu.mutation.SetUpdatedAt(t)
+	return rpu
+}
+
+// Mutation returns the RetentionPolicyMutation object of the builder.
+func (rpu *RetentionPolicyUpdate) Mutation() *RetentionPolicyMutation {
+	return rpu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rpu *RetentionPolicyUpdate) Save(ctx context.Context) (int, error) {
+	rpu.defaults()
+	return withHooks(ctx, rpu.sqlSave, rpu.mutation, rpu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rpu *RetentionPolicyUpdate) SaveX(ctx context.Context) int {
+	affected, err := rpu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rpu *RetentionPolicyUpdate) Exec(ctx context.Context) error {
+	_, err := rpu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpu *RetentionPolicyUpdate) ExecX(ctx context.Context) {
+	if err := rpu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rpu *RetentionPolicyUpdate) defaults() {
+	if _, ok := rpu.mutation.UpdatedAt(); !ok {
+		v := retentionpolicy.UpdateDefaultUpdatedAt()
+		rpu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rpu *RetentionPolicyUpdate) check() error {
+	if v, ok := rpu.mutation.KeepAllDays(); ok {
+		if err := retentionpolicy.KeepAllDaysValidator(v); err != nil {
+			return &ValidationError{Name: "keep_all_days", err: fmt.Errorf(`ent: validator failed for field "RetentionPolicy.keep_all_days": %w`, err)}
+		}
+	}
+	if v, ok := rpu.mutation.KeepDailyWeeks(); ok {
+		if err := retentionpolicy.KeepDailyWeeksValidator(v); err != nil {
+			return &ValidationError{Name: "keep_daily_weeks", err: fmt.Errorf(`ent: validator failed for field "RetentionPolicy.keep_daily_weeks": %w`, err)}
+		}
+	}
+	if v, ok := rpu.mutation.KeepWeeklyMonths(); ok {
+		if err := retentionpolicy.KeepWeeklyMonthsValidator(v); err != nil {
+			return &ValidationError{Name: "keep_weekly_months", err: fmt.Errorf(`ent: validator failed for field "RetentionPolicy.keep_weekly_months": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rpu *RetentionPolicyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rpu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(retentionpolicy.Table, retentionpolicy.Columns, sqlgraph.NewFieldSpec(retentionpolicy.FieldID, field.TypeString))
+	if ps := rpu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rpu.mutation.KeepAllDays(); ok {
+		_spec.SetField(retentionpolicy.FieldKeepAllDays, field.TypeInt, value)
+	}
+	if value, ok := rpu.mutation.AddedKeepAllDays(); ok {
+		_spec.AddField(retentionpolicy.FieldKeepAllDays, field.TypeInt, value)
+	}
+	if value, ok := rpu.mutation.KeepDailyWeeks(); ok {
+		_spec.SetField(retentionpolicy.FieldKeepDailyWeeks, field.TypeInt, value)
+	}
+	if value, ok := rpu.mutation.AddedKeepDailyWeeks(); ok {
+		_spec.AddField(retentionpolicy.FieldKeepDailyWeeks, field.TypeInt, value)
+	}
+	if value, ok := rpu.mutation.KeepWeeklyMonths(); ok {
+		_spec.SetField(retentionpolicy.FieldKeepWeeklyMonths, field.TypeInt, value)
+	}
+	if value, ok := rpu.mutation.AddedKeepWeeklyMonths(); ok {
+		_spec.AddField(retentionpolicy.FieldKeepWeeklyMonths, field.TypeInt, value)
+	}
+	if value, ok := rpu.mutation.UpdatedAt(); ok {
+		_spec.SetField(retentionpolicy.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rpu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{retentionpolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rpu.mutation.done = true
+	return n, nil
+}
+
+// RetentionPolicyUpdateOne is the builder for updating a single RetentionPolicy entity.
+type RetentionPolicyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RetentionPolicyMutation
+}
+
+// SetKeepAllDays sets the "keep_all_days" field.
+func (rpuo *RetentionPolicyUpdateOne) SetKeepAllDays(i int) *RetentionPolicyUpdateOne {
+	rpuo.mutation.ResetKeepAllDays()
+	rpuo.mutation.SetKeepAllDays(i)
+	return rpuo
+}
+
+// SetNillableKeepAllDays sets the "keep_all_days" field if the given value is not nil.
+func (rpuo *RetentionPolicyUpdateOne) SetNillableKeepAllDays(i *int) *RetentionPolicyUpdateOne {
+	if i != nil {
+		rpuo.SetKeepAllDays(*i)
+	}
+	return rpuo
+}
+
+// AddKeepAllDays adds i to the "keep_all_days" field.
+func (rpuo *RetentionPolicyUpdateOne) AddKeepAllDays(i int) *RetentionPolicyUpdateOne {
+	rpuo.mutation.AddKeepAllDays(i)
+	return rpuo
+}
+
+// SetKeepDailyWeeks sets the "keep_daily_weeks" field.
+func (rpuo *RetentionPolicyUpdateOne) SetKeepDailyWeeks(i int) *RetentionPolicyUpdateOne {
+	rpuo.mutation.ResetKeepDailyWeeks()
+	rpuo.mutation.SetKeepDailyWeeks(i)
+	return rpuo
+}
+
+// SetNillableKeepDailyWeeks sets the "keep_daily_weeks" field if the given value is not nil.
+func (rpuo *RetentionPolicyUpdateOne) SetNillableKeepDailyWeeks(i *int) *RetentionPolicyUpdateOne {
+	if i != nil {
+		rpuo.SetKeepDailyWeeks(*i)
+	}
+	return rpuo
+}
+
+// AddKeepDailyWeeks adds i to the "keep_daily_weeks" field.
+func (rpuo *RetentionPolicyUpdateOne) AddKeepDailyWeeks(i int) *RetentionPolicyUpdateOne {
+	rpuo.mutation.AddKeepDailyWeeks(i)
+	return rpuo
+}
+
+// SetKeepWeeklyMonths sets the "keep_weekly_months" field.
+func (rpuo *RetentionPolicyUpdateOne) SetKeepWeeklyMonths(i int) *RetentionPolicyUpdateOne {
+	rpuo.mutation.ResetKeepWeeklyMonths()
+	rpuo.mutation.SetKeepWeeklyMonths(i)
+	return rpuo
+}
+
+// SetNillableKeepWeeklyMonths sets the "keep_weekly_months" field if the given value is not nil.
+func (rpuo *RetentionPolicyUpdateOne) SetNillableKeepWeeklyMonths(i *int) *RetentionPolicyUpdateOne {
+	if i != nil {
+		rpuo.SetKeepWeeklyMonths(*i)
+	}
+	return rpuo
+}
+
+// AddKeepWeeklyMonths adds i to the "keep_weekly_months" field.
+func (rpuo *RetentionPolicyUpdateOne) AddKeepWeeklyMonths(i int) *RetentionPolicyUpdateOne {
+	rpuo.mutation.AddKeepWeeklyMonths(i)
+	return rpuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rpuo *RetentionPolicyUpdateOne) SetUpdatedAt(t time.Time) *RetentionPolicyUpdateOne {
+	rpuo.mutation.SetUpdatedAt(t)
+	return rpuo
+}
+
+// Mutation returns the RetentionPolicyMutation object of the builder.
+func (rpuo *RetentionPolicyUpdateOne) Mutation() *RetentionPolicyMutation {
+	return rpuo.mutation
+}
+
+// Where appends a list predicates to the RetentionPolicyUpdate builder.
+func (rpuo *RetentionPolicyUpdateOne) Where(ps ...predicate.RetentionPolicy) *RetentionPolicyUpdateOne {
+	rpuo.mutation.Where(ps...)
+	return rpuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rpuo *RetentionPolicyUpdateOne) Select(field string, fields ...string) *RetentionPolicyUpdateOne {
+	rpuo.fields = append([]string{field}, fields...)
+	return rpuo
+}
+
+// Save executes the query and returns the updated RetentionPolicy entity.
+func (rpuo *RetentionPolicyUpdateOne) Save(ctx context.Context) (*RetentionPolicy, error) {
+	rpuo.defaults()
+	return withHooks(ctx, rpuo.sqlSave, rpuo.mutation, rpuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rpuo *RetentionPolicyUpdateOne) SaveX(ctx context.Context) *RetentionPolicy {
+	node, err := rpuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rpuo *RetentionPolicyUpdateOne) Exec(ctx context.Context) error {
+	_, err := rpuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpuo *RetentionPolicyUpdateOne) ExecX(ctx context.Context) {
+	if err := rpuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rpuo *RetentionPolicyUpdateOne) defaults() {
+	if _, ok := rpuo.mutation.UpdatedAt(); !ok {
+		v := retentionpolicy.UpdateDefaultUpdatedAt()
+		rpuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rpuo *RetentionPolicyUpdateOne) check() error {
+	if v, ok := rpuo.mutation.KeepAllDays(); ok {
+		if err := retentionpolicy.KeepAllDaysValidator(v); err != nil {
+			return &ValidationError{Name: "keep_all_days", err: fmt.Errorf(`ent: validator failed for field "RetentionPolicy.keep_all_days": %w`, err)}
+		}
+	}
+	if v, ok := rpuo.mutation.KeepDailyWeeks(); ok {
+		if err := retentionpolicy.KeepDailyWeeksValidator(v); err != nil {
+			return &ValidationError{Name: "keep_daily_weeks", err: fmt.Errorf(`ent: validator failed for field "RetentionPolicy.keep_daily_weeks": %w`, err)}
+		}
+	}
+	if v, ok := rpuo.mutation.KeepWeeklyMonths(); ok {
+		if err := retentionpolicy.KeepWeeklyMonthsValidator(v); err != nil {
+			return &ValidationError{Name: "keep_weekly_months", err: fmt.Errorf(`ent: validator failed for field "RetentionPolicy.keep_weekly_months": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rpuo *RetentionPolicyUpdateOne) sqlSave(ctx context.Context) (_node *RetentionPolicy, err error) {
+	if err := rpuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(retentionpolicy.Table, retentionpolicy.Columns, sqlgraph.NewFieldSpec(retentionpolicy.FieldID, field.TypeString))
+	id, ok := rpuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RetentionPolicy.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rpuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, retentionpolicy.FieldID)
+		for _, f := range fields {
+			if !retentionpolicy.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != retentionpolicy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rpuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rpuo.mutation.KeepAllDays(); ok {
+		_spec.SetField(retentionpolicy.FieldKeepAllDays, field.TypeInt, value)
+	}
+	if value, ok := rpuo.mutation.AddedKeepAllDays(); ok {
+		_spec.AddField(retentionpolicy.FieldKeepAllDays, field.TypeInt, value)
+	}
+	if value, ok := rpuo.mutation.KeepDailyWeeks(); ok {
+		_spec.SetField(retentionpolicy.FieldKeepDailyWeeks, field.TypeInt, value)
+	}
+	if value, ok := rpuo.mutation.AddedKeepDailyWeeks(); ok {
+		_spec.AddField(retentionpolicy.FieldKeepDailyWeeks, field.TypeInt, value)
+	}
+	if value, ok := rpuo.mutation.KeepWeeklyMonths(); ok {
+		_spec.SetField(retentionpolicy.FieldKeepWeeklyMonths, field.TypeInt, value)
+	}
+	if value, ok := rpuo.mutation.AddedKeepWeeklyMonths(); ok {
+		_spec.AddField(retentionpolicy.FieldKeepWeeklyMonths, field.TypeInt, value)
+	}
+	if value, ok := rpuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(retentionpolicy.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &RetentionPolicy{config: rpuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rpuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{retentionpolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rpuo.mutation.done = true
+	return _node, nil
+}
@@ -3,14 +3,597 @@
 package ent
 
 import (
+	"beyerleinf/spotify-backup/ent/album"
+	"beyerleinf/spotify-backup/ent/artist"
+	"beyerleinf/spotify-backup/ent/authstate"
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/backupschedule"
+	"beyerleinf/spotify-backup/ent/episode"
+	"beyerleinf/spotify-backup/ent/followedartist"
+	"beyerleinf/spotify-backup/ent/job"
+	"beyerleinf/spotify-backup/ent/lease"
+	"beyerleinf/spotify-backup/ent/playlist"
+	"beyerleinf/spotify-backup/ent/playlistsnapshot"
+	"beyerleinf/spotify-backup/ent/restoreplan"
+	"beyerleinf/spotify-backup/ent/retentionpolicy"
+	"beyerleinf/spotify-backup/ent/savedalbum"
+	"beyerleinf/spotify-backup/ent/savedaudiobook"
+	"beyerleinf/spotify-backup/ent/savedepisode"
+	"beyerleinf/spotify-backup/ent/savedshow"
+	"beyerleinf/spotify-backup/ent/savedtrack"
 	"beyerleinf/spotify-backup/ent/schema"
+	"beyerleinf/spotify-backup/ent/schema/schematype"
+	"beyerleinf/spotify-backup/ent/schemamigration"
+	"beyerleinf/spotify-backup/ent/show"
+	"beyerleinf/spotify-backup/ent/snapshotitem"
+	"beyerleinf/spotify-backup/ent/spotifytoken"
+	"beyerleinf/spotify-backup/ent/track"
 	"beyerleinf/spotify-backup/ent/user"
+	"time"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	albumFields := schema.Album{}.Fields()
+	_ = albumFields
+	// albumDescSpotifyID is the schema descriptor for spotify_id field.
+	albumDescSpotifyID := albumFields[1].Descriptor()
+	// album.SpotifyIDValidator is a validator for the "spotify_id" field. It is called by the builders before save.
+	album.SpotifyIDValidator = albumDescSpotifyID.Validators[0].(func(string) error)
+	// albumDescAlbumType is the schema descriptor for album_type field.
+	albumDescAlbumType := albumFields[4].Descriptor()
+	// album.DefaultAlbumType holds the default value on creation for the album_type field.
+	album.DefaultAlbumType = albumDescAlbumType.Default.(string)
+	// albumDescReleaseDate is the schema descriptor for release_date field.
+	albumDescReleaseDate := albumFields[5].Descriptor()
+	// album.DefaultReleaseDate holds the default value on creation for the release_date field.
+	album.DefaultReleaseDate = albumDescReleaseDate.Default.(string)
+	// albumDescTotalTracks is the schema descriptor for total_tracks field.
+	albumDescTotalTracks := albumFields[6].Descriptor()
+	// album.DefaultTotalTracks holds the default value on creation for the total_tracks field.
+	album.DefaultTotalTracks = albumDescTotalTracks.Default.(int)
+	// album.TotalTracksValidator is a validator for the "total_tracks" field. It is called by the builders before save.
+	album.TotalTracksValidator = albumDescTotalTracks.Validators[0].(func(int) error)
+	// albumDescLabel is the schema descriptor for label field.
+	albumDescLabel := albumFields[7].Descriptor()
+	// album.DefaultLabel holds the default value on creation for the label field.
+	album.DefaultLabel = albumDescLabel.Default.(string)
+	// albumDescUpc is the schema descriptor for upc field.
+	albumDescUpc := albumFields[8].Descriptor()
+	// album.DefaultUpc holds the default value on creation for the upc field.
+	album.DefaultUpc = albumDescUpc.Default.(string)
+	// albumDescCreatedAt is the schema descriptor for created_at field.
+	albumDescCreatedAt := albumFields[9].Descriptor()
+	// album.DefaultCreatedAt holds the default value on creation for the created_at field.
+	album.DefaultCreatedAt = albumDescCreatedAt.Default.(func() time.Time)
+	// albumDescID is the schema descriptor for id field.
+	albumDescID := albumFields[0].Descriptor()
+	// album.DefaultID holds the default value on creation for the id field.
+	album.DefaultID = albumDescID.Default.(func() string)
+	artistFields := schema.Artist{}.Fields()
+	_ = artistFields
+	// artistDescSpotifyID is the schema descriptor for spotify_id field.
+	artistDescSpotifyID := artistFields[1].Descriptor()
+	// artist.SpotifyIDValidator is a validator for the "spotify_id" field. It is called by the builders before save.
+	artist.SpotifyIDValidator = artistDescSpotifyID.Validators[0].(func(string) error)
+	// artistDescCreatedAt is the schema descriptor for created_at field.
+	artistDescCreatedAt := artistFields[5].Descriptor()
+	// artist.DefaultCreatedAt holds the default value on creation for the created_at field.
+	artist.DefaultCreatedAt = artistDescCreatedAt.Default.(func() time.Time)
+	// artistDescID is the schema descriptor for id field.
+	artistDescID := artistFields[0].Descriptor()
+	// artist.DefaultID holds the default value on creation for the id field.
+	artist.DefaultID = artistDescID.Default.(func() string)
+	authstateFields := schema.AuthState{}.Fields()
+	_ = authstateFields
+	// authstateDescState is the schema descriptor for state field.
+	authstateDescState := authstateFields[1].Descriptor()
+	// authstate.StateValidator is a validator for the "state" field. It is called by the builders before save.
+	authstate.StateValidator = authstateDescState.Validators[0].(func(string) error)
+	// authstateDescID is the schema descriptor for id field.
+	authstateDescID := authstateFields[0].Descriptor()
+	// authstate.DefaultID holds the default value on creation for the id field.
+	authstate.DefaultID = authstateDescID.Default.(func() string)
+	backuprunFields := schema.BackupRun{}.Fields()
+	_ = backuprunFields
+	// backuprunDescUserID is the schema descriptor for user_id field.
+	backuprunDescUserID := backuprunFields[1].Descriptor()
+	// backuprun.DefaultUserID holds the default value on creation for the user_id field.
+	backuprun.DefaultUserID = backuprunDescUserID.Default.(string)
+	// backuprunDescJobID is the schema descriptor for job_id field.
+	backuprunDescJobID := backuprunFields[3].Descriptor()
+	// backuprun.DefaultJobID holds the default value on creation for the job_id field.
+	backuprun.DefaultJobID = backuprunDescJobID.Default.(string)
+	// backuprunDescCollections is the schema descriptor for collections field.
+	backuprunDescCollections := backuprunFields[5].Descriptor()
+	// backuprun.DefaultCollections holds the default value on creation for the collections field.
+	backuprun.DefaultCollections = backuprunDescCollections.Default.(map[string]schematype.CollectionResult)
+	// backuprunDescAPICalls is the schema descriptor for api_calls field.
+	backuprunDescAPICalls := backuprunFields[6].Descriptor()
+	// backuprun.DefaultAPICalls holds the default value on creation for the api_calls field.
+	backuprun.DefaultAPICalls = backuprunDescAPICalls.Default.(int)
+	// backuprun.APICallsValidator is a validator for the "api_calls" field. It is called by the builders before save.
+	backuprun.APICallsValidator = backuprunDescAPICalls.Validators[0].(func(int) error)
+	// backuprunDescError is the schema descriptor for error field.
+	backuprunDescError := backuprunFields[7].Descriptor()
+	// backuprun.DefaultError holds the default value on creation for the error field.
+	backuprun.DefaultError = backuprunDescError.Default.(string)
+	// backuprunDescResumes is the schema descriptor for resumes field.
+	backuprunDescResumes := backuprunFields[9].Descriptor()
+	// backuprun.DefaultResumes holds the default value on creation for the resumes field.
+	backuprun.DefaultResumes = backuprunDescResumes.Default.(int)
+	// backuprun.ResumesValidator is a validator for the "resumes" field. It is called by the builders before save.
+	backuprun.ResumesValidator = backuprunDescResumes.Validators[0].(func(int) error)
+	// backuprunDescPinned is the schema descriptor for pinned field.
+	backuprunDescPinned := backuprunFields[10].Descriptor()
+	// backuprun.DefaultPinned holds the default value on creation for the pinned field.
+	backuprun.DefaultPinned = backuprunDescPinned.Default.(bool)
+	// backuprunDescNote is the schema descriptor for note field.
+	backuprunDescNote := backuprunFields[11].Descriptor()
+	// backuprun.DefaultNote holds the default value on creation for the note field.
+	backuprun.DefaultNote = backuprunDescNote.Default.(string)
+	// backuprunDescLabels is the schema descriptor for labels field.
+	backuprunDescLabels := backuprunFields[12].Descriptor()
+	// backuprun.DefaultLabels holds the default value on creation for the labels field.
+	backuprun.DefaultLabels = backuprunDescLabels.Default.([]string)
+	// backuprunDescStartedAt is the schema descriptor for started_at field.
+	backuprunDescStartedAt := backuprunFields[13].Descriptor()
+	// backuprun.DefaultStartedAt holds the default value on creation for the started_at field.
+	backuprun.DefaultStartedAt = backuprunDescStartedAt.Default.(func() time.Time)
+	// backuprunDescID is the schema descriptor for id field.
+	backuprunDescID := backuprunFields[0].Descriptor()
+	// backuprun.DefaultID holds the default value on creation for the id field.
+	backuprun.DefaultID = backuprunDescID.Default.(func() string)
+	backupscheduleFields := schema.BackupSchedule{}.Fields()
+	_ = backupscheduleFields
+	// backupscheduleDescUserID is the schema descriptor for user_id field.
+	backupscheduleDescUserID := backupscheduleFields[1].Descriptor()
+	// backupschedule.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	backupschedule.UserIDValidator = backupscheduleDescUserID.Validators[0].(func(string) error)
+	// backupscheduleDescCron is the schema descriptor for cron field.
+	backupscheduleDescCron := backupscheduleFields[2].Descriptor()
+	// backupschedule.CronValidator is a validator for the "cron" field. It is called by the builders before save.
+	backupschedule.CronValidator = backupscheduleDescCron.Validators[0].(func(string) error)
+	// backupscheduleDescTimeZone is the schema descriptor for time_zone field.
+	backupscheduleDescTimeZone := backupscheduleFields[3].Descriptor()
+	// backupschedule.DefaultTimeZone holds the default value on creation for the time_zone field.
+	backupschedule.DefaultTimeZone = backupscheduleDescTimeZone.Default.(string)
+	// backupscheduleDescEnabled is the schema descriptor for enabled field.
+	backupscheduleDescEnabled := backupscheduleFields[5].Descriptor()
+	// backupschedule.DefaultEnabled holds the default value on creation for the enabled field.
+	backupschedule.DefaultEnabled = backupscheduleDescEnabled.Default.(bool)
+	// backupscheduleDescCreatedAt is the schema descriptor for created_at field.
+	backupscheduleDescCreatedAt := backupscheduleFields[8].Descriptor()
+	// backupschedule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	backupschedule.DefaultCreatedAt = backupscheduleDescCreatedAt.Default.(func() time.Time)
+	// backupscheduleDescUpdatedAt is the schema descriptor for updated_at field.
+	backupscheduleDescUpdatedAt := backupscheduleFields[9].Descriptor()
+	// backupschedule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	backupschedule.DefaultUpdatedAt = backupscheduleDescUpdatedAt.Default.(func() time.Time)
+	// backupschedule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	backupschedule.UpdateDefaultUpdatedAt = backupscheduleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// backupscheduleDescID is the schema descriptor for id field.
+	backupscheduleDescID := backupscheduleFields[0].Descriptor()
+	// backupschedule.DefaultID holds the default value on creation for the id field.
+	backupschedule.DefaultID = backupscheduleDescID.Default.(func() string)
+	episodeFields := schema.Episode{}.Fields()
+	_ = episodeFields
+	// episodeDescSpotifyID is the schema descriptor for spotify_id field.
+	episodeDescSpotifyID := episodeFields[1].Descriptor()
+	// episode.SpotifyIDValidator is a validator for the "spotify_id" field. It is called by the builders before save.
+	episode.SpotifyIDValidator = episodeDescSpotifyID.Validators[0].(func(string) error)
+	// episodeDescDurationMs is the schema descriptor for duration_ms field.
+	episodeDescDurationMs := episodeFields[4].Descriptor()
+	// episode.DurationMsValidator is a validator for the "duration_ms" field. It is called by the builders before save.
+	episode.DurationMsValidator = episodeDescDurationMs.Validators[0].(func(int) error)
+	// episodeDescExplicit is the schema descriptor for explicit field.
+	episodeDescExplicit := episodeFields[5].Descriptor()
+	// episode.DefaultExplicit holds the default value on creation for the explicit field.
+	episode.DefaultExplicit = episodeDescExplicit.Default.(bool)
+	// episodeDescReleaseDate is the schema descriptor for release_date field.
+	episodeDescReleaseDate := episodeFields[6].Descriptor()
+	// episode.DefaultReleaseDate holds the default value on creation for the release_date field.
+	episode.DefaultReleaseDate = episodeDescReleaseDate.Default.(string)
+	// episodeDescCreatedAt is the schema descriptor for created_at field.
+	episodeDescCreatedAt := episodeFields[7].Descriptor()
+	// episode.DefaultCreatedAt holds the default value on creation for the created_at field.
+	episode.DefaultCreatedAt = episodeDescCreatedAt.Default.(func() time.Time)
+	// episodeDescID is the schema descriptor for id field.
+	episodeDescID := episodeFields[0].Descriptor()
+	// episode.DefaultID holds the default value on creation for the id field.
+	episode.DefaultID = episodeDescID.Default.(func() string)
+	followedartistFields := schema.FollowedArtist{}.Fields()
+	_ = followedartistFields
+	// followedartistDescFollowers is the schema descriptor for followers field.
+	followedartistDescFollowers := followedartistFields[1].Descriptor()
+	// followedartist.DefaultFollowers holds the default value on creation for the followers field.
+	followedartist.DefaultFollowers = followedartistDescFollowers.Default.(int)
+	// followedartist.FollowersValidator is a validator for the "followers" field. It is called by the builders before save.
+	followedartist.FollowersValidator = followedartistDescFollowers.Validators[0].(func(int) error)
+	// followedartistDescPopularity is the schema descriptor for popularity field.
+	followedartistDescPopularity := followedartistFields[2].Descriptor()
+	// followedartist.DefaultPopularity holds the default value on creation for the popularity field.
+	followedartist.DefaultPopularity = followedartistDescPopularity.Default.(int)
+	// followedartist.PopularityValidator is a validator for the "popularity" field. It is called by the builders before save.
+	followedartist.PopularityValidator = followedartistDescPopularity.Validators[0].(func(int) error)
+	// followedartistDescCreatedAt is the schema descriptor for created_at field.
+	followedartistDescCreatedAt := followedartistFields[3].Descriptor()
+	// followedartist.DefaultCreatedAt holds the default value on creation for the created_at field.
+	followedartist.DefaultCreatedAt = followedartistDescCreatedAt.Default.(func() time.Time)
+	// followedartistDescID is the schema descriptor for id field.
+	followedartistDescID := followedartistFields[0].Descriptor()
+	// followedartist.DefaultID holds the default value on creation for the id field.
+	followedartist.DefaultID = followedartistDescID.Default.(func() string)
+	jobFields := schema.Job{}.Fields()
+	_ = jobFields
+	// jobDescType is the schema descriptor for type field.
+	jobDescType := jobFields[1].Descriptor()
+	// job.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	job.TypeValidator = jobDescType.Validators[0].(func(string) error)
+	// jobDescKey is the schema descriptor for key field.
+	jobDescKey := jobFields[2].Descriptor()
+	// job.DefaultKey holds the default value on creation for the key field.
+	job.DefaultKey = jobDescKey.Default.(string)
+	// jobDescError is the schema descriptor for error field.
+	jobDescError := jobFields[6].Descriptor()
+	// job.DefaultError holds the default value on creation for the error field.
+	job.DefaultError = jobDescError.Default.(string)
+	// jobDescAttempts is the schema descriptor for attempts field.
+	jobDescAttempts := jobFields[7].Descriptor()
+	// job.DefaultAttempts holds the default value on creation for the attempts field.
+	job.DefaultAttempts = jobDescAttempts.Default.(int)
+	// job.AttemptsValidator is a validator for the "attempts" field. It is called by the builders before save.
+	job.AttemptsValidator = jobDescAttempts.Validators[0].(func(int) error)
+	// jobDescMaxAttempts is the schema descriptor for max_attempts field.
+	jobDescMaxAttempts := jobFields[8].Descriptor()
+	// job.MaxAttemptsValidator is a validator for the "max_attempts" field. It is called by the builders before save.
+	job.MaxAttemptsValidator = jobDescMaxAttempts.Validators[0].(func(int) error)
+	// jobDescRunAfter is the schema descriptor for run_after field.
+	jobDescRunAfter := jobFields[9].Descriptor()
+	// job.DefaultRunAfter holds the default value on creation for the run_after field.
+	job.DefaultRunAfter = jobDescRunAfter.Default.(func() time.Time)
+	// jobDescLockedBy is the schema descriptor for locked_by field.
+	jobDescLockedBy := jobFields[10].Descriptor()
+	// job.DefaultLockedBy holds the default value on creation for the locked_by field.
+	job.DefaultLockedBy = jobDescLockedBy.Default.(string)
+	// jobDescCreatedAt is the schema descriptor for created_at field.
+	jobDescCreatedAt := jobFields[12].Descriptor()
+	// job.DefaultCreatedAt holds the default value on creation for the created_at field.
+	job.DefaultCreatedAt = jobDescCreatedAt.Default.(func() time.Time)
+	// jobDescID is the schema descriptor for id field.
+	jobDescID := jobFields[0].Descriptor()
+	// job.DefaultID holds the default value on creation for the id field.
+	job.DefaultID = jobDescID.Default.(func() string)
+	leaseFields := schema.Lease{}.Fields()
+	_ = leaseFields
+	// leaseDescName is the schema descriptor for name field.
+	leaseDescName := leaseFields[1].Descriptor()
+	// lease.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	lease.NameValidator = leaseDescName.Validators[0].(func(string) error)
+	// leaseDescHolder is the schema descriptor for holder field.
+	leaseDescHolder := leaseFields[2].Descriptor()
+	// lease.HolderValidator is a validator for the "holder" field. It is called by the builders before save.
+	lease.HolderValidator = leaseDescHolder.Validators[0].(func(string) error)
+	// leaseDescAcquiredAt is the schema descriptor for acquired_at field.
+	leaseDescAcquiredAt := leaseFields[3].Descriptor()
+	// lease.DefaultAcquiredAt holds the default value on creation for the acquired_at field.
+	lease.DefaultAcquiredAt = leaseDescAcquiredAt.Default.(func() time.Time)
+	// leaseDescID is the schema descriptor for id field.
+	leaseDescID := leaseFields[0].Descriptor()
+	// lease.DefaultID holds the default value on creation for the id field.
+	lease.DefaultID = leaseDescID.Default.(func() string)
+	playlistFields := schema.Playlist{}.Fields()
+	_ = playlistFields
+	// playlistDescSpotifyID is the schema descriptor for spotify_id field.
+	playlistDescSpotifyID := playlistFields[1].Descriptor()
+	// playlist.SpotifyIDValidator is a validator for the "spotify_id" field. It is called by the builders before save.
+	playlist.SpotifyIDValidator = playlistDescSpotifyID.Validators[0].(func(string) error)
+	// playlistDescCreatedAt is the schema descriptor for created_at field.
+	playlistDescCreatedAt := playlistFields[2].Descriptor()
+	// playlist.DefaultCreatedAt holds the default value on creation for the created_at field.
+	playlist.DefaultCreatedAt = playlistDescCreatedAt.Default.(func() time.Time)
+	// playlistDescID is the schema descriptor for id field.
+	playlistDescID := playlistFields[0].Descriptor()
+	// playlist.DefaultID holds the default value on creation for the id field.
+	playlist.DefaultID = playlistDescID.Default.(func() string)
+	playlistsnapshotFields := schema.PlaylistSnapshot{}.Fields()
+	_ = playlistsnapshotFields
+	// playlistsnapshotDescDescription is the schema descriptor for description field.
+	playlistsnapshotDescDescription := playlistsnapshotFields[2].Descriptor()
+	// playlistsnapshot.DefaultDescription holds the default value on creation for the description field.
+	playlistsnapshot.DefaultDescription = playlistsnapshotDescDescription.Default.(string)
+	// playlistsnapshotDescOwnerName is the schema descriptor for owner_name field.
+	playlistsnapshotDescOwnerName := playlistsnapshotFields[4].Descriptor()
+	// playlistsnapshot.DefaultOwnerName holds the default value on creation for the owner_name field.
+	playlistsnapshot.DefaultOwnerName = playlistsnapshotDescOwnerName.Default.(string)
+	// playlistsnapshotDescCollaborative is the schema descriptor for collaborative field.
+	playlistsnapshotDescCollaborative := playlistsnapshotFields[5].Descriptor()
+	// playlistsnapshot.DefaultCollaborative holds the default value on creation for the collaborative field.
+	playlistsnapshot.DefaultCollaborative = playlistsnapshotDescCollaborative.Default.(bool)
+	// playlistsnapshotDescTotal is the schema descriptor for total field.
+	playlistsnapshotDescTotal := playlistsnapshotFields[8].Descriptor()
+	// playlistsnapshot.TotalValidator is a validator for the "total" field. It is called by the builders before save.
+	playlistsnapshot.TotalValidator = playlistsnapshotDescTotal.Validators[0].(func(int) error)
+	// playlistsnapshotDescComplete is the schema descriptor for complete field.
+	playlistsnapshotDescComplete := playlistsnapshotFields[9].Descriptor()
+	// playlistsnapshot.DefaultComplete holds the default value on creation for the complete field.
+	playlistsnapshot.DefaultComplete = playlistsnapshotDescComplete.Default.(bool)
+	// playlistsnapshotDescPinned is the schema descriptor for pinned field.
+	playlistsnapshotDescPinned := playlistsnapshotFields[10].Descriptor()
+	// playlistsnapshot.DefaultPinned holds the default value on creation for the pinned field.
+	playlistsnapshot.DefaultPinned = playlistsnapshotDescPinned.Default.(bool)
+	// playlistsnapshotDescNote is the schema descriptor for note field.
+	playlistsnapshotDescNote := playlistsnapshotFields[11].Descriptor()
+	// playlistsnapshot.DefaultNote holds the default value on creation for the note field.
+	playlistsnapshot.DefaultNote = playlistsnapshotDescNote.Default.(string)
+	// playlistsnapshotDescLabels is the schema descriptor for labels field.
+	playlistsnapshotDescLabels := playlistsnapshotFields[12].Descriptor()
+	// playlistsnapshot.DefaultLabels holds the default value on creation for the labels field.
+	playlistsnapshot.DefaultLabels = playlistsnapshotDescLabels.Default.([]string)
+	// playlistsnapshotDescCreatedAt is the schema descriptor for created_at field.
+	playlistsnapshotDescCreatedAt := playlistsnapshotFields[13].Descriptor()
+	// playlistsnapshot.DefaultCreatedAt holds the default value on creation for the created_at field.
+	playlistsnapshot.DefaultCreatedAt = playlistsnapshotDescCreatedAt.Default.(func() time.Time)
+	// playlistsnapshotDescID is the schema descriptor for id field.
+	playlistsnapshotDescID := playlistsnapshotFields[0].Descriptor()
+	// playlistsnapshot.DefaultID holds the default value on creation for the id field.
+	playlistsnapshot.DefaultID = playlistsnapshotDescID.Default.(func() string)
+	restoreplanFields := schema.RestorePlan{}.Fields()
+	_ = restoreplanFields
+	// restoreplanDescUserID is the schema descriptor for user_id field.
+	restoreplanDescUserID := restoreplanFields[1].Descriptor()
+	// restoreplan.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	restoreplan.UserIDValidator = restoreplanDescUserID.Validators[0].(func(string) error)
+	// restoreplanDescRunID is the schema descriptor for run_id field.
+	restoreplanDescRunID := restoreplanFields[2].Descriptor()
+	// restoreplan.DefaultRunID holds the default value on creation for the run_id field.
+	restoreplan.DefaultRunID = restoreplanDescRunID.Default.(string)
+	// restoreplanDescPlaylists is the schema descriptor for playlists field.
+	restoreplanDescPlaylists := restoreplanFields[5].Descriptor()
+	// restoreplan.DefaultPlaylists holds the default value on creation for the playlists field.
+	restoreplan.DefaultPlaylists = restoreplanDescPlaylists.Default.([]schematype.PlaylistRestore)
+	// restoreplanDescCollections is the schema descriptor for collections field.
+	restoreplanDescCollections := restoreplanFields[6].Descriptor()
+	// restoreplan.DefaultCollections holds the default value on creation for the collections field.
+	restoreplan.DefaultCollections = restoreplanDescCollections.Default.([]schematype.CollectionRestore)
+	// restoreplanDescDrift is the schema descriptor for drift field.
+	restoreplanDescDrift := restoreplanFields[7].Descriptor()
+	// restoreplan.DefaultDrift holds the default value on creation for the drift field.
+	restoreplan.DefaultDrift = restoreplanDescDrift.Default.([]string)
+	// restoreplanDescError is the schema descriptor for error field.
+	restoreplanDescError := restoreplanFields[8].Descriptor()
+	// restoreplan.DefaultError holds the default value on creation for the error field.
+	restoreplan.DefaultError = restoreplanDescError.Default.(string)
+	// restoreplanDescCreatedAt is the schema descriptor for created_at field.
+	restoreplanDescCreatedAt := restoreplanFields[9].Descriptor()
+	// restoreplan.DefaultCreatedAt holds the default value on creation for the created_at field.
+	restoreplan.DefaultCreatedAt = restoreplanDescCreatedAt.Default.(func() time.Time)
+	// restoreplanDescID is the schema descriptor for id field.
+	restoreplanDescID := restoreplanFields[0].Descriptor()
+	// restoreplan.DefaultID holds the default value on creation for the id field.
+	restoreplan.DefaultID = restoreplanDescID.Default.(func() string)
+	retentionpolicyFields := schema.RetentionPolicy{}.Fields()
+	_ = retentionpolicyFields
+	// retentionpolicyDescUserID is the schema descriptor for user_id field.
+	retentionpolicyDescUserID := retentionpolicyFields[1].Descriptor()
+	// retentionpolicy.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	retentionpolicy.UserIDValidator = retentionpolicyDescUserID.Validators[0].(func(string) error)
+	// retentionpolicyDescKeepAllDays is the schema descriptor for keep_all_days field.
+	retentionpolicyDescKeepAllDays := retentionpolicyFields[2].Descriptor()
+	// retentionpolicy.KeepAllDaysValidator is a validator for the "keep_all_days" field. It is called by the builders before save.
+	retentionpolicy.KeepAllDaysValidator = retentionpolicyDescKeepAllDays.Validators[0].(func(int) error)
+	// retentionpolicyDescKeepDailyWeeks is the schema descriptor for keep_daily_weeks field.
+	retentionpolicyDescKeepDailyWeeks := retentionpolicyFields[3].Descriptor()
+	// retentionpolicy.KeepDailyWeeksValidator is a validator for the "keep_daily_weeks" field. It is called by the builders before save.
+	retentionpolicy.KeepDailyWeeksValidator = retentionpolicyDescKeepDailyWeeks.Validators[0].(func(int) error)
+	// retentionpolicyDescKeepWeeklyMonths is the schema descriptor for keep_weekly_months field.
+	retentionpolicyDescKeepWeeklyMonths := retentionpolicyFields[4].Descriptor()
+	// retentionpolicy.KeepWeeklyMonthsValidator is a validator for the "keep_weekly_months" field. It is called by the builders before save.
+	retentionpolicy.KeepWeeklyMonthsValidator = retentionpolicyDescKeepWeeklyMonths.Validators[0].(func(int) error)
+	// retentionpolicyDescCreatedAt is the schema descriptor for created_at field.
+	retentionpolicyDescCreatedAt := retentionpolicyFields[5].Descriptor()
+	// retentionpolicy.DefaultCreatedAt holds the default value on creation for the created_at field.
+	retentionpolicy.DefaultCreatedAt = retentionpolicyDescCreatedAt.Default.(func() time.Time)
+	// retentionpolicyDescUpdatedAt is the schema descriptor for updated_at field.
+	retentionpolicyDescUpdatedAt := retentionpolicyFields[6].Descriptor()
+	// retentionpolicy.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	retentionpolicy.DefaultUpdatedAt = retentionpolicyDescUpdatedAt.Default.(func() time.Time)
+	// retentionpolicy.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	retentionpolicy.UpdateDefaultUpdatedAt = retentionpolicyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// retentionpolicyDescID is the schema descriptor for id field.
+	retentionpolicyDescID := retentionpolicyFields[0].Descriptor()
+	// retentionpolicy.DefaultID holds the default value on creation for the id field.
+	retentionpolicy.DefaultID = retentionpolicyDescID.Default.(func() string)
+	savedalbumFields := schema.SavedAlbum{}.Fields()
+	_ = savedalbumFields
+	// savedalbumDescCreatedAt is the schema descriptor for created_at field.
+	savedalbumDescCreatedAt := savedalbumFields[2].Descriptor()
+	// savedalbum.DefaultCreatedAt holds the default value on creation for the created_at field.
+	savedalbum.DefaultCreatedAt = savedalbumDescCreatedAt.Default.(func() time.Time)
+	// savedalbumDescID is the schema descriptor for id field.
+	savedalbumDescID := savedalbumFields[0].Descriptor()
+	// savedalbum.DefaultID holds the default value on creation for the id field.
+	savedalbum.DefaultID = savedalbumDescID.Default.(func() string)
+	savedaudiobookFields := schema.SavedAudiobook{}.Fields()
+	_ = savedaudiobookFields
+	// savedaudiobookDescPublisher is the schema descriptor for publisher field.
+	savedaudiobookDescPublisher := savedaudiobookFields[7].Descriptor()
+	// savedaudiobook.DefaultPublisher holds the default value on creation for the publisher field.
+	savedaudiobook.DefaultPublisher = savedaudiobookDescPublisher.Default.(string)
+	// savedaudiobookDescTotalChapters is the schema descriptor for total_chapters field.
+	savedaudiobookDescTotalChapters := savedaudiobookFields[8].Descriptor()
+	// savedaudiobook.TotalChaptersValidator is a validator for the "total_chapters" field. It is called by the builders before save.
+	savedaudiobook.TotalChaptersValidator = savedaudiobookDescTotalChapters.Validators[0].(func(int) error)
+	// savedaudiobookDescCreatedAt is the schema descriptor for created_at field.
+	savedaudiobookDescCreatedAt := savedaudiobookFields[9].Descriptor()
+	// savedaudiobook.DefaultCreatedAt holds the default value on creation for the created_at field.
+	savedaudiobook.DefaultCreatedAt = savedaudiobookDescCreatedAt.Default.(func() time.Time)
+	// savedaudiobookDescID is the schema descriptor for id field.
+	savedaudiobookDescID := savedaudiobookFields[0].Descriptor()
+	// savedaudiobook.DefaultID holds the default value on creation for the id field.
+	savedaudiobook.DefaultID = savedaudiobookDescID.Default.(func() string)
+	savedepisodeFields := schema.SavedEpisode{}.Fields()
+	_ = savedepisodeFields
+	// savedepisodeDescFullyPlayed is the schema descriptor for fully_played field.
+	savedepisodeDescFullyPlayed := savedepisodeFields[2].Descriptor()
+	// savedepisode.DefaultFullyPlayed holds the default value on creation for the fully_played field.
+	savedepisode.DefaultFullyPlayed = savedepisodeDescFullyPlayed.Default.(bool)
+	// savedepisodeDescResumePositionMs is the schema descriptor for resume_position_ms field.
+	savedepisodeDescResumePositionMs := savedepisodeFields[3].Descriptor()
+	// savedepisode.DefaultResumePositionMs holds the default value on creation for the resume_position_ms field.
+	savedepisode.DefaultResumePositionMs = savedepisodeDescResumePositionMs.Default.(int)
+	// savedepisode.ResumePositionMsValidator is a validator for the "resume_position_ms" field. It is called by the builders before save.
+	savedepisode.ResumePositionMsValidator = savedepisodeDescResumePositionMs.Validators[0].(func(int) error)
+	// savedepisodeDescCreatedAt is the schema descriptor for created_at field.
+	savedepisodeDescCreatedAt := savedepisodeFields[4].Descriptor()
+	// savedepisode.DefaultCreatedAt holds the default value on creation for the created_at field.
+	savedepisode.DefaultCreatedAt = savedepisodeDescCreatedAt.Default.(func() time.Time)
+	// savedepisodeDescID is the schema descriptor for id field.
+	savedepisodeDescID := savedepisodeFields[0].Descriptor()
+	// savedepisode.DefaultID holds the default value on creation for the id field.
+	savedepisode.DefaultID = savedepisodeDescID.Default.(func() string)
+	savedshowFields := schema.SavedShow{}.Fields()
+	_ = savedshowFields
+	// savedshowDescTotalEpisodes is the schema descriptor for total_episodes field.
+	savedshowDescTotalEpisodes := savedshowFields[2].Descriptor()
+	// savedshow.TotalEpisodesValidator is a validator for the "total_episodes" field. It is called by the builders before save.
+	savedshow.TotalEpisodesValidator = savedshowDescTotalEpisodes.Validators[0].(func(int) error)
+	// savedshowDescCreatedAt is the schema descriptor for created_at field.
+	savedshowDescCreatedAt := savedshowFields[3].Descriptor()
+	// savedshow.DefaultCreatedAt holds the default value on creation for the created_at field.
+	savedshow.DefaultCreatedAt = savedshowDescCreatedAt.Default.(func() time.Time)
+	// savedshowDescID is the schema descriptor for id field.
+	savedshowDescID := savedshowFields[0].Descriptor()
+	// savedshow.DefaultID holds the default value on creation for the id field.
+	savedshow.DefaultID = savedshowDescID.Default.(func() string)
+	savedtrackFields := schema.SavedTrack{}.Fields()
+	_ = savedtrackFields
+	// savedtrackDescLinkedFromID is the schema descriptor for linked_from_id field.
+	savedtrackDescLinkedFromID := savedtrackFields[2].Descriptor()
+	// savedtrack.DefaultLinkedFromID holds the default value on creation for the linked_from_id field.
+	savedtrack.DefaultLinkedFromID = savedtrackDescLinkedFromID.Default.(string)
+	// savedtrackDescCreatedAt is the schema descriptor for created_at field.
+	savedtrackDescCreatedAt := savedtrackFields[4].Descriptor()
+	// savedtrack.DefaultCreatedAt holds the default value on creation for the created_at field.
+	savedtrack.DefaultCreatedAt = savedtrackDescCreatedAt.Default.(func() time.Time)
+	// savedtrackDescID is the schema descriptor for id field.
+	savedtrackDescID := savedtrackFields[0].Descriptor()
+	// savedtrack.DefaultID holds the default value on creation for the id field.
+	savedtrack.DefaultID = savedtrackDescID.Default.(func() string)
+	schemamigrationFields := schema.SchemaMigration{}.Fields()
+	_ = schemamigrationFields
+	// schemamigrationDescName is the schema descriptor for name field.
+	schemamigrationDescName := schemamigrationFields[1].Descriptor()
+	// schemamigration.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	schemamigration.NameValidator = schemamigrationDescName.Validators[0].(func(string) error)
+	// schemamigrationDescAppliedAt is the schema descriptor for applied_at field.
+	schemamigrationDescAppliedAt := schemamigrationFields[2].Descriptor()
+	// schemamigration.DefaultAppliedAt holds the default value on creation for the applied_at field.
+	schemamigration.DefaultAppliedAt = schemamigrationDescAppliedAt.Default.(func() time.Time)
+	// schemamigrationDescID is the schema descriptor for id field.
+	schemamigrationDescID := schemamigrationFields[0].Descriptor()
+	// schemamigration.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	schemamigration.IDValidator = schemamigrationDescID.Validators[0].(func(int) error)
+	showFields := schema.Show{}.Fields()
+	_ = showFields
+	// showDescSpotifyID is the schema descriptor for spotify_id field.
+	showDescSpotifyID := showFields[1].Descriptor()
+	// show.SpotifyIDValidator is a validator for the "spotify_id" field. It is called by the builders before save.
+	show.SpotifyIDValidator = showDescSpotifyID.Validators[0].(func(string) error)
+	// showDescPublisher is the schema descriptor for publisher field.
+	showDescPublisher := showFields[4].Descriptor()
+	// show.DefaultPublisher holds the default value on creation for the publisher field.
+	show.DefaultPublisher = showDescPublisher.Default.(string)
+	// showDescCreatedAt is the schema descriptor for created_at field.
+	showDescCreatedAt := showFields[5].Descriptor()
+	// show.DefaultCreatedAt holds the default value on creation for the created_at field.
+	show.DefaultCreatedAt = showDescCreatedAt.Default.(func() time.Time)
+	// showDescID is the schema descriptor for id field.
+	showDescID := showFields[0].Descriptor()
+	// show.DefaultID holds the default value on creation for the id field.
+	show.DefaultID = showDescID.Default.(func() string)
+	snapshotitemFields := schema.SnapshotItem{}.Fields()
+	_ = snapshotitemFields
+	// snapshotitemDescPosition is the schema descriptor for position field.
+	snapshotitemDescPosition := snapshotitemFields[1].Descriptor()
+	// snapshotitem.PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	snapshotitem.PositionValidator = snapshotitemDescPosition.Validators[0].(func(int) error)
+	// snapshotitemDescAddedBy is the schema descriptor for added_by field.
+	snapshotitemDescAddedBy := snapshotitemFields[3].Descriptor()
+	// snapshotitem.DefaultAddedBy holds the default value on creation for the added_by field.
+	snapshotitem.DefaultAddedBy = snapshotitemDescAddedBy.Default.(string)
+	// snapshotitemDescIsLocal is the schema descriptor for is_local field.
+	snapshotitemDescIsLocal := snapshotitemFields[4].Descriptor()
+	// snapshotitem.DefaultIsLocal holds the default value on creation for the is_local field.
+	snapshotitem.DefaultIsLocal = snapshotitemDescIsLocal.Default.(bool)
+	// snapshotitemDescLinkedFromID is the schema descriptor for linked_from_id field.
+	snapshotitemDescLinkedFromID := snapshotitemFields[7].Descriptor()
+	// snapshotitem.DefaultLinkedFromID holds the default value on creation for the linked_from_id field.
+	snapshotitem.DefaultLinkedFromID = snapshotitemDescLinkedFromID.Default.(string)
+	// snapshotitemDescID is the schema descriptor for id field.
+	snapshotitemDescID := snapshotitemFields[0].Descriptor()
+	// snapshotitem.DefaultID holds the default value on creation for the id field.
+	snapshotitem.DefaultID = snapshotitemDescID.Default.(func() string)
+	spotifytokenFields := schema.SpotifyToken{}.Fields()
+	_ = spotifytokenFields
+	// spotifytokenDescName is the schema descriptor for name field.
+	spotifytokenDescName := spotifytokenFields[1].Descriptor()
+	// spotifytoken.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	spotifytoken.NameValidator = spotifytokenDescName.Validators[0].(func(string) error)
+	// spotifytokenDescToken is the schema descriptor for token field.
+	spotifytokenDescToken := spotifytokenFields[2].Descriptor()
+	// spotifytoken.TokenValidator is a validator for the "token" field. It is called by the builders before save.
+	spotifytoken.TokenValidator = spotifytokenDescToken.Validators[0].(func([]byte) error)
+	// spotifytokenDescUpdatedAt is the schema descriptor for updated_at field.
+	spotifytokenDescUpdatedAt := spotifytokenFields[3].Descriptor()
+	// spotifytoken.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	spotifytoken.DefaultUpdatedAt = spotifytokenDescUpdatedAt.Default.(func() time.Time)
+	// spotifytoken.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	spotifytoken.UpdateDefaultUpdatedAt = spotifytokenDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// spotifytokenDescID is the schema descriptor for id field.
+	spotifytokenDescID := spotifytokenFields[0].Descriptor()
+	// spotifytoken.DefaultID holds the default value on creation for the id field.
+	spotifytoken.DefaultID = spotifytokenDescID.Default.(func() string)
+	trackFields := schema.Track{}.Fields()
+	_ = trackFields
+	// trackDescSpotifyID is the schema descriptor for spotify_id field.
+	trackDescSpotifyID := trackFields[1].Descriptor()
+	// track.SpotifyIDValidator is a validator for the "spotify_id" field. It is called by the builders before save.
+	track.SpotifyIDValidator = trackDescSpotifyID.Validators[0].(func(string) error)
+	// trackDescDurationMs is the schema descriptor for duration_ms field.
+	trackDescDurationMs := trackFields[4].Descriptor()
+	// track.DurationMsValidator is a validator for the "duration_ms" field. It is called by the builders before save.
+	track.DurationMsValidator = trackDescDurationMs.Validators[0].(func(int) error)
+	// trackDescExplicit is the schema descriptor for explicit field.
+	trackDescExplicit := trackFields[5].Descriptor()
+	// track.DefaultExplicit holds the default value on creation for the explicit field.
+	track.DefaultExplicit = trackDescExplicit.Default.(bool)
+	// trackDescDiscNumber is the schema descriptor for disc_number field.
+	trackDescDiscNumber := trackFields[6].Descriptor()
+	// track.DefaultDiscNumber holds the default value on creation for the disc_number field.
+	track.DefaultDiscNumber = trackDescDiscNumber.Default.(int)
+	// track.DiscNumberValidator is a validator for the "disc_number" field. It is called by the builders before save.
+	track.DiscNumberValidator = trackDescDiscNumber.Validators[0].(func(int) error)
+	// trackDescTrackNumber is the schema descriptor for track_number field.
+	trackDescTrackNumber := trackFields[7].Descriptor()
+	// track.DefaultTrackNumber holds the default value on creation for the track_number field.
+	track.DefaultTrackNumber = trackDescTrackNumber.Default.(int)
+	// track.TrackNumberValidator is a validator for the "track_number" field. It is called by the builders before save.
+	track.TrackNumberValidator = trackDescTrackNumber.Validators[0].(func(int) error)
+	// trackDescIsrc is the schema descriptor for isrc field.
+	trackDescIsrc := trackFields[8].Descriptor()
+	// track.DefaultIsrc holds the default value on creation for the isrc field.
+	track.DefaultIsrc = trackDescIsrc.Default.(string)
+	// trackDescCreatedAt is the schema descriptor for created_at field.
+	trackDescCreatedAt := trackFields[9].Descriptor()
+	// track.DefaultCreatedAt holds the default value on creation for the created_at field.
+	track.DefaultCreatedAt = trackDescCreatedAt.Default.(func() time.Time)
+	// trackDescID is the schema descriptor for id field.
+	trackDescID := trackFields[0].Descriptor()
+	// track.DefaultID holds the default value on creation for the id field.
+	track.DefaultID = trackDescID.Default.(func() string)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescID is the schema descriptor for id field.
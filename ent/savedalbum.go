@@ -0,0 +1,183 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/album"
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/savedalbum"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// SavedAlbum is the model entity for the SavedAlbum schema.
+type SavedAlbum struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// AddedAt holds the value of the "added_at" field.
+	AddedAt time.Time `json:"added_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SavedAlbumQuery when eager-loading is set.
+	Edges                   SavedAlbumEdges `json:"edges"`
+	backup_run_saved_albums *string
+	saved_album_album       *string
+	selectValues            sql.SelectValues
+}
+
+// SavedAlbumEdges holds the relations/edges for other nodes in the graph.
+type SavedAlbumEdges struct {
+	// Run holds the value of the run edge.
+	Run *BackupRun `json:"run,omitempty"`
+	// Album holds the value of the album edge.
+	Album *Album `json:"album,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// RunOrErr returns the Run value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SavedAlbumEdges) RunOrErr() (*BackupRun, error) {
+	if e.Run != nil {
+		return e.Run, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: backuprun.Label}
+	}
+	return nil, &NotLoadedError{edge: "run"}
+}
+
+// AlbumOrErr returns the Album value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SavedAlbumEdges) AlbumOrErr() (*Album, error) {
+	if e.Album != nil {
+		return e.Album, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: album.Label}
+	}
+	return nil, &NotLoadedError{edge: "album"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SavedAlbum) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case savedalbum.FieldID:
+			values[i] = new(sql.NullString)
+		case savedalbum.FieldAddedAt, savedalbum.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case savedalbum.ForeignKeys[0]: // backup_run_saved_albums
+			values[i] = new(sql.NullString)
+		case savedalbum.ForeignKeys[1]: // saved_album_album
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SavedAlbum fields.
+func (sa *SavedAlbum) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case savedalbum.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				sa.ID = value.String
+			}
+		case savedalbum.FieldAddedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field added_at", values[i])
+			} else if value.Valid {
+				sa.AddedAt = value.Time
+			}
+		case savedalbum.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sa.CreatedAt = value.Time
+			}
+		case savedalbum.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field backup_run_saved_albums", values[i])
+			} else if value.Valid {
+				sa.backup_run_saved_albums = new(string)
+				*sa.backup_run_saved_albums = value.String
+			}
+		case savedalbum.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field saved_album_album", values[i])
+			} else if value.Valid {
+				sa.saved_album_album = new(string)
+				*sa.saved_album_album = value.String
+			}
+		default:
+			sa.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SavedAlbum.
+// This includes values selected through modifiers, order, etc.
+func (sa *SavedAlbum) Value(name string) (ent.Value, error) {
+	return sa.selectValues.Get(name)
+}
+
+// QueryRun queries the "run" edge of the SavedAlbum entity.
+func (sa *SavedAlbum) QueryRun() *BackupRunQuery {
+	return NewSavedAlbumClient(sa.config).QueryRun(sa)
+}
+
+// QueryAlbum queries the "album" edge of the SavedAlbum entity.
+func (sa *SavedAlbum) QueryAlbum() *AlbumQuery {
+	return NewSavedAlbumClient(sa.config).QueryAlbum(sa)
+}
+
+// Update returns a builder for updating this SavedAlbum.
+// Note that you need to call SavedAlbum.Unwrap() before calling this method if this SavedAlbum
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sa *SavedAlbum) Update() *SavedAlbumUpdateOne {
+	return NewSavedAlbumClient(sa.config).UpdateOne(sa)
+}
+
+// Unwrap unwraps the SavedAlbum entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sa *SavedAlbum) Unwrap() *SavedAlbum {
+	_tx, ok := sa.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SavedAlbum is not a transactional entity")
+	}
+	sa.config.driver = _tx.drv
+	return sa
+}
+
+// String implements the fmt.Stringer.
+func (sa *SavedAlbum) String() string {
+	var builder strings.Builder
+	builder.WriteString("SavedAlbum(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sa.ID))
+	builder.WriteString("added_at=")
+	builder.WriteString(sa.AddedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(sa.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SavedAlbums is a parsable slice of SavedAlbum.
+type SavedAlbums []*SavedAlbum
@@ -0,0 +1,302 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/album"
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/savedalbum"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SavedAlbumCreate is the builder for creating a SavedAlbum entity.
+type SavedAlbumCreate struct {
+	config
+	mutation *SavedAlbumMutation
+	hooks    []Hook
+}
+
+// SetAddedAt sets the "added_at" field.
+func (sac *SavedAlbumCreate) SetAddedAt(t time.Time) *SavedAlbumCreate {
+	sac.mutation.SetAddedAt(t)
+	return sac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sac *SavedAlbumCreate) SetCreatedAt(t time.Time) *SavedAlbumCreate {
+	sac.mutation.SetCreatedAt(t)
+	return sac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sac *SavedAlbumCreate) SetNillableCreatedAt(t *time.Time) *SavedAlbumCreate {
+	if t != nil {
+		sac.SetCreatedAt(*t)
+	}
+	return sac
+}
+
+// SetID sets the "id" field.
+func (sac *SavedAlbumCreate) SetID(s string) *SavedAlbumCreate {
+	sac.mutation.SetID(s)
+	return sac
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (sac *SavedAlbumCreate) SetNillableID(s *string) *SavedAlbumCreate {
+	if s != nil {
+		sac.SetID(*s)
+	}
+	return sac
+}
+
+// SetRunID sets the "run" edge to the BackupRun entity by ID.
+func (sac *SavedAlbumCreate) SetRunID(id string) *SavedAlbumCreate {
+	sac.mutation.SetRunID(id)
+	return sac
+}
+
+// SetRun sets the "run" edge to the BackupRun entity.
+func (sac *SavedAlbumCreate) SetRun(b *BackupRun) *SavedAlbumCreate {
+	return sac.SetRunID(b.ID)
+}
+
+// SetAlbumID sets the "album" edge to the Album entity by ID.
+func (sac *SavedAlbumCreate) SetAlbumID(id string) *SavedAlbumCreate {
+	sac.mutation.SetAlbumID(id)
+	return sac
+}
+
+// SetAlbum sets the "album" edge to the Album entity.
+func (sac *SavedAlbumCreate) SetAlbum(a *Album) *SavedAlbumCreate {
+	return sac.SetAlbumID(a.ID)
+}
+
+// Mutation returns the SavedAlbumMutation object of the builder.
+func (sac *SavedAlbumCreate) Mutation() *SavedAlbumMutation {
+	return sac.mutation
+}
+
+// Save creates the SavedAlbum in the database.
+func (sac *SavedAlbumCreate) Save(ctx context.Context) (*SavedAlbum, error) {
+	sac.defaults()
+	return withHooks(ctx, sac.sqlSave, sac.mutation, sac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sac *SavedAlbumCreate) SaveX(ctx context.Context) *SavedAlbum {
+	v, err := sac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sac *SavedAlbumCreate) Exec(ctx context.Context) error {
+	_, err := sac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sac *SavedAlbumCreate) ExecX(ctx context.Context) {
+	if err := sac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sac *SavedAlbumCreate) defaults() {
+	if _, ok := sac.mutation.CreatedAt(); !ok {
+		v := savedalbum.DefaultCreatedAt()
+		sac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sac.mutation.ID(); !ok {
+		v := savedalbum.DefaultID()
+		sac.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sac *SavedAlbumCreate) check() error {
+	if _, ok := sac.mutation.AddedAt(); !ok {
+		return &ValidationError{Name: "added_at", err: errors.New(`ent: missing required field "SavedAlbum.added_at"`)}
+	}
+	if _, ok := sac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SavedAlbum.created_at"`)}
+	}
+	if len(sac.mutation.RunIDs()) == 0 {
+		return &ValidationError{Name: "run", err: errors.New(`ent: missing required edge "SavedAlbum.run"`)}
+	}
+	if len(sac.mutation.AlbumIDs()) == 0 {
+		return &ValidationError{Name: "album", err: errors.New(`ent: missing required edge "SavedAlbum.album"`)}
+	}
+	return nil
+}
+
+func (sac *SavedAlbumCreate) sqlSave(ctx context.Context) (*SavedAlbum, error) {
+	if err := sac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected SavedAlbum.ID type: %T", _spec.ID.Value)
+		}
+	}
+	sac.mutation.id = &_node.ID
+	sac.mutation.done = true
+	return _node, nil
+}
+
+func (sac *SavedAlbumCreate) createSpec() (*SavedAlbum, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SavedAlbum{config: sac.config}
+		_spec = sqlgraph.NewCreateSpec(savedalbum.Table, sqlgraph.NewFieldSpec(savedalbum.FieldID, field.TypeString))
+	)
+	if id, ok := sac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := sac.mutation.AddedAt(); ok {
+		_spec.SetField(savedalbum.FieldAddedAt, field.TypeTime, value)
+		_node.AddedAt = value
+	}
+	if value, ok := sac.mutation.CreatedAt(); ok {
+		_spec.SetField(savedalbum.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := sac.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedalbum.RunTable,
+			Columns: []string{savedalbum.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.backup_run_saved_albums = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sac.mutation.AlbumIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   savedalbum.AlbumTable,
+			Columns: []string{savedalbum.AlbumColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(album.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.saved_album_album = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SavedAlbumCreateBulk is the builder for creating many SavedAlbum entities in bulk.
+type SavedAlbumCreateBulk struct {
+	config
+	err      error
+	builders []*SavedAlbumCreate
+}
+
+// Save creates the SavedAlbum entities in the database.
+func (sacb *SavedAlbumCreateBulk) Save(ctx context.Context) ([]*SavedAlbum, error) {
+	if sacb.err != nil {
+		return nil, sacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sacb.builders))
+	nodes := make([]*SavedAlbum, len(sacb.builders))
+	mutators := make([]Mutator, len(sacb.builders))
+	for i := range sacb.builders {
+		func(i int, root context.Context) {
+			builder := sacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SavedAlbumMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sacb *SavedAlbumCreateBulk) SaveX(ctx context.Context) []*SavedAlbum {
+	v, err := sacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sacb *SavedAlbumCreateBulk) Exec(ctx context.Context) error {
+	_, err := sacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sacb *SavedAlbumCreateBulk) ExecX(ctx context.Context) {
+	if err := sacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
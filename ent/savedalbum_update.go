@@ -0,0 +1,424 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/album"
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/predicate"
+	"beyerleinf/spotify-backup/ent/savedalbum"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SavedAlbumUpdate is the builder for updating SavedAlbum entities.
+type SavedAlbumUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SavedAlbumMutation
+}
+
+// Where appends a list predicates to the SavedAlbumUpdate builder.
+func (sau *SavedAlbumUpdate) Where(ps ...predicate.SavedAlbum) *SavedAlbumUpdate {
+	sau.mutation.Where(ps...)
+	return sau
+}
+
+// SetAddedAt sets the "added_at" field.
+func (sau *SavedAlbumUpdate) SetAddedAt(t time.Time) *SavedAlbumUpdate {
+	sau.mutation.SetAddedAt(t)
+	return sau
+}
+
+// SetNillableAddedAt sets the "added_at" field if the given value is not nil.
+func (sau *SavedAlbumUpdate) SetNillableAddedAt(t *time.Time) *SavedAlbumUpdate {
+	if t != nil {
+		sau.SetAddedAt(*t)
+	}
+	return sau
+}
+
+// SetRunID sets the "run" edge to the BackupRun entity by ID.
+func (sau *SavedAlbumUpdate) SetRunID(id string) *SavedAlbumUpdate {
+	sau.mutation.SetRunID(id)
+	return sau
+}
+
+// SetRun sets the "run" edge to the BackupRun entity.
+func (sau *SavedAlbumUpdate) SetRun(b *BackupRun) *SavedAlbumUpdate {
+	return sau.SetRunID(b.ID)
+}
+
+// SetAlbumID sets the "album" edge to the Album entity by ID.
+func (sau *SavedAlbumUpdate) SetAlbumID(id string) *SavedAlbumUpdate {
+	sau.mutation.SetAlbumID(id)
+	return sau
+}
+
+// SetAlbum sets the "album" edge to the Album entity.
+func (sau *SavedAlbumUpdate) SetAlbum(a *Album) *SavedAlbumUpdate {
+	return sau.SetAlbumID(a.ID)
+}
+
+// Mutation returns the SavedAlbumMutation object of the builder.
+func (sau *SavedAlbumUpdate) Mutation() *SavedAlbumMutation {
+	return sau.mutation
+}
+
+// ClearRun clears the "run" edge to the BackupRun entity.
+func (sau *SavedAlbumUpdate) ClearRun() *SavedAlbumUpdate {
+	sau.mutation.ClearRun()
+	return sau
+}
+
+// ClearAlbum clears the "album" edge to the Album entity.
+func (sau *SavedAlbumUpdate) ClearAlbum() *SavedAlbumUpdate {
+	sau.mutation.ClearAlbum()
+	return sau
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sau *SavedAlbumUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, sau.sqlSave, sau.mutation, sau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sau *SavedAlbumUpdate) SaveX(ctx context.Context) int {
+	affected, err := sau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sau *SavedAlbumUpdate) Exec(ctx context.Context) error {
+	_, err := sau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sau *SavedAlbumUpdate) ExecX(ctx context.Context) {
+	if err := sau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sau *SavedAlbumUpdate) check() error {
+	if sau.mutation.RunCleared() && len(sau.mutation.RunIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SavedAlbum.run"`)
+	}
+	if sau.mutation.AlbumCleared() && len(sau.mutation.AlbumIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SavedAlbum.album"`)
+	}
+	return nil
+}
+
+func (sau *SavedAlbumUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := sau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(savedalbum.Table, savedalbum.Columns, sqlgraph.NewFieldSpec(savedalbum.FieldID, field.TypeString))
+	if ps := sau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sau.mutation.AddedAt(); ok {
+		_spec.SetField(savedalbum.FieldAddedAt, field.TypeTime, value)
+	}
+	if sau.mutation.RunCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedalbum.RunTable,
+			Columns: []string{savedalbum.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sau.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedalbum.RunTable,
+			Columns: []string{savedalbum.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if sau.mutation.AlbumCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   savedalbum.AlbumTable,
+			Columns: []string{savedalbum.AlbumColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(album.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sau.mutation.AlbumIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   savedalbum.AlbumTable,
+			Columns: []string{savedalbum.AlbumColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(album.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{savedalbum.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sau.mutation.done = true
+	return n, nil
+}
+
+// SavedAlbumUpdateOne is the builder for updating a single SavedAlbum entity.
+type SavedAlbumUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SavedAlbumMutation
+}
+
+// SetAddedAt sets the "added_at" field.
+func (sauo *SavedAlbumUpdateOne) SetAddedAt(t time.Time) *SavedAlbumUpdateOne {
+	sauo.mutation.SetAddedAt(t)
+	return sauo
+}
+
+// SetNillableAddedAt sets the "added_at" field if the given value is not nil.
+func (sauo *SavedAlbumUpdateOne) SetNillableAddedAt(t *time.Time) *SavedAlbumUpdateOne {
+	if t != nil {
+		sauo.SetAddedAt(*t)
+	}
+	return sauo
+}
+
+// SetRunID sets the "run" edge to the BackupRun entity by ID.
+func (sauo *SavedAlbumUpdateOne) SetRunID(id string) *SavedAlbumUpdateOne {
+	sauo.mutation.SetRunID(id)
+	return sauo
+}
+
+// SetRun sets the "run" edge to the BackupRun entity.
+func (sauo *SavedAlbumUpdateOne) SetRun(b *BackupRun) *SavedAlbumUpdateOne {
+	return sauo.SetRunID(b.ID)
+}
+
+// SetAlbumID sets the "album" edge to the Album entity by ID.
+func (sauo *SavedAlbumUpdateOne) SetAlbumID(id string) *SavedAlbumUpdateOne {
+	sauo.mutation.SetAlbumID(id)
+	return sauo
+}
+
+// SetAlbum sets the "album" edge to the Album entity.
+func (sauo *SavedAlbumUpdateOne) SetAlbum(a *Album) *SavedAlbumUpdateOne {
+	return sauo.SetAlbumID(a.ID)
+}
+
+// Mutation returns the SavedAlbumMutation object of the builder.
+func (sauo *SavedAlbumUpdateOne) Mutation() *SavedAlbumMutation {
+	return sauo.mutation
+}
+
+// ClearRun clears the "run" edge to the BackupRun entity.
+func (sauo *SavedAlbumUpdateOne) ClearRun() *SavedAlbumUpdateOne {
+	sauo.mutation.ClearRun()
+	return sauo
+}
+
+// ClearAlbum clears the "album" edge to the Album entity.
+func (sauo *SavedAlbumUpdateOne) ClearAlbum() *SavedAlbumUpdateOne {
+	sauo.mutation.ClearAlbum()
+	return sauo
+}
+
+// Where appends a list predicates to the SavedAlbumUpdate builder.
+func (sauo *SavedAlbumUpdateOne) Where(ps ...predicate.SavedAlbum) *SavedAlbumUpdateOne {
+	sauo.mutation.Where(ps...)
+	return sauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sauo *SavedAlbumUpdateOne) Select(field string, fields ...string) *SavedAlbumUpdateOne {
+	sauo.fields = append([]string{field}, fields...)
+	return sauo
+}
+
+// Save executes the query and returns the updated SavedAlbum entity.
+func (sauo *SavedAlbumUpdateOne) Save(ctx context.Context) (*SavedAlbum, error) {
+	return withHooks(ctx, sauo.sqlSave, sauo.mutation, sauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sauo *SavedAlbumUpdateOne) SaveX(ctx context.Context) *SavedAlbum {
+	node, err := sauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sauo *SavedAlbumUpdateOne) Exec(ctx context.Context) error {
+	_, err := sauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sauo *SavedAlbumUpdateOne) ExecX(ctx context.Context) {
+	if err := sauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sauo *SavedAlbumUpdateOne) check() error {
+	if sauo.mutation.RunCleared() && len(sauo.mutation.RunIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SavedAlbum.run"`)
+	}
+	if sauo.mutation.AlbumCleared() && len(sauo.mutation.AlbumIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SavedAlbum.album"`)
+	}
+	return nil
+}
+
+func (sauo *SavedAlbumUpdateOne) sqlSave(ctx context.Context) (_node *SavedAlbum, err error) {
+	if err := sauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(savedalbum.Table, savedalbum.Columns, sqlgraph.NewFieldSpec(savedalbum.FieldID, field.TypeString))
+	id, ok := sauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SavedAlbum.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, savedalbum.FieldID)
+		for _, f := range fields {
+			if !savedalbum.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != savedalbum.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sauo.mutation.AddedAt(); ok {
+		_spec.SetField(savedalbum.FieldAddedAt, field.TypeTime, value)
+	}
+	if sauo.mutation.RunCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedalbum.RunTable,
+			Columns: []string{savedalbum.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sauo.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedalbum.RunTable,
+			Columns: []string{savedalbum.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if sauo.mutation.AlbumCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   savedalbum.AlbumTable,
+			Columns: []string{savedalbum.AlbumColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(album.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sauo.mutation.AlbumIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   savedalbum.AlbumTable,
+			Columns: []string{savedalbum.AlbumColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(album.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SavedAlbum{config: sauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{savedalbum.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	sauo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,243 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/savedaudiobook"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// SavedAudiobook is the model entity for the SavedAudiobook schema.
+type SavedAudiobook struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// AddedAt holds the value of the "added_at" field.
+	AddedAt *time.Time `json:"added_at,omitempty"`
+	// SpotifyID holds the value of the "spotify_id" field.
+	SpotifyID string `json:"spotify_id,omitempty"`
+	// URI holds the value of the "uri" field.
+	URI string `json:"uri,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Authors holds the value of the "authors" field.
+	Authors []string `json:"authors,omitempty"`
+	// Narrators holds the value of the "narrators" field.
+	Narrators []string `json:"narrators,omitempty"`
+	// Publisher holds the value of the "publisher" field.
+	Publisher string `json:"publisher,omitempty"`
+	// TotalChapters holds the value of the "total_chapters" field.
+	TotalChapters int `json:"total_chapters,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SavedAudiobookQuery when eager-loading is set.
+	Edges                       SavedAudiobookEdges `json:"edges"`
+	backup_run_saved_audiobooks *string
+	selectValues                sql.SelectValues
+}
+
+// SavedAudiobookEdges holds the relations/edges for other nodes in the graph.
+type SavedAudiobookEdges struct {
+	// Run holds the value of the run edge.
+	Run *BackupRun `json:"run,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// RunOrErr returns the Run value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SavedAudiobookEdges) RunOrErr() (*BackupRun, error) {
+	if e.Run != nil {
+		return e.Run, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: backuprun.Label}
+	}
+	return nil, &NotLoadedError{edge: "run"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SavedAudiobook) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case savedaudiobook.FieldAuthors, savedaudiobook.FieldNarrators:
+			values[i] = new([]byte)
+		case savedaudiobook.FieldTotalChapters:
+			values[i] = new(sql.NullInt64)
+		case savedaudiobook.FieldID, savedaudiobook.FieldSpotifyID, savedaudiobook.FieldURI, savedaudiobook.FieldName, savedaudiobook.FieldPublisher:
+			values[i] = new(sql.NullString)
+		case savedaudiobook.FieldAddedAt, savedaudiobook.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case savedaudiobook.ForeignKeys[0]: // backup_run_saved_audiobooks
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SavedAudiobook fields.
+func (sa *SavedAudiobook) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case savedaudiobook.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				sa.ID = value.String
+			}
+		case savedaudiobook.FieldAddedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field added_at", values[i])
+			} else if value.Valid {
+				sa.AddedAt = new(time.Time)
+				*sa.AddedAt = value.Time
+			}
+		case savedaudiobook.FieldSpotifyID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field spotify_id", values[i])
+			} else if value.Valid {
+				sa.SpotifyID = value.String
+			}
+		case savedaudiobook.FieldURI:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field uri", values[i])
+			} else if value.Valid {
+				sa.URI = value.String
+			}
+		case savedaudiobook.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				sa.Name = value.String
+			}
+		case savedaudiobook.FieldAuthors:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field authors", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &sa.Authors); err != nil {
+					return fmt.Errorf("unmarshal field authors: %w", err)
+				}
+			}
+		case savedaudiobook.FieldNarrators:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field narrators", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &sa.Narrators); err != nil {
+					return fmt.Errorf("unmarshal field narrators: %w", err)
+				}
+			}
+		case savedaudiobook.FieldPublisher:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field publisher", values[i])
+			} else if value.Valid {
+				sa.Publisher = value.String
+			}
+		case savedaudiobook.FieldTotalChapters:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_chapters", values[i])
+			} else if value.Valid {
+				sa.TotalChapters = int(value.Int64)
+			}
+		case savedaudiobook.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sa.CreatedAt = value.Time
+			}
+		case savedaudiobook.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field backup_run_saved_audiobooks", values[i])
+			} else if value.Valid {
+				sa.backup_run_saved_audiobooks = new(string)
+				*sa.backup_run_saved_audiobooks = value.String
+			}
+		default:
+			sa.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SavedAudiobook.
+// This includes values selected through modifiers, order, etc.
+func (sa *SavedAudiobook) Value(name string) (ent.Value, error) {
+	return sa.selectValues.Get(name)
+}
+
+// QueryRun queries the "run" edge of the SavedAudiobook entity.
+func (sa *SavedAudiobook) QueryRun() *BackupRunQuery {
+	return NewSavedAudiobookClient(sa.config).QueryRun(sa)
+}
+
+// Update returns a builder for updating this SavedAudiobook.
+// Note that you need to call SavedAudiobook.Unwrap() before calling this method if this SavedAudiobook
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sa *SavedAudiobook) Update() *SavedAudiobookUpdateOne {
+	return NewSavedAudiobookClient(sa.config).UpdateOne(sa)
+}
+
+// Unwrap unwraps the SavedAudiobook entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sa *SavedAudiobook) Unwrap() *SavedAudiobook {
+	_tx, ok := sa.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SavedAudiobook is not a transactional entity")
+	}
+	sa.config.driver = _tx.drv
+	return sa
+}
+
+// String implements the fmt.Stringer.
+func (sa *SavedAudiobook) String() string {
+	var builder strings.Builder
+	builder.WriteString("SavedAudiobook(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sa.ID))
+	if v := sa.AddedAt; v != nil {
+		builder.WriteString("added_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("spotify_id=")
+	builder.WriteString(sa.SpotifyID)
+	builder.WriteString(", ")
+	builder.WriteString("uri=")
+	builder.WriteString(sa.URI)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(sa.Name)
+	builder.WriteString(", ")
+	builder.WriteString("authors=")
+	builder.WriteString(fmt.Sprintf("%v", sa.Authors))
+	builder.WriteString(", ")
+	builder.WriteString("narrators=")
+	builder.WriteString(fmt.Sprintf("%v", sa.Narrators))
+	builder.WriteString(", ")
+	builder.WriteString("publisher=")
+	builder.WriteString(sa.Publisher)
+	builder.WriteString(", ")
+	builder.WriteString("total_chapters=")
+	builder.WriteString(fmt.Sprintf("%v", sa.TotalChapters))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(sa.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SavedAudiobooks is a parsable slice of SavedAudiobook.
+type SavedAudiobooks []*SavedAudiobook
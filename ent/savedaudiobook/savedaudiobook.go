@@ -0,0 +1,149 @@
+// Code generated by ent, DO NOT EDIT.
+
+package savedaudiobook
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the savedaudiobook type in the database.
+	Label = "saved_audiobook"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldAddedAt holds the string denoting the added_at field in the database.
+	FieldAddedAt = "added_at"
+	// FieldSpotifyID holds the string denoting the spotify_id field in the database.
+	FieldSpotifyID = "spotify_id"
+	// FieldURI holds the string denoting the uri field in the database.
+	FieldURI = "uri"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldAuthors holds the string denoting the authors field in the database.
+	FieldAuthors = "authors"
+	// FieldNarrators holds the string denoting the narrators field in the database.
+	FieldNarrators = "narrators"
+	// FieldPublisher holds the string denoting the publisher field in the database.
+	FieldPublisher = "publisher"
+	// FieldTotalChapters holds the string denoting the total_chapters field in the database.
+	FieldTotalChapters = "total_chapters"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeRun holds the string denoting the run edge name in mutations.
+	EdgeRun = "run"
+	// Table holds the table name of the savedaudiobook in the database.
+	Table = "saved_audiobooks"
+	// RunTable is the table that holds the run relation/edge.
+	RunTable = "saved_audiobooks"
+	// RunInverseTable is the table name for the BackupRun entity.
+	// It exists in this package in order to avoid circular dependency with the "backuprun" package.
+	RunInverseTable = "backup_runs"
+	// RunColumn is the table column denoting the run relation/edge.
+	RunColumn = "backup_run_saved_audiobooks"
+)
+
+// Columns holds all SQL columns for savedaudiobook fields.
+var Columns = []string{
+	FieldID,
+	FieldAddedAt,
+	FieldSpotifyID,
+	FieldURI,
+	FieldName,
+	FieldAuthors,
+	FieldNarrators,
+	FieldPublisher,
+	FieldTotalChapters,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "saved_audiobooks"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"backup_run_saved_audiobooks",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultPublisher holds the default value on creation for the "publisher" field.
+	DefaultPublisher string
+	// TotalChaptersValidator is a validator for the "total_chapters" field. It is called by the builders before save.
+	TotalChaptersValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() string
+)
+
+// OrderOption defines the ordering options for the SavedAudiobook queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByAddedAt orders the results by the added_at field.
+func ByAddedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAddedAt, opts...).ToFunc()
+}
+
+// BySpotifyID orders the results by the spotify_id field.
+func BySpotifyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpotifyID, opts...).ToFunc()
+}
+
+// ByURI orders the results by the uri field.
+func ByURI(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldURI, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByPublisher orders the results by the publisher field.
+func ByPublisher(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPublisher, opts...).ToFunc()
+}
+
+// ByTotalChapters orders the results by the total_chapters field.
+func ByTotalChapters(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalChapters, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByRunField orders the results by run field.
+func ByRunField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRunStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newRunStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RunInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, RunTable, RunColumn),
+	)
+}
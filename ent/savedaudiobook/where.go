@@ -0,0 +1,549 @@
+// Code generated by ent, DO NOT EDIT.
+
+package savedaudiobook
+
+import (
+	"beyerleinf/spotify-backup/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldContainsFold(FieldID, id))
+}
+
+// AddedAt applies equality check predicate on the "added_at" field. It's identical to AddedAtEQ.
+func AddedAt(v time.Time) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldEQ(FieldAddedAt, v))
+}
+
+// SpotifyID applies equality check predicate on the "spotify_id" field. It's identical to SpotifyIDEQ.
+func SpotifyID(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldEQ(FieldSpotifyID, v))
+}
+
+// URI applies equality check predicate on the "uri" field. It's identical to URIEQ.
+func URI(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldEQ(FieldURI, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldEQ(FieldName, v))
+}
+
+// Publisher applies equality check predicate on the "publisher" field. It's identical to PublisherEQ.
+func Publisher(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldEQ(FieldPublisher, v))
+}
+
+// TotalChapters applies equality check predicate on the "total_chapters" field. It's identical to TotalChaptersEQ.
+func TotalChapters(v int) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldEQ(FieldTotalChapters, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// AddedAtEQ applies the EQ predicate on the "added_at" field.
+func AddedAtEQ(v time.Time) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldEQ(FieldAddedAt, v))
+}
+
+// AddedAtNEQ applies the NEQ predicate on the "added_at" field.
+func AddedAtNEQ(v time.Time) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldNEQ(FieldAddedAt, v))
+}
+
+// AddedAtIn applies the In predicate on the "added_at" field.
+func AddedAtIn(vs ...time.Time) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldIn(FieldAddedAt, vs...))
+}
+
+// AddedAtNotIn applies the NotIn predicate on the "added_at" field.
+func AddedAtNotIn(vs ...time.Time) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldNotIn(FieldAddedAt, vs...))
+}
+
+// AddedAtGT applies the GT predicate on the "added_at" field.
+func AddedAtGT(v time.Time) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldGT(FieldAddedAt, v))
+}
+
+// AddedAtGTE applies the GTE predicate on the "added_at" field.
+func AddedAtGTE(v time.Time) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldGTE(FieldAddedAt, v))
+}
+
+// AddedAtLT applies the LT predicate on the "added_at" field.
+func AddedAtLT(v time.Time) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldLT(FieldAddedAt, v))
+}
+
+// AddedAtLTE applies the LTE predicate on the "added_at" field.
+func AddedAtLTE(v time.Time) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldLTE(FieldAddedAt, v))
+}
+
+// AddedAtIsNil applies the IsNil predicate on the "added_at" field.
+func AddedAtIsNil() predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldIsNull(FieldAddedAt))
+}
+
+// AddedAtNotNil applies the NotNil predicate on the "added_at" field.
+func AddedAtNotNil() predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldNotNull(FieldAddedAt))
+}
+
+// SpotifyIDEQ applies the EQ predicate on the "spotify_id" field.
+func SpotifyIDEQ(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldEQ(FieldSpotifyID, v))
+}
+
+// SpotifyIDNEQ applies the NEQ predicate on the "spotify_id" field.
+func SpotifyIDNEQ(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldNEQ(FieldSpotifyID, v))
+}
+
+// SpotifyIDIn applies the In predicate on the "spotify_id" field.
+func SpotifyIDIn(vs ...string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldIn(FieldSpotifyID, vs...))
+}
+
+// SpotifyIDNotIn applies the NotIn predicate on the "spotify_id" field.
+func SpotifyIDNotIn(vs ...string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldNotIn(FieldSpotifyID, vs...))
+}
+
+// SpotifyIDGT applies the GT predicate on the "spotify_id" field.
+func SpotifyIDGT(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldGT(FieldSpotifyID, v))
+}
+
+// SpotifyIDGTE applies the GTE predicate on the "spotify_id" field.
+func SpotifyIDGTE(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldGTE(FieldSpotifyID, v))
+}
+
+// SpotifyIDLT applies the LT predicate on the "spotify_id" field.
+func SpotifyIDLT(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldLT(FieldSpotifyID, v))
+}
+
+// SpotifyIDLTE applies the LTE predicate on the "spotify_id" field.
+func SpotifyIDLTE(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldLTE(FieldSpotifyID, v))
+}
+
+// SpotifyIDContains applies the Contains predicate on the "spotify_id" field.
+func SpotifyIDContains(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldContains(FieldSpotifyID, v))
+}
+
+// SpotifyIDHasPrefix applies the HasPrefix predicate on the "spotify_id" field.
+func SpotifyIDHasPrefix(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldHasPrefix(FieldSpotifyID, v))
+}
+
+// SpotifyIDHasSuffix applies the HasSuffix predicate on the "spotify_id" field.
+func SpotifyIDHasSuffix(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldHasSuffix(FieldSpotifyID, v))
+}
+
+// SpotifyIDEqualFold applies the EqualFold predicate on the "spotify_id" field.
+func SpotifyIDEqualFold(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldEqualFold(FieldSpotifyID, v))
+}
+
+// SpotifyIDContainsFold applies the ContainsFold predicate on the "spotify_id" field.
+func SpotifyIDContainsFold(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldContainsFold(FieldSpotifyID, v))
+}
+
+// URIEQ applies the EQ predicate on the "uri" field.
+func URIEQ(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldEQ(FieldURI, v))
+}
+
+// URINEQ applies the NEQ predicate on the "uri" field.
+func URINEQ(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldNEQ(FieldURI, v))
+}
+
+// URIIn applies the In predicate on the "uri" field.
+func URIIn(vs ...string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldIn(FieldURI, vs...))
+}
+
+// URINotIn applies the NotIn predicate on the "uri" field.
+func URINotIn(vs ...string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldNotIn(FieldURI, vs...))
+}
+
+// URIGT applies the GT predicate on the "uri" field.
+func URIGT(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldGT(FieldURI, v))
+}
+
+// URIGTE applies the GTE predicate on the "uri" field.
+func URIGTE(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldGTE(FieldURI, v))
+}
+
+// URILT applies the LT predicate on the "uri" field.
+func URILT(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldLT(FieldURI, v))
+}
+
+// URILTE applies the LTE predicate on the "uri" field.
+func URILTE(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldLTE(FieldURI, v))
+}
+
+// URIContains applies the Contains predicate on the "uri" field.
+func URIContains(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldContains(FieldURI, v))
+}
+
+// URIHasPrefix applies the HasPrefix predicate on the "uri" field.
+func URIHasPrefix(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldHasPrefix(FieldURI, v))
+}
+
+// URIHasSuffix applies the HasSuffix predicate on the "uri" field.
+func URIHasSuffix(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldHasSuffix(FieldURI, v))
+}
+
+// URIEqualFold applies the EqualFold predicate on the "uri" field.
+func URIEqualFold(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldEqualFold(FieldURI, v))
+}
+
+// URIContainsFold applies the ContainsFold predicate on the "uri" field.
+func URIContainsFold(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldContainsFold(FieldURI, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldContainsFold(FieldName, v))
+}
+
+// AuthorsIsNil applies the IsNil predicate on the "authors" field.
+func AuthorsIsNil() predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldIsNull(FieldAuthors))
+}
+
+// AuthorsNotNil applies the NotNil predicate on the "authors" field.
+func AuthorsNotNil() predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldNotNull(FieldAuthors))
+}
+
+// NarratorsIsNil applies the IsNil predicate on the "narrators" field.
+func NarratorsIsNil() predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldIsNull(FieldNarrators))
+}
+
+// NarratorsNotNil applies the NotNil predicate on the "narrators" field.
+func NarratorsNotNil() predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldNotNull(FieldNarrators))
+}
+
+// PublisherEQ applies the EQ predicate on the "publisher" field.
+func PublisherEQ(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldEQ(FieldPublisher, v))
+}
+
+// PublisherNEQ applies the NEQ predicate on the "publisher" field.
+func PublisherNEQ(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldNEQ(FieldPublisher, v))
+}
+
+// PublisherIn applies the In predicate on the "publisher" field.
+func PublisherIn(vs ...string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldIn(FieldPublisher, vs...))
+}
+
+// PublisherNotIn applies the NotIn predicate on the "publisher" field.
+func PublisherNotIn(vs ...string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldNotIn(FieldPublisher, vs...))
+}
+
+// PublisherGT applies the GT predicate on the "publisher" field.
+func PublisherGT(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldGT(FieldPublisher, v))
+}
+
+// PublisherGTE applies the GTE predicate on the "publisher" field.
+func PublisherGTE(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldGTE(FieldPublisher, v))
+}
+
+// PublisherLT applies the LT predicate on the "publisher" field.
+func PublisherLT(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldLT(FieldPublisher, v))
+}
+
+// PublisherLTE applies the LTE predicate on the "publisher" field.
+func PublisherLTE(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldLTE(FieldPublisher, v))
+}
+
+// PublisherContains applies the Contains predicate on the "publisher" field.
+func PublisherContains(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldContains(FieldPublisher, v))
+}
+
+// PublisherHasPrefix applies the HasPrefix predicate on the "publisher" field.
+func PublisherHasPrefix(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldHasPrefix(FieldPublisher, v))
+}
+
+// PublisherHasSuffix applies the HasSuffix predicate on the "publisher" field.
+func PublisherHasSuffix(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldHasSuffix(FieldPublisher, v))
+}
+
+// PublisherEqualFold applies the EqualFold predicate on the "publisher" field.
+func PublisherEqualFold(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldEqualFold(FieldPublisher, v))
+}
+
+// PublisherContainsFold applies the ContainsFold predicate on the "publisher" field.
+func PublisherContainsFold(v string) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldContainsFold(FieldPublisher, v))
+}
+
+// TotalChaptersEQ applies the EQ predicate on the "total_chapters" field.
+func TotalChaptersEQ(v int) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldEQ(FieldTotalChapters, v))
+}
+
+// TotalChaptersNEQ applies the NEQ predicate on the "total_chapters" field.
+func TotalChaptersNEQ(v int) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldNEQ(FieldTotalChapters, v))
+}
+
+// TotalChaptersIn applies the In predicate on the "total_chapters" field.
+func TotalChaptersIn(vs ...int) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldIn(FieldTotalChapters, vs...))
+}
+
+// TotalChaptersNotIn applies the NotIn predicate on the "total_chapters" field.
+func TotalChaptersNotIn(vs ...int) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldNotIn(FieldTotalChapters, vs...))
+}
+
+// TotalChaptersGT applies the GT predicate on the "total_chapters" field.
+func TotalChaptersGT(v int) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldGT(FieldTotalChapters, v))
+}
+
+// TotalChaptersGTE applies the GTE predicate on the "total_chapters" field.
+func TotalChaptersGTE(v int) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldGTE(FieldTotalChapters, v))
+}
+
+// TotalChaptersLT applies the LT predicate on the "total_chapters" field.
+func TotalChaptersLT(v int) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldLT(FieldTotalChapters, v))
+}
+
+// TotalChaptersLTE applies the LTE predicate on the "total_chapters" field.
+func TotalChaptersLTE(v int) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldLTE(FieldTotalChapters, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasRun applies the HasEdge predicate on the "run" edge.
+func HasRun() predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RunTable, RunColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRunWith applies the HasEdge predicate on the "run" edge with a given conditions (other predicates).
+func HasRunWith(preds ...predicate.BackupRun) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(func(s *sql.Selector) {
+		step := newRunStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SavedAudiobook) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SavedAudiobook) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SavedAudiobook) predicate.SavedAudiobook {
+	return predicate.SavedAudiobook(sql.NotPredicates(p))
+}
@@ -0,0 +1,377 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/savedaudiobook"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SavedAudiobookCreate is the builder for creating a SavedAudiobook entity.
+type SavedAudiobookCreate struct {
+	config
+	mutation *SavedAudiobookMutation
+	hooks    []Hook
+}
+
+// SetAddedAt sets the "added_at" field.
+func (sac *SavedAudiobookCreate) SetAddedAt(t time.Time) *SavedAudiobookCreate {
+	sac.mutation.SetAddedAt(t)
+	return sac
+}
+
+// SetNillableAddedAt sets the "added_at" field if the given value is not nil.
+func (sac *SavedAudiobookCreate) SetNillableAddedAt(t *time.Time) *SavedAudiobookCreate {
+	if t != nil {
+		sac.SetAddedAt(*t)
+	}
+	return sac
+}
+
+// SetSpotifyID sets the "spotify_id" field.
+func (sac *SavedAudiobookCreate) SetSpotifyID(s string) *SavedAudiobookCreate {
+	sac.mutation.SetSpotifyID(s)
+	return sac
+}
+
+// SetURI sets the "uri" field.
+func (sac *SavedAudiobookCreate) SetURI(s string) *SavedAudiobookCreate {
+	sac.mutation.SetURI(s)
+	return sac
+}
+
+// SetName sets the "name" field.
+func (sac *SavedAudiobookCreate) SetName(s string) *SavedAudiobookCreate {
+	sac.mutation.SetName(s)
+	return sac
+}
+
+// SetAuthors sets the "authors" field.
+func (sac *SavedAudiobookCreate) SetAuthors(s []string) *SavedAudiobookCreate {
+	sac.mutation.SetAuthors(s)
+	return sac
+}
+
+// SetNarrators sets the "narrators" field.
+func (sac *SavedAudiobookCreate) SetNarrators(s []string) *SavedAudiobookCreate {
+	sac.mutation.SetNarrators(s)
+	return sac
+}
+
+// SetPublisher sets the "publisher" field.
+func (sac *SavedAudiobookCreate) SetPublisher(s string) *SavedAudiobookCreate {
+	sac.mutation.SetPublisher(s)
+	return sac
+}
+
+// SetNillablePublisher sets the "publisher" field if the given value is not nil.
+func (sac *SavedAudiobookCreate) SetNillablePublisher(s *string) *SavedAudiobookCreate {
+	if s != nil {
+		sac.SetPublisher(*s)
+	}
+	return sac
+}
+
+// SetTotalChapters sets the "total_chapters" field.
+func (sac *SavedAudiobookCreate) SetTotalChapters(i int) *SavedAudiobookCreate {
+	sac.mutation.SetTotalChapters(i)
+	return sac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sac *SavedAudiobookCreate) SetCreatedAt(t time.Time) *SavedAudiobookCreate {
+	sac.mutation.SetCreatedAt(t)
+	return sac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sac *SavedAudiobookCreate) SetNillableCreatedAt(t *time.Time) *SavedAudiobookCreate {
+	if t != nil {
+		sac.SetCreatedAt(*t)
+	}
+	return sac
+}
+
+// SetID sets the "id" field.
+func (sac *SavedAudiobookCreate) SetID(s string) *SavedAudiobookCreate {
+	sac.mutation.SetID(s)
+	return sac
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (sac *SavedAudiobookCreate) SetNillableID(s *string) *SavedAudiobookCreate {
+	if s != nil {
+		sac.SetID(*s)
+	}
+	return sac
+}
+
+// SetRunID sets the "run" edge to the BackupRun entity by ID.
+func (sac *SavedAudiobookCreate) SetRunID(id string) *SavedAudiobookCreate {
+	sac.mutation.SetRunID(id)
+	return sac
+}
+
+// SetRun sets the "run" edge to the BackupRun entity.
+func (sac *SavedAudiobookCreate) SetRun(b *BackupRun) *SavedAudiobookCreate {
+	return sac.SetRunID(b.ID)
+}
+
+// Mutation returns the SavedAudiobookMutation object of the builder.
+func (sac *SavedAudiobookCreate) Mutation() *SavedAudiobookMutation {
+	return sac.mutation
+}
+
+// Save creates the SavedAudiobook in the database.
+func (sac *SavedAudiobookCreate) Save(ctx context.Context) (*SavedAudiobook, error) {
+	sac.defaults()
+	return withHooks(ctx, sac.sqlSave, sac.mutation, sac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sac *SavedAudiobookCreate) SaveX(ctx context.Context) *SavedAudiobook {
+	v, err := sac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sac *SavedAudiobookCreate) Exec(ctx context.Context) error {
+	_, err := sac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sac *SavedAudiobookCreate) ExecX(ctx context.Context) {
+	if err := sac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sac *SavedAudiobookCreate) defaults() {
+	if _, ok := sac.mutation.Publisher(); !ok {
+		v := savedaudiobook.DefaultPublisher
+		sac.mutation.SetPublisher(v)
+	}
+	if _, ok := sac.mutation.CreatedAt(); !ok {
+		v := savedaudiobook.DefaultCreatedAt()
+		sac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sac.mutation.ID(); !ok {
+		v := savedaudiobook.DefaultID()
+		sac.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sac *SavedAudiobookCreate) check() error {
+	if _, ok := sac.mutation.SpotifyID(); !ok {
+		return &ValidationError{Name: "spotify_id", err: errors.New(`ent: missing required field "SavedAudiobook.spotify_id"`)}
+	}
+	if _, ok := sac.mutation.URI(); !ok {
+		return &ValidationError{Name: "uri", err: errors.New(`ent: missing required field "SavedAudiobook.uri"`)}
+	}
+	if _, ok := sac.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "SavedAudiobook.name"`)}
+	}
+	if _, ok := sac.mutation.Publisher(); !ok {
+		return &ValidationError{Name: "publisher", err: errors.New(`ent: missing required field "SavedAudiobook.publisher"`)}
+	}
+	if _, ok := sac.mutation.TotalChapters(); !ok {
+		return &ValidationError{Name: "total_chapters", err: errors.New(`ent: missing required field "SavedAudiobook.total_chapters"`)}
+	}
+	if v, ok := sac.mutation.TotalChapters(); ok {
+		if err := savedaudiobook.TotalChaptersValidator(v); err != nil {
+			return &ValidationError{Name: "total_chapters", err: fmt.Errorf(`ent: validator failed for field "SavedAudiobook.total_chapters": %w`, err)}
+		}
+	}
+	if _, ok := sac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SavedAudiobook.created_at"`)}
+	}
+	if len(sac.mutation.RunIDs()) == 0 {
+		return &ValidationError{Name: "run", err: errors.New(`ent: missing required edge "SavedAudiobook.run"`)}
+	}
+	return nil
+}
+
+func (sac *SavedAudiobookCreate) sqlSave(ctx context.Context) (*SavedAudiobook, error) {
+	if err := sac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected SavedAudiobook.ID type: %T", _spec.ID.Value)
+		}
+	}
+	sac.mutation.id = &_node.ID
+	sac.mutation.done = true
+	return _node, nil
+}
+
+func (sac *SavedAudiobookCreate) createSpec() (*SavedAudiobook, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SavedAudiobook{config: sac.config}
+		_spec = sqlgraph.NewCreateSpec(savedaudiobook.Table, sqlgraph.NewFieldSpec(savedaudiobook.FieldID, field.TypeString))
+	)
+	if id, ok := sac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := sac.mutation.AddedAt(); ok {
+		_spec.SetField(savedaudiobook.FieldAddedAt, field.TypeTime, value)
+		_node.AddedAt = &value
+	}
+	if value, ok := sac.mutation.SpotifyID(); ok {
+		_spec.SetField(savedaudiobook.FieldSpotifyID, field.TypeString, value)
+		_node.SpotifyID = value
+	}
+	if value, ok := sac.mutation.URI(); ok {
+		_spec.SetField(savedaudiobook.FieldURI, field.TypeString, value)
+		_node.URI = value
+	}
+	if value, ok := sac.mutation.Name(); ok {
+		_spec.SetField(savedaudiobook.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := sac.mutation.Authors(); ok {
+		_spec.SetField(savedaudiobook.FieldAuthors, field.TypeJSON, value)
+		_node.Authors = value
+	}
+	if value, ok := sac.mutation.Narrators(); ok {
+		_spec.SetField(savedaudiobook.FieldNarrators, field.TypeJSON, value)
+		_node.Narrators = value
+	}
+	if value, ok := sac.mutation.Publisher(); ok {
+		_spec.SetField(savedaudiobook.FieldPublisher, field.TypeString, value)
+		_node.Publisher = value
+	}
+	if value, ok := sac.mutation.TotalChapters(); ok {
+		_spec.SetField(savedaudiobook.FieldTotalChapters, field.TypeInt, value)
+		_node.TotalChapters = value
+	}
+	if value, ok := sac.mutation.CreatedAt(); ok {
+		_spec.SetField(savedaudiobook.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := sac.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedaudiobook.RunTable,
+			Columns: []string{savedaudiobook.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.backup_run_saved_audiobooks = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SavedAudiobookCreateBulk is the builder for creating many SavedAudiobook entities in bulk.
+type SavedAudiobookCreateBulk struct {
+	config
+	err      error
+	builders []*SavedAudiobookCreate
+}
+
+// Save creates the SavedAudiobook entities in the database.
+func (sacb *SavedAudiobookCreateBulk) Save(ctx context.Context) ([]*SavedAudiobook, error) {
+	if sacb.err != nil {
+		return nil, sacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sacb.builders))
+	nodes := make([]*SavedAudiobook, len(sacb.builders))
+	mutators := make([]Mutator, len(sacb.builders))
+	for i := range sacb.builders {
+		func(i int, root context.Context) {
+			builder := sacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SavedAudiobookMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sacb *SavedAudiobookCreateBulk) SaveX(ctx context.Context) []*SavedAudiobook {
+	v, err := sacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sacb *SavedAudiobookCreateBulk) Exec(ctx context.Context) error {
+	_, err := sacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sacb *SavedAudiobookCreateBulk) ExecX(ctx context.Context) {
+	if err := sacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/predicate"
+	"beyerleinf/spotify-backup/ent/savedaudiobook"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SavedAudiobookDelete is the builder for deleting a SavedAudiobook entity.
+type SavedAudiobookDelete struct {
+	config
+	hooks    []Hook
+	mutation *SavedAudiobookMutation
+}
+
+// Where appends a list predicates to the SavedAudiobookDelete builder.
+func (sad *SavedAudiobookDelete) Where(ps ...predicate.SavedAudiobook) *SavedAudiobookDelete {
+	sad.mutation.Where(ps...)
+	return sad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (sad *SavedAudiobookDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, sad.sqlExec, sad.mutation, sad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sad *SavedAudiobookDelete) ExecX(ctx context.Context) int {
+	n, err := sad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (sad *SavedAudiobookDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(savedaudiobook.Table, sqlgraph.NewFieldSpec(savedaudiobook.FieldID, field.TypeString))
+	if ps := sad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, sad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	sad.mutation.done = true
+	return affected, err
+}
+
+// SavedAudiobookDeleteOne is the builder for deleting a single SavedAudiobook entity.
+type SavedAudiobookDeleteOne struct {
+	sad *SavedAudiobookDelete
+}
+
+// Where appends a list predicates to the SavedAudiobookDelete builder.
+func (sado *SavedAudiobookDeleteOne) Where(ps ...predicate.SavedAudiobook) *SavedAudiobookDeleteOne {
+	sado.sad.mutation.Where(ps...)
+	return sado
+}
+
+// Exec executes the deletion query.
+func (sado *SavedAudiobookDeleteOne) Exec(ctx context.Context) error {
+	n, err := sado.sad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{savedaudiobook.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sado *SavedAudiobookDeleteOne) ExecX(ctx context.Context) {
+	if err := sado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,651 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/predicate"
+	"beyerleinf/spotify-backup/ent/savedaudiobook"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SavedAudiobookQuery is the builder for querying SavedAudiobook entities.
+type SavedAudiobookQuery struct {
+	config
+	ctx        *QueryContext
+	order      []savedaudiobook.OrderOption
+	inters     []Interceptor
+	predicates []predicate.SavedAudiobook
+	withRun    *BackupRunQuery
+	withFKs    bool
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SavedAudiobookQuery builder.
+func (saq *SavedAudiobookQuery) Where(ps ...predicate.SavedAudiobook) *SavedAudiobookQuery {
+	saq.predicates = append(saq.predicates, ps...)
+	return saq
+}
+
+// Limit the number of records to be returned by this query.
+func (saq *SavedAudiobookQuery) Limit(limit int) *SavedAudiobookQuery {
+	saq.ctx.Limit = &limit
+	return saq
+}
+
+// Offset to start from.
+func (saq *SavedAudiobookQuery) Offset(offset int) *SavedAudiobookQuery {
+	saq.ctx.Offset = &offset
+	return saq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (saq *SavedAudiobookQuery) Unique(unique bool) *SavedAudiobookQuery {
+	saq.ctx.Unique = &unique
+	return saq
+}
+
+// Order specifies how the records should be ordered.
+func (saq *SavedAudiobookQuery) Order(o ...savedaudiobook.OrderOption) *SavedAudiobookQuery {
+	saq.order = append(saq.order, o...)
+	return saq
+}
+
+// QueryRun chains the current query on the "run" edge.
+func (saq *SavedAudiobookQuery) QueryRun() *BackupRunQuery {
+	query := (&BackupRunClient{config: saq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := saq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := saq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(savedaudiobook.Table, savedaudiobook.FieldID, selector),
+			sqlgraph.To(backuprun.Table, backuprun.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, savedaudiobook.RunTable, savedaudiobook.RunColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(saq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SavedAudiobook entity from the query.
+// Returns a *NotFoundError when no SavedAudiobook was found.
+func (saq *SavedAudiobookQuery) First(ctx context.Context) (*SavedAudiobook, error) {
+	nodes, err := saq.Limit(1).All(setContextOp(ctx, saq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{savedaudiobook.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (saq *SavedAudiobookQuery) FirstX(ctx context.Context) *SavedAudiobook {
+	node, err := saq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SavedAudiobook ID from the query.
+// Returns a *NotFoundError when no SavedAudiobook ID was found.
+func (saq *SavedAudiobookQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = saq.Limit(1).IDs(setContextOp(ctx, saq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{savedaudiobook.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (saq *SavedAudiobookQuery) FirstIDX(ctx context.Context) string {
+	id, err := saq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SavedAudiobook entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SavedAudiobook entity is found.
+// Returns a *NotFoundError when no SavedAudiobook entities are found.
+func (saq *SavedAudiobookQuery) Only(ctx context.Context) (*SavedAudiobook, error) {
+	nodes, err := saq.Limit(2).All(setContextOp(ctx, saq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{savedaudiobook.Label}
+	default:
+		return nil, &NotSingularError{savedaudiobook.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (saq *SavedAudiobookQuery) OnlyX(ctx context.Context) *SavedAudiobook {
+	node, err := saq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SavedAudiobook ID in the query.
+// Returns a *NotSingularError when more than one SavedAudiobook ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (saq *SavedAudiobookQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = saq.Limit(2).IDs(setContextOp(ctx, saq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{savedaudiobook.Label}
+	default:
+		err = &NotSingularError{savedaudiobook.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (saq *SavedAudiobookQuery) OnlyIDX(ctx context.Context) string {
+	id, err := saq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SavedAudiobooks.
+func (saq *SavedAudiobookQuery) All(ctx context.Context) ([]*SavedAudiobook, error) {
+	ctx = setContextOp(ctx, saq.ctx, ent.OpQueryAll)
+	if err := saq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SavedAudiobook, *SavedAudiobookQuery]()
+	return withInterceptors[[]*SavedAudiobook](ctx, saq, qr, saq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (saq *SavedAudiobookQuery) AllX(ctx context.Context) []*SavedAudiobook {
+	nodes, err := saq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SavedAudiobook IDs.
+func (saq *SavedAudiobookQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if saq.ctx.Unique == nil && saq.path != nil {
+		saq.Unique(true)
+	}
+	ctx = setContextOp(ctx, saq.ctx, ent.OpQueryIDs)
+	if err = saq.Select(savedaudiobook.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (saq *SavedAudiobookQuery) IDsX(ctx context.Context) []string {
+	ids, err := saq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (saq *SavedAudiobookQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, saq.ctx, ent.OpQueryCount)
+	if err := saq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, saq, querierCount[*SavedAudiobookQuery](), saq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (saq *SavedAudiobookQuery) CountX(ctx context.Context) int {
+	count, err := saq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (saq *SavedAudiobookQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, saq.ctx, ent.OpQueryExist)
+	switch _, err := saq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (saq *SavedAudiobookQuery) ExistX(ctx context.Context) bool {
+	exist, err := saq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SavedAudiobookQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (saq *SavedAudiobookQuery) Clone() *SavedAudiobookQuery {
+	if saq == nil {
+		return nil
+	}
+	return &SavedAudiobookQuery{
+		config:     saq.config,
+		ctx:        saq.ctx.Clone(),
+		order:      append([]savedaudiobook.OrderOption{}, saq.order...),
+		inters:     append([]Interceptor{}, saq.inters...),
+		predicates: append([]predicate.SavedAudiobook{}, saq.predicates...),
+		withRun:    saq.withRun.Clone(),
+		// clone intermediate query.
+		sql:  saq.sql.Clone(),
+		path: saq.path,
+	}
+}
+
+// WithRun tells the query-builder to eager-load the nodes that are connected to
+// the "run" edge. The optional arguments are used to configure the query builder of the edge.
+func (saq *SavedAudiobookQuery) WithRun(opts ...func(*BackupRunQuery)) *SavedAudiobookQuery {
+	query := (&BackupRunClient{config: saq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	saq.withRun = query
+	return saq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		AddedAt time.Time `json:"added_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SavedAudiobook.Query().
+//		GroupBy(savedaudiobook.FieldAddedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (saq *SavedAudiobookQuery) GroupBy(field string, fields ...string) *SavedAudiobookGroupBy {
+	saq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SavedAudiobookGroupBy{build: saq}
+	grbuild.flds = &saq.ctx.Fields
+	grbuild.label = savedaudiobook.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		AddedAt time.Time `json:"added_at,omitempty"`
+//	}
+//
+//	client.SavedAudiobook.Query().
+//		Select(savedaudiobook.FieldAddedAt).
+//		Scan(ctx, &v)
+func (saq *SavedAudiobookQuery) Select(fields ...string) *SavedAudiobookSelect {
+	saq.ctx.Fields = append(saq.ctx.Fields, fields...)
+	sbuild := &SavedAudiobookSelect{SavedAudiobookQuery: saq}
+	sbuild.label = savedaudiobook.Label
+	sbuild.flds, sbuild.scan = &saq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SavedAudiobookSelect configured with the given aggregations.
+func (saq *SavedAudiobookQuery) Aggregate(fns ...AggregateFunc) *SavedAudiobookSelect {
+	return saq.Select().Aggregate(fns...)
+}
+
+func (saq *SavedAudiobookQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range saq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, saq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range saq.ctx.Fields {
+		if !savedaudiobook.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if saq.path != nil {
+		prev, err := saq.path(ctx)
+		if err != nil {
+			return err
+		}
+		saq.sql = prev
+	}
+	return nil
+}
+
+func (saq *SavedAudiobookQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SavedAudiobook, error) {
+	var (
+		nodes       = []*SavedAudiobook{}
+		withFKs     = saq.withFKs
+		_spec       = saq.querySpec()
+		loadedTypes = [1]bool{
+			saq.withRun != nil,
+		}
+	)
+	if saq.withRun != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, savedaudiobook.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SavedAudiobook).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SavedAudiobook{config: saq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(saq.modifiers) > 0 {
+		_spec.Modifiers = saq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, saq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := saq.withRun; query != nil {
+		if err := saq.loadRun(ctx, query, nodes, nil,
+			func(n *SavedAudiobook, e *BackupRun) { n.Edges.Run = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (saq *SavedAudiobookQuery) loadRun(ctx context.Context, query *BackupRunQuery, nodes []*SavedAudiobook, init func(*SavedAudiobook), assign func(*SavedAudiobook, *BackupRun)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*SavedAudiobook)
+	for i := range nodes {
+		if nodes[i].backup_run_saved_audiobooks == nil {
+			continue
+		}
+		fk := *nodes[i].backup_run_saved_audiobooks
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(backuprun.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "backup_run_saved_audiobooks" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (saq *SavedAudiobookQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := saq.querySpec()
+	if len(saq.modifiers) > 0 {
+		_spec.Modifiers = saq.modifiers
+	}
+	_spec.Node.Columns = saq.ctx.Fields
+	if len(saq.ctx.Fields) > 0 {
+		_spec.Unique = saq.ctx.Unique != nil && *saq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, saq.driver, _spec)
+}
+
+func (saq *SavedAudiobookQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(savedaudiobook.Table, savedaudiobook.Columns, sqlgraph.NewFieldSpec(savedaudiobook.FieldID, field.TypeString))
+	_spec.From = saq.sql
+	if unique := saq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if saq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := saq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, savedaudiobook.FieldID)
+		for i := range fields {
+			if fields[i] != savedaudiobook.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := saq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := saq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := saq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := saq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (saq *SavedAudiobookQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(saq.driver.Dialect())
+	t1 := builder.Table(savedaudiobook.Table)
+	columns := saq.ctx.Fields
+	if len(columns) == 0 {
+		columns = savedaudiobook.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if saq.sql != nil {
+		selector = saq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if saq.ctx.Unique != nil && *saq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range saq.modifiers {
+		m(selector)
+	}
+	for _, p := range saq.predicates {
+		p(selector)
+	}
+	for _, p := range saq.order {
+		p(selector)
+	}
+	if offset := saq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := saq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (saq *SavedAudiobookQuery) ForUpdate(opts ...sql.LockOption) *SavedAudiobookQuery {
+	if saq.driver.Dialect() == dialect.Postgres {
+		saq.Unique(false)
+	}
+	saq.modifiers = append(saq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return saq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (saq *SavedAudiobookQuery) ForShare(opts ...sql.LockOption) *SavedAudiobookQuery {
+	if saq.driver.Dialect() == dialect.Postgres {
+		saq.Unique(false)
+	}
+	saq.modifiers = append(saq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return saq
+}
+
+// SavedAudiobookGroupBy is the group-by builder for SavedAudiobook entities.
+type SavedAudiobookGroupBy struct {
+	selector
+	build *SavedAudiobookQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (sagb *SavedAudiobookGroupBy) Aggregate(fns ...AggregateFunc) *SavedAudiobookGroupBy {
+	sagb.fns = append(sagb.fns, fns...)
+	return sagb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sagb *SavedAudiobookGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sagb.build.ctx, ent.OpQueryGroupBy)
+	if err := sagb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SavedAudiobookQuery, *SavedAudiobookGroupBy](ctx, sagb.build, sagb, sagb.build.inters, v)
+}
+
+func (sagb *SavedAudiobookGroupBy) sqlScan(ctx context.Context, root *SavedAudiobookQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(sagb.fns))
+	for _, fn := range sagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*sagb.flds)+len(sagb.fns))
+		for _, f := range *sagb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*sagb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sagb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SavedAudiobookSelect is the builder for selecting fields of SavedAudiobook entities.
+type SavedAudiobookSelect struct {
+	*SavedAudiobookQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sas *SavedAudiobookSelect) Aggregate(fns ...AggregateFunc) *SavedAudiobookSelect {
+	sas.fns = append(sas.fns, fns...)
+	return sas
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sas *SavedAudiobookSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sas.ctx, ent.OpQuerySelect)
+	if err := sas.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SavedAudiobookQuery, *SavedAudiobookSelect](ctx, sas.SavedAudiobookQuery, sas, sas.inters, v)
+}
+
+func (sas *SavedAudiobookSelect) sqlScan(ctx context.Context, root *SavedAudiobookQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sas.fns))
+	for _, fn := range sas.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sas.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sas.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,660 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/predicate"
+	"beyerleinf/spotify-backup/ent/savedaudiobook"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// SavedAudiobookUpdate is the builder for updating SavedAudiobook entities.
+type SavedAudiobookUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SavedAudiobookMutation
+}
+
+// Where appends a list predicates to the SavedAudiobookUpdate builder.
+func (sau *SavedAudiobookUpdate) Where(ps ...predicate.SavedAudiobook) *SavedAudiobookUpdate {
+	sau.mutation.Where(ps...)
+	return sau
+}
+
+// SetAddedAt sets the "added_at" field.
+func (sau *SavedAudiobookUpdate) SetAddedAt(t time.Time) *SavedAudiobookUpdate {
+	sau.mutation.SetAddedAt(t)
+	return sau
+}
+
+// SetNillableAddedAt sets the "added_at" field if the given value is not nil.
+func (sau *SavedAudiobookUpdate) SetNillableAddedAt(t *time.Time) *SavedAudiobookUpdate {
+	if t != nil {
+		sau.SetAddedAt(*t)
+	}
+	return sau
+}
+
+// ClearAddedAt clears the value of the "added_at" field.
+func (sau *SavedAudiobookUpdate) ClearAddedAt() *SavedAudiobookUpdate {
+	sau.mutation.ClearAddedAt()
+	return sau
+}
+
+// SetSpotifyID sets the "spotify_id" field.
+func (sau *SavedAudiobookUpdate) SetSpotifyID(s string) *SavedAudiobookUpdate {
+	sau.mutation.SetSpotifyID(s)
+	return sau
+}
+
+// SetNillableSpotifyID sets the "spotify_id" field if the given value is not nil.
+func (sau *SavedAudiobookUpdate) SetNillableSpotifyID(s *string) *SavedAudiobookUpdate {
+	if s != nil {
+		sau.SetSpotifyID(*s)
+	}
+	return sau
+}
+
+// SetURI sets the "uri" field.
+func (sau *SavedAudiobookUpdate) SetURI(s string) *SavedAudiobookUpdate {
+	sau.mutation.SetURI(s)
+	return sau
+}
+
+// SetNillableURI sets the "uri" field if the given value is not nil.
+func (sau *SavedAudiobookUpdate) SetNillableURI(s *string) *SavedAudiobookUpdate {
+	if s != nil {
+		sau.SetURI(*s)
+	}
+	return sau
+}
+
+// SetName sets the "name" field.
+func (sau *SavedAudiobookUpdate) SetName(s string) *SavedAudiobookUpdate {
+	sau.mutation.SetName(s)
+	return sau
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (sau *SavedAudiobookUpdate) SetNillableName(s *string) *SavedAudiobookUpdate {
+	if s != nil {
+		sau.SetName(*s)
+	}
+	return sau
+}
+
+// SetAuthors sets the "authors" field.
+func (sau *SavedAudiobookUpdate) SetAuthors(s []string) *SavedAudiobookUpdate {
+	sau.mutation.SetAuthors(s)
+	return sau
+}
+
+// AppendAuthors appends s to the "authors" field.
+func (sau *SavedAudiobookUpdate) AppendAuthors(s []string) *SavedAudiobookUpdate {
+	sau.mutation.AppendAuthors(s)
+	return sau
+}
+
+// ClearAuthors clears the value of the "authors" field.
+func (sau *SavedAudiobookUpdate) ClearAuthors() *SavedAudiobookUpdate {
+	sau.mutation.ClearAuthors()
+	return sau
+}
+
+// SetNarrators sets the "narrators" field.
+func (sau *SavedAudiobookUpdate) SetNarrators(s []string) *SavedAudiobookUpdate {
+	sau.mutation.SetNarrators(s)
+	return sau
+}
+
+// AppendNarrators appends s to the "narrators" field.
+func (sau *SavedAudiobookUpdate) AppendNarrators(s []string) *SavedAudiobookUpdate {
+	sau.mutation.AppendNarrators(s)
+	return sau
+}
+
+// ClearNarrators clears the value of the "narrators" field.
+func (sau *SavedAudiobookUpdate) ClearNarrators() *SavedAudiobookUpdate {
+	sau.mutation.ClearNarrators()
+	return sau
+}
+
+// SetPublisher sets the "publisher" field.
+func (sau *SavedAudiobookUpdate) SetPublisher(s string) *SavedAudiobookUpdate {
+	sau.mutation.SetPublisher(s)
+	return sau
+}
+
+// SetNillablePublisher sets the "publisher" field if the given value is not nil.
+func (sau *SavedAudiobookUpdate) SetNillablePublisher(s *string) *SavedAudiobookUpdate {
+	if s != nil {
+		sau.SetPublisher(*s)
+	}
+	return sau
+}
+
+// SetTotalChapters sets the "total_chapters" field.
+func (sau *SavedAudiobookUpdate) SetTotalChapters(i int) *SavedAudiobookUpdate {
+	sau.mutation.ResetTotalChapters()
+	sau.mutation.SetTotalChapters(i)
+	return sau
+}
+
+// SetNillableTotalChapters sets the "total_chapters" field if the given value is not nil.
+func (sau *SavedAudiobookUpdate) SetNillableTotalChapters(i *int) *SavedAudiobookUpdate {
+	if i != nil {
+		sau.SetTotalChapters(*i)
+	}
+	return sau
+}
+
+// AddTotalChapters adds i to the "total_chapters" field.
+func (sau *SavedAudiobookUpdate) AddTotalChapters(i int) *SavedAudiobookUpdate {
+	sau.mutation.AddTotalChapters(i)
+	return sau
+}
+
+// SetRunID sets the "run" edge to the BackupRun entity by ID.
+func (sau *SavedAudiobookUpdate) SetRunID(id string) *SavedAudiobookUpdate {
+	sau.mutation.SetRunID(id)
+	return sau
+}
+
+// SetRun sets the "run" edge to the BackupRun entity.
+func (sau *SavedAudiobookUpdate) SetRun(b *BackupRun) *SavedAudiobookUpdate {
+	return sau.SetRunID(b.ID)
+}
+
+// Mutation returns the SavedAudiobookMutation object of the builder.
+func (sau *SavedAudiobookUpdate) Mutation() *SavedAudiobookMutation {
+	return sau.mutation
+}
+
+// ClearRun clears the "run" edge to the BackupRun entity.
+func (sau *SavedAudiobookUpdate) ClearRun() *SavedAudiobookUpdate {
+	sau.mutation.ClearRun()
+	return sau
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sau *SavedAudiobookUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, sau.sqlSave, sau.mutation, sau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sau *SavedAudiobookUpdate) SaveX(ctx context.Context) int {
+	affected, err := sau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sau *SavedAudiobookUpdate) Exec(ctx context.Context) error {
+	_, err := sau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sau *SavedAudiobookUpdate) ExecX(ctx context.Context) {
+	if err := sau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sau *SavedAudiobookUpdate) check() error {
+	if v, ok := sau.mutation.TotalChapters(); ok {
+		if err := savedaudiobook.TotalChaptersValidator(v); err != nil {
+			return &ValidationError{Name: "total_chapters", err: fmt.Errorf(`ent: validator failed for field "SavedAudiobook.total_chapters": %w`, err)}
+		}
+	}
+	if sau.mutation.RunCleared() && len(sau.mutation.RunIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SavedAudiobook.run"`)
+	}
+	return nil
+}
+
+func (sau *SavedAudiobookUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := sau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(savedaudiobook.Table, savedaudiobook.Columns, sqlgraph.NewFieldSpec(savedaudiobook.FieldID, field.TypeString))
+	if ps := sau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sau.mutation.AddedAt(); ok {
+		_spec.SetField(savedaudiobook.FieldAddedAt, field.TypeTime, value)
+	}
+	if sau.mutation.AddedAtCleared() {
+		_spec.ClearField(savedaudiobook.FieldAddedAt, field.TypeTime)
+	}
+	if value, ok := sau.mutation.SpotifyID(); ok {
+		_spec.SetField(savedaudiobook.FieldSpotifyID, field.TypeString, value)
+	}
+	if value, ok := sau.mutation.URI(); ok {
+		_spec.SetField(savedaudiobook.FieldURI, field.TypeString, value)
+	}
+	if value, ok := sau.mutation.Name(); ok {
+		_spec.SetField(savedaudiobook.FieldName, field.TypeString, value)
+	}
+	if value, ok := sau.mutation.Authors(); ok {
+		_spec.SetField(savedaudiobook.FieldAuthors, field.TypeJSON, value)
+	}
+	if value, ok := sau.mutation.AppendedAuthors(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, savedaudiobook.FieldAuthors, value)
+		})
+	}
+	if sau.mutation.AuthorsCleared() {
+		_spec.ClearField(savedaudiobook.FieldAuthors, field.TypeJSON)
+	}
+	if value, ok := sau.mutation.Narrators(); ok {
+		_spec.SetField(savedaudiobook.FieldNarrators, field.TypeJSON, value)
+	}
+	if value, ok := sau.mutation.AppendedNarrators(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, savedaudiobook.FieldNarrators, value)
+		})
+	}
+	if sau.mutation.NarratorsCleared() {
+		_spec.ClearField(savedaudiobook.FieldNarrators, field.TypeJSON)
+	}
+	if value, ok := sau.mutation.Publisher(); ok {
+		_spec.SetField(savedaudiobook.FieldPublisher, field.TypeString, value)
+	}
+	if value, ok := sau.mutation.TotalChapters(); ok {
+		_spec.SetField(savedaudiobook.FieldTotalChapters, field.TypeInt, value)
+	}
+	if value, ok := sau.mutation.AddedTotalChapters(); ok {
+		_spec.AddField(savedaudiobook.FieldTotalChapters, field.TypeInt, value)
+	}
+	if sau.mutation.RunCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedaudiobook.RunTable,
+			Columns: []string{savedaudiobook.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sau.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedaudiobook.RunTable,
+			Columns: []string{savedaudiobook.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{savedaudiobook.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sau.mutation.done = true
+	return n, nil
+}
+
+// SavedAudiobookUpdateOne is the builder for updating a single SavedAudiobook entity.
+type SavedAudiobookUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SavedAudiobookMutation
+}
+
+// SetAddedAt sets the "added_at" field.
+func (sauo *SavedAudiobookUpdateOne) SetAddedAt(t time.Time) *SavedAudiobookUpdateOne {
+	sauo.mutation.SetAddedAt(t)
+	return sauo
+}
+
+// SetNillableAddedAt sets the "added_at" field if the given value is not nil.
+func (sauo *SavedAudiobookUpdateOne) SetNillableAddedAt(t *time.Time) *SavedAudiobookUpdateOne {
+	if t != nil {
+		sauo.SetAddedAt(*t)
+	}
+	return sauo
+}
+
+// ClearAddedAt clears the value of the "added_at" field.
+func (sauo *SavedAudiobookUpdateOne) ClearAddedAt() *SavedAudiobookUpdateOne {
+	sauo.mutation.ClearAddedAt()
+	return sauo
+}
+
+// SetSpotifyID sets the "spotify_id" field.
+func (sauo *SavedAudiobookUpdateOne) SetSpotifyID(s string) *SavedAudiobookUpdateOne {
+	sauo.mutation.SetSpotifyID(s)
+	return sauo
+}
+
+// SetNillableSpotifyID sets the "spotify_id" field if the given value is not nil.
+func (sauo *SavedAudiobookUpdateOne) SetNillableSpotifyID(s *string) *SavedAudiobookUpdateOne {
+	if s != nil {
+		sauo.SetSpotifyID(*s)
+	}
+	return sauo
+}
+
+// SetURI sets the "uri" field.
+func (sauo *SavedAudiobookUpdateOne) SetURI(s string) *SavedAudiobookUpdateOne {
+	sauo.mutation.SetURI(s)
+	return sauo
+}
+
+// SetNillableURI sets the "uri" field if the given value is not nil.
+func (sauo *SavedAudiobookUpdateOne) SetNillableURI(s *string) *SavedAudiobookUpdateOne {
+	if s != nil {
+		sauo.SetURI(*s)
+	}
+	return sauo
+}
+
+// SetName sets the "name" field.
+func (sauo *SavedAudiobookUpdateOne) SetName(s string) *SavedAudiobookUpdateOne {
+	sauo.mutation.SetName(s)
+	return sauo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (sauo *SavedAudiobookUpdateOne) SetNillableName(s *string) *SavedAudiobookUpdateOne {
+	if s != nil {
+		sauo.SetName(*s)
+	}
+	return sauo
+}
+
+// SetAuthors sets the "authors" field.
+func (sauo *SavedAudiobookUpdateOne) SetAuthors(s []string) *SavedAudiobookUpdateOne {
+	sauo.mutation.SetAuthors(s)
+	return sauo
+}
+
+// AppendAuthors appends s to the "authors" field.
+func (sauo *SavedAudiobookUpdateOne) AppendAuthors(s []string) *SavedAudiobookUpdateOne {
+	sauo.mutation.AppendAuthors(s)
+	return sauo
+}
+
+// ClearAuthors clears the value of the "authors" field.
+func (sauo *SavedAudiobookUpdateOne) ClearAuthors() *SavedAudiobookUpdateOne {
+	sauo.mutation.ClearAuthors()
+	return sauo
+}
+
+// SetNarrators sets the "narrators" field.
+func (sauo *SavedAudiobookUpdateOne) SetNarrators(s []string) *SavedAudiobookUpdateOne {
+	sauo.mutation.SetNarrators(s)
+	return sauo
+}
+
+// AppendNarrators appends s to the "narrators" field.
+func (sauo *SavedAudiobookUpdateOne) AppendNarrators(s []string) *SavedAudiobookUpdateOne {
+	sauo.mutation.AppendNarrators(s)
+	return sauo
+}
+
+// ClearNarrators clears the value of the "narrators" field.
+func (sauo *SavedAudiobookUpdateOne) ClearNarrators() *SavedAudiobookUpdateOne {
+	sauo.mutation.ClearNarrators()
+	return sauo
+}
+
+// SetPublisher sets the "publisher" field.
+func (sauo *SavedAudiobookUpdateOne) SetPublisher(s string) *SavedAudiobookUpdateOne {
+	sauo.mutation.SetPublisher(s)
+	return sauo
+}
+
+// SetNillablePublisher sets the "publisher" field if the given value is not nil.
+func (sauo *SavedAudiobookUpdateOne) SetNillablePublisher(s *string) *SavedAudiobookUpdateOne {
+	if s != nil {
+		sauo.SetPublisher(*s)
+	}
+	return sauo
+}
+
+// SetTotalChapters sets the "total_chapters" field.
+func (sauo *SavedAudiobookUpdateOne) SetTotalChapters(i int) *SavedAudiobookUpdateOne {
+	sauo.mutation.ResetTotalChapters()
+	sauo.mutation.SetTotalChapters(i)
+	return sauo
+}
+
+// SetNillableTotalChapters sets the "total_chapters" field if the given value is not nil.
+func (sauo *SavedAudiobookUpdateOne) SetNillableTotalChapters(i *int) *SavedAudiobookUpdateOne {
+	if i != nil {
+		sauo.SetTotalChapters(*i)
+	}
+	return sauo
+}
+
+// AddTotalChapters adds i to the "total_chapters" field.
+func (sauo *SavedAudiobookUpdateOne) AddTotalChapters(i int) *SavedAudiobookUpdateOne {
+	sauo.mutation.AddTotalChapters(i)
+	return sauo
+}
+
+// SetRunID sets the "run" edge to the BackupRun entity by ID.
+func (sauo *SavedAudiobookUpdateOne) SetRunID(id string) *SavedAudiobookUpdateOne {
+	sauo.mutation.SetRunID(id)
+	return sauo
+}
+
+// SetRun sets the "run" edge to the BackupRun entity.
+func (sauo *SavedAudiobookUpdateOne) SetRun(b *BackupRun) *SavedAudiobookUpdateOne {
+	return sauo.SetRunID(b.ID)
+}
+
+// Mutation returns the SavedAudiobookMutation object of the builder.
+func (sauo *SavedAudiobookUpdateOne) Mutation() *SavedAudiobookMutation {
+	return sauo.mutation
+}
+
+// ClearRun clears the "run" edge to the BackupRun entity.
+func (sauo *SavedAudiobookUpdateOne) ClearRun() *SavedAudiobookUpdateOne {
+	sauo.mutation.ClearRun()
+	return sauo
+}
+
+// Where appends a list predicates to the SavedAudiobookUpdate builder.
+func (sauo *SavedAudiobookUpdateOne) Where(ps ...predicate.SavedAudiobook) *SavedAudiobookUpdateOne {
+	sauo.mutation.Where(ps...)
+	return sauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sauo *SavedAudiobookUpdateOne) Select(field string, fields ...string) *SavedAudiobookUpdateOne {
+	sauo.fields = append([]string{field}, fields...)
+	return sauo
+}
+
+// Save executes the query and returns the updated SavedAudiobook entity.
+func (sauo *SavedAudiobookUpdateOne) Save(ctx context.Context) (*SavedAudiobook, error) {
+	return withHooks(ctx, sauo.sqlSave, sauo.mutation, sauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sauo *SavedAudiobookUpdateOne) SaveX(ctx context.Context) *SavedAudiobook {
+	node, err := sauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sauo *SavedAudiobookUpdateOne) Exec(ctx context.Context) error {
+	_, err := sauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sauo *SavedAudiobookUpdateOne) ExecX(ctx context.Context) {
+	if err := sauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sauo *SavedAudiobookUpdateOne) check() error {
+	if v, ok := sauo.mutation.TotalChapters(); ok {
+		if err := savedaudiobook.TotalChaptersValidator(v); err != nil {
+			return &ValidationError{Name: "total_chapters", err: fmt.Errorf(`ent: validator failed for field "SavedAudiobook.total_chapters": %w`, err)}
+		}
+	}
+	if sauo.mutation.RunCleared() && len(sauo.mutation.RunIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SavedAudiobook.run"`)
+	}
+	return nil
+}
+
+func (sauo *SavedAudiobookUpdateOne) sqlSave(ctx context.Context) (_node *SavedAudiobook, err error) {
+	if err := sauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(savedaudiobook.Table, savedaudiobook.Columns, sqlgraph.NewFieldSpec(savedaudiobook.FieldID, field.TypeString))
+	id, ok := sauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SavedAudiobook.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, savedaudiobook.FieldID)
+		for _, f := range fields {
+			if !savedaudiobook.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != savedaudiobook.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sauo.mutation.AddedAt(); ok {
+		_spec.SetField(savedaudiobook.FieldAddedAt, field.TypeTime, value)
+	}
+	if sauo.mutation.AddedAtCleared() {
+		_spec.ClearField(savedaudiobook.FieldAddedAt, field.TypeTime)
+	}
+	if value, ok := sauo.mutation.SpotifyID(); ok {
+		_spec.SetField(savedaudiobook.FieldSpotifyID, field.TypeString, value)
+	}
+	if value, ok := sauo.mutation.URI(); ok {
+		_spec.SetField(savedaudiobook.FieldURI, field.TypeString, value)
+	}
+	if value, ok := sauo.mutation.Name(); ok {
+		_spec.SetField(savedaudiobook.FieldName, field.TypeString, value)
+	}
+	if value, ok := sauo.mutation.Authors(); ok {
+		_spec.SetField(savedaudiobook.FieldAuthors, field.TypeJSON, value)
+	}
+	if value, ok := sauo.mutation.AppendedAuthors(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, savedaudiobook.FieldAuthors, value)
+		})
+	}
+	if sauo.mutation.AuthorsCleared() {
+		_spec.ClearField(savedaudiobook.FieldAuthors, field.TypeJSON)
+	}
+	if value, ok := sauo.mutation.Narrators(); ok {
+		_spec.SetField(savedaudiobook.FieldNarrators, field.TypeJSON, value)
+	}
+	if value, ok := sauo.mutation.AppendedNarrators(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, savedaudiobook.FieldNarrators, value)
+		})
+	}
+	if sauo.mutation.NarratorsCleared() {
+		_spec.ClearField(savedaudiobook.FieldNarrators, field.TypeJSON)
+	}
+	if value, ok := sauo.mutation.Publisher(); ok {
+		_spec.SetField(savedaudiobook.FieldPublisher, field.TypeString, value)
+	}
+	if value, ok := sauo.mutation.TotalChapters(); ok {
+		_spec.SetField(savedaudiobook.FieldTotalChapters, field.TypeInt, value)
+	}
+	if value, ok := sauo.mutation.AddedTotalChapters(); ok {
+		_spec.AddField(savedaudiobook.FieldTotalChapters, field.TypeInt, value)
+	}
+	if sauo.mutation.RunCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedaudiobook.RunTable,
+			Columns: []string{savedaudiobook.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sauo.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedaudiobook.RunTable,
+			Columns: []string{savedaudiobook.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SavedAudiobook{config: sauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{savedaudiobook.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	sauo.mutation.done = true
+	return _node, nil
+}
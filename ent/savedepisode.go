@@ -0,0 +1,209 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/episode"
+	"beyerleinf/spotify-backup/ent/savedepisode"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// SavedEpisode is the model entity for the SavedEpisode schema.
+type SavedEpisode struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// AddedAt holds the value of the "added_at" field.
+	AddedAt time.Time `json:"added_at,omitempty"`
+	// FullyPlayed holds the value of the "fully_played" field.
+	FullyPlayed bool `json:"fully_played,omitempty"`
+	// ResumePositionMs holds the value of the "resume_position_ms" field.
+	ResumePositionMs int `json:"resume_position_ms,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SavedEpisodeQuery when eager-loading is set.
+	Edges                     SavedEpisodeEdges `json:"edges"`
+	backup_run_saved_episodes *string
+	saved_episode_episode     *string
+	selectValues              sql.SelectValues
+}
+
+// SavedEpisodeEdges holds the relations/edges for other nodes in the graph.
+type SavedEpisodeEdges struct {
+	// Run holds the value of the run edge.
+	Run *BackupRun `json:"run,omitempty"`
+	// Episode holds the value of the episode edge.
+	Episode *Episode `json:"episode,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// RunOrErr returns the Run value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SavedEpisodeEdges) RunOrErr() (*BackupRun, error) {
+	if e.Run != nil {
+		return e.Run, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: backuprun.Label}
+	}
+	return nil, &NotLoadedError{edge: "run"}
+}
+
+// EpisodeOrErr returns the Episode value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SavedEpisodeEdges) EpisodeOrErr() (*Episode, error) {
+	if e.Episode != nil {
+		return e.Episode, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: episode.Label}
+	}
+	return nil, &NotLoadedError{edge: "episode"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SavedEpisode) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case savedepisode.FieldFullyPlayed:
+			values[i] = new(sql.NullBool)
+		case savedepisode.FieldResumePositionMs:
+			values[i] = new(sql.NullInt64)
+		case savedepisode.FieldID:
+			values[i] = new(sql.NullString)
+		case savedepisode.FieldAddedAt, savedepisode.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case savedepisode.ForeignKeys[0]: // backup_run_saved_episodes
+			values[i] = new(sql.NullString)
+		case savedepisode.ForeignKeys[1]: // saved_episode_episode
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SavedEpisode fields.
+func (se *SavedEpisode) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case savedepisode.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				se.ID = value.String
+			}
+		case savedepisode.FieldAddedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field added_at", values[i])
+			} else if value.Valid {
+				se.AddedAt = value.Time
+			}
+		case savedepisode.FieldFullyPlayed:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field fully_played", values[i])
+			} else if value.Valid {
+				se.FullyPlayed = value.Bool
+			}
+		case savedepisode.FieldResumePositionMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field resume_position_ms", values[i])
+			} else if value.Valid {
+				se.ResumePositionMs = int(value.Int64)
+			}
+		case savedepisode.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				se.CreatedAt = value.Time
+			}
+		case savedepisode.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field backup_run_saved_episodes", values[i])
+			} else if value.Valid {
+				se.backup_run_saved_episodes = new(string)
+				*se.backup_run_saved_episodes = value.String
+			}
+		case savedepisode.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field saved_episode_episode", values[i])
+			} else if value.Valid {
+				se.saved_episode_episode = new(string)
+				*se.saved_episode_episode = value.String
+			}
+		default:
+			se.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SavedEpisode.
+// This includes values selected through modifiers, order, etc.
+func (se *SavedEpisode) Value(name string) (ent.Value, error) {
+	return se.selectValues.Get(name)
+}
+
+// QueryRun queries the "run" edge of the SavedEpisode entity.
+func (se *SavedEpisode) QueryRun() *BackupRunQuery {
+	return NewSavedEpisodeClient(se.config).QueryRun(se)
+}
+
+// QueryEpisode queries the "episode" edge of the SavedEpisode entity.
+func (se *SavedEpisode) QueryEpisode() *EpisodeQuery {
+	return NewSavedEpisodeClient(se.config).QueryEpisode(se)
+}
+
+// Update returns a builder for updating this SavedEpisode.
+// Note that you need to call SavedEpisode.Unwrap() before calling this method if this SavedEpisode
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (se *SavedEpisode) Update() *SavedEpisodeUpdateOne {
+	return NewSavedEpisodeClient(se.config).UpdateOne(se)
+}
+
+// Unwrap unwraps the SavedEpisode entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (se *SavedEpisode) Unwrap() *SavedEpisode {
+	_tx, ok := se.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SavedEpisode is not a transactional entity")
+	}
+	se.config.driver = _tx.drv
+	return se
+}
+
+// String implements the fmt.Stringer.
+func (se *SavedEpisode) String() string {
+	var builder strings.Builder
+	builder.WriteString("SavedEpisode(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", se.ID))
+	builder.WriteString("added_at=")
+	builder.WriteString(se.AddedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("fully_played=")
+	builder.WriteString(fmt.Sprintf("%v", se.FullyPlayed))
+	builder.WriteString(", ")
+	builder.WriteString("resume_position_ms=")
+	builder.WriteString(fmt.Sprintf("%v", se.ResumePositionMs))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(se.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SavedEpisodes is a parsable slice of SavedEpisode.
+type SavedEpisodes []*SavedEpisode
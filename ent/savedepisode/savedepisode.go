@@ -0,0 +1,145 @@
+// Code generated by ent, DO NOT EDIT.
+
+package savedepisode
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the savedepisode type in the database.
+	Label = "saved_episode"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldAddedAt holds the string denoting the added_at field in the database.
+	FieldAddedAt = "added_at"
+	// FieldFullyPlayed holds the string denoting the fully_played field in the database.
+	FieldFullyPlayed = "fully_played"
+	// FieldResumePositionMs holds the string denoting the resume_position_ms field in the database.
+	FieldResumePositionMs = "resume_position_ms"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeRun holds the string denoting the run edge name in mutations.
+	EdgeRun = "run"
+	// EdgeEpisode holds the string denoting the episode edge name in mutations.
+	EdgeEpisode = "episode"
+	// Table holds the table name of the savedepisode in the database.
+	Table = "saved_episodes"
+	// RunTable is the table that holds the run relation/edge.
+	RunTable = "saved_episodes"
+	// RunInverseTable is the table name for the BackupRun entity.
+	// It exists in this package in order to avoid circular dependency with the "backuprun" package.
+	RunInverseTable = "backup_runs"
+	// RunColumn is the table column denoting the run relation/edge.
+	RunColumn = "backup_run_saved_episodes"
+	// EpisodeTable is the table that holds the episode relation/edge.
+	EpisodeTable = "saved_episodes"
+	// EpisodeInverseTable is the table name for the Episode entity.
+	// It exists in this package in order to avoid circular dependency with the "episode" package.
+	EpisodeInverseTable = "episodes"
+	// EpisodeColumn is the table column denoting the episode relation/edge.
+	EpisodeColumn = "saved_episode_episode"
+)
+
+// Columns holds all SQL columns for savedepisode fields.
+var Columns = []string{
+	FieldID,
+	FieldAddedAt,
+	FieldFullyPlayed,
+	FieldResumePositionMs,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "saved_episodes"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"backup_run_saved_episodes",
+	"saved_episode_episode",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultFullyPlayed holds the default value on creation for the "fully_played" field.
+	DefaultFullyPlayed bool
+	// DefaultResumePositionMs holds the default value on creation for the "resume_position_ms" field.
+	DefaultResumePositionMs int
+	// ResumePositionMsValidator is a validator for the "resume_position_ms" field. It is called by the builders before save.
+	ResumePositionMsValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() string
+)
+
+// OrderOption defines the ordering options for the SavedEpisode queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByAddedAt orders the results by the added_at field.
+func ByAddedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAddedAt, opts...).ToFunc()
+}
+
+// ByFullyPlayed orders the results by the fully_played field.
+func ByFullyPlayed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFullyPlayed, opts...).ToFunc()
+}
+
+// ByResumePositionMs orders the results by the resume_position_ms field.
+func ByResumePositionMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResumePositionMs, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByRunField orders the results by run field.
+func ByRunField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRunStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByEpisodeField orders the results by episode field.
+func ByEpisodeField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEpisodeStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newRunStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RunInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, RunTable, RunColumn),
+	)
+}
+func newEpisodeStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EpisodeInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, EpisodeTable, EpisodeColumn),
+	)
+}
@@ -0,0 +1,277 @@
+// Code generated by ent, DO NOT EDIT.
+
+package savedepisode
+
+import (
+	"beyerleinf/spotify-backup/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.SavedEpisode {
+	return predicate.SavedEpisode(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.SavedEpisode {
+	return predicate.SavedEpisode(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.SavedEpisode {
+	return predicate.SavedEpisode(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.SavedEpisode {
+	return predicate.SavedEpisode(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.SavedEpisode {
+	return predicate.SavedEpisode(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.SavedEpisode {
+	return predicate.SavedEpisode(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.SavedEpisode {
+	return predicate.SavedEpisode(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.SavedEpisode {
+	return predicate.SavedEpisode(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.SavedEpisode {
+	return predicate.SavedEpisode(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.SavedEpisode {
+	return predicate.SavedEpisode(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.SavedEpisode {
+	return predicate.SavedEpisode(sql.FieldContainsFold(FieldID, id))
+}
+
+// AddedAt applies equality check predicate on the "added_at" field. It's identical to AddedAtEQ.
+func AddedAt(v time.Time) predicate.SavedEpisode {
+	return predicate.SavedEpisode(sql.FieldEQ(FieldAddedAt, v))
+}
+
+// FullyPlayed applies equality check predicate on the "fully_played" field. It's identical to FullyPlayedEQ.
+func FullyPlayed(v bool) predicate.SavedEpisode {
+	return predicate.SavedEpisode(sql.FieldEQ(FieldFullyPlayed, v))
+}
+
+// ResumePositionMs applies equality check predicate on the "resume_position_ms" field. It's identical to ResumePositionMsEQ.
+func ResumePositionMs(v int) predicate.SavedEpisode {
+	return predicate.SavedEpisode(sql.FieldEQ(FieldResumePositionMs, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SavedEpisode {
+	return predicate.SavedEpisode(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// AddedAtEQ applies the EQ predicate on the "added_at" field.
+func AddedAtEQ(v time.Time) predicate.SavedEpisode {
+	return predicate.SavedEpisode(sql.FieldEQ(FieldAddedAt, v))
+}
+
+// AddedAtNEQ applies the NEQ predicate on the "added_at" field.
+func AddedAtNEQ(v time.Time) predicate.SavedEpisode {
+	return predicate.SavedEpisode(sql.FieldNEQ(FieldAddedAt, v))
+}
+
+// AddedAtIn applies the In predicate on the "added_at" field.
+func AddedAtIn(vs ...time.Time) predicate.SavedEpisode {
+	return predicate.SavedEpisode(sql.FieldIn(FieldAddedAt, vs...))
+}
+
+// AddedAtNotIn applies the NotIn predicate on the "added_at" field.
+func AddedAtNotIn(vs ...time.Time) predicate.SavedEpisode {
+	return predicate.SavedEpisode(sql.FieldNotIn(FieldAddedAt, vs...))
+}
+
+// AddedAtGT applies the GT predicate on the "added_at" field.
+func AddedAtGT(v time.Time) predicate.SavedEpisode {
+	return predicate.SavedEpisode(sql.FieldGT(FieldAddedAt, v))
+}
+
+// AddedAtGTE applies the GTE predicate on the "added_at" field.
+func AddedAtGTE(v time.Time) predicate.SavedEpisode {
+	return predicate.SavedEpisode(sql.FieldGTE(FieldAddedAt, v))
+}
+
+// AddedAtLT applies the LT predicate on the "added_at" field.
+func AddedAtLT(v time.Time) predicate.SavedEpisode {
+	return predicate.SavedEpisode(sql.FieldLT(FieldAddedAt, v))
+}
+
+// AddedAtLTE applies the LTE predicate on the "added_at" field.
+func AddedAtLTE(v time.Time) predicate.SavedEpisode {
+	return predicate.SavedEpisode(sql.FieldLTE(FieldAddedAt, v))
+}
+
+// FullyPlayedEQ applies the EQ predicate on the "fully_played" field.
+func FullyPlayedEQ(v bool) predicate.SavedEpisode {
+	return predicate.SavedEpisode(sql.FieldEQ(FieldFullyPlayed, v))
+}
+
+// FullyPlayedNEQ applies the NEQ predicate on the "fully_played" field.
+func FullyPlayedNEQ(v bool) predicate.SavedEpisode {
+	return predicate.SavedEpisode(sql.FieldNEQ(FieldFullyPlayed, v))
+}
+
+// ResumePositionMsEQ applies the EQ predicate on the "resume_position_ms" field.
+func ResumePositionMsEQ(v int) predicate.SavedEpisode {
+	return predicate.SavedEpisode(sql.FieldEQ(FieldResumePositionMs, v))
+}
+
+// ResumePositionMsNEQ applies the NEQ predicate on the "resume_position_ms" field.
+func ResumePositionMsNEQ(v int) predicate.SavedEpisode {
+	return predicate.SavedEpisode(sql.FieldNEQ(FieldResumePositionMs, v))
+}
+
+// ResumePositionMsIn applies the In predicate on the "resume_position_ms" field.
+func ResumePositionMsIn(vs ...int) predicate.SavedEpisode {
+	return predicate.SavedEpisode(sql.FieldIn(FieldResumePositionMs, vs...))
+}
+
+// ResumePositionMsNotIn applies the NotIn predicate on the "resume_position_ms" field.
+func ResumePositionMsNotIn(vs ...int) predicate.SavedEpisode {
+	return predicate.SavedEpisode(sql.FieldNotIn(FieldResumePositionMs, vs...))
+}
+
+// ResumePositionMsGT applies the GT predicate on the "resume_position_ms" field.
+func ResumePositionMsGT(v int) predicate.SavedEpisode {
+	return predicate.SavedEpisode(sql.FieldGT(FieldResumePositionMs, v))
+}
+
+// ResumePositionMsGTE applies the GTE predicate on the "resume_position_ms" field.
+func ResumePositionMsGTE(v int) predicate.SavedEpisode {
+	return predicate.SavedEpisode(sql.FieldGTE(FieldResumePositionMs, v))
+}
+
+// ResumePositionMsLT applies the LT predicate on the "resume_position_ms" field.
+func ResumePositionMsLT(v int) predicate.SavedEpisode {
+	return predicate.SavedEpisode(sql.FieldLT(FieldResumePositionMs, v))
+}
+
+// ResumePositionMsLTE applies the LTE predicate on the "resume_position_ms" field.
+func ResumePositionMsLTE(v int) predicate.SavedEpisode {
+	return predicate.SavedEpisode(sql.FieldLTE(FieldResumePositionMs, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SavedEpisode {
+	return predicate.SavedEpisode(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SavedEpisode {
+	return predicate.SavedEpisode(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SavedEpisode {
+	return predicate.SavedEpisode(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SavedEpisode {
+	return predicate.SavedEpisode(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SavedEpisode {
+	return predicate.SavedEpisode(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SavedEpisode {
+	return predicate.SavedEpisode(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SavedEpisode {
+	return predicate.SavedEpisode(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SavedEpisode {
+	return predicate.SavedEpisode(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasRun applies the HasEdge predicate on the "run" edge.
+func HasRun() predicate.SavedEpisode {
+	return predicate.SavedEpisode(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RunTable, RunColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRunWith applies the HasEdge predicate on the "run" edge with a given conditions (other predicates).
+func HasRunWith(preds ...predicate.BackupRun) predicate.SavedEpisode {
+	return predicate.SavedEpisode(func(s *sql.Selector) {
+		step := newRunStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEpisode applies the HasEdge predicate on the "episode" edge.
+func HasEpisode() predicate.SavedEpisode {
+	return predicate.SavedEpisode(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, EpisodeTable, EpisodeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEpisodeWith applies the HasEdge predicate on the "episode" edge with a given conditions (other predicates).
+func HasEpisodeWith(preds ...predicate.Episode) predicate.SavedEpisode {
+	return predicate.SavedEpisode(func(s *sql.Selector) {
+		step := newEpisodeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SavedEpisode) predicate.SavedEpisode {
+	return predicate.SavedEpisode(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SavedEpisode) predicate.SavedEpisode {
+	return predicate.SavedEpisode(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SavedEpisode) predicate.SavedEpisode {
+	return predicate.SavedEpisode(sql.NotPredicates(p))
+}
@@ -0,0 +1,357 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/episode"
+	"beyerleinf/spotify-backup/ent/savedepisode"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SavedEpisodeCreate is the builder for creating a SavedEpisode entity.
+type SavedEpisodeCreate struct {
+	config
+	mutation *SavedEpisodeMutation
+	hooks    []Hook
+}
+
+// SetAddedAt sets the "added_at" field.
+func (sec *SavedEpisodeCreate) SetAddedAt(t time.Time) *SavedEpisodeCreate {
+	sec.mutation.SetAddedAt(t)
+	return sec
+}
+
+// SetFullyPlayed sets the "fully_played" field.
+func (sec *SavedEpisodeCreate) SetFullyPlayed(b bool) *SavedEpisodeCreate {
+	sec.mutation.SetFullyPlayed(b)
+	return sec
+}
+
+// SetNillableFullyPlayed sets the "fully_played" field if the given value is not nil.
+func (sec *SavedEpisodeCreate) SetNillableFullyPlayed(b *bool) *SavedEpisodeCreate {
+	if b != nil {
+		sec.SetFullyPlayed(*b)
+	}
+	return sec
+}
+
+// SetResumePositionMs sets the "resume_position_ms" field.
+func (sec *SavedEpisodeCreate) SetResumePositionMs(i int) *SavedEpisodeCreate {
+	sec.mutation.SetResumePositionMs(i)
+	return sec
+}
+
+// SetNillableResumePositionMs sets the "resume_position_ms" field if the given value is not nil.
+func (sec *SavedEpisodeCreate) SetNillableResumePositionMs(i *int) *SavedEpisodeCreate {
+	if i != nil {
+		sec.SetResumePositionMs(*i)
+	}
+	return sec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sec *SavedEpisodeCreate) SetCreatedAt(t time.Time) *SavedEpisodeCreate {
+	sec.mutation.SetCreatedAt(t)
+	return sec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sec *SavedEpisodeCreate) SetNillableCreatedAt(t *time.Time) *SavedEpisodeCreate {
+	if t != nil {
+		sec.SetCreatedAt(*t)
+	}
+	return sec
+}
+
+// SetID sets the "id" field.
+func (sec *SavedEpisodeCreate) SetID(s string) *SavedEpisodeCreate {
+	sec.mutation.SetID(s)
+	return sec
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (sec *SavedEpisodeCreate) SetNillableID(s *string) *SavedEpisodeCreate {
+	if s != nil {
+		sec.SetID(*s)
+	}
+	return sec
+}
+
+// SetRunID sets the "run" edge to the BackupRun entity by ID.
+func (sec *SavedEpisodeCreate) SetRunID(id string) *SavedEpisodeCreate {
+	sec.mutation.SetRunID(id)
+	return sec
+}
+
+// SetRun sets the "run" edge to the BackupRun entity.
+func (sec *SavedEpisodeCreate) SetRun(b *BackupRun) *SavedEpisodeCreate {
+	return sec.SetRunID(b.ID)
+}
+
+// SetEpisodeID sets the "episode" edge to the Episode entity by ID.
+func (sec *SavedEpisodeCreate) SetEpisodeID(id string) *SavedEpisodeCreate {
+	sec.mutation.SetEpisodeID(id)
+	return sec
+}
+
+// SetEpisode sets the "episode" edge to the Episode entity.
+func (sec *SavedEpisodeCreate) SetEpisode(e *Episode) *SavedEpisodeCreate {
+	return sec.SetEpisodeID(e.ID)
+}
+
+// Mutation returns the SavedEpisodeMutation object of the builder.
+func (sec *SavedEpisodeCreate) Mutation() *SavedEpisodeMutation {
+	return sec.mutation
+}
+
+// Save creates the SavedEpisode in the database.
+func (sec *SavedEpisodeCreate) Save(ctx context.Context) (*SavedEpisode, error) {
+	sec.defaults()
+	return withHooks(ctx, sec.sqlSave, sec.mutation, sec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sec *SavedEpisodeCreate) SaveX(ctx context.Context) *SavedEpisode {
+	v, err := sec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sec *SavedEpisodeCreate) Exec(ctx context.Context) error {
+	_, err := sec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sec *SavedEpisodeCreate) ExecX(ctx context.Context) {
+	if err := sec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sec *SavedEpisodeCreate) defaults() {
+	if _, ok := sec.mutation.FullyPlayed(); !ok {
+		v := savedepisode.DefaultFullyPlayed
+		sec.mutation.SetFullyPlayed(v)
+	}
+	if _, ok := sec.mutation.ResumePositionMs(); !ok {
+		v := savedepisode.DefaultResumePositionMs
+		sec.mutation.SetResumePositionMs(v)
+	}
+	if _, ok := sec.mutation.CreatedAt(); !ok {
+		v := savedepisode.DefaultCreatedAt()
+		sec.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sec.mutation.ID(); !ok {
+		v := savedepisode.DefaultID()
+		sec.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sec *SavedEpisodeCreate) check() error {
+	if _, ok := sec.mutation.AddedAt(); !ok {
+		return &ValidationError{Name: "added_at", err: errors.New(`ent: missing required field "SavedEpisode.added_at"`)}
+	}
+	if _, ok := sec.mutation.FullyPlayed(); !ok {
+		return &ValidationError{Name: "fully_played", err: errors.New(`ent: missing required field "SavedEpisode.fully_played"`)}
+	}
+	if _, ok := sec.mutation.ResumePositionMs(); !ok {
+		return &ValidationError{Name: "resume_position_ms", err: errors.New(`ent: missing required field "SavedEpisode.resume_position_ms"`)}
+	}
+	if v, ok := sec.mutation.ResumePositionMs(); ok {
+		if err := savedepisode.ResumePositionMsValidator(v); err != nil {
+			return &ValidationError{Name: "resume_position_ms", err: fmt.Errorf(`ent: validator failed for field "SavedEpisode.resume_position_ms": %w`, err)}
+		}
+	}
+	if _, ok := sec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SavedEpisode.created_at"`)}
+	}
+	if len(sec.mutation.RunIDs()) == 0 {
+		return &ValidationError{Name: "run", err: errors.New(`ent: missing required edge "SavedEpisode.run"`)}
+	}
+	if len(sec.mutation.EpisodeIDs()) == 0 {
+		return &ValidationError{Name: "episode", err: errors.New(`ent: missing required edge "SavedEpisode.episode"`)}
+	}
+	return nil
+}
+
+func (sec *SavedEpisodeCreate) sqlSave(ctx context.Context) (*SavedEpisode, error) {
+	if err := sec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected SavedEpisode.ID type: %T", _spec.ID.Value)
+		}
+	}
+	sec.mutation.id = &_node.ID
+	sec.mutation.done = true
+	return _node, nil
+}
+
+func (sec *SavedEpisodeCreate) createSpec() (*SavedEpisode, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SavedEpisode{config: sec.config}
+		_spec = sqlgraph.NewCreateSpec(savedepisode.Table, sqlgraph.NewFieldSpec(savedepisode.FieldID, field.TypeString))
+	)
+	if id, ok := sec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := sec.mutation.AddedAt(); ok {
+		_spec.SetField(savedepisode.FieldAddedAt, field.TypeTime, value)
+		_node.AddedAt = value
+	}
+	if value, ok := sec.mutation.FullyPlayed(); ok {
+		_spec.SetField(savedepisode.FieldFullyPlayed, field.TypeBool, value)
+		_node.FullyPlayed = value
+	}
+	if value, ok := sec.mutation.ResumePositionMs(); ok {
+		_spec.SetField(savedepisode.FieldResumePositionMs, field.TypeInt, value)
+		_node.ResumePositionMs = value
+	}
+	if value, ok := sec.mutation.CreatedAt(); ok {
+		_spec.SetField(savedepisode.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := sec.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedepisode.RunTable,
+			Columns: []string{savedepisode.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.backup_run_saved_episodes = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sec.mutation.EpisodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   savedepisode.EpisodeTable,
+			Columns: []string{savedepisode.EpisodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.saved_episode_episode = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SavedEpisodeCreateBulk is the builder for creating many SavedEpisode entities in bulk.
+type SavedEpisodeCreateBulk struct {
+	config
+	err      error
+	builders []*SavedEpisodeCreate
+}
+
+// Save creates the SavedEpisode entities in the database.
+func (secb *SavedEpisodeCreateBulk) Save(ctx context.Context) ([]*SavedEpisode, error) {
+	if secb.err != nil {
+		return nil, secb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(secb.builders))
+	nodes := make([]*SavedEpisode, len(secb.builders))
+	mutators := make([]Mutator, len(secb.builders))
+	for i := range secb.builders {
+		func(i int, root context.Context) {
+			builder := secb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SavedEpisodeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, secb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, secb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, secb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (secb *SavedEpisodeCreateBulk) SaveX(ctx context.Context) []*SavedEpisode {
+	v, err := secb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (secb *SavedEpisodeCreateBulk) Exec(ctx context.Context) error {
+	_, err := secb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (secb *SavedEpisodeCreateBulk) ExecX(ctx context.Context) {
+	if err := secb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
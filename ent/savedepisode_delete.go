@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/predicate"
+	"beyerleinf/spotify-backup/ent/savedepisode"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SavedEpisodeDelete is the builder for deleting a SavedEpisode entity.
+type SavedEpisodeDelete struct {
+	config
+	hooks    []Hook
+	mutation *SavedEpisodeMutation
+}
+
+// Where appends a list predicates to the SavedEpisodeDelete builder.
+func (sed *SavedEpisodeDelete) Where(ps ...predicate.SavedEpisode) *SavedEpisodeDelete {
+	sed.mutation.Where(ps...)
+	return sed
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (sed *SavedEpisodeDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, sed.sqlExec, sed.mutation, sed.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sed *SavedEpisodeDelete) ExecX(ctx context.Context) int {
+	n, err := sed.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (sed *SavedEpisodeDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(savedepisode.Table, sqlgraph.NewFieldSpec(savedepisode.FieldID, field.TypeString))
+	if ps := sed.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, sed.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	sed.mutation.done = true
+	return affected, err
+}
+
+// SavedEpisodeDeleteOne is the builder for deleting a single SavedEpisode entity.
+type SavedEpisodeDeleteOne struct {
+	sed *SavedEpisodeDelete
+}
+
+// Where appends a list predicates to the SavedEpisodeDelete builder.
+func (sedo *SavedEpisodeDeleteOne) Where(ps ...predicate.SavedEpisode) *SavedEpisodeDeleteOne {
+	sedo.sed.mutation.Where(ps...)
+	return sedo
+}
+
+// Exec executes the deletion query.
+func (sedo *SavedEpisodeDeleteOne) Exec(ctx context.Context) error {
+	n, err := sedo.sed.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{savedepisode.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sedo *SavedEpisodeDeleteOne) ExecX(ctx context.Context) {
+	if err := sedo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
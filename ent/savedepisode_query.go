@@ -0,0 +1,726 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/episode"
+	"beyerleinf/spotify-backup/ent/predicate"
+	"beyerleinf/spotify-backup/ent/savedepisode"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SavedEpisodeQuery is the builder for querying SavedEpisode entities.
+type SavedEpisodeQuery struct {
+	config
+	ctx         *QueryContext
+	order       []savedepisode.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.SavedEpisode
+	withRun     *BackupRunQuery
+	withEpisode *EpisodeQuery
+	withFKs     bool
+	modifiers   []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SavedEpisodeQuery builder.
+func (seq *SavedEpisodeQuery) Where(ps ...predicate.SavedEpisode) *SavedEpisodeQuery {
+	seq.predicates = append(seq.predicates, ps...)
+	return seq
+}
+
+// Limit the number of records to be returned by this query.
+func (seq *SavedEpisodeQuery) Limit(limit int) *SavedEpisodeQuery {
+	seq.ctx.Limit = &limit
+	return seq
+}
+
+// Offset to start from.
+func (seq *SavedEpisodeQuery) Offset(offset int) *SavedEpisodeQuery {
+	seq.ctx.Offset = &offset
+	return seq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (seq *SavedEpisodeQuery) Unique(unique bool) *SavedEpisodeQuery {
+	seq.ctx.Unique = &unique
+	return seq
+}
+
+// Order specifies how the records should be ordered.
+func (seq *SavedEpisodeQuery) Order(o ...savedepisode.OrderOption) *SavedEpisodeQuery {
+	seq.order = append(seq.order, o...)
+	return seq
+}
+
+// QueryRun chains the current query on the "run" edge.
+func (seq *SavedEpisodeQuery) QueryRun() *BackupRunQuery {
+	query := (&BackupRunClient{config: seq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := seq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := seq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(savedepisode.Table, savedepisode.FieldID, selector),
+			sqlgraph.To(backuprun.Table, backuprun.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, savedepisode.RunTable, savedepisode.RunColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(seq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEpisode chains the current query on the "episode" edge.
+func (seq *SavedEpisodeQuery) QueryEpisode() *EpisodeQuery {
+	query := (&EpisodeClient{config: seq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := seq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := seq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(savedepisode.Table, savedepisode.FieldID, selector),
+			sqlgraph.To(episode.Table, episode.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, savedepisode.EpisodeTable, savedepisode.EpisodeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(seq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SavedEpisode entity from the query.
+// Returns a *NotFoundError when no SavedEpisode was found.
+func (seq *SavedEpisodeQuery) First(ctx context.Context) (*SavedEpisode, error) {
+	nodes, err := seq.Limit(1).All(setContextOp(ctx, seq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{savedepisode.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (seq *SavedEpisodeQuery) FirstX(ctx context.Context) *SavedEpisode {
+	node, err := seq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SavedEpisode ID from the query.
+// Returns a *NotFoundError when no SavedEpisode ID was found.
+func (seq *SavedEpisodeQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = seq.Limit(1).IDs(setContextOp(ctx, seq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{savedepisode.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (seq *SavedEpisodeQuery) FirstIDX(ctx context.Context) string {
+	id, err := seq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SavedEpisode entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SavedEpisode entity is found.
+// Returns a *NotFoundError when no SavedEpisode entities are found.
+func (seq *SavedEpisodeQuery) Only(ctx context.Context) (*SavedEpisode, error) {
+	nodes, err := seq.Limit(2).All(setContextOp(ctx, seq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{savedepisode.Label}
+	default:
+		return nil, &NotSingularError{savedepisode.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (seq *SavedEpisodeQuery) OnlyX(ctx context.Context) *SavedEpisode {
+	node, err := seq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SavedEpisode ID in the query.
+// Returns a *NotSingularError when more than one SavedEpisode ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (seq *SavedEpisodeQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = seq.Limit(2).IDs(setContextOp(ctx, seq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{savedepisode.Label}
+	default:
+		err = &NotSingularError{savedepisode.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (seq *SavedEpisodeQuery) OnlyIDX(ctx context.Context) string {
+	id, err := seq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SavedEpisodes.
+func (seq *SavedEpisodeQuery) All(ctx context.Context) ([]*SavedEpisode, error) {
+	ctx = setContextOp(ctx, seq.ctx, ent.OpQueryAll)
+	if err := seq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SavedEpisode, *SavedEpisodeQuery]()
+	return withInterceptors[[]*SavedEpisode](ctx, seq, qr, seq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (seq *SavedEpisodeQuery) AllX(ctx context.Context) []*SavedEpisode {
+	nodes, err := seq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SavedEpisode IDs.
+func (seq *SavedEpisodeQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if seq.ctx.Unique == nil && seq.path != nil {
+		seq.Unique(true)
+	}
+	ctx = setContextOp(ctx, seq.ctx, ent.OpQueryIDs)
+	if err = seq.Select(savedepisode.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (seq *SavedEpisodeQuery) IDsX(ctx context.Context) []string {
+	ids, err := seq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (seq *SavedEpisodeQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, seq.ctx, ent.OpQueryCount)
+	if err := seq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, seq, querierCount[*SavedEpisodeQuery](), seq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (seq *SavedEpisodeQuery) CountX(ctx context.Context) int {
+	count, err := seq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (seq *SavedEpisodeQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, seq.ctx, ent.OpQueryExist)
+	switch _, err := seq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (seq *SavedEpisodeQuery) ExistX(ctx context.Context) bool {
+	exist, err := seq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SavedEpisodeQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (seq *SavedEpisodeQuery) Clone() *SavedEpisodeQuery {
+	if seq == nil {
+		return nil
+	}
+	return &SavedEpisodeQuery{
+		config:      seq.config,
+		ctx:         seq.ctx.Clone(),
+		order:       append([]savedepisode.OrderOption{}, seq.order...),
+		inters:      append([]Interceptor{}, seq.inters...),
+		predicates:  append([]predicate.SavedEpisode{}, seq.predicates...),
+		withRun:     seq.withRun.Clone(),
+		withEpisode: seq.withEpisode.Clone(),
+		// clone intermediate query.
+		sql:  seq.sql.Clone(),
+		path: seq.path,
+	}
+}
+
+// WithRun tells the query-builder to eager-load the nodes that are connected to
+// the "run" edge. The optional arguments are used to configure the query builder of the edge.
+func (seq *SavedEpisodeQuery) WithRun(opts ...func(*BackupRunQuery)) *SavedEpisodeQuery {
+	query := (&BackupRunClient{config: seq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	seq.withRun = query
+	return seq
+}
+
+// WithEpisode tells the query-builder to eager-load the nodes that are connected to
+// the "episode" edge. The optional arguments are used to configure the query builder of the edge.
+func (seq *SavedEpisodeQuery) WithEpisode(opts ...func(*EpisodeQuery)) *SavedEpisodeQuery {
+	query := (&EpisodeClient{config: seq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	seq.withEpisode = query
+	return seq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		AddedAt time.Time `json:"added_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SavedEpisode.Query().
+//		GroupBy(savedepisode.FieldAddedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (seq *SavedEpisodeQuery) GroupBy(field string, fields ...string) *SavedEpisodeGroupBy {
+	seq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SavedEpisodeGroupBy{build: seq}
+	grbuild.flds = &seq.ctx.Fields
+	grbuild.label = savedepisode.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		AddedAt time.Time `json:"added_at,omitempty"`
+//	}
+//
+//	client.SavedEpisode.Query().
+//		Select(savedepisode.FieldAddedAt).
+//		Scan(ctx, &v)
+func (seq *SavedEpisodeQuery) Select(fields ...string) *SavedEpisodeSelect {
+	seq.ctx.Fields = append(seq.ctx.Fields, fields...)
+	sbuild := &SavedEpisodeSelect{SavedEpisodeQuery: seq}
+	sbuild.label = savedepisode.Label
+	sbuild.flds, sbuild.scan = &seq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SavedEpisodeSelect configured with the given aggregations.
+func (seq *SavedEpisodeQuery) Aggregate(fns ...AggregateFunc) *SavedEpisodeSelect {
+	return seq.Select().Aggregate(fns...)
+}
+
+func (seq *SavedEpisodeQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range seq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, seq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range seq.ctx.Fields {
+		if !savedepisode.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if seq.path != nil {
+		prev, err := seq.path(ctx)
+		if err != nil {
+			return err
+		}
+		seq.sql = prev
+	}
+	return nil
+}
+
+func (seq *SavedEpisodeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SavedEpisode, error) {
+	var (
+		nodes       = []*SavedEpisode{}
+		withFKs     = seq.withFKs
+		_spec       = seq.querySpec()
+		loadedTypes = [2]bool{
+			seq.withRun != nil,
+			seq.withEpisode != nil,
+		}
+	)
+	if seq.withRun != nil || seq.withEpisode != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, savedepisode.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SavedEpisode).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SavedEpisode{config: seq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(seq.modifiers) > 0 {
+		_spec.Modifiers = seq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, seq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := seq.withRun; query != nil {
+		if err := seq.loadRun(ctx, query, nodes, nil,
+			func(n *SavedEpisode, e *BackupRun) { n.Edges.Run = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := seq.withEpisode; query != nil {
+		if err := seq.loadEpisode(ctx, query, nodes, nil,
+			func(n *SavedEpisode, e *Episode) { n.Edges.Episode = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (seq *SavedEpisodeQuery) loadRun(ctx context.Context, query *BackupRunQuery, nodes []*SavedEpisode, init func(*SavedEpisode), assign func(*SavedEpisode, *BackupRun)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*SavedEpisode)
+	for i := range nodes {
+		if nodes[i].backup_run_saved_episodes == nil {
+			continue
+		}
+		fk := *nodes[i].backup_run_saved_episodes
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(backuprun.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "backup_run_saved_episodes" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (seq *SavedEpisodeQuery) loadEpisode(ctx context.Context, query *EpisodeQuery, nodes []*SavedEpisode, init func(*SavedEpisode), assign func(*SavedEpisode, *Episode)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*SavedEpisode)
+	for i := range nodes {
+		if nodes[i].saved_episode_episode == nil {
+			continue
+		}
+		fk := *nodes[i].saved_episode_episode
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(episode.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "saved_episode_episode" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (seq *SavedEpisodeQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := seq.querySpec()
+	if len(seq.modifiers) > 0 {
+		_spec.Modifiers = seq.modifiers
+	}
+	_spec.Node.Columns = seq.ctx.Fields
+	if len(seq.ctx.Fields) > 0 {
+		_spec.Unique = seq.ctx.Unique != nil && *seq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, seq.driver, _spec)
+}
+
+func (seq *SavedEpisodeQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(savedepisode.Table, savedepisode.Columns, sqlgraph.NewFieldSpec(savedepisode.FieldID, field.TypeString))
+	_spec.From = seq.sql
+	if unique := seq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if seq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := seq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, savedepisode.FieldID)
+		for i := range fields {
+			if fields[i] != savedepisode.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := seq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := seq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := seq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := seq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (seq *SavedEpisodeQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(seq.driver.Dialect())
+	t1 := builder.Table(savedepisode.Table)
+	columns := seq.ctx.Fields
+	if len(columns) == 0 {
+		columns = savedepisode.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if seq.sql != nil {
+		selector = seq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if seq.ctx.Unique != nil && *seq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range seq.modifiers {
+		m(selector)
+	}
+	for _, p := range seq.predicates {
+		p(selector)
+	}
+	for _, p := range seq.order {
+		p(selector)
+	}
+	if offset := seq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := seq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (seq *SavedEpisodeQuery) ForUpdate(opts ...sql.LockOption) *SavedEpisodeQuery {
+	if seq.driver.Dialect() == dialect.Postgres {
+		seq.Unique(false)
+	}
+	seq.modifiers = append(seq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return seq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (seq *SavedEpisodeQuery) ForShare(opts ...sql.LockOption) *SavedEpisodeQuery {
+	if seq.driver.Dialect() == dialect.Postgres {
+		seq.Unique(false)
+	}
+	seq.modifiers = append(seq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return seq
+}
+
+// SavedEpisodeGroupBy is the group-by builder for SavedEpisode entities.
+type SavedEpisodeGroupBy struct {
+	selector
+	build *SavedEpisodeQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (segb *SavedEpisodeGroupBy) Aggregate(fns ...AggregateFunc) *SavedEpisodeGroupBy {
+	segb.fns = append(segb.fns, fns...)
+	return segb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (segb *SavedEpisodeGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, segb.build.ctx, ent.OpQueryGroupBy)
+	if err := segb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SavedEpisodeQuery, *SavedEpisodeGroupBy](ctx, segb.build, segb, segb.build.inters, v)
+}
+
+func (segb *SavedEpisodeGroupBy) sqlScan(ctx context.Context, root *SavedEpisodeQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(segb.fns))
+	for _, fn := range segb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*segb.flds)+len(segb.fns))
+		for _, f := range *segb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*segb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := segb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SavedEpisodeSelect is the builder for selecting fields of SavedEpisode entities.
+type SavedEpisodeSelect struct {
+	*SavedEpisodeQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ses *SavedEpisodeSelect) Aggregate(fns ...AggregateFunc) *SavedEpisodeSelect {
+	ses.fns = append(ses.fns, fns...)
+	return ses
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ses *SavedEpisodeSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ses.ctx, ent.OpQuerySelect)
+	if err := ses.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SavedEpisodeQuery, *SavedEpisodeSelect](ctx, ses.SavedEpisodeQuery, ses, ses.inters, v)
+}
+
+func (ses *SavedEpisodeSelect) sqlScan(ctx context.Context, root *SavedEpisodeQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ses.fns))
+	for _, fn := range ses.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ses.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ses.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
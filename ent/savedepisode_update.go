@@ -0,0 +1,522 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/episode"
+	"beyerleinf/spotify-backup/ent/predicate"
+	"beyerleinf/spotify-backup/ent/savedepisode"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SavedEpisodeUpdate is the builder for updating SavedEpisode entities.
+type SavedEpisodeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SavedEpisodeMutation
+}
+
+// Where appends a list predicates to the SavedEpisodeUpdate builder.
+func (seu *SavedEpisodeUpdate) Where(ps ...predicate.SavedEpisode) *SavedEpisodeUpdate {
+	seu.mutation.Where(ps...)
+	return seu
+}
+
+// SetAddedAt sets the "added_at" field.
+func (seu *SavedEpisodeUpdate) SetAddedAt(t time.Time) *SavedEpisodeUpdate {
+	seu.mutation.SetAddedAt(t)
+	return seu
+}
+
+// SetNillableAddedAt sets the "added_at" field if the given value is not nil.
+func (seu *SavedEpisodeUpdate) SetNillableAddedAt(t *time.Time) *SavedEpisodeUpdate {
+	if t != nil {
+		seu.SetAddedAt(*t)
+	}
+	return seu
+}
+
+// SetFullyPlayed sets the "fully_played" field.
+func (seu *SavedEpisodeUpdate) SetFullyPlayed(b bool) *SavedEpisodeUpdate {
+	seu.mutation.SetFullyPlayed(b)
+	return seu
+}
+
+// SetNillableFullyPlayed sets the "fully_played" field if the given value is not nil.
+func (seu *SavedEpisodeUpdate) SetNillableFullyPlayed(b *bool) *SavedEpisodeUpdate {
+	if b != nil {
+		seu.SetFullyPlayed(*b)
+	}
+	return seu
+}
+
+// SetResumePositionMs sets the "resume_position_ms" field.
+func (seu *SavedEpisodeUpdate) SetResumePositionMs(i int) *SavedEpisodeUpdate {
+	seu.mutation.ResetResumePositionMs()
+	seu.mutation.SetResumePositionMs(i)
+	return seu
+}
+
+// SetNillableResumePositionMs sets the "resume_position_ms" field if the given value is not nil.
+func (seu *SavedEpisodeUpdate) SetNillableResumePositionMs(i *int) *SavedEpisodeUpdate {
+	if i != nil {
+		seu.SetResumePositionMs(*i)
+	}
+	return seu
+}
+
+// AddResumePositionMs adds i to the "resume_position_ms" field.
+func (seu *SavedEpisodeUpdate) AddResumePositionMs(i int) *SavedEpisodeUpdate {
+	seu.mutation.AddResumePositionMs(i)
+	return seu
+}
+
+// SetRunID sets the "run" edge to the BackupRun entity by ID.
+func (seu *SavedEpisodeUpdate) SetRunID(id string) *SavedEpisodeUpdate {
+	seu.mutation.SetRunID(id)
+	return seu
+}
+
+// SetRun sets the "run" edge to the BackupRun entity.
+func (seu *SavedEpisodeUpdate) SetRun(b *BackupRun) *SavedEpisodeUpdate {
+	return seu.SetRunID(b.ID)
+}
+
+// SetEpisodeID sets the "episode" edge to the Episode entity by ID.
+func (seu *SavedEpisodeUpdate) SetEpisodeID(id string) *SavedEpisodeUpdate {
+	seu.mutation.SetEpisodeID(id)
+	return seu
+}
+
+// SetEpisode sets the "episode" edge to the Episode entity.
+func (seu *SavedEpisodeUpdate) SetEpisode(e *Episode) *SavedEpisodeUpdate {
+	return seu.SetEpisodeID(e.ID)
+}
+
+// Mutation returns the SavedEpisodeMutation object of the builder.
+func (seu *SavedEpisodeUpdate) Mutation() *SavedEpisodeMutation {
+	return seu.mutation
+}
+
+// ClearRun clears the "run" edge to the BackupRun entity.
+func (seu *SavedEpisodeUpdate) ClearRun() *SavedEpisodeUpdate {
+	seu.mutation.ClearRun()
+	return seu
+}
+
+// ClearEpisode clears the "episode" edge to the Episode entity.
+func (seu *SavedEpisodeUpdate) ClearEpisode() *SavedEpisodeUpdate {
+	seu.mutation.ClearEpisode()
+	return seu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (seu *SavedEpisodeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, seu.sqlSave, seu.mutation, seu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (seu *SavedEpisodeUpdate) SaveX(ctx context.Context) int {
+	affected, err := seu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (seu *SavedEpisodeUpdate) Exec(ctx context.Context) error {
+	_, err := seu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (seu *SavedEpisodeUpdate) ExecX(ctx context.Context) {
+	if err := seu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (seu *SavedEpisodeUpdate) check() error {
+	if v, ok := seu.mutation.ResumePositionMs(); ok {
+		if err := savedepisode.ResumePositionMsValidator(v); err != nil {
+			return &ValidationError{Name: "resume_position_ms", err: fmt.Errorf(`ent: validator failed for field "SavedEpisode.resume_position_ms": %w`, err)}
+		}
+	}
+	if seu.mutation.RunCleared() && len(seu.mutation.RunIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SavedEpisode.run"`)
+	}
+	if seu.mutation.EpisodeCleared() && len(seu.mutation.EpisodeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SavedEpisode.episode"`)
+	}
+	return nil
+}
+
+func (seu *SavedEpisodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := seu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(savedepisode.Table, savedepisode.Columns, sqlgraph.NewFieldSpec(savedepisode.FieldID, field.TypeString))
+	if ps := seu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := seu.mutation.AddedAt(); ok {
+		_spec.SetField(savedepisode.FieldAddedAt, field.TypeTime, value)
+	}
+	if value, ok := seu.mutation.FullyPlayed(); ok {
+		_spec.SetField(savedepisode.FieldFullyPlayed, field.TypeBool, value)
+	}
+	if value, ok := seu.mutation.ResumePositionMs(); ok {
+		_spec.SetField(savedepisode.FieldResumePositionMs, field.TypeInt, value)
+	}
+	if value, ok := seu.mutation.AddedResumePositionMs(); ok {
+		_spec.AddField(savedepisode.FieldResumePositionMs, field.TypeInt, value)
+	}
+	if seu.mutation.RunCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedepisode.RunTable,
+			Columns: []string{savedepisode.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := seu.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedepisode.RunTable,
+			Columns: []string{savedepisode.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if seu.mutation.EpisodeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   savedepisode.EpisodeTable,
+			Columns: []string{savedepisode.EpisodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := seu.mutation.EpisodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   savedepisode.EpisodeTable,
+			Columns: []string{savedepisode.EpisodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, seu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{savedepisode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	seu.mutation.done = true
+	return n, nil
+}
+
+// SavedEpisodeUpdateOne is the builder for updating a single SavedEpisode entity.
+type SavedEpisodeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SavedEpisodeMutation
+}
+
+// SetAddedAt sets the "added_at" field.
+func (seuo *SavedEpisodeUpdateOne) SetAddedAt(t time.Time) *SavedEpisodeUpdateOne {
+	seuo.mutation.SetAddedAt(t)
+	return seuo
+}
+
+// SetNillableAddedAt sets the "added_at" field if the given value is not nil.
+func (seuo *SavedEpisodeUpdateOne) SetNillableAddedAt(t *time.Time) *SavedEpisodeUpdateOne {
+	if t != nil {
+		seuo.SetAddedAt(*t)
+	}
+	return seuo
+}
+
+// SetFullyPlayed sets the "fully_played" field.
+func (seuo *SavedEpisodeUpdateOne) SetFullyPlayed(b bool) *SavedEpisodeUpdateOne {
+	seuo.mutation.SetFullyPlayed(b)
+	return seuo
+}
+
+// SetNillableFullyPlayed sets the "fully_played" field if the given value is not nil.
+func (seuo *SavedEpisodeUpdateOne) SetNillableFullyPlayed(b *bool) *SavedEpisodeUpdateOne {
+	if b != nil {
+		seuo.SetFullyPlayed(*b)
+	}
+	return seuo
+}
+
+// SetResumePositionMs sets the "resume_position_ms" field.
+func (seuo *SavedEpisodeUpdateOne) SetResumePositionMs(i int) *SavedEpisodeUpdateOne {
+	seuo.mutation.ResetResumePositionMs()
+	seuo.mutation.SetResumePositionMs(i)
+	return seuo
+}
+
+// SetNillableResumePositionMs sets the "resume_position_ms" field if the given value is not nil.
+func (seuo *SavedEpisodeUpdateOne) SetNillableResumePositionMs(i *int) *SavedEpisodeUpdateOne {
+	if i != nil {
+		seuo.SetResumePositionMs(*i)
+	}
+	return seuo
+}
+
+// AddResumePositionMs adds i to the "resume_position_ms" field.
+func (seuo *SavedEpisodeUpdateOne) AddResumePositionMs(i int) *SavedEpisodeUpdateOne {
+	seuo.mutation.AddResumePositionMs(i)
+	return seuo
+}
+
+// SetRunID sets the "run" edge to the BackupRun entity by ID.
+func (seuo *SavedEpisodeUpdateOne) SetRunID(id string) *SavedEpisodeUpdateOne {
+	seuo.mutation.SetRunID(id)
+	return seuo
+}
+
+// SetRun sets the "run" edge to the BackupRun entity.
+func (seuo *SavedEpisodeUpdateOne) SetRun(b *BackupRun) *SavedEpisodeUpdateOne {
+	return seuo.SetRunID(b.ID)
+}
+
+// SetEpisodeID sets the "episode" edge to the Episode entity by ID.
+func (seuo *SavedEpisodeUpdateOne) SetEpisodeID(id string) *SavedEpisodeUpdateOne {
+	seuo.mutation.SetEpisodeID(id)
+	return seuo
+}
+
+// SetEpisode sets the "episode" edge to the Episode entity.
+func (seuo *SavedEpisodeUpdateOne) SetEpisode(e *Episode) *SavedEpisodeUpdateOne {
+	return seuo.SetEpisodeID(e.ID)
+}
+
+// Mutation returns the SavedEpisodeMutation object of the builder.
+func (seuo *SavedEpisodeUpdateOne) Mutation() *SavedEpisodeMutation {
+	return seuo.mutation
+}
+
+// ClearRun clears the "run" edge to the BackupRun entity.
+func (seuo *SavedEpisodeUpdateOne) ClearRun() *SavedEpisodeUpdateOne {
+	seuo.mutation.ClearRun()
+	return seuo
+}
+
+// ClearEpisode clears the "episode" edge to the Episode entity.
+func (seuo *SavedEpisodeUpdateOne) ClearEpisode() *SavedEpisodeUpdateOne {
+	seuo.mutation.ClearEpisode()
+	return seuo
+}
+
+// Where appends a list predicates to the SavedEpisodeUpdate builder.
+func (seuo *SavedEpisodeUpdateOne) Where(ps ...predicate.SavedEpisode) *SavedEpisodeUpdateOne {
+	seuo.mutation.Where(ps...)
+	return seuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (seuo *SavedEpisodeUpdateOne) Select(field string, fields ...string) *SavedEpisodeUpdateOne {
+	seuo.fields = append([]string{field}, fields...)
+	return seuo
+}
+
+// Save executes the query and returns the updated SavedEpisode entity.
+func (seuo *SavedEpisodeUpdateOne) Save(ctx context.Context) (*SavedEpisode, error) {
+	return withHooks(ctx, seuo.sqlSave, seuo.mutation, seuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (seuo *SavedEpisodeUpdateOne) SaveX(ctx context.Context) *SavedEpisode {
+	node, err := seuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (seuo *SavedEpisodeUpdateOne) Exec(ctx context.Context) error {
+	_, err := seuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (seuo *SavedEpisodeUpdateOne) ExecX(ctx context.Context) {
+	if err := seuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (seuo *SavedEpisodeUpdateOne) check() error {
+	if v, ok := seuo.mutation.ResumePositionMs(); ok {
+		if err := savedepisode.ResumePositionMsValidator(v); err != nil {
+			return &ValidationError{Name: "resume_position_ms", err: fmt.Errorf(`ent: validator failed for field "SavedEpisode.resume_position_ms": %w`, err)}
+		}
+	}
+	if seuo.mutation.RunCleared() && len(seuo.mutation.RunIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SavedEpisode.run"`)
+	}
+	if seuo.mutation.EpisodeCleared() && len(seuo.mutation.EpisodeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SavedEpisode.episode"`)
+	}
+	return nil
+}
+
+func (seuo *SavedEpisodeUpdateOne) sqlSave(ctx context.Context) (_node *SavedEpisode, err error) {
+	if err := seuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(savedepisode.Table, savedepisode.Columns, sqlgraph.NewFieldSpec(savedepisode.FieldID, field.TypeString))
+	id, ok := seuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SavedEpisode.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := seuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, savedepisode.FieldID)
+		for _, f := range fields {
+			if !savedepisode.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != savedepisode.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := seuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := seuo.mutation.AddedAt(); ok {
+		_spec.SetField(savedepisode.FieldAddedAt, field.TypeTime, value)
+	}
+	if value, ok := seuo.mutation.FullyPlayed(); ok {
+		_spec.SetField(savedepisode.FieldFullyPlayed, field.TypeBool, value)
+	}
+	if value, ok := seuo.mutation.ResumePositionMs(); ok {
+		_spec.SetField(savedepisode.FieldResumePositionMs, field.TypeInt, value)
+	}
+	if value, ok := seuo.mutation.AddedResumePositionMs(); ok {
+		_spec.AddField(savedepisode.FieldResumePositionMs, field.TypeInt, value)
+	}
+	if seuo.mutation.RunCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedepisode.RunTable,
+			Columns: []string{savedepisode.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := seuo.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedepisode.RunTable,
+			Columns: []string{savedepisode.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if seuo.mutation.EpisodeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   savedepisode.EpisodeTable,
+			Columns: []string{savedepisode.EpisodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := seuo.mutation.EpisodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   savedepisode.EpisodeTable,
+			Columns: []string{savedepisode.EpisodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SavedEpisode{config: seuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, seuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{savedepisode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	seuo.mutation.done = true
+	return _node, nil
+}
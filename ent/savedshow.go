@@ -0,0 +1,196 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/savedshow"
+	"beyerleinf/spotify-backup/ent/show"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// SavedShow is the model entity for the SavedShow schema.
+type SavedShow struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// AddedAt holds the value of the "added_at" field.
+	AddedAt time.Time `json:"added_at,omitempty"`
+	// TotalEpisodes holds the value of the "total_episodes" field.
+	TotalEpisodes int `json:"total_episodes,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SavedShowQuery when eager-loading is set.
+	Edges                  SavedShowEdges `json:"edges"`
+	backup_run_saved_shows *string
+	saved_show_show        *string
+	selectValues           sql.SelectValues
+}
+
+// SavedShowEdges holds the relations/edges for other nodes in the graph.
+type SavedShowEdges struct {
+	// Run holds the value of the run edge.
+	Run *BackupRun `json:"run,omitempty"`
+	// Show holds the value of the show edge.
+	Show *Show `json:"show,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// RunOrErr returns the Run value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SavedShowEdges) RunOrErr() (*BackupRun, error) {
+	if e.Run != nil {
+		return e.Run, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: backuprun.Label}
+	}
+	return nil, &NotLoadedError{edge: "run"}
+}
+
+// ShowOrErr returns the Show value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SavedShowEdges) ShowOrErr() (*Show, error) {
+	if e.Show != nil {
+		return e.Show, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: show.Label}
+	}
+	return nil, &NotLoadedError{edge: "show"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SavedShow) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case savedshow.FieldTotalEpisodes:
+			values[i] = new(sql.NullInt64)
+		case savedshow.FieldID:
+			values[i] = new(sql.NullString)
+		case savedshow.FieldAddedAt, savedshow.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case savedshow.ForeignKeys[0]: // backup_run_saved_shows
+			values[i] = new(sql.NullString)
+		case savedshow.ForeignKeys[1]: // saved_show_show
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SavedShow fields.
+func (ss *SavedShow) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case savedshow.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				ss.ID = value.String
+			}
+		case savedshow.FieldAddedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field added_at", values[i])
+			} else if value.Valid {
+				ss.AddedAt = value.Time
+			}
+		case savedshow.FieldTotalEpisodes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_episodes", values[i])
+			} else if value.Valid {
+				ss.TotalEpisodes = int(value.Int64)
+			}
+		case savedshow.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ss.CreatedAt = value.Time
+			}
+		case savedshow.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field backup_run_saved_shows", values[i])
+			} else if value.Valid {
+				ss.backup_run_saved_shows = new(string)
+				*ss.backup_run_saved_shows = value.String
+			}
+		case savedshow.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field saved_show_show", values[i])
+			} else if value.Valid {
+				ss.saved_show_show = new(string)
+				*ss.saved_show_show = value.String
+			}
+		default:
+			ss.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SavedShow.
+// This includes values selected through modifiers, order, etc.
+func (ss *SavedShow) Value(name string) (ent.Value, error) {
+	return ss.selectValues.Get(name)
+}
+
+// QueryRun queries the "run" edge of the SavedShow entity.
+func (ss *SavedShow) QueryRun() *BackupRunQuery {
+	return NewSavedShowClient(ss.config).QueryRun(ss)
+}
+
+// QueryShow queries the "show" edge of the SavedShow entity.
+func (ss *SavedShow) QueryShow() *ShowQuery {
+	return NewSavedShowClient(ss.config).QueryShow(ss)
+}
+
+// Update returns a builder for updating this SavedShow.
+// Note that you need to call SavedShow.Unwrap() before calling this method if this SavedShow
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ss *SavedShow) Update() *SavedShowUpdateOne {
+	return NewSavedShowClient(ss.config).UpdateOne(ss)
+}
+
+// Unwrap unwraps the SavedShow entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ss *SavedShow) Unwrap() *SavedShow {
+	_tx, ok := ss.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SavedShow is not a transactional entity")
+	}
+	ss.config.driver = _tx.drv
+	return ss
+}
+
+// String implements the fmt.Stringer.
+func (ss *SavedShow) String() string {
+	var builder strings.Builder
+	builder.WriteString("SavedShow(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ss.ID))
+	builder.WriteString("added_at=")
+	builder.WriteString(ss.AddedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("total_episodes=")
+	builder.WriteString(fmt.Sprintf("%v", ss.TotalEpisodes))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ss.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SavedShows is a parsable slice of SavedShow.
+type SavedShows []*SavedShow
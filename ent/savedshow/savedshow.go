@@ -0,0 +1,133 @@
+// Code generated by ent, DO NOT EDIT.
+
+package savedshow
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the savedshow type in the database.
+	Label = "saved_show"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldAddedAt holds the string denoting the added_at field in the database.
+	FieldAddedAt = "added_at"
+	// FieldTotalEpisodes holds the string denoting the total_episodes field in the database.
+	FieldTotalEpisodes = "total_episodes"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeRun holds the string denoting the run edge name in mutations.
+	EdgeRun = "run"
+	// EdgeShow holds the string denoting the show edge name in mutations.
+	EdgeShow = "show"
+	// Table holds the table name of the savedshow in the database.
+	Table = "saved_shows"
+	// RunTable is the table that holds the run relation/edge.
+	RunTable = "saved_shows"
+	// RunInverseTable is the table name for the BackupRun entity.
+	// It exists in this package in order to avoid circular dependency with the "backuprun" package.
+	RunInverseTable = "backup_runs"
+	// RunColumn is the table column denoting the run relation/edge.
+	RunColumn = "backup_run_saved_shows"
+	// ShowTable is the table that holds the show relation/edge.
+	ShowTable = "saved_shows"
+	// ShowInverseTable is the table name for the Show entity.
+	// It exists in this package in order to avoid circular dependency with the "show" package.
+	ShowInverseTable = "shows"
+	// ShowColumn is the table column denoting the show relation/edge.
+	ShowColumn = "saved_show_show"
+)
+
+// Columns holds all SQL columns for savedshow fields.
+var Columns = []string{
+	FieldID,
+	FieldAddedAt,
+	FieldTotalEpisodes,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "saved_shows"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"backup_run_saved_shows",
+	"saved_show_show",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// TotalEpisodesValidator is a validator for the "total_episodes" field. It is called by the builders before save.
+	TotalEpisodesValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() string
+)
+
+// OrderOption defines the ordering options for the SavedShow queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByAddedAt orders the results by the added_at field.
+func ByAddedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAddedAt, opts...).ToFunc()
+}
+
+// ByTotalEpisodes orders the results by the total_episodes field.
+func ByTotalEpisodes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalEpisodes, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByRunField orders the results by run field.
+func ByRunField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRunStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByShowField orders the results by show field.
+func ByShowField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newShowStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newRunStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RunInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, RunTable, RunColumn),
+	)
+}
+func newShowStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ShowInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, ShowTable, ShowColumn),
+	)
+}
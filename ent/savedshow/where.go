@@ -0,0 +1,262 @@
+// Code generated by ent, DO NOT EDIT.
+
+package savedshow
+
+import (
+	"beyerleinf/spotify-backup/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.SavedShow {
+	return predicate.SavedShow(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.SavedShow {
+	return predicate.SavedShow(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.SavedShow {
+	return predicate.SavedShow(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.SavedShow {
+	return predicate.SavedShow(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.SavedShow {
+	return predicate.SavedShow(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.SavedShow {
+	return predicate.SavedShow(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.SavedShow {
+	return predicate.SavedShow(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.SavedShow {
+	return predicate.SavedShow(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.SavedShow {
+	return predicate.SavedShow(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.SavedShow {
+	return predicate.SavedShow(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.SavedShow {
+	return predicate.SavedShow(sql.FieldContainsFold(FieldID, id))
+}
+
+// AddedAt applies equality check predicate on the "added_at" field. It's identical to AddedAtEQ.
+func AddedAt(v time.Time) predicate.SavedShow {
+	return predicate.SavedShow(sql.FieldEQ(FieldAddedAt, v))
+}
+
+// TotalEpisodes applies equality check predicate on the "total_episodes" field. It's identical to TotalEpisodesEQ.
+func TotalEpisodes(v int) predicate.SavedShow {
+	return predicate.SavedShow(sql.FieldEQ(FieldTotalEpisodes, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SavedShow {
+	return predicate.SavedShow(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// AddedAtEQ applies the EQ predicate on the "added_at" field.
+func AddedAtEQ(v time.Time) predicate.SavedShow {
+	return predicate.SavedShow(sql.FieldEQ(FieldAddedAt, v))
+}
+
+// AddedAtNEQ applies the NEQ predicate on the "added_at" field.
+func AddedAtNEQ(v time.Time) predicate.SavedShow {
+	return predicate.SavedShow(sql.FieldNEQ(FieldAddedAt, v))
+}
+
+// AddedAtIn applies the In predicate on the "added_at" field.
+func AddedAtIn(vs ...time.Time) predicate.SavedShow {
+	return predicate.SavedShow(sql.FieldIn(FieldAddedAt, vs...))
+}
+
+// AddedAtNotIn applies the NotIn predicate on the "added_at" field.
+func AddedAtNotIn(vs ...time.Time) predicate.SavedShow {
+	return predicate.SavedShow(sql.FieldNotIn(FieldAddedAt, vs...))
+}
+
+// AddedAtGT applies the GT predicate on the "added_at" field.
+func AddedAtGT(v time.Time) predicate.SavedShow {
+	return predicate.SavedShow(sql.FieldGT(FieldAddedAt, v))
+}
+
+// AddedAtGTE applies the GTE predicate on the "added_at" field.
+func AddedAtGTE(v time.Time) predicate.SavedShow {
+	return predicate.SavedShow(sql.FieldGTE(FieldAddedAt, v))
+}
+
+// AddedAtLT applies the LT predicate on the "added_at" field.
+func AddedAtLT(v time.Time) predicate.SavedShow {
+	return predicate.SavedShow(sql.FieldLT(FieldAddedAt, v))
+}
+
+// AddedAtLTE applies the LTE predicate on the "added_at" field.
+func AddedAtLTE(v time.Time) predicate.SavedShow {
+	return predicate.SavedShow(sql.FieldLTE(FieldAddedAt, v))
+}
+
+// TotalEpisodesEQ applies the EQ predicate on the "total_episodes" field.
+func TotalEpisodesEQ(v int) predicate.SavedShow {
+	return predicate.SavedShow(sql.FieldEQ(FieldTotalEpisodes, v))
+}
+
+// TotalEpisodesNEQ applies the NEQ predicate on the "total_episodes" field.
+func TotalEpisodesNEQ(v int) predicate.SavedShow {
+	return predicate.SavedShow(sql.FieldNEQ(FieldTotalEpisodes, v))
+}
+
+// TotalEpisodesIn applies the In predicate on the "total_episodes" field.
+func TotalEpisodesIn(vs ...int) predicate.SavedShow {
+	return predicate.SavedShow(sql.FieldIn(FieldTotalEpisodes, vs...))
+}
+
+// TotalEpisodesNotIn applies the NotIn predicate on the "total_episodes" field.
+func TotalEpisodesNotIn(vs ...int) predicate.SavedShow {
+	return predicate.SavedShow(sql.FieldNotIn(FieldTotalEpisodes, vs...))
+}
+
+// TotalEpisodesGT applies the GT predicate on the "total_episodes" field.
+func TotalEpisodesGT(v int) predicate.SavedShow {
+	return predicate.SavedShow(sql.FieldGT(FieldTotalEpisodes, v))
+}
+
+// TotalEpisodesGTE applies the GTE predicate on the "total_episodes" field.
+func TotalEpisodesGTE(v int) predicate.SavedShow {
+	return predicate.SavedShow(sql.FieldGTE(FieldTotalEpisodes, v))
+}
+
+// TotalEpisodesLT applies the LT predicate on the "total_episodes" field.
+func TotalEpisodesLT(v int) predicate.SavedShow {
+	return predicate.SavedShow(sql.FieldLT(FieldTotalEpisodes, v))
+}
+
+// TotalEpisodesLTE applies the LTE predicate on the "total_episodes" field.
+func TotalEpisodesLTE(v int) predicate.SavedShow {
+	return predicate.SavedShow(sql.FieldLTE(FieldTotalEpisodes, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SavedShow {
+	return predicate.SavedShow(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SavedShow {
+	return predicate.SavedShow(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SavedShow {
+	return predicate.SavedShow(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SavedShow {
+	return predicate.SavedShow(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SavedShow {
+	return predicate.SavedShow(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SavedShow {
+	return predicate.SavedShow(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SavedShow {
+	return predicate.SavedShow(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SavedShow {
+	return predicate.SavedShow(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasRun applies the HasEdge predicate on the "run" edge.
+func HasRun() predicate.SavedShow {
+	return predicate.SavedShow(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RunTable, RunColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRunWith applies the HasEdge predicate on the "run" edge with a given conditions (other predicates).
+func HasRunWith(preds ...predicate.BackupRun) predicate.SavedShow {
+	return predicate.SavedShow(func(s *sql.Selector) {
+		step := newRunStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasShow applies the HasEdge predicate on the "show" edge.
+func HasShow() predicate.SavedShow {
+	return predicate.SavedShow(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ShowTable, ShowColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasShowWith applies the HasEdge predicate on the "show" edge with a given conditions (other predicates).
+func HasShowWith(preds ...predicate.Show) predicate.SavedShow {
+	return predicate.SavedShow(func(s *sql.Selector) {
+		step := newShowStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SavedShow) predicate.SavedShow {
+	return predicate.SavedShow(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SavedShow) predicate.SavedShow {
+	return predicate.SavedShow(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SavedShow) predicate.SavedShow {
+	return predicate.SavedShow(sql.NotPredicates(p))
+}
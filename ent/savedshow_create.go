@@ -0,0 +1,320 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/savedshow"
+	"beyerleinf/spotify-backup/ent/show"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SavedShowCreate is the builder for creating a SavedShow entity.
+type SavedShowCreate struct {
+	config
+	mutation *SavedShowMutation
+	hooks    []Hook
+}
+
+// SetAddedAt sets the "added_at" field.
+func (ssc *SavedShowCreate) SetAddedAt(t time.Time) *SavedShowCreate {
+	ssc.mutation.SetAddedAt(t)
+	return ssc
+}
+
+// SetTotalEpisodes sets the "total_episodes" field.
+func (ssc *SavedShowCreate) SetTotalEpisodes(i int) *SavedShowCreate {
+	ssc.mutation.SetTotalEpisodes(i)
+	return ssc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ssc *SavedShowCreate) SetCreatedAt(t time.Time) *SavedShowCreate {
+	ssc.mutation.SetCreatedAt(t)
+	return ssc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ssc *SavedShowCreate) SetNillableCreatedAt(t *time.Time) *SavedShowCreate {
+	if t != nil {
+		ssc.SetCreatedAt(*t)
+	}
+	return ssc
+}
+
+// SetID sets the "id" field.
+func (ssc *SavedShowCreate) SetID(s string) *SavedShowCreate {
+	ssc.mutation.SetID(s)
+	return ssc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ssc *SavedShowCreate) SetNillableID(s *string) *SavedShowCreate {
+	if s != nil {
+		ssc.SetID(*s)
+	}
+	return ssc
+}
+
+// SetRunID sets the "run" edge to the BackupRun entity by ID.
+func (ssc *SavedShowCreate) SetRunID(id string) *SavedShowCreate {
+	ssc.mutation.SetRunID(id)
+	return ssc
+}
+
+// SetRun sets the "run" edge to the BackupRun entity.
+func (ssc *SavedShowCreate) SetRun(b *BackupRun) *SavedShowCreate {
+	return ssc.SetRunID(b.ID)
+}
+
+// SetShowID sets the "show" edge to the Show entity by ID.
+func (ssc *SavedShowCreate) SetShowID(id string) *SavedShowCreate {
+	ssc.mutation.SetShowID(id)
+	return ssc
+}
+
+// SetShow sets the "show" edge to the Show entity.
+func (ssc *SavedShowCreate) SetShow(s *Show) *SavedShowCreate {
+	return ssc.SetShowID(s.ID)
+}
+
+// Mutation returns the SavedShowMutation object of the builder.
+func (ssc *SavedShowCreate) Mutation() *SavedShowMutation {
+	return ssc.mutation
+}
+
+// Save creates the SavedShow in the database.
+func (ssc *SavedShowCreate) Save(ctx context.Context) (*SavedShow, error) {
+	ssc.defaults()
+	return withHooks(ctx, ssc.sqlSave, ssc.mutation, ssc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ssc *SavedShowCreate) SaveX(ctx context.Context) *SavedShow {
+	v, err := ssc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ssc *SavedShowCreate) Exec(ctx context.Context) error {
+	_, err := ssc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssc *SavedShowCreate) ExecX(ctx context.Context) {
+	if err := ssc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ssc *SavedShowCreate) defaults() {
+	if _, ok := ssc.mutation.CreatedAt(); !ok {
+		v := savedshow.DefaultCreatedAt()
+		ssc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ssc.mutation.ID(); !ok {
+		v := savedshow.DefaultID()
+		ssc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ssc *SavedShowCreate) check() error {
+	if _, ok := ssc.mutation.AddedAt(); !ok {
+		return &ValidationError{Name: "added_at", err: errors.New(`ent: missing required field "SavedShow.added_at"`)}
+	}
+	if _, ok := ssc.mutation.TotalEpisodes(); !ok {
+		return &ValidationError{Name: "total_episodes", err: errors.New(`ent: missing required field "SavedShow.total_episodes"`)}
+	}
+	if v, ok := ssc.mutation.TotalEpisodes(); ok {
+		if err := savedshow.TotalEpisodesValidator(v); err != nil {
+			return &ValidationError{Name: "total_episodes", err: fmt.Errorf(`ent: validator failed for field "SavedShow.total_episodes": %w`, err)}
+		}
+	}
+	if _, ok := ssc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SavedShow.created_at"`)}
+	}
+	if len(ssc.mutation.RunIDs()) == 0 {
+		return &ValidationError{Name: "run", err: errors.New(`ent: missing required edge "SavedShow.run"`)}
+	}
+	if len(ssc.mutation.ShowIDs()) == 0 {
+		return &ValidationError{Name: "show", err: errors.New(`ent: missing required edge "SavedShow.show"`)}
+	}
+	return nil
+}
+
+func (ssc *SavedShowCreate) sqlSave(ctx context.Context) (*SavedShow, error) {
+	if err := ssc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ssc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ssc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected SavedShow.ID type: %T", _spec.ID.Value)
+		}
+	}
+	ssc.mutation.id = &_node.ID
+	ssc.mutation.done = true
+	return _node, nil
+}
+
+func (ssc *SavedShowCreate) createSpec() (*SavedShow, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SavedShow{config: ssc.config}
+		_spec = sqlgraph.NewCreateSpec(savedshow.Table, sqlgraph.NewFieldSpec(savedshow.FieldID, field.TypeString))
+	)
+	if id, ok := ssc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := ssc.mutation.AddedAt(); ok {
+		_spec.SetField(savedshow.FieldAddedAt, field.TypeTime, value)
+		_node.AddedAt = value
+	}
+	if value, ok := ssc.mutation.TotalEpisodes(); ok {
+		_spec.SetField(savedshow.FieldTotalEpisodes, field.TypeInt, value)
+		_node.TotalEpisodes = value
+	}
+	if value, ok := ssc.mutation.CreatedAt(); ok {
+		_spec.SetField(savedshow.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := ssc.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedshow.RunTable,
+			Columns: []string{savedshow.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.backup_run_saved_shows = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ssc.mutation.ShowIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   savedshow.ShowTable,
+			Columns: []string{savedshow.ShowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(show.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.saved_show_show = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SavedShowCreateBulk is the builder for creating many SavedShow entities in bulk.
+type SavedShowCreateBulk struct {
+	config
+	err      error
+	builders []*SavedShowCreate
+}
+
+// Save creates the SavedShow entities in the database.
+func (sscb *SavedShowCreateBulk) Save(ctx context.Context) ([]*SavedShow, error) {
+	if sscb.err != nil {
+		return nil, sscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sscb.builders))
+	nodes := make([]*SavedShow, len(sscb.builders))
+	mutators := make([]Mutator, len(sscb.builders))
+	for i := range sscb.builders {
+		func(i int, root context.Context) {
+			builder := sscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SavedShowMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sscb *SavedShowCreateBulk) SaveX(ctx context.Context) []*SavedShow {
+	v, err := sscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sscb *SavedShowCreateBulk) Exec(ctx context.Context) error {
+	_, err := sscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sscb *SavedShowCreateBulk) ExecX(ctx context.Context) {
+	if err := sscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,726 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/predicate"
+	"beyerleinf/spotify-backup/ent/savedshow"
+	"beyerleinf/spotify-backup/ent/show"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SavedShowQuery is the builder for querying SavedShow entities.
+type SavedShowQuery struct {
+	config
+	ctx        *QueryContext
+	order      []savedshow.OrderOption
+	inters     []Interceptor
+	predicates []predicate.SavedShow
+	withRun    *BackupRunQuery
+	withShow   *ShowQuery
+	withFKs    bool
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SavedShowQuery builder.
+func (ssq *SavedShowQuery) Where(ps ...predicate.SavedShow) *SavedShowQuery {
+	ssq.predicates = append(ssq.predicates, ps...)
+	return ssq
+}
+
+// Limit the number of records to be returned by this query.
+func (ssq *SavedShowQuery) Limit(limit int) *SavedShowQuery {
+	ssq.ctx.Limit = &limit
+	return ssq
+}
+
+// Offset to start from.
+func (ssq *SavedShowQuery) Offset(offset int) *SavedShowQuery {
+	ssq.ctx.Offset = &offset
+	return ssq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ssq *SavedShowQuery) Unique(unique bool) *SavedShowQuery {
+	ssq.ctx.Unique = &unique
+	return ssq
+}
+
+// Order specifies how the records should be ordered.
+func (ssq *SavedShowQuery) Order(o ...savedshow.OrderOption) *SavedShowQuery {
+	ssq.order = append(ssq.order, o...)
+	return ssq
+}
+
+// QueryRun chains the current query on the "run" edge.
+func (ssq *SavedShowQuery) QueryRun() *BackupRunQuery {
+	query := (&BackupRunClient{config: ssq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ssq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ssq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(savedshow.Table, savedshow.FieldID, selector),
+			sqlgraph.To(backuprun.Table, backuprun.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, savedshow.RunTable, savedshow.RunColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ssq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryShow chains the current query on the "show" edge.
+func (ssq *SavedShowQuery) QueryShow() *ShowQuery {
+	query := (&ShowClient{config: ssq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ssq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ssq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(savedshow.Table, savedshow.FieldID, selector),
+			sqlgraph.To(show.Table, show.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, savedshow.ShowTable, savedshow.ShowColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ssq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SavedShow entity from the query.
+// Returns a *NotFoundError when no SavedShow was found.
+func (ssq *SavedShowQuery) First(ctx context.Context) (*SavedShow, error) {
+	nodes, err := ssq.Limit(1).All(setContextOp(ctx, ssq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{savedshow.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ssq *SavedShowQuery) FirstX(ctx context.Context) *SavedShow {
+	node, err := ssq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SavedShow ID from the query.
+// Returns a *NotFoundError when no SavedShow ID was found.
+func (ssq *SavedShowQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = ssq.Limit(1).IDs(setContextOp(ctx, ssq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{savedshow.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ssq *SavedShowQuery) FirstIDX(ctx context.Context) string {
+	id, err := ssq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SavedShow entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SavedShow entity is found.
+// Returns a *NotFoundError when no SavedShow entities are found.
+func (ssq *SavedShowQuery) Only(ctx context.Context) (*SavedShow, error) {
+	nodes, err := ssq.Limit(2).All(setContextOp(ctx, ssq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{savedshow.Label}
+	default:
+		return nil, &NotSingularError{savedshow.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ssq *SavedShowQuery) OnlyX(ctx context.Context) *SavedShow {
+	node, err := ssq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SavedShow ID in the query.
+// Returns a *NotSingularError when more than one SavedShow ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ssq *SavedShowQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = ssq.Limit(2).IDs(setContextOp(ctx, ssq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{savedshow.Label}
+	default:
+		err = &NotSingularError{savedshow.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ssq *SavedShowQuery) OnlyIDX(ctx context.Context) string {
+	id, err := ssq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SavedShows.
+func (ssq *SavedShowQuery) All(ctx context.Context) ([]*SavedShow, error) {
+	ctx = setContextOp(ctx, ssq.ctx, ent.OpQueryAll)
+	if err := ssq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SavedShow, *SavedShowQuery]()
+	return withInterceptors[[]*SavedShow](ctx, ssq, qr, ssq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ssq *SavedShowQuery) AllX(ctx context.Context) []*SavedShow {
+	nodes, err := ssq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SavedShow IDs.
+func (ssq *SavedShowQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if ssq.ctx.Unique == nil && ssq.path != nil {
+		ssq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ssq.ctx, ent.OpQueryIDs)
+	if err = ssq.Select(savedshow.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ssq *SavedShowQuery) IDsX(ctx context.Context) []string {
+	ids, err := ssq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ssq *SavedShowQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ssq.ctx, ent.OpQueryCount)
+	if err := ssq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ssq, querierCount[*SavedShowQuery](), ssq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ssq *SavedShowQuery) CountX(ctx context.Context) int {
+	count, err := ssq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ssq *SavedShowQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ssq.ctx, ent.OpQueryExist)
+	switch _, err := ssq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ssq *SavedShowQuery) ExistX(ctx context.Context) bool {
+	exist, err := ssq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SavedShowQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ssq *SavedShowQuery) Clone() *SavedShowQuery {
+	if ssq == nil {
+		return nil
+	}
+	return &SavedShowQuery{
+		config:     ssq.config,
+		ctx:        ssq.ctx.Clone(),
+		order:      append([]savedshow.OrderOption{}, ssq.order...),
+		inters:     append([]Interceptor{}, ssq.inters...),
+		predicates: append([]predicate.SavedShow{}, ssq.predicates...),
+		withRun:    ssq.withRun.Clone(),
+		withShow:   ssq.withShow.Clone(),
+		// clone intermediate query.
+		sql:  ssq.sql.Clone(),
+		path: ssq.path,
+	}
+}
+
+// WithRun tells the query-builder to eager-load the nodes that are connected to
+// the "run" edge. The optional arguments are used to configure the query builder of the edge.
+func (ssq *SavedShowQuery) WithRun(opts ...func(*BackupRunQuery)) *SavedShowQuery {
+	query := (&BackupRunClient{config: ssq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ssq.withRun = query
+	return ssq
+}
+
+// WithShow tells the query-builder to eager-load the nodes that are connected to
+// the "show" edge. The optional arguments are used to configure the query builder of the edge.
+func (ssq *SavedShowQuery) WithShow(opts ...func(*ShowQuery)) *SavedShowQuery {
+	query := (&ShowClient{config: ssq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ssq.withShow = query
+	return ssq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		AddedAt time.Time `json:"added_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SavedShow.Query().
+//		GroupBy(savedshow.FieldAddedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ssq *SavedShowQuery) GroupBy(field string, fields ...string) *SavedShowGroupBy {
+	ssq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SavedShowGroupBy{build: ssq}
+	grbuild.flds = &ssq.ctx.Fields
+	grbuild.label = savedshow.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		AddedAt time.Time `json:"added_at,omitempty"`
+//	}
+//
+//	client.SavedShow.Query().
+//		Select(savedshow.FieldAddedAt).
+//		Scan(ctx, &v)
+func (ssq *SavedShowQuery) Select(fields ...string) *SavedShowSelect {
+	ssq.ctx.Fields = append(ssq.ctx.Fields, fields...)
+	sbuild := &SavedShowSelect{SavedShowQuery: ssq}
+	sbuild.label = savedshow.Label
+	sbuild.flds, sbuild.scan = &ssq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SavedShowSelect configured with the given aggregations.
+func (ssq *SavedShowQuery) Aggregate(fns ...AggregateFunc) *SavedShowSelect {
+	return ssq.Select().Aggregate(fns...)
+}
+
+func (ssq *SavedShowQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ssq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ssq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ssq.ctx.Fields {
+		if !savedshow.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ssq.path != nil {
+		prev, err := ssq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ssq.sql = prev
+	}
+	return nil
+}
+
+func (ssq *SavedShowQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SavedShow, error) {
+	var (
+		nodes       = []*SavedShow{}
+		withFKs     = ssq.withFKs
+		_spec       = ssq.querySpec()
+		loadedTypes = [2]bool{
+			ssq.withRun != nil,
+			ssq.withShow != nil,
+		}
+	)
+	if ssq.withRun != nil || ssq.withShow != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, savedshow.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SavedShow).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SavedShow{config: ssq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(ssq.modifiers) > 0 {
+		_spec.Modifiers = ssq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ssq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := ssq.withRun; query != nil {
+		if err := ssq.loadRun(ctx, query, nodes, nil,
+			func(n *SavedShow, e *BackupRun) { n.Edges.Run = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := ssq.withShow; query != nil {
+		if err := ssq.loadShow(ctx, query, nodes, nil,
+			func(n *SavedShow, e *Show) { n.Edges.Show = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (ssq *SavedShowQuery) loadRun(ctx context.Context, query *BackupRunQuery, nodes []*SavedShow, init func(*SavedShow), assign func(*SavedShow, *BackupRun)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*SavedShow)
+	for i := range nodes {
+		if nodes[i].backup_run_saved_shows == nil {
+			continue
+		}
+		fk := *nodes[i].backup_run_saved_shows
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(backuprun.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "backup_run_saved_shows" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (ssq *SavedShowQuery) loadShow(ctx context.Context, query *ShowQuery, nodes []*SavedShow, init func(*SavedShow), assign func(*SavedShow, *Show)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*SavedShow)
+	for i := range nodes {
+		if nodes[i].saved_show_show == nil {
+			continue
+		}
+		fk := *nodes[i].saved_show_show
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(show.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "saved_show_show" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (ssq *SavedShowQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ssq.querySpec()
+	if len(ssq.modifiers) > 0 {
+		_spec.Modifiers = ssq.modifiers
+	}
+	_spec.Node.Columns = ssq.ctx.Fields
+	if len(ssq.ctx.Fields) > 0 {
+		_spec.Unique = ssq.ctx.Unique != nil && *ssq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ssq.driver, _spec)
+}
+
+func (ssq *SavedShowQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(savedshow.Table, savedshow.Columns, sqlgraph.NewFieldSpec(savedshow.FieldID, field.TypeString))
+	_spec.From = ssq.sql
+	if unique := ssq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ssq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ssq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, savedshow.FieldID)
+		for i := range fields {
+			if fields[i] != savedshow.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ssq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ssq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ssq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ssq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ssq *SavedShowQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ssq.driver.Dialect())
+	t1 := builder.Table(savedshow.Table)
+	columns := ssq.ctx.Fields
+	if len(columns) == 0 {
+		columns = savedshow.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ssq.sql != nil {
+		selector = ssq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ssq.ctx.Unique != nil && *ssq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range ssq.modifiers {
+		m(selector)
+	}
+	for _, p := range ssq.predicates {
+		p(selector)
+	}
+	for _, p := range ssq.order {
+		p(selector)
+	}
+	if offset := ssq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ssq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (ssq *SavedShowQuery) ForUpdate(opts ...sql.LockOption) *SavedShowQuery {
+	if ssq.driver.Dialect() == dialect.Postgres {
+		ssq.Unique(false)
+	}
+	ssq.modifiers = append(ssq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return ssq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (ssq *SavedShowQuery) ForShare(opts ...sql.LockOption) *SavedShowQuery {
+	if ssq.driver.Dialect() == dialect.Postgres {
+		ssq.Unique(false)
+	}
+	ssq.modifiers = append(ssq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return ssq
+}
+
+// SavedShowGroupBy is the group-by builder for SavedShow entities.
+type SavedShowGroupBy struct {
+	selector
+	build *SavedShowQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ssgb *SavedShowGroupBy) Aggregate(fns ...AggregateFunc) *SavedShowGroupBy {
+	ssgb.fns = append(ssgb.fns, fns...)
+	return ssgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ssgb *SavedShowGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ssgb.build.ctx, ent.OpQueryGroupBy)
+	if err := ssgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SavedShowQuery, *SavedShowGroupBy](ctx, ssgb.build, ssgb, ssgb.build.inters, v)
+}
+
+func (ssgb *SavedShowGroupBy) sqlScan(ctx context.Context, root *SavedShowQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ssgb.fns))
+	for _, fn := range ssgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ssgb.flds)+len(ssgb.fns))
+		for _, f := range *ssgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ssgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ssgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SavedShowSelect is the builder for selecting fields of SavedShow entities.
+type SavedShowSelect struct {
+	*SavedShowQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sss *SavedShowSelect) Aggregate(fns ...AggregateFunc) *SavedShowSelect {
+	sss.fns = append(sss.fns, fns...)
+	return sss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sss *SavedShowSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sss.ctx, ent.OpQuerySelect)
+	if err := sss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SavedShowQuery, *SavedShowSelect](ctx, sss.SavedShowQuery, sss, sss.inters, v)
+}
+
+func (sss *SavedShowSelect) sqlScan(ctx context.Context, root *SavedShowQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sss.fns))
+	for _, fn := range sss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,488 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/predicate"
+	"beyerleinf/spotify-backup/ent/savedshow"
+	"beyerleinf/spotify-backup/ent/show"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SavedShowUpdate is the builder for updating SavedShow entities.
+type SavedShowUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SavedShowMutation
+}
+
+// Where appends a list predicates to the SavedShowUpdate builder.
+func (ssu *SavedShowUpdate) Where(ps ...predicate.SavedShow) *SavedShowUpdate {
+	ssu.mutation.Where(ps...)
+	return ssu
+}
+
+// SetAddedAt sets the "added_at" field.
+func (ssu *SavedShowUpdate) SetAddedAt(t time.Time) *SavedShowUpdate {
+	ssu.mutation.SetAddedAt(t)
+	return ssu
+}
+
+// SetNillableAddedAt sets the "added_at" field if the given value is not nil.
+func (ssu *SavedShowUpdate) SetNillableAddedAt(t *time.Time) *SavedShowUpdate {
+	if t != nil {
+		ssu.SetAddedAt(*t)
+	}
+	return ssu
+}
+
+// SetTotalEpisodes sets the "total_episodes" field.
+func (ssu *SavedShowUpdate) SetTotalEpisodes(i int) *SavedShowUpdate {
+	ssu.mutation.ResetTotalEpisodes()
+	ssu.mutation.SetTotalEpisodes(i)
+	return ssu
+}
+
+// SetNillableTotalEpisodes sets the "total_episodes" field if the given value is not nil.
+func (ssu *SavedShowUpdate) SetNillableTotalEpisodes(i *int) *SavedShowUpdate {
+	if i != nil {
+		ssu.SetTotalEpisodes(*i)
+	}
+	return ssu
+}
+
+// AddTotalEpisodes adds i to the "total_episodes" field.
+func (ssu *SavedShowUpdate) AddTotalEpisodes(i int) *SavedShowUpdate {
+	ssu.mutation.AddTotalEpisodes(i)
+	return ssu
+}
+
+// SetRunID sets the "run" edge to the BackupRun entity by ID.
+func (ssu *SavedShowUpdate) SetRunID(id string) *SavedShowUpdate {
+	ssu.mutation.SetRunID(id)
+	return ssu
+}
+
+// SetRun sets the "run" edge to the BackupRun entity.
+func (ssu *SavedShowUpdate) SetRun(b *BackupRun) *SavedShowUpdate {
+	return ssu.SetRunID(b.ID)
+}
+
+// SetShowID sets the "show" edge to the Show entity by ID.
+func (ssu *SavedShowUpdate) SetShowID(id string) *SavedShowUpdate {
+	ssu.mutation.SetShowID(id)
+	return ssu
+}
+
+// SetShow sets the "show" edge to the Show entity.
+func (ssu *SavedShowUpdate) SetShow(s *Show) *SavedShowUpdate {
+	return ssu.SetShowID(s.ID)
+}
+
+// Mutation returns the SavedShowMutation object of the builder.
+func (ssu *SavedShowUpdate) Mutation() *SavedShowMutation {
+	return ssu.mutation
+}
+
+// ClearRun clears the "run" edge to the BackupRun entity.
+func (ssu *SavedShowUpdate) ClearRun() *SavedShowUpdate {
+	ssu.mutation.ClearRun()
+	return ssu
+}
+
+// ClearShow clears the "show" edge to the Show entity.
+func (ssu *SavedShowUpdate) ClearShow() *SavedShowUpdate {
+	ssu.mutation.ClearShow()
+	return ssu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ssu *SavedShowUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ssu.sqlSave, ssu.mutation, ssu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ssu *SavedShowUpdate) SaveX(ctx context.Context) int {
+	affected, err := ssu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ssu *SavedShowUpdate) Exec(ctx context.Context) error {
+	_, err := ssu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssu *SavedShowUpdate) ExecX(ctx context.Context) {
+	if err := ssu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ssu *SavedShowUpdate) check() error {
+	if v, ok := ssu.mutation.TotalEpisodes(); ok {
+		if err := savedshow.TotalEpisodesValidator(v); err != nil {
+			return &ValidationError{Name: "total_episodes", err: fmt.Errorf(`ent: validator failed for field "SavedShow.total_episodes": %w`, err)}
+		}
+	}
+	if ssu.mutation.RunCleared() && len(ssu.mutation.RunIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SavedShow.run"`)
+	}
+	if ssu.mutation.ShowCleared() && len(ssu.mutation.ShowIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SavedShow.show"`)
+	}
+	return nil
+}
+
+func (ssu *SavedShowUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ssu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(savedshow.Table, savedshow.Columns, sqlgraph.NewFieldSpec(savedshow.FieldID, field.TypeString))
+	if ps := ssu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ssu.mutation.AddedAt(); ok {
+		_spec.SetField(savedshow.FieldAddedAt, field.TypeTime, value)
+	}
+	if value, ok := ssu.mutation.TotalEpisodes(); ok {
+		_spec.SetField(savedshow.FieldTotalEpisodes, field.TypeInt, value)
+	}
+	if value, ok := ssu.mutation.AddedTotalEpisodes(); ok {
+		_spec.AddField(savedshow.FieldTotalEpisodes, field.TypeInt, value)
+	}
+	if ssu.mutation.RunCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedshow.RunTable,
+			Columns: []string{savedshow.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ssu.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedshow.RunTable,
+			Columns: []string{savedshow.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ssu.mutation.ShowCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   savedshow.ShowTable,
+			Columns: []string{savedshow.ShowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(show.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ssu.mutation.ShowIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   savedshow.ShowTable,
+			Columns: []string{savedshow.ShowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(show.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ssu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{savedshow.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ssu.mutation.done = true
+	return n, nil
+}
+
+// SavedShowUpdateOne is the builder for updating a single SavedShow entity.
+type SavedShowUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SavedShowMutation
+}
+
+// SetAddedAt sets the "added_at" field.
+func (ssuo *SavedShowUpdateOne) SetAddedAt(t time.Time) *SavedShowUpdateOne {
+	ssuo.mutation.SetAddedAt(t)
+	return ssuo
+}
+
+// SetNillableAddedAt sets the "added_at" field if the given value is not nil.
+func (ssuo *SavedShowUpdateOne) SetNillableAddedAt(t *time.Time) *SavedShowUpdateOne {
+	if t != nil {
+		ssuo.SetAddedAt(*t)
+	}
+	return ssuo
+}
+
+// SetTotalEpisodes sets the "total_episodes" field.
+func (ssuo *SavedShowUpdateOne) SetTotalEpisodes(i int) *SavedShowUpdateOne {
+	ssuo.mutation.ResetTotalEpisodes()
+	ssuo.mutation.SetTotalEpisodes(i)
+	return ssuo
+}
+
+// SetNillableTotalEpisodes sets the "total_episodes" field if the given value is not nil.
+func (ssuo *SavedShowUpdateOne) SetNillableTotalEpisodes(i *int) *SavedShowUpdateOne {
+	if i != nil {
+		ssuo.SetTotalEpisodes(*i)
+	}
+	return ssuo
+}
+
+// AddTotalEpisodes adds i to the "total_episodes" field.
+func (ssuo *SavedShowUpdateOne) AddTotalEpisodes(i int) *SavedShowUpdateOne {
+	ssuo.mutation.AddTotalEpisodes(i)
+	return ssuo
+}
+
+// SetRunID sets the "run" edge to the BackupRun entity by ID.
+func (ssuo *SavedShowUpdateOne) SetRunID(id string) *SavedShowUpdateOne {
+	ssuo.mutation.SetRunID(id)
+	return ssuo
+}
+
+// SetRun sets the "run" edge to the BackupRun entity.
+func (ssuo *SavedShowUpdateOne) SetRun(b *BackupRun) *SavedShowUpdateOne {
+	return ssuo.SetRunID(b.ID)
+}
+
+// SetShowID sets the "show" edge to the Show entity by ID.
+func (ssuo *SavedShowUpdateOne) SetShowID(id string) *SavedShowUpdateOne {
+	ssuo.mutation.SetShowID(id)
+	return ssuo
+}
+
+// SetShow sets the "show" edge to the Show entity.
+func (ssuo *SavedShowUpdateOne) SetShow(s *Show) *SavedShowUpdateOne {
+	return ssuo.SetShowID(s.ID)
+}
+
+// Mutation returns the SavedShowMutation object of the builder.
+func (ssuo *SavedShowUpdateOne) Mutation() *SavedShowMutation {
+	return ssuo.mutation
+}
+
+// ClearRun clears the "run" edge to the BackupRun entity.
+func (ssuo *SavedShowUpdateOne) ClearRun() *SavedShowUpdateOne {
+	ssuo.mutation.ClearRun()
+	return ssuo
+}
+
+// ClearShow clears the "show" edge to the Show entity.
+func (ssuo *SavedShowUpdateOne) ClearShow() *SavedShowUpdateOne {
+	ssuo.mutation.ClearShow()
+	return ssuo
+}
+
+// Where appends a list predicates to the SavedShowUpdate builder.
+func (ssuo *SavedShowUpdateOne) Where(ps ...predicate.SavedShow) *SavedShowUpdateOne {
+	ssuo.mutation.Where(ps...)
+	return ssuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ssuo *SavedShowUpdateOne) Select(field string, fields ...string) *SavedShowUpdateOne {
+	ssuo.fields = append([]string{field}, fields...)
+	return ssuo
+}
+
+// Save executes the query and returns the updated SavedShow entity.
+func (ssuo *SavedShowUpdateOne) Save(ctx context.Context) (*SavedShow, error) {
+	return withHooks(ctx, ssuo.sqlSave, ssuo.mutation, ssuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ssuo *SavedShowUpdateOne) SaveX(ctx context.Context) *SavedShow {
+	node, err := ssuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ssuo *SavedShowUpdateOne) Exec(ctx context.Context) error {
+	_, err := ssuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssuo *SavedShowUpdateOne) ExecX(ctx context.Context) {
+	if err := ssuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ssuo *SavedShowUpdateOne) check() error {
+	if v, ok := ssuo.mutation.TotalEpisodes(); ok {
+		if err := savedshow.TotalEpisodesValidator(v); err != nil {
+			return &ValidationError{Name: "total_episodes", err: fmt.Errorf(`ent: validator failed for field "SavedShow.total_episodes": %w`, err)}
+		}
+	}
+	if ssuo.mutation.RunCleared() && len(ssuo.mutation.RunIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SavedShow.run"`)
+	}
+	if ssuo.mutation.ShowCleared() && len(ssuo.mutation.ShowIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SavedShow.show"`)
+	}
+	return nil
+}
+
+func (ssuo *SavedShowUpdateOne) sqlSave(ctx context.Context) (_node *SavedShow, err error) {
+	if err := ssuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(savedshow.Table, savedshow.Columns, sqlgraph.NewFieldSpec(savedshow.FieldID, field.TypeString))
+	id, ok := ssuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SavedShow.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ssuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, savedshow.FieldID)
+		for _, f := range fields {
+			if !savedshow.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != savedshow.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ssuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ssuo.mutation.AddedAt(); ok {
+		_spec.SetField(savedshow.FieldAddedAt, field.TypeTime, value)
+	}
+	if value, ok := ssuo.mutation.TotalEpisodes(); ok {
+		_spec.SetField(savedshow.FieldTotalEpisodes, field.TypeInt, value)
+	}
+	if value, ok := ssuo.mutation.AddedTotalEpisodes(); ok {
+		_spec.AddField(savedshow.FieldTotalEpisodes, field.TypeInt, value)
+	}
+	if ssuo.mutation.RunCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedshow.RunTable,
+			Columns: []string{savedshow.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ssuo.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedshow.RunTable,
+			Columns: []string{savedshow.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ssuo.mutation.ShowCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   savedshow.ShowTable,
+			Columns: []string{savedshow.ShowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(show.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ssuo.mutation.ShowIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   savedshow.ShowTable,
+			Columns: []string{savedshow.ShowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(show.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SavedShow{config: ssuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ssuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{savedshow.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ssuo.mutation.done = true
+	return _node, nil
+}
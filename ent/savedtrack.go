@@ -0,0 +1,210 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/savedtrack"
+	"beyerleinf/spotify-backup/ent/track"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// SavedTrack is the model entity for the SavedTrack schema.
+type SavedTrack struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// AddedAt holds the value of the "added_at" field.
+	AddedAt time.Time `json:"added_at,omitempty"`
+	// LinkedFromID holds the value of the "linked_from_id" field.
+	LinkedFromID string `json:"linked_from_id,omitempty"`
+	// IsPlayable holds the value of the "is_playable" field.
+	IsPlayable *bool `json:"is_playable,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SavedTrackQuery when eager-loading is set.
+	Edges                   SavedTrackEdges `json:"edges"`
+	backup_run_saved_tracks *string
+	saved_track_track       *string
+	selectValues            sql.SelectValues
+}
+
+// SavedTrackEdges holds the relations/edges for other nodes in the graph.
+type SavedTrackEdges struct {
+	// Run holds the value of the run edge.
+	Run *BackupRun `json:"run,omitempty"`
+	// Track holds the value of the track edge.
+	Track *Track `json:"track,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// RunOrErr returns the Run value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SavedTrackEdges) RunOrErr() (*BackupRun, error) {
+	if e.Run != nil {
+		return e.Run, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: backuprun.Label}
+	}
+	return nil, &NotLoadedError{edge: "run"}
+}
+
+// TrackOrErr returns the Track value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SavedTrackEdges) TrackOrErr() (*Track, error) {
+	if e.Track != nil {
+		return e.Track, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: track.Label}
+	}
+	return nil, &NotLoadedError{edge: "track"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SavedTrack) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case savedtrack.FieldIsPlayable:
+			values[i] = new(sql.NullBool)
+		case savedtrack.FieldID, savedtrack.FieldLinkedFromID:
+			values[i] = new(sql.NullString)
+		case savedtrack.FieldAddedAt, savedtrack.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case savedtrack.ForeignKeys[0]: // backup_run_saved_tracks
+			values[i] = new(sql.NullString)
+		case savedtrack.ForeignKeys[1]: // saved_track_track
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SavedTrack fields.
+func (st *SavedTrack) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case savedtrack.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				st.ID = value.String
+			}
+		case savedtrack.FieldAddedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field added_at", values[i])
+			} else if value.Valid {
+				st.AddedAt = value.Time
+			}
+		case savedtrack.FieldLinkedFromID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field linked_from_id", values[i])
+			} else if value.Valid {
+				st.LinkedFromID = value.String
+			}
+		case savedtrack.FieldIsPlayable:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_playable", values[i])
+			} else if value.Valid {
+				st.IsPlayable = new(bool)
+				*st.IsPlayable = value.Bool
+			}
+		case savedtrack.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				st.CreatedAt = value.Time
+			}
+		case savedtrack.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field backup_run_saved_tracks", values[i])
+			} else if value.Valid {
+				st.backup_run_saved_tracks = new(string)
+				*st.backup_run_saved_tracks = value.String
+			}
+		case savedtrack.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field saved_track_track", values[i])
+			} else if value.Valid {
+				st.saved_track_track = new(string)
+				*st.saved_track_track = value.String
+			}
+		default:
+			st.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SavedTrack.
+// This includes values selected through modifiers, order, etc.
+func (st *SavedTrack) Value(name string) (ent.Value, error) {
+	return st.selectValues.Get(name)
+}
+
+// QueryRun queries the "run" edge of the SavedTrack entity.
+func (st *SavedTrack) QueryRun() *BackupRunQuery {
+	return NewSavedTrackClient(st.config).QueryRun(st)
+}
+
+// QueryTrack queries the "track" edge of the SavedTrack entity.
+func (st *SavedTrack) QueryTrack() *TrackQuery {
+	return NewSavedTrackClient(st.config).QueryTrack(st)
+}
+
+// Update returns a builder for updating this SavedTrack.
+// Note that you need to call SavedTrack.Unwrap() before calling this method if this SavedTrack
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (st *SavedTrack) Update() *SavedTrackUpdateOne {
+	return NewSavedTrackClient(st.config).UpdateOne(st)
+}
+
+// Unwrap unwraps the SavedTrack entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (st *SavedTrack) Unwrap() *SavedTrack {
+	_tx, ok := st.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SavedTrack is not a transactional entity")
+	}
+	st.config.driver = _tx.drv
+	return st
+}
+
+// String implements the fmt.Stringer.
+func (st *SavedTrack) String() string {
+	var builder strings.Builder
+	builder.WriteString("SavedTrack(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", st.ID))
+	builder.WriteString("added_at=")
+	builder.WriteString(st.AddedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("linked_from_id=")
+	builder.WriteString(st.LinkedFromID)
+	builder.WriteString(", ")
+	if v := st.IsPlayable; v != nil {
+		builder.WriteString("is_playable=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(st.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SavedTracks is a parsable slice of SavedTrack.
+type SavedTracks []*SavedTrack
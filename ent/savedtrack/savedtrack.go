@@ -0,0 +1,141 @@
+// Code generated by ent, DO NOT EDIT.
+
+package savedtrack
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the savedtrack type in the database.
+	Label = "saved_track"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldAddedAt holds the string denoting the added_at field in the database.
+	FieldAddedAt = "added_at"
+	// FieldLinkedFromID holds the string denoting the linked_from_id field in the database.
+	FieldLinkedFromID = "linked_from_id"
+	// FieldIsPlayable holds the string denoting the is_playable field in the database.
+	FieldIsPlayable = "is_playable"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeRun holds the string denoting the run edge name in mutations.
+	EdgeRun = "run"
+	// EdgeTrack holds the string denoting the track edge name in mutations.
+	EdgeTrack = "track"
+	// Table holds the table name of the savedtrack in the database.
+	Table = "saved_tracks"
+	// RunTable is the table that holds the run relation/edge.
+	RunTable = "saved_tracks"
+	// RunInverseTable is the table name for the BackupRun entity.
+	// It exists in this package in order to avoid circular dependency with the "backuprun" package.
+	RunInverseTable = "backup_runs"
+	// RunColumn is the table column denoting the run relation/edge.
+	RunColumn = "backup_run_saved_tracks"
+	// TrackTable is the table that holds the track relation/edge.
+	TrackTable = "saved_tracks"
+	// TrackInverseTable is the table name for the Track entity.
+	// It exists in this package in order to avoid circular dependency with the "track" package.
+	TrackInverseTable = "tracks"
+	// TrackColumn is the table column denoting the track relation/edge.
+	TrackColumn = "saved_track_track"
+)
+
+// Columns holds all SQL columns for savedtrack fields.
+var Columns = []string{
+	FieldID,
+	FieldAddedAt,
+	FieldLinkedFromID,
+	FieldIsPlayable,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "saved_tracks"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"backup_run_saved_tracks",
+	"saved_track_track",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultLinkedFromID holds the default value on creation for the "linked_from_id" field.
+	DefaultLinkedFromID string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() string
+)
+
+// OrderOption defines the ordering options for the SavedTrack queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByAddedAt orders the results by the added_at field.
+func ByAddedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAddedAt, opts...).ToFunc()
+}
+
+// ByLinkedFromID orders the results by the linked_from_id field.
+func ByLinkedFromID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLinkedFromID, opts...).ToFunc()
+}
+
+// ByIsPlayable orders the results by the is_playable field.
+func ByIsPlayable(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsPlayable, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByRunField orders the results by run field.
+func ByRunField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRunStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByTrackField orders the results by track field.
+func ByTrackField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTrackStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newRunStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RunInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, RunTable, RunColumn),
+	)
+}
+func newTrackStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TrackInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, TrackTable, TrackColumn),
+	)
+}
@@ -0,0 +1,312 @@
+// Code generated by ent, DO NOT EDIT.
+
+package savedtrack
+
+import (
+	"beyerleinf/spotify-backup/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldContainsFold(FieldID, id))
+}
+
+// AddedAt applies equality check predicate on the "added_at" field. It's identical to AddedAtEQ.
+func AddedAt(v time.Time) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldEQ(FieldAddedAt, v))
+}
+
+// LinkedFromID applies equality check predicate on the "linked_from_id" field. It's identical to LinkedFromIDEQ.
+func LinkedFromID(v string) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldEQ(FieldLinkedFromID, v))
+}
+
+// IsPlayable applies equality check predicate on the "is_playable" field. It's identical to IsPlayableEQ.
+func IsPlayable(v bool) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldEQ(FieldIsPlayable, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// AddedAtEQ applies the EQ predicate on the "added_at" field.
+func AddedAtEQ(v time.Time) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldEQ(FieldAddedAt, v))
+}
+
+// AddedAtNEQ applies the NEQ predicate on the "added_at" field.
+func AddedAtNEQ(v time.Time) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldNEQ(FieldAddedAt, v))
+}
+
+// AddedAtIn applies the In predicate on the "added_at" field.
+func AddedAtIn(vs ...time.Time) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldIn(FieldAddedAt, vs...))
+}
+
+// AddedAtNotIn applies the NotIn predicate on the "added_at" field.
+func AddedAtNotIn(vs ...time.Time) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldNotIn(FieldAddedAt, vs...))
+}
+
+// AddedAtGT applies the GT predicate on the "added_at" field.
+func AddedAtGT(v time.Time) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldGT(FieldAddedAt, v))
+}
+
+// AddedAtGTE applies the GTE predicate on the "added_at" field.
+func AddedAtGTE(v time.Time) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldGTE(FieldAddedAt, v))
+}
+
+// AddedAtLT applies the LT predicate on the "added_at" field.
+func AddedAtLT(v time.Time) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldLT(FieldAddedAt, v))
+}
+
+// AddedAtLTE applies the LTE predicate on the "added_at" field.
+func AddedAtLTE(v time.Time) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldLTE(FieldAddedAt, v))
+}
+
+// LinkedFromIDEQ applies the EQ predicate on the "linked_from_id" field.
+func LinkedFromIDEQ(v string) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldEQ(FieldLinkedFromID, v))
+}
+
+// LinkedFromIDNEQ applies the NEQ predicate on the "linked_from_id" field.
+func LinkedFromIDNEQ(v string) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldNEQ(FieldLinkedFromID, v))
+}
+
+// LinkedFromIDIn applies the In predicate on the "linked_from_id" field.
+func LinkedFromIDIn(vs ...string) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldIn(FieldLinkedFromID, vs...))
+}
+
+// LinkedFromIDNotIn applies the NotIn predicate on the "linked_from_id" field.
+func LinkedFromIDNotIn(vs ...string) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldNotIn(FieldLinkedFromID, vs...))
+}
+
+// LinkedFromIDGT applies the GT predicate on the "linked_from_id" field.
+func LinkedFromIDGT(v string) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldGT(FieldLinkedFromID, v))
+}
+
+// LinkedFromIDGTE applies the GTE predicate on the "linked_from_id" field.
+func LinkedFromIDGTE(v string) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldGTE(FieldLinkedFromID, v))
+}
+
+// LinkedFromIDLT applies the LT predicate on the "linked_from_id" field.
+func LinkedFromIDLT(v string) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldLT(FieldLinkedFromID, v))
+}
+
+// LinkedFromIDLTE applies the LTE predicate on the "linked_from_id" field.
+func LinkedFromIDLTE(v string) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldLTE(FieldLinkedFromID, v))
+}
+
+// LinkedFromIDContains applies the Contains predicate on the "linked_from_id" field.
+func LinkedFromIDContains(v string) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldContains(FieldLinkedFromID, v))
+}
+
+// LinkedFromIDHasPrefix applies the HasPrefix predicate on the "linked_from_id" field.
+func LinkedFromIDHasPrefix(v string) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldHasPrefix(FieldLinkedFromID, v))
+}
+
+// LinkedFromIDHasSuffix applies the HasSuffix predicate on the "linked_from_id" field.
+func LinkedFromIDHasSuffix(v string) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldHasSuffix(FieldLinkedFromID, v))
+}
+
+// LinkedFromIDEqualFold applies the EqualFold predicate on the "linked_from_id" field.
+func LinkedFromIDEqualFold(v string) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldEqualFold(FieldLinkedFromID, v))
+}
+
+// LinkedFromIDContainsFold applies the ContainsFold predicate on the "linked_from_id" field.
+func LinkedFromIDContainsFold(v string) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldContainsFold(FieldLinkedFromID, v))
+}
+
+// IsPlayableEQ applies the EQ predicate on the "is_playable" field.
+func IsPlayableEQ(v bool) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldEQ(FieldIsPlayable, v))
+}
+
+// IsPlayableNEQ applies the NEQ predicate on the "is_playable" field.
+func IsPlayableNEQ(v bool) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldNEQ(FieldIsPlayable, v))
+}
+
+// IsPlayableIsNil applies the IsNil predicate on the "is_playable" field.
+func IsPlayableIsNil() predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldIsNull(FieldIsPlayable))
+}
+
+// IsPlayableNotNil applies the NotNil predicate on the "is_playable" field.
+func IsPlayableNotNil() predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldNotNull(FieldIsPlayable))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasRun applies the HasEdge predicate on the "run" edge.
+func HasRun() predicate.SavedTrack {
+	return predicate.SavedTrack(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RunTable, RunColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRunWith applies the HasEdge predicate on the "run" edge with a given conditions (other predicates).
+func HasRunWith(preds ...predicate.BackupRun) predicate.SavedTrack {
+	return predicate.SavedTrack(func(s *sql.Selector) {
+		step := newRunStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTrack applies the HasEdge predicate on the "track" edge.
+func HasTrack() predicate.SavedTrack {
+	return predicate.SavedTrack(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, TrackTable, TrackColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTrackWith applies the HasEdge predicate on the "track" edge with a given conditions (other predicates).
+func HasTrackWith(preds ...predicate.Track) predicate.SavedTrack {
+	return predicate.SavedTrack(func(s *sql.Selector) {
+		step := newTrackStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SavedTrack) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SavedTrack) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SavedTrack) predicate.SavedTrack {
+	return predicate.SavedTrack(sql.NotPredicates(p))
+}
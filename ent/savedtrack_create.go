@@ -0,0 +1,345 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/savedtrack"
+	"beyerleinf/spotify-backup/ent/track"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SavedTrackCreate is the builder for creating a SavedTrack entity.
+type SavedTrackCreate struct {
+	config
+	mutation *SavedTrackMutation
+	hooks    []Hook
+}
+
+// SetAddedAt sets the "added_at" field.
+func (stc *SavedTrackCreate) SetAddedAt(t time.Time) *SavedTrackCreate {
+	stc.mutation.SetAddedAt(t)
+	return stc
+}
+
+// SetLinkedFromID sets the "linked_from_id" field.
+func (stc *SavedTrackCreate) SetLinkedFromID(s string) *SavedTrackCreate {
+	stc.mutation.SetLinkedFromID(s)
+	return stc
+}
+
+// SetNillableLinkedFromID sets the "linked_from_id" field if the given value is not nil.
+func (stc *SavedTrackCreate) SetNillableLinkedFromID(s *string) *SavedTrackCreate {
+	if s != nil {
+		stc.SetLinkedFromID(*s)
+	}
+	return stc
+}
+
+// SetIsPlayable sets the "is_playable" field.
+func (stc *SavedTrackCreate) SetIsPlayable(b bool) *SavedTrackCreate {
+	stc.mutation.SetIsPlayable(b)
+	return stc
+}
+
+// SetNillableIsPlayable sets the "is_playable" field if the given value is not nil.
+func (stc *SavedTrackCreate) SetNillableIsPlayable(b *bool) *SavedTrackCreate {
+	if b != nil {
+		stc.SetIsPlayable(*b)
+	}
+	return stc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (stc *SavedTrackCreate) SetCreatedAt(t time.Time) *SavedTrackCreate {
+	stc.mutation.SetCreatedAt(t)
+	return stc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (stc *SavedTrackCreate) SetNillableCreatedAt(t *time.Time) *SavedTrackCreate {
+	if t != nil {
+		stc.SetCreatedAt(*t)
+	}
+	return stc
+}
+
+// SetID sets the "id" field.
+func (stc *SavedTrackCreate) SetID(s string) *SavedTrackCreate {
+	stc.mutation.SetID(s)
+	return stc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (stc *SavedTrackCreate) SetNillableID(s *string) *SavedTrackCreate {
+	if s != nil {
+		stc.SetID(*s)
+	}
+	return stc
+}
+
+// SetRunID sets the "run" edge to the BackupRun entity by ID.
+func (stc *SavedTrackCreate) SetRunID(id string) *SavedTrackCreate {
+	stc.mutation.SetRunID(id)
+	return stc
+}
+
+// SetRun sets the "run" edge to the BackupRun entity.
+func (stc *SavedTrackCreate) SetRun(b *BackupRun) *SavedTrackCreate {
+	return stc.SetRunID(b.ID)
+}
+
+// SetTrackID sets the "track" edge to the Track entity by ID.
+func (stc *SavedTrackCreate) SetTrackID(id string) *SavedTrackCreate {
+	stc.mutation.SetTrackID(id)
+	return stc
+}
+
+// SetTrack sets the "track" edge to the Track entity.
+func (stc *SavedTrackCreate) SetTrack(t *Track) *SavedTrackCreate {
+	return stc.SetTrackID(t.ID)
+}
+
+// Mutation returns the SavedTrackMutation object of the builder.
+func (stc *SavedTrackCreate) Mutation() *SavedTrackMutation {
+	return stc.mutation
+}
+
+// Save creates the SavedTrack in the database.
+func (stc *SavedTrackCreate) Save(ctx context.Context) (*SavedTrack, error) {
+	stc.defaults()
+	return withHooks(ctx, stc.sqlSave, stc.mutation, stc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (stc *SavedTrackCreate) SaveX(ctx context.Context) *SavedTrack {
+	v, err := stc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (stc *SavedTrackCreate) Exec(ctx context.Context) error {
+	_, err := stc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (stc *SavedTrackCreate) ExecX(ctx context.Context) {
+	if err := stc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (stc *SavedTrackCreate) defaults() {
+	if _, ok := stc.mutation.LinkedFromID(); !ok {
+		v := savedtrack.DefaultLinkedFromID
+		stc.mutation.SetLinkedFromID(v)
+	}
+	if _, ok := stc.mutation.CreatedAt(); !ok {
+		v := savedtrack.DefaultCreatedAt()
+		stc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := stc.mutation.ID(); !ok {
+		v := savedtrack.DefaultID()
+		stc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (stc *SavedTrackCreate) check() error {
+	if _, ok := stc.mutation.AddedAt(); !ok {
+		return &ValidationError{Name: "added_at", err: errors.New(`ent: missing required field "SavedTrack.added_at"`)}
+	}
+	if _, ok := stc.mutation.LinkedFromID(); !ok {
+		return &ValidationError{Name: "linked_from_id", err: errors.New(`ent: missing required field "SavedTrack.linked_from_id"`)}
+	}
+	if _, ok := stc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SavedTrack.created_at"`)}
+	}
+	if len(stc.mutation.RunIDs()) == 0 {
+		return &ValidationError{Name: "run", err: errors.New(`ent: missing required edge "SavedTrack.run"`)}
+	}
+	if len(stc.mutation.TrackIDs()) == 0 {
+		return &ValidationError{Name: "track", err: errors.New(`ent: missing required edge "SavedTrack.track"`)}
+	}
+	return nil
+}
+
+func (stc *SavedTrackCreate) sqlSave(ctx context.Context) (*SavedTrack, error) {
+	if err := stc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := stc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, stc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected SavedTrack.ID type: %T", _spec.ID.Value)
+		}
+	}
+	stc.mutation.id = &_node.ID
+	stc.mutation.done = true
+	return _node, nil
+}
+
+func (stc *SavedTrackCreate) createSpec() (*SavedTrack, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SavedTrack{config: stc.config}
+		_spec = sqlgraph.NewCreateSpec(savedtrack.Table, sqlgraph.NewFieldSpec(savedtrack.FieldID, field.TypeString))
+	)
+	if id, ok := stc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := stc.mutation.AddedAt(); ok {
+		_spec.SetField(savedtrack.FieldAddedAt, field.TypeTime, value)
+		_node.AddedAt = value
+	}
+	if value, ok := stc.mutation.LinkedFromID(); ok {
+		_spec.SetField(savedtrack.FieldLinkedFromID, field.TypeString, value)
+		_node.LinkedFromID = value
+	}
+	if value, ok := stc.mutation.IsPlayable(); ok {
+		_spec.SetField(savedtrack.FieldIsPlayable, field.TypeBool, value)
+		_node.IsPlayable = &value
+	}
+	if value, ok := stc.mutation.CreatedAt(); ok {
+		_spec.SetField(savedtrack.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := stc.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedtrack.RunTable,
+			Columns: []string{savedtrack.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.backup_run_saved_tracks = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := stc.mutation.TrackIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   savedtrack.TrackTable,
+			Columns: []string{savedtrack.TrackColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.saved_track_track = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SavedTrackCreateBulk is the builder for creating many SavedTrack entities in bulk.
+type SavedTrackCreateBulk struct {
+	config
+	err      error
+	builders []*SavedTrackCreate
+}
+
+// Save creates the SavedTrack entities in the database.
+func (stcb *SavedTrackCreateBulk) Save(ctx context.Context) ([]*SavedTrack, error) {
+	if stcb.err != nil {
+		return nil, stcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(stcb.builders))
+	nodes := make([]*SavedTrack, len(stcb.builders))
+	mutators := make([]Mutator, len(stcb.builders))
+	for i := range stcb.builders {
+		func(i int, root context.Context) {
+			builder := stcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SavedTrackMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, stcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, stcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, stcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (stcb *SavedTrackCreateBulk) SaveX(ctx context.Context) []*SavedTrack {
+	v, err := stcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (stcb *SavedTrackCreateBulk) Exec(ctx context.Context) error {
+	_, err := stcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (stcb *SavedTrackCreateBulk) ExecX(ctx context.Context) {
+	if err := stcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,726 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/predicate"
+	"beyerleinf/spotify-backup/ent/savedtrack"
+	"beyerleinf/spotify-backup/ent/track"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SavedTrackQuery is the builder for querying SavedTrack entities.
+type SavedTrackQuery struct {
+	config
+	ctx        *QueryContext
+	order      []savedtrack.OrderOption
+	inters     []Interceptor
+	predicates []predicate.SavedTrack
+	withRun    *BackupRunQuery
+	withTrack  *TrackQuery
+	withFKs    bool
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SavedTrackQuery builder.
+func (stq *SavedTrackQuery) Where(ps ...predicate.SavedTrack) *SavedTrackQuery {
+	stq.predicates = append(stq.predicates, ps...)
+	return stq
+}
+
+// Limit the number of records to be returned by this query.
+func (stq *SavedTrackQuery) Limit(limit int) *SavedTrackQuery {
+	stq.ctx.Limit = &limit
+	return stq
+}
+
+// Offset to start from.
+func (stq *SavedTrackQuery) Offset(offset int) *SavedTrackQuery {
+	stq.ctx.Offset = &offset
+	return stq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (stq *SavedTrackQuery) Unique(unique bool) *SavedTrackQuery {
+	stq.ctx.Unique = &unique
+	return stq
+}
+
+// Order specifies how the records should be ordered.
+func (stq *SavedTrackQuery) Order(o ...savedtrack.OrderOption) *SavedTrackQuery {
+	stq.order = append(stq.order, o...)
+	return stq
+}
+
+// QueryRun chains the current query on the "run" edge.
+func (stq *SavedTrackQuery) QueryRun() *BackupRunQuery {
+	query := (&BackupRunClient{config: stq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := stq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := stq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(savedtrack.Table, savedtrack.FieldID, selector),
+			sqlgraph.To(backuprun.Table, backuprun.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, savedtrack.RunTable, savedtrack.RunColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(stq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTrack chains the current query on the "track" edge.
+func (stq *SavedTrackQuery) QueryTrack() *TrackQuery {
+	query := (&TrackClient{config: stq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := stq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := stq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(savedtrack.Table, savedtrack.FieldID, selector),
+			sqlgraph.To(track.Table, track.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, savedtrack.TrackTable, savedtrack.TrackColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(stq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SavedTrack entity from the query.
+// Returns a *NotFoundError when no SavedTrack was found.
+func (stq *SavedTrackQuery) First(ctx context.Context) (*SavedTrack, error) {
+	nodes, err := stq.Limit(1).All(setContextOp(ctx, stq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{savedtrack.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (stq *SavedTrackQuery) FirstX(ctx context.Context) *SavedTrack {
+	node, err := stq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SavedTrack ID from the query.
+// Returns a *NotFoundError when no SavedTrack ID was found.
+func (stq *SavedTrackQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = stq.Limit(1).IDs(setContextOp(ctx, stq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{savedtrack.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (stq *SavedTrackQuery) FirstIDX(ctx context.Context) string {
+	id, err := stq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SavedTrack entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SavedTrack entity is found.
+// Returns a *NotFoundError when no SavedTrack entities are found.
+func (stq *SavedTrackQuery) Only(ctx context.Context) (*SavedTrack, error) {
+	nodes, err := stq.Limit(2).All(setContextOp(ctx, stq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{savedtrack.Label}
+	default:
+		return nil, &NotSingularError{savedtrack.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (stq *SavedTrackQuery) OnlyX(ctx context.Context) *SavedTrack {
+	node, err := stq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SavedTrack ID in the query.
+// Returns a *NotSingularError when more than one SavedTrack ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (stq *SavedTrackQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = stq.Limit(2).IDs(setContextOp(ctx, stq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{savedtrack.Label}
+	default:
+		err = &NotSingularError{savedtrack.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (stq *SavedTrackQuery) OnlyIDX(ctx context.Context) string {
+	id, err := stq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SavedTracks.
+func (stq *SavedTrackQuery) All(ctx context.Context) ([]*SavedTrack, error) {
+	ctx = setContextOp(ctx, stq.ctx, ent.OpQueryAll)
+	if err := stq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SavedTrack, *SavedTrackQuery]()
+	return withInterceptors[[]*SavedTrack](ctx, stq, qr, stq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (stq *SavedTrackQuery) AllX(ctx context.Context) []*SavedTrack {
+	nodes, err := stq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SavedTrack IDs.
+func (stq *SavedTrackQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if stq.ctx.Unique == nil && stq.path != nil {
+		stq.Unique(true)
+	}
+	ctx = setContextOp(ctx, stq.ctx, ent.OpQueryIDs)
+	if err = stq.Select(savedtrack.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (stq *SavedTrackQuery) IDsX(ctx context.Context) []string {
+	ids, err := stq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (stq *SavedTrackQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, stq.ctx, ent.OpQueryCount)
+	if err := stq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, stq, querierCount[*SavedTrackQuery](), stq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (stq *SavedTrackQuery) CountX(ctx context.Context) int {
+	count, err := stq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (stq *SavedTrackQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, stq.ctx, ent.OpQueryExist)
+	switch _, err := stq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (stq *SavedTrackQuery) ExistX(ctx context.Context) bool {
+	exist, err := stq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SavedTrackQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (stq *SavedTrackQuery) Clone() *SavedTrackQuery {
+	if stq == nil {
+		return nil
+	}
+	return &SavedTrackQuery{
+		config:     stq.config,
+		ctx:        stq.ctx.Clone(),
+		order:      append([]savedtrack.OrderOption{}, stq.order...),
+		inters:     append([]Interceptor{}, stq.inters...),
+		predicates: append([]predicate.SavedTrack{}, stq.predicates...),
+		withRun:    stq.withRun.Clone(),
+		withTrack:  stq.withTrack.Clone(),
+		// clone intermediate query.
+		sql:  stq.sql.Clone(),
+		path: stq.path,
+	}
+}
+
+// WithRun tells the query-builder to eager-load the nodes that are connected to
+// the "run" edge. The optional arguments are used to configure the query builder of the edge.
+func (stq *SavedTrackQuery) WithRun(opts ...func(*BackupRunQuery)) *SavedTrackQuery {
+	query := (&BackupRunClient{config: stq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	stq.withRun = query
+	return stq
+}
+
+// WithTrack tells the query-builder to eager-load the nodes that are connected to
+// the "track" edge. The optional arguments are used to configure the query builder of the edge.
+func (stq *SavedTrackQuery) WithTrack(opts ...func(*TrackQuery)) *SavedTrackQuery {
+	query := (&TrackClient{config: stq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	stq.withTrack = query
+	return stq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		AddedAt time.Time `json:"added_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SavedTrack.Query().
+//		GroupBy(savedtrack.FieldAddedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (stq *SavedTrackQuery) GroupBy(field string, fields ...string) *SavedTrackGroupBy {
+	stq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SavedTrackGroupBy{build: stq}
+	grbuild.flds = &stq.ctx.Fields
+	grbuild.label = savedtrack.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		AddedAt time.Time `json:"added_at,omitempty"`
+//	}
+//
+//	client.SavedTrack.Query().
+//		Select(savedtrack.FieldAddedAt).
+//		Scan(ctx, &v)
+func (stq *SavedTrackQuery) Select(fields ...string) *SavedTrackSelect {
+	stq.ctx.Fields = append(stq.ctx.Fields, fields...)
+	sbuild := &SavedTrackSelect{SavedTrackQuery: stq}
+	sbuild.label = savedtrack.Label
+	sbuild.flds, sbuild.scan = &stq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SavedTrackSelect configured with the given aggregations.
+func (stq *SavedTrackQuery) Aggregate(fns ...AggregateFunc) *SavedTrackSelect {
+	return stq.Select().Aggregate(fns...)
+}
+
+func (stq *SavedTrackQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range stq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, stq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range stq.ctx.Fields {
+		if !savedtrack.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if stq.path != nil {
+		prev, err := stq.path(ctx)
+		if err != nil {
+			return err
+		}
+		stq.sql = prev
+	}
+	return nil
+}
+
+func (stq *SavedTrackQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SavedTrack, error) {
+	var (
+		nodes       = []*SavedTrack{}
+		withFKs     = stq.withFKs
+		_spec       = stq.querySpec()
+		loadedTypes = [2]bool{
+			stq.withRun != nil,
+			stq.withTrack != nil,
+		}
+	)
+	if stq.withRun != nil || stq.withTrack != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, savedtrack.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SavedTrack).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SavedTrack{config: stq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(stq.modifiers) > 0 {
+		_spec.Modifiers = stq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, stq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := stq.withRun; query != nil {
+		if err := stq.loadRun(ctx, query, nodes, nil,
+			func(n *SavedTrack, e *BackupRun) { n.Edges.Run = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := stq.withTrack; query != nil {
+		if err := stq.loadTrack(ctx, query, nodes, nil,
+			func(n *SavedTrack, e *Track) { n.Edges.Track = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (stq *SavedTrackQuery) loadRun(ctx context.Context, query *BackupRunQuery, nodes []*SavedTrack, init func(*SavedTrack), assign func(*SavedTrack, *BackupRun)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*SavedTrack)
+	for i := range nodes {
+		if nodes[i].backup_run_saved_tracks == nil {
+			continue
+		}
+		fk := *nodes[i].backup_run_saved_tracks
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(backuprun.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "backup_run_saved_tracks" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (stq *SavedTrackQuery) loadTrack(ctx context.Context, query *TrackQuery, nodes []*SavedTrack, init func(*SavedTrack), assign func(*SavedTrack, *Track)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*SavedTrack)
+	for i := range nodes {
+		if nodes[i].saved_track_track == nil {
+			continue
+		}
+		fk := *nodes[i].saved_track_track
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(track.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "saved_track_track" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (stq *SavedTrackQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := stq.querySpec()
+	if len(stq.modifiers) > 0 {
+		_spec.Modifiers = stq.modifiers
+	}
+	_spec.Node.Columns = stq.ctx.Fields
+	if len(stq.ctx.Fields) > 0 {
+		_spec.Unique = stq.ctx.Unique != nil && *stq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, stq.driver, _spec)
+}
+
+func (stq *SavedTrackQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(savedtrack.Table, savedtrack.Columns, sqlgraph.NewFieldSpec(savedtrack.FieldID, field.TypeString))
+	_spec.From = stq.sql
+	if unique := stq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if stq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := stq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, savedtrack.FieldID)
+		for i := range fields {
+			if fields[i] != savedtrack.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := stq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := stq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := stq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := stq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (stq *SavedTrackQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(stq.driver.Dialect())
+	t1 := builder.Table(savedtrack.Table)
+	columns := stq.ctx.Fields
+	if len(columns) == 0 {
+		columns = savedtrack.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if stq.sql != nil {
+		selector = stq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if stq.ctx.Unique != nil && *stq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range stq.modifiers {
+		m(selector)
+	}
+	for _, p := range stq.predicates {
+		p(selector)
+	}
+	for _, p := range stq.order {
+		p(selector)
+	}
+	if offset := stq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := stq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (stq *SavedTrackQuery) ForUpdate(opts ...sql.LockOption) *SavedTrackQuery {
+	if stq.driver.Dialect() == dialect.Postgres {
+		stq.Unique(false)
+	}
+	stq.modifiers = append(stq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return stq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (stq *SavedTrackQuery) ForShare(opts ...sql.LockOption) *SavedTrackQuery {
+	if stq.driver.Dialect() == dialect.Postgres {
+		stq.Unique(false)
+	}
+	stq.modifiers = append(stq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return stq
+}
+
+// SavedTrackGroupBy is the group-by builder for SavedTrack entities.
+type SavedTrackGroupBy struct {
+	selector
+	build *SavedTrackQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (stgb *SavedTrackGroupBy) Aggregate(fns ...AggregateFunc) *SavedTrackGroupBy {
+	stgb.fns = append(stgb.fns, fns...)
+	return stgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (stgb *SavedTrackGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, stgb.build.ctx, ent.OpQueryGroupBy)
+	if err := stgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SavedTrackQuery, *SavedTrackGroupBy](ctx, stgb.build, stgb, stgb.build.inters, v)
+}
+
+func (stgb *SavedTrackGroupBy) sqlScan(ctx context.Context, root *SavedTrackQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(stgb.fns))
+	for _, fn := range stgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*stgb.flds)+len(stgb.fns))
+		for _, f := range *stgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*stgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := stgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SavedTrackSelect is the builder for selecting fields of SavedTrack entities.
+type SavedTrackSelect struct {
+	*SavedTrackQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sts *SavedTrackSelect) Aggregate(fns ...AggregateFunc) *SavedTrackSelect {
+	sts.fns = append(sts.fns, fns...)
+	return sts
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sts *SavedTrackSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sts.ctx, ent.OpQuerySelect)
+	if err := sts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SavedTrackQuery, *SavedTrackSelect](ctx, sts.SavedTrackQuery, sts, sts.inters, v)
+}
+
+func (sts *SavedTrackSelect) sqlScan(ctx context.Context, root *SavedTrackQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sts.fns))
+	for _, fn := range sts.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sts.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,510 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/predicate"
+	"beyerleinf/spotify-backup/ent/savedtrack"
+	"beyerleinf/spotify-backup/ent/track"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SavedTrackUpdate is the builder for updating SavedTrack entities.
+type SavedTrackUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SavedTrackMutation
+}
+
+// Where appends a list predicates to the SavedTrackUpdate builder.
+func (stu *SavedTrackUpdate) Where(ps ...predicate.SavedTrack) *SavedTrackUpdate {
+	stu.mutation.Where(ps...)
+	return stu
+}
+
+// SetAddedAt sets the "added_at" field.
+func (stu *SavedTrackUpdate) SetAddedAt(t time.Time) *SavedTrackUpdate {
+	stu.mutation.SetAddedAt(t)
+	return stu
+}
+
+// SetNillableAddedAt sets the "added_at" field if the given value is not nil.
+func (stu *SavedTrackUpdate) SetNillableAddedAt(t *time.Time) *SavedTrackUpdate {
+	if t != nil {
+		stu.SetAddedAt(*t)
+	}
+	return stu
+}
+
+// SetLinkedFromID sets the "linked_from_id" field.
+func (stu *SavedTrackUpdate) SetLinkedFromID(s string) *SavedTrackUpdate {
+	stu.mutation.SetLinkedFromID(s)
+	return stu
+}
+
+// SetNillableLinkedFromID sets the "linked_from_id" field if the given value is not nil.
+func (stu *SavedTrackUpdate) SetNillableLinkedFromID(s *string) *SavedTrackUpdate {
+	if s != nil {
+		stu.SetLinkedFromID(*s)
+	}
+	return stu
+}
+
+// SetIsPlayable sets the "is_playable" field.
+func (stu *SavedTrackUpdate) SetIsPlayable(b bool) *SavedTrackUpdate {
+	stu.mutation.SetIsPlayable(b)
+	return stu
+}
+
+// SetNillableIsPlayable sets the "is_playable" field if the given value is not nil.
+func (stu *SavedTrackUpdate) SetNillableIsPlayable(b *bool) *SavedTrackUpdate {
+	if b != nil {
+		stu.SetIsPlayable(*b)
+	}
+	return stu
+}
+
+// ClearIsPlayable clears the value of the "is_playable" field.
+func (stu *SavedTrackUpdate) ClearIsPlayable() *SavedTrackUpdate {
+	stu.mutation.ClearIsPlayable()
+	return stu
+}
+
+// SetRunID sets the "run" edge to the BackupRun entity by ID.
+func (stu *SavedTrackUpdate) SetRunID(id string) *SavedTrackUpdate {
+	stu.mutation.SetRunID(id)
+	return stu
+}
+
+// SetRun sets the "run" edge to the BackupRun entity.
+func (stu *SavedTrackUpdate) SetRun(b *BackupRun) *SavedTrackUpdate {
+	return stu.SetRunID(b.ID)
+}
+
+// SetTrackID sets the "track" edge to the Track entity by ID.
+func (stu *SavedTrackUpdate) SetTrackID(id string) *SavedTrackUpdate {
+	stu.mutation.SetTrackID(id)
+	return stu
+}
+
+// SetTrack sets the "track" edge to the Track entity.
+func (stu *SavedTrackUpdate) SetTrack(t *Track) *SavedTrackUpdate {
+	return stu.SetTrackID(t.ID)
+}
+
+// Mutation returns the SavedTrackMutation object of the builder.
+func (stu *SavedTrackUpdate) Mutation() *SavedTrackMutation {
+	return stu.mutation
+}
+
+// ClearRun clears the "run" edge to the BackupRun entity.
+func (stu *SavedTrackUpdate) ClearRun() *SavedTrackUpdate {
+	stu.mutation.ClearRun()
+	return stu
+}
+
+// ClearTrack clears the "track" edge to the Track entity.
+func (stu *SavedTrackUpdate) ClearTrack() *SavedTrackUpdate {
+	stu.mutation.ClearTrack()
+	return stu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (stu *SavedTrackUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, stu.sqlSave, stu.mutation, stu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (stu *SavedTrackUpdate) SaveX(ctx context.Context) int {
+	affected, err := stu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (stu *SavedTrackUpdate) Exec(ctx context.Context) error {
+	_, err := stu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (stu *SavedTrackUpdate) ExecX(ctx context.Context) {
+	if err := stu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (stu *SavedTrackUpdate) check() error {
+	if stu.mutation.RunCleared() && len(stu.mutation.RunIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SavedTrack.run"`)
+	}
+	if stu.mutation.TrackCleared() && len(stu.mutation.TrackIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SavedTrack.track"`)
+	}
+	return nil
+}
+
+func (stu *SavedTrackUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := stu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(savedtrack.Table, savedtrack.Columns, sqlgraph.NewFieldSpec(savedtrack.FieldID, field.TypeString))
+	if ps := stu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := stu.mutation.AddedAt(); ok {
+		_spec.SetField(savedtrack.FieldAddedAt, field.TypeTime, value)
+	}
+	if value, ok := stu.mutation.LinkedFromID(); ok {
+		_spec.SetField(savedtrack.FieldLinkedFromID, field.TypeString, value)
+	}
+	if value, ok := stu.mutation.IsPlayable(); ok {
+		_spec.SetField(savedtrack.FieldIsPlayable, field.TypeBool, value)
+	}
+	if stu.mutation.IsPlayableCleared() {
+		_spec.ClearField(savedtrack.FieldIsPlayable, field.TypeBool)
+	}
+	if stu.mutation.RunCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedtrack.RunTable,
+			Columns: []string{savedtrack.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := stu.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedtrack.RunTable,
+			Columns: []string{savedtrack.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if stu.mutation.TrackCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   savedtrack.TrackTable,
+			Columns: []string{savedtrack.TrackColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := stu.mutation.TrackIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   savedtrack.TrackTable,
+			Columns: []string{savedtrack.TrackColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, stu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{savedtrack.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	stu.mutation.done = true
+	return n, nil
+}
+
+// SavedTrackUpdateOne is the builder for updating a single SavedTrack entity.
+type SavedTrackUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SavedTrackMutation
+}
+
+// SetAddedAt sets the "added_at" field.
+func (stuo *SavedTrackUpdateOne) SetAddedAt(t time.Time) *SavedTrackUpdateOne {
+	stuo.mutation.SetAddedAt(t)
+	return stuo
+}
+
+// SetNillableAddedAt sets the "added_at" field if the given value is not nil.
+func (stuo *SavedTrackUpdateOne) SetNillableAddedAt(t *time.Time) *SavedTrackUpdateOne {
+	if t != nil {
+		stuo.SetAddedAt(*t)
+	}
+	return stuo
+}
+
+// SetLinkedFromID sets the "linked_from_id" field.
+func (stuo *SavedTrackUpdateOne) SetLinkedFromID(s string) *SavedTrackUpdateOne {
+	stuo.mutation.SetLinkedFromID(s)
+	return stuo
+}
+
+// SetNillableLinkedFromID sets the "linked_from_id" field if the given value is not nil.
+func (stuo *SavedTrackUpdateOne) SetNillableLinkedFromID(s *string) *SavedTrackUpdateOne {
+	if s != nil {
+		stuo.SetLinkedFromID(*s)
+	}
+	return stuo
+}
+
+// SetIsPlayable sets the "is_playable" field.
+func (stuo *SavedTrackUpdateOne) SetIsPlayable(b bool) *SavedTrackUpdateOne {
+	stuo.mutation.SetIsPlayable(b)
+	return stuo
+}
+
+// SetNillableIsPlayable sets the "is_playable" field if the given value is not nil.
+func (stuo *SavedTrackUpdateOne) SetNillableIsPlayable(b *bool) *SavedTrackUpdateOne {
+	if b != nil {
+		stuo.SetIsPlayable(*b)
+	}
+	return stuo
+}
+
+// ClearIsPlayable clears the value of the "is_playable" field.
+func (stuo *SavedTrackUpdateOne) ClearIsPlayable() *SavedTrackUpdateOne {
+	stuo.mutation.ClearIsPlayable()
+	return stuo
+}
+
+// SetRunID sets the "run" edge to the BackupRun entity by ID.
+func (stuo *SavedTrackUpdateOne) SetRunID(id string) *SavedTrackUpdateOne {
+	stuo.mutation.SetRunID(id)
+	return stuo
+}
+
+// SetRun sets the "run" edge to the BackupRun entity.
+func (stuo *SavedTrackUpdateOne) SetRun(b *BackupRun) *SavedTrackUpdateOne {
+	return stuo.SetRunID(b.ID)
+}
+
+// SetTrackID sets the "track" edge to the Track entity by ID.
+func (stuo *SavedTrackUpdateOne) SetTrackID(id string) *SavedTrackUpdateOne {
+	stuo.mutation.SetTrackID(id)
+	return stuo
+}
+
+// SetTrack sets the "track" edge to the Track entity.
+func (stuo *SavedTrackUpdateOne) SetTrack(t *Track) *SavedTrackUpdateOne {
+	return stuo.SetTrackID(t.ID)
+}
+
+// Mutation returns the SavedTrackMutation object of the builder.
+func (stuo *SavedTrackUpdateOne) Mutation() *SavedTrackMutation {
+	return stuo.mutation
+}
+
+// ClearRun clears the "run" edge to the BackupRun entity.
+func (stuo *SavedTrackUpdateOne) ClearRun() *SavedTrackUpdateOne {
+	stuo.mutation.ClearRun()
+	return stuo
+}
+
+// ClearTrack clears the "track" edge to the Track entity.
+func (stuo *SavedTrackUpdateOne) ClearTrack() *SavedTrackUpdateOne {
+	stuo.mutation.ClearTrack()
+	return stuo
+}
+
+// Where appends a list predicates to the SavedTrackUpdate builder.
+func (stuo *SavedTrackUpdateOne) Where(ps ...predicate.SavedTrack) *SavedTrackUpdateOne {
+	stuo.mutation.Where(ps...)
+	return stuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (stuo *SavedTrackUpdateOne) Select(field string, fields ...string) *SavedTrackUpdateOne {
+	stuo.fields = append([]string{field}, fields...)
+	return stuo
+}
+
+// Save executes the query and returns the updated SavedTrack entity.
+func (stuo *SavedTrackUpdateOne) Save(ctx context.Context) (*SavedTrack, error) {
+	return withHooks(ctx, stuo.sqlSave, stuo.mutation, stuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (stuo *SavedTrackUpdateOne) SaveX(ctx context.Context) *SavedTrack {
+	node, err := stuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (stuo *SavedTrackUpdateOne) Exec(ctx context.Context) error {
+	_, err := stuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (stuo *SavedTrackUpdateOne) ExecX(ctx context.Context) {
+	if err := stuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (stuo *SavedTrackUpdateOne) check() error {
+	if stuo.mutation.RunCleared() && len(stuo.mutation.RunIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SavedTrack.run"`)
+	}
+	if stuo.mutation.TrackCleared() && len(stuo.mutation.TrackIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SavedTrack.track"`)
+	}
+	return nil
+}
+
+func (stuo *SavedTrackUpdateOne) sqlSave(ctx context.Context) (_node *SavedTrack, err error) {
+	if err := stuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(savedtrack.Table, savedtrack.Columns, sqlgraph.NewFieldSpec(savedtrack.FieldID, field.TypeString))
+	id, ok := stuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SavedTrack.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := stuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, savedtrack.FieldID)
+		for _, f := range fields {
+			if !savedtrack.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != savedtrack.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := stuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := stuo.mutation.AddedAt(); ok {
+		_spec.SetField(savedtrack.FieldAddedAt, field.TypeTime, value)
+	}
+	if value, ok := stuo.mutation.LinkedFromID(); ok {
+		_spec.SetField(savedtrack.FieldLinkedFromID, field.TypeString, value)
+	}
+	if value, ok := stuo.mutation.IsPlayable(); ok {
+		_spec.SetField(savedtrack.FieldIsPlayable, field.TypeBool, value)
+	}
+	if stuo.mutation.IsPlayableCleared() {
+		_spec.ClearField(savedtrack.FieldIsPlayable, field.TypeBool)
+	}
+	if stuo.mutation.RunCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedtrack.RunTable,
+			Columns: []string{savedtrack.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := stuo.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedtrack.RunTable,
+			Columns: []string{savedtrack.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuprun.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if stuo.mutation.TrackCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   savedtrack.TrackTable,
+			Columns: []string{savedtrack.TrackColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := stuo.mutation.TrackIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   savedtrack.TrackTable,
+			Columns: []string{savedtrack.TrackColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SavedTrack{config: stuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, stuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{savedtrack.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	stuo.mutation.done = true
+	return _node, nil
+}
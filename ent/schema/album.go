@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Album holds the schema definition for the Album entity.
+// Albums are part of the catalog and shared by all backups.
+type Album struct {
+	ent.Schema
+}
+
+// Fields of the Album.
+func (Album) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique().Immutable().DefaultFunc(newID),
+		field.String("spotify_id").Unique().Immutable().NotEmpty(),
+		field.String("uri"),
+		field.String("name"),
+		field.String("album_type").Default(""),
+		field.String("release_date").Default(""),
+		field.Int("total_tracks").NonNegative().Default(0),
+		field.String("label").Default(""),
+		field.String("upc").Default(""),
+		field.Time("created_at").Immutable().Default(time.Now),
+	}
+}
+
+// Edges of the Album.
+func (Album) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("artists", Artist.Type),
+		edge.From("tracks", Track.Type).Ref("album"),
+		edge.From("saved_albums", SavedAlbum.Type).Ref("album"),
+	}
+}
@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Artist holds the schema definition for the Artist entity.
+// Artists are part of the catalog and shared by all backups.
+type Artist struct {
+	ent.Schema
+}
+
+// Fields of the Artist.
+func (Artist) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique().Immutable().DefaultFunc(newID),
+		field.String("spotify_id").Unique().Immutable().NotEmpty(),
+		field.String("uri"),
+		field.String("name"),
+		field.Strings("genres").Optional(),
+		field.Time("created_at").Immutable().Default(time.Now),
+	}
+}
+
+// Edges of the Artist.
+func (Artist) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tracks", Track.Type).Ref("artists"),
+		edge.From("albums", Album.Type).Ref("artists"),
+		edge.From("followed_artists", FollowedArtist.Type).Ref("artist"),
+	}
+}
@@ -0,0 +1,28 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// AuthState holds the schema definition for the AuthState entity. It is the
+// state of a sign in with Spotify that was started but not completed yet, so
+// the callback is accepted by any server instance.
+type AuthState struct {
+	ent.Schema
+}
+
+// Fields of the AuthState.
+func (AuthState) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique().Immutable().DefaultFunc(newID),
+		field.String("state").Unique().Immutable().NotEmpty(),
+		// expires_at is when the sign in is no longer accepted.
+		field.Time("expires_at").Immutable(),
+	}
+}
+
+// Edges of the AuthState.
+func (AuthState) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"beyerleinf/spotify-backup/ent/schema/schematype"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// BackupRun holds the schema definition for the BackupRun entity.
+// Every backup of a user's library is recorded as a run, which owns
+// everything that was backed up and records how the backup went.
+type BackupRun struct {
+	ent.Schema
+}
+
+// Fields of the BackupRun.
+func (BackupRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique().Immutable().DefaultFunc(newID),
+		// user_id is the Spotify ID of the user whose library was backed up.
+		field.String("user_id").Default(""),
+		field.Enum("trigger").Values("schedule", "manual", "api").Default("manual").Immutable(),
+		// job_id is the ID of the job that started the run, if it ran as a job.
+		field.String("job_id").Default("").Immutable(),
+		// status is partial if some collections failed and failed if none succeeded.
+		field.Enum("status").Values("running", "succeeded", "partial", "failed").Default("running"),
+		// collections maps the name of every collection that was backed up to its result.
+		field.JSON("collections", map[string]schematype.CollectionResult{}).Default(map[string]schematype.CollectionResult{}),
+		field.Int("api_calls").NonNegative().Default(0),
+		field.String("error").Default(""),
+		// checkpoint is the progress of playlists that were only partially
+		// backed up, so the run can be resumed if it failed.
+		field.JSON("checkpoint", schematype.Checkpoint{}).Optional(),
+		// resumes is how often the run was resumed after it failed.
+		field.Int("resumes").NonNegative().Default(0),
+		// pinned runs are never deleted by pruning.
+		field.Bool("pinned").Default(false),
+		// note and labels let users describe a run, e.g. "before cleanup".
+		field.String("note").Default(""),
+		field.Strings("labels").Default([]string{}),
+		field.Time("started_at").Immutable().Default(time.Now),
+		field.Time("finished_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the BackupRun.
+func (BackupRun) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("playlist_snapshots", PlaylistSnapshot.Type),
+		edge.To("saved_tracks", SavedTrack.Type),
+		edge.To("saved_albums", SavedAlbum.Type),
+		edge.To("saved_shows", SavedShow.Type),
+		edge.To("saved_episodes", SavedEpisode.Type),
+		edge.To("saved_audiobooks", SavedAudiobook.Type),
+		edge.To("followed_artists", FollowedArtist.Type),
+	}
+}
+
+// Indexes of the BackupRun.
+func (BackupRun) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("started_at"),
+	}
+}
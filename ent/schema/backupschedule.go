@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// BackupSchedule holds the schema definition for the BackupSchedule entity.
+// It defines when the library of a user is backed up automatically.
+type BackupSchedule struct {
+	ent.Schema
+}
+
+// Fields of the BackupSchedule.
+func (BackupSchedule) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique().Immutable().DefaultFunc(newID),
+		// user_id is the Spotify ID of the user whose library is backed up.
+		field.String("user_id").Unique().Immutable().NotEmpty(),
+		// cron is a standard cron expression with five fields or a
+		// descriptor like @daily, evaluated in time_zone.
+		field.String("cron").NotEmpty(),
+		field.String("time_zone").Default("UTC"),
+		// misfire_policy decides whether a backup that was missed, e.g. because
+		// the server was down, is skipped or run as soon as possible.
+		field.Enum("misfire_policy").Values("skip", "catch_up").Default("catch_up"),
+		field.Bool("enabled").Default(true),
+		field.Time("next_run_at").Optional().Nillable(),
+		field.Time("last_run_at").Optional().Nillable(),
+		field.Time("created_at").Immutable().Default(time.Now),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the BackupSchedule.
+func (BackupSchedule) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Episode holds the schema definition for the Episode entity.
+// Episodes are part of the catalog and shared by all backups.
+type Episode struct {
+	ent.Schema
+}
+
+// Fields of the Episode.
+func (Episode) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique().Immutable().DefaultFunc(newID),
+		field.String("spotify_id").Unique().Immutable().NotEmpty(),
+		field.String("uri"),
+		field.String("name"),
+		field.Int("duration_ms").NonNegative(),
+		field.Bool("explicit").Default(false),
+		field.String("release_date").Default(""),
+		field.Time("created_at").Immutable().Default(time.Now),
+	}
+}
+
+// Edges of the Episode.
+func (Episode) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("show", Show.Type).Unique(),
+		edge.From("snapshot_items", SnapshotItem.Type).Ref("episode"),
+		edge.From("saved_episodes", SavedEpisode.Type).Ref("episode"),
+	}
+}
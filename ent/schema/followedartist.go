@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// FollowedArtist holds the schema definition for the FollowedArtist entity.
+// All FollowedArtists of a run form the set of artists the user followed
+// at the time of that backup.
+type FollowedArtist struct {
+	ent.Schema
+}
+
+// Fields of the FollowedArtist.
+func (FollowedArtist) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique().Immutable().DefaultFunc(newID),
+		field.Int("followers").NonNegative().Default(0),
+		field.Int("popularity").NonNegative().Default(0),
+		field.Time("created_at").Immutable().Default(time.Now),
+	}
+}
+
+// Edges of the FollowedArtist.
+func (FollowedArtist) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("run", BackupRun.Type).Ref("followed_artists").Unique().Required(),
+		edge.To("artist", Artist.Type).Unique().Required(),
+	}
+}
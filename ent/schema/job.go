@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"encoding/json"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Job holds the schema definition for the Job entity.
+// Jobs are long running tasks like backups that are queued in
+// the database and run by the workers of any server instance.
+type Job struct {
+	ent.Schema
+}
+
+// Fields of the Job.
+func (Job) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique().Immutable().DefaultFunc(newID),
+		field.String("type").Immutable().NotEmpty(),
+		// key prevents queueing a job while another job with the same key is
+		// pending or running, e.g. two backups of the same user. Empty keys
+		// don't conflict.
+		field.String("key").Immutable().Default(""),
+		field.Enum("status").Values("pending", "running", "succeeded", "failed", "canceled").Default("pending"),
+		field.JSON("payload", json.RawMessage{}).Optional(),
+		field.JSON("result", json.RawMessage{}).Optional(),
+		field.String("error").Default(""),
+		field.Int("attempts").NonNegative().Default(0),
+		field.Int("max_attempts").Positive(),
+		// run_after is the earliest time the job may run. It is pushed back
+		// on every failed attempt.
+		field.Time("run_after").Default(time.Now),
+		// locked_by identifies the worker running the job. It renews locked_at
+		// while it is running, so jobs of crashed workers can be run again.
+		field.String("locked_by").Default(""),
+		field.Time("locked_at").Optional().Nillable(),
+		field.Time("created_at").Immutable().Default(time.Now),
+		field.Time("started_at").Optional().Nillable(),
+		field.Time("finished_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the Job.
+func (Job) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Job.
+func (Job) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "run_after"),
+		index.Fields("key").
+			Unique().
+			Annotations(entsql.IndexWhere("key <> '' AND status IN ('pending', 'running')")),
+	}
+}
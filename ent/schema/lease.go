@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// Lease holds the schema definition for the Lease entity. A lease makes
+// a single server instance the leader of a task like scheduling backups.
+type Lease struct {
+	ent.Schema
+}
+
+// Fields of the Lease.
+func (Lease) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique().Immutable().DefaultFunc(newID),
+		// name identifies the task the lease is held for.
+		field.String("name").Unique().Immutable().NotEmpty(),
+		// holder is the ID of the server instance that holds the lease.
+		field.String("holder").NotEmpty(),
+		field.Time("acquired_at").Default(time.Now),
+		// expires_at is renewed by the holder. Once it passed, another
+		// instance may take over the lease.
+		field.Time("expires_at"),
+	}
+}
+
+// Edges of the Lease.
+func (Lease) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,9 @@
+package schema
+
+import gonanoid "github.com/matoous/go-nanoid/v2"
+
+// newID generates the default primary key for all entities.
+func newID() string {
+	id, _ := gonanoid.New()
+	return id
+}
@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Playlist holds the schema definition for the Playlist entity.
+// A Playlist only identifies a Spotify playlist. Everything that
+// can change between backups is stored in a [PlaylistSnapshot].
+type Playlist struct {
+	ent.Schema
+}
+
+// Fields of the Playlist.
+func (Playlist) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique().Immutable().DefaultFunc(newID),
+		field.String("spotify_id").Unique().Immutable().NotEmpty(),
+		field.Time("created_at").Immutable().Default(time.Now),
+	}
+}
+
+// Edges of the Playlist.
+func (Playlist) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("snapshots", PlaylistSnapshot.Type),
+	}
+}
@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PlaylistSnapshot holds the schema definition for the PlaylistSnapshot entity.
+// It captures a playlist's metadata and items at the time of a backup.
+type PlaylistSnapshot struct {
+	ent.Schema
+}
+
+// Fields of the PlaylistSnapshot.
+func (PlaylistSnapshot) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique().Immutable().DefaultFunc(newID),
+		field.String("name"),
+		field.String("description").Default(""),
+		field.String("owner_id"),
+		field.String("owner_name").Default(""),
+		field.Bool("collaborative").Default(false),
+		field.Bool("public").Optional().Nillable(),
+		field.String("snapshot_id"),
+		field.Int("total").NonNegative(),
+		// complete is false while the items are still being fetched.
+		// Incomplete snapshots are never reused by later backups.
+		field.Bool("complete").Default(true),
+		// pinned snapshots are never deleted by pruning.
+		field.Bool("pinned").Default(false),
+		// note and labels let users describe a snapshot, e.g. "before cleanup".
+		field.String("note").Default(""),
+		field.Strings("labels").Default([]string{}),
+		field.Time("created_at").Immutable().Default(time.Now),
+	}
+}
+
+// Edges of the PlaylistSnapshot.
+func (PlaylistSnapshot) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("playlist", Playlist.Type).Ref("snapshots").Unique().Required(),
+		// runs are all backup runs the snapshot is part of. A playlist that didn't
+		// change since the last backup is not stored again, instead its latest
+		// snapshot is linked to the new run as well.
+		edge.From("runs", BackupRun.Type).Ref("playlist_snapshots"),
+		edge.To("items", SnapshotItem.Type),
+	}
+}
+
+// Indexes of the PlaylistSnapshot.
+func (PlaylistSnapshot) Indexes() []ent.Index {
+	return []ent.Index{
+		// Backups look up the latest snapshot of every playlist.
+		index.Edges("playlist"),
+	}
+}
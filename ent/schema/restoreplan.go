@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"beyerleinf/spotify-backup/ent/schema/schematype"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RestorePlan holds the schema definition for the RestorePlan entity.
+// A plan lists every change a restore would make to the user's library,
+// so it can be reviewed before it is applied exactly as planned.
+type RestorePlan struct {
+	ent.Schema
+}
+
+// Fields of the RestorePlan.
+func (RestorePlan) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique().Immutable().DefaultFunc(newID),
+		// user_id is the Spotify ID of the user whose library is restored.
+		field.String("user_id").Immutable().NotEmpty(),
+		// run_id is the backup run the plan restores from, if any.
+		field.String("run_id").Immutable().Default(""),
+		// strategy decides what happens to playlists that still exist:
+		// they are overwritten, missing items are appended or a copy is created.
+		field.Enum("strategy").Values("overwrite", "merge", "copy").Default("overwrite").Immutable(),
+		// status is stale if the library changed since the plan was made.
+		field.Enum("status").Values("pending", "applying", "applied", "failed", "stale").Default("pending"),
+		field.JSON("playlists", []schematype.PlaylistRestore{}).Default([]schematype.PlaylistRestore{}),
+		field.JSON("collections", []schematype.CollectionRestore{}).Default([]schematype.CollectionRestore{}),
+		// drift describes the changes that made the plan stale.
+		field.Strings("drift").Default([]string{}),
+		field.String("error").Default(""),
+		field.Time("created_at").Immutable().Default(time.Now),
+		field.Time("applied_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the RestorePlan.
+func (RestorePlan) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the RestorePlan.
+func (RestorePlan) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("run_id"),
+	}
+}
@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// RetentionPolicy holds the schema definition for the RetentionPolicy entity.
+// It defines how long the backups of a user are kept. Recent backups are kept
+// completely, older ones are thinned out to one per day, week and month.
+type RetentionPolicy struct {
+	ent.Schema
+}
+
+// Fields of the RetentionPolicy.
+func (RetentionPolicy) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique().Immutable().DefaultFunc(newID),
+		// user_id is the Spotify ID of the user whose backups are pruned.
+		field.String("user_id").Unique().Immutable().NotEmpty(),
+		// keep_all_days is how many days all backups are kept.
+		field.Int("keep_all_days").NonNegative(),
+		// keep_daily_weeks is how many weeks after that one backup per day is kept.
+		field.Int("keep_daily_weeks").NonNegative(),
+		// keep_weekly_months is how many months after that one backup per week
+		// is kept. Older backups are kept once per month forever.
+		field.Int("keep_weekly_months").NonNegative(),
+		field.Time("created_at").Immutable().Default(time.Now),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the RetentionPolicy.
+func (RetentionPolicy) Edges() []ent.Edge {
+	return nil
+}
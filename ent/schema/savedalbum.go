@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// SavedAlbum holds the schema definition for the SavedAlbum entity.
+// It is an album in the user's library at the time of a backup.
+type SavedAlbum struct {
+	ent.Schema
+}
+
+// Fields of the SavedAlbum.
+func (SavedAlbum) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique().Immutable().DefaultFunc(newID),
+		field.Time("added_at"),
+		field.Time("created_at").Immutable().Default(time.Now),
+	}
+}
+
+// Edges of the SavedAlbum.
+func (SavedAlbum) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("run", BackupRun.Type).Ref("saved_albums").Unique().Required(),
+		edge.To("album", Album.Type).Unique().Required(),
+	}
+}
@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// SavedAudiobook holds the schema definition for the SavedAudiobook entity.
+// It is an audiobook in the user's library at the time of a backup.
+type SavedAudiobook struct {
+	ent.Schema
+}
+
+// Fields of the SavedAudiobook.
+func (SavedAudiobook) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique().Immutable().DefaultFunc(newID),
+		// Spotify does not always report when an audiobook was saved.
+		field.Time("added_at").Optional().Nillable(),
+		field.String("spotify_id"),
+		field.String("uri"),
+		field.String("name"),
+		field.Strings("authors").Optional(),
+		field.Strings("narrators").Optional(),
+		field.String("publisher").Default(""),
+		field.Int("total_chapters").NonNegative(),
+		field.Time("created_at").Immutable().Default(time.Now),
+	}
+}
+
+// Edges of the SavedAudiobook.
+func (SavedAudiobook) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("run", BackupRun.Type).Ref("saved_audiobooks").Unique().Required(),
+	}
+}
@@ -0,0 +1,35 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// SavedEpisode holds the schema definition for the SavedEpisode entity.
+// It is an episode in the user's library at the time of a backup,
+// including how far the user listened to it.
+type SavedEpisode struct {
+	ent.Schema
+}
+
+// Fields of the SavedEpisode.
+func (SavedEpisode) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique().Immutable().DefaultFunc(newID),
+		field.Time("added_at"),
+		field.Bool("fully_played").Default(false),
+		field.Int("resume_position_ms").NonNegative().Default(0),
+		field.Time("created_at").Immutable().Default(time.Now),
+	}
+}
+
+// Edges of the SavedEpisode.
+func (SavedEpisode) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("run", BackupRun.Type).Ref("saved_episodes").Unique().Required(),
+		edge.To("episode", Episode.Type).Unique().Required(),
+	}
+}
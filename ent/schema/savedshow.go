@@ -0,0 +1,33 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// SavedShow holds the schema definition for the SavedShow entity.
+// It is a podcast the user followed at the time of a backup.
+type SavedShow struct {
+	ent.Schema
+}
+
+// Fields of the SavedShow.
+func (SavedShow) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique().Immutable().DefaultFunc(newID),
+		field.Time("added_at"),
+		field.Int("total_episodes").NonNegative(),
+		field.Time("created_at").Immutable().Default(time.Now),
+	}
+}
+
+// Edges of the SavedShow.
+func (SavedShow) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("run", BackupRun.Type).Ref("saved_shows").Unique().Required(),
+		edge.To("show", Show.Type).Unique().Required(),
+	}
+}
@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// SavedTrack holds the schema definition for the SavedTrack entity.
+// It is a track in the user's Liked Songs at the time of a backup.
+type SavedTrack struct {
+	ent.Schema
+}
+
+// Fields of the SavedTrack.
+func (SavedTrack) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique().Immutable().DefaultFunc(newID),
+		field.Time("added_at"),
+		// linked_from_id is the ID of the track that was originally saved if
+		// Spotify relinked it to another track available in the user's market.
+		field.String("linked_from_id").Default(""),
+		field.Bool("is_playable").Optional().Nillable(),
+		field.Time("created_at").Immutable().Default(time.Now),
+	}
+}
+
+// Edges of the SavedTrack.
+func (SavedTrack) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("run", BackupRun.Type).Ref("saved_tracks").Unique().Required(),
+		edge.To("track", Track.Type).Unique().Required(),
+	}
+}
@@ -0,0 +1,30 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// SchemaMigration holds the schema definition for the SchemaMigration entity.
+// It records a data migration that was applied to the database, so each
+// migration runs only once.
+type SchemaMigration struct {
+	ent.Schema
+}
+
+// Fields of the SchemaMigration.
+func (SchemaMigration) Fields() []ent.Field {
+	return []ent.Field{
+		// id is the version of the migration.
+		field.Int("id").Positive().Immutable(),
+		field.String("name").NotEmpty(),
+		field.Time("applied_at").Immutable().Default(time.Now),
+	}
+}
+
+// Edges of the SchemaMigration.
+func (SchemaMigration) Edges() []ent.Edge {
+	return nil
+}
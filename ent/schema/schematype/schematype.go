@@ -0,0 +1,94 @@
+// Package schematype contains the Go types of JSON fields used in the ent schema.
+package schematype
+
+import "time"
+
+// CollectionResult is the outcome of backing up a single collection,
+// like the user's playlists or Liked Songs, as part of a backup run.
+type CollectionResult struct {
+	// Count is the number of items that were backed up.
+	Count int `json:"count"`
+	// APICalls is the number of Web API requests made to back up the collection.
+	APICalls int `json:"api_calls"`
+	// Error describes why backing up the collection failed. It is empty on success.
+	Error string `json:"error,omitempty"`
+}
+
+// Checkpoint is the progress of a backup run. It is saved after every page
+// of playlist items, so a run that failed can be resumed where it stopped.
+type Checkpoint struct {
+	// Playlists maps the Spotify ID of every playlist whose items are
+	// being fetched to how far the backup got.
+	Playlists map[string]PlaylistCheckpoint `json:"playlists,omitempty"`
+}
+
+// PlaylistCheckpoint is the progress of backing up the items of a playlist.
+type PlaylistCheckpoint struct {
+	// SnapshotID is the playlist's snapshot_id. A checkpoint is discarded
+	// if the playlist changed, since its offset no longer applies.
+	SnapshotID string `json:"snapshot_id"`
+	// Snapshot is the ID of the incomplete [ent.PlaylistSnapshot] the items are stored in.
+	Snapshot string `json:"snapshot"`
+	// Offset is the number of items that have been stored.
+	Offset int `json:"offset"`
+}
+
+// PlaylistRestore is the planned restore of a playlist snapshot.
+type PlaylistRestore struct {
+	// SnapshotID is the ID of the restored [ent.PlaylistSnapshot].
+	SnapshotID string `json:"snapshot_id"`
+	// PlaylistID is the Spotify ID of the backed up playlist.
+	PlaylistID string `json:"playlist_id"`
+	// Action is create, overwrite, append, follow or unchanged.
+	Action string `json:"action"`
+	// OwnerID is the Spotify ID of the user who owns the playlist if a copy
+	// of a playlist of another user is created.
+	OwnerID string `json:"owner_id,omitempty"`
+	// LiveSnapshotID is the snapshot_id of the playlist in the user's library
+	// when the plan was made. It is empty if the playlist was not in the library.
+	LiveSnapshotID string `json:"live_snapshot_id,omitempty"`
+
+	Name          string `json:"name"`
+	Description   string `json:"description"`
+	Public        *bool  `json:"public,omitempty"`
+	Collaborative bool   `json:"collaborative"`
+
+	// URIs are the items that are added to the playlist, in order.
+	URIs []string `json:"uris"`
+	// Removed is the number of items that are removed by overwriting the playlist.
+	Removed int `json:"removed"`
+	// Unavailable are the added tracks that are not available in the user's market.
+	Unavailable []RestoreItem `json:"unavailable,omitempty"`
+	// LocalFiles are the URIs of local files, which cannot be restored.
+	LocalFiles []string `json:"local_files,omitempty"`
+
+	// TargetID is the Spotify ID of the playlist that is changed. It is set
+	// when the plan is made, unless the playlist is created while applying it.
+	TargetID string `json:"target_id,omitempty"`
+	// Added is the number of URIs that have been added while applying the plan.
+	Added int  `json:"added"`
+	Done  bool `json:"done"`
+}
+
+// CollectionRestore is the planned restore of a saved collection, like Liked Songs.
+type CollectionRestore struct {
+	Collection string `json:"collection"`
+	// Save are the items that are saved or followed, oldest first.
+	Save []RestoreItem `json:"save"`
+	// Remove are the items that are removed or unfollowed.
+	Remove []RestoreItem `json:"remove"`
+	// Unavailable are the saved tracks that are not available in the user's market.
+	Unavailable []RestoreItem `json:"unavailable,omitempty"`
+	// Fingerprint identifies the state of the collection in the user's
+	// library when the plan was made.
+	Fingerprint string `json:"fingerprint"`
+	Done        bool   `json:"done"`
+}
+
+// RestoreItem is a track, album or artist of a restore plan.
+type RestoreItem struct {
+	URI  string `json:"uri"`
+	Name string `json:"name"`
+	// AddedAt is the time a track is saved with, to restore the order of Liked Songs.
+	AddedAt *time.Time `json:"added_at,omitempty"`
+}
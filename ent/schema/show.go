@@ -0,0 +1,35 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Show holds the schema definition for the Show entity.
+// Shows are part of the catalog and shared by all backups.
+type Show struct {
+	ent.Schema
+}
+
+// Fields of the Show.
+func (Show) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique().Immutable().DefaultFunc(newID),
+		field.String("spotify_id").Unique().Immutable().NotEmpty(),
+		field.String("uri"),
+		field.String("name"),
+		field.String("publisher").Default(""),
+		field.Time("created_at").Immutable().Default(time.Now),
+	}
+}
+
+// Edges of the Show.
+func (Show) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("episodes", Episode.Type).Ref("show"),
+		edge.From("saved_shows", SavedShow.Type).Ref("show"),
+	}
+}
@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SnapshotItem holds the schema definition for the SnapshotItem entity.
+// It is a single track or episode at a position inside a [PlaylistSnapshot].
+// The metadata of the track or episode is stored in the catalog, except for
+// local files which only exist on the user's devices and are identified by their URI.
+type SnapshotItem struct {
+	ent.Schema
+}
+
+// Fields of the SnapshotItem.
+func (SnapshotItem) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique().Immutable().DefaultFunc(newID),
+		field.Int("position").NonNegative(),
+		field.Time("added_at").Optional().Nillable(),
+		field.String("added_by").Default(""),
+		field.Bool("is_local").Default(false),
+		field.Enum("type").Values("track", "episode"),
+		field.String("uri"),
+		// linked_from_id is the ID of the track that was originally added if
+		// Spotify relinked it to another track available in the user's market.
+		field.String("linked_from_id").Default(""),
+		field.Bool("is_playable").Optional().Nillable(),
+	}
+}
+
+// Edges of the SnapshotItem.
+func (SnapshotItem) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("snapshot", PlaylistSnapshot.Type).Ref("items").Unique().Required(),
+		edge.To("track", Track.Type).Unique(),
+		edge.To("episode", Episode.Type).Unique(),
+	}
+}
+
+// Indexes of the SnapshotItem.
+func (SnapshotItem) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("position").Edges("snapshot").Unique(),
+	}
+}
@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// SpotifyToken holds the schema definition for the SpotifyToken entity. It
+// stores the credentials for the Spotify API in the database, so every server
+// instance uses and refreshes the same token.
+type SpotifyToken struct {
+	ent.Schema
+}
+
+// Fields of the SpotifyToken.
+func (SpotifyToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique().Immutable().DefaultFunc(newID),
+		// name identifies the credentials, since only one account is signed in.
+		field.String("name").Unique().Immutable().NotEmpty(),
+		// token is the encrypted access and refresh token.
+		field.Bytes("token").Sensitive().NotEmpty(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the SpotifyToken.
+func (SpotifyToken) Edges() []ent.Edge {
+	return nil
+}
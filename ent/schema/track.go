@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Track holds the schema definition for the Track entity.
+// Tracks are part of the catalog and shared by all backups,
+// so their metadata is only stored once.
+type Track struct {
+	ent.Schema
+}
+
+// Fields of the Track.
+func (Track) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique().Immutable().DefaultFunc(newID),
+		field.String("spotify_id").Unique().Immutable().NotEmpty(),
+		field.String("uri"),
+		field.String("name"),
+		field.Int("duration_ms").NonNegative(),
+		field.Bool("explicit").Default(false),
+		field.Int("disc_number").NonNegative().Default(0),
+		field.Int("track_number").NonNegative().Default(0),
+		field.String("isrc").Default(""),
+		field.Time("created_at").Immutable().Default(time.Now),
+	}
+}
+
+// Edges of the Track.
+func (Track) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("album", Album.Type).Unique(),
+		edge.To("artists", Artist.Type),
+		// The references below let pruning find tracks that are no longer used.
+		edge.From("snapshot_items", SnapshotItem.Type).Ref("track"),
+		edge.From("saved_tracks", SavedTrack.Type).Ref("track"),
+	}
+}
+
+// Indexes of the Track.
+func (Track) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("isrc"),
+	}
+}
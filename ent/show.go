@@ -0,0 +1,190 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/show"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Show is the model entity for the Show schema.
+type Show struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// SpotifyID holds the value of the "spotify_id" field.
+	SpotifyID string `json:"spotify_id,omitempty"`
+	// URI holds the value of the "uri" field.
+	URI string `json:"uri,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Publisher holds the value of the "publisher" field.
+	Publisher string `json:"publisher,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ShowQuery when eager-loading is set.
+	Edges        ShowEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ShowEdges holds the relations/edges for other nodes in the graph.
+type ShowEdges struct {
+	// Episodes holds the value of the episodes edge.
+	Episodes []*Episode `json:"episodes,omitempty"`
+	// SavedShows holds the value of the saved_shows edge.
+	SavedShows []*SavedShow `json:"saved_shows,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// EpisodesOrErr returns the Episodes value or an error if the edge
+// was not loaded in eager-loading.
+func (e ShowEdges) EpisodesOrErr() ([]*Episode, error) {
+	if e.loadedTypes[0] {
+		return e.Episodes, nil
+	}
+	return nil, &NotLoadedError{edge: "episodes"}
+}
+
+// SavedShowsOrErr returns the SavedShows value or an error if the edge
+// was not loaded in eager-loading.
+func (e ShowEdges) SavedShowsOrErr() ([]*SavedShow, error) {
+	if e.loadedTypes[1] {
+		return e.SavedShows, nil
+	}
+	return nil, &NotLoadedError{edge: "saved_shows"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Show) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case show.FieldID, show.FieldSpotifyID, show.FieldURI, show.FieldName, show.FieldPublisher:
+			values[i] = new(sql.NullString)
+		case show.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Show fields.
+func (s *Show) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case show.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				s.ID = value.String
+			}
+		case show.FieldSpotifyID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field spotify_id", values[i])
+			} else if value.Valid {
+				s.SpotifyID = value.String
+			}
+		case show.FieldURI:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field uri", values[i])
+			} else if value.Valid {
+				s.URI = value.String
+			}
+		case show.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				s.Name = value.String
+			}
+		case show.FieldPublisher:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field publisher", values[i])
+			} else if value.Valid {
+				s.Publisher = value.String
+			}
+		case show.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				s.CreatedAt = value.Time
+			}
+		default:
+			s.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Show.
+// This includes values selected through modifiers, order, etc.
+func (s *Show) Value(name string) (ent.Value, error) {
+	return s.selectValues.Get(name)
+}
+
+// QueryEpisodes queries the "episodes" edge of the Show entity.
+func (s *Show) QueryEpisodes() *EpisodeQuery {
+	return NewShowClient(s.config).QueryEpisodes(s)
+}
+
+// QuerySavedShows queries the "saved_shows" edge of the Show entity.
+func (s *Show) QuerySavedShows() *SavedShowQuery {
+	return NewShowClient(s.config).QuerySavedShows(s)
+}
+
+// Update returns a builder for updating this Show.
+// Note that you need to call Show.Unwrap() before calling this method if this Show
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (s *Show) Update() *ShowUpdateOne {
+	return NewShowClient(s.config).UpdateOne(s)
+}
+
+// Unwrap unwraps the Show entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (s *Show) Unwrap() *Show {
+	_tx, ok := s.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Show is not a transactional entity")
+	}
+	s.config.driver = _tx.drv
+	return s
+}
+
+// String implements the fmt.Stringer.
+func (s *Show) String() string {
+	var builder strings.Builder
+	builder.WriteString("Show(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", s.ID))
+	builder.WriteString("spotify_id=")
+	builder.WriteString(s.SpotifyID)
+	builder.WriteString(", ")
+	builder.WriteString("uri=")
+	builder.WriteString(s.URI)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(s.Name)
+	builder.WriteString(", ")
+	builder.WriteString("publisher=")
+	builder.WriteString(s.Publisher)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(s.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Shows is a parsable slice of Show.
+type Shows []*Show
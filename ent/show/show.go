@@ -0,0 +1,153 @@
+// Code generated by ent, DO NOT EDIT.
+
+package show
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the show type in the database.
+	Label = "show"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSpotifyID holds the string denoting the spotify_id field in the database.
+	FieldSpotifyID = "spotify_id"
+	// FieldURI holds the string denoting the uri field in the database.
+	FieldURI = "uri"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldPublisher holds the string denoting the publisher field in the database.
+	FieldPublisher = "publisher"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeEpisodes holds the string denoting the episodes edge name in mutations.
+	EdgeEpisodes = "episodes"
+	// EdgeSavedShows holds the string denoting the saved_shows edge name in mutations.
+	EdgeSavedShows = "saved_shows"
+	// Table holds the table name of the show in the database.
+	Table = "shows"
+	// EpisodesTable is the table that holds the episodes relation/edge.
+	EpisodesTable = "episodes"
+	// EpisodesInverseTable is the table name for the Episode entity.
+	// It exists in this package in order to avoid circular dependency with the "episode" package.
+	EpisodesInverseTable = "episodes"
+	// EpisodesColumn is the table column denoting the episodes relation/edge.
+	EpisodesColumn = "episode_show"
+	// SavedShowsTable is the table that holds the saved_shows relation/edge.
+	SavedShowsTable = "saved_shows"
+	// SavedShowsInverseTable is the table name for the SavedShow entity.
+	// It exists in this package in order to avoid circular dependency with the "savedshow" package.
+	SavedShowsInverseTable = "saved_shows"
+	// SavedShowsColumn is the table column denoting the saved_shows relation/edge.
+	SavedShowsColumn = "saved_show_show"
+)
+
+// Columns holds all SQL columns for show fields.
+var Columns = []string{
+	FieldID,
+	FieldSpotifyID,
+	FieldURI,
+	FieldName,
+	FieldPublisher,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// SpotifyIDValidator is a validator for the "spotify_id" field. It is called by the builders before save.
+	SpotifyIDValidator func(string) error
+	// DefaultPublisher holds the default value on creation for the "publisher" field.
+	DefaultPublisher string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() string
+)
+
+// OrderOption defines the ordering options for the Show queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// BySpotifyID orders the results by the spotify_id field.
+func BySpotifyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpotifyID, opts...).ToFunc()
+}
+
+// ByURI orders the results by the uri field.
+func ByURI(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldURI, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByPublisher orders the results by the publisher field.
+func ByPublisher(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPublisher, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByEpisodesCount orders the results by episodes count.
+func ByEpisodesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEpisodesStep(), opts...)
+	}
+}
+
+// ByEpisodes orders the results by episodes terms.
+func ByEpisodes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEpisodesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySavedShowsCount orders the results by saved_shows count.
+func BySavedShowsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSavedShowsStep(), opts...)
+	}
+}
+
+// BySavedShows orders the results by saved_shows terms.
+func BySavedShows(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSavedShowsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newEpisodesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EpisodesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, EpisodesTable, EpisodesColumn),
+	)
+}
+func newSavedShowsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SavedShowsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, SavedShowsTable, SavedShowsColumn),
+	)
+}
@@ -0,0 +1,358 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/episode"
+	"beyerleinf/spotify-backup/ent/savedshow"
+	"beyerleinf/spotify-backup/ent/show"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ShowCreate is the builder for creating a Show entity.
+type ShowCreate struct {
+	config
+	mutation *ShowMutation
+	hooks    []Hook
+}
+
+// SetSpotifyID sets the "spotify_id" field.
+func (sc *ShowCreate) SetSpotifyID(s string) *ShowCreate {
+	sc.mutation.SetSpotifyID(s)
+	return sc
+}
+
+// SetURI sets the "uri" field.
+func (sc *ShowCreate) SetURI(s string) *ShowCreate {
+	sc.mutation.SetURI(s)
+	return sc
+}
+
+// SetName sets the "name" field.
+func (sc *ShowCreate) SetName(s string) *ShowCreate {
+	sc.mutation.SetName(s)
+	return sc
+}
+
+// SetPublisher sets the "publisher" field.
+func (sc *ShowCreate) SetPublisher(s string) *ShowCreate {
+	sc.mutation.SetPublisher(s)
+	return sc
+}
+
+// SetNillablePublisher sets the "publisher" field if the given value is not nil.
+func (sc *ShowCreate) SetNillablePublisher(s *string) *ShowCreate {
+	if s != nil {
+		sc.SetPublisher(*s)
+	}
+	return sc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sc *ShowCreate) SetCreatedAt(t time.Time) *ShowCreate {
+	sc.mutation.SetCreatedAt(t)
+	return sc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sc *ShowCreate) SetNillableCreatedAt(t *time.Time) *ShowCreate {
+	if t != nil {
+		sc.SetCreatedAt(*t)
+	}
+	return sc
+}
+
+// SetID sets the "id" field.
+func (sc *ShowCreate) SetID(s string) *ShowCreate {
+	sc.mutation.SetID(s)
+	return sc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (sc *ShowCreate) SetNillableID(s *string) *ShowCreate {
+	if s != nil {
+		sc.SetID(*s)
+	}
+	return sc
+}
+
+// AddEpisodeIDs adds the "episodes" edge to the Episode entity by IDs.
+func (sc *ShowCreate) AddEpisodeIDs(ids ...string) *ShowCreate {
+	sc.mutation.AddEpisodeIDs(ids...)
+	return sc
+}
+
+// AddEpisodes adds the "episodes" edges to the Episode entity.
+func (sc *ShowCreate) AddEpisodes(e ...*Episode) *ShowCreate {
+	ids := make([]string, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return sc.AddEpisodeIDs(ids...)
+}
+
+// AddSavedShowIDs adds the "saved_shows" edge to the SavedShow entity by IDs.
+func (sc *ShowCreate) AddSavedShowIDs(ids ...string) *ShowCreate {
+	sc.mutation.AddSavedShowIDs(ids...)
+	return sc
+}
+
+// AddSavedShows adds the "saved_shows" edges to the SavedShow entity.
+func (sc *ShowCreate) AddSavedShows(s ...*SavedShow) *ShowCreate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return sc.AddSavedShowIDs(ids...)
+}
+
+// Mutation returns the ShowMutation object of the builder.
+func (sc *ShowCreate) Mutation() *ShowMutation {
+	return sc.mutation
+}
+
+// Save creates the Show in the database.
+func (sc *ShowCreate) Save(ctx context.Context) (*Show, error) {
+	sc.defaults()
+	return withHooks(ctx, sc.sqlSave, sc.mutation, sc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sc *ShowCreate) SaveX(ctx context.Context) *Show {
+	v, err := sc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sc *ShowCreate) Exec(ctx context.Context) error {
+	_, err := sc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sc *ShowCreate) ExecX(ctx context.Context) {
+	if err := sc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sc *ShowCreate) defaults() {
+	if _, ok := sc.mutation.Publisher(); !ok {
+		v := show.DefaultPublisher
+		sc.mutation.SetPublisher(v)
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		v := show.DefaultCreatedAt()
+		sc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sc.mutation.ID(); !ok {
+		v := show.DefaultID()
+		sc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sc *ShowCreate) check() error {
+	if _, ok := sc.mutation.SpotifyID(); !ok {
+		return &ValidationError{Name: "spotify_id", err: errors.New(`ent: missing required field "Show.spotify_id"`)}
+	}
+	if v, ok := sc.mutation.SpotifyID(); ok {
+		if err := show.SpotifyIDValidator(v); err != nil {
+			return &ValidationError{Name: "spotify_id", err: fmt.Errorf(`ent: validator failed for field "Show.spotify_id": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.URI(); !ok {
+		return &ValidationError{Name: "uri", err: errors.New(`ent: missing required field "Show.uri"`)}
+	}
+	if _, ok := sc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Show.name"`)}
+	}
+	if _, ok := sc.mutation.Publisher(); !ok {
+		return &ValidationError{Name: "publisher", err: errors.New(`ent: missing required field "Show.publisher"`)}
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Show.created_at"`)}
+	}
+	return nil
+}
+
+func (sc *ShowCreate) sqlSave(ctx context.Context) (*Show, error) {
+	if err := sc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Show.ID type: %T", _spec.ID.Value)
+		}
+	}
+	sc.mutation.id = &_node.ID
+	sc.mutation.done = true
+	return _node, nil
+}
+
+func (sc *ShowCreate) createSpec() (*Show, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Show{config: sc.config}
+		_spec = sqlgraph.NewCreateSpec(show.Table, sqlgraph.NewFieldSpec(show.FieldID, field.TypeString))
+	)
+	if id, ok := sc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := sc.mutation.SpotifyID(); ok {
+		_spec.SetField(show.FieldSpotifyID, field.TypeString, value)
+		_node.SpotifyID = value
+	}
+	if value, ok := sc.mutation.URI(); ok {
+		_spec.SetField(show.FieldURI, field.TypeString, value)
+		_node.URI = value
+	}
+	if value, ok := sc.mutation.Name(); ok {
+		_spec.SetField(show.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := sc.mutation.Publisher(); ok {
+		_spec.SetField(show.FieldPublisher, field.TypeString, value)
+		_node.Publisher = value
+	}
+	if value, ok := sc.mutation.CreatedAt(); ok {
+		_spec.SetField(show.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := sc.mutation.EpisodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   show.EpisodesTable,
+			Columns: []string{show.EpisodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.SavedShowsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   show.SavedShowsTable,
+			Columns: []string{show.SavedShowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedshow.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ShowCreateBulk is the builder for creating many Show entities in bulk.
+type ShowCreateBulk struct {
+	config
+	err      error
+	builders []*ShowCreate
+}
+
+// Save creates the Show entities in the database.
+func (scb *ShowCreateBulk) Save(ctx context.Context) ([]*Show, error) {
+	if scb.err != nil {
+		return nil, scb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
+	nodes := make([]*Show, len(scb.builders))
+	mutators := make([]Mutator, len(scb.builders))
+	for i := range scb.builders {
+		func(i int, root context.Context) {
+			builder := scb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ShowMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, scb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scb *ShowCreateBulk) SaveX(ctx context.Context) []*Show {
+	v, err := scb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scb *ShowCreateBulk) Exec(ctx context.Context) error {
+	_, err := scb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scb *ShowCreateBulk) ExecX(ctx context.Context) {
+	if err := scb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
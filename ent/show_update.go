@@ -0,0 +1,603 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/episode"
+	"beyerleinf/spotify-backup/ent/predicate"
+	"beyerleinf/spotify-backup/ent/savedshow"
+	"beyerleinf/spotify-backup/ent/show"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ShowUpdate is the builder for updating Show entities.
+type ShowUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ShowMutation
+}
+
+// Where appends a list predicates to the ShowUpdate builder.
+func (su *ShowUpdate) Where(ps ...predicate.Show) *ShowUpdate {
+	su.mutation.Where(ps...)
+	return su
+}
+
+// SetURI sets the "uri" field.
+func (su *ShowUpdate) SetURI(s string) *ShowUpdate {
+	su.mutation.SetURI(s)
+	return su
+}
+
+// SetNillableURI sets the "uri" field if the given value is not nil.
+func (su *ShowUpdate) SetNillableURI(s *string) *ShowUpdate {
+	if s != nil {
+		su.SetURI(*s)
+	}
+	return su
+}
+
+// SetName sets the "name" field.
+func (su *ShowUpdate) SetName(s string) *ShowUpdate {
+	su.mutation.SetName(s)
+	return su
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (su *ShowUpdate) SetNillableName(s *string) *ShowUpdate {
+	if s != nil {
+		su.SetName(*s)
+	}
+	return su
+}
+
+// SetPublisher sets the "publisher" field.
+func (su *ShowUpdate) SetPublisher(s string) *ShowUpdate {
+	su.mutation.SetPublisher(s)
+	return su
+}
+
+// SetNillablePublisher sets the "publisher" field if the given value is not nil.
+func (su *ShowUpdate) SetNillablePublisher(s *string) *ShowUpdate {
+	if s != nil {
+		su.SetPublisher(*s)
+	}
+	return su
+}
+
+// AddEpisodeIDs adds the "episodes" edge to the Episode entity by IDs.
+func (su *ShowUpdate) AddEpisodeIDs(ids ...string) *ShowUpdate {
+	su.mutation.AddEpisodeIDs(ids...)
+	return su
+}
+
+// AddEpisodes adds the "episodes" edges to the Episode entity.
+func (su *ShowUpdate) AddEpisodes(e ...*Episode) *ShowUpdate {
+	ids := make([]string, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return su.AddEpisodeIDs(ids...)
+}
+
+// AddSavedShowIDs adds the "saved_shows" edge to the SavedShow entity by IDs.
+func (su *ShowUpdate) AddSavedShowIDs(ids ...string) *ShowUpdate {
+	su.mutation.AddSavedShowIDs(ids...)
+	return su
+}
+
+// AddSavedShows adds the "saved_shows" edges to the SavedShow entity.
+func (su *ShowUpdate) AddSavedShows(s ...*SavedShow) *ShowUpdate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return su.AddSavedShowIDs(ids...)
+}
+
+// Mutation returns the ShowMutation object of the builder.
+func (su *ShowUpdate) Mutation() *ShowMutation {
+	return su.mutation
+}
+
+// ClearEpisodes clears all "episodes" edges to the Episode entity.
+func (su *ShowUpdate) ClearEpisodes() *ShowUpdate {
+	su.mutation.ClearEpisodes()
+	return su
+}
+
+// RemoveEpisodeIDs removes the "episodes" edge to Episode entities by IDs.
+func (su *ShowUpdate) RemoveEpisodeIDs(ids ...string) *ShowUpdate {
+	su.mutation.RemoveEpisodeIDs(ids...)
+	return su
+}
+
+// RemoveEpisodes removes "episodes" edges to Episode entities.
+func (su *ShowUpdate) RemoveEpisodes(e ...*Episode) *ShowUpdate {
+	ids := make([]string, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return su.RemoveEpisodeIDs(ids...)
+}
+
+// ClearSavedShows clears all "saved_shows" edges to the SavedShow entity.
+func (su *ShowUpdate) ClearSavedShows() *ShowUpdate {
+	su.mutation.ClearSavedShows()
+	return su
+}
+
+// RemoveSavedShowIDs removes the "saved_shows" edge to SavedShow entities by IDs.
+func (su *ShowUpdate) RemoveSavedShowIDs(ids ...string) *ShowUpdate {
+	su.mutation.RemoveSavedShowIDs(ids...)
+	return su
+}
+
+// RemoveSavedShows removes "saved_shows" edges to SavedShow entities.
+func (su *ShowUpdate) RemoveSavedShows(s ...*SavedShow) *ShowUpdate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return su.RemoveSavedShowIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (su *ShowUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, su.sqlSave, su.mutation, su.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (su *ShowUpdate) SaveX(ctx context.Context) int {
+	affected, err := su.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (su *ShowUpdate) Exec(ctx context.Context) error {
+	_, err := su.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (su *ShowUpdate) ExecX(ctx context.Context) {
+	if err := su.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (su *ShowUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(show.Table, show.Columns, sqlgraph.NewFieldSpec(show.FieldID, field.TypeString))
+	if ps := su.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := su.mutation.URI(); ok {
+		_spec.SetField(show.FieldURI, field.TypeString, value)
+	}
+	if value, ok := su.mutation.Name(); ok {
+		_spec.SetField(show.FieldName, field.TypeString, value)
+	}
+	if value, ok := su.mutation.Publisher(); ok {
+		_spec.SetField(show.FieldPublisher, field.TypeString, value)
+	}
+	if su.mutation.EpisodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   show.EpisodesTable,
+			Columns: []string{show.EpisodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedEpisodesIDs(); len(nodes) > 0 && !su.mutation.EpisodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   show.EpisodesTable,
+			Columns: []string{show.EpisodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.EpisodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   show.EpisodesTable,
+			Columns: []string{show.EpisodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.SavedShowsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   show.SavedShowsTable,
+			Columns: []string{show.SavedShowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedshow.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedSavedShowsIDs(); len(nodes) > 0 && !su.mutation.SavedShowsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   show.SavedShowsTable,
+			Columns: []string{show.SavedShowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedshow.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.SavedShowsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   show.SavedShowsTable,
+			Columns: []string{show.SavedShowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedshow.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{show.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	su.mutation.done = true
+	return n, nil
+}
+
+// ShowUpdateOne is the builder for updating a single Show entity.
+type ShowUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ShowMutation
+}
+
+// SetURI sets the "uri" field.
+func (suo *ShowUpdateOne) SetURI(s string) *ShowUpdateOne {
+	suo.mutation.SetURI(s)
+	return suo
+}
+
+// SetNillableURI sets the "uri" field if the given value is not nil.
+func (suo *ShowUpdateOne) SetNillableURI(s *string) *ShowUpdateOne {
+	if s != nil {
+		suo.SetURI(*s)
+	}
+	return suo
+}
+
+// SetName sets the "name" field.
+func (suo *ShowUpdateOne) SetName(s string) *ShowUpdateOne {
+	suo.mutation.SetName(s)
+	return suo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (suo *ShowUpdateOne) SetNillableName(s *string) *ShowUpdateOne {
+	if s != nil {
+		suo.SetName(*s)
+	}
+	return suo
+}
+
+// SetPublisher sets the "publisher" field.
+func (suo *ShowUpdateOne) SetPublisher(s string) *ShowUpdateOne {
+	suo.mutation.SetPublisher(s)
+	return suo
+}
+
+// SetNillablePublisher sets the "publisher" field if the given value is not nil.
+func (suo *ShowUpdateOne) SetNillablePublisher(s *string) *ShowUpdateOne {
+	if s != nil {
+		suo.SetPublisher(*s)
+	}
+	return suo
+}
+
+// AddEpisodeIDs adds the "episodes" edge to the Episode entity by IDs.
+func (suo *ShowUpdateOne) AddEpisodeIDs(ids ...string) *ShowUpdateOne {
+	suo.mutation.AddEpisodeIDs(ids...)
+	return suo
+}
+
+// AddEpisodes adds the "episodes" edges to the Episode entity.
+func (suo *ShowUpdateOne) AddEpisodes(e ...*Episode) *ShowUpdateOne {
+	ids := make([]string, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return suo.AddEpisodeIDs(ids...)
+}
+
+// AddSavedShowIDs adds the "saved_shows" edge to the SavedShow entity by IDs.
+func (suo *ShowUpdateOne) AddSavedShowIDs(ids ...string) *ShowUpdateOne {
+	suo.mutation.AddSavedShowIDs(ids...)
+	return suo
+}
+
+// AddSavedShows adds the "saved_shows" edges to the SavedShow entity.
+func (suo *ShowUpdateOne) AddSavedShows(s ...*SavedShow) *ShowUpdateOne {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return suo.AddSavedShowIDs(ids...)
+}
+
+// Mutation returns the ShowMutation object of the builder.
+func (suo *ShowUpdateOne) Mutation() *ShowMutation {
+	return suo.mutation
+}
+
+// ClearEpisodes clears all "episodes" edges to the Episode entity.
+func (suo *ShowUpdateOne) ClearEpisodes() *ShowUpdateOne {
+	suo.mutation.ClearEpisodes()
+	return suo
+}
+
+// RemoveEpisodeIDs removes the "episodes" edge to Episode entities by IDs.
+func (suo *ShowUpdateOne) RemoveEpisodeIDs(ids ...string) *ShowUpdateOne {
+	suo.mutation.RemoveEpisodeIDs(ids...)
+	return suo
+}
+
+// RemoveEpisodes removes "episodes" edges to Episode entities.
+func (suo *ShowUpdateOne) RemoveEpisodes(e ...*Episode) *ShowUpdateOne {
+	ids := make([]string, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return suo.RemoveEpisodeIDs(ids...)
+}
+
+// ClearSavedShows clears all "saved_shows" edges to the SavedShow entity.
+func (suo *ShowUpdateOne) ClearSavedShows() *ShowUpdateOne {
+	suo.mutation.ClearSavedShows()
+	return suo
+}
+
+// RemoveSavedShowIDs removes the "saved_shows" edge to SavedShow entities by IDs.
+func (suo *ShowUpdateOne) RemoveSavedShowIDs(ids ...string) *ShowUpdateOne {
+	suo.mutation.RemoveSavedShowIDs(ids...)
+	return suo
+}
+
+// RemoveSavedShows removes "saved_shows" edges to SavedShow entities.
+func (suo *ShowUpdateOne) RemoveSavedShows(s ...*SavedShow) *ShowUpdateOne {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return suo.RemoveSavedShowIDs(ids...)
+}
+
+// Where appends a list predicates to the ShowUpdate builder.
+func (suo *ShowUpdateOne) Where(ps ...predicate.Show) *ShowUpdateOne {
+	suo.mutation.Where(ps...)
+	return suo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (suo *ShowUpdateOne) Select(field string, fields ...string) *ShowUpdateOne {
+	suo.fields = append([]string{field}, fields...)
+	return suo
+}
+
+// Save executes the query and returns the updated Show entity.
+func (suo *ShowUpdateOne) Save(ctx context.Context) (*Show, error) {
+	return withHooks(ctx, suo.sqlSave, suo.mutation, suo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (suo *ShowUpdateOne) SaveX(ctx context.Context) *Show {
+	node, err := suo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (suo *ShowUpdateOne) Exec(ctx context.Context) error {
+	_, err := suo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (suo *ShowUpdateOne) ExecX(ctx context.Context) {
+	if err := suo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (suo *ShowUpdateOne) sqlSave(ctx context.Context) (_node *Show, err error) {
+	_spec := sqlgraph.NewUpdateSpec(show.Table, show.Columns, sqlgraph.NewFieldSpec(show.FieldID, field.TypeString))
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Show.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := suo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, show.FieldID)
+		for _, f := range fields {
+			if !show.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != show.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := suo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := suo.mutation.URI(); ok {
+		_spec.SetField(show.FieldURI, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.Name(); ok {
+		_spec.SetField(show.FieldName, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.Publisher(); ok {
+		_spec.SetField(show.FieldPublisher, field.TypeString, value)
+	}
+	if suo.mutation.EpisodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   show.EpisodesTable,
+			Columns: []string{show.EpisodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedEpisodesIDs(); len(nodes) > 0 && !suo.mutation.EpisodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   show.EpisodesTable,
+			Columns: []string{show.EpisodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.EpisodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   show.EpisodesTable,
+			Columns: []string{show.EpisodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if suo.mutation.SavedShowsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   show.SavedShowsTable,
+			Columns: []string{show.SavedShowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedshow.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedSavedShowsIDs(); len(nodes) > 0 && !suo.mutation.SavedShowsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   show.SavedShowsTable,
+			Columns: []string{show.SavedShowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedshow.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.SavedShowsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   show.SavedShowsTable,
+			Columns: []string{show.SavedShowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedshow.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Show{config: suo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{show.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	suo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,288 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/episode"
+	"beyerleinf/spotify-backup/ent/playlistsnapshot"
+	"beyerleinf/spotify-backup/ent/snapshotitem"
+	"beyerleinf/spotify-backup/ent/track"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// SnapshotItem is the model entity for the SnapshotItem schema.
+type SnapshotItem struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Position holds the value of the "position" field.
+	Position int `json:"position,omitempty"`
+	// AddedAt holds the value of the "added_at" field.
+	AddedAt *time.Time `json:"added_at,omitempty"`
+	// AddedBy holds the value of the "added_by" field.
+	AddedBy string `json:"added_by,omitempty"`
+	// IsLocal holds the value of the "is_local" field.
+	IsLocal bool `json:"is_local,omitempty"`
+	// Type holds the value of the "type" field.
+	Type snapshotitem.Type `json:"type,omitempty"`
+	// URI holds the value of the "uri" field.
+	URI string `json:"uri,omitempty"`
+	// LinkedFromID holds the value of the "linked_from_id" field.
+	LinkedFromID string `json:"linked_from_id,omitempty"`
+	// IsPlayable holds the value of the "is_playable" field.
+	IsPlayable *bool `json:"is_playable,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SnapshotItemQuery when eager-loading is set.
+	Edges                   SnapshotItemEdges `json:"edges"`
+	playlist_snapshot_items *string
+	snapshot_item_track     *string
+	snapshot_item_episode   *string
+	selectValues            sql.SelectValues
+}
+
+// SnapshotItemEdges holds the relations/edges for other nodes in the graph.
+type SnapshotItemEdges struct {
+	// Snapshot holds the value of the snapshot edge.
+	Snapshot *PlaylistSnapshot `json:"snapshot,omitempty"`
+	// Track holds the value of the track edge.
+	Track *Track `json:"track,omitempty"`
+	// Episode holds the value of the episode edge.
+	Episode *Episode `json:"episode,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// SnapshotOrErr returns the Snapshot value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SnapshotItemEdges) SnapshotOrErr() (*PlaylistSnapshot, error) {
+	if e.Snapshot != nil {
+		return e.Snapshot, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: playlistsnapshot.Label}
+	}
+	return nil, &NotLoadedError{edge: "snapshot"}
+}
+
+// TrackOrErr returns the Track value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SnapshotItemEdges) TrackOrErr() (*Track, error) {
+	if e.Track != nil {
+		return e.Track, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: track.Label}
+	}
+	return nil, &NotLoadedError{edge: "track"}
+}
+
+// EpisodeOrErr returns the Episode value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SnapshotItemEdges) EpisodeOrErr() (*Episode, error) {
+	if e.Episode != nil {
+		return e.Episode, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: episode.Label}
+	}
+	return nil, &NotLoadedError{edge: "episode"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SnapshotItem) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case snapshotitem.FieldIsLocal, snapshotitem.FieldIsPlayable:
+			values[i] = new(sql.NullBool)
+		case snapshotitem.FieldPosition:
+			values[i] = new(sql.NullInt64)
+		case snapshotitem.FieldID, snapshotitem.FieldAddedBy, snapshotitem.FieldType, snapshotitem.FieldURI, snapshotitem.FieldLinkedFromID:
+			values[i] = new(sql.NullString)
+		case snapshotitem.FieldAddedAt:
+			values[i] = new(sql.NullTime)
+		case snapshotitem.ForeignKeys[0]: // playlist_snapshot_items
+			values[i] = new(sql.NullString)
+		case snapshotitem.ForeignKeys[1]: // snapshot_item_track
+			values[i] = new(sql.NullString)
+		case snapshotitem.ForeignKeys[2]: // snapshot_item_episode
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SnapshotItem fields.
+func (si *SnapshotItem) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case snapshotitem.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				si.ID = value.String
+			}
+		case snapshotitem.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				si.Position = int(value.Int64)
+			}
+		case snapshotitem.FieldAddedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field added_at", values[i])
+			} else if value.Valid {
+				si.AddedAt = new(time.Time)
+				*si.AddedAt = value.Time
+			}
+		case snapshotitem.FieldAddedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field added_by", values[i])
+			} else if value.Valid {
+				si.AddedBy = value.String
+			}
+		case snapshotitem.FieldIsLocal:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_local", values[i])
+			} else if value.Valid {
+				si.IsLocal = value.Bool
+			}
+		case snapshotitem.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				si.Type = snapshotitem.Type(value.String)
+			}
+		case snapshotitem.FieldURI:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field uri", values[i])
+			} else if value.Valid {
+				si.URI = value.String
+			}
+		case snapshotitem.FieldLinkedFromID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field linked_from_id", values[i])
+			} else if value.Valid {
+				si.LinkedFromID = value.String
+			}
+		case snapshotitem.FieldIsPlayable:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_playable", values[i])
+			} else if value.Valid {
+				si.IsPlayable = new(bool)
+				*si.IsPlayable = value.Bool
+			}
+		case snapshotitem.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field playlist_snapshot_items", values[i])
+			} else if value.Valid {
+				si.playlist_snapshot_items = new(string)
+				*si.playlist_snapshot_items = value.String
+			}
+		case snapshotitem.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field snapshot_item_track", values[i])
+			} else if value.Valid {
+				si.snapshot_item_track = new(string)
+				*si.snapshot_item_track = value.String
+			}
+		case snapshotitem.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field snapshot_item_episode", values[i])
+			} else if value.Valid {
+				si.snapshot_item_episode = new(string)
+				*si.snapshot_item_episode = value.String
+			}
+		default:
+			si.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SnapshotItem.
+// This includes values selected through modifiers, order, etc.
+func (si *SnapshotItem) Value(name string) (ent.Value, error) {
+	return si.selectValues.Get(name)
+}
+
+// QuerySnapshot queries the "snapshot" edge of the SnapshotItem entity.
+func (si *SnapshotItem) QuerySnapshot() *PlaylistSnapshotQuery {
+	return NewSnapshotItemClient(si.config).QuerySnapshot(si)
+}
+
+// QueryTrack queries the "track" edge of the SnapshotItem entity.
+func (si *SnapshotItem) QueryTrack() *TrackQuery {
+	return NewSnapshotItemClient(si.config).QueryTrack(si)
+}
+
+// QueryEpisode queries the "episode" edge of the SnapshotItem entity.
+func (si *SnapshotItem) QueryEpisode() *EpisodeQuery {
+	return NewSnapshotItemClient(si.config).QueryEpisode(si)
+}
+
+// Update returns a builder for updating this SnapshotItem.
+// Note that you need to call SnapshotItem.Unwrap() before calling this method if this SnapshotItem
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (si *SnapshotItem) Update() *SnapshotItemUpdateOne {
+	return NewSnapshotItemClient(si.config).UpdateOne(si)
+}
+
+// Unwrap unwraps the SnapshotItem entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (si *SnapshotItem) Unwrap() *SnapshotItem {
+	_tx, ok := si.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SnapshotItem is not a transactional entity")
+	}
+	si.config.driver = _tx.drv
+	return si
+}
+
+// String implements the fmt.Stringer.
+func (si *SnapshotItem) String() string {
+	var builder strings.Builder
+	builder.WriteString("SnapshotItem(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", si.ID))
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", si.Position))
+	builder.WriteString(", ")
+	if v := si.AddedAt; v != nil {
+		builder.WriteString("added_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("added_by=")
+	builder.WriteString(si.AddedBy)
+	builder.WriteString(", ")
+	builder.WriteString("is_local=")
+	builder.WriteString(fmt.Sprintf("%v", si.IsLocal))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", si.Type))
+	builder.WriteString(", ")
+	builder.WriteString("uri=")
+	builder.WriteString(si.URI)
+	builder.WriteString(", ")
+	builder.WriteString("linked_from_id=")
+	builder.WriteString(si.LinkedFromID)
+	builder.WriteString(", ")
+	if v := si.IsPlayable; v != nil {
+		builder.WriteString("is_playable=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SnapshotItems is a parsable slice of SnapshotItem.
+type SnapshotItems []*SnapshotItem
@@ -0,0 +1,224 @@
+// Code generated by ent, DO NOT EDIT.
+
+package snapshotitem
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the snapshotitem type in the database.
+	Label = "snapshot_item"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPosition holds the string denoting the position field in the database.
+	FieldPosition = "position"
+	// FieldAddedAt holds the string denoting the added_at field in the database.
+	FieldAddedAt = "added_at"
+	// FieldAddedBy holds the string denoting the added_by field in the database.
+	FieldAddedBy = "added_by"
+	// FieldIsLocal holds the string denoting the is_local field in the database.
+	FieldIsLocal = "is_local"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldURI holds the string denoting the uri field in the database.
+	FieldURI = "uri"
+	// FieldLinkedFromID holds the string denoting the linked_from_id field in the database.
+	FieldLinkedFromID = "linked_from_id"
+	// FieldIsPlayable holds the string denoting the is_playable field in the database.
+	FieldIsPlayable = "is_playable"
+	// EdgeSnapshot holds the string denoting the snapshot edge name in mutations.
+	EdgeSnapshot = "snapshot"
+	// EdgeTrack holds the string denoting the track edge name in mutations.
+	EdgeTrack = "track"
+	// EdgeEpisode holds the string denoting the episode edge name in mutations.
+	EdgeEpisode = "episode"
+	// Table holds the table name of the snapshotitem in the database.
+	Table = "snapshot_items"
+	// SnapshotTable is the table that holds the snapshot relation/edge.
+	SnapshotTable = "snapshot_items"
+	// SnapshotInverseTable is the table name for the PlaylistSnapshot entity.
+	// It exists in this package in order to avoid circular dependency with the "playlistsnapshot" package.
+	SnapshotInverseTable = "playlist_snapshots"
+	// SnapshotColumn is the table column denoting the snapshot relation/edge.
+	SnapshotColumn = "playlist_snapshot_items"
+	// TrackTable is the table that holds the track relation/edge.
+	TrackTable = "snapshot_items"
+	// TrackInverseTable is the table name for the Track entity.
+	// It exists in this package in order to avoid circular dependency with the "track" package.
+	TrackInverseTable = "tracks"
+	// TrackColumn is the table column denoting the track relation/edge.
+	TrackColumn = "snapshot_item_track"
+	// EpisodeTable is the table that holds the episode relation/edge.
+	EpisodeTable = "snapshot_items"
+	// EpisodeInverseTable is the table name for the Episode entity.
+	// It exists in this package in order to avoid circular dependency with the "episode" package.
+	EpisodeInverseTable = "episodes"
+	// EpisodeColumn is the table column denoting the episode relation/edge.
+	EpisodeColumn = "snapshot_item_episode"
+)
+
+// Columns holds all SQL columns for snapshotitem fields.
+var Columns = []string{
+	FieldID,
+	FieldPosition,
+	FieldAddedAt,
+	FieldAddedBy,
+	FieldIsLocal,
+	FieldType,
+	FieldURI,
+	FieldLinkedFromID,
+	FieldIsPlayable,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "snapshot_items"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"playlist_snapshot_items",
+	"snapshot_item_track",
+	"snapshot_item_episode",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	PositionValidator func(int) error
+	// DefaultAddedBy holds the default value on creation for the "added_by" field.
+	DefaultAddedBy string
+	// DefaultIsLocal holds the default value on creation for the "is_local" field.
+	DefaultIsLocal bool
+	// DefaultLinkedFromID holds the default value on creation for the "linked_from_id" field.
+	DefaultLinkedFromID string
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() string
+)
+
+// Type defines the type for the "type" enum field.
+type Type string
+
+// Type values.
+const (
+	TypeTrack   Type = "track"
+	TypeEpisode Type = "episode"
+)
+
+func (_type Type) String() string {
+	return string(_type)
+}
+
+// TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
+func TypeValidator(_type Type) error {
+	switch _type {
+	case TypeTrack, TypeEpisode:
+		return nil
+	default:
+		return fmt.Errorf("snapshotitem: invalid enum value for type field: %q", _type)
+	}
+}
+
+// OrderOption defines the ordering options for the SnapshotItem queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByPosition orders the results by the position field.
+func ByPosition(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPosition, opts...).ToFunc()
+}
+
+// ByAddedAt orders the results by the added_at field.
+func ByAddedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAddedAt, opts...).ToFunc()
+}
+
+// ByAddedBy orders the results by the added_by field.
+func ByAddedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAddedBy, opts...).ToFunc()
+}
+
+// ByIsLocal orders the results by the is_local field.
+func ByIsLocal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsLocal, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByURI orders the results by the uri field.
+func ByURI(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldURI, opts...).ToFunc()
+}
+
+// ByLinkedFromID orders the results by the linked_from_id field.
+func ByLinkedFromID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLinkedFromID, opts...).ToFunc()
+}
+
+// ByIsPlayable orders the results by the is_playable field.
+func ByIsPlayable(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsPlayable, opts...).ToFunc()
+}
+
+// BySnapshotField orders the results by snapshot field.
+func BySnapshotField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSnapshotStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByTrackField orders the results by track field.
+func ByTrackField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTrackStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByEpisodeField orders the results by episode field.
+func ByEpisodeField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEpisodeStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newSnapshotStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SnapshotInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SnapshotTable, SnapshotColumn),
+	)
+}
+func newTrackStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TrackInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, TrackTable, TrackColumn),
+	)
+}
+func newEpisodeStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EpisodeInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, EpisodeTable, EpisodeColumn),
+	)
+}
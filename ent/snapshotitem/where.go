@@ -0,0 +1,520 @@
+// Code generated by ent, DO NOT EDIT.
+
+package snapshotitem
+
+import (
+	"beyerleinf/spotify-backup/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldContainsFold(FieldID, id))
+}
+
+// Position applies equality check predicate on the "position" field. It's identical to PositionEQ.
+func Position(v int) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldEQ(FieldPosition, v))
+}
+
+// AddedAt applies equality check predicate on the "added_at" field. It's identical to AddedAtEQ.
+func AddedAt(v time.Time) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldEQ(FieldAddedAt, v))
+}
+
+// AddedBy applies equality check predicate on the "added_by" field. It's identical to AddedByEQ.
+func AddedBy(v string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldEQ(FieldAddedBy, v))
+}
+
+// IsLocal applies equality check predicate on the "is_local" field. It's identical to IsLocalEQ.
+func IsLocal(v bool) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldEQ(FieldIsLocal, v))
+}
+
+// URI applies equality check predicate on the "uri" field. It's identical to URIEQ.
+func URI(v string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldEQ(FieldURI, v))
+}
+
+// LinkedFromID applies equality check predicate on the "linked_from_id" field. It's identical to LinkedFromIDEQ.
+func LinkedFromID(v string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldEQ(FieldLinkedFromID, v))
+}
+
+// IsPlayable applies equality check predicate on the "is_playable" field. It's identical to IsPlayableEQ.
+func IsPlayable(v bool) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldEQ(FieldIsPlayable, v))
+}
+
+// PositionEQ applies the EQ predicate on the "position" field.
+func PositionEQ(v int) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldEQ(FieldPosition, v))
+}
+
+// PositionNEQ applies the NEQ predicate on the "position" field.
+func PositionNEQ(v int) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldNEQ(FieldPosition, v))
+}
+
+// PositionIn applies the In predicate on the "position" field.
+func PositionIn(vs ...int) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldIn(FieldPosition, vs...))
+}
+
+// PositionNotIn applies the NotIn predicate on the "position" field.
+func PositionNotIn(vs ...int) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldNotIn(FieldPosition, vs...))
+}
+
+// PositionGT applies the GT predicate on the "position" field.
+func PositionGT(v int) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldGT(FieldPosition, v))
+}
+
+// PositionGTE applies the GTE predicate on the "position" field.
+func PositionGTE(v int) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldGTE(FieldPosition, v))
+}
+
+// PositionLT applies the LT predicate on the "position" field.
+func PositionLT(v int) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldLT(FieldPosition, v))
+}
+
+// PositionLTE applies the LTE predicate on the "position" field.
+func PositionLTE(v int) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldLTE(FieldPosition, v))
+}
+
+// AddedAtEQ applies the EQ predicate on the "added_at" field.
+func AddedAtEQ(v time.Time) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldEQ(FieldAddedAt, v))
+}
+
+// AddedAtNEQ applies the NEQ predicate on the "added_at" field.
+func AddedAtNEQ(v time.Time) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldNEQ(FieldAddedAt, v))
+}
+
+// AddedAtIn applies the In predicate on the "added_at" field.
+func AddedAtIn(vs ...time.Time) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldIn(FieldAddedAt, vs...))
+}
+
+// AddedAtNotIn applies the NotIn predicate on the "added_at" field.
+func AddedAtNotIn(vs ...time.Time) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldNotIn(FieldAddedAt, vs...))
+}
+
+// AddedAtGT applies the GT predicate on the "added_at" field.
+func AddedAtGT(v time.Time) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldGT(FieldAddedAt, v))
+}
+
+// AddedAtGTE applies the GTE predicate on the "added_at" field.
+func AddedAtGTE(v time.Time) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldGTE(FieldAddedAt, v))
+}
+
+// AddedAtLT applies the LT predicate on the "added_at" field.
+func AddedAtLT(v time.Time) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldLT(FieldAddedAt, v))
+}
+
+// AddedAtLTE applies the LTE predicate on the "added_at" field.
+func AddedAtLTE(v time.Time) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldLTE(FieldAddedAt, v))
+}
+
+// AddedAtIsNil applies the IsNil predicate on the "added_at" field.
+func AddedAtIsNil() predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldIsNull(FieldAddedAt))
+}
+
+// AddedAtNotNil applies the NotNil predicate on the "added_at" field.
+func AddedAtNotNil() predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldNotNull(FieldAddedAt))
+}
+
+// AddedByEQ applies the EQ predicate on the "added_by" field.
+func AddedByEQ(v string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldEQ(FieldAddedBy, v))
+}
+
+// AddedByNEQ applies the NEQ predicate on the "added_by" field.
+func AddedByNEQ(v string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldNEQ(FieldAddedBy, v))
+}
+
+// AddedByIn applies the In predicate on the "added_by" field.
+func AddedByIn(vs ...string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldIn(FieldAddedBy, vs...))
+}
+
+// AddedByNotIn applies the NotIn predicate on the "added_by" field.
+func AddedByNotIn(vs ...string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldNotIn(FieldAddedBy, vs...))
+}
+
+// AddedByGT applies the GT predicate on the "added_by" field.
+func AddedByGT(v string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldGT(FieldAddedBy, v))
+}
+
+// AddedByGTE applies the GTE predicate on the "added_by" field.
+func AddedByGTE(v string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldGTE(FieldAddedBy, v))
+}
+
+// AddedByLT applies the LT predicate on the "added_by" field.
+func AddedByLT(v string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldLT(FieldAddedBy, v))
+}
+
+// AddedByLTE applies the LTE predicate on the "added_by" field.
+func AddedByLTE(v string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldLTE(FieldAddedBy, v))
+}
+
+// AddedByContains applies the Contains predicate on the "added_by" field.
+func AddedByContains(v string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldContains(FieldAddedBy, v))
+}
+
+// AddedByHasPrefix applies the HasPrefix predicate on the "added_by" field.
+func AddedByHasPrefix(v string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldHasPrefix(FieldAddedBy, v))
+}
+
+// AddedByHasSuffix applies the HasSuffix predicate on the "added_by" field.
+func AddedByHasSuffix(v string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldHasSuffix(FieldAddedBy, v))
+}
+
+// AddedByEqualFold applies the EqualFold predicate on the "added_by" field.
+func AddedByEqualFold(v string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldEqualFold(FieldAddedBy, v))
+}
+
+// AddedByContainsFold applies the ContainsFold predicate on the "added_by" field.
+func AddedByContainsFold(v string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldContainsFold(FieldAddedBy, v))
+}
+
+// IsLocalEQ applies the EQ predicate on the "is_local" field.
+func IsLocalEQ(v bool) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldEQ(FieldIsLocal, v))
+}
+
+// IsLocalNEQ applies the NEQ predicate on the "is_local" field.
+func IsLocalNEQ(v bool) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldNEQ(FieldIsLocal, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v Type) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v Type) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...Type) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...Type) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldNotIn(FieldType, vs...))
+}
+
+// URIEQ applies the EQ predicate on the "uri" field.
+func URIEQ(v string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldEQ(FieldURI, v))
+}
+
+// URINEQ applies the NEQ predicate on the "uri" field.
+func URINEQ(v string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldNEQ(FieldURI, v))
+}
+
+// URIIn applies the In predicate on the "uri" field.
+func URIIn(vs ...string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldIn(FieldURI, vs...))
+}
+
+// URINotIn applies the NotIn predicate on the "uri" field.
+func URINotIn(vs ...string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldNotIn(FieldURI, vs...))
+}
+
+// URIGT applies the GT predicate on the "uri" field.
+func URIGT(v string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldGT(FieldURI, v))
+}
+
+// URIGTE applies the GTE predicate on the "uri" field.
+func URIGTE(v string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldGTE(FieldURI, v))
+}
+
+// URILT applies the LT predicate on the "uri" field.
+func URILT(v string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldLT(FieldURI, v))
+}
+
+// URILTE applies the LTE predicate on the "uri" field.
+func URILTE(v string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldLTE(FieldURI, v))
+}
+
+// URIContains applies the Contains predicate on the "uri" field.
+func URIContains(v string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldContains(FieldURI, v))
+}
+
+// URIHasPrefix applies the HasPrefix predicate on the "uri" field.
+func URIHasPrefix(v string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldHasPrefix(FieldURI, v))
+}
+
+// URIHasSuffix applies the HasSuffix predicate on the "uri" field.
+func URIHasSuffix(v string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldHasSuffix(FieldURI, v))
+}
+
+// URIEqualFold applies the EqualFold predicate on the "uri" field.
+func URIEqualFold(v string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldEqualFold(FieldURI, v))
+}
+
+// URIContainsFold applies the ContainsFold predicate on the "uri" field.
+func URIContainsFold(v string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldContainsFold(FieldURI, v))
+}
+
+// LinkedFromIDEQ applies the EQ predicate on the "linked_from_id" field.
+func LinkedFromIDEQ(v string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldEQ(FieldLinkedFromID, v))
+}
+
+// LinkedFromIDNEQ applies the NEQ predicate on the "linked_from_id" field.
+func LinkedFromIDNEQ(v string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldNEQ(FieldLinkedFromID, v))
+}
+
+// LinkedFromIDIn applies the In predicate on the "linked_from_id" field.
+func LinkedFromIDIn(vs ...string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldIn(FieldLinkedFromID, vs...))
+}
+
+// LinkedFromIDNotIn applies the NotIn predicate on the "linked_from_id" field.
+func LinkedFromIDNotIn(vs ...string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldNotIn(FieldLinkedFromID, vs...))
+}
+
+// LinkedFromIDGT applies the GT predicate on the "linked_from_id" field.
+func LinkedFromIDGT(v string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldGT(FieldLinkedFromID, v))
+}
+
+// LinkedFromIDGTE applies the GTE predicate on the "linked_from_id" field.
+func LinkedFromIDGTE(v string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldGTE(FieldLinkedFromID, v))
+}
+
+// LinkedFromIDLT applies the LT predicate on the "linked_from_id" field.
+func LinkedFromIDLT(v string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldLT(FieldLinkedFromID, v))
+}
+
+// LinkedFromIDLTE applies the LTE predicate on the "linked_from_id" field.
+func LinkedFromIDLTE(v string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldLTE(FieldLinkedFromID, v))
+}
+
+// LinkedFromIDContains applies the Contains predicate on the "linked_from_id" field.
+func LinkedFromIDContains(v string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldContains(FieldLinkedFromID, v))
+}
+
+// LinkedFromIDHasPrefix applies the HasPrefix predicate on the "linked_from_id" field.
+func LinkedFromIDHasPrefix(v string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldHasPrefix(FieldLinkedFromID, v))
+}
+
+// LinkedFromIDHasSuffix applies the HasSuffix predicate on the "linked_from_id" field.
+func LinkedFromIDHasSuffix(v string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldHasSuffix(FieldLinkedFromID, v))
+}
+
+// LinkedFromIDEqualFold applies the EqualFold predicate on the "linked_from_id" field.
+func LinkedFromIDEqualFold(v string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldEqualFold(FieldLinkedFromID, v))
+}
+
+// LinkedFromIDContainsFold applies the ContainsFold predicate on the "linked_from_id" field.
+func LinkedFromIDContainsFold(v string) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldContainsFold(FieldLinkedFromID, v))
+}
+
+// IsPlayableEQ applies the EQ predicate on the "is_playable" field.
+func IsPlayableEQ(v bool) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldEQ(FieldIsPlayable, v))
+}
+
+// IsPlayableNEQ applies the NEQ predicate on the "is_playable" field.
+func IsPlayableNEQ(v bool) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldNEQ(FieldIsPlayable, v))
+}
+
+// IsPlayableIsNil applies the IsNil predicate on the "is_playable" field.
+func IsPlayableIsNil() predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldIsNull(FieldIsPlayable))
+}
+
+// IsPlayableNotNil applies the NotNil predicate on the "is_playable" field.
+func IsPlayableNotNil() predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.FieldNotNull(FieldIsPlayable))
+}
+
+// HasSnapshot applies the HasEdge predicate on the "snapshot" edge.
+func HasSnapshot() predicate.SnapshotItem {
+	return predicate.SnapshotItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SnapshotTable, SnapshotColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSnapshotWith applies the HasEdge predicate on the "snapshot" edge with a given conditions (other predicates).
+func HasSnapshotWith(preds ...predicate.PlaylistSnapshot) predicate.SnapshotItem {
+	return predicate.SnapshotItem(func(s *sql.Selector) {
+		step := newSnapshotStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTrack applies the HasEdge predicate on the "track" edge.
+func HasTrack() predicate.SnapshotItem {
+	return predicate.SnapshotItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, TrackTable, TrackColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTrackWith applies the HasEdge predicate on the "track" edge with a given conditions (other predicates).
+func HasTrackWith(preds ...predicate.Track) predicate.SnapshotItem {
+	return predicate.SnapshotItem(func(s *sql.Selector) {
+		step := newTrackStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEpisode applies the HasEdge predicate on the "episode" edge.
+func HasEpisode() predicate.SnapshotItem {
+	return predicate.SnapshotItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, EpisodeTable, EpisodeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEpisodeWith applies the HasEdge predicate on the "episode" edge with a given conditions (other predicates).
+func HasEpisodeWith(preds ...predicate.Episode) predicate.SnapshotItem {
+	return predicate.SnapshotItem(func(s *sql.Selector) {
+		step := newEpisodeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SnapshotItem) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SnapshotItem) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SnapshotItem) predicate.SnapshotItem {
+	return predicate.SnapshotItem(sql.NotPredicates(p))
+}
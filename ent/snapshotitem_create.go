@@ -0,0 +1,466 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/episode"
+	"beyerleinf/spotify-backup/ent/playlistsnapshot"
+	"beyerleinf/spotify-backup/ent/snapshotitem"
+	"beyerleinf/spotify-backup/ent/track"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SnapshotItemCreate is the builder for creating a SnapshotItem entity.
+type SnapshotItemCreate struct {
+	config
+	mutation *SnapshotItemMutation
+	hooks    []Hook
+}
+
+// SetPosition sets the "position" field.
+func (sic *SnapshotItemCreate) SetPosition(i int) *SnapshotItemCreate {
+	sic.mutation.SetPosition(i)
+	return sic
+}
+
+// SetAddedAt sets the "added_at" field.
+func (sic *SnapshotItemCreate) SetAddedAt(t time.Time) *SnapshotItemCreate {
+	sic.mutation.SetAddedAt(t)
+	return sic
+}
+
+// SetNillableAddedAt sets the "added_at" field if the given value is not nil.
+func (sic *SnapshotItemCreate) SetNillableAddedAt(t *time.Time) *SnapshotItemCreate {
+	if t != nil {
+		sic.SetAddedAt(*t)
+	}
+	return sic
+}
+
+// SetAddedBy sets the "added_by" field.
+func (sic *SnapshotItemCreate) SetAddedBy(s string) *SnapshotItemCreate {
+	sic.mutation.SetAddedBy(s)
+	return sic
+}
+
+// SetNillableAddedBy sets the "added_by" field if the given value is not nil.
+func (sic *SnapshotItemCreate) SetNillableAddedBy(s *string) *SnapshotItemCreate {
+	if s != nil {
+		sic.SetAddedBy(*s)
+	}
+	return sic
+}
+
+// SetIsLocal sets the "is_local" field.
+func (sic *SnapshotItemCreate) SetIsLocal(b bool) *SnapshotItemCreate {
+	sic.mutation.SetIsLocal(b)
+	return sic
+}
+
+// SetNillableIsLocal sets the "is_local" field if the given value is not nil.
+func (sic *SnapshotItemCreate) SetNillableIsLocal(b *bool) *SnapshotItemCreate {
+	if b != nil {
+		sic.SetIsLocal(*b)
+	}
+	return sic
+}
+
+// SetType sets the "type" field.
+func (sic *SnapshotItemCreate) SetType(s snapshotitem.Type) *SnapshotItemCreate {
+	sic.mutation.SetType(s)
+	return sic
+}
+
+// SetURI sets the "uri" field.
+func (sic *SnapshotItemCreate) SetURI(s string) *SnapshotItemCreate {
+	sic.mutation.SetURI(s)
+	return sic
+}
+
+// SetLinkedFromID sets the "linked_from_id" field.
+func (sic *SnapshotItemCreate) SetLinkedFromID(s string) *SnapshotItemCreate {
+	sic.mutation.SetLinkedFromID(s)
+	return sic
+}
+
+// SetNillableLinkedFromID sets the "linked_from_id" field if the given value is not nil.
+func (sic *SnapshotItemCreate) SetNillableLinkedFromID(s *string) *SnapshotItemCreate {
+	if s != nil {
+		sic.SetLinkedFromID(*s)
+	}
+	return sic
+}
+
+// SetIsPlayable sets the "is_playable" field.
+func (sic *SnapshotItemCreate) SetIsPlayable(b bool) *SnapshotItemCreate {
+	sic.mutation.SetIsPlayable(b)
+	return sic
+}
+
+// SetNillableIsPlayable sets the "is_playable" field if the given value is not nil.
+func (sic *SnapshotItemCreate) SetNillableIsPlayable(b *bool) *SnapshotItemCreate {
+	if b != nil {
+		sic.SetIsPlayable(*b)
+	}
+	return sic
+}
+
+// SetID sets the "id" field.
+func (sic *SnapshotItemCreate) SetID(s string) *SnapshotItemCreate {
+	sic.mutation.SetID(s)
+	return sic
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (sic *SnapshotItemCreate) SetNillableID(s *string) *SnapshotItemCreate {
+	if s != nil {
+		sic.SetID(*s)
+	}
+	return sic
+}
+
+// SetSnapshotID sets the "snapshot" edge to the PlaylistSnapshot entity by ID.
+func (sic *SnapshotItemCreate) SetSnapshotID(id string) *SnapshotItemCreate {
+	sic.mutation.SetSnapshotID(id)
+	return sic
+}
+
+// SetSnapshot sets the "snapshot" edge to the PlaylistSnapshot entity.
+func (sic *SnapshotItemCreate) SetSnapshot(p *PlaylistSnapshot) *SnapshotItemCreate {
+	return sic.SetSnapshotID(p.ID)
+}
+
+// SetTrackID sets the "track" edge to the Track entity by ID.
+func (sic *SnapshotItemCreate) SetTrackID(id string) *SnapshotItemCreate {
+	sic.mutation.SetTrackID(id)
+	return sic
+}
+
+// SetNillableTrackID sets the "track" edge to the Track entity by ID if the given value is not nil.
+func (sic *SnapshotItemCreate) SetNillableTrackID(id *string) *SnapshotItemCreate {
+	if id != nil {
+		sic = sic.SetTrackID(*id)
+	}
+	return sic
+}
+
+// SetTrack sets the "track" edge to the Track entity.
+func (sic *SnapshotItemCreate) SetTrack(t *Track) *SnapshotItemCreate {
+	return sic.SetTrackID(t.ID)
+}
+
+// SetEpisodeID sets the "episode" edge to the Episode entity by ID.
+func (sic *SnapshotItemCreate) SetEpisodeID(id string) *SnapshotItemCreate {
+	sic.mutation.SetEpisodeID(id)
+	return sic
+}
+
+// SetNillableEpisodeID sets the "episode" edge to the Episode entity by ID if the given value is not nil.
+func (sic *SnapshotItemCreate) SetNillableEpisodeID(id *string) *SnapshotItemCreate {
+	if id != nil {
+		sic = sic.SetEpisodeID(*id)
+	}
+	return sic
+}
+
+// SetEpisode sets the "episode" edge to the Episode entity.
+func (sic *SnapshotItemCreate) SetEpisode(e *Episode) *SnapshotItemCreate {
+	return sic.SetEpisodeID(e.ID)
+}
+
+// Mutation returns the SnapshotItemMutation object of the builder.
+func (sic *SnapshotItemCreate) Mutation() *SnapshotItemMutation {
+	return sic.mutation
+}
+
+// Save creates the SnapshotItem in the database.
+func (sic *SnapshotItemCreate) Save(ctx context.Context) (*SnapshotItem, error) {
+	sic.defaults()
+	return withHooks(ctx, sic.sqlSave, sic.mutation, sic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sic *SnapshotItemCreate) SaveX(ctx context.Context) *SnapshotItem {
+	v, err := sic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sic *SnapshotItemCreate) Exec(ctx context.Context) error {
+	_, err := sic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sic *SnapshotItemCreate) ExecX(ctx context.Context) {
+	if err := sic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sic *SnapshotItemCreate) defaults() {
+	if _, ok := sic.mutation.AddedBy(); !ok {
+		v := snapshotitem.DefaultAddedBy
+		sic.mutation.SetAddedBy(v)
+	}
+	if _, ok := sic.mutation.IsLocal(); !ok {
+		v := snapshotitem.DefaultIsLocal
+		sic.mutation.SetIsLocal(v)
+	}
+	if _, ok := sic.mutation.LinkedFromID(); !ok {
+		v := snapshotitem.DefaultLinkedFromID
+		sic.mutation.SetLinkedFromID(v)
+	}
+	if _, ok := sic.mutation.ID(); !ok {
+		v := snapshotitem.DefaultID()
+		sic.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sic *SnapshotItemCreate) check() error {
+	if _, ok := sic.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "SnapshotItem.position"`)}
+	}
+	if v, ok := sic.mutation.Position(); ok {
+		if err := snapshotitem.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "SnapshotItem.position": %w`, err)}
+		}
+	}
+	if _, ok := sic.mutation.AddedBy(); !ok {
+		return &ValidationError{Name: "added_by", err: errors.New(`ent: missing required field "SnapshotItem.added_by"`)}
+	}
+	if _, ok := sic.mutation.IsLocal(); !ok {
+		return &ValidationError{Name: "is_local", err: errors.New(`ent: missing required field "SnapshotItem.is_local"`)}
+	}
+	if _, ok := sic.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "SnapshotItem.type"`)}
+	}
+	if v, ok := sic.mutation.GetType(); ok {
+		if err := snapshotitem.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "SnapshotItem.type": %w`, err)}
+		}
+	}
+	if _, ok := sic.mutation.URI(); !ok {
+		return &ValidationError{Name: "uri", err: errors.New(`ent: missing required field "SnapshotItem.uri"`)}
+	}
+	if _, ok := sic.mutation.LinkedFromID(); !ok {
+		return &ValidationError{Name: "linked_from_id", err: errors.New(`ent: missing required field "SnapshotItem.linked_from_id"`)}
+	}
+	if len(sic.mutation.SnapshotIDs()) == 0 {
+		return &ValidationError{Name: "snapshot", err: errors.New(`ent: missing required edge "SnapshotItem.snapshot"`)}
+	}
+	return nil
+}
+
+func (sic *SnapshotItemCreate) sqlSave(ctx context.Context) (*SnapshotItem, error) {
+	if err := sic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected SnapshotItem.ID type: %T", _spec.ID.Value)
+		}
+	}
+	sic.mutation.id = &_node.ID
+	sic.mutation.done = true
+	return _node, nil
+}
+
+func (sic *SnapshotItemCreate) createSpec() (*SnapshotItem, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SnapshotItem{config: sic.config}
+		_spec = sqlgraph.NewCreateSpec(snapshotitem.Table, sqlgraph.NewFieldSpec(snapshotitem.FieldID, field.TypeString))
+	)
+	if id, ok := sic.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := sic.mutation.Position(); ok {
+		_spec.SetField(snapshotitem.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if value, ok := sic.mutation.AddedAt(); ok {
+		_spec.SetField(snapshotitem.FieldAddedAt, field.TypeTime, value)
+		_node.AddedAt = &value
+	}
+	if value, ok := sic.mutation.AddedBy(); ok {
+		_spec.SetField(snapshotitem.FieldAddedBy, field.TypeString, value)
+		_node.AddedBy = value
+	}
+	if value, ok := sic.mutation.IsLocal(); ok {
+		_spec.SetField(snapshotitem.FieldIsLocal, field.TypeBool, value)
+		_node.IsLocal = value
+	}
+	if value, ok := sic.mutation.GetType(); ok {
+		_spec.SetField(snapshotitem.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := sic.mutation.URI(); ok {
+		_spec.SetField(snapshotitem.FieldURI, field.TypeString, value)
+		_node.URI = value
+	}
+	if value, ok := sic.mutation.LinkedFromID(); ok {
+		_spec.SetField(snapshotitem.FieldLinkedFromID, field.TypeString, value)
+		_node.LinkedFromID = value
+	}
+	if value, ok := sic.mutation.IsPlayable(); ok {
+		_spec.SetField(snapshotitem.FieldIsPlayable, field.TypeBool, value)
+		_node.IsPlayable = &value
+	}
+	if nodes := sic.mutation.SnapshotIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   snapshotitem.SnapshotTable,
+			Columns: []string{snapshotitem.SnapshotColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlistsnapshot.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.playlist_snapshot_items = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sic.mutation.TrackIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   snapshotitem.TrackTable,
+			Columns: []string{snapshotitem.TrackColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.snapshot_item_track = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sic.mutation.EpisodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   snapshotitem.EpisodeTable,
+			Columns: []string{snapshotitem.EpisodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.snapshot_item_episode = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SnapshotItemCreateBulk is the builder for creating many SnapshotItem entities in bulk.
+type SnapshotItemCreateBulk struct {
+	config
+	err      error
+	builders []*SnapshotItemCreate
+}
+
+// Save creates the SnapshotItem entities in the database.
+func (sicb *SnapshotItemCreateBulk) Save(ctx context.Context) ([]*SnapshotItem, error) {
+	if sicb.err != nil {
+		return nil, sicb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sicb.builders))
+	nodes := make([]*SnapshotItem, len(sicb.builders))
+	mutators := make([]Mutator, len(sicb.builders))
+	for i := range sicb.builders {
+		func(i int, root context.Context) {
+			builder := sicb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SnapshotItemMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sicb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sicb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sicb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sicb *SnapshotItemCreateBulk) SaveX(ctx context.Context) []*SnapshotItem {
+	v, err := sicb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sicb *SnapshotItemCreateBulk) Exec(ctx context.Context) error {
+	_, err := sicb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sicb *SnapshotItemCreateBulk) ExecX(ctx context.Context) {
+	if err := sicb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/predicate"
+	"beyerleinf/spotify-backup/ent/snapshotitem"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SnapshotItemDelete is the builder for deleting a SnapshotItem entity.
+type SnapshotItemDelete struct {
+	config
+	hooks    []Hook
+	mutation *SnapshotItemMutation
+}
+
+// Where appends a list predicates to the SnapshotItemDelete builder.
+func (sid *SnapshotItemDelete) Where(ps ...predicate.SnapshotItem) *SnapshotItemDelete {
+	sid.mutation.Where(ps...)
+	return sid
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (sid *SnapshotItemDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, sid.sqlExec, sid.mutation, sid.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sid *SnapshotItemDelete) ExecX(ctx context.Context) int {
+	n, err := sid.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (sid *SnapshotItemDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(snapshotitem.Table, sqlgraph.NewFieldSpec(snapshotitem.FieldID, field.TypeString))
+	if ps := sid.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, sid.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	sid.mutation.done = true
+	return affected, err
+}
+
+// SnapshotItemDeleteOne is the builder for deleting a single SnapshotItem entity.
+type SnapshotItemDeleteOne struct {
+	sid *SnapshotItemDelete
+}
+
+// Where appends a list predicates to the SnapshotItemDelete builder.
+func (sido *SnapshotItemDeleteOne) Where(ps ...predicate.SnapshotItem) *SnapshotItemDeleteOne {
+	sido.sid.mutation.Where(ps...)
+	return sido
+}
+
+// Exec executes the deletion query.
+func (sido *SnapshotItemDeleteOne) Exec(ctx context.Context) error {
+	n, err := sido.sid.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{snapshotitem.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sido *SnapshotItemDeleteOne) ExecX(ctx context.Context) {
+	if err := sido.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,801 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/episode"
+	"beyerleinf/spotify-backup/ent/playlistsnapshot"
+	"beyerleinf/spotify-backup/ent/predicate"
+	"beyerleinf/spotify-backup/ent/snapshotitem"
+	"beyerleinf/spotify-backup/ent/track"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SnapshotItemQuery is the builder for querying SnapshotItem entities.
+type SnapshotItemQuery struct {
+	config
+	ctx          *QueryContext
+	order        []snapshotitem.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.SnapshotItem
+	withSnapshot *PlaylistSnapshotQuery
+	withTrack    *TrackQuery
+	withEpisode  *EpisodeQuery
+	withFKs      bool
+	modifiers    []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SnapshotItemQuery builder.
+func (siq *SnapshotItemQuery) Where(ps ...predicate.SnapshotItem) *SnapshotItemQuery {
+	siq.predicates = append(siq.predicates, ps...)
+	return siq
+}
+
+// Limit the number of records to be returned by this query.
+func (siq *SnapshotItemQuery) Limit(limit int) *SnapshotItemQuery {
+	siq.ctx.Limit = &limit
+	return siq
+}
+
+// Offset to start from.
+func (siq *SnapshotItemQuery) Offset(offset int) *SnapshotItemQuery {
+	siq.ctx.Offset = &offset
+	return siq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (siq *SnapshotItemQuery) Unique(unique bool) *SnapshotItemQuery {
+	siq.ctx.Unique = &unique
+	return siq
+}
+
+// Order specifies how the records should be ordered.
+func (siq *SnapshotItemQuery) Order(o ...snapshotitem.OrderOption) *SnapshotItemQuery {
+	siq.order = append(siq.order, o...)
+	return siq
+}
+
+// QuerySnapshot chains the current query on the "snapshot" edge.
+func (siq *SnapshotItemQuery) QuerySnapshot() *PlaylistSnapshotQuery {
+	query := (&PlaylistSnapshotClient{config: siq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := siq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := siq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(snapshotitem.Table, snapshotitem.FieldID, selector),
+			sqlgraph.To(playlistsnapshot.Table, playlistsnapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, snapshotitem.SnapshotTable, snapshotitem.SnapshotColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(siq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTrack chains the current query on the "track" edge.
+func (siq *SnapshotItemQuery) QueryTrack() *TrackQuery {
+	query := (&TrackClient{config: siq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := siq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := siq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(snapshotitem.Table, snapshotitem.FieldID, selector),
+			sqlgraph.To(track.Table, track.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, snapshotitem.TrackTable, snapshotitem.TrackColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(siq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEpisode chains the current query on the "episode" edge.
+func (siq *SnapshotItemQuery) QueryEpisode() *EpisodeQuery {
+	query := (&EpisodeClient{config: siq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := siq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := siq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(snapshotitem.Table, snapshotitem.FieldID, selector),
+			sqlgraph.To(episode.Table, episode.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, snapshotitem.EpisodeTable, snapshotitem.EpisodeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(siq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SnapshotItem entity from the query.
+// Returns a *NotFoundError when no SnapshotItem was found.
+func (siq *SnapshotItemQuery) First(ctx context.Context) (*SnapshotItem, error) {
+	nodes, err := siq.Limit(1).All(setContextOp(ctx, siq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{snapshotitem.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (siq *SnapshotItemQuery) FirstX(ctx context.Context) *SnapshotItem {
+	node, err := siq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SnapshotItem ID from the query.
+// Returns a *NotFoundError when no SnapshotItem ID was found.
+func (siq *SnapshotItemQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = siq.Limit(1).IDs(setContextOp(ctx, siq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{snapshotitem.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (siq *SnapshotItemQuery) FirstIDX(ctx context.Context) string {
+	id, err := siq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SnapshotItem entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SnapshotItem entity is found.
+// Returns a *NotFoundError when no SnapshotItem entities are found.
+func (siq *SnapshotItemQuery) Only(ctx context.Context) (*SnapshotItem, error) {
+	nodes, err := siq.Limit(2).All(setContextOp(ctx, siq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{snapshotitem.Label}
+	default:
+		return nil, &NotSingularError{snapshotitem.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (siq *SnapshotItemQuery) OnlyX(ctx context.Context) *SnapshotItem {
+	node, err := siq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SnapshotItem ID in the query.
+// Returns a *NotSingularError when more than one SnapshotItem ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (siq *SnapshotItemQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = siq.Limit(2).IDs(setContextOp(ctx, siq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{snapshotitem.Label}
+	default:
+		err = &NotSingularError{snapshotitem.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (siq *SnapshotItemQuery) OnlyIDX(ctx context.Context) string {
+	id, err := siq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SnapshotItems.
+func (siq *SnapshotItemQuery) All(ctx context.Context) ([]*SnapshotItem, error) {
+	ctx = setContextOp(ctx, siq.ctx, ent.OpQueryAll)
+	if err := siq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SnapshotItem, *SnapshotItemQuery]()
+	return withInterceptors[[]*SnapshotItem](ctx, siq, qr, siq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (siq *SnapshotItemQuery) AllX(ctx context.Context) []*SnapshotItem {
+	nodes, err := siq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SnapshotItem IDs.
+func (siq *SnapshotItemQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if siq.ctx.Unique == nil && siq.path != nil {
+		siq.Unique(true)
+	}
+	ctx = setContextOp(ctx, siq.ctx, ent.OpQueryIDs)
+	if err = siq.Select(snapshotitem.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (siq *SnapshotItemQuery) IDsX(ctx context.Context) []string {
+	ids, err := siq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (siq *SnapshotItemQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, siq.ctx, ent.OpQueryCount)
+	if err := siq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, siq, querierCount[*SnapshotItemQuery](), siq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (siq *SnapshotItemQuery) CountX(ctx context.Context) int {
+	count, err := siq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (siq *SnapshotItemQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, siq.ctx, ent.OpQueryExist)
+	switch _, err := siq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (siq *SnapshotItemQuery) ExistX(ctx context.Context) bool {
+	exist, err := siq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SnapshotItemQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (siq *SnapshotItemQuery) Clone() *SnapshotItemQuery {
+	if siq == nil {
+		return nil
+	}
+	return &SnapshotItemQuery{
+		config:       siq.config,
+		ctx:          siq.ctx.Clone(),
+		order:        append([]snapshotitem.OrderOption{}, siq.order...),
+		inters:       append([]Interceptor{}, siq.inters...),
+		predicates:   append([]predicate.SnapshotItem{}, siq.predicates...),
+		withSnapshot: siq.withSnapshot.Clone(),
+		withTrack:    siq.withTrack.Clone(),
+		withEpisode:  siq.withEpisode.Clone(),
+		// clone intermediate query.
+		sql:  siq.sql.Clone(),
+		path: siq.path,
+	}
+}
+
+// WithSnapshot tells the query-builder to eager-load the nodes that are connected to
+// the "snapshot" edge. The optional arguments are used to configure the query builder of the edge.
+func (siq *SnapshotItemQuery) WithSnapshot(opts ...func(*PlaylistSnapshotQuery)) *SnapshotItemQuery {
+	query := (&PlaylistSnapshotClient{config: siq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	siq.withSnapshot = query
+	return siq
+}
+
+// WithTrack tells the query-builder to eager-load the nodes that are connected to
+// the "track" edge. The optional arguments are used to configure the query builder of the edge.
+func (siq *SnapshotItemQuery) WithTrack(opts ...func(*TrackQuery)) *SnapshotItemQuery {
+	query := (&TrackClient{config: siq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	siq.withTrack = query
+	return siq
+}
+
+// WithEpisode tells the query-builder to eager-load the nodes that are connected to
+// the "episode" edge. The optional arguments are used to configure the query builder of the edge.
+func (siq *SnapshotItemQuery) WithEpisode(opts ...func(*EpisodeQuery)) *SnapshotItemQuery {
+	query := (&EpisodeClient{config: siq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	siq.withEpisode = query
+	return siq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Position int `json:"position,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SnapshotItem.Query().
+//		GroupBy(snapshotitem.FieldPosition).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (siq *SnapshotItemQuery) GroupBy(field string, fields ...string) *SnapshotItemGroupBy {
+	siq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SnapshotItemGroupBy{build: siq}
+	grbuild.flds = &siq.ctx.Fields
+	grbuild.label = snapshotitem.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Position int `json:"position,omitempty"`
+//	}
+//
+//	client.SnapshotItem.Query().
+//		Select(snapshotitem.FieldPosition).
+//		Scan(ctx, &v)
+func (siq *SnapshotItemQuery) Select(fields ...string) *SnapshotItemSelect {
+	siq.ctx.Fields = append(siq.ctx.Fields, fields...)
+	sbuild := &SnapshotItemSelect{SnapshotItemQuery: siq}
+	sbuild.label = snapshotitem.Label
+	sbuild.flds, sbuild.scan = &siq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SnapshotItemSelect configured with the given aggregations.
+func (siq *SnapshotItemQuery) Aggregate(fns ...AggregateFunc) *SnapshotItemSelect {
+	return siq.Select().Aggregate(fns...)
+}
+
+func (siq *SnapshotItemQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range siq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, siq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range siq.ctx.Fields {
+		if !snapshotitem.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if siq.path != nil {
+		prev, err := siq.path(ctx)
+		if err != nil {
+			return err
+		}
+		siq.sql = prev
+	}
+	return nil
+}
+
+func (siq *SnapshotItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SnapshotItem, error) {
+	var (
+		nodes       = []*SnapshotItem{}
+		withFKs     = siq.withFKs
+		_spec       = siq.querySpec()
+		loadedTypes = [3]bool{
+			siq.withSnapshot != nil,
+			siq.withTrack != nil,
+			siq.withEpisode != nil,
+		}
+	)
+	if siq.withSnapshot != nil || siq.withTrack != nil || siq.withEpisode != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, snapshotitem.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SnapshotItem).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SnapshotItem{config: siq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(siq.modifiers) > 0 {
+		_spec.Modifiers = siq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, siq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := siq.withSnapshot; query != nil {
+		if err := siq.loadSnapshot(ctx, query, nodes, nil,
+			func(n *SnapshotItem, e *PlaylistSnapshot) { n.Edges.Snapshot = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := siq.withTrack; query != nil {
+		if err := siq.loadTrack(ctx, query, nodes, nil,
+			func(n *SnapshotItem, e *Track) { n.Edges.Track = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := siq.withEpisode; query != nil {
+		if err := siq.loadEpisode(ctx, query, nodes, nil,
+			func(n *SnapshotItem, e *Episode) { n.Edges.Episode = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (siq *SnapshotItemQuery) loadSnapshot(ctx context.Context, query *PlaylistSnapshotQuery, nodes []*SnapshotItem, init func(*SnapshotItem), assign func(*SnapshotItem, *PlaylistSnapshot)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*SnapshotItem)
+	for i := range nodes {
+		if nodes[i].playlist_snapshot_items == nil {
+			continue
+		}
+		fk := *nodes[i].playlist_snapshot_items
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(playlistsnapshot.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "playlist_snapshot_items" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (siq *SnapshotItemQuery) loadTrack(ctx context.Context, query *TrackQuery, nodes []*SnapshotItem, init func(*SnapshotItem), assign func(*SnapshotItem, *Track)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*SnapshotItem)
+	for i := range nodes {
+		if nodes[i].snapshot_item_track == nil {
+			continue
+		}
+		fk := *nodes[i].snapshot_item_track
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(track.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "snapshot_item_track" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (siq *SnapshotItemQuery) loadEpisode(ctx context.Context, query *EpisodeQuery, nodes []*SnapshotItem, init func(*SnapshotItem), assign func(*SnapshotItem, *Episode)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*SnapshotItem)
+	for i := range nodes {
+		if nodes[i].snapshot_item_episode == nil {
+			continue
+		}
+		fk := *nodes[i].snapshot_item_episode
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(episode.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "snapshot_item_episode" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (siq *SnapshotItemQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := siq.querySpec()
+	if len(siq.modifiers) > 0 {
+		_spec.Modifiers = siq.modifiers
+	}
+	_spec.Node.Columns = siq.ctx.Fields
+	if len(siq.ctx.Fields) > 0 {
+		_spec.Unique = siq.ctx.Unique != nil && *siq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, siq.driver, _spec)
+}
+
+func (siq *SnapshotItemQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(snapshotitem.Table, snapshotitem.Columns, sqlgraph.NewFieldSpec(snapshotitem.FieldID, field.TypeString))
+	_spec.From = siq.sql
+	if unique := siq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if siq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := siq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, snapshotitem.FieldID)
+		for i := range fields {
+			if fields[i] != snapshotitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := siq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := siq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := siq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := siq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (siq *SnapshotItemQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(siq.driver.Dialect())
+	t1 := builder.Table(snapshotitem.Table)
+	columns := siq.ctx.Fields
+	if len(columns) == 0 {
+		columns = snapshotitem.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if siq.sql != nil {
+		selector = siq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if siq.ctx.Unique != nil && *siq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range siq.modifiers {
+		m(selector)
+	}
+	for _, p := range siq.predicates {
+		p(selector)
+	}
+	for _, p := range siq.order {
+		p(selector)
+	}
+	if offset := siq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := siq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (siq *SnapshotItemQuery) ForUpdate(opts ...sql.LockOption) *SnapshotItemQuery {
+	if siq.driver.Dialect() == dialect.Postgres {
+		siq.Unique(false)
+	}
+	siq.modifiers = append(siq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return siq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (siq *SnapshotItemQuery) ForShare(opts ...sql.LockOption) *SnapshotItemQuery {
+	if siq.driver.Dialect() == dialect.Postgres {
+		siq.Unique(false)
+	}
+	siq.modifiers = append(siq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return siq
+}
+
+// SnapshotItemGroupBy is the group-by builder for SnapshotItem entities.
+type SnapshotItemGroupBy struct {
+	selector
+	build *SnapshotItemQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (sigb *SnapshotItemGroupBy) Aggregate(fns ...AggregateFunc) *SnapshotItemGroupBy {
+	sigb.fns = append(sigb.fns, fns...)
+	return sigb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sigb *SnapshotItemGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sigb.build.ctx, ent.OpQueryGroupBy)
+	if err := sigb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SnapshotItemQuery, *SnapshotItemGroupBy](ctx, sigb.build, sigb, sigb.build.inters, v)
+}
+
+func (sigb *SnapshotItemGroupBy) sqlScan(ctx context.Context, root *SnapshotItemQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(sigb.fns))
+	for _, fn := range sigb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*sigb.flds)+len(sigb.fns))
+		for _, f := range *sigb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*sigb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sigb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SnapshotItemSelect is the builder for selecting fields of SnapshotItem entities.
+type SnapshotItemSelect struct {
+	*SnapshotItemQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sis *SnapshotItemSelect) Aggregate(fns ...AggregateFunc) *SnapshotItemSelect {
+	sis.fns = append(sis.fns, fns...)
+	return sis
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sis *SnapshotItemSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sis.ctx, ent.OpQuerySelect)
+	if err := sis.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SnapshotItemQuery, *SnapshotItemSelect](ctx, sis.SnapshotItemQuery, sis, sis.inters, v)
+}
+
+func (sis *SnapshotItemSelect) sqlScan(ctx context.Context, root *SnapshotItemQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sis.fns))
+	for _, fn := range sis.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sis.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sis.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,857 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/episode"
+	"beyerleinf/spotify-backup/ent/playlistsnapshot"
+	"beyerleinf/spotify-backup/ent/predicate"
+	"beyerleinf/spotify-backup/ent/snapshotitem"
+	"beyerleinf/spotify-backup/ent/track"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SnapshotItemUpdate is the builder for updating SnapshotItem entities.
+type SnapshotItemUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SnapshotItemMutation
+}
+
+// Where appends a list predicates to the SnapshotItemUpdate builder.
+func (siu *SnapshotItemUpdate) Where(ps ...predicate.SnapshotItem) *SnapshotItemUpdate {
+	siu.mutation.Where(ps...)
+	return siu
+}
+
+// SetPosition sets the "position" field.
+func (siu *SnapshotItemUpdate) SetPosition(i int) *SnapshotItemUpdate {
+	siu.mutation.ResetPosition()
+	siu.mutation.SetPosition(i)
+	return siu
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (siu *SnapshotItemUpdate) SetNillablePosition(i *int) *SnapshotItemUpdate {
+	if i != nil {
+		siu.SetPosition(*i)
+	}
+	return siu
+}
+
+// AddPosition adds i to the "position" field.
+func (siu *SnapshotItemUpdate) AddPosition(i int) *SnapshotItemUpdate {
+	siu.mutation.AddPosition(i)
+	return siu
+}
+
+// SetAddedAt sets the "added_at" field.
+func (siu *SnapshotItemUpdate) SetAddedAt(t time.Time) *SnapshotItemUpdate {
+	siu.mutation.SetAddedAt(t)
+	return siu
+}
+
+// SetNillableAddedAt sets the "added_at" field if the given value is not nil.
+func (siu *SnapshotItemUpdate) SetNillableAddedAt(t *time.Time) *SnapshotItemUpdate {
+	if t != nil {
+		siu.SetAddedAt(*t)
+	}
+	return siu
+}
+
+// ClearAddedAt clears the value of the "added_at" field.
+func (siu *SnapshotItemUpdate) ClearAddedAt() *SnapshotItemUpdate {
+	siu.mutation.ClearAddedAt()
+	return siu
+}
+
+// SetAddedBy sets the "added_by" field.
+func (siu *SnapshotItemUpdate) SetAddedBy(s string) *SnapshotItemUpdate {
+	siu.mutation.SetAddedBy(s)
+	return siu
+}
+
+// SetNillableAddedBy sets the "added_by" field if the given value is not nil.
+func (siu *SnapshotItemUpdate) SetNillableAddedBy(s *string) *SnapshotItemUpdate {
+	if s != nil {
+		siu.SetAddedBy(*s)
+	}
+	return siu
+}
+
+// SetIsLocal sets the "is_local" field.
+func (siu *SnapshotItemUpdate) SetIsLocal(b bool) *SnapshotItemUpdate {
+	siu.mutation.SetIsLocal(b)
+	return siu
+}
+
+// SetNillableIsLocal sets the "is_local" field if the given value is not nil.
+func (siu *SnapshotItemUpdate) SetNillableIsLocal(b *bool) *SnapshotItemUpdate {
+	if b != nil {
+		siu.SetIsLocal(*b)
+	}
+	return siu
+}
+
+// SetType sets the "type" field.
+func (siu *SnapshotItemUpdate) SetType(s snapshotitem.Type) *SnapshotItemUpdate {
+	siu.mutation.SetType(s)
+	return siu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (siu *SnapshotItemUpdate) SetNillableType(s *snapshotitem.Type) *SnapshotItemUpdate {
+	if s != nil {
+		siu.SetType(*s)
+	}
+	return siu
+}
+
+// SetURI sets the "uri" field.
+func (siu *SnapshotItemUpdate) SetURI(s string) *SnapshotItemUpdate {
+	siu.mutation.SetURI(s)
+	return siu
+}
+
+// SetNillableURI sets the "uri" field if the given value is not nil.
+func (siu *SnapshotItemUpdate) SetNillableURI(s *string) *SnapshotItemUpdate {
+	if s != nil {
+		siu.SetURI(*s)
+	}
+	return siu
+}
+
+// SetLinkedFromID sets the "linked_from_id" field.
+func (siu *SnapshotItemUpdate) SetLinkedFromID(s string) *SnapshotItemUpdate {
+	siu.mutation.SetLinkedFromID(s)
+	return siu
+}
+
+// SetNillableLinkedFromID sets the "linked_from_id" field if the given value is not nil.
+func (siu *SnapshotItemUpdate) SetNillableLinkedFromID(s *string) *SnapshotItemUpdate {
+	if s != nil {
+		siu.SetLinkedFromID(*s)
+	}
+	return siu
+}
+
+// SetIsPlayable sets the "is_playable" field.
+func (siu *SnapshotItemUpdate) SetIsPlayable(b bool) *SnapshotItemUpdate {
+	siu.mutation.SetIsPlayable(b)
+	return siu
+}
+
+// SetNillableIsPlayable sets the "is_playable" field if the given value is not nil.
+func (siu *SnapshotItemUpdate) SetNillableIsPlayable(b *bool) *SnapshotItemUpdate {
+	if b != nil {
+		siu.SetIsPlayable(*b)
+	}
+	return siu
+}
+
+// ClearIsPlayable clears the value of the "is_playable" field.
+func (siu *SnapshotItemUpdate) ClearIsPlayable() *SnapshotItemUpdate {
+	siu.mutation.ClearIsPlayable()
+	return siu
+}
+
+// SetSnapshotID sets the "snapshot" edge to the PlaylistSnapshot entity by ID.
+func (siu *SnapshotItemUpdate) SetSnapshotID(id string) *SnapshotItemUpdate {
+	siu.mutation.SetSnapshotID(id)
+	return siu
+}
+
+// SetSnapshot sets the "snapshot" edge to the PlaylistSnapshot entity.
+func (siu *SnapshotItemUpdate) SetSnapshot(p *PlaylistSnapshot) *SnapshotItemUpdate {
+	return siu.SetSnapshotID(p.ID)
+}
+
+// SetTrackID sets the "track" edge to the Track entity by ID.
+func (siu *SnapshotItemUpdate) SetTrackID(id string) *SnapshotItemUpdate {
+	siu.mutation.SetTrackID(id)
+	return siu
+}
+
+// SetNillableTrackID sets the "track" edge to the Track entity by ID if the given value is not nil.
+func (siu *SnapshotItemUpdate) SetNillableTrackID(id *string) *SnapshotItemUpdate {
+	if id != nil {
+		siu = siu.SetTrackID(*id)
+	}
+	return siu
+}
+
+// SetTrack sets the "track" edge to the Track entity.
+func (siu *SnapshotItemUpdate) SetTrack(t *Track) *SnapshotItemUpdate {
+	return siu.SetTrackID(t.ID)
+}
+
+// SetEpisodeID sets the "episode" edge to the Episode entity by ID.
+func (siu *SnapshotItemUpdate) SetEpisodeID(id string) *SnapshotItemUpdate {
+	siu.mutation.SetEpisodeID(id)
+	return siu
+}
+
+// SetNillableEpisodeID sets the "episode" edge to the Episode entity by ID if the given value is not nil.
+func (siu *SnapshotItemUpdate) SetNillableEpisodeID(id *string) *SnapshotItemUpdate {
+	if id != nil {
+		siu = siu.SetEpisodeID(*id)
+	}
+	return siu
+}
+
+// SetEpisode sets the "episode" edge to the Episode entity.
+func (siu *SnapshotItemUpdate) SetEpisode(e *Episode) *SnapshotItemUpdate {
+	return siu.SetEpisodeID(e.ID)
+}
+
+// Mutation returns the SnapshotItemMutation object of the builder.
+func (siu *SnapshotItemUpdate) Mutation() *SnapshotItemMutation {
+	return siu.mutation
+}
+
+// ClearSnapshot clears the "snapshot" edge to the PlaylistSnapshot entity.
+func (siu *SnapshotItemUpdate) ClearSnapshot() *SnapshotItemUpdate {
+	siu.mutation.ClearSnapshot()
+	return siu
+}
+
+// ClearTrack clears the "track" edge to the Track entity.
+func (siu *SnapshotItemUpdate) ClearTrack() *SnapshotItemUpdate {
+	siu.mutation.ClearTrack()
+	return siu
+}
+
+// ClearEpisode clears the "episode" edge to the Episode entity.
+func (siu *SnapshotItemUpdate) ClearEpisode() *SnapshotItemUpdate {
+	siu.mutation.ClearEpisode()
+	return siu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (siu *SnapshotItemUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, siu.sqlSave, siu.mutation, siu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (siu *SnapshotItemUpdate) SaveX(ctx context.Context) int {
+	affected, err := siu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (siu *SnapshotItemUpdate) Exec(ctx context.Context) error {
+	_, err := siu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (siu *SnapshotItemUpdate) ExecX(ctx context.Context) {
+	if err := siu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (siu *SnapshotItemUpdate) check() error {
+	if v, ok := siu.mutation.Position(); ok {
+		if err := snapshotitem.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "SnapshotItem.position": %w`, err)}
+		}
+	}
+	if v, ok := siu.mutation.GetType(); ok {
+		if err := snapshotitem.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "SnapshotItem.type": %w`, err)}
+		}
+	}
+	if siu.mutation.SnapshotCleared() && len(siu.mutation.SnapshotIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SnapshotItem.snapshot"`)
+	}
+	return nil
+}
+
+func (siu *SnapshotItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := siu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(snapshotitem.Table, snapshotitem.Columns, sqlgraph.NewFieldSpec(snapshotitem.FieldID, field.TypeString))
+	if ps := siu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := siu.mutation.Position(); ok {
+		_spec.SetField(snapshotitem.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := siu.mutation.AddedPosition(); ok {
+		_spec.AddField(snapshotitem.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := siu.mutation.AddedAt(); ok {
+		_spec.SetField(snapshotitem.FieldAddedAt, field.TypeTime, value)
+	}
+	if siu.mutation.AddedAtCleared() {
+		_spec.ClearField(snapshotitem.FieldAddedAt, field.TypeTime)
+	}
+	if value, ok := siu.mutation.AddedBy(); ok {
+		_spec.SetField(snapshotitem.FieldAddedBy, field.TypeString, value)
+	}
+	if value, ok := siu.mutation.IsLocal(); ok {
+		_spec.SetField(snapshotitem.FieldIsLocal, field.TypeBool, value)
+	}
+	if value, ok := siu.mutation.GetType(); ok {
+		_spec.SetField(snapshotitem.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := siu.mutation.URI(); ok {
+		_spec.SetField(snapshotitem.FieldURI, field.TypeString, value)
+	}
+	if value, ok := siu.mutation.LinkedFromID(); ok {
+		_spec.SetField(snapshotitem.FieldLinkedFromID, field.TypeString, value)
+	}
+	if value, ok := siu.mutation.IsPlayable(); ok {
+		_spec.SetField(snapshotitem.FieldIsPlayable, field.TypeBool, value)
+	}
+	if siu.mutation.IsPlayableCleared() {
+		_spec.ClearField(snapshotitem.FieldIsPlayable, field.TypeBool)
+	}
+	if siu.mutation.SnapshotCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   snapshotitem.SnapshotTable,
+			Columns: []string{snapshotitem.SnapshotColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlistsnapshot.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := siu.mutation.SnapshotIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   snapshotitem.SnapshotTable,
+			Columns: []string{snapshotitem.SnapshotColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlistsnapshot.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if siu.mutation.TrackCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   snapshotitem.TrackTable,
+			Columns: []string{snapshotitem.TrackColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := siu.mutation.TrackIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   snapshotitem.TrackTable,
+			Columns: []string{snapshotitem.TrackColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if siu.mutation.EpisodeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   snapshotitem.EpisodeTable,
+			Columns: []string{snapshotitem.EpisodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := siu.mutation.EpisodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   snapshotitem.EpisodeTable,
+			Columns: []string{snapshotitem.EpisodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, siu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{snapshotitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	siu.mutation.done = true
+	return n, nil
+}
+
+// SnapshotItemUpdateOne is the builder for updating a single SnapshotItem entity.
+type SnapshotItemUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SnapshotItemMutation
+}
+
+// SetPosition sets the "position" field.
+func (siuo *SnapshotItemUpdateOne) SetPosition(i int) *SnapshotItemUpdateOne {
+	siuo.mutation.ResetPosition()
+	siuo.mutation.SetPosition(i)
+	return siuo
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (siuo *SnapshotItemUpdateOne) SetNillablePosition(i *int) *SnapshotItemUpdateOne {
+	if i != nil {
+		siuo.SetPosition(*i)
+	}
+	return siuo
+}
+
+// AddPosition adds i to the "position" field.
+func (siuo *SnapshotItemUpdateOne) AddPosition(i int) *SnapshotItemUpdateOne {
+	siuo.mutation.AddPosition(i)
+	return siuo
+}
+
+// SetAddedAt sets the "added_at" field.
+func (siuo *SnapshotItemUpdateOne) SetAddedAt(t time.Time) *SnapshotItemUpdateOne {
+	siuo.mutation.SetAddedAt(t)
+	return siuo
+}
+
+// SetNillableAddedAt sets the "added_at" field if the given value is not nil.
+func (siuo *SnapshotItemUpdateOne) SetNillableAddedAt(t *time.Time) *SnapshotItemUpdateOne {
+	if t != nil {
+		siuo.SetAddedAt(*t)
+	}
+	return siuo
+}
+
+// ClearAddedAt clears the value of the "added_at" field.
+func (siuo *SnapshotItemUpdateOne) ClearAddedAt() *SnapshotItemUpdateOne {
+	siuo.mutation.ClearAddedAt()
+	return siuo
+}
+
+// SetAddedBy sets the "added_by" field.
+func (siuo *SnapshotItemUpdateOne) SetAddedBy(s string) *SnapshotItemUpdateOne {
+	siuo.mutation.SetAddedBy(s)
+	return siuo
+}
+
+// SetNillableAddedBy sets the "added_by" field if the given value is not nil.
+func (siuo *SnapshotItemUpdateOne) SetNillableAddedBy(s *string) *SnapshotItemUpdateOne {
+	if s != nil {
+		siuo.SetAddedBy(*s)
+	}
+	return siuo
+}
+
+// SetIsLocal sets the "is_local" field.
+func (siuo *SnapshotItemUpdateOne) SetIsLocal(b bool) *SnapshotItemUpdateOne {
+	siuo.mutation.SetIsLocal(b)
+	return siuo
+}
+
+// SetNillableIsLocal sets the "is_local" field if the given value is not nil.
+func (siuo *SnapshotItemUpdateOne) SetNillableIsLocal(b *bool) *SnapshotItemUpdateOne {
+	if b != nil {
+		siuo.SetIsLocal(*b)
+	}
+	return siuo
+}
+
+// SetType sets the "type" field.
+func (siuo *SnapshotItemUpdateOne) SetType(s snapshotitem.Type) *SnapshotItemUpdateOne {
+	siuo.mutation.SetType(s)
+	return siuo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (siuo *SnapshotItemUpdateOne) SetNillableType(s *snapshotitem.Type) *SnapshotItemUpdateOne {
+	if s != nil {
+		siuo.SetType(*s)
+	}
+	return siuo
+}
+
+// SetURI sets the "uri" field.
+func (siuo *SnapshotItemUpdateOne) SetURI(s string) *SnapshotItemUpdateOne {
+	siuo.mutation.SetURI(s)
+	return siuo
+}
+
+// SetNillableURI sets the "uri" field if the given value is not nil.
+func (siuo *SnapshotItemUpdateOne) SetNillableURI(s *string) *SnapshotItemUpdateOne {
+	if s != nil {
+		siuo.SetURI(*s)
+	}
+	return siuo
+}
+
+// SetLinkedFromID sets the "linked_from_id" field.
+func (siuo *SnapshotItemUpdateOne) SetLinkedFromID(s string) *SnapshotItemUpdateOne {
+	siuo.mutation.SetLinkedFromID(s)
+	return siuo
+}
+
+// SetNillableLinkedFromID sets the "linked_from_id" field if the given value is not nil.
+func (siuo *SnapshotItemUpdateOne) SetNillableLinkedFromID(s *string) *SnapshotItemUpdateOne {
+	if s != nil {
+		siuo.SetLinkedFromID(*s)
+	}
+	return siuo
+}
+
+// SetIsPlayable sets the "is_playable" field.
+func (siuo *SnapshotItemUpdateOne) SetIsPlayable(b bool) *SnapshotItemUpdateOne {
+	siuo.mutation.SetIsPlayable(b)
+	return siuo
+}
+
+// SetNillableIsPlayable sets the "is_playable" field if the given value is not nil.
+func (siuo *SnapshotItemUpdateOne) SetNillableIsPlayable(b *bool) *SnapshotItemUpdateOne {
+	if b != nil {
+		siuo.SetIsPlayable(*b)
+	}
+	return siuo
+}
+
+// ClearIsPlayable clears the value of the "is_playable" field.
+func (siuo *SnapshotItemUpdateOne) ClearIsPlayable() *SnapshotItemUpdateOne {
+	siuo.mutation.ClearIsPlayable()
+	return siuo
+}
+
+// SetSnapshotID sets the "snapshot" edge to the PlaylistSnapshot entity by ID.
+func (siuo *SnapshotItemUpdateOne) SetSnapshotID(id string) *SnapshotItemUpdateOne {
+	siuo.mutation.SetSnapshotID(id)
+	return siuo
+}
+
+// SetSnapshot sets the "snapshot" edge to the PlaylistSnapshot entity.
+func (siuo *SnapshotItemUpdateOne) SetSnapshot(p *PlaylistSnapshot) *SnapshotItemUpdateOne {
+	return siuo.SetSnapshotID(p.ID)
+}
+
+// SetTrackID sets the "track" edge to the Track entity by ID.
+func (siuo *SnapshotItemUpdateOne) SetTrackID(id string) *SnapshotItemUpdateOne {
+	siuo.mutation.SetTrackID(id)
+	return siuo
+}
+
+// SetNillableTrackID sets the "track" edge to the Track entity by ID if the given value is not nil.
+func (siuo *SnapshotItemUpdateOne) SetNillableTrackID(id *string) *SnapshotItemUpdateOne {
+	if id != nil {
+		siuo = siuo.SetTrackID(*id)
+	}
+	return siuo
+}
+
+// SetTrack sets the "track" edge to the Track entity.
+func (siuo *SnapshotItemUpdateOne) SetTrack(t *Track) *SnapshotItemUpdateOne {
+	return siuo.SetTrackID(t.ID)
+}
+
+// SetEpisodeID sets the "episode" edge to the Episode entity by ID.
+func (siuo *SnapshotItemUpdateOne) SetEpisodeID(id string) *SnapshotItemUpdateOne {
+	siuo.mutation.SetEpisodeID(id)
+	return siuo
+}
+
+// SetNillableEpisodeID sets the "episode" edge to the Episode entity by ID if the given value is not nil.
+func (siuo *SnapshotItemUpdateOne) SetNillableEpisodeID(id *string) *SnapshotItemUpdateOne {
+	if id != nil {
+		siuo = siuo.SetEpisodeID(*id)
+	}
+	return siuo
+}
+
+// SetEpisode sets the "episode" edge to the Episode entity.
+func (siuo *SnapshotItemUpdateOne) SetEpisode(e *Episode) *SnapshotItemUpdateOne {
+	return siuo.SetEpisodeID(e.ID)
+}
+
+// Mutation returns the SnapshotItemMutation object of the builder.
+func (siuo *SnapshotItemUpdateOne) Mutation() *SnapshotItemMutation {
+	return siuo.mutation
+}
+
+// ClearSnapshot clears the "snapshot" edge to the PlaylistSnapshot entity.
+func (siuo *SnapshotItemUpdateOne) ClearSnapshot() *SnapshotItemUpdateOne {
+	siuo.mutation.ClearSnapshot()
+	return siuo
+}
+
+// ClearTrack clears the "track" edge to the Track entity.
+func (siuo *SnapshotItemUpdateOne) ClearTrack() *SnapshotItemUpdateOne {
+	siuo.mutation.ClearTrack()
+	return siuo
+}
+
+// ClearEpisode clears the "episode" edge to the Episode entity.
+func (siuo *SnapshotItemUpdateOne) ClearEpisode() *SnapshotItemUpdateOne {
+	siuo.mutation.ClearEpisode()
+	return siuo
+}
+
+// Where appends a list predicates to the SnapshotItemUpdate builder.
+func (siuo *SnapshotItemUpdateOne) Where(ps ...predicate.SnapshotItem) *SnapshotItemUpdateOne {
+	siuo.mutation.Where(ps...)
+	return siuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (siuo *SnapshotItemUpdateOne) Select(field string, fields ...string) *SnapshotItemUpdateOne {
+	siuo.fields = append([]string{field}, fields...)
+	return siuo
+}
+
+// Save executes the query and returns the updated SnapshotItem entity.
+func (siuo *SnapshotItemUpdateOne) Save(ctx context.Context) (*SnapshotItem, error) {
+	return withHooks(ctx, siuo.sqlSave, siuo.mutation, siuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (siuo *SnapshotItemUpdateOne) SaveX(ctx context.Context) *SnapshotItem {
+	node, err := siuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (siuo *SnapshotItemUpdateOne) Exec(ctx context.Context) error {
+	_, err := siuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (siuo *SnapshotItemUpdateOne) ExecX(ctx context.Context) {
+	if err := siuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (siuo *SnapshotItemUpdateOne) check() error {
+	if v, ok := siuo.mutation.Position(); ok {
+		if err := snapshotitem.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "SnapshotItem.position": %w`, err)}
+		}
+	}
+	if v, ok := siuo.mutation.GetType(); ok {
+		if err := snapshotitem.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "SnapshotItem.type": %w`, err)}
+		}
+	}
+	if siuo.mutation.SnapshotCleared() && len(siuo.mutation.SnapshotIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SnapshotItem.snapshot"`)
+	}
+	return nil
+}
+
+func (siuo *SnapshotItemUpdateOne) sqlSave(ctx context.Context) (_node *SnapshotItem, err error) {
+	if err := siuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(snapshotitem.Table, snapshotitem.Columns, sqlgraph.NewFieldSpec(snapshotitem.FieldID, field.TypeString))
+	id, ok := siuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SnapshotItem.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := siuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, snapshotitem.FieldID)
+		for _, f := range fields {
+			if !snapshotitem.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != snapshotitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := siuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := siuo.mutation.Position(); ok {
+		_spec.SetField(snapshotitem.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := siuo.mutation.AddedPosition(); ok {
+		_spec.AddField(snapshotitem.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := siuo.mutation.AddedAt(); ok {
+		_spec.SetField(snapshotitem.FieldAddedAt, field.TypeTime, value)
+	}
+	if siuo.mutation.AddedAtCleared() {
+		_spec.ClearField(snapshotitem.FieldAddedAt, field.TypeTime)
+	}
+	if value, ok := siuo.mutation.AddedBy(); ok {
+		_spec.SetField(snapshotitem.FieldAddedBy, field.TypeString, value)
+	}
+	if value, ok := siuo.mutation.IsLocal(); ok {
+		_spec.SetField(snapshotitem.FieldIsLocal, field.TypeBool, value)
+	}
+	if value, ok := siuo.mutation.GetType(); ok {
+		_spec.SetField(snapshotitem.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := siuo.mutation.URI(); ok {
+		_spec.SetField(snapshotitem.FieldURI, field.TypeString, value)
+	}
+	if value, ok := siuo.mutation.LinkedFromID(); ok {
+		_spec.SetField(snapshotitem.FieldLinkedFromID, field.TypeString, value)
+	}
+	if value, ok := siuo.mutation.IsPlayable(); ok {
+		_spec.SetField(snapshotitem.FieldIsPlayable, field.TypeBool, value)
+	}
+	if siuo.mutation.IsPlayableCleared() {
+		_spec.ClearField(snapshotitem.FieldIsPlayable, field.TypeBool)
+	}
+	if siuo.mutation.SnapshotCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   snapshotitem.SnapshotTable,
+			Columns: []string{snapshotitem.SnapshotColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlistsnapshot.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := siuo.mutation.SnapshotIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   snapshotitem.SnapshotTable,
+			Columns: []string{snapshotitem.SnapshotColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlistsnapshot.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if siuo.mutation.TrackCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   snapshotitem.TrackTable,
+			Columns: []string{snapshotitem.TrackColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := siuo.mutation.TrackIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   snapshotitem.TrackTable,
+			Columns: []string{snapshotitem.TrackColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if siuo.mutation.EpisodeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   snapshotitem.EpisodeTable,
+			Columns: []string{snapshotitem.EpisodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := siuo.mutation.EpisodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   snapshotitem.EpisodeTable,
+			Columns: []string{snapshotitem.EpisodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SnapshotItem{config: siuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, siuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{snapshotitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	siuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,261 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/spotifytoken"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SpotifyTokenCreate is the builder for creating a SpotifyToken entity.
+type SpotifyTokenCreate struct {
+	config
+	mutation *SpotifyTokenMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (stc *SpotifyTokenCreate) SetName(s string) *SpotifyTokenCreate {
+	stc.mutation.SetName(s)
+	return stc
+}
+
+// SetToken sets the "token" field.
+func (stc *SpotifyTokenCreate) SetToken(b []byte) *SpotifyTokenCreate {
+	stc.mutation.SetToken(b)
+	return stc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (stc *SpotifyTokenCreate) SetUpdatedAt(t time.Time) *SpotifyTokenCreate {
+	stc.mutation.SetUpdatedAt(t)
+	return stc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (stc *SpotifyTokenCreate) SetNillableUpdatedAt(t *time.Time) *SpotifyTokenCreate {
+	if t != nil {
+		stc.SetUpdatedAt(*t)
+	}
+	return stc
+}
+
+// SetID sets the "id" field.
+func (stc *SpotifyTokenCreate) SetID(s string) *SpotifyTokenCreate {
+	stc.mutation.SetID(s)
+	return stc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (stc *SpotifyTokenCreate) SetNillableID(s *string) *SpotifyTokenCreate {
+	if s != nil {
+		stc.SetID(*s)
+	}
+	return stc
+}
+
+// Mutation returns the SpotifyTokenMutation object of the builder.
+func (stc *SpotifyTokenCreate) Mutation() *SpotifyTokenMutation {
+	return stc.mutation
+}
+
+// Save creates the SpotifyToken in the database.
+func (stc *SpotifyTokenCreate) Save(ctx context.Context) (*SpotifyToken, error) {
+	stc.defaults()
+	return withHooks(ctx, stc.sqlSave, stc.mutation, stc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (stc *SpotifyTokenCreate) SaveX(ctx context.Context) *SpotifyToken {
+	v, err := stc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (stc *SpotifyTokenCreate) Exec(ctx context.Context) error {
+	_, err := stc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (stc *SpotifyTokenCreate) ExecX(ctx context.Context) {
+	if err := stc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (stc *SpotifyTokenCreate) defaults() {
+	if _, ok := stc.mutation.UpdatedAt(); !ok {
+		v := spotifytoken.DefaultUpdatedAt()
+		stc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := stc.mutation.ID(); !ok {
+		v := spotifytoken.DefaultID()
+		stc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (stc *SpotifyTokenCreate) check() error {
+	if _, ok := stc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "SpotifyToken.name"`)}
+	}
+	if v, ok := stc.mutation.Name(); ok {
+		if err := spotifytoken.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SpotifyToken.name": %w`, err)}
+		}
+	}
+	if _, ok := stc.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "SpotifyToken.token"`)}
+	}
+	if v, ok := stc.mutation.Token(); ok {
+		if err := spotifytoken.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "SpotifyToken.token": %w`, err)}
+		}
+	}
+	if _, ok := stc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SpotifyToken.updated_at"`)}
+	}
+	return nil
+}
+
+func (stc *SpotifyTokenCreate) sqlSave(ctx context.Context) (*SpotifyToken, error) {
+	if err := stc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := stc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, stc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected SpotifyToken.ID type: %T", _spec.ID.Value)
+		}
+	}
+	stc.mutation.id = &_node.ID
+	stc.mutation.done = true
+	return _node, nil
+}
+
+func (stc *SpotifyTokenCreate) createSpec() (*SpotifyToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SpotifyToken{config: stc.config}
+		_spec = sqlgraph.NewCreateSpec(spotifytoken.Table, sqlgraph.NewFieldSpec(spotifytoken.FieldID, field.TypeString))
+	)
+	if id, ok := stc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := stc.mutation.Name(); ok {
+		_spec.SetField(spotifytoken.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := stc.mutation.Token(); ok {
+		_spec.SetField(spotifytoken.FieldToken, field.TypeBytes, value)
+		_node.Token = value
+	}
+	if value, ok := stc.mutation.UpdatedAt(); ok {
+		_spec.SetField(spotifytoken.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// SpotifyTokenCreateBulk is the builder for creating many SpotifyToken entities in bulk.
+type SpotifyTokenCreateBulk struct {
+	config
+	err      error
+	builders []*SpotifyTokenCreate
+}
+
+// Save creates the SpotifyToken entities in the database.
+func (stcb *SpotifyTokenCreateBulk) Save(ctx context.Context) ([]*SpotifyToken, error) {
+	if stcb.err != nil {
+		return nil, stcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(stcb.builders))
+	nodes := make([]*SpotifyToken, len(stcb.builders))
+	mutators := make([]Mutator, len(stcb.builders))
+	for i := range stcb.builders {
+		func(i int, root context.Context) {
+			builder := stcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SpotifyTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, stcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, stcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, stcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (stcb *SpotifyTokenCreateBulk) SaveX(ctx context.Context) []*SpotifyToken {
+	v, err := stcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (stcb *SpotifyTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := stcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (stcb *SpotifyTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := stcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
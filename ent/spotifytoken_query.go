@@ -0,0 +1,564 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/predicate"
+	"beyerleinf/spotify-backup/ent/spotifytoken"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SpotifyTokenQuery is the builder for querying SpotifyToken entities.
+type SpotifyTokenQuery struct {
+	config
+	ctx        *QueryContext
+	order      []spotifytoken.OrderOption
+	inters     []Interceptor
+	predicates []predicate.SpotifyToken
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SpotifyTokenQuery builder.
+func (stq *SpotifyTokenQuery) Where(ps ...predicate.SpotifyToken) *SpotifyTokenQuery {
+	stq.predicates = append(stq.predicates, ps...)
+	return stq
+}
+
+// Limit the number of records to be returned by this query.
+func (stq *SpotifyTokenQuery) Limit(limit int) *SpotifyTokenQuery {
+	stq.ctx.Limit = &limit
+	return stq
+}
+
+// Offset to start from.
+func (stq *SpotifyTokenQuery) Offset(offset int) *SpotifyTokenQuery {
+	stq.ctx.Offset = &offset
+	return stq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (stq *SpotifyTokenQuery) Unique(unique bool) *SpotifyTokenQuery {
+	stq.ctx.Unique = &unique
+	return stq
+}
+
+// Order specifies how the records should be ordered.
+func (stq *SpotifyTokenQuery) Order(o ...spotifytoken.OrderOption) *SpotifyTokenQuery {
+	stq.order = append(stq.order, o...)
+	return stq
+}
+
+// First returns the first SpotifyToken entity from the query.
+// Returns a *NotFoundError when no SpotifyToken was found.
+func (stq *SpotifyTokenQuery) First(ctx context.Context) (*SpotifyToken, error) {
+	nodes, err := stq.Limit(1).All(setContextOp(ctx, stq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{spotifytoken.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (stq *SpotifyTokenQuery) FirstX(ctx context.Context) *SpotifyToken {
+	node, err := stq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SpotifyToken ID from the query.
+// Returns a *NotFoundError when no SpotifyToken ID was found.
+func (stq *SpotifyTokenQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = stq.Limit(1).IDs(setContextOp(ctx, stq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{spotifytoken.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (stq *SpotifyTokenQuery) FirstIDX(ctx context.Context) string {
+	id, err := stq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SpotifyToken entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SpotifyToken entity is found.
+// Returns a *NotFoundError when no SpotifyToken entities are found.
+func (stq *SpotifyTokenQuery) Only(ctx context.Context) (*SpotifyToken, error) {
+	nodes, err := stq.Limit(2).All(setContextOp(ctx, stq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{spotifytoken.Label}
+	default:
+		return nil, &NotSingularError{spotifytoken.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (stq *SpotifyTokenQuery) OnlyX(ctx context.Context) *SpotifyToken {
+	node, err := stq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SpotifyToken ID in the query.
+// Returns a *NotSingularError when more than one SpotifyToken ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (stq *SpotifyTokenQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = stq.Limit(2).IDs(setContextOp(ctx, stq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{spotifytoken.Label}
+	default:
+		err = &NotSingularError{spotifytoken.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (stq *SpotifyTokenQuery) OnlyIDX(ctx context.Context) string {
+	id, err := stq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SpotifyTokens.
+func (stq *SpotifyTokenQuery) All(ctx context.Context) ([]*SpotifyToken, error) {
+	ctx = setContextOp(ctx, stq.ctx, ent.OpQueryAll)
+	if err := stq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SpotifyToken, *SpotifyTokenQuery]()
+	return withInterceptors[[]*SpotifyToken](ctx, stq, qr, stq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (stq *SpotifyTokenQuery) AllX(ctx context.Context) []*SpotifyToken {
+	nodes, err := stq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SpotifyToken IDs.
+func (stq *SpotifyTokenQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if stq.ctx.Unique == nil && stq.path != nil {
+		stq.Unique(true)
+	}
+	ctx = setContextOp(ctx, stq.ctx, ent.OpQueryIDs)
+	if err = stq.Select(spotifytoken.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (stq *SpotifyTokenQuery) IDsX(ctx context.Context) []string {
+	ids, err := stq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (stq *SpotifyTokenQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, stq.ctx, ent.OpQueryCount)
+	if err := stq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, stq, querierCount[*SpotifyTokenQuery](), stq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (stq *SpotifyTokenQuery) CountX(ctx context.Context) int {
+	count, err := stq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (stq *SpotifyTokenQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, stq.ctx, ent.OpQueryExist)
+	switch _, err := stq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (stq *SpotifyTokenQuery) ExistX(ctx context.Context) bool {
+	exist, err := stq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SpotifyTokenQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (stq *SpotifyTokenQuery) Clone() *SpotifyTokenQuery {
+	if stq == nil {
+		return nil
+	}
+	return &SpotifyTokenQuery{
+		config:     stq.config,
+		ctx:        stq.ctx.Clone(),
+		order:      append([]spotifytoken.OrderOption{}, stq.order...),
+		inters:     append([]Interceptor{}, stq.inters...),
+		predicates: append([]predicate.SpotifyToken{}, stq.predicates...),
+		// clone intermediate query.
+		sql:  stq.sql.Clone(),
+		path: stq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SpotifyToken.Query().
+//		GroupBy(spotifytoken.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (stq *SpotifyTokenQuery) GroupBy(field string, fields ...string) *SpotifyTokenGroupBy {
+	stq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SpotifyTokenGroupBy{build: stq}
+	grbuild.flds = &stq.ctx.Fields
+	grbuild.label = spotifytoken.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.SpotifyToken.Query().
+//		Select(spotifytoken.FieldName).
+//		Scan(ctx, &v)
+func (stq *SpotifyTokenQuery) Select(fields ...string) *SpotifyTokenSelect {
+	stq.ctx.Fields = append(stq.ctx.Fields, fields...)
+	sbuild := &SpotifyTokenSelect{SpotifyTokenQuery: stq}
+	sbuild.label = spotifytoken.Label
+	sbuild.flds, sbuild.scan = &stq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SpotifyTokenSelect configured with the given aggregations.
+func (stq *SpotifyTokenQuery) Aggregate(fns ...AggregateFunc) *SpotifyTokenSelect {
+	return stq.Select().Aggregate(fns...)
+}
+
+func (stq *SpotifyTokenQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range stq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, stq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range stq.ctx.Fields {
+		if !spotifytoken.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if stq.path != nil {
+		prev, err := stq.path(ctx)
+		if err != nil {
+			return err
+		}
+		stq.sql = prev
+	}
+	return nil
+}
+
+func (stq *SpotifyTokenQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SpotifyToken, error) {
+	var (
+		nodes = []*SpotifyToken{}
+		_spec = stq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SpotifyToken).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SpotifyToken{config: stq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(stq.modifiers) > 0 {
+		_spec.Modifiers = stq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, stq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (stq *SpotifyTokenQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := stq.querySpec()
+	if len(stq.modifiers) > 0 {
+		_spec.Modifiers = stq.modifiers
+	}
+	_spec.Node.Columns = stq.ctx.Fields
+	if len(stq.ctx.Fields) > 0 {
+		_spec.Unique = stq.ctx.Unique != nil && *stq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, stq.driver, _spec)
+}
+
+func (stq *SpotifyTokenQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(spotifytoken.Table, spotifytoken.Columns, sqlgraph.NewFieldSpec(spotifytoken.FieldID, field.TypeString))
+	_spec.From = stq.sql
+	if unique := stq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if stq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := stq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, spotifytoken.FieldID)
+		for i := range fields {
+			if fields[i] != spotifytoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := stq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := stq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := stq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := stq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (stq *SpotifyTokenQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(stq.driver.Dialect())
+	t1 := builder.Table(spotifytoken.Table)
+	columns := stq.ctx.Fields
+	if len(columns) == 0 {
+		columns = spotifytoken.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if stq.sql != nil {
+		selector = stq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if stq.ctx.Unique != nil && *stq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range stq.modifiers {
+		m(selector)
+	}
+	for _, p := range stq.predicates {
+		p(selector)
+	}
+	for _, p := range stq.order {
+		p(selector)
+	}
+	if offset := stq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := stq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (stq *SpotifyTokenQuery) ForUpdate(opts ...sql.LockOption) *SpotifyTokenQuery {
+	if stq.driver.Dialect() == dialect.Postgres {
+		stq.Unique(false)
+	}
+	stq.modifiers = append(stq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return stq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (stq *SpotifyTokenQuery) ForShare(opts ...sql.LockOption) *SpotifyTokenQuery {
+	if stq.driver.Dialect() == dialect.Postgres {
+		stq.Unique(false)
+	}
+	stq.modifiers = append(stq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return stq
+}
+
+// SpotifyTokenGroupBy is the group-by builder for SpotifyToken entities.
+type SpotifyTokenGroupBy struct {
+	selector
+	build *SpotifyTokenQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (stgb *SpotifyTokenGroupBy) Aggregate(fns ...AggregateFunc) *SpotifyTokenGroupBy {
+	stgb.fns = append(stgb.fns, fns...)
+	return stgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (stgb *SpotifyTokenGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, stgb.build.ctx, ent.OpQueryGroupBy)
+	if err := stgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SpotifyTokenQuery, *SpotifyTokenGroupBy](ctx, stgb.build, stgb, stgb.build.inters, v)
+}
+
+func (stgb *SpotifyTokenGroupBy) sqlScan(ctx context.Context, root *SpotifyTokenQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(stgb.fns))
+	for _, fn := range stgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*stgb.flds)+len(stgb.fns))
+		for _, f := range *stgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*stgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := stgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SpotifyTokenSelect is the builder for selecting fields of SpotifyToken entities.
+type SpotifyTokenSelect struct {
+	*SpotifyTokenQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sts *SpotifyTokenSelect) Aggregate(fns ...AggregateFunc) *SpotifyTokenSelect {
+	sts.fns = append(sts.fns, fns...)
+	return sts
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sts *SpotifyTokenSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sts.ctx, ent.OpQuerySelect)
+	if err := sts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SpotifyTokenQuery, *SpotifyTokenSelect](ctx, sts.SpotifyTokenQuery, sts, sts.inters, v)
+}
+
+func (sts *SpotifyTokenSelect) sqlScan(ctx context.Context, root *SpotifyTokenQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sts.fns))
+	for _, fn := range sts.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sts.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,256 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/predicate"
+	"beyerleinf/spotify-backup/ent/spotifytoken"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SpotifyTokenUpdate is the builder for updating SpotifyToken entities.
+type SpotifyTokenUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SpotifyTokenMutation
+}
+
+// Where appends a list predicates to the SpotifyTokenUpdate builder.
+func (stu *SpotifyTokenUpdate) Where(ps ...predicate.SpotifyToken) *SpotifyTokenUpdate {
+	stu.mutation.Where(ps...)
+	return stu
+}
+
+// SetToken sets the "token" field.
+func (stu *SpotifyTokenUpdate) SetToken(b []byte) *SpotifyTokenUpdate {
+	stu.mutation.SetToken(b)
+	return stu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (stu *SpotifyTokenUpdate) SetUpdatedAt(t time.Time) *SpotifyTokenUpdate {
+	stu.mutation.SetUpdatedAt(t)
+	return stu
+}
+
+// Mutation returns the SpotifyTokenMutation object of the builder.
+func (stu *SpotifyTokenUpdate) Mutation() *SpotifyTokenMutation {
+	return stu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (stu *SpotifyTokenUpdate) Save(ctx context.Context) (int, error) {
+	stu.defaults()
+	return withHooks(ctx, stu.sqlSave, stu.mutation, stu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (stu *SpotifyTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := stu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (stu *SpotifyTokenUpdate) Exec(ctx context.Context) error {
+	_, err := stu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (stu *SpotifyTokenUpdate) ExecX(ctx context.Context) {
+	if err := stu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (stu *SpotifyTokenUpdate) defaults() {
+	if _, ok := stu.mutation.UpdatedAt(); !ok {
+		v := spotifytoken.UpdateDefaultUpdatedAt()
+		stu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (stu *SpotifyTokenUpdate) check() error {
+	if v, ok := stu.mutation.Token(); ok {
+		if err := spotifytoken.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "SpotifyToken.token": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (stu *SpotifyTokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := stu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(spotifytoken.Table, spotifytoken.Columns, sqlgraph.NewFieldSpec(spotifytoken.FieldID, field.TypeString))
+	if ps := stu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := stu.mutation.Token(); ok {
+		_spec.SetField(spotifytoken.FieldToken, field.TypeBytes, value)
+	}
+	if value, ok := stu.mutation.UpdatedAt(); ok {
+		_spec.SetField(spotifytoken.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, stu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{spotifytoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	stu.mutation.done = true
+	return n, nil
+}
+
+// SpotifyTokenUpdateOne is the builder for updating a single SpotifyToken entity.
+type SpotifyTokenUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SpotifyTokenMutation
+}
+
+// SetToken sets the "token" field.
+func (stuo *SpotifyTokenUpdateOne) SetToken(b []byte) *SpotifyTokenUpdateOne {
+	stuo.mutation.SetToken(b)
+	return stuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (stuo *SpotifyTokenUpdateOne) SetUpdatedAt(t time.Time) *SpotifyTokenUpdateOne {
+	stuo.mutation.SetUpdatedAt(t)
+	return stuo
+}
+
+// Mutation returns the SpotifyTokenMutation object of the builder.
+func (stuo *SpotifyTokenUpdateOne) Mutation() *SpotifyTokenMutation {
+	return stuo.mutation
+}
+
+// Where appends a list predicates to the SpotifyTokenUpdate builder.
+func (stuo *SpotifyTokenUpdateOne) Where(ps ...predicate.SpotifyToken) *SpotifyTokenUpdateOne {
+	stuo.mutation.Where(ps...)
+	return stuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (stuo *SpotifyTokenUpdateOne) Select(field string, fields ...string) *SpotifyTokenUpdateOne {
+	stuo.fields = append([]string{field}, fields...)
+	return stuo
+}
+
+// Save executes the query and returns the updated SpotifyToken entity.
+func (stuo *SpotifyTokenUpdateOne) Save(ctx context.Context) (*SpotifyToken, error) {
+	stuo.defaults()
+	return withHooks(ctx, stuo.sqlSave, stuo.mutation, stuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (stuo *SpotifyTokenUpdateOne) SaveX(ctx context.Context) *SpotifyToken {
+	node, err := stuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (stuo *SpotifyTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := stuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (stuo *SpotifyTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := stuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (stuo *SpotifyTokenUpdateOne) defaults() {
+	if _, ok := stuo.mutation.UpdatedAt(); !ok {
+		v := spotifytoken.UpdateDefaultUpdatedAt()
+		stuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (stuo *SpotifyTokenUpdateOne) check() error {
+	if v, ok := stuo.mutation.Token(); ok {
+		if err := spotifytoken.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "SpotifyToken.token": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (stuo *SpotifyTokenUpdateOne) sqlSave(ctx context.Context) (_node *SpotifyToken, err error) {
+	if err := stuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(spotifytoken.Table, spotifytoken.Columns, sqlgraph.NewFieldSpec(spotifytoken.FieldID, field.TypeString))
+	id, ok := stuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SpotifyToken.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := stuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, spotifytoken.FieldID)
+		for _, f := range fields {
+			if !spotifytoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != spotifytoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := stuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := stuo.mutation.Token(); ok {
+		_spec.SetField(spotifytoken.FieldToken, field.TypeBytes, value)
+	}
+	if value, ok := stuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(spotifytoken.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &SpotifyToken{config: stuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, stuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{spotifytoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	stuo.mutation.done = true
+	return _node, nil
+}
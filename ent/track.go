@@ -0,0 +1,283 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/album"
+	"beyerleinf/spotify-backup/ent/track"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Track is the model entity for the Track schema.
+type Track struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// SpotifyID holds the value of the "spotify_id" field.
+	SpotifyID string `json:"spotify_id,omitempty"`
+	// URI holds the value of the "uri" field.
+	URI string `json:"uri,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// DurationMs holds the value of the "duration_ms" field.
+	DurationMs int `json:"duration_ms,omitempty"`
+	// Explicit holds the value of the "explicit" field.
+	Explicit bool `json:"explicit,omitempty"`
+	// DiscNumber holds the value of the "disc_number" field.
+	DiscNumber int `json:"disc_number,omitempty"`
+	// TrackNumber holds the value of the "track_number" field.
+	TrackNumber int `json:"track_number,omitempty"`
+	// Isrc holds the value of the "isrc" field.
+	Isrc string `json:"isrc,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TrackQuery when eager-loading is set.
+	Edges        TrackEdges `json:"edges"`
+	track_album  *string
+	selectValues sql.SelectValues
+}
+
+// TrackEdges holds the relations/edges for other nodes in the graph.
+type TrackEdges struct {
+	// Album holds the value of the album edge.
+	Album *Album `json:"album,omitempty"`
+	// Artists holds the value of the artists edge.
+	Artists []*Artist `json:"artists,omitempty"`
+	// SnapshotItems holds the value of the snapshot_items edge.
+	SnapshotItems []*SnapshotItem `json:"snapshot_items,omitempty"`
+	// SavedTracks holds the value of the saved_tracks edge.
+	SavedTracks []*SavedTrack `json:"saved_tracks,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// AlbumOrErr returns the Album value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TrackEdges) AlbumOrErr() (*Album, error) {
+	if e.Album != nil {
+		return e.Album, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: album.Label}
+	}
+	return nil, &NotLoadedError{edge: "album"}
+}
+
+// ArtistsOrErr returns the Artists value or an error if the edge
+// was not loaded in eager-loading.
+func (e TrackEdges) ArtistsOrErr() ([]*Artist, error) {
+	if e.loadedTypes[1] {
+		return e.Artists, nil
+	}
+	return nil, &NotLoadedError{edge: "artists"}
+}
+
+// SnapshotItemsOrErr returns the SnapshotItems value or an error if the edge
+// was not loaded in eager-loading.
+func (e TrackEdges) SnapshotItemsOrErr() ([]*SnapshotItem, error) {
+	if e.loadedTypes[2] {
+		return e.SnapshotItems, nil
+	}
+	return nil, &NotLoadedError{edge: "snapshot_items"}
+}
+
+// SavedTracksOrErr returns the SavedTracks value or an error if the edge
+// was not loaded in eager-loading.
+func (e TrackEdges) SavedTracksOrErr() ([]*SavedTrack, error) {
+	if e.loadedTypes[3] {
+		return e.SavedTracks, nil
+	}
+	return nil, &NotLoadedError{edge: "saved_tracks"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Track) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case track.FieldExplicit:
+			values[i] = new(sql.NullBool)
+		case track.FieldDurationMs, track.FieldDiscNumber, track.FieldTrackNumber:
+			values[i] = new(sql.NullInt64)
+		case track.FieldID, track.FieldSpotifyID, track.FieldURI, track.FieldName, track.FieldIsrc:
+			values[i] = new(sql.NullString)
+		case track.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case track.ForeignKeys[0]: // track_album
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Track fields.
+func (t *Track) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case track.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				t.ID = value.String
+			}
+		case track.FieldSpotifyID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field spotify_id", values[i])
+			} else if value.Valid {
+				t.SpotifyID = value.String
+			}
+		case track.FieldURI:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field uri", values[i])
+			} else if value.Valid {
+				t.URI = value.String
+			}
+		case track.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				t.Name = value.String
+			}
+		case track.FieldDurationMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration_ms", values[i])
+			} else if value.Valid {
+				t.DurationMs = int(value.Int64)
+			}
+		case track.FieldExplicit:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field explicit", values[i])
+			} else if value.Valid {
+				t.Explicit = value.Bool
+			}
+		case track.FieldDiscNumber:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field disc_number", values[i])
+			} else if value.Valid {
+				t.DiscNumber = int(value.Int64)
+			}
+		case track.FieldTrackNumber:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field track_number", values[i])
+			} else if value.Valid {
+				t.TrackNumber = int(value.Int64)
+			}
+		case track.FieldIsrc:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field isrc", values[i])
+			} else if value.Valid {
+				t.Isrc = value.String
+			}
+		case track.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				t.CreatedAt = value.Time
+			}
+		case track.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field track_album", values[i])
+			} else if value.Valid {
+				t.track_album = new(string)
+				*t.track_album = value.String
+			}
+		default:
+			t.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Track.
+// This includes values selected through modifiers, order, etc.
+func (t *Track) Value(name string) (ent.Value, error) {
+	return t.selectValues.Get(name)
+}
+
+// QueryAlbum queries the "album" edge of the Track entity.
+func (t *Track) QueryAlbum() *AlbumQuery {
+	return NewTrackClient(t.config).QueryAlbum(t)
+}
+
+// QueryArtists queries the "artists" edge of the Track entity.
+func (t *Track) QueryArtists() *ArtistQuery {
+	return NewTrackClient(t.config).QueryArtists(t)
+}
+
+// QuerySnapshotItems queries the "snapshot_items" edge of the Track entity.
+func (t *Track) QuerySnapshotItems() *SnapshotItemQuery {
+	return NewTrackClient(t.config).QuerySnapshotItems(t)
+}
+
+// QuerySavedTracks queries the "saved_tracks" edge of the Track entity.
+func (t *Track) QuerySavedTracks() *SavedTrackQuery {
+	return NewTrackClient(t.config).QuerySavedTracks(t)
+}
+
+// Update returns a builder for updating this Track.
+// Note that you need to call Track.Unwrap() before calling this method if this Track
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (t *Track) Update() *TrackUpdateOne {
+	return NewTrackClient(t.config).UpdateOne(t)
+}
+
+// Unwrap unwraps the Track entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (t *Track) Unwrap() *Track {
+	_tx, ok := t.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Track is not a transactional entity")
+	}
+	t.config.driver = _tx.drv
+	return t
+}
+
+// String implements the fmt.Stringer.
+func (t *Track) String() string {
+	var builder strings.Builder
+	builder.WriteString("Track(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", t.ID))
+	builder.WriteString("spotify_id=")
+	builder.WriteString(t.SpotifyID)
+	builder.WriteString(", ")
+	builder.WriteString("uri=")
+	builder.WriteString(t.URI)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(t.Name)
+	builder.WriteString(", ")
+	builder.WriteString("duration_ms=")
+	builder.WriteString(fmt.Sprintf("%v", t.DurationMs))
+	builder.WriteString(", ")
+	builder.WriteString("explicit=")
+	builder.WriteString(fmt.Sprintf("%v", t.Explicit))
+	builder.WriteString(", ")
+	builder.WriteString("disc_number=")
+	builder.WriteString(fmt.Sprintf("%v", t.DiscNumber))
+	builder.WriteString(", ")
+	builder.WriteString("track_number=")
+	builder.WriteString(fmt.Sprintf("%v", t.TrackNumber))
+	builder.WriteString(", ")
+	builder.WriteString("isrc=")
+	builder.WriteString(t.Isrc)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(t.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Tracks is a parsable slice of Track.
+type Tracks []*Track
@@ -0,0 +1,265 @@
+// Code generated by ent, DO NOT EDIT.
+
+package track
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the track type in the database.
+	Label = "track"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSpotifyID holds the string denoting the spotify_id field in the database.
+	FieldSpotifyID = "spotify_id"
+	// FieldURI holds the string denoting the uri field in the database.
+	FieldURI = "uri"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldDurationMs holds the string denoting the duration_ms field in the database.
+	FieldDurationMs = "duration_ms"
+	// FieldExplicit holds the string denoting the explicit field in the database.
+	FieldExplicit = "explicit"
+	// FieldDiscNumber holds the string denoting the disc_number field in the database.
+	FieldDiscNumber = "disc_number"
+	// FieldTrackNumber holds the string denoting the track_number field in the database.
+	FieldTrackNumber = "track_number"
+	// FieldIsrc holds the string denoting the isrc field in the database.
+	FieldIsrc = "isrc"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeAlbum holds the string denoting the album edge name in mutations.
+	EdgeAlbum = "album"
+	// EdgeArtists holds the string denoting the artists edge name in mutations.
+	EdgeArtists = "artists"
+	// EdgeSnapshotItems holds the string denoting the snapshot_items edge name in mutations.
+	EdgeSnapshotItems = "snapshot_items"
+	// EdgeSavedTracks holds the string denoting the saved_tracks edge name in mutations.
+	EdgeSavedTracks = "saved_tracks"
+	// Table holds the table name of the track in the database.
+	Table = "tracks"
+	// AlbumTable is the table that holds the album relation/edge.
+	AlbumTable = "tracks"
+	// AlbumInverseTable is the table name for the Album entity.
+	// It exists in this package in order to avoid circular dependency with the "album" package.
+	AlbumInverseTable = "albums"
+	// AlbumColumn is the table column denoting the album relation/edge.
+	AlbumColumn = "track_album"
+	// ArtistsTable is the table that holds the artists relation/edge. The primary key declared below.
+	ArtistsTable = "track_artists"
+	// ArtistsInverseTable is the table name for the Artist entity.
+	// It exists in this package in order to avoid circular dependency with the "artist" package.
+	ArtistsInverseTable = "artists"
+	// SnapshotItemsTable is the table that holds the snapshot_items relation/edge.
+	SnapshotItemsTable = "snapshot_items"
+	// SnapshotItemsInverseTable is the table name for the SnapshotItem entity.
+	// It exists in this package in order to avoid circular dependency with the "snapshotitem" package.
+	SnapshotItemsInverseTable = "snapshot_items"
+	// SnapshotItemsColumn is the table column denoting the snapshot_items relation/edge.
+	SnapshotItemsColumn = "snapshot_item_track"
+	// SavedTracksTable is the table that holds the saved_tracks relation/edge.
+	SavedTracksTable = "saved_tracks"
+	// SavedTracksInverseTable is the table name for the SavedTrack entity.
+	// It exists in this package in order to avoid circular dependency with the "savedtrack" package.
+	SavedTracksInverseTable = "saved_tracks"
+	// SavedTracksColumn is the table column denoting the saved_tracks relation/edge.
+	SavedTracksColumn = "saved_track_track"
+)
+
+// Columns holds all SQL columns for track fields.
+var Columns = []string{
+	FieldID,
+	FieldSpotifyID,
+	FieldURI,
+	FieldName,
+	FieldDurationMs,
+	FieldExplicit,
+	FieldDiscNumber,
+	FieldTrackNumber,
+	FieldIsrc,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "tracks"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"track_album",
+}
+
+var (
+	// ArtistsPrimaryKey and ArtistsColumn2 are the table columns denoting the
+	// primary key for the artists relation (M2M).
+	ArtistsPrimaryKey = []string{"track_id", "artist_id"}
+)
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// SpotifyIDValidator is a validator for the "spotify_id" field. It is called by the builders before save.
+	SpotifyIDValidator func(string) error
+	// DurationMsValidator is a validator for the "duration_ms" field. It is called by the builders before save.
+	DurationMsValidator func(int) error
+	// DefaultExplicit holds the default value on creation for the "explicit" field.
+	DefaultExplicit bool
+	// DefaultDiscNumber holds the default value on creation for the "disc_number" field.
+	DefaultDiscNumber int
+	// DiscNumberValidator is a validator for the "disc_number" field. It is called by the builders before save.
+	DiscNumberValidator func(int) error
+	// DefaultTrackNumber holds the default value on creation for the "track_number" field.
+	DefaultTrackNumber int
+	// TrackNumberValidator is a validator for the "track_number" field. It is called by the builders before save.
+	TrackNumberValidator func(int) error
+	// DefaultIsrc holds the default value on creation for the "isrc" field.
+	DefaultIsrc string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() string
+)
+
+// OrderOption defines the ordering options for the Track queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// BySpotifyID orders the results by the spotify_id field.
+func BySpotifyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpotifyID, opts...).ToFunc()
+}
+
+// ByURI orders the results by the uri field.
+func ByURI(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldURI, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByDurationMs orders the results by the duration_ms field.
+func ByDurationMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDurationMs, opts...).ToFunc()
+}
+
+// ByExplicit orders the results by the explicit field.
+func ByExplicit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExplicit, opts...).ToFunc()
+}
+
+// ByDiscNumber orders the results by the disc_number field.
+func ByDiscNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiscNumber, opts...).ToFunc()
+}
+
+// ByTrackNumber orders the results by the track_number field.
+func ByTrackNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrackNumber, opts...).ToFunc()
+}
+
+// ByIsrc orders the results by the isrc field.
+func ByIsrc(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsrc, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByAlbumField orders the results by album field.
+func ByAlbumField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAlbumStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByArtistsCount orders the results by artists count.
+func ByArtistsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newArtistsStep(), opts...)
+	}
+}
+
+// ByArtists orders the results by artists terms.
+func ByArtists(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newArtistsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySnapshotItemsCount orders the results by snapshot_items count.
+func BySnapshotItemsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSnapshotItemsStep(), opts...)
+	}
+}
+
+// BySnapshotItems orders the results by snapshot_items terms.
+func BySnapshotItems(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSnapshotItemsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySavedTracksCount orders the results by saved_tracks count.
+func BySavedTracksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSavedTracksStep(), opts...)
+	}
+}
+
+// BySavedTracks orders the results by saved_tracks terms.
+func BySavedTracks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSavedTracksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newAlbumStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AlbumInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, AlbumTable, AlbumColumn),
+	)
+}
+func newArtistsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ArtistsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, ArtistsTable, ArtistsPrimaryKey...),
+	)
+}
+func newSnapshotItemsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SnapshotItemsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, SnapshotItemsTable, SnapshotItemsColumn),
+	)
+}
+func newSavedTracksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SavedTracksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, SavedTracksTable, SavedTracksColumn),
+	)
+}
@@ -0,0 +1,648 @@
+// Code generated by ent, DO NOT EDIT.
+
+package track
+
+import (
+	"beyerleinf/spotify-backup/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Track {
+	return predicate.Track(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Track {
+	return predicate.Track(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Track {
+	return predicate.Track(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Track {
+	return predicate.Track(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Track {
+	return predicate.Track(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Track {
+	return predicate.Track(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Track {
+	return predicate.Track(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Track {
+	return predicate.Track(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Track {
+	return predicate.Track(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Track {
+	return predicate.Track(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Track {
+	return predicate.Track(sql.FieldContainsFold(FieldID, id))
+}
+
+// SpotifyID applies equality check predicate on the "spotify_id" field. It's identical to SpotifyIDEQ.
+func SpotifyID(v string) predicate.Track {
+	return predicate.Track(sql.FieldEQ(FieldSpotifyID, v))
+}
+
+// URI applies equality check predicate on the "uri" field. It's identical to URIEQ.
+func URI(v string) predicate.Track {
+	return predicate.Track(sql.FieldEQ(FieldURI, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Track {
+	return predicate.Track(sql.FieldEQ(FieldName, v))
+}
+
+// DurationMs applies equality check predicate on the "duration_ms" field. It's identical to DurationMsEQ.
+func DurationMs(v int) predicate.Track {
+	return predicate.Track(sql.FieldEQ(FieldDurationMs, v))
+}
+
+// Explicit applies equality check predicate on the "explicit" field. It's identical to ExplicitEQ.
+func Explicit(v bool) predicate.Track {
+	return predicate.Track(sql.FieldEQ(FieldExplicit, v))
+}
+
+// DiscNumber applies equality check predicate on the "disc_number" field. It's identical to DiscNumberEQ.
+func DiscNumber(v int) predicate.Track {
+	return predicate.Track(sql.FieldEQ(FieldDiscNumber, v))
+}
+
+// TrackNumber applies equality check predicate on the "track_number" field. It's identical to TrackNumberEQ.
+func TrackNumber(v int) predicate.Track {
+	return predicate.Track(sql.FieldEQ(FieldTrackNumber, v))
+}
+
+// Isrc applies equality check predicate on the "isrc" field. It's identical to IsrcEQ.
+func Isrc(v string) predicate.Track {
+	return predicate.Track(sql.FieldEQ(FieldIsrc, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Track {
+	return predicate.Track(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// SpotifyIDEQ applies the EQ predicate on the "spotify_id" field.
+func SpotifyIDEQ(v string) predicate.Track {
+	return predicate.Track(sql.FieldEQ(FieldSpotifyID, v))
+}
+
+// SpotifyIDNEQ applies the NEQ predicate on the "spotify_id" field.
+func SpotifyIDNEQ(v string) predicate.Track {
+	return predicate.Track(sql.FieldNEQ(FieldSpotifyID, v))
+}
+
+// SpotifyIDIn applies the In predicate on the "spotify_id" field.
+func SpotifyIDIn(vs ...string) predicate.Track {
+	return predicate.Track(sql.FieldIn(FieldSpotifyID, vs...))
+}
+
+// SpotifyIDNotIn applies the NotIn predicate on the "spotify_id" field.
+func SpotifyIDNotIn(vs ...string) predicate.Track {
+	return predicate.Track(sql.FieldNotIn(FieldSpotifyID, vs...))
+}
+
+// SpotifyIDGT applies the GT predicate on the "spotify_id" field.
+func SpotifyIDGT(v string) predicate.Track {
+	return predicate.Track(sql.FieldGT(FieldSpotifyID, v))
+}
+
+// SpotifyIDGTE applies the GTE predicate on the "spotify_id" field.
+func SpotifyIDGTE(v string) predicate.Track {
+	return predicate.Track(sql.FieldGTE(FieldSpotifyID, v))
+}
+
+// SpotifyIDLT applies the LT predicate on the "spotify_id" field.
+func SpotifyIDLT(v string) predicate.Track {
+	return predicate.Track(sql.FieldLT(FieldSpotifyID, v))
+}
+
+// SpotifyIDLTE applies the LTE predicate on the "spotify_id" field.
+func SpotifyIDLTE(v string) predicate.Track {
+	return predicate.Track(sql.FieldLTE(FieldSpotifyID, v))
+}
+
+// SpotifyIDContains applies the Contains predicate on the "spotify_id" field.
+func SpotifyIDContains(v string) predicate.Track {
+	return predicate.Track(sql.FieldContains(FieldSpotifyID, v))
+}
+
+// SpotifyIDHasPrefix applies the HasPrefix predicate on the "spotify_id" field.
+func SpotifyIDHasPrefix(v string) predicate.Track {
+	return predicate.Track(sql.FieldHasPrefix(FieldSpotifyID, v))
+}
+
+// SpotifyIDHasSuffix applies the HasSuffix predicate on the "spotify_id" field.
+func SpotifyIDHasSuffix(v string) predicate.Track {
+	return predicate.Track(sql.FieldHasSuffix(FieldSpotifyID, v))
+}
+
+// SpotifyIDEqualFold applies the EqualFold predicate on the "spotify_id" field.
+func SpotifyIDEqualFold(v string) predicate.Track {
+	return predicate.Track(sql.FieldEqualFold(FieldSpotifyID, v))
+}
+
+// SpotifyIDContainsFold applies the ContainsFold predicate on the "spotify_id" field.
+func SpotifyIDContainsFold(v string) predicate.Track {
+	return predicate.Track(sql.FieldContainsFold(FieldSpotifyID, v))
+}
+
+// URIEQ applies the EQ predicate on the "uri" field.
+func URIEQ(v string) predicate.Track {
+	return predicate.Track(sql.FieldEQ(FieldURI, v))
+}
+
+// URINEQ applies the NEQ predicate on the "uri" field.
+func URINEQ(v string) predicate.Track {
+	return predicate.Track(sql.FieldNEQ(FieldURI, v))
+}
+
+// URIIn applies the In predicate on the "uri" field.
+func URIIn(vs ...string) predicate.Track {
+	return predicate.Track(sql.FieldIn(FieldURI, vs...))
+}
+
+// URINotIn applies the NotIn predicate on the "uri" field.
+func URINotIn(vs ...string) predicate.Track {
+	return predicate.Track(sql.FieldNotIn(FieldURI, vs...))
+}
+
+// URIGT applies the GT predicate on the "uri" field.
+func URIGT(v string) predicate.Track {
+	return predicate.Track(sql.FieldGT(FieldURI, v))
+}
+
+// URIGTE applies the GTE predicate on the "uri" field.
+func URIGTE(v string) predicate.Track {
+	return predicate.Track(sql.FieldGTE(FieldURI, v))
+}
+
+// URILT applies the LT predicate on the "uri" field.
+func URILT(v string) predicate.Track {
+	return predicate.Track(sql.FieldLT(FieldURI, v))
+}
+
+// URILTE applies the LTE predicate on the "uri" field.
+func URILTE(v string) predicate.Track {
+	return predicate.Track(sql.FieldLTE(FieldURI, v))
+}
+
+// URIContains applies the Contains predicate on the "uri" field.
+func URIContains(v string) predicate.Track {
+	return predicate.Track(sql.FieldContains(FieldURI, v))
+}
+
+// URIHasPrefix applies the HasPrefix predicate on the "uri" field.
+func URIHasPrefix(v string) predicate.Track {
+	return predicate.Track(sql.FieldHasPrefix(FieldURI, v))
+}
+
+// URIHasSuffix applies the HasSuffix predicate on the "uri" field.
+func URIHasSuffix(v string) predicate.Track {
+	return predicate.Track(sql.FieldHasSuffix(FieldURI, v))
+}
+
+// URIEqualFold applies the EqualFold predicate on the "uri" field.
+func URIEqualFold(v string) predicate.Track {
+	return predicate.Track(sql.FieldEqualFold(FieldURI, v))
+}
+
+// URIContainsFold applies the ContainsFold predicate on the "uri" field.
+func URIContainsFold(v string) predicate.Track {
+	return predicate.Track(sql.FieldContainsFold(FieldURI, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Track {
+	return predicate.Track(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Track {
+	return predicate.Track(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Track {
+	return predicate.Track(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Track {
+	return predicate.Track(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Track {
+	return predicate.Track(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Track {
+	return predicate.Track(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Track {
+	return predicate.Track(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Track {
+	return predicate.Track(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Track {
+	return predicate.Track(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Track {
+	return predicate.Track(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Track {
+	return predicate.Track(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Track {
+	return predicate.Track(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Track {
+	return predicate.Track(sql.FieldContainsFold(FieldName, v))
+}
+
+// DurationMsEQ applies the EQ predicate on the "duration_ms" field.
+func DurationMsEQ(v int) predicate.Track {
+	return predicate.Track(sql.FieldEQ(FieldDurationMs, v))
+}
+
+// DurationMsNEQ applies the NEQ predicate on the "duration_ms" field.
+func DurationMsNEQ(v int) predicate.Track {
+	return predicate.Track(sql.FieldNEQ(FieldDurationMs, v))
+}
+
+// DurationMsIn applies the In predicate on the "duration_ms" field.
+func DurationMsIn(vs ...int) predicate.Track {
+	return predicate.Track(sql.FieldIn(FieldDurationMs, vs...))
+}
+
+// DurationMsNotIn applies the NotIn predicate on the "duration_ms" field.
+func DurationMsNotIn(vs ...int) predicate.Track {
+	return predicate.Track(sql.FieldNotIn(FieldDurationMs, vs...))
+}
+
+// DurationMsGT applies the GT predicate on the "duration_ms" field.
+func DurationMsGT(v int) predicate.Track {
+	return predicate.Track(sql.FieldGT(FieldDurationMs, v))
+}
+
+// DurationMsGTE applies the GTE predicate on the "duration_ms" field.
+func DurationMsGTE(v int) predicate.Track {
+	return predicate.Track(sql.FieldGTE(FieldDurationMs, v))
+}
+
+// DurationMsLT applies the LT predicate on the "duration_ms" field.
+func DurationMsLT(v int) predicate.Track {
+	return predicate.Track(sql.FieldLT(FieldDurationMs, v))
+}
+
+// DurationMsLTE applies the LTE predicate on the "duration_ms" field.
+func DurationMsLTE(v int) predicate.Track {
+	return predicate.Track(sql.FieldLTE(FieldDurationMs, v))
+}
+
+// ExplicitEQ applies the EQ predicate on the "explicit" field.
+func ExplicitEQ(v bool) predicate.Track {
+	return predicate.Track(sql.FieldEQ(FieldExplicit, v))
+}
+
+// ExplicitNEQ applies the NEQ predicate on the "explicit" field.
+func ExplicitNEQ(v bool) predicate.Track {
+	return predicate.Track(sql.FieldNEQ(FieldExplicit, v))
+}
+
+// DiscNumberEQ applies the EQ predicate on the "disc_number" field.
+func DiscNumberEQ(v int) predicate.Track {
+	return predicate.Track(sql.FieldEQ(FieldDiscNumber, v))
+}
+
+// DiscNumberNEQ applies the NEQ predicate on the "disc_number" field.
+func DiscNumberNEQ(v int) predicate.Track {
+	return predicate.Track(sql.FieldNEQ(FieldDiscNumber, v))
+}
+
+// DiscNumberIn applies the In predicate on the "disc_number" field.
+func DiscNumberIn(vs ...int) predicate.Track {
+	return predicate.Track(sql.FieldIn(FieldDiscNumber, vs...))
+}
+
+// DiscNumberNotIn applies the NotIn predicate on the "disc_number" field.
+func DiscNumberNotIn(vs ...int) predicate.Track {
+	return predicate.Track(sql.FieldNotIn(FieldDiscNumber, vs...))
+}
+
+// DiscNumberGT applies the GT predicate on the "disc_number" field.
+func DiscNumberGT(v int) predicate.Track {
+	return predicate.Track(sql.FieldGT(FieldDiscNumber, v))
+}
+
+// DiscNumberGTE applies the GTE predicate on the "disc_number" field.
+func DiscNumberGTE(v int) predicate.Track {
+	return predicate.Track(sql.FieldGTE(FieldDiscNumber, v))
+}
+
+// DiscNumberLT applies the LT predicate on the "disc_number" field.
+func DiscNumberLT(v int) predicate.Track {
+	return predicate.Track(sql.FieldLT(FieldDiscNumber, v))
+}
+
+// DiscNumberLTE applies the LTE predicate on the "disc_number" field.
+func DiscNumberLTE(v int) predicate.Track {
+	return predicate.Track(sql.FieldLTE(FieldDiscNumber, v))
+}
+
+// TrackNumberEQ applies the EQ predicate on the "track_number" field.
+func TrackNumberEQ(v int) predicate.Track {
+	return predicate.Track(sql.FieldEQ(FieldTrackNumber, v))
+}
+
+// TrackNumberNEQ applies the NEQ predicate on the "track_number" field.
+func TrackNumberNEQ(v int) predicate.Track {
+	return predicate.Track(sql.FieldNEQ(FieldTrackNumber, v))
+}
+
+// TrackNumberIn applies the In predicate on the "track_number" field.
+func TrackNumberIn(vs ...int) predicate.Track {
+	return predicate.Track(sql.FieldIn(FieldTrackNumber, vs...))
+}
+
+// TrackNumberNotIn applies the NotIn predicate on the "track_number" field.
+func TrackNumberNotIn(vs ...int) predicate.Track {
+	return predicate.Track(sql.FieldNotIn(FieldTrackNumber, vs...))
+}
+
+// TrackNumberGT applies the GT predicate on the "track_number" field.
+func TrackNumberGT(v int) predicate.Track {
+	return predicate.Track(sql.FieldGT(FieldTrackNumber, v))
+}
+
+// TrackNumberGTE applies the GTE predicate on the "track_number" field.
+func TrackNumberGTE(v int) predicate.Track {
+	return predicate.Track(sql.FieldGTE(FieldTrackNumber, v))
+}
+
+// TrackNumberLT applies the LT predicate on the "track_number" field.
+func TrackNumberLT(v int) predicate.Track {
+	return predicate.Track(sql.FieldLT(FieldTrackNumber, v))
+}
+
+// TrackNumberLTE applies the LTE predicate on the "track_number" field.
+func TrackNumberLTE(v int) predicate.Track {
+	return predicate.Track(sql.FieldLTE(FieldTrackNumber, v))
+}
+
+// IsrcEQ applies the EQ predicate on the "isrc" field.
+func IsrcEQ(v string) predicate.Track {
+	return predicate.Track(sql.FieldEQ(FieldIsrc, v))
+}
+
+// IsrcNEQ applies the NEQ predicate on the "isrc" field.
+func IsrcNEQ(v string) predicate.Track {
+	return predicate.Track(sql.FieldNEQ(FieldIsrc, v))
+}
+
+// IsrcIn applies the In predicate on the "isrc" field.
+func IsrcIn(vs ...string) predicate.Track {
+	return predicate.Track(sql.FieldIn(FieldIsrc, vs...))
+}
+
+// IsrcNotIn applies the NotIn predicate on the "isrc" field.
+func IsrcNotIn(vs ...string) predicate.Track {
+	return predicate.Track(sql.FieldNotIn(FieldIsrc, vs...))
+}
+
+// IsrcGT applies the GT predicate on the "isrc" field.
+func IsrcGT(v string) predicate.Track {
+	return predicate.Track(sql.FieldGT(FieldIsrc, v))
+}
+
+// IsrcGTE applies the GTE predicate on the "isrc" field.
+func IsrcGTE(v string) predicate.Track {
+	return predicate.Track(sql.FieldGTE(FieldIsrc, v))
+}
+
+// IsrcLT applies the LT predicate on the "isrc" field.
+func IsrcLT(v string) predicate.Track {
+	return predicate.Track(sql.FieldLT(FieldIsrc, v))
+}
+
+// IsrcLTE applies the LTE predicate on the "isrc" field.
+func IsrcLTE(v string) predicate.Track {
+	return predicate.Track(sql.FieldLTE(FieldIsrc, v))
+}
+
+// IsrcContains applies the Contains predicate on the "isrc" field.
+func IsrcContains(v string) predicate.Track {
+	return predicate.Track(sql.FieldContains(FieldIsrc, v))
+}
+
+// IsrcHasPrefix applies the HasPrefix predicate on the "isrc" field.
+func IsrcHasPrefix(v string) predicate.Track {
+	return predicate.Track(sql.FieldHasPrefix(FieldIsrc, v))
+}
+
+// IsrcHasSuffix applies the HasSuffix predicate on the "isrc" field.
+func IsrcHasSuffix(v string) predicate.Track {
+	return predicate.Track(sql.FieldHasSuffix(FieldIsrc, v))
+}
+
+// IsrcEqualFold applies the EqualFold predicate on the "isrc" field.
+func IsrcEqualFold(v string) predicate.Track {
+	return predicate.Track(sql.FieldEqualFold(FieldIsrc, v))
+}
+
+// IsrcContainsFold applies the ContainsFold predicate on the "isrc" field.
+func IsrcContainsFold(v string) predicate.Track {
+	return predicate.Track(sql.FieldContainsFold(FieldIsrc, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Track {
+	return predicate.Track(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Track {
+	return predicate.Track(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Track {
+	return predicate.Track(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Track {
+	return predicate.Track(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Track {
+	return predicate.Track(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Track {
+	return predicate.Track(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Track {
+	return predicate.Track(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Track {
+	return predicate.Track(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasAlbum applies the HasEdge predicate on the "album" edge.
+func HasAlbum() predicate.Track {
+	return predicate.Track(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, AlbumTable, AlbumColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAlbumWith applies the HasEdge predicate on the "album" edge with a given conditions (other predicates).
+func HasAlbumWith(preds ...predicate.Album) predicate.Track {
+	return predicate.Track(func(s *sql.Selector) {
+		step := newAlbumStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasArtists applies the HasEdge predicate on the "artists" edge.
+func HasArtists() predicate.Track {
+	return predicate.Track(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, ArtistsTable, ArtistsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasArtistsWith applies the HasEdge predicate on the "artists" edge with a given conditions (other predicates).
+func HasArtistsWith(preds ...predicate.Artist) predicate.Track {
+	return predicate.Track(func(s *sql.Selector) {
+		step := newArtistsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSnapshotItems applies the HasEdge predicate on the "snapshot_items" edge.
+func HasSnapshotItems() predicate.Track {
+	return predicate.Track(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, SnapshotItemsTable, SnapshotItemsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSnapshotItemsWith applies the HasEdge predicate on the "snapshot_items" edge with a given conditions (other predicates).
+func HasSnapshotItemsWith(preds ...predicate.SnapshotItem) predicate.Track {
+	return predicate.Track(func(s *sql.Selector) {
+		step := newSnapshotItemsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSavedTracks applies the HasEdge predicate on the "saved_tracks" edge.
+func HasSavedTracks() predicate.Track {
+	return predicate.Track(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, SavedTracksTable, SavedTracksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSavedTracksWith applies the HasEdge predicate on the "saved_tracks" edge with a given conditions (other predicates).
+func HasSavedTracksWith(preds ...predicate.SavedTrack) predicate.Track {
+	return predicate.Track(func(s *sql.Selector) {
+		step := newSavedTracksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Track) predicate.Track {
+	return predicate.Track(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Track) predicate.Track {
+	return predicate.Track(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Track) predicate.Track {
+	return predicate.Track(sql.NotPredicates(p))
+}
@@ -0,0 +1,530 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/album"
+	"beyerleinf/spotify-backup/ent/artist"
+	"beyerleinf/spotify-backup/ent/savedtrack"
+	"beyerleinf/spotify-backup/ent/snapshotitem"
+	"beyerleinf/spotify-backup/ent/track"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TrackCreate is the builder for creating a Track entity.
+type TrackCreate struct {
+	config
+	mutation *TrackMutation
+	hooks    []Hook
+}
+
+// SetSpotifyID sets the "spotify_id" field.
+func (tc *TrackCreate) SetSpotifyID(s string) *TrackCreate {
+	tc.mutation.SetSpotifyID(s)
+	return tc
+}
+
+// SetURI sets the "uri" field.
+func (tc *TrackCreate) SetURI(s string) *TrackCreate {
+	tc.mutation.SetURI(s)
+	return tc
+}
+
+// SetName sets the "name" field.
+func (tc *TrackCreate) SetName(s string) *TrackCreate {
+	tc.mutation.SetName(s)
+	return tc
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (tc *TrackCreate) SetDurationMs(i int) *TrackCreate {
+	tc.mutation.SetDurationMs(i)
+	return tc
+}
+
+// SetExplicit sets the "explicit" field.
+func (tc *TrackCreate) SetExplicit(b bool) *TrackCreate {
+	tc.mutation.SetExplicit(b)
+	return tc
+}
+
+// SetNillableExplicit sets the "explicit" field if the given value is not nil.
+func (tc *TrackCreate) SetNillableExplicit(b *bool) *TrackCreate {
+	if b != nil {
+		tc.SetExplicit(*b)
+	}
+	return tc
+}
+
+// SetDiscNumber sets the "disc_number" field.
+func (tc *TrackCreate) SetDiscNumber(i int) *TrackCreate {
+	tc.mutation.SetDiscNumber(i)
+	return tc
+}
+
+// SetNillableDiscNumber sets the "disc_number" field if the given value is not nil.
+func (tc *TrackCreate) SetNillableDiscNumber(i *int) *TrackCreate {
+	if i != nil {
+		tc.SetDiscNumber(*i)
+	}
+	return tc
+}
+
+// SetTrackNumber sets the "track_number" field.
+func (tc *TrackCreate) SetTrackNumber(i int) *TrackCreate {
+	tc.mutation.SetTrackNumber(i)
+	return tc
+}
+
+// SetNillableTrackNumber sets the "track_number" field if the given value is not nil.
+func (tc *TrackCreate) SetNillableTrackNumber(i *int) *TrackCreate {
+	if i != nil {
+		tc.SetTrackNumber(*i)
+	}
+	return tc
+}
+
+// SetIsrc sets the "isrc" field.
+func (tc *TrackCreate) SetIsrc(s string) *TrackCreate {
+	tc.mutation.SetIsrc(s)
+	return tc
+}
+
+// SetNillableIsrc sets the "isrc" field if the given value is not nil.
+func (tc *TrackCreate) SetNillableIsrc(s *string) *TrackCreate {
+	if s != nil {
+		tc.SetIsrc(*s)
+	}
+	return tc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tc *TrackCreate) SetCreatedAt(t time.Time) *TrackCreate {
+	tc.mutation.SetCreatedAt(t)
+	return tc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tc *TrackCreate) SetNillableCreatedAt(t *time.Time) *TrackCreate {
+	if t != nil {
+		tc.SetCreatedAt(*t)
+	}
+	return tc
+}
+
+// SetID sets the "id" field.
+func (tc *TrackCreate) SetID(s string) *TrackCreate {
+	tc.mutation.SetID(s)
+	return tc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (tc *TrackCreate) SetNillableID(s *string) *TrackCreate {
+	if s != nil {
+		tc.SetID(*s)
+	}
+	return tc
+}
+
+// SetAlbumID sets the "album" edge to the Album entity by ID.
+func (tc *TrackCreate) SetAlbumID(id string) *TrackCreate {
+	tc.mutation.SetAlbumID(id)
+	return tc
+}
+
+// SetNillableAlbumID sets the "album" edge to the Album entity by ID if the given value is not nil.
+func (tc *TrackCreate) SetNillableAlbumID(id *string) *TrackCreate {
+	if id != nil {
+		tc = tc.SetAlbumID(*id)
+	}
+	return tc
+}
+
+// SetAlbum sets the "album" edge to the Album entity.
+func (tc *TrackCreate) SetAlbum(a *Album) *TrackCreate {
+	return tc.SetAlbumID(a.ID)
+}
+
+// AddArtistIDs adds the "artists" edge to the Artist entity by IDs.
+func (tc *TrackCreate) AddArtistIDs(ids ...string) *TrackCreate {
+	tc.mutation.AddArtistIDs(ids...)
+	return tc
+}
+
+// AddArtists adds the "artists" edges to the Artist entity.
+func (tc *TrackCreate) AddArtists(a ...*Artist) *TrackCreate {
+	ids := make([]string, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return tc.AddArtistIDs(ids...)
+}
+
+// AddSnapshotItemIDs adds the "snapshot_items" edge to the SnapshotItem entity by IDs.
+func (tc *TrackCreate) AddSnapshotItemIDs(ids ...string) *TrackCreate {
+	tc.mutation.AddSnapshotItemIDs(ids...)
+	return tc
+}
+
+// AddSnapshotItems adds the "snapshot_items" edges to the SnapshotItem entity.
+func (tc *TrackCreate) AddSnapshotItems(s ...*SnapshotItem) *TrackCreate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return tc.AddSnapshotItemIDs(ids...)
+}
+
+// AddSavedTrackIDs adds the "saved_tracks" edge to the SavedTrack entity by IDs.
+func (tc *TrackCreate) AddSavedTrackIDs(ids ...string) *TrackCreate {
+	tc.mutation.AddSavedTrackIDs(ids...)
+	return tc
+}
+
+// AddSavedTracks adds the "saved_tracks" edges to the SavedTrack entity.
+func (tc *TrackCreate) AddSavedTracks(s ...*SavedTrack) *TrackCreate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return tc.AddSavedTrackIDs(ids...)
+}
+
+// Mutation returns the TrackMutation object of the builder.
+func (tc *TrackCreate) Mutation() *TrackMutation {
+	return tc.mutation
+}
+
+// Save creates the Track in the database.
+func (tc *TrackCreate) Save(ctx context.Context) (*Track, error) {
+	tc.defaults()
+	return withHooks(ctx, tc.sqlSave, tc.mutation, tc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tc *TrackCreate) SaveX(ctx context.Context) *Track {
+	v, err := tc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tc *TrackCreate) Exec(ctx context.Context) error {
+	_, err := tc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tc *TrackCreate) ExecX(ctx context.Context) {
+	if err := tc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tc *TrackCreate) defaults() {
+	if _, ok := tc.mutation.Explicit(); !ok {
+		v := track.DefaultExplicit
+		tc.mutation.SetExplicit(v)
+	}
+	if _, ok := tc.mutation.DiscNumber(); !ok {
+		v := track.DefaultDiscNumber
+		tc.mutation.SetDiscNumber(v)
+	}
+	if _, ok := tc.mutation.TrackNumber(); !ok {
+		v := track.DefaultTrackNumber
+		tc.mutation.SetTrackNumber(v)
+	}
+	if _, ok := tc.mutation.Isrc(); !ok {
+		v := track.DefaultIsrc
+		tc.mutation.SetIsrc(v)
+	}
+	if _, ok := tc.mutation.CreatedAt(); !ok {
+		v := track.DefaultCreatedAt()
+		tc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := tc.mutation.ID(); !ok {
+		v := track.DefaultID()
+		tc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tc *TrackCreate) check() error {
+	if _, ok := tc.mutation.SpotifyID(); !ok {
+		return &ValidationError{Name: "spotify_id", err: errors.New(`ent: missing required field "Track.spotify_id"`)}
+	}
+	if v, ok := tc.mutation.SpotifyID(); ok {
+		if err := track.SpotifyIDValidator(v); err != nil {
+			return &ValidationError{Name: "spotify_id", err: fmt.Errorf(`ent: validator failed for field "Track.spotify_id": %w`, err)}
+		}
+	}
+	if _, ok := tc.mutation.URI(); !ok {
+		return &ValidationError{Name: "uri", err: errors.New(`ent: missing required field "Track.uri"`)}
+	}
+	if _, ok := tc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Track.name"`)}
+	}
+	if _, ok := tc.mutation.DurationMs(); !ok {
+		return &ValidationError{Name: "duration_ms", err: errors.New(`ent: missing required field "Track.duration_ms"`)}
+	}
+	if v, ok := tc.mutation.DurationMs(); ok {
+		if err := track.DurationMsValidator(v); err != nil {
+			return &ValidationError{Name: "duration_ms", err: fmt.Errorf(`ent: validator failed for field "Track.duration_ms": %w`, err)}
+		}
+	}
+	if _, ok := tc.mutation.Explicit(); !ok {
+		return &ValidationError{Name: "explicit", err: errors.New(`ent: missing required field "Track.explicit"`)}
+	}
+	if _, ok := tc.mutation.DiscNumber(); !ok {
+		return &ValidationError{Name: "disc_number", err: errors.New(`ent: missing required field "Track.disc_number"`)}
+	}
+	if v, ok := tc.mutation.DiscNumber(); ok {
+		if err := track.DiscNumberValidator(v); err != nil {
+			return &ValidationError{Name: "disc_number", err: fmt.Errorf(`ent: validator failed for field "Track.disc_number": %w`, err)}
+		}
+	}
+	if _, ok := tc.mutation.TrackNumber(); !ok {
+		return &ValidationError{Name: "track_number", err: errors.New(`ent: missing required field "Track.track_number"`)}
+	}
+	if v, ok := tc.mutation.TrackNumber(); ok {
+		if err := track.TrackNumberValidator(v); err != nil {
+			return &ValidationError{Name: "track_number", err: fmt.Errorf(`ent: validator failed for field "Track.track_number": %w`, err)}
+		}
+	}
+	if _, ok := tc.mutation.Isrc(); !ok {
+		return &ValidationError{Name: "isrc", err: errors.New(`ent: missing required field "Track.isrc"`)}
+	}
+	if _, ok := tc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Track.created_at"`)}
+	}
+	return nil
+}
+
+func (tc *TrackCreate) sqlSave(ctx context.Context) (*Track, error) {
+	if err := tc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Track.ID type: %T", _spec.ID.Value)
+		}
+	}
+	tc.mutation.id = &_node.ID
+	tc.mutation.done = true
+	return _node, nil
+}
+
+func (tc *TrackCreate) createSpec() (*Track, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Track{config: tc.config}
+		_spec = sqlgraph.NewCreateSpec(track.Table, sqlgraph.NewFieldSpec(track.FieldID, field.TypeString))
+	)
+	if id, ok := tc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := tc.mutation.SpotifyID(); ok {
+		_spec.SetField(track.FieldSpotifyID, field.TypeString, value)
+		_node.SpotifyID = value
+	}
+	if value, ok := tc.mutation.URI(); ok {
+		_spec.SetField(track.FieldURI, field.TypeString, value)
+		_node.URI = value
+	}
+	if value, ok := tc.mutation.Name(); ok {
+		_spec.SetField(track.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := tc.mutation.DurationMs(); ok {
+		_spec.SetField(track.FieldDurationMs, field.TypeInt, value)
+		_node.DurationMs = value
+	}
+	if value, ok := tc.mutation.Explicit(); ok {
+		_spec.SetField(track.FieldExplicit, field.TypeBool, value)
+		_node.Explicit = value
+	}
+	if value, ok := tc.mutation.DiscNumber(); ok {
+		_spec.SetField(track.FieldDiscNumber, field.TypeInt, value)
+		_node.DiscNumber = value
+	}
+	if value, ok := tc.mutation.TrackNumber(); ok {
+		_spec.SetField(track.FieldTrackNumber, field.TypeInt, value)
+		_node.TrackNumber = value
+	}
+	if value, ok := tc.mutation.Isrc(); ok {
+		_spec.SetField(track.FieldIsrc, field.TypeString, value)
+		_node.Isrc = value
+	}
+	if value, ok := tc.mutation.CreatedAt(); ok {
+		_spec.SetField(track.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := tc.mutation.AlbumIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   track.AlbumTable,
+			Columns: []string{track.AlbumColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(album.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.track_album = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.ArtistsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   track.ArtistsTable,
+			Columns: track.ArtistsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artist.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.SnapshotItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   track.SnapshotItemsTable,
+			Columns: []string{track.SnapshotItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshotitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.SavedTracksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   track.SavedTracksTable,
+			Columns: []string{track.SavedTracksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedtrack.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TrackCreateBulk is the builder for creating many Track entities in bulk.
+type TrackCreateBulk struct {
+	config
+	err      error
+	builders []*TrackCreate
+}
+
+// Save creates the Track entities in the database.
+func (tcb *TrackCreateBulk) Save(ctx context.Context) ([]*Track, error) {
+	if tcb.err != nil {
+		return nil, tcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tcb.builders))
+	nodes := make([]*Track, len(tcb.builders))
+	mutators := make([]Mutator, len(tcb.builders))
+	for i := range tcb.builders {
+		func(i int, root context.Context) {
+			builder := tcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TrackMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tcb *TrackCreateBulk) SaveX(ctx context.Context) []*Track {
+	v, err := tcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tcb *TrackCreateBulk) Exec(ctx context.Context) error {
+	_, err := tcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tcb *TrackCreateBulk) ExecX(ctx context.Context) {
+	if err := tcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1117 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"beyerleinf/spotify-backup/ent/album"
+	"beyerleinf/spotify-backup/ent/artist"
+	"beyerleinf/spotify-backup/ent/predicate"
+	"beyerleinf/spotify-backup/ent/savedtrack"
+	"beyerleinf/spotify-backup/ent/snapshotitem"
+	"beyerleinf/spotify-backup/ent/track"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TrackUpdate is the builder for updating Track entities.
+type TrackUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TrackMutation
+}
+
+// Where appends a list predicates to the TrackUpdate builder.
+func (tu *TrackUpdate) Where(ps ...predicate.Track) *TrackUpdate {
+	tu.mutation.Where(ps...)
+	return tu
+}
+
+// SetURI sets the "uri" field.
+func (tu *TrackUpdate) SetURI(s string) *TrackUpdate {
+	tu.mutation.SetURI(s)
+	return tu
+}
+
+// SetNillableURI sets the "uri" field if the given value is not nil.
+func (tu *TrackUpdate) SetNillableURI(s *string) *TrackUpdate {
+	if s != nil {
+		tu.SetURI(*s)
+	}
+	return tu
+}
+
+// SetName sets the "name" field.
+func (tu *TrackUpdate) SetName(s string) *TrackUpdate {
+	tu.mutation.SetName(s)
+	return tu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (tu *TrackUpdate) SetNillableName(s *string) *TrackUpdate {
+	if s != nil {
+		tu.SetName(*s)
+	}
+	return tu
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (tu *TrackUpdate) SetDurationMs(i int) *TrackUpdate {
+	tu.mutation.ResetDurationMs()
+	tu.mutation.SetDurationMs(i)
+	return tu
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (tu *TrackUpdate) SetNillableDurationMs(i *int) *TrackUpdate {
+	if i != nil {
+		tu.SetDurationMs(*i)
+	}
+	return tu
+}
+
+// AddDurationMs adds i to the "duration_ms" field.
+func (tu *TrackUpdate) AddDurationMs(i int) *TrackUpdate {
+	tu.mutation.AddDurationMs(i)
+	return tu
+}
+
+// SetExplicit sets the "explicit" field.
+func (tu *TrackUpdate) SetExplicit(b bool) *TrackUpdate {
+	tu.mutation.SetExplicit(b)
+	return tu
+}
+
+// SetNillableExplicit sets the "explicit" field if the given value is not nil.
+func (tu *TrackUpdate) SetNillableExplicit(b *bool) *TrackUpdate {
+	if b != nil {
+		tu.SetExplicit(*b)
+	}
+	return tu
+}
+
+// SetDiscNumber sets the "disc_number" field.
+func (tu *TrackUpdate) SetDiscNumber(i int) *TrackUpdate {
+	tu.mutation.ResetDiscNumber()
+	tu.mutation.SetDiscNumber(i)
+	return tu
+}
+
+// SetNillableDiscNumber sets the "disc_number" field if the given value is not nil.
+func (tu *TrackUpdate) SetNillableDiscNumber(i *int) *TrackUpdate {
+	if i != nil {
+		tu.SetDiscNumber(*i)
+	}
+	return tu
+}
+
+// AddDiscNumber adds i to the "disc_number" field.
+func (tu *TrackUpdate) AddDiscNumber(i int) *TrackUpdate {
+	tu.mutation.AddDiscNumber(i)
+	return tu
+}
+
+// SetTrackNumber sets the "track_number" field.
+func (tu *TrackUpdate) SetTrackNumber(i int) *TrackUpdate {
+	tu.mutation.ResetTrackNumber()
+	tu.mutation.SetTrackNumber(i)
+	return tu
+}
+
+// SetNillableTrackNumber sets the "track_number" field if the given value is not nil.
+func (tu *TrackUpdate) SetNillableTrackNumber(i *int) *TrackUpdate {
+	if i != nil {
+		tu.SetTrackNumber(*i)
+	}
+	return tu
+}
+
+// AddTrackNumber adds i to the "track_number" field.
+func (tu *TrackUpdate) AddTrackNumber(i int) *TrackUpdate {
+	tu.mutation.AddTrackNumber(i)
+	return tu
+}
+
+// SetIsrc sets the "isrc" field.
+func (tu *TrackUpdate) SetIsrc(s string) *TrackUpdate {
+	tu.mutation.SetIsrc(s)
+	return tu
+}
+
+// SetNillableIsrc sets the "isrc" field if the given value is not nil.
+func (tu *TrackUpdate) SetNillableIsrc(s *string) *TrackUpdate {
+	if s != nil {
+		tu.SetIsrc(*s)
+	}
+	return tu
+}
+
+// SetAlbumID sets the "album" edge to the Album entity by ID.
+func (tu *TrackUpdate) SetAlbumID(id string) *TrackUpdate {
+	tu.mutation.SetAlbumID(id)
+	return tu
+}
+
+// SetNillableAlbumID sets the "album" edge to the Album entity by ID if the given value is not nil.
+func (tu *TrackUpdate) SetNillableAlbumID(id *string) *TrackUpdate {
+	if id != nil {
+		tu = tu.SetAlbumID(*id)
+	}
+	return tu
+}
+
+// SetAlbum sets the "album" edge to the Album entity.
+func (tu *TrackUpdate) SetAlbum(a *Album) *TrackUpdate {
+	return tu.SetAlbumID(a.ID)
+}
+
+// AddArtistIDs adds the "artists" edge to the Artist entity by IDs.
+func (tu *TrackUpdate) AddArtistIDs(ids ...string) *TrackUpdate {
+	tu.mutation.AddArtistIDs(ids...)
+	return tu
+}
+
+// AddArtists adds the "artists" edges to the Artist entity.
+func (tu *TrackUpdate) AddArtists(a ...*Artist) *TrackUpdate {
+	ids := make([]string, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return tu.AddArtistIDs(ids...)
+}
+
+// AddSnapshotItemIDs adds the "snapshot_items" edge to the SnapshotItem entity by IDs.
+func (tu *TrackUpdate) AddSnapshotItemIDs(ids ...string) *TrackUpdate {
+	tu.mutation.AddSnapshotItemIDs(ids...)
+	return tu
+}
+
+// AddSnapshotItems adds the "snapshot_items" edges to the SnapshotItem entity.
+func (tu *TrackUpdate) AddSnapshotItems(s ...*SnapshotItem) *TrackUpdate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return tu.AddSnapshotItemIDs(ids...)
+}
+
+// AddSavedTrackIDs adds the "saved_tracks" edge to the SavedTrack entity by IDs.
+func (tu *TrackUpdate) AddSavedTrackIDs(ids ...string) *TrackUpdate {
+	tu.mutation.AddSavedTrackIDs(ids...)
+	return tu
+}
+
+// AddSavedTracks adds the "saved_tracks" edges to the SavedTrack entity.
+func (tu *TrackUpdate) AddSavedTracks(s ...*SavedTrack) *TrackUpdate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return tu.AddSavedTrackIDs(ids...)
+}
+
+// Mutation returns the TrackMutation object of the builder.
+func (tu *TrackUpdate) Mutation() *TrackMutation {
+	return tu.mutation
+}
+
+// ClearAlbum clears the "album" edge to the Album entity.
+func (tu *TrackUpdate) ClearAlbum() *TrackUpdate {
+	tu.mutation.ClearAlbum()
+	return tu
+}
+
+// ClearArtists clears all "artists" edges to the Artist entity.
+func (tu *TrackUpdate) ClearArtists() *TrackUpdate {
+	tu.mutation.ClearArtists()
+	return tu
+}
+
+// RemoveArtistIDs removes the "artists" edge to Artist entities by IDs.
+func (tu *TrackUpdate) RemoveArtistIDs(ids ...string) *TrackUpdate {
+	tu.mutation.RemoveArtistIDs(ids...)
+	return tu
+}
+
+// RemoveArtists removes "artists" edges to Artist entities.
+func (tu *TrackUpdate) RemoveArtists(a ...*Artist) *TrackUpdate {
+	ids := make([]string, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return tu.RemoveArtistIDs(ids...)
+}
+
+// ClearSnapshotItems clears all "snapshot_items" edges to the SnapshotItem entity.
+func (tu *TrackUpdate) ClearSnapshotItems() *TrackUpdate {
+	tu.mutation.ClearSnapshotItems()
+	return tu
+}
+
+// RemoveSnapshotItemIDs removes the "snapshot_items" edge to SnapshotItem entities by IDs.
+func (tu *TrackUpdate) RemoveSnapshotItemIDs(ids ...string) *TrackUpdate {
+	tu.mutation.RemoveSnapshotItemIDs(ids...)
+	return tu
+}
+
+// RemoveSnapshotItems removes "snapshot_items" edges to SnapshotItem entities.
+func (tu *TrackUpdate) RemoveSnapshotItems(s ...*SnapshotItem) *TrackUpdate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return tu.RemoveSnapshotItemIDs(ids...)
+}
+
+// ClearSavedTracks clears all "saved_tracks" edges to the SavedTrack entity.
+func (tu *TrackUpdate) ClearSavedTracks() *TrackUpdate {
+	tu.mutation.ClearSavedTracks()
+	return tu
+}
+
+// RemoveSavedTrackIDs removes the "saved_tracks" edge to SavedTrack entities by IDs.
+func (tu *TrackUpdate) RemoveSavedTrackIDs(ids ...string) *TrackUpdate {
+	tu.mutation.RemoveSavedTrackIDs(ids...)
+	return tu
+}
+
+// RemoveSavedTracks removes "saved_tracks" edges to SavedTrack entities.
+func (tu *TrackUpdate) RemoveSavedTracks(s ...*SavedTrack) *TrackUpdate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return tu.RemoveSavedTrackIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tu *TrackUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, tu.sqlSave, tu.mutation, tu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tu *TrackUpdate) SaveX(ctx context.Context) int {
+	affected, err := tu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tu *TrackUpdate) Exec(ctx context.Context) error {
+	_, err := tu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tu *TrackUpdate) ExecX(ctx context.Context) {
+	if err := tu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tu *TrackUpdate) check() error {
+	if v, ok := tu.mutation.DurationMs(); ok {
+		if err := track.DurationMsValidator(v); err != nil {
+			return &ValidationError{Name: "duration_ms", err: fmt.Errorf(`ent: validator failed for field "Track.duration_ms": %w`, err)}
+		}
+	}
+	if v, ok := tu.mutation.DiscNumber(); ok {
+		if err := track.DiscNumberValidator(v); err != nil {
+			return &ValidationError{Name: "disc_number", err: fmt.Errorf(`ent: validator failed for field "Track.disc_number": %w`, err)}
+		}
+	}
+	if v, ok := tu.mutation.TrackNumber(); ok {
+		if err := track.TrackNumberValidator(v); err != nil {
+			return &ValidationError{Name: "track_number", err: fmt.Errorf(`ent: validator failed for field "Track.track_number": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (tu *TrackUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(track.Table, track.Columns, sqlgraph.NewFieldSpec(track.FieldID, field.TypeString))
+	if ps := tu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tu.mutation.URI(); ok {
+		_spec.SetField(track.FieldURI, field.TypeString, value)
+	}
+	if value, ok := tu.mutation.Name(); ok {
+		_spec.SetField(track.FieldName, field.TypeString, value)
+	}
+	if value, ok := tu.mutation.DurationMs(); ok {
+		_spec.SetField(track.FieldDurationMs, field.TypeInt, value)
+	}
+	if value, ok := tu.mutation.AddedDurationMs(); ok {
+		_spec.AddField(track.FieldDurationMs, field.TypeInt, value)
+	}
+	if value, ok := tu.mutation.Explicit(); ok {
+		_spec.SetField(track.FieldExplicit, field.TypeBool, value)
+	}
+	if value, ok := tu.mutation.DiscNumber(); ok {
+		_spec.SetField(track.FieldDiscNumber, field.TypeInt, value)
+	}
+	if value, ok := tu.mutation.AddedDiscNumber(); ok {
+		_spec.AddField(track.FieldDiscNumber, field.TypeInt, value)
+	}
+	if value, ok := tu.mutation.TrackNumber(); ok {
+		_spec.SetField(track.FieldTrackNumber, field.TypeInt, value)
+	}
+	if value, ok := tu.mutation.AddedTrackNumber(); ok {
+		_spec.AddField(track.FieldTrackNumber, field.TypeInt, value)
+	}
+	if value, ok := tu.mutation.Isrc(); ok {
+		_spec.SetField(track.FieldIsrc, field.TypeString, value)
+	}
+	if tu.mutation.AlbumCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   track.AlbumTable,
+			Columns: []string{track.AlbumColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(album.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.AlbumIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   track.AlbumTable,
+			Columns: []string{track.AlbumColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(album.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.ArtistsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   track.ArtistsTable,
+			Columns: track.ArtistsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artist.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedArtistsIDs(); len(nodes) > 0 && !tu.mutation.ArtistsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   track.ArtistsTable,
+			Columns: track.ArtistsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artist.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.ArtistsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   track.ArtistsTable,
+			Columns: track.ArtistsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artist.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.SnapshotItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   track.SnapshotItemsTable,
+			Columns: []string{track.SnapshotItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshotitem.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedSnapshotItemsIDs(); len(nodes) > 0 && !tu.mutation.SnapshotItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   track.SnapshotItemsTable,
+			Columns: []string{track.SnapshotItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshotitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.SnapshotItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   track.SnapshotItemsTable,
+			Columns: []string{track.SnapshotItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshotitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.SavedTracksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   track.SavedTracksTable,
+			Columns: []string{track.SavedTracksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedtrack.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedSavedTracksIDs(); len(nodes) > 0 && !tu.mutation.SavedTracksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   track.SavedTracksTable,
+			Columns: []string{track.SavedTracksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedtrack.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.SavedTracksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   track.SavedTracksTable,
+			Columns: []string{track.SavedTracksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedtrack.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{track.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tu.mutation.done = true
+	return n, nil
+}
+
+// TrackUpdateOne is the builder for updating a single Track entity.
+type TrackUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TrackMutation
+}
+
+// SetURI sets the "uri" field.
+func (tuo *TrackUpdateOne) SetURI(s string) *TrackUpdateOne {
+	tuo.mutation.SetURI(s)
+	return tuo
+}
+
+// SetNillableURI sets the "uri" field if the given value is not nil.
+func (tuo *TrackUpdateOne) SetNillableURI(s *string) *TrackUpdateOne {
+	if s != nil {
+		tuo.SetURI(*s)
+	}
+	return tuo
+}
+
+// SetName sets the "name" field.
+func (tuo *TrackUpdateOne) SetName(s string) *TrackUpdateOne {
+	tuo.mutation.SetName(s)
+	return tuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (tuo *TrackUpdateOne) SetNillableName(s *string) *TrackUpdateOne {
+	if s != nil {
+		tuo.SetName(*s)
+	}
+	return tuo
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (tuo *TrackUpdateOne) SetDurationMs(i int) *TrackUpdateOne {
+	tuo.mutation.ResetDurationMs()
+	tuo.mutation.SetDurationMs(i)
+	return tuo
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (tuo *TrackUpdateOne) SetNillableDurationMs(i *int) *TrackUpdateOne {
+	if i != nil {
+		tuo.SetDurationMs(*i)
+	}
+	return tuo
+}
+
+// AddDurationMs adds i to the "duration_ms" field.
+func (tuo *TrackUpdateOne) AddDurationMs(i int) *TrackUpdateOne {
+	tuo.mutation.AddDurationMs(i)
+	return tuo
+}
+
+// SetExplicit sets the "explicit" field.
+func (tuo *TrackUpdateOne) SetExplicit(b bool) *TrackUpdateOne {
+	tuo.mutation.SetExplicit(b)
+	return tuo
+}
+
+// SetNillableExplicit sets the "explicit" field if the given value is not nil.
+func (tuo *TrackUpdateOne) SetNillableExplicit(b *bool) *TrackUpdateOne {
+	if b != nil {
+		tuo.SetExplicit(*b)
+	}
+	return tuo
+}
+
+// SetDiscNumber sets the "disc_number" field.
+func (tuo *TrackUpdateOne) SetDiscNumber(i int) *TrackUpdateOne {
+	tuo.mutation.ResetDiscNumber()
+	tuo.mutation.SetDiscNumber(i)
+	return tuo
+}
+
+// SetNillableDiscNumber sets the "disc_number" field if the given value is not nil.
+func (tuo *TrackUpdateOne) SetNillableDiscNumber(i *int) *TrackUpdateOne {
+	if i != nil {
+		tuo.SetDiscNumber(*i)
+	}
+	return tuo
+}
+
+// AddDiscNumber adds i to the "disc_number" field.
+func (tuo *TrackUpdateOne) AddDiscNumber(i int) *TrackUpdateOne {
+	tuo.mutation.AddDiscNumber(i)
+	return tuo
+}
+
+// SetTrackNumber sets the "track_number" field.
+func (tuo *TrackUpdateOne) SetTrackNumber(i int) *TrackUpdateOne {
+	tuo.mutation.ResetTrackNumber()
+	tuo.mutation.SetTrackNumber(i)
+	return tuo
+}
+
+// SetNillableTrackNumber sets the "track_number" field if the given value is not nil.
+func (tuo *TrackUpdateOne) SetNillableTrackNumber(i *int) *TrackUpdateOne {
+	if i != nil {
+		tuo.SetTrackNumber(*i)
+	}
+	return tuo
+}
+
+// AddTrackNumber adds i to the "track_number" field.
+func (tuo *TrackUpdateOne) AddTrackNumber(i int) *TrackUpdateOne {
+	tuo.mutation.AddTrackNumber(i)
+	return tuo
+}
+
+// SetIsrc sets the "isrc" field.
+func (tuo *TrackUpdateOne) SetIsrc(s string) *TrackUpdateOne {
+	tuo.mutation.SetIsrc(s)
+	return tuo
+}
+
+// SetNillableIsrc sets the "isrc" field if the given value is not nil.
+func (tuo *TrackUpdateOne) SetNillableIsrc(s *string) *TrackUpdateOne {
+	if s != nil {
+		tuo.SetIsrc(*s)
+	}
+	return tuo
+}
+
+// SetAlbumID sets the "album" edge to the Album entity by ID.
+func (tuo *TrackUpdateOne) SetAlbumID(id string) *TrackUpdateOne {
+	tuo.mutation.SetAlbumID(id)
+	return tuo
+}
+
+// SetNillableAlbumID sets the "album" edge to the Album entity by ID if the given value is not nil.
+func (tuo *TrackUpdateOne) SetNillableAlbumID(id *string) *TrackUpdateOne {
+	if id != nil {
+		tuo = tuo.SetAlbumID(*id)
+	}
+	return tuo
+}
+
+// SetAlbum sets the "album" edge to the Album entity.
+func (tuo *TrackUpdateOne) SetAlbum(a *Album) *TrackUpdateOne {
+	return tuo.SetAlbumID(a.ID)
+}
+
+// AddArtistIDs adds the "artists" edge to the Artist entity by IDs.
+func (tuo *TrackUpdateOne) AddArtistIDs(ids ...string) *TrackUpdateOne {
+	tuo.mutation.AddArtistIDs(ids...)
+	return tuo
+}
+
+// AddArtists adds the "artists" edges to the Artist entity.
+func (tuo *TrackUpdateOne) AddArtists(a ...*Artist) *TrackUpdateOne {
+	ids := make([]string, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return tuo.AddArtistIDs(ids...)
+}
+
+// AddSnapshotItemIDs adds the "snapshot_items" edge to the SnapshotItem entity by IDs.
+func (tuo *TrackUpdateOne) AddSnapshotItemIDs(ids ...string) *TrackUpdateOne {
+	tuo.mutation.AddSnapshotItemIDs(ids...)
+	return tuo
+}
+
+// AddSnapshotItems adds the "snapshot_items" edges to the SnapshotItem entity.
+func (tuo *TrackUpdateOne) AddSnapshotItems(s ...*SnapshotItem) *TrackUpdateOne {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return tuo.AddSnapshotItemIDs(ids...)
+}
+
+// AddSavedTrackIDs adds the "saved_tracks" edge to the SavedTrack entity by IDs.
+func (tuo *TrackUpdateOne) AddSavedTrackIDs(ids ...string) *TrackUpdateOne {
+	tuo.mutation.AddSavedTrackIDs(ids...)
+	return tuo
+}
+
+// AddSavedTracks adds the "saved_tracks" edges to the SavedTrack entity.
+func (tuo *TrackUpdateOne) AddSavedTracks(s ...*SavedTrack) *TrackUpdateOne {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return tuo.AddSavedTrackIDs(ids...)
+}
+
+// Mutation returns the TrackMutation object of the builder.
+func (tuo *TrackUpdateOne) Mutation() *TrackMutation {
+	return tuo.mutation
+}
+
+// ClearAlbum clears the "album" edge to the Album entity.
+func (tuo *TrackUpdateOne) ClearAlbum() *TrackUpdateOne {
+	tuo.mutation.ClearAlbum()
+	return tuo
+}
+
+// ClearArtists clears all "artists" edges to the Artist entity.
+func (tuo *TrackUpdateOne) ClearArtists() *TrackUpdateOne {
+	tuo.mutation.ClearArtists()
+	return tuo
+}
+
+// RemoveArtistIDs removes the "artists" edge to Artist entities by IDs.
+func (tuo *TrackUpdateOne) RemoveArtistIDs(ids ...string) *TrackUpdateOne {
+	tuo.mutation.RemoveArtistIDs(ids...)
+	return tuo
+}
+
+// RemoveArtists removes "artists" edges to Artist entities.
+func (tuo *TrackUpdateOne) RemoveArtists(a ...*Artist) *TrackUpdateOne {
+	ids := make([]string, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return tuo.RemoveArtistIDs(ids...)
+}
+
+// ClearSnapshotItems clears all "snapshot_items" edges to the SnapshotItem entity.
+func (tuo *TrackUpdateOne) ClearSnapshotItems() *TrackUpdateOne {
+	tuo.mutation.ClearSnapshotItems()
+	return tuo
+}
+
+// RemoveSnapshotItemIDs removes the "snapshot_items" edge to SnapshotItem entities by IDs.
+func (tuo *TrackUpdateOne) RemoveSnapshotItemIDs(ids ...string) *TrackUpdateOne {
+	tuo.mutation.RemoveSnapshotItemIDs(ids...)
+	return tuo
+}
+
+// RemoveSnapshotItems removes "snapshot_items" edges to SnapshotItem entities.
+func (tuo *TrackUpdateOne) RemoveSnapshotItems(s ...*SnapshotItem) *TrackUpdateOne {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return tuo.RemoveSnapshotItemIDs(ids...)
+}
+
+// ClearSavedTracks clears all "saved_tracks" edges to the SavedTrack entity.
+func (tuo *TrackUpdateOne) ClearSavedTracks() *TrackUpdateOne {
+	tuo.mutation.ClearSavedTracks()
+	return tuo
+}
+
+// RemoveSavedTrackIDs removes the "saved_tracks" edge to SavedTrack entities by IDs.
+func (tuo *TrackUpdateOne) RemoveSavedTrackIDs(ids ...string) *TrackUpdateOne {
+	tuo.mutation.RemoveSavedTrackIDs(ids...)
+	return tuo
+}
+
+// RemoveSavedTracks removes "saved_tracks" edges to SavedTrack entities.
+func (tuo *TrackUpdateOne) RemoveSavedTracks(s ...*SavedTrack) *TrackUpdateOne {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return tuo.RemoveSavedTrackIDs(ids...)
+}
+
+// Where appends a list predicates to the TrackUpdate builder.
+func (tuo *TrackUpdateOne) Where(ps ...predicate.Track) *TrackUpdateOne {
+	tuo.mutation.Where(ps...)
+	return tuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tuo *TrackUpdateOne) Select(field string, fields ...string) *TrackUpdateOne {
+	tuo.fields = append([]string{field}, fields...)
+	return tuo
+}
+
+// Save executes the query and returns the updated Track entity.
+func (tuo *TrackUpdateOne) Save(ctx context.Context) (*Track, error) {
+	return withHooks(ctx, tuo.sqlSave, tuo.mutation, tuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tuo *TrackUpdateOne) SaveX(ctx context.Context) *Track {
+	node, err := tuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tuo *TrackUpdateOne) Exec(ctx context.Context) error {
+	_, err := tuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tuo *TrackUpdateOne) ExecX(ctx context.Context) {
+	if err := tuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tuo *TrackUpdateOne) check() error {
+	if v, ok := tuo.mutation.DurationMs(); ok {
+		if err := track.DurationMsValidator(v); err != nil {
+			return &ValidationError{Name: "duration_ms", err: fmt.Errorf(`ent: validator failed for field "Track.duration_ms": %w`, err)}
+		}
+	}
+	if v, ok := tuo.mutation.DiscNumber(); ok {
+		if err := track.DiscNumberValidator(v); err != nil {
+			return &ValidationError{Name: "disc_number", err: fmt.Errorf(`ent: validator failed for field "Track.disc_number": %w`, err)}
+		}
+	}
+	if v, ok := tuo.mutation.TrackNumber(); ok {
+		if err := track.TrackNumberValidator(v); err != nil {
+			return &ValidationError{Name: "track_number", err: fmt.Errorf(`ent: validator failed for field "Track.track_number": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (tuo *TrackUpdateOne) sqlSave(ctx context.Context) (_node *Track, err error) {
+	if err := tuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(track.Table, track.Columns, sqlgraph.NewFieldSpec(track.FieldID, field.TypeString))
+	id, ok := tuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Track.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, track.FieldID)
+		for _, f := range fields {
+			if !track.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != track.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tuo.mutation.URI(); ok {
+		_spec.SetField(track.FieldURI, field.TypeString, value)
+	}
+	if value, ok := tuo.mutation.Name(); ok {
+		_spec.SetField(track.FieldName, field.TypeString, value)
+	}
+	if value, ok := tuo.mutation.DurationMs(); ok {
+		_spec.SetField(track.FieldDurationMs, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.AddedDurationMs(); ok {
+		_spec.AddField(track.FieldDurationMs, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.Explicit(); ok {
+		_spec.SetField(track.FieldExplicit, field.TypeBool, value)
+	}
+	if value, ok := tuo.mutation.DiscNumber(); ok {
+		_spec.SetField(track.FieldDiscNumber, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.AddedDiscNumber(); ok {
+		_spec.AddField(track.FieldDiscNumber, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.TrackNumber(); ok {
+		_spec.SetField(track.FieldTrackNumber, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.AddedTrackNumber(); ok {
+		_spec.AddField(track.FieldTrackNumber, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.Isrc(); ok {
+		_spec.SetField(track.FieldIsrc, field.TypeString, value)
+	}
+	if tuo.mutation.AlbumCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   track.AlbumTable,
+			Columns: []string{track.AlbumColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(album.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.AlbumIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   track.AlbumTable,
+			Columns: []string{track.AlbumColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(album.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.ArtistsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   track.ArtistsTable,
+			Columns: track.ArtistsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artist.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedArtistsIDs(); len(nodes) > 0 && !tuo.mutation.ArtistsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   track.ArtistsTable,
+			Columns: track.ArtistsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artist.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.ArtistsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   track.ArtistsTable,
+			Columns: track.ArtistsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artist.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.SnapshotItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   track.SnapshotItemsTable,
+			Columns: []string{track.SnapshotItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshotitem.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedSnapshotItemsIDs(); len(nodes) > 0 && !tuo.mutation.SnapshotItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   track.SnapshotItemsTable,
+			Columns: []string{track.SnapshotItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshotitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.SnapshotItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   track.SnapshotItemsTable,
+			Columns: []string{track.SnapshotItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshotitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.SavedTracksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   track.SavedTracksTable,
+			Columns: []string{track.SavedTracksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedtrack.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedSavedTracksIDs(); len(nodes) > 0 && !tuo.mutation.SavedTracksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   track.SavedTracksTable,
+			Columns: []string{track.SavedTracksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedtrack.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.SavedTracksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   track.SavedTracksTable,
+			Columns: []string{track.SavedTracksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedtrack.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Track{config: tuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{track.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tuo.mutation.done = true
+	return _node, nil
+}
@@ -4,6 +4,8 @@ package ent
 
 import (
 	"context"
+	stdsql "database/sql"
+	"fmt"
 	"sync"
 
 	"entgo.io/ent/dialect"
@@ -12,6 +14,52 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Album is the client for interacting with the Album builders.
+	Album *AlbumClient
+	// Artist is the client for interacting with the Artist builders.
+	Artist *ArtistClient
+	// AuthState is the client for interacting with the AuthState builders.
+	AuthState *AuthStateClient
+	// BackupRun is the client for interacting with the BackupRun builders.
+	BackupRun *BackupRunClient
+	// BackupSchedule is the client for interacting with the BackupSchedule builders.
+	BackupSchedule *BackupScheduleClient
+	// Episode is the client for interacting with the Episode builders.
+	Episode *EpisodeClient
+	// FollowedArtist is the client for interacting with the FollowedArtist builders.
+	FollowedArtist *FollowedArtistClient
+	// Job is the client for interacting with the Job builders.
+	Job *JobClient
+	// Lease is the client for interacting with the Lease builders.
+	Lease *LeaseClient
+	// Playlist is the client for interacting with the Playlist builders.
+	Playlist *PlaylistClient
+	// PlaylistSnapshot is the client for interacting with the PlaylistSnapshot builders.
+	PlaylistSnapshot *PlaylistSnapshotClient
+	// RestorePlan is the client for interacting with the RestorePlan builders.
+	RestorePlan *RestorePlanClient
+	// RetentionPolicy is the client for interacting with the RetentionPolicy builders.
+	RetentionPolicy *RetentionPolicyClient
+	// SavedAlbum is the client for interacting with the SavedAlbum builders.
+	SavedAlbum *SavedAlbumClient
+	// SavedAudiobook is the client for interacting with the SavedAudiobook builders.
+	SavedAudiobook *SavedAudiobookClient
+	// SavedEpisode is the client for interacting with the SavedEpisode builders.
+	SavedEpisode *SavedEpisodeClient
+	// SavedShow is the client for interacting with the SavedShow builders.
+	SavedShow *SavedShowClient
+	// SavedTrack is the client for interacting with the SavedTrack builders.
+	SavedTrack *SavedTrackClient
+	// SchemaMigration is the client for interacting with the SchemaMigration builders.
+	SchemaMigration *SchemaMigrationClient
+	// Show is the client for interacting with the Show builders.
+	Show *ShowClient
+	// SnapshotItem is the client for interacting with the SnapshotItem builders.
+	SnapshotItem *SnapshotItemClient
+	// SpotifyToken is the client for interacting with the SpotifyToken builders.
+	SpotifyToken *SpotifyTokenClient
+	// Track is the client for interacting with the Track builders.
+	Track *TrackClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 
@@ -145,6 +193,29 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Album = NewAlbumClient(tx.config)
+	tx.Artist = NewArtistClient(tx.config)
+	tx.AuthState = NewAuthStateClient(tx.config)
+	tx.BackupRun = NewBackupRunClient(tx.config)
+	tx.BackupSchedule = NewBackupScheduleClient(tx.config)
+	tx.Episode = NewEpisodeClient(tx.config)
+	tx.FollowedArtist = NewFollowedArtistClient(tx.config)
+	tx.Job = NewJobClient(tx.config)
+	tx.Lease = NewLeaseClient(tx.config)
+	tx.Playlist = NewPlaylistClient(tx.config)
+	tx.PlaylistSnapshot = NewPlaylistSnapshotClient(tx.config)
+	tx.RestorePlan = NewRestorePlanClient(tx.config)
+	tx.RetentionPolicy = NewRetentionPolicyClient(tx.config)
+	tx.SavedAlbum = NewSavedAlbumClient(tx.config)
+	tx.SavedAudiobook = NewSavedAudiobookClient(tx.config)
+	tx.SavedEpisode = NewSavedEpisodeClient(tx.config)
+	tx.SavedShow = NewSavedShowClient(tx.config)
+	tx.SavedTrack = NewSavedTrackClient(tx.config)
+	tx.SchemaMigration = NewSchemaMigrationClient(tx.config)
+	tx.Show = NewShowClient(tx.config)
+	tx.SnapshotItem = NewSnapshotItemClient(tx.config)
+	tx.SpotifyToken = NewSpotifyTokenClient(tx.config)
+	tx.Track = NewTrackClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
 
@@ -155,7 +226,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: User.QueryXXX(), the query will be executed
+// applies a query, for example: Album.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -208,3 +279,27 @@ func (tx *txDriver) Query(ctx context.Context, query string, args, v any) error
 }
 
 var _ dialect.Driver = (*txDriver)(nil)
+
+// ExecContext allows calling the underlying ExecContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.ExecContext for more information.
+func (tx *txDriver) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := tx.tx.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.QueryContext for more information.
+func (tx *txDriver) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := tx.tx.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
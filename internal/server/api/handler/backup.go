@@ -0,0 +1,261 @@
+package handler
+
+import (
+	"beyerleinf/spotify-backup/ent"
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/internal/server/config"
+	"beyerleinf/spotify-backup/pkg/diff"
+	"beyerleinf/spotify-backup/pkg/logger"
+	"beyerleinf/spotify-backup/pkg/service/queue"
+	"beyerleinf/spotify-backup/pkg/service/spotify"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	defaultBackupRunsLimit = 20
+	maxBackupRunsLimit     = 100
+)
+
+// A BackupHandler instance.
+type BackupHandler struct {
+	slogger        *logger.Logger
+	spotifyService *spotify.Service
+	config         *config.Config
+}
+
+// NewBackupHandler creates a new instance of the [BackupHandler].
+func NewBackupHandler(spotifyService *spotify.Service, config *config.Config) *BackupHandler {
+	return &BackupHandler{
+		slogger:        logger.New("backup-api", config.Server.LogLevel),
+		spotifyService: spotifyService,
+		config:         config,
+	}
+}
+
+// GetBackupRuns returns the most recent backup runs, newest first.
+// The number of runs is set by the limit query parameter.
+func (h *BackupHandler) GetBackupRuns(c echo.Context) error {
+	limit := defaultBackupRunsLimit
+	if value := c.QueryParam("limit"); value != "" {
+		var err error
+		limit, err = strconv.Atoi(value)
+		if err != nil || limit < 1 || limit > maxBackupRunsLimit {
+			return echo.NewHTTPError(http.StatusBadRequest, "limit must be between 1 and "+strconv.Itoa(maxBackupRunsLimit))
+		}
+	}
+
+	runs, err := h.spotifyService.BackupRuns(c.Request().Context(), limit)
+	if err != nil {
+		h.slogger.Error("Failed to load backup runs", "err", err)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, runs)
+}
+
+// GetBackupRun returns a single backup run including its playlist snapshots.
+func (h *BackupHandler) GetBackupRun(c echo.Context) error {
+	run, err := h.spotifyService.GetBackupRun(c.Request().Context(), c.Param("id"))
+	if ent.IsNotFound(err) {
+		return echo.NewHTTPError(http.StatusNotFound, "backup run not found")
+	}
+	if err != nil {
+		h.slogger.Error("Failed to load backup run", "err", err)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, run)
+}
+
+// CreateBackupRun queues a backup of the current user's library and returns
+// the job. The run shows up in the history once the job has started.
+func (h *BackupHandler) CreateBackupRun(c echo.Context) error {
+	j, err := h.spotifyService.EnqueueCurrentUserBackup(c.Request().Context(), backuprun.TriggerAPI)
+	if err != nil {
+		h.slogger.Error("Failed to queue backup", "err", err)
+
+		if errors.Is(err, queue.ErrDuplicate) {
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		}
+
+		var unauthenticated *spotify.UnauthenticatedError
+		if errors.As(err, &unauthenticated) {
+			return echo.NewHTTPError(http.StatusUnauthorized, "not authenticated with Spotify")
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusAccepted, j)
+}
+
+// UpdateBackupRun pins, describes or labels a backup run. Properties that
+// are missing from the request body are left unchanged.
+func (h *BackupHandler) UpdateBackupRun(c echo.Context) error {
+	var annotation spotify.Annotation
+	if err := c.Bind(&annotation); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	run, err := h.spotifyService.AnnotateBackupRun(c.Request().Context(), c.Param("id"), annotation)
+	if err != nil {
+		return h.annotationError(err, "backup run not found")
+	}
+
+	return c.JSON(http.StatusOK, run)
+}
+
+// UpdatePlaylistSnapshot pins, describes or labels a playlist snapshot like [BackupHandler.UpdateBackupRun].
+func (h *BackupHandler) UpdatePlaylistSnapshot(c echo.Context) error {
+	var annotation spotify.Annotation
+	if err := c.Bind(&annotation); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	snapshot, err := h.spotifyService.AnnotatePlaylistSnapshot(c.Request().Context(), c.Param("id"), annotation)
+	if err != nil {
+		return h.annotationError(err, "playlist snapshot not found")
+	}
+
+	return c.JSON(http.StatusOK, snapshot)
+}
+
+func (h *BackupHandler) annotationError(err error, notFound string) error {
+	switch {
+	case ent.IsNotFound(err):
+		return echo.NewHTTPError(http.StatusNotFound, notFound)
+	case errors.Is(err, spotify.ErrInvalidAnnotation):
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	default:
+		h.slogger.Error("Failed to save annotation", "err", err)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+}
+
+// RestoreBackupRun resets saved collections of the current user to the state
+// of a backup run and returns the job. It plans the restore with the
+// overwrite strategy and queues applying the plan. The collections are set by
+// the collections property of the request body, by default every collection
+// that can be restored is.
+func (h *BackupHandler) RestoreBackupRun(c echo.Context) error {
+	var body struct {
+		Collections []string `json:"collections"`
+	}
+	if err := c.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	j, err := h.spotifyService.EnqueueCollectionsRestore(c.Request().Context(), c.Param("id"), body.Collections)
+	if err != nil {
+		return h.restoreError(err, "backup run not found")
+	}
+
+	return c.JSON(http.StatusAccepted, j)
+}
+
+// GetBackupRunDiff returns what changed in the playlists and saved collections
+// since an earlier backup run. The earlier run is set by the from query
+// parameter. Otherwise it is the latest backup that started before the since
+// query parameter, a date or RFC 3339 timestamp, or before the run itself.
+// With format=text the diff is returned as human-readable text.
+func (h *BackupHandler) GetBackupRunDiff(c echo.Context) error {
+	var since time.Time
+	if value := c.QueryParam("since"); value != "" {
+		var err error
+		since, err = parseTime(value)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "since must be a date or RFC 3339 timestamp")
+		}
+	}
+
+	runDiff, err := h.spotifyService.DiffBackupRuns(c.Request().Context(), c.QueryParam("from"), c.Param("id"), since)
+	switch {
+	case ent.IsNotFound(err):
+		return echo.NewHTTPError(http.StatusNotFound, "backup run not found")
+	case errors.Is(err, spotify.ErrNoEarlierBackup):
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	case err != nil:
+		h.slogger.Error("Failed to compare backup runs", "err", err)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	if c.QueryParam("format") == "text" {
+		if len(runDiff.Diffs) == 0 {
+			return c.String(http.StatusOK, "No changes\n")
+		}
+
+		return c.String(http.StatusOK, diff.Text(runDiff.Diffs...))
+	}
+
+	return c.JSON(http.StatusOK, runDiff)
+}
+
+// GetPlaylistSnapshotDiff returns what changed in a playlist snapshot since the
+// snapshot set by the from query parameter or the previous snapshot of the
+// playlist. With format=text the diff is returned as human-readable text.
+func (h *BackupHandler) GetPlaylistSnapshotDiff(c echo.Context) error {
+	d, err := h.spotifyService.DiffPlaylistSnapshots(c.Request().Context(), c.QueryParam("from"), c.Param("id"))
+	if ent.IsNotFound(err) {
+		return echo.NewHTTPError(http.StatusNotFound, "playlist snapshot not found")
+	}
+	if err != nil {
+		h.slogger.Error("Failed to compare playlist snapshots", "err", err)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	if c.QueryParam("format") == "text" {
+		return c.String(http.StatusOK, diff.Text(d))
+	}
+
+	return c.JSON(http.StatusOK, d)
+}
+
+// RestorePlaylistSnapshot restores a playlist snapshot to Spotify and returns
+// the job. It plans the restore with the overwrite strategy and queues
+// applying the plan, so the playlist is reset if the current user still owns it.
+func (h *BackupHandler) RestorePlaylistSnapshot(c echo.Context) error {
+	j, err := h.spotifyService.EnqueuePlaylistRestore(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		return h.restoreError(err, "playlist snapshot not found")
+	}
+
+	return c.JSON(http.StatusAccepted, j)
+}
+
+// restoreError maps an error of queueing a restore to an HTTP error.
+func (h *BackupHandler) restoreError(err error, notFound string) error {
+	var (
+		drift           *spotify.PlanDriftError
+		unauthenticated *spotify.UnauthenticatedError
+	)
+	switch {
+	case ent.IsNotFound(err):
+		return echo.NewHTTPError(http.StatusNotFound, notFound)
+	case errors.Is(err, spotify.ErrNothingToRestore),
+		errors.Is(err, spotify.ErrIncompleteSnapshot),
+		errors.Is(err, spotify.ErrCollectionNotRestorable),
+		errors.Is(err, spotify.ErrCollectionNotBackedUp):
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	case errors.As(err, &drift), errors.Is(err, queue.ErrDuplicate):
+		return echo.NewHTTPError(http.StatusConflict, err.Error())
+	case errors.As(err, &unauthenticated):
+		return echo.NewHTTPError(http.StatusUnauthorized, "not authenticated with Spotify")
+	default:
+		h.slogger.Error("Failed to queue restore", "err", err)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+}
+
+// parseTime parses a date or RFC 3339 timestamp. Dates are in UTC.
+func parseTime(value string) (time.Time, error) {
+	if t, err := time.Parse(time.DateOnly, value); err == nil {
+		return t, nil
+	}
+
+	return time.Parse(time.RFC3339, value)
+}
@@ -4,6 +4,7 @@ import (
 	"beyerleinf/spotify-backup/ent"
 	"beyerleinf/spotify-backup/internal/server/config"
 	"beyerleinf/spotify-backup/pkg/logger"
+	"beyerleinf/spotify-backup/pkg/service/leader"
 	"context"
 	"fmt"
 	"net/http"
@@ -15,14 +16,16 @@ import (
 type HealthHandler struct {
 	slogger *logger.Logger
 	db      *ent.Client
+	elector *leader.Elector
 	config  *config.Config
 }
 
 // NewHealthHandler creates a new instance of the [HealthHandler].
-func NewHealthHandler(db *ent.Client, config *config.Config) *HealthHandler {
+func NewHealthHandler(db *ent.Client, elector *leader.Elector, config *config.Config) *HealthHandler {
 	return &HealthHandler{
 		slogger: logger.New("health-check", config.Server.LogLevel),
 		db:      db,
+		elector: elector,
 		config:  config,
 	}
 }
@@ -30,10 +33,11 @@ func NewHealthHandler(db *ent.Client, config *config.Config) *HealthHandler {
 // GetHealthStatus checks the health status of various components and
 // returns an API response.
 func (h *HealthHandler) GetHealthStatus(c echo.Context) error {
-	dbErr := h.testDBConnection()
+	dbErr := h.testDBConnection(c.Request().Context())
 
 	res := map[string]string{
-		"status": "ok",
+		"status":   "ok",
+		"instance": h.elector.ID(),
 	}
 
 	if dbErr != nil {
@@ -42,12 +46,20 @@ func (h *HealthHandler) GetHealthStatus(c echo.Context) error {
 		res["database"] = "ok"
 	}
 
+	// leader is the instance that schedules backups. It is empty
+	// while no instance holds the lease, e.g. during a failover.
+	res["leader"] = ""
+	if lease, err := h.elector.Leader(c.Request().Context()); err != nil {
+		h.slogger.Error("failed to query leader", "err", err)
+		res["leader"] = "err"
+	} else if lease != nil {
+		res["leader"] = lease.Holder
+	}
+
 	return c.JSON(http.StatusOK, res)
 }
 
-func (h *HealthHandler) testDBConnection() error {
-	ctx := context.Background()
-
+func (h *HealthHandler) testDBConnection(ctx context.Context) error {
 	err := h.db.Ping(ctx)
 	if err != nil {
 		h.slogger.Error("failed to query database", "err", err)
@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"beyerleinf/spotify-backup/ent"
+	"beyerleinf/spotify-backup/internal/server/config"
+	"beyerleinf/spotify-backup/pkg/logger"
+	"beyerleinf/spotify-backup/pkg/service/queue"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	defaultJobsLimit = 20
+	maxJobsLimit     = 100
+)
+
+// A JobHandler instance.
+type JobHandler struct {
+	slogger *logger.Logger
+	queue   *queue.Queue
+	config  *config.Config
+}
+
+// NewJobHandler creates a new instance of the [JobHandler].
+func NewJobHandler(queue *queue.Queue, config *config.Config) *JobHandler {
+	return &JobHandler{
+		slogger: logger.New("job-api", config.Server.LogLevel),
+		queue:   queue,
+		config:  config,
+	}
+}
+
+// GetJobs returns the most recent jobs, newest first.
+// The number of jobs is set by the limit query parameter.
+func (h *JobHandler) GetJobs(c echo.Context) error {
+	limit := defaultJobsLimit
+	if value := c.QueryParam("limit"); value != "" {
+		var err error
+		limit, err = strconv.Atoi(value)
+		if err != nil || limit < 1 || limit > maxJobsLimit {
+			return echo.NewHTTPError(http.StatusBadRequest, "limit must be between 1 and "+strconv.Itoa(maxJobsLimit))
+		}
+	}
+
+	jobs, err := h.queue.Jobs(c.Request().Context(), limit)
+	if err != nil {
+		h.slogger.Error("Failed to load jobs", "err", err)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, jobs)
+}
+
+// GetJob returns a single job.
+func (h *JobHandler) GetJob(c echo.Context) error {
+	j, err := h.queue.GetJob(c.Request().Context(), c.Param("id"))
+	if ent.IsNotFound(err) {
+		return echo.NewHTTPError(http.StatusNotFound, "job not found")
+	}
+	if err != nil {
+		h.slogger.Error("Failed to load job", "err", err)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, j)
+}
+
+// CancelJob cancels a pending or running job and returns it.
+func (h *JobHandler) CancelJob(c echo.Context) error {
+	ctx := c.Request().Context()
+	id := c.Param("id")
+
+	err := h.queue.Cancel(ctx, id)
+	switch {
+	case ent.IsNotFound(err):
+		return echo.NewHTTPError(http.StatusNotFound, "job not found")
+	case errors.Is(err, queue.ErrNotCancelable):
+		return echo.NewHTTPError(http.StatusConflict, err.Error())
+	case err != nil:
+		h.slogger.Error("Failed to cancel job", "err", err)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	j, err := h.queue.GetJob(ctx, id)
+	if err != nil {
+		h.slogger.Error("Failed to load job", "err", err)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, j)
+}
@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"beyerleinf/spotify-backup/ent"
+	"beyerleinf/spotify-backup/internal/server/config"
+	"beyerleinf/spotify-backup/pkg/logger"
+	"beyerleinf/spotify-backup/pkg/service/spotify"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// A PlaylistHandler instance.
+type PlaylistHandler struct {
+	slogger        *logger.Logger
+	spotifyService *spotify.Service
+	config         *config.Config
+}
+
+// NewPlaylistHandler creates a new instance of the [PlaylistHandler].
+func NewPlaylistHandler(spotifyService *spotify.Service, config *config.Config) *PlaylistHandler {
+	return &PlaylistHandler{
+		slogger:        logger.New("playlist-api", config.Server.LogLevel),
+		spotifyService: spotifyService,
+		config:         config,
+	}
+}
+
+// GetPlaylists returns all backed up playlists.
+func (h *PlaylistHandler) GetPlaylists(c echo.Context) error {
+	playlists, err := h.spotifyService.Playlists(c.Request().Context())
+	if err != nil {
+		h.slogger.Error("Failed to load playlists", "err", err)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, playlists)
+}
+
+// GetPlaylistAt returns a playlist as it looked at the time given by the time
+// query parameter, an RFC 3339 timestamp or a date. A date refers to the end
+// of that day in UTC. Without a time, the latest backup of the playlist is returned.
+func (h *PlaylistHandler) GetPlaylistAt(c echo.Context) error {
+	at := time.Now()
+	if value := c.QueryParam("time"); value != "" {
+		var err error
+		at, err = parseTimeAt(value)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "time must be a date or RFC 3339 timestamp")
+		}
+	}
+
+	playlist, err := h.spotifyService.PlaylistAt(c.Request().Context(), c.Param("id"), at)
+	switch {
+	case ent.IsNotFound(err):
+		return echo.NewHTTPError(http.StatusNotFound, "playlist not found")
+	case errors.Is(err, spotify.ErrPlaylistNotInBackup):
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	case err != nil:
+		h.slogger.Error("Failed to load playlist", "err", err)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, playlist)
+}
+
+// parseTimeAt parses a date or RFC 3339 timestamp. Dates refer to the end of the day in UTC.
+func parseTimeAt(value string) (time.Time, error) {
+	if t, err := time.Parse(time.DateOnly, value); err == nil {
+		return t.AddDate(0, 0, 1).Add(-time.Nanosecond), nil
+	}
+
+	return time.Parse(time.RFC3339, value)
+}
@@ -0,0 +1,135 @@
+package handler
+
+import (
+	"beyerleinf/spotify-backup/ent"
+	"beyerleinf/spotify-backup/internal/server/config"
+	"beyerleinf/spotify-backup/pkg/logger"
+	"beyerleinf/spotify-backup/pkg/service/queue"
+	"beyerleinf/spotify-backup/pkg/service/spotify"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	defaultRestorePlansLimit = 20
+	maxRestorePlansLimit     = 100
+)
+
+// A RestoreHandler instance.
+type RestoreHandler struct {
+	slogger        *logger.Logger
+	spotifyService *spotify.Service
+	config         *config.Config
+}
+
+// NewRestoreHandler creates a new instance of the [RestoreHandler].
+func NewRestoreHandler(spotifyService *spotify.Service, config *config.Config) *RestoreHandler {
+	return &RestoreHandler{
+		slogger:        logger.New("restore-api", config.Server.LogLevel),
+		spotifyService: spotifyService,
+		config:         config,
+	}
+}
+
+// GetRestorePlans returns the most recent restore plans, newest first. The
+// number of plans is set by the limit query parameter and the run_id query
+// parameter only returns the plans that restore from that backup run.
+func (h *RestoreHandler) GetRestorePlans(c echo.Context) error {
+	limit := defaultRestorePlansLimit
+	if value := c.QueryParam("limit"); value != "" {
+		var err error
+		limit, err = strconv.Atoi(value)
+		if err != nil || limit < 1 || limit > maxRestorePlansLimit {
+			return echo.NewHTTPError(http.StatusBadRequest, "limit must be between 1 and "+strconv.Itoa(maxRestorePlansLimit))
+		}
+	}
+
+	plans, err := h.spotifyService.RestorePlans(c.Request().Context(), c.QueryParam("run_id"), limit)
+	if err != nil {
+		h.slogger.Error("Failed to load restore plans", "err", err)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, plans)
+}
+
+// GetRestorePlan returns a single restore plan.
+func (h *RestoreHandler) GetRestorePlan(c echo.Context) error {
+	plan, err := h.spotifyService.GetRestorePlan(c.Request().Context(), c.Param("id"))
+	if ent.IsNotFound(err) {
+		return echo.NewHTTPError(http.StatusNotFound, "restore plan not found")
+	}
+	if err != nil {
+		h.slogger.Error("Failed to load restore plan", "err", err)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, plan)
+}
+
+// CreateRestorePlan plans restoring playlist snapshots and saved collections
+// of a backup run without changing the library and returns the plan. The
+// strategy property of the request body decides whether playlists that
+// still exist are overwritten, merged or restored as a copy.
+func (h *RestoreHandler) CreateRestorePlan(c echo.Context) error {
+	var req spotify.PlanRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	plan, err := h.spotifyService.PlanRestore(c.Request().Context(), req)
+	if err != nil {
+		var unauthenticated *spotify.UnauthenticatedError
+		switch {
+		case ent.IsNotFound(err):
+			return echo.NewHTTPError(http.StatusNotFound, "backup run or playlist snapshot not found")
+		case errors.Is(err, spotify.ErrInvalidPlan),
+			errors.Is(err, spotify.ErrNothingToRestore),
+			errors.Is(err, spotify.ErrIncompleteSnapshot),
+			errors.Is(err, spotify.ErrCollectionNotRestorable),
+			errors.Is(err, spotify.ErrCollectionNotBackedUp):
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		case errors.As(err, &unauthenticated):
+			return echo.NewHTTPError(http.StatusUnauthorized, "not authenticated with Spotify")
+		default:
+			h.slogger.Error("Failed to plan restore", "err", err)
+			return echo.NewHTTPError(http.StatusInternalServerError)
+		}
+	}
+
+	return c.JSON(http.StatusCreated, plan)
+}
+
+// ApplyRestorePlan queues applying a restore plan and returns the job. If
+// the library changed since the plan was made, the plan becomes stale and
+// the changes are returned instead.
+func (h *RestoreHandler) ApplyRestorePlan(c echo.Context) error {
+	j, err := h.spotifyService.EnqueueRestorePlan(c.Request().Context(), c.Param("id"))
+	if err == nil {
+		return c.JSON(http.StatusAccepted, j)
+	}
+
+	var (
+		drift           *spotify.PlanDriftError
+		unauthenticated *spotify.UnauthenticatedError
+	)
+	switch {
+	case ent.IsNotFound(err):
+		return echo.NewHTTPError(http.StatusNotFound, "restore plan not found")
+	case errors.As(err, &drift):
+		return c.JSON(http.StatusConflict, map[string]any{
+			"message": "the library changed since the restore plan was made",
+			"drift":   drift.Drift,
+		})
+	case errors.Is(err, spotify.ErrPlanNotPending), errors.Is(err, spotify.ErrPlanForOtherUser), errors.Is(err, queue.ErrDuplicate):
+		return echo.NewHTTPError(http.StatusConflict, err.Error())
+	case errors.As(err, &unauthenticated):
+		return echo.NewHTTPError(http.StatusUnauthorized, "not authenticated with Spotify")
+	default:
+		h.slogger.Error("Failed to queue restore", "err", err)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,71 @@
+package router
+
+import (
+	"beyerleinf/spotify-backup/internal/server/api/handler"
+	"beyerleinf/spotify-backup/pkg/router"
+
+	"github.com/labstack/echo/v4"
+)
+
+// BackupRoutes returns all routes associated with the /backups route.
+func BackupRoutes(backupHandler *handler.BackupHandler) router.RouteGroup {
+	return router.RouteGroup{
+		Prefix: "/backups",
+		Routes: []router.Route{
+			{
+				Method:  echo.GET,
+				Path:    "",
+				Handler: backupHandler.GetBackupRuns,
+			},
+			{
+				Method:  echo.POST,
+				Path:    "",
+				Handler: backupHandler.CreateBackupRun,
+			},
+			{
+				Method:  echo.GET,
+				Path:    "/:id",
+				Handler: backupHandler.GetBackupRun,
+			},
+			{
+				Method:  echo.PATCH,
+				Path:    "/:id",
+				Handler: backupHandler.UpdateBackupRun,
+			},
+			{
+				Method:  echo.GET,
+				Path:    "/:id/diff",
+				Handler: backupHandler.GetBackupRunDiff,
+			},
+			{
+				Method:  echo.POST,
+				Path:    "/:id/restore",
+				Handler: backupHandler.RestoreBackupRun,
+			},
+		},
+	}
+}
+
+// PlaylistSnapshotRoutes returns all routes associated with the /playlist-snapshots route.
+func PlaylistSnapshotRoutes(backupHandler *handler.BackupHandler) router.RouteGroup {
+	return router.RouteGroup{
+		Prefix: "/playlist-snapshots",
+		Routes: []router.Route{
+			{
+				Method:  echo.PATCH,
+				Path:    "/:id",
+				Handler: backupHandler.UpdatePlaylistSnapshot,
+			},
+			{
+				Method:  echo.GET,
+				Path:    "/:id/diff",
+				Handler: backupHandler.GetPlaylistSnapshotDiff,
+			},
+			{
+				Method:  echo.POST,
+				Path:    "/:id/restore",
+				Handler: backupHandler.RestorePlaylistSnapshot,
+			},
+		},
+	}
+}
@@ -0,0 +1,32 @@
+package router
+
+import (
+	"beyerleinf/spotify-backup/internal/server/api/handler"
+	"beyerleinf/spotify-backup/pkg/router"
+
+	"github.com/labstack/echo/v4"
+)
+
+// JobRoutes returns all routes associated with the /jobs route.
+func JobRoutes(jobHandler *handler.JobHandler) router.RouteGroup {
+	return router.RouteGroup{
+		Prefix: "/jobs",
+		Routes: []router.Route{
+			{
+				Method:  echo.GET,
+				Path:    "",
+				Handler: jobHandler.GetJobs,
+			},
+			{
+				Method:  echo.GET,
+				Path:    "/:id",
+				Handler: jobHandler.GetJob,
+			},
+			{
+				Method:  echo.POST,
+				Path:    "/:id/cancel",
+				Handler: jobHandler.CancelJob,
+			},
+		},
+	}
+}
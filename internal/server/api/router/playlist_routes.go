@@ -0,0 +1,27 @@
+package router
+
+import (
+	"beyerleinf/spotify-backup/internal/server/api/handler"
+	"beyerleinf/spotify-backup/pkg/router"
+
+	"github.com/labstack/echo/v4"
+)
+
+// PlaylistRoutes returns all routes associated with the /playlists route.
+func PlaylistRoutes(playlistHandler *handler.PlaylistHandler) router.RouteGroup {
+	return router.RouteGroup{
+		Prefix: "/playlists",
+		Routes: []router.Route{
+			{
+				Method:  echo.GET,
+				Path:    "",
+				Handler: playlistHandler.GetPlaylists,
+			},
+			{
+				Method:  echo.GET,
+				Path:    "/:id/at",
+				Handler: playlistHandler.GetPlaylistAt,
+			},
+		},
+	}
+}
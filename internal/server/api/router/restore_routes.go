@@ -0,0 +1,37 @@
+package router
+
+import (
+	"beyerleinf/spotify-backup/internal/server/api/handler"
+	"beyerleinf/spotify-backup/pkg/router"
+
+	"github.com/labstack/echo/v4"
+)
+
+// RestorePlanRoutes returns all routes associated with the /restore-plans route.
+func RestorePlanRoutes(restoreHandler *handler.RestoreHandler) router.RouteGroup {
+	return router.RouteGroup{
+		Prefix: "/restore-plans",
+		Routes: []router.Route{
+			{
+				Method:  echo.GET,
+				Path:    "",
+				Handler: restoreHandler.GetRestorePlans,
+			},
+			{
+				Method:  echo.POST,
+				Path:    "",
+				Handler: restoreHandler.CreateRestorePlan,
+			},
+			{
+				Method:  echo.GET,
+				Path:    "/:id",
+				Handler: restoreHandler.GetRestorePlan,
+			},
+			{
+				Method:  echo.POST,
+				Path:    "/:id/apply",
+				Handler: restoreHandler.ApplyRestorePlan,
+			},
+		},
+	}
+}
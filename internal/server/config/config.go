@@ -2,19 +2,27 @@ package config
 
 import (
 	"beyerleinf/spotify-backup/pkg/logger"
+	"errors"
 	"fmt"
 	"log/slog"
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 )
 
 // Config is the root level configuration struct.
 type Config struct {
-	Server        ServerConfig   `mapstructure:"server" env:"SERVER"`
-	Database      DatabaseConfig `mapstructure:"database" env:"DB"`
-	Spotify       SpotifyConfig  `mapstructure:"spotify" env:"SPOTIFY"`
-	EncryptionKey string         `mapstructure:"encryption_key" env:"ENCRYPTION_KEY"`
+	Server        ServerConfig    `mapstructure:"server" env:"SERVER"`
+	Database      DatabaseConfig  `mapstructure:"database" env:"DB"`
+	Spotify       SpotifyConfig   `mapstructure:"spotify" env:"SPOTIFY"`
+	HTTP          HTTPConfig      `mapstructure:"http" env:"HTTP"`
+	Backup        BackupConfig    `mapstructure:"backup" env:"BACKUP"`
+	Retention     RetentionConfig `mapstructure:"retention" env:"RETENTION"`
+	Scheduler     SchedulerConfig `mapstructure:"scheduler" env:"SCHEDULER"`
+	Queue         QueueConfig     `mapstructure:"queue" env:"QUEUE"`
+	Leader        LeaderConfig    `mapstructure:"leader" env:"LEADER"`
+	EncryptionKey string          `mapstructure:"encryption_key" env:"ENCRYPTION_KEY"`
 }
 
 // ServerConfig contains setting relating to the http server and the application in general.
@@ -37,6 +45,96 @@ type SpotifyConfig struct {
 	ClientID     string `mapstructure:"client_id" env:"CLIENT_ID"`
 	ClientSecret string `mapstructure:"client_secret" env:"CLIENT_SECRET"`
 	RedirectURI  string `mapstructure:"redirect_uri" env:"REDIRECT_URI"`
+	// AccountsURL is the base URL of Spotify's Accounts service.
+	AccountsURL string `mapstructure:"accounts_url" env:"ACCOUNTS_URL"`
+	// APIURL is the base URL of Spotify's Web API.
+	APIURL string `mapstructure:"api_url" env:"API_URL"`
+}
+
+// HTTPConfig contains settings for outgoing HTTP requests, e.g. to the Spotify API.
+type HTTPConfig struct {
+	// Timeout limits each attempt of a request. Waiting to retry a request
+	// that was rate limited doesn't count towards it.
+	Timeout             time.Duration `mapstructure:"timeout" env:"TIMEOUT"`
+	DialTimeout         time.Duration `mapstructure:"dial_timeout" env:"DIAL_TIMEOUT"`
+	TLSHandshakeTimeout time.Duration `mapstructure:"tls_handshake_timeout" env:"TLS_HANDSHAKE_TIMEOUT"`
+	Proxy               string        `mapstructure:"proxy" env:"PROXY"`
+	CACertFiles         []string      `mapstructure:"ca_cert_files" env:"CA_CERT_FILES"`
+	MaxIdleConns        int           `mapstructure:"max_idle_conns" env:"MAX_IDLE_CONNS"`
+	UserAgent           string        `mapstructure:"user_agent" env:"USER_AGENT"`
+	// RateLimit is the number of requests per second that are sent at most,
+	// shared by every user and job of a server instance. Every instance has
+	// a limit of its own, so with multiple instances the limit should be
+	// divided among them. Zero disables throttling.
+	RateLimit float64 `mapstructure:"rate_limit" env:"RATE_LIMIT"`
+	// Burst is the number of requests that may be sent at once.
+	Burst int `mapstructure:"burst" env:"BURST"`
+}
+
+// BackupConfig contains settings for backing up a user's library.
+type BackupConfig struct {
+	// ResumeWindow is how long after it started a backup that was interrupted,
+	// or whose job is retried, is resumed instead of starting from scratch.
+	// Zero disables resuming.
+	ResumeWindow time.Duration `mapstructure:"resume_window" env:"RESUME_WINDOW"`
+	// PlaylistConcurrency is the number of playlists whose items are fetched at the same time.
+	PlaylistConcurrency int `mapstructure:"playlist_concurrency" env:"PLAYLIST_CONCURRENCY"`
+}
+
+// RetentionConfig contains settings for pruning old backups. Every user can
+// set their own retention policy in the UI, the defaults apply to the others.
+type RetentionConfig struct {
+	// Enabled prunes the backups of a user after every successful backup.
+	Enabled               bool `mapstructure:"enabled" env:"ENABLED"`
+	DefaultKeepAllDays    int  `mapstructure:"default_keep_all_days" env:"DEFAULT_KEEP_ALL_DAYS"`
+	DefaultKeepDailyWeeks int  `mapstructure:"default_keep_daily_weeks" env:"DEFAULT_KEEP_DAILY_WEEKS"`
+	// DefaultKeepWeeklyMonths is how many months one backup per week is kept.
+	// Older backups are kept once per month forever.
+	DefaultKeepWeeklyMonths int `mapstructure:"default_keep_weekly_months" env:"DEFAULT_KEEP_WEEKLY_MONTHS"`
+}
+
+// SchedulerConfig contains settings for scheduled backups. Every user's
+// schedule is configured in the UI, these settings apply to all of them.
+type SchedulerConfig struct {
+	Enabled bool `mapstructure:"enabled" env:"ENABLED"`
+	// PollInterval is how often the scheduler checks for due backups.
+	PollInterval time.Duration `mapstructure:"poll_interval" env:"POLL_INTERVAL"`
+	// MisfireThreshold is how late a backup may start before it is considered
+	// missed, e.g. because the server was down, and the misfire policy applies.
+	MisfireThreshold time.Duration `mapstructure:"misfire_threshold" env:"MISFIRE_THRESHOLD"`
+	// DefaultCron, DefaultTimeZone and DefaultMisfirePolicy are suggested
+	// to users who haven't set up a schedule yet.
+	DefaultCron          string `mapstructure:"default_cron" env:"DEFAULT_CRON"`
+	DefaultTimeZone      string `mapstructure:"default_time_zone" env:"DEFAULT_TIME_ZONE"`
+	DefaultMisfirePolicy string `mapstructure:"default_misfire_policy" env:"DEFAULT_MISFIRE_POLICY"`
+}
+
+// QueueConfig contains settings for the job queue and its workers.
+type QueueConfig struct {
+	// Workers is the number of jobs this server runs at the same time.
+	Workers int `mapstructure:"workers" env:"WORKERS"`
+	// PollInterval is how often idle workers check for new jobs.
+	PollInterval time.Duration `mapstructure:"poll_interval" env:"POLL_INTERVAL"`
+	// LeaseTimeout is how long a running job may go without a heartbeat of its
+	// worker before it is considered abandoned and run again.
+	LeaseTimeout time.Duration `mapstructure:"lease_timeout" env:"LEASE_TIMEOUT"`
+	// MaxAttempts is how often a job is attempted before it fails.
+	MaxAttempts int `mapstructure:"max_attempts" env:"MAX_ATTEMPTS"`
+	// RetryBackoff is the delay before the first retry of a failed job. It
+	// doubles with every further attempt up to MaxRetryBackoff.
+	RetryBackoff    time.Duration `mapstructure:"retry_backoff" env:"RETRY_BACKOFF"`
+	MaxRetryBackoff time.Duration `mapstructure:"max_retry_backoff" env:"MAX_RETRY_BACKOFF"`
+}
+
+// LeaderConfig contains settings for electing the instance that schedules
+// backups when multiple instances of the server are running.
+type LeaderConfig struct {
+	// LeaseDuration is how long the leader keeps its lease without renewing it.
+	// If the leader stops, another instance takes over after at most this long.
+	LeaseDuration time.Duration `mapstructure:"lease_duration" env:"LEASE_DURATION"`
+	// RenewInterval is how often the leader renews its lease and the other
+	// instances try to acquire it. It must be shorter than LeaseDuration.
+	RenewInterval time.Duration `mapstructure:"renew_interval" env:"RENEW_INTERVAL"`
 }
 
 // LoadConfig uses viper to load the configuration file.
@@ -59,6 +157,37 @@ func LoadConfig() (*Config, error) {
 	viper.SetDefault("database.username", "SpotifyBackup")
 	viper.SetDefault("database.password", "secret")
 	viper.SetDefault("database.db_name", "SpotifyBackup")
+	viper.SetDefault("spotify.accounts_url", "https://accounts.spotify.com")
+	viper.SetDefault("spotify.api_url", "https://api.spotify.com/v1")
+	viper.SetDefault("http.timeout", "30s")
+	viper.SetDefault("http.dial_timeout", "10s")
+	viper.SetDefault("http.tls_handshake_timeout", "10s")
+	viper.SetDefault("http.proxy", "")
+	viper.SetDefault("http.ca_cert_files", []string{})
+	viper.SetDefault("http.max_idle_conns", 10)
+	viper.SetDefault("http.user_agent", "spotify-backup")
+	viper.SetDefault("http.rate_limit", 10)
+	viper.SetDefault("http.burst", 20)
+	viper.SetDefault("backup.resume_window", "24h")
+	viper.SetDefault("backup.playlist_concurrency", 4)
+	viper.SetDefault("retention.enabled", true)
+	viper.SetDefault("retention.default_keep_all_days", 7)
+	viper.SetDefault("retention.default_keep_daily_weeks", 4)
+	viper.SetDefault("retention.default_keep_weekly_months", 12)
+	viper.SetDefault("scheduler.enabled", true)
+	viper.SetDefault("scheduler.poll_interval", "30s")
+	viper.SetDefault("scheduler.misfire_threshold", "5m")
+	viper.SetDefault("scheduler.default_cron", "0 3 * * *")
+	viper.SetDefault("scheduler.default_time_zone", "UTC")
+	viper.SetDefault("scheduler.default_misfire_policy", "catch_up")
+	viper.SetDefault("queue.workers", 2)
+	viper.SetDefault("queue.poll_interval", "5s")
+	viper.SetDefault("queue.lease_timeout", "2m")
+	viper.SetDefault("queue.max_attempts", 5)
+	viper.SetDefault("queue.retry_backoff", "30s")
+	viper.SetDefault("queue.max_retry_backoff", "1h")
+	viper.SetDefault("leader.lease_duration", "30s")
+	viper.SetDefault("leader.renew_interval", "10s")
 
 	if err := viper.ReadInConfig(); err != nil {
 		if _, ok := err.(viper.ConfigFileNotFoundError); ok {
@@ -74,7 +203,39 @@ func LoadConfig() (*Config, error) {
 		return &Config{}, fmt.Errorf("unable to decode into struct: %w", err)
 	}
 
+	if err := config.validate(); err != nil {
+		return &Config{}, err
+	}
+
 	slogger.Trace("Loaded config", "config", config)
 
 	return &config, nil
 }
+
+// validate checks the settings that would make the server fail at startup,
+// like intervals that tickers can't be created with.
+func (c *Config) validate() error {
+	intervals := []struct {
+		name  string
+		value time.Duration
+	}{
+		{"scheduler.poll_interval", c.Scheduler.PollInterval},
+		{"queue.poll_interval", c.Queue.PollInterval},
+		// Workers send heartbeats three times per lease timeout.
+		{"queue.lease_timeout", c.Queue.LeaseTimeout / 3},
+		{"leader.lease_duration", c.Leader.LeaseDuration},
+		{"leader.renew_interval", c.Leader.RenewInterval},
+	}
+
+	for _, interval := range intervals {
+		if interval.value <= 0 {
+			return fmt.Errorf("%s must be positive", interval.name)
+		}
+	}
+
+	if c.Leader.RenewInterval >= c.Leader.LeaseDuration {
+		return errors.New("leader.renew_interval must be shorter than leader.lease_duration")
+	}
+
+	return nil
+}
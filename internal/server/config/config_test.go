@@ -0,0 +1,41 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func validConfig() Config {
+	return Config{
+		Scheduler: SchedulerConfig{PollInterval: 30 * time.Second},
+		Queue:     QueueConfig{PollInterval: 5 * time.Second, LeaseTimeout: 2 * time.Minute},
+		Leader:    LeaderConfig{LeaseDuration: 30 * time.Second, RenewInterval: 10 * time.Second},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(c *Config)
+		wantErr bool
+	}{
+		{name: "defaults", modify: func(c *Config) {}},
+		{name: "zero scheduler poll interval", modify: func(c *Config) { c.Scheduler.PollInterval = 0 }, wantErr: true},
+		{name: "negative queue poll interval", modify: func(c *Config) { c.Queue.PollInterval = -time.Second }, wantErr: true},
+		{name: "zero lease timeout", modify: func(c *Config) { c.Queue.LeaseTimeout = 0 }, wantErr: true},
+		{name: "lease timeout too short for heartbeats", modify: func(c *Config) { c.Queue.LeaseTimeout = 2 }, wantErr: true},
+		{name: "zero renew interval", modify: func(c *Config) { c.Leader.RenewInterval = 0 }, wantErr: true},
+		{name: "renew interval not shorter than lease", modify: func(c *Config) { c.Leader.RenewInterval = c.Leader.LeaseDuration }, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := validConfig()
+			tt.modify(&c)
+
+			if err := c.validate(); (err != nil) != tt.wantErr {
+				t.Errorf("validate() = %v, want error: %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,277 @@
+package handler
+
+import (
+	"beyerleinf/spotify-backup/ent"
+	"beyerleinf/spotify-backup/ent/restoreplan"
+	"beyerleinf/spotify-backup/internal/server/config"
+	"beyerleinf/spotify-backup/pkg/logger"
+	"beyerleinf/spotify-backup/pkg/service/spotify"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	backupsPageTitle = "Backups | Spotify Backup"
+	backupsPageLimit = 50
+)
+
+// A BackupsHandler instance.
+type BackupsHandler struct {
+	slogger        *logger.Logger
+	spotifyService *spotify.Service
+	config         *config.Config
+}
+
+// backupRunView is a backup run formatted for display.
+type backupRunView struct {
+	ID          string
+	StartedAt   string
+	Duration    string
+	Trigger     string
+	Status      string
+	Resumes     int
+	APICalls    int
+	Error       string
+	Pinned      bool
+	Note        string
+	Labels      []string
+	Collections []collectionView
+}
+
+// playlistSnapshotView is a playlist snapshot formatted for display.
+type playlistSnapshotView struct {
+	ID         string
+	PlaylistID string
+	Name       string
+	Owner      string
+	Total      int
+	SnapshotID string
+	CreatedAt  string
+	Pinned     bool
+	Note       string
+	Labels     []string
+}
+
+// restorePlanSummaryView is a restore plan in a list formatted for display.
+type restorePlanSummaryView struct {
+	ID        string
+	CreatedAt string
+	Strategy  string
+	Status    string
+	Playlists int
+}
+
+// collectionView is the result of backing up a collection formatted for display.
+type collectionView struct {
+	Name     string
+	Count    int
+	APICalls int
+	Error    string
+}
+
+// NewBackupsHandler creates a new instance.
+func NewBackupsHandler(spotifyService *spotify.Service, config *config.Config) *BackupsHandler {
+	return &BackupsHandler{
+		slogger:        logger.New("backups-ui", config.Server.LogLevel),
+		spotifyService: spotifyService,
+		config:         config,
+	}
+}
+
+// BackupsPage serves the history of backup runs.
+func (h *BackupsHandler) BackupsPage(c echo.Context) error {
+	const templateName = "backups"
+
+	runs, err := h.spotifyService.BackupRuns(c.Request().Context(), backupsPageLimit)
+	if err != nil {
+		h.slogger.Error("Failed to load backup runs", "err", err)
+
+		return c.Render(http.StatusInternalServerError, templateName, map[string]any{
+			"Title": backupsPageTitle,
+			"Error": "Failed to load backups.",
+		})
+	}
+
+	views := make([]backupRunView, 0, len(runs))
+	for _, run := range runs {
+		views = append(views, h.newBackupRunView(run))
+	}
+
+	return c.Render(http.StatusOK, templateName, map[string]any{
+		"Title": backupsPageTitle,
+		"Runs":  views,
+	})
+}
+
+func (h *BackupsHandler) newBackupRunView(run *ent.BackupRun) backupRunView {
+	view := backupRunView{
+		ID:        run.ID,
+		StartedAt: run.StartedAt.Format(time.DateTime),
+		Trigger:   run.Trigger.String(),
+		Status:    run.Status.String(),
+		Resumes:   run.Resumes,
+		APICalls:  run.APICalls,
+		Error:     run.Error,
+		Pinned:    run.Pinned,
+		Note:      run.Note,
+		Labels:    run.Labels,
+	}
+
+	if run.FinishedAt != nil {
+		view.Duration = run.FinishedAt.Sub(run.StartedAt).Round(time.Second).String()
+	}
+
+	for _, name := range h.spotifyService.CollectionNames() {
+		result, ok := run.Collections[name]
+		if !ok {
+			continue
+		}
+
+		view.Collections = append(view.Collections, collectionView{
+			Name:     name,
+			Count:    result.Count,
+			APICalls: result.APICalls,
+			Error:    result.Error,
+		})
+	}
+
+	return view
+}
+
+// BackupPage serves a single backup run with its playlist snapshots.
+func (h *BackupsHandler) BackupPage(c echo.Context) error {
+	const templateName = "backup"
+
+	run, err := h.spotifyService.GetBackupRun(c.Request().Context(), c.Param("id"))
+	if ent.IsNotFound(err) {
+		return echo.NewHTTPError(http.StatusNotFound, "backup run not found")
+	}
+	if err != nil {
+		h.slogger.Error("Failed to load backup run", "err", err)
+
+		return c.Render(http.StatusInternalServerError, templateName, map[string]any{
+			"Title": backupsPageTitle,
+			"Error": "Failed to load backup.",
+		})
+	}
+
+	snapshots := make([]playlistSnapshotView, 0, len(run.Edges.PlaylistSnapshots))
+	for _, snapshot := range run.Edges.PlaylistSnapshots {
+		snapshots = append(snapshots, playlistSnapshotView{
+			ID:         snapshot.ID,
+			PlaylistID: snapshot.Edges.Playlist.SpotifyID,
+			Name:       snapshot.Name,
+			Owner:      snapshot.OwnerName,
+			Total:      snapshot.Total,
+			SnapshotID: snapshot.SnapshotID,
+			CreatedAt:  snapshot.CreatedAt.Format(time.DateTime),
+			Pinned:     snapshot.Pinned,
+			Note:       snapshot.Note,
+			Labels:     snapshot.Labels,
+		})
+	}
+
+	plans, err := h.spotifyService.RestorePlans(c.Request().Context(), run.ID, backupsPageLimit)
+	if err != nil {
+		h.slogger.Error("Failed to load restore plans", "err", err)
+	}
+
+	planViews := make([]restorePlanSummaryView, 0, len(plans))
+	for _, plan := range plans {
+		planViews = append(planViews, restorePlanSummaryView{
+			ID:        plan.ID,
+			CreatedAt: plan.CreatedAt.Format(time.DateTime),
+			Strategy:  plan.Strategy.String(),
+			Status:    plan.Status.String(),
+			Playlists: len(plan.Playlists),
+		})
+	}
+
+	var message string
+	switch c.QueryParam("error") {
+	case "annotation":
+		message = "The note or labels are too long."
+	case "restore":
+		message = "Failed to plan the restore. Select complete playlists or collections and make sure you are logged in to Spotify."
+	}
+
+	return c.Render(http.StatusOK, templateName, map[string]any{
+		"Title":        backupsPageTitle,
+		"Error":        message,
+		"Run":          h.newBackupRunView(run),
+		"Date":         run.StartedAt.UTC().Format(time.DateOnly),
+		"Snapshots":    snapshots,
+		"Restorable":   h.spotifyService.RestorableCollections(run),
+		"RestorePlans": planViews,
+	})
+}
+
+// PlanRestore plans restoring the selected playlists and collections of a
+// backup run and redirects to the plan, so it can be reviewed before it is applied.
+func (h *BackupsHandler) PlanRestore(c echo.Context) error {
+	id := c.Param("id")
+
+	form, err := c.FormParams()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest)
+	}
+
+	plan, err := h.spotifyService.PlanRestore(c.Request().Context(), spotify.PlanRequest{
+		RunID:       id,
+		SnapshotIDs: form["snapshot"],
+		Collections: form["collection"],
+		Strategy:    restoreplan.Strategy(form.Get("strategy")),
+	})
+	if ent.IsNotFound(err) {
+		return echo.NewHTTPError(http.StatusNotFound)
+	}
+	if err != nil {
+		h.slogger.Error("Failed to plan restore", "err", err)
+		return c.Redirect(http.StatusSeeOther, "/ui/backups/"+id+"?error=restore")
+	}
+
+	return c.Redirect(http.StatusSeeOther, "/ui/restore-plans/"+plan.ID)
+}
+
+// AnnotateBackup saves the pin, note and labels of a backup run.
+func (h *BackupsHandler) AnnotateBackup(c echo.Context) error {
+	id := c.Param("id")
+
+	_, err := h.spotifyService.AnnotateBackupRun(c.Request().Context(), id, annotationForm(c))
+
+	return h.redirectAfterAnnotation(c, id, err)
+}
+
+// AnnotatePlaylistSnapshot saves the pin, note and labels of a playlist snapshot.
+func (h *BackupsHandler) AnnotatePlaylistSnapshot(c echo.Context) error {
+	_, err := h.spotifyService.AnnotatePlaylistSnapshot(c.Request().Context(), c.Param("snapshotID"), annotationForm(c))
+
+	return h.redirectAfterAnnotation(c, c.Param("id"), err)
+}
+
+// annotationForm reads an annotation from the form on the backup page.
+func annotationForm(c echo.Context) spotify.Annotation {
+	pinned := c.FormValue("pinned") == "on"
+	note := c.FormValue("note")
+	labels := spotify.ParseLabels(c.FormValue("labels"))
+
+	return spotify.Annotation{Pinned: &pinned, Note: &note, Labels: &labels}
+}
+
+func (h *BackupsHandler) redirectAfterAnnotation(c echo.Context, runID string, err error) error {
+	page := "/ui/backups/" + runID
+	switch {
+	case err == nil:
+		return c.Redirect(http.StatusSeeOther, page)
+	case ent.IsNotFound(err):
+		return echo.NewHTTPError(http.StatusNotFound)
+	case errors.Is(err, spotify.ErrInvalidAnnotation):
+		return c.Redirect(http.StatusSeeOther, page+"?error=annotation")
+	default:
+		h.slogger.Error("Failed to save annotation", "err", err)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+}
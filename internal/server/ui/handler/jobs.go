@@ -0,0 +1,104 @@
+package handler
+
+import (
+	"beyerleinf/spotify-backup/ent"
+	"beyerleinf/spotify-backup/ent/job"
+	"beyerleinf/spotify-backup/internal/server/config"
+	"beyerleinf/spotify-backup/pkg/logger"
+	"beyerleinf/spotify-backup/pkg/service/queue"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	jobsPageTitle = "Jobs | Spotify Backup"
+	jobsPageLimit = 50
+)
+
+// A JobsHandler instance.
+type JobsHandler struct {
+	slogger *logger.Logger
+	queue   *queue.Queue
+	config  *config.Config
+}
+
+// jobView is a job formatted for display.
+type jobView struct {
+	ID         string
+	Type       string
+	Status     string
+	Attempts   string
+	CreatedAt  string
+	RunAfter   string
+	FinishedAt string
+	Error      string
+	Cancelable bool
+}
+
+// NewJobsHandler creates a new instance.
+func NewJobsHandler(queue *queue.Queue, config *config.Config) *JobsHandler {
+	return &JobsHandler{
+		slogger: logger.New("jobs-ui", config.Server.LogLevel),
+		queue:   queue,
+		config:  config,
+	}
+}
+
+// JobsPage serves the list of queued and recent jobs.
+func (h *JobsHandler) JobsPage(c echo.Context) error {
+	const templateName = "jobs"
+
+	jobs, err := h.queue.Jobs(c.Request().Context(), jobsPageLimit)
+	if err != nil {
+		h.slogger.Error("Failed to load jobs", "err", err)
+
+		return c.Render(http.StatusInternalServerError, templateName, map[string]any{
+			"Title": jobsPageTitle,
+			"Error": "Failed to load jobs.",
+		})
+	}
+
+	views := make([]jobView, 0, len(jobs))
+	for _, j := range jobs {
+		views = append(views, newJobView(j))
+	}
+
+	return c.Render(http.StatusOK, templateName, map[string]any{
+		"Title": jobsPageTitle,
+		"Jobs":  views,
+	})
+}
+
+// CancelJob cancels a pending or running job.
+func (h *JobsHandler) CancelJob(c echo.Context) error {
+	if err := h.queue.Cancel(c.Request().Context(), c.Param("id")); err != nil {
+		h.slogger.Error("Failed to cancel job", "err", err)
+	}
+
+	return c.Redirect(http.StatusSeeOther, "/ui/jobs")
+}
+
+func newJobView(j *ent.Job) jobView {
+	view := jobView{
+		ID:         j.ID,
+		Type:       j.Type,
+		Status:     j.Status.String(),
+		Attempts:   fmt.Sprintf("%d/%d", j.Attempts, j.MaxAttempts),
+		CreatedAt:  j.CreatedAt.Format(time.DateTime),
+		Error:      j.Error,
+		Cancelable: j.Status == job.StatusPending || j.Status == job.StatusRunning,
+	}
+
+	if j.Status == job.StatusPending {
+		view.RunAfter = j.RunAfter.Format(time.DateTime)
+	}
+
+	if j.FinishedAt != nil {
+		view.FinishedAt = j.FinishedAt.Format(time.DateTime)
+	}
+
+	return view
+}
@@ -0,0 +1,188 @@
+package handler
+
+import (
+	"beyerleinf/spotify-backup/ent"
+	"beyerleinf/spotify-backup/internal/server/config"
+	"beyerleinf/spotify-backup/pkg/logger"
+	"beyerleinf/spotify-backup/pkg/service/spotify"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+const playlistsPageTitle = "Playlists | Spotify Backup"
+
+// A PlaylistsHandler instance.
+type PlaylistsHandler struct {
+	slogger        *logger.Logger
+	spotifyService *spotify.Service
+	config         *config.Config
+}
+
+// playlistItemView is an item of a playlist formatted for display.
+type playlistItemView struct {
+	Position  int
+	Name      string
+	Artists   string
+	Album     string
+	AddedAt   string
+	AddedBy   string
+	Available bool
+}
+
+// NewPlaylistsHandler creates a new instance.
+func NewPlaylistsHandler(spotifyService *spotify.Service, config *config.Config) *PlaylistsHandler {
+	return &PlaylistsHandler{
+		slogger:        logger.New("playlists-ui", config.Server.LogLevel),
+		spotifyService: spotifyService,
+		config:         config,
+	}
+}
+
+// PlaylistsPage serves the list of backed up playlists.
+func (h *PlaylistsHandler) PlaylistsPage(c echo.Context) error {
+	const templateName = "playlists"
+
+	playlists, err := h.spotifyService.Playlists(c.Request().Context())
+	if err != nil {
+		h.slogger.Error("Failed to load playlists", "err", err)
+
+		return c.Render(http.StatusInternalServerError, templateName, map[string]any{
+			"Title": playlistsPageTitle,
+			"Error": "Failed to load playlists.",
+		})
+	}
+
+	return c.Render(http.StatusOK, templateName, map[string]any{
+		"Title":     playlistsPageTitle,
+		"Playlists": playlists,
+	})
+}
+
+// PlaylistPage serves a playlist as it looked at the end of the day given by
+// the date query parameter, or as of its latest backup without a date.
+func (h *PlaylistsHandler) PlaylistPage(c echo.Context) error {
+	const templateName = "playlist"
+
+	date := c.QueryParam("date")
+	at := time.Now()
+	if date != "" {
+		day, err := time.Parse(time.DateOnly, date)
+		if err != nil {
+			return c.Render(http.StatusBadRequest, templateName, map[string]any{
+				"Title": playlistsPageTitle,
+				"ID":    c.Param("id"),
+				"Date":  date,
+				"Error": "The date is invalid.",
+			})
+		}
+
+		at = day.AddDate(0, 0, 1).Add(-time.Nanosecond)
+	}
+
+	playlist, err := h.spotifyService.PlaylistAt(c.Request().Context(), c.Param("id"), at)
+	if err != nil {
+		status, message := http.StatusInternalServerError, "Failed to load playlist."
+		switch {
+		case ent.IsNotFound(err):
+			status, message = http.StatusNotFound, "This playlist has never been backed up."
+		case errors.Is(err, spotify.ErrPlaylistNotInBackup):
+			status, message = http.StatusNotFound, "This playlist was not in your library on that day."
+		default:
+			h.slogger.Error("Failed to load playlist", "err", err)
+		}
+
+		return c.Render(status, templateName, map[string]any{
+			"Title": playlistsPageTitle,
+			"ID":    c.Param("id"),
+			"Date":  date,
+			"Error": message,
+		})
+	}
+
+	snapshot := playlist.Snapshot
+	items := make([]playlistItemView, 0, len(snapshot.Edges.Items))
+	for _, item := range snapshot.Edges.Items {
+		items = append(items, newPlaylistItemView(item))
+	}
+
+	if date == "" {
+		date = playlist.BackedUpAt.UTC().Format(time.DateOnly)
+	}
+
+	return c.Render(http.StatusOK, templateName, map[string]any{
+		"Title":       snapshot.Name + " | Spotify Backup",
+		"ID":          c.Param("id"),
+		"Date":        date,
+		"Snapshot":    snapshot,
+		"BackedUpAt":  playlist.BackedUpAt.Format(time.DateTime),
+		"RunID":       playlist.RunID,
+		"Visibility":  visibility(snapshot),
+		"Items":       items,
+		"Unavailable": countUnavailable(items),
+	})
+}
+
+func newPlaylistItemView(item *ent.SnapshotItem) playlistItemView {
+	view := playlistItemView{
+		Position:  item.Position + 1,
+		Name:      item.URI,
+		AddedBy:   item.AddedBy,
+		Available: item.IsPlayable == nil || *item.IsPlayable,
+	}
+
+	if item.AddedAt != nil {
+		view.AddedAt = item.AddedAt.Format(time.DateOnly)
+	}
+
+	switch {
+	case item.Edges.Track != nil:
+		track := item.Edges.Track
+		view.Name = track.Name
+
+		names := make([]string, 0, len(track.Edges.Artists))
+		for _, artist := range track.Edges.Artists {
+			names = append(names, artist.Name)
+		}
+		view.Artists = strings.Join(names, ", ")
+
+		if track.Edges.Album != nil {
+			view.Album = track.Edges.Album.Name
+		}
+	case item.Edges.Episode != nil:
+		view.Name = item.Edges.Episode.Name
+		if item.Edges.Episode.Edges.Show != nil {
+			view.Album = item.Edges.Episode.Edges.Show.Name
+		}
+	}
+
+	return view
+}
+
+func countUnavailable(items []playlistItemView) int {
+	n := 0
+	for _, item := range items {
+		if !item.Available {
+			n++
+		}
+	}
+
+	return n
+}
+
+// visibility describes who could see a playlist, if Spotify reported it.
+func visibility(snapshot *ent.PlaylistSnapshot) string {
+	switch {
+	case snapshot.Collaborative:
+		return "collaborative"
+	case snapshot.Public == nil:
+		return ""
+	case *snapshot.Public:
+		return "public"
+	default:
+		return "private"
+	}
+}
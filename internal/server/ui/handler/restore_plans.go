@@ -0,0 +1,184 @@
+package handler
+
+import (
+	"beyerleinf/spotify-backup/ent"
+	"beyerleinf/spotify-backup/ent/restoreplan"
+	"beyerleinf/spotify-backup/ent/schema/schematype"
+	"beyerleinf/spotify-backup/internal/server/config"
+	"beyerleinf/spotify-backup/pkg/logger"
+	"beyerleinf/spotify-backup/pkg/service/queue"
+	"beyerleinf/spotify-backup/pkg/service/spotify"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+const restorePlanPageTitle = "Restore | Spotify Backup"
+
+// A RestorePlansHandler instance.
+type RestorePlansHandler struct {
+	slogger        *logger.Logger
+	spotifyService *spotify.Service
+	config         *config.Config
+}
+
+// restorePlanView is a restore plan formatted for display.
+type restorePlanView struct {
+	ID          string
+	RunID       string
+	CreatedAt   string
+	AppliedAt   string
+	Strategy    string
+	Status      string
+	Pending     bool
+	Error       string
+	Drift       []string
+	Playlists   []playlistRestoreView
+	Collections []collectionRestoreView
+}
+
+// playlistRestoreView is the planned restore of a playlist formatted for display.
+type playlistRestoreView struct {
+	Name        string
+	Action      string
+	Owner       string
+	TargetID    string
+	Items       int
+	Removed     int
+	Unavailable []string
+	LocalFiles  int
+	Done        bool
+}
+
+// collectionRestoreView is the planned restore of a collection formatted for display.
+type collectionRestoreView struct {
+	Name        string
+	Save        int
+	Remove      int
+	Unavailable []string
+	Done        bool
+}
+
+// NewRestorePlansHandler creates a new instance.
+func NewRestorePlansHandler(spotifyService *spotify.Service, config *config.Config) *RestorePlansHandler {
+	return &RestorePlansHandler{
+		slogger:        logger.New("restore-ui", config.Server.LogLevel),
+		spotifyService: spotifyService,
+		config:         config,
+	}
+}
+
+// RestorePlanPage serves a restore plan, so it can be reviewed before it is applied.
+func (h *RestorePlansHandler) RestorePlanPage(c echo.Context) error {
+	const templateName = "restore-plan"
+
+	plan, err := h.spotifyService.GetRestorePlan(c.Request().Context(), c.Param("id"))
+	if ent.IsNotFound(err) {
+		return echo.NewHTTPError(http.StatusNotFound, "restore plan not found")
+	}
+	if err != nil {
+		h.slogger.Error("Failed to load restore plan", "err", err)
+
+		return c.Render(http.StatusInternalServerError, templateName, map[string]any{
+			"Title": restorePlanPageTitle,
+			"Error": "Failed to load the restore plan.",
+		})
+	}
+
+	var message string
+	switch c.QueryParam("error") {
+	case "drift":
+		message = "The library changed since the plan was made. Plan the restore again."
+	case "pending":
+		message = "The plan can no longer be applied."
+	case "queued":
+		message = "A restore is already queued."
+	case "apply":
+		message = "Failed to apply the plan. Make sure you are logged in to Spotify as the user the plan was made for."
+	}
+
+	return c.Render(http.StatusOK, templateName, map[string]any{
+		"Title": restorePlanPageTitle,
+		"Error": message,
+		"Plan":  newRestorePlanView(plan),
+	})
+}
+
+// ApplyRestorePlan queues applying a restore plan and redirects back to it.
+func (h *RestorePlansHandler) ApplyRestorePlan(c echo.Context) error {
+	id := c.Param("id")
+	page := "/ui/restore-plans/" + id
+
+	_, err := h.spotifyService.EnqueueRestorePlan(c.Request().Context(), id)
+
+	var drift *spotify.PlanDriftError
+	switch {
+	case err == nil:
+		return c.Redirect(http.StatusSeeOther, page)
+	case ent.IsNotFound(err):
+		return echo.NewHTTPError(http.StatusNotFound)
+	case errors.As(err, &drift):
+		return c.Redirect(http.StatusSeeOther, page+"?error=drift")
+	case errors.Is(err, spotify.ErrPlanNotPending):
+		return c.Redirect(http.StatusSeeOther, page+"?error=pending")
+	case errors.Is(err, queue.ErrDuplicate):
+		return c.Redirect(http.StatusSeeOther, page+"?error=queued")
+	default:
+		h.slogger.Error("Failed to queue restore", "err", err)
+		return c.Redirect(http.StatusSeeOther, page+"?error=apply")
+	}
+}
+
+func newRestorePlanView(plan *ent.RestorePlan) restorePlanView {
+	view := restorePlanView{
+		ID:        plan.ID,
+		RunID:     plan.RunID,
+		CreatedAt: plan.CreatedAt.Format(time.DateTime),
+		Strategy:  plan.Strategy.String(),
+		Status:    plan.Status.String(),
+		Pending:   plan.Status == restoreplan.StatusPending,
+		Error:     plan.Error,
+		Drift:     plan.Drift,
+	}
+
+	if plan.AppliedAt != nil {
+		view.AppliedAt = plan.AppliedAt.Format(time.DateTime)
+	}
+
+	for _, p := range plan.Playlists {
+		view.Playlists = append(view.Playlists, playlistRestoreView{
+			Name:        p.Name,
+			Action:      p.Action,
+			Owner:       p.OwnerID,
+			TargetID:    p.TargetID,
+			Items:       len(p.URIs),
+			Removed:     p.Removed,
+			Unavailable: restoreItemNames(p.Unavailable),
+			LocalFiles:  len(p.LocalFiles),
+			Done:        p.Done,
+		})
+	}
+
+	for _, c := range plan.Collections {
+		view.Collections = append(view.Collections, collectionRestoreView{
+			Name:        c.Collection,
+			Save:        len(c.Save),
+			Remove:      len(c.Remove),
+			Unavailable: restoreItemNames(c.Unavailable),
+			Done:        c.Done,
+		})
+	}
+
+	return view
+}
+
+func restoreItemNames(items []schematype.RestoreItem) []string {
+	names := make([]string, 0, len(items))
+	for _, item := range items {
+		names = append(names, item.Name)
+	}
+
+	return names
+}
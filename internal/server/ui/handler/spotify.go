@@ -1,10 +1,19 @@
 package handler
 
 import (
+	"beyerleinf/spotify-backup/ent"
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/backupschedule"
 	"beyerleinf/spotify-backup/internal/server/config"
 	"beyerleinf/spotify-backup/pkg/logger"
+	"beyerleinf/spotify-backup/pkg/service/queue"
+	"beyerleinf/spotify-backup/pkg/service/retention"
+	"beyerleinf/spotify-backup/pkg/service/scheduler"
 	"beyerleinf/spotify-backup/pkg/service/spotify"
+	"errors"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/labstack/echo/v4"
 )
@@ -13,16 +22,33 @@ import (
 type SpotifyHandler struct {
 	slogger        *logger.Logger
 	spotifyService *spotify.Service
+	scheduler      *scheduler.Scheduler
+	pruner         *retention.Pruner
 	config         *config.Config
 }
 
 const pageTitle = "Spotify Settings | Spotify Backup"
 
+// errorMessages maps the error query parameter of the settings page to a message.
+var errorMessages = map[string]string{
+	"code_or_state":    "Spotify did not return a valid authorization code.",
+	"get_access_token": "Failed to sign in with Spotify.",
+	"unauthenticated":  "You are not signed in with Spotify. Please authenticate again.",
+	"forbidden":        "Spotify denied access. Please authenticate again to grant all required permissions.",
+	"rate_limited":     "Spotify is rate limiting requests. Please try again later.",
+	"backup":           "The backup failed. See the backups page for details.",
+	"backup_running":   "A backup is already queued or running.",
+	"schedule":         "The schedule is invalid. Check the cron expression and time zone.",
+	"retention":        "The retention policy is invalid. All values must be whole numbers of at least 0.",
+}
+
 // NewSpotifyHandler creates a new instance.
-func NewSpotifyHandler(spotifyService *spotify.Service, config *config.Config) *SpotifyHandler {
+func NewSpotifyHandler(spotifyService *spotify.Service, scheduler *scheduler.Scheduler, pruner *retention.Pruner, config *config.Config) *SpotifyHandler {
 	return &SpotifyHandler{
 		slogger:        logger.New("spotify-ui", config.Server.LogLevel),
 		spotifyService: spotifyService,
+		scheduler:      scheduler,
+		pruner:         pruner,
 		config:         config,
 	}
 }
@@ -41,7 +67,7 @@ func (s *SpotifyHandler) SpotifyAuthCallbackPage(c echo.Context) error {
 		return nil
 	}
 
-	err := s.spotifyService.HandleAuthCallback(code, state)
+	err := s.spotifyService.HandleAuthCallback(c.Request().Context(), code, state)
 	if err != nil {
 		s.slogger.Error("error handling auth callback", "err", err)
 
@@ -65,24 +91,167 @@ func (s *SpotifyHandler) SpotifyAuthCallbackPage(c echo.Context) error {
 func (s *SpotifyHandler) SpotifySettingsPage(c echo.Context) error {
 	const templateName = "spotify_settings"
 
-	authURL := s.spotifyService.GetAuthURL()
+	authURL, err := s.spotifyService.GetAuthURL(c.Request().Context())
+	if err != nil {
+		return err
+	}
+
 	authError := c.QueryParams().Get("error")
 
-	profile, err := s.spotifyService.GetUserProfile()
+	profile, err := s.spotifyService.GetUserProfile(c.Request().Context())
 	if err != nil {
-		s.slogger.Error("Failed to load user profile. Not authenticated?", "err", err)
+		var unauthenticated *spotify.UnauthenticatedError
+		if errors.As(err, &unauthenticated) {
+			s.slogger.Verbose("Not authenticated with Spotify", "err", err)
+		} else {
+			s.slogger.Error("Failed to load user profile", "err", err)
+		}
 
 		return c.Render(http.StatusOK, templateName, map[string]any{
 			"Title":    pageTitle,
 			"AuthURL":  authURL,
 			"HasError": authError,
+			"Error":    errorMessages[authError],
 		})
 	}
 
+	schedule, err := s.scheduler.GetSchedule(c.Request().Context(), profile.ID)
+	if err != nil {
+		s.slogger.Error("Failed to load schedule", "err", err)
+	}
+
+	retentionSettings, err := s.pruner.Settings(c.Request().Context(), profile.ID)
+	if err != nil {
+		s.slogger.Error("Failed to load retention policy", "err", err)
+	}
+
 	return c.Render(http.StatusOK, templateName, map[string]any{
-		"Title":    pageTitle,
-		"AuthURL":  authURL,
-		"HasError": authError,
-		"Profile":  profile,
+		"Title":            pageTitle,
+		"AuthURL":          authURL,
+		"HasError":         authError,
+		"Error":            errorMessages[authError],
+		"Profile":          profile,
+		"Schedule":         s.scheduleSettings(schedule),
+		"NextRun":          nextRun(schedule),
+		"SchedulerEnabled": s.config.Scheduler.Enabled,
+		"Retention":        retentionSettings,
+		"RetentionEnabled": s.config.Retention.Enabled,
+	})
+}
+
+// SpotifySaveSchedule saves the backup schedule of the current user.
+func (s *SpotifyHandler) SpotifySaveSchedule(c echo.Context) error {
+	profile, err := s.spotifyService.GetUserProfile(c.Request().Context())
+	if err != nil {
+		s.slogger.Error("Failed to load user profile", "err", err)
+
+		return c.Redirect(http.StatusSeeOther, "/ui/spotify/auth?error=unauthenticated")
+	}
+
+	_, err = s.scheduler.SaveSchedule(c.Request().Context(), profile.ID, scheduler.ScheduleSettings{
+		Cron:          c.FormValue("cron"),
+		TimeZone:      c.FormValue("time_zone"),
+		MisfirePolicy: backupschedule.MisfirePolicy(c.FormValue("misfire_policy")),
+		Enabled:       c.FormValue("enabled") == "on",
 	})
+	if err != nil {
+		s.slogger.Verbose("Failed to save schedule", "err", err)
+
+		return c.Redirect(http.StatusSeeOther, "/ui/spotify/auth?error=schedule")
+	}
+
+	return c.Redirect(http.StatusSeeOther, "/ui/spotify/auth")
+}
+
+// SpotifySaveRetention saves the retention policy of the current user.
+func (s *SpotifyHandler) SpotifySaveRetention(c echo.Context) error {
+	profile, err := s.spotifyService.GetUserProfile(c.Request().Context())
+	if err != nil {
+		s.slogger.Error("Failed to load user profile", "err", err)
+
+		return c.Redirect(http.StatusSeeOther, "/ui/spotify/auth?error=unauthenticated")
+	}
+
+	var settings retention.PolicySettings
+	for name, v := range map[string]*int{
+		"keep_all_days":      &settings.KeepAllDays,
+		"keep_daily_weeks":   &settings.KeepDailyWeeks,
+		"keep_weekly_months": &settings.KeepWeeklyMonths,
+	} {
+		*v, err = strconv.Atoi(c.FormValue(name))
+		if err != nil {
+			return c.Redirect(http.StatusSeeOther, "/ui/spotify/auth?error=retention")
+		}
+	}
+
+	_, err = s.pruner.SavePolicy(c.Request().Context(), profile.ID, settings)
+	if err != nil {
+		s.slogger.Verbose("Failed to save retention policy", "err", err)
+
+		return c.Redirect(http.StatusSeeOther, "/ui/spotify/auth?error=retention")
+	}
+
+	return c.Redirect(http.StatusSeeOther, "/ui/spotify/auth")
+}
+
+// scheduleSettings returns the settings of a schedule or the defaults if there is none.
+func (s *SpotifyHandler) scheduleSettings(schedule *ent.BackupSchedule) scheduler.ScheduleSettings {
+	if schedule == nil {
+		return s.scheduler.DefaultSettings()
+	}
+
+	return scheduler.ScheduleSettings{
+		Cron:          schedule.Cron,
+		TimeZone:      schedule.TimeZone,
+		MisfirePolicy: schedule.MisfirePolicy,
+		Enabled:       schedule.Enabled,
+	}
+}
+
+// nextRun returns when the next scheduled backup runs, formatted in the schedule's time zone.
+func nextRun(schedule *ent.BackupSchedule) string {
+	if schedule == nil || !schedule.Enabled || schedule.NextRunAt == nil {
+		return ""
+	}
+
+	next := *schedule.NextRunAt
+	if loc, err := time.LoadLocation(schedule.TimeZone); err == nil {
+		next = next.In(loc)
+	}
+
+	return next.Format("2006-01-02 15:04 MST")
+}
+
+// SpotifyBackup queues a backup of the current user's library.
+func (s *SpotifyHandler) SpotifyBackup(c echo.Context) error {
+	_, err := s.spotifyService.EnqueueCurrentUserBackup(c.Request().Context(), backuprun.TriggerManual)
+	if err != nil {
+		s.slogger.Error("Failed to queue backup", "err", err)
+
+		return c.Redirect(http.StatusSeeOther, "/ui/spotify/auth?error="+backupErrorCode(err))
+	}
+
+	return c.Redirect(http.StatusSeeOther, "/ui/jobs")
+}
+
+// backupErrorCode returns the error query parameter describing why a backup failed.
+func backupErrorCode(err error) string {
+	var (
+		unauthenticated *spotify.UnauthenticatedError
+		forbidden       *spotify.ForbiddenError
+		rateLimited     *spotify.RateLimitedError
+	)
+
+	switch {
+	case errors.Is(err, queue.ErrDuplicate):
+		return "backup_running"
+	case errors.As(err, &unauthenticated):
+		return "unauthenticated"
+	case errors.As(err, &forbidden):
+		return "forbidden"
+	case errors.As(err, &rateLimited):
+		return "rate_limited"
+	default:
+		return "backup"
+	}
 }
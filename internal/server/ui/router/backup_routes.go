@@ -0,0 +1,42 @@
+package ui
+
+import (
+	"beyerleinf/spotify-backup/internal/server/ui/handler"
+	"beyerleinf/spotify-backup/pkg/router"
+
+	"github.com/labstack/echo/v4"
+)
+
+// BackupRoutes returns all routes associated with the /backups route.
+func BackupRoutes(backupsHandler *handler.BackupsHandler) router.RouteGroup {
+	return router.RouteGroup{
+		Prefix: "/backups",
+		Routes: []router.Route{
+			{
+				Method:  echo.GET,
+				Path:    "",
+				Handler: backupsHandler.BackupsPage,
+			},
+			{
+				Method:  echo.GET,
+				Path:    "/:id",
+				Handler: backupsHandler.BackupPage,
+			},
+			{
+				Method:  echo.POST,
+				Path:    "/:id/annotation",
+				Handler: backupsHandler.AnnotateBackup,
+			},
+			{
+				Method:  echo.POST,
+				Path:    "/:id/snapshots/:snapshotID/annotation",
+				Handler: backupsHandler.AnnotatePlaylistSnapshot,
+			},
+			{
+				Method:  echo.POST,
+				Path:    "/:id/restore",
+				Handler: backupsHandler.PlanRestore,
+			},
+		},
+	}
+}
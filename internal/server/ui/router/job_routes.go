@@ -0,0 +1,27 @@
+package ui
+
+import (
+	"beyerleinf/spotify-backup/internal/server/ui/handler"
+	"beyerleinf/spotify-backup/pkg/router"
+
+	"github.com/labstack/echo/v4"
+)
+
+// JobRoutes returns all routes associated with the /jobs route.
+func JobRoutes(jobsHandler *handler.JobsHandler) router.RouteGroup {
+	return router.RouteGroup{
+		Prefix: "/jobs",
+		Routes: []router.Route{
+			{
+				Method:  echo.GET,
+				Path:    "",
+				Handler: jobsHandler.JobsPage,
+			},
+			{
+				Method:  echo.POST,
+				Path:    "/:id/cancel",
+				Handler: jobsHandler.CancelJob,
+			},
+		},
+	}
+}
@@ -0,0 +1,27 @@
+package ui
+
+import (
+	"beyerleinf/spotify-backup/internal/server/ui/handler"
+	"beyerleinf/spotify-backup/pkg/router"
+
+	"github.com/labstack/echo/v4"
+)
+
+// PlaylistRoutes returns all routes associated with the /playlists route.
+func PlaylistRoutes(playlistsHandler *handler.PlaylistsHandler) router.RouteGroup {
+	return router.RouteGroup{
+		Prefix: "/playlists",
+		Routes: []router.Route{
+			{
+				Method:  echo.GET,
+				Path:    "",
+				Handler: playlistsHandler.PlaylistsPage,
+			},
+			{
+				Method:  echo.GET,
+				Path:    "/:id",
+				Handler: playlistsHandler.PlaylistPage,
+			},
+		},
+	}
+}
@@ -0,0 +1,27 @@
+package ui
+
+import (
+	"beyerleinf/spotify-backup/internal/server/ui/handler"
+	"beyerleinf/spotify-backup/pkg/router"
+
+	"github.com/labstack/echo/v4"
+)
+
+// RestorePlanRoutes returns all routes associated with the /restore-plans route.
+func RestorePlanRoutes(restorePlansHandler *handler.RestorePlansHandler) router.RouteGroup {
+	return router.RouteGroup{
+		Prefix: "/restore-plans",
+		Routes: []router.Route{
+			{
+				Method:  echo.GET,
+				Path:    "/:id",
+				Handler: restorePlansHandler.RestorePlanPage,
+			},
+			{
+				Method:  echo.POST,
+				Path:    "/:id/apply",
+				Handler: restorePlansHandler.ApplyRestorePlan,
+			},
+		},
+	}
+}
@@ -22,6 +22,21 @@ func SpotifyRoutes(spotifyHandler *handler.SpotifyHandler) router.RouteGroup {
 				Path:    "/callback",
 				Handler: spotifyHandler.SpotifyAuthCallbackPage,
 			},
+			{
+				Method:  echo.POST,
+				Path:    "/backup",
+				Handler: spotifyHandler.SpotifyBackup,
+			},
+			{
+				Method:  echo.POST,
+				Path:    "/schedule",
+				Handler: spotifyHandler.SpotifySaveSchedule,
+			},
+			{
+				Method:  echo.POST,
+				Path:    "/retention",
+				Handler: spotifyHandler.SpotifySaveRetention,
+			},
 		},
 	}
 }
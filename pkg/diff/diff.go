@@ -0,0 +1,257 @@
+// Package diff compares two snapshots of a playlist or a collection of the
+// user's library, like Liked Songs. It reports added, removed and moved items,
+// changed metadata and items whose availability changed. A [Diff] can be
+// marshaled to JSON or written as human-readable text.
+package diff
+
+import (
+	"cmp"
+	"slices"
+	"sort"
+)
+
+// A Snapshot is the state of a playlist or collection at one point in time.
+type Snapshot struct {
+	// Title names the playlist or collection, e.g. "Liked Songs".
+	Title string
+	// Metadata holds properties like the name or description of a playlist.
+	// A property that is missing in one of the snapshots is compared as "".
+	Metadata map[string]string
+	// Ordered is set if the position of items is meaningful, like in
+	// playlists. Moves are only reported for ordered snapshots.
+	Ordered bool
+	Items   []Item
+}
+
+// An Item is an entry of a snapshot.
+type Item struct {
+	// Key identifies the item across snapshots, usually its URI. Items that
+	// occur more than once are matched in the order they appear.
+	Key string `json:"key"`
+	// Name describes the item, e.g. its title and artists.
+	Name string `json:"name"`
+	// Position is the index of the item in its snapshot.
+	Position int `json:"position"`
+	// Playable reports whether the item is available in the user's market,
+	// it is nil if unknown.
+	Playable *bool `json:"playable,omitempty"`
+}
+
+// Status describes whether a playlist or collection exists in both snapshots.
+type Status string
+
+// Status values.
+const (
+	StatusChanged   Status = "changed"
+	StatusUnchanged Status = "unchanged"
+	StatusAdded     Status = "added"
+	StatusRemoved   Status = "removed"
+)
+
+// A Diff are the changes between two snapshots.
+type Diff struct {
+	Title  string `json:"title"`
+	Status Status `json:"status"`
+	// Changes are the metadata properties that changed, sorted by name.
+	Changes []Change `json:"changes,omitempty"`
+	// Added are the items of the new snapshot that weren't in the old one.
+	Added []Item `json:"added,omitempty"`
+	// Removed are the items of the old snapshot that aren't in the new one.
+	Removed []Item `json:"removed,omitempty"`
+	// Moved are items whose position changed relative to the other items.
+	// Items that only shifted because others were added or removed are
+	// not reported.
+	Moved []Move `json:"moved,omitempty"`
+	// Availability are the items whose availability changed.
+	Availability []AvailabilityChange `json:"availability,omitempty"`
+}
+
+// A Change is a metadata property that changed.
+type Change struct {
+	Property string `json:"property"`
+	Old      string `json:"old"`
+	New      string `json:"new"`
+}
+
+// A Move is an item that moved from one position to another.
+type Move struct {
+	Item Item `json:"item"`
+	From int  `json:"from"`
+}
+
+// An AvailabilityChange is an item that became available or unavailable.
+type AvailabilityChange struct {
+	Item     Item `json:"item"`
+	Playable bool `json:"playable"`
+}
+
+// Compare returns the changes from before to after. Either of them may be nil if
+// the playlist or collection didn't exist at the time, in which case the
+// diff only reports that it was added or removed.
+func Compare(before, after *Snapshot) *Diff {
+	switch {
+	case before == nil && after == nil:
+		return &Diff{Status: StatusUnchanged}
+	case before == nil:
+		return &Diff{Title: after.Title, Status: StatusAdded}
+	case after == nil:
+		return &Diff{Title: before.Title, Status: StatusRemoved}
+	}
+
+	d := &Diff{
+		Title:   after.Title,
+		Changes: compareMetadata(before.Metadata, after.Metadata),
+	}
+
+	beforeOccurrences := occurrences(before.Items)
+	afterOccurrences := occurrences(after.Items)
+	beforePositions := positions(beforeOccurrences)
+	afterPositions := positions(afterOccurrences)
+
+	// common are the positions in the after snapshot of the items that are
+	// in both snapshots, in the order of the before snapshot.
+	var common []int
+	for i, item := range before.Items {
+		j, ok := afterPositions[beforeOccurrences[i]]
+		if !ok {
+			d.Removed = append(d.Removed, item)
+			continue
+		}
+
+		common = append(common, j)
+
+		was, is := item.Playable, after.Items[j].Playable
+		if was != nil && is != nil && *was != *is {
+			d.Availability = append(d.Availability, AvailabilityChange{Item: after.Items[j], Playable: *is})
+		}
+	}
+
+	for j, item := range after.Items {
+		if _, ok := beforePositions[afterOccurrences[j]]; !ok {
+			d.Added = append(d.Added, item)
+		}
+	}
+
+	if before.Ordered && after.Ordered {
+		// The items in the longest subsequence that kept their order didn't
+		// move, the others did.
+		kept := map[int]bool{}
+		for _, j := range longestIncreasing(common) {
+			kept[j] = true
+		}
+
+		for _, j := range common {
+			if !kept[j] {
+				i := beforePositions[afterOccurrences[j]]
+				d.Moved = append(d.Moved, Move{Item: after.Items[j], From: before.Items[i].Position})
+			}
+		}
+
+		slices.SortFunc(d.Moved, func(a, b Move) int {
+			return cmp.Compare(a.Item.Position, b.Item.Position)
+		})
+	}
+
+	d.Status = StatusUnchanged
+	if !d.Empty() {
+		d.Status = StatusChanged
+	}
+
+	return d
+}
+
+// Empty reports whether the snapshots are the same.
+func (d *Diff) Empty() bool {
+	return d.Status != StatusAdded && d.Status != StatusRemoved &&
+		len(d.Changes) == 0 && len(d.Added) == 0 && len(d.Removed) == 0 &&
+		len(d.Moved) == 0 && len(d.Availability) == 0
+}
+
+func compareMetadata(before, after map[string]string) []Change {
+	properties := map[string]bool{}
+	for property := range before {
+		properties[property] = true
+	}
+	for property := range after {
+		properties[property] = true
+	}
+
+	var changes []Change
+	for property := range properties {
+		was, is := before[property], after[property]
+		if was != is {
+			changes = append(changes, Change{Property: property, Old: was, New: is})
+		}
+	}
+
+	slices.SortFunc(changes, func(a, b Change) int {
+		return cmp.Compare(a.Property, b.Property)
+	})
+
+	return changes
+}
+
+// An occurrence identifies the n-th item with the same key of a snapshot,
+// so items that occur more than once can be matched.
+type occurrence struct {
+	key string
+	n   int
+}
+
+// occurrences returns the occurrence of every item by position.
+func occurrences(items []Item) []occurrence {
+	counts := map[string]int{}
+	result := make([]occurrence, len(items))
+
+	for i, item := range items {
+		result[i] = occurrence{key: item.Key, n: counts[item.Key]}
+		counts[item.Key]++
+	}
+
+	return result
+}
+
+// positions maps every occurrence to its position.
+func positions(occurrences []occurrence) map[occurrence]int {
+	result := make(map[occurrence]int, len(occurrences))
+	for i, o := range occurrences {
+		result[o] = i
+	}
+
+	return result
+}
+
+// longestIncreasing returns a longest strictly increasing subsequence of values.
+func longestIncreasing(values []int) []int {
+	// tails[k] is the index in values of the smallest value that ends an
+	// increasing subsequence of length k+1, prev links every value to its
+	// predecessor in the subsequence.
+	var tails []int
+	prev := make([]int, len(values))
+
+	for i, v := range values {
+		k := sort.Search(len(tails), func(k int) bool { return values[tails[k]] >= v })
+
+		prev[i] = -1
+		if k > 0 {
+			prev[i] = tails[k-1]
+		}
+
+		if k == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[k] = i
+		}
+	}
+
+	result := make([]int, len(tails))
+	if len(tails) == 0 {
+		return result
+	}
+
+	for i, k := len(tails)-1, tails[len(tails)-1]; i >= 0; i, k = i-1, prev[k] {
+		result[i] = values[k]
+	}
+
+	return result
+}
@@ -0,0 +1,202 @@
+package diff
+
+import (
+	"reflect"
+	"testing"
+)
+
+// items returns items named after their keys, at the positions they are given in.
+func items(keys ...string) []Item {
+	result := make([]Item, len(keys))
+	for i, key := range keys {
+		result[i] = Item{Key: key, Name: key, Position: i}
+	}
+
+	return result
+}
+
+func ordered(keys ...string) *Snapshot {
+	return &Snapshot{Title: "Road Trip", Ordered: true, Items: items(keys...)}
+}
+
+func TestCompareItems(t *testing.T) {
+	tests := []struct {
+		name        string
+		before      *Snapshot
+		after       *Snapshot
+		wantAdded   []Item
+		wantRemoved []Item
+		wantMoved   []Move
+	}{
+		{
+			name:   "unchanged",
+			before: ordered("a", "b", "c"),
+			after:  ordered("a", "b", "c"),
+		},
+		{
+			name:        "added and removed items don't move the others",
+			before:      ordered("a", "b", "c"),
+			after:       ordered("x", "a", "c", "d"),
+			wantAdded:   []Item{{Key: "x", Name: "x", Position: 0}, {Key: "d", Name: "d", Position: 3}},
+			wantRemoved: []Item{{Key: "b", Name: "b", Position: 1}},
+		},
+		{
+			name:      "moving one item to the end",
+			before:    ordered("a", "b", "c", "d"),
+			after:     ordered("b", "c", "d", "a"),
+			wantMoved: []Move{{Item: Item{Key: "a", Name: "a", Position: 3}, From: 0}},
+		},
+		{
+			name:      "swapping two items moves one of them",
+			before:    ordered("a", "b"),
+			after:     ordered("b", "a"),
+			wantMoved: []Move{{Item: Item{Key: "a", Name: "a", Position: 1}, From: 0}},
+		},
+		{
+			name:        "removing a duplicate removes its last occurrence",
+			before:      ordered("a", "b", "a"),
+			after:       ordered("a", "b"),
+			wantRemoved: []Item{{Key: "a", Name: "a", Position: 2}},
+		},
+		{
+			name:      "duplicates are matched in order",
+			before:    ordered("a", "b", "a"),
+			after:     ordered("a", "a", "b"),
+			wantMoved: []Move{{Item: Item{Key: "b", Name: "b", Position: 2}, From: 1}},
+		},
+		{
+			name:      "adding a duplicate",
+			before:    ordered("a", "b"),
+			after:     ordered("a", "b", "a"),
+			wantAdded: []Item{{Key: "a", Name: "a", Position: 2}},
+		},
+		{
+			name:   "moves aren't reported for unordered snapshots",
+			before: &Snapshot{Items: items("a", "b")},
+			after:  &Snapshot{Items: items("b", "a")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := Compare(tt.before, tt.after)
+
+			if !reflect.DeepEqual(d.Added, tt.wantAdded) {
+				t.Errorf("Added = %v, want %v", d.Added, tt.wantAdded)
+			}
+			if !reflect.DeepEqual(d.Removed, tt.wantRemoved) {
+				t.Errorf("Removed = %v, want %v", d.Removed, tt.wantRemoved)
+			}
+			if !reflect.DeepEqual(d.Moved, tt.wantMoved) {
+				t.Errorf("Moved = %v, want %v", d.Moved, tt.wantMoved)
+			}
+
+			wantStatus := StatusChanged
+			if tt.wantAdded == nil && tt.wantRemoved == nil && tt.wantMoved == nil {
+				wantStatus = StatusUnchanged
+			}
+			if d.Status != wantStatus {
+				t.Errorf("Status = %s, want %s", d.Status, wantStatus)
+			}
+		})
+	}
+}
+
+func TestCompareMetadata(t *testing.T) {
+	before := &Snapshot{Metadata: map[string]string{"name": "Road Trip", "public": "true", "collaborative": "false"}}
+	after := &Snapshot{Metadata: map[string]string{"name": "Road Trip 2024", "description": "Summer", "collaborative": "false"}}
+
+	want := []Change{
+		{Property: "description", Old: "", New: "Summer"},
+		{Property: "name", Old: "Road Trip", New: "Road Trip 2024"},
+		{Property: "public", Old: "true", New: ""},
+	}
+
+	if got := Compare(before, after).Changes; !reflect.DeepEqual(got, want) {
+		t.Errorf("Changes = %v, want %v", got, want)
+	}
+
+	// A property that is added empty isn't a change.
+	after = &Snapshot{Metadata: map[string]string{"description": ""}}
+	if got := Compare(&Snapshot{}, after); !got.Empty() {
+		t.Errorf("Compare() = %+v, want no changes", got)
+	}
+}
+
+func TestCompareAvailability(t *testing.T) {
+	yes, no := true, false
+
+	before := ordered("a", "b", "c")
+	before.Items[0].Playable = &yes
+	before.Items[1].Playable = &yes
+
+	after := ordered("a", "b", "c")
+	after.Items[0].Playable = &no
+	after.Items[1].Playable = &yes
+	after.Items[2].Playable = &no
+
+	want := []AvailabilityChange{{Item: after.Items[0], Playable: false}}
+
+	if got := Compare(before, after).Availability; !reflect.DeepEqual(got, want) {
+		t.Errorf("Availability = %v, want %v", got, want)
+	}
+}
+
+func TestCompareStatus(t *testing.T) {
+	tests := []struct {
+		name   string
+		before *Snapshot
+		after  *Snapshot
+		want   Status
+	}{
+		{name: "both missing", want: StatusUnchanged},
+		{name: "added", after: ordered("a"), want: StatusAdded},
+		{name: "removed", before: ordered("a"), want: StatusRemoved},
+		{name: "unchanged", before: ordered("a"), after: ordered("a"), want: StatusUnchanged},
+		{name: "changed", before: ordered("a"), after: ordered("b"), want: StatusChanged},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := Compare(tt.before, tt.after)
+			if d.Status != tt.want {
+				t.Errorf("Status = %s, want %s", d.Status, tt.want)
+			}
+			if d.Empty() != (tt.want == StatusUnchanged) {
+				t.Errorf("Empty() = %v for status %s", d.Empty(), d.Status)
+			}
+		})
+	}
+}
+
+func TestWriteText(t *testing.T) {
+	playable := true
+	changed := &Diff{
+		Title:   "Road Trip",
+		Status:  StatusChanged,
+		Changes: []Change{{Property: "name", Old: "Road Trip", New: "Road Trip 2024"}},
+		Added:   []Item{{Name: "Song A", Position: 0}},
+		Removed: []Item{{Name: "Song B", Position: 4}},
+		Moved:   []Move{{Item: Item{Name: "Song C", Position: 2}, From: 0}},
+		Availability: []AvailabilityChange{
+			{Item: Item{Name: "Song D", Playable: &playable}, Playable: true},
+			{Item: Item{Name: "Song E"}, Playable: false},
+		},
+	}
+	added := &Diff{Title: "Liked Songs", Status: StatusAdded}
+
+	want := `Road Trip: 1 details changed, 1 added, 1 removed, 1 moved, 2 availability changed
+  ~ name: "Road Trip" -> "Road Trip 2024"
+  + 1. Song A
+  - 5. Song B
+  > Song C moved from 1 to 3
+  ! Song D is available again
+  ! Song E is no longer available
+
+Liked Songs: new
+`
+
+	if got := Text(changed, added); got != want {
+		t.Errorf("Text() =\n%s\nwant\n%s", got, want)
+	}
+}
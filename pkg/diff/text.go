@@ -0,0 +1,90 @@
+package diff
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Summary returns a one line summary of the diff, e.g.
+// "Road Trip: 2 added, 1 removed".
+func (d *Diff) Summary() string {
+	switch d.Status {
+	case StatusAdded:
+		return d.Title + ": new"
+	case StatusRemoved:
+		return d.Title + ": deleted"
+	case StatusUnchanged:
+		return d.Title + ": no changes"
+	}
+
+	var parts []string
+	for _, count := range []struct {
+		n    int
+		verb string
+	}{
+		{len(d.Changes), "details changed"},
+		{len(d.Added), "added"},
+		{len(d.Removed), "removed"},
+		{len(d.Moved), "moved"},
+		{len(d.Availability), "availability changed"},
+	} {
+		if count.n > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", count.n, count.verb))
+		}
+	}
+
+	return d.Title + ": " + strings.Join(parts, ", ")
+}
+
+// WriteText writes the diffs as human-readable text to w. Every diff starts
+// with its summary, followed by one line per change. Positions are shown
+// starting at 1.
+func WriteText(w io.Writer, diffs ...*Diff) error {
+	var b strings.Builder
+
+	for i, d := range diffs {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+
+		b.WriteString(d.Summary() + "\n")
+
+		for _, c := range d.Changes {
+			fmt.Fprintf(&b, "  ~ %s: %q -> %q\n", c.Property, c.Old, c.New)
+		}
+
+		for _, item := range d.Added {
+			fmt.Fprintf(&b, "  + %d. %s\n", item.Position+1, item.Name)
+		}
+
+		for _, item := range d.Removed {
+			fmt.Fprintf(&b, "  - %d. %s\n", item.Position+1, item.Name)
+		}
+
+		for _, m := range d.Moved {
+			fmt.Fprintf(&b, "  > %s moved from %d to %d\n", m.Item.Name, m.From+1, m.Item.Position+1)
+		}
+
+		for _, a := range d.Availability {
+			state := "is no longer available"
+			if a.Playable {
+				state = "is available again"
+			}
+
+			fmt.Fprintf(&b, "  ! %s %s\n", a.Item.Name, state)
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+
+	return err
+}
+
+// Text returns the diffs as human-readable text like [WriteText].
+func Text(diffs ...*Diff) string {
+	var b strings.Builder
+	_ = WriteText(&b, diffs...)
+
+	return b.String()
+}
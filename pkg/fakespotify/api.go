@@ -0,0 +1,279 @@
+package fakespotify
+
+import (
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultPageSize          = 20
+	maxPageSize              = 50
+	maxPlaylistItemsPageSize = 100
+	maxTracks                = 50
+)
+
+func (s *Server) handleMe(_ *http.Request) (any, int) {
+	return s.fixture.User, http.StatusOK
+}
+
+func (s *Server) handlePlaylists(r *http.Request) (any, int) {
+	playlists := make([]any, 0, len(s.fixture.Playlists))
+	for _, p := range s.fixture.Playlists {
+		playlists = append(playlists, s.simplifiedPlaylist(r, p))
+	}
+
+	return s.page(r, playlists, maxPageSize)
+}
+
+// handlePlaylist serves a playlist, whether or not it is in the user's library.
+func (s *Server) handlePlaylist(r *http.Request) (any, int) {
+	id := r.PathValue("id")
+
+	p := s.playlist(id)
+	if p == nil {
+		i := slices.IndexFunc(s.fixture.OtherPlaylists, func(p Playlist) bool { return p.ID == id })
+		if i < 0 {
+			return "Resource not found", http.StatusNotFound
+		}
+
+		p = &s.fixture.OtherPlaylists[i]
+	}
+
+	return s.simplifiedPlaylist(r, *p), http.StatusOK
+}
+
+func (s *Server) handlePlaylistItems(r *http.Request) (any, int) {
+	p := s.playlist(r.PathValue("id"))
+	if p == nil {
+		return "Resource not found", http.StatusNotFound
+	}
+
+	items := make([]any, 0, len(p.Items))
+	for _, item := range p.Items {
+		items = append(items, map[string]any{
+			"added_at": item.AddedAt.Format(time.RFC3339),
+			"added_by": map[string]any{
+				"id":   item.AddedBy.ID,
+				"type": "user",
+				"uri":  "spotify:user:" + item.AddedBy.ID,
+			},
+			"is_local": false,
+			"track":    s.object(item.URI),
+		})
+	}
+
+	return s.page(r, items, maxPlaylistItemsPageSize)
+}
+
+// handleSaved serves a collection of the user's library whose items are
+// wrapped in an object with the added_at timestamp.
+func (s *Server) handleSaved(objectType string, collection func(*Fixture) []SavedItem) apiHandler {
+	return func(r *http.Request) (any, int) {
+		saved := collection(s.fixture)
+
+		items := make([]any, 0, len(saved))
+		for _, item := range saved {
+			items = append(items, map[string]any{
+				"added_at": item.AddedAt.Format(time.RFC3339),
+				objectType: s.object("spotify:" + objectType + ":" + item.ID),
+			})
+		}
+
+		return s.page(r, items, maxPageSize)
+	}
+}
+
+// handleSavedAudiobooks serves the user's audiobooks. Unlike the other
+// collections, Spotify returns them without an added_at timestamp.
+func (s *Server) handleSavedAudiobooks(r *http.Request) (any, int) {
+	items := make([]any, 0, len(s.fixture.SavedAudiobooks))
+	for _, item := range s.fixture.SavedAudiobooks {
+		items = append(items, s.object("spotify:audiobook:"+item.ID))
+	}
+
+	return s.page(r, items, maxPageSize)
+}
+
+// handleFollowing serves the followed artists, which are paged using cursors.
+func (s *Server) handleFollowing(r *http.Request) (any, int) {
+	query := r.URL.Query()
+	if query.Get("type") != "artist" {
+		return "Invalid type", http.StatusBadRequest
+	}
+
+	limit, ok := parseLimit(query.Get("limit"), maxPageSize)
+	if !ok {
+		return "Invalid limit", http.StatusBadRequest
+	}
+
+	if s.opts.MaxPageSize > 0 {
+		limit = min(limit, s.opts.MaxPageSize)
+	}
+
+	ids := s.fixture.FollowedArtists
+
+	start := 0
+	if after := query.Get("after"); after != "" {
+		start = slices.Index(ids, after) + 1
+	}
+
+	end := min(start+limit, len(ids))
+
+	items := make([]any, 0, end-start)
+	for _, id := range ids[start:end] {
+		items = append(items, s.object("spotify:artist:"+id))
+	}
+
+	cursors := map[string]any{"after": nil}
+	var next any
+	if end < len(ids) {
+		cursors["after"] = ids[end-1]
+
+		query.Set("after", ids[end-1])
+		next = requestURL(r, query)
+	}
+
+	return map[string]any{
+		"artists": map[string]any{
+			"href":    requestURL(r, r.URL.Query()),
+			"items":   items,
+			"limit":   limit,
+			"next":    next,
+			"cursors": cursors,
+			"total":   len(ids),
+		},
+	}, http.StatusOK
+}
+
+// handleTracks serves several tracks by ID. Unknown tracks are null and
+// relinked tracks are served as the track they are relinked to.
+func (s *Server) handleTracks(r *http.Request) (any, int) {
+	ids := strings.Split(r.URL.Query().Get("ids"), ",")
+	if len(ids) == 0 || ids[0] == "" || len(ids) > maxTracks {
+		return "Invalid ids", http.StatusBadRequest
+	}
+
+	tracks := make([]any, 0, len(ids))
+	for _, id := range ids {
+		uri, ok := s.resolve("spotify:track:" + id)
+		if !ok {
+			tracks = append(tracks, nil)
+			continue
+		}
+
+		tracks = append(tracks, s.object(uri))
+	}
+
+	return map[string]any{"tracks": tracks}, http.StatusOK
+}
+
+func (s *Server) playlist(id string) *Playlist {
+	for i := range s.fixture.Playlists {
+		if s.fixture.Playlists[i].ID == id {
+			return &s.fixture.Playlists[i]
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) simplifiedPlaylist(r *http.Request, p Playlist) map[string]any {
+	base := baseURL(r) + "/v1/playlists/" + p.ID
+
+	return map[string]any{
+		"id":            p.ID,
+		"type":          "playlist",
+		"uri":           "spotify:playlist:" + p.ID,
+		"href":          base,
+		"name":          p.Name,
+		"description":   p.Description,
+		"public":        p.Public,
+		"collaborative": p.Collaborative,
+		"owner":         p.Owner,
+		"snapshot_id":   p.SnapshotID,
+		"images":        []any{},
+		"tracks": map[string]any{
+			"href":  base + "/tracks",
+			"total": len(p.Items),
+		},
+	}
+}
+
+// page returns the page of items selected by the limit and offset query parameters.
+func (s *Server) page(r *http.Request, items []any, maxLimit int) (any, int) {
+	query := r.URL.Query()
+
+	limit, ok := parseLimit(query.Get("limit"), maxLimit)
+	if !ok {
+		return "Invalid limit", http.StatusBadRequest
+	}
+
+	offset := 0
+	if value := query.Get("offset"); value != "" {
+		var err error
+		offset, err = strconv.Atoi(value)
+		if err != nil || offset < 0 {
+			return "Invalid offset", http.StatusBadRequest
+		}
+	}
+
+	if s.opts.MaxPageSize > 0 {
+		limit = min(limit, s.opts.MaxPageSize)
+	}
+
+	start := min(offset, len(items))
+	end := min(offset+limit, len(items))
+
+	var next, previous any
+	if end < len(items) {
+		query.Set("offset", strconv.Itoa(end))
+		query.Set("limit", strconv.Itoa(limit))
+		next = requestURL(r, query)
+	}
+
+	if start > 0 {
+		query.Set("offset", strconv.Itoa(max(start-limit, 0)))
+		query.Set("limit", strconv.Itoa(limit))
+		previous = requestURL(r, query)
+	}
+
+	return map[string]any{
+		"href":     requestURL(r, r.URL.Query()),
+		"items":    items[start:end],
+		"limit":    limit,
+		"offset":   offset,
+		"next":     next,
+		"previous": previous,
+		"total":    len(items),
+	}, http.StatusOK
+}
+
+func parseLimit(value string, maxLimit int) (int, bool) {
+	if value == "" {
+		return defaultPageSize, true
+	}
+
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit < 1 || limit > maxLimit {
+		return 0, false
+	}
+
+	return limit, true
+}
+
+func baseURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+
+	return scheme + "://" + r.Host
+}
+
+func requestURL(r *http.Request, query url.Values) string {
+	return baseURL(r) + r.URL.Path + "?" + query.Encode()
+}
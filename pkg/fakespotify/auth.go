@@ -0,0 +1,109 @@
+package fakespotify
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// handleAuthorize immediately grants access and redirects back to the app.
+func (s *Server) handleAuthorize(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	redirectURI, err := url.Parse(query.Get("redirect_uri"))
+	if err != nil || query.Get("client_id") == "" || query.Get("response_type") != "code" {
+		http.Error(w, "invalid authorization request", http.StatusBadRequest)
+		return
+	}
+
+	callback := redirectURI.Query()
+	callback.Set("code", s.IssueCode())
+	callback.Set("state", query.Get("state"))
+	redirectURI.RawQuery = callback.Encode()
+
+	http.Redirect(w, r, redirectURI.String(), http.StatusFound)
+}
+
+// handleToken exchanges authorization codes and refresh tokens for access tokens.
+func (s *Server) handleToken(w http.ResponseWriter, r *http.Request) {
+	if _, _, ok := r.BasicAuth(); !ok {
+		writeAuthError(w, http.StatusBadRequest, "invalid_client", "Invalid client")
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		writeAuthError(w, http.StatusBadRequest, "invalid_request", err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res := map[string]any{
+		"token_type": "Bearer",
+		"scope":      "",
+		"expires_in": int(s.opts.TokenLifetime.Seconds()),
+	}
+
+	switch r.PostForm.Get("grant_type") {
+	case "authorization_code":
+		code := r.PostForm.Get("code")
+		if !s.codes[code] {
+			writeAuthError(w, http.StatusBadRequest, "invalid_grant", "Invalid authorization code")
+			return
+		}
+
+		delete(s.codes, code)
+
+		refreshToken := randomToken()
+		s.refreshTokens[refreshToken] = true
+		res["refresh_token"] = refreshToken
+	case "refresh_token":
+		if !s.refreshTokens[r.PostForm.Get("refresh_token")] {
+			writeAuthError(w, http.StatusBadRequest, "invalid_grant", "Invalid refresh token")
+			return
+		}
+	default:
+		writeAuthError(w, http.StatusBadRequest, "unsupported_grant_type", "grant_type must be client_credentials, authorization_code or refresh_token")
+		return
+	}
+
+	accessToken := randomToken()
+	s.accessTokens[accessToken] = time.Now().Add(s.opts.TokenLifetime)
+	res["access_token"] = accessToken
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(res)
+}
+
+// IssueCode returns a new authorization code as if the user granted access.
+// It can be exchanged for tokens once.
+func (s *Server) IssueCode() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	code := randomToken()
+	s.codes[code] = true
+
+	return code
+}
+
+// RevokeTokens invalidates all access and refresh tokens as if the
+// user removed the app's access to their account.
+func (s *Server) RevokeTokens() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	clear(s.accessTokens)
+	clear(s.refreshTokens)
+}
+
+func randomToken() string {
+	b := make([]byte, 24)
+	_, _ = rand.Read(b)
+
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,102 @@
+package fakespotify
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+//go:embed fixture.json
+var defaultFixture []byte
+
+// A Fixture is the data a [Server] is seeded with.
+//
+// The catalog (artists, albums, tracks, shows, episodes and audiobooks) holds
+// complete Spotify API objects which are served as they are. Playlists and the
+// user's library only reference catalog objects by their ID or URI, so a single
+// object can appear in any number of places.
+type Fixture struct {
+	User       json.RawMessage   `json:"user"`
+	Artists    []json.RawMessage `json:"artists"`
+	Albums     []json.RawMessage `json:"albums"`
+	Tracks     []json.RawMessage `json:"tracks"`
+	Shows      []json.RawMessage `json:"shows"`
+	Episodes   []json.RawMessage `json:"episodes"`
+	Audiobooks []json.RawMessage `json:"audiobooks"`
+
+	Playlists       []Playlist  `json:"playlists"`
+	SavedTracks     []SavedItem `json:"saved_tracks"`
+	SavedAlbums     []SavedItem `json:"saved_albums"`
+	SavedShows      []SavedItem `json:"saved_shows"`
+	SavedEpisodes   []SavedItem `json:"saved_episodes"`
+	SavedAudiobooks []SavedItem `json:"saved_audiobooks"`
+	FollowedArtists []string    `json:"followed_artists"`
+
+	// OtherPlaylists are playlists of other users that aren't in the user's
+	// library, but can be looked up and followed.
+	OtherPlaylists []Playlist `json:"other_playlists"`
+}
+
+// A Playlist is a playlist the user owns or follows.
+type Playlist struct {
+	ID            string          `json:"id"`
+	Name          string          `json:"name"`
+	Description   string          `json:"description"`
+	Public        *bool           `json:"public"`
+	Collaborative bool            `json:"collaborative"`
+	Owner         json.RawMessage `json:"owner"`
+	SnapshotID    string          `json:"snapshot_id"`
+	Items         []PlaylistItem  `json:"items"`
+}
+
+// A PlaylistItem references a track or episode by its URI.
+type PlaylistItem struct {
+	AddedAt time.Time `json:"added_at"`
+	AddedBy UserRef   `json:"added_by"`
+	URI     string    `json:"uri"`
+}
+
+// A UserRef references a Spotify user.
+type UserRef struct {
+	ID string `json:"id"`
+}
+
+// A SavedItem references a catalog object saved in the user's library.
+type SavedItem struct {
+	AddedAt time.Time `json:"added_at"`
+	ID      string    `json:"id"`
+}
+
+// DefaultFixture returns a new copy of the fixture embedded in this package.
+// It contains a small library with a few playlists, including a collaborative
+// playlist, episodes in playlists, explicit, unavailable and relinked tracks.
+func DefaultFixture() *Fixture {
+	fixture, err := ParseFixture(defaultFixture)
+	if err != nil {
+		panic(fmt.Sprintf("embedded fixture is invalid: %v", err))
+	}
+
+	return fixture
+}
+
+// LoadFixture reads a fixture from a JSON file.
+func LoadFixture(path string) (*Fixture, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseFixture(data)
+}
+
+// ParseFixture parses a fixture from JSON.
+func ParseFixture(data []byte) (*Fixture, error) {
+	var fixture Fixture
+	if err := json.Unmarshal(data, &fixture); err != nil {
+		return nil, err
+	}
+
+	return &fixture, nil
+}
@@ -0,0 +1,344 @@
+package fakespotify
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+// maxModifyItems is the number of items that can be added to a playlist at once.
+const maxModifyItems = 100
+
+// playlistDetails is the body of requests that create or change a playlist.
+type playlistDetails struct {
+	Name          *string `json:"name"`
+	Description   *string `json:"description"`
+	Public        *bool   `json:"public"`
+	Collaborative *bool   `json:"collaborative"`
+}
+
+// playlistItemsBody is the body of requests that add or replace items.
+type playlistItemsBody struct {
+	URIs     []string `json:"uris"`
+	Position *int     `json:"position"`
+}
+
+// handleCreatePlaylist creates a playlist owned by the user.
+func (s *Server) handleCreatePlaylist(r *http.Request) (any, int) {
+	if r.PathValue("user_id") != s.userID {
+		return "You cannot create a playlist for another user", http.StatusForbidden
+	}
+
+	var details playlistDetails
+	if err := json.NewDecoder(r.Body).Decode(&details); err != nil || details.Name == nil || *details.Name == "" {
+		return "Missing required field: name", http.StatusBadRequest
+	}
+
+	owner, _ := json.Marshal(map[string]any{
+		"id":   s.userID,
+		"type": "user",
+		"uri":  "spotify:user:" + s.userID,
+	})
+
+	public := true
+	p := Playlist{
+		ID:     fmt.Sprintf("plnew%017d", len(s.fixture.Playlists)+s.revisions),
+		Public: &public,
+		Owner:  owner,
+	}
+	applyDetails(&p, details)
+	s.touch(&p)
+
+	s.fixture.Playlists = append(s.fixture.Playlists, p)
+
+	return s.simplifiedPlaylist(r, p), http.StatusCreated
+}
+
+// handleChangePlaylistDetails changes the name, description or visibility of a playlist.
+func (s *Server) handleChangePlaylistDetails(r *http.Request) (any, int) {
+	p, status := s.ownPlaylist(r)
+	if p == nil {
+		return http.StatusText(status), status
+	}
+
+	var details playlistDetails
+	if err := json.NewDecoder(r.Body).Decode(&details); err != nil {
+		return "Malformed json", http.StatusBadRequest
+	}
+
+	applyDetails(p, details)
+	s.touch(p)
+
+	return nil, http.StatusOK
+}
+
+// handleReplacePlaylistItems replaces all items of a playlist.
+func (s *Server) handleReplacePlaylistItems(r *http.Request) (any, int) {
+	return s.modifyPlaylistItems(r, func(p *Playlist, items []PlaylistItem, _ *int) {
+		p.Items = items
+	}, http.StatusOK)
+}
+
+// handleAddPlaylistItems inserts items at a position or appends them.
+func (s *Server) handleAddPlaylistItems(r *http.Request) (any, int) {
+	return s.modifyPlaylistItems(r, func(p *Playlist, items []PlaylistItem, position *int) {
+		at := len(p.Items)
+		if position != nil {
+			at = min(max(*position, 0), len(p.Items))
+		}
+
+		p.Items = append(p.Items[:at:at], append(items, p.Items[at:]...)...)
+	}, http.StatusCreated)
+}
+
+func (s *Server) modifyPlaylistItems(r *http.Request, modify func(p *Playlist, items []PlaylistItem, position *int), success int) (any, int) {
+	p, status := s.ownPlaylist(r)
+	if p == nil {
+		return http.StatusText(status), status
+	}
+
+	var body playlistItemsBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return "Malformed json", http.StatusBadRequest
+	}
+
+	if len(body.URIs) > maxModifyItems {
+		return "You can add a maximum of 100 tracks per request.", http.StatusBadRequest
+	}
+
+	items := make([]PlaylistItem, 0, len(body.URIs))
+	for _, uri := range body.URIs {
+		uri, ok := s.resolve(uri)
+		if !ok {
+			return "Invalid track uri: " + uri, http.StatusBadRequest
+		}
+
+		items = append(items, PlaylistItem{
+			AddedAt: time.Now().UTC().Truncate(time.Second),
+			AddedBy: UserRef{ID: s.userID},
+			URI:     uri,
+		})
+	}
+
+	modify(p, items, body.Position)
+	s.touch(p)
+
+	return map[string]any{"snapshot_id": p.SnapshotID}, success
+}
+
+// handleFollowPlaylist adds a playlist of another user to the library.
+func (s *Server) handleFollowPlaylist(r *http.Request) (any, int) {
+	id := r.PathValue("id")
+	if s.playlist(id) != nil {
+		return nil, http.StatusOK
+	}
+
+	i := slices.IndexFunc(s.fixture.OtherPlaylists, func(p Playlist) bool { return p.ID == id })
+	if i < 0 {
+		return "Resource not found", http.StatusNotFound
+	}
+
+	s.fixture.Playlists = append(s.fixture.Playlists, s.fixture.OtherPlaylists[i])
+	s.fixture.OtherPlaylists = slices.Delete(s.fixture.OtherPlaylists, i, i+1)
+
+	return nil, http.StatusOK
+}
+
+// handleUnfollowPlaylist removes a playlist from the library. Playlists of
+// other users can be followed again, the user's own are deleted.
+func (s *Server) handleUnfollowPlaylist(r *http.Request) (any, int) {
+	id := r.PathValue("id")
+
+	i := slices.IndexFunc(s.fixture.Playlists, func(p Playlist) bool { return p.ID == id })
+	if i < 0 {
+		return "Resource not found", http.StatusNotFound
+	}
+
+	p := s.fixture.Playlists[i]
+	s.fixture.Playlists = slices.Delete(s.fixture.Playlists, i, i+1)
+
+	var owner UserRef
+	if err := json.Unmarshal(p.Owner, &owner); err == nil && owner.ID != s.userID {
+		s.fixture.OtherPlaylists = append(s.fixture.OtherPlaylists, p)
+	}
+
+	return nil, http.StatusOK
+}
+
+// ownPlaylist returns the playlist of the request if the user owns it or
+// the status code of the error otherwise.
+func (s *Server) ownPlaylist(r *http.Request) (*Playlist, int) {
+	p := s.playlist(r.PathValue("id"))
+	if p == nil {
+		return nil, http.StatusNotFound
+	}
+
+	var owner UserRef
+	if err := json.Unmarshal(p.Owner, &owner); err != nil || owner.ID != s.userID {
+		return nil, http.StatusForbidden
+	}
+
+	return p, http.StatusOK
+}
+
+func applyDetails(p *Playlist, details playlistDetails) {
+	if details.Name != nil {
+		p.Name = *details.Name
+	}
+	if details.Description != nil {
+		p.Description = *details.Description
+	}
+	if details.Public != nil {
+		p.Public = details.Public
+	}
+	if details.Collaborative != nil {
+		p.Collaborative = *details.Collaborative
+	}
+}
+
+// touch gives a playlist a new snapshot ID after it was modified.
+func (s *Server) touch(p *Playlist) {
+	s.revisions++
+	p.SnapshotID = fmt.Sprintf("%s-rev%d", p.ID, s.revisions)
+}
+
+// Limits of the IDs that can be saved or removed with a single request.
+const (
+	maxSaveTracks    = 50
+	maxSaveAlbums    = 20
+	maxFollowArtists = 50
+)
+
+// libraryBody is the body of requests that save or remove items of the library.
+type libraryBody struct {
+	IDs            []string `json:"ids"`
+	TimestampedIDs []struct {
+		ID      string    `json:"id"`
+		AddedAt time.Time `json:"added_at"`
+	} `json:"timestamped_ids"`
+}
+
+// handleSave saves items to a collection. Tracks may be saved with the time
+// they were originally added, so the collection is ordered like before.
+func (s *Server) handleSave(objectType string, maxIDs int, collection func(*Fixture) *[]SavedItem) apiHandler {
+	return func(r *http.Request) (any, int) {
+		body, status := s.libraryBody(r, objectType, maxIDs)
+		if status != http.StatusOK {
+			return "Invalid request", status
+		}
+
+		now := time.Now().UTC().Truncate(time.Second)
+		saved := collection(s.fixture)
+		save := func(id string, addedAt time.Time) {
+			*saved = slices.DeleteFunc(*saved, func(item SavedItem) bool { return item.ID == id })
+			*saved = append(*saved, SavedItem{AddedAt: addedAt, ID: id})
+		}
+
+		for _, id := range body.IDs {
+			save(id, now)
+		}
+		for _, item := range body.TimestampedIDs {
+			save(item.ID, item.AddedAt.UTC())
+		}
+
+		// Spotify returns collections most recently added first.
+		slices.SortStableFunc(*saved, func(a, b SavedItem) int {
+			return b.AddedAt.Compare(a.AddedAt)
+		})
+
+		return nil, http.StatusOK
+	}
+}
+
+// handleRemoveSaved removes items from a collection.
+func (s *Server) handleRemoveSaved(objectType string, maxIDs int, collection func(*Fixture) *[]SavedItem) apiHandler {
+	return func(r *http.Request) (any, int) {
+		body, status := s.libraryBody(r, objectType, maxIDs)
+		if status != http.StatusOK {
+			return "Invalid request", status
+		}
+
+		saved := collection(s.fixture)
+		*saved = slices.DeleteFunc(*saved, func(item SavedItem) bool {
+			return slices.Contains(body.IDs, item.ID)
+		})
+
+		return nil, http.StatusOK
+	}
+}
+
+// handleFollow follows artists.
+func (s *Server) handleFollow(r *http.Request) (any, int) {
+	if r.URL.Query().Get("type") != "artist" {
+		return "Invalid type", http.StatusBadRequest
+	}
+
+	body, status := s.libraryBody(r, "artist", maxFollowArtists)
+	if status != http.StatusOK {
+		return "Invalid request", status
+	}
+
+	for _, id := range body.IDs {
+		if !slices.Contains(s.fixture.FollowedArtists, id) {
+			s.fixture.FollowedArtists = append(s.fixture.FollowedArtists, id)
+		}
+	}
+
+	return nil, http.StatusNoContent
+}
+
+// handleUnfollow unfollows artists.
+func (s *Server) handleUnfollow(r *http.Request) (any, int) {
+	if r.URL.Query().Get("type") != "artist" {
+		return "Invalid type", http.StatusBadRequest
+	}
+
+	body, status := s.libraryBody(r, "artist", maxFollowArtists)
+	if status != http.StatusOK {
+		return "Invalid request", status
+	}
+
+	s.fixture.FollowedArtists = slices.DeleteFunc(s.fixture.FollowedArtists, func(id string) bool {
+		return slices.Contains(body.IDs, id)
+	})
+
+	return nil, http.StatusNoContent
+}
+
+// libraryBody decodes and validates the IDs of a request that modifies the library.
+func (s *Server) libraryBody(r *http.Request, objectType string, maxIDs int) (libraryBody, int) {
+	var body libraryBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return body, http.StatusBadRequest
+	}
+
+	if n := len(body.IDs) + len(body.TimestampedIDs); n == 0 || n > maxIDs {
+		return body, http.StatusBadRequest
+	}
+
+	resolve := func(id string) (string, bool) {
+		prefix := "spotify:" + objectType + ":"
+		uri, ok := s.resolve(prefix + id)
+
+		return strings.TrimPrefix(uri, prefix), ok
+	}
+
+	for i, id := range body.IDs {
+		var ok bool
+		if body.IDs[i], ok = resolve(id); !ok {
+			return body, http.StatusBadRequest
+		}
+	}
+
+	for i, item := range body.TimestampedIDs {
+		var ok bool
+		if body.TimestampedIDs[i].ID, ok = resolve(item.ID); !ok {
+			return body, http.StatusBadRequest
+		}
+	}
+
+	return body, http.StatusOK
+}
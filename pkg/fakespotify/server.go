@@ -0,0 +1,255 @@
+// Package fakespotify emulates the parts of Spotify's Accounts service and Web API
+// used by this project. It allows to develop and test without a Spotify account.
+//
+// A [Server] is a [http.Handler], so it can be started with [httptest.NewServer]:
+//
+//	srv := httptest.NewServer(fakespotify.New(fakespotify.DefaultFixture(), fakespotify.Options{}))
+//	defer srv.Close()
+//
+// The Accounts service is served at the root of the server and the Web API below
+// /v1, i.e. spotify.accounts_url is srv.URL and spotify.api_url is srv.URL + "/v1".
+package fakespotify
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Options configure the behavior of a [Server].
+type Options struct {
+	// MaxPageSize caps the number of items per page regardless of the
+	// requested limit, which allows to exercise paging with small fixtures.
+	// Zero honors the requested limit.
+	MaxPageSize int
+	// RateLimitEvery makes every n-th Web API request fail with
+	// 429 Too Many Requests. Zero disables rate limiting.
+	RateLimitEvery int
+	// RetryAfter is the value of the Retry-After header of rate limited responses.
+	RetryAfter time.Duration
+	// TokenLifetime is how long access tokens are valid. Defaults to one hour.
+	TokenLifetime time.Duration
+	// FailPaths are Web API paths, e.g. /v1/me/albums, whose requests fail
+	// with 500 Internal Server Error, which allows to exercise partial failures.
+	FailPaths []string
+}
+
+// A Server is a fake Spotify Accounts service and Web API.
+type Server struct {
+	opts Options
+	mux  *http.ServeMux
+
+	mu       sync.Mutex
+	fixture  *Fixture
+	userID   string
+	catalog  map[string]json.RawMessage
+	requests int
+	// revisions counts the modifications of playlists.
+	revisions int
+	// relinked maps the URIs of tracks that are relinked in the user's
+	// market to the URIs of the tracks they are relinked to.
+	relinked map[string]string
+
+	codes         map[string]bool
+	accessTokens  map[string]time.Time
+	refreshTokens map[string]bool
+}
+
+// New creates a [Server] seeded with fixture.
+func New(fixture *Fixture, opts Options) *Server {
+	if opts.TokenLifetime == 0 {
+		opts.TokenLifetime = time.Hour
+	}
+
+	s := &Server{
+		opts:          opts,
+		mux:           http.NewServeMux(),
+		fixture:       fixture,
+		catalog:       map[string]json.RawMessage{},
+		relinked:      map[string]string{},
+		codes:         map[string]bool{},
+		accessTokens:  map[string]time.Time{},
+		refreshTokens: map[string]bool{},
+	}
+
+	var user struct {
+		ID string `json:"id"`
+	}
+	_ = json.Unmarshal(fixture.User, &user)
+	s.userID = user.ID
+
+	for _, objects := range [][]json.RawMessage{
+		fixture.Artists, fixture.Albums, fixture.Tracks,
+		fixture.Shows, fixture.Episodes, fixture.Audiobooks,
+	} {
+		for _, object := range objects {
+			var ref struct {
+				URI        string `json:"uri"`
+				LinkedFrom *struct {
+					URI string `json:"uri"`
+				} `json:"linked_from"`
+			}
+			if err := json.Unmarshal(object, &ref); err == nil && ref.URI != "" {
+				s.catalog[ref.URI] = object
+
+				if ref.LinkedFrom != nil {
+					s.relinked[ref.LinkedFrom.URI] = ref.URI
+				}
+			}
+		}
+	}
+
+	s.routes()
+
+	return s
+}
+
+func (s *Server) routes() {
+	s.mux.HandleFunc("GET /authorize", s.handleAuthorize)
+	s.mux.HandleFunc("POST /api/token", s.handleToken)
+
+	s.mux.HandleFunc("GET /v1/me", s.api(s.handleMe))
+	s.mux.HandleFunc("GET /v1/me/playlists", s.api(s.handlePlaylists))
+	s.mux.HandleFunc("GET /v1/playlists/{id}", s.api(s.handlePlaylist))
+	s.mux.HandleFunc("GET /v1/playlists/{id}/tracks", s.api(s.handlePlaylistItems))
+	s.mux.HandleFunc("POST /v1/users/{user_id}/playlists", s.api(s.handleCreatePlaylist))
+	s.mux.HandleFunc("PUT /v1/playlists/{id}", s.api(s.handleChangePlaylistDetails))
+	s.mux.HandleFunc("PUT /v1/playlists/{id}/tracks", s.api(s.handleReplacePlaylistItems))
+	s.mux.HandleFunc("POST /v1/playlists/{id}/tracks", s.api(s.handleAddPlaylistItems))
+	s.mux.HandleFunc("PUT /v1/playlists/{id}/followers", s.api(s.handleFollowPlaylist))
+	s.mux.HandleFunc("DELETE /v1/playlists/{id}/followers", s.api(s.handleUnfollowPlaylist))
+	s.mux.HandleFunc("GET /v1/me/tracks", s.api(s.handleSaved("track", func(f *Fixture) []SavedItem { return f.SavedTracks })))
+	s.mux.HandleFunc("GET /v1/me/albums", s.api(s.handleSaved("album", func(f *Fixture) []SavedItem { return f.SavedAlbums })))
+	s.mux.HandleFunc("GET /v1/me/shows", s.api(s.handleSaved("show", func(f *Fixture) []SavedItem { return f.SavedShows })))
+	s.mux.HandleFunc("GET /v1/me/episodes", s.api(s.handleSaved("episode", func(f *Fixture) []SavedItem { return f.SavedEpisodes })))
+	s.mux.HandleFunc("GET /v1/me/audiobooks", s.api(s.handleSavedAudiobooks))
+	s.mux.HandleFunc("GET /v1/me/following", s.api(s.handleFollowing))
+	s.mux.HandleFunc("GET /v1/tracks", s.api(s.handleTracks))
+	s.mux.HandleFunc("PUT /v1/me/tracks", s.api(s.handleSave("track", maxSaveTracks, func(f *Fixture) *[]SavedItem { return &f.SavedTracks })))
+	s.mux.HandleFunc("DELETE /v1/me/tracks", s.api(s.handleRemoveSaved("track", maxSaveTracks, func(f *Fixture) *[]SavedItem { return &f.SavedTracks })))
+	s.mux.HandleFunc("PUT /v1/me/albums", s.api(s.handleSave("album", maxSaveAlbums, func(f *Fixture) *[]SavedItem { return &f.SavedAlbums })))
+	s.mux.HandleFunc("DELETE /v1/me/albums", s.api(s.handleRemoveSaved("album", maxSaveAlbums, func(f *Fixture) *[]SavedItem { return &f.SavedAlbums })))
+	s.mux.HandleFunc("PUT /v1/me/following", s.api(s.handleFollow))
+	s.mux.HandleFunc("DELETE /v1/me/following", s.api(s.handleUnfollow))
+}
+
+// ServeHTTP implements [http.Handler].
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// Update calls update with the fixture while holding the server's lock, so
+// the library can be changed between requests, e.g. to emulate changes the
+// user made in another app.
+func (s *Server) Update(update func(f *Fixture)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	update(s.fixture)
+}
+
+// An apiHandler handles an authenticated Web API request while holding
+// the server's lock. It returns the response body and status code.
+type apiHandler func(r *http.Request) (any, int)
+
+// api wraps an apiHandler with authentication, rate limiting and ETags.
+func (s *Server) api(handler apiHandler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		s.requests++
+		if s.opts.RateLimitEvery > 0 && s.requests%s.opts.RateLimitEvery == 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(int(s.opts.RetryAfter.Seconds())))
+			writeError(w, http.StatusTooManyRequests, "API rate limit exceeded")
+			return
+		}
+
+		if slices.Contains(s.opts.FailPaths, r.URL.Path) {
+			writeError(w, http.StatusInternalServerError, "Server error")
+			return
+		}
+
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		expiresAt, known := s.accessTokens[token]
+		switch {
+		case !ok || !known:
+			writeError(w, http.StatusUnauthorized, "Invalid access token")
+			return
+		case time.Now().After(expiresAt):
+			writeError(w, http.StatusUnauthorized, "The access token expired")
+			return
+		}
+
+		body, status := handler(r)
+		if status >= http.StatusBadRequest {
+			writeError(w, status, fmt.Sprint(body))
+			return
+		}
+
+		data, err := json.Marshal(body)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		sum := sha256.Sum256(data)
+		etag := `"` + hex.EncodeToString(sum[:8]) + `"`
+		w.Header().Set("ETag", etag)
+
+		if r.Method == http.MethodGet && r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(status)
+		_, _ = w.Write(data)
+	}
+}
+
+// writeError writes an error in the format used by the Web API.
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"error": map[string]any{
+			"status":  status,
+			"message": message,
+		},
+	})
+}
+
+// writeAuthError writes an error in the format used by the Accounts service.
+func writeAuthError(w http.ResponseWriter, status int, code string, description string) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(map[string]string{
+		"error":             code,
+		"error_description": description,
+	})
+}
+
+// object returns a catalog object or nil if it does not exist.
+func (s *Server) object(uri string) json.RawMessage {
+	return s.catalog[uri]
+}
+
+// resolve returns the URI of the catalog object that is added to the library
+// for uri, which differs if the track is relinked in the user's market.
+func (s *Server) resolve(uri string) (string, bool) {
+	if relinked, ok := s.relinked[uri]; ok {
+		uri = relinked
+	}
+
+	return uri, s.object(uri) != nil
+}
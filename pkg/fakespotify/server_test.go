@@ -0,0 +1,265 @@
+package fakespotify
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// start starts a server seeded with the default fixture.
+func start(t *testing.T, opts Options) (*Server, *httptest.Server) {
+	t.Helper()
+
+	fake := New(DefaultFixture(), opts)
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+
+	return fake, srv
+}
+
+// requestToken requests tokens with the given grant and returns the
+// response's status code and body.
+func requestToken(t *testing.T, srv *httptest.Server, form url.Values) (int, map[string]any) {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodPost, srv.URL+"/api/token", strings.NewReader(form.Encode()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth("client", "secret")
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	var body map[string]any
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		t.Fatal(err)
+	}
+
+	return res.StatusCode, body
+}
+
+// signIn exchanges a new authorization code and returns the access and refresh token.
+func signIn(t *testing.T, fake *Server, srv *httptest.Server) (string, string) {
+	t.Helper()
+
+	status, body := requestToken(t, srv, url.Values{"grant_type": {"authorization_code"}, "code": {fake.IssueCode()}})
+	if status != http.StatusOK {
+		t.Fatalf("POST /api/token = %d %v, want %d", status, body, http.StatusOK)
+	}
+
+	return body["access_token"].(string), body["refresh_token"].(string)
+}
+
+// get requests a Web API URL and decodes the response body into v unless it is nil.
+func get(t *testing.T, rawURL string, token string, headers map[string]string, v any) *http.Response {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodGet, rawURL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+token)
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	if v != nil && res.StatusCode == http.StatusOK {
+		if err := json.NewDecoder(res.Body).Decode(v); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return res
+}
+
+type page struct {
+	Items []struct {
+		Track struct {
+			URI string `json:"uri"`
+		} `json:"track"`
+	} `json:"items"`
+	Limit int     `json:"limit"`
+	Next  *string `json:"next"`
+	Total int     `json:"total"`
+}
+
+func TestPaging(t *testing.T) {
+	fake, srv := start(t, Options{MaxPageSize: 5})
+	token, _ := signIn(t, fake, srv)
+
+	want := DefaultFixture().Playlists[0].Items
+
+	next := srv.URL + "/v1/playlists/" + DefaultFixture().Playlists[0].ID + "/tracks?limit=100"
+	var uris []string
+	pages := 0
+	for next != "" {
+		var p page
+		if res := get(t, next, token, nil, &p); res.StatusCode != http.StatusOK {
+			t.Fatalf("GET %s = %d, want %d", next, res.StatusCode, http.StatusOK)
+		}
+
+		if p.Limit != 5 || p.Total != len(want) {
+			t.Errorf("GET %s limit = %d, total = %d, want 5 and %d", next, p.Limit, p.Total, len(want))
+		}
+
+		for _, item := range p.Items {
+			uris = append(uris, item.Track.URI)
+		}
+
+		next = ""
+		if p.Next != nil {
+			next = *p.Next
+		}
+
+		pages++
+	}
+
+	if wantPages := (len(want) + 4) / 5; pages != wantPages {
+		t.Errorf("paged through %d pages, want %d", pages, wantPages)
+	}
+
+	if len(uris) != len(want) {
+		t.Fatalf("paged through %d items, want %d", len(uris), len(want))
+	}
+
+	for i, item := range want {
+		if uris[i] != item.URI {
+			t.Errorf("item %d = %s, want %s", i, uris[i], item.URI)
+		}
+	}
+}
+
+func TestFollowingCursors(t *testing.T) {
+	fake, srv := start(t, Options{MaxPageSize: 4})
+	token, _ := signIn(t, fake, srv)
+
+	var ids []string
+	next := srv.URL + "/v1/me/following?type=artist&limit=50"
+	for next != "" {
+		var body struct {
+			Artists struct {
+				Items []struct {
+					ID string `json:"id"`
+				} `json:"items"`
+				Next *string `json:"next"`
+			} `json:"artists"`
+		}
+		if res := get(t, next, token, nil, &body); res.StatusCode != http.StatusOK {
+			t.Fatalf("GET %s = %d, want %d", next, res.StatusCode, http.StatusOK)
+		}
+
+		for _, item := range body.Artists.Items {
+			ids = append(ids, item.ID)
+		}
+
+		next = ""
+		if body.Artists.Next != nil {
+			next = *body.Artists.Next
+		}
+	}
+
+	want := DefaultFixture().FollowedArtists
+	if strings.Join(ids, ",") != strings.Join(want, ",") {
+		t.Errorf("followed artists = %v, want %v", ids, want)
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	fake, srv := start(t, Options{RateLimitEvery: 3, RetryAfter: 2 * time.Second})
+	token, _ := signIn(t, fake, srv)
+
+	for i := 1; i <= 6; i++ {
+		res := get(t, srv.URL+"/v1/me", token, nil, nil)
+
+		if limited := i%3 == 0; limited {
+			if res.StatusCode != http.StatusTooManyRequests || res.Header.Get("Retry-After") != "2" {
+				t.Errorf("request %d = %d with Retry-After %q, want %d with Retry-After 2",
+					i, res.StatusCode, res.Header.Get("Retry-After"), http.StatusTooManyRequests)
+			}
+		} else if res.StatusCode != http.StatusOK {
+			t.Errorf("request %d = %d, want %d", i, res.StatusCode, http.StatusOK)
+		}
+	}
+}
+
+func TestETags(t *testing.T) {
+	fake, srv := start(t, Options{})
+	token, _ := signIn(t, fake, srv)
+
+	res := get(t, srv.URL+"/v1/me/playlists", token, nil, nil)
+	etag := res.Header.Get("ETag")
+	if etag == "" {
+		t.Fatal("GET /me/playlists returned no ETag")
+	}
+
+	if res := get(t, srv.URL+"/v1/me/playlists", token, map[string]string{"If-None-Match": etag}, nil); res.StatusCode != http.StatusNotModified {
+		t.Errorf("GET /me/playlists with the same ETag = %d, want %d", res.StatusCode, http.StatusNotModified)
+	}
+
+	fake.Update(func(f *Fixture) {
+		f.Playlists[0].SnapshotID = "changed"
+	})
+
+	res = get(t, srv.URL+"/v1/me/playlists", token, map[string]string{"If-None-Match": etag}, nil)
+	if res.StatusCode != http.StatusOK || res.Header.Get("ETag") == etag {
+		t.Errorf("GET /me/playlists after a change = %d with ETag %s, want %d with a new ETag",
+			res.StatusCode, res.Header.Get("ETag"), http.StatusOK)
+	}
+}
+
+func TestTokenRefresh(t *testing.T) {
+	fake, srv := start(t, Options{TokenLifetime: time.Millisecond})
+	token, refreshToken := signIn(t, fake, srv)
+
+	time.Sleep(10 * time.Millisecond)
+
+	if res := get(t, srv.URL+"/v1/me", token, nil, nil); res.StatusCode != http.StatusUnauthorized {
+		t.Errorf("GET /me with an expired token = %d, want %d", res.StatusCode, http.StatusUnauthorized)
+	}
+
+	status, body := requestToken(t, srv, url.Values{"grant_type": {"refresh_token"}, "refresh_token": {refreshToken}})
+	if status != http.StatusOK {
+		t.Fatalf("POST /api/token with a refresh token = %d %v, want %d", status, body, http.StatusOK)
+	}
+
+	if body["access_token"] == token {
+		t.Error("POST /api/token with a refresh token returned the expired access token")
+	}
+
+	fake.RevokeTokens()
+
+	status, body = requestToken(t, srv, url.Values{"grant_type": {"refresh_token"}, "refresh_token": {refreshToken}})
+	if status != http.StatusBadRequest || body["error"] != "invalid_grant" {
+		t.Errorf("POST /api/token with a revoked refresh token = %d %v, want %d invalid_grant", status, body, http.StatusBadRequest)
+	}
+}
+
+func TestFailPaths(t *testing.T) {
+	fake, srv := start(t, Options{FailPaths: []string{"/v1/me/albums"}})
+	token, _ := signIn(t, fake, srv)
+
+	if res := get(t, srv.URL+"/v1/me/albums", token, nil, nil); res.StatusCode != http.StatusInternalServerError {
+		t.Errorf("GET /me/albums = %d, want %d", res.StatusCode, http.StatusInternalServerError)
+	}
+
+	if res := get(t, srv.URL+"/v1/me/tracks", token, nil, nil); res.StatusCode != http.StatusOK {
+		t.Errorf("GET /me/tracks = %d, want %d", res.StatusCode, http.StatusOK)
+	}
+}
@@ -1,62 +1,162 @@
 package request
 
 import (
+	"beyerleinf/spotify-backup/internal/server/config"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
 	"io"
+	"maps"
+	"net"
 	"net/http"
+	"net/url"
+	"os"
+	"time"
 )
 
-// Post sends a POST request.
-func Post(ctx context.Context, url string, body io.Reader, headers map[string][]string) ([]byte, int, error) {
-	req, err := http.NewRequestWithContext(ctx, "POST", url, body)
+// A Client sends HTTP requests using the settings from [config.HTTPConfig].
+// All requests are throttled by a limiter shared by the client, see [NewLimiter],
+// and retried if they are rate limited.
+type Client struct {
+	httpClient *http.Client
+	userAgent  string
+}
+
+// A Response is the result of a request whose body has been read completely.
+type Response struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// NewClient creates a new [Client] instance.
+func NewClient(config *config.Config) (*Client, error) {
+	cfg := config.HTTP
+
+	proxy := http.ProxyFromEnvironment
+	if cfg.Proxy != "" {
+		proxyURL, err := url.Parse(cfg.Proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy url: %w", err)
+		}
+
+		proxy = http.ProxyURL(proxyURL)
+	}
+
+	rootCAs, err := loadRootCAs(cfg.CACertFiles)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 
-	req.Header = headers
+	dialer := &net.Dialer{
+		Timeout:   cfg.DialTimeout,
+		KeepAlive: 30 * time.Second,
+	}
 
-	res, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return nil, 0, err
+	transport := &http.Transport{
+		Proxy:               proxy,
+		DialContext:         dialer.DialContext,
+		TLSClientConfig:     &tls.Config{RootCAs: rootCAs, MinVersion: tls.VersionTLS12},
+		TLSHandshakeTimeout: cfg.TLSHandshakeTimeout,
+		MaxIdleConns:        cfg.MaxIdleConns,
+		MaxIdleConnsPerHost: cfg.MaxIdleConns,
+		IdleConnTimeout:     90 * time.Second,
+		ForceAttemptHTTP2:   true,
 	}
 
-	defer res.Body.Close()
+	// The timeout applies to each attempt rather than the whole request,
+	// because waiting for a retry after a 429 may take longer.
+	retry := NewRetryTransport(transport, NewLimiter(cfg.RateLimit, cfg.Burst))
+	retry.Timeout = cfg.Timeout
 
-	data, err := io.ReadAll(res.Body)
+	return &Client{
+		httpClient: &http.Client{Transport: retry},
+		userAgent:  cfg.UserAgent,
+	}, nil
+}
+
+// loadRootCAs returns the system's root CAs extended by the certificates in files.
+func loadRootCAs(files []string) (*x509.CertPool, error) {
+	pool, err := x509.SystemCertPool()
 	if err != nil {
-		return nil, 0, err
+		pool = x509.NewCertPool()
 	}
 
-	return data, res.StatusCode, nil
-}
+	for _, file := range files {
+		pem, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA certificate: %w", err)
+		}
 
-// PostForm sends a POST request with a application/x-www-form-urlencoded body.
-func PostForm(ctx context.Context, url string, body io.Reader, headers map[string][]string) ([]byte, int, error) {
-	headers["Content-Type"] = []string{"application/x-www-form-urlencoded"}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no valid certificates found in %s", file)
+		}
+	}
 
-	return Post(ctx, url, body, headers)
+	return pool, nil
 }
 
-// Get sends a GET request.
-func Get(ctx context.Context, url string, headers map[string][]string) ([]byte, int, error) {
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+// Do sends a request and reads the whole response body.
+func (c *Client) Do(ctx context.Context, method string, url string, body io.Reader, headers map[string][]string) (*Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 
-	req.Header = headers
+	maps.Copy(req.Header, headers)
 
-	res, err := http.DefaultClient.Do(req)
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+
+	res, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 
 	defer res.Body.Close()
 
 	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Response{
+		StatusCode: res.StatusCode,
+		Header:     res.Header,
+		Body:       data,
+	}, nil
+}
+
+// Post sends a POST request.
+func (c *Client) Post(ctx context.Context, url string, body io.Reader, headers map[string][]string) ([]byte, int, error) {
+	res, err := c.Do(ctx, http.MethodPost, url, body, headers)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return res.Body, res.StatusCode, nil
+}
+
+// PostForm sends a POST request with a application/x-www-form-urlencoded body.
+func (c *Client) PostForm(ctx context.Context, url string, body io.Reader, headers map[string][]string) ([]byte, int, error) {
+	headers = maps.Clone(headers)
+	if headers == nil {
+		headers = map[string][]string{}
+	}
+
+	headers["Content-Type"] = []string{"application/x-www-form-urlencoded"}
+
+	return c.Post(ctx, url, body, headers)
+}
+
+// Get sends a GET request.
+func (c *Client) Get(ctx context.Context, url string, headers map[string][]string) ([]byte, int, error) {
+	res, err := c.Do(ctx, http.MethodGet, url, nil, headers)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	return data, res.StatusCode, nil
+	return res.Body, res.StatusCode, nil
 }
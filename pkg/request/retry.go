@@ -0,0 +1,182 @@
+package request
+
+import (
+	"context"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	defaultMaxRetries = 5
+	defaultMaxWait    = 2 * time.Minute
+	defaultBackoff    = time.Second
+)
+
+// NewLimiter creates the token bucket all outgoing requests of a [Client] are
+// throttled with. The client is shared by every user and job, which ensures
+// that concurrent backups of this process cannot exceed the rate limit
+// together. The limiter is per process, so every server instance sends up to
+// this rate. A rate of zero or less disables throttling.
+func NewLimiter(requestsPerSecond float64, burst int) *rate.Limiter {
+	if requestsPerSecond <= 0 {
+		return rate.NewLimiter(rate.Inf, 0)
+	}
+
+	return rate.NewLimiter(rate.Limit(requestsPerSecond), max(burst, 1))
+}
+
+// A RetryTransport is a [http.RoundTripper] which throttles requests using
+// a rate limiter and retries idempotent requests that have been rejected
+// with 429 Too Many Requests after waiting for the duration the server
+// asked for in the Retry-After header.
+type RetryTransport struct {
+	// Base is the transport used to send requests.
+	Base http.RoundTripper
+	// Limiter throttles every attempt, including retries.
+	Limiter *rate.Limiter
+	// MaxRetries is the maximum number of retries per request.
+	MaxRetries int
+	// MaxWait is the longest time to wait before a retry. If the server
+	// asks to wait longer, the 429 response is returned to the caller.
+	MaxWait time.Duration
+	// Timeout limits each attempt including reading its response body, so
+	// waiting for a retry doesn't count towards it. Zero means no timeout.
+	Timeout time.Duration
+}
+
+// NewRetryTransport creates a [RetryTransport] with sensible defaults.
+func NewRetryTransport(base http.RoundTripper, limiter *rate.Limiter) *RetryTransport {
+	return &RetryTransport{
+		Base:       base,
+		Limiter:    limiter,
+		MaxRetries: defaultMaxRetries,
+		MaxWait:    defaultMaxWait,
+	}
+}
+
+// RoundTrip implements [http.RoundTripper].
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	for attempt := 0; ; attempt++ {
+		if err := t.Limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+
+		res, err := t.roundTrip(req)
+		if err != nil || res.StatusCode != http.StatusTooManyRequests {
+			return res, err
+		}
+
+		if attempt >= t.MaxRetries || !isIdempotent(req) {
+			return res, nil
+		}
+
+		wait := backoff(res.Header.Get("Retry-After"), attempt)
+		if wait > t.MaxWait {
+			return res, nil
+		}
+
+		next, ok := rewind(req)
+		if !ok {
+			return res, nil
+		}
+
+		res.Body.Close()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+
+		req = next
+	}
+}
+
+// roundTrip sends a single attempt of req limited by the timeout.
+func (t *RetryTransport) roundTrip(req *http.Request) (*http.Response, error) {
+	if t.Timeout <= 0 {
+		return t.Base.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), t.Timeout)
+
+	res, err := t.Base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	res.Body = &cancelBody{ReadCloser: res.Body, cancel: cancel}
+
+	return res, nil
+}
+
+// cancelBody is a response body that releases the context of its attempt
+// once it has been closed.
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	defer b.cancel()
+
+	return b.ReadCloser.Close()
+}
+
+// backoff returns how long to wait before the next attempt. It honors
+// the Retry-After header and falls back to exponential backoff if the
+// header is missing. Up to 50% of jitter is added so that concurrent
+// requests do not all retry at the same instant.
+func backoff(retryAfter string, attempt int) time.Duration {
+	wait := defaultBackoff << attempt
+
+	if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds >= 0 {
+		wait = time.Duration(seconds) * time.Second
+	} else if date, err := http.ParseTime(retryAfter); err == nil {
+		wait = max(time.Until(date), 0)
+	}
+
+	return wait + rand.N(wait/2+1)
+}
+
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// rewind returns a copy of req whose body can be sent again.
+// It reports false if the body cannot be read a second time.
+func rewind(req *http.Request) (*http.Request, bool) {
+	next := req.Clone(req.Context())
+
+	if req.Body == nil || req.Body == http.NoBody {
+		return next, true
+	}
+
+	if req.GetBody == nil {
+		return nil, false
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, false
+	}
+
+	next.Body = body
+
+	return next, true
+}
@@ -0,0 +1,88 @@
+package request
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryTransportTimeoutAppliesPerAttempt(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		io.WriteString(w, "ok")
+	}))
+	defer srv.Close()
+
+	transport := NewRetryTransport(http.DefaultTransport, NewLimiter(0, 0))
+	transport.Timeout = 500 * time.Millisecond
+	client := &http.Client{Transport: transport}
+
+	res, err := client.Get(srv.URL)
+	if err != nil {
+		t.Fatalf("request failed although every attempt was faster than the timeout: %v", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatalf("failed to read body: %v", err)
+	}
+	if res.StatusCode != http.StatusOK || string(body) != "ok" {
+		t.Errorf("got %d %q, want 200 \"ok\"", res.StatusCode, body)
+	}
+	if n := requests.Load(); n != 2 {
+		t.Errorf("got %d attempts, want 2", n)
+	}
+}
+
+func TestRetryTransportTimeoutLimitsAttempt(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}))
+	defer srv.Close()
+
+	transport := NewRetryTransport(http.DefaultTransport, NewLimiter(0, 0))
+	transport.Timeout = 100 * time.Millisecond
+	client := &http.Client{Transport: transport}
+
+	_, err := client.Get(srv.URL)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got %v, want a deadline exceeded error", err)
+	}
+}
+
+func TestRetryTransportDoesNotRetryPost(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	client := &http.Client{Transport: NewRetryTransport(http.DefaultTransport, NewLimiter(0, 0))}
+
+	res, err := client.Post(srv.URL, "text/plain", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusTooManyRequests || requests.Load() != 1 {
+		t.Errorf("got %d after %d attempts, want 429 after 1", res.StatusCode, requests.Load())
+	}
+}
@@ -0,0 +1,210 @@
+// Package leader elects a single server instance to run a task, so tasks
+// like scheduling backups aren't run by every replica. The leader holds a
+// [ent.Lease] in the database which it renews periodically. If it stops
+// renewing the lease, e.g. because it crashed, another instance takes over
+// once the lease expired.
+package leader
+
+import (
+	"beyerleinf/spotify-backup/ent"
+	"beyerleinf/spotify-backup/ent/lease"
+	"beyerleinf/spotify-backup/internal/server/config"
+	"beyerleinf/spotify-backup/pkg/logger"
+	"beyerleinf/spotify-backup/pkg/util"
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+// An Elector competes with the other server instances for the lease of a task.
+type Elector struct {
+	slogger *logger.Logger
+	config  *config.Config
+	db      *ent.Client
+	name    string
+	id      string
+
+	leader atomic.Bool
+}
+
+// New creates an [Elector] for the task with the given name.
+func New(config *config.Config, db *ent.Client, name string) *Elector {
+	return &Elector{
+		slogger: logger.New("leader", config.Server.LogLevel),
+		config:  config,
+		db:      db,
+		name:    name,
+		id:      util.InstanceID(),
+	}
+}
+
+// ID returns the ID this instance holds the lease with.
+func (e *Elector) ID() string {
+	return e.id
+}
+
+// IsLeader reports whether this instance currently holds the lease.
+func (e *Elector) IsLeader() bool {
+	return e.leader.Load()
+}
+
+// Leader returns the current lease of the task or nil if no instance holds it.
+func (e *Elector) Leader(ctx context.Context) (*ent.Lease, error) {
+	l, err := e.db.Lease.Query().
+		Where(lease.Name(e.name), lease.ExpiresAtGT(time.Now())).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+
+	return l, err
+}
+
+// Run competes for the lease until ctx is canceled. While this instance is
+// the leader, task runs with a context that is canceled when the lease is
+// lost. The lease is released on shutdown, so another instance can take
+// over right away.
+func (e *Elector) Run(ctx context.Context, task func(ctx context.Context)) {
+	e.slogger.Info("Campaigning for leadership", "task", e.name, "instance", e.id)
+
+	ticker := time.NewTicker(e.config.Leader.RenewInterval)
+	defer ticker.Stop()
+
+	for {
+		expiresAt, err := e.acquire(ctx)
+		if err != nil && ctx.Err() == nil {
+			e.slogger.Error("Failed to acquire lease", "task", e.name, "err", err)
+		}
+
+		if err == nil && !expiresAt.IsZero() {
+			e.lead(ctx, ticker, expiresAt, task)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// lead runs task and renews the lease until it is lost or ctx is canceled.
+func (e *Elector) lead(ctx context.Context, ticker *time.Ticker, expiresAt time.Time, task func(ctx context.Context)) {
+	e.leader.Store(true)
+	defer e.leader.Store(false)
+
+	e.slogger.Info("Became leader", "task", e.name, "instance", e.id)
+
+	taskCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		task(taskCtx)
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			cancel()
+			<-done
+			e.release(context.WithoutCancel(ctx))
+
+			return
+		case <-done:
+			// The task stopped on its own, so the lease is no longer needed.
+			e.release(ctx)
+
+			return
+		case <-ticker.C:
+		}
+
+		renewed, err := e.acquire(ctx)
+		switch {
+		case err != nil && ctx.Err() != nil:
+			continue
+		case err != nil:
+			e.slogger.Error("Failed to renew lease", "task", e.name, "err", err)
+
+			// The lease is kept as long as it is valid, so a short outage of
+			// the database doesn't stop the task. Leaving a margin of one
+			// interval makes sure no other instance takes over before it stopped.
+			if time.Until(expiresAt) > e.config.Leader.RenewInterval {
+				continue
+			}
+		case !renewed.IsZero():
+			expiresAt = renewed
+			continue
+		}
+
+		e.slogger.Warn("Lost leadership", "task", e.name, "instance", e.id)
+		cancel()
+		<-done
+
+		return
+	}
+}
+
+// acquire takes or renews the lease. It returns when the lease expires
+// or the zero time if another instance holds it.
+func (e *Elector) acquire(ctx context.Context) (time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(e.config.Leader.LeaseDuration)
+
+	// Renew the lease if this instance already holds it.
+	n, err := e.db.Lease.Update().
+		Where(lease.Name(e.name), lease.Holder(e.id)).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if n > 0 {
+		return expiresAt, nil
+	}
+
+	// Take over the lease once the previous holder let it expire. The condition
+	// is checked by the update itself, so only one instance can win.
+	n, err = e.db.Lease.Update().
+		Where(lease.Name(e.name), lease.ExpiresAtLT(now)).
+		SetHolder(e.id).
+		SetAcquiredAt(now).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if n > 0 {
+		return expiresAt, nil
+	}
+
+	err = e.db.Lease.Create().
+		SetName(e.name).
+		SetHolder(e.id).
+		SetAcquiredAt(now).
+		SetExpiresAt(expiresAt).
+		Exec(ctx)
+	if ent.IsConstraintError(err) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return expiresAt, nil
+}
+
+// release lets the lease expire right away.
+func (e *Elector) release(ctx context.Context) {
+	err := e.db.Lease.Update().
+		Where(lease.Name(e.name), lease.Holder(e.id)).
+		SetExpiresAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		e.slogger.Error("Failed to release lease", "task", e.name, "err", err)
+		return
+	}
+
+	e.slogger.Info("Released lease", "task", e.name, "instance", e.id)
+}
@@ -0,0 +1,73 @@
+package lock
+
+import (
+	"context"
+	"sync"
+)
+
+// A localLocker takes locks that are only held within this process. It is
+// meant for databases without advisory locks, which are used by a single
+// server instance, like in tests.
+type localLocker struct {
+	mu    sync.Mutex
+	locks map[string]*sync.RWMutex
+}
+
+// NewLocal creates a [Locker] whose locks are only held within this process.
+func NewLocal() Locker {
+	return &localLocker{locks: map[string]*sync.RWMutex{}}
+}
+
+func (l *localLocker) TryLock(_ context.Context, name string) (func(), error) {
+	m := l.get(name)
+	if !m.TryLock() {
+		return nil, ErrLocked
+	}
+
+	return m.Unlock, nil
+}
+
+func (l *localLocker) Lock(ctx context.Context, name string) (func(), error) {
+	m := l.get(name)
+	return wait(ctx, m.Lock, m.Unlock)
+}
+
+func (l *localLocker) RLock(ctx context.Context, name string) (func(), error) {
+	m := l.get(name)
+	return wait(ctx, m.RLock, m.RUnlock)
+}
+
+// wait takes a lock unless the context is done first.
+func wait(ctx context.Context, lock func(), unlock func()) (func(), error) {
+	locked := make(chan struct{})
+	go func() {
+		lock()
+		close(locked)
+	}()
+
+	select {
+	case <-locked:
+		return unlock, nil
+	case <-ctx.Done():
+		// The lock is released as soon as it was taken.
+		go func() {
+			<-locked
+			unlock()
+		}()
+
+		return nil, ctx.Err()
+	}
+}
+
+func (l *localLocker) get(name string) *sync.RWMutex {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	m, ok := l.locks[name]
+	if !ok {
+		m = &sync.RWMutex{}
+		l.locks[name] = m
+	}
+
+	return m
+}
@@ -0,0 +1,104 @@
+package lock
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestLocalTryLockIsExclusive(t *testing.T) {
+	ctx := context.Background()
+	l := NewLocal()
+
+	unlock, err := l.TryLock(ctx, "backup:user")
+	if err != nil {
+		t.Fatalf("TryLock() = %v", err)
+	}
+
+	if _, err := l.TryLock(ctx, "backup:user"); !errors.Is(err, ErrLocked) {
+		t.Errorf("second TryLock() = %v, want ErrLocked", err)
+	}
+
+	if _, err := l.RLock(ctx, "backup:other"); err != nil {
+		t.Errorf("RLock() of another lock = %v", err)
+	}
+
+	unlock()
+
+	if _, err := l.TryLock(ctx, "backup:user"); err != nil {
+		t.Errorf("TryLock() after unlocking = %v", err)
+	}
+}
+
+func TestLocalRLockIsShared(t *testing.T) {
+	ctx := context.Background()
+	l := NewLocal()
+
+	runlock, err := l.RLock(ctx, "catalog")
+	if err != nil {
+		t.Fatalf("RLock() = %v", err)
+	}
+
+	runlock2, err := l.RLock(ctx, "catalog")
+	if err != nil {
+		t.Fatalf("second RLock() = %v", err)
+	}
+
+	if _, err := l.TryLock(ctx, "catalog"); !errors.Is(err, ErrLocked) {
+		t.Errorf("TryLock() while shared = %v, want ErrLocked", err)
+	}
+
+	runlock()
+	runlock2()
+
+	unlock, err := l.TryLock(ctx, "catalog")
+	if err != nil {
+		t.Fatalf("TryLock() after all shared holders released = %v", err)
+	}
+
+	timeout, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := l.RLock(timeout, "catalog"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("RLock() while held exclusively = %v, want context.DeadlineExceeded", err)
+	}
+
+	unlock()
+
+	if _, err := l.RLock(ctx, "catalog"); err != nil {
+		t.Errorf("RLock() after unlocking = %v", err)
+	}
+}
+
+func TestLocalLockWaits(t *testing.T) {
+	ctx := context.Background()
+	l := NewLocal()
+
+	runlock, err := l.RLock(ctx, "catalog")
+	if err != nil {
+		t.Fatalf("RLock() = %v", err)
+	}
+
+	timeout, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := l.Lock(timeout, "catalog"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Lock() while shared = %v, want context.DeadlineExceeded", err)
+	}
+
+	locked := make(chan error)
+	go func() {
+		unlock, err := l.Lock(ctx, "catalog")
+		if err == nil {
+			unlock()
+		}
+		locked <- err
+	}()
+
+	runlock()
+
+	if err := <-locked; err != nil {
+		t.Errorf("Lock() after releasing = %v", err)
+	}
+}
@@ -0,0 +1,40 @@
+// Package lock provides named locks that are held across all server
+// instances, so work like backing up a user never runs twice at the same
+// time, no matter which instance started it.
+package lock
+
+import (
+	"context"
+	"errors"
+)
+
+// Catalog is the lock that backups hold shared while they run. Pruning holds
+// it exclusively, so it never deletes catalog entries or playlist snapshots
+// that a running backup is about to refer to.
+const Catalog = "catalog"
+
+// SpotifyToken is the lock that is held while the Spotify access token is
+// refreshed, so only one instance refreshes it at a time.
+const SpotifyToken = "spotify-token"
+
+// Backup returns the name of the lock that is held while a user is backed up.
+func Backup(userID string) string {
+	return "backup:" + userID
+}
+
+// ErrLocked is returned when a lock is held by someone else.
+var ErrLocked = errors.New("the lock is held by someone else")
+
+// A Locker takes named locks. A lock can be held exclusively by a single
+// holder or shared by any number of holders.
+type Locker interface {
+	// TryLock takes the lock exclusively. It returns [ErrLocked] right away
+	// if the lock is held by anyone else. The returned function releases it.
+	TryLock(ctx context.Context, name string) (func(), error)
+	// Lock takes the lock exclusively, waiting while it is held by anyone
+	// else. The returned function releases it.
+	Lock(ctx context.Context, name string) (func(), error)
+	// RLock takes the lock shared, waiting while it is held exclusively.
+	// The returned function releases it.
+	RLock(ctx context.Context, name string) (func(), error)
+}
@@ -0,0 +1,62 @@
+package lock
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+)
+
+// A postgresLocker takes session level advisory locks. Every lock uses a
+// connection of its own, so it is released when the session ends, even if
+// the instance holding it crashes.
+type postgresLocker struct {
+	db *sql.DB
+}
+
+// NewPostgres creates a [Locker] that uses Postgres advisory locks.
+func NewPostgres(db *sql.DB) Locker {
+	return &postgresLocker{db: db}
+}
+
+func (l *postgresLocker) TryLock(ctx context.Context, name string) (func(), error) {
+	return l.lock(ctx, "SELECT pg_try_advisory_lock(hashtext($1))", "SELECT pg_advisory_unlock(hashtext($1))", name)
+}
+
+func (l *postgresLocker) Lock(ctx context.Context, name string) (func(), error) {
+	return l.lock(ctx, "SELECT true FROM pg_advisory_lock(hashtext($1))", "SELECT pg_advisory_unlock(hashtext($1))", name)
+}
+
+func (l *postgresLocker) RLock(ctx context.Context, name string) (func(), error) {
+	return l.lock(ctx, "SELECT true FROM pg_advisory_lock_shared(hashtext($1))",
+		"SELECT pg_advisory_unlock_shared(hashtext($1))", name)
+}
+
+func (l *postgresLocker) lock(ctx context.Context, lockQuery string, unlockQuery string, name string) (func(), error) {
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var locked bool
+	if err := conn.QueryRowContext(ctx, lockQuery, name).Scan(&locked); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	if !locked {
+		conn.Close()
+		return nil, ErrLocked
+	}
+
+	return func() {
+		var unlocked bool
+		err := conn.QueryRowContext(context.WithoutCancel(ctx), unlockQuery, name).Scan(&unlocked)
+		if err != nil || !unlocked {
+			// Closing returns the connection to the pool with the session and
+			// its lock still alive, so it is discarded to end the session.
+			_ = conn.Raw(func(any) error { return driver.ErrBadConn })
+		}
+
+		conn.Close()
+	}, nil
+}
@@ -0,0 +1,387 @@
+package migration
+
+import (
+	"beyerleinf/spotify-backup/ent"
+	"beyerleinf/spotify-backup/ent/album"
+	"beyerleinf/spotify-backup/ent/artist"
+	"beyerleinf/spotify-backup/ent/episode"
+	"beyerleinf/spotify-backup/ent/show"
+	"beyerleinf/spotify-backup/ent/track"
+	"cmp"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+const batchSize = 100
+
+// legacyColumns are the columns that stored the metadata of tracks, episodes,
+// albums, shows and artists next to every row referencing them, before the
+// metadata was moved into the catalog. They are dropped once copied.
+var legacyColumns = map[string][]string{
+	"followed_artists": {"spotify_id", "uri", "name", "genres"},
+	"saved_albums":     {"spotify_id", "uri", "name", "artists", "album_type", "release_date", "total_tracks"},
+	"saved_shows":      {"spotify_id", "uri", "name", "publisher"},
+	"saved_episodes":   {"spotify_id", "uri", "name", "show", "duration_ms", "release_date"},
+	"saved_tracks":     {"spotify_id", "uri", "name", "artists", "album", "duration_ms", "explicit"},
+	"snapshot_items":   {"spotify_id", "name", "artists", "album", "duration_ms", "explicit"},
+	// Playlist snapshots belonged to a single run before they were shared by runs.
+	"playlist_snapshots": {"backup_run_playlist_snapshots"},
+}
+
+// A reference is a set of rows that stored a catalog object by its Spotify ID.
+type reference struct {
+	table string
+	// column is the foreign key column that references the catalog object now.
+	column  string
+	catalog string
+	// where selects the rows that reference the catalog, if not all of them do.
+	where string
+}
+
+var references = []reference{
+	{table: "followed_artists", column: "followed_artist_artist", catalog: "artists"},
+	{table: "saved_albums", column: "saved_album_album", catalog: "albums"},
+	{table: "saved_shows", column: "saved_show_show", catalog: "shows"},
+	{table: "saved_episodes", column: "saved_episode_episode", catalog: "episodes"},
+	{table: "saved_tracks", column: "saved_track_track", catalog: "tracks"},
+	// Local files have no Spotify ID and aren't stored in the catalog.
+	{table: "snapshot_items", column: "snapshot_item_track", catalog: "tracks", where: "type = 'track' AND spotify_id <> ''"},
+	{table: "snapshot_items", column: "snapshot_item_episode", catalog: "episodes", where: "type = 'episode' AND spotify_id <> ''"},
+}
+
+// migrateCatalog moves the metadata that was stored next to every saved item,
+// followed artist and snapshot item into the catalog and links the rows to it.
+//
+// The legacy rows only stored the names of a track's artists and album and of
+// an episode's show, without their Spotify IDs. Such tracks and episodes are
+// stored without them and completed when they are backed up again.
+func migrateCatalog(ctx context.Context, tx *ent.Tx) error {
+	legacy := map[string][]string{}
+	for table, names := range legacyColumns {
+		existing, err := columns(ctx, tx, table)
+		if err != nil {
+			return err
+		}
+
+		for _, name := range names {
+			if slices.Contains(existing, name) {
+				legacy[table] = append(legacy[table], name)
+			}
+		}
+	}
+
+	// Only the references whose Spotify IDs were not dropped yet can be migrated.
+	var refs []reference
+	for _, ref := range references {
+		if slices.Contains(legacy[ref.table], "spotify_id") {
+			refs = append(refs, ref)
+		}
+	}
+
+	copies := []func(ctx context.Context, tx *ent.Tx, refs []reference) error{
+		copyArtists, copyAlbums, copyShows, copyTracks, copyEpisodes,
+	}
+	for _, fn := range copies {
+		if err := fn(ctx, tx, refs); err != nil {
+			return err
+		}
+	}
+
+	for _, ref := range refs {
+		query := fmt.Sprintf("UPDATE %[1]s SET %[2]s = (SELECT %[3]s.id FROM %[3]s WHERE %[3]s.spotify_id = %[1]s.spotify_id)",
+			ref.table, ref.column, ref.catalog)
+		if ref.where != "" {
+			query += " WHERE " + ref.where
+		}
+
+		if _, err := tx.ExecContext(ctx, query); err != nil {
+			return fmt.Errorf("linking %s to %s: %w", ref.table, ref.catalog, err)
+		}
+	}
+
+	if slices.Contains(legacy["playlist_snapshots"], "backup_run_playlist_snapshots") {
+		_, err := tx.ExecContext(ctx, `INSERT INTO backup_run_playlist_snapshots (backup_run_id, playlist_snapshot_id)
+			SELECT s.backup_run_playlist_snapshots, s.id FROM playlist_snapshots s
+			WHERE s.backup_run_playlist_snapshots IS NOT NULL AND NOT EXISTS (
+				SELECT 1 FROM backup_run_playlist_snapshots r
+				WHERE r.backup_run_id = s.backup_run_playlist_snapshots AND r.playlist_snapshot_id = s.id
+			)`)
+		if err != nil {
+			return fmt.Errorf("linking playlist snapshots to runs: %w", err)
+		}
+	}
+
+	for _, table := range slices.Sorted(maps.Keys(legacy)) {
+		for _, name := range legacy[table] {
+			if _, err := tx.ExecContext(ctx, fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s", table, name)); err != nil {
+				return fmt.Errorf("dropping %s.%s: %w", table, name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func copyArtists(ctx context.Context, tx *ent.Tx, refs []reference) error {
+	artists := map[string]ent.Artist{}
+	err := scan(ctx, tx, refs, "artists", "spotify_id, uri, name, genres", func(rows *sql.Rows) error {
+		var (
+			a      ent.Artist
+			genres []byte
+		)
+		if err := rows.Scan(&a.SpotifyID, &a.URI, &a.Name, &genres); err != nil {
+			return err
+		}
+
+		if len(genres) > 0 {
+			if err := json.Unmarshal(genres, &a.Genres); err != nil {
+				return err
+			}
+		}
+
+		if _, ok := artists[a.SpotifyID]; !ok {
+			artists[a.SpotifyID] = a
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return store(ctx, artists, func(ids []string) ([]string, error) {
+		return tx.Artist.Query().Where(artist.SpotifyIDIn(ids...)).Select(artist.FieldSpotifyID).Strings(ctx)
+	}, func(batch []ent.Artist) error {
+		return tx.Artist.MapCreateBulk(batch, func(create *ent.ArtistCreate, i int) {
+			a := batch[i]
+			create.SetSpotifyID(a.SpotifyID).SetURI(a.URI).SetName(a.Name).SetGenres(a.Genres)
+		}).Exec(ctx)
+	})
+}
+
+func copyAlbums(ctx context.Context, tx *ent.Tx, refs []reference) error {
+	albums := map[string]ent.Album{}
+	err := scan(ctx, tx, refs, "albums", "spotify_id, uri, name, album_type, release_date, total_tracks", func(rows *sql.Rows) error {
+		var a ent.Album
+		if err := rows.Scan(&a.SpotifyID, &a.URI, &a.Name, &a.AlbumType, &a.ReleaseDate, &a.TotalTracks); err != nil {
+			return err
+		}
+
+		if _, ok := albums[a.SpotifyID]; !ok {
+			albums[a.SpotifyID] = a
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return store(ctx, albums, func(ids []string) ([]string, error) {
+		return tx.Album.Query().Where(album.SpotifyIDIn(ids...)).Select(album.FieldSpotifyID).Strings(ctx)
+	}, func(batch []ent.Album) error {
+		return tx.Album.MapCreateBulk(batch, func(create *ent.AlbumCreate, i int) {
+			a := batch[i]
+			create.SetSpotifyID(a.SpotifyID).SetURI(a.URI).SetName(a.Name).
+				SetAlbumType(a.AlbumType).SetReleaseDate(a.ReleaseDate).SetTotalTracks(a.TotalTracks)
+		}).Exec(ctx)
+	})
+}
+
+func copyShows(ctx context.Context, tx *ent.Tx, refs []reference) error {
+	shows := map[string]ent.Show{}
+	err := scan(ctx, tx, refs, "shows", "spotify_id, uri, name, publisher", func(rows *sql.Rows) error {
+		var s ent.Show
+		if err := rows.Scan(&s.SpotifyID, &s.URI, &s.Name, &s.Publisher); err != nil {
+			return err
+		}
+
+		if _, ok := shows[s.SpotifyID]; !ok {
+			shows[s.SpotifyID] = s
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return store(ctx, shows, func(ids []string) ([]string, error) {
+		return tx.Show.Query().Where(show.SpotifyIDIn(ids...)).Select(show.FieldSpotifyID).Strings(ctx)
+	}, func(batch []ent.Show) error {
+		return tx.Show.MapCreateBulk(batch, func(create *ent.ShowCreate, i int) {
+			s := batch[i]
+			create.SetSpotifyID(s.SpotifyID).SetURI(s.URI).SetName(s.Name).SetPublisher(s.Publisher)
+		}).Exec(ctx)
+	})
+}
+
+func copyTracks(ctx context.Context, tx *ent.Tx, refs []reference) error {
+	tracks := map[string]ent.Track{}
+	err := scan(ctx, tx, refs, "tracks", "spotify_id, uri, name, duration_ms, explicit", func(rows *sql.Rows) error {
+		var t ent.Track
+		if err := rows.Scan(&t.SpotifyID, &t.URI, &t.Name, &t.DurationMs, &t.Explicit); err != nil {
+			return err
+		}
+
+		if _, ok := tracks[t.SpotifyID]; !ok {
+			tracks[t.SpotifyID] = t
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return store(ctx, tracks, func(ids []string) ([]string, error) {
+		return tx.Track.Query().Where(track.SpotifyIDIn(ids...)).Select(track.FieldSpotifyID).Strings(ctx)
+	}, func(batch []ent.Track) error {
+		return tx.Track.MapCreateBulk(batch, func(create *ent.TrackCreate, i int) {
+			t := batch[i]
+			create.SetSpotifyID(t.SpotifyID).SetURI(t.URI).SetName(t.Name).
+				SetDurationMs(t.DurationMs).SetExplicit(t.Explicit)
+		}).Exec(ctx)
+	})
+}
+
+func copyEpisodes(ctx context.Context, tx *ent.Tx, refs []reference) error {
+	episodes := map[string]ent.Episode{}
+	add := func(rows *sql.Rows) error {
+		var e ent.Episode
+		if err := rows.Scan(&e.SpotifyID, &e.URI, &e.Name, &e.DurationMs, &e.Explicit, &e.ReleaseDate); err != nil {
+			return err
+		}
+
+		// Only snapshot items stored whether an episode is explicit
+		// and only saved episodes stored when it was released.
+		if existing, ok := episodes[e.SpotifyID]; ok {
+			e.Explicit = e.Explicit || existing.Explicit
+			e.ReleaseDate = cmp.Or(existing.ReleaseDate, e.ReleaseDate)
+		}
+
+		episodes[e.SpotifyID] = e
+
+		return nil
+	}
+
+	saved, items := splitReferences(refs, "saved_episodes")
+	if err := scan(ctx, tx, saved, "episodes", "spotify_id, uri, name, duration_ms, false, release_date", add); err != nil {
+		return err
+	}
+
+	if err := scan(ctx, tx, items, "episodes", "spotify_id, uri, name, duration_ms, explicit, ''", add); err != nil {
+		return err
+	}
+
+	return store(ctx, episodes, func(ids []string) ([]string, error) {
+		return tx.Episode.Query().Where(episode.SpotifyIDIn(ids...)).Select(episode.FieldSpotifyID).Strings(ctx)
+	}, func(batch []ent.Episode) error {
+		return tx.Episode.MapCreateBulk(batch, func(create *ent.EpisodeCreate, i int) {
+			e := batch[i]
+			create.SetSpotifyID(e.SpotifyID).SetURI(e.URI).SetName(e.Name).
+				SetDurationMs(e.DurationMs).SetExplicit(e.Explicit).SetReleaseDate(e.ReleaseDate)
+		}).Exec(ctx)
+	})
+}
+
+// scan selects the given columns of all rows that reference the catalog table.
+func scan(ctx context.Context, tx *ent.Tx, refs []reference, catalog string, columns string, fn func(rows *sql.Rows) error) error {
+	for _, ref := range refs {
+		if ref.catalog != catalog {
+			continue
+		}
+
+		query := fmt.Sprintf("SELECT %s FROM %s", columns, ref.table)
+		if ref.where != "" {
+			query += " WHERE " + ref.where
+		}
+
+		rows, err := tx.QueryContext(ctx, query)
+		if err != nil {
+			return fmt.Errorf("reading %s of %s: %w", catalog, ref.table, err)
+		}
+
+		for rows.Next() {
+			if err := fn(rows); err != nil {
+				rows.Close()
+				return fmt.Errorf("reading %s of %s: %w", catalog, ref.table, err)
+			}
+		}
+
+		if err := rows.Close(); err != nil {
+			return err
+		}
+
+		if err := rows.Err(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// store creates the objects that are not in the catalog yet in batches.
+// stored returns which of the given Spotify IDs are in the catalog already.
+func store[T any](
+	ctx context.Context, objects map[string]T,
+	stored func(ids []string) ([]string, error), create func(batch []T) error,
+) error {
+	for batch := range slices.Chunk(slices.Sorted(maps.Keys(objects)), batchSize) {
+		existing, err := stored(batch)
+		if err != nil {
+			return err
+		}
+
+		var missing []T
+		for _, id := range batch {
+			if !slices.Contains(existing, id) {
+				missing = append(missing, objects[id])
+			}
+		}
+
+		if len(missing) == 0 {
+			continue
+		}
+
+		if err := create(missing); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// columns returns the names of the columns of a table.
+func columns(ctx context.Context, tx *ent.Tx, table string) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf("SELECT * FROM %s WHERE 1 = 0", table))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	names, err := rows.Columns()
+	for i, name := range names {
+		names[i] = strings.ToLower(name)
+	}
+
+	return names, err
+}
+
+// splitReferences splits refs into those of the given table and all others.
+func splitReferences(refs []reference, table string) (matching, others []reference) {
+	for _, ref := range refs {
+		if ref.table == table {
+			matching = append(matching, ref)
+		} else {
+			others = append(others, ref)
+		}
+	}
+
+	return matching, others
+}
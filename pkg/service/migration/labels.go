@@ -0,0 +1,23 @@
+package migration
+
+import (
+	"beyerleinf/spotify-backup/ent"
+	"context"
+)
+
+// migrateLabels gives backup runs and playlist snapshots made before they
+// could be labeled an empty list of labels.
+func migrateLabels(ctx context.Context, tx *ent.Tx) error {
+	queries := []string{
+		`UPDATE backup_runs SET labels = '[]' WHERE labels IS NULL`,
+		`UPDATE playlist_snapshots SET labels = '[]' WHERE labels IS NULL`,
+	}
+
+	for _, query := range queries {
+		if _, err := tx.ExecContext(ctx, query); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
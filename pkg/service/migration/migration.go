@@ -0,0 +1,130 @@
+// Package migration keeps the database schema up to date. Missing tables and
+// columns are created from the ent schema, while data that has to move when
+// the schema changes is copied by versioned migrations. Every migration runs
+// once in a transaction and is recorded as an [ent.SchemaMigration].
+//
+// Columns are never dropped automatically. A migration drops the columns it
+// copied the data of, so upgrading never loses backed up data.
+package migration
+
+import (
+	"beyerleinf/spotify-backup/ent"
+	"beyerleinf/spotify-backup/internal/server/config"
+	"beyerleinf/spotify-backup/pkg/logger"
+	"beyerleinf/spotify-backup/pkg/util"
+	"context"
+	"fmt"
+	"slices"
+
+	atlas "ariga.io/atlas/sql/schema"
+	"entgo.io/ent/dialect/sql/schema"
+)
+
+// A migration moves data that a schema change would otherwise lose.
+type migration struct {
+	version int
+	name    string
+	up      func(ctx context.Context, tx *ent.Tx) error
+}
+
+// migrations are applied in order. New migrations are appended with the next version.
+var migrations = []migration{
+	{version: 1, name: "catalog", up: migrateCatalog},
+	{version: 2, name: "runs", up: migrateRuns},
+	{version: 3, name: "labels", up: migrateLabels},
+}
+
+// A Migrator updates the database schema.
+type Migrator struct {
+	slogger *logger.Logger
+	db      *ent.Client
+}
+
+// New creates a [Migrator] instance.
+func New(config *config.Config, db *ent.Client) *Migrator {
+	return &Migrator{
+		slogger: logger.New("migration", config.Server.LogLevel),
+		db:      db,
+	}
+}
+
+// Run updates the schema and applies all migrations that were not applied yet.
+//
+// Columns added to existing tables are created as nullable first, so the
+// migrations can fill them in for rows that already exist. Only once all
+// migrations were applied, the columns are made required.
+func (m *Migrator) Run(ctx context.Context) error {
+	if err := m.db.Schema.Create(ctx, schema.WithDiffHook(nullableColumns)); err != nil {
+		return fmt.Errorf("creating schema: %w", err)
+	}
+
+	applied, err := m.db.SchemaMigration.Query().IDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, mig := range migrations {
+		if slices.Contains(applied, mig.version) {
+			continue
+		}
+
+		m.slogger.Info("Applying migration", "version", mig.version, "name", mig.name)
+
+		if err := m.apply(ctx, mig); err != nil {
+			return fmt.Errorf("applying migration %d (%s): %w", mig.version, mig.name, err)
+		}
+	}
+
+	if err := m.db.Schema.Create(ctx); err != nil {
+		return fmt.Errorf("completing schema: %w", err)
+	}
+
+	return nil
+}
+
+// apply runs a migration and records it in the same transaction.
+func (m *Migrator) apply(ctx context.Context, mig migration) error {
+	return util.WithTx(ctx, m.db, func(tx *ent.Tx) error {
+		if err := mig.up(ctx, tx); err != nil {
+			return err
+		}
+
+		return tx.SchemaMigration.Create().SetID(mig.version).SetName(mig.name).Exec(ctx)
+	})
+}
+
+// nullableColumns keeps columns nullable that are added to existing tables
+// or would be made required, since existing rows have no value for them yet.
+func nullableColumns(next schema.Differ) schema.Differ {
+	return schema.DiffFunc(func(current, desired *atlas.Schema) ([]atlas.Change, error) {
+		changes, err := next.Diff(current, desired)
+		if err != nil {
+			return nil, err
+		}
+
+		return slices.DeleteFunc(changes, func(change atlas.Change) bool {
+			modify, ok := change.(*atlas.ModifyTable)
+			if !ok {
+				return false
+			}
+
+			modify.Changes = slices.DeleteFunc(modify.Changes, func(change atlas.Change) bool {
+				switch c := change.(type) {
+				case *atlas.AddColumn:
+					c.C.Type.Null = true
+				case *atlas.ModifyColumn:
+					if c.Change.Is(atlas.ChangeNull) && !c.To.Type.Null {
+						c.To.Type.Null = true
+						c.Change &^= atlas.ChangeNull
+					}
+
+					return c.Change == atlas.NoChange
+				}
+
+				return false
+			})
+
+			return len(modify.Changes) == 0
+		}), nil
+	})
+}
@@ -0,0 +1,290 @@
+package migration
+
+import (
+	"beyerleinf/spotify-backup/ent"
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/episode"
+	"beyerleinf/spotify-backup/ent/savedtrack"
+	"beyerleinf/spotify-backup/ent/snapshotitem"
+	"beyerleinf/spotify-backup/internal/server/config"
+	"context"
+	"database/sql"
+	"fmt"
+	"maps"
+	"slices"
+	"testing"
+
+	atlas "ariga.io/atlas/sql/schema"
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
+	_ "modernc.org/sqlite"
+)
+
+// legacyColumnTypes are the types of the legacy columns in SQLite.
+var legacyColumnTypes = map[string]string{
+	"spotify_id": "text NOT NULL DEFAULT ''", "uri": "text", "name": "text", "genres": "json",
+	"artists": "json", "album_type": "text", "release_date": "text", "total_tracks": "integer",
+	"publisher": "text", "show": "text", "duration_ms": "integer", "album": "text", "explicit": "bool",
+	"backup_run_playlist_snapshots": "text",
+}
+
+var legacyRows = []string{
+	`INSERT INTO backup_runs (id, started_at, finished_at) VALUES ('run1', '2024-01-01 00:00:00', '2024-01-01 00:01:00')`,
+	`INSERT INTO backup_runs (id, started_at) VALUES ('run2', '2024-01-02 00:00:00')`,
+	`INSERT INTO playlists (id, spotify_id, created_at) VALUES ('pl1', 'playlist1', '2024-01-01 00:00:00')`,
+	`INSERT INTO playlist_snapshots (id, name, owner_id, snapshot_id, total, created_at, playlist_snapshots,
+		backup_run_playlist_snapshots) VALUES ('snap1', 'Road Trip', 'owner', 'abc', 3, '2024-01-01 00:00:00', 'pl1', 'run1')`,
+	`INSERT INTO snapshot_items (id, position, is_local, type, uri, playlist_snapshot_items, spotify_id, name, artists,
+		album, duration_ms, explicit) VALUES ('item1', 0, false, 'track', 'spotify:track:tr1', 'snap1', 'tr1', 'Song',
+		'["Artist"]', 'Album', 1000, true)`,
+	`INSERT INTO snapshot_items (id, position, is_local, type, uri, playlist_snapshot_items, spotify_id, name,
+		duration_ms, explicit) VALUES ('item2', 1, true, 'track', 'spotify:local:a:b:Local:1', 'snap1', '', 'Local', 1, false)`,
+	`INSERT INTO snapshot_items (id, position, is_local, type, uri, playlist_snapshot_items, spotify_id, name,
+		duration_ms, explicit) VALUES ('item3', 2, false, 'episode', 'spotify:episode:ep1', 'snap1', 'ep1', 'Episode', 2000, true)`,
+	`INSERT INTO saved_tracks (id, added_at, created_at, backup_run_saved_tracks, spotify_id, uri, name, artists, album,
+		duration_ms, explicit) VALUES ('st1', '2024-01-01 00:00:00', '2024-01-01 00:00:00', 'run1', 'tr1',
+		'spotify:track:tr1', 'Song', '["Artist"]', 'Album', 1000, true)`,
+	`INSERT INTO saved_tracks (id, added_at, created_at, backup_run_saved_tracks, spotify_id, uri, name, duration_ms,
+		explicit) VALUES ('st2', '2024-01-01 00:00:00', '2024-01-01 00:00:00', 'run1', 'tr2', 'spotify:track:tr2',
+		'Other Song', 3000, false)`,
+	`INSERT INTO saved_albums (id, added_at, created_at, backup_run_saved_albums, spotify_id, uri, name, album_type,
+		release_date, total_tracks) VALUES ('sa1', '2024-01-01 00:00:00', '2024-01-01 00:00:00', 'run1', 'al1',
+		'spotify:album:al1', 'Album', 'album', '2020', 12)`,
+	`INSERT INTO saved_shows (id, added_at, total_episodes, created_at, backup_run_saved_shows, spotify_id, uri, name,
+		publisher) VALUES ('ss1', '2024-01-01 00:00:00', 5, '2024-01-01 00:00:00', 'run1', 'sh1', 'spotify:show:sh1',
+		'Show', 'Publisher')`,
+	`INSERT INTO saved_episodes (id, added_at, created_at, backup_run_saved_episodes, spotify_id, uri, name, show,
+		duration_ms, release_date) VALUES ('se1', '2024-01-01 00:00:00', '2024-01-01 00:00:00', 'run1', 'ep1',
+		'spotify:episode:ep1', 'Episode', 'Show', 2000, '2023-05-01')`,
+	`INSERT INTO followed_artists (id, created_at, backup_run_followed_artists, spotify_id, uri, name, genres)
+		VALUES ('fa1', '2024-01-01 00:00:00', 'run1', 'ar1', 'spotify:artist:ar1', 'Artist', '["rock"]')`,
+}
+
+func open(t *testing.T) *ent.Client {
+	t.Helper()
+
+	db, err := sql.Open("sqlite", fmt.Sprintf("file:%s?mode=memory&cache=shared&_pragma=foreign_keys(1)", t.Name()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.SQLite, db)))
+	t.Cleanup(func() { client.Close() })
+
+	return client
+}
+
+// prepareLegacy creates the schema the way the first pass of [Migrator.Run]
+// leaves a database on Postgres that was created before the catalog existed:
+// the current columns exist but are nullable, and the legacy columns still
+// hold the data. SQLite rebuilds tables to add foreign keys, which drops the
+// legacy columns, so the legacy schema can't be upgraded directly.
+func prepareLegacy(ctx context.Context, t *testing.T, client *ent.Client) {
+	t.Helper()
+
+	err := client.Schema.Create(ctx, schema.WithDiffHook(func(next schema.Differ) schema.Differ {
+		return schema.DiffFunc(func(current, desired *atlas.Schema) ([]atlas.Change, error) {
+			changes, err := next.Diff(current, desired)
+			for _, change := range changes {
+				if add, ok := change.(*atlas.AddTable); ok {
+					for _, c := range add.T.Columns {
+						c.Type.Null = true
+					}
+				}
+			}
+
+			return changes, err
+		})
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, table := range slices.Sorted(maps.Keys(legacyColumns)) {
+		for _, name := range legacyColumns[table] {
+			query := fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table, name, legacyColumnTypes[name])
+			if _, err := client.ExecContext(ctx, query); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	for _, query := range legacyRows {
+		if _, err := client.ExecContext(ctx, query); err != nil {
+			t.Fatalf("inserting legacy rows: %v", err)
+		}
+	}
+}
+
+func newMigrator(client *ent.Client) *Migrator {
+	return New(&config.Config{}, client)
+}
+
+func TestRunMigratesLegacyCatalog(t *testing.T) {
+	ctx := context.Background()
+	client := open(t)
+	prepareLegacy(ctx, t, client)
+
+	if err := newMigrator(client).Run(ctx); err != nil {
+		t.Fatalf("Run() = %v", err)
+	}
+
+	saved, err := client.SavedTrack.Query().WithTrack().Order(ent.Asc(savedtrack.FieldID)).All(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(saved) != 2 || saved[0].Edges.Track.Name != "Song" || saved[0].Edges.Track.DurationMs != 1000 ||
+		!saved[0].Edges.Track.Explicit || saved[1].Edges.Track.Name != "Other Song" {
+		t.Errorf("saved tracks weren't linked to the catalog: %+v", saved)
+	}
+
+	items, err := client.SnapshotItem.Query().WithTrack().WithEpisode().Order(ent.Asc(snapshotitem.FieldPosition)).All(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 3 {
+		t.Fatalf("got %d snapshot items, want 3", len(items))
+	}
+	if items[0].Edges.Track == nil || items[0].Edges.Track.ID != saved[0].Edges.Track.ID {
+		t.Errorf("snapshot item doesn't share the track of the saved track: %+v", items[0].Edges.Track)
+	}
+	if items[1].Edges.Track != nil || items[1].URI != "spotify:local:a:b:Local:1" {
+		t.Errorf("local file was linked to the catalog: %+v", items[1])
+	}
+	if items[2].Edges.Episode == nil || items[2].Edges.Episode.Name != "Episode" {
+		t.Errorf("snapshot item wasn't linked to its episode: %+v", items[2].Edges.Episode)
+	}
+
+	e, err := client.Episode.Query().Where(episode.SpotifyID("ep1")).Only(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.ReleaseDate != "2023-05-01" || !e.Explicit {
+		t.Errorf("episode wasn't merged from saved episode and snapshot item: %+v", e)
+	}
+
+	a, err := client.FollowedArtist.Query().QueryArtist().Only(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.SpotifyID != "ar1" || !slices.Equal(a.Genres, []string{"rock"}) {
+		t.Errorf("followed artist wasn't linked to the catalog: %+v", a)
+	}
+
+	al, err := client.SavedAlbum.Query().QueryAlbum().Only(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if al.Name != "Album" || al.TotalTracks != 12 || al.ReleaseDate != "2020" {
+		t.Errorf("saved album wasn't linked to the catalog: %+v", al)
+	}
+
+	sh, err := client.SavedShow.Query().QueryShow().Only(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sh.Publisher != "Publisher" {
+		t.Errorf("saved show wasn't linked to the catalog: %+v", sh)
+	}
+
+	runs, err := client.PlaylistSnapshot.Query().QueryRuns().IDs(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(runs, []string{"run1"}) {
+		t.Errorf("playlist snapshot runs = %v, want [run1]", runs)
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tx.Rollback()
+
+	for table, names := range legacyColumns {
+		existing, err := columns(ctx, tx, table)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, name := range names {
+			if slices.Contains(existing, name) {
+				t.Errorf("legacy column %s.%s wasn't dropped", table, name)
+			}
+		}
+	}
+}
+
+func TestRunFillsInLegacyRuns(t *testing.T) {
+	ctx := context.Background()
+	client := open(t)
+	prepareLegacy(ctx, t, client)
+
+	if err := newMigrator(client).Run(ctx); err != nil {
+		t.Fatalf("Run() = %v", err)
+	}
+
+	tests := []struct {
+		id   string
+		want backuprun.Status
+	}{
+		{id: "run1", want: backuprun.StatusSucceeded},
+		{id: "run2", want: backuprun.StatusFailed},
+	}
+
+	for _, tt := range tests {
+		run, err := client.BackupRun.Get(ctx, tt.id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if run.Status != tt.want || run.Collections == nil {
+			t.Errorf("run %s = %+v, want status %s and collections", tt.id, run, tt.want)
+		}
+	}
+}
+
+func TestRunFillsInLegacyLabels(t *testing.T) {
+	ctx := context.Background()
+	client := open(t)
+	prepareLegacy(ctx, t, client)
+
+	if err := newMigrator(client).Run(ctx); err != nil {
+		t.Fatalf("Run() = %v", err)
+	}
+
+	run, err := client.BackupRun.Get(ctx, "run1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if run.Labels == nil {
+		t.Errorf("run labels weren't filled in: %+v", run)
+	}
+
+	snapshot, err := client.PlaylistSnapshot.Get(ctx, "snap1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if snapshot.Labels == nil {
+		t.Errorf("playlist snapshot labels weren't filled in: %+v", snapshot)
+	}
+}
+
+func TestRunIsIdempotent(t *testing.T) {
+	ctx := context.Background()
+	client := open(t)
+
+	for range 2 {
+		if err := newMigrator(client).Run(ctx); err != nil {
+			t.Fatalf("Run() = %v", err)
+		}
+	}
+
+	versions, err := client.SchemaMigration.Query().IDs(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(versions) != len(migrations) {
+		t.Errorf("applied migrations = %v, want %d", versions, len(migrations))
+	}
+}
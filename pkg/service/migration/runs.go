@@ -0,0 +1,25 @@
+package migration
+
+import (
+	"beyerleinf/spotify-backup/ent"
+	"context"
+)
+
+// migrateRuns fills in the status and collections of backup runs made before
+// they were recorded in detail. Their collections weren't recorded, so a run
+// counts as succeeded if it finished.
+func migrateRuns(ctx context.Context, tx *ent.Tx) error {
+	queries := []string{
+		`UPDATE backup_runs SET status = CASE WHEN finished_at IS NULL THEN 'failed' ELSE 'succeeded' END
+			WHERE collections IS NULL`,
+		`UPDATE backup_runs SET collections = '{}' WHERE collections IS NULL`,
+	}
+
+	for _, query := range queries {
+		if _, err := tx.ExecContext(ctx, query); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
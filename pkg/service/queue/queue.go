@@ -0,0 +1,178 @@
+// Package queue runs jobs like backups in the background. Jobs are stored
+// in the database, so they survive restarts and can be run by any server
+// instance. Workers claim jobs using FOR UPDATE SKIP LOCKED, so every job
+// is run by a single worker at a time.
+package queue
+
+import (
+	"beyerleinf/spotify-backup/ent"
+	"beyerleinf/spotify-backup/ent/job"
+	"beyerleinf/spotify-backup/internal/server/config"
+	"beyerleinf/spotify-backup/pkg/logger"
+	"beyerleinf/spotify-backup/pkg/util"
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrDuplicate is returned when a job is enqueued while another job
+// with the same key is pending or running.
+var ErrDuplicate = errors.New("a job with the same key is already queued")
+
+// ErrNotCancelable is returned when canceling a job that already finished.
+var ErrNotCancelable = errors.New("the job already finished")
+
+// A Handler runs a job of a certain type. The returned result is stored as
+// the job's result. Failed jobs are retried unless the error is [Permanent].
+type Handler func(ctx context.Context, job *ent.Job) (any, error)
+
+// A Queue stores jobs and runs them using a pool of workers.
+type Queue struct {
+	slogger  *logger.Logger
+	config   *config.Config
+	db       *ent.Client
+	workerID string
+
+	handlers map[string]Handler
+	wake     chan struct{}
+
+	mu      sync.Mutex
+	running map[string]context.CancelFunc
+}
+
+// New creates a [Queue] instance.
+func New(config *config.Config, db *ent.Client) *Queue {
+	return &Queue{
+		slogger:  logger.New("queue", config.Server.LogLevel),
+		config:   config,
+		db:       db,
+		workerID: util.InstanceID(),
+		handlers: map[string]Handler{},
+		wake:     make(chan struct{}, 1),
+		running:  map[string]context.CancelFunc{},
+	}
+}
+
+// Register sets the handler for jobs of the given type. It must be called before [Queue.Run].
+func (q *Queue) Register(jobType string, handler Handler) {
+	q.handlers[jobType] = handler
+}
+
+// Enqueue adds a job to the queue which runs as soon as a worker is available.
+// If key is not empty, [ErrDuplicate] is returned while another job with the
+// same key is pending or running.
+func (q *Queue) Enqueue(ctx context.Context, jobType string, key string, payload any) (*ent.Job, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	j, err := q.db.Job.Create().
+		SetType(jobType).
+		SetKey(key).
+		SetPayload(data).
+		SetMaxAttempts(q.config.Queue.MaxAttempts).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		return nil, ErrDuplicate
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	q.slogger.Verbose("Enqueued job", "job", j.ID, "type", jobType)
+
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+
+	return j, nil
+}
+
+// Cancel cancels a pending or running job. A running job is stopped by
+// canceling its context, which happens within one heartbeat if it runs
+// on another server instance.
+func (q *Queue) Cancel(ctx context.Context, id string) error {
+	n, err := q.db.Job.Update().
+		Where(job.ID(id), job.StatusIn(job.StatusPending, job.StatusRunning)).
+		SetStatus(job.StatusCanceled).
+		SetFinishedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		if _, err := q.db.Job.Get(ctx, id); err != nil {
+			return err
+		}
+
+		return ErrNotCancelable
+	}
+
+	q.mu.Lock()
+	if cancel, ok := q.running[id]; ok {
+		cancel()
+	}
+	q.mu.Unlock()
+
+	q.slogger.Info("Canceled job", "job", id)
+
+	return nil
+}
+
+// Jobs returns the most recent jobs, newest first.
+func (q *Queue) Jobs(ctx context.Context, limit int) ([]*ent.Job, error) {
+	return q.db.Job.Query().
+		Order(ent.Desc(job.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+}
+
+// GetJob returns a single job.
+func (q *Queue) GetJob(ctx context.Context, id string) (*ent.Job, error) {
+	return q.db.Job.Get(ctx, id)
+}
+
+// A permanentError is an error that retrying a job won't fix.
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *permanentError) Unwrap() error {
+	return e.err
+}
+
+// Permanent marks an error returned by a [Handler] as permanent,
+// so the job fails without being retried.
+func Permanent(err error) error {
+	return &permanentError{err: err}
+}
+
+// A postponedError is an error that keeps a job from running right now,
+// like a lock that is held by another job.
+type postponedError struct {
+	err   error
+	delay time.Duration
+}
+
+func (e *postponedError) Error() string {
+	return e.err.Error()
+}
+
+func (e *postponedError) Unwrap() error {
+	return e.err
+}
+
+// Postpone marks an error returned by a [Handler] as temporary, so the job
+// runs again after delay without using up one of its attempts.
+func Postpone(err error, delay time.Duration) error {
+	return &postponedError{err: err, delay: delay}
+}
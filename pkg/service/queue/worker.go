@@ -0,0 +1,325 @@
+package queue
+
+import (
+	"beyerleinf/spotify-backup/ent"
+	"beyerleinf/spotify-backup/ent/job"
+	"beyerleinf/spotify-backup/ent/predicate"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// Run starts the workers and blocks until ctx is canceled. Jobs that are
+// still running are then interrupted and put back into the queue, so they
+// are run again once a worker is available.
+func (q *Queue) Run(ctx context.Context) {
+	q.slogger.Info("Starting workers", "worker", q.workerID, "count", q.config.Queue.Workers)
+
+	var wg sync.WaitGroup
+	for range q.config.Queue.Workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			q.work(ctx)
+		}()
+	}
+
+	ticker := time.NewTicker(q.config.Queue.LeaseTimeout / 2)
+	defer ticker.Stop()
+
+	for {
+		q.reap(ctx)
+
+		select {
+		case <-ctx.Done():
+			wg.Wait()
+			q.slogger.Info("Stopped workers", "worker", q.workerID)
+
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// work runs jobs one after another until ctx is canceled.
+func (q *Queue) work(ctx context.Context) {
+	for ctx.Err() == nil {
+		j, err := q.claim(ctx)
+		if err != nil && ctx.Err() == nil {
+			q.slogger.Error("Failed to claim job", "err", err)
+		}
+
+		if j != nil {
+			q.run(ctx, j)
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-q.wake:
+		case <-time.After(q.config.Queue.PollInterval):
+		}
+	}
+}
+
+// claim locks the next job that is due and marks it as running.
+// It returns nil if there is none.
+func (q *Queue) claim(ctx context.Context) (*ent.Job, error) {
+	tx, err := q.db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	j, err := tx.Job.Query().
+		Where(
+			job.StatusEQ(job.StatusPending),
+			job.RunAfterLTE(now),
+			job.TypeIn(slices.Collect(maps.Keys(q.handlers))...),
+		).
+		Order(ent.Asc(job.FieldRunAfter)).
+		ForUpdate(sql.WithLockAction(sql.SkipLocked)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, tx.Rollback()
+	}
+	if err != nil {
+		return nil, errors.Join(err, tx.Rollback())
+	}
+
+	update := tx.Job.UpdateOne(j).
+		SetStatus(job.StatusRunning).
+		AddAttempts(1).
+		SetLockedBy(q.workerID).
+		SetLockedAt(now)
+	if j.StartedAt == nil {
+		update.SetStartedAt(now)
+	}
+
+	j, err = update.Save(ctx)
+	if err != nil {
+		return nil, errors.Join(err, tx.Rollback())
+	}
+
+	return j, tx.Commit()
+}
+
+// run runs a claimed job and records its outcome.
+func (q *Queue) run(ctx context.Context, j *ent.Job) {
+	jobCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	q.mu.Lock()
+	q.running[j.ID] = cancel
+	q.mu.Unlock()
+
+	defer func() {
+		q.mu.Lock()
+		delete(q.running, j.ID)
+		q.mu.Unlock()
+	}()
+
+	heartbeatDone := make(chan struct{})
+	go func() {
+		defer close(heartbeatDone)
+		q.heartbeat(jobCtx, cancel, j.ID)
+	}()
+
+	q.slogger.Info("Running job", "job", j.ID, "type", j.Type, "attempt", j.Attempts)
+
+	result, err := q.handle(jobCtx, j)
+
+	cancel()
+	<-heartbeatDone
+
+	// The outcome is recorded even if the server is shutting down.
+	if err := q.finish(context.WithoutCancel(ctx), j, result, err, ctx.Err() != nil); err != nil {
+		q.slogger.Error("Failed to record job outcome", "job", j.ID, "err", err)
+	}
+}
+
+// handle runs the handler of a job and recovers from panics, so
+// a single job can't crash the server.
+func (q *Queue) handle(ctx context.Context, j *ent.Job) (result any, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job panicked: %v", r)
+		}
+	}()
+
+	return q.handlers[j.Type](ctx, j)
+}
+
+// heartbeat renews the lock of a running job until ctx is canceled. If the
+// job has been canceled, possibly on another instance, cancel is called.
+func (q *Queue) heartbeat(ctx context.Context, cancel context.CancelFunc, id string) {
+	ticker := time.NewTicker(q.config.Queue.LeaseTimeout / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		n, err := q.db.Job.Update().
+			Where(job.ID(id), job.StatusEQ(job.StatusRunning), job.LockedBy(q.workerID)).
+			SetLockedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				q.slogger.Error("Failed to renew job lock", "job", id, "err", err)
+			}
+
+			continue
+		}
+
+		if n == 0 {
+			q.slogger.Info("Job is no longer running, stopping it", "job", id)
+			cancel()
+
+			return
+		}
+	}
+}
+
+// finish records the outcome of a job. Failed jobs are retried with
+// an exponential backoff until they run out of attempts. Jobs that were
+// interrupted by a shutdown are put back into the queue right away, and
+// postponed jobs after their delay, without counting the attempt.
+func (q *Queue) finish(ctx context.Context, j *ent.Job, result any, runErr error, shutdown bool) error {
+	now := time.Now()
+
+	update := q.db.Job.Update().
+		Where(job.ID(j.ID), job.StatusEQ(job.StatusRunning), job.LockedBy(q.workerID)).
+		SetLockedBy("").
+		ClearLockedAt()
+
+	var (
+		permanent *permanentError
+		postponed *postponedError
+		logResult func()
+	)
+	switch {
+	case runErr == nil:
+		data, err := json.Marshal(result)
+		if err != nil {
+			return err
+		}
+
+		update.SetStatus(job.StatusSucceeded).SetResult(data).SetError("").SetFinishedAt(now)
+		logResult = func() { q.slogger.Info("Job succeeded", "job", j.ID, "type", j.Type) }
+	case shutdown:
+		update.SetStatus(job.StatusPending).AddAttempts(-1).SetRunAfter(now)
+		logResult = func() { q.slogger.Info("Job was interrupted by shutdown", "job", j.ID, "type", j.Type) }
+	case errors.As(runErr, &postponed):
+		runAfter := now.Add(postponed.delay)
+
+		update.SetStatus(job.StatusPending).AddAttempts(-1).SetError(runErr.Error()).SetRunAfter(runAfter)
+		logResult = func() {
+			q.slogger.Info("Job was postponed", "job", j.ID, "type", j.Type, "run_after", runAfter, "err", runErr)
+		}
+	case errors.As(runErr, &permanent) || j.Attempts >= j.MaxAttempts:
+		update.SetStatus(job.StatusFailed).SetError(runErr.Error()).SetFinishedAt(now)
+		logResult = func() {
+			q.slogger.Error("Job failed", "job", j.ID, "type", j.Type, "attempt", j.Attempts, "err", runErr)
+		}
+	default:
+		runAfter := now.Add(q.backoff(j.Attempts))
+
+		update.SetStatus(job.StatusPending).SetError(runErr.Error()).SetRunAfter(runAfter)
+		logResult = func() {
+			q.slogger.Warn("Job failed, retrying", "job", j.ID, "type", j.Type, "attempt", j.Attempts,
+				"run_after", runAfter, "err", runErr)
+		}
+	}
+
+	n, err := update.Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	// A job that has been canceled in the meantime is not updated.
+	if n == 0 {
+		q.slogger.Info("Job was canceled", "job", j.ID, "type", j.Type)
+		return nil
+	}
+
+	logResult()
+
+	return nil
+}
+
+// reap puts running jobs whose worker stopped renewing the lock, e.g. because
+// the server crashed, back into the queue or fails them if they have no
+// attempts left.
+func (q *Queue) reap(ctx context.Context) {
+	now := time.Now()
+	expired := now.Add(-q.config.Queue.LeaseTimeout)
+	const reason = "the worker running the job stopped responding"
+
+	abandoned := []predicate.Job{
+		job.StatusEQ(job.StatusRunning),
+		job.LockedAtLT(expired),
+	}
+
+	retried, err := q.db.Job.Update().
+		Where(append(abandoned, predicate.Job(func(s *sql.Selector) {
+			s.Where(sql.ColumnsLT(job.FieldAttempts, job.FieldMaxAttempts))
+		}))...).
+		SetStatus(job.StatusPending).
+		SetLockedBy("").
+		ClearLockedAt().
+		SetError(reason).
+		SetRunAfter(now).
+		Save(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			q.slogger.Error("Failed to requeue abandoned jobs", "err", err)
+		}
+
+		return
+	}
+
+	failed, err := q.db.Job.Update().
+		Where(abandoned...).
+		SetStatus(job.StatusFailed).
+		SetLockedBy("").
+		ClearLockedAt().
+		SetError(reason).
+		SetFinishedAt(now).
+		Save(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			q.slogger.Error("Failed to fail abandoned jobs", "err", err)
+		}
+
+		return
+	}
+
+	if retried > 0 || failed > 0 {
+		q.slogger.Warn("Recovered abandoned jobs", "requeued", retried, "failed", failed)
+	}
+}
+
+// backoff returns the delay before the next attempt of a job that failed attempts times.
+func (q *Queue) backoff(attempts int) time.Duration {
+	delay := q.config.Queue.RetryBackoff
+	for range attempts - 1 {
+		delay *= 2
+		if delay >= q.config.Queue.MaxRetryBackoff {
+			return q.config.Queue.MaxRetryBackoff
+		}
+	}
+
+	return delay
+}
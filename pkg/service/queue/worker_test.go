@@ -0,0 +1,123 @@
+package queue
+
+import (
+	"beyerleinf/spotify-backup/ent"
+	"beyerleinf/spotify-backup/ent/job"
+	"beyerleinf/spotify-backup/internal/server/config"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	_ "modernc.org/sqlite"
+)
+
+func open(t *testing.T) *ent.Client {
+	t.Helper()
+
+	db, err := sql.Open("sqlite", fmt.Sprintf("file:%s?mode=memory&cache=shared&_pragma=foreign_keys(1)", t.Name()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.SQLite, db)))
+	t.Cleanup(func() { client.Close() })
+
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	return client
+}
+
+func newQueue(client *ent.Client) *Queue {
+	return New(&config.Config{Queue: config.QueueConfig{
+		Workers:         1,
+		PollInterval:    time.Second,
+		LeaseTimeout:    time.Minute,
+		MaxAttempts:     2,
+		RetryBackoff:    time.Minute,
+		MaxRetryBackoff: time.Hour,
+	}}, client)
+}
+
+// running creates a job that this queue's worker is running for the given attempt.
+func running(ctx context.Context, t *testing.T, q *Queue, attempts int) *ent.Job {
+	t.Helper()
+
+	j, err := q.db.Job.Create().
+		SetType("test").
+		SetStatus(job.StatusRunning).
+		SetAttempts(attempts).
+		SetMaxAttempts(q.config.Queue.MaxAttempts).
+		SetLockedBy(q.workerID).
+		SetLockedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return j
+}
+
+// finish records the outcome of a job and returns the job as it was stored.
+func finish(ctx context.Context, t *testing.T, q *Queue, j *ent.Job, runErr error) *ent.Job {
+	t.Helper()
+
+	if err := q.finish(ctx, j, nil, runErr, false); err != nil {
+		t.Fatalf("finish() = %v", err)
+	}
+
+	j, err := q.db.Job.Get(ctx, j.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return j
+}
+
+func TestFinish(t *testing.T) {
+	tests := []struct {
+		name         string
+		attempts     int
+		err          error
+		wantStatus   job.Status
+		wantAttempts int
+	}{
+		{name: "succeeded", attempts: 1, wantStatus: job.StatusSucceeded, wantAttempts: 1},
+		{name: "retried", attempts: 1, err: errors.New("broken"), wantStatus: job.StatusPending, wantAttempts: 1},
+		{name: "out of attempts", attempts: 2, err: errors.New("broken"), wantStatus: job.StatusFailed, wantAttempts: 2},
+		{name: "permanent", attempts: 1, err: Permanent(errors.New("broken")), wantStatus: job.StatusFailed, wantAttempts: 1},
+		{name: "postponed", attempts: 1, err: Postpone(errors.New("busy"), time.Hour), wantStatus: job.StatusPending},
+		{
+			name: "postponed on last attempt", attempts: 2, err: Postpone(errors.New("busy"), time.Hour),
+			wantStatus: job.StatusPending, wantAttempts: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			q := newQueue(open(t))
+
+			j := finish(ctx, t, q, running(ctx, t, q, tt.attempts), tt.err)
+			if j.Status != tt.wantStatus || j.Attempts != tt.wantAttempts {
+				t.Errorf("job = %s with %d attempts, want %s with %d", j.Status, j.Attempts, tt.wantStatus, tt.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestFinishPostponesByDelay(t *testing.T) {
+	ctx := context.Background()
+	q := newQueue(open(t))
+
+	j := finish(ctx, t, q, running(ctx, t, q, 1), Postpone(errors.New("busy"), time.Hour))
+	if time.Until(j.RunAfter) < 59*time.Minute {
+		t.Errorf("postponed job runs after %v, want in an hour", j.RunAfter)
+	}
+}
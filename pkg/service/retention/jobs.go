@@ -0,0 +1,41 @@
+package retention
+
+import (
+	"beyerleinf/spotify-backup/ent"
+	"beyerleinf/spotify-backup/pkg/service/queue"
+	"context"
+	"encoding/json"
+	"errors"
+)
+
+// PruneJobType is the type of jobs that prune the backups of a user.
+const PruneJobType = "prune"
+
+// PruneJobPayload is the payload of a prune job.
+type PruneJobPayload struct {
+	// UserID is the Spotify ID of the user whose backups are pruned.
+	UserID string `json:"user_id"`
+}
+
+// EnqueuePrune queues pruning the backups of a user. Only one prune of a
+// user can be queued at a time, otherwise [queue.ErrDuplicate] is returned.
+func EnqueuePrune(ctx context.Context, q *queue.Queue, userID string) (*ent.Job, error) {
+	return q.Enqueue(ctx, PruneJobType, PruneJobType+":"+userID, PruneJobPayload{UserID: userID})
+}
+
+// RunPruneJob is the [queue.Handler] of prune jobs. While a backup is running,
+// the job is postponed instead of failing, since pruning is queued after every
+// backup and with several users another backup is often still running.
+func (p *Pruner) RunPruneJob(ctx context.Context, job *ent.Job) (any, error) {
+	var payload PruneJobPayload
+	if err := json.Unmarshal(job.Payload, &payload); err != nil {
+		return nil, queue.Permanent(err)
+	}
+
+	result, err := p.Prune(ctx, payload.UserID)
+	if errors.Is(err, ErrBackupRunning) {
+		return nil, queue.Postpone(err, p.config.Queue.RetryBackoff)
+	}
+
+	return result, err
+}
@@ -0,0 +1,266 @@
+package retention
+
+import (
+	"beyerleinf/spotify-backup/ent"
+	"beyerleinf/spotify-backup/ent/album"
+	"beyerleinf/spotify-backup/ent/artist"
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/episode"
+	"beyerleinf/spotify-backup/ent/followedartist"
+	"beyerleinf/spotify-backup/ent/playlist"
+	"beyerleinf/spotify-backup/ent/playlistsnapshot"
+	"beyerleinf/spotify-backup/ent/predicate"
+	"beyerleinf/spotify-backup/ent/savedalbum"
+	"beyerleinf/spotify-backup/ent/savedaudiobook"
+	"beyerleinf/spotify-backup/ent/savedepisode"
+	"beyerleinf/spotify-backup/ent/savedshow"
+	"beyerleinf/spotify-backup/ent/savedtrack"
+	"beyerleinf/spotify-backup/ent/show"
+	"beyerleinf/spotify-backup/ent/snapshotitem"
+	"beyerleinf/spotify-backup/ent/track"
+	"beyerleinf/spotify-backup/pkg/service/lock"
+	"beyerleinf/spotify-backup/pkg/util"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrBackupRunning is returned when pruning while a backup is running.
+var ErrBackupRunning = errors.New("cannot prune backups while a backup is running")
+
+// PruneResult is the number of rows a prune deleted.
+type PruneResult struct {
+	Runs      int `json:"runs"`
+	Snapshots int `json:"snapshots"`
+	Playlists int `json:"playlists"`
+	Tracks    int `json:"tracks"`
+	Albums    int `json:"albums"`
+	Artists   int `json:"artists"`
+	Episodes  int `json:"episodes"`
+	Shows     int `json:"shows"`
+}
+
+// Prune deletes the backup runs of a user that expired according to the user's
+// retention policy, together with everything they backed up. Playlist snapshots
+// are only deleted once no remaining run refers to them. Afterwards, catalog
+// entries that nothing refers to anymore are deleted.
+//
+// Playlist snapshots and catalog entries are shared by backups of all users,
+// so pruning never runs at the same time as a backup, otherwise
+// [ErrBackupRunning] is returned.
+func (p *Pruner) Prune(ctx context.Context, userID string) (PruneResult, error) {
+	var result PruneResult
+
+	unlock, err := p.locker.TryLock(ctx, lock.Catalog)
+	if errors.Is(err, lock.ErrLocked) {
+		return result, ErrBackupRunning
+	}
+	if err != nil {
+		return result, err
+	}
+	defer unlock()
+
+	settings, err := p.Settings(ctx, userID)
+	if err != nil {
+		return result, err
+	}
+
+	runs, err := p.db.BackupRun.Query().
+		Where(backuprun.UserID(userID)).
+		Order(ent.Desc(backuprun.FieldStartedAt)).
+		All(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	keep := settings.keep(runs, time.Now())
+
+	for _, run := range runs {
+		if keep[run.ID] {
+			continue
+		}
+
+		snapshots, err := p.deleteRun(ctx, run)
+		if err != nil {
+			return result, fmt.Errorf("failed to delete run %s: %w", run.ID, err)
+		}
+
+		result.Runs++
+		result.Snapshots += snapshots
+	}
+
+	if err := p.collectGarbage(ctx, &result); err != nil {
+		return result, fmt.Errorf("failed to delete unused catalog entries: %w", err)
+	}
+
+	p.slogger.Info("Pruned backups", "user", userID, "kept", len(keep), "result", result)
+
+	return result, nil
+}
+
+// keep returns the IDs of the runs to keep. runs must be sorted newest first.
+//
+// All runs of the last KeepAllDays are kept. Before that, the newest run of
+// every day is kept for KeepDailyWeeks, then the newest run of every week for
+// KeepWeeklyMonths and the newest run of every month forever. A run older than
+// KeepDailyWeeks is also kept while it is the newest of its month, since weeks
+// can span two months. The newest runs are picked among all runs, no matter
+// their age, so a run that was pruned is never kept again as it ages. Failed
+// runs are only kept while all runs are, since they hold no complete backup.
+// Runs that are still running or pinned are always kept and don't take the
+// place of another run in their day, week or month.
+func (s PolicySettings) keep(runs []*ent.BackupRun, now time.Time) map[string]bool {
+	allSince := now.AddDate(0, 0, -s.KeepAllDays)
+	dailySince := allSince.AddDate(0, 0, -7*s.KeepDailyWeeks)
+	weeklySince := dailySince.AddDate(0, -s.KeepWeeklyMonths, 0)
+
+	keep := map[string]bool{}
+	buckets := map[string]bool{}
+	newest := func(bucket string) bool {
+		if buckets[bucket] {
+			return false
+		}
+
+		buckets[bucket] = true
+		return true
+	}
+
+	for _, run := range runs {
+		startedAt := run.StartedAt.UTC()
+
+		switch {
+		case run.Pinned || run.Status == backuprun.StatusRunning:
+			keep[run.ID] = true
+			continue
+		case run.Status == backuprun.StatusFailed:
+			if startedAt.After(allSince) {
+				keep[run.ID] = true
+			}
+			continue
+		}
+
+		year, week := startedAt.ISOWeek()
+		day := newest("day " + startedAt.Format(time.DateOnly))
+		weekly := newest(fmt.Sprintf("week %d-%d", year, week))
+		monthly := newest("month " + startedAt.Format("2006-01"))
+
+		var kept bool
+		switch {
+		case startedAt.After(allSince):
+			kept = true
+		case startedAt.After(dailySince):
+			kept = day
+		case startedAt.After(weeklySince):
+			kept = weekly || monthly
+		default:
+			kept = monthly
+		}
+
+		if kept {
+			keep[run.ID] = true
+		}
+	}
+
+	return keep
+}
+
+// deleteRun deletes a run with everything it backed up and returns the number
+// of playlist snapshots that were deleted because no other run refers to them
+// and they are not pinned.
+func (p *Pruner) deleteRun(ctx context.Context, run *ent.BackupRun) (int, error) {
+	deleted := 0
+
+	err := util.WithTx(ctx, p.db, func(tx *ent.Tx) error {
+		snapshotIDs, err := tx.BackupRun.QueryPlaylistSnapshots(run).IDs(ctx)
+		if err != nil {
+			return err
+		}
+
+		deletes := []func(context.Context) (int, error){
+			tx.SavedTrack.Delete().Where(savedtrack.HasRunWith(backuprun.ID(run.ID))).Exec,
+			tx.SavedAlbum.Delete().Where(savedalbum.HasRunWith(backuprun.ID(run.ID))).Exec,
+			tx.SavedShow.Delete().Where(savedshow.HasRunWith(backuprun.ID(run.ID))).Exec,
+			tx.SavedEpisode.Delete().Where(savedepisode.HasRunWith(backuprun.ID(run.ID))).Exec,
+			tx.SavedAudiobook.Delete().Where(savedaudiobook.HasRunWith(backuprun.ID(run.ID))).Exec,
+			tx.FollowedArtist.Delete().Where(followedartist.HasRunWith(backuprun.ID(run.ID))).Exec,
+		}
+		for _, del := range deletes {
+			if _, err := del(ctx); err != nil {
+				return err
+			}
+		}
+
+		// Deleting the run also unlinks its playlist snapshots.
+		if err := tx.BackupRun.DeleteOne(run).Exec(ctx); err != nil {
+			return err
+		}
+
+		orphaned := []predicate.PlaylistSnapshot{
+			playlistsnapshot.IDIn(snapshotIDs...),
+			playlistsnapshot.Not(playlistsnapshot.HasRuns()),
+			playlistsnapshot.Pinned(false),
+		}
+
+		_, err = tx.SnapshotItem.Delete().
+			Where(snapshotitem.HasSnapshotWith(orphaned...)).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		deleted, err = tx.PlaylistSnapshot.Delete().Where(orphaned...).Exec(ctx)
+
+		return err
+	})
+
+	return deleted, err
+}
+
+// collectGarbage deletes catalog entries and playlists that no backup refers to
+// anymore. Tracks and episodes are deleted first, since they refer to albums,
+// artists and shows themselves.
+func (p *Pruner) collectGarbage(ctx context.Context, result *PruneResult) error {
+	var err error
+
+	result.Playlists, err = p.db.Playlist.Delete().
+		Where(playlist.Not(playlist.HasSnapshots())).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	result.Tracks, err = p.db.Track.Delete().
+		Where(track.Not(track.HasSnapshotItems()), track.Not(track.HasSavedTracks())).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	result.Episodes, err = p.db.Episode.Delete().
+		Where(episode.Not(episode.HasSnapshotItems()), episode.Not(episode.HasSavedEpisodes())).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	result.Albums, err = p.db.Album.Delete().
+		Where(album.Not(album.HasTracks()), album.Not(album.HasSavedAlbums())).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	result.Artists, err = p.db.Artist.Delete().
+		Where(artist.Not(artist.HasTracks()), artist.Not(artist.HasAlbums()), artist.Not(artist.HasFollowedArtists())).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	result.Shows, err = p.db.Show.Delete().
+		Where(show.Not(show.HasEpisodes()), show.Not(show.HasSavedShows())).
+		Exec(ctx)
+
+	return err
+}
@@ -0,0 +1,136 @@
+package retention
+
+import (
+	"beyerleinf/spotify-backup/ent"
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/internal/server/config"
+	"beyerleinf/spotify-backup/pkg/service/lock"
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"testing"
+	"time"
+)
+
+func run(id string, startedAt string, status backuprun.Status, pinned bool) *ent.BackupRun {
+	t, err := time.Parse(time.DateTime, startedAt)
+	if err != nil {
+		panic(err)
+	}
+
+	return &ent.BackupRun{ID: id, StartedAt: t, Status: status, Pinned: pinned}
+}
+
+func TestKeep(t *testing.T) {
+	now, _ := time.Parse(time.DateTime, "2024-06-15 12:00:00")
+	settings := PolicySettings{KeepAllDays: 2, KeepDailyWeeks: 1, KeepWeeklyMonths: 1}
+
+	// Newest first, like Prune queries them.
+	runs := []*ent.BackupRun{
+		run("all", "2024-06-15 10:00:00", backuprun.StatusSucceeded, false),
+		run("all-failed", "2024-06-14 09:00:00", backuprun.StatusFailed, false),
+		run("daily", "2024-06-12 20:00:00", backuprun.StatusSucceeded, false),
+		run("daily-older", "2024-06-12 08:00:00", backuprun.StatusSucceeded, false),
+		run("daily-failed", "2024-06-11 08:00:00", backuprun.StatusFailed, false),
+		run("daily-partial", "2024-06-10 08:00:00", backuprun.StatusPartial, false),
+		run("weekly-pinned", "2024-06-02 08:00:00", backuprun.StatusSucceeded, true),
+		run("weekly", "2024-06-01 08:00:00", backuprun.StatusSucceeded, false),
+		run("weekly-newest-of-month", "2024-05-29 08:00:00", backuprun.StatusSucceeded, false),
+		run("weekly-older", "2024-05-28 08:00:00", backuprun.StatusSucceeded, false),
+		run("weekly-running", "2024-05-20 08:00:00", backuprun.StatusRunning, false),
+		run("monthly", "2024-04-20 08:00:00", backuprun.StatusSucceeded, false),
+		run("monthly-older", "2024-04-02 08:00:00", backuprun.StatusSucceeded, false),
+		run("monthly-failed", "2024-03-25 08:00:00", backuprun.StatusFailed, false),
+		run("monthly-previous", "2024-03-05 08:00:00", backuprun.StatusSucceeded, false),
+	}
+
+	got := slices.Sorted(maps.Keys(settings.keep(runs, now)))
+	want := []string{
+		"all", "all-failed", "daily", "daily-partial", "monthly", "monthly-previous",
+		"weekly", "weekly-newest-of-month", "weekly-pinned", "weekly-running",
+	}
+
+	if !slices.Equal(got, want) {
+		t.Errorf("keep() = %v, want %v", got, want)
+	}
+}
+
+func TestKeepNeverKeepsAPrunedRunAgain(t *testing.T) {
+	settings := PolicySettings{KeepAllDays: 1, KeepDailyWeeks: 1, KeepWeeklyMonths: 1}
+
+	// Four runs a day for a year, newest first.
+	start, _ := time.Parse(time.DateTime, "2024-01-01 00:00:00")
+	var runs []*ent.BackupRun
+	for i := range 365 * 4 {
+		startedAt := start.Add(time.Duration(i) * 6 * time.Hour)
+		runs = slices.Insert(runs, 0, &ent.BackupRun{
+			ID:        startedAt.Format(time.DateTime),
+			StartedAt: startedAt,
+			Status:    backuprun.StatusSucceeded,
+		})
+	}
+
+	// Prune once a day, deleting what is not kept, like Prune does.
+	pruned := map[string]bool{}
+	for now := start; now.Before(start.AddDate(1, 0, 0)); now = now.Add(24 * time.Hour) {
+		existing := slices.DeleteFunc(slices.Clone(runs), func(r *ent.BackupRun) bool {
+			return r.StartedAt.After(now) || pruned[r.ID]
+		})
+
+		keep := settings.keep(existing, now)
+		for _, r := range existing {
+			if !keep[r.ID] {
+				pruned[r.ID] = true
+			}
+		}
+
+		// What is kept now must be what would be kept had nothing been deleted.
+		all := slices.DeleteFunc(slices.Clone(runs), func(r *ent.BackupRun) bool { return r.StartedAt.After(now) })
+		if want := settings.keep(all, now); !maps.Equal(keep, want) {
+			t.Fatalf("keep() at %s = %d runs, want %d", now, len(keep), len(want))
+		}
+	}
+
+	// 2024-02-29 is in the same week as 2024-03-03, but it is the newest run of
+	// February, so it has to be kept while it is in the weekly window.
+	now, _ := time.Parse(time.DateTime, "2024-03-12 00:00:00")
+	all := slices.DeleteFunc(slices.Clone(runs), func(r *ent.BackupRun) bool { return r.StartedAt.After(now) })
+	if !settings.keep(all, now)["2024-02-29 18:00:00"] {
+		t.Error("keep() dropped the newest run of February")
+	}
+}
+
+func TestPruneFailsWhileBackupIsRunning(t *testing.T) {
+	ctx := context.Background()
+	locker := lock.NewLocal()
+
+	runlock, err := locker.RLock(ctx, lock.Catalog)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer runlock()
+
+	p := New(&config.Config{}, nil, locker)
+	if _, err := p.Prune(ctx, "user"); !errors.Is(err, ErrBackupRunning) {
+		t.Errorf("Prune() = %v, want ErrBackupRunning", err)
+	}
+}
+
+func TestRunPruneJobIsPostponedWhileBackupIsRunning(t *testing.T) {
+	ctx := context.Background()
+	locker := lock.NewLocal()
+
+	runlock, err := locker.RLock(ctx, lock.Catalog)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer runlock()
+
+	p := New(&config.Config{}, nil, locker)
+	job := &ent.Job{Payload: []byte(`{"user_id":"user"}`)}
+
+	if _, err := p.RunPruneJob(ctx, job); !errors.Is(err, ErrBackupRunning) || err == ErrBackupRunning {
+		t.Errorf("RunPruneJob() = %v, want postponed ErrBackupRunning", err)
+	}
+}
@@ -0,0 +1,111 @@
+// Package retention prunes old backups according to every user's [ent.RetentionPolicy].
+// Recent backups are kept completely, older ones are thinned out following a
+// grandfather-father-son scheme: one backup per day, then one per week and
+// finally one per month, which is kept forever.
+package retention
+
+import (
+	"beyerleinf/spotify-backup/ent"
+	"beyerleinf/spotify-backup/ent/retentionpolicy"
+	"beyerleinf/spotify-backup/internal/server/config"
+	"beyerleinf/spotify-backup/pkg/logger"
+	"beyerleinf/spotify-backup/pkg/service/lock"
+	"context"
+)
+
+// A Pruner deletes backups that expired according to their retention policy.
+type Pruner struct {
+	slogger *logger.Logger
+	config  *config.Config
+	db      *ent.Client
+	locker  lock.Locker
+}
+
+// New creates a [Pruner] instance.
+func New(config *config.Config, db *ent.Client, locker lock.Locker) *Pruner {
+	return &Pruner{
+		slogger: logger.New("retention", config.Server.LogLevel),
+		config:  config,
+		db:      db,
+		locker:  locker,
+	}
+}
+
+// PolicySettings are the settings of a user's retention policy that can be changed.
+type PolicySettings struct {
+	KeepAllDays      int
+	KeepDailyWeeks   int
+	KeepWeeklyMonths int
+}
+
+// GetPolicy returns the retention policy of a user or nil if the user has none.
+func (p *Pruner) GetPolicy(ctx context.Context, userID string) (*ent.RetentionPolicy, error) {
+	policy, err := p.db.RetentionPolicy.Query().Where(retentionpolicy.UserID(userID)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+
+	return policy, err
+}
+
+// DefaultSettings returns the settings that apply to users without a retention policy.
+func (p *Pruner) DefaultSettings() PolicySettings {
+	return PolicySettings{
+		KeepAllDays:      p.config.Retention.DefaultKeepAllDays,
+		KeepDailyWeeks:   p.config.Retention.DefaultKeepDailyWeeks,
+		KeepWeeklyMonths: p.config.Retention.DefaultKeepWeeklyMonths,
+	}
+}
+
+// Settings returns the settings of a user's retention policy or the defaults if the user has none.
+func (p *Pruner) Settings(ctx context.Context, userID string) (PolicySettings, error) {
+	policy, err := p.GetPolicy(ctx, userID)
+	if err != nil || policy == nil {
+		return p.DefaultSettings(), err
+	}
+
+	return PolicySettings{
+		KeepAllDays:      policy.KeepAllDays,
+		KeepDailyWeeks:   policy.KeepDailyWeeks,
+		KeepWeeklyMonths: policy.KeepWeeklyMonths,
+	}, nil
+}
+
+// SavePolicy creates or updates the retention policy of a user.
+func (p *Pruner) SavePolicy(ctx context.Context, userID string, settings PolicySettings) (*ent.RetentionPolicy, error) {
+	if err := validateSettings(settings); err != nil {
+		return nil, err
+	}
+
+	policy, err := p.GetPolicy(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if policy == nil {
+		return p.db.RetentionPolicy.Create().
+			SetUserID(userID).
+			SetKeepAllDays(settings.KeepAllDays).
+			SetKeepDailyWeeks(settings.KeepDailyWeeks).
+			SetKeepWeeklyMonths(settings.KeepWeeklyMonths).
+			Save(ctx)
+	}
+
+	return policy.Update().
+		SetKeepAllDays(settings.KeepAllDays).
+		SetKeepDailyWeeks(settings.KeepDailyWeeks).
+		SetKeepWeeklyMonths(settings.KeepWeeklyMonths).
+		Save(ctx)
+}
+
+func validateSettings(settings PolicySettings) error {
+	if err := retentionpolicy.KeepAllDaysValidator(settings.KeepAllDays); err != nil {
+		return err
+	}
+
+	if err := retentionpolicy.KeepDailyWeeksValidator(settings.KeepDailyWeeks); err != nil {
+		return err
+	}
+
+	return retentionpolicy.KeepWeeklyMonthsValidator(settings.KeepWeeklyMonths)
+}
@@ -0,0 +1,148 @@
+// Package scheduler queues backups automatically according to every user's [ent.BackupSchedule].
+package scheduler
+
+import (
+	"beyerleinf/spotify-backup/ent"
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/backupschedule"
+	"beyerleinf/spotify-backup/internal/server/config"
+	"beyerleinf/spotify-backup/pkg/logger"
+	"beyerleinf/spotify-backup/pkg/service/queue"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// An Enqueuer queues backups of a user's library.
+type Enqueuer interface {
+	EnqueueBackup(ctx context.Context, userID string, trigger backuprun.Trigger) (*ent.Job, error)
+}
+
+// A Scheduler queues backups when they are due according to their schedule.
+// A backup that is still queued or running when the next one is due is not
+// queued again, so runs of the same user never overlap.
+type Scheduler struct {
+	slogger  *logger.Logger
+	config   *config.Config
+	db       *ent.Client
+	enqueuer Enqueuer
+}
+
+// New creates a [Scheduler] instance.
+func New(config *config.Config, db *ent.Client, enqueuer Enqueuer) *Scheduler {
+	return &Scheduler{
+		slogger:  logger.New("scheduler", config.Server.LogLevel),
+		config:   config,
+		db:       db,
+		enqueuer: enqueuer,
+	}
+}
+
+// Run checks for due backups until ctx is canceled.
+func (s *Scheduler) Run(ctx context.Context) {
+	s.slogger.Info("Starting scheduler", "poll_interval", s.config.Scheduler.PollInterval)
+
+	ticker := time.NewTicker(s.config.Scheduler.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		s.tick(ctx, time.Now())
+
+		select {
+		case <-ctx.Done():
+			s.slogger.Info("Stopped scheduler")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// tick queues all backups that are due at now.
+func (s *Scheduler) tick(ctx context.Context, now time.Time) {
+	schedules, err := s.db.BackupSchedule.Query().
+		Where(backupschedule.Enabled(true)).
+		All(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			s.slogger.Error("Failed to load schedules", "err", err)
+		}
+
+		return
+	}
+
+	for _, schedule := range schedules {
+		if err := s.check(ctx, schedule, now); err != nil {
+			s.slogger.Error("Failed to check schedule", "user", schedule.UserID, "err", err)
+		}
+	}
+}
+
+// check queues a backup if the schedule is due and sets its next run.
+func (s *Scheduler) check(ctx context.Context, schedule *ent.BackupSchedule, now time.Time) error {
+	sched, err := Parse(schedule.Cron, schedule.TimeZone)
+	if err != nil {
+		return err
+	}
+
+	// Schedules that never run are rejected when they are saved, but ones
+	// saved before that may still exist.
+	next := sched.Next(now)
+	if next.IsZero() {
+		s.slogger.Warn("Disabling schedule that never runs", "user", schedule.UserID, "cron", schedule.Cron, "time_zone", schedule.TimeZone)
+		return s.db.BackupSchedule.UpdateOne(schedule).SetEnabled(false).ClearNextRunAt().Exec(ctx)
+	}
+
+	if schedule.NextRunAt == nil {
+		return s.db.BackupSchedule.UpdateOne(schedule).SetNextRunAt(next).Exec(ctx)
+	}
+
+	if now.Before(*schedule.NextRunAt) {
+		return nil
+	}
+
+	update := s.db.BackupSchedule.UpdateOne(schedule).SetNextRunAt(next)
+
+	late := now.Sub(*schedule.NextRunAt)
+	if late > s.config.Scheduler.MisfireThreshold && schedule.MisfirePolicy == backupschedule.MisfirePolicySkip {
+		s.slogger.Warn("Skipping missed backup", "user", schedule.UserID, "due", *schedule.NextRunAt)
+		return update.Exec(ctx)
+	}
+
+	j, err := s.enqueuer.EnqueueBackup(ctx, schedule.UserID, backuprun.TriggerSchedule)
+	switch {
+	case errors.Is(err, queue.ErrDuplicate):
+		s.slogger.Warn("Skipping backup because the previous one is still queued or running", "user", schedule.UserID)
+	case err != nil:
+		return err
+	default:
+		s.slogger.Info("Queued scheduled backup", "user", schedule.UserID, "job", j.ID)
+		update.SetLastRunAt(now)
+	}
+
+	return update.Exec(ctx)
+}
+
+// Parse parses a cron expression which is evaluated in the given time zone.
+// It accepts five fields (minute, hour, day of month, month and day of week)
+// and descriptors like @daily.
+func Parse(spec string, timeZone string) (cron.Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	if strings.HasPrefix(spec, "TZ=") || strings.HasPrefix(spec, "CRON_TZ=") {
+		return nil, errors.New("the time zone must not be part of the cron expression")
+	}
+
+	if _, err := time.LoadLocation(timeZone); err != nil {
+		return nil, fmt.Errorf("invalid time zone %q: %w", timeZone, err)
+	}
+
+	sched, err := cron.ParseStandard("CRON_TZ=" + timeZone + " " + spec)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cron expression %q: %w", spec, err)
+	}
+
+	return sched, nil
+}
@@ -0,0 +1,126 @@
+package scheduler
+
+import (
+	"beyerleinf/spotify-backup/ent"
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/backupschedule"
+	"beyerleinf/spotify-backup/internal/server/config"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	_ "modernc.org/sqlite"
+)
+
+func open(t *testing.T) *ent.Client {
+	t.Helper()
+
+	db, err := sql.Open("sqlite", fmt.Sprintf("file:%s?mode=memory&cache=shared&_pragma=foreign_keys(1)", t.Name()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.SQLite, db)))
+	t.Cleanup(func() { client.Close() })
+
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	return client
+}
+
+// enqueuer records the users whose backups were queued.
+type enqueuer struct {
+	users []string
+}
+
+func (e *enqueuer) EnqueueBackup(_ context.Context, userID string, _ backuprun.Trigger) (*ent.Job, error) {
+	e.users = append(e.users, userID)
+
+	return &ent.Job{ID: fmt.Sprint(len(e.users))}, nil
+}
+
+func newScheduler(t *testing.T) (*Scheduler, *enqueuer) {
+	t.Helper()
+
+	e := &enqueuer{}
+	cfg := &config.Config{Scheduler: config.SchedulerConfig{MisfireThreshold: time.Hour}}
+
+	return New(cfg, open(t), e), e
+}
+
+func TestSaveSchedule(t *testing.T) {
+	tests := []struct {
+		name    string
+		cron    string
+		wantErr error
+	}{
+		{"daily", "0 3 * * *", nil},
+		{"leap day", "0 0 29 2 *", nil},
+		{"february 30th", "0 0 30 2 *", ErrNeverDue},
+		{"april 31st", "0 0 31 4 *", ErrNeverDue},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := newScheduler(t)
+
+			schedule, err := s.SaveSchedule(context.Background(), "user", ScheduleSettings{
+				Cron:          tt.cron,
+				TimeZone:      "Europe/Berlin",
+				MisfirePolicy: backupschedule.MisfirePolicyCatchUp,
+				Enabled:       true,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("SaveSchedule() = %v, want %v", err, tt.wantErr)
+			}
+
+			if err == nil && (schedule.NextRunAt == nil || !schedule.NextRunAt.After(time.Now())) {
+				t.Errorf("SaveSchedule() next run = %v, want a time in the future", schedule.NextRunAt)
+			}
+		})
+	}
+}
+
+func TestTickDisablesSchedulesThatNeverRun(t *testing.T) {
+	ctx := context.Background()
+	s, e := newScheduler(t)
+	now := time.Now()
+
+	// Saved before impossible expressions were rejected, once with the next
+	// run calculated and once without.
+	for user, nextRunAt := range map[string]*time.Time{"due": &now, "new": nil} {
+		err := s.db.BackupSchedule.Create().
+			SetUserID(user).
+			SetCron("0 0 30 2 *").
+			SetNillableNextRunAt(nextRunAt).
+			Exec(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	s.tick(ctx, now)
+
+	if len(e.users) > 0 {
+		t.Errorf("tick() queued backups of %v, want none", e.users)
+	}
+
+	schedules, err := s.db.BackupSchedule.Query().All(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, schedule := range schedules {
+		if schedule.Enabled || schedule.NextRunAt != nil {
+			t.Errorf("tick() left schedule of %s enabled: %t, next run at %v, want it disabled without a next run",
+				schedule.UserID, schedule.Enabled, schedule.NextRunAt)
+		}
+	}
+}
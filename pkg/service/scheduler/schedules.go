@@ -0,0 +1,88 @@
+package scheduler
+
+import (
+	"beyerleinf/spotify-backup/ent"
+	"beyerleinf/spotify-backup/ent/backupschedule"
+	"context"
+	"errors"
+	"strings"
+	"time"
+)
+
+// ErrNeverDue is returned when saving a schedule whose cron expression
+// never matches a date, e.g. 0 0 30 2 * for February 30th.
+var ErrNeverDue = errors.New("the cron expression never matches a date")
+
+// ScheduleSettings are the settings of a user's schedule that can be changed.
+type ScheduleSettings struct {
+	Cron          string
+	TimeZone      string
+	MisfirePolicy backupschedule.MisfirePolicy
+	Enabled       bool
+}
+
+// GetSchedule returns the schedule of a user or nil if the user has none.
+func (s *Scheduler) GetSchedule(ctx context.Context, userID string) (*ent.BackupSchedule, error) {
+	schedule, err := s.db.BackupSchedule.Query().Where(backupschedule.UserID(userID)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+
+	return schedule, err
+}
+
+// DefaultSettings returns the settings suggested to users without a schedule.
+func (s *Scheduler) DefaultSettings() ScheduleSettings {
+	return ScheduleSettings{
+		Cron:          s.config.Scheduler.DefaultCron,
+		TimeZone:      s.config.Scheduler.DefaultTimeZone,
+		MisfirePolicy: backupschedule.MisfirePolicy(s.config.Scheduler.DefaultMisfirePolicy),
+		Enabled:       true,
+	}
+}
+
+// SaveSchedule creates or updates the schedule of a user. The next run
+// is calculated from the new settings, a schedule that would never run is
+// rejected with [ErrNeverDue].
+func (s *Scheduler) SaveSchedule(ctx context.Context, userID string, settings ScheduleSettings) (*ent.BackupSchedule, error) {
+	settings.Cron = strings.TrimSpace(settings.Cron)
+	settings.TimeZone = strings.TrimSpace(settings.TimeZone)
+
+	sched, err := Parse(settings.Cron, settings.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := backupschedule.MisfirePolicyValidator(settings.MisfirePolicy); err != nil {
+		return nil, err
+	}
+
+	next := sched.Next(time.Now())
+	if next.IsZero() {
+		return nil, ErrNeverDue
+	}
+
+	schedule, err := s.GetSchedule(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if schedule == nil {
+		return s.db.BackupSchedule.Create().
+			SetUserID(userID).
+			SetCron(settings.Cron).
+			SetTimeZone(settings.TimeZone).
+			SetMisfirePolicy(settings.MisfirePolicy).
+			SetEnabled(settings.Enabled).
+			SetNextRunAt(next).
+			Save(ctx)
+	}
+
+	return schedule.Update().
+		SetCron(settings.Cron).
+		SetTimeZone(settings.TimeZone).
+		SetMisfirePolicy(settings.MisfirePolicy).
+		SetEnabled(settings.Enabled).
+		SetNextRunAt(next).
+		Save(ctx)
+}
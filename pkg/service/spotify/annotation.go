@@ -0,0 +1,117 @@
+package spotify
+
+import (
+	"beyerleinf/spotify-backup/ent"
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	maxNoteLength  = 2000
+	maxLabelLength = 64
+	maxLabels      = 20
+)
+
+// ErrInvalidAnnotation is returned when a note or labels exceed their limits.
+var ErrInvalidAnnotation = errors.New("notes are limited to 2000 characters, labels to 64 characters and 20 per backup")
+
+// An Annotation pins, describes or labels a backup run or playlist snapshot.
+// Fields that are nil are left unchanged.
+type Annotation struct {
+	Pinned *bool     `json:"pinned"`
+	Note   *string   `json:"note"`
+	Labels *[]string `json:"labels"`
+}
+
+// AnnotateBackupRun applies an [Annotation] to a backup run and returns the updated run.
+func (s *Service) AnnotateBackupRun(ctx context.Context, id string, annotation Annotation) (*ent.BackupRun, error) {
+	note, labels, err := annotation.normalize()
+	if err != nil {
+		return nil, err
+	}
+
+	update := s.db.BackupRun.UpdateOneID(id)
+	if annotation.Pinned != nil {
+		update.SetPinned(*annotation.Pinned)
+	}
+	if note != nil {
+		update.SetNote(*note)
+	}
+	if labels != nil {
+		update.SetLabels(labels)
+	}
+
+	return update.Save(ctx)
+}
+
+// AnnotatePlaylistSnapshot applies an [Annotation] to a playlist snapshot and returns the updated snapshot.
+// A pinned snapshot is kept even if every run it is part of is pruned.
+func (s *Service) AnnotatePlaylistSnapshot(ctx context.Context, id string, annotation Annotation) (*ent.PlaylistSnapshot, error) {
+	note, labels, err := annotation.normalize()
+	if err != nil {
+		return nil, err
+	}
+
+	update := s.db.PlaylistSnapshot.UpdateOneID(id)
+	if annotation.Pinned != nil {
+		update.SetPinned(*annotation.Pinned)
+	}
+	if note != nil {
+		update.SetNote(*note)
+	}
+	if labels != nil {
+		update.SetLabels(labels)
+	}
+
+	return update.Save(ctx)
+}
+
+// normalize trims the note and labels, drops empty and duplicate labels and
+// checks their limits. The returned values are nil if they are not changed.
+func (a Annotation) normalize() (*string, []string, error) {
+	var note *string
+	if a.Note != nil {
+		trimmed := strings.TrimSpace(*a.Note)
+		if utf8.RuneCountInString(trimmed) > maxNoteLength {
+			return nil, nil, ErrInvalidAnnotation
+		}
+
+		note = &trimmed
+	}
+
+	if a.Labels == nil {
+		return note, nil, nil
+	}
+
+	labels := []string{}
+	for _, label := range *a.Labels {
+		label = strings.TrimSpace(label)
+		if label == "" || slices.Contains(labels, label) {
+			continue
+		}
+
+		if utf8.RuneCountInString(label) > maxLabelLength {
+			return nil, nil, ErrInvalidAnnotation
+		}
+
+		labels = append(labels, label)
+	}
+
+	if len(labels) > maxLabels {
+		return nil, nil, ErrInvalidAnnotation
+	}
+
+	return note, labels, nil
+}
+
+// ParseLabels splits a comma separated list of labels, e.g. from a form field.
+func ParseLabels(value string) []string {
+	if strings.TrimSpace(value) == "" {
+		return []string{}
+	}
+
+	return strings.Split(value, ",")
+}
@@ -0,0 +1,69 @@
+package spotify
+
+import (
+	"beyerleinf/spotify-backup/ent"
+	"beyerleinf/spotify-backup/pkg/util"
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+)
+
+const (
+	followedArtistsPageSize = 50
+	// followArtistsBatchSize is the number of artists that can be
+	// followed or unfollowed with a single request.
+	followArtistsBatchSize = 50
+)
+
+// FollowedArtists iterates over all artists the current user follows.
+// Unlike most other endpoints this one is paged using the ID of
+// the last artist of the previous page instead of an offset.
+// [Get Followed Artists]: https://developer.spotify.com/documentation/web-api/reference/get-followed
+func (c *Client) FollowedArtists(ctx context.Context) iter.Seq2[Artist, error] {
+	query := limit(followedArtistsPageSize)
+	query.Set("type", "artist")
+
+	return PaginateCursor[Artist](ctx, c, "/me/following", query, "artists")
+}
+
+// FollowArtists follows up to [followArtistsBatchSize] artists.
+// [Follow Artists or Users]: https://developer.spotify.com/documentation/web-api/reference/follow-artists-users
+func (c *Client) FollowArtists(ctx context.Context, ids []string) error {
+	return c.Send(ctx, http.MethodPut, "/me/following?type=artist", map[string]any{"ids": ids}, nil)
+}
+
+// UnfollowArtists unfollows up to [followArtistsBatchSize] artists.
+// [Unfollow Artists or Users]: https://developer.spotify.com/documentation/web-api/reference/unfollow-artists-users
+func (c *Client) UnfollowArtists(ctx context.Context, ids []string) error {
+	return c.Send(ctx, http.MethodDelete, "/me/following?type=artist", map[string]any{"ids": ids}, nil)
+}
+
+// backupFollowedArtists stores the artists the current user follows as part of the given run.
+func (s *Service) backupFollowedArtists(ctx context.Context, run *ent.BackupRun) (int, error) {
+	artists, err := Collect(s.client.FollowedArtists(ctx))
+	if err != nil {
+		return 0, fmt.Errorf("failed to get followed artists: %w", err)
+	}
+
+	err = util.WithTx(ctx, s.db, func(tx *ent.Tx) error {
+		cat := newCatalog(tx)
+		if err := cat.addArtists(ctx, artists); err != nil {
+			return err
+		}
+
+		return createInBatches(ctx, tx.FollowedArtist, artists, func(c *ent.FollowedArtistCreate, a Artist) {
+			c.SetRun(run).
+				SetArtistID(cat.artists[a.ID]).
+				SetFollowers(a.Followers.Total).
+				SetPopularity(a.Popularity)
+		})
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to save followed artists: %w", err)
+	}
+
+	s.slogger.Info("Backed up followed artists", "run", run.ID, "count", len(artists))
+
+	return len(artists), nil
+}
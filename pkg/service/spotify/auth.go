@@ -1,8 +1,12 @@
 package spotify
 
 import (
+	"beyerleinf/spotify-backup/ent"
+	"beyerleinf/spotify-backup/ent/authstate"
+	"beyerleinf/spotify-backup/ent/spotifytoken"
 	"beyerleinf/spotify-backup/pkg/assert"
-	"beyerleinf/spotify-backup/pkg/request"
+	"beyerleinf/spotify-backup/pkg/service/lock"
+	"beyerleinf/spotify-backup/pkg/util"
 	"context"
 	"crypto/aes"
 	"crypto/cipher"
@@ -17,33 +21,71 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
-	"sync"
 	"time"
 )
 
-var tokenMutex sync.RWMutex
+const (
+	// tokenName is the name of the stored credentials of the signed in account.
+	tokenName = "spotify"
+	// tokenFile is where instances used to store the credentials before they
+	// were stored in the database. It is imported once if it still exists.
+	tokenFile = "token.bin"
+	// authStateTTL is how long a user has to sign in with Spotify.
+	authStateTTL = 10 * time.Minute
+)
+
+// scopes are the authorization scopes requested from the user.
+// [Scopes]: https://developer.spotify.com/documentation/web-api/concepts/scopes
+var scopes = []string{
+	"user-read-private",
+	"playlist-read-private",
+	"playlist-read-collaborative",
+	"user-library-read",
+	"user-library-modify",
+	"user-read-playback-position",
+	"user-follow-read",
+	"user-follow-modify",
+	"playlist-modify-public",
+	"playlist-modify-private",
+}
+
+// GetAuthURL returns a URL to redirect a user to sign in with Spotify. Its
+// state is stored in the database, so the callback may reach any instance.
+func (s *Service) GetAuthURL(ctx context.Context) (string, error) {
+	now := time.Now()
+
+	_, err := s.db.AuthState.Delete().Where(authstate.ExpiresAtLT(now)).Exec(ctx)
+	if err != nil {
+		return "", err
+	}
 
-const tokenFile = "token.bin"
+	state := util.GenerateRandomString(16)
 
-var authToken *AuthToken
+	err = s.db.AuthState.Create().SetState(state).SetExpiresAt(now.Add(authStateTTL)).Exec(ctx)
+	if err != nil {
+		return "", err
+	}
 
-// GetAuthURL returns a URL to redirect a user to sign in with Spotify.
-func (s *Service) GetAuthURL() string {
-	scope := url.QueryEscape("user-read-private playlist-read-private")
+	scope := url.QueryEscape(strings.Join(scopes, " "))
 
-	return fmt.Sprintf("https://accounts.spotify.com/authorize?response_type=code&client_id=%s&scope=%s&redirect_uri=%s&state=%s",
-		s.config.Spotify.ClientID, scope, url.QueryEscape(s.redirectURI), s.state,
-	)
+	return fmt.Sprintf("%s/authorize?response_type=code&client_id=%s&scope=%s&redirect_uri=%s&state=%s",
+		s.accountsURL(), s.config.Spotify.ClientID, scope, url.QueryEscape(s.redirectURI), state,
+	), nil
 }
 
 // HandleAuthCallback handles a callback request from Spotify's Auth API.
 // It takes a code and the state used to initiate the authentication flow
 // and follows Spotify's requirements to request an Access Token.
 // [Spotify Authorization Code Flow]: https://developer.spotify.com/documentation/web-api/tutorials/code-flow
-func (s *Service) HandleAuthCallback(code string, state string) error {
-	ctx := context.Background()
-
-	if state != s.state {
+func (s *Service) HandleAuthCallback(ctx context.Context, code string, state string) error {
+	// Every state is accepted once, so a callback can't be replayed.
+	n, err := s.db.AuthState.Delete().
+		Where(authstate.State(state), authstate.ExpiresAtGT(time.Now())).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
 		return errors.New("state mismatch")
 	}
 
@@ -59,13 +101,13 @@ func (s *Service) HandleAuthCallback(code string, state string) error {
 		"Authorization": {"Basic " + authHeaderValue},
 	}
 
-	data, status, err := request.PostForm(ctx, "https://accounts.spotify.com/api/token", strings.NewReader(form.Encode()), headers)
+	data, status, err := s.http.PostForm(ctx, s.accountsURL()+"/api/token", strings.NewReader(form.Encode()), headers)
 	if err != nil {
 		return err
 	}
 
 	if status != http.StatusOK {
-		return fmt.Errorf("token request failed: %d - %s", status, string(data))
+		return newAuthError(status, data)
 	}
 
 	var tokenResponse AuthTokenResponse
@@ -75,82 +117,86 @@ func (s *Service) HandleAuthCallback(code string, state string) error {
 		return err
 	}
 
-	tokenMutex.Lock()
-	authToken = &AuthToken{
+	err = s.saveToken(ctx, &AuthToken{
 		AccessToken:  tokenResponse.AccessToken,
 		RefreshToken: tokenResponse.RefreshToken,
 		ExpiresAt:    s.calculateExpiresAt(tokenResponse.ExpiresIn),
+	})
+	if err != nil {
+		return err
 	}
-	tokenMutex.Unlock()
-
-	s.saveToken()
 
 	s.slogger.Verbose("Successfully authenticated with Spotify!")
 
 	return nil
 }
 
-// GetAccessToken tries to read the current Access Token from an encrypted file
-// on disk. If that fails or of the Access Token expired, it will request
-// a new Access Token using [RefreshAccessToken].
-func (s *Service) GetAccessToken() (string, error) {
-	tokenMutex.RLock()
-	token := authToken
-	tokenMutex.RUnlock()
+// GetAccessToken reads the current Access Token from the database. If it
+// expired, it requests a new Access Token using [RefreshAccessToken].
+func (s *Service) GetAccessToken(ctx context.Context) (string, error) {
+	token, err := s.loadToken(ctx)
+	if err != nil {
+		return "", err
+	}
 
 	if token == nil {
-		s.loadToken()
-
-		tokenMutex.RLock()
-		token = authToken
-		tokenMutex.RUnlock()
+		return "", &UnauthenticatedError{}
 	}
 
-	if token != nil && time.Now().Before(token.ExpiresAt) {
+	if time.Now().Before(token.ExpiresAt) {
 		assert.NotEqual("", token.AccessToken, "existing access token should not be an empty string")
-		assert.NotNil(token.AccessToken, "existing access token is nil")
 
 		return token.AccessToken, nil
 	}
 
-	if token != nil && time.Now().After(token.ExpiresAt) {
-		assert.NotEqual("", token.RefreshToken, "stored refresh token should not be an empty string")
+	// Concurrent requests share a single refresh, also across instances,
+	// since Spotify may rotate the refresh token. Whoever waited for it
+	// uses the new token instead of refreshing again.
+	s.refreshMu.Lock()
+	defer s.refreshMu.Unlock()
 
-		err := s.RefreshAccessToken(token.RefreshToken)
-		if err != nil {
-			return "", err
-		}
+	unlock, err := s.locker.Lock(ctx, lock.SpotifyToken)
+	if err != nil {
+		return "", err
+	}
+	defer unlock()
 
-		tokenMutex.RLock()
-		token = authToken
-		tokenMutex.RUnlock()
+	token, err = s.loadToken(ctx)
+	if err != nil {
+		return "", err
+	}
 
-		assert.NotEqual("", token.AccessToken, "new access token should not be an empty string")
-		assert.NotNil(token.AccessToken, "new access token is nil")
+	if token == nil {
+		return "", &UnauthenticatedError{}
+	}
 
+	if time.Now().Before(token.ExpiresAt) {
 		return token.AccessToken, nil
 	}
 
-	if token == nil {
-		return "", &UnauthenticatedError{}
+	assert.NotEqual("", token.RefreshToken, "stored refresh token should not be an empty string")
+
+	err = s.RefreshAccessToken(ctx, token)
+	if err != nil {
+		return "", err
 	}
 
-	assert.Assert(true, "We should not have reached this")
-	return "", errors.New("something went wrong")
+	assert.NotEqual("", token.AccessToken, "new access token should not be an empty string")
+
+	return token.AccessToken, nil
 }
 
 // RefreshAccessToken makes a call to Spotify's Authentication API using
 // the Refresh Token obtained on the last authentication request.
-// It will request a new Access Token using the Refresh Token.
+// It will request a new Access Token using the Refresh Token, update
+// the token with it and store it.
 // [Refreshing Tokens]: https://developer.spotify.com/documentation/web-api/tutorials/refreshing-tokens
-func (s *Service) RefreshAccessToken(refreshToken string) error {
-	assert.NotEqual("", refreshToken, "RefreshToken should not be an empty string")
-
-	ctx := context.Background()
+func (s *Service) RefreshAccessToken(ctx context.Context, token *AuthToken) error {
+	assert.NotEqual("", token.RefreshToken, "RefreshToken should not be an empty string")
 
 	form := url.Values{}
 	form.Add("grant_type", "refresh_token")
-	form.Add("refresh_token", refreshToken)
+	form.Add("refresh_token", token.RefreshToken)
 	form.Add("client_id", s.config.Spotify.ClientID)
 
 	clientIDAndSecret := fmt.Sprintf("%s:%s", s.config.Spotify.ClientID, s.config.Spotify.ClientSecret)
@@ -160,13 +206,26 @@ func (s *Service) RefreshAccessToken(refreshToken string) error {
 		"Authorization": {"Basic " + authHeaderValue},
 	}
 
-	data, status, err := request.PostForm(ctx, "https://accounts.spotify.com/api/token", strings.NewReader(form.Encode()), headers)
+	data, status, err := s.http.PostForm(ctx, s.accountsURL()+"/api/token", strings.NewReader(form.Encode()), headers)
 	if err != nil {
 		return err
 	}
 
 	if status != http.StatusOK {
-		return fmt.Errorf("token request failed: %d - %s", status, string(data))
+		err = newAuthError(status, data)
+
+		// The refresh token has been revoked or expired,
+		// so the user has to sign in again.
+		var invalidGrant *InvalidGrantError
+		if errors.As(err, &invalidGrant) {
+			if err := s.deleteToken(ctx); err != nil {
+				s.slogger.Error("Error deleting auth token", "err", err)
+			}
+
+			return &UnauthenticatedError{Err: err}
+		}
+
+		return err
 	}
 
 	var tokenResponse AuthTokenResponse
@@ -178,89 +237,121 @@ func (s *Service) RefreshAccessToken(refreshToken string) error {
 	assert.NotEqual("", tokenResponse.AccessToken, "AccessToken should not be empty")
 	assert.NotNil(tokenResponse.ExpiresIn, "ExpiresAt should not be nil")
 
-	tokenMutex.Lock()
-
-	authToken.AccessToken = tokenResponse.AccessToken
-	authToken.ExpiresAt = s.calculateExpiresAt(tokenResponse.ExpiresIn)
+	token.AccessToken = tokenResponse.AccessToken
+	token.ExpiresAt = s.calculateExpiresAt(tokenResponse.ExpiresIn)
 
 	if tokenResponse.RefreshToken != "" {
-		authToken.RefreshToken = tokenResponse.RefreshToken
+		token.RefreshToken = tokenResponse.RefreshToken
 	}
 
-	tokenMutex.Unlock()
-
-	s.saveToken()
-
-	return nil
+	return s.saveToken(ctx, token)
 }
 
-func (s *Service) saveToken() {
-	tokenMutex.RLock()
-	defer tokenMutex.RUnlock()
+func (s *Service) accountsURL() string {
+	return strings.TrimSuffix(s.config.Spotify.AccountsURL, "/")
+}
 
-	assert.NotEqual("", authToken.AccessToken, "AccessToken should not be empty")
-	assert.NotNil(authToken.ExpiresAt, "ExpiresAt should not be nil")
-	assert.NotEqual("", authToken.RefreshToken, "RefreshToken should not be empty")
+func (s *Service) saveToken(ctx context.Context, token *AuthToken) error {
+	assert.NotEqual("", token.AccessToken, "AccessToken should not be empty")
+	assert.NotEqual("", token.RefreshToken, "RefreshToken should not be empty")
 
-	jsonData, err := json.Marshal(authToken)
+	jsonData, err := json.Marshal(token)
 	if err != nil {
-		s.slogger.Error("Error marshaling auth token", "err", err)
-		return
+		return fmt.Errorf("marshaling auth token: %w", err)
 	}
 
 	encryptedData, err := s.encryptToken(jsonData)
 	if err != nil {
-		s.slogger.Error("Error encrypting auth token", "err", err)
-		return
+		return fmt.Errorf("encrypting auth token: %w", err)
 	}
 
-	tokenPath := filepath.Join(s.storageDir, tokenFile)
+	n, err := s.db.SpotifyToken.Update().
+		Where(spotifytoken.Name(tokenName)).
+		SetToken(encryptedData).
+		Save(ctx)
+	if err != nil || n > 0 {
+		return err
+	}
 
-	err = os.WriteFile(tokenPath, encryptedData, 0600)
-	if err != nil {
-		s.slogger.Error("Error writing encrypted auth token", "err", err)
-		return
+	err = s.db.SpotifyToken.Create().SetName(tokenName).SetToken(encryptedData).Exec(ctx)
+	if ent.IsConstraintError(err) {
+		// Another instance stored a token first, this one is newer.
+		return s.db.SpotifyToken.Update().
+			Where(spotifytoken.Name(tokenName)).
+			SetToken(encryptedData).
+			Exec(ctx)
 	}
+
+	return err
 }
 
-func (s *Service) loadToken() {
-	tokenPath := filepath.Join(s.storageDir, tokenFile)
+func (s *Service) deleteToken(ctx context.Context) error {
+	_, err := s.db.SpotifyToken.Delete().Where(spotifytoken.Name(tokenName)).Exec(ctx)
+	return err
+}
 
-	_, err := os.Stat(tokenPath)
+// loadToken returns the stored token or nil if no account is signed in.
+func (s *Service) loadToken(ctx context.Context) (*AuthToken, error) {
+	stored, err := s.db.SpotifyToken.Query().Where(spotifytoken.Name(tokenName)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return s.importTokenFile(ctx)
+	}
 	if err != nil {
-		return
+		return nil, err
 	}
 
-	encryptedData, err := os.ReadFile(tokenPath)
+	decryptedData, err := s.decryptToken(stored.Token)
 	if err != nil {
-		if !os.IsNotExist(err) {
-			s.slogger.Error("Error reading encrypted auth token", "err", err)
-		}
-		return
+		return nil, fmt.Errorf("decrypting auth token: %w", err)
+	}
+
+	var token AuthToken
+	err = json.Unmarshal(decryptedData, &token)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshaling auth token: %w", err)
 	}
 
-	assert.NotEqual(0, len(encryptedData), "token file exists but is empty")
+	assert.NotEqual("", token.AccessToken, "AccessToken should not be empty")
+	assert.NotEqual("", token.RefreshToken, "RefreshToken should not be empty")
+
+	return &token, nil
+}
+
+// importTokenFile moves a token that an older version stored on disk into
+// the database. It returns nil if there is none.
+func (s *Service) importTokenFile(ctx context.Context) (*AuthToken, error) {
+	tokenPath := filepath.Join(s.storageDir, tokenFile)
+
+	encryptedData, err := os.ReadFile(tokenPath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading encrypted auth token: %w", err)
+	}
 
 	decryptedData, err := s.decryptToken(encryptedData)
 	if err != nil {
-		s.slogger.Error("Error decrypting auth token", "err", err)
-		return
+		return nil, fmt.Errorf("decrypting auth token: %w", err)
 	}
 
 	var token AuthToken
 	err = json.Unmarshal(decryptedData, &token)
 	if err != nil {
-		s.slogger.Error("Error unmarshaling auth token", "err", err)
-		return
+		return nil, fmt.Errorf("unmarshaling auth token: %w", err)
 	}
 
-	assert.NotEqual("", token.AccessToken, "AccessToken should not be empty")
-	assert.NotNil(token.ExpiresAt, "ExpiresAt should not be nil")
-	assert.NotEqual("", token.RefreshToken, "RefreshToken should not be empty")
+	if err := s.saveToken(ctx, &token); err != nil {
+		return nil, err
+	}
+
+	if err := os.Remove(tokenPath); err != nil {
+		s.slogger.Error("Error deleting imported auth token", "err", err)
+	}
+
+	s.slogger.Info("Moved the auth token from disk into the database")
 
-	tokenMutex.Lock()
-	authToken = &token
-	tokenMutex.Unlock()
+	return &token, nil
 }
 
 func (s *Service) encryptToken(data []byte) ([]byte, error) {
@@ -0,0 +1,319 @@
+package spotify
+
+import (
+	"beyerleinf/spotify-backup/ent"
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/schema/schematype"
+	"beyerleinf/spotify-backup/pkg/service/lock"
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"sync/atomic"
+	"time"
+)
+
+const createBatchSize = 100
+
+// A collection is a part of the user's library that is backed up on its own.
+// Its backup function returns the number of items it backed up.
+type collection struct {
+	name   string
+	backup func(ctx context.Context, run *ent.BackupRun) (int, error)
+}
+
+func (s *Service) collections() []collection {
+	return []collection{
+		{name: "playlists", backup: s.backupPlaylists},
+		{name: "saved_tracks", backup: s.backupSavedTracks},
+		{name: "saved_albums", backup: s.backupSavedAlbums},
+		{name: "saved_shows", backup: s.backupSavedShows},
+		{name: "saved_episodes", backup: s.backupSavedEpisodes},
+		{name: "saved_audiobooks", backup: s.backupSavedAudiobooks},
+		{name: "followed_artists", backup: s.backupFollowedArtists},
+	}
+}
+
+// CollectionNames returns the names of all collections in the order they are backed up.
+func (s *Service) CollectionNames() []string {
+	collections := s.collections()
+
+	names := make([]string, 0, len(collections))
+	for _, c := range collections {
+		names = append(names, c.name)
+	}
+
+	return names
+}
+
+// BackupRuns returns the most recent backup runs, newest first.
+func (s *Service) BackupRuns(ctx context.Context, limit int) ([]*ent.BackupRun, error) {
+	return s.db.BackupRun.Query().
+		Order(ent.Desc(backuprun.FieldStartedAt)).
+		Limit(limit).
+		All(ctx)
+}
+
+// GetBackupRun returns a backup run including the playlist snapshots it produced.
+func (s *Service) GetBackupRun(ctx context.Context, id string) (*ent.BackupRun, error) {
+	return s.db.BackupRun.Query().
+		Where(backuprun.ID(id)).
+		WithPlaylistSnapshots(func(q *ent.PlaylistSnapshotQuery) {
+			q.WithPlaylist()
+		}).
+		Only(ctx)
+}
+
+// Backup backs up the current user's library. All backed up data is
+// attached to a new [ent.BackupRun] which is returned once it finished.
+//
+// A collection that fails to back up doesn't stop the others. The result of
+// every collection is recorded on the run and the errors are returned joined.
+// Only one backup of a user runs at a time across all server instances,
+// otherwise [ErrBackupInProgress] is returned.
+func (s *Service) Backup(ctx context.Context, trigger backuprun.Trigger) (*ent.BackupRun, error) {
+	return s.backup(ctx, trigger, "", nil)
+}
+
+// BackupUser backs up the library of the given user like [Service.Backup].
+// It fails with a [UserMismatchError] if a different user is authenticated with Spotify.
+func (s *Service) BackupUser(ctx context.Context, userID string, trigger backuprun.Trigger) (*ent.BackupRun, error) {
+	return s.backup(ctx, trigger, userID, nil)
+}
+
+// backup backs up the library of the authenticated user, who has to be the
+// user with the given ID unless it is empty. job is the job the backup runs
+// as, if any.
+func (s *Service) backup(ctx context.Context, trigger backuprun.Trigger, userID string, job *ent.Job) (*ent.BackupRun, error) {
+	// The run is kept up to date even if the backup is canceled.
+	record := context.WithoutCancel(ctx)
+
+	var calls atomic.Int64
+	profile, err := s.client.CurrentUser(countAPICalls(ctx, &calls))
+	if err == nil && userID != "" && profile.ID != userID {
+		err = &UserMismatchError{UserID: userID, AuthenticatedUserID: profile.ID}
+	}
+
+	// The run is recorded even though nothing was backed up, so the failure shows up in the history.
+	if err != nil {
+		run, createErr := s.db.BackupRun.Create().SetTrigger(trigger).SetUserID(userID).Save(record)
+		if createErr != nil {
+			return nil, errors.Join(err, createErr)
+		}
+
+		return s.finishBackup(ctx, run, int(calls.Load()), err)
+	}
+
+	// The lock is held in the database, so a backup started on one instance
+	// excludes backups of the same user started on any other instance.
+	unlock, err := s.locker.TryLock(ctx, lock.Backup(profile.ID))
+	if errors.Is(err, lock.ErrLocked) {
+		return nil, ErrBackupInProgress
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	// Pruning waits until no backup is running, so it doesn't delete catalog
+	// entries that this backup looked up but didn't refer to yet.
+	runlock, err := s.locker.RLock(ctx, lock.Catalog)
+	if err != nil {
+		return nil, err
+	}
+	defer runlock()
+
+	run, err := s.resumableRun(ctx, profile.ID, job)
+	if err != nil {
+		return nil, err
+	}
+
+	if run != nil {
+		run, err = run.Update().
+			SetStatus(backuprun.StatusRunning).
+			SetError("").
+			ClearFinishedAt().
+			AddResumes(1).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		calls.Add(int64(run.APICalls))
+		s.slogger.Info("Resuming backup", "run", run.ID, "user", profile.ID, "trigger", trigger, "resumes", run.Resumes)
+	} else {
+		create := s.db.BackupRun.Create().SetTrigger(trigger).SetUserID(profile.ID)
+		if job != nil {
+			create.SetJobID(job.ID)
+		}
+
+		run, err = create.Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		// Runs that were interrupted too long ago to be resumed would otherwise stay running forever.
+		err = s.db.BackupRun.Update().
+			Where(backuprun.UserID(profile.ID), backuprun.StatusEQ(backuprun.StatusRunning), backuprun.IDNEQ(run.ID)).
+			SetStatus(backuprun.StatusFailed).
+			SetError("the backup was interrupted").
+			SetFinishedAt(time.Now()).
+			Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		s.slogger.Info("Starting backup", "run", run.ID, "user", profile.ID, "trigger", trigger)
+	}
+
+	results := maps.Clone(run.Collections)
+	if results == nil {
+		results = map[string]schematype.CollectionResult{}
+	}
+	var errs []error
+	for _, c := range s.collections() {
+		// A resumed run only backs up the collections that failed.
+		if result, ok := results[c.name]; ok && result.Error == "" {
+			s.slogger.Verbose("Collection is already backed up", "run", run.ID, "collection", c.name)
+			continue
+		}
+
+		var collectionCalls atomic.Int64
+		count, backupErr := c.backup(countAPICalls(ctx, &collectionCalls), run)
+
+		result := schematype.CollectionResult{Count: count, APICalls: int(collectionCalls.Load())}
+		calls.Add(collectionCalls.Load())
+
+		if backupErr != nil {
+			s.slogger.Error("Failed to back up collection", "run", run.ID, "collection", c.name, "err", backupErr)
+
+			result.Error = backupErr.Error()
+			errs = append(errs, backupErr)
+		}
+
+		results[c.name] = result
+
+		run, err = run.Update().SetCollections(results).SetAPICalls(int(calls.Load())).Save(record)
+		if err != nil {
+			return run, err
+		}
+
+		// Without a valid token every other collection would fail as well.
+		// A canceled backup stops right away.
+		var unauthenticated *UnauthenticatedError
+		if errors.As(backupErr, &unauthenticated) || ctx.Err() != nil {
+			break
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		errs = append(errs, err)
+	}
+
+	return s.finishBackup(ctx, run, int(calls.Load()), errors.Join(errs...))
+}
+
+// resumableRun returns the run to resume, or nil if a new run has to be
+// started. A run that is still marked as running was interrupted, e.g. by a
+// crash, since only one backup of a user runs at a time, so it is resumed.
+// A run that finished without succeeding is only resumed when the job that
+// started it runs again, whether it is retried or was interrupted by a
+// shutdown, which doesn't count as an attempt. Either is resumed only within
+// the resume window.
+func (s *Service) resumableRun(ctx context.Context, userID string, job *ent.Job) (*ent.BackupRun, error) {
+	if s.config.Backup.ResumeWindow <= 0 {
+		return nil, nil
+	}
+
+	resumable := backuprun.StatusEQ(backuprun.StatusRunning)
+	if job != nil {
+		resumable = backuprun.Or(resumable, backuprun.And(backuprun.JobID(job.ID), backuprun.StatusNEQ(backuprun.StatusSucceeded)))
+	}
+
+	run, err := s.db.BackupRun.Query().
+		Where(
+			backuprun.UserID(userID),
+			backuprun.StartedAtGT(time.Now().Add(-s.config.Backup.ResumeWindow)),
+			resumable,
+		).
+		Order(ent.Desc(backuprun.FieldStartedAt)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+
+	return run, err
+}
+
+// finishBackup records the outcome of a run. If backupErr is not nil, it is
+// returned together with any error that occurred while saving the run.
+func (s *Service) finishBackup(ctx context.Context, run *ent.BackupRun, apiCalls int, backupErr error) (*ent.BackupRun, error) {
+	// The outcome is recorded even if the backup was canceled.
+	ctx = context.WithoutCancel(ctx)
+
+	status := backuprun.StatusSucceeded
+
+	succeeded := 0
+	for _, result := range run.Collections {
+		if result.Error == "" {
+			succeeded++
+		}
+	}
+
+	switch {
+	case backupErr == nil:
+	case succeeded > 0:
+		status = backuprun.StatusPartial
+	default:
+		status = backuprun.StatusFailed
+	}
+
+	update := run.Update().
+		SetStatus(status).
+		SetAPICalls(apiCalls).
+		SetFinishedAt(time.Now())
+
+	if backupErr != nil {
+		update.SetError(backupErr.Error())
+	} else {
+		update.SetError("")
+	}
+
+	run, err := update.Save(ctx)
+	if err != nil {
+		return run, errors.Join(backupErr, err)
+	}
+
+	s.slogger.Info("Finished backup", "run", run.ID, "status", run.Status, "api_calls", run.APICalls,
+		"duration", run.FinishedAt.Sub(run.StartedAt))
+
+	return run, backupErr
+}
+
+// A bulkCreator is an ent client that can create entities in bulk.
+type bulkCreator[C any, B interface{ Exec(context.Context) error }] interface {
+	Create() C
+	CreateBulk(builders ...C) B
+}
+
+// createInBatches creates one entity per item using set to populate the
+// builder. The entities are inserted in batches to keep the number of
+// query parameters within the database's limits.
+func createInBatches[T any, C any, B interface{ Exec(context.Context) error }](
+	ctx context.Context, client bulkCreator[C, B], items []T, set func(C, T),
+) error {
+	for batch := range slices.Chunk(items, createBatchSize) {
+		builders := make([]C, 0, len(batch))
+		for _, item := range batch {
+			builder := client.Create()
+			set(builder, item)
+			builders = append(builders, builder)
+		}
+
+		if err := client.CreateBulk(builders...).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,286 @@
+package spotify
+
+import (
+	"beyerleinf/spotify-backup/ent"
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/playlist"
+	"beyerleinf/spotify-backup/ent/playlistsnapshot"
+	"beyerleinf/spotify-backup/ent/snapshotitem"
+	"beyerleinf/spotify-backup/pkg/fakespotify"
+	"context"
+	"net/http"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// backup backs up the library and fails the test if that fails.
+func backup(ctx context.Context, t *testing.T, s *Service) *ent.BackupRun {
+	t.Helper()
+
+	run, err := s.Backup(ctx, backuprun.TriggerManual)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return run
+}
+
+// backedUpPlaylists returns the URIs of the items of the playlists a run
+// backed up by the Spotify ID of the playlist.
+func backedUpPlaylists(ctx context.Context, t *testing.T, s *Service, run *ent.BackupRun) map[string][]string {
+	t.Helper()
+
+	snapshots, err := s.db.PlaylistSnapshot.Query().
+		Where(playlistsnapshot.HasRunsWith(backuprun.ID(run.ID))).
+		WithPlaylist().
+		WithItems(func(q *ent.SnapshotItemQuery) { q.Order(ent.Asc(snapshotitem.FieldPosition)) }).
+		All(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	playlists := map[string][]string{}
+	for _, snapshot := range snapshots {
+		if !snapshot.Complete {
+			t.Errorf("snapshot of playlist %s is incomplete", snapshot.Edges.Playlist.SpotifyID)
+		}
+
+		uris := []string{}
+		for _, item := range snapshot.Edges.Items {
+			uris = append(uris, item.URI)
+		}
+
+		playlists[snapshot.Edges.Playlist.SpotifyID] = uris
+	}
+
+	return playlists
+}
+
+// fixturePlaylists returns the URIs of the items of the playlists of a fixture by ID.
+func fixturePlaylists(f *fakespotify.Fixture) map[string][]string {
+	playlists := map[string][]string{}
+	for _, p := range f.Playlists {
+		uris := []string{}
+		for _, item := range p.Items {
+			uris = append(uris, item.URI)
+		}
+
+		playlists[p.ID] = uris
+	}
+
+	return playlists
+}
+
+func TestBackup(t *testing.T) {
+	tests := []struct {
+		name string
+		opts fakespotify.Options
+	}{
+		{"all at once", fakespotify.Options{}},
+		// Two items per page need a dozen pages for the longest playlist.
+		{"paged", fakespotify.Options{MaxPageSize: 2}},
+		// Retry-After is 0 seconds, so retrying doesn't slow the test down.
+		{"rate limited", fakespotify.Options{MaxPageSize: 2, RateLimitEvery: 3}},
+	}
+
+	wantCounts := map[string]int{
+		"playlists":        3,
+		"saved_tracks":     18,
+		"saved_albums":     3,
+		"saved_shows":      2,
+		"saved_episodes":   3,
+		"saved_audiobooks": 1,
+		"followed_artists": 6,
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			s, _ := newService(t, tt.opts)
+
+			run := backup(ctx, t, s)
+			if run.Status != backuprun.StatusSucceeded {
+				t.Errorf("Backup() status = %s, want %s", run.Status, backuprun.StatusSucceeded)
+			}
+
+			for name, want := range wantCounts {
+				if got := run.Collections[name]; got.Count != want || got.Error != "" {
+					t.Errorf("Backup() %s = %d items, error %q, want %d items", name, got.Count, got.Error, want)
+				}
+			}
+
+			got := backedUpPlaylists(ctx, t, s, run)
+			want := fixturePlaylists(fakespotify.DefaultFixture())
+			if len(got) != len(want) {
+				t.Errorf("Backup() backed up %d playlists, want %d", len(got), len(want))
+			}
+
+			for id, want := range want {
+				if !slices.Equal(got[id], want) {
+					t.Errorf("Backup() playlist %s = %v, want %v", id, got[id], want)
+				}
+			}
+		})
+	}
+}
+
+func TestBackupReusesUnchangedPlaylists(t *testing.T) {
+	ctx := context.Background()
+	s, fake := newService(t, fakespotify.Options{MaxPageSize: 2})
+
+	first := backup(ctx, t, s)
+
+	// Podcasts & Chill changes, which gives it a new snapshot_id.
+	fake.Update(func(f *fakespotify.Fixture) {
+		p := &f.Playlists[1]
+		p.Items = p.Items[1:]
+		p.SnapshotID = "changed"
+	})
+
+	second := backup(ctx, t, s)
+
+	tests := []struct {
+		playlistID string
+		reused     bool
+	}{
+		{"pl00000123456789abcdef", true},
+		{"pl00010123456789abcdef", false},
+		{"pl00020123456789abcdef", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.playlistID, func(t *testing.T) {
+			snapshots, err := s.db.PlaylistSnapshot.Query().
+				Where(playlistsnapshot.HasPlaylistWith(playlist.SpotifyID(tt.playlistID))).
+				WithRuns().
+				All(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			wantSnapshots := 2
+			if tt.reused {
+				wantSnapshots = 1
+			}
+
+			if len(snapshots) != wantSnapshots {
+				t.Fatalf("Backup() stored %d snapshots, want %d", len(snapshots), wantSnapshots)
+			}
+
+			if tt.reused && len(snapshots[0].Edges.Runs) != 2 {
+				t.Errorf("Backup() linked the snapshot to %d runs, want 2", len(snapshots[0].Edges.Runs))
+			}
+		})
+	}
+
+	if got := backedUpPlaylists(ctx, t, s, second)["pl00010123456789abcdef"]; len(got) != 3 {
+		t.Errorf("Backup() backed up %d items of the changed playlist, want 3", len(got))
+	}
+
+	// Only the changed playlist's items are requested again.
+	if got, was := second.Collections["playlists"].APICalls, first.Collections["playlists"].APICalls; got >= was {
+		t.Errorf("Backup() made %d requests for playlists, want fewer than the %d of the first backup", got, was)
+	}
+}
+
+func TestBackupRecordsPartialFailure(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newService(t, fakespotify.Options{FailPaths: []string{"/v1/me/albums"}})
+
+	run, err := s.Backup(ctx, backuprun.TriggerManual)
+	if err == nil {
+		t.Fatal("Backup() = nil, want error")
+	}
+
+	if run.Status != backuprun.StatusPartial {
+		t.Errorf("Backup() status = %s, want %s", run.Status, backuprun.StatusPartial)
+	}
+
+	for _, name := range s.CollectionNames() {
+		result, ok := run.Collections[name]
+		if !ok {
+			t.Errorf("Backup() didn't back up %s", name)
+			continue
+		}
+
+		if failed := result.Error != ""; failed != (name == "saved_albums") {
+			t.Errorf("Backup() %s error = %q", name, result.Error)
+		}
+	}
+
+	if !slices.Contains(s.RestorableCollections(run), "saved_tracks") || slices.Contains(s.RestorableCollections(run), "saved_albums") {
+		t.Errorf("RestorableCollections() = %v, want the collections that were backed up", s.RestorableCollections(run))
+	}
+}
+
+func TestBackupJobResumesAfterShutdown(t *testing.T) {
+	ctx, shutdown := context.WithCancel(context.Background())
+	defer shutdown()
+
+	roadTrip := fakespotify.DefaultFixture().Playlists[0].ID
+
+	// The worker shuts down while the third page of Road Trip is requested.
+	// The offsets of the pages requested afterwards show where it resumes.
+	var (
+		mu      sync.Mutex
+		offsets []int
+	)
+	fake := fakespotify.New(fakespotify.DefaultFixture(), fakespotify.Options{MaxPageSize: 2})
+	s := signIn(t, fake, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/playlists/"+roadTrip+"/tracks" {
+			offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+
+			mu.Lock()
+			if ctx.Err() != nil {
+				offsets = append(offsets, offset)
+			} else if offset == 4 {
+				shutdown()
+			}
+			mu.Unlock()
+		}
+
+		fake.ServeHTTP(w, r)
+	}))
+	s.config.Backup.ResumeWindow = time.Hour
+
+	j, err := s.EnqueueBackup(context.Background(), fakeUserID, backuprun.TriggerManual)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The job is claimed, interrupted and claimed again. Being interrupted by
+	// a shutdown doesn't count as an attempt, so it runs as the first attempt twice.
+	j.Attempts = 1
+
+	if _, err := s.RunBackupJob(ctx, j); err == nil {
+		t.Fatal("RunBackupJob() = nil, want error")
+	}
+
+	if _, err := s.RunBackupJob(context.Background(), j); err != nil {
+		t.Fatal(err)
+	}
+
+	runs, err := s.db.BackupRun.Query().All(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(runs) != 1 {
+		t.Fatalf("RunBackupJob() made %d runs, want the interrupted run to be resumed", len(runs))
+	}
+
+	if run := runs[0]; run.Status != backuprun.StatusSucceeded || run.Resumes != 1 {
+		t.Errorf("RunBackupJob() = %s run resumed %d times, want %s run resumed once", run.Status, run.Resumes, backuprun.StatusSucceeded)
+	}
+
+	if len(offsets) == 0 || offsets[0] == 0 {
+		t.Errorf("RunBackupJob() requested Road Trip at offsets %v after resuming, want to continue after the last checkpoint", offsets)
+	}
+
+	if got, want := backedUpPlaylists(context.Background(), t, s, runs[0])[roadTrip], fixturePlaylists(fakespotify.DefaultFixture())[roadTrip]; !slices.Equal(got, want) {
+		t.Errorf("RunBackupJob() backed up Road Trip as %v, want %v", got, want)
+	}
+}
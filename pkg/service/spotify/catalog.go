@@ -0,0 +1,415 @@
+package spotify
+
+import (
+	"beyerleinf/spotify-backup/ent"
+	"beyerleinf/spotify-backup/ent/album"
+	"beyerleinf/spotify-backup/ent/artist"
+	"beyerleinf/spotify-backup/ent/episode"
+	"beyerleinf/spotify-backup/ent/show"
+	"beyerleinf/spotify-backup/ent/track"
+	"context"
+	"slices"
+)
+
+const lookupBatchSize = 500
+
+// A catalog stores tracks, episodes and their artists, albums and shows,
+// so their metadata is stored only once no matter how many snapshots
+// reference them. It maps Spotify IDs to entity IDs and caches every
+// entity it has seen, so each object is looked up at most once per
+// transaction.
+type catalog struct {
+	tx       *ent.Tx
+	artists  map[string]string
+	albums   map[string]string
+	tracks   map[string]string
+	shows    map[string]string
+	episodes map[string]string
+}
+
+// catalogRef maps a Spotify ID to the ID of the entity storing the object.
+type catalogRef struct {
+	ID        string `json:"id"`
+	SpotifyID string `json:"spotify_id"`
+}
+
+func newCatalog(tx *ent.Tx) *catalog {
+	return &catalog{
+		tx:       tx,
+		artists:  map[string]string{},
+		albums:   map[string]string{},
+		tracks:   map[string]string{},
+		shows:    map[string]string{},
+		episodes: map[string]string{},
+	}
+}
+
+// addArtists stores all artists that are not in the catalog yet.
+// Artists are often stored from the simplified artist of a track first, which
+// lacks genres, so those are updated once the full artist is seen.
+func (c *catalog) addArtists(ctx context.Context, artists []Artist) error {
+	byID := make(map[string]Artist, len(artists))
+	for _, a := range artists {
+		byID[a.ID] = a
+	}
+
+	var existing []string
+	missing, err := pending(ctx, c.artists, byID, func(ctx context.Context, ids []string, refs *[]catalogRef) error {
+		err := c.tx.Artist.Query().
+			Where(artist.SpotifyIDIn(ids...)).
+			Select(artist.FieldID, artist.FieldSpotifyID).
+			Scan(ctx, refs)
+
+		for _, ref := range *refs {
+			existing = append(existing, ref.SpotifyID)
+		}
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, spotifyID := range existing {
+		if a := byID[spotifyID]; len(a.Genres) > 0 {
+			err := c.tx.Artist.UpdateOneID(c.artists[spotifyID]).SetGenres(a.Genres).Exec(ctx)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return createInBatches(ctx, c.tx.Artist, missing, func(create *ent.ArtistCreate, spotifyID string) {
+		a := byID[spotifyID]
+
+		id := artist.DefaultID()
+		c.artists[spotifyID] = id
+
+		create.
+			SetID(id).
+			SetSpotifyID(a.ID).
+			SetURI(a.URI).
+			SetName(a.Name).
+			SetGenres(a.Genres)
+	})
+}
+
+// addAlbums stores all albums and their artists that are not in the catalog yet.
+// Albums are often stored from the simplified album of a track first, which lacks
+// a UPC and label, so those are added once the full album is seen.
+func (c *catalog) addAlbums(ctx context.Context, albums []Album) error {
+	byID := make(map[string]Album, len(albums))
+	var artists []Artist
+	for _, a := range albums {
+		byID[a.ID] = a
+		artists = append(artists, simplifiedArtists(a.Artists)...)
+	}
+
+	if err := c.addArtists(ctx, artists); err != nil {
+		return err
+	}
+
+	var incomplete []string
+	missing, err := pending(ctx, c.albums, byID, func(ctx context.Context, ids []string, refs *[]catalogRef) error {
+		var rows []struct {
+			ID        string `json:"id"`
+			SpotifyID string `json:"spotify_id"`
+			UPC       string `json:"upc"`
+		}
+
+		err := c.tx.Album.Query().
+			Where(album.SpotifyIDIn(ids...)).
+			Select(album.FieldID, album.FieldSpotifyID, album.FieldUpc).
+			Scan(ctx, &rows)
+
+		for _, row := range rows {
+			*refs = append(*refs, catalogRef{ID: row.ID, SpotifyID: row.SpotifyID})
+
+			if row.UPC == "" && byID[row.SpotifyID].ExternalIDs.UPC != "" {
+				incomplete = append(incomplete, row.SpotifyID)
+			}
+		}
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, spotifyID := range incomplete {
+		a := byID[spotifyID]
+
+		err := c.tx.Album.UpdateOneID(c.albums[spotifyID]).
+			SetUpc(a.ExternalIDs.UPC).
+			SetLabel(a.Label).
+			SetTotalTracks(a.TotalTracks).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return createInBatches(ctx, c.tx.Album, missing, func(create *ent.AlbumCreate, spotifyID string) {
+		a := byID[spotifyID]
+
+		id := album.DefaultID()
+		c.albums[spotifyID] = id
+
+		create.
+			SetID(id).
+			SetSpotifyID(a.ID).
+			SetURI(a.URI).
+			SetName(a.Name).
+			SetAlbumType(a.AlbumType).
+			SetReleaseDate(a.ReleaseDate).
+			SetTotalTracks(a.TotalTracks).
+			SetLabel(a.Label).
+			SetUpc(a.ExternalIDs.UPC).
+			AddArtistIDs(c.artistIDs(a.Artists)...)
+	})
+}
+
+// addTracks stores all tracks and their albums and artists that are not in the catalog yet.
+// Local files are skipped as they have no Spotify ID. Tracks that were migrated from
+// backups made before the catalog existed lack their album and artists, so those are
+// added once the track is seen again.
+func (c *catalog) addTracks(ctx context.Context, tracks []Track) error {
+	byID := make(map[string]Track, len(tracks))
+	var albums []Album
+	var artists []Artist
+	for _, t := range tracks {
+		if t.IsLocal || t.ID == "" {
+			continue
+		}
+
+		byID[t.ID] = t
+		albums = append(albums, Album{SimplifiedAlbum: t.Album})
+		artists = append(artists, simplifiedArtists(t.Artists)...)
+	}
+
+	if err := c.addAlbums(ctx, albums); err != nil {
+		return err
+	}
+
+	if err := c.addArtists(ctx, artists); err != nil {
+		return err
+	}
+
+	var incomplete []string
+	missing, err := pending(ctx, c.tracks, byID, func(ctx context.Context, ids []string, refs *[]catalogRef) error {
+		err := c.tx.Track.Query().
+			Where(track.SpotifyIDIn(ids...)).
+			Select(track.FieldID, track.FieldSpotifyID).
+			Scan(ctx, refs)
+		if err != nil {
+			return err
+		}
+
+		ids, err = c.tx.Track.Query().
+			Where(track.SpotifyIDIn(ids...), track.Not(track.HasAlbum())).
+			Select(track.FieldSpotifyID).
+			Strings(ctx)
+		incomplete = append(incomplete, ids...)
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, spotifyID := range incomplete {
+		t := byID[spotifyID]
+
+		albumID, ok := c.albums[t.Album.ID]
+		if !ok {
+			continue
+		}
+
+		err := c.tx.Track.UpdateOneID(c.tracks[spotifyID]).
+			SetAlbumID(albumID).
+			AddArtistIDs(c.artistIDs(t.Artists)...).
+			SetDiscNumber(t.DiscNumber).
+			SetTrackNumber(t.TrackNumber).
+			SetIsrc(t.ExternalIDs.ISRC).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return createInBatches(ctx, c.tx.Track, missing, func(create *ent.TrackCreate, spotifyID string) {
+		t := byID[spotifyID]
+
+		id := track.DefaultID()
+		c.tracks[spotifyID] = id
+
+		create.
+			SetID(id).
+			SetSpotifyID(t.ID).
+			SetURI(t.URI).
+			SetName(t.Name).
+			SetDurationMs(t.DurationMS).
+			SetExplicit(t.Explicit).
+			SetDiscNumber(t.DiscNumber).
+			SetTrackNumber(t.TrackNumber).
+			SetIsrc(t.ExternalIDs.ISRC).
+			AddArtistIDs(c.artistIDs(t.Artists)...)
+
+		if albumID, ok := c.albums[t.Album.ID]; ok {
+			create.SetAlbumID(albumID)
+		}
+	})
+}
+
+// addShows stores all shows that are not in the catalog yet.
+func (c *catalog) addShows(ctx context.Context, shows []SimplifiedShow) error {
+	byID := make(map[string]SimplifiedShow, len(shows))
+	for _, s := range shows {
+		byID[s.ID] = s
+	}
+
+	missing, err := pending(ctx, c.shows, byID, func(ctx context.Context, ids []string, refs *[]catalogRef) error {
+		return c.tx.Show.Query().
+			Where(show.SpotifyIDIn(ids...)).
+			Select(show.FieldID, show.FieldSpotifyID).
+			Scan(ctx, refs)
+	})
+	if err != nil {
+		return err
+	}
+
+	return createInBatches(ctx, c.tx.Show, missing, func(create *ent.ShowCreate, spotifyID string) {
+		s := byID[spotifyID]
+
+		id := show.DefaultID()
+		c.shows[spotifyID] = id
+
+		create.
+			SetID(id).
+			SetSpotifyID(s.ID).
+			SetURI(s.URI).
+			SetName(s.Name).
+			SetPublisher(s.Publisher)
+	})
+}
+
+// addEpisodes stores all episodes and their shows that are not in the catalog yet.
+// Episodes that were migrated from backups made before the catalog existed lack
+// their show, so it is added once the episode is seen again.
+func (c *catalog) addEpisodes(ctx context.Context, episodes []Episode) error {
+	byID := make(map[string]Episode, len(episodes))
+	var shows []SimplifiedShow
+	for _, e := range episodes {
+		byID[e.ID] = e
+		shows = append(shows, e.Show)
+	}
+
+	if err := c.addShows(ctx, shows); err != nil {
+		return err
+	}
+
+	var incomplete []string
+	missing, err := pending(ctx, c.episodes, byID, func(ctx context.Context, ids []string, refs *[]catalogRef) error {
+		err := c.tx.Episode.Query().
+			Where(episode.SpotifyIDIn(ids...)).
+			Select(episode.FieldID, episode.FieldSpotifyID).
+			Scan(ctx, refs)
+		if err != nil {
+			return err
+		}
+
+		ids, err = c.tx.Episode.Query().
+			Where(episode.SpotifyIDIn(ids...), episode.Not(episode.HasShow())).
+			Select(episode.FieldSpotifyID).
+			Strings(ctx)
+		incomplete = append(incomplete, ids...)
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, spotifyID := range incomplete {
+		showID, ok := c.shows[byID[spotifyID].Show.ID]
+		if !ok {
+			continue
+		}
+
+		if err := c.tx.Episode.UpdateOneID(c.episodes[spotifyID]).SetShowID(showID).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	return createInBatches(ctx, c.tx.Episode, missing, func(create *ent.EpisodeCreate, spotifyID string) {
+		e := byID[spotifyID]
+
+		id := episode.DefaultID()
+		c.episodes[spotifyID] = id
+
+		create.
+			SetID(id).
+			SetSpotifyID(e.ID).
+			SetURI(e.URI).
+			SetName(e.Name).
+			SetDurationMs(e.DurationMS).
+			SetExplicit(e.Explicit).
+			SetReleaseDate(e.ReleaseDate)
+
+		if showID, ok := c.shows[e.Show.ID]; ok {
+			create.SetShowID(showID)
+		}
+	})
+}
+
+func (c *catalog) artistIDs(artists []SimplifiedArtist) []string {
+	ids := make([]string, 0, len(artists))
+	for _, a := range artists {
+		if id, ok := c.artists[a.ID]; ok && !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+// pending returns the Spotify IDs of objects that are not stored yet. The IDs
+// of objects that are already stored are looked up using lookup and cached.
+func pending[T any](
+	ctx context.Context, cache map[string]string, objects map[string]T,
+	lookup func(ctx context.Context, ids []string, refs *[]catalogRef) error,
+) ([]string, error) {
+	var unknown []string
+	for id := range objects {
+		if _, ok := cache[id]; !ok && id != "" {
+			unknown = append(unknown, id)
+		}
+	}
+
+	slices.Sort(unknown)
+
+	for batch := range slices.Chunk(unknown, lookupBatchSize) {
+		var refs []catalogRef
+		if err := lookup(ctx, batch, &refs); err != nil {
+			return nil, err
+		}
+
+		for _, ref := range refs {
+			cache[ref.SpotifyID] = ref.ID
+		}
+	}
+
+	return slices.DeleteFunc(unknown, func(id string) bool {
+		_, ok := cache[id]
+		return ok
+	}), nil
+}
+
+func simplifiedArtists(artists []SimplifiedArtist) []Artist {
+	result := make([]Artist, 0, len(artists))
+	for _, a := range artists {
+		result = append(result, Artist{ID: a.ID, Name: a.Name, URI: a.URI})
+	}
+
+	return result
+}
@@ -0,0 +1,113 @@
+package spotify
+
+import (
+	"beyerleinf/spotify-backup/ent"
+	"beyerleinf/spotify-backup/ent/playlistsnapshot"
+	"beyerleinf/spotify-backup/ent/schema/schematype"
+	"beyerleinf/spotify-backup/ent/snapshotitem"
+	"beyerleinf/spotify-backup/pkg/util"
+	"context"
+	"maps"
+	"slices"
+	"sync"
+)
+
+// playlistProgress keeps the checkpoint of a run up to date while its playlists are backed up.
+type playlistProgress struct {
+	mu         sync.Mutex
+	run        *ent.BackupRun
+	checkpoint schematype.Checkpoint
+}
+
+func newPlaylistProgress(run *ent.BackupRun) *playlistProgress {
+	playlists := maps.Clone(run.Checkpoint.Playlists)
+	if playlists == nil {
+		playlists = map[string]schematype.PlaylistCheckpoint{}
+	}
+
+	return &playlistProgress{
+		run:        run,
+		checkpoint: schematype.Checkpoint{Playlists: playlists},
+	}
+}
+
+// get returns the checkpoint of a playlist if it has been backed up partially.
+func (p *playlistProgress) get(playlistID string) (schematype.PlaylistCheckpoint, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	cp, ok := p.checkpoint.Playlists[playlistID]
+
+	return cp, ok
+}
+
+// commit runs fn and saves the checkpoint of a playlist in a single transaction,
+// so the checkpoint never gets ahead of the items it refers to. fn may update cp
+// before it is saved and a nil cp removes the checkpoint once the playlist is
+// complete.
+//
+// Commits are serialized. Besides keeping the checkpoint consistent, this keeps
+// workers that fetch playlists concurrently from adding the same tracks to the
+// catalog at the same time.
+func (p *playlistProgress) commit(
+	ctx context.Context, db *ent.Client, playlistID string, cp *schematype.PlaylistCheckpoint, fn func(tx *ent.Tx) error,
+) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	playlists := maps.Clone(p.checkpoint.Playlists)
+
+	err := util.WithTx(ctx, db, func(tx *ent.Tx) error {
+		if err := fn(tx); err != nil {
+			return err
+		}
+
+		if cp != nil {
+			playlists[playlistID] = *cp
+		} else {
+			delete(playlists, playlistID)
+		}
+
+		return tx.BackupRun.UpdateOneID(p.run.ID).
+			SetCheckpoint(schematype.Checkpoint{Playlists: playlists}).
+			Exec(ctx)
+	})
+	if err != nil {
+		return err
+	}
+
+	p.checkpoint.Playlists = playlists
+
+	return nil
+}
+
+// discard deletes the incomplete snapshot of a playlist together with its checkpoint.
+func (p *playlistProgress) discard(ctx context.Context, db *ent.Client, playlistID string) error {
+	cp, ok := p.get(playlistID)
+	if !ok {
+		return nil
+	}
+
+	return p.commit(ctx, db, playlistID, nil, func(tx *ent.Tx) error {
+		_, err := tx.SnapshotItem.Delete().
+			Where(snapshotitem.HasSnapshotWith(playlistsnapshot.ID(cp.Snapshot))).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.PlaylistSnapshot.Delete().
+			Where(playlistsnapshot.ID(cp.Snapshot), playlistsnapshot.Complete(false)).
+			Exec(ctx)
+
+		return err
+	})
+}
+
+// pending returns the IDs of all playlists that have a checkpoint.
+func (p *playlistProgress) pending() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return slices.Collect(maps.Keys(p.checkpoint.Playlists))
+}
@@ -0,0 +1,234 @@
+package spotify
+
+import (
+	"beyerleinf/spotify-backup/pkg/request"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"iter"
+	"maps"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+// A TokenFunc returns a valid access token for Spotify's Web API.
+type TokenFunc func(ctx context.Context) (string, error)
+
+// A Client is a typed client for Spotify's Web API. Paged endpoints are
+// exposed as iterators which request the next page once the items of the
+// current page have been consumed.
+type Client struct {
+	http    *request.Client
+	baseURL string
+	token   TokenFunc
+}
+
+// NewClient creates a [Client] for the Web API at baseURL which sends requests
+// using http and authenticates every request with the access token returned by token.
+func NewClient(baseURL string, http *request.Client, token TokenFunc) *Client {
+	return &Client{
+		http:    http,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		token:   token,
+	}
+}
+
+// Get requests an endpoint and unmarshals the response into v.
+// The path is either relative to the API's base URL or an absolute URL,
+// e.g. the next URL of a paging object.
+func (c *Client) Get(ctx context.Context, path string, query url.Values, v any) error {
+	res, err := c.do(ctx, http.MethodGet, c.url(path, query), nil)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return newAPIError(res)
+	}
+
+	return json.Unmarshal(res.Body, v)
+}
+
+// Send sends body as JSON to an endpoint that modifies the user's library and
+// unmarshals the response into v, unless v is nil or the response is empty.
+func (c *Client) Send(ctx context.Context, method string, path string, body any, v any) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.do(ctx, method, c.url(path, nil), data)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		return newAPIError(res)
+	}
+
+	if v == nil || len(res.Body) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(res.Body, v)
+}
+
+// do sends an authenticated request with an optional JSON body.
+func (c *Client) do(ctx context.Context, method string, url string, body []byte) (*request.Response, error) {
+	token, err := c.token(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	headers := map[string][]string{
+		"Authorization": {"Bearer " + token},
+	}
+
+	var reader io.Reader
+	if body != nil {
+		headers["Content-Type"] = []string{"application/json"}
+		reader = bytes.NewReader(body)
+	}
+
+	if calls, ok := ctx.Value(apiCallsKey{}).(*atomic.Int64); ok {
+		calls.Add(1)
+	}
+
+	return c.http.Do(ctx, method, url, reader, headers)
+}
+
+type apiCallsKey struct{}
+
+// countAPICalls returns a context that counts the Web API requests made with it in calls.
+func countAPICalls(ctx context.Context, calls *atomic.Int64) context.Context {
+	return context.WithValue(ctx, apiCallsKey{}, calls)
+}
+
+func (c *Client) url(path string, query url.Values) string {
+	u := path
+	if !strings.HasPrefix(path, "https://") && !strings.HasPrefix(path, "http://") {
+		u = c.baseURL + path
+	}
+
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	return u
+}
+
+// Paginate iterates over all items of an endpoint that returns a [Paging] object.
+// The iteration stops after the first error.
+// [Pagination]: https://developer.spotify.com/documentation/web-api/concepts/api-calls#pagination
+func Paginate[T any](ctx context.Context, c *Client, path string, query url.Values) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for page, err := range Pages[T](ctx, c, path, query) {
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range page.Items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Pages iterates over the pages of an endpoint that returns a [Paging] object,
+// starting at the offset in query. The iteration stops after the first error.
+func Pages[T any](ctx context.Context, c *Client, path string, query url.Values) iter.Seq2[Paging[T], error] {
+	return func(yield func(Paging[T], error) bool) {
+		next := c.url(path, query)
+		for next != "" {
+			var page Paging[T]
+			if err := c.Get(ctx, next, nil, &page); err != nil {
+				yield(page, err)
+				return
+			}
+
+			if !yield(page, nil) {
+				return
+			}
+
+			next = page.Next
+		}
+	}
+}
+
+// PaginateCursor iterates over all items of an endpoint that returns a [CursorPaging]
+// object. Some endpoints wrap the paging object in another object, in which case key
+// is the name of the property holding it. The iteration stops after the first error.
+func PaginateCursor[T any](ctx context.Context, c *Client, path string, query url.Values, key string) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		query := maps.Clone(query)
+		if query == nil {
+			query = url.Values{}
+		}
+
+		for {
+			page, err := getCursorPage[T](ctx, c, path, query, key)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range page.Items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if page.Next == "" || page.Cursors.After == "" {
+				return
+			}
+
+			query.Set("after", page.Cursors.After)
+		}
+	}
+}
+
+func getCursorPage[T any](ctx context.Context, c *Client, path string, query url.Values, key string) (CursorPaging[T], error) {
+	var page CursorPaging[T]
+
+	if key == "" {
+		err := c.Get(ctx, path, query, &page)
+		return page, err
+	}
+
+	var wrapper map[string]json.RawMessage
+	if err := c.Get(ctx, path, query, &wrapper); err != nil {
+		return page, err
+	}
+
+	err := json.Unmarshal(wrapper[key], &page)
+	return page, err
+}
+
+// Collect gathers all items of seq into a slice. It returns the first error of seq.
+func Collect[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	var items []T
+
+	for item, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// limit returns the query to request pages of the given size.
+func limit(n int) url.Values {
+	return url.Values{"limit": {strconv.Itoa(n)}}
+}
@@ -0,0 +1,407 @@
+package spotify
+
+import (
+	"beyerleinf/spotify-backup/ent"
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/followedartist"
+	"beyerleinf/spotify-backup/ent/playlistsnapshot"
+	"beyerleinf/spotify-backup/ent/savedalbum"
+	"beyerleinf/spotify-backup/ent/savedaudiobook"
+	"beyerleinf/spotify-backup/ent/savedepisode"
+	"beyerleinf/spotify-backup/ent/savedshow"
+	"beyerleinf/spotify-backup/ent/savedtrack"
+	"beyerleinf/spotify-backup/ent/snapshotitem"
+	"beyerleinf/spotify-backup/pkg/diff"
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// collectionTitles are the names of the saved collections shown in diffs.
+var collectionTitles = map[string]string{
+	"saved_tracks":     "Liked Songs",
+	"saved_albums":     "Saved Albums",
+	"saved_shows":      "Saved Shows",
+	"saved_episodes":   "Saved Episodes",
+	"saved_audiobooks": "Saved Audiobooks",
+	"followed_artists": "Followed Artists",
+}
+
+// DiffPlaylistSnapshots compares two playlist snapshots. If fromID is empty,
+// the snapshot is compared to the previous snapshot of the same playlist.
+func (s *Service) DiffPlaylistSnapshots(ctx context.Context, fromID, toID string) (*diff.Diff, error) {
+	to, err := s.db.PlaylistSnapshot.Get(ctx, toID)
+	if err != nil {
+		return nil, err
+	}
+
+	var from *ent.PlaylistSnapshot
+	if fromID != "" {
+		from, err = s.db.PlaylistSnapshot.Get(ctx, fromID)
+	} else {
+		from, err = to.QueryPlaylist().QuerySnapshots().
+			Where(playlistsnapshot.CreatedAtLT(to.CreatedAt), playlistsnapshot.Complete(true)).
+			Order(ent.Desc(playlistsnapshot.FieldCreatedAt)).
+			First(ctx)
+		if ent.IsNotFound(err) {
+			from, err = nil, nil
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var before *diff.Snapshot
+	if from != nil {
+		if before, err = s.playlistDiffSnapshot(ctx, from); err != nil {
+			return nil, err
+		}
+	}
+
+	after, err := s.playlistDiffSnapshot(ctx, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return diff.Compare(before, after), nil
+}
+
+// ErrNoEarlierBackup is returned when a backup is compared to the one before
+// it, but there is no earlier backup.
+var ErrNoEarlierBackup = errors.New("there is no earlier backup to compare with")
+
+// A RunDiff are the changes between two backup runs.
+type RunDiff struct {
+	From  string       `json:"from"`
+	To    string       `json:"to"`
+	Diffs []*diff.Diff `json:"diffs"`
+}
+
+// DiffBackupRuns compares the playlists and saved collections of two backup
+// runs and returns the diffs of everything that changed. Collections that
+// failed to back up in either run are not compared. If fromID is empty, the
+// run is compared to the latest backup of the same user that started before
+// since, or before the run itself if since is zero.
+func (s *Service) DiffBackupRuns(ctx context.Context, fromID, toID string, since time.Time) (*RunDiff, error) {
+	to, err := s.db.BackupRun.Get(ctx, toID)
+	if err != nil {
+		return nil, err
+	}
+
+	var from *ent.BackupRun
+	if fromID != "" {
+		from, err = s.db.BackupRun.Get(ctx, fromID)
+	} else {
+		if since.IsZero() || since.After(to.StartedAt) {
+			since = to.StartedAt
+		}
+
+		from, err = s.db.BackupRun.Query().
+			Where(
+				backuprun.UserID(to.UserID),
+				backuprun.StartedAtLT(since),
+				backuprun.StatusIn(backuprun.StatusSucceeded, backuprun.StatusPartial),
+			).
+			Order(ent.Desc(backuprun.FieldStartedAt)).
+			First(ctx)
+		if ent.IsNotFound(err) {
+			return nil, ErrNoEarlierBackup
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	diffs := []*diff.Diff{}
+
+	if collectionSucceeded(from, "playlists") && collectionSucceeded(to, "playlists") {
+		playlistDiffs, err := s.diffRunPlaylists(ctx, from, to)
+		if err != nil {
+			return nil, err
+		}
+
+		diffs = append(diffs, playlistDiffs...)
+	}
+
+	for _, name := range s.CollectionNames() {
+		if collectionTitles[name] == "" || !collectionSucceeded(from, name) || !collectionSucceeded(to, name) {
+			continue
+		}
+
+		before, err := s.collectionDiffSnapshot(ctx, from, name)
+		if err != nil {
+			return nil, err
+		}
+
+		after, err := s.collectionDiffSnapshot(ctx, to, name)
+		if err != nil {
+			return nil, err
+		}
+
+		if d := diff.Compare(before, after); !d.Empty() {
+			diffs = append(diffs, d)
+		}
+	}
+
+	return &RunDiff{From: from.ID, To: to.ID, Diffs: diffs}, nil
+}
+
+func collectionSucceeded(run *ent.BackupRun, name string) bool {
+	result, ok := run.Collections[name]
+	return ok && result.Error == ""
+}
+
+// diffRunPlaylists compares the playlists of two runs, ordered by name.
+// Snapshots that both runs share are the same, so they are skipped.
+func (s *Service) diffRunPlaylists(ctx context.Context, from, to *ent.BackupRun) ([]*diff.Diff, error) {
+	type pair struct{ before, after *ent.PlaylistSnapshot }
+
+	pairs := map[string]*pair{}
+	for i, run := range []*ent.BackupRun{from, to} {
+		snapshots, err := run.QueryPlaylistSnapshots().WithPlaylist().All(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, snapshot := range snapshots {
+			id := snapshot.Edges.Playlist.ID
+			if pairs[id] == nil {
+				pairs[id] = &pair{}
+			}
+
+			if i == 0 {
+				pairs[id].before = snapshot
+			} else {
+				pairs[id].after = snapshot
+			}
+		}
+	}
+
+	var diffs []*diff.Diff
+	for _, p := range pairs {
+		if p.before != nil && p.after != nil && p.before.ID == p.after.ID {
+			continue
+		}
+
+		var before, after *diff.Snapshot
+		var err error
+		if p.before != nil {
+			if before, err = s.playlistDiffSnapshot(ctx, p.before); err != nil {
+				return nil, err
+			}
+		}
+		if p.after != nil {
+			if after, err = s.playlistDiffSnapshot(ctx, p.after); err != nil {
+				return nil, err
+			}
+		}
+
+		if d := diff.Compare(before, after); !d.Empty() {
+			diffs = append(diffs, d)
+		}
+	}
+
+	slices.SortFunc(diffs, func(a, b *diff.Diff) int {
+		return cmp.Compare(strings.ToLower(a.Title), strings.ToLower(b.Title))
+	})
+
+	return diffs, nil
+}
+
+// playlistDiffSnapshot loads a playlist snapshot with its items for comparison.
+func (s *Service) playlistDiffSnapshot(ctx context.Context, snapshot *ent.PlaylistSnapshot) (*diff.Snapshot, error) {
+	items, err := snapshot.QueryItems().
+		WithTrack(func(q *ent.TrackQuery) { q.WithArtists() }).
+		WithEpisode(func(q *ent.EpisodeQuery) { q.WithShow() }).
+		Order(ent.Asc(snapshotitem.FieldPosition)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := map[string]string{
+		"name":          snapshot.Name,
+		"description":   snapshot.Description,
+		"collaborative": strconv.FormatBool(snapshot.Collaborative),
+	}
+	if snapshot.Public != nil {
+		metadata["public"] = strconv.FormatBool(*snapshot.Public)
+	}
+
+	result := &diff.Snapshot{
+		Title:    snapshot.Name,
+		Metadata: metadata,
+		Ordered:  true,
+		Items:    make([]diff.Item, 0, len(items)),
+	}
+
+	for _, item := range items {
+		entry := diff.Item{
+			Key:      item.URI,
+			Name:     item.URI,
+			Position: item.Position,
+			Playable: item.IsPlayable,
+		}
+
+		// Relinked tracks are identified by the track that was added, so
+		// relinking doesn't show up as a change.
+		if item.LinkedFromID != "" {
+			entry.Key = "spotify:track:" + item.LinkedFromID
+		}
+
+		switch {
+		case item.Edges.Track != nil:
+			entry.Name = trackName(item.Edges.Track)
+		case item.Edges.Episode != nil:
+			entry.Name = episodeName(item.Edges.Episode)
+		}
+
+		result.Items = append(result.Items, entry)
+	}
+
+	return result, nil
+}
+
+// collectionDiffSnapshot loads a saved collection of a run for comparison.
+// Saved collections are not ordered, since Spotify orders them by the time
+// items were saved.
+func (s *Service) collectionDiffSnapshot(ctx context.Context, run *ent.BackupRun, name string) (*diff.Snapshot, error) {
+	result := &diff.Snapshot{Title: collectionTitles[name]}
+	add := func(key, name string, playable *bool) {
+		result.Items = append(result.Items, diff.Item{
+			Key:      key,
+			Name:     name,
+			Position: len(result.Items),
+			Playable: playable,
+		})
+	}
+
+	runID := backuprun.ID(run.ID)
+
+	switch name {
+	case "saved_tracks":
+		saved, err := s.db.SavedTrack.Query().
+			Where(savedtrack.HasRunWith(runID)).
+			WithTrack(func(q *ent.TrackQuery) { q.WithArtists() }).
+			Order(ent.Desc(savedtrack.FieldAddedAt)).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, t := range saved {
+			key := t.Edges.Track.URI
+			if t.LinkedFromID != "" {
+				key = "spotify:track:" + t.LinkedFromID
+			}
+
+			add(key, trackName(t.Edges.Track), t.IsPlayable)
+		}
+	case "saved_albums":
+		saved, err := s.db.SavedAlbum.Query().
+			Where(savedalbum.HasRunWith(runID)).
+			WithAlbum(func(q *ent.AlbumQuery) { q.WithArtists() }).
+			Order(ent.Desc(savedalbum.FieldAddedAt)).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, a := range saved {
+			add(a.Edges.Album.URI, withArtists(a.Edges.Album.Name, a.Edges.Album.Edges.Artists), nil)
+		}
+	case "saved_shows":
+		saved, err := s.db.SavedShow.Query().
+			Where(savedshow.HasRunWith(runID)).
+			WithShow().
+			Order(ent.Desc(savedshow.FieldAddedAt)).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, show := range saved {
+			add(show.Edges.Show.URI, show.Edges.Show.Name, nil)
+		}
+	case "saved_episodes":
+		saved, err := s.db.SavedEpisode.Query().
+			Where(savedepisode.HasRunWith(runID)).
+			WithEpisode(func(q *ent.EpisodeQuery) { q.WithShow() }).
+			Order(ent.Desc(savedepisode.FieldAddedAt)).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, e := range saved {
+			add(e.Edges.Episode.URI, episodeName(e.Edges.Episode), nil)
+		}
+	case "saved_audiobooks":
+		saved, err := s.db.SavedAudiobook.Query().
+			Where(savedaudiobook.HasRunWith(runID)).
+			Order(ent.Desc(savedaudiobook.FieldAddedAt)).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, a := range saved {
+			name := a.Name
+			if len(a.Authors) > 0 {
+				name += " - " + strings.Join(a.Authors, ", ")
+			}
+
+			add(a.URI, name, nil)
+		}
+	case "followed_artists":
+		followed, err := s.db.FollowedArtist.Query().
+			Where(followedartist.HasRunWith(runID)).
+			WithArtist().
+			Order(ent.Asc(followedartist.FieldCreatedAt), ent.Asc(followedartist.FieldID)).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, a := range followed {
+			add(a.Edges.Artist.URI, a.Edges.Artist.Name, nil)
+		}
+	}
+
+	return result, nil
+}
+
+// trackName returns the name of a track followed by its artists.
+func trackName(t *ent.Track) string {
+	return withArtists(t.Name, t.Edges.Artists)
+}
+
+// episodeName returns the name of an episode followed by its show.
+func episodeName(e *ent.Episode) string {
+	if e.Edges.Show == nil {
+		return e.Name
+	}
+
+	return e.Name + " - " + e.Edges.Show.Name
+}
+
+func withArtists(name string, artists []*ent.Artist) string {
+	names := make([]string, 0, len(artists))
+	for _, a := range artists {
+		names = append(names, a.Name)
+	}
+
+	return withArtistNames(name, names)
+}
+
+func withArtistNames(name string, artists []string) string {
+	if len(artists) == 0 {
+		return name
+	}
+
+	return name + " - " + strings.Join(artists, ", ")
+}
@@ -1,9 +1,179 @@
 package spotify
 
+import (
+	"beyerleinf/spotify-backup/pkg/request"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// ErrBackupInProgress is returned when a backup is started while
+// another backup of the same user is still running.
+var ErrBackupInProgress = errors.New("a backup of this user is already in progress")
+
+// A UserMismatchError is returned when a backup of a user is requested
+// while a different user is authenticated with Spotify.
+type UserMismatchError struct {
+	UserID              string
+	AuthenticatedUserID string
+}
+
+func (e *UserMismatchError) Error() string {
+	return fmt.Sprintf("cannot back up user %s while signed in as %s", e.UserID, e.AuthenticatedUserID)
+}
+
 // A UnauthenticatedError is returned when Authentication
 // with the Spotify API failed.
-type UnauthenticatedError struct{}
+type UnauthenticatedError struct {
+	// Err is the error that caused the authentication to fail, if any.
+	Err error
+}
 
 func (e *UnauthenticatedError) Error() string {
 	return "Authentication with Spotify failed! Try signing into your Account again."
 }
+
+func (e *UnauthenticatedError) Unwrap() error {
+	return e.Err
+}
+
+// An APIError is an error response from Spotify's Web API.
+// Responses with a well known status code are returned as one of the more
+// specific errors below, which all wrap an APIError, so [errors.As] matches
+// them with an *APIError as well.
+// [Response Status Codes]: https://developer.spotify.com/documentation/web-api/concepts/api-calls#response-status-codes
+type APIError struct {
+	Status  int
+	Message string
+}
+
+func (e *APIError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("spotify api request failed with status %d", e.Status)
+	}
+
+	return fmt.Sprintf("spotify api request failed with status %d: %s", e.Status, e.Message)
+}
+
+// A RateLimitedError is returned when the app exceeded Spotify's rate limit.
+type RateLimitedError struct {
+	APIError
+	// RetryAfter is how long Spotify asked to wait before retrying.
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitedError) Unwrap() error {
+	return &e.APIError
+}
+
+// A ForbiddenError is returned when the user did not grant the required scopes
+// or the requested resource is not available to the user.
+type ForbiddenError struct {
+	APIError
+}
+
+func (e *ForbiddenError) Unwrap() error {
+	return &e.APIError
+}
+
+// A NotFoundError is returned when the requested resource does not exist.
+type NotFoundError struct {
+	APIError
+}
+
+func (e *NotFoundError) Unwrap() error {
+	return &e.APIError
+}
+
+// A ServerError is returned when Spotify failed to handle the request.
+type ServerError struct {
+	APIError
+}
+
+func (e *ServerError) Unwrap() error {
+	return &e.APIError
+}
+
+// An AuthError is an error response from Spotify's Accounts service.
+// Well known errors are returned as one of the more specific errors below,
+// which wrap an AuthError.
+// [OAuth 2.0 Error Response]: https://datatracker.ietf.org/doc/html/rfc6749#section-5.2
+type AuthError struct {
+	Status      int
+	Code        string
+	Description string
+}
+
+func (e *AuthError) Error() string {
+	return fmt.Sprintf("spotify token request failed with status %d: %s - %s", e.Status, e.Code, e.Description)
+}
+
+// An InvalidGrantError is returned when an authorization code or
+// refresh token is invalid, expired or has been revoked.
+type InvalidGrantError struct {
+	AuthError
+}
+
+func (e *InvalidGrantError) Unwrap() error {
+	return &e.AuthError
+}
+
+// newAPIError parses an error response from the Web API.
+func newAPIError(res *request.Response) error {
+	status, data := res.StatusCode, res.Body
+
+	var body struct {
+		Error struct {
+			Status  int    `json:"status"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+
+	apiErr := APIError{Status: status}
+	if json.Unmarshal(data, &body) == nil {
+		apiErr.Message = body.Error.Message
+	} else {
+		apiErr.Message = string(data)
+	}
+
+	switch {
+	case status == http.StatusUnauthorized:
+		return &UnauthenticatedError{Err: &apiErr}
+	case status == http.StatusForbidden:
+		return &ForbiddenError{apiErr}
+	case status == http.StatusNotFound:
+		return &NotFoundError{apiErr}
+	case status == http.StatusTooManyRequests:
+		retryAfter, _ := strconv.Atoi(res.Header.Get("Retry-After"))
+		return &RateLimitedError{apiErr, time.Duration(retryAfter) * time.Second}
+	case status >= http.StatusInternalServerError:
+		return &ServerError{apiErr}
+	default:
+		return &apiErr
+	}
+}
+
+// newAuthError parses an error response from the Accounts service.
+func newAuthError(status int, data []byte) error {
+	var body struct {
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+
+	authErr := AuthError{Status: status}
+	if json.Unmarshal(data, &body) == nil {
+		authErr.Code = body.Error
+		authErr.Description = body.ErrorDescription
+	} else {
+		authErr.Description = string(data)
+	}
+
+	if authErr.Code == "invalid_grant" {
+		return &InvalidGrantError{authErr}
+	}
+
+	return &authErr
+}
@@ -0,0 +1,172 @@
+package spotify
+
+import (
+	"beyerleinf/spotify-backup/ent"
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/playlist"
+	"beyerleinf/spotify-backup/ent/playlistsnapshot"
+	"beyerleinf/spotify-backup/ent/snapshotitem"
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ErrPlaylistNotInBackup is returned when a playlist wasn't part of the
+// user's library at the requested time, e.g. because it was created later.
+var ErrPlaylistNotInBackup = errors.New("the playlist was not backed up at that time")
+
+// A PlaylistSummary is a backed up playlist as of its latest snapshot.
+type PlaylistSummary struct {
+	ID         string    `json:"id"`
+	SpotifyID  string    `json:"spotify_id"`
+	Name       string    `json:"name"`
+	OwnerName  string    `json:"owner_name"`
+	Total      int       `json:"total"`
+	Snapshots  int       `json:"snapshots"`
+	LastChange time.Time `json:"last_change"`
+}
+
+// A PlaylistAt is a playlist as it looked at a point in time.
+type PlaylistAt struct {
+	Playlist *ent.Playlist `json:"playlist"`
+	// Snapshot is the snapshot that was in effect, including its items.
+	Snapshot *ent.PlaylistSnapshot `json:"snapshot"`
+	// RunID is the backup run that captured the playlist at the time.
+	RunID string `json:"run_id"`
+	// BackedUpAt is when that run started.
+	BackedUpAt time.Time `json:"backed_up_at"`
+}
+
+// Playlists returns all backed up playlists ordered by name.
+func (s *Service) Playlists(ctx context.Context) ([]PlaylistSummary, error) {
+	snapshots, err := s.db.PlaylistSnapshot.Query().
+		Where(playlistsnapshot.Complete(true)).
+		WithPlaylist().
+		Order(ent.Desc(playlistsnapshot.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := map[string]*PlaylistSummary{}
+	for _, snapshot := range snapshots {
+		p := snapshot.Edges.Playlist
+
+		// Snapshots are ordered newest first, so the first one describes the playlist.
+		summary, ok := summaries[p.ID]
+		if !ok {
+			summary = &PlaylistSummary{
+				ID:         p.ID,
+				SpotifyID:  p.SpotifyID,
+				Name:       snapshot.Name,
+				OwnerName:  snapshot.OwnerName,
+				Total:      snapshot.Total,
+				LastChange: snapshot.CreatedAt,
+			}
+			summaries[p.ID] = summary
+		}
+
+		summary.Snapshots++
+	}
+
+	result := make([]PlaylistSummary, 0, len(summaries))
+	for _, summary := range summaries {
+		result = append(result, *summary)
+	}
+
+	slices.SortFunc(result, func(a, b PlaylistSummary) int {
+		return cmp.Or(
+			cmp.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name)),
+			cmp.Compare(a.ID, b.ID),
+		)
+	})
+
+	return result, nil
+}
+
+// PlaylistAt returns a playlist as it looked at the given time. The playlist
+// is identified by its Spotify ID or its ID in the database. The snapshot in
+// effect is the one captured by the latest backup that started at or before t.
+// If a later backup before t no longer contained the playlist, it was removed
+// from the library and [ErrPlaylistNotInBackup] is returned.
+func (s *Service) PlaylistAt(ctx context.Context, id string, t time.Time) (*PlaylistAt, error) {
+	p, err := s.db.Playlist.Query().
+		Where(playlist.Or(playlist.SpotifyID(id), playlist.ID(id))).
+		First(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	run, err := s.db.BackupRun.Query().
+		Where(
+			backuprun.StartedAtLTE(t),
+			backuprun.HasPlaylistSnapshotsWith(
+				playlistsnapshot.HasPlaylistWith(playlist.ID(p.ID)),
+				playlistsnapshot.Complete(true),
+			),
+		).
+		Order(ent.Desc(backuprun.FieldStartedAt)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrPlaylistNotInBackup
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	removed, err := s.removedSince(ctx, run, p, t)
+	if err != nil {
+		return nil, err
+	}
+	if removed {
+		return nil, ErrPlaylistNotInBackup
+	}
+
+	snapshot, err := run.QueryPlaylistSnapshots().
+		Where(playlistsnapshot.HasPlaylistWith(playlist.ID(p.ID)), playlistsnapshot.Complete(true)).
+		WithItems(func(q *ent.SnapshotItemQuery) {
+			q.WithTrack(func(q *ent.TrackQuery) { q.WithArtists().WithAlbum() }).
+				WithEpisode(func(q *ent.EpisodeQuery) { q.WithShow() }).
+				Order(ent.Asc(snapshotitem.FieldPosition))
+		}).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PlaylistAt{
+		Playlist:   p,
+		Snapshot:   snapshot,
+		RunID:      run.ID,
+		BackedUpAt: run.StartedAt,
+	}, nil
+}
+
+// removedSince reports whether a backup of the same user after run, but not
+// after t, backed up all playlists without the given one.
+func (s *Service) removedSince(ctx context.Context, run *ent.BackupRun, p *ent.Playlist, t time.Time) (bool, error) {
+	later, err := s.db.BackupRun.Query().
+		Where(
+			backuprun.UserID(run.UserID),
+			backuprun.StartedAtGTE(run.StartedAt),
+			backuprun.StartedAtLTE(t),
+			backuprun.StatusIn(backuprun.StatusSucceeded, backuprun.StatusPartial),
+			backuprun.Not(backuprun.HasPlaylistSnapshotsWith(playlistsnapshot.HasPlaylistWith(playlist.ID(p.ID)))),
+		).
+		All(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	for _, r := range later {
+		// Runs that failed to back up playlists don't tell whether it still existed.
+		if collectionSucceeded(r, "playlists") {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
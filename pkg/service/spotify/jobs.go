@@ -0,0 +1,308 @@
+package spotify
+
+import (
+	"beyerleinf/spotify-backup/ent"
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/restoreplan"
+	"beyerleinf/spotify-backup/pkg/service/queue"
+	"beyerleinf/spotify-backup/pkg/service/retention"
+	"context"
+	"encoding/json"
+	"errors"
+)
+
+// BackupJobType is the type of jobs that back up a user's library.
+const BackupJobType = "backup"
+
+// BackupJobPayload is the payload of a backup job.
+type BackupJobPayload struct {
+	// UserID is the Spotify ID of the user whose library is backed up.
+	UserID  string            `json:"user_id"`
+	Trigger backuprun.Trigger `json:"trigger"`
+}
+
+// BackupJobResult is the result of a successful backup job.
+type BackupJobResult struct {
+	RunID string `json:"run_id"`
+}
+
+// EnqueueBackup queues a backup of a user's library. Only one backup of a
+// user can be queued at a time, otherwise [queue.ErrDuplicate] is returned.
+func (s *Service) EnqueueBackup(ctx context.Context, userID string, trigger backuprun.Trigger) (*ent.Job, error) {
+	payload := BackupJobPayload{UserID: userID, Trigger: trigger}
+
+	return s.queue.Enqueue(ctx, BackupJobType, BackupJobType+":"+userID, payload)
+}
+
+// EnqueueCurrentUserBackup queues a backup of the library of the user
+// that is authenticated with Spotify.
+func (s *Service) EnqueueCurrentUserBackup(ctx context.Context, trigger backuprun.Trigger) (*ent.Job, error) {
+	profile, err := s.client.CurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.EnqueueBackup(ctx, profile.ID, trigger)
+}
+
+// RunBackupJob is the [queue.Handler] of backup jobs.
+func (s *Service) RunBackupJob(ctx context.Context, job *ent.Job) (any, error) {
+	var payload BackupJobPayload
+	if err := json.Unmarshal(job.Payload, &payload); err != nil {
+		return nil, queue.Permanent(err)
+	}
+
+	// A job that runs again resumes the run it started, unless it succeeded.
+	run, err := s.backup(ctx, payload.Trigger, payload.UserID, job)
+	if err != nil {
+		// Retrying won't help until the user signs in again.
+		var (
+			unauthenticated *UnauthenticatedError
+			mismatch        *UserMismatchError
+		)
+		if errors.As(err, &unauthenticated) || errors.As(err, &mismatch) {
+			return nil, queue.Permanent(err)
+		}
+
+		return nil, err
+	}
+
+	// Backups are pruned after every successful backup, so
+	// storage only grows as far as the retention policy allows.
+	if s.config.Retention.Enabled {
+		_, err := retention.EnqueuePrune(ctx, s.queue, payload.UserID)
+		if err != nil && !errors.Is(err, queue.ErrDuplicate) {
+			s.slogger.Error("Failed to queue pruning backups", "user", payload.UserID, "err", err)
+		}
+	}
+
+	return BackupJobResult{RunID: run.ID}, nil
+}
+
+// ApplyRestorePlanJobType is the type of jobs that apply a restore plan.
+const ApplyRestorePlanJobType = "apply_restore_plan"
+
+// ApplyRestorePlanJobPayload is the payload of an apply restore plan job.
+type ApplyRestorePlanJobPayload struct {
+	PlanID string `json:"plan_id"`
+}
+
+// ApplyRestorePlanJobResult is the result of a successful apply restore plan job.
+type ApplyRestorePlanJobResult struct {
+	PlanID string `json:"plan_id"`
+	// Playlists and Collections are the number of playlists and saved
+	// collections that were restored.
+	Playlists   int `json:"playlists"`
+	Collections int `json:"collections"`
+}
+
+// EnqueueRestorePlan queues applying a restore plan. The plan is checked for
+// drift first, so the user learns right away if it became stale. Only one
+// restore of a user can be queued at a time, otherwise [queue.ErrDuplicate]
+// is returned.
+func (s *Service) EnqueueRestorePlan(ctx context.Context, id string) (*ent.Job, error) {
+	plan, err := s.db.RestorePlan.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if plan.Status != restoreplan.StatusPending {
+		return nil, ErrPlanNotPending
+	}
+
+	if err := s.checkDrift(ctx, plan); err != nil {
+		return nil, err
+	}
+
+	payload := ApplyRestorePlanJobPayload{PlanID: plan.ID}
+
+	return s.queue.Enqueue(ctx, ApplyRestorePlanJobType, "restore:"+plan.UserID, payload)
+}
+
+// RunApplyRestorePlanJob is the [queue.Handler] of apply restore plan jobs.
+// A plan that fails for good is marked as failed.
+func (s *Service) RunApplyRestorePlanJob(ctx context.Context, job *ent.Job) (any, error) {
+	var payload ApplyRestorePlanJobPayload
+	if err := json.Unmarshal(job.Payload, &payload); err != nil {
+		return nil, queue.Permanent(err)
+	}
+
+	plan, err := s.ApplyRestorePlan(ctx, payload.PlanID)
+	if err == nil {
+		return ApplyRestorePlanJobResult{
+			PlanID:      plan.ID,
+			Playlists:   len(plan.Playlists),
+			Collections: len(plan.Collections),
+		}, nil
+	}
+
+	var (
+		unauthenticated *UnauthenticatedError
+		drift           *PlanDriftError
+	)
+	permanent := ent.IsNotFound(err) || errors.Is(err, ErrPlanNotPending) || errors.Is(err, ErrPlanForOtherUser) ||
+		errors.As(err, &unauthenticated) || errors.As(err, &drift)
+
+	if permanent || job.Attempts >= job.MaxAttempts {
+		// Plans that are stale or were applied already keep their status.
+		failErr := s.db.RestorePlan.UpdateOneID(payload.PlanID).
+			Where(restoreplan.StatusIn(restoreplan.StatusPending, restoreplan.StatusApplying)).
+			SetStatus(restoreplan.StatusFailed).
+			SetError(err.Error()).
+			Exec(ctx)
+		if failErr != nil && !ent.IsNotFound(failErr) {
+			s.slogger.Error("Failed to mark restore plan as failed", "plan", payload.PlanID, "err", failErr)
+		}
+	}
+
+	if permanent {
+		return nil, queue.Permanent(err)
+	}
+
+	return nil, err
+}
+
+// RestorePlaylistJobType is the type of jobs that restored a playlist snapshot
+// before restores were planned. They are still run, so jobs queued before
+// are not lost, but restore through a plan like [Service.EnqueuePlaylistRestore].
+const RestorePlaylistJobType = "restore_playlist"
+
+// RestorePlaylistJobPayload is the payload of a restore playlist job.
+type RestorePlaylistJobPayload struct {
+	SnapshotID string `json:"snapshot_id"`
+}
+
+// RestoreCollectionsJobType is the type of jobs that restored saved collections
+// before restores were planned. They are still run, so jobs queued before
+// are not lost, but restore through a plan like [Service.EnqueueCollectionsRestore].
+const RestoreCollectionsJobType = "restore_collections"
+
+// RestoreCollectionsJobPayload is the payload of a restore collections job.
+type RestoreCollectionsJobPayload struct {
+	RunID       string   `json:"run_id"`
+	Collections []string `json:"collections"`
+}
+
+// RestoreJobResult is the result of a successful restore playlist or restore
+// collections job, which plans the restore and queues applying the plan.
+type RestoreJobResult struct {
+	PlanID string `json:"plan_id"`
+	JobID  string `json:"job_id"`
+}
+
+// EnqueuePlaylistRestore plans restoring a playlist snapshot with the
+// overwrite strategy and queues applying the plan. The playlist is reset if
+// the user still owns it, otherwise it is restored like [Service.PlanRestore]
+// describes.
+func (s *Service) EnqueuePlaylistRestore(ctx context.Context, snapshotID string) (*ent.Job, error) {
+	_, j, err := s.enqueueRestore(ctx, PlanRequest{
+		SnapshotIDs: []string{snapshotID},
+		Strategy:    restoreplan.StrategyOverwrite,
+	})
+
+	return j, err
+}
+
+// EnqueueCollectionsRestore plans resetting saved collections to the state of
+// a backup run with the overwrite strategy and queues applying the plan. If
+// collections is empty, every collection that can be restored from the run is.
+func (s *Service) EnqueueCollectionsRestore(ctx context.Context, runID string, collections []string) (*ent.Job, error) {
+	req, err := s.collectionsRestoreRequest(ctx, runID, collections)
+	if err != nil {
+		return nil, err
+	}
+
+	_, j, err := s.enqueueRestore(ctx, req)
+
+	return j, err
+}
+
+// RunRestorePlaylistJob is the [queue.Handler] of restore playlist jobs.
+func (s *Service) RunRestorePlaylistJob(ctx context.Context, job *ent.Job) (any, error) {
+	var payload RestorePlaylistJobPayload
+	if err := json.Unmarshal(job.Payload, &payload); err != nil {
+		return nil, queue.Permanent(err)
+	}
+
+	return s.runRestoreJob(ctx, PlanRequest{
+		SnapshotIDs: []string{payload.SnapshotID},
+		Strategy:    restoreplan.StrategyOverwrite,
+	})
+}
+
+// RunRestoreCollectionsJob is the [queue.Handler] of restore collections jobs.
+func (s *Service) RunRestoreCollectionsJob(ctx context.Context, job *ent.Job) (any, error) {
+	var payload RestoreCollectionsJobPayload
+	if err := json.Unmarshal(job.Payload, &payload); err != nil {
+		return nil, queue.Permanent(err)
+	}
+
+	req, err := s.collectionsRestoreRequest(ctx, payload.RunID, payload.Collections)
+	if err != nil {
+		return nil, queue.Permanent(err)
+	}
+
+	return s.runRestoreJob(ctx, req)
+}
+
+// collectionsRestoreRequest returns the request of a plan that overwrites
+// collections with the state of a run, or all that can be restored if
+// collections is empty.
+func (s *Service) collectionsRestoreRequest(ctx context.Context, runID string, collections []string) (PlanRequest, error) {
+	req := PlanRequest{RunID: runID, Collections: collections, Strategy: restoreplan.StrategyOverwrite}
+	if len(collections) > 0 {
+		return req, nil
+	}
+
+	run, err := s.db.BackupRun.Get(ctx, runID)
+	if err != nil {
+		return req, err
+	}
+
+	// Without collections, the plan would restore the playlists as well.
+	if req.Collections = s.RestorableCollections(run); len(req.Collections) == 0 {
+		return req, ErrNothingToRestore
+	}
+
+	return req, nil
+}
+
+// runRestoreJob plans a restore and queues applying it.
+func (s *Service) runRestoreJob(ctx context.Context, req PlanRequest) (any, error) {
+	plan, j, err := s.enqueueRestore(ctx, req)
+	if err != nil {
+		var (
+			unauthenticated *UnauthenticatedError
+			drift           *PlanDriftError
+		)
+		if ent.IsNotFound(err) || errors.Is(err, ErrInvalidPlan) || errors.Is(err, ErrNothingToRestore) ||
+			errors.Is(err, ErrIncompleteSnapshot) || errors.Is(err, ErrCollectionNotRestorable) ||
+			errors.Is(err, ErrCollectionNotBackedUp) || errors.As(err, &unauthenticated) || errors.As(err, &drift) {
+			return nil, queue.Permanent(err)
+		}
+
+		return nil, err
+	}
+
+	return RestoreJobResult{PlanID: plan.ID, JobID: j.ID}, nil
+}
+
+// enqueueRestore plans a restore and queues applying it. The plan is deleted
+// again if it can't be queued, so retrying doesn't leave plans behind.
+func (s *Service) enqueueRestore(ctx context.Context, req PlanRequest) (*ent.RestorePlan, *ent.Job, error) {
+	plan, err := s.PlanRestore(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	j, err := s.EnqueueRestorePlan(ctx, plan.ID)
+	if err != nil {
+		if deleteErr := s.db.RestorePlan.DeleteOneID(plan.ID).Exec(ctx); deleteErr != nil {
+			s.slogger.Error("Failed to delete restore plan", "plan", plan.ID, "err", deleteErr)
+		}
+
+		return nil, nil, err
+	}
+
+	return plan, j, nil
+}
@@ -0,0 +1,268 @@
+package spotify
+
+import (
+	"beyerleinf/spotify-backup/ent"
+	"beyerleinf/spotify-backup/pkg/util"
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"slices"
+	"time"
+)
+
+const (
+	savedItemsPageSize = 50
+	// saveTracksBatchSize and saveAlbumsBatchSize are the number of items
+	// that can be saved or removed with a single request.
+	saveTracksBatchSize = 50
+	saveAlbumsBatchSize = 20
+)
+
+// A TimestampedID is a track to save with the time it was added.
+type TimestampedID struct {
+	ID      string    `json:"id"`
+	AddedAt time.Time `json:"added_at"`
+}
+
+// SavedTracks iterates over all tracks in the current user's Liked Songs,
+// most recently added first.
+// [Get User's Saved Tracks]: https://developer.spotify.com/documentation/web-api/reference/get-users-saved-tracks
+func (c *Client) SavedTracks(ctx context.Context) iter.Seq2[SavedTrack, error] {
+	query := limit(savedItemsPageSize)
+	query.Set("market", "from_token")
+
+	return Paginate[SavedTrack](ctx, c, "/me/tracks", query)
+}
+
+// SaveTracks saves up to [saveTracksBatchSize] tracks to the current user's
+// Liked Songs. Every track is saved with its AddedAt time, which Spotify
+// uses to order Liked Songs.
+// [Save Tracks for Current User]: https://developer.spotify.com/documentation/web-api/reference/save-tracks-user
+func (c *Client) SaveTracks(ctx context.Context, tracks []TimestampedID) error {
+	return c.Send(ctx, http.MethodPut, "/me/tracks", map[string]any{"timestamped_ids": tracks}, nil)
+}
+
+// RemoveSavedTracks removes up to [saveTracksBatchSize] tracks from the current user's Liked Songs.
+// [Remove User's Saved Tracks]: https://developer.spotify.com/documentation/web-api/reference/remove-tracks-user
+func (c *Client) RemoveSavedTracks(ctx context.Context, ids []string) error {
+	return c.Send(ctx, http.MethodDelete, "/me/tracks", map[string]any{"ids": ids}, nil)
+}
+
+// backupSavedTracks stores the current user's Liked Songs as part of the given run.
+func (s *Service) backupSavedTracks(ctx context.Context, run *ent.BackupRun) (int, error) {
+	tracks, err := Collect(s.client.SavedTracks(ctx))
+	if err != nil {
+		return 0, fmt.Errorf("failed to get saved tracks: %w", err)
+	}
+
+	// Local files have no Spotify ID, so they can't be stored in the catalog.
+	tracks = slices.DeleteFunc(tracks, func(t SavedTrack) bool {
+		return t.Track.IsLocal || t.Track.ID == ""
+	})
+
+	err = util.WithTx(ctx, s.db, func(tx *ent.Tx) error {
+		cat := newCatalog(tx)
+
+		catalogTracks := make([]Track, 0, len(tracks))
+		for _, t := range tracks {
+			catalogTracks = append(catalogTracks, t.Track)
+		}
+
+		if err := cat.addTracks(ctx, catalogTracks); err != nil {
+			return err
+		}
+
+		return createInBatches(ctx, tx.SavedTrack, tracks, func(c *ent.SavedTrackCreate, t SavedTrack) {
+			c.SetRun(run).
+				SetAddedAt(t.AddedAt).
+				SetTrackID(cat.tracks[t.Track.ID]).
+				SetNillableIsPlayable(t.Track.IsPlayable)
+
+			if t.Track.LinkedFrom != nil {
+				c.SetLinkedFromID(t.Track.LinkedFrom.ID)
+			}
+		})
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to save saved tracks: %w", err)
+	}
+
+	s.slogger.Info("Backed up saved tracks", "run", run.ID, "count", len(tracks))
+
+	return len(tracks), nil
+}
+
+// SavedAlbums iterates over all albums in the current user's library.
+// [Get User's Saved Albums]: https://developer.spotify.com/documentation/web-api/reference/get-users-saved-albums
+func (c *Client) SavedAlbums(ctx context.Context) iter.Seq2[SavedAlbum, error] {
+	return Paginate[SavedAlbum](ctx, c, "/me/albums", limit(savedItemsPageSize))
+}
+
+// SaveAlbums saves up to [saveAlbumsBatchSize] albums to the current user's library.
+// [Save Albums for Current User]: https://developer.spotify.com/documentation/web-api/reference/save-albums-user
+func (c *Client) SaveAlbums(ctx context.Context, ids []string) error {
+	return c.Send(ctx, http.MethodPut, "/me/albums", map[string]any{"ids": ids}, nil)
+}
+
+// RemoveSavedAlbums removes up to [saveAlbumsBatchSize] albums from the current user's library.
+// [Remove Users' Saved Albums]: https://developer.spotify.com/documentation/web-api/reference/remove-albums-user
+func (c *Client) RemoveSavedAlbums(ctx context.Context, ids []string) error {
+	return c.Send(ctx, http.MethodDelete, "/me/albums", map[string]any{"ids": ids}, nil)
+}
+
+// backupSavedAlbums stores the current user's saved albums as part of the given run.
+func (s *Service) backupSavedAlbums(ctx context.Context, run *ent.BackupRun) (int, error) {
+	albums, err := Collect(s.client.SavedAlbums(ctx))
+	if err != nil {
+		return 0, fmt.Errorf("failed to get saved albums: %w", err)
+	}
+
+	err = util.WithTx(ctx, s.db, func(tx *ent.Tx) error {
+		cat := newCatalog(tx)
+
+		catalogAlbums := make([]Album, 0, len(albums))
+		for _, a := range albums {
+			catalogAlbums = append(catalogAlbums, a.Album)
+		}
+
+		if err := cat.addAlbums(ctx, catalogAlbums); err != nil {
+			return err
+		}
+
+		return createInBatches(ctx, tx.SavedAlbum, albums, func(c *ent.SavedAlbumCreate, a SavedAlbum) {
+			c.SetRun(run).
+				SetAddedAt(a.AddedAt).
+				SetAlbumID(cat.albums[a.Album.ID])
+		})
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to save saved albums: %w", err)
+	}
+
+	s.slogger.Info("Backed up saved albums", "run", run.ID, "count", len(albums))
+
+	return len(albums), nil
+}
+
+// SavedShows iterates over all shows the current user follows.
+// [Get User's Saved Shows]: https://developer.spotify.com/documentation/web-api/reference/get-users-saved-shows
+func (c *Client) SavedShows(ctx context.Context) iter.Seq2[SavedShow, error] {
+	return Paginate[SavedShow](ctx, c, "/me/shows", limit(savedItemsPageSize))
+}
+
+// backupSavedShows stores the current user's saved shows as part of the given run.
+func (s *Service) backupSavedShows(ctx context.Context, run *ent.BackupRun) (int, error) {
+	shows, err := Collect(s.client.SavedShows(ctx))
+	if err != nil {
+		return 0, fmt.Errorf("failed to get saved shows: %w", err)
+	}
+
+	err = util.WithTx(ctx, s.db, func(tx *ent.Tx) error {
+		cat := newCatalog(tx)
+
+		catalogShows := make([]SimplifiedShow, 0, len(shows))
+		for _, sh := range shows {
+			catalogShows = append(catalogShows, sh.Show)
+		}
+
+		if err := cat.addShows(ctx, catalogShows); err != nil {
+			return err
+		}
+
+		return createInBatches(ctx, tx.SavedShow, shows, func(c *ent.SavedShowCreate, sh SavedShow) {
+			c.SetRun(run).
+				SetAddedAt(sh.AddedAt).
+				SetShowID(cat.shows[sh.Show.ID]).
+				SetTotalEpisodes(sh.Show.TotalEpisodes)
+		})
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to save saved shows: %w", err)
+	}
+
+	s.slogger.Info("Backed up saved shows", "run", run.ID, "count", len(shows))
+
+	return len(shows), nil
+}
+
+// SavedEpisodes iterates over all episodes in the current user's library.
+// [Get User's Saved Episodes]: https://developer.spotify.com/documentation/web-api/reference/get-users-saved-episodes
+func (c *Client) SavedEpisodes(ctx context.Context) iter.Seq2[SavedEpisode, error] {
+	return Paginate[SavedEpisode](ctx, c, "/me/episodes", limit(savedItemsPageSize))
+}
+
+// backupSavedEpisodes stores the current user's saved episodes and their
+// resume points as part of the given run.
+func (s *Service) backupSavedEpisodes(ctx context.Context, run *ent.BackupRun) (int, error) {
+	episodes, err := Collect(s.client.SavedEpisodes(ctx))
+	if err != nil {
+		return 0, fmt.Errorf("failed to get saved episodes: %w", err)
+	}
+
+	err = util.WithTx(ctx, s.db, func(tx *ent.Tx) error {
+		cat := newCatalog(tx)
+
+		catalogEpisodes := make([]Episode, 0, len(episodes))
+		for _, e := range episodes {
+			catalogEpisodes = append(catalogEpisodes, e.Episode)
+		}
+
+		if err := cat.addEpisodes(ctx, catalogEpisodes); err != nil {
+			return err
+		}
+
+		return createInBatches(ctx, tx.SavedEpisode, episodes, func(c *ent.SavedEpisodeCreate, e SavedEpisode) {
+			c.SetRun(run).
+				SetAddedAt(e.AddedAt).
+				SetEpisodeID(cat.episodes[e.Episode.ID])
+
+			if e.Episode.ResumePoint != nil {
+				c.SetFullyPlayed(e.Episode.ResumePoint.FullyPlayed).
+					SetResumePositionMs(e.Episode.ResumePoint.ResumePositionMS)
+			}
+		})
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to save saved episodes: %w", err)
+	}
+
+	s.slogger.Info("Backed up saved episodes", "run", run.ID, "count", len(episodes))
+
+	return len(episodes), nil
+}
+
+// SavedAudiobooks iterates over all audiobooks in the current user's library.
+// [Get User's Saved Audiobooks]: https://developer.spotify.com/documentation/web-api/reference/get-users-saved-audiobooks
+func (c *Client) SavedAudiobooks(ctx context.Context) iter.Seq2[SavedAudiobook, error] {
+	return Paginate[SavedAudiobook](ctx, c, "/me/audiobooks", limit(savedItemsPageSize))
+}
+
+// backupSavedAudiobooks stores the current user's saved audiobooks as part of the given run.
+func (s *Service) backupSavedAudiobooks(ctx context.Context, run *ent.BackupRun) (int, error) {
+	audiobooks, err := Collect(s.client.SavedAudiobooks(ctx))
+	if err != nil {
+		return 0, fmt.Errorf("failed to get saved audiobooks: %w", err)
+	}
+
+	err = util.WithTx(ctx, s.db, func(tx *ent.Tx) error {
+		return createInBatches(ctx, tx.SavedAudiobook, audiobooks, func(c *ent.SavedAudiobookCreate, a SavedAudiobook) {
+			c.SetRun(run).
+				SetNillableAddedAt(a.AddedAt).
+				SetSpotifyID(a.ID).
+				SetURI(a.URI).
+				SetName(a.Name).
+				SetAuthors(personNames(a.Authors)).
+				SetNarrators(personNames(a.Narrators)).
+				SetPublisher(a.Publisher).
+				SetTotalChapters(a.TotalChapters)
+		})
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to save saved audiobooks: %w", err)
+	}
+
+	s.slogger.Info("Backed up saved audiobooks", "run", run.ID, "count", len(audiobooks))
+
+	return len(audiobooks), nil
+}
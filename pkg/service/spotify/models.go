@@ -1,6 +1,9 @@
 package spotify
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
 // Image is an image in a Spotify API response.
 type Image struct {
@@ -11,7 +14,7 @@ type Image struct {
 
 // UserProfile represents the logged in users' Spotify profile.
 type UserProfile struct {
-	ID          string  `json:"string"`
+	ID          string  `json:"id"`
 	DisplayName string  `json:"display_name"`
 	Images      []Image `json:"images"`
 }
@@ -31,3 +34,269 @@ type AuthToken struct {
 	RefreshToken string    `json:"refresh_token"`
 	ExpiresAt    time.Time `json:"expires_at"`
 }
+
+// Paging is a page of items in a Spotify API response.
+// [Paging Object]: https://developer.spotify.com/documentation/web-api/concepts/api-calls#pagination
+type Paging[T any] struct {
+	Href     string `json:"href"`
+	Items    []T    `json:"items"`
+	Limit    int    `json:"limit"`
+	Next     string `json:"next"`
+	Offset   int    `json:"offset"`
+	Previous string `json:"previous"`
+	Total    int    `json:"total"`
+}
+
+// CursorPaging is a page of items in a Spotify API response that is
+// paged using cursors instead of offsets.
+type CursorPaging[T any] struct {
+	Href    string  `json:"href"`
+	Items   []T     `json:"items"`
+	Limit   int     `json:"limit"`
+	Next    string  `json:"next"`
+	Cursors Cursors `json:"cursors"`
+	Total   int     `json:"total"`
+}
+
+// Cursors point to the items before and after a [CursorPaging] page.
+type Cursors struct {
+	After  string `json:"after"`
+	Before string `json:"before"`
+}
+
+// PublicUser is a reference to another Spotify user.
+type PublicUser struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"display_name"`
+}
+
+// SimplifiedArtist is an artist as embedded in tracks and albums.
+type SimplifiedArtist struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	URI  string `json:"uri"`
+}
+
+// Artist is a full artist object.
+type Artist struct {
+	ID         string   `json:"id"`
+	Name       string   `json:"name"`
+	URI        string   `json:"uri"`
+	Genres     []string `json:"genres"`
+	Popularity int      `json:"popularity"`
+	Images     []Image  `json:"images"`
+	Followers  struct {
+		Total int `json:"total"`
+	} `json:"followers"`
+}
+
+// SimplifiedAlbum is an album as embedded in tracks.
+type SimplifiedAlbum struct {
+	ID          string             `json:"id"`
+	Name        string             `json:"name"`
+	URI         string             `json:"uri"`
+	AlbumType   string             `json:"album_type"`
+	ReleaseDate string             `json:"release_date"`
+	TotalTracks int                `json:"total_tracks"`
+	Artists     []SimplifiedArtist `json:"artists"`
+	Images      []Image            `json:"images"`
+}
+
+// ArtistNames returns the names of all artists of the album.
+func (a SimplifiedAlbum) ArtistNames() []string {
+	return artistNames(a.Artists)
+}
+
+// Album is a full album object.
+type Album struct {
+	SimplifiedAlbum
+	Label       string      `json:"label"`
+	ExternalIDs ExternalIDs `json:"external_ids"`
+}
+
+// SavedAlbum is an album in the user's library.
+type SavedAlbum struct {
+	AddedAt time.Time `json:"added_at"`
+	Album   Album     `json:"album"`
+}
+
+// SimplifiedShow is a show as embedded in episodes.
+type SimplifiedShow struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	URI           string `json:"uri"`
+	Publisher     string `json:"publisher"`
+	TotalEpisodes int    `json:"total_episodes"`
+}
+
+// SavedShow is a show the user follows.
+type SavedShow struct {
+	AddedAt time.Time      `json:"added_at"`
+	Show    SimplifiedShow `json:"show"`
+}
+
+// Track is a full track object.
+type Track struct {
+	ID          string             `json:"id"`
+	Name        string             `json:"name"`
+	URI         string             `json:"uri"`
+	DurationMS  int                `json:"duration_ms"`
+	Explicit    bool               `json:"explicit"`
+	IsLocal     bool               `json:"is_local"`
+	DiscNumber  int                `json:"disc_number"`
+	TrackNumber int                `json:"track_number"`
+	Artists     []SimplifiedArtist `json:"artists"`
+	Album       SimplifiedAlbum    `json:"album"`
+	ExternalIDs ExternalIDs        `json:"external_ids"`
+	// IsPlayable and LinkedFrom are only set if a market was requested.
+	// [Track Relinking]: https://developer.spotify.com/documentation/web-api/concepts/track-relinking
+	IsPlayable *bool        `json:"is_playable"`
+	LinkedFrom *LinkedTrack `json:"linked_from"`
+}
+
+// ExternalIDs are identifiers of a track or album outside of Spotify.
+type ExternalIDs struct {
+	ISRC string `json:"isrc"`
+	EAN  string `json:"ean"`
+	UPC  string `json:"upc"`
+}
+
+// LinkedTrack is the track that was requested if Spotify
+// relinked it to another track available in the user's market.
+type LinkedTrack struct {
+	ID  string `json:"id"`
+	URI string `json:"uri"`
+}
+
+// ArtistNames returns the names of all artists of the track.
+func (t Track) ArtistNames() []string {
+	return artistNames(t.Artists)
+}
+
+// SavedTrack is a track in the user's Liked Songs.
+type SavedTrack struct {
+	AddedAt time.Time `json:"added_at"`
+	Track   Track     `json:"track"`
+}
+
+// Episode is a full episode object.
+type Episode struct {
+	ID          string         `json:"id"`
+	Name        string         `json:"name"`
+	URI         string         `json:"uri"`
+	DurationMS  int            `json:"duration_ms"`
+	Explicit    bool           `json:"explicit"`
+	ReleaseDate string         `json:"release_date"`
+	Show        SimplifiedShow `json:"show"`
+	ResumePoint *ResumePoint   `json:"resume_point"`
+}
+
+// ResumePoint is the user's playback position within an episode.
+type ResumePoint struct {
+	FullyPlayed      bool `json:"fully_played"`
+	ResumePositionMS int  `json:"resume_position_ms"`
+}
+
+// SavedEpisode is an episode in the user's library.
+type SavedEpisode struct {
+	AddedAt time.Time `json:"added_at"`
+	Episode Episode   `json:"episode"`
+}
+
+// SimplifiedAudiobook is an audiobook as returned when listing audiobooks.
+type SimplifiedAudiobook struct {
+	ID            string   `json:"id"`
+	Name          string   `json:"name"`
+	URI           string   `json:"uri"`
+	Publisher     string   `json:"publisher"`
+	TotalChapters int      `json:"total_chapters"`
+	Authors       []Person `json:"authors"`
+	Narrators     []Person `json:"narrators"`
+}
+
+// Person is an author or narrator of an audiobook.
+type Person struct {
+	Name string `json:"name"`
+}
+
+// SavedAudiobook is an audiobook in the user's library. Spotify returns
+// saved audiobooks without the usual wrapper object, so AddedAt is only
+// set if the API reports it.
+type SavedAudiobook struct {
+	AddedAt *time.Time `json:"added_at"`
+	SimplifiedAudiobook
+}
+
+// SimplifiedPlaylist is a playlist as returned when listing playlists.
+type SimplifiedPlaylist struct {
+	ID            string     `json:"id"`
+	Name          string     `json:"name"`
+	Description   string     `json:"description"`
+	Collaborative bool       `json:"collaborative"`
+	Public        *bool      `json:"public"`
+	SnapshotID    string     `json:"snapshot_id"`
+	URI           string     `json:"uri"`
+	Owner         PublicUser `json:"owner"`
+	Images        []Image    `json:"images"`
+	Tracks        struct {
+		Href  string `json:"href"`
+		Total int    `json:"total"`
+	} `json:"tracks"`
+}
+
+// PlaylistItem is a single entry of a playlist.
+type PlaylistItem struct {
+	AddedAt *time.Time     `json:"added_at"`
+	AddedBy *PublicUser    `json:"added_by"`
+	IsLocal bool           `json:"is_local"`
+	Track   *PlaylistTrack `json:"track"`
+}
+
+// PlaylistTrack is the item of a [PlaylistItem]. Playlists may contain
+// tracks and episodes, so depending on Type either Track or Episode is set.
+type PlaylistTrack struct {
+	Type    string
+	Track   *Track
+	Episode *Episode
+}
+
+// UnmarshalJSON decodes a track or episode object based on its type.
+func (p *PlaylistTrack) UnmarshalJSON(data []byte) error {
+	var typed struct {
+		Type string `json:"type"`
+	}
+
+	if err := json.Unmarshal(data, &typed); err != nil {
+		return err
+	}
+
+	p.Type = typed.Type
+
+	switch typed.Type {
+	case "episode":
+		p.Episode = &Episode{}
+		return json.Unmarshal(data, p.Episode)
+	default:
+		p.Type = "track"
+		p.Track = &Track{}
+		return json.Unmarshal(data, p.Track)
+	}
+}
+
+func artistNames(artists []SimplifiedArtist) []string {
+	names := make([]string, 0, len(artists))
+	for _, artist := range artists {
+		names = append(names, artist.Name)
+	}
+
+	return names
+}
+
+func personNames(people []Person) []string {
+	names := make([]string, 0, len(people))
+	for _, person := range people {
+		names = append(names, person.Name)
+	}
+
+	return names
+}
@@ -0,0 +1,722 @@
+package spotify
+
+import (
+	"beyerleinf/spotify-backup/ent"
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/followedartist"
+	"beyerleinf/spotify-backup/ent/playlistsnapshot"
+	"beyerleinf/spotify-backup/ent/restoreplan"
+	"beyerleinf/spotify-backup/ent/savedalbum"
+	"beyerleinf/spotify-backup/ent/savedtrack"
+	"beyerleinf/spotify-backup/ent/schema/schematype"
+	"beyerleinf/spotify-backup/ent/snapshotitem"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Actions of a [schematype.PlaylistRestore].
+const (
+	// RestoreActionCreate creates a new playlist, because the playlist is no
+	// longer in the library or a copy was requested.
+	RestoreActionCreate = "create"
+	// RestoreActionFollow follows a playlist of another user again that is no
+	// longer in the library. It is restored as its owner keeps it now.
+	RestoreActionFollow = "follow"
+	// RestoreActionOverwrite replaces the details and items of the playlist.
+	RestoreActionOverwrite = "overwrite"
+	// RestoreActionAppend appends the items that are missing from the playlist.
+	RestoreActionAppend = "append"
+	// RestoreActionUnchanged leaves a playlist alone that already matches the
+	// snapshot or is a playlist of another user that the user still follows.
+	RestoreActionUnchanged = "unchanged"
+)
+
+// copySuffix is appended to the name of playlists that are restored as a copy
+// next to the playlist they were backed up from.
+const copySuffix = " (restored)"
+
+// tracksBatchSize is the number of tracks that can be requested at once.
+const tracksBatchSize = 50
+
+var (
+	// ErrInvalidPlan is returned when a restore plan is requested with an
+	// unknown strategy or collections without a backup run.
+	ErrInvalidPlan = errors.New("a restore needs a valid strategy and collections can only be restored from a backup run")
+	// ErrNothingToRestore is returned when a restore plan would restore nothing.
+	ErrNothingToRestore = errors.New("there is nothing to restore")
+	// ErrPlanNotPending is returned when applying a plan that was applied
+	// already, is being applied or became stale.
+	ErrPlanNotPending = errors.New("the restore plan can no longer be applied")
+	// ErrPlanForOtherUser is returned when applying a plan while a different
+	// user is authenticated with Spotify than the one it was made for.
+	ErrPlanForOtherUser = errors.New("the restore plan was made for a different Spotify user")
+)
+
+// A PlanDriftError is returned when a restore plan is applied after the
+// parts of the library it changes were changed since the plan was made.
+type PlanDriftError struct {
+	Drift []string
+}
+
+func (e *PlanDriftError) Error() string {
+	return "the library changed since the restore plan was made: " + strings.Join(e.Drift, "; ")
+}
+
+// A PlanRequest selects what a restore plan restores.
+type PlanRequest struct {
+	// RunID is the backup run to restore from. If neither snapshots nor
+	// collections are selected, all playlists and every collection of the
+	// run that can be restored are.
+	RunID       string   `json:"run_id"`
+	SnapshotIDs []string `json:"snapshot_ids"`
+	// Collections are restored from the run, which is required for them.
+	Collections []string             `json:"collections"`
+	Strategy    restoreplan.Strategy `json:"strategy"`
+}
+
+// PlanRestore plans a restore without changing the library. The plan lists
+// the playlists that are created, overwritten, appended to or followed and the items
+// of saved collections that are saved or removed, including tracks that
+// are no longer available in the user's market.
+//
+// The strategy decides what happens to playlists that are still owned by
+// the user: they are overwritten, the missing items are appended (merge) or
+// a copy is created. Playlists of other users that are still followed can't
+// be changed, so they are left alone unless copies are created. With merge
+// and copy, saved collections only get their missing items back, while
+// overwrite also removes items that were saved since the backup.
+func (s *Service) PlanRestore(ctx context.Context, req PlanRequest) (*ent.RestorePlan, error) {
+	if req.Strategy == "" {
+		req.Strategy = restoreplan.DefaultStrategy
+	}
+	if restoreplan.StrategyValidator(req.Strategy) != nil {
+		return nil, ErrInvalidPlan
+	}
+
+	var run *ent.BackupRun
+	if req.RunID != "" {
+		var err error
+		if run, err = s.db.BackupRun.Get(ctx, req.RunID); err != nil {
+			return nil, err
+		}
+	} else if len(req.Collections) > 0 {
+		return nil, ErrInvalidPlan
+	}
+
+	snapshotIDs, collections := req.SnapshotIDs, req.Collections
+	if run != nil && len(snapshotIDs) == 0 && len(collections) == 0 {
+		var err error
+		snapshotIDs, err = run.QueryPlaylistSnapshots().Where(playlistsnapshot.Complete(true)).IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		collections = s.RestorableCollections(run)
+	} else if len(collections) > 0 {
+		var err error
+		if collections, err = collectionsToRestore(run, collections); err != nil {
+			return nil, err
+		}
+	}
+
+	snapshots, err := s.restoreSnapshots(ctx, snapshotIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(snapshots) == 0 && len(collections) == 0 {
+		return nil, ErrNothingToRestore
+	}
+
+	profile, err := s.client.CurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	live, err := s.livePlaylists(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// names describes the tracks of the plan, e.g. if they are unavailable.
+	names := map[string]string{}
+
+	playlists := make([]schematype.PlaylistRestore, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		p, err := s.planPlaylist(ctx, snapshot, live, profile.ID, req.Strategy, names)
+		if err != nil {
+			return nil, err
+		}
+
+		playlists = append(playlists, p)
+	}
+
+	restores := make([]schematype.CollectionRestore, 0, len(collections))
+	for _, name := range collections {
+		c, err := s.planCollection(ctx, run, name, req.Strategy)
+		if err != nil {
+			return nil, fmt.Errorf("failed to plan restoring %s: %w", collectionTitles[name], err)
+		}
+
+		restores = append(restores, c)
+	}
+
+	if err := s.findUnavailable(ctx, playlists, restores, names); err != nil {
+		return nil, err
+	}
+
+	return s.db.RestorePlan.Create().
+		SetUserID(profile.ID).
+		SetRunID(req.RunID).
+		SetStrategy(req.Strategy).
+		SetPlaylists(playlists).
+		SetCollections(restores).
+		Save(ctx)
+}
+
+// GetRestorePlan returns a restore plan.
+func (s *Service) GetRestorePlan(ctx context.Context, id string) (*ent.RestorePlan, error) {
+	return s.db.RestorePlan.Get(ctx, id)
+}
+
+// RestorePlans returns the most recent restore plans, newest first. If runID
+// is not empty, only the plans that restore from that backup run are returned.
+func (s *Service) RestorePlans(ctx context.Context, runID string, limit int) ([]*ent.RestorePlan, error) {
+	query := s.db.RestorePlan.Query()
+	if runID != "" {
+		query.Where(restoreplan.RunID(runID))
+	}
+
+	return query.
+		Order(ent.Desc(restoreplan.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+}
+
+// restoreSnapshots loads the playlist snapshots to restore ordered by name.
+func (s *Service) restoreSnapshots(ctx context.Context, ids []string) ([]*ent.PlaylistSnapshot, error) {
+	ids = slices.Compact(slices.Sorted(slices.Values(ids)))
+
+	snapshots := make([]*ent.PlaylistSnapshot, 0, len(ids))
+	for _, id := range ids {
+		snapshot, err := s.db.PlaylistSnapshot.Query().
+			Where(playlistsnapshot.ID(id)).
+			WithPlaylist().
+			WithItems(func(q *ent.SnapshotItemQuery) {
+				q.WithTrack(func(q *ent.TrackQuery) { q.WithArtists() }).
+					WithEpisode(func(q *ent.EpisodeQuery) { q.WithShow() }).
+					Order(ent.Asc(snapshotitem.FieldPosition))
+			}).
+			Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if !snapshot.Complete {
+			return nil, ErrIncompleteSnapshot
+		}
+
+		snapshots = append(snapshots, snapshot)
+	}
+
+	slices.SortFunc(snapshots, func(a, b *ent.PlaylistSnapshot) int {
+		return strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+	})
+
+	return snapshots, nil
+}
+
+// livePlaylists returns the playlists in the user's library by ID.
+func (s *Service) livePlaylists(ctx context.Context) (map[string]SimplifiedPlaylist, error) {
+	playlists := map[string]SimplifiedPlaylist{}
+	for p, err := range s.client.Playlists(ctx) {
+		if err != nil {
+			return nil, fmt.Errorf("failed to list playlists: %w", err)
+		}
+
+		playlists[p.ID] = p
+	}
+
+	return playlists, nil
+}
+
+// planPlaylist plans restoring a playlist snapshot and records the names of its tracks.
+func (s *Service) planPlaylist(ctx context.Context, snapshot *ent.PlaylistSnapshot, live map[string]SimplifiedPlaylist, userID string, strategy restoreplan.Strategy, names map[string]string) (schematype.PlaylistRestore, error) {
+	p := schematype.PlaylistRestore{
+		SnapshotID:    snapshot.ID,
+		PlaylistID:    snapshot.Edges.Playlist.SpotifyID,
+		Name:          snapshot.Name,
+		Description:   snapshot.Description,
+		Public:        snapshot.Public,
+		Collaborative: snapshot.Collaborative,
+		URIs:          []string{},
+	}
+	// Spotify only allows collaborative playlists that are private.
+	if p.Collaborative {
+		p.Public = new(bool)
+	}
+
+	for _, item := range snapshot.Edges.Items {
+		if item.IsLocal {
+			p.LocalFiles = append(p.LocalFiles, item.URI)
+			continue
+		}
+
+		uri := restoreURI(item)
+		p.URIs = append(p.URIs, uri)
+
+		switch {
+		case item.Edges.Track != nil:
+			names[uri] = trackName(item.Edges.Track)
+		case item.Edges.Episode != nil:
+			names[uri] = episodeName(item.Edges.Episode)
+		}
+	}
+
+	current, ok := live[p.PlaylistID]
+	if ok {
+		p.LiveSnapshotID = current.SnapshotID
+	}
+
+	switch {
+	case ok && current.SnapshotID == snapshot.SnapshotID:
+		p.Action = RestoreActionUnchanged
+		p.TargetID = current.ID
+		p.URIs = []string{}
+	case ok && current.Owner.ID != userID && strategy != restoreplan.StrategyCopy:
+		// Playlists of other users can't be changed. The user still follows it
+		// as its owner keeps it now, so it is only copied if copies were asked for.
+		p.Action = RestoreActionUnchanged
+		p.TargetID = current.ID
+		p.URIs = []string{}
+	case ok && strategy == restoreplan.StrategyCopy:
+		p.Action = RestoreActionCreate
+		p.Name += copySuffix
+		if current.Owner.ID != userID {
+			p.OwnerID = current.Owner.ID
+		}
+	case !ok && snapshot.OwnerID != userID:
+		// A playlist of another user is followed again if it still exists.
+		// Otherwise it is copied, so it doesn't look like the user's own.
+		_, err := s.client.Playlist(ctx, p.PlaylistID)
+
+		var notFound *NotFoundError
+		switch {
+		case err == nil:
+			p.Action = RestoreActionFollow
+			p.TargetID = p.PlaylistID
+			p.URIs = []string{}
+		case errors.As(err, &notFound):
+			p.Action = RestoreActionCreate
+			p.Name += copySuffix
+			p.OwnerID = snapshot.OwnerID
+		default:
+			return p, fmt.Errorf("failed to get playlist: %w", err)
+		}
+	case !ok:
+		p.Action = RestoreActionCreate
+	default:
+		liveURIs, err := s.livePlaylistURIs(ctx, current.ID)
+		if err != nil {
+			return p, err
+		}
+
+		p.TargetID = current.ID
+
+		if strategy == restoreplan.StrategyMerge {
+			p.Action = RestoreActionAppend
+			p.URIs = missing(p.URIs, liveURIs)
+		} else {
+			p.Action = RestoreActionOverwrite
+			p.Removed = len(missing(liveURIs, p.URIs))
+		}
+	}
+
+	return p, nil
+}
+
+// livePlaylistURIs returns the URIs of the items of a playlist in the library.
+// Relinked tracks are identified by the track that was added, like in backups.
+func (s *Service) livePlaylistURIs(ctx context.Context, playlistID string) ([]string, error) {
+	var uris []string
+	for item, err := range s.client.PlaylistItems(ctx, playlistID) {
+		if err != nil {
+			return nil, fmt.Errorf("failed to get playlist items: %w", err)
+		}
+
+		switch {
+		case item.Track == nil:
+			continue
+		case item.Track.Episode != nil:
+			uris = append(uris, item.Track.Episode.URI)
+		case item.Track.Track.LinkedFrom != nil:
+			uris = append(uris, item.Track.Track.LinkedFrom.URI)
+		default:
+			uris = append(uris, item.Track.Track.URI)
+		}
+	}
+
+	return uris, nil
+}
+
+// planCollection plans restoring a saved collection from a run.
+func (s *Service) planCollection(ctx context.Context, run *ent.BackupRun, name string, strategy restoreplan.Strategy) (schematype.CollectionRestore, error) {
+	c := schematype.CollectionRestore{
+		Collection: name,
+		Save:       []schematype.RestoreItem{},
+		Remove:     []schematype.RestoreItem{},
+	}
+
+	backedUp, err := s.backedUpCollection(ctx, run, name)
+	if err != nil {
+		return c, err
+	}
+
+	live, err := s.liveCollection(ctx, name)
+	if err != nil {
+		return c, err
+	}
+
+	c.Fingerprint = fingerprint(live)
+
+	// The library lists the most recently saved items first, like backups
+	// only the latest time a song was saved counts.
+	current := map[string]schematype.RestoreItem{}
+	for _, item := range live {
+		if _, ok := current[item.URI]; !ok {
+			current[item.URI] = item
+		}
+	}
+
+	for _, item := range backedUp {
+		saved, ok := current[item.URI]
+		delete(current, item.URI)
+
+		// Overwriting Liked Songs saves songs again that were saved at a
+		// different time since, so they are ordered like in the backup.
+		resave := strategy == restoreplan.StrategyOverwrite && saved.AddedAt != nil &&
+			item.AddedAt != nil && !saved.AddedAt.Equal(*item.AddedAt)
+
+		if !ok || resave {
+			c.Save = append(c.Save, item)
+		}
+	}
+
+	// Only overwriting removes the items that were saved after the backup.
+	if strategy == restoreplan.StrategyOverwrite {
+		for _, item := range live {
+			if _, ok := current[item.URI]; ok {
+				c.Remove = append(c.Remove, item)
+				delete(current, item.URI)
+			}
+		}
+	}
+
+	return c, nil
+}
+
+// backedUpCollection returns the items of a saved collection of a run, oldest first.
+func (s *Service) backedUpCollection(ctx context.Context, run *ent.BackupRun, name string) ([]schematype.RestoreItem, error) {
+	var items []schematype.RestoreItem
+	runID := backuprun.ID(run.ID)
+
+	switch name {
+	case "saved_tracks":
+		saved, err := s.db.SavedTrack.Query().
+			Where(savedtrack.HasRunWith(runID)).
+			WithTrack(func(q *ent.TrackQuery) { q.WithArtists() }).
+			Order(ent.Desc(savedtrack.FieldAddedAt)).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		seen := map[string]bool{}
+		for _, t := range saved {
+			uri := t.Edges.Track.URI
+			if t.LinkedFromID != "" {
+				uri = "spotify:track:" + t.LinkedFromID
+			}
+
+			// A song can only be saved once, so only the latest time it was added counts.
+			if seen[uri] {
+				continue
+			}
+			seen[uri] = true
+
+			addedAt := t.AddedAt.UTC()
+			items = append(items, schematype.RestoreItem{URI: uri, Name: trackName(t.Edges.Track), AddedAt: &addedAt})
+		}
+
+		slices.Reverse(items)
+	case "saved_albums":
+		saved, err := s.db.SavedAlbum.Query().
+			Where(savedalbum.HasRunWith(runID)).
+			WithAlbum(func(q *ent.AlbumQuery) { q.WithArtists() }).
+			Order(ent.Asc(savedalbum.FieldAddedAt)).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, a := range saved {
+			items = append(items, schematype.RestoreItem{
+				URI:  a.Edges.Album.URI,
+				Name: withArtists(a.Edges.Album.Name, a.Edges.Album.Edges.Artists),
+			})
+		}
+	case "followed_artists":
+		followed, err := s.db.FollowedArtist.Query().
+			Where(followedartist.HasRunWith(runID)).
+			WithArtist().
+			Order(ent.Asc(followedartist.FieldCreatedAt), ent.Asc(followedartist.FieldID)).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, a := range followed {
+			items = append(items, schematype.RestoreItem{URI: a.Edges.Artist.URI, Name: a.Edges.Artist.Name})
+		}
+	}
+
+	return items, nil
+}
+
+// liveCollection returns the items of a saved collection in the user's library.
+func (s *Service) liveCollection(ctx context.Context, name string) ([]schematype.RestoreItem, error) {
+	var items []schematype.RestoreItem
+
+	switch name {
+	case "saved_tracks":
+		for t, err := range s.client.SavedTracks(ctx) {
+			if err != nil {
+				return nil, fmt.Errorf("failed to get saved tracks: %w", err)
+			}
+
+			if t.Track.IsLocal || t.Track.ID == "" {
+				continue
+			}
+
+			uri := t.Track.URI
+			if t.Track.LinkedFrom != nil {
+				uri = t.Track.LinkedFrom.URI
+			}
+
+			addedAt := t.AddedAt.UTC()
+			items = append(items, schematype.RestoreItem{
+				URI:     uri,
+				Name:    withArtistNames(t.Track.Name, t.Track.ArtistNames()),
+				AddedAt: &addedAt,
+			})
+		}
+	case "saved_albums":
+		for a, err := range s.client.SavedAlbums(ctx) {
+			if err != nil {
+				return nil, fmt.Errorf("failed to get saved albums: %w", err)
+			}
+
+			items = append(items, schematype.RestoreItem{
+				URI:  a.Album.URI,
+				Name: withArtistNames(a.Album.Name, a.Album.ArtistNames()),
+			})
+		}
+	case "followed_artists":
+		for a, err := range s.client.FollowedArtists(ctx) {
+			if err != nil {
+				return nil, fmt.Errorf("failed to get followed artists: %w", err)
+			}
+
+			items = append(items, schematype.RestoreItem{URI: a.URI, Name: a.Name})
+		}
+	}
+
+	return items, nil
+}
+
+// findUnavailable records the tracks that a plan adds to playlists or Liked
+// Songs, but which are not available in the user's market. They are still
+// restored, since they may become available again.
+func (s *Service) findUnavailable(ctx context.Context, playlists []schematype.PlaylistRestore, collections []schematype.CollectionRestore, names map[string]string) error {
+	var uris []string
+	for _, p := range playlists {
+		uris = append(uris, p.URIs...)
+	}
+	for _, c := range collections {
+		if c.Collection == "saved_tracks" {
+			for _, item := range c.Save {
+				uris = append(uris, item.URI)
+				names[item.URI] = item.Name
+			}
+		}
+	}
+
+	unavailable, err := s.unavailableTracks(ctx, uris)
+	if err != nil {
+		return err
+	}
+
+	for i := range playlists {
+		for _, uri := range playlists[i].URIs {
+			if unavailable[uri] {
+				playlists[i].Unavailable = append(playlists[i].Unavailable, schematype.RestoreItem{URI: uri, Name: names[uri]})
+			}
+		}
+	}
+
+	for i := range collections {
+		for _, item := range collections[i].Save {
+			if collections[i].Collection == "saved_tracks" && unavailable[item.URI] {
+				collections[i].Unavailable = append(collections[i].Unavailable, item)
+			}
+		}
+	}
+
+	return nil
+}
+
+// unavailableTracks returns the track URIs that are not playable in the
+// user's market. Tracks that no longer exist are unavailable as well.
+func (s *Service) unavailableTracks(ctx context.Context, uris []string) (map[string]bool, error) {
+	var ids []string
+	for _, uri := range uris {
+		if id, ok := strings.CutPrefix(uri, "spotify:track:"); ok {
+			ids = append(ids, id)
+		}
+	}
+
+	slices.Sort(ids)
+	ids = slices.Compact(ids)
+
+	unavailable := map[string]bool{}
+	for batch := range slices.Chunk(ids, tracksBatchSize) {
+		tracks, err := s.client.Tracks(ctx, batch)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check availability of tracks: %w", err)
+		}
+
+		for i, t := range tracks {
+			if t == nil || t.IsPlayable != nil && !*t.IsPlayable {
+				unavailable["spotify:track:"+batch[i]] = true
+			}
+		}
+	}
+
+	return unavailable, nil
+}
+
+// DetectDrift returns what changed in the parts of the user's library that a
+// plan changes since it was made. Parts of the plan that were applied
+// already are skipped.
+func (s *Service) DetectDrift(ctx context.Context, plan *ent.RestorePlan) ([]string, error) {
+	if err := s.checkUser(ctx, plan); err != nil {
+		return nil, err
+	}
+
+	drift := []string{}
+
+	if slices.ContainsFunc(plan.Playlists, func(p schematype.PlaylistRestore) bool { return !p.Done }) {
+		live, err := s.livePlaylists(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, p := range plan.Playlists {
+			if p.Done {
+				continue
+			}
+
+			current, ok := live[p.PlaylistID]
+			switch {
+			case (p.Action == RestoreActionCreate || p.Action == RestoreActionFollow) && p.LiveSnapshotID == "":
+				if ok {
+					drift = append(drift, fmt.Sprintf("Playlist %q was added back to the library", p.Name))
+				}
+			case p.Action == RestoreActionCreate:
+				// Copies don't depend on the playlist they are created next to.
+			case !ok:
+				drift = append(drift, fmt.Sprintf("Playlist %q was removed from the library", p.Name))
+			case current.SnapshotID != p.LiveSnapshotID:
+				drift = append(drift, fmt.Sprintf("Playlist %q was changed", p.Name))
+			}
+		}
+	}
+
+	for _, c := range plan.Collections {
+		if c.Done {
+			continue
+		}
+
+		live, err := s.liveCollection(ctx, c.Collection)
+		if err != nil {
+			return nil, err
+		}
+
+		if fingerprint(live) != c.Fingerprint {
+			drift = append(drift, collectionTitles[c.Collection]+" were changed")
+		}
+	}
+
+	return drift, nil
+}
+
+// missing returns the items that are not in existing, in the order of items.
+// Items that occur more than once are missing as often as they occur more
+// often than in existing.
+func missing(items, existing []string) []string {
+	counts := map[string]int{}
+	for _, item := range existing {
+		counts[item]++
+	}
+
+	result := []string{}
+	for _, item := range items {
+		if counts[item] > 0 {
+			counts[item]--
+			continue
+		}
+
+		result = append(result, item)
+	}
+
+	return result
+}
+
+// fingerprint identifies the state of a collection, so changes can be detected.
+func fingerprint(items []schematype.RestoreItem) string {
+	lines := make([]string, 0, len(items))
+	for _, item := range items {
+		line := item.URI
+		if item.AddedAt != nil {
+			line += " " + item.AddedAt.UTC().Format(time.RFC3339)
+		}
+
+		lines = append(lines, line)
+	}
+
+	slices.Sort(lines)
+	sum := sha256.Sum256([]byte(strings.Join(lines, "\n")))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// checkUser returns [ErrPlanForOtherUser] if the user that is authenticated
+// with Spotify is not the one the plan was made for.
+func (s *Service) checkUser(ctx context.Context, plan *ent.RestorePlan) error {
+	profile, err := s.client.CurrentUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	if profile.ID != plan.UserID {
+		return ErrPlanForOtherUser
+	}
+
+	return nil
+}
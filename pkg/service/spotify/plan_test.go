@@ -0,0 +1,429 @@
+package spotify
+
+import (
+	"beyerleinf/spotify-backup/ent"
+	"beyerleinf/spotify-backup/ent/restoreplan"
+	"beyerleinf/spotify-backup/ent/schema/schematype"
+	"beyerleinf/spotify-backup/ent/snapshotitem"
+	"beyerleinf/spotify-backup/pkg/fakespotify"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+)
+
+// trackID returns the ID of the n-th track of the default fixture.
+func trackID(n int) string {
+	return fmt.Sprintf("tr%04d0123456789abcdef", n)
+}
+
+func trackURI(n int) string {
+	return "spotify:track:" + trackID(n)
+}
+
+// snapshot returns a complete snapshot of a playlist with the given items.
+func snapshot(playlistID, ownerID, snapshotID string, uris ...string) *ent.PlaylistSnapshot {
+	items := make([]*ent.SnapshotItem, 0, len(uris))
+	for i, uri := range uris {
+		items = append(items, &ent.SnapshotItem{Position: i, Type: snapshotitem.TypeTrack, URI: uri})
+	}
+
+	return &ent.PlaylistSnapshot{
+		ID:         "snapshot-" + playlistID,
+		Name:       "Playlist",
+		OwnerID:    ownerID,
+		SnapshotID: snapshotID,
+		Complete:   true,
+		Edges: ent.PlaylistSnapshotEdges{
+			Playlist: &ent.Playlist{SpotifyID: playlistID},
+			Items:    items,
+		},
+	}
+}
+
+func uris(items []schematype.RestoreItem) []string {
+	result := make([]string, 0, len(items))
+	for _, item := range items {
+		result = append(result, item.URI)
+	}
+
+	return result
+}
+
+func TestMissing(t *testing.T) {
+	tests := []struct {
+		name     string
+		items    []string
+		existing []string
+		want     []string
+	}{
+		{"none existing", []string{"a", "b"}, nil, []string{"a", "b"}},
+		{"all existing", []string{"a", "b"}, []string{"b", "a"}, []string{}},
+		{"some existing", []string{"a", "b", "c"}, []string{"b"}, []string{"a", "c"}},
+		{"duplicates", []string{"a", "a", "b", "a"}, []string{"a"}, []string{"a", "b", "a"}},
+		{"more existing", []string{"a"}, []string{"a", "a"}, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := missing(tt.items, tt.existing); !slices.Equal(got, tt.want) {
+				t.Errorf("missing() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	first := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	later := first.Add(time.Hour)
+
+	items := []schematype.RestoreItem{{URI: "a", AddedAt: &first}, {URI: "b"}}
+
+	tests := []struct {
+		name  string
+		items []schematype.RestoreItem
+		same  bool
+	}{
+		{"same items", []schematype.RestoreItem{{URI: "a", AddedAt: &first}, {URI: "b"}}, true},
+		{"different order", []schematype.RestoreItem{{URI: "b"}, {URI: "a", AddedAt: &first}}, true},
+		{"different name", []schematype.RestoreItem{{URI: "a", Name: "A", AddedAt: &first}, {URI: "b"}}, true},
+		{"added again", []schematype.RestoreItem{{URI: "a", AddedAt: &later}, {URI: "b"}}, false},
+		{"item removed", []schematype.RestoreItem{{URI: "a", AddedAt: &first}}, false},
+		{"item added", []schematype.RestoreItem{{URI: "a", AddedAt: &first}, {URI: "b"}, {URI: "c"}}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fingerprint(tt.items) == fingerprint(items); got != tt.same {
+				t.Errorf("fingerprint() equal = %v, want %v", got, tt.same)
+			}
+		})
+	}
+}
+
+func TestPlanPlaylist(t *testing.T) {
+	ctx := context.Background()
+	s, fake := newService(t, fakespotify.Options{})
+
+	fake.Update(func(f *fakespotify.Fixture) {
+		f.OtherPlaylists = append(f.OtherPlaylists, fakespotify.Playlist{
+			ID:         "plfriend",
+			Name:       "Playlist",
+			Owner:      json.RawMessage(`{"id":"friend"}`),
+			SnapshotID: "friend-snapshot",
+		})
+	})
+
+	live, err := s.livePlaylists(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Road Trip is owned by the user and has the tracks 0 to 23.
+	roadTrip := "pl00000123456789abcdef"
+	items := []string{trackURI(0), trackURI(26), trackURI(1)}
+
+	tests := []struct {
+		name     string
+		snapshot *ent.PlaylistSnapshot
+		strategy restoreplan.Strategy
+		want     schematype.PlaylistRestore
+	}{
+		{
+			name:     "overwrite",
+			snapshot: snapshot(roadTrip, fakeUserID, "old", items...),
+			strategy: restoreplan.StrategyOverwrite,
+			want: schematype.PlaylistRestore{
+				Action: RestoreActionOverwrite, Name: "Playlist", TargetID: roadTrip, URIs: items, Removed: 22,
+			},
+		},
+		{
+			name:     "merge",
+			snapshot: snapshot(roadTrip, fakeUserID, "old", items...),
+			strategy: restoreplan.StrategyMerge,
+			want: schematype.PlaylistRestore{
+				Action: RestoreActionAppend, Name: "Playlist", TargetID: roadTrip, URIs: []string{trackURI(26)},
+			},
+		},
+		{
+			name:     "copy",
+			snapshot: snapshot(roadTrip, fakeUserID, "old", items...),
+			strategy: restoreplan.StrategyCopy,
+			want:     schematype.PlaylistRestore{Action: RestoreActionCreate, Name: "Playlist (restored)", URIs: items},
+		},
+		{
+			name:     "unchanged",
+			snapshot: snapshot(roadTrip, fakeUserID, "MSxmYWtlc25hcHNob3Qx", items...),
+			strategy: restoreplan.StrategyOverwrite,
+			want: schematype.PlaylistRestore{
+				Action: RestoreActionUnchanged, Name: "Playlist", TargetID: roadTrip, URIs: []string{},
+			},
+		},
+		{
+			name:     "changed playlist of another user is left alone",
+			snapshot: snapshot("pl00020123456789abcdef", "friend", "old", items...),
+			strategy: restoreplan.StrategyOverwrite,
+			want: schematype.PlaylistRestore{
+				Action: RestoreActionUnchanged, Name: "Playlist", TargetID: "pl00020123456789abcdef", URIs: []string{},
+			},
+		},
+		{
+			name:     "changed playlist of another user is copied on request",
+			snapshot: snapshot("pl00020123456789abcdef", "friend", "old", items...),
+			strategy: restoreplan.StrategyCopy,
+			want: schematype.PlaylistRestore{
+				Action: RestoreActionCreate, Name: "Playlist (restored)", OwnerID: "friend", URIs: items,
+			},
+		},
+		{
+			name:     "deleted playlist is created",
+			snapshot: snapshot("pldeleted", fakeUserID, "old", items...),
+			strategy: restoreplan.StrategyMerge,
+			want:     schematype.PlaylistRestore{Action: RestoreActionCreate, Name: "Playlist", URIs: items},
+		},
+		{
+			name:     "unfollowed playlist of another user is followed",
+			snapshot: snapshot("plfriend", "friend", "old", items...),
+			strategy: restoreplan.StrategyOverwrite,
+			want: schematype.PlaylistRestore{
+				Action: RestoreActionFollow, Name: "Playlist", TargetID: "plfriend", URIs: []string{},
+			},
+		},
+		{
+			name:     "deleted playlist of another user is copied",
+			snapshot: snapshot("plgone", "friend", "old", items...),
+			strategy: restoreplan.StrategyOverwrite,
+			want: schematype.PlaylistRestore{
+				Action: RestoreActionCreate, Name: "Playlist (restored)", OwnerID: "friend", URIs: items,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.planPlaylist(ctx, tt.snapshot, live, fakeUserID, tt.strategy, map[string]string{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got.Action != tt.want.Action || got.Name != tt.want.Name || got.TargetID != tt.want.TargetID ||
+				got.OwnerID != tt.want.OwnerID || got.Removed != tt.want.Removed {
+				t.Errorf("planPlaylist() = %s %q target %q owner %q removed %d, want %s %q target %q owner %q removed %d",
+					got.Action, got.Name, got.TargetID, got.OwnerID, got.Removed,
+					tt.want.Action, tt.want.Name, tt.want.TargetID, tt.want.OwnerID, tt.want.Removed)
+			}
+
+			if !slices.Equal(got.URIs, tt.want.URIs) {
+				t.Errorf("planPlaylist() URIs = %v, want %v", got.URIs, tt.want.URIs)
+			}
+		})
+	}
+}
+
+func TestPlanCollection(t *testing.T) {
+	ctx := context.Background()
+	s, fake := newService(t, fakespotify.Options{})
+	run := backup(ctx, t, s)
+
+	// Since the backup, the oldest liked song was removed, the second oldest
+	// was saved again and a new one was saved.
+	fake.Update(func(f *fakespotify.Fixture) {
+		f.SavedTracks = slices.DeleteFunc(f.SavedTracks, func(item fakespotify.SavedItem) bool {
+			return item.ID == trackID(0)
+		})
+
+		for i := range f.SavedTracks {
+			if f.SavedTracks[i].ID == trackID(2) {
+				f.SavedTracks[i].AddedAt = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+			}
+		}
+
+		f.SavedTracks = append(f.SavedTracks, fakespotify.SavedItem{
+			AddedAt: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+			ID:      trackID(27),
+		})
+	})
+
+	tests := []struct {
+		strategy   restoreplan.Strategy
+		wantSave   []string
+		wantRemove []string
+	}{
+		{restoreplan.StrategyOverwrite, []string{trackURI(0), trackURI(2)}, []string{trackURI(27)}},
+		{restoreplan.StrategyMerge, []string{trackURI(0)}, []string{}},
+		{restoreplan.StrategyCopy, []string{trackURI(0)}, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.strategy), func(t *testing.T) {
+			got, err := s.planCollection(ctx, run, "saved_tracks", tt.strategy)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if save := uris(got.Save); !slices.Equal(save, tt.wantSave) {
+				t.Errorf("planCollection() Save = %v, want %v", save, tt.wantSave)
+			}
+
+			if remove := uris(got.Remove); !slices.Equal(remove, tt.wantRemove) {
+				t.Errorf("planCollection() Remove = %v, want %v", remove, tt.wantRemove)
+			}
+		})
+	}
+}
+
+func TestFindUnavailable(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newService(t, fakespotify.Options{})
+
+	// Track 29 isn't playable in the user's market and the other track doesn't exist.
+	gone := "spotify:track:trgone0123456789abcdef"
+	playlists := []schematype.PlaylistRestore{
+		{URIs: []string{trackURI(0), trackURI(29), "spotify:episode:ep00000123456789abcdef", gone}},
+		{URIs: []string{trackURI(1)}},
+	}
+	collections := []schematype.CollectionRestore{
+		{Collection: "saved_tracks", Save: []schematype.RestoreItem{{URI: trackURI(29)}, {URI: trackURI(2)}}},
+		{Collection: "saved_albums", Save: []schematype.RestoreItem{{URI: "spotify:album:al00000123456789abcdef"}}},
+	}
+
+	if err := s.findUnavailable(ctx, playlists, collections, map[string]string{}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		got  []schematype.RestoreItem
+		want []string
+	}{
+		{"playlist", playlists[0].Unavailable, []string{trackURI(29), gone}},
+		{"available playlist", playlists[1].Unavailable, []string{}},
+		{"liked songs", collections[0].Unavailable, []string{trackURI(29)}},
+		{"saved albums", collections[1].Unavailable, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := uris(tt.got); !slices.Equal(got, tt.want) {
+				t.Errorf("findUnavailable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetectDrift(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(f *fakespotify.Fixture)
+		want   []string
+	}{
+		{
+			name:   "unchanged",
+			change: func(f *fakespotify.Fixture) {},
+			want:   []string{},
+		},
+		{
+			name: "playlist changed",
+			change: func(f *fakespotify.Fixture) {
+				f.Playlists[0].SnapshotID = "changed"
+			},
+			want: []string{`Playlist "Road Trip" was changed`},
+		},
+		{
+			name: "playlist removed",
+			change: func(f *fakespotify.Fixture) {
+				f.Playlists = f.Playlists[1:]
+			},
+			want: []string{`Playlist "Road Trip" was removed from the library`},
+		},
+		{
+			name: "liked songs changed",
+			change: func(f *fakespotify.Fixture) {
+				f.SavedTracks = f.SavedTracks[1:]
+			},
+			want: []string{"Liked Songs were changed"},
+		},
+		{
+			name: "artist unfollowed",
+			change: func(f *fakespotify.Fixture) {
+				f.FollowedArtists = f.FollowedArtists[1:]
+			},
+			want: []string{"Followed Artists were changed"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			s, fake := newService(t, fakespotify.Options{})
+			run := backup(ctx, t, s)
+
+			plan, err := s.PlanRestore(ctx, PlanRequest{RunID: run.ID, Strategy: restoreplan.StrategyMerge})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			fake.Update(tt.change)
+
+			got, err := s.DetectDrift(ctx, plan)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("DetectDrift() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetectDriftFailsForOtherUser(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newService(t, fakespotify.Options{})
+
+	plan := &ent.RestorePlan{UserID: "someone"}
+	if _, err := s.DetectDrift(ctx, plan); !errors.Is(err, ErrPlanForOtherUser) {
+		t.Errorf("DetectDrift() = %v, want ErrPlanForOtherUser", err)
+	}
+}
+
+func TestApplyRestorePlanFailsOnDrift(t *testing.T) {
+	ctx := context.Background()
+	s, fake := newService(t, fakespotify.Options{})
+	run := backup(ctx, t, s)
+
+	plan, err := s.PlanRestore(ctx, PlanRequest{
+		RunID:       run.ID,
+		Collections: []string{"saved_tracks"},
+		Strategy:    restoreplan.StrategyOverwrite,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Saving a song changes the fingerprint of Liked Songs.
+	fake.Update(func(f *fakespotify.Fixture) {
+		f.SavedTracks = append(f.SavedTracks, fakespotify.SavedItem{AddedAt: time.Now().UTC(), ID: trackID(27)})
+	})
+
+	var drift *PlanDriftError
+	if _, err := s.ApplyRestorePlan(ctx, plan.ID); !errors.As(err, &drift) {
+		t.Fatalf("ApplyRestorePlan() = %v, want PlanDriftError", err)
+	}
+
+	if want := []string{"Liked Songs were changed"}; !slices.Equal(drift.Drift, want) {
+		t.Errorf("ApplyRestorePlan() drift = %q, want %q", drift.Drift, want)
+	}
+
+	plan, err = s.GetRestorePlan(ctx, plan.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if plan.Status != restoreplan.StatusStale {
+		t.Errorf("ApplyRestorePlan() status = %s, want %s", plan.Status, restoreplan.StatusStale)
+	}
+}
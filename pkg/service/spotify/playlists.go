@@ -0,0 +1,440 @@
+package spotify
+
+import (
+	"beyerleinf/spotify-backup/ent"
+	"beyerleinf/spotify-backup/ent/backuprun"
+	"beyerleinf/spotify-backup/ent/episode"
+	"beyerleinf/spotify-backup/ent/playlist"
+	"beyerleinf/spotify-backup/ent/playlistsnapshot"
+	"beyerleinf/spotify-backup/ent/schema/schematype"
+	"beyerleinf/spotify-backup/ent/snapshotitem"
+	"beyerleinf/spotify-backup/ent/track"
+	"beyerleinf/spotify-backup/pkg/util"
+	"context"
+	"fmt"
+	"iter"
+	"net/url"
+	"slices"
+	"strconv"
+	"sync/atomic"
+
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	playlistsPageSize     = 50
+	playlistItemsPageSize = 100
+)
+
+// Playlists iterates over all playlists owned or followed by the current user.
+// [Get Current User's Playlists]: https://developer.spotify.com/documentation/web-api/reference/get-a-list-of-current-users-playlists
+func (c *Client) Playlists(ctx context.Context) iter.Seq2[SimplifiedPlaylist, error] {
+	return func(yield func(SimplifiedPlaylist, error) bool) {
+		for p, err := range Paginate[SimplifiedPlaylist](ctx, c, "/me/playlists", limit(playlistsPageSize)) {
+			// Spotify occasionally returns null entries for playlists
+			// that are no longer available.
+			if err == nil && p.ID == "" {
+				continue
+			}
+
+			if !yield(p, err) {
+				return
+			}
+		}
+	}
+}
+
+// PlaylistItems iterates over all items of a playlist in their playlist order.
+// [Get Playlist Items]: https://developer.spotify.com/documentation/web-api/reference/get-playlists-tracks
+func (c *Client) PlaylistItems(ctx context.Context, playlistID string) iter.Seq2[PlaylistItem, error] {
+	return Paginate[PlaylistItem](ctx, c, playlistItemsPath(playlistID), playlistItemsQuery(0))
+}
+
+// PlaylistItemPages iterates over the pages of a playlist's items like
+// [Client.PlaylistItems], starting at the item at offset.
+func (c *Client) PlaylistItemPages(ctx context.Context, playlistID string, offset int) iter.Seq2[Paging[PlaylistItem], error] {
+	return Pages[PlaylistItem](ctx, c, playlistItemsPath(playlistID), playlistItemsQuery(offset))
+}
+
+func playlistItemsPath(playlistID string) string {
+	return "/playlists/" + url.PathEscape(playlistID) + "/tracks"
+}
+
+func playlistItemsQuery(offset int) url.Values {
+	query := limit(playlistItemsPageSize)
+	query.Set("additional_types", "track,episode")
+	// Requesting the items for the user's market makes Spotify report whether
+	// they are playable and which tracks have been relinked.
+	query.Set("market", "from_token")
+
+	if offset > 0 {
+		query.Set("offset", strconv.Itoa(offset))
+	}
+
+	return query
+}
+
+// backupPlaylists stores a snapshot of every playlist of the current user
+// as part of the given run. The items of a playlist are only fetched if its
+// snapshot_id changed since the last backup, otherwise the latest snapshot
+// is linked to the run.
+//
+// Progress is checkpointed after every page of items. If the run is resumed,
+// playlists that were backed up already are skipped and a partially fetched
+// playlist continues at the last page, unless it changed in the meantime.
+//
+// Up to [config.BackupConfig.PlaylistConcurrency] playlists are backed up at
+// the same time. Their requests are still throttled by the shared rate limiter,
+// so more workers only help as long as the limiter has capacity left. The first
+// playlist that fails cancels the others.
+func (s *Service) backupPlaylists(ctx context.Context, run *ent.BackupRun) (int, error) {
+	playlists, err := Collect(s.client.Playlists(ctx))
+	if err != nil {
+		return 0, fmt.Errorf("failed to list playlists: %w", err)
+	}
+
+	done, err := s.db.Playlist.Query().
+		Where(playlist.HasSnapshotsWith(
+			playlistsnapshot.Complete(true),
+			playlistsnapshot.HasRunsWith(backuprun.ID(run.ID)),
+		)).
+		Select(playlist.FieldSpotifyID).
+		Strings(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get backed up playlists: %w", err)
+	}
+
+	progress := newPlaylistProgress(run)
+
+	var unchanged atomic.Int64
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(max(s.config.Backup.PlaylistConcurrency, 1))
+
+	for _, p := range playlists {
+		if gctx.Err() != nil {
+			break
+		}
+
+		if slices.Contains(done, p.ID) {
+			s.slogger.Verbose("Playlist is already backed up", "playlist", p.ID, "name", p.Name)
+			continue
+		}
+
+		g.Go(func() error {
+			reused, err := s.backupPlaylist(gctx, run, p, progress)
+			if err != nil {
+				return fmt.Errorf("failed to save playlist %s: %w", p.ID, err)
+			}
+
+			if reused {
+				unchanged.Add(1)
+			}
+
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return 0, err
+	}
+
+	// Playlists that were removed since the run was interrupted
+	// leave an incomplete snapshot behind.
+	for _, playlistID := range progress.pending() {
+		if err := progress.discard(ctx, s.db, playlistID); err != nil {
+			return 0, fmt.Errorf("failed to discard incomplete snapshot of playlist %s: %w", playlistID, err)
+		}
+	}
+
+	s.slogger.Info("Backed up playlists", "run", run.ID, "count", len(playlists), "unchanged", unchanged.Load(),
+		"already_backed_up", len(done))
+
+	return len(playlists), nil
+}
+
+// backupPlaylist stores a snapshot of a playlist as part of the run. It reports
+// whether the latest snapshot was reused because the playlist didn't change.
+func (s *Service) backupPlaylist(ctx context.Context, run *ent.BackupRun, p SimplifiedPlaylist, progress *playlistProgress) (bool, error) {
+	latest, err := s.latestPlaylistSnapshot(ctx, p.ID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get latest snapshot: %w", err)
+	}
+
+	if latest != nil && latest.SnapshotID == p.SnapshotID {
+		if err := s.reusePlaylistSnapshot(ctx, run, p, latest); err != nil {
+			return false, err
+		}
+
+		s.slogger.Verbose("Playlist is unchanged", "playlist", p.ID, "name", p.Name, "snapshot", latest.ID)
+
+		return true, nil
+	}
+
+	total, err := s.fetchPlaylistSnapshot(ctx, run, p, progress)
+	if err != nil {
+		return false, err
+	}
+
+	s.slogger.Verbose("Backed up playlist", "playlist", p.ID, "name", p.Name, "items", total)
+
+	return false, nil
+}
+
+// latestPlaylistSnapshot returns the most recent complete snapshot of a playlist
+// or nil if it has never been backed up.
+func (s *Service) latestPlaylistSnapshot(ctx context.Context, spotifyID string) (*ent.PlaylistSnapshot, error) {
+	snapshot, err := s.db.PlaylistSnapshot.Query().
+		Where(
+			playlistsnapshot.HasPlaylistWith(playlist.SpotifyID(spotifyID)),
+			playlistsnapshot.Complete(true),
+		).
+		Order(ent.Desc(playlistsnapshot.FieldCreatedAt)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+
+	return snapshot, err
+}
+
+// reusePlaylistSnapshot links the latest snapshot of a playlist whose items
+// didn't change to the run. Details like the visibility of a playlist can
+// change without a new snapshot_id, in which case a new snapshot with the
+// items of the latest one is stored.
+func (s *Service) reusePlaylistSnapshot(ctx context.Context, run *ent.BackupRun, p SimplifiedPlaylist, latest *ent.PlaylistSnapshot) error {
+	if snapshotMatches(latest, p) {
+		return s.db.PlaylistSnapshot.UpdateOne(latest).AddRuns(run).Exec(ctx)
+	}
+
+	return util.WithTx(ctx, s.db, func(tx *ent.Tx) error {
+		create, err := createPlaylistSnapshot(ctx, tx, run, p)
+		if err != nil {
+			return err
+		}
+
+		snapshot, err := create.SetTotal(latest.Total).Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		items, err := tx.PlaylistSnapshot.QueryItems(latest).
+			WithTrack(func(q *ent.TrackQuery) { q.Select(track.FieldID) }).
+			WithEpisode(func(q *ent.EpisodeQuery) { q.Select(episode.FieldID) }).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+
+		return createInBatches(ctx, tx.SnapshotItem, items, func(c *ent.SnapshotItemCreate, item *ent.SnapshotItem) {
+			c.SetSnapshot(snapshot).
+				SetPosition(item.Position).
+				SetNillableAddedAt(item.AddedAt).
+				SetAddedBy(item.AddedBy).
+				SetIsLocal(item.IsLocal).
+				SetType(item.Type).
+				SetURI(item.URI).
+				SetLinkedFromID(item.LinkedFromID).
+				SetNillableIsPlayable(item.IsPlayable)
+
+			if item.Edges.Track != nil {
+				c.SetTrackID(item.Edges.Track.ID)
+			}
+
+			if item.Edges.Episode != nil {
+				c.SetEpisodeID(item.Edges.Episode.ID)
+			}
+		})
+	})
+}
+
+// snapshotMatches reports whether the details of a playlist equal those stored in snapshot.
+func snapshotMatches(snapshot *ent.PlaylistSnapshot, p SimplifiedPlaylist) bool {
+	samePublic := (snapshot.Public == nil) == (p.Public == nil) &&
+		(p.Public == nil || *snapshot.Public == *p.Public)
+
+	return samePublic &&
+		snapshot.Name == p.Name &&
+		snapshot.Description == p.Description &&
+		snapshot.OwnerID == p.Owner.ID &&
+		snapshot.OwnerName == p.Owner.DisplayName &&
+		snapshot.Collaborative == p.Collaborative
+}
+
+// fetchPlaylistSnapshot fetches the items of a playlist page by page and stores
+// them in a new snapshot, which is marked as complete once all items are stored.
+// It continues an incomplete snapshot of the run if the playlist didn't change.
+// It returns the number of items of the playlist.
+func (s *Service) fetchPlaylistSnapshot(ctx context.Context, run *ent.BackupRun, p SimplifiedPlaylist, progress *playlistProgress) (int, error) {
+	cp, ok := progress.get(p.ID)
+	if ok && cp.SnapshotID != p.SnapshotID {
+		s.slogger.Verbose("Playlist changed since the backup was interrupted, starting over", "playlist", p.ID)
+
+		if err := progress.discard(ctx, s.db, p.ID); err != nil {
+			return 0, err
+		}
+
+		ok = false
+	}
+
+	if ok {
+		s.slogger.Verbose("Resuming playlist", "playlist", p.ID, "offset", cp.Offset)
+	} else {
+		cp = schematype.PlaylistCheckpoint{SnapshotID: p.SnapshotID}
+
+		err := progress.commit(ctx, s.db, p.ID, &cp, func(tx *ent.Tx) error {
+			create, err := createPlaylistSnapshot(ctx, tx, run, p)
+			if err != nil {
+				return err
+			}
+
+			snapshot, err := create.SetTotal(0).SetComplete(false).Save(ctx)
+			if err != nil {
+				return err
+			}
+
+			cp.Snapshot = snapshot.ID
+
+			return nil
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	for page, err := range s.client.PlaylistItemPages(ctx, p.ID, cp.Offset) {
+		if err != nil {
+			return 0, fmt.Errorf("failed to get items of playlist %s: %w", p.ID, err)
+		}
+
+		err = progress.commit(ctx, s.db, p.ID, &cp, func(tx *ent.Tx) error {
+			if err := savePlaylistItems(ctx, tx, cp.Snapshot, page); err != nil {
+				return err
+			}
+
+			cp.Offset = page.Offset + len(page.Items)
+
+			return nil
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	err := progress.commit(ctx, s.db, p.ID, nil, func(tx *ent.Tx) error {
+		return tx.PlaylistSnapshot.UpdateOneID(cp.Snapshot).
+			SetTotal(cp.Offset).
+			SetComplete(true).
+			Exec(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return cp.Offset, nil
+}
+
+// savePlaylistItems stores a page of playlist items in a snapshot. Items are
+// positioned by their offset in the playlist.
+func savePlaylistItems(ctx context.Context, tx *ent.Tx, snapshotID string, page Paging[PlaylistItem]) error {
+	cat := newCatalog(tx)
+
+	var tracks []Track
+	var episodes []Episode
+	positions := make([]int, 0, len(page.Items))
+	for i, item := range page.Items {
+		// Items whose track has been removed from Spotify are returned without one.
+		if item.Track == nil {
+			continue
+		}
+
+		switch {
+		case item.Track.Episode != nil:
+			episodes = append(episodes, *item.Track.Episode)
+		case item.Track.Track != nil:
+			tracks = append(tracks, *item.Track.Track)
+		default:
+			continue
+		}
+
+		positions = append(positions, i)
+	}
+
+	if err := cat.addTracks(ctx, tracks); err != nil {
+		return err
+	}
+
+	if err := cat.addEpisodes(ctx, episodes); err != nil {
+		return err
+	}
+
+	return createInBatches(ctx, tx.SnapshotItem, positions, func(c *ent.SnapshotItemCreate, i int) {
+		setSnapshotItem(c.SetSnapshotID(snapshotID).SetPosition(page.Offset+i), cat, page.Items[i])
+	})
+}
+
+// createPlaylistSnapshot prepares a new snapshot of the run with the details
+// of a playlist. The playlist itself is created when it is backed up for the
+// first time.
+func createPlaylistSnapshot(
+	ctx context.Context, tx *ent.Tx, run *ent.BackupRun, p SimplifiedPlaylist,
+) (*ent.PlaylistSnapshotCreate, error) {
+	pl, err := tx.Playlist.Query().Where(playlist.SpotifyID(p.ID)).Only(ctx)
+	if ent.IsNotFound(err) {
+		pl, err = tx.Playlist.Create().SetSpotifyID(p.ID).Save(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.PlaylistSnapshot.Create().
+		SetPlaylist(pl).
+		AddRuns(run).
+		SetName(p.Name).
+		SetDescription(p.Description).
+		SetOwnerID(p.Owner.ID).
+		SetOwnerName(p.Owner.DisplayName).
+		SetCollaborative(p.Collaborative).
+		SetNillablePublic(p.Public).
+		SetSnapshotID(p.SnapshotID), nil
+}
+
+// setSnapshotItem sets the fields of a snapshot item and links it to the
+// catalog entry of its track or episode. Local files are only stored by their URI.
+func setSnapshotItem(create *ent.SnapshotItemCreate, cat *catalog, item PlaylistItem) {
+	create.
+		SetNillableAddedAt(item.AddedAt).
+		SetIsLocal(item.IsLocal)
+
+	if item.AddedBy != nil {
+		create.SetAddedBy(item.AddedBy.ID)
+	}
+
+	if item.Track.Episode != nil {
+		episode := item.Track.Episode
+
+		create.
+			SetType(snapshotitem.TypeEpisode).
+			SetURI(episode.URI)
+
+		if id, ok := cat.episodes[episode.ID]; ok {
+			create.SetEpisodeID(id)
+		}
+
+		return
+	}
+
+	track := item.Track.Track
+
+	create.
+		SetType(snapshotitem.TypeTrack).
+		SetURI(track.URI).
+		SetNillableIsPlayable(track.IsPlayable)
+
+	if track.LinkedFrom != nil {
+		create.SetLinkedFromID(track.LinkedFrom.ID)
+	}
+
+	if id, ok := cat.tracks[track.ID]; ok && !track.IsLocal {
+		create.SetTrackID(id)
+	}
+}
@@ -0,0 +1,406 @@
+package spotify
+
+import (
+	"beyerleinf/spotify-backup/ent"
+	"beyerleinf/spotify-backup/ent/restoreplan"
+	"beyerleinf/spotify-backup/ent/schema/schematype"
+	"beyerleinf/spotify-backup/ent/snapshotitem"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	// playlistItemsBatchSize is the number of items that can be added to a
+	// playlist with a single request.
+	playlistItemsBatchSize = 100
+	// rateLimitAttempts is how often a request that modifies the library is
+	// sent if Spotify keeps rejecting it because of its rate limit.
+	rateLimitAttempts = 3
+)
+
+// ErrIncompleteSnapshot is returned when restoring a snapshot whose
+// items were not backed up completely.
+var ErrIncompleteSnapshot = errors.New("the playlist snapshot is incomplete and cannot be restored")
+
+// PlaylistDetails are the properties of a playlist that can be changed.
+type PlaylistDetails struct {
+	Name          string `json:"name"`
+	Description   string `json:"description"`
+	Public        *bool  `json:"public,omitempty"`
+	Collaborative bool   `json:"collaborative"`
+}
+
+// CreatePlaylist creates a playlist owned by the user.
+// [Create Playlist]: https://developer.spotify.com/documentation/web-api/reference/create-playlist
+func (c *Client) CreatePlaylist(ctx context.Context, userID string, details PlaylistDetails) (SimplifiedPlaylist, error) {
+	var p SimplifiedPlaylist
+	err := c.Send(ctx, http.MethodPost, "/users/"+url.PathEscape(userID)+"/playlists", details, &p)
+
+	return p, err
+}
+
+// Playlist returns a playlist, whether or not it is in the user's library.
+// [Get Playlist]: https://developer.spotify.com/documentation/web-api/reference/get-playlist
+func (c *Client) Playlist(ctx context.Context, playlistID string) (SimplifiedPlaylist, error) {
+	query := url.Values{}
+	query.Set("fields", "id,name,description,collaborative,public,snapshot_id,uri,owner,images,tracks(href,total)")
+
+	var p SimplifiedPlaylist
+	err := c.Get(ctx, "/playlists/"+url.PathEscape(playlistID), query, &p)
+
+	return p, err
+}
+
+// FollowPlaylist adds a playlist of another user to the user's library.
+// [Follow Playlist]: https://developer.spotify.com/documentation/web-api/reference/follow-playlist
+func (c *Client) FollowPlaylist(ctx context.Context, playlistID string) error {
+	return c.Send(ctx, http.MethodPut, "/playlists/"+url.PathEscape(playlistID)+"/followers", map[string]any{}, nil)
+}
+
+// ChangePlaylistDetails changes the name, description and visibility of a playlist.
+// [Change Playlist Details]: https://developer.spotify.com/documentation/web-api/reference/change-playlist-details
+func (c *Client) ChangePlaylistDetails(ctx context.Context, playlistID string, details PlaylistDetails) error {
+	return c.Send(ctx, http.MethodPut, "/playlists/"+url.PathEscape(playlistID), details, nil)
+}
+
+// ReplacePlaylistItems replaces all items of a playlist with up to
+// [playlistItemsBatchSize] items. An empty list clears the playlist.
+// [Update Playlist Items]: https://developer.spotify.com/documentation/web-api/reference/reorder-or-replace-playlists-tracks
+func (c *Client) ReplacePlaylistItems(ctx context.Context, playlistID string, uris []string) error {
+	return c.Send(ctx, http.MethodPut, playlistItemsPath(playlistID), map[string]any{"uris": uris}, nil)
+}
+
+// AddPlaylistItems appends up to [playlistItemsBatchSize] items to a playlist.
+// [Add Items to Playlist]: https://developer.spotify.com/documentation/web-api/reference/add-tracks-to-playlist
+func (c *Client) AddPlaylistItems(ctx context.Context, playlistID string, uris []string) error {
+	return c.Send(ctx, http.MethodPost, playlistItemsPath(playlistID), map[string]any{"uris": uris}, nil)
+}
+
+// Tracks returns up to [tracksBatchSize] tracks for the user's market, so
+// they report whether they are playable. Tracks that don't exist are nil.
+// [Get Several Tracks]: https://developer.spotify.com/documentation/web-api/reference/get-several-tracks
+func (c *Client) Tracks(ctx context.Context, ids []string) ([]*Track, error) {
+	query := url.Values{}
+	query.Set("ids", strings.Join(ids, ","))
+	query.Set("market", "from_token")
+
+	var res struct {
+		Tracks []*Track `json:"tracks"`
+	}
+	err := c.Get(ctx, "/tracks", query, &res)
+
+	return res.Tracks, err
+}
+
+// ApplyRestorePlan applies a restore plan exactly as it was planned. Before
+// anything is changed, the plan is checked for drift. If the library changed
+// since the plan was made, the plan becomes stale and a [PlanDriftError] is
+// returned.
+//
+// Progress is saved after every change, so a plan that failed to apply
+// completely continues where it stopped when it is applied again.
+func (s *Service) ApplyRestorePlan(ctx context.Context, id string) (*ent.RestorePlan, error) {
+	plan, err := s.db.RestorePlan.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	switch plan.Status {
+	case restoreplan.StatusApplied:
+		return plan, nil
+	case restoreplan.StatusPending:
+		if err := s.checkDrift(ctx, plan); err != nil {
+			return nil, err
+		}
+
+		if plan, err = plan.Update().SetStatus(restoreplan.StatusApplying).Save(ctx); err != nil {
+			return nil, err
+		}
+	case restoreplan.StatusApplying:
+		// The plan is resumed, the changes it made already would be reported
+		// as drift, but it must still be applied to the library of its user.
+		if err := s.checkUser(ctx, plan); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, ErrPlanNotPending
+	}
+
+	save := func() error {
+		return s.db.RestorePlan.UpdateOne(plan).
+			SetPlaylists(plan.Playlists).
+			SetCollections(plan.Collections).
+			Exec(ctx)
+	}
+
+	for i := range plan.Playlists {
+		p := &plan.Playlists[i]
+		if p.Done {
+			continue
+		}
+
+		if err := s.applyPlaylistRestore(ctx, plan.UserID, p, save); err != nil {
+			return plan, fmt.Errorf("failed to restore playlist %q: %w", p.Name, err)
+		}
+
+		p.Done = true
+		if err := save(); err != nil {
+			return plan, err
+		}
+	}
+
+	for i := range plan.Collections {
+		c := &plan.Collections[i]
+		if c.Done {
+			continue
+		}
+
+		if err := s.applyCollectionRestore(ctx, c); err != nil {
+			return plan, fmt.Errorf("failed to restore %s: %w", collectionTitles[c.Collection], err)
+		}
+
+		c.Done = true
+		if err := save(); err != nil {
+			return plan, err
+		}
+	}
+
+	s.slogger.Info("Applied restore plan", "plan", plan.ID, "playlists", len(plan.Playlists), "collections", len(plan.Collections))
+
+	return plan.Update().
+		SetStatus(restoreplan.StatusApplied).
+		SetAppliedAt(time.Now()).
+		Save(ctx)
+}
+
+// checkDrift returns a [PlanDriftError] and marks the plan as stale if the
+// library changed since it was made.
+func (s *Service) checkDrift(ctx context.Context, plan *ent.RestorePlan) error {
+	drift, err := s.DetectDrift(ctx, plan)
+	if err != nil {
+		return err
+	}
+
+	if len(drift) == 0 {
+		return nil
+	}
+
+	err = plan.Update().
+		SetStatus(restoreplan.StatusStale).
+		SetDrift(drift).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return &PlanDriftError{Drift: drift}
+}
+
+// applyPlaylistRestore creates, overwrites, appends to or follows a playlist as planned.
+func (s *Service) applyPlaylistRestore(ctx context.Context, userID string, p *schematype.PlaylistRestore, save func() error) error {
+	details := PlaylistDetails{
+		Name:          p.Name,
+		Description:   p.Description,
+		Public:        p.Public,
+		Collaborative: p.Collaborative,
+	}
+
+	switch p.Action {
+	case RestoreActionUnchanged:
+		return nil
+	case RestoreActionFollow:
+		err := retryRateLimited(ctx, func() error {
+			return s.client.FollowPlaylist(ctx, p.TargetID)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to follow playlist: %w", err)
+		}
+
+		return nil
+	case RestoreActionCreate:
+		if p.TargetID == "" {
+			var created SimplifiedPlaylist
+			err := retryRateLimited(ctx, func() (err error) {
+				created, err = s.client.CreatePlaylist(ctx, userID, details)
+				return err
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create playlist: %w", err)
+			}
+
+			// The playlist is saved right away, so it isn't created twice.
+			p.TargetID = created.ID
+			if err := save(); err != nil {
+				return err
+			}
+		}
+	case RestoreActionOverwrite:
+		if p.Added == 0 {
+			if err := s.client.ChangePlaylistDetails(ctx, p.TargetID, details); err != nil {
+				return fmt.Errorf("failed to change playlist details: %w", err)
+			}
+
+			// Replacing the items with the first batch clears the playlist.
+			first := p.URIs[:min(len(p.URIs), playlistItemsBatchSize)]
+			if err := s.client.ReplacePlaylistItems(ctx, p.TargetID, first); err != nil {
+				return fmt.Errorf("failed to replace playlist items: %w", err)
+			}
+
+			p.Added = len(first)
+			if err := save(); err != nil {
+				return err
+			}
+		}
+	}
+
+	for batch := range slices.Chunk(p.URIs[p.Added:], playlistItemsBatchSize) {
+		err := retryRateLimited(ctx, func() error {
+			return s.client.AddPlaylistItems(ctx, p.TargetID, batch)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add playlist items: %w", err)
+		}
+
+		p.Added += len(batch)
+		if err := save(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// applyCollectionRestore removes and saves the items of a collection as
+// planned. Saving and removing items is idempotent, so it is repeated
+// completely if it fails.
+func (s *Service) applyCollectionRestore(ctx context.Context, c *schematype.CollectionRestore) error {
+	remove := spotifyIDs(c.Remove)
+	save := spotifyIDs(c.Save)
+
+	switch c.Collection {
+	case "saved_tracks":
+		tracks := make([]TimestampedID, 0, len(c.Save))
+		for _, item := range c.Save {
+			tracks = append(tracks, TimestampedID{ID: spotifyID(item.URI), AddedAt: *item.AddedAt})
+		}
+
+		if err := applyInBatches(ctx, remove, saveTracksBatchSize, s.client.RemoveSavedTracks); err != nil {
+			return err
+		}
+
+		return applyInBatches(ctx, tracks, saveTracksBatchSize, s.client.SaveTracks)
+	case "saved_albums":
+		if err := applyInBatches(ctx, remove, saveAlbumsBatchSize, s.client.RemoveSavedAlbums); err != nil {
+			return err
+		}
+
+		return applyInBatches(ctx, save, saveAlbumsBatchSize, s.client.SaveAlbums)
+	case "followed_artists":
+		if err := applyInBatches(ctx, remove, followArtistsBatchSize, s.client.UnfollowArtists); err != nil {
+			return err
+		}
+
+		return applyInBatches(ctx, save, followArtistsBatchSize, s.client.FollowArtists)
+	}
+
+	return nil
+}
+
+// spotifyIDs returns the Spotify IDs of items.
+func spotifyIDs(items []schematype.RestoreItem) []string {
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, spotifyID(item.URI))
+	}
+
+	return ids
+}
+
+// spotifyID returns the ID of a Spotify URI like spotify:track:{id}.
+func spotifyID(uri string) string {
+	return uri[strings.LastIndex(uri, ":")+1:]
+}
+
+// restoreURI returns the URI to add for an item. Tracks that Spotify relinked
+// are restored as the track that was originally added, so Spotify can relink
+// it again for the user's market.
+func restoreURI(item *ent.SnapshotItem) string {
+	if item.Type == snapshotitem.TypeTrack && item.LinkedFromID != "" {
+		return "spotify:track:" + item.LinkedFromID
+	}
+
+	return item.URI
+}
+
+// retryRateLimited calls fn again after the delay Spotify asked for if it was
+// rate limited. Unlike other requests, POST requests are not retried by the
+// transport, but a rejected request hasn't modified anything and can be sent again.
+func retryRateLimited(ctx context.Context, fn func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := fn()
+
+		var limited *RateLimitedError
+		if attempt == rateLimitAttempts || !errors.As(err, &limited) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(max(limited.RetryAfter, time.Second)):
+		}
+	}
+}
+
+// restorableCollections are the saved collections that can be restored,
+// in the order they are restored.
+var restorableCollections = []string{"saved_tracks", "saved_albums", "followed_artists"}
+
+var (
+	// ErrCollectionNotRestorable is returned when restoring a collection other than the [restorableCollections].
+	ErrCollectionNotRestorable = errors.New("only liked songs, saved albums and followed artists can be restored")
+	// ErrCollectionNotBackedUp is returned when restoring a collection that the backup run failed to back up.
+	ErrCollectionNotBackedUp = errors.New("the collection was not backed up successfully by this backup")
+)
+
+// RestorableCollections returns the names of the collections that can be
+// restored from a backup run, in the order they are restored.
+func (s *Service) RestorableCollections(run *ent.BackupRun) []string {
+	return slices.DeleteFunc(slices.Clone(restorableCollections), func(name string) bool {
+		return !collectionSucceeded(run, name)
+	})
+}
+
+// collectionsToRestore validates the names of the collections to restore from
+// a run and returns them in the order they are restored.
+func collectionsToRestore(run *ent.BackupRun, names []string) ([]string, error) {
+	for _, name := range names {
+		if !slices.Contains(restorableCollections, name) {
+			return nil, ErrCollectionNotRestorable
+		}
+		if !collectionSucceeded(run, name) {
+			return nil, ErrCollectionNotBackedUp
+		}
+	}
+
+	return slices.DeleteFunc(slices.Clone(restorableCollections), func(name string) bool {
+		return !slices.Contains(names, name)
+	}), nil
+}
+
+// applyInBatches calls apply with batches of up to size items.
+func applyInBatches[T any](ctx context.Context, items []T, size int, apply func(context.Context, []T) error) error {
+	for batch := range slices.Chunk(items, size) {
+		if err := retryRateLimited(ctx, func() error { return apply(ctx, batch) }); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
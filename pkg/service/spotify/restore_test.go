@@ -0,0 +1,141 @@
+package spotify
+
+import (
+	"beyerleinf/spotify-backup/ent/restoreplan"
+	"beyerleinf/spotify-backup/pkg/fakespotify"
+	"context"
+	"encoding/json"
+	"slices"
+	"testing"
+	"time"
+)
+
+// playlistByName returns the playlist of the library with the given name, if any.
+func playlistByName(f *fakespotify.Fixture, name string) (fakespotify.Playlist, bool) {
+	i := slices.IndexFunc(f.Playlists, func(p fakespotify.Playlist) bool { return p.Name == name })
+	if i < 0 {
+		return fakespotify.Playlist{}, false
+	}
+
+	return f.Playlists[i], true
+}
+
+func playlistURIs(p fakespotify.Playlist) []string {
+	uris := make([]string, 0, len(p.Items))
+	for _, item := range p.Items {
+		uris = append(uris, item.URI)
+	}
+
+	return uris
+}
+
+func TestApplyRestorePlan(t *testing.T) {
+	ctx := context.Background()
+	s, fake := newService(t, fakespotify.Options{MaxPageSize: 2})
+	run := backup(ctx, t, s)
+
+	original := fakespotify.DefaultFixture()
+	roadTrip, podcasts, shared := original.Playlists[0], original.Playlists[1], original.Playlists[2]
+
+	// Since the backup, Road Trip was deleted, an item was removed from
+	// Podcasts & Chill, Shared Finds of another user was unfollowed, the
+	// oldest liked song was removed, an album was saved and an artist was
+	// unfollowed.
+	fake.Update(func(f *fakespotify.Fixture) {
+		f.Playlists[1].Items = f.Playlists[1].Items[1:]
+		f.Playlists[1].SnapshotID = "changed"
+		f.OtherPlaylists = append(f.OtherPlaylists, f.Playlists[2])
+		f.Playlists = f.Playlists[1:2]
+
+		f.SavedTracks = f.SavedTracks[:len(f.SavedTracks)-1]
+		f.SavedAlbums = append(f.SavedAlbums, fakespotify.SavedItem{
+			AddedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			ID:      "al00070123456789abcdef",
+		})
+		f.FollowedArtists = f.FollowedArtists[1:]
+	})
+
+	plan, err := s.PlanRestore(ctx, PlanRequest{RunID: run.ID, Strategy: restoreplan.StrategyOverwrite})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actions := map[string]string{}
+	for _, p := range plan.Playlists {
+		actions[p.PlaylistID] = p.Action
+	}
+
+	wantActions := map[string]string{
+		roadTrip.ID: RestoreActionCreate,
+		podcasts.ID: RestoreActionOverwrite,
+		shared.ID:   RestoreActionFollow,
+	}
+	for id, want := range wantActions {
+		if actions[id] != want {
+			t.Errorf("PlanRestore() action of %s = %q, want %q", id, actions[id], want)
+		}
+	}
+
+	plan, err = s.ApplyRestorePlan(ctx, plan.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if plan.Status != restoreplan.StatusApplied {
+		t.Errorf("ApplyRestorePlan() status = %s, want %s", plan.Status, restoreplan.StatusApplied)
+	}
+
+	fake.Update(func(f *fakespotify.Fixture) {
+		restored, ok := playlistByName(f, roadTrip.Name)
+		switch {
+		case !ok:
+			t.Errorf("ApplyRestorePlan() didn't create %q", roadTrip.Name)
+		case restored.ID == roadTrip.ID:
+			t.Errorf("ApplyRestorePlan() created %q with the ID of the deleted playlist", roadTrip.Name)
+		case !slices.Equal(playlistURIs(restored), playlistURIs(roadTrip)):
+			t.Errorf("ApplyRestorePlan() created %q with %v, want %v", roadTrip.Name, playlistURIs(restored), playlistURIs(roadTrip))
+		}
+
+		if restored, _ := playlistByName(f, podcasts.Name); !slices.Equal(playlistURIs(restored), playlistURIs(podcasts)) {
+			t.Errorf("ApplyRestorePlan() overwrote %q with %v, want %v", podcasts.Name, playlistURIs(restored), playlistURIs(podcasts))
+		}
+
+		followed, ok := playlistByName(f, shared.Name)
+		var owner fakespotify.UserRef
+		_ = json.Unmarshal(followed.Owner, &owner)
+		if !ok || followed.ID != shared.ID || owner.ID != "friend" {
+			t.Errorf("ApplyRestorePlan() = %q %s by %q, want %s followed again", shared.Name, followed.ID, owner.ID, shared.ID)
+		}
+
+		savedIDs := func(items []fakespotify.SavedItem) []string {
+			ids := make([]string, 0, len(items))
+			for _, item := range items {
+				ids = append(ids, item.ID)
+			}
+
+			return slices.Compact(slices.Sorted(slices.Values(ids)))
+		}
+
+		if got, want := savedIDs(f.SavedTracks), savedIDs(original.SavedTracks); !slices.Equal(got, want) {
+			t.Errorf("ApplyRestorePlan() liked songs = %v, want %v", got, want)
+		}
+
+		oldest := f.SavedTracks[len(f.SavedTracks)-1]
+		if want := original.SavedTracks[len(original.SavedTracks)-1]; oldest.ID != want.ID || !oldest.AddedAt.Equal(want.AddedAt) {
+			t.Errorf("ApplyRestorePlan() oldest liked song = %s at %s, want %s at %s", oldest.ID, oldest.AddedAt, want.ID, want.AddedAt)
+		}
+
+		if got, want := savedIDs(f.SavedAlbums), savedIDs(original.SavedAlbums); !slices.Equal(got, want) {
+			t.Errorf("ApplyRestorePlan() saved albums = %v, want %v", got, want)
+		}
+
+		if got, want := slices.Sorted(slices.Values(f.FollowedArtists)), original.FollowedArtists; !slices.Equal(got, want) {
+			t.Errorf("ApplyRestorePlan() followed artists = %v, want %v", got, want)
+		}
+	})
+
+	// Applying the plan again changes nothing.
+	if _, err := s.ApplyRestorePlan(ctx, plan.ID); err != nil {
+		t.Errorf("ApplyRestorePlan() again = %v, want nil", err)
+	}
+}
@@ -1,58 +1,61 @@
 package spotify
 
 import (
+	"beyerleinf/spotify-backup/ent"
 	"beyerleinf/spotify-backup/internal/server/config"
 	"beyerleinf/spotify-backup/pkg/logger"
 	"beyerleinf/spotify-backup/pkg/request"
-	util "beyerleinf/spotify-backup/pkg/util"
+	"beyerleinf/spotify-backup/pkg/service/lock"
+	"beyerleinf/spotify-backup/pkg/service/queue"
 	"context"
-	"encoding/json"
+	"sync"
 )
 
 // A Service instance.
 type Service struct {
 	slogger     *logger.Logger
 	config      *config.Config
-	state       string
 	redirectURI string
 	storageDir  string
+	db          *ent.Client
+	http        *request.Client
+	client      *Client
+	queue       *queue.Queue
+	locker      lock.Locker
+
+	refreshMu sync.Mutex
 }
 
 // New creates a [Service] instance.
-func New(config *config.Config, storageDir string) *Service {
-	return &Service{
+func New(
+	config *config.Config, storageDir string, db *ent.Client, http *request.Client, queue *queue.Queue, locker lock.Locker,
+) *Service {
+	s := &Service{
 		slogger:     logger.New("spotify", config.Server.LogLevel.Level()),
-		state:       util.GenerateRandomString(16),
 		redirectURI: config.Spotify.RedirectURI + "/ui/spotify/callback",
 		storageDir:  storageDir,
 		config:      config,
+		db:          db,
+		http:        http,
+		queue:       queue,
+		locker:      locker,
 	}
-}
 
-// GetUserProfile returns a [UserProfile] from Spotify's API.
-// [Get User Profile API]: https://developer.spotify.com/documentation/web-api/reference/get-current-users-profile
-func (s *Service) GetUserProfile() (UserProfile, error) {
-	ctx := context.Background()
+	s.client = NewClient(config.Spotify.APIURL, http, s.GetAccessToken)
 
-	token, err := s.GetAccessToken()
-	if err != nil {
-		return UserProfile{}, err
-	}
-
-	headers := map[string][]string{
-		"Authorization": {"Bearer " + token},
-	}
+	return s
+}
 
-	data, _, err := request.Get(ctx, "https://api.spotify.com/v1/me", headers)
-	if err != nil {
-		return UserProfile{}, err
-	}
+// GetUserProfile returns a [UserProfile] from Spotify's API.
+func (s *Service) GetUserProfile(ctx context.Context) (UserProfile, error) {
+	return s.client.CurrentUser(ctx)
+}
 
+// CurrentUser returns the profile of the current user.
+// [Get User Profile API]: https://developer.spotify.com/documentation/web-api/reference/get-current-users-profile
+func (c *Client) CurrentUser(ctx context.Context) (UserProfile, error) {
 	var profile UserProfile
-	err = json.Unmarshal(data, &profile)
-	if err != nil {
-		return UserProfile{}, err
-	}
+	err := c.Get(ctx, "/me", nil, &profile)
 
-	return profile, nil
+	return profile, err
 }
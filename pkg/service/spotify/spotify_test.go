@@ -0,0 +1,113 @@
+package spotify
+
+import (
+	"beyerleinf/spotify-backup/ent"
+	"beyerleinf/spotify-backup/internal/server/config"
+	"beyerleinf/spotify-backup/pkg/fakespotify"
+	"beyerleinf/spotify-backup/pkg/request"
+	"beyerleinf/spotify-backup/pkg/service/lock"
+	"beyerleinf/spotify-backup/pkg/service/queue"
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	_ "modernc.org/sqlite"
+)
+
+// fakeUserID is the ID of the user of the default fixture.
+const fakeUserID = "fakeuser"
+
+func open(t *testing.T) *ent.Client {
+	t.Helper()
+
+	name := strings.ReplaceAll(t.Name(), "/", "_")
+	db, err := sql.Open("sqlite", fmt.Sprintf("file:%s?mode=memory&cache=shared&_pragma=foreign_keys(1)", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// An in-memory database is dropped together with its last connection and
+	// canceling a query closes its connection, so one is kept open.
+	conn, err := db.Conn(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.SQLite, db)))
+	t.Cleanup(func() { client.Close() })
+
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	return client
+}
+
+// newService starts a fake Spotify seeded with the default fixture and
+// returns a service that is signed in to it.
+func newService(t *testing.T, opts fakespotify.Options) (*Service, *fakespotify.Server) {
+	t.Helper()
+
+	fake := fakespotify.New(fakespotify.DefaultFixture(), opts)
+
+	return signIn(t, fake, fake), fake
+}
+
+// signIn starts a server with handler, which passes requests on to fake,
+// and returns a service that is signed in to it.
+func signIn(t *testing.T, fake *fakespotify.Server, handler http.Handler) *Service {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	cfg := &config.Config{
+		Spotify: config.SpotifyConfig{
+			ClientID:     "client",
+			ClientSecret: "secret",
+			RedirectURI:  "http://localhost",
+			AccountsURL:  srv.URL,
+			APIURL:       srv.URL + "/v1",
+		},
+		Backup: config.BackupConfig{PlaylistConcurrency: 2},
+		Queue: config.QueueConfig{
+			MaxAttempts:  2,
+			RetryBackoff: time.Minute,
+		},
+		EncryptionKey: "0123456789abcdef0123456789abcdef",
+	}
+
+	client, err := request.NewClient(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db := open(t)
+	s := New(cfg, t.TempDir(), db, client, queue.New(cfg, db), lock.NewLocal())
+
+	ctx := context.Background()
+	authURL, err := s.GetAuthURL(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	u, err := url.Parse(authURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.HandleAuthCallback(ctx, fake.IssueCode(), u.Query().Get("state")); err != nil {
+		t.Fatal(err)
+	}
+
+	return s
+}
@@ -0,0 +1,15 @@
+package util
+
+import (
+	"fmt"
+	"os"
+	"sync"
+)
+
+// InstanceID returns an ID that identifies this server instance, e.g. as the
+// worker of a job or the leader of a task. It is unique across restarts.
+var InstanceID = sync.OnceValue(func() string {
+	hostname, _ := os.Hostname()
+
+	return fmt.Sprintf("%s-%d-%s", hostname, os.Getpid(), GenerateRandomString(6))
+})
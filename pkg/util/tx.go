@@ -0,0 +1,26 @@
+package util
+
+import (
+	"beyerleinf/spotify-backup/ent"
+	"context"
+	"errors"
+)
+
+// WithTx runs fn inside a transaction which is committed if fn succeeds
+// and rolled back otherwise.
+func WithTx(ctx context.Context, db *ent.Client, fn func(tx *ent.Tx) error) error {
+	tx, err := db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return errors.Join(err, rerr)
+		}
+
+		return err
+	}
+
+	return tx.Commit()
+}